@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/migrate"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	pgURI        = flag.String("pg-uri", "", "PostgreSQL connection URI (defaults to DATABASE_URL)")
+	dir          = flag.String("dir", "./migrations", "Directory with goose SQL migrations")
+	versionTable = flag.String("table", migrate.DefaultVersionTable, "Goose version table")
+	format       = flag.String("format", "text", "Output format: text or json")
+	timeout      = flag.Duration("timeout", time.Minute, "Dry run timeout")
+)
+
+// migrate-dryrun reports pending migrations, the locks they take and the rows they
+// touch without applying them. It is run by the migration job before goose.
+func main() {
+	flag.Parse()
+
+	if *pgURI == "" {
+		*pgURI = os.Getenv("DATABASE_URL")
+	}
+	if *pgURI == "" {
+		log.Fatal("PostgreSQL URI is required: set --pg-uri or DATABASE_URL")
+	}
+	if *format != "text" && *format != "json" {
+		log.Fatalf("Unknown output format %q, expected text or json", *format)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	ctx, cancelTimeout := context.WithTimeout(ctx, *timeout)
+	defer cancelTimeout()
+
+	conn, err := pgx.Connect(ctx, *pgURI)
+	if err != nil {
+		log.Fatalf("Failed to connect to PostgreSQL: %v", err)
+	}
+	defer conn.Close(context.Background())
+
+	report, err := migrate.DryRun(ctx, conn, *dir, *versionTable)
+	if err != nil {
+		log.Fatalf("Migration dry run failed: %v", err)
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"flag"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -12,21 +13,42 @@ import (
 	"time"
 
 	"netguard-pg-backend/internal/api/netguard"
+	"netguard-pg-backend/internal/app/apply"
+	"netguard-pg-backend/internal/app/cdc"
+	"netguard-pg-backend/internal/app/debug"
+	"netguard-pg-backend/internal/app/events"
+	"netguard-pg-backend/internal/app/leader"
 	"netguard-pg-backend/internal/app/server"
+	"netguard-pg-backend/internal/app/startup"
+	"netguard-pg-backend/internal/app/validationwebhook"
+	"netguard-pg-backend/internal/application/admission"
 	"netguard-pg-backend/internal/application/services"
+	"netguard-pg-backend/internal/application/services/resources"
+	"netguard-pg-backend/internal/application/validation"
 	"netguard-pg-backend/internal/config"
+	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/ipam"
 	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/infrastructure/repositories/mysql"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg"
+	"netguard-pg-backend/internal/infrastructure/repositories/readcache"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
+	"netguard-pg-backend/internal/infrastructure/repositories/tenancy"
+	"netguard-pg-backend/internal/logging"
 	"netguard-pg-backend/internal/sync"
 	"netguard-pg-backend/internal/sync/adapters"
 	"netguard-pg-backend/internal/sync/clients"
+	"netguard-pg-backend/internal/sync/drift"
 	"netguard-pg-backend/internal/sync/interfaces"
 	"netguard-pg-backend/internal/sync/manager"
+	"netguard-pg-backend/internal/sync/monitoring"
+	"netguard-pg-backend/internal/sync/outbox"
 	"netguard-pg-backend/internal/sync/syncers"
+	"netguard-pg-backend/internal/sync/synchronizer"
 	"netguard-pg-backend/internal/sync/types"
 
-	"github.com/go-logr/stdr"
+	"github.com/go-logr/logr"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -35,14 +57,20 @@ import (
 )
 
 var (
-	memoryDB   = flag.Bool("memory", false, "Use in-memory database")
-	pgURI      = flag.String("pg-uri", "", "PostgreSQL connection URI")
-	migrateDB  = flag.Bool("migrate", false, "Run database migrations")
-	configPath = flag.String("config", "config/config.yaml", "Path to configuration file")
-	grpcAddr   = flag.String("grpc-addr", "", "gRPC server address (overrides config)")
-	httpAddr   = flag.String("http-addr", "", "HTTP server address (overrides config)")
+	memoryDB         = flag.Bool("memory", false, "Use in-memory database")
+	snapshotPath     = flag.String("snapshot-path", "", "Path of periodic snapshots of the in-memory database, restored on startup")
+	snapshotInterval = flag.Duration("snapshot-interval", time.Minute, "Interval of in-memory database snapshots")
+	embeddedDB       = flag.String("embedded-db", "", "Path of the embedded storage file for single-node deployments without PostgreSQL")
+	pgURI            = flag.String("pg-uri", "", "PostgreSQL connection URI")
+	migrateDB        = flag.Bool("migrate", false, "Run database migrations")
+	configPath       = flag.String("config", "config/config.yaml", "Path to configuration file")
+	grpcAddr         = flag.String("grpc-addr", "", "gRPC server address (overrides config)")
+	httpAddr         = flag.String("http-addr", "", "HTTP server address (overrides config)")
 )
 
+// ruleS2SOperationLock is the advisory lock serializing RuleS2S operations across replicas
+const ruleS2SOperationLock = "netguard-rules2s-operations"
+
 func main() {
 	flag.Parse()
 
@@ -57,6 +85,15 @@ func main() {
 		log.Fatalf("Configuration validation failed: %v", err)
 	}
 
+	// Setup structured logging (klog output is redirected to the same logger)
+	if err := logging.Configure(logging.Options{
+		Level:      cfg.Log.Level,
+		Format:     cfg.Log.Format,
+		Subsystems: cfg.Log.Subsystems,
+	}); err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+
 	// Override config values with command line flags if provided
 	if *grpcAddr != "" {
 		cfg.Settings.GRPCAddr = *grpcAddr
@@ -78,49 +115,305 @@ func main() {
 
 	// Create registry
 	var registry ports.Registry
-	if *memoryDB {
+	var storage string
+	var readCache *readcache.Cache
+	// queryMetrics writes the query metrics of the PostgreSQL registry
+	queryMetrics := func(io.Writer) error { return nil }
+	if *memoryDB && *snapshotPath != "" {
+		memRegistry, err := mem.NewSnapshotRegistry(*snapshotPath)
+		if err != nil {
+			log.Fatalf("Failed to restore in-memory database snapshot: %v", err)
+		}
+		go memRegistry.RunSnapshots(ctx, *snapshotInterval)
+		log.Printf("📸 In-memory database is snapshotted to %s every %s", *snapshotPath, *snapshotInterval)
+		registry = memRegistry
+		storage = startup.StorageMemory
+	} else if *memoryDB {
 		registry = mem.NewRegistry()
+		storage = startup.StorageMemory
+	} else if *embeddedDB != "" {
+		fileRegistry, err := mem.NewFileRegistry(*embeddedDB)
+		if err != nil {
+			log.Fatalf("Failed to open embedded storage: %v", err)
+		}
+		log.Printf("💾 Resources are stored in %s", *embeddedDB)
+		registry = fileRegistry
+		storage = startup.StorageEmbedded
 	} else if *pgURI != "" {
 
 		// Migrations are now handled by separate Goose container (sgroups pattern)
 		// The --migrate flag is deprecated but kept for compatibility
 		if *migrateDB {
 			log.Println("⚠️  DEPRECATED: --migrate flag is no longer needed. Migrations are handled by separate Goose container.")
-			log.Println("✅ Assuming migrations were completed by the netguard-migrations Kubernetes Job")
 		}
 
 		// Create PostgreSQL registry (fixed after Docker image cache issue)
-		log.Println("Creating PostgreSQL registry...")
-		pgRegistry, err := pg.NewRegistryFromURI(ctx, *pgURI)
+		pgRegistry, err := pg.NewRegistryFromURI(ctx, *pgURI, cfg.Postgres)
 		if err != nil {
 			log.Fatalf("Failed to create PostgreSQL registry: %v", err)
 		}
 		if pgRegistry == nil {
 			log.Fatalf("PostgreSQL registry is nil!")
 		}
-		log.Println("PostgreSQL registry created successfully")
+		// 🗄️ SCHEMA_CHECK: migrations are applied by the Goose container, the backend
+		// refuses to serve a schema older or newer than the one it was built against
+		schema, err := pgRegistry.SchemaStatus(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read database schema version: %v", err)
+		}
+		if err := schema.Err(); err != nil {
+			log.Fatalf("Refusing to serve: %v", err)
+		}
+		log.Printf("🗄️ Database schema version %d", schema.Version)
+		if cfg.ReadCache.Enabled {
+			readCache = readcache.New(cfg.ReadCache)
+			pgRegistry.SetReadCache(readCache)
+			log.Printf("🗃️ Reader lookups are cached for %s", cfg.ReadCache.TTL)
+		}
+		queryMetrics = pgRegistry.WriteQueryMetrics
 		registry = pgRegistry
+		storage = startup.StoragePostgres
+	} else if cfg.MySQL.DSN != "" {
+		// Migrations of migrations/mysql are applied by goose like the PostgreSQL ones
+		mysqlRegistry, err := mysql.NewRegistry(ctx, cfg.MySQL)
+		if err != nil {
+			log.Fatalf("Failed to create MySQL registry: %v", err)
+		}
+		schema, err := mysqlRegistry.SchemaStatus(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read database schema version: %v", err)
+		}
+		if err := schema.Err(); err != nil {
+			log.Fatalf("Refusing to serve: %v", err)
+		}
+		log.Printf("🗄️ MySQL database schema version %d", schema.Version)
+		registry = mysqlRegistry
+		storage = startup.StorageMySQL
 	} else {
-		log.Fatal("Either --memory or --pg-uri must be specified")
+		log.Fatal("Either --memory, --embedded-db, --pg-uri or mysql.dsn (MYSQL_DSN) must be specified")
 	}
 	defer registry.Close()
 
+	// Background jobs run on a single replica, requests are served by all replicas
+	elector := setupLeaderElection(ctx, cfg, registry)
+
 	// Setup sync manager
-	syncManager := setupSyncManager(ctx, cfg)
+	syncManager, sgroupsConnections := setupSyncManager(ctx, cfg)
 
 	// Setup reverse sync system (SGROUP -> NETGUARD synchronization)
-	reverseSyncSystem := setupReverseSyncSystem(ctx, cfg, registry, syncManager)
+	reverseSyncCtx, stopReverseSync := context.WithCancel(ctx)
+	reverseSyncSystem := setupReverseSyncSystem(reverseSyncCtx, cfg, registry, syncManager, sgroupsConnections[types.SyncTargetDefault], elector)
+	reloader := newSyncReloader(*configPath, cfg, registry, syncManager, sgroupsConnections, elector)
+	reloader.setReverseSync(reverseSyncSystem, stopReverseSync)
 
 	// Create condition manager (needed for facade)
 	conditionManager := services.NewConditionManager(registry)
 
+	// Requests with tenant credentials only see and change resources of their tenant,
+	// background jobs and the infrastructure below keep the unrestricted registry
+	facadeRegistry := registry
+	if cfg.Tenancy.Enabled {
+		facadeRegistry = tenancy.NewRegistry(registry)
+		log.Printf("🏢 Tenant isolation is enabled for %d tenants", len(cfg.Tenancy.Tenants))
+	}
+
 	// Create facade service (new architecture)
-	netguardFacade := services.NewNetguardFacade(registry, conditionManager, syncManager)
+	netguardFacade := services.NewNetguardFacade(facadeRegistry, conditionManager, syncManager)
+	netguardFacade.SetReverseSyncStatus(reloader.reverseSyncStatus)
+	netguardFacade.SetReverseSyncController(reloader)
+	if cfg.Settings.LegacyRuleGeneration {
+		netguardFacade.SetLegacyRuleGeneration(true)
+	}
+	netguardFacade.SetRulePriorityCalculator(rulePriorityStrategy(cfg.RulePriority))
+
+	// Imported resources failing validation are quarantined for review instead of being dropped
+	if quarantine := registryQuarantine(registry); quarantine != nil {
+		netguardFacade.SetQuarantine(quarantine)
+		netguardFacade.SetQuarantinePromoter(models.QuarantineSourceApply, apply.Promoter(netguardFacade))
+		netguardFacade.SetQuarantinePromoter(models.QuarantineSourceReverseSync, adapters.PromoteQuarantinedHost(adapters.NewPostgreSQLHostWriter(registry, nil)))
+	}
+
+	// RuleS2S operations of all replicas sharing the database are serialized
+	if pgRegistry, ok := registry.(*pg.Registry); ok {
+		netguardFacade.SetOperationLock(pgRegistry.OperationLock(ruleS2SOperationLock))
+	}
+
+	// Bulk operations are admitted at a lower priority than interactive ones
+	if cfg.Admission.Enabled {
+		netguardFacade.SetAdmission(admission.NewController(admission.Config{
+			BulkThreshold:    cfg.Admission.BulkThreshold,
+			BulkConcurrency:  cfg.Admission.BulkConcurrency,
+			BulkBatchSize:    cfg.Admission.BulkBatchSize,
+			BulkBatchPause:   cfg.Admission.BulkBatchPause,
+			InteractiveYield: cfg.Admission.InteractiveYield,
+		}))
+	}
+
+	// Bound resource and request sizes enforced by validators
+	validation.SetLimits(validation.Limits{
+		MaxPortsPerService:         cfg.Limits.MaxPortsPerService,
+		MaxAddressGroupsPerService: cfg.Limits.MaxAddressGroupsPerService,
+		MaxResourcesPerRequest:     cfg.Limits.MaxResourcesPerRequest,
+		MaxNameLength:              cfg.Limits.MaxNameLength,
+	})
+
+	// Reserve Network CIDRs in the external IPAM
+	if cfg.IPAM.Enabled {
+		netbox, err := ipam.NewNetBox(cfg.IPAM.NetBox)
+		if err != nil {
+			log.Fatalf("Failed to setup IPAM: %v", err)
+		}
+		netguardFacade.SetIPAM(netbox)
+		log.Printf("🗺️  Network CIDRs are reserved in NetBox %s", cfg.IPAM.NetBox.URL)
+	}
+
+	// Persist the Watch change feed so watchers can resume after reconnects
+	var changeLog ports.ChangeLog
+	switch r := registry.(type) {
+	case *pg.Registry:
+		changeLog = r.ChangeLog()
+	case *mem.Registry:
+		changeLog = r.ChangeLog()
+	default:
+		changeLog = mem.NewChangeLog()
+	}
+	if err := netguardFacade.ChangeFeed().SetLog(ctx, changeLog); err != nil {
+		log.Fatalf("Failed to setup change log: %v", err)
+	}
+	// Events of all replicas are read from the shared log, compaction runs on the leader only
+	go netguardFacade.ChangeFeed().RunTail(ctx, cfg.ChangeFeed.TailInterval)
+	go elector.RunWhileLeader(ctx, "change feed compaction", func(ctx context.Context) {
+		netguardFacade.ChangeFeed().RunCompaction(ctx, cfg.ChangeFeed.Horizon, cfg.ChangeFeed.CompactionInterval)
+	})
+
+	// Record condition status transitions, the history is compacted by the leader
+	var conditionHistory ports.ConditionHistory
+	switch r := registry.(type) {
+	case *pg.Registry:
+		conditionHistory = r.ConditionHistory()
+	case *mem.Registry:
+		conditionHistory = r.ConditionHistory()
+	default:
+		conditionHistory = mem.NewConditionHistory()
+	}
+	netguardFacade.SetConditionHistory(conditionHistory)
+	go elector.RunWhileLeader(ctx, "condition history compaction", func(ctx context.Context) {
+		netguardFacade.RunConditionHistoryCompaction(ctx, cfg.ConditionHistory.Horizon, cfg.ConditionHistory.CompactionInterval)
+	})
+
+	// Publish domain events of committed changes to the configured sinks
+	eventMetrics := setupEvents(ctx, cfg, netguardFacade)
+
+	// Notify security teams about changes of the effective policy
+	if cfg.RuleWebhook.Enabled {
+		ruleWebhook := events.NewRuleWebhook(cfg.RuleWebhook.URL, cfg.RuleWebhook.Headers, cfg.RuleWebhook.Timeout,
+			cfg.RuleWebhook.Interval, cfg.RuleWebhook.Namespaces, logging.For(logging.SubsystemEvents))
+		netguardFacade.SetIEAgAgRuleDiffNotifier(ruleWebhook)
+		go ruleWebhook.Run(ctx)
+		log.Printf("🔔 IEAgAgRule changes are posted to %s", cfg.RuleWebhook.URL)
+	}
+
+	// Stream committed resource changes to Kafka or NATS
+	changeDataMetrics := setupChangeData(ctx, cfg, netguardFacade)
+
+	// Enforce external validation webhooks on writes of direct backend clients
+	if cfg.ValidationHooks.Enabled {
+		webhooks := make([]validationwebhook.Webhook, 0, len(cfg.ValidationHooks.Webhooks))
+		for _, webhook := range cfg.ValidationHooks.Webhooks {
+			webhooks = append(webhooks, validationwebhook.Webhook{
+				Name:          webhook.Name,
+				URL:           webhook.URL,
+				Headers:       webhook.Headers,
+				Timeout:       webhook.Timeout,
+				Kinds:         webhook.Kinds,
+				Namespaces:    webhook.Namespaces,
+				Operations:    webhook.Operations,
+				FailurePolicy: validationwebhook.FailurePolicy(webhook.FailurePolicy),
+			})
+		}
+		dispatcher, err := validationwebhook.NewDispatcher(webhooks, logging.For(logging.SubsystemValidation))
+		if err != nil {
+			log.Fatalf("Failed to setup validation webhooks: %v", err)
+		}
+		netguardFacade.SetResourceValidator(dispatcher)
+		log.Printf("🛡️  Writes are validated by %d validation webhooks", len(webhooks))
+	}
+
+	// Generate and remove IEAgAg rules of RuleS2S entering or leaving their validity window
+	go elector.RunWhileLeader(ctx, "rule schedule", func(ctx context.Context) {
+		netguardFacade.RunRuleSchedule(ctx, cfg.RuleSchedule.Interval)
+	})
+
+	// Recalculate only the aggregation groups of changed RuleS2S from now on
+	go func() {
+		if err := netguardFacade.RebuildIEAgAgRuleIndex(ctx); err != nil {
+			log.Printf("⚠️  Failed to build IEAgAg rule aggregation index, rules are recalculated in full: %v", err)
+		}
+	}()
+
+	// Remove IEAgAg rules orphaned by missed recalculations
+	if cfg.RuleGC.Enabled {
+		go elector.RunWhileLeader(ctx, "rule gc", func(ctx context.Context) {
+			netguardFacade.RunRuleGC(ctx, cfg.RuleGC.Interval, resources.RuleGCOptions{
+				DryRun:           cfg.RuleGC.DryRun,
+				MaxDeletionRatio: cfg.RuleGC.MaxDeletionRatio,
+				MinRulesForRatio: cfg.RuleGC.MinRules,
+			})
+		})
+	}
 
-	// Using immediate force sync approach instead of finalizers
+	// Surface sgroups unavailability in resource conditions
+	reportCircuitBreakers(ctx, reloader.namespaceTargets, sgroupsConnections, netguardFacade)
+
+	// Deliver sgroups sync operations through the transactional outbox
+	if syncManager != nil && cfg.Sync.Outbox.Enabled {
+		setupSyncOutbox(ctx, cfg, registry, syncManager, netguardFacade)
+	}
+
+	// Periodically compare the database with sgroups
+	var driftDetector *drift.Detector
+	if syncManager != nil && cfg.Sync.Drift.Enabled {
+		driftDetector = setupDriftDetector(ctx, cfg, registry, syncManager, sgroupsConnections[types.SyncTargetDefault], netguardFacade, elector)
+	}
+	reloader.driftDetector = driftDetector
+
+	// Re-read sync configuration on SIGHUP
+	reloadCh := make(chan os.Signal, 1)
+	signal.Notify(reloadCh, syscall.SIGHUP)
+	reloadDone := make(chan struct{})
+	go func() {
+		defer close(reloadDone)
+		reloader.Run(ctx, reloadCh)
+	}()
+
+	// Finish deletions of resources with finalizers that didn't complete on the last sync
+	go elector.RunWhileLeader(ctx, "deletion finalizer", func(ctx context.Context) {
+		netguardFacade.RunDeletionFinalizer(ctx, cfg.Finalizer.Interval)
+	})
+
+	// Refresh conditions of resources whose dependencies changed out-of-band
+	if cfg.Revalidation.Enabled {
+		go elector.RunWhileLeader(ctx, "revalidation", func(ctx context.Context) {
+			netguardFacade.RunRevalidation(ctx, cfg.Revalidation.Interval)
+		})
+	}
 
 	// Setup gRPC server
-	grpcServer := grpc.NewServer()
+	var grpcOptions []grpc.ServerOption
+	if cfg.Limits.MaxGRPCRecvMessageSize > 0 {
+		grpcOptions = append(grpcOptions, grpc.MaxRecvMsgSize(cfg.Limits.MaxGRPCRecvMessageSize))
+	}
+	if cfg.Limits.MaxGRPCSendMessageSize > 0 {
+		grpcOptions = append(grpcOptions, grpc.MaxSendMsgSize(cfg.Limits.MaxGRPCSendMessageSize))
+	}
+	var authenticator *netguard.TenantAuthenticator
+	if cfg.Tenancy.Enabled {
+		authenticator = netguard.NewTenantAuthenticator(cfg.Tenancy.TenantsByToken(), cfg.Tenancy.RequireToken)
+		grpcOptions = append(grpcOptions,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamInterceptor()))
+	}
+	grpcServer := grpc.NewServer(grpcOptions...)
 	netguardServer := netguard.NewNetguardServiceServer(netguardFacade)
 	netguardpb.RegisterNetguardServiceServer(grpcServer, netguardServer)
 
@@ -128,6 +421,7 @@ func main() {
 	healthServer := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
 	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	reportSGroupsConnections(sgroupsConnections, healthServer)
 
 	// Start gRPC server
 	lis, err := net.Listen("tcp", cfg.Settings.GRPCAddr)
@@ -140,8 +434,46 @@ func main() {
 		}
 	}()
 
+	// Debug endpoints are exposed only when explicitly enabled
+	var debugHandler http.Handler
+	if cfg.Debug.Enabled {
+		handler := debug.NewHandler(registry, syncManager)
+		if driftDetector != nil {
+			handler.SetDriftDetector(driftDetector)
+		}
+		if connection := sgroupsConnections[types.SyncTargetDefault]; connection != nil && connection.shadow != nil {
+			handler.SetSyncShadow(connection.shadow)
+		}
+		handler.SetHostConflicts(reloader.hostConflicts)
+		debugHandler = handler
+	}
+
 	// Setup HTTP server with gRPC-Gateway
-	httpServer, err := server.SetupServer(ctx, cfg.Settings.GRPCAddr, cfg.Settings.HTTPAddr, netguardFacade)
+	// Circuit breaker, sgroups connection state, repository List selectivity, PostgreSQL query
+	// durations, read cache and reverse sync statistics are exported at /metrics
+	var breakers []*clients.CircuitBreakerGateway
+	var monitors []*clients.ConnectionMonitor
+	for _, connection := range sgroupsConnections {
+		if connection.breaker != nil {
+			breakers = append(breakers, connection.breaker)
+		}
+		monitors = append(monitors, connection.monitor)
+	}
+	metricsHandler := clients.MetricsHandler(breakers, monitors, func(w io.Writer) error {
+		return readstats.WriteMetrics(w, readstats.Default())
+	}, queryMetrics, func(w io.Writer) error {
+		if readCache != nil {
+			return readcache.WriteMetrics(w, readCache)
+		}
+		return nil
+	}, func(w io.Writer) error {
+		if status, enabled := reloader.reverseSyncStatus(); enabled {
+			return monitoring.WriteStatusMetrics(w, status)
+		}
+		return nil
+	}, netguardFacade.WriteRuleGCMetrics, elector.WriteMetrics, eventMetrics, changeDataMetrics)
+
+	httpServer, err := server.SetupServer(ctx, cfg.Settings.GRPCAddr, cfg.Settings.HTTPAddr, netguardFacade, debugHandler, metricsHandler, authenticator)
 	if err != nil {
 		log.Fatalf("Failed to setup server: %v", err)
 	}
@@ -153,16 +485,25 @@ func main() {
 		}
 	}()
 
+	// Report how the backend was started in a single structured record
+	startupLogger := logging.For(logging.SubsystemStartup)
+	startupReport := startup.Build(ctx, cfg, startup.Options{
+		Storage:     storage,
+		Registry:    registry,
+		SyncManager: syncManager,
+		ReverseSync: reverseSyncSystem != nil,
+	}, startupLogger)
+	startup.Log(startupLogger, startupReport)
+	netguardFacade.SetStartupReport(startupReport)
+
 	// Wait for context cancellation
 	<-ctx.Done()
 
 	// Gracefully stop services
 
-	// Stop reverse sync system first
-	if reverseSyncSystem != nil {
-		if err := reverseSyncSystem.Stop(); err != nil {
-		}
-	}
+	// Stop reverse sync system first, once a reload in progress is finished
+	<-reloadDone
+	reloader.stopReverseSync()
 
 	// Stop gRPC and HTTP servers
 	grpcServer.GracefulStop()
@@ -170,75 +511,397 @@ func main() {
 	}
 }
 
-// setupSyncManager creates and configures the sync manager for sgroups integration
-func setupSyncManager(ctx context.Context, cfg *config.Config) interfaces.SyncManager {
+// sgroupsConnection holds the circuit breaker and health monitor of a sgroups target
+type sgroupsConnection struct {
+	config  clients.SGroupsConfig
+	gateway *clients.SwappableGateway // replaced when the endpoint is reloaded
+	breaker *clients.CircuitBreakerGateway // nil if the circuit breaker is disabled
+	monitor *clients.ConnectionMonitor
+	shadow  *clients.SyncShadow // nil unless sync shadow mode is enabled
+}
+
+// setupSyncManager creates and configures the sync manager for sgroups integration.
+// sgroups is not required to be reachable: connections are health checked in background
+// and syncs failed meanwhile are retried. Connections are returned by target name.
+func setupSyncManager(ctx context.Context, cfg *config.Config) (interfaces.SyncManager, map[string]*sgroupsConnection) {
 	// Use sync configuration from loaded config
 	syncConfig := cfg.Sync
 
 	// Validate configuration
 	if err := syncConfig.Validate(); err != nil {
-		return nil
+		log.Fatalf("Sync configuration validation failed: %v", err)
 	}
 
 	// Skip sync setup if disabled
 	if !syncConfig.Enabled {
-		return nil
+		return nil, nil
 	}
 
-	// Create SGroups client
-	sgroupsClient, err := clients.NewSGroupsClient(syncConfig.SGroups)
-	if err != nil {
-		return nil
+	// In shadow mode requests of all sgroups targets are recorded instead of being sent
+	var shadow *clients.SyncShadow
+	if syncConfig.Shadow.Enabled {
+		log.Printf("🕶️  Sync shadow mode is enabled, requests are not sent to sgroups")
+		shadow = clients.NewSyncShadow(syncConfig.Shadow)
 	}
 
-	// Test connection to sgroups
-	if err := sgroupsClient.Health(ctx); err != nil {
-		return nil
+	// Create SGroups client
+	connections := make(map[string]*sgroupsConnection)
+	sgroupsClient, err := newSGroupsClient(ctx, syncConfig.SGroups, types.SyncTargetDefault, connections, shadow)
+	if err != nil {
+		log.Fatalf("Failed to create sgroups client: %v", err)
 	}
 
 	// Create logger for sync manager
-	logger := stdr.New(log.Default())
+	logger := logging.For(logging.SubsystemSync)
 
 	// Create sync manager
 	syncManager := manager.NewSyncManager(sgroupsClient, logger)
+	if configurer, ok := syncManager.(interfaces.RetryPolicyConfigurer); ok {
+		configurer.SetRetryPolicy(syncConfig.Retry, syncConfig.RetryOverrides)
+	}
+	if configurer, ok := syncManager.(interfaces.SyncBatchingConfigurer); ok && syncConfig.Batching.Window > 0 {
+		configurer.SetBatching(syncConfig.Batching)
+	}
 
-	// Register AddressGroup syncer
-	addressGroupSyncer := syncers.NewAddressGroupSyncer(sgroupsClient, logger)
-	if err := syncManager.RegisterSyncer(types.SyncSubjectTypeGroups, addressGroupSyncer); err != nil {
+	if configurer, ok := syncManager.(interfaces.SyncScopeConfigurer); ok {
+		if err := configurer.SetSyncScope(syncConfig.Scope); err != nil {
+			log.Fatalf("Failed to configure sync scope: %v", err)
+		}
+	}
+
+	// Register syncers of the default sgroups target
+	if err := registerSyncers(syncManager.RegisterSyncer, sgroupsClient, logger); err != nil {
+		log.Fatalf("Failed to register syncers: %v", err)
+	}
+
+	// Register syncers of additional sgroups targets and route namespaces to them
+	if router, ok := syncManager.(interfaces.SyncTargetRouter); ok {
+		for name, targetConfig := range syncConfig.Targets {
+			targetClient, err := newSGroupsClient(ctx, targetConfig, name, connections, shadow)
+			if err != nil {
+				log.Fatalf("Failed to create sgroups client of sync target %s: %v", name, err)
+			}
+
+			register := func(subjectType types.SyncSubjectType, syncer interface{}) error {
+				return router.RegisterTargetSyncer(name, subjectType, syncer)
+			}
+			if err := registerSyncers(register, targetClient, logger.WithValues("target", name)); err != nil {
+				log.Fatalf("Failed to register syncers of sync target %s: %v", name, err)
+			}
+		}
+
+		if err := router.SetNamespaceTargets(syncConfig.Namespaces); err != nil {
+			log.Fatalf("Failed to route namespaces to sync targets: %v", err)
+		}
+	}
+
+	if err := applyDisabledSyncers(syncManager, syncConfig.DisabledSyncers); err != nil {
+		log.Fatalf("Failed to disable syncers: %v", err)
+	}
+
+	// Start sync manager
+	if err := syncManager.Start(ctx); err != nil {
+		log.Fatalf("Failed to start sync manager: %v", err)
+	}
+
+	return syncManager, connections
+}
+
+// applyDisabledSyncers disables the syncers of the listed subject types and enables the others
+func applyDisabledSyncers(syncManager interfaces.SyncManager, disabledSyncers []types.SyncSubjectType) error {
+	controller, ok := syncManager.(interfaces.SyncerController)
+	if !ok {
 		return nil
 	}
 
+	disabled := make(map[types.SyncSubjectType]bool, len(disabledSyncers))
+	for _, subjectType := range disabledSyncers {
+		disabled[subjectType] = true
+		if err := controller.SetSyncerEnabled(subjectType, false); err != nil {
+			return err
+		}
+	}
+	for _, state := range controller.SyncerStates() {
+		if !state.Enabled && !disabled[state.SubjectType] {
+			if err := controller.SetSyncerEnabled(state.SubjectType, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newSGroupsClient creates a sgroups client of the target, wrapped with a circuit breaker when enabled
+// and with a shadow gateway recording syncs into shadow when it is not nil.
+// The connection is health checked in background until ctx is canceled.
+func newSGroupsClient(ctx context.Context, sgroupsConfig clients.SGroupsConfig, target string, connections map[string]*sgroupsConnection, shadow *clients.SyncShadow) (interfaces.SGroupGateway, error) {
+	sgroupsClient, err := clients.NewSGroupsClient(sgroupsConfig)
+	if err != nil {
+		return nil, err
+	}
+	gateway := clients.NewSwappableGateway(sgroupsClient)
+
+	// The monitor checks the client itself, so health checks don't count against the circuit breaker
+	connection := &sgroupsConnection{
+		config:  sgroupsConfig,
+		gateway: gateway,
+		monitor: clients.NewConnectionMonitor(gateway, sgroupsConfig.Connection, target),
+	}
+	connections[target] = connection
+	go connection.monitor.Run(ctx)
+
+	var result interfaces.SGroupGateway = gateway
+	if sgroupsConfig.CircuitBreaker.Enabled {
+		connection.breaker = clients.NewCircuitBreakerGateway(gateway, sgroupsConfig.CircuitBreaker, target)
+		result = connection.breaker
+	}
+	if shadow != nil {
+		connection.shadow = shadow
+		result = clients.NewShadowGateway(result, shadow, target)
+	}
+	return result, nil
+}
+
+// reportSGroupsConnections reflects sgroups connection state in the gRPC health service:
+// "sgroups" for the default target and "sgroups/<target>" for others
+func reportSGroupsConnections(connections map[string]*sgroupsConnection, healthServer *health.Server) {
+	for target, connection := range connections {
+		service := "sgroups"
+		if target != types.SyncTargetDefault {
+			service += "/" + target
+		}
+		setStatus := func(state clients.ConnectionState) {
+			servingStatus := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			if state == clients.ConnectionReady {
+				servingStatus = grpc_health_v1.HealthCheckResponse_SERVING
+			}
+			healthServer.SetServingStatus(service, servingStatus)
+		}
+		connection.monitor.OnStateChange(func(state clients.ConnectionState) {
+			log.Printf("🔌 sgroups connection of target %s is %s", target, state)
+			setStatus(state)
+		})
+		setStatus(connection.monitor.State())
+	}
+}
+
+// reportCircuitBreakers reflects circuit breaker state changes into conditions of AddressGroups
+// synchronized to the sgroups instance of the breaker
+func reportCircuitBreakers(ctx context.Context, namespaceTargets func() map[string]string, connections map[string]*sgroupsConnection, facade *services.NetguardFacade) {
+	for target, connection := range connections {
+		breaker := connection.breaker
+		if breaker == nil {
+			continue
+		}
+		inTarget := func(namespace string) bool {
+			routed, exists := namespaceTargets()[namespace]
+			if !exists {
+				routed = types.SyncTargetDefault
+			}
+			return routed == target
+		}
+		breaker.OnStateChange(func(state clients.CircuitState) {
+			log.Printf("🔌 sgroups circuit breaker of target %s is %s", target, state)
+			switch state {
+			case clients.CircuitOpen:
+				facade.ReportSGroupsAvailability(ctx, false, inTarget)
+			case clients.CircuitClosed:
+				facade.ReportSGroupsAvailability(ctx, true, inTarget)
+			}
+		})
+	}
+}
+
+// registerSyncers registers syncers of all synchronized subject types backed by the sgroups client
+func registerSyncers(register func(types.SyncSubjectType, interface{}) error, sgroupsClient interfaces.SGroupGateway, logger logr.Logger) error {
+	// Register AddressGroup syncer
+	if err := register(types.SyncSubjectTypeGroups, syncers.NewAddressGroupSyncer(sgroupsClient, logger)); err != nil {
+		return err
+	}
+
 	// Register Network syncer
-	networkSyncer := syncers.NewNetworkSyncer(sgroupsClient, logger)
-	if err := syncManager.RegisterSyncer(types.SyncSubjectTypeNetworks, networkSyncer); err != nil {
-		return nil
+	if err := register(types.SyncSubjectTypeNetworks, syncers.NewNetworkSyncer(sgroupsClient, logger)); err != nil {
+		return err
 	}
 
 	// Register Host syncer
-	hostSyncer := syncers.NewHostSyncer(sgroupsClient, logger)
-	if err := syncManager.RegisterSyncer(types.SyncSubjectTypeHosts, hostSyncer); err != nil {
-		return nil
+	if err := register(types.SyncSubjectTypeHosts, syncers.NewHostSyncer(sgroupsClient, logger)); err != nil {
+		return err
 	}
 
 	// Register IEAgAgRule syncer
-	ieagagRuleSyncer := syncers.NewIEAgAgRuleSyncer(sgroupsClient, logger)
-	if err := syncManager.RegisterSyncer(types.SyncSubjectTypeIEAgAgRules, ieagagRuleSyncer); err != nil {
-		return nil
+	return register(types.SyncSubjectTypeIEAgAgRules, syncers.NewIEAgAgRuleSyncer(sgroupsClient, logger))
+}
+
+// setupSyncOutbox starts the outbox dispatcher and routes facade sgroups sync through it
+func setupSyncOutbox(ctx context.Context, cfg *config.Config, registry ports.Registry, syncManager interfaces.SyncManager, facade *services.NetguardFacade) {
+	var syncOutbox ports.SyncOutbox
+	switch r := registry.(type) {
+	case *pg.Registry:
+		syncOutbox = r.SyncOutbox()
+	case *mem.Registry:
+		syncOutbox = r.SyncOutbox()
+	default:
+		log.Printf("⚠️  Sync outbox is not supported by %T, syncing after commit", registry)
+		return
 	}
 
-	// Start sync manager
-	if err := syncManager.Start(ctx); err != nil {
+	outboxConfig := outbox.DefaultConfig()
+	outboxConfig.PollInterval = cfg.Sync.Outbox.PollInterval
+	outboxConfig.BatchSize = cfg.Sync.Outbox.BatchSize
+	outboxConfig.Lease = cfg.Sync.Outbox.Lease
+	outboxConfig.MaxBackoff = cfg.Sync.Outbox.MaxBackoff
+	outboxConfig.MaxAttempts = cfg.Sync.Outbox.MaxAttempts
+
+	dispatcher := outbox.NewDispatcher(syncOutbox, syncManager, outboxConfig, logging.For(logging.SubsystemSync))
+	dispatcher.SetReporter(facade)
+	facade.SetSyncOutbox(dispatcher)
+	if deadLetters, ok := syncOutbox.(ports.SyncDeadLetterQueue); ok {
+		facade.SetSyncDeadLetters(deadLetters)
+	}
+	go dispatcher.Run(ctx)
+}
+
+// setupDriftDetector starts the drift detection between the database and the default sgroups instance
+func setupDriftDetector(ctx context.Context, cfg *config.Config, registry ports.Registry, syncManager interfaces.SyncManager, connection *sgroupsConnection, facade *services.NetguardFacade, elector *leader.Elector) *drift.Detector {
+	if connection == nil {
+		return nil
+	}
+	if _, ok := connection.gateway.Gateway().(interfaces.SGroupStateLister); !ok {
+		log.Printf("⚠️  Drift detection is not supported by %T", connection.gateway.Gateway())
 		return nil
 	}
 
-	return syncManager
+	// The detector lists sgroups state through the swappable gateway to follow endpoint reloads
+	detector := drift.NewDetector(registry, connection.gateway, syncManager, newDriftConfig(cfg.Sync), logging.For(logging.SubsystemSync))
+	detector.SetReporter(facade)
+	go elector.RunWhileLeader(ctx, "drift detection", detector.Run)
+	return detector
+}
+
+// registryQuarantine returns the quarantine of resources imported into the registry, nil if not supported
+func registryQuarantine(registry ports.Registry) ports.Quarantine {
+	switch r := registry.(type) {
+	case *pg.Registry:
+		return r.Quarantine()
+	case *mem.Registry:
+		return r.Quarantine()
+	}
+	return nil
+}
+
+// rulePriorityStrategy creates the strategy calculating priorities of generated IEAgAgRules
+func rulePriorityStrategy(cfg config.RulePriority) resources.RulePriorityStrategy {
+	strategy := resources.RulePriorityStrategy{
+		Default:    cfg.Default,
+		Tiers:      cfg.Tiers,
+		Namespaces: cfg.Namespaces,
+		Traffic:    make(map[models.Traffic]int32, len(cfg.Traffic)),
+	}
+	for traffic, priority := range cfg.Traffic {
+		strategy.Traffic[models.Traffic(traffic)] = priority
+	}
+	return strategy
+}
+
+// setupEvents starts the domain event bus with the enabled sinks and returns its metrics writer
+func setupEvents(ctx context.Context, cfg *config.Config, facade *services.NetguardFacade) func(io.Writer) error {
+	if !cfg.Events.Enabled {
+		return func(io.Writer) error { return nil }
+	}
+
+	logger := logging.For(logging.SubsystemEvents)
+	var sinks []events.Sink
+	if cfg.Events.Log.Enabled {
+		sinks = append(sinks, events.NewLogSink(logger))
+	}
+	if cfg.Events.Webhook.Enabled {
+		sinks = append(sinks, events.NewWebhookSink(cfg.Events.Webhook.URL, cfg.Events.Webhook.Headers, cfg.Events.Webhook.Timeout))
+	}
+	if cfg.Events.Kubernetes.Enabled {
+		kubeSink, err := events.NewKubernetesSink(cfg.Events.Kubernetes.Kubeconfig, cfg.Events.Kubernetes.Component)
+		if err != nil {
+			log.Fatalf("Failed to setup Kubernetes event sink: %v", err)
+		}
+		go func() {
+			<-ctx.Done()
+			kubeSink.Close()
+		}()
+		sinks = append(sinks, kubeSink)
+	}
+
+	bus := events.NewBus(cfg.Events.BufferSize, logger, sinks...)
+	go bus.Run(ctx)
+	facade.SetEventPublisher(bus)
+	log.Printf("📣 Domain events are published to %d sinks", len(sinks))
+	return bus.WriteMetrics
+}
+
+// setupChangeData starts publishing committed resource changes and returns the publisher metrics writer
+func setupChangeData(ctx context.Context, cfg *config.Config, facade *services.NetguardFacade) func(io.Writer) error {
+	if !cfg.ChangeData.Enabled {
+		return func(io.Writer) error { return nil }
+	}
+
+	var transport cdc.Transport
+	var err error
+	switch cfg.ChangeData.Transport {
+	case config.ChangeDataTransportNATS:
+		transport, err = cdc.NewNATSTransport(cdc.NATSOptions{
+			URL:           cfg.ChangeData.NATS.URL,
+			SubjectPrefix: cfg.ChangeData.NATS.SubjectPrefix,
+			User:          cfg.ChangeData.NATS.User,
+			Password:      cfg.ChangeData.NATS.Password,
+			Token:         cfg.ChangeData.NATS.Token,
+			Timeout:       cfg.ChangeData.Timeout,
+		})
+	default:
+		transport, err = cdc.NewKafkaTransport(cdc.KafkaOptions{
+			RESTProxyURL: cfg.ChangeData.Kafka.RESTProxyURL,
+			Topic:        cfg.ChangeData.Kafka.Topic,
+			Headers:      cfg.ChangeData.Kafka.Headers,
+			Timeout:      cfg.ChangeData.Timeout,
+		})
+	}
+	if err != nil {
+		log.Fatalf("Failed to setup change data transport: %v", err)
+	}
+
+	publisher := cdc.NewPublisher(transport, cdc.Options{
+		BufferSize:    cfg.ChangeData.BufferSize,
+		MaxAttempts:   cfg.ChangeData.MaxAttempts,
+		RetryInterval: cfg.ChangeData.RetryInterval,
+	}, logging.For(logging.SubsystemChangeData))
+	go publisher.Run(ctx)
+	facade.SetResourceChangePublisher(publisher)
+	log.Printf("🛰️  Resource changes are published to %s", transport.Name())
+	return publisher.WriteMetrics
+}
+
+// setupLeaderElection starts campaigning for the leader lock. Without leader election or with the
+// in-memory registry this replica runs the background jobs from the start.
+func setupLeaderElection(ctx context.Context, cfg *config.Config, registry ports.Registry) *leader.Elector {
+	if !cfg.Leader.Enabled {
+		return leader.Standalone()
+	}
+	pgRegistry, ok := registry.(*pg.Registry)
+	if !ok {
+		log.Printf("⚠️  Leader election is not supported by %T, running background jobs on this replica", registry)
+		return leader.Standalone()
+	}
+
+	elector := leader.NewElector(pgRegistry.LeaderLock(cfg.Leader.LockName), cfg.Leader.RetryInterval, logging.For(logging.SubsystemLeader))
+	go elector.Run(ctx)
+	log.Printf("👑 Background jobs run on the replica holding leader lock %q", cfg.Leader.LockName)
+	return elector
 }
 
 // setupReverseSyncSystem creates and configures the reverse sync system for SGROUP -> NETGUARD synchronization
-func setupReverseSyncSystem(ctx context.Context, cfg *config.Config, registry ports.Registry, syncManager interfaces.SyncManager) *sync.ReverseSyncSystem {
+// The system is started once the default sgroups connection becomes ready and runs while this replica leads.
+func setupReverseSyncSystem(ctx context.Context, cfg *config.Config, registry ports.Registry, syncManager interfaces.SyncManager, connection *sgroupsConnection, elector *leader.Elector) *sync.ReverseSyncSystem {
 
 	// Skip setup if sync manager is not available (sync disabled)
-	if syncManager == nil {
+	if syncManager == nil || connection == nil {
 		return nil
 	}
 
@@ -247,27 +910,36 @@ func setupReverseSyncSystem(ctx context.Context, cfg *config.Config, registry po
 		return nil
 	}
 
-	// Create SGROUP gateway using existing sync configuration
-	sgroupsClient, err := clients.NewSGroupsClient(cfg.Sync.SGroups)
+	// Create PostgreSQL adapters
+	hostReader := adapters.NewPostgreSQLHostReader(registry)
+	namespaces, err := synchronizer.NewNamespaceMapper(cfg.ReverseSync.NamespaceMapping)
 	if err != nil {
 		return nil
 	}
+	hostWriter := adapters.NewPostgreSQLHostWriter(registry, namespaces)
 
-	// Test connection to SGROUP
-	if err := sgroupsClient.Health(ctx); err != nil {
-		return nil
+	// Hosts with invalid IP addresses in SGROUP are quarantined
+	var hostOptions []synchronizer.HostSynchronizerOption
+	if quarantine := registryQuarantine(registry); quarantine != nil {
+		hostOptions = append(hostOptions, synchronizer.WithHostQuarantine(adapters.NewHostQuarantine(quarantine)))
 	}
 
-	// Create PostgreSQL adapters
-	hostReader := adapters.NewPostgreSQLHostReader(registry)
-	hostWriter := adapters.NewPostgreSQLHostWriter(registry)
-
 	// Create reverse sync system
+	// The default sgroups connection is shared, so endpoint reloads apply to reverse sync too
+	resourceStores := sync.ResourceStores{
+		NetworkReader:      adapters.NewPostgreSQLNetworkReader(registry),
+		NetworkWriter:      adapters.NewPostgreSQLNetworkWriter(registry),
+		AddressGroupReader: adapters.NewPostgreSQLAddressGroupReader(registry),
+		AddressGroupWriter: adapters.NewPostgreSQLAddressGroupWriter(registry),
+	}
+
 	reverseSyncSystem, err := sync.NewReverseSyncSystem(
-		sgroupsClient,
+		connection.gateway,
 		hostReader,
 		hostWriter,
+		resourceStores,
 		cfg.ReverseSync,
+		hostOptions...,
 	)
 	if err != nil {
 		return nil
@@ -275,7 +947,9 @@ func setupReverseSyncSystem(ctx context.Context, cfg *config.Config, registry po
 
 	// Start reverse sync system
 	go func() {
-		if err := reverseSyncSystem.Start(ctx); err != nil {
+		select {
+		case <-connection.monitor.Ready():
+		case <-ctx.Done():
 			return
 		}
 
@@ -291,13 +965,38 @@ func setupReverseSyncSystem(ctx context.Context, cfg *config.Config, registry po
 						return
 					case <-ticker.C:
 						if reverseSyncSystem.IsRunning() {
-							_ = reverseSyncSystem.GetStats()
+							status := reverseSyncSystem.Status()
+							log.Printf("📊 Reverse sync: %d events, %d processed, %d failed, lag %s, %d pending conflicts",
+								status.TotalEvents, status.ProcessedEvents, status.FailedEvents, status.Lag, status.PendingConflicts)
 						}
 					}
 				}
 			}()
 		}
+
+		elector.RunWhileLeader(ctx, "reverse sync", func(ctx context.Context) {
+			if err := reverseSyncSystem.Start(ctx); err != nil {
+				log.Printf("⚠️  Failed to start reverse sync: %v", err)
+				return
+			}
+			<-ctx.Done()
+			_ = reverseSyncSystem.Stop()
+		})
 	}()
 
 	return reverseSyncSystem
 }
+
+// newDriftConfig returns drift detector configuration of the default sgroups target
+func newDriftConfig(syncConfig config.SyncConfig) drift.Config {
+	driftConfig := drift.DefaultConfig()
+	driftConfig.Interval = syncConfig.Drift.Interval
+	driftConfig.Policy = syncConfig.Drift.Policy
+	// Namespaces routed to other targets are not expected in the default sgroups
+	for namespace, target := range syncConfig.Namespaces {
+		if target != types.SyncTargetDefault {
+			driftConfig.ExcludeNamespaces = append(driftConfig.ExcludeNamespaces, namespace)
+		}
+	}
+	return driftConfig
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/app/leader"
+	"netguard-pg-backend/internal/config"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/logging"
+	"netguard-pg-backend/internal/sync"
+	"netguard-pg-backend/internal/sync/clients"
+	"netguard-pg-backend/internal/sync/drift"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/synchronizer"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// syncReloader re-reads the configuration file and applies the sync and reverse_sync
+// sections without restarting the server: retry, batching, scope, disabled syncers, namespace routes,
+// drift detection, endpoints of existing sgroups targets and the reverse sync system.
+// Adding or removing sgroups targets, circuit breaker, connection and shadow settings require a restart.
+type syncReloader struct {
+	configPath    string
+	current       *config.Config
+	registry      ports.Registry
+	syncManager   interfaces.SyncManager
+	connections   map[string]*sgroupsConnection
+	driftDetector *drift.Detector
+	elector       *leader.Elector
+	logger        logr.Logger
+
+	// reverseSync is restarted when its configuration or the default sgroups endpoint changes
+	reverseSync       atomic.Pointer[sync.ReverseSyncSystem]
+	cancelReverseSync context.CancelFunc
+	// reverseSyncPaused is kept across restarts of the reverse sync system
+	reverseSyncPaused atomic.Bool
+
+	// namespaces are the applied namespace routes, read by circuit breaker listeners
+	namespaces atomic.Pointer[map[string]string]
+}
+
+func newSyncReloader(configPath string, cfg *config.Config, registry ports.Registry, syncManager interfaces.SyncManager, connections map[string]*sgroupsConnection, elector *leader.Elector) *syncReloader {
+	r := &syncReloader{
+		configPath:  configPath,
+		current:     cfg,
+		registry:    registry,
+		syncManager: syncManager,
+		connections: connections,
+		elector:     elector,
+		logger:      logging.For(logging.SubsystemSync),
+	}
+	namespaces := cfg.Sync.Namespaces
+	r.namespaces.Store(&namespaces)
+	return r
+}
+
+// setReverseSync sets the running reverse sync system, cancel stops its background goroutines
+func (r *syncReloader) setReverseSync(system *sync.ReverseSyncSystem, cancel context.CancelFunc) {
+	if system != nil && r.reverseSyncPaused.Load() {
+		system.Pause()
+	}
+	r.reverseSync.Store(system)
+	r.cancelReverseSync = cancel
+}
+
+// stopReverseSync stops the running reverse sync system
+func (r *syncReloader) stopReverseSync() {
+	if reverseSync := r.reverseSync.Load(); reverseSync != nil && reverseSync.IsRunning() {
+		if err := reverseSync.Stop(); err != nil {
+			r.logger.Error(err, "Failed to stop reverse sync system")
+		}
+	}
+	if r.cancelReverseSync != nil {
+		r.cancelReverseSync()
+	}
+	r.reverseSync.Store(nil)
+}
+
+// hostConflicts returns the hosts of the running reverse sync system queued for manual review
+func (r *syncReloader) hostConflicts() synchronizer.HostConflictReviewer {
+	if reverseSync := r.reverseSync.Load(); reverseSync != nil {
+		return reverseSync.HostConflicts()
+	}
+	return nil
+}
+
+// reverseSyncStatus returns statistics of the running reverse sync system,
+// false while reverse sync is disabled
+func (r *syncReloader) reverseSyncStatus() (interfaces.ReverseSyncStatus, bool) {
+	if reverseSync := r.reverseSync.Load(); reverseSync != nil {
+		return reverseSync.Status(), true
+	}
+	return interfaces.ReverseSyncStatus{}, false
+}
+
+// PauseReverseSync implements interfaces.ReverseSyncController
+func (r *syncReloader) PauseReverseSync() error {
+	reverseSync := r.reverseSync.Load()
+	if reverseSync == nil {
+		return interfaces.ErrReverseSyncDisabled
+	}
+	r.reverseSyncPaused.Store(true)
+	reverseSync.Pause()
+	r.logger.Info("Reverse sync paused")
+	return nil
+}
+
+// ResumeReverseSync implements interfaces.ReverseSyncController
+func (r *syncReloader) ResumeReverseSync() error {
+	reverseSync := r.reverseSync.Load()
+	if reverseSync == nil {
+		return interfaces.ErrReverseSyncDisabled
+	}
+	r.reverseSyncPaused.Store(false)
+	reverseSync.Resume()
+	r.logger.Info("Reverse sync resumed")
+	return nil
+}
+
+// TriggerReverseSync implements interfaces.ReverseSyncController
+func (r *syncReloader) TriggerReverseSync(ctx context.Context) error {
+	reverseSync := r.reverseSync.Load()
+	if reverseSync == nil {
+		return interfaces.ErrReverseSyncDisabled
+	}
+	r.logger.Info("Reverse sync cycle triggered manually")
+	return reverseSync.TriggerSync(ctx)
+}
+
+// namespaceTargets returns the applied namespace to sgroups target routes
+func (r *syncReloader) namespaceTargets() map[string]string {
+	return *r.namespaces.Load()
+}
+
+// Run reloads the configuration on every received signal until ctx is canceled
+func (r *syncReloader) Run(ctx context.Context, signals <-chan os.Signal) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			r.logger.Info("Reloading configuration", "path", r.configPath)
+			if err := r.reload(ctx); err != nil {
+				r.logger.Error(err, "Configuration reload failed, keeping the running configuration")
+				continue
+			}
+			r.logger.Info("Configuration reloaded")
+		}
+	}
+}
+
+func (r *syncReloader) reload(ctx context.Context) error {
+	cfg, err := config.NewConfig(r.configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("configuration validation failed: %w", err)
+	}
+	if cfg.Sync.Enabled != r.current.Sync.Enabled {
+		r.logger.Info("Enabling or disabling sync requires a restart, the sync section is not reloaded")
+		cfg.Sync = r.current.Sync
+	}
+
+	if err := r.applySync(cfg.Sync); err != nil {
+		return err
+	}
+	r.applyReverseSync(ctx, cfg)
+	r.current = cfg
+	return nil
+}
+
+// applySync applies the sync section to the running sync manager, sgroups connections and drift detector
+func (r *syncReloader) applySync(next config.SyncConfig) error {
+	previous := r.current.Sync
+	if r.syncManager == nil {
+		return nil
+	}
+
+	// Every sgroups target must be connected before anything is applied
+	for namespace, target := range next.Namespaces {
+		if target == types.SyncTargetDefault || target == types.SyncTargetNone {
+			continue
+		}
+		if _, exists := r.connections[target]; !exists {
+			return fmt.Errorf("namespace %s is routed to sync target %s added after startup, restart is required", namespace, target)
+		}
+	}
+	if next.Shadow != previous.Shadow {
+		r.logger.Info("Sync shadow mode settings are applied after restart")
+	}
+	for _, target := range changedTargets(previous.Targets, next.Targets) {
+		r.logger.Info("Adding or removing a sync target requires a restart", "target", target)
+	}
+
+	// Replace clients of sgroups targets with changed endpoints
+	for target, connection := range r.connections {
+		nextConfig, exists := next.Targets[target]
+		if target == types.SyncTargetDefault {
+			nextConfig, exists = next.SGroups, true
+		}
+		if !exists || !endpointChanged(connection.config, nextConfig) {
+			continue
+		}
+		if connection.config.CircuitBreaker != nextConfig.CircuitBreaker || connection.config.Connection != nextConfig.Connection {
+			r.logger.Info("circuit_breaker and connection settings of sync target are applied after restart", "target", target)
+		}
+		sgroupsClient, err := clients.NewSGroupsClient(nextConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create sgroups client of sync target %s: %w", target, err)
+		}
+		if err := connection.gateway.Swap(sgroupsClient); err != nil {
+			r.logger.Error(err, "Failed to close previous sgroups client", "target", target)
+		}
+		connection.config.GRPCAddress = nextConfig.GRPCAddress
+		connection.config.RequestTimeout = nextConfig.RequestTimeout
+		connection.config.KeepAlive = nextConfig.KeepAlive
+		connection.config.TLS = nextConfig.TLS
+		r.logger.Info("sgroups endpoint of sync target changed", "target", target, "address", nextConfig.GRPCAddress)
+	}
+
+	if configurer, ok := r.syncManager.(interfaces.RetryPolicyConfigurer); ok {
+		configurer.SetRetryPolicy(next.Retry, next.RetryOverrides)
+	}
+	if configurer, ok := r.syncManager.(interfaces.SyncBatchingConfigurer); ok {
+		configurer.SetBatching(next.Batching)
+	}
+	if configurer, ok := r.syncManager.(interfaces.SyncScopeConfigurer); ok {
+		if err := configurer.SetSyncScope(next.Scope); err != nil {
+			return fmt.Errorf("failed to configure sync scope: %w", err)
+		}
+	}
+	// Syncers toggled by the admin API keep their state until disabled_syncers is edited
+	if !reflect.DeepEqual(previous.DisabledSyncers, next.DisabledSyncers) {
+		if err := applyDisabledSyncers(r.syncManager, next.DisabledSyncers); err != nil {
+			return fmt.Errorf("failed to disable syncers: %w", err)
+		}
+	}
+	if router, ok := r.syncManager.(interfaces.SyncTargetRouter); ok {
+		if err := router.SetNamespaceTargets(next.Namespaces); err != nil {
+			return fmt.Errorf("failed to route namespaces to sync targets: %w", err)
+		}
+		namespaces := next.Namespaces
+		r.namespaces.Store(&namespaces)
+	}
+
+	if next.Drift.Enabled != previous.Drift.Enabled {
+		r.logger.Info("Enabling or disabling drift detection requires a restart")
+	} else if r.driftDetector != nil {
+		r.driftDetector.SetConfig(newDriftConfig(next))
+	}
+	return nil
+}
+
+// applyReverseSync restarts the reverse sync system when its configuration or the default sgroups endpoint changed
+func (r *syncReloader) applyReverseSync(ctx context.Context, cfg *config.Config) {
+	if r.syncManager == nil {
+		return
+	}
+	if reflect.DeepEqual(cfg.ReverseSync, r.current.ReverseSync) && !endpointChanged(r.current.Sync.SGroups, cfg.Sync.SGroups) {
+		return
+	}
+
+	r.logger.Info("Restarting reverse sync system")
+	r.stopReverseSync()
+	reverseSyncCtx, cancel := context.WithCancel(ctx)
+	r.setReverseSync(setupReverseSyncSystem(reverseSyncCtx, cfg, r.registry, r.syncManager, r.connections[types.SyncTargetDefault], r.elector), cancel)
+}
+
+// endpointChanged returns true if the sgroups client must be recreated to apply next
+func endpointChanged(previous, next clients.SGroupsConfig) bool {
+	return previous.GRPCAddress != next.GRPCAddress ||
+		previous.RequestTimeout != next.RequestTimeout ||
+		previous.KeepAlive != next.KeepAlive ||
+		previous.TLS != next.TLS
+}
+
+// changedTargets returns sorted names of targets present in only one of the configurations
+func changedTargets(previous, next map[string]clients.SGroupsConfig) []string {
+	var changed []string
+	for name := range previous {
+		if _, exists := next[name]; !exists {
+			changed = append(changed, name)
+		}
+	}
+	for name := range next {
+		if _, exists := previous[name]; !exists {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
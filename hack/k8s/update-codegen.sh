@@ -65,4 +65,17 @@ openapi-gen \
   k8s.io/apimachinery/pkg/runtime/schema \
   k8s.io/apimachinery/pkg/api/resource
 
+openapi-gen \
+  --output-dir       "${SCRIPT_ROOT}/internal/k8s/apis/netguard/v1" \
+  --output-pkg       "netguard-pg-backend/internal/k8s/apis/netguard/v1" \
+  --output-file      zz_generated.openapi.go \
+  --go-header-file   "${SCRIPT_ROOT}/hack/k8s/boilerplate.go.txt" \
+  --report-filename  /dev/null \
+  netguard-pg-backend/internal/k8s/apis/netguard/v1 \
+  k8s.io/apimachinery/pkg/apis/meta/v1 \
+  k8s.io/apimachinery/pkg/version \
+  k8s.io/apimachinery/pkg/runtime \
+  k8s.io/apimachinery/pkg/runtime/schema \
+  k8s.io/apimachinery/pkg/api/resource
+
 echo ">>> Code-gen finished" 
\ No newline at end of file
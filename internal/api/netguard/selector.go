@@ -0,0 +1,86 @@
+package netguard
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
+)
+
+// listSelector narrows the result of a List request with label and field selectors
+type listSelector struct {
+	labels labels.Selector
+	fields fields.Selector
+}
+
+// parseListSelector parses the selectors of a List request of kind, nil when the request has none.
+// Unsupported fields are rejected with InvalidArgument.
+func parseListSelector(kind string, selector *netguardpb.ListSelector) (*listSelector, error) {
+	if selector.GetLabelSelector() == "" && selector.GetFieldSelector() == "" {
+		return nil, nil
+	}
+
+	result := &listSelector{labels: labels.Everything(), fields: fields.Everything()}
+	if selector.GetLabelSelector() != "" {
+		parsed, err := labels.Parse(selector.GetLabelSelector())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid label selector: %v", err)
+		}
+		result.labels = parsed
+	}
+	if selector.GetFieldSelector() != "" {
+		parsed, err := fields.ParseSelector(selector.GetFieldSelector())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid field selector: %v", err)
+		}
+		for _, requirement := range parsed.Requirements() {
+			if !netguardv1beta1.IsSelectableField(kind, requirement.Field) {
+				return nil, status.Errorf(codes.InvalidArgument, "field selector of %s doesn't support %s", kind, requirement.Field)
+			}
+		}
+		result.fields = parsed
+	}
+	return result, nil
+}
+
+// narrowScope pushes exact metadata.namespace and metadata.name matches down to the repository scope
+// when the request doesn't list identifiers
+func (s *listSelector) narrowScope(scope ports.Scope) ports.Scope {
+	if s == nil || !scope.IsEmpty() {
+		return scope
+	}
+	namespace, ok := s.fields.RequiresExactMatch("metadata.namespace")
+	if !ok || namespace == "" {
+		return scope
+	}
+	name, _ := s.fields.RequiresExactMatch("metadata.name")
+	return ports.NewResourceIdentifierScope(models.NewResourceIdentifier(name, models.WithNamespace(namespace)))
+}
+
+// matches reports whether the resource matches the selectors
+func (s *listSelector) matches(resource interface{}) bool {
+	resourceLabels, resourceFields, ok := models.SelectorAttributes(resource)
+	if !ok {
+		return false
+	}
+	return s.labels.Matches(labels.Set(resourceLabels)) && s.fields.Matches(fields.Set(resourceFields))
+}
+
+// selectResources keeps the resources matching the selector, all resources for a nil selector
+func selectResources[T any](selector *listSelector, resources []T) []T {
+	if selector == nil {
+		return resources
+	}
+	selected := make([]T, 0, len(resources))
+	for _, resource := range resources {
+		if selector.matches(resource) {
+			selected = append(selected, resource)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,70 @@
+package netguard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
+)
+
+func ruleS2S(name string, traffic models.Traffic, labels map[string]string) models.RuleS2S {
+	return models.RuleS2S{
+		SelfRef:    models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("prod"))),
+		Traffic:    traffic,
+		ServiceRef: v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "db"}, Namespace: "data"},
+		Meta:       models.Meta{Labels: labels},
+	}
+}
+
+func TestSelectResources_FieldAndLabelSelectors(t *testing.T) {
+	rules := []models.RuleS2S{
+		ruleS2S("web-ingress", models.INGRESS, map[string]string{"team": "web"}),
+		ruleS2S("web-egress", models.EGRESS, map[string]string{"team": "web"}),
+		ruleS2S("api-ingress", models.INGRESS, map[string]string{"team": "api"}),
+	}
+
+	selector, err := parseListSelector("RuleS2S", &netguardpb.ListSelector{
+		LabelSelector: "team=web",
+		FieldSelector: "spec.traffic=INGRESS,spec.serviceRef.namespace=data",
+	})
+	require.NoError(t, err)
+
+	selected := selectResources(selector, rules)
+	require.Len(t, selected, 1)
+	assert.Equal(t, "web-ingress", selected[0].Name)
+
+	assert.Len(t, selectResources[models.RuleS2S](nil, rules), 3, "requests without selectors list everything")
+}
+
+func TestParseListSelector_RejectsUnsupportedFields(t *testing.T) {
+	for name, selector := range map[string]*netguardpb.ListSelector{
+		"unknown field":  {FieldSelector: "spec.transport=TCP"},
+		"invalid labels": {LabelSelector: "team in (web"},
+	} {
+		_, err := parseListSelector("RuleS2S", selector)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	selector, err := parseListSelector("RuleS2S", nil)
+	require.NoError(t, err)
+	assert.Nil(t, selector)
+}
+
+func TestListSelector_NarrowScope(t *testing.T) {
+	selector, err := parseListSelector("RuleS2S", &netguardpb.ListSelector{FieldSelector: "metadata.namespace=prod,spec.traffic=INGRESS"})
+	require.NoError(t, err)
+
+	scope, ok := selector.narrowScope(ports.EmptyScope{}).(ports.ResourceIdentifierScope)
+	require.True(t, ok, "exact namespace match narrows the repository scope")
+	assert.Equal(t, []models.ResourceIdentifier{models.NewResourceIdentifier("", models.WithNamespace("prod"))}, scope.Identifiers)
+
+	requested := ports.NewResourceIdentifierScope(models.NewResourceIdentifier("web-ingress", models.WithNamespace("prod")))
+	assert.Equal(t, requested, selector.narrowScope(requested), "requested identifiers are kept")
+}
@@ -2,17 +2,30 @@ package netguard
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"netguard-pg-backend/internal/application/admission"
 	"netguard-pg-backend/internal/application/services"
+	"netguard-pg-backend/internal/application/validation"
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 	"netguard-pg-backend/internal/k8s/client"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
 
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	// commonpb "github.com/H-BF/protos/pkg/api/common" - replaced with local types
 	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
@@ -36,7 +49,30 @@ func convertSyncOp(protoSyncOp netguardpb.SyncOp) models.SyncOp {
 	return models.ProtoToSyncOp(int32(protoSyncOp))
 }
 
+// Sync применяет изменения ресурсов. Ошибки изменения неизменяемых полей возвращаются
+// как InvalidArgument с перечнем измененных полей в BadRequest, все нарушения валидации
+// ресурса возвращаются одним ответом с нарушением на каждое поле
 func (s *NetguardServiceServer) Sync(ctx context.Context, req *netguardpb.SyncReq) (*emptypb.Empty, error) {
+	resp, err := s.sync(ctx, req)
+	return resp, validationStatus(err)
+}
+
+func (s *NetguardServiceServer) sync(ctx context.Context, req *netguardpb.SyncReq) (*emptypb.Empty, error) {
+	// Слишком большие запросы отклоняются до допуска и конвертации ресурсов
+	count := syncReqResourceCount(req)
+	if err := validation.CurrentLimits().ValidateRequestSize(count); err != nil {
+		return nil, err
+	}
+
+	// Массовые операции допускаются с более низким приоритетом, чем интерактивные
+	class := s.service.Admission().Classify(priorityHint(ctx), count)
+	release, admitErr := s.service.Admission().Admit(ctx, class)
+	if admitErr != nil {
+		return nil, status.FromContextError(admitErr).Err()
+	}
+	defer release()
+	ctx = admission.WithClass(ctx, class)
+
 	// Преобразуем тип операции из proto в модель
 	syncOp := convertSyncOp(req.SyncOp)
 
@@ -249,6 +285,70 @@ func (s *NetguardServiceServer) Sync(ctx context.Context, req *netguardpb.SyncRe
 			return nil, errors.Wrap(err, "failed to sync host bindings")
 		}
 
+	case *netguardpb.SyncReq_RuleS2SExceptions:
+		if subject.RuleS2SExceptions == nil || len(subject.RuleS2SExceptions.RuleS2SExceptions) == 0 {
+			return &emptypb.Empty{}, nil
+		}
+
+		// Конвертируем исключения RuleS2S
+		exceptions := make([]models.RuleS2SException, 0, len(subject.RuleS2SExceptions.RuleS2SExceptions))
+		for _, e := range subject.RuleS2SExceptions.RuleS2SExceptions {
+			exceptions = append(exceptions, client.ConvertRuleS2SExceptionFromProto(e))
+		}
+
+		err = s.service.Sync(ctx, syncOp, exceptions)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sync RuleS2S exceptions")
+		}
+
+	case *netguardpb.SyncReq_CrossNamespacePolicies:
+		if subject.CrossNamespacePolicies == nil || len(subject.CrossNamespacePolicies.CrossNamespacePolicies) == 0 {
+			return &emptypb.Empty{}, nil
+		}
+
+		// Конвертируем политики межнеймспейсных ссылок
+		policies := make([]models.CrossNamespacePolicy, 0, len(subject.CrossNamespacePolicies.CrossNamespacePolicies))
+		for _, p := range subject.CrossNamespacePolicies.CrossNamespacePolicies {
+			policies = append(policies, client.ConvertCrossNamespacePolicyFromProto(p))
+		}
+
+		err = s.service.Sync(ctx, syncOp, policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sync cross namespace policies")
+		}
+
+	case *netguardpb.SyncReq_RuleTemplates:
+		if subject.RuleTemplates == nil || len(subject.RuleTemplates.RuleTemplates) == 0 {
+			return &emptypb.Empty{}, nil
+		}
+
+		// Конвертируем шаблоны правил
+		templates := make([]models.RuleTemplate, 0, len(subject.RuleTemplates.RuleTemplates))
+		for _, t := range subject.RuleTemplates.RuleTemplates {
+			templates = append(templates, client.ConvertRuleTemplateFromProto(t))
+		}
+
+		err = s.service.Sync(ctx, syncOp, templates)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sync rule templates")
+		}
+
+	case *netguardpb.SyncReq_NamespacePostures:
+		if subject.NamespacePostures == nil || len(subject.NamespacePostures.NamespacePostures) == 0 {
+			return &emptypb.Empty{}, nil
+		}
+
+		// Конвертируем режимы неймспейсов
+		postures := make([]models.NamespacePosture, 0, len(subject.NamespacePostures.NamespacePostures))
+		for _, p := range subject.NamespacePostures.NamespacePostures {
+			postures = append(postures, client.ConvertNamespacePostureFromProto(p))
+		}
+
+		err = s.service.Sync(ctx, syncOp, postures)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sync namespace postures")
+		}
+
 	default:
 		return nil, errors.New("subject not specified")
 	}
@@ -268,6 +368,705 @@ func (s *NetguardServiceServer) SyncStatus(ctx context.Context, _ *emptypb.Empty
 	}, nil
 }
 
+// priorityHint returns the request class requested by the client in gRPC metadata
+func priorityHint(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(admission.PriorityHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// syncReqResourceCount returns the number of resources in the sync request subject
+func syncReqResourceCount(req *netguardpb.SyncReq) int {
+	msg := req.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("subject"))
+	if field == nil || field.Message() == nil {
+		return 0
+	}
+
+	count := 0
+	msg.Get(field).Message().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() {
+			count += v.List().Len()
+		}
+		return true
+	})
+	return count
+}
+
+// GetDetailedSyncStatus returns sync status per resource kind and, optionally, per resource
+func (s *NetguardServiceServer) GetDetailedSyncStatus(ctx context.Context, req *netguardpb.GetDetailedSyncStatusReq) (*netguardpb.GetDetailedSyncStatusResp, error) {
+	status, enabled := s.service.GetDetailedSyncStatus(ctx)
+	resp := &netguardpb.GetDetailedSyncStatusResp{Enabled: enabled}
+	if !enabled {
+		return resp, nil
+	}
+
+	kinds := make(map[string]bool, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds[kind] = true
+	}
+	wants := func(kind string) bool {
+		return len(kinds) == 0 || kinds[kind]
+	}
+
+	for _, kind := range status.Kinds {
+		if !wants(string(kind.SubjectType)) {
+			continue
+		}
+		resp.Kinds = append(resp.Kinds, &netguardpb.KindSyncStatus{
+			Kind:            string(kind.SubjectType),
+			TotalRequests:   kind.TotalRequests,
+			SuccessfulSyncs: kind.SuccessfulSyncs,
+			FailedSyncs:     kind.FailedSyncs,
+			Retries:         kind.Retries,
+			LastSyncTime:    optionalTimestamp(kind.LastSyncTime),
+			LastSuccessTime: optionalTimestamp(kind.LastSuccessTime),
+			LastError:       kind.LastError,
+			LastErrorTime:   optionalTimestamp(kind.LastErrorTime),
+		})
+	}
+
+	if !req.GetIncludeResources() {
+		return resp, nil
+	}
+	for _, entity := range status.Entities {
+		if !wants(string(entity.SubjectType)) {
+			continue
+		}
+		if req.GetFailedOnly() && entity.LastError == "" {
+			continue
+		}
+		resp.Resources = append(resp.Resources, &netguardpb.ResourceSyncStatus{
+			Kind:                string(entity.SubjectType),
+			Key:                 entity.Key,
+			Operation:           string(entity.Operation),
+			LastSyncTime:        optionalTimestamp(entity.LastSyncTime),
+			LastSuccessTime:     optionalTimestamp(entity.LastSuccessTime),
+			LastError:           entity.LastError,
+			Retries:             int32(entity.Retries),
+			ConsecutiveFailures: int32(entity.ConsecutiveFailures),
+		})
+	}
+
+	return resp, nil
+}
+
+// GetStartupReport returns the report logged on startup
+func (s *NetguardServiceServer) GetStartupReport(ctx context.Context, _ *emptypb.Empty) (*netguardpb.GetStartupReportResp, error) {
+	report, ok := s.service.GetStartupReport()
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "the backend is still starting")
+	}
+
+	resp := &netguardpb.GetStartupReportResp{
+		App:            report.App,
+		Version:        report.Version,
+		StartedAt:      optionalTimestamp(report.StartedAt),
+		StorageBackend: report.StorageBackend,
+		SchemaVersion:  report.SchemaVersion,
+		ReverseSync: &netguardpb.StartupReverseSync{
+			Enabled:   report.ReverseSync.Enabled,
+			AutoStart: report.ReverseSync.AutoStart,
+		},
+		AuthMode: report.AuthMode,
+		GrpcAddr: report.GRPCAddr,
+		HttpAddr: report.HTTPAddr,
+		Features: report.Features,
+	}
+	for _, syncer := range report.Syncers {
+		item := &netguardpb.StartupSyncer{Target: syncer.Target}
+		for _, subjectType := range syncer.SubjectTypes {
+			item.SubjectTypes = append(item.SubjectTypes, string(subjectType))
+		}
+		resp.Syncers = append(resp.Syncers, item)
+	}
+	return resp, nil
+}
+
+// GetSchemaStatus returns the applied and the supported schema version, FailedPrecondition
+// for storage backends without schema migrations
+func (s *NetguardServiceServer) GetSchemaStatus(ctx context.Context, _ *emptypb.Empty) (*netguardpb.GetSchemaStatusResp, error) {
+	schema, err := s.service.GetSchemaStatus(ctx)
+	switch {
+	case errors.Is(err, services.ErrSchemaNotVersioned):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to get schema status")
+	}
+	return &netguardpb.GetSchemaStatusResp{
+		Version:          schema.Version,
+		SupportedVersion: schema.SupportedVersion,
+		Compatible:       schema.Compatible(),
+	}, nil
+}
+
+// ListSyncers returns the sgroups syncers and whether they are enabled
+func (s *NetguardServiceServer) ListSyncers(ctx context.Context, _ *emptypb.Empty) (*netguardpb.ListSyncersResp, error) {
+	states, err := s.service.ListSyncers()
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	resp := &netguardpb.ListSyncersResp{}
+	for _, state := range states {
+		resp.Items = append(resp.Items, &netguardpb.Syncer{
+			SubjectType: string(state.SubjectType),
+			Targets:     state.Targets,
+			Enabled:     state.Enabled,
+		})
+	}
+	return resp, nil
+}
+
+// SetSyncerEnabled pauses or resumes synchronization of a subject type with sgroups
+func (s *NetguardServiceServer) SetSyncerEnabled(ctx context.Context, req *netguardpb.SetSyncerEnabledReq) (*emptypb.Empty, error) {
+	if req.GetSubjectType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "subject_type is required")
+	}
+
+	err := s.service.SetSyncerEnabled(types.SyncSubjectType(req.GetSubjectType()), req.GetEnabled())
+	switch {
+	case errors.Is(err, services.ErrSyncerControlDisabled):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, interfaces.ErrSyncerNotRegistered):
+		return nil, status.Errorf(codes.NotFound, "no syncer registered for subject type %s", req.GetSubjectType())
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to set syncer enabled")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PurgeNamespace deletes all resources of a namespace, FailedPrecondition while resources of
+// other namespaces reference it
+func (s *NetguardServiceServer) PurgeNamespace(ctx context.Context, req *netguardpb.PurgeNamespaceReq) (*netguardpb.PurgeNamespaceResp, error) {
+	if req.GetNamespace() == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	result, err := s.service.PurgeNamespace(ctx, req.GetNamespace())
+	var dependency *validation.DependencyExistsError
+	switch {
+	case errors.As(err, &dependency):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to purge namespace")
+	}
+
+	resp := &netguardpb.PurgeNamespaceResp{Namespace: result.Namespace, Deleted: make(map[string]int32, len(result.Deleted))}
+	for kind, count := range result.Deleted {
+		resp.Deleted[kind] = int32(count)
+	}
+	return resp, nil
+}
+
+// GetReverseSyncStatus returns statistics of the reverse synchronization from sgroups
+func (s *NetguardServiceServer) GetReverseSyncStatus(ctx context.Context, _ *emptypb.Empty) (*netguardpb.GetReverseSyncStatusResp, error) {
+	status, enabled := s.service.GetReverseSyncStatus()
+	resp := &netguardpb.GetReverseSyncStatusResp{Enabled: enabled}
+	if !enabled {
+		return resp, nil
+	}
+
+	resp.Running = status.Running
+	resp.StartTime = optionalTimestamp(status.StartTime)
+	resp.TotalEvents = status.TotalEvents
+	resp.ProcessedEvents = status.ProcessedEvents
+	resp.FailedEvents = status.FailedEvents
+	resp.LastEventTime = optionalTimestamp(status.LastEventTime)
+	resp.LastSuccessfulSync = optionalTimestamp(status.LastSuccessfulSync)
+	resp.Lag = durationpb.New(status.Lag)
+	resp.PendingConflicts = int32(status.PendingConflicts)
+	resp.Paused = status.Paused
+	resp.SkippedEvents = status.SkippedEvents
+	for _, entity := range status.Entities {
+		resp.Entities = append(resp.Entities, &netguardpb.ReverseSyncEntityStatus{
+			EntityType:       entity.EntityType,
+			TotalRequests:    entity.TotalRequests,
+			SuccessfulSyncs:  entity.SuccessfulSyncs,
+			FailedSyncs:      entity.FailedSyncs,
+			ObjectsProcessed: entity.ObjectsProcessed,
+			ObjectsFailed:    entity.ObjectsFailed,
+			Conflicts:        entity.Conflicts,
+			LastSyncTime:     optionalTimestamp(entity.LastSyncTime),
+		})
+	}
+	return resp, nil
+}
+
+// PauseReverseSync pauses the reverse synchronization from sgroups
+func (s *NetguardServiceServer) PauseReverseSync(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.service.PauseReverseSync(); err != nil {
+		return nil, reverseSyncControlStatus(err, "failed to pause reverse sync")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ResumeReverseSync resumes the reverse synchronization from sgroups
+func (s *NetguardServiceServer) ResumeReverseSync(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.service.ResumeReverseSync(); err != nil {
+		return nil, reverseSyncControlStatus(err, "failed to resume reverse sync")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// TriggerReverseSync runs a reverse synchronization cycle immediately
+func (s *NetguardServiceServer) TriggerReverseSync(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.service.TriggerReverseSync(ctx); err != nil {
+		return nil, reverseSyncControlStatus(err, "failed to trigger reverse sync")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// reverseSyncControlStatus maps errors of the reverse sync control to gRPC statuses
+func reverseSyncControlStatus(err error, message string) error {
+	if errors.Is(err, interfaces.ErrReverseSyncDisabled) || errors.Is(err, interfaces.ErrReverseSyncNotRunning) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return errors.Wrap(err, message)
+}
+
+// ListFailedSyncs returns sgroups syncs moved to the dead-letter queue
+func (s *NetguardServiceServer) ListFailedSyncs(ctx context.Context, req *netguardpb.ListFailedSyncsReq) (*netguardpb.ListFailedSyncsResp, error) {
+	entries, err := s.service.ListFailedSyncs(ctx)
+	if errors.Is(err, services.ErrSyncDeadLettersDisabled) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list failed syncs")
+	}
+
+	kinds := make(map[string]bool, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds[kind] = true
+	}
+
+	resp := &netguardpb.ListFailedSyncsResp{}
+	for _, entry := range entries {
+		if len(kinds) > 0 && !kinds[entry.Kind] {
+			continue
+		}
+		item := &netguardpb.FailedSync{
+			Id:        entry.ID,
+			Kind:      entry.Kind,
+			Operation: string(entry.Operation),
+			Attempts:  int32(entry.Attempts),
+			LastError: entry.LastError,
+			CreatedAt: optionalTimestamp(entry.CreatedAt),
+			FailedAt:  optionalTimestamp(entry.FailedAt),
+		}
+		if id, ok := entry.ResourceIdentifier(); ok {
+			item.Identifier = &netguardpb.ResourceIdentifier{Name: id.Name, Namespace: id.Namespace}
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
+
+// RetryFailedSync syncs the resource of a dead-lettered entry again
+func (s *NetguardServiceServer) RetryFailedSync(ctx context.Context, req *netguardpb.RetryFailedSyncReq) (*emptypb.Empty, error) {
+	err := s.service.RetryFailedSync(ctx, req.GetId())
+	switch {
+	case errors.Is(err, services.ErrSyncDeadLettersDisabled):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ports.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "failed sync %d not found", req.GetId())
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to retry failed sync")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListQuarantinedResources returns imported resources kept in the quarantine
+func (s *NetguardServiceServer) ListQuarantinedResources(ctx context.Context, req *netguardpb.ListQuarantinedResourcesReq) (*netguardpb.ListQuarantinedResourcesResp, error) {
+	resources, err := s.service.ListQuarantined(ctx)
+	if errors.Is(err, services.ErrQuarantineDisabled) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list quarantined resources")
+	}
+
+	kinds := make(map[string]bool, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds[kind] = true
+	}
+	sources := make(map[string]bool, len(req.GetSources()))
+	for _, source := range req.GetSources() {
+		sources[source] = true
+	}
+
+	resp := &netguardpb.ListQuarantinedResourcesResp{}
+	for _, resource := range resources {
+		if len(kinds) > 0 && !kinds[resource.Kind] {
+			continue
+		}
+		if len(sources) > 0 && !sources[resource.Source] {
+			continue
+		}
+		resp.Items = append(resp.Items, &netguardpb.QuarantinedResource{
+			Id:          resource.ID,
+			Source:      resource.Source,
+			Kind:        resource.Kind,
+			Identifier:  &netguardpb.ResourceIdentifier{Name: resource.Name, Namespace: resource.Namespace},
+			Reasons:     resource.Reasons,
+			Document:    string(resource.Document),
+			Occurrences: int32(resource.Occurrences),
+			FirstSeenAt: optionalTimestamp(resource.FirstSeenAt),
+			LastSeenAt:  optionalTimestamp(resource.LastSeenAt),
+		})
+	}
+	return resp, nil
+}
+
+// PromoteQuarantinedResource imports a quarantined resource again
+func (s *NetguardServiceServer) PromoteQuarantinedResource(ctx context.Context, req *netguardpb.PromoteQuarantinedResourceReq) (*emptypb.Empty, error) {
+	err := s.service.PromoteQuarantined(ctx, req.GetId(), []byte(req.GetDocument()))
+	switch {
+	case errors.Is(err, services.ErrQuarantineDisabled):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ports.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "quarantined resource %d not found", req.GetId())
+	case errors.Is(err, services.ErrQuarantinePromotionFailed):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to promote quarantined resource")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteQuarantinedResource drops a quarantined resource
+func (s *NetguardServiceServer) DeleteQuarantinedResource(ctx context.Context, req *netguardpb.DeleteQuarantinedResourceReq) (*emptypb.Empty, error) {
+	err := s.service.DeleteQuarantined(ctx, req.GetId())
+	switch {
+	case errors.Is(err, services.ErrQuarantineDisabled):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ports.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "quarantined resource %d not found", req.GetId())
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to delete quarantined resource")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// validationStatus converts structured validation errors to InvalidArgument statuses
+// with field violations, other errors are returned as is
+func validationStatus(err error) error {
+	var denied *validation.WebhookDeniedError
+	if errors.As(err, &denied) {
+		return webhookDeniedStatus(err, denied)
+	}
+	var violations *validation.ValidationErrors
+	if errors.As(err, &violations) && len(violations.Violations) > 1 {
+		return violationsStatus(err, violations)
+	}
+	var conflicts *validation.PortConflictError
+	if errors.As(err, &conflicts) {
+		return violationsStatus(err, &validation.ValidationErrors{
+			EntityID:   conflicts.Service,
+			Violations: []validation.FieldViolation{{Field: "spec.ingressPorts", Err: conflicts}},
+		})
+	}
+
+	var limit *validation.LimitExceededError
+	if !errors.As(err, &limit) {
+		return immutableFieldStatus(err)
+	}
+
+	field := limit.Field
+	if limit.EntityID != "" {
+		field = limit.EntityID + ": " + limit.Field
+	}
+	badRequest := &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+		Field:       field,
+		Description: fmt.Sprintf("exceeds limit: %d > %d", limit.Actual, limit.Limit),
+	}}}
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+// violationsStatus converts all collected violations of a resource to an InvalidArgument
+// status with a field violation each
+func violationsStatus(err error, violations *validation.ValidationErrors) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range violations.Violations {
+		field := violation.Field
+		switch {
+		case violations.EntityID != "" && field != "":
+			field = violations.EntityID + ": " + field
+		case field == "":
+			field = violations.EntityID
+		}
+
+		var limit *validation.LimitExceededError
+		var immutable *validation.ImmutableFieldError
+		var conflicts *validation.PortConflictError
+		switch {
+		case errors.As(violation.Err, &conflicts):
+			// A violation per conflicting service and port pair
+			for _, conflict := range conflicts.Conflicts {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       field,
+					Description: conflict.String(),
+				})
+			}
+		case errors.As(violation.Err, &limit):
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: fmt.Sprintf("exceeds limit: %d > %d", limit.Actual, limit.Limit),
+			})
+		case errors.As(violation.Err, &immutable) && len(immutable.Changes) > 0:
+			for _, change := range immutable.Changes {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       change.Path,
+					Description: immutable.Reason + ": " + change.String(),
+				})
+			}
+		default:
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: violation.Err.Error(),
+			})
+		}
+	}
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+// webhookDeniedStatus converts a denial of a validation webhook to InvalidArgument with a
+// field violation per reason returned by the webhook
+func webhookDeniedStatus(err error, denied *validation.WebhookDeniedError) error {
+	if len(denied.Violations) == 0 {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range denied.Violations {
+		field := violation.Field
+		switch {
+		case violation.Resource != "" && field != "":
+			field = violation.Resource + ": " + field
+		case field == "":
+			field = violation.Resource
+		}
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: violation.Message,
+		})
+	}
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+// immutableFieldStatus converts validation errors of immutable fields to InvalidArgument
+// with a field violation per changed field, other errors are returned as is
+func immutableFieldStatus(err error) error {
+	var immutable *validation.ImmutableFieldError
+	if !errors.As(err, &immutable) {
+		return transactionStatus(err)
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, change := range immutable.Changes {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       change.Path,
+			Description: immutable.Reason + ": " + change.String(),
+		})
+	}
+	st, detailsErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+// transactionStatus converts deadlocks, serialization failures and transaction timeouts
+// to Aborted, the client can retry the request. Other errors are returned as is.
+func transactionStatus(err error) error {
+	if !ports.IsRetryable(err) {
+		return err
+	}
+	return status.Error(codes.Aborted, err.Error())
+}
+
+// optionalTimestamp converts time to timestamp, zero time is reported as absent
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// deletionTimestampToProto converts the deletion intent of a resource
+func deletionTimestampToProto(t *metav1.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// deletionTimestampFromProto converts the deletion intent of a resource
+func deletionTimestampFromProto(ts *timestamppb.Timestamp) *metav1.Time {
+	if ts == nil {
+		return nil
+	}
+	t := metav1.NewTime(ts.AsTime())
+	return &t
+}
+
+// ownerReferencesToProto converts the owner references of a resource
+func ownerReferencesToProto(references []metav1.OwnerReference) []*netguardpb.OwnerReference {
+	if len(references) == 0 {
+		return nil
+	}
+	result := make([]*netguardpb.OwnerReference, 0, len(references))
+	for _, reference := range references {
+		result = append(result, &netguardpb.OwnerReference{
+			ApiVersion:         reference.APIVersion,
+			Kind:               reference.Kind,
+			Name:               reference.Name,
+			Uid:                string(reference.UID),
+			Controller:         reference.Controller != nil && *reference.Controller,
+			BlockOwnerDeletion: reference.BlockOwnerDeletion != nil && *reference.BlockOwnerDeletion,
+		})
+	}
+	return result
+}
+
+// ownerReferencesFromProto converts the owner references of a resource
+func ownerReferencesFromProto(references []*netguardpb.OwnerReference) []metav1.OwnerReference {
+	if len(references) == 0 {
+		return nil
+	}
+	result := make([]metav1.OwnerReference, 0, len(references))
+	for _, reference := range references {
+		owner := metav1.OwnerReference{
+			APIVersion: reference.GetApiVersion(),
+			Kind:       reference.GetKind(),
+			Name:       reference.GetName(),
+			UID:        k8stypes.UID(reference.GetUid()),
+		}
+		if reference.GetController() {
+			controller := true
+			owner.Controller = &controller
+		}
+		if reference.GetBlockOwnerDeletion() {
+			block := true
+			owner.BlockOwnerDeletion = &block
+		}
+		result = append(result, owner)
+	}
+	return result
+}
+
+// Watch streams committed Service, AddressGroup and RuleS2S changes to the client
+func (s *NetguardServiceServer) Watch(req *netguardpb.WatchReq, stream netguardpb.NetguardService_WatchServer) error {
+	kinds := make(map[string]bool, len(req.GetKinds()))
+	for _, kind := range req.GetKinds() {
+		kinds[kind] = true
+	}
+	wants := func(kind string) bool {
+		return len(kinds) == 0 || kinds[kind]
+	}
+
+	// Subscribe before reading the log, so no event is lost between replay and live events
+	events, cancel := s.service.ChangeFeed().Subscribe()
+	defer cancel()
+
+	ctx := stream.Context()
+	sent := req.GetSinceRevision()
+	send := func(event models.ChangeEvent) error {
+		if event.Revision <= sent {
+			return nil
+		}
+		sent = event.Revision
+
+		resp := &netguardpb.WatchEvent{
+			SyncOp:   netguardpb.SyncOp(models.SyncOpToProto(event.SyncOp)),
+			Revision: event.Revision,
+		}
+		switch resource := event.Resource.(type) {
+		case models.Service:
+			if !wants(models.ChangeKindService) {
+				return nil
+			}
+			resp.Resource = &netguardpb.WatchEvent_Service{Service: convertServiceToPB(resource)}
+		case models.AddressGroup:
+			if !wants(models.ChangeKindAddressGroup) {
+				return nil
+			}
+			resp.Resource = &netguardpb.WatchEvent_AddressGroup{AddressGroup: convertAddressGroupToPB(resource)}
+		case models.RuleS2S:
+			if !wants(models.ChangeKindRuleS2S) {
+				return nil
+			}
+			resp.Resource = &netguardpb.WatchEvent_RuleS2S{RuleS2S: convertRuleS2SToPB(resource)}
+		default:
+			return nil
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return errors.Wrap(err, "failed to send watch event")
+		}
+		return nil
+	}
+
+	if req.GetSinceRevision() > 0 {
+		// The replay is streamed from the log, a blocked Send holds back reading the log
+		// instead of buffering the whole backlog of a watcher
+		var sendErr error
+		err := s.service.ChangeFeed().Stream(ctx, req.GetSinceRevision(), func(event models.ChangeEvent) error {
+			sendErr = send(event)
+			return sendErr
+		})
+		if sendErr != nil {
+			return sendErr
+		}
+		if err != nil {
+			if errors.Is(err, ports.ErrRevisionCompacted) {
+				return status.Errorf(codes.OutOfRange, "revision %d has been compacted, relist required", req.GetSinceRevision())
+			}
+			return errors.Wrap(err, "failed to read change log")
+		}
+	}
+
+	// Bookmark tells the client that everything up to its revision was delivered.
+	// A fresh watcher relists after the bookmark, so events already committed are skipped.
+	if req.GetSinceRevision() == 0 {
+		sent = s.service.ChangeFeed().Revision()
+	}
+	if err := stream.Send(&netguardpb.WatchEvent{Revision: sent}); err != nil {
+		return errors.Wrap(err, "failed to send watch bookmark")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Aborted, "watch subscriber fell behind, relist required")
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // ListServices gets list of services
 func (s *NetguardServiceServer) ListServices(ctx context.Context, req *netguardpb.ListServicesReq) (*netguardpb.ListServicesResp, error) {
 	var scope ports.Scope = ports.EmptyScope{}
@@ -279,10 +1078,17 @@ func (s *NetguardServiceServer) ListServices(ctx context.Context, req *netguardp
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("Service", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	services, err := s.service.GetServices(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get services")
 	}
+	services = selectResources(selector, services)
 
 	items := make([]*netguardpb.Service, 0, len(services))
 	for _, svc := range services {
@@ -322,10 +1128,17 @@ func (s *NetguardServiceServer) ListAddressGroups(ctx context.Context, req *netg
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("AddressGroup", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	addressGroups, err := s.service.GetAddressGroups(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get address groups")
 	}
+	addressGroups = selectResources(selector, addressGroups)
 
 	items := make([]*netguardpb.AddressGroup, 0, len(addressGroups))
 	for _, ag := range addressGroups {
@@ -352,6 +1165,41 @@ func (s *NetguardServiceServer) GetAddressGroup(ctx context.Context, req *netgua
 	}, nil
 }
 
+// AnalyzeAddressGroupImpact reports what would change if the address group is modified or deleted
+func (s *NetguardServiceServer) AnalyzeAddressGroupImpact(ctx context.Context, req *netguardpb.AnalyzeAddressGroupImpactReq) (*netguardpb.AnalyzeAddressGroupImpactResp, error) {
+	id := idFromReq(req.GetIdentifier())
+
+	var proposed *models.AddressGroup
+	if !req.GetDelete() {
+		if req.GetProposed() == nil {
+			return nil, status.Error(codes.InvalidArgument, "either proposed or delete must be set")
+		}
+		addressGroup := convertAddressGroup(req.GetProposed())
+		proposed = &addressGroup
+	}
+
+	impact, err := s.service.AnalyzeAddressGroupImpact(ctx, id, proposed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to analyze address group impact")
+	}
+
+	resp := &netguardpb.AnalyzeAddressGroupImpactResp{
+		EnforcementChanged: impact.EnforcementChanged,
+		Namespaces:         impact.Namespaces,
+		RuleCountBefore:    int32(impact.RuleCountBefore),
+		RuleCountAfter:     int32(impact.RuleCountAfter),
+		RuleCountDelta:     int32(impact.RuleCountDelta()),
+	}
+	for _, ruleID := range impact.IEAgAgRules {
+		resp.IeagagRules = append(resp.IeagagRules, &netguardpb.ResourceIdentifier{Name: ruleID.Name, Namespace: ruleID.Namespace})
+	}
+	for _, serviceID := range impact.Services {
+		resp.Services = append(resp.Services, &netguardpb.ResourceIdentifier{Name: serviceID.Name, Namespace: serviceID.Namespace})
+	}
+
+	return resp, nil
+}
+
 // ListAddressGroupBindings gets list of address group bindings
 func (s *NetguardServiceServer) ListAddressGroupBindings(ctx context.Context, req *netguardpb.ListAddressGroupBindingsReq) (*netguardpb.ListAddressGroupBindingsResp, error) {
 	var scope ports.Scope = ports.EmptyScope{}
@@ -363,10 +1211,17 @@ func (s *NetguardServiceServer) ListAddressGroupBindings(ctx context.Context, re
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("AddressGroupBinding", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	bindings, err := s.service.GetAddressGroupBindings(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get address group bindings")
 	}
+	bindings = selectResources(selector, bindings)
 
 	items := make([]*netguardpb.AddressGroupBinding, 0, len(bindings))
 	for _, b := range bindings {
@@ -402,10 +1257,17 @@ func (s *NetguardServiceServer) ListAddressGroupPortMappings(ctx context.Context
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("AddressGroupPortMapping", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	mappings, err := s.service.GetAddressGroupPortMappings(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get address group port mappings")
 	}
+	mappings = selectResources(selector, mappings)
 
 	items := make([]*netguardpb.AddressGroupPortMapping, 0, len(mappings))
 	for _, m := range mappings {
@@ -441,10 +1303,17 @@ func (s *NetguardServiceServer) ListRuleS2S(ctx context.Context, req *netguardpb
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("RuleS2S", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	rules, err := s.service.GetRuleS2S(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get rule s2s")
 	}
+	rules = selectResources(selector, rules)
 
 	items := make([]*netguardpb.RuleS2S, 0, len(rules))
 	for _, r := range rules {
@@ -480,10 +1349,17 @@ func (s *NetguardServiceServer) ListServiceAliases(ctx context.Context, req *net
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("ServiceAlias", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	aliases, err := s.service.GetServiceAliases(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get service aliases")
 	}
+	aliases = selectResources(selector, aliases)
 
 	items := make([]*netguardpb.ServiceAlias, 0, len(aliases))
 	for _, a := range aliases {
@@ -524,11 +1400,14 @@ func convertService(svc *netguardpb.Service) models.Service {
 	// copy meta if provided
 	if svc.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             svc.Meta.Uid,
-			ResourceVersion: svc.Meta.ResourceVersion,
-			Generation:      svc.Meta.Generation,
-			Labels:          svc.Meta.Labels,
-			Annotations:     svc.Meta.Annotations,
+			UID:               svc.Meta.Uid,
+			ResourceVersion:   svc.Meta.ResourceVersion,
+			Finalizers:        svc.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(svc.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(svc.Meta.OwnerReferences),
+			Generation:        svc.Meta.Generation,
+			Labels:            svc.Meta.Labels,
+			Annotations:       svc.Meta.Annotations,
 		}
 		if svc.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(svc.Meta.CreationTs.AsTime())
@@ -536,13 +1415,7 @@ func convertService(svc *netguardpb.Service) models.Service {
 	}
 
 	// Convert ingress ports
-	for _, p := range svc.IngressPorts {
-		result.IngressPorts = append(result.IngressPorts, models.IngressPort{
-			Protocol:    models.TransportProtocol(p.Protocol.String()),
-			Port:        p.Port,
-			Description: p.Description,
-		})
-	}
+	result.IngressPorts = convertIngressPorts(svc.IngressPorts)
 
 	// Convert address groups with nil-safe access
 	for _, ag := range svc.AddressGroups {
@@ -615,13 +1488,21 @@ func convertAddressGroup(ag *netguardpb.AddressGroup) models.AddressGroup {
 		}
 	}
 
+	for _, ref := range ag.IncludedGroups {
+		result.IncludedGroups = append(result.IncludedGroups, models.NewAddressGroupRef(
+			ref.GetIdentifier().GetName(), models.WithNamespace(ref.GetIdentifier().GetNamespace())))
+	}
+
 	if ag.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             ag.Meta.Uid,
-			ResourceVersion: ag.Meta.ResourceVersion,
-			Generation:      ag.Meta.Generation,
-			Labels:          ag.Meta.Labels,
-			Annotations:     ag.Meta.Annotations,
+			UID:               ag.Meta.Uid,
+			ResourceVersion:   ag.Meta.ResourceVersion,
+			Finalizers:        ag.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(ag.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(ag.Meta.OwnerReferences),
+			Generation:        ag.Meta.Generation,
+			Labels:            ag.Meta.Labels,
+			Annotations:       ag.Meta.Annotations,
 		}
 		if ag.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(ag.Meta.CreationTs.AsTime())
@@ -669,11 +1550,14 @@ func convertAddressGroupBinding(b *netguardpb.AddressGroupBinding) models.Addres
 	// Copy Meta if presented
 	if b.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             b.Meta.Uid,
-			ResourceVersion: b.Meta.ResourceVersion,
-			Generation:      b.Meta.Generation,
-			Labels:          b.Meta.Labels,
-			Annotations:     b.Meta.Annotations,
+			UID:               b.Meta.Uid,
+			ResourceVersion:   b.Meta.ResourceVersion,
+			Finalizers:        b.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(b.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(b.Meta.OwnerReferences),
+			Generation:        b.Meta.Generation,
+			Labels:            b.Meta.Labels,
+			Annotations:       b.Meta.Annotations,
 		}
 		if b.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(b.Meta.CreationTs.AsTime())
@@ -693,11 +1577,14 @@ func convertAddressGroupPortMapping(m *netguardpb.AddressGroupPortMapping) model
 	// Copy Meta
 	if m.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             m.Meta.Uid,
-			ResourceVersion: m.Meta.ResourceVersion,
-			Generation:      m.Meta.Generation,
-			Labels:          m.Meta.Labels,
-			Annotations:     m.Meta.Annotations,
+			UID:               m.Meta.Uid,
+			ResourceVersion:   m.Meta.ResourceVersion,
+			Finalizers:        m.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(m.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(m.Meta.OwnerReferences),
+			Generation:        m.Meta.Generation,
+			Labels:            m.Meta.Labels,
+			Annotations:       m.Meta.Annotations,
 		}
 		if m.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(m.Meta.CreationTs.AsTime())
@@ -744,6 +1631,23 @@ func convertRuleS2S(r *netguardpb.RuleS2S) models.RuleS2S {
 	}
 
 	result.Trace = r.Trace
+	if r.Action != netguardpb.RuleAction_UNDEFINED {
+		result.Action = models.RuleAction(r.Action.String())
+	}
+	switch r.PortsSource {
+	case netguardpb.RuleS2SPortsSource_PORTS_SOURCE_LOCAL:
+		result.PortsSource = models.PortsSourceLocal
+	case netguardpb.RuleS2SPortsSource_PORTS_SOURCE_TARGET:
+		result.PortsSource = models.PortsSourceTarget
+	}
+	result.ExtraPorts = convertIngressPorts(r.ExtraPorts)
+	if r.ValidFrom != nil {
+		result.ValidFrom = r.ValidFrom.AsTime()
+	}
+	if r.ValidUntil != nil {
+		result.ValidUntil = r.ValidUntil.AsTime()
+	}
+	result.Priority = r.Priority
 
 	var localName, localNamespace string
 	if localRef := r.GetServiceLocalRef(); localRef != nil {
@@ -818,11 +1722,14 @@ func convertRuleS2S(r *netguardpb.RuleS2S) models.RuleS2S {
 
 	if r.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             r.Meta.Uid,
-			ResourceVersion: r.Meta.ResourceVersion,
-			Generation:      r.Meta.Generation,
-			Labels:          r.Meta.Labels,
-			Annotations:     r.Meta.Annotations,
+			UID:               r.Meta.Uid,
+			ResourceVersion:   r.Meta.ResourceVersion,
+			Finalizers:        r.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(r.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(r.Meta.OwnerReferences),
+			Generation:        r.Meta.Generation,
+			Labels:            r.Meta.Labels,
+			Annotations:       r.Meta.Annotations,
 		}
 		if r.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(r.Meta.CreationTs.AsTime())
@@ -842,6 +1749,9 @@ func convertServiceToPB(svc models.Service) *netguardpb.Service {
 		Meta: &netguardpb.Meta{
 			Uid:                svc.Meta.UID,
 			ResourceVersion:    svc.Meta.ResourceVersion,
+			Finalizers:         svc.Meta.Finalizers,
+			DeletionTs:         deletionTimestampToProto(svc.Meta.DeletionTimestamp),
+			OwnerReferences:    ownerReferencesToProto(svc.Meta.OwnerReferences),
 			Generation:         svc.Meta.Generation,
 			Labels:             svc.Meta.Labels,
 			Annotations:        svc.Meta.Annotations,
@@ -855,21 +1765,7 @@ func convertServiceToPB(svc models.Service) *netguardpb.Service {
 	}
 
 	// Convert ingress ports
-	for _, p := range svc.IngressPorts {
-		var proto netguardpb.Networks_NetIP_Transport
-		switch p.Protocol {
-		case models.TCP:
-			proto = netguardpb.Networks_NetIP_TCP
-		case models.UDP:
-			proto = netguardpb.Networks_NetIP_UDP
-		}
-
-		result.IngressPorts = append(result.IngressPorts, &netguardpb.IngressPort{
-			Protocol:    proto,
-			Port:        p.Port,
-			Description: p.Description,
-		})
-	}
+	result.IngressPorts = convertIngressPortsToPB(svc.IngressPorts)
 
 	// Convert address groups
 	for _, ag := range svc.AddressGroups {
@@ -924,6 +1820,9 @@ func convertAddressGroupToPB(ag models.AddressGroup) *netguardpb.AddressGroup {
 		Meta: &netguardpb.Meta{
 			Uid:                ag.Meta.UID,
 			ResourceVersion:    ag.Meta.ResourceVersion,
+			Finalizers:         ag.Meta.Finalizers,
+			DeletionTs:         deletionTimestampToProto(ag.Meta.DeletionTimestamp),
+			OwnerReferences:    ownerReferencesToProto(ag.Meta.OwnerReferences),
 			Generation:         ag.Meta.Generation,
 			Labels:             ag.Meta.Labels,
 			Annotations:        ag.Meta.Annotations,
@@ -975,6 +1874,15 @@ func convertAddressGroupToPB(ag models.AddressGroup) *netguardpb.AddressGroup {
 		}
 	}
 
+	for _, ref := range ag.IncludedGroups {
+		result.IncludedGroups = append(result.IncludedGroups, &netguardpb.AddressGroupRef{
+			Identifier: &netguardpb.ResourceIdentifier{
+				Name:      ref.Name,
+				Namespace: ref.Namespace,
+			},
+		})
+	}
+
 	return result
 }
 
@@ -1050,6 +1958,9 @@ func convertAddressGroupBindingToPB(b models.AddressGroupBinding) *netguardpb.Ad
 	pb.Meta = &netguardpb.Meta{
 		Uid:                b.Meta.UID,
 		ResourceVersion:    b.Meta.ResourceVersion,
+		Finalizers:         b.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(b.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(b.Meta.OwnerReferences),
 		Generation:         b.Meta.Generation,
 		Labels:             b.Meta.Labels,
 		Annotations:        b.Meta.Annotations,
@@ -1075,6 +1986,9 @@ func convertAddressGroupPortMappingToPB(m models.AddressGroupPortMapping) *netgu
 	result.Meta = &netguardpb.Meta{
 		Uid:                m.Meta.UID,
 		ResourceVersion:    m.Meta.ResourceVersion,
+		Finalizers:         m.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(m.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(m.Meta.OwnerReferences),
 		Generation:         m.Meta.Generation,
 		Labels:             m.Meta.Labels,
 		Annotations:        m.Meta.Annotations,
@@ -1170,6 +2084,17 @@ func convertRuleS2SToPB(r models.RuleS2S) *netguardpb.RuleS2S {
 	}
 
 	pb.Trace = r.Trace
+	pb.Action = convertActionToPB(r.EffectiveAction())
+	switch r.PortsSource {
+	case models.PortsSourceLocal:
+		pb.PortsSource = netguardpb.RuleS2SPortsSource_PORTS_SOURCE_LOCAL
+	case models.PortsSourceTarget:
+		pb.PortsSource = netguardpb.RuleS2SPortsSource_PORTS_SOURCE_TARGET
+	}
+	pb.ExtraPorts = convertIngressPortsToPB(r.ExtraPorts)
+	pb.ValidFrom = optionalTimestamp(r.ValidFrom)
+	pb.ValidUntil = optionalTimestamp(r.ValidUntil)
+	pb.Priority = r.Priority
 
 	if r.Traffic == models.EGRESS {
 		pb.Traffic = netguardpb.Traffic_Egress
@@ -1180,6 +2105,9 @@ func convertRuleS2SToPB(r models.RuleS2S) *netguardpb.RuleS2S {
 	pb.Meta = &netguardpb.Meta{
 		Uid:                r.Meta.UID,
 		ResourceVersion:    r.Meta.ResourceVersion,
+		Finalizers:         r.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(r.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(r.Meta.OwnerReferences),
 		Generation:         r.Meta.Generation,
 		Labels:             r.Meta.Labels,
 		Annotations:        r.Meta.Annotations,
@@ -1208,6 +2136,9 @@ func convertServiceAliasToPB(a models.ServiceAlias) *netguardpb.ServiceAlias {
 		Meta: &netguardpb.Meta{
 			Uid:                a.Meta.UID,
 			ResourceVersion:    a.Meta.ResourceVersion,
+			Finalizers:         a.Meta.Finalizers,
+			DeletionTs:         deletionTimestampToProto(a.Meta.DeletionTimestamp),
+			OwnerReferences:    ownerReferencesToProto(a.Meta.OwnerReferences),
 			Generation:         a.Meta.Generation,
 			Labels:             a.Meta.Labels,
 			Annotations:        a.Meta.Annotations,
@@ -1245,11 +2176,14 @@ func convertServiceAlias(a *netguardpb.ServiceAlias) models.ServiceAlias {
 	}
 	if a.Meta != nil {
 		alias.Meta = models.Meta{
-			UID:             a.Meta.Uid,
-			ResourceVersion: a.Meta.ResourceVersion,
-			Generation:      a.Meta.Generation,
-			Labels:          a.Meta.Labels,
-			Annotations:     a.Meta.Annotations,
+			UID:               a.Meta.Uid,
+			ResourceVersion:   a.Meta.ResourceVersion,
+			Finalizers:        a.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(a.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(a.Meta.OwnerReferences),
+			Generation:        a.Meta.Generation,
+			Labels:            a.Meta.Labels,
+			Annotations:       a.Meta.Annotations,
 		}
 		if a.Meta.CreationTs != nil {
 			alias.Meta.CreationTS = metav1.NewTime(a.Meta.CreationTs.AsTime())
@@ -1269,34 +2203,161 @@ func (s *NetguardServiceServer) ListIEAgAgRules(ctx context.Context, req *netgua
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
-	rules, err := s.service.GetIEAgAgRules(ctx, scope)
+	selector, err := parseListSelector("IEAgAgRule", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
+	rules, err := s.service.GetIEAgAgRules(ctx, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get IEAgAgRules")
+	}
+	rules = selectResources(selector, rules)
+
+	items := make([]*netguardpb.IEAgAgRule, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, convertIEAgAgRuleToPB(rule))
+	}
+
+	return &netguardpb.ListIEAgAgRulesResp{
+		Items: items,
+	}, nil
+}
+
+// GetIEAgAgRule gets a specific IEAgAgRule by ID
+func (s *NetguardServiceServer) GetIEAgAgRule(ctx context.Context, req *netguardpb.GetIEAgAgRuleReq) (*netguardpb.GetIEAgAgRuleResp, error) {
+	id := idFromReq(req.GetIdentifier())
+	rule, err := s.service.GetIEAgAgRuleByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get IEAgAgRule")
+	}
+
+	return &netguardpb.GetIEAgAgRuleResp{
+		IeagagRule: convertIEAgAgRuleToPB(*rule),
+	}, nil
+}
+
+// ListIEAgAgRuleContributions explains which RuleS2S contribute which ports to IEAgAgRules
+func (s *NetguardServiceServer) ListIEAgAgRuleContributions(ctx context.Context, req *netguardpb.ListIEAgAgRuleContributionsReq) (*netguardpb.ListIEAgAgRuleContributionsResp, error) {
+	var ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier
+	for _, id := range req.GetRuleS2S() {
+		ruleS2SIDs = append(ruleS2SIDs, idFromReq(id))
+	}
+	for _, id := range req.GetIeagagRules() {
+		ieAgAgRuleIDs = append(ieAgAgRuleIDs, idFromReq(id))
+	}
+
+	contributions, err := s.service.GetIEAgAgRuleContributions(ctx, ruleS2SIDs, ieAgAgRuleIDs)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get IEAgAgRules")
+		return nil, errors.Wrap(err, "failed to list IEAgAgRule contributions")
 	}
 
-	items := make([]*netguardpb.IEAgAgRule, 0, len(rules))
-	for _, rule := range rules {
-		items = append(items, convertIEAgAgRuleToPB(rule))
+	items := make([]*netguardpb.IEAgAgRuleContribution, 0, len(contributions))
+	for _, contribution := range contributions {
+		items = append(items, &netguardpb.IEAgAgRuleContribution{
+			RuleS2S: &netguardpb.ResourceIdentifier{
+				Name:      contribution.RuleS2S.Name,
+				Namespace: contribution.RuleS2S.Namespace,
+			},
+			IeagagRule: &netguardpb.ResourceIdentifier{
+				Name:      contribution.IEAgAgRule.Name,
+				Namespace: contribution.IEAgAgRule.Namespace,
+			},
+			Ports: contribution.Ports,
+		})
 	}
 
-	return &netguardpb.ListIEAgAgRulesResp{
+	return &netguardpb.ListIEAgAgRuleContributionsResp{
 		Items: items,
 	}, nil
 }
 
-// GetIEAgAgRule gets a specific IEAgAgRule by ID
-func (s *NetguardServiceServer) GetIEAgAgRule(ctx context.Context, req *netguardpb.GetIEAgAgRuleReq) (*netguardpb.GetIEAgAgRuleResp, error) {
-	id := idFromReq(req.GetIdentifier())
-	rule, err := s.service.GetIEAgAgRuleByID(ctx, id)
+// ListConditionTransitions lists when and why conditions of resources changed their status
+func (s *NetguardServiceServer) ListConditionTransitions(ctx context.Context, req *netguardpb.ListConditionTransitionsReq) (*netguardpb.ListConditionTransitionsResp, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+	query := ports.ConditionTransitionQuery{
+		Kind:    req.GetKind(),
+		AfterID: req.GetAfterId(),
+		Limit:   int(req.GetLimit()),
+	}
+	if req.GetIdentifier() != nil {
+		if query.Kind == "" {
+			return nil, status.Error(codes.InvalidArgument, "kind is required with identifier")
+		}
+		id := idFromReq(req.GetIdentifier())
+		query.Resource = &id
+	}
+
+	transitions, lastID, err := s.service.GetConditionTransitions(ctx, query)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get IEAgAgRule")
+		return nil, errors.Wrap(err, "failed to list condition transitions")
 	}
 
-	return &netguardpb.GetIEAgAgRuleResp{
-		IeagagRule: convertIEAgAgRuleToPB(*rule),
+	items := make([]*netguardpb.ConditionTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		items = append(items, &netguardpb.ConditionTransition{
+			Id:   transition.ID,
+			Kind: transition.Kind,
+			Identifier: &netguardpb.ResourceIdentifier{
+				Name:      transition.Name,
+				Namespace: transition.Namespace,
+			},
+			Type:       transition.Type,
+			FromStatus: string(transition.From),
+			ToStatus:   string(transition.To),
+			Reason:     transition.Reason,
+			Message:    transition.Message,
+			Time:       timestamppb.New(transition.Time),
+		})
+	}
+
+	return &netguardpb.ListConditionTransitionsResp{
+		Items:  items,
+		LastId: lastID,
 	}, nil
 }
 
+// convertIngressPorts converts protobuf ingress ports to domain ports
+func convertIngressPorts(ports []*netguardpb.IngressPort) []models.IngressPort {
+	var result []models.IngressPort
+	for _, p := range ports {
+		result = append(result, models.IngressPort{
+			Protocol:    models.TransportProtocol(p.Protocol.String()),
+			Port:        p.Port,
+			Description: p.Description,
+		})
+	}
+	return result
+}
+
+// convertIngressPortsToPB converts domain ingress ports to protobuf ports
+func convertIngressPortsToPB(ports []models.IngressPort) []*netguardpb.IngressPort {
+	var result []*netguardpb.IngressPort
+	for _, p := range ports {
+		var proto netguardpb.Networks_NetIP_Transport
+		switch p.Protocol {
+		case models.TCP:
+			proto = netguardpb.Networks_NetIP_TCP
+		case models.UDP:
+			proto = netguardpb.Networks_NetIP_UDP
+		case models.SCTP:
+			proto = netguardpb.Networks_NetIP_SCTP
+		case models.ICMP:
+			proto = netguardpb.Networks_NetIP_ICMP
+		}
+
+		result = append(result, &netguardpb.IngressPort{
+			Protocol:    proto,
+			Port:        p.Port,
+			Description: p.Description,
+		})
+	}
+	return result
+}
+
 func convertActionToPB(action models.RuleAction) netguardpb.RuleAction {
 	switch action {
 	case models.ActionAccept:
@@ -1316,6 +2377,10 @@ func convertIEAgAgRuleToPB(rule models.IEAgAgRule) *netguardpb.IEAgAgRule {
 		transport = netguardpb.Networks_NetIP_TCP
 	case models.UDP:
 		transport = netguardpb.Networks_NetIP_UDP
+	case models.SCTP:
+		transport = netguardpb.Networks_NetIP_SCTP
+	case models.ICMP:
+		transport = netguardpb.Networks_NetIP_ICMP
 	default:
 		transport = netguardpb.Networks_NetIP_TCP
 	}
@@ -1359,6 +2424,9 @@ func convertIEAgAgRuleToPB(rule models.IEAgAgRule) *netguardpb.IEAgAgRule {
 	result.Meta = &netguardpb.Meta{
 		Uid:                rule.Meta.UID,
 		ResourceVersion:    rule.Meta.ResourceVersion,
+		Finalizers:         rule.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(rule.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(rule.Meta.OwnerReferences),
 		Generation:         rule.Meta.Generation,
 		Labels:             rule.Meta.Labels,
 		Annotations:        rule.Meta.Annotations,
@@ -1418,11 +2486,14 @@ func convertAddressGroupBindingPolicy(policy *netguardpb.AddressGroupBindingPoli
 	// Copy Meta information if present
 	if policy.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             policy.Meta.Uid,
-			ResourceVersion: policy.Meta.ResourceVersion,
-			Generation:      policy.Meta.Generation,
-			Labels:          policy.Meta.Labels,
-			Annotations:     policy.Meta.Annotations,
+			UID:               policy.Meta.Uid,
+			ResourceVersion:   policy.Meta.ResourceVersion,
+			Finalizers:        policy.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(policy.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(policy.Meta.OwnerReferences),
+			Generation:        policy.Meta.Generation,
+			Labels:            policy.Meta.Labels,
+			Annotations:       policy.Meta.Annotations,
 		}
 		if policy.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(policy.Meta.CreationTs.AsTime())
@@ -1456,6 +2527,9 @@ func convertAddressGroupBindingPolicyToPB(policy models.AddressGroupBindingPolic
 	pbPolicy.Meta = &netguardpb.Meta{
 		Uid:                policy.Meta.UID,
 		ResourceVersion:    policy.Meta.ResourceVersion,
+		Finalizers:         policy.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(policy.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(policy.Meta.OwnerReferences),
 		Generation:         policy.Meta.Generation,
 		Labels:             policy.Meta.Labels,
 		Annotations:        policy.Meta.Annotations,
@@ -1480,10 +2554,17 @@ func (s *NetguardServiceServer) ListAddressGroupBindingPolicies(ctx context.Cont
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("AddressGroupBindingPolicy", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	policies, err := s.service.GetAddressGroupBindingPolicies(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get address group binding policies")
 	}
+	policies = selectResources(selector, policies)
 
 	items := make([]*netguardpb.AddressGroupBindingPolicy, 0, len(policies))
 	for _, policy := range policies {
@@ -1519,10 +2600,17 @@ func (s *NetguardServiceServer) ListNetworks(ctx context.Context, req *netguardp
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("Network", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	networks, err := s.service.GetNetworks(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get networks")
 	}
+	networks = selectResources(selector, networks)
 
 	items := make([]*netguardpb.Network, 0, len(networks))
 	for _, network := range networks {
@@ -1564,10 +2652,17 @@ func (s *NetguardServiceServer) ListNetworkBindings(ctx context.Context, req *ne
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("NetworkBinding", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	bindings, err := s.service.GetNetworkBindings(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get network bindings")
 	}
+	bindings = selectResources(selector, bindings)
 
 	items := make([]*netguardpb.NetworkBinding, 0, len(bindings))
 	for _, binding := range bindings {
@@ -1612,12 +2707,15 @@ func convertNetwork(network *netguardpb.Network) models.Network {
 	// Copy meta if provided
 	if network.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             network.Meta.Uid,
-			ResourceVersion: network.Meta.ResourceVersion,
-			Generation:      network.Meta.Generation,
-			Labels:          network.Meta.Labels,
-			Annotations:     network.Meta.Annotations,
-			Conditions:      models.ProtoConditionsToK8s(network.Meta.Conditions),
+			UID:               network.Meta.Uid,
+			ResourceVersion:   network.Meta.ResourceVersion,
+			Finalizers:        network.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(network.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(network.Meta.OwnerReferences),
+			Generation:        network.Meta.Generation,
+			Labels:            network.Meta.Labels,
+			Annotations:       network.Meta.Annotations,
+			Conditions:        models.ProtoConditionsToK8s(network.Meta.Conditions),
 		}
 		if network.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(network.Meta.CreationTs.AsTime())
@@ -1641,6 +2739,9 @@ func convertNetworkToPB(network models.Network) *netguardpb.Network {
 	pbNetwork.Meta = &netguardpb.Meta{
 		Uid:                network.Meta.UID,
 		ResourceVersion:    network.Meta.ResourceVersion,
+		Finalizers:         network.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(network.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(network.Meta.OwnerReferences),
 		Generation:         network.Meta.Generation,
 		Labels:             network.Meta.Labels,
 		Annotations:        network.Meta.Annotations,
@@ -1725,12 +2826,15 @@ func convertNetworkBinding(binding *netguardpb.NetworkBinding) models.NetworkBin
 	// Copy Meta if presented
 	if binding.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             binding.Meta.Uid,
-			ResourceVersion: binding.Meta.ResourceVersion,
-			Generation:      binding.Meta.Generation,
-			Labels:          binding.Meta.Labels,
-			Annotations:     binding.Meta.Annotations,
-			Conditions:      models.ProtoConditionsToK8s(binding.Meta.Conditions),
+			UID:               binding.Meta.Uid,
+			ResourceVersion:   binding.Meta.ResourceVersion,
+			Finalizers:        binding.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(binding.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(binding.Meta.OwnerReferences),
+			Generation:        binding.Meta.Generation,
+			Labels:            binding.Meta.Labels,
+			Annotations:       binding.Meta.Annotations,
+			Conditions:        models.ProtoConditionsToK8s(binding.Meta.Conditions),
 		}
 		if binding.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(binding.Meta.CreationTs.AsTime())
@@ -1769,6 +2873,9 @@ func convertNetworkBindingToPB(binding models.NetworkBinding) *netguardpb.Networ
 	pbBinding.Meta = &netguardpb.Meta{
 		Uid:                binding.Meta.UID,
 		ResourceVersion:    binding.Meta.ResourceVersion,
+		Finalizers:         binding.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(binding.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(binding.Meta.OwnerReferences),
 		Generation:         binding.Meta.Generation,
 		Labels:             binding.Meta.Labels,
 		Annotations:        binding.Meta.Annotations,
@@ -1793,10 +2900,17 @@ func (s *NetguardServiceServer) ListHosts(ctx context.Context, req *netguardpb.L
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("Host", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	hosts, err := s.service.GetHosts(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get hosts")
 	}
+	hosts = selectResources(selector, hosts)
 
 	pbHosts := make([]*netguardpb.Host, 0, len(hosts))
 	for _, host := range hosts {
@@ -1833,10 +2947,17 @@ func (s *NetguardServiceServer) ListHostBindings(ctx context.Context, req *netgu
 		scope = ports.NewResourceIdentifierScope(identifiers...)
 	}
 
+	selector, err := parseListSelector("HostBinding", req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	scope = selector.narrowScope(scope)
+
 	hostBindings, err := s.service.GetHostBindings(ctx, scope)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get host bindings")
 	}
+	hostBindings = selectResources(selector, hostBindings)
 
 	pbBindings := make([]*netguardpb.HostBinding, 0, len(hostBindings))
 	for _, binding := range hostBindings {
@@ -1862,6 +2983,183 @@ func (s *NetguardServiceServer) GetHostBinding(ctx context.Context, req *netguar
 	}, nil
 }
 
+// ListRuleS2SExceptions gets list of RuleS2S exceptions
+func (s *NetguardServiceServer) ListRuleS2SExceptions(ctx context.Context, req *netguardpb.ListRuleS2SExceptionsReq) (*netguardpb.ListRuleS2SExceptionsResp, error) {
+	var scope ports.Scope = ports.EmptyScope{}
+	if len(req.Identifiers) > 0 {
+		identifiers := make([]models.ResourceIdentifier, 0, len(req.Identifiers))
+		for _, id := range req.Identifiers {
+			identifiers = append(identifiers, models.NewResourceIdentifier(id.Name, models.WithNamespace(id.Namespace)))
+		}
+		scope = ports.NewResourceIdentifierScope(identifiers...)
+	}
+
+	exceptions, err := s.service.GetRuleS2SExceptions(ctx, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RuleS2S exceptions")
+	}
+
+	items := make([]*netguardpb.RuleS2SException, 0, len(exceptions))
+	for _, exception := range exceptions {
+		items = append(items, convertRuleS2SExceptionToPB(exception))
+	}
+
+	return &netguardpb.ListRuleS2SExceptionsResp{
+		Items: items,
+	}, nil
+}
+
+// GetRuleS2SException gets a RuleS2S exception by identifier
+func (s *NetguardServiceServer) GetRuleS2SException(ctx context.Context, req *netguardpb.GetRuleS2SExceptionReq) (*netguardpb.GetRuleS2SExceptionResp, error) {
+	id := models.NewResourceIdentifier(req.Identifier.Name, models.WithNamespace(req.Identifier.Namespace))
+
+	exception, err := s.service.GetRuleS2SExceptionByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get RuleS2S exception")
+	}
+
+	return &netguardpb.GetRuleS2SExceptionResp{
+		RuleS2SException: convertRuleS2SExceptionToPB(*exception),
+	}, nil
+}
+
+// ListCrossNamespacePolicies gets list of cross namespace policies
+func (s *NetguardServiceServer) ListCrossNamespacePolicies(ctx context.Context, req *netguardpb.ListCrossNamespacePoliciesReq) (*netguardpb.ListCrossNamespacePoliciesResp, error) {
+	var scope ports.Scope = ports.EmptyScope{}
+	if len(req.Identifiers) > 0 {
+		identifiers := make([]models.ResourceIdentifier, 0, len(req.Identifiers))
+		for _, id := range req.Identifiers {
+			identifiers = append(identifiers, models.NewResourceIdentifier(id.Name, models.WithNamespace(id.Namespace)))
+		}
+		scope = ports.NewResourceIdentifierScope(identifiers...)
+	}
+
+	policies, err := s.service.GetCrossNamespacePolicies(ctx, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cross namespace policies")
+	}
+
+	items := make([]*netguardpb.CrossNamespacePolicy, 0, len(policies))
+	for _, policy := range policies {
+		items = append(items, convertCrossNamespacePolicyToPB(policy))
+	}
+
+	return &netguardpb.ListCrossNamespacePoliciesResp{
+		Items: items,
+	}, nil
+}
+
+// GetCrossNamespacePolicy gets a cross namespace policy by identifier
+func (s *NetguardServiceServer) GetCrossNamespacePolicy(ctx context.Context, req *netguardpb.GetCrossNamespacePolicyReq) (*netguardpb.GetCrossNamespacePolicyResp, error) {
+	id := models.NewResourceIdentifier(req.Identifier.Name, models.WithNamespace(req.Identifier.Namespace))
+
+	policy, err := s.service.GetCrossNamespacePolicyByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cross namespace policy")
+	}
+
+	return &netguardpb.GetCrossNamespacePolicyResp{
+		CrossNamespacePolicy: convertCrossNamespacePolicyToPB(*policy),
+	}, nil
+}
+
+// ListRuleTemplates gets list of rule templates
+func (s *NetguardServiceServer) ListRuleTemplates(ctx context.Context, req *netguardpb.ListRuleTemplatesReq) (*netguardpb.ListRuleTemplatesResp, error) {
+	var scope ports.Scope = ports.EmptyScope{}
+	if len(req.Identifiers) > 0 {
+		identifiers := make([]models.ResourceIdentifier, 0, len(req.Identifiers))
+		for _, id := range req.Identifiers {
+			identifiers = append(identifiers, models.NewResourceIdentifier(id.Name, models.WithNamespace(id.Namespace)))
+		}
+		scope = ports.NewResourceIdentifierScope(identifiers...)
+	}
+
+	templates, err := s.service.GetRuleTemplates(ctx, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get rule templates")
+	}
+
+	items := make([]*netguardpb.RuleTemplate, 0, len(templates))
+	for _, template := range templates {
+		items = append(items, convertRuleTemplateToPB(template))
+	}
+
+	return &netguardpb.ListRuleTemplatesResp{
+		Items: items,
+	}, nil
+}
+
+// GetRuleTemplate gets a rule template by identifier
+func (s *NetguardServiceServer) GetRuleTemplate(ctx context.Context, req *netguardpb.GetRuleTemplateReq) (*netguardpb.GetRuleTemplateResp, error) {
+	id := models.NewResourceIdentifier(req.Identifier.Name, models.WithNamespace(req.Identifier.Namespace))
+
+	template, err := s.service.GetRuleTemplateByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get rule template")
+	}
+
+	return &netguardpb.GetRuleTemplateResp{
+		RuleTemplate: convertRuleTemplateToPB(*template),
+	}, nil
+}
+
+// ListNamespacePostures gets list of namespace postures
+func (s *NetguardServiceServer) ListNamespacePostures(ctx context.Context, req *netguardpb.ListNamespacePosturesReq) (*netguardpb.ListNamespacePosturesResp, error) {
+	var scope ports.Scope = ports.EmptyScope{}
+	if len(req.Identifiers) > 0 {
+		identifiers := make([]models.ResourceIdentifier, 0, len(req.Identifiers))
+		for _, id := range req.Identifiers {
+			identifiers = append(identifiers, models.NewResourceIdentifier(id.Name, models.WithNamespace(id.Namespace)))
+		}
+		scope = ports.NewResourceIdentifierScope(identifiers...)
+	}
+
+	postures, err := s.service.GetNamespacePostures(ctx, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get namespace postures")
+	}
+
+	items := make([]*netguardpb.NamespacePosture, 0, len(postures))
+	for _, posture := range postures {
+		items = append(items, convertNamespacePostureToPB(posture))
+	}
+
+	return &netguardpb.ListNamespacePosturesResp{
+		Items: items,
+	}, nil
+}
+
+// GetNamespacePosture gets a namespace posture by identifier
+func (s *NetguardServiceServer) GetNamespacePosture(ctx context.Context, req *netguardpb.GetNamespacePostureReq) (*netguardpb.GetNamespacePostureResp, error) {
+	id := models.NewResourceIdentifier(req.Identifier.Name, models.WithNamespace(req.Identifier.Namespace))
+
+	posture, err := s.service.GetNamespacePostureByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get namespace posture")
+	}
+
+	return &netguardpb.GetNamespacePostureResp{
+		NamespacePosture: convertNamespacePostureToPB(*posture),
+	}, nil
+}
+
+// SimulateTraffic checks whether a connection would be allowed by the stored IEAgAg rules
+func (s *NetguardServiceServer) SimulateTraffic(ctx context.Context, req *netguardpb.SimulateTrafficReq) (*netguardpb.SimulateTrafficResp, error) {
+	request := models.TrafficSimulationRequest{
+		Source:      convertTrafficEndpoint(req.GetSource()),
+		Destination: convertTrafficEndpoint(req.GetDestination()),
+		Transport:   models.TransportProtocol(req.Transport.String()),
+		Port:        int(req.Port),
+	}
+
+	result, err := s.service.SimulateTraffic(ctx, request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to simulate traffic: %v", err)
+	}
+
+	return convertTrafficSimulationResultToPB(result), nil
+}
+
 // convertHost converts proto Host to domain Host
 func convertHost(protoHost *netguardpb.Host) models.Host {
 	host := models.Host{
@@ -1910,11 +3208,14 @@ func convertHost(protoHost *netguardpb.Host) models.Host {
 	// Convert Meta if provided
 	if protoHost.Meta != nil {
 		host.Meta = models.Meta{
-			UID:             protoHost.Meta.Uid,
-			ResourceVersion: protoHost.Meta.ResourceVersion,
-			Generation:      protoHost.Meta.Generation,
-			Labels:          protoHost.Meta.Labels,
-			Annotations:     protoHost.Meta.Annotations,
+			UID:               protoHost.Meta.Uid,
+			ResourceVersion:   protoHost.Meta.ResourceVersion,
+			Finalizers:        protoHost.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(protoHost.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(protoHost.Meta.OwnerReferences),
+			Generation:        protoHost.Meta.Generation,
+			Labels:            protoHost.Meta.Labels,
+			Annotations:       protoHost.Meta.Annotations,
 		}
 		if protoHost.Meta.CreationTs != nil {
 			host.Meta.CreationTS = metav1.NewTime(protoHost.Meta.CreationTs.AsTime())
@@ -1975,6 +3276,9 @@ func convertHostToPB(host models.Host) *netguardpb.Host {
 	pbHost.Meta = &netguardpb.Meta{
 		Uid:                host.Meta.UID,
 		ResourceVersion:    host.Meta.ResourceVersion,
+		Finalizers:         host.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(host.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(host.Meta.OwnerReferences),
 		Generation:         host.Meta.Generation,
 		Labels:             host.Meta.Labels,
 		Annotations:        host.Meta.Annotations,
@@ -2026,11 +3330,14 @@ func convertHostBinding(protoBinding *netguardpb.HostBinding) models.HostBinding
 	// Convert Meta if provided
 	if protoBinding.Meta != nil {
 		binding.Meta = models.Meta{
-			UID:             protoBinding.Meta.Uid,
-			ResourceVersion: protoBinding.Meta.ResourceVersion,
-			Generation:      protoBinding.Meta.Generation,
-			Labels:          protoBinding.Meta.Labels,
-			Annotations:     protoBinding.Meta.Annotations,
+			UID:               protoBinding.Meta.Uid,
+			ResourceVersion:   protoBinding.Meta.ResourceVersion,
+			Finalizers:        protoBinding.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(protoBinding.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(protoBinding.Meta.OwnerReferences),
+			Generation:        protoBinding.Meta.Generation,
+			Labels:            protoBinding.Meta.Labels,
+			Annotations:       protoBinding.Meta.Annotations,
 		}
 		if protoBinding.Meta.CreationTs != nil {
 			binding.Meta.CreationTS = metav1.NewTime(protoBinding.Meta.CreationTs.AsTime())
@@ -2071,6 +3378,9 @@ func convertHostBindingToPB(binding models.HostBinding) *netguardpb.HostBinding
 	pbBinding.Meta = &netguardpb.Meta{
 		Uid:                binding.Meta.UID,
 		ResourceVersion:    binding.Meta.ResourceVersion,
+		Finalizers:         binding.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(binding.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(binding.Meta.OwnerReferences),
 		Generation:         binding.Meta.Generation,
 		Labels:             binding.Meta.Labels,
 		Annotations:        binding.Meta.Annotations,
@@ -2083,3 +3393,219 @@ func convertHostBindingToPB(binding models.HostBinding) *netguardpb.HostBinding
 
 	return pbBinding
 }
+
+// convertRuleS2SExceptionToPB converts domain RuleS2SException to proto RuleS2SException
+func convertRuleS2SExceptionToPB(exception models.RuleS2SException) *netguardpb.RuleS2SException {
+	traffic := netguardpb.Traffic_Ingress
+	if exception.Traffic == models.EGRESS {
+		traffic = netguardpb.Traffic_Egress
+	}
+
+	pbException := &netguardpb.RuleS2SException{
+		SelfRef: &netguardpb.ResourceIdentifier{
+			Name:      exception.Name,
+			Namespace: exception.Namespace,
+		},
+		Traffic: traffic,
+		AddressGroupLocal: &netguardpb.AddressGroupRef{
+			Identifier: &netguardpb.ResourceIdentifier{
+				Name:      exception.AddressGroupLocal.Name,
+				Namespace: exception.AddressGroupLocal.Namespace,
+			},
+		},
+		AddressGroup: &netguardpb.AddressGroupRef{
+			Identifier: &netguardpb.ResourceIdentifier{
+				Name:      exception.AddressGroup.Name,
+				Namespace: exception.AddressGroup.Namespace,
+			},
+		},
+		Transport: netguardpb.Networks_NetIP_Transport(netguardpb.Networks_NetIP_Transport_value[string(exception.Transport)]),
+		Ports:     exception.Ports,
+	}
+
+	// Populate Meta information
+	pbException.Meta = &netguardpb.Meta{
+		Uid:                exception.Meta.UID,
+		ResourceVersion:    exception.Meta.ResourceVersion,
+		Finalizers:         exception.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(exception.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(exception.Meta.OwnerReferences),
+		Generation:         exception.Meta.Generation,
+		Labels:             exception.Meta.Labels,
+		Annotations:        exception.Meta.Annotations,
+		Conditions:         models.K8sConditionsToProto(exception.Meta.Conditions),
+		ObservedGeneration: exception.Meta.ObservedGeneration,
+	}
+	if !exception.Meta.CreationTS.IsZero() {
+		pbException.Meta.CreationTs = timestamppb.New(exception.Meta.CreationTS.Time)
+	}
+
+	return pbException
+}
+
+// convertCrossNamespacePolicyToPB converts domain CrossNamespacePolicy to proto CrossNamespacePolicy
+func convertCrossNamespacePolicyToPB(policy models.CrossNamespacePolicy) *netguardpb.CrossNamespacePolicy {
+	pbPolicy := &netguardpb.CrossNamespacePolicy{
+		SelfRef: &netguardpb.ResourceIdentifier{
+			Name:      policy.Name,
+			Namespace: policy.Namespace,
+		},
+		AllowedNamespaces: policy.AllowedNamespaces,
+	}
+
+	// Populate Meta information
+	pbPolicy.Meta = &netguardpb.Meta{
+		Uid:                policy.Meta.UID,
+		ResourceVersion:    policy.Meta.ResourceVersion,
+		Finalizers:         policy.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(policy.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(policy.Meta.OwnerReferences),
+		Generation:         policy.Meta.Generation,
+		Labels:             policy.Meta.Labels,
+		Annotations:        policy.Meta.Annotations,
+		Conditions:         models.K8sConditionsToProto(policy.Meta.Conditions),
+		ObservedGeneration: policy.Meta.ObservedGeneration,
+	}
+	if !policy.Meta.CreationTS.IsZero() {
+		pbPolicy.Meta.CreationTs = timestamppb.New(policy.Meta.CreationTS.Time)
+	}
+
+	return pbPolicy
+}
+
+// convertRuleTemplateToPB converts domain RuleTemplate to proto RuleTemplate
+func convertRuleTemplateToPB(template models.RuleTemplate) *netguardpb.RuleTemplate {
+	traffic := netguardpb.Traffic_Ingress
+	if template.Traffic == models.EGRESS {
+		traffic = netguardpb.Traffic_Egress
+	}
+
+	pbTemplate := &netguardpb.RuleTemplate{
+		SelfRef: &netguardpb.ResourceIdentifier{
+			Name:      template.Name,
+			Namespace: template.Namespace,
+		},
+		Traffic:               traffic,
+		LocalServiceSelector:  template.LocalServiceSelector,
+		TargetServiceSelector: template.TargetServiceSelector,
+		Trace:                 template.Trace,
+	}
+	if template.Action != "" {
+		pbTemplate.Action = convertActionToPB(template.Action)
+	}
+
+	// Populate Meta information
+	pbTemplate.Meta = &netguardpb.Meta{
+		Uid:                template.Meta.UID,
+		ResourceVersion:    template.Meta.ResourceVersion,
+		Finalizers:         template.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(template.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(template.Meta.OwnerReferences),
+		Generation:         template.Meta.Generation,
+		Labels:             template.Meta.Labels,
+		Annotations:        template.Meta.Annotations,
+		Conditions:         models.K8sConditionsToProto(template.Meta.Conditions),
+		ObservedGeneration: template.Meta.ObservedGeneration,
+	}
+	if !template.Meta.CreationTS.IsZero() {
+		pbTemplate.Meta.CreationTs = timestamppb.New(template.Meta.CreationTS.Time)
+	}
+
+	return pbTemplate
+}
+
+// convertNamespacePostureToPB converts domain NamespacePosture to proto NamespacePosture
+func convertNamespacePostureToPB(posture models.NamespacePosture) *netguardpb.NamespacePosture {
+	mode := netguardpb.NamespacePostureMode_POSTURE_DEFAULT_ALLOW
+	if posture.IsDefaultDeny() {
+		mode = netguardpb.NamespacePostureMode_POSTURE_DEFAULT_DENY
+	}
+
+	pbPosture := &netguardpb.NamespacePosture{
+		SelfRef: &netguardpb.ResourceIdentifier{
+			Name:      posture.Name,
+			Namespace: posture.Namespace,
+		},
+		Mode: mode,
+	}
+
+	// Populate Meta information
+	pbPosture.Meta = &netguardpb.Meta{
+		Uid:                posture.Meta.UID,
+		ResourceVersion:    posture.Meta.ResourceVersion,
+		Finalizers:         posture.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(posture.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(posture.Meta.OwnerReferences),
+		Generation:         posture.Meta.Generation,
+		Labels:             posture.Meta.Labels,
+		Annotations:        posture.Meta.Annotations,
+		Conditions:         models.K8sConditionsToProto(posture.Meta.Conditions),
+		ObservedGeneration: posture.Meta.ObservedGeneration,
+	}
+	if !posture.Meta.CreationTS.IsZero() {
+		pbPosture.Meta.CreationTs = timestamppb.New(posture.Meta.CreationTS.Time)
+	}
+
+	return pbPosture
+}
+
+// convertTrafficEndpoint converts proto TrafficEndpoint to domain TrafficEndpoint
+func convertTrafficEndpoint(endpoint *netguardpb.TrafficEndpoint) models.TrafficEndpoint {
+	var result models.TrafficEndpoint
+	if ag := endpoint.GetAddressGroup(); ag != nil {
+		ref := models.NewAddressGroupRef(ag.GetIdentifier().GetName(), models.WithNamespace(ag.GetIdentifier().GetNamespace()))
+		result.AddressGroup = &ref
+	}
+	if host := endpoint.GetHost(); host != nil {
+		id := models.NewResourceIdentifier(host.Name, models.WithNamespace(host.Namespace))
+		result.Host = &id
+	}
+	return result
+}
+
+// convertTrafficSimulationResultToPB converts domain TrafficSimulationResult to proto SimulateTrafficResp
+func convertTrafficSimulationResultToPB(result *models.TrafficSimulationResult) *netguardpb.SimulateTrafficResp {
+	resp := &netguardpb.SimulateTrafficResp{
+		Verdict: convertActionToPB(result.Verdict),
+	}
+
+	for _, side := range result.Sides {
+		traffic := netguardpb.Traffic_Ingress
+		if side.Traffic == models.EGRESS {
+			traffic = netguardpb.Traffic_Egress
+		}
+
+		pbSide := &netguardpb.TrafficSimulationSide{
+			Traffic: traffic,
+			AddressGroupLocal: &netguardpb.AddressGroupRef{
+				Identifier: &netguardpb.ResourceIdentifier{
+					Name:      side.AddressGroupLocal.Name,
+					Namespace: side.AddressGroupLocal.Namespace,
+				},
+			},
+			AddressGroup: &netguardpb.AddressGroupRef{
+				Identifier: &netguardpb.ResourceIdentifier{
+					Name:      side.AddressGroup.Name,
+					Namespace: side.AddressGroup.Namespace,
+				},
+			},
+			Verdict:       convertActionToPB(side.Verdict),
+			DefaultAction: side.DefaultAction,
+		}
+		for _, match := range side.Matches {
+			pbSide.Matches = append(pbSide.Matches, &netguardpb.TrafficRuleMatch{
+				Rule: &netguardpb.ResourceIdentifier{
+					Name:      match.Rule.Name,
+					Namespace: match.Rule.Namespace,
+				},
+				Action:   convertActionToPB(match.Action),
+				Priority: match.Priority,
+				Ports:    match.Ports,
+				Decisive: match.Decisive,
+			})
+		}
+		resp.Sides = append(resp.Sides, pbSide)
+	}
+
+	return resp
+}
@@ -0,0 +1,93 @@
+package netguard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// statusFieldViolations returns the code and the "field: description" pairs of a status error
+func statusFieldViolations(t *testing.T, err error) (codes.Code, []string) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok, "expected a status error, got %v", err)
+
+	var violations []string
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		require.True(t, ok, "unexpected detail %T", detail)
+		for _, violation := range badRequest.FieldViolations {
+			violations = append(violations, violation.Field+": "+violation.Description)
+		}
+	}
+	return st.Code(), violations
+}
+
+func TestViolationsStatus(t *testing.T) {
+	conflicts := &validation.PortConflictError{
+		Service: "app/web",
+		Conflicts: []validation.PortConflict{
+			{Protocol: models.TCP, Service: "app/web", Range: models.PortRange{Start: 80, End: 80},
+				ConflictingService: "app/api", ConflictingRange: models.PortRange{Start: 80, End: 80}},
+			{Protocol: models.TCP, Service: "app/web", Range: models.PortRange{Start: 443, End: 443},
+				ConflictingService: "app/db", ConflictingRange: models.PortRange{Start: 400, End: 500}},
+		},
+	}
+	immutable := &validation.ImmutableFieldError{
+		Reason:  "spec cannot be changed",
+		Changes: []validation.FieldChange{{Path: "spec.serviceRef.name", Old: "web", New: "api"}},
+	}
+
+	violations := validation.NewValidationErrors("Service", "app/web")
+	violations.Add("", errors.New("whole resource"))
+	violations.Add("spec.description", errors.New("too long"))
+	violations.Add("spec.ingressPorts", conflicts)
+	violations.Add("spec.addressGroups", &validation.LimitExceededError{Field: "spec.addressGroups", Limit: 1, Actual: 2})
+	violations.Add("spec", immutable)
+
+	code, fields := statusFieldViolations(t, violationsStatus(violations.Err(), violations))
+	assert.Equal(t, codes.InvalidArgument, code)
+	assert.Equal(t, []string{
+		"app/web: whole resource",
+		"app/web: spec.description: too long",
+		"app/web: spec.ingressPorts: " + conflicts.Conflicts[0].String(),
+		"app/web: spec.ingressPorts: " + conflicts.Conflicts[1].String(),
+		"app/web: spec.addressGroups: exceeds limit: 2 > 1",
+		"spec.serviceRef.name: spec cannot be changed: " + immutable.Changes[0].String(),
+	}, fields)
+}
+
+func TestViolationsStatus_WithoutEntityID(t *testing.T) {
+	violations := &validation.ValidationErrors{Violations: []validation.FieldViolation{
+		{Field: "spec.cidr", Err: errors.New("invalid")},
+		{Err: errors.New("whole resource")},
+	}}
+
+	_, fields := statusFieldViolations(t, violationsStatus(violations, violations))
+	assert.Equal(t, []string{"spec.cidr: invalid", ": whole resource"}, fields)
+}
+
+func TestValidationStatus_Violations(t *testing.T) {
+	violations := validation.NewValidationErrors("Service", "app/web")
+	violations.Add("spec.description", errors.New("too long"))
+	violations.Add("spec.ingressPorts", errors.New("invalid port"))
+
+	code, fields := statusFieldViolations(t, validationStatus(violations.Err()))
+	assert.Equal(t, codes.InvalidArgument, code)
+	assert.Equal(t, []string{"app/web: spec.description: too long", "app/web: spec.ingressPorts: invalid port"}, fields)
+
+	// A port conflict alone is reported per conflicting pair as well
+	conflicts := &validation.PortConflictError{Service: "app/web", Conflicts: []validation.PortConflict{
+		{Protocol: models.TCP, Service: "app/web", ConflictingService: "app/api"},
+	}}
+	_, fields = statusFieldViolations(t, validationStatus(conflicts))
+	assert.Equal(t, []string{"app/web: spec.ingressPorts: " + conflicts.Conflicts[0].String()}, fields)
+}
@@ -0,0 +1,196 @@
+package netguard
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
+)
+
+// tenantMethods are the methods tenant credentials may call. They read and write
+// resources through the tenant-aware registry, other methods such as sync status, the
+// failed syncs, Watch and PurgeNamespace are system-wide and need unrestricted credentials.
+var tenantMethods = map[string]bool{
+	netguardpb.NetguardService_Sync_FullMethodName:                            true,
+	netguardpb.NetguardService_ListServices_FullMethodName:                    true,
+	netguardpb.NetguardService_GetService_FullMethodName:                      true,
+	netguardpb.NetguardService_ListAddressGroups_FullMethodName:               true,
+	netguardpb.NetguardService_GetAddressGroup_FullMethodName:                 true,
+	netguardpb.NetguardService_AnalyzeAddressGroupImpact_FullMethodName:       true,
+	netguardpb.NetguardService_ListAddressGroupBindings_FullMethodName:        true,
+	netguardpb.NetguardService_GetAddressGroupBinding_FullMethodName:          true,
+	netguardpb.NetguardService_ListAddressGroupPortMappings_FullMethodName:    true,
+	netguardpb.NetguardService_GetAddressGroupPortMapping_FullMethodName:      true,
+	netguardpb.NetguardService_ListRuleS2S_FullMethodName:                     true,
+	netguardpb.NetguardService_GetRuleS2S_FullMethodName:                      true,
+	netguardpb.NetguardService_ListServiceAliases_FullMethodName:              true,
+	netguardpb.NetguardService_GetServiceAlias_FullMethodName:                 true,
+	netguardpb.NetguardService_ListAddressGroupBindingPolicies_FullMethodName: true,
+	netguardpb.NetguardService_GetAddressGroupBindingPolicy_FullMethodName:    true,
+	netguardpb.NetguardService_ListIEAgAgRules_FullMethodName:                 true,
+	netguardpb.NetguardService_GetIEAgAgRule_FullMethodName:                   true,
+	netguardpb.NetguardService_ListIEAgAgRuleContributions_FullMethodName:     true,
+	netguardpb.NetguardService_ListNetworks_FullMethodName:                    true,
+	netguardpb.NetguardService_GetNetwork_FullMethodName:                      true,
+	netguardpb.NetguardService_ListNetworkBindings_FullMethodName:             true,
+	netguardpb.NetguardService_GetNetworkBinding_FullMethodName:               true,
+	netguardpb.NetguardService_ListHosts_FullMethodName:                       true,
+	netguardpb.NetguardService_GetHost_FullMethodName:                         true,
+	netguardpb.NetguardService_ListHostBindings_FullMethodName:                true,
+	netguardpb.NetguardService_GetHostBinding_FullMethodName:                  true,
+	netguardpb.NetguardService_ListRuleS2SExceptions_FullMethodName:           true,
+	netguardpb.NetguardService_GetRuleS2SException_FullMethodName:             true,
+	netguardpb.NetguardService_ListCrossNamespacePolicies_FullMethodName:      true,
+	netguardpb.NetguardService_GetCrossNamespacePolicy_FullMethodName:         true,
+	netguardpb.NetguardService_ListRuleTemplates_FullMethodName:               true,
+	netguardpb.NetguardService_GetRuleTemplate_FullMethodName:                 true,
+	netguardpb.NetguardService_ListNamespacePostures_FullMethodName:           true,
+	netguardpb.NetguardService_GetNamespacePosture_FullMethodName:             true,
+	netguardpb.NetguardService_SimulateTraffic_FullMethodName:                 true,
+}
+
+// TenantAuthenticator resolves the bearer token in the authorization metadata of requests
+// to a tenant and scopes the request context to it (ports.WithTenant)
+type TenantAuthenticator struct {
+	tenants map[string]models.Tenant
+	// requireToken rejects requests without a token, otherwise they are unrestricted
+	requireToken bool
+}
+
+// NewTenantAuthenticator creates an authenticator of the tenants keyed by token
+func NewTenantAuthenticator(tenants map[string]models.Tenant, requireToken bool) *TenantAuthenticator {
+	return &TenantAuthenticator{tenants: tenants, requireToken: requireToken}
+}
+
+// authenticate returns the context of a request to method
+func (a *TenantAuthenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	// Health checks of the orchestrator carry no credentials
+	if strings.HasPrefix(method, "/grpc.health.v1.") {
+		return ctx, nil
+	}
+
+	tenant, ok, err := a.resolve(bearerToken(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !ok {
+		return ctx, nil
+	}
+	if !tenantMethods[method] {
+		return nil, status.Errorf(codes.PermissionDenied, "tenant %s is not allowed to call %s", tenant.Name, method)
+	}
+	return ports.WithTenant(ctx, tenant), nil
+}
+
+// resolve returns the tenant of token, ok is false for unrestricted requests without a token
+func (a *TenantAuthenticator) resolve(token string) (tenant models.Tenant, ok bool, err error) {
+	if token == "" {
+		if a.requireToken {
+			return models.Tenant{}, false, errors.New("tenant token is required")
+		}
+		return models.Tenant{}, false, nil
+	}
+	tenant, ok = a.tenants[token]
+	if !ok {
+		return models.Tenant{}, false, errors.New("unknown tenant token")
+	}
+	return tenant, true, nil
+}
+
+// HTTPHandler authenticates HTTP requests served next to the gateway, such as /v2/apply,
+// by the "Authorization: Bearer <token>" header. Requests without a valid token are
+// rejected with 401, the context of tenant requests is scoped to the tenant.
+func (a *TenantAuthenticator) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		tenant, ok, err := a.resolve(strings.TrimSpace(token))
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if ok {
+			r = r.WithContext(ports.WithTenant(r.Context(), tenant))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SystemHTTPHandler authenticates system-wide HTTP endpoints, such as /debug/logging, like
+// HTTPHandler and rejects requests with tenant tokens with 403, only unrestricted requests
+// reach next
+func (a *TenantAuthenticator) SystemHTTPHandler(next http.Handler) http.Handler {
+	return a.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tenant, ok := ports.TenantFromContext(r.Context()); ok {
+			http.Error(w, "tenant "+tenant.Name+" is not allowed to access "+r.URL.Path, http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// UnaryInterceptor authenticates unary requests, tenant violations of the handler are
+// returned as PermissionDenied
+func (a *TenantAuthenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		return resp, tenantViolationStatus(err)
+	}
+}
+
+// StreamInterceptor authenticates streaming requests
+func (a *TenantAuthenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return tenantViolationStatus(handler(srv, &tenantStream{ServerStream: stream, ctx: ctx}))
+	}
+}
+
+// tenantStream is a server stream with the authenticated context
+type tenantStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantStream) Context() context.Context {
+	return s.ctx
+}
+
+// bearerToken returns the token of the "authorization: Bearer <token>" metadata
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if token, found := strings.CutPrefix(value, "Bearer "); found {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
+
+// tenantViolationStatus converts tenant violations to PermissionDenied statuses
+func tenantViolationStatus(err error) error {
+	if err != nil && errors.Is(err, ports.ErrTenantViolation) && status.Code(err) == codes.Unknown {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
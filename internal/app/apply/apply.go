@@ -0,0 +1,400 @@
+// Package apply serves /v2/apply: a multi-document YAML of netguard.sgroups.io/v1beta1
+// manifests is decoded into resources and upserted without a Kubernetes cluster.
+package apply
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	sigyaml "sigs.k8s.io/yaml"
+
+	"netguard-pg-backend/internal/application/admission"
+	"netguard-pg-backend/internal/application/services"
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+	"netguard-pg-backend/internal/k8s/registry/convert"
+)
+
+// MaxBodySize limits the size of an applied YAML body
+const MaxBodySize = 16 << 20
+
+// Bundle holds the decoded resources of a YAML body by kind
+type Bundle struct {
+	Networks                    []models.Network
+	AddressGroups               []models.AddressGroup
+	AddressGroupPortMappings    []models.AddressGroupPortMapping
+	Hosts                       []models.Host
+	Services                    []models.Service
+	ServiceAliases              []models.ServiceAlias
+	AddressGroupBindingPolicies []models.AddressGroupBindingPolicy
+	AddressGroupBindings        []models.AddressGroupBinding
+	NetworkBindings             []models.NetworkBinding
+	HostBindings                []models.HostBinding
+	RuleS2S                     []models.RuleS2S
+	IEAgAgRules                 []models.IEAgAgRule
+}
+
+// KindResult is the number of applied resources of a kind
+type KindResult struct {
+	Kind  string `json:"kind"`
+	Count int    `json:"count"`
+}
+
+// kindResources are the resources of a kind in a form accepted by NetguardFacade.Sync
+type kindResources struct {
+	kind      string
+	count     int
+	resources interface{}
+}
+
+// kinds returns the non-empty kinds in dependency order: referenced resources come first
+func (b *Bundle) kinds() []kindResources {
+	all := []kindResources{
+		{"Network", len(b.Networks), b.Networks},
+		{"AddressGroup", len(b.AddressGroups), b.AddressGroups},
+		{"AddressGroupPortMapping", len(b.AddressGroupPortMappings), b.AddressGroupPortMappings},
+		{"Host", len(b.Hosts), b.Hosts},
+		{"Service", len(b.Services), b.Services},
+		{"ServiceAlias", len(b.ServiceAliases), b.ServiceAliases},
+		{"AddressGroupBindingPolicy", len(b.AddressGroupBindingPolicies), b.AddressGroupBindingPolicies},
+		{"AddressGroupBinding", len(b.AddressGroupBindings), b.AddressGroupBindings},
+		{"NetworkBinding", len(b.NetworkBindings), b.NetworkBindings},
+		{"HostBinding", len(b.HostBindings), b.HostBindings},
+		{"RuleS2S", len(b.RuleS2S), b.RuleS2S},
+		{"IEAgAgRule", len(b.IEAgAgRules), b.IEAgAgRules},
+	}
+	kinds := make([]kindResources, 0, len(all))
+	for _, kind := range all {
+		if kind.count > 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// Count returns the number of resources in the bundle
+func (b *Bundle) Count() int {
+	count := 0
+	for _, kind := range b.kinds() {
+		count += kind.count
+	}
+	return count
+}
+
+// Rejected is a document of the body which failed to decode
+type Rejected struct {
+	// Index is the position of the document in the body
+	Index int
+	// Kind and ResourceIdentifier are set as far as the document can be parsed
+	Kind string
+	models.ResourceIdentifier
+	Document []byte
+	Err      error
+}
+
+// Decode decodes a multi-document YAML (or JSON) body. Documents without a namespace
+// get defaultNamespace, empty documents are skipped.
+func Decode(ctx context.Context, body io.Reader, defaultNamespace string) (*Bundle, error) {
+	return decode(ctx, body, defaultNamespace, func(rejected Rejected) error {
+		return errors.Wrapf(rejected.Err, "document %d", rejected.Index)
+	})
+}
+
+// DecodeAll decodes a body like Decode, but documents which fail to decode are
+// returned as rejected instead of failing the whole body
+func DecodeAll(ctx context.Context, body io.Reader, defaultNamespace string) (*Bundle, []Rejected, error) {
+	var rejected []Rejected
+	bundle, err := decode(ctx, body, defaultNamespace, func(document Rejected) error {
+		rejected = append(rejected, document)
+		return nil
+	})
+	return bundle, rejected, err
+}
+
+func decode(ctx context.Context, body io.Reader, defaultNamespace string, reject func(Rejected) error) (*Bundle, error) {
+	bundle := &Bundle{}
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(body))
+	for index := 0; ; index++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return bundle, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read document %d", index)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		if err := bundle.decodeDocument(ctx, doc, defaultNamespace); err != nil {
+			if err := reject(rejectedDocument(index, doc, defaultNamespace, err)); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// rejectedDocument identifies a document which failed to decode as far as its metadata can be parsed
+func rejectedDocument(index int, doc []byte, defaultNamespace string, err error) Rejected {
+	rejected := Rejected{Index: index, Document: doc, Err: err}
+	var meta metav1.PartialObjectMetadata
+	if sigyaml.Unmarshal(doc, &meta) == nil {
+		rejected.Kind = meta.Kind
+		rejected.Name = meta.Name
+		rejected.Namespace = meta.Namespace
+		if rejected.Name != "" && rejected.Namespace == "" {
+			rejected.Namespace = defaultNamespace
+		}
+	}
+	return rejected
+}
+
+func (b *Bundle) decodeDocument(ctx context.Context, doc []byte, defaultNamespace string) error {
+	var typeMeta metav1.TypeMeta
+	if err := sigyaml.Unmarshal(doc, &typeMeta); err != nil {
+		return errors.Wrap(err, "failed to decode apiVersion and kind")
+	}
+	if typeMeta.Kind == "" && typeMeta.APIVersion == "" {
+		// Comment-only documents have neither
+		return nil
+	}
+	if typeMeta.APIVersion != netguardv1beta1.SchemeGroupVersion.String() {
+		return fmt.Errorf("unsupported apiVersion %q, expected %s", typeMeta.APIVersion, netguardv1beta1.SchemeGroupVersion)
+	}
+
+	switch typeMeta.Kind {
+	case "Network":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewNetworkConverter().ToDomain, &b.Networks)
+	case "AddressGroup":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewAddressGroupConverter().ToDomain, &b.AddressGroups)
+	case "AddressGroupPortMapping":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewAddressGroupPortMappingConverter().ToDomain, &b.AddressGroupPortMappings)
+	case "Host":
+		return decodeAs(ctx, doc, defaultNamespace, (&convert.HostConverter{}).ToDomain, &b.Hosts)
+	case "Service":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewServiceConverter().ToDomain, &b.Services)
+	case "ServiceAlias":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewServiceAliasConverter().ToDomain, &b.ServiceAliases)
+	case "AddressGroupBindingPolicy":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewAddressGroupBindingPolicyConverter().ToDomain, &b.AddressGroupBindingPolicies)
+	case "AddressGroupBinding":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewAddressGroupBindingConverter().ToDomain, &b.AddressGroupBindings)
+	case "NetworkBinding":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewNetworkBindingConverter().ToDomain, &b.NetworkBindings)
+	case "HostBinding":
+		return decodeAs(ctx, doc, defaultNamespace, (&convert.HostBindingConverter{}).ToDomain, &b.HostBindings)
+	case "RuleS2S":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewRuleS2SConverter().ToDomain, &b.RuleS2S)
+	case "IEAgAgRule":
+		return decodeAs(ctx, doc, defaultNamespace, convert.NewIEAgAgRuleConverter().ToDomain, &b.IEAgAgRules)
+	}
+	return fmt.Errorf("unsupported kind %q", typeMeta.Kind)
+}
+
+// decodeAs decodes a manifest strictly, so misspelled fields are rejected, and converts it to the domain model
+func decodeAs[T any, PT interface {
+	*T
+	metav1.Object
+}, D any](ctx context.Context, doc []byte, defaultNamespace string, toDomain func(context.Context, PT) (*D, error), into *[]D) error {
+	obj := PT(new(T))
+	if err := sigyaml.UnmarshalStrict(doc, obj); err != nil {
+		return errors.Wrap(err, "failed to decode manifest")
+	}
+	if obj.GetName() == "" {
+		return fmt.Errorf("metadata.name is required")
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(defaultNamespace)
+	}
+
+	resource, err := toDomain(ctx, obj)
+	if err != nil {
+		return errors.Wrapf(err, "failed to convert %s", obj.GetName())
+	}
+	*into = append(*into, *resource)
+	return nil
+}
+
+// Apply upserts the bundle kind by kind in dependency order. Every kind is synced in its
+// own transaction: on failure the kinds applied before the failed one stay applied.
+func Apply(ctx context.Context, service *services.NetguardFacade, bundle *Bundle) ([]KindResult, error) {
+	var applied []KindResult
+	for _, kind := range bundle.kinds() {
+		if err := service.Sync(ctx, models.SyncOpUpsert, kind.resources); err != nil {
+			return applied, errors.Wrapf(err, "failed to apply %d %s", kind.count, kind.kind)
+		}
+		applied = append(applied, KindResult{Kind: kind.kind, Count: kind.count})
+	}
+	return applied, nil
+}
+
+// Promoter returns the promoter of manifests quarantined by /v2/apply: the (fixed) manifest
+// is decoded and applied, without a namespace it gets the namespace it was quarantined in
+func Promoter(service *services.NetguardFacade) services.QuarantinePromoter {
+	return func(ctx context.Context, resource models.QuarantinedResource) error {
+		namespace := resource.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		bundle, err := Decode(ctx, bytes.NewReader(resource.Document), namespace)
+		if err != nil {
+			return err
+		}
+		if bundle.Count() == 0 {
+			return errors.New("no manifests to apply")
+		}
+		_, err = Apply(ctx, service, bundle)
+		return err
+	}
+}
+
+// QuarantinedDocument is a document of the body placed into the quarantine
+type QuarantinedDocument struct {
+	Index     int    `json:"index"`
+	ID        int64  `json:"id"`
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Error     string `json:"error"`
+}
+
+// Response is the body returned by /v2/apply
+type Response struct {
+	Applied     []KindResult          `json:"applied"`
+	Quarantined []QuarantinedDocument `json:"quarantined,omitempty"`
+	Error       string                `json:"error,omitempty"`
+}
+
+// Handler serves POST /v2/apply. The namespace query parameter is the namespace of
+// manifests without one, "default" if omitted. With quarantine=true documents which
+// fail to decode are placed into the quarantine and the rest of the body is applied.
+type Handler struct {
+	service *services.NetguardFacade
+}
+
+// NewHandler creates the apply handler
+func NewHandler(service *services.NetguardFacade) *Handler {
+	return &Handler{service: service}
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeResponse(w, http.StatusMethodNotAllowed, Response{Error: "only POST is supported"})
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+	quarantine := false
+	if value := r.URL.Query().Get("quarantine"); value != "" {
+		var err error
+		if quarantine, err = strconv.ParseBool(value); err != nil {
+			writeResponse(w, http.StatusBadRequest, Response{Error: fmt.Sprintf("invalid quarantine %q", value)})
+			return
+		}
+	}
+	if quarantine && !h.service.QuarantineEnabled() {
+		writeResponse(w, http.StatusBadRequest, Response{Error: services.ErrQuarantineDisabled.Error()})
+		return
+	}
+
+	body := http.MaxBytesReader(w, r.Body, MaxBodySize)
+	var (
+		bundle   *Bundle
+		rejected []Rejected
+		err      error
+	)
+	if quarantine {
+		bundle, rejected, err = DecodeAll(r.Context(), body, namespace)
+	} else {
+		bundle, err = Decode(r.Context(), body, namespace)
+	}
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, Response{Error: err.Error()})
+		return
+	}
+	if bundle.Count() == 0 && len(rejected) == 0 {
+		writeResponse(w, http.StatusBadRequest, Response{Error: "no manifests to apply"})
+		return
+	}
+	if err := validation.CurrentLimits().ValidateRequestSize(bundle.Count()); err != nil {
+		writeResponse(w, http.StatusRequestEntityTooLarge, Response{Error: err.Error()})
+		return
+	}
+
+	// Manifests are admitted like Sync requests of the same size
+	ctx := r.Context()
+	class := h.service.Admission().Classify(r.Header.Get(admission.PriorityHeader), bundle.Count())
+	release, err := h.service.Admission().Admit(ctx, class)
+	if err != nil {
+		writeResponse(w, http.StatusServiceUnavailable, Response{Error: err.Error()})
+		return
+	}
+	defer release()
+
+	quarantined, err := h.quarantine(ctx, rejected)
+	if err != nil {
+		writeResponse(w, http.StatusInternalServerError, Response{Quarantined: quarantined, Error: err.Error()})
+		return
+	}
+
+	applied, err := Apply(admission.WithClass(ctx, class), h.service, bundle)
+	if err != nil {
+		code := http.StatusUnprocessableEntity
+		if errors.Is(err, ports.ErrTenantViolation) {
+			code = http.StatusForbidden
+		}
+		writeResponse(w, code, Response{Applied: applied, Quarantined: quarantined, Error: err.Error()})
+		return
+	}
+	writeResponse(w, http.StatusOK, Response{Applied: applied, Quarantined: quarantined})
+}
+
+// quarantine places rejected documents into the quarantine
+func (h *Handler) quarantine(ctx context.Context, rejected []Rejected) ([]QuarantinedDocument, error) {
+	var quarantined []QuarantinedDocument
+	for _, document := range rejected {
+		id, err := h.service.QuarantineResource(ctx, models.QuarantinedResource{
+			Source:             models.QuarantineSourceApply,
+			Kind:               document.Kind,
+			ResourceIdentifier: document.ResourceIdentifier,
+			Reasons:            []string{document.Err.Error()},
+			Document:           document.Document,
+		})
+		if err != nil {
+			return quarantined, errors.Wrapf(err, "failed to quarantine document %d", document.Index)
+		}
+		quarantined = append(quarantined, QuarantinedDocument{
+			Index:     document.Index,
+			ID:        id,
+			Kind:      document.Kind,
+			Name:      document.Name,
+			Namespace: document.Namespace,
+			Error:     document.Err.Error(),
+		})
+	}
+	return quarantined, nil
+}
+
+func writeResponse(w http.ResponseWriter, code int, resp Response) {
+	if resp.Applied == nil {
+		resp.Applied = []KindResult{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
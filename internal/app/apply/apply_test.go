@@ -0,0 +1,139 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/application/services"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+const manifests = `
+# services of team-a
+apiVersion: netguard.sgroups.io/v1beta1
+kind: Service
+metadata:
+  name: web
+spec:
+  description: frontend
+---
+apiVersion: netguard.sgroups.io/v1beta1
+kind: AddressGroup
+metadata:
+  name: backend
+  namespace: team-b
+spec:
+  defaultAction: ACCEPT
+---
+`
+
+func TestDecode(t *testing.T) {
+	bundle, err := Decode(context.Background(), strings.NewReader(manifests), "team-a")
+	require.NoError(t, err)
+	require.Len(t, bundle.Services, 1)
+	require.Len(t, bundle.AddressGroups, 1)
+	assert.Equal(t, models.NewResourceIdentifier("web", models.WithNamespace("team-a")), bundle.Services[0].ResourceIdentifier)
+	assert.Equal(t, "team-b", bundle.AddressGroups[0].Namespace)
+	assert.Equal(t, []kindResources{
+		{"AddressGroup", 1, bundle.AddressGroups},
+		{"Service", 1, bundle.Services},
+	}, bundle.kinds(), "address groups are applied before services")
+
+	_, err = Decode(context.Background(), strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: x\n"), "default")
+	assert.ErrorContains(t, err, "unsupported apiVersion")
+
+	_, err = Decode(context.Background(), strings.NewReader("apiVersion: netguard.sgroups.io/v1beta1\nkind: Service\nmetadata:\n  name: x\nspec:\n  descripton: typo\n"), "default")
+	assert.ErrorContains(t, err, "descripton", "unknown fields are rejected")
+}
+
+func TestHandler(t *testing.T) {
+	registry := mem.NewRegistry()
+	handler := NewHandler(services.NewNetguardFacade(registry, services.NewConditionManager(registry), nil))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v2/apply?namespace=team-a", strings.NewReader(manifests)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, []KindResult{{Kind: "AddressGroup", Count: 1}, {Kind: "Service", Count: 1}}, resp.Applied)
+
+	reader, err := registry.Reader(context.Background())
+	require.NoError(t, err)
+	defer reader.Close()
+	_, err = reader.GetServiceByID(context.Background(), models.NewResourceIdentifier("web", models.WithNamespace("team-a")))
+	assert.NoError(t, err)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/apply", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v2/apply", strings.NewReader("---\n")))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandlerQuarantine(t *testing.T) {
+	registry := mem.NewRegistry()
+	facade := services.NewNetguardFacade(registry, services.NewConditionManager(registry), nil)
+	handler := NewHandler(facade)
+
+	const body = manifests + `
+apiVersion: netguard.sgroups.io/v1beta1
+kind: Service
+metadata:
+  name: api
+spec:
+  descripton: typo
+`
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v2/apply?namespace=team-a&quarantine=true", strings.NewReader(body)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "quarantine is not enabled")
+
+	facade.SetQuarantine(registry.Quarantine())
+	facade.SetQuarantinePromoter(models.QuarantineSourceApply, Promoter(facade))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v2/apply?namespace=team-a&quarantine=true", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, []KindResult{{Kind: "AddressGroup", Count: 1}, {Kind: "Service", Count: 1}}, resp.Applied)
+	require.Len(t, resp.Quarantined, 1)
+	assert.Equal(t, "api", resp.Quarantined[0].Name)
+	assert.Equal(t, "team-a", resp.Quarantined[0].Namespace)
+	assert.Contains(t, resp.Quarantined[0].Error, "descripton")
+
+	ctx := context.Background()
+	quarantined, err := facade.ListQuarantined(ctx)
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	assert.Equal(t, models.QuarantineSourceApply, quarantined[0].Source)
+	assert.Equal(t, "Service", quarantined[0].Kind)
+
+	// The quarantined manifest is still invalid, the fixed one is applied
+	id := quarantined[0].ID
+	assert.ErrorIs(t, facade.PromoteQuarantined(ctx, id, nil), services.ErrQuarantinePromotionFailed)
+	fixed := "apiVersion: netguard.sgroups.io/v1beta1\nkind: Service\nmetadata:\n  name: api\nspec:\n  description: fixed\n"
+	require.NoError(t, facade.PromoteQuarantined(ctx, id, []byte(fixed)))
+
+	reader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	defer reader.Close()
+	service, err := reader.GetServiceByID(ctx, models.NewResourceIdentifier("api", models.WithNamespace("team-a")))
+	require.NoError(t, err)
+	assert.Equal(t, "fixed", service.Description)
+
+	quarantined, err = facade.ListQuarantined(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, quarantined)
+}
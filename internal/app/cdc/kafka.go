@@ -0,0 +1,121 @@
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// kafkaContentType is the embedded JSON format of the Kafka REST Proxy v2 API
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// KafkaOptions configure the Kafka transport
+type KafkaOptions struct {
+	// RESTProxyURL is the base URL of the Kafka REST Proxy, e.g. http://kafka-rest:8082
+	RESTProxyURL string
+	// Topic receives changes of all kinds, records are keyed by kind/namespace/name
+	Topic string
+	// Headers are added to every request, e.g. Authorization
+	Headers map[string]string
+	// Timeout bounds every produce request
+	Timeout time.Duration
+}
+
+// KafkaTransport produces messages through the Kafka REST Proxy. Records of a resource share the
+// key, so they land in one partition and are consumed in order.
+type KafkaTransport struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+// NewKafkaTransport creates a transport producing to the topic of options
+func NewKafkaTransport(options KafkaOptions) (*KafkaTransport, error) {
+	if _, err := url.ParseRequestURI(options.RESTProxyURL); err != nil {
+		return nil, fmt.Errorf("invalid Kafka REST proxy url %q: %w", options.RESTProxyURL, err)
+	}
+	return &KafkaTransport{
+		endpoint: strings.TrimSuffix(options.RESTProxyURL, "/") + "/topics/" + url.PathEscape(options.Topic),
+		headers:  options.Headers,
+		client:   &http.Client{Timeout: options.Timeout},
+	}, nil
+}
+
+// kafkaProduceRequest is the body of POST /topics/{topic}
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// kafkaProduceResponse reports the result of every record
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Name implements Transport
+func (t *KafkaTransport) Name() string {
+	return "kafka"
+}
+
+// Send implements Transport
+func (t *KafkaTransport) Send(ctx context.Context, _, key string, payload []byte) error {
+	body, err := json.Marshal(kafkaProduceRequest{Records: []kafkaRecord{{Key: key, Value: payload}}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create Kafka produce request: %w", err)
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("produce to Kafka: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("read Kafka produce response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Kafka REST proxy responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	// A produce request succeeds as a whole even when a record is rejected
+	var produced kafkaProduceResponse
+	if err := json.Unmarshal(data, &produced); err != nil {
+		return fmt.Errorf("decode Kafka produce response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("Kafka rejected record: %s (code %d)", offset.Error, *offset.ErrorCode)
+		}
+	}
+	return nil
+}
+
+// Close implements Transport
+func (t *KafkaTransport) Close() error {
+	t.client.CloseIdleConnections()
+	return nil
+}
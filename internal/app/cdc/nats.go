@@ -0,0 +1,185 @@
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// NATSOptions configure the NATS transport
+type NATSOptions struct {
+	// URL of the server: nats://host:4222, tls://host:4222 or host:port
+	URL string
+	// SubjectPrefix is followed by the resource kind: <prefix>.Service
+	SubjectPrefix string
+	User          string
+	Password      string
+	Token         string
+	// Timeout bounds connecting and every publish
+	Timeout time.Duration
+}
+
+// NATSTransport publishes messages with the NATS client protocol. Every publish is followed by a
+// PING, the PONG confirms the server processed the message (or shows the permission error).
+type NATSTransport struct {
+	options NATSOptions
+	address string
+	tls     bool
+
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewNATSTransport creates a transport connecting lazily on the first publish
+func NewNATSTransport(options NATSOptions) (*NATSTransport, error) {
+	address, secure, err := parseNATSURL(options.URL)
+	if err != nil {
+		return nil, err
+	}
+	return &NATSTransport{options: options, address: address, tls: secure}, nil
+}
+
+func parseNATSURL(raw string) (string, bool, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "nats://" + raw
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid NATS url %q: %w", raw, err)
+	}
+	switch parsed.Scheme {
+	case "nats", "tls":
+	default:
+		return "", false, fmt.Errorf("unsupported NATS url scheme %q", parsed.Scheme)
+	}
+	address := parsed.Host
+	if parsed.Port() == "" {
+		address = net.JoinHostPort(parsed.Hostname(), "4222")
+	}
+	return address, parsed.Scheme == "tls", nil
+}
+
+// Name implements Transport
+func (t *NATSTransport) Name() string {
+	return "nats"
+}
+
+// Send implements Transport, a failed publish drops the connection and the next one reconnects
+func (t *NATSTransport) Send(ctx context.Context, kind, _ string, payload []byte) error {
+	if t.conn == nil {
+		if err := t.connect(ctx); err != nil {
+			return err
+		}
+	}
+	if err := t.publish(t.options.SubjectPrefix+"."+kind, payload); err != nil {
+		_ = t.Close()
+		return err
+	}
+	return nil
+}
+
+// Close implements Transport
+func (t *NATSTransport) Close() error {
+	if t.conn == nil {
+		return nil
+	}
+	err := t.conn.Close()
+	t.conn, t.reader = nil, nil
+	return err
+}
+
+// connect opens a connection and authenticates, the server greets with INFO
+func (t *NATSTransport) connect(ctx context.Context) error {
+	dialer := &net.Dialer{Timeout: t.options.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", t.address)
+	if err != nil {
+		return fmt.Errorf("connect to NATS %s: %w", t.address, err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(t.options.Timeout))
+
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("read NATS server info: %w", err)
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		_ = conn.Close()
+		return fmt.Errorf("unexpected NATS greeting %q", strings.TrimSpace(line))
+	}
+
+	if t.tls {
+		host, _, _ := net.SplitHostPort(t.address)
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return fmt.Errorf("NATS TLS handshake: %w", err)
+		}
+		conn, reader = tlsConn, bufio.NewReader(tlsConn)
+	}
+
+	connect, err := json.Marshal(map[string]interface{}{
+		"verbose":    false,
+		"pedantic":   false,
+		"name":       "netguard-pg-backend",
+		"lang":       "go",
+		"version":    "1.0.0",
+		"protocol":   1,
+		"user":       t.options.User,
+		"pass":       t.options.Password,
+		"auth_token": t.options.Token,
+	})
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	t.conn, t.reader = conn, reader
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\nPING\r\n", connect); err != nil {
+		_ = t.Close()
+		return fmt.Errorf("send NATS connect: %w", err)
+	}
+	if err := t.waitPong(); err != nil {
+		_ = t.Close()
+		return fmt.Errorf("NATS connect rejected: %w", err)
+	}
+	return nil
+}
+
+// publish sends a message and waits for the server to process it
+func (t *NATSTransport) publish(subject string, payload []byte) error {
+	_ = t.conn.SetDeadline(time.Now().Add(t.options.Timeout))
+	if _, err := fmt.Fprintf(t.conn, "PUB %s %d\r\n%s\r\nPING\r\n", subject, len(payload), payload); err != nil {
+		return fmt.Errorf("publish to NATS subject %s: %w", subject, err)
+	}
+	if err := t.waitPong(); err != nil {
+		return fmt.Errorf("publish to NATS subject %s: %w", subject, err)
+	}
+	return nil
+}
+
+// waitPong reads server operations until the PONG, answering server PINGs
+func (t *NATSTransport) waitPong() error {
+	for {
+		line, err := t.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := fmt.Fprint(t.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
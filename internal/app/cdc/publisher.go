@@ -0,0 +1,180 @@
+// Package cdc streams committed netguard resource changes to Kafka or NATS.
+//
+// The facade reads the stored state of synced resources before and after every committed Sync
+// and hands the change to the Publisher (ports.ResourceChangePublisher). The Publisher encodes it
+// as a Message and sends it through a Transport from a single goroutine, so consumers (SIEM, CMDB,
+// firewall analytics) receive the changes of a replica in commit order. Publishing never blocks a
+// request: changes are dropped when the buffer is full or the transport keeps failing.
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Message is the JSON payload of a published change
+type Message struct {
+	// ID is unique per message, consumers deduplicate redeliveries by it
+	ID        string          `json:"id"`
+	Kind      string          `json:"kind"`
+	Namespace string          `json:"namespace,omitempty"`
+	Name      string          `json:"name"`
+	Operation string          `json:"operation"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	Time      time.Time       `json:"time"`
+}
+
+// Transport delivers encoded messages to a broker
+type Transport interface {
+	// Name identifies the transport in logs
+	Name() string
+	// Send delivers one message, key identifies the resource (kind/namespace/name)
+	Send(ctx context.Context, kind, key string, payload []byte) error
+	// Close releases broker connections
+	Close() error
+}
+
+// Options configure the delivery of changes
+type Options struct {
+	// BufferSize is the number of changes waiting for delivery
+	BufferSize int
+	// MaxAttempts is the number of send attempts of a message before it is dropped
+	MaxAttempts int
+	// RetryInterval is the pause between send attempts
+	RetryInterval time.Duration
+}
+
+// Publisher is an asynchronous ports.ResourceChangePublisher on top of a Transport
+type Publisher struct {
+	transport Transport
+	options   Options
+	changes   chan models.ResourceChange
+	logger    logr.Logger
+
+	published atomic.Uint64
+	dropped   atomic.Uint64
+	failed    atomic.Uint64
+}
+
+var _ ports.ResourceChangePublisher = &Publisher{}
+
+// NewPublisher creates a publisher sending changes through transport
+func NewPublisher(transport Transport, options Options, logger logr.Logger) *Publisher {
+	return &Publisher{
+		transport: transport,
+		options:   options,
+		changes:   make(chan models.ResourceChange, options.BufferSize),
+		logger:    logger,
+	}
+}
+
+// PublishChange enqueues the change, it is dropped when the buffer is full
+func (p *Publisher) PublishChange(change models.ResourceChange) {
+	select {
+	case p.changes <- change:
+	default:
+		if p.dropped.Add(1) == 1 {
+			p.logger.Info("Change buffer is full, dropping changes", "kind", change.Kind, "resource", change.ResourceIdentifier.Key())
+		}
+	}
+}
+
+// Run sends buffered changes until ctx is done, then closes the transport
+func (p *Publisher) Run(ctx context.Context) {
+	defer func() {
+		if err := p.transport.Close(); err != nil {
+			p.logger.Error(err, "Failed to close change transport", "transport", p.transport.Name())
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change := <-p.changes:
+			p.send(ctx, change)
+		}
+	}
+}
+
+// send delivers a change retrying failed attempts
+func (p *Publisher) send(ctx context.Context, change models.ResourceChange) {
+	payload, err := Encode(change)
+	if err != nil {
+		p.failed.Add(1)
+		p.logger.Error(err, "Failed to encode change", "kind", change.Kind, "resource", change.ResourceIdentifier.Key())
+		return
+	}
+	key := change.Kind + "/" + change.ResourceIdentifier.Key()
+
+	for attempt := 1; ; attempt++ {
+		err = p.transport.Send(ctx, change.Kind, key, payload)
+		if err == nil {
+			p.published.Add(1)
+			return
+		}
+		if attempt >= p.options.MaxAttempts || ctx.Err() != nil {
+			p.failed.Add(1)
+			p.logger.Error(err, "Failed to publish change, dropping it", "transport", p.transport.Name(),
+				"key", key, "operation", change.Operation, "attempts", attempt)
+			return
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(p.options.RetryInterval):
+		}
+	}
+}
+
+// Encode converts a change to the JSON payload of a Message
+func Encode(change models.ResourceChange) ([]byte, error) {
+	before, err := encodeSnapshot(change.Before)
+	if err != nil {
+		return nil, fmt.Errorf("encode before snapshot: %w", err)
+	}
+	after, err := encodeSnapshot(change.After)
+	if err != nil {
+		return nil, fmt.Errorf("encode after snapshot: %w", err)
+	}
+	return json.Marshal(Message{
+		ID:        uuid.NewString(),
+		Kind:      change.Kind,
+		Namespace: change.Namespace,
+		Name:      change.Name,
+		Operation: change.Operation,
+		Before:    before,
+		After:     after,
+		Time:      change.Time,
+	})
+}
+
+// encodeSnapshot encodes a missing snapshot as JSON null
+func encodeSnapshot(snapshot interface{}) (json.RawMessage, error) {
+	if snapshot == nil {
+		return json.RawMessage("null"), nil
+	}
+	return json.Marshal(snapshot)
+}
+
+// WriteMetrics writes change counters in the Prometheus text format
+func (p *Publisher) WriteMetrics(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "# HELP netguard_change_data_published_total Resource changes published to the change-data feed\n"+
+		"# TYPE netguard_change_data_published_total counter\nnetguard_change_data_published_total %d\n"+
+		"# HELP netguard_change_data_dropped_total Resource changes dropped because the change buffer was full\n"+
+		"# TYPE netguard_change_data_dropped_total counter\nnetguard_change_data_dropped_total %d\n"+
+		"# HELP netguard_change_data_failed_total Resource changes not published after all attempts\n"+
+		"# TYPE netguard_change_data_failed_total counter\nnetguard_change_data_failed_total %d\n",
+		p.published.Load(), p.dropped.Load(), p.failed.Load())
+	return err
+}
@@ -0,0 +1,206 @@
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// flakyTransport fails the first failures sends
+type flakyTransport struct {
+	mu       sync.Mutex
+	failures int
+	sent     []string
+}
+
+func (t *flakyTransport) Name() string {
+	return "flaky"
+}
+
+func (t *flakyTransport) Send(_ context.Context, _, key string, _ []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.failures > 0 {
+		t.failures--
+		return errors.New("broker unavailable")
+	}
+	t.sent = append(t.sent, key)
+	return nil
+}
+
+func (t *flakyTransport) Close() error {
+	return nil
+}
+
+func (t *flakyTransport) keys() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.sent...)
+}
+
+func testChange(name string, before, after interface{}) models.ResourceChange {
+	return models.NewResourceChange("Service", models.NewResourceIdentifier(name, models.WithNamespace("default")), before, after)
+}
+
+func TestPublisher_RetriesFailedSends(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transport := &flakyTransport{failures: 2}
+	publisher := NewPublisher(transport, Options{BufferSize: 10, MaxAttempts: 3, RetryInterval: time.Millisecond}, logr.Discard())
+	go publisher.Run(ctx)
+
+	publisher.PublishChange(testChange("web", nil, map[string]string{"name": "web"}))
+	publisher.PublishChange(testChange("db", nil, map[string]string{"name": "db"}))
+
+	require.Eventually(t, func() bool { return len(transport.keys()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"Service/default/web", "Service/default/db"}, transport.keys())
+}
+
+func TestPublisher_DropsAfterMaxAttempts(t *testing.T) {
+	transport := &flakyTransport{failures: 2}
+	publisher := NewPublisher(transport, Options{BufferSize: 10, MaxAttempts: 2, RetryInterval: time.Millisecond}, logr.Discard())
+
+	publisher.send(context.Background(), testChange("web", nil, "web"))
+	assert.Empty(t, transport.keys())
+
+	var metrics strings.Builder
+	require.NoError(t, publisher.WriteMetrics(&metrics))
+	assert.Contains(t, metrics.String(), "netguard_change_data_failed_total 1")
+}
+
+func TestEncode_Snapshots(t *testing.T) {
+	payload, err := Encode(testChange("web", nil, map[string]string{"description": "after"}))
+	require.NoError(t, err)
+
+	var message Message
+	require.NoError(t, json.Unmarshal(payload, &message))
+	assert.NotEmpty(t, message.ID)
+	assert.Equal(t, models.ResourceChangeCreate, message.Operation)
+	assert.Equal(t, "Service", message.Kind)
+	assert.Equal(t, "default", message.Namespace)
+	assert.Equal(t, "web", message.Name)
+	assert.JSONEq(t, `null`, string(message.Before))
+	assert.JSONEq(t, `{"description":"after"}`, string(message.After))
+}
+
+func TestKafkaTransport_ProducesKeyedRecord(t *testing.T) {
+	var path, contentType string
+	var request kafkaProduceRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":1}]}`))
+	}))
+	defer server.Close()
+
+	transport, err := NewKafkaTransport(KafkaOptions{RESTProxyURL: server.URL + "/", Topic: "netguard-changes", Timeout: time.Second})
+	require.NoError(t, err)
+	require.NoError(t, transport.Send(context.Background(), "Service", "Service/default/web", []byte(`{"id":"1"}`)))
+
+	assert.Equal(t, "/topics/netguard-changes", path)
+	assert.Equal(t, kafkaContentType, contentType)
+	require.Len(t, request.Records, 1)
+	assert.Equal(t, "Service/default/web", request.Records[0].Key)
+	assert.JSONEq(t, `{"id":"1"}`, string(request.Records[0].Value))
+}
+
+func TestKafkaTransport_FailsOnRejectedRecord(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"offsets":[{"error_code":40403,"error":"topic not found"}]}`))
+	}))
+	defer server.Close()
+
+	transport, err := NewKafkaTransport(KafkaOptions{RESTProxyURL: server.URL, Topic: "missing", Timeout: time.Second})
+	require.NoError(t, err)
+	err = transport.Send(context.Background(), "Service", "Service/default/web", []byte(`{}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "topic not found")
+}
+
+// fakeNATSServer accepts one connection and records published subjects and payloads
+func fakeNATSServer(t *testing.T, deny string) (string, <-chan [2]string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	published := make(chan [2]string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		fmt.Fprint(conn, "INFO {\"server_id\":\"test\"}\r\n")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			if len(fields) == 0 {
+				continue
+			}
+			switch fields[0] {
+			case "PING":
+				fmt.Fprint(conn, "PONG\r\n")
+			case "PUB":
+				size, _ := strconv.Atoi(fields[2])
+				payload := make([]byte, size+2)
+				if _, err := io.ReadFull(reader, payload); err != nil {
+					return
+				}
+				if fields[1] == deny {
+					fmt.Fprintf(conn, "-ERR 'Permissions Violation for Publish to \"%s\"'\r\n", deny)
+					continue
+				}
+				published <- [2]string{fields[1], string(payload[:size])}
+			}
+		}
+	}()
+	return listener.Addr().String(), published
+}
+
+func TestNATSTransport_Publishes(t *testing.T) {
+	address, published := fakeNATSServer(t, "")
+
+	transport, err := NewNATSTransport(NATSOptions{URL: "nats://" + address, SubjectPrefix: "netguard.changes", Timeout: time.Second})
+	require.NoError(t, err)
+	defer transport.Close()
+
+	require.NoError(t, transport.Send(context.Background(), "Service", "Service/default/web", []byte(`{"id":"1"}`)))
+	require.NoError(t, transport.Send(context.Background(), "AddressGroup", "AddressGroup/default/ag", []byte(`{"id":"2"}`)))
+
+	assert.Equal(t, [2]string{"netguard.changes.Service", `{"id":"1"}`}, <-published)
+	assert.Equal(t, [2]string{"netguard.changes.AddressGroup", `{"id":"2"}`}, <-published)
+}
+
+func TestNATSTransport_ReportsServerErrors(t *testing.T) {
+	address, _ := fakeNATSServer(t, "netguard.changes.Service")
+
+	transport, err := NewNATSTransport(NATSOptions{URL: address, SubjectPrefix: "netguard.changes", Timeout: time.Second})
+	require.NoError(t, err)
+	defer transport.Close()
+
+	err = transport.Send(context.Background(), "Service", "Service/default/web", []byte(`{}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Permissions Violation")
+}
@@ -0,0 +1,223 @@
+// Package debug provides optional troubleshooting endpoints: pprof profiles,
+// goroutine dumps, a JSON dump of the backend internal state, sync requests
+// recorded in shadow mode and the review of reverse sync host conflicts.
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"netguard-pg-backend/internal/application/services/resources"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/sync/clients"
+	"netguard-pg-backend/internal/sync/drift"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/synchronizer"
+)
+
+// poolStatProvider is implemented by registries backed by a connection pool
+type poolStatProvider interface {
+	PoolStat() *pgxpool.Stat
+}
+
+// State is the internal state dump returned by /debug/state
+type State struct {
+	Time               time.Time            `json:"time"`
+	Goroutines         int                  `json:"goroutines"`
+	Sync               map[string]SyncState `json:"sync,omitempty"`
+	AggregationMutexes int                  `json:"aggregationMutexes"`
+	DBPool             *DBPoolState         `json:"dbPool,omitempty"`
+	Drift              *drift.Stats         `json:"drift,omitempty"`
+}
+
+// SyncState describes sync activity for a single subject type
+type SyncState struct {
+	InFlight        int64 `json:"inFlight"`
+	TotalRequests   int64 `json:"totalRequests"`
+	SuccessfulSyncs int64 `json:"successfulSyncs"`
+	FailedSyncs     int64 `json:"failedSyncs"`
+	LastSyncTime    int64 `json:"lastSyncTime"`
+}
+
+// DBPoolState describes database connection pool usage
+type DBPoolState struct {
+	MaxConns             int32         `json:"maxConns"`
+	TotalConns           int32         `json:"totalConns"`
+	AcquiredConns        int32         `json:"acquiredConns"`
+	IdleConns            int32         `json:"idleConns"`
+	ConstructingConns    int32         `json:"constructingConns"`
+	AcquireCount         int64         `json:"acquireCount"`
+	EmptyAcquireCount    int64         `json:"emptyAcquireCount"`
+	CanceledAcquireCount int64         `json:"canceledAcquireCount"`
+	AcquireDuration      time.Duration `json:"acquireDuration"`
+}
+
+// Handler serves debug endpoints under /debug/
+type Handler struct {
+	registry    ports.Registry
+	syncManager interfaces.SyncManager
+	drift       *drift.Detector
+	shadow      *clients.SyncShadow
+	// hostConflicts returns the host conflict queue of the running reverse sync system
+	hostConflicts func() synchronizer.HostConflictReviewer
+	mux           *http.ServeMux
+}
+
+// NewHandler creates debug handler. syncManager may be nil when sync is disabled.
+func NewHandler(registry ports.Registry, syncManager interfaces.SyncManager) *Handler {
+	h := &Handler{
+		registry:    registry,
+		syncManager: syncManager,
+		mux:         http.NewServeMux(),
+	}
+
+	h.mux.HandleFunc("/debug/pprof/", pprof.Index)
+	h.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	h.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	h.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	h.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	h.mux.HandleFunc("/debug/goroutines", h.serveGoroutines)
+	h.mux.HandleFunc("/debug/state", h.serveState)
+	h.mux.HandleFunc("/debug/sync/shadow", h.serveSyncShadow)
+	h.mux.HandleFunc("/debug/reverse-sync/host-conflicts", h.serveHostConflicts)
+
+	return h
+}
+
+// SetDriftDetector adds drift detection stats to the state dump
+func (h *Handler) SetDriftDetector(detector *drift.Detector) {
+	h.drift = detector
+}
+
+// SetSyncShadow exposes sync requests recorded in shadow mode at /debug/sync/shadow
+func (h *Handler) SetSyncShadow(shadow *clients.SyncShadow) {
+	h.shadow = shadow
+}
+
+// SetHostConflicts exposes reverse sync host conflicts queued for manual review at
+// /debug/reverse-sync/host-conflicts. A conflict is resolved by POST with query
+// parameters host=<uuid> and use=sgroup or use=netguard.
+func (h *Handler) SetHostConflicts(hostConflicts func() synchronizer.HostConflictReviewer) {
+	h.hostConflicts = hostConflicts
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) serveGoroutines(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_ = runtimepprof.Lookup("goroutine").WriteTo(w, 2)
+}
+
+func (h *Handler) serveState(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(h.State())
+}
+
+func (h *Handler) serveSyncShadow(w http.ResponseWriter, _ *http.Request) {
+	if h.shadow == nil {
+		http.Error(w, "sync shadow mode is disabled", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(struct {
+		Total    int64                  `json:"total"`
+		Requests []clients.ShadowedSync `json:"requests"`
+	}{h.shadow.Total(), h.shadow.Requests()})
+}
+
+func (h *Handler) serveHostConflicts(w http.ResponseWriter, r *http.Request) {
+	var reviewer synchronizer.HostConflictReviewer
+	if h.hostConflicts != nil {
+		reviewer = h.hostConflicts()
+	}
+	if reviewer == nil {
+		http.Error(w, "host conflicts are not queued, reverse sync host conflict policy is not manual-review", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(reviewer.PendingConflicts())
+	case http.MethodPost:
+		hostUUID := r.URL.Query().Get("host")
+		use := r.URL.Query().Get("use")
+		if hostUUID == "" || (use != "sgroup" && use != "netguard") {
+			http.Error(w, "host and use=sgroup|netguard query parameters are required", http.StatusBadRequest)
+			return
+		}
+		if err := reviewer.ResolveConflict(r.Context(), hostUUID, use == "sgroup"); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "conflict of host %s resolved, %s IPSet is kept\n", hostUUID, use)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// State collects the current internal state
+func (h *Handler) State() State {
+	state := State{
+		Time:               time.Now(),
+		Goroutines:         runtime.NumGoroutine(),
+		AggregationMutexes: resources.AggregationMutexCount(),
+	}
+
+	if provider, ok := h.syncManager.(interfaces.SyncStatsProvider); ok {
+		state.Sync = make(map[string]SyncState)
+		for subjectType, stats := range provider.GetStats() {
+			state.Sync[string(subjectType)] = SyncState{
+				TotalRequests:   stats.TotalRequests,
+				SuccessfulSyncs: stats.SuccessfulSyncs,
+				FailedSyncs:     stats.FailedSyncs,
+				LastSyncTime:    stats.LastSyncTime,
+			}
+		}
+		for subjectType, inFlight := range provider.InFlight() {
+			syncState := state.Sync[string(subjectType)]
+			syncState.InFlight = inFlight
+			state.Sync[string(subjectType)] = syncState
+		}
+	}
+
+	if provider, ok := h.registry.(poolStatProvider); ok {
+		if stat := provider.PoolStat(); stat != nil {
+			state.DBPool = &DBPoolState{
+				MaxConns:             stat.MaxConns(),
+				TotalConns:           stat.TotalConns(),
+				AcquiredConns:        stat.AcquiredConns(),
+				IdleConns:            stat.IdleConns(),
+				ConstructingConns:    stat.ConstructingConns(),
+				AcquireCount:         stat.AcquireCount(),
+				EmptyAcquireCount:    stat.EmptyAcquireCount(),
+				CanceledAcquireCount: stat.CanceledAcquireCount(),
+				AcquireDuration:      stat.AcquireDuration(),
+			}
+		}
+	}
+
+	if h.drift != nil {
+		stats := h.drift.Stats()
+		state.Drift = &stats
+	}
+
+	return state
+}
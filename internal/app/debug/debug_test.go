@@ -0,0 +1,113 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/sync/clients"
+	"netguard-pg-backend/internal/sync/synchronizer"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+func TestHandler_State(t *testing.T) {
+	handler := NewHandler(mem.NewRegistry(), nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/state", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var state State
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+	assert.Positive(t, state.Goroutines)
+	assert.Nil(t, state.DBPool, "memory registry has no connection pool")
+	assert.Empty(t, state.Sync)
+}
+
+func TestHandler_Goroutines(t *testing.T) {
+	handler := NewHandler(mem.NewRegistry(), nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/goroutines", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "goroutine")
+}
+
+func TestHandler_SyncShadow(t *testing.T) {
+	handler := NewHandler(mem.NewRegistry(), nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/sync/shadow", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code, "shadow mode is disabled")
+
+	shadow := clients.NewSyncShadow(clients.ShadowConfig{Enabled: true})
+	require.NoError(t, shadow.Record("default", &types.SyncRequest{
+		Operation:   types.SyncOperationDelete,
+		SubjectType: types.SyncSubjectTypeNetworks,
+	}, &pb.SyncReq{SyncOp: pb.SyncReq_Delete}))
+	handler.SetSyncShadow(shadow)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/sync/shadow", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var dump struct {
+		Total    int64                  `json:"total"`
+		Requests []clients.ShadowedSync `json:"requests"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &dump))
+	assert.Equal(t, int64(1), dump.Total)
+	require.Len(t, dump.Requests, 1)
+	assert.Equal(t, "Delete", dump.Requests[0].Operation)
+}
+
+// fakeHostConflicts is a host conflict queue with a single conflict
+type fakeHostConflicts struct {
+	conflicts []synchronizer.HostConflict
+}
+
+func (f *fakeHostConflicts) PendingConflicts() []synchronizer.HostConflict { return f.conflicts }
+
+func (f *fakeHostConflicts) ResolveConflict(_ context.Context, hostUUID string, _ bool) error {
+	if len(f.conflicts) == 0 || f.conflicts[0].HostUUID != hostUUID {
+		return errors.New("no pending conflict")
+	}
+	f.conflicts = nil
+	return nil
+}
+
+func TestHandler_HostConflicts(t *testing.T) {
+	handler := NewHandler(mem.NewRegistry(), nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/reverse-sync/host-conflicts", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code, "conflicts are not queued")
+
+	conflicts := &fakeHostConflicts{conflicts: []synchronizer.HostConflict{{HostUUID: "uuid1", SGroupIPs: []string{"10.0.0.1"}}}}
+	handler.SetHostConflicts(func() synchronizer.HostConflictReviewer { return conflicts })
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/reverse-sync/host-conflicts", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var pending []synchronizer.HostConflict
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &pending))
+	require.Len(t, pending, 1)
+	assert.Equal(t, "uuid1", pending[0].HostUUID)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/reverse-sync/host-conflicts?host=uuid1&use=ours", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/debug/reverse-sync/host-conflicts?host=uuid1&use=sgroup", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, conflicts.conflicts)
+}
@@ -0,0 +1,99 @@
+// Package events delivers domain events published by the services to event sinks.
+//
+// Services publish events (resource applied or deleted, IEAgAg rule regenerated, sync failed)
+// through ports.EventPublisher after the change is committed. The Bus buffers them and a single
+// goroutine hands every event to all configured sinks: Kubernetes Events, an HTTP webhook and
+// the log. Publishing never blocks a request: events are dropped when the buffer is full.
+//
+// RuleWebhook notifies an HTTP endpoint about the diff of generated IEAgAgRules per namespace,
+// so security teams see every change of the effective policy.
+package events
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Sink receives domain events from the bus
+type Sink interface {
+	// Name identifies the sink in logs
+	Name() string
+	// Send delivers one event, it is called from a single goroutine
+	Send(ctx context.Context, event models.DomainEvent) error
+}
+
+// Bus is an asynchronous ports.EventPublisher fanning events out to sinks
+type Bus struct {
+	events chan models.DomainEvent
+	sinks  []Sink
+	logger logr.Logger
+
+	published atomic.Uint64
+	dropped   atomic.Uint64
+	failed    atomic.Uint64
+}
+
+var _ ports.EventPublisher = &Bus{}
+
+// NewBus creates a bus buffering up to bufferSize events for the sinks
+func NewBus(bufferSize int, logger logr.Logger, sinks ...Sink) *Bus {
+	return &Bus{
+		events: make(chan models.DomainEvent, bufferSize),
+		sinks:  sinks,
+		logger: logger,
+	}
+}
+
+// Publish enqueues the event, it is dropped when the buffer is full
+func (b *Bus) Publish(event models.DomainEvent) {
+	select {
+	case b.events <- event:
+		b.published.Add(1)
+	default:
+		if b.dropped.Add(1) == 1 {
+			b.logger.Info("Event buffer is full, dropping events", "type", event.Type, "kind", event.Kind)
+		}
+	}
+}
+
+// Run delivers buffered events to the sinks until ctx is done
+func (b *Bus) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-b.events:
+			b.deliver(ctx, event)
+		}
+	}
+}
+
+// deliver hands the event to every sink, a failing sink doesn't affect the others
+func (b *Bus) deliver(ctx context.Context, event models.DomainEvent) {
+	for _, sink := range b.sinks {
+		if err := sink.Send(ctx, event); err != nil {
+			b.failed.Add(1)
+			b.logger.Error(err, "Failed to deliver event", "sink", sink.Name(), "type", event.Type,
+				"kind", event.Kind, "resource", event.ResourceIdentifier.Key())
+		}
+	}
+}
+
+// WriteMetrics writes event counters in the Prometheus text format
+func (b *Bus) WriteMetrics(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "# HELP netguard_events_published_total Domain events accepted by the event bus\n"+
+		"# TYPE netguard_events_published_total counter\nnetguard_events_published_total %d\n"+
+		"# HELP netguard_events_dropped_total Domain events dropped because the event buffer was full\n"+
+		"# TYPE netguard_events_dropped_total counter\nnetguard_events_dropped_total %d\n"+
+		"# HELP netguard_events_failed_total Failed deliveries of domain events to sinks\n"+
+		"# TYPE netguard_events_failed_total counter\nnetguard_events_failed_total %d\n",
+		b.published.Load(), b.dropped.Load(), b.failed.Load())
+	return err
+}
@@ -0,0 +1,137 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/record"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// recordingSink collects delivered events, err is returned from every Send
+type recordingSink struct {
+	mu     sync.Mutex
+	events []models.DomainEvent
+	err    error
+}
+
+func (s *recordingSink) Name() string {
+	return "recording"
+}
+
+func (s *recordingSink) Send(_ context.Context, event models.DomainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func (s *recordingSink) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+func testEvent(name string) models.DomainEvent {
+	return models.NewDomainEvent(models.DomainEventResourceApplied, "Service",
+		models.NewResourceIdentifier(name, models.WithNamespace("default")), "Applied", "Service default/"+name+" applied")
+}
+
+func TestBus_DeliversToAllSinks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	failing := &recordingSink{err: errors.New("unavailable")}
+	working := &recordingSink{}
+	bus := NewBus(10, logr.Discard(), failing, working)
+	go bus.Run(ctx)
+
+	bus.Publish(testEvent("web"))
+	bus.Publish(testEvent("db"))
+
+	// A failing sink doesn't stop delivery to the others
+	require.Eventually(t, func() bool { return working.count() == 2 && failing.count() == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, "web", working.events[0].Name)
+	assert.Equal(t, "db", working.events[1].Name)
+
+	var metrics strings.Builder
+	require.NoError(t, bus.WriteMetrics(&metrics))
+	assert.Contains(t, metrics.String(), "netguard_events_published_total 2")
+	assert.Contains(t, metrics.String(), "netguard_events_failed_total 2")
+}
+
+func TestBus_DropsWhenBufferIsFull(t *testing.T) {
+	sink := &recordingSink{}
+	bus := NewBus(1, logr.Discard(), sink)
+
+	// Nothing reads the buffer, Publish must not block
+	bus.Publish(testEvent("web"))
+	bus.Publish(testEvent("db"))
+
+	var metrics strings.Builder
+	require.NoError(t, bus.WriteMetrics(&metrics))
+	assert.Contains(t, metrics.String(), "netguard_events_published_total 1")
+	assert.Contains(t, metrics.String(), "netguard_events_dropped_total 1")
+}
+
+func TestWebhookSink_PostsEvent(t *testing.T) {
+	var received WebhookEvent
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Token")
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, map[string]string{"X-Token": "secret"}, time.Second)
+	require.NoError(t, sink.Send(context.Background(), testEvent("web").Warning()))
+
+	assert.Equal(t, "secret", header)
+	assert.Equal(t, models.DomainEventResourceApplied, received.Type)
+	assert.Equal(t, "Service", received.Kind)
+	assert.Equal(t, "default", received.Namespace)
+	assert.Equal(t, "web", received.Name)
+	assert.Equal(t, models.DomainEventWarning, received.Severity)
+}
+
+func TestWebhookSink_FailsOnErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, nil, time.Second)
+	err := sink.Send(context.Background(), testEvent("web"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "500")
+}
+
+func TestKubernetesSink_RecordsEvents(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	sink := &KubernetesSink{recorder: recorder}
+
+	require.NoError(t, sink.Send(context.Background(), testEvent("web")))
+	failed := models.NewDomainEvent(models.DomainEventSyncFailed, "AddressGroup",
+		models.NewResourceIdentifier("ag", models.WithNamespace("default")), "SyncFailed", "sgroups sync failed").Warning()
+	require.NoError(t, sink.Send(context.Background(), failed))
+	// Events without a namespace are skipped
+	require.NoError(t, sink.Send(context.Background(), models.NewDomainEvent(models.DomainEventResourceApplied, "Service",
+		models.NewResourceIdentifier("web"), "Applied", "applied")))
+
+	assert.Equal(t, "Normal Applied Service default/web applied", <-recorder.Events)
+	assert.Equal(t, "Warning SyncFailed sgroups sync failed", <-recorder.Events)
+	assert.Empty(t, recorder.Events)
+}
@@ -0,0 +1,82 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
+
+	"netguard-pg-backend/internal/domain/models"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// KubernetesSink records domain events as Kubernetes Events of the netguard resources
+type KubernetesSink struct {
+	broadcaster record.EventBroadcaster
+	recorder    record.EventRecorder
+}
+
+// NewKubernetesSink connects to the cluster of kubeconfig, the in-cluster configuration is used
+// when kubeconfig is empty. Events are reported by component.
+func NewKubernetesSink(kubeconfig, component string) (*KubernetesSink, error) {
+	var clientConfig *restclient.Config
+	var err error
+	if kubeconfig == "" {
+		clientConfig, err = restclient.InClusterConfig()
+	} else {
+		clientConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load kubernetes client configuration: %w", err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create kubernetes client: %w", err)
+	}
+	return newKubernetesSink(record.NewBroadcaster(), &typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")}, component), nil
+}
+
+func newKubernetesSink(broadcaster record.EventBroadcaster, sink record.EventSink, component string) *KubernetesSink {
+	broadcaster.StartRecordingToSink(sink)
+	return &KubernetesSink{
+		broadcaster: broadcaster,
+		recorder:    broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component}),
+	}
+}
+
+// Name implements Sink
+func (s *KubernetesSink) Name() string {
+	return "kubernetes"
+}
+
+// Send implements Sink. Events of resources without a namespace have no place in the cluster
+// and are skipped; the recorder writes events asynchronously and aggregates repeated ones.
+func (s *KubernetesSink) Send(_ context.Context, event models.DomainEvent) error {
+	if event.Namespace == "" || event.Name == "" {
+		return nil
+	}
+	object := &corev1.ObjectReference{
+		APIVersion: netguardv1beta1.SchemeGroupVersion.String(),
+		Kind:       event.Kind,
+		Namespace:  event.Namespace,
+		Name:       event.Name,
+	}
+	eventType := corev1.EventTypeNormal
+	if event.Severity == models.DomainEventWarning {
+		eventType = corev1.EventTypeWarning
+	}
+	s.recorder.Event(object, eventType, event.Reason, event.Message)
+	return nil
+}
+
+// Close stops writing events to the cluster
+func (s *KubernetesSink) Close() {
+	s.broadcaster.Shutdown()
+}
@@ -0,0 +1,31 @@
+package events
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// LogSink writes domain events to the log
+type LogSink struct {
+	logger logr.Logger
+}
+
+// NewLogSink creates a sink logging events with the given logger
+func NewLogSink(logger logr.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+// Name implements Sink
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+// Send implements Sink
+func (s *LogSink) Send(_ context.Context, event models.DomainEvent) error {
+	s.logger.Info(event.Message, "type", event.Type, "severity", event.Severity, "kind", event.Kind,
+		"namespace", event.Namespace, "name", event.Name, "reason", event.Reason)
+	return nil
+}
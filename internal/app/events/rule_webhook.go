@@ -0,0 +1,206 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// RuleWebhookPayload is the JSON body posted when the generated IEAgAgRules of a namespace change
+type RuleWebhookPayload struct {
+	Namespace string             `json:"namespace"`
+	Time      time.Time          `json:"time"`
+	Reasons   []string           `json:"reasons"`
+	Summary   RuleWebhookSummary `json:"summary"`
+	Created   []RuleWebhookRule  `json:"created"`
+	Updated   []RuleWebhookDelta `json:"updated"`
+	Deleted   []RuleWebhookRule  `json:"deleted"`
+}
+
+// RuleWebhookSummary counts the changes of a payload
+type RuleWebhookSummary struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Deleted int `json:"deleted"`
+}
+
+// RuleWebhookDelta is an updated rule
+type RuleWebhookDelta struct {
+	Before RuleWebhookRule `json:"before"`
+	After  RuleWebhookRule `json:"after"`
+}
+
+// RuleWebhookRule is the effective policy of an IEAgAgRule
+type RuleWebhookRule struct {
+	Name              string            `json:"name"`
+	Transport         string            `json:"transport"`
+	Traffic           string            `json:"traffic"`
+	AddressGroupLocal string            `json:"addressGroupLocal"`
+	AddressGroup      string            `json:"addressGroup"`
+	Ports             []RuleWebhookPort `json:"ports"`
+	Action            string            `json:"action"`
+	Priority          int32             `json:"priority"`
+	Logs              bool              `json:"logs"`
+	Trace             bool              `json:"trace"`
+}
+
+// RuleWebhookPort is a port of an IEAgAgRule
+type RuleWebhookPort struct {
+	Source      string `json:"source,omitempty"`
+	Destination string `json:"destination"`
+}
+
+// RuleWebhook posts the diff of generated IEAgAgRules per namespace. Diffs of the recalculations
+// committed within an interval are merged, so a single policy change yields one notification
+// per namespace. Failed notifications are merged with later diffs and retried.
+type RuleWebhook struct {
+	sender     *webhookSender
+	interval   time.Duration
+	namespaces map[string]bool // empty - all namespaces
+	logger     logr.Logger
+
+	mu      sync.Mutex
+	pending map[string]models.IEAgAgRuleDiff
+}
+
+var _ ports.IEAgAgRuleDiffNotifier = &RuleWebhook{}
+
+// NewRuleWebhook creates a webhook posting to url every interval, namespaces limits the
+// notified namespaces
+func NewRuleWebhook(url string, headers map[string]string, timeout, interval time.Duration, namespaces []string, logger logr.Logger) *RuleWebhook {
+	selected := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		selected[namespace] = true
+	}
+	return &RuleWebhook{
+		sender:     newWebhookSender(url, headers, timeout),
+		interval:   interval,
+		namespaces: selected,
+		logger:     logger,
+		pending:    make(map[string]models.IEAgAgRuleDiff),
+	}
+}
+
+// NotifyIEAgAgRuleDiffs implements ports.IEAgAgRuleDiffNotifier
+func (w *RuleWebhook) NotifyIEAgAgRuleDiffs(diffs []models.IEAgAgRuleDiff) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, diff := range diffs {
+		if len(w.namespaces) > 0 && !w.namespaces[diff.Namespace] {
+			continue
+		}
+		w.merge(diff)
+	}
+}
+
+// merge adds a diff to the pending ones, the caller holds mu
+func (w *RuleWebhook) merge(diff models.IEAgAgRuleDiff) {
+	pending, ok := w.pending[diff.Namespace]
+	if !ok {
+		w.pending[diff.Namespace] = diff
+		return
+	}
+	pending.Merge(diff)
+	if pending.Empty() {
+		delete(w.pending, diff.Namespace)
+		return
+	}
+	w.pending[diff.Namespace] = pending
+}
+
+// Run posts pending diffs every interval until ctx is done
+func (w *RuleWebhook) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.flush(ctx)
+		}
+	}
+}
+
+// flush posts the pending diffs, failed ones wait for the next flush
+func (w *RuleWebhook) flush(ctx context.Context) {
+	w.mu.Lock()
+	pending := w.pending
+	w.pending = make(map[string]models.IEAgAgRuleDiff)
+	w.mu.Unlock()
+
+	for namespace, diff := range pending {
+		if err := w.sender.post(ctx, newRuleWebhookPayload(diff)); err != nil {
+			w.logger.Error(err, "Failed to notify about IEAgAgRule changes, retrying later", "namespace", namespace)
+			w.requeue(diff)
+			continue
+		}
+		w.logger.V(1).Info("Notified about IEAgAgRule changes", "namespace", namespace,
+			"created", len(diff.Created), "updated", len(diff.Updated), "deleted", len(diff.Deleted))
+	}
+}
+
+// requeue puts a failed diff in front of the changes made since the flush
+func (w *RuleWebhook) requeue(diff models.IEAgAgRuleDiff) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if later, ok := w.pending[diff.Namespace]; ok {
+		diff.Merge(later)
+	}
+	if diff.Empty() {
+		delete(w.pending, diff.Namespace)
+		return
+	}
+	w.pending[diff.Namespace] = diff
+}
+
+func newRuleWebhookPayload(diff models.IEAgAgRuleDiff) RuleWebhookPayload {
+	payload := RuleWebhookPayload{
+		Namespace: diff.Namespace,
+		Time:      diff.Time,
+		Reasons:   diff.Reasons,
+		Summary: RuleWebhookSummary{
+			Created: len(diff.Created),
+			Updated: len(diff.Updated),
+			Deleted: len(diff.Deleted),
+		},
+		Created: make([]RuleWebhookRule, 0, len(diff.Created)),
+		Updated: make([]RuleWebhookDelta, 0, len(diff.Updated)),
+		Deleted: make([]RuleWebhookRule, 0, len(diff.Deleted)),
+	}
+	for _, rule := range diff.Created {
+		payload.Created = append(payload.Created, newRuleWebhookRule(rule))
+	}
+	for _, update := range diff.Updated {
+		payload.Updated = append(payload.Updated, RuleWebhookDelta{Before: newRuleWebhookRule(update.Before), After: newRuleWebhookRule(update.After)})
+	}
+	for _, rule := range diff.Deleted {
+		payload.Deleted = append(payload.Deleted, newRuleWebhookRule(rule))
+	}
+	return payload
+}
+
+func newRuleWebhookRule(rule models.IEAgAgRule) RuleWebhookRule {
+	ports := make([]RuleWebhookPort, 0, len(rule.Ports))
+	for _, port := range rule.Ports {
+		ports = append(ports, RuleWebhookPort{Source: port.Source, Destination: port.Destination})
+	}
+	return RuleWebhookRule{
+		Name:              rule.Name,
+		Transport:         string(rule.Transport),
+		Traffic:           string(rule.Traffic),
+		AddressGroupLocal: rule.AddressGroupLocalKey(),
+		AddressGroup:      rule.AddressGroupKey(),
+		Ports:             ports,
+		Action:            string(rule.Action),
+		Priority:          rule.Priority,
+		Logs:              rule.Logs,
+		Trace:             rule.Trace,
+	}
+}
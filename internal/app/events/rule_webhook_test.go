@@ -0,0 +1,73 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+func webhookTestRule(name, namespace, port string) models.IEAgAgRule {
+	return models.IEAgAgRule{
+		SelfRef:   models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(namespace))),
+		Transport: models.TCP,
+		Traffic:   models.INGRESS,
+		Ports:     []models.PortSpec{{Destination: port}},
+		Action:    models.ActionAccept,
+	}
+}
+
+func TestRuleWebhook_PostsMergedDiffPerNamespace(t *testing.T) {
+	var mu sync.Mutex
+	var payloads []RuleWebhookPayload
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			fail = false
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var payload RuleWebhookPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+	}))
+	defer server.Close()
+
+	webhook := NewRuleWebhook(server.URL, nil, time.Second, time.Hour, []string{"prod"}, logr.Discard())
+	now := time.Now()
+	webhook.NotifyIEAgAgRuleDiffs(models.NewIEAgAgRuleDiffs(
+		[]models.IEAgAgRule{webhookTestRule("a", "prod", "80"), webhookTestRule("x", "dev", "80")},
+		nil, nil, nil, "first", now))
+
+	// The failed notification is retried together with the later changes
+	webhook.flush(context.Background())
+	webhook.NotifyIEAgAgRuleDiffs(models.NewIEAgAgRuleDiffs(
+		[]models.IEAgAgRule{webhookTestRule("b", "prod", "443")},
+		nil, nil, nil, "second", now))
+	webhook.flush(context.Background())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, payloads, 1)
+	payload := payloads[0]
+	assert.Equal(t, "prod", payload.Namespace)
+	assert.Equal(t, RuleWebhookSummary{Created: 2}, payload.Summary)
+	assert.Equal(t, []string{"first", "second"}, payload.Reasons)
+	require.Len(t, payload.Created, 2)
+	assert.Equal(t, "a", payload.Created[0].Name)
+	assert.Equal(t, "b", payload.Created[1].Name)
+	assert.Equal(t, []RuleWebhookPort{{Destination: "443"}}, payload.Created[1].Ports)
+	assert.Empty(t, payload.Updated)
+	assert.Empty(t, payload.Deleted)
+}
@@ -0,0 +1,98 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// WebhookEvent is the JSON body posted to the webhook for every event
+type WebhookEvent struct {
+	Type      string    `json:"type"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace,omitempty"`
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Severity  string    `json:"severity"`
+	Time      time.Time `json:"time"`
+}
+
+// WebhookSink posts domain events as JSON to an HTTP endpoint
+type WebhookSink struct {
+	sender *webhookSender
+}
+
+// NewWebhookSink creates a sink posting events to url, each request is bounded by timeout
+func NewWebhookSink(url string, headers map[string]string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{sender: newWebhookSender(url, headers, timeout)}
+}
+
+// Name implements Sink
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Send implements Sink
+func (s *WebhookSink) Send(ctx context.Context, event models.DomainEvent) error {
+	return s.sender.post(ctx, WebhookEvent{
+		Type:      event.Type,
+		Kind:      event.Kind,
+		Namespace: event.Namespace,
+		Name:      event.Name,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		Severity:  event.Severity,
+		Time:      event.Time,
+	})
+}
+
+// webhookSender posts JSON bodies to an HTTP endpoint
+type webhookSender struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newWebhookSender(url string, headers map[string]string, timeout time.Duration) *webhookSender {
+	return &webhookSender{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// post sends body as JSON, any status except 2xx is an error
+func (s *webhookSender) post(ctx context.Context, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal webhook body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range s.headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Package leader elects the backend replica running background jobs.
+//
+// All replicas serve requests. Jobs started with RunWhileLeader (reverse sync, drift
+// detection, rule garbage collection, rule schedule, change feed compaction) run only on
+// the replica holding the leader lock (see ports.LeaderLock) and are stopped as soon as
+// the lock is lost, so at most one replica runs them at a time.
+package leader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// releaseTimeout bounds the release of the leader lock on shutdown
+const releaseTimeout = 5 * time.Second
+
+// Elector campaigns for the leader lock and runs background jobs while it is held
+type Elector struct {
+	lock          ports.LeaderLock // nil lock leads from the start
+	retryInterval time.Duration
+	logger        logr.Logger
+
+	mu sync.Mutex
+	// term is canceled when leadership is lost, nil while following
+	term    context.Context
+	endTerm context.CancelFunc
+	// changed is closed and replaced when leadership is gained
+	changed chan struct{}
+}
+
+// NewElector creates an elector campaigning for the lock every retryInterval
+func NewElector(lock ports.LeaderLock, retryInterval time.Duration, logger logr.Logger) *Elector {
+	return &Elector{
+		lock:          lock,
+		retryInterval: retryInterval,
+		logger:        logger,
+		changed:       make(chan struct{}),
+	}
+}
+
+// Standalone returns an elector of a single replica deployment, it leads from the start
+func Standalone() *Elector {
+	e := NewElector(nil, 0, logr.Discard())
+	e.begin(context.Background())
+	return e
+}
+
+// Run campaigns for leadership until ctx is done, then releases the lock
+func (e *Elector) Run(ctx context.Context) {
+	if e.lock == nil {
+		return
+	}
+
+	ticker := time.NewTicker(e.retryInterval)
+	defer ticker.Stop()
+	defer e.resign()
+
+	for {
+		e.campaign(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsLeader reports whether this replica runs the background jobs
+func (e *Elector) IsLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.term != nil
+}
+
+// RunWhileLeader runs job every time this replica becomes leader until ctx is done. The job context
+// is canceled when leadership is lost; a job returning early is started again in the next term only.
+func (e *Elector) RunWhileLeader(ctx context.Context, name string, job func(ctx context.Context)) {
+	for {
+		term, ok := e.waitTerm(ctx)
+		if !ok {
+			return
+		}
+
+		jobCtx, cancel := context.WithCancel(ctx)
+		stop := context.AfterFunc(term, cancel)
+		e.logger.Info("Starting background job", "job", name)
+		job(jobCtx)
+		stop()
+		cancel()
+		e.logger.Info("Background job stopped", "job", name)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-term.Done():
+		}
+	}
+}
+
+// WriteMetrics writes the leadership state in the Prometheus text format
+func (e *Elector) WriteMetrics(w io.Writer) error {
+	leader := 0
+	if e.IsLeader() {
+		leader = 1
+	}
+	_, err := fmt.Fprintf(w, "# HELP netguard_leader Whether this replica runs the background jobs\n# TYPE netguard_leader gauge\nnetguard_leader %d\n", leader)
+	return err
+}
+
+// campaign takes the lock while following and checks it while leading
+func (e *Elector) campaign(ctx context.Context) {
+	if e.IsLeader() {
+		held, err := e.lock.Held(ctx)
+		if err != nil {
+			e.logger.Error(err, "Failed to check leader lock")
+		}
+		if !held {
+			e.end()
+			e.logger.Info("Leadership lost, background jobs stopped")
+		}
+		return
+	}
+
+	acquired, err := e.lock.TryAcquire(ctx)
+	if err != nil {
+		e.logger.Error(err, "Failed to acquire leader lock")
+		return
+	}
+	if acquired {
+		e.begin(ctx)
+		e.logger.Info("Became leader, starting background jobs")
+	}
+}
+
+// waitTerm blocks until this replica leads and returns the context of the term
+func (e *Elector) waitTerm(ctx context.Context) (context.Context, bool) {
+	for {
+		e.mu.Lock()
+		term, changed := e.term, e.changed
+		e.mu.Unlock()
+
+		if term != nil && term.Err() == nil {
+			return term, true
+		}
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-changed:
+		}
+	}
+}
+
+// begin starts a term of leadership
+func (e *Elector) begin(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.term, e.endTerm = context.WithCancel(ctx)
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+// end stops jobs of the current term
+func (e *Elector) end() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.endTerm != nil {
+		e.endTerm()
+	}
+	e.term, e.endTerm = nil, nil
+}
+
+// resign ends the term and releases the lock for another replica
+func (e *Elector) resign() {
+	e.end()
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if err := e.lock.Release(ctx); err != nil {
+		e.logger.Error(err, "Failed to release leader lock")
+	}
+}
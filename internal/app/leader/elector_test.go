@@ -0,0 +1,115 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLock is free until taken, held is cleared to simulate a lost database session
+type fakeLock struct {
+	mu       sync.Mutex
+	taken    bool
+	held     bool
+	released bool
+}
+
+func (l *fakeLock) TryAcquire(context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.taken {
+		return l.held, nil
+	}
+	l.taken, l.held = true, true
+	return true, nil
+}
+
+func (l *fakeLock) Held(context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.held, nil
+}
+
+func (l *fakeLock) Release(context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.released = true
+	return nil
+}
+
+func (l *fakeLock) lose() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.held = false
+}
+
+func TestElector_RunsJobsWhileLeader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lock := &fakeLock{}
+	elector := NewElector(lock, 10*time.Millisecond, logr.Discard())
+
+	var starts, running atomic.Int32
+	jobDone := make(chan struct{})
+	go func() {
+		defer close(jobDone)
+		elector.RunWhileLeader(ctx, "test", func(jobCtx context.Context) {
+			starts.Add(1)
+			running.Add(1)
+			<-jobCtx.Done()
+			running.Add(-1)
+		})
+	}()
+
+	// The job doesn't start before the lock is taken
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(0), starts.Load())
+
+	runDone := make(chan struct{})
+	go func() {
+		defer close(runDone)
+		elector.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool { return running.Load() == 1 }, time.Second, 5*time.Millisecond)
+	assert.True(t, elector.IsLeader())
+
+	// Losing the lock stops the job
+	lock.lose()
+	require.Eventually(t, func() bool { return running.Load() == 0 }, time.Second, 5*time.Millisecond)
+	assert.False(t, elector.IsLeader())
+	assert.Equal(t, int32(1), starts.Load())
+
+	cancel()
+	<-runDone
+	<-jobDone
+	assert.True(t, lock.released)
+}
+
+func TestStandalone_Leads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	elector := Standalone()
+	assert.True(t, elector.IsLeader())
+
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		elector.RunWhileLeader(ctx, "test", func(jobCtx context.Context) {
+			close(started)
+			<-jobCtx.Done()
+		})
+	}()
+
+	<-started
+	cancel()
+	<-done
+}
@@ -12,12 +12,18 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"netguard-pg-backend/internal/api/netguard"
+	"netguard-pg-backend/internal/app/apply"
 	"netguard-pg-backend/internal/application/services"
+	"netguard-pg-backend/internal/logging"
 	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
 )
 
-// SetupServer sets up the HTTP server with gRPC-Gateway and Swagger UI
-func SetupServer(ctx context.Context, grpcAddr string, httpAddr string, service *services.NetguardFacade) (*http.Server, error) {
+// SetupServer sets up the HTTP server with gRPC-Gateway and Swagger UI.
+// debugHandler serves /debug/ endpoints and may be nil when they are disabled, the log levels
+// are changed at /debug/logging next to them and with tenancy enabled only without a tenant token,
+// metricsHandler serves /metrics and may be nil. Multi-document YAML manifests are applied at /v2/apply,
+// with tenancy enabled authenticator checks the tenant token of its requests, nil leaves them unrestricted.
+func SetupServer(ctx context.Context, grpcAddr string, httpAddr string, service *services.NetguardFacade, debugHandler http.Handler, metricsHandler http.Handler, authenticator *netguard.TenantAuthenticator) (*http.Server, error) {
 	// Create gRPC server
 	grpcServer := grpc.NewServer()
 	netguardServer := netguard.NewNetguardServiceServer(service)
@@ -46,9 +52,25 @@ func SetupServer(ctx context.Context, grpcAddr string, httpAddr string, service
 	swaggerDir := http.Dir("./swagger-ui")
 	fileServer := http.FileServer(swaggerDir)
 	httpMux.Handle("/swagger/", http.StripPrefix("/swagger/", fileServer))
+	var applyHandler http.Handler = apply.NewHandler(service)
+	if authenticator != nil {
+		applyHandler = authenticator.HTTPHandler(applyHandler)
+	}
+	httpMux.Handle("/v2/apply", applyHandler)
+	if debugHandler != nil {
+		httpMux.Handle("/debug/", debugHandler)
+		var loggingHandler http.Handler = logging.Handler()
+		if authenticator != nil {
+			loggingHandler = authenticator.SystemHTTPHandler(loggingHandler)
+		}
+		httpMux.Handle("/debug/logging", loggingHandler)
+	}
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, "/swagger/") {
+		if strings.HasPrefix(r.URL.Path, "/swagger/") || strings.HasPrefix(r.URL.Path, "/debug/") || r.URL.Path == "/metrics" || r.URL.Path == "/v2/apply" {
 			httpMux.ServeHTTP(w, r)
 			return
 		}
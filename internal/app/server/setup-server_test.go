@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/api/netguard"
+	"netguard-pg-backend/internal/application/services"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/infrastructure/repositories/tenancy"
+)
+
+const teamAService = `
+apiVersion: netguard.sgroups.io/v1beta1
+kind: Service
+metadata:
+  name: web
+  namespace: team-a
+`
+
+const teamBAddressGroup = `
+apiVersion: netguard.sgroups.io/v1beta1
+kind: AddressGroup
+metadata:
+  name: backend
+  namespace: team-b
+spec:
+  defaultAction: ACCEPT
+`
+
+// newTenantServer serves a tenant-aware registry with the tenant team-a (token "token-a") owning
+// the namespace team-a
+func newTenantServer(t *testing.T, requireToken bool) (http.Handler, *mem.Registry) {
+	t.Helper()
+	backing := mem.NewRegistry()
+	registry := tenancy.NewRegistry(backing)
+	facade := services.NewNetguardFacade(registry, services.NewConditionManager(registry), nil)
+	authenticator := netguard.NewTenantAuthenticator(map[string]models.Tenant{
+		"token-a": {Name: "team-a", Namespaces: []string{"team-a"}},
+	}, requireToken)
+
+	httpServer, err := SetupServer(context.Background(), "127.0.0.1:0", "127.0.0.1:0", facade, nil, nil, authenticator)
+	require.NoError(t, err)
+	return httpServer.Handler, backing
+}
+
+func postApply(handler http.Handler, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v2/apply", strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func storedAddressGroup(t *testing.T, registry *mem.Registry, id models.ResourceIdentifier) error {
+	t.Helper()
+	reader, err := registry.Reader(context.Background())
+	require.NoError(t, err)
+	defer reader.Close()
+	_, err = reader.GetAddressGroupByID(context.Background(), id)
+	return err
+}
+
+func TestApply_RequiresTenantToken(t *testing.T) {
+	handler, _ := newTenantServer(t, true)
+
+	rec := postApply(handler, "", teamAService)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, "requests without a token must be rejected")
+	assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+
+	rec = postApply(handler, "token-b", teamAService)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code, "requests with an unknown token must be rejected")
+
+	rec = postApply(handler, "token-a", teamAService)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+func TestApply_TenantCannotWriteOtherNamespaces(t *testing.T) {
+	handler, registry := newTenantServer(t, true)
+	backend := models.NewResourceIdentifier("backend", models.WithNamespace("team-b"))
+
+	rec := postApply(handler, "token-a", teamBAddressGroup)
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	assert.Error(t, storedAddressGroup(t, registry, backend), "the address group of another tenant must not be written")
+
+	// Without a required token requests are unrestricted
+	handler, registry = newTenantServer(t, false)
+	rec = postApply(handler, "", teamBAddressGroup)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.NoError(t, storedAddressGroup(t, registry, backend))
+}
+
+func TestDebugLogging_MountedOnlyWithDebugEndpoints(t *testing.T) {
+	facade := services.NewNetguardFacade(mem.NewRegistry(), nil, nil)
+	get := func(handler http.Handler, token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/debug/logging", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	httpServer, err := SetupServer(context.Background(), "127.0.0.1:0", "127.0.0.1:0", facade, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, get(httpServer.Handler, ""), "log levels must not be served without debug endpoints")
+
+	authenticator := netguard.NewTenantAuthenticator(map[string]models.Tenant{
+		"token-a": {Name: "team-a", Namespaces: []string{"team-a"}},
+	}, false)
+	httpServer, err = SetupServer(context.Background(), "127.0.0.1:0", "127.0.0.1:0", facade, http.NotFoundHandler(), nil, authenticator)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, get(httpServer.Handler, ""))
+	assert.Equal(t, http.StatusForbidden, get(httpServer.Handler, "token-a"), "tenants must not change log levels")
+	assert.Equal(t, http.StatusUnauthorized, get(httpServer.Handler, "token-b"))
+}
@@ -0,0 +1,119 @@
+// Package startup builds the report describing how the backend was started.
+// The report is logged once on boot and served by the GetStartupReport RPC.
+package startup
+
+import (
+	"context"
+	"runtime/debug"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/config"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/sync/interfaces"
+)
+
+// AppName is the application name reported on startup
+const AppName = "netguard-pg-backend"
+
+// Storage backends
+const (
+	StorageMemory   = "memory"
+	StorageEmbedded = "embedded"
+	StoragePostgres = "postgres"
+	StorageMySQL    = "mysql"
+)
+
+// schemaVersionProvider is implemented by registries with versioned schema migrations
+type schemaVersionProvider interface {
+	SchemaVersion(ctx context.Context) (int64, error)
+}
+
+// Options describes the started components
+type Options struct {
+	Storage  string
+	Registry interface{}
+	// SyncManager is nil if synchronization with sgroups is disabled
+	SyncManager interfaces.SyncManager
+	// ReverseSync is true if the SGROUP -> NETGUARD synchronization was set up
+	ReverseSync bool
+}
+
+// Build builds the startup report. Failures to read optional details are logged
+// and leave the corresponding fields empty.
+func Build(ctx context.Context, cfg *config.Config, opts Options, logger logr.Logger) models.StartupReport {
+	report := models.StartupReport{
+		App:            AppName,
+		Version:        version(),
+		StartedAt:      time.Now(),
+		StorageBackend: opts.Storage,
+		Syncers:        []models.StartupSyncer{},
+		ReverseSync: models.StartupReverseSync{
+			Enabled:   opts.ReverseSync,
+			AutoStart: opts.ReverseSync && cfg.ReverseSync.Manager.AutoStart,
+		},
+		AuthMode: cfg.Authn.Type,
+		GRPCAddr: cfg.Settings.GRPCAddr,
+		HTTPAddr: cfg.Settings.HTTPAddr,
+	}
+	if report.AuthMode == "" {
+		report.AuthMode = config.AuthnTypeTLS
+	}
+
+	if provider, ok := opts.Registry.(schemaVersionProvider); ok {
+		schemaVersion, err := provider.SchemaVersion(ctx)
+		if err != nil {
+			logger.Error(err, "Failed to read schema version")
+		}
+		report.SchemaVersion = schemaVersion
+	}
+
+	if inspector, ok := opts.SyncManager.(interfaces.SyncerInspector); ok {
+		for target, subjectTypes := range inspector.RegisteredSyncers() {
+			report.Syncers = append(report.Syncers, models.StartupSyncer{Target: target, SubjectTypes: subjectTypes})
+		}
+		sort.Slice(report.Syncers, func(i, j int) bool { return report.Syncers[i].Target < report.Syncers[j].Target })
+	}
+
+	syncEnabled := opts.SyncManager != nil
+	report.Features = map[string]bool{
+		"sync":                 syncEnabled,
+		"syncOutbox":           syncEnabled && cfg.Sync.Outbox.Enabled,
+		"syncBatching":         syncEnabled && cfg.Sync.Batching.Window > 0,
+		"driftDetection":       syncEnabled && cfg.Sync.Drift.Enabled,
+		"circuitBreaker":       syncEnabled && cfg.Sync.SGroups.CircuitBreaker.Enabled,
+		"legacyRuleGeneration": cfg.Settings.LegacyRuleGeneration,
+		"admission":            cfg.Admission.Enabled,
+		"validationWebhooks":   cfg.ValidationHooks.Enabled,
+		"debugEndpoints":       cfg.Debug.Enabled,
+	}
+	return report
+}
+
+// Log writes the report as a single structured log record
+func Log(logger logr.Logger, report models.StartupReport) {
+	logger.Info("Startup report",
+		"app", report.App,
+		"version", report.Version,
+		"startedAt", report.StartedAt,
+		"storageBackend", report.StorageBackend,
+		"schemaVersion", report.SchemaVersion,
+		"syncers", report.Syncers,
+		"reverseSync", report.ReverseSync,
+		"authMode", report.AuthMode,
+		"grpcAddr", report.GRPCAddr,
+		"httpAddr", report.HTTPAddr,
+		"features", report.Features,
+	)
+}
+
+// version returns the main module version embedded by the Go toolchain
+func version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == "" {
+		return "unknown"
+	}
+	return info.Main.Version
+}
@@ -0,0 +1,307 @@
+// Package validationwebhook enforces external validation webhooks on writes of backend clients.
+//
+// Kubernetes clients are checked by the admission webhook of the aggregated API server, while
+// direct gRPC and HTTP clients of the backend bypass it. The facade submits the resources of every
+// Sync to the Dispatcher (ports.ResourceValidator) before they are applied. The Dispatcher posts
+// them to the webhooks selecting their kind, namespace and operation and rejects the request when a
+// webhook denies it, so the policies of the admission webhook are enforced for every client.
+package validationwebhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// FailurePolicy defines what happens when a webhook can't be called or its response is invalid
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail rejects the request (default)
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyIgnore skips the webhook
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// defaultTimeout bounds a webhook call when the webhook has no timeout
+const defaultTimeout = 5 * time.Second
+
+// maxResponseSize limits the decoded webhook response
+const maxResponseSize = 1 << 20
+
+// Webhook is an external validation webhook
+type Webhook struct {
+	// Name identifies the webhook in denial reasons and logs
+	Name    string
+	URL     string
+	Headers map[string]string
+	// Timeout bounds a call, 5s when zero
+	Timeout time.Duration
+	// Kinds the webhook validates (e.g. Service, RuleS2S), all kinds when empty
+	Kinds []string
+	// Namespaces the webhook validates, all namespaces when empty
+	Namespaces []string
+	// Operations the webhook validates (create, update, delete), create and update when empty
+	Operations []string
+	// FailurePolicy is applied when the webhook fails, Fail when empty
+	FailurePolicy FailurePolicy
+}
+
+// Request is the JSON body posted to a webhook, it holds the resources of one Sync selected
+// by the webhook
+type Request struct {
+	// UID identifies the request in webhook logs
+	UID string `json:"uid"`
+	// Tenant is the authenticated tenant of the client, empty without tenancy
+	Tenant    string     `json:"tenant,omitempty"`
+	Resources []Resource `json:"resources"`
+}
+
+// Resource is a requested write of a resource
+type Resource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Operation string `json:"operation"`
+	// Object is null on delete, OldObject is null on create
+	Object    json.RawMessage `json:"object"`
+	OldObject json.RawMessage `json:"oldObject"`
+}
+
+// Response is the JSON body a webhook responds with
+type Response struct {
+	Allowed bool   `json:"allowed"`
+	Message string `json:"message,omitempty"`
+	// Violations are the reasons of a denial per resource
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Violation is a reason of a denial. Kind and Name are empty for reasons of the whole request,
+// Field is the path of the violated field, e.g. spec.ingressPorts
+type Violation struct {
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Field     string `json:"field,omitempty"`
+	Message   string `json:"message"`
+}
+
+// hook is a webhook with its HTTP client and selectors
+type hook struct {
+	Webhook
+	client     *http.Client
+	kinds      map[string]bool // empty - all kinds
+	namespaces map[string]bool // empty - all namespaces
+	operations map[string]bool
+}
+
+// Dispatcher validates requested writes with the webhooks in configuration order, the first
+// denial rejects the request
+type Dispatcher struct {
+	webhooks []*hook
+	logger   logr.Logger
+}
+
+var _ ports.ResourceValidator = &Dispatcher{}
+
+// NewDispatcher creates a dispatcher calling webhooks, webhooks without name or URL, with
+// duplicate names or unknown operations and failure policies are an error
+func NewDispatcher(webhooks []Webhook, logger logr.Logger) (*Dispatcher, error) {
+	dispatcher := &Dispatcher{logger: logger}
+	names := make(map[string]bool, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook.Name == "" || webhook.URL == "" {
+			return nil, fmt.Errorf("validation webhook name and url are required")
+		}
+		if names[webhook.Name] {
+			return nil, fmt.Errorf("duplicate validation webhook %s", webhook.Name)
+		}
+		names[webhook.Name] = true
+
+		switch webhook.FailurePolicy {
+		case "":
+			webhook.FailurePolicy = FailurePolicyFail
+		case FailurePolicyFail, FailurePolicyIgnore:
+		default:
+			return nil, fmt.Errorf("validation webhook %s: unknown failure policy %s", webhook.Name, webhook.FailurePolicy)
+		}
+		if webhook.Timeout <= 0 {
+			webhook.Timeout = defaultTimeout
+		}
+		if len(webhook.Operations) == 0 {
+			webhook.Operations = []string{models.ResourceChangeCreate, models.ResourceChangeUpdate}
+		}
+		for _, operation := range webhook.Operations {
+			switch operation {
+			case models.ResourceChangeCreate, models.ResourceChangeUpdate, models.ResourceChangeDelete:
+			default:
+				return nil, fmt.Errorf("validation webhook %s: unknown operation %s", webhook.Name, operation)
+			}
+		}
+
+		dispatcher.webhooks = append(dispatcher.webhooks, &hook{
+			Webhook:    webhook,
+			client:     &http.Client{Timeout: webhook.Timeout},
+			kinds:      set(webhook.Kinds),
+			namespaces: set(webhook.Namespaces),
+			operations: set(webhook.Operations),
+		})
+	}
+	return dispatcher, nil
+}
+
+// ValidateResources implements ports.ResourceValidator. A denial is returned as a
+// validation.WebhookDeniedError.
+func (d *Dispatcher) ValidateResources(ctx context.Context, reviews []models.ResourceReview) error {
+	for _, webhook := range d.webhooks {
+		selected := webhook.selected(reviews)
+		if len(selected) == 0 {
+			continue
+		}
+		if err := d.call(ctx, webhook, selected); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// call posts the selected reviews to a webhook and applies its failure policy
+func (d *Dispatcher) call(ctx context.Context, webhook *hook, reviews []models.ResourceReview) error {
+	request, err := newRequest(ctx, reviews)
+	if err != nil {
+		return fmt.Errorf("encode request of validation webhook %s: %w", webhook.Name, err)
+	}
+
+	response, err := webhook.post(ctx, request)
+	if err != nil {
+		if webhook.FailurePolicy == FailurePolicyIgnore {
+			d.logger.Error(err, "Validation webhook failed, ignoring it", "webhook", webhook.Name, "uid", request.UID)
+			return nil
+		}
+		return fmt.Errorf("validation webhook %s failed: %w", webhook.Name, err)
+	}
+	if response.Allowed {
+		return nil
+	}
+
+	d.logger.Info("Validation webhook denied the request", "webhook", webhook.Name, "uid", request.UID,
+		"resources", len(reviews), "message", response.Message)
+	denied := &validation.WebhookDeniedError{Webhook: webhook.Name, Message: response.Message}
+	for _, violation := range response.Violations {
+		var resource string
+		if violation.Name != "" {
+			resource = violation.Kind + "/" + models.NewResourceIdentifier(violation.Name, models.WithNamespace(violation.Namespace)).Key()
+		}
+		denied.Violations = append(denied.Violations, validation.WebhookViolation{
+			Resource: resource,
+			Field:    violation.Field,
+			Message:  violation.Message,
+		})
+	}
+	return denied
+}
+
+// selected returns the reviews of the kinds, namespaces and operations of the webhook
+func (h *hook) selected(reviews []models.ResourceReview) []models.ResourceReview {
+	var selected []models.ResourceReview
+	for _, review := range reviews {
+		if len(h.kinds) > 0 && !h.kinds[review.Kind] {
+			continue
+		}
+		if len(h.namespaces) > 0 && !h.namespaces[review.Namespace] {
+			continue
+		}
+		if !h.operations[review.Operation] {
+			continue
+		}
+		selected = append(selected, review)
+	}
+	return selected
+}
+
+// post sends the request as JSON and decodes the response, any status except 2xx is an error
+func (h *hook) post(ctx context.Context, request Request) (Response, error) {
+	var response Response
+	data, err := json.Marshal(request)
+	if err != nil {
+		return response, fmt.Errorf("marshal webhook request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(data))
+	if err != nil {
+		return response, fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range h.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return response, fmt.Errorf("post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return response, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&response); err != nil {
+		return response, fmt.Errorf("decode webhook response: %w", err)
+	}
+	return response, nil
+}
+
+// newRequest encodes the reviews with the tenant of the client
+func newRequest(ctx context.Context, reviews []models.ResourceReview) (Request, error) {
+	request := Request{UID: uuid.NewString(), Resources: make([]Resource, 0, len(reviews))}
+	if tenant, ok := ports.TenantFromContext(ctx); ok {
+		request.Tenant = tenant.Name
+	}
+	for _, review := range reviews {
+		object, err := encodeObject(review.Object)
+		if err != nil {
+			return request, fmt.Errorf("encode %s %s: %w", review.Kind, review.Key(), err)
+		}
+		oldObject, err := encodeObject(review.OldObject)
+		if err != nil {
+			return request, fmt.Errorf("encode stored %s %s: %w", review.Kind, review.Key(), err)
+		}
+		request.Resources = append(request.Resources, Resource{
+			Kind:      review.Kind,
+			Namespace: review.Namespace,
+			Name:      review.Name,
+			Operation: review.Operation,
+			Object:    object,
+			OldObject: oldObject,
+		})
+	}
+	return request, nil
+}
+
+// encodeObject encodes a missing object as JSON null
+func encodeObject(object interface{}) (json.RawMessage, error) {
+	if object == nil {
+		return json.RawMessage("null"), nil
+	}
+	return json.Marshal(object)
+}
+
+func set(values []string) map[string]bool {
+	selected := make(map[string]bool, len(values))
+	for _, value := range values {
+		selected[value] = true
+	}
+	return selected
+}
@@ -0,0 +1,118 @@
+package validationwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+func testReviews() []models.ResourceReview {
+	web := models.NewResourceIdentifier("web", models.WithNamespace("app"))
+	rule := models.NewResourceIdentifier("rule", models.WithNamespace("app"))
+	return []models.ResourceReview{
+		models.NewResourceReview("Service", web, models.Service{SelfRef: models.NewSelfRef(web)}, nil),
+		models.NewResourceReview("RuleS2S", rule, nil, models.RuleS2S{SelfRef: models.NewSelfRef(rule)}),
+	}
+}
+
+func TestDispatcher_DeniesWithViolations(t *testing.T) {
+	var requests []Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Token"))
+		var request Request
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request)
+		_ = json.NewEncoder(w).Encode(Response{
+			Message:    "policy violated",
+			Violations: []Violation{{Kind: "Service", Namespace: "app", Name: "web", Field: "spec.ingressPorts", Message: "port 22 is forbidden"}},
+		})
+	}))
+	defer server.Close()
+
+	dispatcher, err := NewDispatcher([]Webhook{{
+		Name:    "policies",
+		URL:     server.URL,
+		Headers: map[string]string{"X-Token": "secret"},
+		Kinds:   []string{"Service", "RuleS2S"},
+	}}, logr.Discard())
+	require.NoError(t, err)
+
+	ctx := ports.WithTenant(context.Background(), models.Tenant{Name: "team-a"})
+	err = dispatcher.ValidateResources(ctx, testReviews())
+	var denied *validation.WebhookDeniedError
+	require.ErrorAs(t, err, &denied)
+	assert.Equal(t, "policies", denied.Webhook)
+	assert.Equal(t, []validation.WebhookViolation{{Resource: "Service/app/web", Field: "spec.ingressPorts", Message: "port 22 is forbidden"}}, denied.Violations)
+	assert.Contains(t, err.Error(), "validation webhook policies denied the request: policy violated")
+
+	// Deletions are not selected by default
+	require.Len(t, requests, 1)
+	assert.Equal(t, "team-a", requests[0].Tenant)
+	require.Len(t, requests[0].Resources, 1)
+	resource := requests[0].Resources[0]
+	assert.Equal(t, "Service", resource.Kind)
+	assert.Equal(t, models.ResourceChangeCreate, resource.Operation)
+	assert.JSONEq(t, "null", string(resource.OldObject))
+}
+
+func TestDispatcher_SelectsAndAllows(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_ = json.NewEncoder(w).Encode(Response{Allowed: true})
+	}))
+	defer server.Close()
+
+	dispatcher, err := NewDispatcher([]Webhook{
+		{Name: "rules", URL: server.URL, Kinds: []string{"RuleS2S"}, Operations: []string{models.ResourceChangeDelete}},
+		{Name: "other-namespace", URL: server.URL, Namespaces: []string{"prod"}},
+	}, logr.Discard())
+	require.NoError(t, err)
+
+	require.NoError(t, dispatcher.ValidateResources(context.Background(), testReviews()))
+	assert.Equal(t, 1, calls)
+}
+
+func TestDispatcher_FailurePolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	ignoring, err := NewDispatcher([]Webhook{{Name: "optional", URL: server.URL, FailurePolicy: FailurePolicyIgnore}}, logr.Discard())
+	require.NoError(t, err)
+	assert.NoError(t, ignoring.ValidateResources(context.Background(), testReviews()))
+
+	failing, err := NewDispatcher([]Webhook{{Name: "required", URL: server.URL, Timeout: time.Second}}, logr.Discard())
+	require.NoError(t, err)
+	err = failing.ValidateResources(context.Background(), testReviews())
+	assert.ErrorContains(t, err, "validation webhook required failed: webhook responded with status 500")
+	var denied *validation.WebhookDeniedError
+	assert.False(t, errors.As(err, &denied))
+}
+
+func TestNewDispatcher_InvalidWebhooks(t *testing.T) {
+	for name, webhooks := range map[string][]Webhook{
+		"MissingURL":    {{Name: "a"}},
+		"Duplicate":     {{Name: "a", URL: "http://a"}, {Name: "a", URL: "http://b"}},
+		"FailurePolicy": {{Name: "a", URL: "http://a", FailurePolicy: "Retry"}},
+		"Operation":     {{Name: "a", URL: "http://a", Operations: []string{"patch"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewDispatcher(webhooks, logr.Discard())
+			assert.Error(t, err)
+		})
+	}
+}
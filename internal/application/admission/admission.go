@@ -0,0 +1,181 @@
+// Package admission classifies requests into interactive and bulk ones and
+// admits bulk operations at a lower priority: only a few of them run at once,
+// they are applied in small batches (short transactions and lock scopes) and
+// every batch yields to in-flight interactive operations.
+package admission
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Class is the priority class of a request
+type Class int
+
+const (
+	// ClassInteractive - single-resource operations issued by users and controllers
+	ClassInteractive Class = iota
+	// ClassBulk - mass apply, import and delete operations
+	ClassBulk
+)
+
+// PriorityHeader is the gRPC metadata key clients use to classify requests explicitly.
+// HTTP clients send it as Grpc-Metadata-X-Netguard-Priority.
+const PriorityHeader = "x-netguard-priority"
+
+// String returns the class name
+func (c Class) String() string {
+	if c == ClassBulk {
+		return "bulk"
+	}
+	return "interactive"
+}
+
+// yieldPollInterval is how often a bulk batch checks for in-flight interactive operations
+const yieldPollInterval = 10 * time.Millisecond
+
+// Config holds admission configuration
+type Config struct {
+	// BulkThreshold - requests with more resources are classified as bulk
+	BulkThreshold int
+	// BulkConcurrency - maximum number of concurrently running bulk requests
+	BulkConcurrency int
+	// BulkBatchSize - number of resources applied by a bulk request in one transaction
+	BulkBatchSize int
+	// BulkBatchPause - pause between batches of a bulk request
+	BulkBatchPause time.Duration
+	// InteractiveYield - maximum time a bulk batch waits for interactive operations to finish
+	InteractiveYield time.Duration
+}
+
+// DefaultConfig returns default admission configuration
+func DefaultConfig() Config {
+	return Config{
+		BulkThreshold:    100,
+		BulkConcurrency:  2,
+		BulkBatchSize:    50,
+		BulkBatchPause:   50 * time.Millisecond,
+		InteractiveYield: 500 * time.Millisecond,
+	}
+}
+
+// Controller admits requests according to their class.
+// A nil Controller admits everything immediately and never splits requests.
+type Controller struct {
+	config      Config
+	bulkSlots   chan struct{}
+	interactive atomic.Int64
+}
+
+// NewController creates a new admission controller
+func NewController(config Config) *Controller {
+	if config.BulkConcurrency <= 0 {
+		config.BulkConcurrency = 1
+	}
+	return &Controller{
+		config:    config,
+		bulkSlots: make(chan struct{}, config.BulkConcurrency),
+	}
+}
+
+// Classify returns the class of a request with the given number of resources.
+// An explicit hint ("bulk" or "interactive") takes precedence over the size.
+func (c *Controller) Classify(hint string, resources int) Class {
+	switch strings.ToLower(strings.TrimSpace(hint)) {
+	case ClassBulk.String():
+		return ClassBulk
+	case ClassInteractive.String():
+		return ClassInteractive
+	}
+	if c != nil && c.config.BulkThreshold > 0 && resources > c.config.BulkThreshold {
+		return ClassBulk
+	}
+	return ClassInteractive
+}
+
+// Admit waits until a request of the class may run. The returned release
+// function must be called when the request is finished.
+func (c *Controller) Admit(ctx context.Context, class Class) (func(), error) {
+	if c == nil {
+		return func() {}, nil
+	}
+
+	if class != ClassBulk {
+		c.interactive.Add(1)
+		return func() { c.interactive.Add(-1) }, nil
+	}
+
+	select {
+	case c.bulkSlots <- struct{}{}:
+		return func() { <-c.bulkSlots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// BatchSize returns the number of resources a bulk request applies at once, 0 means no splitting
+func (c *Controller) BatchSize() int {
+	if c == nil {
+		return 0
+	}
+	return c.config.BulkBatchSize
+}
+
+// WaitBulkBatch throttles a bulk request before its next batch: it pauses and
+// then waits (up to InteractiveYield) for in-flight interactive operations
+func (c *Controller) WaitBulkBatch(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	if err := sleep(ctx, c.config.BulkBatchPause); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(c.config.InteractiveYield)
+	for c.interactive.Load() > 0 && time.Now().Before(deadline) {
+		if err := sleep(ctx, yieldPollInterval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InFlightInteractive returns the number of running interactive requests
+func (c *Controller) InFlightInteractive() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.interactive.Load()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type classKey struct{}
+
+// WithClass returns a context carrying the request class
+func WithClass(ctx context.Context, class Class) context.Context {
+	return context.WithValue(ctx, classKey{}, class)
+}
+
+// ClassFrom returns the request class stored in the context, interactive by default
+func ClassFrom(ctx context.Context) Class {
+	if class, ok := ctx.Value(classKey{}).(Class); ok {
+		return class
+	}
+	return ClassInteractive
+}
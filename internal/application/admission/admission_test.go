@@ -0,0 +1,71 @@
+package admission
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestController_Classify(t *testing.T) {
+	c := NewController(Config{BulkThreshold: 10})
+
+	assert.Equal(t, ClassInteractive, c.Classify("", 1))
+	assert.Equal(t, ClassInteractive, c.Classify("", 10))
+	assert.Equal(t, ClassBulk, c.Classify("", 11))
+	assert.Equal(t, ClassBulk, c.Classify("Bulk", 1))
+	assert.Equal(t, ClassInteractive, c.Classify("interactive", 1000))
+
+	var disabled *Controller
+	assert.Equal(t, ClassInteractive, disabled.Classify("", 1000))
+}
+
+func TestController_BulkConcurrency(t *testing.T) {
+	c := NewController(Config{BulkConcurrency: 1})
+
+	release, err := c.Admit(context.Background(), ClassBulk)
+	require.NoError(t, err)
+
+	// The only bulk slot is taken
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.Admit(ctx, ClassBulk)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Interactive requests are never queued behind bulk ones
+	releaseInteractive, err := c.Admit(context.Background(), ClassInteractive)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), c.InFlightInteractive())
+	releaseInteractive()
+	assert.Equal(t, int64(0), c.InFlightInteractive())
+
+	release()
+	release, err = c.Admit(context.Background(), ClassBulk)
+	require.NoError(t, err)
+	release()
+}
+
+func TestController_WaitBulkBatchYieldsToInteractive(t *testing.T) {
+	c := NewController(Config{InteractiveYield: time.Second})
+
+	release, err := c.Admit(context.Background(), ClassInteractive)
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		release()
+	}()
+
+	start := time.Now()
+	require.NoError(t, c.WaitBulkBatch(context.Background()))
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 30*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestClassFrom(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ClassInteractive, ClassFrom(ctx))
+	assert.Equal(t, ClassBulk, ClassFrom(WithClass(ctx, ClassBulk)))
+}
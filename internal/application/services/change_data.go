@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// changeDataItem is a synced resource together with its stored state
+type changeDataItem struct {
+	kind     string
+	id       models.ResourceIdentifier
+	snapshot interface{} // nil when the resource is not stored
+}
+
+// SetResourceChangePublisher streams committed resource changes with before/after snapshots to publisher
+func (f *NetguardFacade) SetResourceChangePublisher(publisher ports.ResourceChangePublisher) {
+	f.changeData = publisher
+}
+
+// changeDataSnapshots reads the stored state of synced resources. The second return value is false
+// when the state can't be read, changes of the batch are not published then.
+func (f *NetguardFacade) changeDataSnapshots(ctx context.Context, resources interface{}) ([]changeDataItem, bool) {
+	items := reflect.ValueOf(resources)
+	if items.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	reader, err := f.registry.PrimaryReader(ctx)
+	if err != nil {
+		klog.Errorf("❌ CHANGE_DATA: Failed to get reader for resource snapshots: %v", err)
+		return nil, false
+	}
+	defer reader.Close()
+
+	snapshots := make([]changeDataItem, 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		if item.Kind() != reflect.Struct {
+			return nil, false
+		}
+		field := item.FieldByName("ResourceIdentifier")
+		if !field.IsValid() {
+			return nil, false
+		}
+		id, ok := field.Interface().(models.ResourceIdentifier)
+		if !ok {
+			return nil, false
+		}
+
+		snapshot, err := storedResource(ctx, reader, item.Interface())
+		if err != nil {
+			klog.Errorf("❌ CHANGE_DATA: Failed to read %s %s snapshot: %v", item.Type().Name(), id.Key(), err)
+			return nil, false
+		}
+		snapshots = append(snapshots, changeDataItem{kind: item.Type().Name(), id: id, snapshot: snapshot})
+	}
+	return snapshots, true
+}
+
+// publishResourceChanges publishes changes between the snapshots taken before the sync and the
+// state committed by it. Deletions of resources that didn't exist are not changes.
+func (f *NetguardFacade) publishResourceChanges(ctx context.Context, resources interface{}, before []changeDataItem) {
+	after, ok := f.changeDataSnapshots(ctx, resources)
+	if !ok || len(after) != len(before) {
+		return
+	}
+	for i := range after {
+		if before[i].snapshot == nil && after[i].snapshot == nil {
+			continue
+		}
+		f.changeData.PublishChange(models.NewResourceChange(after[i].kind, after[i].id, before[i].snapshot, after[i].snapshot))
+	}
+}
+
+// storedResource returns the stored copy of a resource, nil when it doesn't exist
+func storedResource(ctx context.Context, reader ports.Reader, resource interface{}) (interface{}, error) {
+	switch r := resource.(type) {
+	case models.Service:
+		return storedCopy(reader.GetServiceByID(ctx, r.ResourceIdentifier))
+	case models.AddressGroup:
+		return storedCopy(reader.GetAddressGroupByID(ctx, r.ResourceIdentifier))
+	case models.AddressGroupBinding:
+		return storedCopy(reader.GetAddressGroupBindingByID(ctx, r.ResourceIdentifier))
+	case models.AddressGroupPortMapping:
+		return storedCopy(reader.GetAddressGroupPortMappingByID(ctx, r.ResourceIdentifier))
+	case models.RuleS2S:
+		return storedCopy(reader.GetRuleS2SByID(ctx, r.ResourceIdentifier))
+	case models.ServiceAlias:
+		return storedCopy(reader.GetServiceAliasByID(ctx, r.ResourceIdentifier))
+	case models.AddressGroupBindingPolicy:
+		return storedCopy(reader.GetAddressGroupBindingPolicyByID(ctx, r.ResourceIdentifier))
+	case models.IEAgAgRule:
+		return storedCopy(reader.GetIEAgAgRuleByID(ctx, r.ResourceIdentifier))
+	case models.Network:
+		return storedCopy(reader.GetNetworkByID(ctx, r.ResourceIdentifier))
+	case models.NetworkBinding:
+		return storedCopy(reader.GetNetworkBindingByID(ctx, r.ResourceIdentifier))
+	case models.Host:
+		return storedCopy(reader.GetHostByID(ctx, r.ResourceIdentifier))
+	case models.HostBinding:
+		return storedCopy(reader.GetHostBindingByID(ctx, r.ResourceIdentifier))
+	case models.RuleS2SException:
+		return storedCopy(reader.GetRuleS2SExceptionByID(ctx, r.ResourceIdentifier))
+	case models.CrossNamespacePolicy:
+		return storedCopy(reader.GetCrossNamespacePolicyByID(ctx, r.ResourceIdentifier))
+	case models.RuleTemplate:
+		return storedCopy(reader.GetRuleTemplateByID(ctx, r.ResourceIdentifier))
+	case models.NamespacePosture:
+		return storedCopy(reader.GetNamespacePostureByID(ctx, r.ResourceIdentifier))
+	}
+	return nil, errors.Errorf("unsupported resource type %T for change data", resource)
+}
+
+// storedCopy dereferences a resource read by ID, a missing resource is nil
+func storedCopy[T any](resource *T, err error) (interface{}, error) {
+	if errors.Is(err, ports.ErrNotFound) || (err == nil && resource == nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return *resource, nil
+}
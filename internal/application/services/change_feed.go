@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// defaultWatchBuffer is the per-subscriber event buffer size
+const defaultWatchBuffer = 256
+
+// ChangeFeed fans out committed resource changes to Watch subscribers.
+// Slow subscribers are disconnected instead of blocking writers: their channel
+// is closed and the client is expected to relist and resubscribe.
+//
+// When a ChangeLog is attached, published events are persisted so watchers can
+// resume from older revisions (see Since) instead of relisting. With a shared log
+// (ports.SharedChangeLog) revisions are allocated by the log and events are delivered
+// by RunTail, so watchers connected to any replica see changes made on every replica.
+type ChangeFeed struct {
+	mu          sync.Mutex
+	revision    uint64
+	nextID      uint64
+	subscribers map[uint64]chan models.ChangeEvent
+	log         ports.ChangeLog
+}
+
+// NewChangeFeed creates a new empty change feed
+func NewChangeFeed() *ChangeFeed {
+	return &ChangeFeed{
+		subscribers: make(map[uint64]chan models.ChangeEvent),
+	}
+}
+
+// SetLog attaches a persistent change log and continues revisions from the last stored one
+func (f *ChangeFeed) SetLog(ctx context.Context, log ports.ChangeLog) error {
+	last, err := log.LastRevision(ctx)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.log = log
+	if last > f.revision {
+		f.revision = last
+	}
+	return nil
+}
+
+// Publish assigns a revision to the change and delivers it to all subscribers
+func (f *ChangeFeed) Publish(ctx context.Context, syncOp models.SyncOp, resource interface{}) {
+	if f == nil {
+		return
+	}
+
+	event := models.ChangeEvent{
+		SyncOp:    syncOp,
+		Resource:  resource,
+		Timestamp: time.Now(),
+	}
+
+	f.mu.Lock()
+	shared, isShared := f.log.(ports.SharedChangeLog)
+	f.mu.Unlock()
+	if isShared {
+		// The event is delivered by RunTail on every replica, this one included
+		if _, err := shared.AppendNext(ctx, event); err != nil {
+			klog.Errorf("❌ CHANGE_FEED: Failed to persist change event of %s %s: %v", event.Kind(), event.ResourceID().Key(), err)
+		}
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.revision++
+	event.Revision = f.revision
+
+	if f.log != nil {
+		// The change is already committed, a lost log entry only forces resuming watchers to relist
+		if err := f.log.Append(ctx, event); err != nil {
+			klog.Errorf("❌ CHANGE_FEED: Failed to persist change event %d: %v", event.Revision, err)
+		}
+	}
+
+	f.deliver(event)
+}
+
+// RunTail delivers events appended to a shared change log by any replica to the local
+// subscribers until ctx is done. It does nothing unless the attached log is shared.
+func (f *ChangeFeed) RunTail(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.tail(ctx)
+		}
+	}
+}
+
+// tail delivers events stored after the last delivered revision
+func (f *ChangeFeed) tail(ctx context.Context) {
+	f.mu.Lock()
+	revision, log := f.revision, f.log
+	f.mu.Unlock()
+	if _, ok := log.(ports.SharedChangeLog); !ok {
+		return
+	}
+
+	events, err := log.Since(ctx, revision)
+	if errors.Is(err, ports.ErrRevisionCompacted) {
+		// The replica fell behind the compaction horizon, its subscribers have to relist
+		last, err := log.LastRevision(ctx)
+		if err != nil {
+			klog.Errorf("❌ CHANGE_FEED: Failed to get last change log revision: %v", err)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		for id, ch := range f.subscribers {
+			close(ch)
+			delete(f.subscribers, id)
+		}
+		if last > f.revision {
+			f.revision = last
+		}
+		klog.Warningf("⚠️ CHANGE_FEED: Change log compacted past revision %d, watchers disconnected", revision)
+		return
+	}
+	if err != nil {
+		klog.Errorf("❌ CHANGE_FEED: Failed to read change log: %v", err)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, event := range events {
+		if event.Revision <= f.revision {
+			continue
+		}
+		f.revision = event.Revision
+		f.deliver(event)
+	}
+}
+
+// deliver sends the event to all subscribers, the caller holds f.mu
+func (f *ChangeFeed) deliver(event models.ChangeEvent) {
+	for id, ch := range f.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// Subscriber can't keep up - drop it so it relists
+			close(ch)
+			delete(f.subscribers, id)
+		}
+	}
+}
+
+// Subscribe registers a new subscriber. The returned cancel function must be
+// called when the subscriber is no longer interested in events.
+func (f *ChangeFeed) Subscribe() (<-chan models.ChangeEvent, func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nextID++
+	id := f.nextID
+	ch := make(chan models.ChangeEvent, defaultWatchBuffer)
+	f.subscribers[id] = ch
+
+	cancel := func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if existing, ok := f.subscribers[id]; ok {
+			close(existing)
+			delete(f.subscribers, id)
+		}
+	}
+
+	return ch, cancel
+}
+
+// Since returns events published after the given revision.
+// ports.ErrRevisionCompacted is returned if the revision can't be resumed from.
+func (f *ChangeFeed) Since(ctx context.Context, revision uint64) ([]models.ChangeEvent, error) {
+	f.mu.Lock()
+	current, log := f.revision, f.log
+	f.mu.Unlock()
+
+	if revision >= current {
+		return nil, nil
+	}
+	if log == nil {
+		return nil, ports.ErrRevisionCompacted
+	}
+	return log.Since(ctx, revision)
+}
+
+// Stream passes events published after the given revision to consume one by one, see
+// ports.ChangeLog.Stream. ports.ErrRevisionCompacted is returned if the revision can't be
+// resumed from.
+func (f *ChangeFeed) Stream(ctx context.Context, revision uint64, consume func(models.ChangeEvent) error) error {
+	f.mu.Lock()
+	current, log := f.revision, f.log
+	f.mu.Unlock()
+
+	if revision >= current {
+		return nil
+	}
+	if log == nil {
+		return ports.ErrRevisionCompacted
+	}
+	return log.Stream(ctx, revision, consume)
+}
+
+// RunCompaction periodically removes log events older than horizon until ctx is done
+func (f *ChangeFeed) RunCompaction(ctx context.Context, horizon, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.mu.Lock()
+			log := f.log
+			f.mu.Unlock()
+			if log == nil {
+				continue
+			}
+			if err := log.Compact(ctx, time.Now().Add(-horizon)); err != nil {
+				klog.Errorf("❌ CHANGE_FEED: Failed to compact change log: %v", err)
+			}
+		}
+	}
+}
+
+// Revision returns the revision of the last delivered event
+func (f *ChangeFeed) Revision() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.revision
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+// TestChangeFeed_ResumeFromLog tests replay of persisted events and compaction
+func TestChangeFeed_ResumeFromLog(t *testing.T) {
+	ctx := context.Background()
+	changeLog := mem.NewChangeLog()
+
+	feed := NewChangeFeed()
+	require.NoError(t, feed.SetLog(ctx, changeLog))
+
+	service := models.Service{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("default")))}
+	feed.Publish(ctx, models.SyncOpUpsert, service)
+	feed.Publish(ctx, models.SyncOpUpsert, service)
+	feed.Publish(ctx, models.SyncOpDelete, service)
+
+	events, err := feed.Since(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(2), events[0].Revision)
+	assert.Equal(t, models.SyncOpDelete, events[1].SyncOp)
+
+	// Up to date watcher has nothing to replay
+	events, err = feed.Since(ctx, feed.Revision())
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	// After compaction old revisions require relist
+	require.NoError(t, changeLog.Compact(ctx, time.Now().Add(time.Second)))
+	_, err = feed.Since(ctx, 1)
+	assert.ErrorIs(t, err, ports.ErrRevisionCompacted)
+
+	// Revisions continue after restart
+	restarted := NewChangeFeed()
+	require.NoError(t, restarted.SetLog(ctx, changeLog))
+	assert.Equal(t, uint64(3), restarted.Revision())
+}
+
+// TestChangeFeed_Stream tests that replay streams events and stops on a consumer error
+func TestChangeFeed_Stream(t *testing.T) {
+	ctx := context.Background()
+	feed := NewChangeFeed()
+	require.NoError(t, feed.SetLog(ctx, mem.NewChangeLog()))
+
+	service := models.Service{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("default")))}
+	for i := 0; i < 5; i++ {
+		feed.Publish(ctx, models.SyncOpUpsert, service)
+	}
+
+	var revisions []uint64
+	require.NoError(t, feed.Stream(ctx, 2, func(event models.ChangeEvent) error {
+		revisions = append(revisions, event.Revision)
+		return nil
+	}))
+	assert.Equal(t, []uint64{3, 4, 5}, revisions)
+
+	// A consumer that can't keep up stops the stream with its error
+	stop := errors.New("watcher gone")
+	revisions = nil
+	err := feed.Stream(ctx, 0, func(event models.ChangeEvent) error {
+		revisions = append(revisions, event.Revision)
+		if len(revisions) == 2 {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []uint64{1, 2}, revisions)
+}
+
+// TestChangeFeed_WithoutLog tests that resume is impossible without a change log
+func TestChangeFeed_WithoutLog(t *testing.T) {
+	ctx := context.Background()
+	feed := NewChangeFeed()
+	feed.Publish(ctx, models.SyncOpUpsert, models.AddressGroup{})
+
+	_, err := feed.Since(ctx, 0)
+	assert.ErrorIs(t, err, ports.ErrRevisionCompacted)
+}
+
+// sharedChangeLog allocates revisions of a memory change log like a log shared by replicas
+type sharedChangeLog struct {
+	*mem.ChangeLog
+	mu sync.Mutex
+}
+
+func (l *sharedChangeLog) AppendNext(ctx context.Context, event models.ChangeEvent) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	last, err := l.LastRevision(ctx)
+	if err != nil {
+		return 0, err
+	}
+	event.Revision = last + 1
+	return event.Revision, l.Append(ctx, event)
+}
+
+// TestChangeFeed_SharedLog tests delivery of events published on another replica
+func TestChangeFeed_SharedLog(t *testing.T) {
+	ctx := context.Background()
+	changeLog := &sharedChangeLog{ChangeLog: mem.NewChangeLog()}
+
+	first, second := NewChangeFeed(), NewChangeFeed()
+	require.NoError(t, first.SetLog(ctx, changeLog))
+	require.NoError(t, second.SetLog(ctx, changeLog))
+
+	events, cancel := second.Subscribe()
+	defer cancel()
+
+	first.Publish(ctx, models.SyncOpUpsert, models.AddressGroup{})
+	first.Publish(ctx, models.SyncOpDelete, models.AddressGroup{})
+
+	// Nothing is delivered until the log is tailed
+	assert.Equal(t, uint64(0), first.Revision())
+	second.tail(ctx)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(1), (<-events).Revision)
+	assert.Equal(t, models.SyncOpDelete, (<-events).SyncOp)
+	assert.Equal(t, uint64(2), second.Revision())
+
+	// A replica behind the compacted revision disconnects its watchers
+	require.NoError(t, changeLog.Compact(ctx, time.Now().Add(time.Second)))
+	first.tail(ctx)
+	assert.Equal(t, uint64(2), first.Revision())
+
+	lagging := NewChangeFeed()
+	require.NoError(t, lagging.SetLog(ctx, mem.NewChangeLog()))
+	lagging.log = changeLog
+	lost, cancelLost := lagging.Subscribe()
+	defer cancelLost()
+	lagging.tail(ctx)
+	_, ok := <-lost
+	assert.False(t, ok)
+	assert.Equal(t, uint64(2), lagging.Revision())
+}
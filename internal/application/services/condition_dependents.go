@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// reevaluateServiceDependents re-processes conditions of RuleS2S and AddressGroupBindings
+// referencing a service that became Ready and which are still NotReady. Without it they stay
+// NotReady (e.g. "local service not found") until the next write touches them. Writes of an
+// already Ready service don't change what dependents see, so only the transition to Ready
+// relative to base triggers it.
+func (cm *ConditionManager) reevaluateServiceDependents(ctx context.Context, service *models.Service, base []metav1.Condition) {
+	if !becameReady(base, &service.Meta) {
+		return
+	}
+
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		klog.Errorf("❌ DEPENDENTS: Failed to get reader for dependents of service %s: %v", service.Key(), err)
+		return
+	}
+
+	// Bindings live in the namespace of their service, RuleS2S of other namespaces reference
+	// it only when a CrossNamespacePolicy of the service namespace allows them
+	var bindings []models.AddressGroupBinding
+	err = reader.ListAddressGroupBindings(ctx, func(binding models.AddressGroupBinding) error {
+		if !binding.Meta.IsReady() && models.RefersTo(binding.ServiceRef, binding.Namespace, service.ResourceIdentifier) {
+			bindings = append(bindings, binding)
+		}
+		return nil
+	}, namespaceScope(service.Namespace))
+	if err != nil {
+		klog.Errorf("❌ DEPENDENTS: Failed to list AddressGroupBindings dependent on service %s: %v", service.Key(), err)
+	}
+
+	var rules []models.RuleS2S
+	for _, namespace := range serviceRuleNamespaces(ctx, reader, service) {
+		err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+			if !rule.Meta.IsReady() && ruleS2SReferencesService(rule, service.ResourceIdentifier) {
+				rules = append(rules, rule)
+			}
+			return nil
+		}, namespaceScope(namespace))
+		if err != nil {
+			klog.Errorf("❌ DEPENDENTS: Failed to list RuleS2S of namespace %s dependent on service %s: %v", namespace, service.Key(), err)
+		}
+	}
+	// Process* open their own readers
+	reader.Close()
+
+	if len(rules) == 0 && len(bindings) == 0 {
+		return
+	}
+	klog.Infof("🔁 DEPENDENTS: Service %s became Ready, re-evaluating %d RuleS2S and %d AddressGroupBindings", service.Key(), len(rules), len(bindings))
+
+	for i := range rules {
+		if err := cm.ProcessRuleS2SConditions(ctx, &rules[i]); err != nil {
+			klog.Errorf("❌ DEPENDENTS: Failed to re-evaluate RuleS2S %s: %v", rules[i].Key(), err)
+		}
+	}
+	for i := range bindings {
+		if err := cm.ProcessAddressGroupBindingConditions(ctx, &bindings[i]); err != nil {
+			klog.Errorf("❌ DEPENDENTS: Failed to re-evaluate AddressGroupBinding %s: %v", bindings[i].Key(), err)
+		}
+	}
+}
+
+// reevaluateAddressGroupDependents re-processes conditions of NotReady Services and
+// AddressGroupBindings referencing an address group that became Ready. Services that become
+// Ready cascade to their own dependents through ProcessServiceConditions.
+func (cm *ConditionManager) reevaluateAddressGroupDependents(ctx context.Context, ag *models.AddressGroup, base []metav1.Condition) {
+	if !becameReady(base, &ag.Meta) {
+		return
+	}
+
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		klog.Errorf("❌ DEPENDENTS: Failed to get reader for dependents of address group %s: %v", ag.Key(), err)
+		return
+	}
+
+	// Services and bindings of other namespaces use the group only when an
+	// AddressGroupBindingPolicy of the group namespace allows them
+	var services []models.Service
+	var bindings []models.AddressGroupBinding
+	for _, namespace := range addressGroupDependentNamespaces(ctx, reader, ag) {
+		err = reader.ListServices(ctx, func(service models.Service) error {
+			if !service.Meta.IsReady() && serviceReferencesAddressGroup(service, ag.ResourceIdentifier) {
+				services = append(services, service)
+			}
+			return nil
+		}, namespaceScope(namespace))
+		if err != nil {
+			klog.Errorf("❌ DEPENDENTS: Failed to list Services of namespace %s dependent on address group %s: %v", namespace, ag.Key(), err)
+		}
+
+		err = reader.ListAddressGroupBindings(ctx, func(binding models.AddressGroupBinding) error {
+			if !binding.Meta.IsReady() && models.RefersTo(binding.AddressGroupRef, binding.Namespace, ag.ResourceIdentifier) {
+				bindings = append(bindings, binding)
+			}
+			return nil
+		}, namespaceScope(namespace))
+		if err != nil {
+			klog.Errorf("❌ DEPENDENTS: Failed to list AddressGroupBindings of namespace %s dependent on address group %s: %v", namespace, ag.Key(), err)
+		}
+	}
+	reader.Close()
+
+	if len(services) == 0 && len(bindings) == 0 {
+		return
+	}
+	klog.Infof("🔁 DEPENDENTS: AddressGroup %s became Ready, re-evaluating %d Services and %d AddressGroupBindings", ag.Key(), len(services), len(bindings))
+
+	for i := range services {
+		if err := cm.ProcessServiceConditions(ctx, &services[i]); err != nil {
+			klog.Errorf("❌ DEPENDENTS: Failed to re-evaluate Service %s: %v", services[i].Key(), err)
+		}
+	}
+	for i := range bindings {
+		if err := cm.ProcessAddressGroupBindingConditions(ctx, &bindings[i]); err != nil {
+			klog.Errorf("❌ DEPENDENTS: Failed to re-evaluate AddressGroupBinding %s: %v", bindings[i].Key(), err)
+		}
+	}
+}
+
+// becameReady reports whether the resource is Ready now and was not Ready in base
+func becameReady(base []metav1.Condition, meta *models.Meta) bool {
+	if !meta.IsReady() {
+		return false
+	}
+	for _, condition := range base {
+		if condition.Type == models.ConditionReady && condition.Status == metav1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
+// namespaceScope limits a listing to a namespace, resources without a namespace can't be
+// narrowed down and are listed unscoped
+func namespaceScope(namespace string) ports.Scope {
+	if namespace == "" {
+		return ports.EmptyScope{}
+	}
+	return ports.ResourceIdentifierScope{Identifiers: []models.ResourceIdentifier{{Namespace: namespace}}}
+}
+
+// serviceRuleNamespaces returns the namespaces whose RuleS2S may reference the service: its own
+// namespace and the namespaces allowed by CrossNamespacePolicies of the service namespace
+func serviceRuleNamespaces(ctx context.Context, reader ports.Reader, service *models.Service) []string {
+	namespaces := []string{service.Namespace}
+	if service.Namespace == "" {
+		return namespaces
+	}
+	seen := map[string]bool{service.Namespace: true}
+	err := reader.ListCrossNamespacePolicies(ctx, func(policy models.CrossNamespacePolicy) error {
+		for _, namespace := range policy.AllowedNamespaces {
+			if !seen[namespace] {
+				seen[namespace] = true
+				namespaces = append(namespaces, namespace)
+			}
+		}
+		return nil
+	}, namespaceScope(service.Namespace))
+	if err != nil {
+		klog.Errorf("❌ DEPENDENTS: Failed to list CrossNamespacePolicies of namespace %s: %v", service.Namespace, err)
+	}
+	return namespaces
+}
+
+// addressGroupDependentNamespaces returns the namespaces whose Services and bindings may use the
+// address group: its own namespace and the service namespaces of AddressGroupBindingPolicies
+// of the group
+func addressGroupDependentNamespaces(ctx context.Context, reader ports.Reader, ag *models.AddressGroup) []string {
+	namespaces := []string{ag.Namespace}
+	if ag.Namespace == "" {
+		return namespaces
+	}
+	seen := map[string]bool{ag.Namespace: true}
+	err := reader.ListAddressGroupBindingPolicies(ctx, func(policy models.AddressGroupBindingPolicy) error {
+		namespace := policy.ServiceRef.Namespace
+		if models.RefersTo(policy.AddressGroupRef, policy.Namespace, ag.ResourceIdentifier) && namespace != "" && !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+		return nil
+	}, namespaceScope(ag.Namespace))
+	if err != nil {
+		klog.Errorf("❌ DEPENDENTS: Failed to list AddressGroupBindingPolicies of namespace %s: %v", ag.Namespace, err)
+	}
+	return namespaces
+}
+
+// ruleS2SReferencesService checks whether the rule uses the service as local or target one
+func ruleS2SReferencesService(rule models.RuleS2S, id models.ResourceIdentifier) bool {
+	return models.RefersTo(rule.ServiceLocalRef, rule.Namespace, id) || models.RefersTo(rule.ServiceRef, rule.Namespace, id)
+}
+
+// serviceReferencesAddressGroup checks spec and aggregated address groups of the service
+func serviceReferencesAddressGroup(service models.Service, id models.ResourceIdentifier) bool {
+	for _, ref := range service.AddressGroups {
+		if models.RefersTo(ref, service.Namespace, id) {
+			return true
+		}
+	}
+	for _, aggregated := range service.AggregatedAddressGroups {
+		if models.RefersTo(aggregated.Ref, service.Namespace, id) {
+			return true
+		}
+	}
+	return false
+}
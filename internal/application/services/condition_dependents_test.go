@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRuleS2SReferencesService(t *testing.T) {
+	web := models.NewResourceIdentifier("web", models.WithNamespace("app"))
+	rule := models.RuleS2S{
+		SelfRef:         models.NewSelfRef(models.NewResourceIdentifier("rule", models.WithNamespace("app"))),
+		ServiceLocalRef: v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "web"}},
+		ServiceRef:      v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "db"}, Namespace: "data"},
+	}
+
+	if !ruleS2SReferencesService(rule, web) {
+		t.Error("local service with empty namespace must resolve to the rule namespace")
+	}
+	if !ruleS2SReferencesService(rule, models.NewResourceIdentifier("db", models.WithNamespace("data"))) {
+		t.Error("target service must match")
+	}
+	if ruleS2SReferencesService(rule, models.NewResourceIdentifier("db", models.WithNamespace("app"))) {
+		t.Error("service from another namespace must not match")
+	}
+}
+
+func TestServiceReferencesAddressGroup(t *testing.T) {
+	ag := models.NewResourceIdentifier("ag", models.WithNamespace("app"))
+	service := models.Service{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("app"))),
+	}
+	if serviceReferencesAddressGroup(service, ag) {
+		t.Fatal("service without address groups must not match")
+	}
+
+	service.AggregatedAddressGroups = []models.AddressGroupReference{
+		{Ref: v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "ag"}, Namespace: "app"}},
+	}
+	if !serviceReferencesAddressGroup(service, ag) {
+		t.Error("aggregated address group must match")
+	}
+}
+
+func TestBecameReady(t *testing.T) {
+	ready := models.Meta{}
+	ready.SetReadyCondition(metav1.ConditionTrue, models.ReasonReady, "ready")
+	notReady := models.Meta{}
+	notReady.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "not ready")
+
+	if !becameReady(nil, &ready) {
+		t.Error("a new Ready resource must trigger re-evaluation")
+	}
+	if !becameReady(notReady.Conditions, &ready) {
+		t.Error("the False to True transition must trigger re-evaluation")
+	}
+	if becameReady(ready.Conditions, &ready) {
+		t.Error("a write of an already Ready resource must not trigger re-evaluation")
+	}
+	if becameReady(ready.Conditions, &notReady) {
+		t.Error("a NotReady resource must not trigger re-evaluation")
+	}
+}
+
+func TestDependentNamespaces(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+
+	writer, err := registry.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crossNamespacePolicies := []models.CrossNamespacePolicy{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("allow", models.WithNamespace("data"))), AllowedNamespaces: []string{"app", "ops"}},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("other", models.WithNamespace("infra"))), AllowedNamespaces: []string{"dev"}},
+	}
+	if err := writer.SyncCrossNamespacePolicies(ctx, crossNamespacePolicies, nil); err != nil {
+		t.Fatal(err)
+	}
+	bindingPolicies := []models.AddressGroupBindingPolicy{
+		{
+			SelfRef:         models.NewSelfRef(models.NewResourceIdentifier("allow-web", models.WithNamespace("infra"))),
+			AddressGroupRef: models.NewAddressGroupRef("shared"),
+			ServiceRef:      models.NewServiceRef("web", models.WithNamespace("app")),
+		},
+		{
+			SelfRef:         models.NewSelfRef(models.NewResourceIdentifier("allow-other", models.WithNamespace("infra"))),
+			AddressGroupRef: models.NewAddressGroupRef("other"),
+			ServiceRef:      models.NewServiceRef("db", models.WithNamespace("data")),
+		},
+	}
+	if err := writer.SyncAddressGroupBindingPolicies(ctx, bindingPolicies, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := registry.Reader(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	service := &models.Service{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("db", models.WithNamespace("data")))}
+	if namespaces := serviceRuleNamespaces(ctx, reader, service); !reflect.DeepEqual(namespaces, []string{"data", "app", "ops"}) {
+		t.Errorf("serviceRuleNamespaces() = %v", namespaces)
+	}
+
+	ag := &models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("shared", models.WithNamespace("infra")))}
+	if namespaces := addressGroupDependentNamespaces(ctx, reader, ag); !reflect.DeepEqual(namespaces, []string{"infra", "app"}) {
+		t.Errorf("addressGroupDependentNamespaces() = %v", namespaces)
+	}
+}
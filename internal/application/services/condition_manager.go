@@ -43,16 +43,34 @@ type ConditionManager struct {
 
 	// 🎯 CONDITION_BATCHING: Batching system to reduce k8s_metadata table contention
 	batchMutex   sync.Mutex
-	pendingBatch map[string]interface{} // resourceType:resourceKey -> resource with conditions
+	pendingBatch map[string]conditionUpdate // resourceType:resourceKey -> resource with conditions
 	batchTimer   *time.Timer
 	batchSize    int
 	batchTimeout time.Duration
 
 	// 🔒 SEQUENTIAL_PROCESSING: Shared mutex for serializing condition operations to prevent deadlocks
 	// This extends the NetguardFacade sequential processing pattern to cover condition batching
-	sequentialMutex *sync.Mutex
+	sequentialMutex sync.Locker
+
+	// 🔒 CONDITION_MERGE: Serializes read-merge-write of conditions per resource
+	resourceLocks resourceLocks
+
+	// history records condition status transitions (nil - not recorded)
+	history ports.ConditionHistory
+
+	// changeFeed receives Services/AddressGroups whose conditions were written (nil - not published)
+	changeFeed *ChangeFeed
+
+	// dependencyStates are the missing dependencies of resources by kind/key found by the
+	// previous RevalidateStaleConditions pass
+	revalidationMutex sync.Mutex
+	dependencyStates  map[string]string
 }
 
+// conditionWriterOptions open writers of conditions with ReadCommitted isolation, they see
+// resources committed by other transactions after the condition computation started
+var conditionWriterOptions = ports.WriterOptions{Isolation: ports.IsolationReadCommitted}
+
 // NewConditionManager создает новый ConditionManager
 func NewConditionManager(registry ports.Registry) *ConditionManager {
 	cm := &ConditionManager{
@@ -62,7 +80,7 @@ func NewConditionManager(registry ports.Registry) *ConditionManager {
 		syncManager:    nil, // Will be injected later to avoid circular dependency
 
 		// 🎯 CONDITION_BATCHING: Initialize batching system to reduce database contention
-		pendingBatch: make(map[string]interface{}),
+		pendingBatch: make(map[string]conditionUpdate),
 		batchSize:    5,               // 🔧 DEADLOCK_FIX: Reduced from 10 to 5 to minimize lock contention
 		batchTimeout: 2 * time.Second, // Flush batch every 2 seconds max
 
@@ -74,10 +92,15 @@ func NewConditionManager(registry ports.Registry) *ConditionManager {
 
 // SetSequentialMutex injects the shared sequential processing mutex from NetguardFacade
 // This allows condition batching to participate in the same sequential processing that prevents deadlocks
-func (cm *ConditionManager) SetSequentialMutex(mutex *sync.Mutex) {
+func (cm *ConditionManager) SetSequentialMutex(mutex sync.Locker) {
 	cm.sequentialMutex = mutex
 }
 
+// SetConditionHistory makes written condition status transitions recorded in the history
+func (cm *ConditionManager) SetConditionHistory(history ports.ConditionHistory) {
+	cm.history = history
+}
+
 // SetIEAgAgRuleManager injects the IEAgAg rule manager (called after construction to avoid circular dependency)
 func (cm *ConditionManager) SetIEAgAgRuleManager(manager IEAgAgRuleManager) {
 	cm.ieAgAgManager = manager
@@ -88,6 +111,11 @@ func (cm *ConditionManager) SetRuleS2SService(service RuleS2SService) {
 	cm.ruleS2SService = service
 }
 
+// SetChangeFeed publishes Services and AddressGroups to feed after their conditions are written
+func (cm *ConditionManager) SetChangeFeed(feed *ChangeFeed) {
+	cm.changeFeed = feed
+}
+
 // SetSyncManager injects the SyncManager for external sync operations
 func (cm *ConditionManager) SetSyncManager(syncManager interfaces.SyncManager) {
 	cm.syncManager = syncManager
@@ -121,6 +149,9 @@ func (w *generatorOnlyWrapper) CleanupIEAgAgRulesForRuleS2S(ctx context.Context,
 
 // ProcessServiceConditions формирует условия для Service ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessServiceConditions(ctx context.Context, service *models.Service) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&service.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	service.Meta.ClearErrorCondition()
 	service.Meta.TouchOnWrite("v1")
@@ -128,7 +159,7 @@ func (cm *ConditionManager) ProcessServiceConditions(ctx context.Context, servic
 	klog.Infof("🔄 ConditionManager.ProcessServiceConditions: processing service %s/%s after commit", service.Namespace, service.Name)
 
 	// Получаем reader для валидации (транзакция уже закоммичена)
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to get reader for %s/%s: %v", service.Namespace, service.Name, err)
 		service.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
@@ -184,6 +215,10 @@ func (cm *ConditionManager) ProcessServiceConditions(ctx context.Context, servic
 		return nil
 	}
 
+	// Группы, зарегистрированные и в spec, и через AddressGroupBinding, учитываются один раз,
+	// повторные регистрации отражаются отдельным условием и не блокируют готовность
+	cm.setAddressGroupsConflictCondition(ctx, reader, service)
+
 	// Все проверки пройдены - сервис готов
 	klog.Infof("🎉 ConditionManager: All checks passed, setting Ready=true for %s/%s", service.Namespace, service.Name)
 	service.Meta.SetReadyCondition(metav1.ConditionTrue, models.ReasonReady, "Service is ready for use")
@@ -191,15 +226,51 @@ func (cm *ConditionManager) ProcessServiceConditions(ctx context.Context, servic
 	klog.Infof("✅ ConditionManager.ProcessServiceConditions: service %s/%s processed successfully with %d conditions", service.Namespace, service.Name, len(service.Meta.Conditions))
 
 	// 🎯 CONDITION_BATCHING: Use batched condition updates to reduce k8s_metadata contention
-	cm.batchConditionUpdate("Service", service)
+	cm.batchConditionUpdate("Service", service, base)
 	klog.V(3).Infof("🎯 CONDITION_BATCHING: Queued service %s/%s for batch condition update", service.Namespace, service.Name)
 
 	klog.Infof("💾 ConditionManager: Successfully saved conditions for service %s/%s", service.Namespace, service.Name)
+
+	// Правила и биндинги, ожидавшие этот сервис, переоцениваем сразу
+	cm.reevaluateServiceDependents(ctx, service, base)
 	return nil
 }
 
+// setAddressGroupsConflictCondition выставляет AddressGroupsConflict по агрегированным группам
+// закоммиченного Service: spec берется из объекта, binding-регистрации - из базы
+func (cm *ConditionManager) setAddressGroupsConflictCondition(ctx context.Context, reader ports.Reader, service *models.Service) {
+	aggregated := *service
+	if committed, err := reader.GetServiceByID(ctx, service.ResourceIdentifier); err == nil {
+		aggregated.AggregatedAddressGroups = committed.AggregatedAddressGroups
+	}
+
+	conflicts := aggregated.AddressGroupRegistrationConflicts()
+	if len(conflicts) == 0 {
+		// Условие снимается только если оно уже было выставлено
+		if service.Meta.GetCondition(models.ConditionAddressGroupsConflict) != nil {
+			service.Meta.SetAddressGroupsConflictCondition(metav1.ConditionFalse, models.ReasonNoConflicts, "Each address group is registered once")
+		}
+		return
+	}
+
+	descriptions := make([]string, len(conflicts))
+	for i, conflict := range conflicts {
+		sources := make([]string, len(conflict.Sources))
+		for k, source := range conflict.Sources {
+			sources[k] = string(source)
+		}
+		descriptions[i] = fmt.Sprintf("%s (%s)", models.AddressGroupRefKey(conflict.Ref), strings.Join(sources, ", "))
+	}
+	klog.Warningf("⚠️ ConditionManager: AddressGroups registered more than once for %s/%s: %v", service.Namespace, service.Name, descriptions)
+	service.Meta.SetAddressGroupsConflictCondition(metav1.ConditionTrue, models.ReasonDuplicateRegistration,
+		fmt.Sprintf("AddressGroups registered more than once: %s", strings.Join(descriptions, "; ")))
+}
+
 // ProcessAddressGroupConditions формирует условия для AddressGroup ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessAddressGroupConditions(ctx context.Context, ag *models.AddressGroup) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&ag.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	ag.Meta.ClearErrorCondition()
 	ag.Meta.TouchOnWrite("v1")
@@ -207,7 +278,7 @@ func (cm *ConditionManager) ProcessAddressGroupConditions(ctx context.Context, a
 	klog.Infof("🔄 ConditionManager.ProcessAddressGroupConditions: processing address group %s/%s after commit", ag.Namespace, ag.Name)
 
 	// Получаем reader для валидации (транзакция уже закоммичена)
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to get reader for %s/%s: %v", ag.Namespace, ag.Name, err)
 		ag.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader: %v", err))
@@ -239,7 +310,7 @@ func (cm *ConditionManager) ProcessAddressGroupConditions(ctx context.Context, a
 			ag.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "External sync failed")
 			ag.Meta.SetValidatedCondition(metav1.ConditionTrue, models.ReasonValidated, "Address group passed all validations")
 
-			cm.batchConditionUpdate("AddressGroup", ag)
+			cm.batchConditionUpdate("AddressGroup", ag, base)
 			return fmt.Errorf("external sync failed for AddressGroup %s/%s: %w", ag.Namespace, ag.Name, err)
 		}
 		klog.Infof("✅ EXTERNAL_SYNC_FIX: Successfully synced AddressGroup %s/%s to SGROUP", ag.Namespace, ag.Name)
@@ -255,12 +326,18 @@ func (cm *ConditionManager) ProcessAddressGroupConditions(ctx context.Context, a
 
 	klog.Infof("✅ ConditionManager.ProcessAddressGroupConditions: address group %s/%s processed successfully with %d conditions", ag.Namespace, ag.Name, len(ag.Meta.Conditions))
 
-	cm.batchConditionUpdate("AddressGroup", ag)
+	cm.batchConditionUpdate("AddressGroup", ag, base)
+
+	// Сервисы и биндинги, ожидавшие эту address group, переоцениваем сразу
+	cm.reevaluateAddressGroupDependents(ctx, ag, base)
 	return nil
 }
 
 // ProcessRuleS2SConditions формирует условия для RuleS2S ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessRuleS2SConditions(ctx context.Context, rule *models.RuleS2S) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&rule.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	rule.Meta.ClearErrorCondition()
 	rule.Meta.TouchOnWrite("v1")
@@ -288,7 +365,7 @@ func (cm *ConditionManager) ProcessRuleS2SConditions(ctx context.Context, rule *
 		rule.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "RuleS2S has validation errors")
 		rule.Meta.SetValidatedCondition(metav1.ConditionFalse, models.ReasonValidationFailed, fmt.Sprintf("Validation failed: %v", err))
 
-		cm.batchConditionUpdate("RuleS2S", rule)
+		cm.batchConditionUpdate("RuleS2S", rule, base)
 		return nil
 	}
 
@@ -311,7 +388,7 @@ func (cm *ConditionManager) ProcessRuleS2SConditions(ctx context.Context, rule *
 			klog.Warningf("⚠️ ConditionManager: IEAgAgManager is nil, cannot cleanup rules for RuleS2S %s/%s", rule.Namespace, rule.Name)
 		}
 
-		cm.batchConditionUpdate("RuleS2S", rule)
+		cm.batchConditionUpdate("RuleS2S", rule, base)
 		return nil
 	}
 
@@ -329,7 +406,7 @@ func (cm *ConditionManager) ProcessRuleS2SConditions(ctx context.Context, rule *
 		// 🎯 CONDITION_BATCHING: Queue Ready=True conditions for batch update before IEAgAgRule generation
 		// This ensures the aggregation system can see the Ready=True status in the database
 		klog.Infof("💾 CONDITION_BATCHING: Queuing Ready=True conditions for batch update for RuleS2S %s/%s", rule.Namespace, rule.Name)
-		cm.batchConditionUpdate("RuleS2S", rule)
+		cm.batchConditionUpdate("RuleS2S", rule, base)
 		// Force flush batch to ensure Ready=True is visible before IEAgAg generation
 		cm.flushConditionBatch()
 		klog.Infof("✅ CONDITION_BATCHING: Successfully flushed Ready=True conditions for RuleS2S %s/%s", rule.Namespace, rule.Name)
@@ -398,7 +475,7 @@ func (cm *ConditionManager) ProcessRuleS2SConditions(ctx context.Context, rule *
 	// This avoids double-batching conditions for Ready=True case while ensuring Ready=False is queued
 	if !rule.Meta.IsReady() {
 		klog.Infof("💾 CONDITION_BATCHING: Queuing Ready=False conditions for RuleS2S %s/%s", rule.Namespace, rule.Name)
-		cm.batchConditionUpdate("RuleS2S", rule)
+		cm.batchConditionUpdate("RuleS2S", rule, base)
 	} else {
 		klog.Infof("✅ CONDITION_BATCHING: Skipping condition queue for Ready=True RuleS2S %s/%s (already flushed before generation)", rule.Namespace, rule.Name)
 	}
@@ -416,12 +493,15 @@ func (cm *ConditionManager) ProcessIEAgAgRuleConditions(ctx context.Context, rul
 	klog.Infof("   - TargetAG: %s/%s", rule.AddressGroup.Namespace, rule.AddressGroup.Name)
 	klog.Infof("   - Current conditions count: %d", len(rule.Meta.Conditions))
 
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&rule.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	rule.Meta.ClearErrorCondition()
 	rule.Meta.TouchOnWrite("v1")
 
 	// Получаем reader для валидации
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		klog.Errorf("❌ IEAGAG_CONDITIONS: Failed to get reader for %s/%s: %v", rule.Namespace, rule.Name, err)
 		rule.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
@@ -488,7 +568,7 @@ func (cm *ConditionManager) ProcessIEAgAgRuleConditions(ctx context.Context, rul
 		rule.Namespace, rule.Name, len(rule.Meta.Conditions))
 
 	// 🎯 CONDITION_BATCHING: Queue conditions for batch update (non-blocking)
-	cm.batchConditionUpdate("IEAgAgRule", rule)
+	cm.batchConditionUpdate("IEAgAgRule", rule, base)
 	klog.V(3).Infof("🎯 CONDITION_BATCHING: Queued IEAgAgRule %s/%s for batch condition update", rule.Namespace, rule.Name)
 
 	klog.Infof("✅ IEAGAG_CONDITIONS: Successfully processed and saved conditions for IEAgAgRule %s/%s", rule.Namespace, rule.Name)
@@ -497,6 +577,9 @@ func (cm *ConditionManager) ProcessIEAgAgRuleConditions(ctx context.Context, rul
 
 // ProcessAddressGroupBindingConditions формирует условия для AddressGroupBinding ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessAddressGroupBindingConditions(ctx context.Context, binding *models.AddressGroupBinding) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&binding.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	binding.Meta.ClearErrorCondition()
 	binding.Meta.TouchOnWrite("v1")
@@ -504,7 +587,7 @@ func (cm *ConditionManager) ProcessAddressGroupBindingConditions(ctx context.Con
 	klog.Infof("🔄 ConditionManager.ProcessAddressGroupBindingConditions: processing binding %s/%s after commit", binding.Namespace, binding.Name)
 
 	// Получаем reader для валидации
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		binding.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
 		binding.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Backend validation unavailable")
@@ -603,7 +686,7 @@ func (cm *ConditionManager) ProcessAddressGroupBindingConditions(ctx context.Con
 	klog.Infof("✅ ConditionManager.ProcessAddressGroupBindingConditions: binding %s/%s processed successfully with 3 conditions", binding.Namespace, binding.Name)
 
 	// Save the processed conditions back to storage
-	if err := cm.saveAddressGroupBindingConditions(ctx, binding); err != nil {
+	if err := cm.saveAddressGroupBindingConditions(ctx, binding, base); err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to save conditions for address group binding %s/%s: %v", binding.Namespace, binding.Name, err)
 		return nil
 	}
@@ -613,6 +696,9 @@ func (cm *ConditionManager) ProcessAddressGroupBindingConditions(ctx context.Con
 
 // ProcessServiceAliasConditions формирует условия для ServiceAlias ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessServiceAliasConditions(ctx context.Context, alias *models.ServiceAlias) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&alias.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	alias.Meta.ClearErrorCondition()
 	alias.Meta.TouchOnWrite("v1")
@@ -620,7 +706,7 @@ func (cm *ConditionManager) ProcessServiceAliasConditions(ctx context.Context, a
 	klog.V(4).Infof("ConditionManager.ProcessServiceAliasConditions: processing service alias %s/%s after commit", alias.Namespace, alias.Name)
 
 	// Получаем reader для валидации
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		alias.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
 		alias.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Backend validation unavailable")
@@ -666,7 +752,7 @@ func (cm *ConditionManager) ProcessServiceAliasConditions(ctx context.Context, a
 	klog.V(4).Infof("ConditionManager.ProcessServiceAliasConditions: service alias %s/%s processed successfully", alias.Namespace, alias.Name)
 
 	// Save the processed conditions back to storage
-	if err := cm.saveServiceAliasConditions(ctx, alias); err != nil {
+	if err := cm.saveServiceAliasConditions(ctx, alias, base); err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to save conditions for service alias %s/%s: %v", alias.Namespace, alias.Name, err)
 		return nil
 	}
@@ -676,6 +762,9 @@ func (cm *ConditionManager) ProcessServiceAliasConditions(ctx context.Context, a
 
 // ProcessAddressGroupPortMappingConditions формирует условия для AddressGroupPortMapping ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessAddressGroupPortMappingConditions(ctx context.Context, mapping *models.AddressGroupPortMapping) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&mapping.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	mapping.Meta.ClearErrorCondition()
 	mapping.Meta.TouchOnWrite("v1")
@@ -683,7 +772,7 @@ func (cm *ConditionManager) ProcessAddressGroupPortMappingConditions(ctx context
 	klog.V(4).Infof("ConditionManager.ProcessAddressGroupPortMappingConditions: processing port mapping %s/%s after commit", mapping.Namespace, mapping.Name)
 
 	// Получаем reader для валидации
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		mapping.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
 		mapping.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Backend validation unavailable")
@@ -741,7 +830,7 @@ func (cm *ConditionManager) ProcessAddressGroupPortMappingConditions(ctx context
 	klog.V(4).Infof("ConditionManager.ProcessAddressGroupPortMappingConditions: port mapping %s/%s processed successfully", mapping.Namespace, mapping.Name)
 
 	// Save the processed conditions back to storage
-	if err := cm.saveAddressGroupPortMappingConditions(ctx, mapping); err != nil {
+	if err := cm.saveAddressGroupPortMappingConditions(ctx, mapping, base); err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to save conditions for AddressGroupPortMapping %s/%s: %v", mapping.Namespace, mapping.Name, err)
 		return nil
 	}
@@ -751,6 +840,9 @@ func (cm *ConditionManager) ProcessAddressGroupPortMappingConditions(ctx context
 
 // ProcessAddressGroupBindingPolicyConditions формирует условия для AddressGroupBindingPolicy ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessAddressGroupBindingPolicyConditions(ctx context.Context, policy *models.AddressGroupBindingPolicy) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&policy.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	policy.Meta.ClearErrorCondition()
 	policy.Meta.TouchOnWrite("v1")
@@ -758,7 +850,7 @@ func (cm *ConditionManager) ProcessAddressGroupBindingPolicyConditions(ctx conte
 	klog.V(4).Infof("ConditionManager.ProcessAddressGroupBindingPolicyConditions: processing policy %s/%s after commit", policy.Namespace, policy.Name)
 
 	// Получаем reader для валидации
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		policy.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
 		policy.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Backend validation unavailable")
@@ -787,7 +879,7 @@ func (cm *ConditionManager) ProcessAddressGroupBindingPolicyConditions(ctx conte
 	// Проверяем что AddressGroup РЕАЛЬНО существует в committed состоянии
 	// Create ResourceIdentifier from NamespacedObjectReference
 	agID := models.NewResourceIdentifier(policy.AddressGroupRef.Name, models.WithNamespace(policy.AddressGroupRef.Namespace))
-	_, err = reader.GetAddressGroupByID(ctx, agID)
+	addressGroup, err := reader.GetAddressGroupByID(ctx, agID)
 	if err == ports.ErrNotFound {
 		policy.Meta.SetErrorCondition(models.ReasonDependencyError, fmt.Sprintf("AddressGroup %s not found", policy.AddressGroupRefKey()))
 		policy.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Required AddressGroup not found")
@@ -798,18 +890,27 @@ func (cm *ConditionManager) ProcessAddressGroupBindingPolicyConditions(ctx conte
 		return nil
 	}
 
-	// Проверяем что Service РЕАЛЬНО существует в committed состоянии
-	// Create ResourceIdentifier from NamespacedObjectReference
-	serviceID := models.NewResourceIdentifier(policy.ServiceRef.Name, models.WithNamespace(policy.ServiceRef.Namespace))
-	_, err = reader.GetServiceByID(ctx, serviceID)
-	if err == ports.ErrNotFound {
-		policy.Meta.SetErrorCondition(models.ReasonDependencyError, fmt.Sprintf("Service %s not found", policy.ServiceRefKey()))
-		policy.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Required Service not found")
-		return nil
-	} else if err != nil {
-		policy.Meta.SetErrorCondition(models.ReasonDependencyError, fmt.Sprintf("Failed to get Service %s: %v", policy.ServiceRefKey(), err))
-		policy.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Service validation failed")
-		return nil
+	if policy.AllowsServiceNamespace() {
+		// A policy for every Service of a namespace is effective only while the group is global
+		if !addressGroup.IsGlobal() {
+			policy.Meta.SetErrorCondition(models.ReasonDependencyError, fmt.Sprintf("AddressGroup %s is not global", policy.AddressGroupRefKey()))
+			policy.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Policy without a service name requires a global AddressGroup")
+			return nil
+		}
+	} else {
+		// Проверяем что Service РЕАЛЬНО существует в committed состоянии
+		// Create ResourceIdentifier from NamespacedObjectReference
+		serviceID := models.NewResourceIdentifier(policy.ServiceRef.Name, models.WithNamespace(policy.ServiceRef.Namespace))
+		_, err = reader.GetServiceByID(ctx, serviceID)
+		if err == ports.ErrNotFound {
+			policy.Meta.SetErrorCondition(models.ReasonDependencyError, fmt.Sprintf("Service %s not found", policy.ServiceRefKey()))
+			policy.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Required Service not found")
+			return nil
+		} else if err != nil {
+			policy.Meta.SetErrorCondition(models.ReasonDependencyError, fmt.Sprintf("Failed to get Service %s: %v", policy.ServiceRefKey(), err))
+			policy.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "Service validation failed")
+			return nil
+		}
 	}
 
 	// Все проверки пройдены - политика готова
@@ -818,7 +919,7 @@ func (cm *ConditionManager) ProcessAddressGroupBindingPolicyConditions(ctx conte
 	klog.V(4).Infof("ConditionManager.ProcessAddressGroupBindingPolicyConditions: policy %s/%s processed successfully", policy.Namespace, policy.Name)
 
 	// Save the processed conditions back to storage
-	if err := cm.saveAddressGroupBindingPolicyConditions(ctx, policy); err != nil {
+	if err := cm.saveAddressGroupBindingPolicyConditions(ctx, policy, base); err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to save conditions for AddressGroupBindingPolicy %s/%s: %v", policy.Namespace, policy.Name, err)
 		return nil
 	}
@@ -828,6 +929,9 @@ func (cm *ConditionManager) ProcessAddressGroupBindingPolicyConditions(ctx conte
 
 // ProcessNetworkConditions формирует условия для Network ПОСЛЕ успешного commit
 func (cm *ConditionManager) ProcessNetworkConditions(ctx context.Context, network *models.Network, syncResult error) error {
+	// Условия сохраняются слиянием с записанными параллельно относительно этого снимка
+	base := snapshotConditions(&network.Meta)
+
 	// Очищаем старые ошибки и обновляем метаданные
 	network.Meta.ClearErrorCondition()
 	network.Meta.TouchOnWrite("v1")
@@ -835,7 +939,7 @@ func (cm *ConditionManager) ProcessNetworkConditions(ctx context.Context, networ
 	klog.Infof("🔄 ConditionManager.ProcessNetworkConditions: processing network %s/%s after commit", network.Namespace, network.Name)
 
 	// Получаем reader для валидации (транзакция уже закоммичена)
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to get reader for %s/%s: %v", network.Namespace, network.Name, err)
 		network.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
@@ -882,7 +986,7 @@ func (cm *ConditionManager) ProcessNetworkConditions(ctx context.Context, networ
 	klog.Infof("✅ ConditionManager.ProcessNetworkConditions: network %s/%s processed successfully with %d conditions", network.Namespace, network.Name, len(network.Meta.Conditions))
 
 	// Save the processed conditions back to storage
-	if err := cm.saveNetworkConditions(ctx, network); err != nil {
+	if err := cm.saveNetworkConditions(ctx, network, base); err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to save conditions for network %s/%s: %v", network.Namespace, network.Name, err)
 		// Don't fail the entire operation, conditions will be reprocessed on next update
 		return nil
@@ -901,7 +1005,7 @@ func (cm *ConditionManager) ProcessNetworkBindingConditions(ctx context.Context,
 	klog.Infof("🔄 ConditionManager.ProcessNetworkBindingConditions: processing network binding %s/%s after commit", binding.Namespace, binding.Name)
 
 	// Получаем reader для валидации (транзакция уже закоммичена)
-	reader, err := cm.registry.Reader(ctx)
+	reader, err := cm.registry.PrimaryReader(ctx)
 	if err != nil {
 		klog.Errorf("❌ ConditionManager: Failed to get reader for %s/%s: %v", binding.Namespace, binding.Name, err)
 		binding.Meta.SetErrorCondition(models.ReasonBackendError, fmt.Sprintf("Failed to get reader for validation: %v", err))
@@ -1184,8 +1288,11 @@ func (cm *ConditionManager) saveResourceConditions(ctx context.Context, resource
 	return nil
 }
 
-// saveNetworkConditions saves the processed conditions for a Network back to storage
-func (cm *ConditionManager) saveNetworkConditions(ctx context.Context, network *models.Network) error {
+// saveNetworkConditions saves the processed conditions for a Network back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveNetworkConditions(ctx context.Context, network *models.Network, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "Network", resource: network, base: base})()
+
 	writer, err := cm.registry.Writer(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get writer for saving network conditions: %w", err)
@@ -1212,8 +1319,9 @@ func (cm *ConditionManager) saveNetworkConditions(ctx context.Context, network *
 // 🎯 CONDITION_BATCHING: Batched condition update system to reduce k8s_metadata contention
 // This addresses the PostgreSQL timeout issues by reducing the number of database round trips
 
-// batchConditionUpdate adds a resource to the pending batch for condition updates
-func (cm *ConditionManager) batchConditionUpdate(resourceType string, resource interface{}) {
+// batchConditionUpdate adds a resource to the pending batch for condition updates,
+// base are the conditions the resource conditions were computed from
+func (cm *ConditionManager) batchConditionUpdate(resourceType string, resource interface{}, base []metav1.Condition) {
 	cm.batchMutex.Lock()
 	defer cm.batchMutex.Unlock()
 
@@ -1234,7 +1342,18 @@ func (cm *ConditionManager) batchConditionUpdate(resourceType string, resource i
 	}
 
 	batchKey := fmt.Sprintf("%s:%s", resourceType, resourceKey)
-	cm.pendingBatch[batchKey] = resource
+	update := conditionUpdate{resourceType: resourceType, resource: resource, base: base}
+
+	// A pending update of the same resource is not written yet, the new one is merged on top of it
+	if pending, exists := cm.pendingBatch[batchKey]; exists && pending.resource != resource {
+		meta, _, _, err := conditionTarget(resource)
+		pendingMeta, _, _, pendingErr := conditionTarget(pending.resource)
+		if err == nil && pendingErr == nil {
+			meta.Conditions = mergeConditions(base, meta.Conditions, pendingMeta.Conditions)
+			update.base = pending.base
+		}
+	}
+	cm.pendingBatch[batchKey] = update
 
 	klog.V(3).Infof("🎯 CONDITION_BATCHING: Added %s to batch (size: %d/%d)", batchKey, len(cm.pendingBatch), cm.batchSize)
 
@@ -1273,11 +1392,11 @@ func (cm *ConditionManager) flushConditionBatch() {
 	}
 
 	// Copy the batch and clear it
-	currentBatch := make(map[string]interface{})
+	currentBatch := make(map[string]conditionUpdate)
 	for k, v := range cm.pendingBatch {
 		currentBatch[k] = v
 	}
-	cm.pendingBatch = make(map[string]interface{})
+	cm.pendingBatch = make(map[string]conditionUpdate)
 
 	// Reset the timer
 	if cm.batchTimer != nil {
@@ -1291,259 +1410,206 @@ func (cm *ConditionManager) flushConditionBatch() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Use WriterForConditions for ReadCommitted isolation
-	if registryWithConditions, ok := cm.registry.(interface {
-		WriterForConditions(context.Context) (ports.Writer, error)
-	}); ok {
-		writer, err := registryWithConditions.WriterForConditions(ctx)
-		if err != nil {
-			klog.Errorf("❌ CONDITION_BATCHING: Failed to get condition writer: %v", err)
-			return
-		}
+	// Use a ReadCommitted writer, the batch may contain resources committed by other transactions
+	writer, err := cm.registry.WriterWithOptions(ctx, conditionWriterOptions)
+	if err != nil {
+		klog.Errorf("❌ CONDITION_BATCHING: Failed to get condition writer: %v", err)
+		return
+	}
 
-		// Group resources by type for efficient batch processing
-		services := make([]*models.Service, 0)
-		addressGroups := make([]*models.AddressGroup, 0)
-		ruleS2S := make([]*models.RuleS2S, 0)
-		ieAgAgRules := make([]*models.IEAgAgRule, 0)
-
-		for batchKey, resource := range currentBatch {
-			resourceType := strings.Split(batchKey, ":")[0]
-			switch resourceType {
-			case "Service":
-				if svc, ok := resource.(*models.Service); ok {
-					services = append(services, svc)
-				}
-			case "AddressGroup":
-				if ag, ok := resource.(*models.AddressGroup); ok {
-					addressGroups = append(addressGroups, ag)
-				}
-			case "RuleS2S":
-				if rule, ok := resource.(*models.RuleS2S); ok {
-					ruleS2S = append(ruleS2S, rule)
-				}
-			case "IEAgAgRule":
-				if rule, ok := resource.(*models.IEAgAgRule); ok {
-					ieAgAgRules = append(ieAgAgRules, rule)
-				}
-			}
-		}
+	// 🔒 CONDITION_MERGE: Keep conditions written since the batched ones were computed
+	updates := make([]conditionUpdate, 0, len(currentBatch))
+	for _, update := range currentBatch {
+		updates = append(updates, update)
+	}
+	defer cm.lockConditions(ctx, updates...)()
+
+	// Group resources by type for efficient batch processing
+	services := make([]*models.Service, 0)
+	addressGroups := make([]*models.AddressGroup, 0)
+	ruleS2S := make([]*models.RuleS2S, 0)
+	ieAgAgRules := make([]*models.IEAgAgRule, 0)
 
-		// 🚀 DEPENDENCY_ORDERED_SYNC: Process resources in external sync dependency order
-		// Phase 1: Services (no external dependencies)
-		success := true
-		if len(services) > 0 {
-			serviceModels := make([]models.Service, len(services))
-			for i, svc := range services {
-				serviceModels[i] = *svc
+	for batchKey, update := range currentBatch {
+		resourceType := strings.Split(batchKey, ":")[0]
+		resource := update.resource
+		switch resourceType {
+		case "Service":
+			if svc, ok := resource.(*models.Service); ok {
+				services = append(services, svc)
 			}
-			if err := writer.SyncServices(ctx, serviceModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
-				klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d services: %v", len(services), err)
-				success = false
-			} else {
-				klog.V(2).Infof("✅ CONDITION_BATCHING: Successfully batched %d service condition updates", len(services))
+		case "AddressGroup":
+			if ag, ok := resource.(*models.AddressGroup); ok {
+				addressGroups = append(addressGroups, ag)
+			}
+		case "RuleS2S":
+			if rule, ok := resource.(*models.RuleS2S); ok {
+				ruleS2S = append(ruleS2S, rule)
+			}
+		case "IEAgAgRule":
+			if rule, ok := resource.(*models.IEAgAgRule); ok {
+				ieAgAgRules = append(ieAgAgRules, rule)
 			}
 		}
+	}
 
-		// Phase 2: AddressGroups (must be synced to SGROUP BEFORE IEAgAgRules)
-		if len(addressGroups) > 0 && success {
-			agModels := make([]models.AddressGroup, len(addressGroups))
-			for i, ag := range addressGroups {
-				agModels[i] = *ag
-			}
+	// 🚀 DEPENDENCY_ORDERED_SYNC: Process resources in external sync dependency order
+	// Phase 1: Services (no external dependencies)
+	success := true
+	if len(services) > 0 {
+		serviceModels := make([]models.Service, len(services))
+		for i, svc := range services {
+			serviceModels[i] = *svc
+		}
+		if err := writer.SyncServices(ctx, serviceModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
+			klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d services: %v", len(services), err)
+			success = false
+		} else {
+			klog.V(2).Infof("✅ CONDITION_BATCHING: Successfully batched %d service condition updates", len(services))
+		}
+	}
 
-			// 🚀 EXTERNAL_SYNC_COORDINATION: Sync AddressGroups to SGROUP first
-			if cm.syncManager != nil {
-				klog.Infof("🔄 DEPENDENCY_ORDERED_SYNC: External sync phase 1 - syncing %d AddressGroups to SGROUP", len(addressGroups))
-				for _, ag := range addressGroups {
-					if err := cm.syncManager.SyncEntity(ctx, ag, types.SyncOperationUpsert); err != nil {
-						klog.Errorf("❌ DEPENDENCY_ORDERED_SYNC: Failed to sync AddressGroup %s/%s to SGROUP: %v", ag.Namespace, ag.Name, err)
-						success = false
-						break
-					}
-				}
-				if success {
-					klog.Infof("✅ DEPENDENCY_ORDERED_SYNC: Successfully synced %d AddressGroups to SGROUP", len(addressGroups))
-				}
-			}
+	// Phase 2: AddressGroups (must be synced to SGROUP BEFORE IEAgAgRules)
+	if len(addressGroups) > 0 && success {
+		agModels := make([]models.AddressGroup, len(addressGroups))
+		for i, ag := range addressGroups {
+			agModels[i] = *ag
+		}
 
-			// Only update conditions if external sync succeeded
-			if success {
-				if err := writer.SyncAddressGroups(ctx, agModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
-					klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d address groups: %v", len(addressGroups), err)
+		// 🚀 EXTERNAL_SYNC_COORDINATION: Sync AddressGroups to SGROUP first
+		if cm.syncManager != nil {
+			klog.Infof("🔄 DEPENDENCY_ORDERED_SYNC: External sync phase 1 - syncing %d AddressGroups to SGROUP", len(addressGroups))
+			for _, ag := range addressGroups {
+				if err := cm.syncManager.SyncEntity(ctx, ag, types.SyncOperationUpsert); err != nil {
+					klog.Errorf("❌ DEPENDENCY_ORDERED_SYNC: Failed to sync AddressGroup %s/%s to SGROUP: %v", ag.Namespace, ag.Name, err)
 					success = false
-				} else {
-					klog.V(2).Infof("✅ CONDITION_BATCHING: Successfully batched %d AddressGroup condition updates", len(addressGroups))
+					break
 				}
 			}
+			if success {
+				klog.Infof("✅ DEPENDENCY_ORDERED_SYNC: Successfully synced %d AddressGroups to SGROUP", len(addressGroups))
+			}
 		}
 
-		if len(ruleS2S) > 0 && success {
-			ruleModels := make([]models.RuleS2S, len(ruleS2S))
-			for i, rule := range ruleS2S {
-				ruleModels[i] = *rule
-			}
-			if err := writer.SyncRuleS2S(ctx, ruleModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
-				klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d RuleS2S: %v", len(ruleS2S), err)
+		// Only update conditions if external sync succeeded
+		if success {
+			if err := writer.SyncAddressGroups(ctx, agModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
+				klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d address groups: %v", len(addressGroups), err)
 				success = false
 			} else {
-				klog.V(2).Infof("✅ CONDITION_BATCHING: Successfully batched %d RuleS2S condition updates", len(ruleS2S))
+				klog.V(2).Infof("✅ CONDITION_BATCHING: Successfully batched %d AddressGroup condition updates", len(addressGroups))
 			}
 		}
+	}
 
-		// Phase 4: IEAgAgRules (must be synced AFTER AddressGroups are in SGROUP)
-		if len(ieAgAgRules) > 0 && success {
-			ruleModels := make([]models.IEAgAgRule, len(ieAgAgRules))
-			for i, rule := range ieAgAgRules {
-				ruleModels[i] = *rule
-			}
-
-			if err := writer.SyncIEAgAgRules(ctx, ruleModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
-				klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d IEAgAgRules: %v", len(ieAgAgRules), err)
-				success = false
-			}
+	if len(ruleS2S) > 0 && success {
+		ruleModels := make([]models.RuleS2S, len(ruleS2S))
+		for i, rule := range ruleS2S {
+			ruleModels[i] = *rule
 		}
-
-		if success {
-			if err := writer.Commit(); err != nil {
-				klog.Errorf("❌ CONDITION_BATCHING: Failed to commit batch transaction: %v", err)
-				writer.Abort()
-			}
+		if err := writer.SyncRuleS2S(ctx, ruleModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
+			klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d RuleS2S: %v", len(ruleS2S), err)
+			success = false
 		} else {
-			writer.Abort()
-		}
-	} else {
-		klog.Errorf("❌ CONDITION_BATCHING: WriterForConditions not available, falling back to individual updates")
-		// Fallback to individual updates if batching not supported
-		for batchKey, resource := range currentBatch {
-			resourceType := strings.Split(batchKey, ":")[0]
-			switch resourceType {
-			case "Service":
-				if svc, ok := resource.(*models.Service); ok {
-					cm.saveServiceConditions(ctx, svc)
-				}
-			case "AddressGroup":
-				if ag, ok := resource.(*models.AddressGroup); ok {
-					cm.saveAddressGroupConditions(ctx, ag)
-				}
-			case "RuleS2S":
-				if rule, ok := resource.(*models.RuleS2S); ok {
-					// Individual batch save - already optimized through batching system
-					cm.saveRuleS2SConditions(ctx, rule)
-				}
-			case "IEAgAgRule":
-				if rule, ok := resource.(*models.IEAgAgRule); ok {
-					// Individual batch save - already optimized through batching system
-					cm.saveIEAgAgRuleConditions(ctx, rule)
-				}
-			}
+			klog.V(2).Infof("✅ CONDITION_BATCHING: Successfully batched %d RuleS2S condition updates", len(ruleS2S))
 		}
 	}
-}
 
-// saveServiceConditions saves the processed conditions for a Service back to storage
-func (cm *ConditionManager) saveServiceConditions(ctx context.Context, service *models.Service) error {
-	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use WriterForConditions for ReadCommitted isolation
-	if registryWithConditions, ok := cm.registry.(interface {
-		WriterForConditions(context.Context) (ports.Writer, error)
-	}); ok {
-		writer, err := registryWithConditions.WriterForConditions(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get condition writer for service %s/%s: %w", service.Namespace, service.Name, err)
+	// Phase 4: IEAgAgRules (must be synced AFTER AddressGroups are in SGROUP)
+	if len(ieAgAgRules) > 0 && success {
+		ruleModels := make([]models.IEAgAgRule, len(ieAgAgRules))
+		for i, rule := range ieAgAgRules {
+			ruleModels[i] = *rule
 		}
 
-		scope := ports.NewResourceIdentifierScope(service.ResourceIdentifier)
-
-		if err := writer.SyncServices(ctx, []models.Service{*service}, scope, ports.ConditionOnlyOperation{}); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to sync service conditions with ReadCommitted transaction: %w", err)
+		if err := writer.SyncIEAgAgRules(ctx, ruleModels, ports.EmptyScope{}, ports.ConditionOnlyOperation{}); err != nil {
+			klog.Errorf("❌ CONDITION_BATCHING: Failed to batch sync %d IEAgAgRules: %v", len(ieAgAgRules), err)
+			success = false
 		}
+	}
 
-		if err := writer.Commit(); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to commit service conditions with ReadCommitted transaction: %w", err)
-		}
+	if !success {
+		writer.Abort()
+		return
+	}
+	if err := writer.Commit(); err != nil {
+		klog.Errorf("❌ CONDITION_BATCHING: Failed to commit batch transaction: %v", err)
+		writer.Abort()
+		return
+	}
 
-		return nil
+	// Statuses changed, watchers (and the API watch cache) must see them
+	serviceModels := make([]models.Service, 0, len(services))
+	for _, svc := range services {
+		serviceModels = append(serviceModels, *svc)
 	}
+	agModels := make([]models.AddressGroup, 0, len(addressGroups))
+	for _, ag := range addressGroups {
+		agModels = append(agModels, *ag)
+	}
+	publishServices(ctx, cm.changeFeed, cm.registry, models.SyncOpUpsert, serviceModels)
+	publishAddressGroups(ctx, cm.changeFeed, cm.registry, models.SyncOpUpsert, agModels)
+}
 
-	// 🔄 FALLBACK: Use traditional Writer if WriterForConditions not available
-	writer, err := cm.registry.Writer(ctx)
+// saveServiceConditions saves the processed conditions for a Service back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveServiceConditions(ctx context.Context, service *models.Service, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "Service", resource: service, base: base})()
+
+	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use a writer with ReadCommitted isolation
+	writer, err := cm.registry.WriterWithOptions(ctx, conditionWriterOptions)
 	if err != nil {
-		return fmt.Errorf("failed to get writer for saving service conditions: %w", err)
+		return fmt.Errorf("failed to get condition writer for service %s/%s: %w", service.Namespace, service.Name, err)
 	}
 
-	// Create a scope for this specific service
 	scope := ports.NewResourceIdentifierScope(service.ResourceIdentifier)
 
-	// Sync the service with updated conditions
-	// Note: This will only update the conditions, the main data should already be committed
-	// 🔧 PRODUCTION FIX: Use ConditionOnlyOperation to signal PostgreSQL backend to use fresh ReadCommitted transaction
 	if err := writer.SyncServices(ctx, []models.Service{*service}, scope, ports.ConditionOnlyOperation{}); err != nil {
 		writer.Abort()
-		return fmt.Errorf("failed to sync service with conditions: %w", err)
+		return fmt.Errorf("failed to sync service conditions with ReadCommitted transaction: %w", err)
 	}
 
 	if err := writer.Commit(); err != nil {
 		writer.Abort()
-		return fmt.Errorf("failed to commit service conditions: %w", err)
+		return fmt.Errorf("failed to commit service conditions with ReadCommitted transaction: %w", err)
 	}
 
+	publishServices(ctx, cm.changeFeed, cm.registry, models.SyncOpUpsert, []models.Service{*service})
 	return nil
 }
 
-// saveAddressGroupConditions saves the processed conditions for an AddressGroup back to storage
-func (cm *ConditionManager) saveAddressGroupConditions(ctx context.Context, ag *models.AddressGroup) error {
-	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use WriterForConditions for ReadCommitted isolation
-	if registryWithConditions, ok := cm.registry.(interface {
-		WriterForConditions(context.Context) (ports.Writer, error)
-	}); ok {
-		writer, err := registryWithConditions.WriterForConditions(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get condition writer for AddressGroup %s/%s: %w", ag.Namespace, ag.Name, err)
-		}
+// saveAddressGroupConditions saves the processed conditions for an AddressGroup back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveAddressGroupConditions(ctx context.Context, ag *models.AddressGroup, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "AddressGroup", resource: ag, base: base})()
 
-		scope := ports.NewResourceIdentifierScope(ag.ResourceIdentifier)
-
-		if err := writer.SyncAddressGroups(ctx, []models.AddressGroup{*ag}, scope, ports.ConditionOnlyOperation{}); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to sync AddressGroup conditions with ReadCommitted transaction: %w", err)
-		}
-
-		if err := writer.Commit(); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to commit AddressGroup conditions with ReadCommitted transaction: %w", err)
-		}
-
-		return nil
-	}
-
-	// 🔄 FALLBACK: Use traditional Writer if WriterForConditions not available
-	writer, err := cm.registry.Writer(ctx)
+	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use a writer with ReadCommitted isolation
+	writer, err := cm.registry.WriterWithOptions(ctx, conditionWriterOptions)
 	if err != nil {
-		return fmt.Errorf("failed to get writer for saving address group conditions: %w", err)
+		return fmt.Errorf("failed to get condition writer for AddressGroup %s/%s: %w", ag.Namespace, ag.Name, err)
 	}
 
-	// Create a scope for this specific address group
 	scope := ports.NewResourceIdentifierScope(ag.ResourceIdentifier)
 
-	// Sync the address group with updated conditions
-	// Note: This will only update the conditions, the main data should already be committed
 	if err := writer.SyncAddressGroups(ctx, []models.AddressGroup{*ag}, scope, ports.ConditionOnlyOperation{}); err != nil {
 		writer.Abort()
-		return fmt.Errorf("failed to sync address group with conditions: %w", err)
+		return fmt.Errorf("failed to sync AddressGroup conditions with ReadCommitted transaction: %w", err)
 	}
 
 	if err := writer.Commit(); err != nil {
 		writer.Abort()
-		return fmt.Errorf("failed to commit address group conditions: %w", err)
+		return fmt.Errorf("failed to commit AddressGroup conditions with ReadCommitted transaction: %w", err)
 	}
 
+	publishAddressGroups(ctx, cm.changeFeed, cm.registry, models.SyncOpUpsert, []models.AddressGroup{*ag})
 	return nil
 }
 
-// saveServiceAliasConditions saves the processed conditions for a ServiceAlias back to storage
-func (cm *ConditionManager) saveServiceAliasConditions(ctx context.Context, alias *models.ServiceAlias) error {
+// saveServiceAliasConditions saves the processed conditions for a ServiceAlias back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveServiceAliasConditions(ctx context.Context, alias *models.ServiceAlias, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "ServiceAlias", resource: alias, base: base})()
+
 	writer, err := cm.registry.Writer(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get writer for service alias conditions: %w", err)
@@ -1567,8 +1633,11 @@ func (cm *ConditionManager) saveServiceAliasConditions(ctx context.Context, alia
 	return nil
 }
 
-// saveAddressGroupBindingConditions saves the processed conditions for an AddressGroupBinding back to storage
-func (cm *ConditionManager) saveAddressGroupBindingConditions(ctx context.Context, binding *models.AddressGroupBinding) error {
+// saveAddressGroupBindingConditions saves the processed conditions for an AddressGroupBinding back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveAddressGroupBindingConditions(ctx context.Context, binding *models.AddressGroupBinding, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "AddressGroupBinding", resource: binding, base: base})()
+
 	writer, err := cm.registry.Writer(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get writer for address group binding conditions: %w", err)
@@ -1592,8 +1661,11 @@ func (cm *ConditionManager) saveAddressGroupBindingConditions(ctx context.Contex
 	return nil
 }
 
-// saveIEAgAgRuleConditions saves the processed conditions for an IEAgAgRule back to storage
-func (cm *ConditionManager) saveIEAgAgRuleConditions(ctx context.Context, rule *models.IEAgAgRule) error {
+// saveIEAgAgRuleConditions saves the processed conditions for an IEAgAgRule back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveIEAgAgRuleConditions(ctx context.Context, rule *models.IEAgAgRule, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "IEAgAgRule", resource: rule, base: base})()
+
 	// 🎯 BUSINESS_FLOW_FIX: Increased timeout for complex condition operations
 	// Previous: 30s was good, but complex flows with many resources need more time
 	// ReadCommitted isolation reduces contention, but condition processing can be complex
@@ -1602,92 +1674,40 @@ func (cm *ConditionManager) saveIEAgAgRuleConditions(ctx context.Context, rule *
 
 	klog.V(2).Infof("🕐 TIMEOUT_FIX: Starting condition save for IEAgAgRule %s/%s with dedicated 30s timeout", rule.Namespace, rule.Name)
 
-	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use WriterForConditions instead of Writer
+	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use a ReadCommitted writer instead of the default one
 	// This creates ReadCommitted transactions that don't conflict with main RepeatableRead transactions
 	// Eliminates PostgreSQL serialization conflicts during condition updates
 
 	// Use specialized condition writer with ReadCommitted isolation
-	if registryWithConditions, ok := cm.registry.(interface {
-		WriterForConditions(context.Context) (ports.Writer, error)
-	}); ok {
-		klog.V(2).Infof("🚀 PHASE_1_FIX: Using WriterForConditions (ReadCommitted) for IEAgAgRule %s/%s", rule.Namespace, rule.Name)
-
-		writer, err := registryWithConditions.WriterForConditions(conditionCtx)
-		if err != nil {
-			return fmt.Errorf("failed to get condition writer for IEAgAgRule %s/%s: %w", rule.Namespace, rule.Name, err)
-		}
-
-		scope := ports.NewResourceIdentifierScope(rule.ResourceIdentifier)
+	klog.V(2).Infof("🚀 PHASE_1_FIX: Using ReadCommitted writer for IEAgAgRule %s/%s", rule.Namespace, rule.Name)
 
-		// Single attempt with ReadCommitted - no retry needed due to reduced contention
-		if err := writer.SyncIEAgAgRules(conditionCtx, []models.IEAgAgRule{*rule}, scope, ports.ConditionOnlyOperation{}); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to sync IEAgAgRule conditions with ReadCommitted transaction: %w", err)
-		}
-
-		if err := writer.Commit(); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to commit IEAgAgRule conditions with ReadCommitted transaction: %w", err)
-		}
-
-		klog.Infof("💾 PHASE_1_FIX: Successfully saved IEAgAgRule %s/%s conditions with ReadCommitted isolation", rule.Namespace, rule.Name)
-		return nil
+	writer, err := cm.registry.WriterWithOptions(conditionCtx, conditionWriterOptions)
+	if err != nil {
+		return fmt.Errorf("failed to get condition writer for IEAgAgRule %s/%s: %w", rule.Namespace, rule.Name, err)
 	}
 
-	// 🔄 FALLBACK: Use traditional retry logic with RepeatableRead if WriterForConditions not available
-	klog.V(2).Infof("⚠️ FALLBACK: WriterForConditions not available, using traditional retry for IEAgAgRule %s/%s", rule.Namespace, rule.Name)
-
-	const maxRetries = 2 // Reduced retries since this is now fallback only
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		if attempt > 1 {
-			// Shorter backoff for fallback
-			backoff := time.Duration(50*attempt) * time.Millisecond
-			klog.V(2).Infof("⏳ FALLBACK: Retry attempt %d/%d for IEAgAgRule %s/%s after %v", attempt, maxRetries, rule.Namespace, rule.Name, backoff)
-			time.Sleep(backoff)
-		}
-
-		writer, err := cm.registry.Writer(conditionCtx)
-		if err != nil {
-			if attempt == maxRetries {
-				return fmt.Errorf("failed to get writer for IEAgAgRule conditions after %d attempts: %w", maxRetries, err)
-			}
-			klog.V(2).Infof("⚠️ TIMEOUT_FIX: Writer creation failed on attempt %d for IEAgAgRule %s/%s: %v", attempt, rule.Namespace, rule.Name, err)
-			continue
-		}
-
-		scope := ports.NewResourceIdentifierScope(rule.ResourceIdentifier)
-
-		// Sync the IEAgAgRule with updated conditions
-		// Note: This will only update the conditions, the main data should already be committed
-		if err := writer.SyncIEAgAgRules(conditionCtx, []models.IEAgAgRule{*rule}, scope, ports.ConditionOnlyOperation{}); err != nil {
-			writer.Abort()
-			if attempt == maxRetries {
-				return fmt.Errorf("failed to sync IEAgAgRule with conditions after %d attempts: %w", maxRetries, err)
-			}
-			klog.V(2).Infof("⚠️ TIMEOUT_FIX: Sync failed on attempt %d for IEAgAgRule %s/%s: %v", attempt, rule.Namespace, rule.Name, err)
-			continue
-		}
+	scope := ports.NewResourceIdentifierScope(rule.ResourceIdentifier)
 
-		if err := writer.Commit(); err != nil {
-			writer.Abort()
-			if attempt == maxRetries {
-				return fmt.Errorf("failed to commit IEAgAgRule conditions after %d attempts: %w", maxRetries, err)
-			}
-			klog.V(2).Infof("⚠️ TIMEOUT_FIX: Commit failed on attempt %d for IEAgAgRule %s/%s: %v", attempt, rule.Namespace, rule.Name, err)
-			continue
-		}
+	// Single attempt with ReadCommitted - no retry needed due to reduced contention
+	if err := writer.SyncIEAgAgRules(conditionCtx, []models.IEAgAgRule{*rule}, scope, ports.ConditionOnlyOperation{}); err != nil {
+		writer.Abort()
+		return fmt.Errorf("failed to sync IEAgAgRule conditions with ReadCommitted transaction: %w", err)
+	}
 
-		// Success!
-		klog.Infof("💾 TIMEOUT_FIX: Successfully saved conditions for IEAgAgRule %s/%s on attempt %d", rule.Namespace, rule.Name, attempt)
-		return nil
+	if err := writer.Commit(); err != nil {
+		writer.Abort()
+		return fmt.Errorf("failed to commit IEAgAgRule conditions with ReadCommitted transaction: %w", err)
 	}
 
-	// This should not be reached due to the maxRetries check above, but adding for safety
-	return fmt.Errorf("failed to save IEAgAgRule conditions after %d attempts", maxRetries)
+	klog.Infof("💾 PHASE_1_FIX: Successfully saved IEAgAgRule %s/%s conditions with ReadCommitted isolation", rule.Namespace, rule.Name)
+	return nil
 }
 
-// saveRuleS2SConditions saves the processed conditions for a RuleS2S back to storage
-func (cm *ConditionManager) saveRuleS2SConditions(ctx context.Context, rule *models.RuleS2S) error {
+// saveRuleS2SConditions saves the processed conditions for a RuleS2S back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveRuleS2SConditions(ctx context.Context, rule *models.RuleS2S, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "RuleS2S", resource: rule, base: base})()
+
 	// 🎯 BUSINESS_FLOW_FIX: Increased timeout for complex condition operations
 	// Previous: 30s was good, but complex flows with many resources need more time
 	// ReadCommitted isolation reduces contention, but condition processing can be complex
@@ -1696,91 +1716,40 @@ func (cm *ConditionManager) saveRuleS2SConditions(ctx context.Context, rule *mod
 
 	klog.V(2).Infof("🕐 TIMEOUT_FIX: Starting condition save for RuleS2S %s/%s with dedicated 30s timeout", rule.Namespace, rule.Name)
 
-	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use WriterForConditions instead of Writer
+	// 🎯 PHASE_1_TRANSACTION_ISOLATION: Use a ReadCommitted writer instead of the default one
 	// This creates ReadCommitted transactions that don't conflict with main RepeatableRead transactions
 	// Eliminates PostgreSQL serialization conflicts during condition updates
 
 	// Use specialized condition writer with ReadCommitted isolation
-	if registryWithConditions, ok := cm.registry.(interface {
-		WriterForConditions(context.Context) (ports.Writer, error)
-	}); ok {
-		klog.V(2).Infof("🚀 PHASE_1_FIX: Using WriterForConditions (ReadCommitted) for RuleS2S %s/%s", rule.Namespace, rule.Name)
-
-		writer, err := registryWithConditions.WriterForConditions(conditionCtx)
-		if err != nil {
-			return fmt.Errorf("failed to get condition writer for RuleS2S %s/%s: %w", rule.Namespace, rule.Name, err)
-		}
-
-		scope := ports.NewResourceIdentifierScope(rule.ResourceIdentifier)
+	klog.V(2).Infof("🚀 PHASE_1_FIX: Using ReadCommitted writer for RuleS2S %s/%s", rule.Namespace, rule.Name)
 
-		// Single attempt with ReadCommitted - no retry needed due to reduced contention
-		if err := writer.SyncRuleS2S(conditionCtx, []models.RuleS2S{*rule}, scope, ports.ConditionOnlyOperation{}); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to sync RuleS2S conditions with ReadCommitted transaction: %w", err)
-		}
-
-		if err := writer.Commit(); err != nil {
-			writer.Abort()
-			return fmt.Errorf("failed to commit RuleS2S conditions with ReadCommitted transaction: %w", err)
-		}
-
-		klog.Infof("💾 PHASE_1_FIX: Successfully saved RuleS2S %s/%s conditions with ReadCommitted isolation", rule.Namespace, rule.Name)
-		return nil
+	writer, err := cm.registry.WriterWithOptions(conditionCtx, conditionWriterOptions)
+	if err != nil {
+		return fmt.Errorf("failed to get condition writer for RuleS2S %s/%s: %w", rule.Namespace, rule.Name, err)
 	}
 
-	// 🔄 FALLBACK: Use traditional retry logic with RepeatableRead if WriterForConditions not available
-	klog.V(2).Infof("⚠️ FALLBACK: WriterForConditions not available, using traditional retry for RuleS2S %s/%s", rule.Namespace, rule.Name)
-
-	const maxRetries = 2 // Reduced retries since this is now fallback only
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		if attempt > 1 {
-			// Shorter backoff for fallback
-			backoff := time.Duration(50*attempt) * time.Millisecond
-			klog.V(2).Infof("⏳ FALLBACK: Retry attempt %d/%d for RuleS2S %s/%s after %v", attempt, maxRetries, rule.Namespace, rule.Name, backoff)
-			time.Sleep(backoff)
-		}
+	scope := ports.NewResourceIdentifierScope(rule.ResourceIdentifier)
 
-		writer, err := cm.registry.Writer(conditionCtx)
-		if err != nil {
-			if attempt == maxRetries {
-				return fmt.Errorf("failed to get writer for RuleS2S conditions after %d attempts: %w", maxRetries, err)
-			}
-			klog.V(2).Infof("⚠️ TIMEOUT_FIX: Writer creation failed on attempt %d for RuleS2S %s/%s: %v", attempt, rule.Namespace, rule.Name, err)
-			continue
-		}
-
-		scope := ports.NewResourceIdentifierScope(rule.ResourceIdentifier)
-
-		// Sync the RuleS2S with updated conditions
-		if err := writer.SyncRuleS2S(conditionCtx, []models.RuleS2S{*rule}, scope, ports.ConditionOnlyOperation{}); err != nil {
-			writer.Abort()
-			if attempt == maxRetries {
-				return fmt.Errorf("failed to sync RuleS2S with conditions after %d attempts: %w", maxRetries, err)
-			}
-			klog.V(2).Infof("⚠️ TIMEOUT_FIX: Sync failed on attempt %d for RuleS2S %s/%s: %v", attempt, rule.Namespace, rule.Name, err)
-			continue
-		}
-
-		if err := writer.Commit(); err != nil {
-			writer.Abort()
-			if attempt == maxRetries {
-				return fmt.Errorf("failed to commit RuleS2S conditions after %d attempts: %w", maxRetries, err)
-			}
-			klog.V(2).Infof("⚠️ TIMEOUT_FIX: Commit failed on attempt %d for RuleS2S %s/%s: %v", attempt, rule.Namespace, rule.Name, err)
-			continue
-		}
+	// Single attempt with ReadCommitted - no retry needed due to reduced contention
+	if err := writer.SyncRuleS2S(conditionCtx, []models.RuleS2S{*rule}, scope, ports.ConditionOnlyOperation{}); err != nil {
+		writer.Abort()
+		return fmt.Errorf("failed to sync RuleS2S conditions with ReadCommitted transaction: %w", err)
+	}
 
-		// Success!
-		klog.Infof("💾 TIMEOUT_FIX: Successfully saved conditions for RuleS2S %s/%s on attempt %d", rule.Namespace, rule.Name, attempt)
-		return nil
+	if err := writer.Commit(); err != nil {
+		writer.Abort()
+		return fmt.Errorf("failed to commit RuleS2S conditions with ReadCommitted transaction: %w", err)
 	}
 
-	// This should not be reached due to the maxRetries check above, but adding for safety
-	return fmt.Errorf("failed to save RuleS2S conditions after %d attempts", maxRetries)
+	klog.Infof("💾 PHASE_1_FIX: Successfully saved RuleS2S %s/%s conditions with ReadCommitted isolation", rule.Namespace, rule.Name)
+	return nil
 }
 
-// saveAddressGroupPortMappingConditions saves the processed conditions for an AddressGroupPortMapping back to storage
-func (cm *ConditionManager) saveAddressGroupPortMappingConditions(ctx context.Context, mapping *models.AddressGroupPortMapping) error {
+// saveAddressGroupPortMappingConditions saves the processed conditions for an AddressGroupPortMapping back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveAddressGroupPortMappingConditions(ctx context.Context, mapping *models.AddressGroupPortMapping, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "AddressGroupPortMapping", resource: mapping, base: base})()
+
 	writer, err := cm.registry.Writer(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get writer for AddressGroupPortMapping conditions: %w", err)
@@ -1803,8 +1772,11 @@ func (cm *ConditionManager) saveAddressGroupPortMappingConditions(ctx context.Co
 	return nil
 }
 
-// saveAddressGroupBindingPolicyConditions saves the processed conditions for an AddressGroupBindingPolicy back to storage
-func (cm *ConditionManager) saveAddressGroupBindingPolicyConditions(ctx context.Context, policy *models.AddressGroupBindingPolicy) error {
+// saveAddressGroupBindingPolicyConditions saves the processed conditions for an AddressGroupBindingPolicy back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveAddressGroupBindingPolicyConditions(ctx context.Context, policy *models.AddressGroupBindingPolicy, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "AddressGroupBindingPolicy", resource: policy, base: base})()
+
 	writer, err := cm.registry.Writer(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get writer for AddressGroupBindingPolicy conditions: %w", err)
@@ -1827,8 +1799,11 @@ func (cm *ConditionManager) saveAddressGroupBindingPolicyConditions(ctx context.
 	return nil
 }
 
-// saveNetworkBindingConditions saves the processed conditions for a NetworkBinding back to storage
-func (cm *ConditionManager) saveNetworkBindingConditions(ctx context.Context, binding *models.NetworkBinding) error {
+// saveNetworkBindingConditions saves the processed conditions for a NetworkBinding back to storage,
+// merged with conditions stored concurrently since base
+func (cm *ConditionManager) saveNetworkBindingConditions(ctx context.Context, binding *models.NetworkBinding, base []metav1.Condition) error {
+	defer cm.lockConditions(ctx, conditionUpdate{resourceType: "NetworkBinding", resource: binding, base: base})()
+
 	writer, err := cm.registry.Writer(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get writer for NetworkBinding conditions: %w", err)
@@ -1936,3 +1911,178 @@ func (cm *ConditionManager) checkRuleS2SCanGenerateIEAgAg(ctx context.Context, r
 
 	return true
 }
+
+// MarkSyncDeadLettered surfaces a permanently failed sgroups sync in the conditions of the resource
+func (cm *ConditionManager) MarkSyncDeadLettered(ctx context.Context, entry models.SyncOutboxEntry) {
+	if entry.Kind != models.ChangeKindAddressGroup {
+		return
+	}
+	id, ok := entry.ResourceIdentifier()
+	if !ok {
+		return
+	}
+
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		syncLog.Error(err, "Failed to get reader for dead-letter AddressGroup", "addressGroup", id.Key())
+		return
+	}
+	ag, err := reader.GetAddressGroupByID(ctx, id)
+	reader.Close()
+	if err != nil {
+		// Deleted address groups have no conditions to update
+		syncLog.V(2).Info("Dead-letter AddressGroup not available for condition update", "addressGroup", id.Key(), "error", err.Error())
+		return
+	}
+
+	base := snapshotConditions(&ag.Meta)
+	message := fmt.Sprintf("Sync with SGROUP failed after %d attempts (dead-letter entry %d): %s", entry.Attempts, entry.ID, entry.LastError)
+	ag.Meta.SetSyncedCondition(metav1.ConditionFalse, models.ReasonSyncDeadLettered, message)
+	ag.Meta.SetReadyCondition(metav1.ConditionFalse, models.ReasonSyncDeadLettered, "External sync failed permanently")
+	ag.Meta.SetErrorCondition(models.ReasonSyncDeadLettered, message)
+
+	syncLog.Info("AddressGroup marked Synced=False by dead-letter entry", "addressGroup", id.Key(), "entry", entry.ID)
+	cm.batchConditionUpdate("AddressGroup", ag, base)
+}
+
+// ClearSyncDeadLettered restores conditions set by MarkSyncDeadLettered after the resource was delivered
+func (cm *ConditionManager) ClearSyncDeadLettered(ctx context.Context, entries []models.SyncOutboxEntry) {
+	var ids []models.ResourceIdentifier
+	for _, entry := range entries {
+		if entry.Kind != models.ChangeKindAddressGroup || entry.Operation == types.SyncOperationDelete {
+			continue
+		}
+		if id, ok := entry.ResourceIdentifier(); ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		syncLog.Error(err, "Failed to get reader to clear dead-letter sync conditions")
+		return
+	}
+	var recovered []models.AddressGroup
+	err = reader.ListAddressGroups(ctx, func(ag models.AddressGroup) error {
+		if synced := ag.Meta.GetCondition(models.ConditionSynced); synced != nil && synced.Reason == models.ReasonSyncDeadLettered {
+			recovered = append(recovered, ag)
+		}
+		return nil
+	}, ports.NewResourceIdentifierScope(ids...))
+	reader.Close()
+	if err != nil {
+		syncLog.Error(err, "Failed to list AddressGroups to clear dead-letter sync conditions")
+		return
+	}
+
+	for i := range recovered {
+		ag := &recovered[i]
+		base := snapshotConditions(&ag.Meta)
+		ag.Meta.ClearErrorCondition()
+		ag.Meta.SetSyncedCondition(metav1.ConditionTrue, models.ReasonSynced, "Address group successfully synced to backend and SGROUP")
+		if ready := ag.Meta.GetCondition(models.ConditionReady); ready != nil && ready.Reason == models.ReasonSyncDeadLettered {
+			ag.Meta.SetReadyCondition(metav1.ConditionTrue, models.ReasonReady, "Address group is ready and operational")
+		}
+
+		syncLog.Info("AddressGroup recovered from dead-letter queue", "addressGroup", ag.Key())
+		cm.batchConditionUpdate("AddressGroup", ag, base)
+	}
+}
+
+// UpdateSyncDriftConditions marks drifted AddressGroups Synced=False and restores
+// AddressGroups marked by a previous drift check that no longer drift
+func (cm *ConditionManager) UpdateSyncDriftConditions(ctx context.Context, drifted []models.ResourceIdentifier) {
+	driftedKeys := make(map[string]bool, len(drifted))
+	for _, id := range drifted {
+		driftedKeys[id.Key()] = true
+	}
+
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		syncLog.Error(err, "Failed to get reader to update drift conditions")
+		return
+	}
+	var marked, recovered []models.AddressGroup
+	err = reader.ListAddressGroups(ctx, func(ag models.AddressGroup) error {
+		synced := ag.Meta.GetCondition(models.ConditionSynced)
+		hasDrift := synced != nil && synced.Reason == models.ReasonSyncDrift
+		switch {
+		case driftedKeys[ag.Key()] && !hasDrift:
+			marked = append(marked, ag)
+		case !driftedKeys[ag.Key()] && hasDrift:
+			recovered = append(recovered, ag)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	reader.Close()
+	if err != nil {
+		syncLog.Error(err, "Failed to list AddressGroups to update drift conditions")
+		return
+	}
+
+	for i := range marked {
+		ag := &marked[i]
+		base := snapshotConditions(&ag.Meta)
+		ag.Meta.SetSyncedCondition(metav1.ConditionFalse, models.ReasonSyncDrift, "Address group in SGROUP differs from the backend state")
+		syncLog.Info("AddressGroup drifted, marked Synced=False", "addressGroup", ag.Key())
+		cm.batchConditionUpdate("AddressGroup", ag, base)
+	}
+	for i := range recovered {
+		ag := &recovered[i]
+		base := snapshotConditions(&ag.Meta)
+		ag.Meta.SetSyncedCondition(metav1.ConditionTrue, models.ReasonSynced, "Address group successfully synced to backend and SGROUP")
+		syncLog.Info("AddressGroup is in sync with SGROUP again", "addressGroup", ag.Key())
+		cm.batchConditionUpdate("AddressGroup", ag, base)
+	}
+}
+
+// UpdateSGroupsAvailabilityConditions marks synced AddressGroups Synced=False while the sgroups
+// circuit breaker is open and restores them once it closes. inTarget selects namespaces
+// synchronized to the sgroups instance of the breaker.
+func (cm *ConditionManager) UpdateSGroupsAvailabilityConditions(ctx context.Context, available bool, inTarget func(namespace string) bool) {
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		syncLog.Error(err, "Failed to get reader to update circuit breaker sync conditions")
+		return
+	}
+	var changed []models.AddressGroup
+	err = reader.ListAddressGroups(ctx, func(ag models.AddressGroup) error {
+		if !inTarget(ag.Namespace) {
+			return nil
+		}
+		synced := ag.Meta.GetCondition(models.ConditionSynced)
+		if synced == nil {
+			return nil
+		}
+		// Only AddressGroups marked by the breaker are restored, other sync failures are kept
+		if available && synced.Reason == models.ReasonSGroupsUnavailable ||
+			!available && synced.Status == metav1.ConditionTrue {
+			changed = append(changed, ag)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	reader.Close()
+	if err != nil {
+		syncLog.Error(err, "Failed to list AddressGroups to update circuit breaker sync conditions")
+		return
+	}
+
+	for i := range changed {
+		ag := &changed[i]
+		base := snapshotConditions(&ag.Meta)
+		if available {
+			ag.Meta.SetSyncedCondition(metav1.ConditionTrue, models.ReasonSynced, "Address group successfully synced to backend and SGROUP")
+		} else {
+			ag.Meta.SetSyncedCondition(metav1.ConditionFalse, models.ReasonSGroupsUnavailable, "SGROUP is unavailable, changes are synced once it recovers")
+		}
+		cm.batchConditionUpdate("AddressGroup", ag, base)
+	}
+	if available {
+		syncLog.Info("SGROUP is available again, AddressGroups marked Synced=True", "addressGroups", len(changed))
+	} else {
+		syncLog.Info("SGROUP is unavailable, AddressGroups marked Synced=False", "addressGroups", len(changed))
+	}
+}
@@ -0,0 +1,326 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// 🔒 CONDITION_MERGE: Condition writes are merged with conditions stored concurrently.
+// Conditions are computed from a snapshot (base) that may be stale by the time they are
+// written: a resource update, a dead-letter or drift report may have changed the stored
+// conditions meanwhile. Writes take only the condition types changed relative to base,
+// the others are re-read from storage under a per-resource lock.
+
+// conditionUpdate is a resource with conditions computed from base
+type conditionUpdate struct {
+	resourceType string
+	resource     interface{}
+	// base are the conditions the computation started from, nil if unknown
+	base []metav1.Condition
+}
+
+// snapshotConditions returns a copy of the conditions to be used as the base of an update
+func snapshotConditions(meta *models.Meta) []metav1.Condition {
+	if meta == nil {
+		return nil
+	}
+	return append([]metav1.Condition{}, meta.Conditions...)
+}
+
+// mergeConditions merges conditions computed from base into the stored conditions.
+// Condition types changed, added or removed relative to base are taken from computed,
+// the others from stored. A nil base keeps stored types missing from computed.
+func mergeConditions(base, computed, stored []metav1.Condition) []metav1.Condition {
+	baseByType := conditionsByType(base)
+	computedByType := conditionsByType(computed)
+
+	changed := func(conditionType string) bool {
+		baseCondition, inBase := baseByType[conditionType]
+		computedCondition, inComputed := computedByType[conditionType]
+		if inBase != inComputed {
+			return base != nil || inComputed
+		}
+		return inComputed && !reflect.DeepEqual(baseCondition, computedCondition)
+	}
+
+	merged := make([]metav1.Condition, 0, len(stored)+len(computed))
+	seen := make(map[string]bool, len(stored)+len(computed))
+	for _, condition := range stored {
+		seen[condition.Type] = true
+		if !changed(condition.Type) {
+			merged = append(merged, condition)
+		} else if computedCondition, exists := computedByType[condition.Type]; exists {
+			merged = append(merged, computedCondition)
+		}
+	}
+	for _, condition := range computed {
+		if !seen[condition.Type] && changed(condition.Type) {
+			merged = append(merged, condition)
+		}
+	}
+	return merged
+}
+
+func conditionsByType(conditions []metav1.Condition) map[string]metav1.Condition {
+	byType := make(map[string]metav1.Condition, len(conditions))
+	for _, condition := range conditions {
+		byType[condition.Type] = condition
+	}
+	return byType
+}
+
+// conditionTarget returns the meta, kind and identifier of a resource with conditions
+func conditionTarget(resource interface{}) (*models.Meta, string, models.ResourceIdentifier, error) {
+	switch r := resource.(type) {
+	case *models.Service:
+		return &r.Meta, "Service", r.ResourceIdentifier, nil
+	case *models.AddressGroup:
+		return &r.Meta, "AddressGroup", r.ResourceIdentifier, nil
+	case *models.RuleS2S:
+		return &r.Meta, "RuleS2S", r.ResourceIdentifier, nil
+	case *models.IEAgAgRule:
+		return &r.Meta, "IEAgAgRule", r.ResourceIdentifier, nil
+	case *models.AddressGroupBinding:
+		return &r.Meta, "AddressGroupBinding", r.ResourceIdentifier, nil
+	case *models.AddressGroupPortMapping:
+		return &r.Meta, "AddressGroupPortMapping", r.ResourceIdentifier, nil
+	case *models.AddressGroupBindingPolicy:
+		return &r.Meta, "AddressGroupBindingPolicy", r.ResourceIdentifier, nil
+	case *models.ServiceAlias:
+		return &r.Meta, "ServiceAlias", r.ResourceIdentifier, nil
+	case *models.Network:
+		return &r.Meta, "Network", r.ResourceIdentifier, nil
+	case *models.NetworkBinding:
+		return &r.Meta, "NetworkBinding", r.ResourceIdentifier, nil
+	}
+	return nil, "", models.ResourceIdentifier{}, fmt.Errorf("unsupported resource type %T for conditions", resource)
+}
+
+// conditionKey returns the lock key of a resource with conditions
+func conditionKey(kind string, id models.ResourceIdentifier) string {
+	return kind + ":" + id.Key()
+}
+
+// storedConditions reads the conditions currently stored for the resource
+func storedConditions(ctx context.Context, reader ports.Reader, resource interface{}) ([]metav1.Condition, error) {
+	var meta *models.Meta
+	var err error
+	switch r := resource.(type) {
+	case *models.Service:
+		var stored *models.Service
+		if stored, err = reader.GetServiceByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.AddressGroup:
+		var stored *models.AddressGroup
+		if stored, err = reader.GetAddressGroupByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.RuleS2S:
+		var stored *models.RuleS2S
+		if stored, err = reader.GetRuleS2SByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.IEAgAgRule:
+		var stored *models.IEAgAgRule
+		if stored, err = reader.GetIEAgAgRuleByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.AddressGroupBinding:
+		var stored *models.AddressGroupBinding
+		if stored, err = reader.GetAddressGroupBindingByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.AddressGroupPortMapping:
+		var stored *models.AddressGroupPortMapping
+		if stored, err = reader.GetAddressGroupPortMappingByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.AddressGroupBindingPolicy:
+		var stored *models.AddressGroupBindingPolicy
+		if stored, err = reader.GetAddressGroupBindingPolicyByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.ServiceAlias:
+		var stored *models.ServiceAlias
+		if stored, err = reader.GetServiceAliasByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.Network:
+		var stored *models.Network
+		if stored, err = reader.GetNetworkByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	case *models.NetworkBinding:
+		var stored *models.NetworkBinding
+		if stored, err = reader.GetNetworkBindingByID(ctx, r.ResourceIdentifier); err == nil {
+			meta = &stored.Meta
+		}
+	default:
+		return nil, fmt.Errorf("unsupported resource type %T for conditions", resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return meta.Conditions, nil
+}
+
+// mergeStoredConditions merges conditions stored since the update base into the update resource.
+// The caller holds the resource lock. Resources that are not stored keep computed conditions.
+// It returns the status transitions of the merged conditions relative to the stored ones.
+func (cm *ConditionManager) mergeStoredConditions(ctx context.Context, updates ...conditionUpdate) []models.ConditionTransition {
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		klog.Errorf("❌ CONDITION_MERGE: Failed to get reader, writing computed conditions: %v", err)
+		return nil
+	}
+	defer reader.Close()
+
+	var transitions []models.ConditionTransition
+	now := time.Now()
+	for _, update := range updates {
+		meta, kind, id, err := conditionTarget(update.resource)
+		if err != nil {
+			klog.Errorf("❌ CONDITION_MERGE: %v", err)
+			continue
+		}
+		stored, err := storedConditions(ctx, reader, update.resource)
+		if err != nil {
+			if !errors.Is(err, ports.ErrNotFound) {
+				klog.Errorf("❌ CONDITION_MERGE: Failed to read stored conditions of %s, writing computed conditions: %v", conditionKey(kind, id), err)
+			}
+			continue
+		}
+		meta.Conditions = mergeConditions(update.base, meta.Conditions, stored)
+		if cm.history != nil {
+			transitions = append(transitions, models.ConditionTransitions(kind, id, stored, meta.Conditions, now)...)
+		}
+	}
+	return transitions
+}
+
+// lockConditions merges stored conditions into the updates and returns the function
+// releasing their resource locks, to be called once the conditions are written
+func (cm *ConditionManager) lockConditions(ctx context.Context, updates ...conditionUpdate) func() {
+	keys := make([]string, 0, len(updates))
+	for _, update := range updates {
+		if _, kind, id, err := conditionTarget(update.resource); err == nil {
+			keys = append(keys, conditionKey(kind, id))
+		}
+	}
+	unlock := cm.resourceLocks.lock(keys...)
+	transitions := cm.mergeStoredConditions(ctx, updates...)
+	return func() {
+		defer unlock()
+		cm.recordTransitions(ctx, updates, transitions)
+	}
+}
+
+// recordTransitions stores the transitions of the updates that were written. The caller still
+// holds the resource locks, so the stored conditions are those written by the updates.
+func (cm *ConditionManager) recordTransitions(ctx context.Context, updates []conditionUpdate, transitions []models.ConditionTransition) {
+	if cm.history == nil || len(transitions) == 0 {
+		return
+	}
+
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		klog.Errorf("❌ CONDITION_HISTORY: Failed to get reader, %d transitions not recorded: %v", len(transitions), err)
+		return
+	}
+	defer reader.Close()
+
+	// A failed write leaves the previous status stored, its transitions didn't happen
+	written := make(map[string]map[string]metav1.ConditionStatus, len(updates))
+	for _, update := range updates {
+		_, kind, id, err := conditionTarget(update.resource)
+		if err != nil {
+			continue
+		}
+		stored, err := storedConditions(ctx, reader, update.resource)
+		if err != nil {
+			continue
+		}
+		statuses := make(map[string]metav1.ConditionStatus, len(stored))
+		for _, condition := range stored {
+			statuses[condition.Type] = condition.Status
+		}
+		written[conditionKey(kind, id)] = statuses
+	}
+
+	recorded := make([]models.ConditionTransition, 0, len(transitions))
+	for _, transition := range transitions {
+		if status, ok := written[conditionKey(transition.Kind, transition.ResourceIdentifier)][transition.Type]; ok && status == transition.To {
+			recorded = append(recorded, transition)
+		}
+	}
+	if err := cm.history.Record(ctx, recorded); err != nil {
+		klog.Errorf("❌ CONDITION_HISTORY: Failed to record %d condition transitions: %v", len(recorded), err)
+		return
+	}
+	for _, transition := range recorded {
+		klog.V(2).Infof("📜 CONDITION_HISTORY: %s %s %s %s -> %s (%s)", transition.Kind, transition.Key(), transition.Type, transition.From, transition.To, transition.Reason)
+	}
+}
+
+// resourceLocks is a set of mutexes by resource key
+type resourceLocks struct {
+	mu    sync.Mutex
+	locks map[string]*resourceLock
+}
+
+type resourceLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock locks the keys in sorted order, so concurrent batches can't deadlock,
+// and returns the function unlocking them
+func (l *resourceLocks) lock(keys ...string) func() {
+	keys = append([]string{}, keys...)
+	sort.Strings(keys)
+
+	acquired := make([]string, 0, len(keys))
+	for i, key := range keys {
+		if i > 0 && key == keys[i-1] {
+			continue
+		}
+		l.mu.Lock()
+		if l.locks == nil {
+			l.locks = make(map[string]*resourceLock)
+		}
+		lock, exists := l.locks[key]
+		if !exists {
+			lock = &resourceLock{}
+			l.locks[key] = lock
+		}
+		lock.refs++
+		l.mu.Unlock()
+
+		lock.mu.Lock()
+		acquired = append(acquired, key)
+	}
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		for _, key := range acquired {
+			lock := l.locks[key]
+			lock.mu.Unlock()
+			if lock.refs--; lock.refs == 0 {
+				delete(l.locks, key)
+			}
+		}
+	}
+}
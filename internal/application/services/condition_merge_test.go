@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+func conditionTypes(conditions []metav1.Condition) map[string]string {
+	types := make(map[string]string, len(conditions))
+	for _, condition := range conditions {
+		types[condition.Type] = condition.Reason
+	}
+	return types
+}
+
+func TestMergeConditions(t *testing.T) {
+	base := []metav1.Condition{
+		models.NewReadyCondition(metav1.ConditionFalse, models.ReasonNotReady, "pending"),
+		models.NewErrorCondition(models.ReasonValidationFailed, "invalid"),
+	}
+	computed := []metav1.Condition{
+		models.NewReadyCondition(metav1.ConditionTrue, models.ReasonReady, "ready"),
+		models.NewValidatedCondition(metav1.ConditionTrue, models.ReasonValidated, "valid"),
+	}
+	stored := []metav1.Condition{
+		base[0],
+		base[1],
+		models.NewSyncedCondition(metav1.ConditionFalse, models.ReasonSyncDeadLettered, "dead-lettered"),
+	}
+
+	merged := mergeConditions(base, computed, stored)
+	assert.Equal(t, map[string]string{
+		models.ConditionReady:     models.ReasonReady,
+		models.ConditionValidated: models.ReasonValidated,
+		// Written concurrently and not changed by the computation
+		models.ConditionSynced: models.ReasonSyncDeadLettered,
+	}, conditionTypes(merged), "removed Error must stay removed")
+
+	// Without a base conditions missing from the computed ones are kept
+	merged = mergeConditions(nil, computed, stored)
+	assert.Contains(t, conditionTypes(merged), models.ConditionError)
+	assert.Equal(t, models.ReasonReady, conditionTypes(merged)[models.ConditionReady])
+}
+
+func TestConditionManager_SaveKeepsConcurrentConditions(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+	cm := NewConditionManager(registry)
+
+	ag := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("ag", models.WithNamespace("app")))}
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{ag}, ports.EmptyScope{}))
+	require.NoError(t, writer.Commit())
+
+	// Conditions are computed from the stale copy while a dead-letter report is stored
+	stale := ag
+	base := snapshotConditions(&stale.Meta)
+	stale.Meta.SetReadyCondition(metav1.ConditionTrue, models.ReasonReady, "ready")
+
+	ag.Meta.SetSyncedCondition(metav1.ConditionFalse, models.ReasonSyncDeadLettered, "dead-lettered")
+	require.NoError(t, cm.saveAddressGroupConditions(ctx, &ag, nil))
+	require.NoError(t, cm.saveAddressGroupConditions(ctx, &stale, base))
+
+	reader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	defer reader.Close()
+	stored, err := reader.GetAddressGroupByID(ctx, ag.ResourceIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		models.ConditionReady:  models.ReasonReady,
+		models.ConditionSynced: models.ReasonSyncDeadLettered,
+	}, conditionTypes(stored.Meta.Conditions))
+}
+
+func TestConditionManager_RecordsConditionTransitions(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+	history := mem.NewConditionHistory()
+	cm := NewConditionManager(registry)
+	cm.SetConditionHistory(history)
+
+	ag := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("ag", models.WithNamespace("app")))}
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{ag}, ports.EmptyScope{}))
+	require.NoError(t, writer.Commit())
+
+	// Every save computes conditions on its own copy of the resource
+	save := func(status metav1.ConditionStatus, reason, message string) {
+		update := ag
+		update.Meta.Conditions = snapshotConditions(&ag.Meta)
+		update.Meta.SetReadyCondition(status, reason, message)
+		require.NoError(t, cm.saveAddressGroupConditions(ctx, &update, nil))
+		ag.Meta.Conditions = snapshotConditions(&update.Meta)
+	}
+	save(metav1.ConditionTrue, models.ReasonReady, "ready")
+	// Only the message changes, it is not a transition
+	save(metav1.ConditionTrue, models.ReasonReady, "still ready")
+	save(metav1.ConditionFalse, models.ReasonNotReady, "service deleted")
+
+	transitions, err := history.List(ctx, ports.ConditionTransitionQuery{Kind: "AddressGroup", Resource: &ag.ResourceIdentifier})
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	assert.Equal(t, metav1.ConditionStatus(""), transitions[0].From)
+	assert.Equal(t, metav1.ConditionTrue, transitions[0].To)
+	assert.Equal(t, metav1.ConditionTrue, transitions[1].From)
+	assert.Equal(t, metav1.ConditionFalse, transitions[1].To)
+	assert.Equal(t, "service deleted", transitions[1].Message)
+
+	// Transitions of resources that are not stored are not recorded
+	missing := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("missing", models.WithNamespace("app")))}
+	missing.Meta.SetReadyCondition(metav1.ConditionTrue, models.ReasonReady, "ready")
+	_ = cm.saveAddressGroupConditions(ctx, &missing, nil)
+	last, err := history.LastID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), last)
+}
+
+func TestConditionManager_PublishesConditionWrites(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+	feed := NewChangeFeed()
+	cm := NewConditionManager(registry)
+	cm.SetChangeFeed(feed)
+
+	ag := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("ag", models.WithNamespace("app")))}
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{ag}, ports.EmptyScope{}))
+	require.NoError(t, writer.Commit())
+
+	events, cancel := feed.Subscribe()
+	defer cancel()
+
+	ag.Meta.SetReadyCondition(metav1.ConditionTrue, models.ReasonReady, "ready")
+	require.NoError(t, cm.saveAddressGroupConditions(ctx, &ag, nil))
+
+	// Watchers receive the stored status, not only spec changes
+	event := <-events
+	assert.Equal(t, models.SyncOpUpsert, event.SyncOp)
+	published, ok := event.Resource.(models.AddressGroup)
+	require.True(t, ok)
+	assert.Equal(t, models.ReasonReady, conditionTypes(published.Meta.Conditions)[models.ConditionReady])
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+
+	"k8s.io/klog/v2"
+)
+
+// dependencyIndex holds the keys of the stored resources other resources reference
+type dependencyIndex struct {
+	services      map[string]bool
+	addressGroups map[string]bool
+	networks      map[string]bool
+}
+
+func loadDependencyIndex(ctx context.Context, reader ports.Reader) (dependencyIndex, error) {
+	index := dependencyIndex{services: map[string]bool{}, addressGroups: map[string]bool{}, networks: map[string]bool{}}
+	scope := ports.EmptyScope{}
+	if err := reader.ListServices(ctx, func(service models.Service) error {
+		index.services[service.Key()] = true
+		return nil
+	}, scope); err != nil {
+		return index, err
+	}
+	if err := reader.ListAddressGroups(ctx, func(ag models.AddressGroup) error {
+		index.addressGroups[ag.Key()] = true
+		return nil
+	}, scope); err != nil {
+		return index, err
+	}
+	err := reader.ListNetworks(ctx, func(network models.Network) error {
+		index.networks[network.Key()] = true
+		return nil
+	}, scope)
+	return index, err
+}
+
+// absent returns the keys of ids absent from keys
+func absent(keys map[string]bool, ids ...models.ResourceIdentifier) []string {
+	var keysOfAbsent []string
+	for _, id := range ids {
+		if !keys[id.Key()] {
+			keysOfAbsent = append(keysOfAbsent, id.Key())
+		}
+	}
+	return keysOfAbsent
+}
+
+// missing joins the sorted keys of missing dependencies into one state string
+func missing(absentKeys ...[]string) string {
+	var keys []string
+	for _, group := range absentKeys {
+		keys = append(keys, group...)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// namespacedRef returns the identifier of a referenced namespaced object, an empty reference
+// namespace means the namespace of the referencing object
+func namespacedRef(ref v1beta1.NamespacedObjectReference, ownerNamespace string) models.ResourceIdentifier {
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = ownerNamespace
+	}
+	return models.NewResourceIdentifier(ref.Name, models.WithNamespace(namespace))
+}
+
+// addressGroupRef returns the identifier of a referenced address group, global address
+// groups have no namespace
+func addressGroupRef(ref v1beta1.NamespacedObjectReference) models.ResourceIdentifier {
+	return models.NewResourceIdentifier(ref.Name, models.WithNamespace(ref.Namespace))
+}
+
+// revalidationCandidate is a stored resource with the state of its dependencies
+type revalidationCandidate struct {
+	key string // kind/namespace/name
+	// missing are the keys of the missing dependencies
+	missing string
+	ready   bool
+	process func(ctx context.Context) error
+}
+
+// isStale reports whether the conditions of a resource must be processed again: a Ready
+// resource lost a dependency, or its dependencies changed since the previous pass.
+// Resources first seen by the pass are compared from the next one.
+func isStale(previous map[string]string, candidate revalidationCandidate) bool {
+	if candidate.ready && candidate.missing != "" {
+		return true
+	}
+	missing, seen := previous[candidate.key]
+	return seen && missing != candidate.missing
+}
+
+// revalidationCandidates lists the resources referencing other resources
+func (cm *ConditionManager) revalidationCandidates(ctx context.Context, reader ports.Reader, index dependencyIndex) ([]revalidationCandidate, error) {
+	var candidates []revalidationCandidate
+	scope := ports.EmptyScope{}
+
+	if err := reader.ListServices(ctx, func(service models.Service) error {
+		ids := make([]models.ResourceIdentifier, 0, len(service.AddressGroups))
+		for _, ref := range service.AddressGroups {
+			ids = append(ids, addressGroupRef(ref))
+		}
+		candidates = append(candidates, revalidationCandidate{
+			key:     "Service/" + service.Key(),
+			missing: missing(absent(index.addressGroups, ids...)),
+			ready:   service.Meta.IsReady(),
+			process: func(ctx context.Context) error {
+				base := snapshotConditions(&service.Meta)
+				if err := cm.ProcessServiceConditions(ctx, &service); err != nil {
+					return err
+				}
+				// Failed checks return without saving, the batch keeps one update per resource
+				cm.batchConditionUpdate("Service", &service, base)
+				return nil
+			},
+		})
+		return nil
+	}, scope); err != nil {
+		return nil, err
+	}
+
+	if err := reader.ListServiceAliases(ctx, func(alias models.ServiceAlias) error {
+		candidates = append(candidates, revalidationCandidate{
+			key:     "ServiceAlias/" + alias.Key(),
+			missing: missing(absent(index.services, namespacedRef(alias.ServiceRef, alias.Namespace))),
+			ready:   alias.Meta.IsReady(),
+			process: func(ctx context.Context) error {
+				base := snapshotConditions(&alias.Meta)
+				if err := cm.ProcessServiceAliasConditions(ctx, &alias); err != nil {
+					return err
+				}
+				return cm.saveServiceAliasConditions(ctx, &alias, base)
+			},
+		})
+		return nil
+	}, scope); err != nil {
+		return nil, err
+	}
+
+	if err := reader.ListAddressGroupBindings(ctx, func(binding models.AddressGroupBinding) error {
+		missingKeys := missing(absent(index.services, namespacedRef(binding.ServiceRef, binding.Namespace)),
+			absent(index.addressGroups, addressGroupRef(binding.AddressGroupRef)))
+		candidates = append(candidates, revalidationCandidate{
+			key:     "AddressGroupBinding/" + binding.Key(),
+			missing: missingKeys,
+			ready:   binding.Meta.IsReady(),
+			process: func(ctx context.Context) error {
+				base := snapshotConditions(&binding.Meta)
+				if err := cm.ProcessAddressGroupBindingConditions(ctx, &binding); err != nil {
+					return err
+				}
+				return cm.saveAddressGroupBindingConditions(ctx, &binding, base)
+			},
+		})
+		return nil
+	}, scope); err != nil {
+		return nil, err
+	}
+
+	if err := reader.ListAddressGroupBindingPolicies(ctx, func(policy models.AddressGroupBindingPolicy) error {
+		missingKeys := missing(absent(index.services, namespacedRef(policy.ServiceRef, policy.Namespace)),
+			absent(index.addressGroups, addressGroupRef(policy.AddressGroupRef)))
+		candidates = append(candidates, revalidationCandidate{
+			key:     "AddressGroupBindingPolicy/" + policy.Key(),
+			missing: missingKeys,
+			ready:   policy.Meta.IsReady(),
+			process: func(ctx context.Context) error {
+				base := snapshotConditions(&policy.Meta)
+				if err := cm.ProcessAddressGroupBindingPolicyConditions(ctx, &policy); err != nil {
+					return err
+				}
+				return cm.saveAddressGroupBindingPolicyConditions(ctx, &policy, base)
+			},
+		})
+		return nil
+	}, scope); err != nil {
+		return nil, err
+	}
+
+	if err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		missingKeys := missing(absent(index.services,
+			namespacedRef(rule.ServiceLocalRef, rule.Namespace), namespacedRef(rule.ServiceRef, rule.Namespace)))
+		candidates = append(candidates, revalidationCandidate{
+			key:     "RuleS2S/" + rule.Key(),
+			missing: missingKeys,
+			ready:   rule.Meta.IsReady(),
+			process: func(ctx context.Context) error {
+				base := snapshotConditions(&rule.Meta)
+				if err := cm.ProcessRuleS2SConditions(ctx, &rule); err != nil {
+					return err
+				}
+				cm.batchConditionUpdate("RuleS2S", &rule, base)
+				return nil
+			},
+		})
+		return nil
+	}, scope); err != nil {
+		return nil, err
+	}
+
+	err := reader.ListNetworkBindings(ctx, func(binding models.NetworkBinding) error {
+		// Networks and address groups of a binding are in its namespace
+		network := models.NewResourceIdentifier(binding.NetworkRef.Name, models.WithNamespace(binding.Namespace))
+		ag := models.NewResourceIdentifier(binding.AddressGroupRef.Name, models.WithNamespace(binding.Namespace))
+		candidates = append(candidates, revalidationCandidate{
+			key:     "NetworkBinding/" + binding.Key(),
+			missing: missing(absent(index.networks, network), absent(index.addressGroups, ag)),
+			ready:   binding.Meta.IsReady(),
+			process: func(ctx context.Context) error {
+				base := snapshotConditions(&binding.Meta)
+				if err := cm.ProcessNetworkBindingConditions(ctx, &binding); err != nil {
+					return err
+				}
+				return cm.saveNetworkBindingConditions(ctx, &binding, base)
+			},
+		})
+		return nil
+	}, scope)
+	return candidates, err
+}
+
+// RevalidateStaleConditions processes again the conditions of resources whose dependencies
+// changed without a write of the resource itself, e.g. a referenced Service deleted
+// out-of-band or created after the resource. The dependency states are compared with the
+// previous pass. It returns the number of processed resources.
+func (cm *ConditionManager) RevalidateStaleConditions(ctx context.Context) (int, error) {
+	reader, err := cm.registry.PrimaryReader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	index, err := loadDependencyIndex(ctx, reader)
+	if err != nil {
+		reader.Close()
+		return 0, err
+	}
+	candidates, err := cm.revalidationCandidates(ctx, reader, index)
+	// Process* open their own readers
+	reader.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	cm.revalidationMutex.Lock()
+	defer cm.revalidationMutex.Unlock()
+
+	states := make(map[string]string, len(candidates))
+	processed := 0
+	for _, candidate := range candidates {
+		states[candidate.key] = candidate.missing
+		if !isStale(cm.dependencyStates, candidate) {
+			continue
+		}
+		klog.Infof("🔁 REVALIDATION: Dependencies of %s changed (missing: %q), re-processing conditions", candidate.key, candidate.missing)
+		if err := candidate.process(ctx); err != nil {
+			klog.Errorf("❌ REVALIDATION: Failed to re-process conditions of %s: %v", candidate.key, err)
+			continue
+		}
+		processed++
+	}
+	// Deleted resources are forgotten
+	cm.dependencyStates = states
+	return processed, nil
+}
+
+// RunRevalidation periodically processes again the conditions of resources whose
+// dependencies changed, so Validated and Ready follow out-of-band changes instead of only
+// changing on writes of the resource
+func (f *NetguardFacade) RunRevalidation(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// RuleS2S conditions generate and remove IEAgAg rules
+			f.ruleS2SMutex.Lock()
+			processed, err := f.conditionManager.RevalidateStaleConditions(ctx)
+			f.ruleS2SMutex.Unlock()
+			if err != nil {
+				klog.Errorf("❌ REVALIDATION: Failed to re-validate resource conditions: %v", err)
+				continue
+			}
+			if processed > 0 {
+				klog.Infof("✅ REVALIDATION: Re-processed conditions of %d resources", processed)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"testing"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func TestMissingDependencies(t *testing.T) {
+	keys := map[string]bool{"app/web": true}
+	web := models.NewResourceIdentifier("web", models.WithNamespace("app"))
+	db := models.NewResourceIdentifier("db", models.WithNamespace("data"))
+	api := models.NewResourceIdentifier("api", models.WithNamespace("app"))
+
+	if got := missing(absent(keys, web)); got != "" {
+		t.Errorf("existing dependency must not be missing, got %q", got)
+	}
+	if got := missing(absent(keys, web, db), absent(keys, api)); got != "app/api,data/db" {
+		t.Errorf("missing dependencies must be sorted, got %q", got)
+	}
+
+	ref := v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "web"}}
+	if got := namespacedRef(ref, "app").Key(); got != "app/web" {
+		t.Errorf("reference with empty namespace must resolve to the owner namespace, got %q", got)
+	}
+	if got := addressGroupRef(v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "ag"}}).Key(); got != "ag" {
+		t.Errorf("address group reference with empty namespace must be global, got %q", got)
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	previous := map[string]string{"Service/app/web": "", "RuleS2S/app/rule": "app/db"}
+	tests := []struct {
+		name      string
+		candidate revalidationCandidate
+		want      bool
+	}{
+		{"ReadyLostDependency", revalidationCandidate{key: "Service/app/web", missing: "app/ag", ready: true}, true},
+		{"ReadyFirstSeenMissing", revalidationCandidate{key: "Service/app/new", missing: "app/ag", ready: true}, true},
+		{"NotReadyFirstSeen", revalidationCandidate{key: "Service/app/new", missing: "app/ag"}, false},
+		{"Unchanged", revalidationCandidate{key: "RuleS2S/app/rule", missing: "app/db"}, false},
+		{"DependencyCreated", revalidationCandidate{key: "RuleS2S/app/rule", missing: ""}, true},
+		{"ReadyComplete", revalidationCandidate{key: "Service/app/web", ready: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isStale(previous, tt.candidate); got != tt.want {
+				t.Errorf("isStale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
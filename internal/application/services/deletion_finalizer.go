@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// readyToFinalize returns the resources marked for deletion whose finalizers, except
+// models.BackendSyncFinalizer, are all removed
+func readyToFinalize(resources interface{}) []interface{} {
+	items := reflect.ValueOf(resources)
+	if items.Kind() != reflect.Slice {
+		return nil
+	}
+
+	var ready []interface{}
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		if item.Kind() != reflect.Struct {
+			return nil
+		}
+		field := item.FieldByName("Meta")
+		if !field.IsValid() {
+			return nil
+		}
+		meta, ok := field.Interface().(models.Meta)
+		if !ok {
+			return nil
+		}
+		if meta.ReadyToFinalize() {
+			// Single resource slice of the same type, each deletion is synced on its own
+			single := reflect.MakeSlice(items.Type(), 1, 1)
+			single.Index(0).Set(item)
+			ready = append(ready, single.Interface())
+		}
+	}
+	return ready
+}
+
+// finalizeDeletions deletes the synced resources ready to be finalized. The deletion runs the cascade
+// cleanup and the sgroups deletion; the resource disappears from the backend only when both complete,
+// which releases models.BackendSyncFinalizer of the Kubernetes object.
+// Failed deletions are logged and retried by RunDeletionFinalizer.
+func (f *NetguardFacade) finalizeDeletions(ctx context.Context, resources interface{}) {
+	for _, single := range readyToFinalize(resources) {
+		if err := f.syncAndPublish(ctx, models.SyncOpDelete, single); err != nil {
+			klog.Errorf("❌ FINALIZER: Failed to finalize deletion of %T: %v", single, err)
+			continue
+		}
+		klog.V(2).Infof("✅ FINALIZER: Finalized deletion of %T", single)
+	}
+}
+
+// FinalizePendingDeletions deletes all stored resources marked for deletion whose finalizers are removed
+func (f *NetguardFacade) FinalizePendingDeletions(ctx context.Context) error {
+	scope := ports.EmptyScope{}
+	listers := []func() (interface{}, error){
+		func() (interface{}, error) { return f.GetServices(ctx, scope) },
+		func() (interface{}, error) { return f.GetServiceAliases(ctx, scope) },
+		func() (interface{}, error) { return f.GetAddressGroups(ctx, scope) },
+		func() (interface{}, error) { return f.GetAddressGroupBindings(ctx, scope) },
+		func() (interface{}, error) { return f.GetAddressGroupPortMappings(ctx, scope) },
+		func() (interface{}, error) { return f.GetAddressGroupBindingPolicies(ctx, scope) },
+		func() (interface{}, error) { return f.GetRuleS2S(ctx, scope) },
+		func() (interface{}, error) { return f.GetIEAgAgRules(ctx, scope) },
+		func() (interface{}, error) { return f.GetNetworks(ctx, scope) },
+		func() (interface{}, error) { return f.GetNetworkBindings(ctx, scope) },
+		func() (interface{}, error) { return f.GetHosts(ctx, scope) },
+		func() (interface{}, error) { return f.GetHostBindings(ctx, scope) },
+	}
+
+	var firstErr error
+	for _, list := range listers {
+		resources, err := list()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		f.finalizeDeletions(ctx, resources)
+	}
+	return firstErr
+}
+
+// RunDeletionFinalizer periodically retries the deletions of resources ready to be finalized,
+// e.g. after sgroups was unavailable or the last finalizer was removed while the backend was stopped
+func (f *NetguardFacade) RunDeletionFinalizer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.FinalizePendingDeletions(ctx); err != nil {
+				klog.Errorf("❌ FINALIZER: Failed to list resources pending deletion: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/application/services/resources/testutil"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+// TestNetguardFacade_FinalizesDeletions tests that resources marked for deletion are removed
+// once only the backend finalizer is left
+func TestNetguardFacade_FinalizesDeletions(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+	facade := NewNetguardFacade(registry, NewConditionManager(registry), testutil.NewMockSyncManager())
+
+	service := testutil.CreateTestService("finalized-service", "test-namespace")
+	service.Meta.Finalizers = []string{models.BackendSyncFinalizer, "example.com/cleanup"}
+	require.NoError(t, facade.Sync(ctx, models.SyncOpUpsert, []models.Service{service}))
+
+	// Another finalizer is pending, the deletion intent is only stored
+	service.Meta.MarkDeleting(metav1.Now())
+	require.NoError(t, facade.Sync(ctx, models.SyncOpUpsert, []models.Service{service}))
+	stored, err := facade.GetServiceByID(ctx, service.ResourceIdentifier)
+	require.NoError(t, err)
+	assert.True(t, stored.Meta.IsDeleting())
+	assert.Equal(t, []string{"example.com/cleanup"}, stored.Meta.PendingFinalizers())
+
+	// Removing the last foreign finalizer lets the backend delete the service
+	service.Meta.RemoveFinalizer("example.com/cleanup")
+	require.NoError(t, facade.Sync(ctx, models.SyncOpUpsert, []models.Service{service}))
+	services, err := facade.GetServices(ctx, ports.EmptyScope{})
+	require.NoError(t, err)
+	assert.Empty(t, services)
+}
+
+// TestNetguardFacade_FinalizePendingDeletions tests the retry of stored deletions
+func TestNetguardFacade_FinalizePendingDeletions(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+	facade := NewNetguardFacade(registry, NewConditionManager(registry), testutil.NewMockSyncManager())
+
+	kept := testutil.CreateTestService("kept-service", "test-namespace")
+	require.NoError(t, facade.Sync(ctx, models.SyncOpUpsert, []models.Service{kept}))
+
+	// Stored directly, as if the backend stopped before the deletion was finalized
+	deleting := testutil.CreateTestService("deleting-service", "test-namespace")
+	deleting.Meta.Finalizers = []string{models.BackendSyncFinalizer}
+	deleting.Meta.MarkDeleting(metav1.Now())
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{deleting}, ports.NewResourceIdentifierScope(deleting.ResourceIdentifier)))
+	require.NoError(t, writer.Commit())
+
+	require.NoError(t, facade.FinalizePendingDeletions(ctx))
+	services, err := facade.GetServices(ctx, ports.EmptyScope{})
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	assert.Equal(t, kept.ResourceIdentifier, services[0].ResourceIdentifier)
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// deletedRuleS2SUIDs returns the stored UIDs of RuleS2S about to be deleted
+func (f *NetguardFacade) deletedRuleS2SUIDs(ctx context.Context, rules []models.RuleS2S) map[string]bool {
+	ids := make([]models.ResourceIdentifier, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ResourceIdentifier)
+	}
+	stored, err := readCommitted(ctx, f.registry, ids, func(reader ports.Reader, id models.ResourceIdentifier) (*models.RuleS2S, error) {
+		return reader.GetRuleS2SByID(ctx, id)
+	})
+	if err != nil {
+		klog.Errorf("❌ IEAGAG_OWNERS: Failed to read RuleS2S about to be deleted: %v", err)
+	}
+	uids := make(map[string]bool, len(stored))
+	for _, rule := range stored {
+		if rule.Meta.UID != "" {
+			uids[rule.Meta.UID] = true
+		}
+	}
+	return uids
+}
+
+// deletedAddressGroupUIDs returns the stored UIDs of address groups about to be deleted
+func (f *NetguardFacade) deletedAddressGroupUIDs(ctx context.Context, groups []models.AddressGroup) map[string]bool {
+	ids := make([]models.ResourceIdentifier, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, group.ResourceIdentifier)
+	}
+	stored, err := readCommitted(ctx, f.registry, ids, func(reader ports.Reader, id models.ResourceIdentifier) (*models.AddressGroup, error) {
+		return reader.GetAddressGroupByID(ctx, id)
+	})
+	if err != nil {
+		klog.Errorf("❌ IEAGAG_OWNERS: Failed to read address groups about to be deleted: %v", err)
+	}
+	uids := make(map[string]bool, len(stored))
+	for _, group := range stored {
+		if group.Meta.UID != "" {
+			uids[group.Meta.UID] = true
+		}
+	}
+	return uids
+}
+
+// deleteOwnedIEAgAgRules deletes the generated IEAgAgRules left without owners, as Kubernetes GC
+// does for the owner references of the rules. Failures are only logged, the owners are deleted already.
+func (f *NetguardFacade) deleteOwnedIEAgAgRules(ctx context.Context, ownerUIDs map[string]bool) {
+	if err := f.ruleS2SResourceService.DeleteIEAgAgRulesOwnedBy(ctx, ownerUIDs); err != nil {
+		klog.Errorf("❌ IEAGAG_OWNERS: Failed to delete IEAgAg rules of deleted owners: %v", err)
+	}
+}
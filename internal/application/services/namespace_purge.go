@@ -0,0 +1,349 @@
+package services
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// ErrNamespaceRequired is returned by PurgeNamespace without a namespace
+var ErrNamespaceRequired = errors.New("namespace is required")
+
+// namespaceResources are the resources deleted by a namespace purge
+type namespaceResources struct {
+	services               []models.Service
+	serviceAliases         []models.ServiceAlias
+	addressGroups          []models.AddressGroup
+	addressGroupBindings   []models.AddressGroupBinding
+	portMappings           []models.AddressGroupPortMapping
+	bindingPolicies        []models.AddressGroupBindingPolicy
+	rules                  []models.RuleS2S
+	ruleExceptions         []models.RuleS2SException
+	ieAgAgRules            []models.IEAgAgRule
+	networks               []models.Network
+	networkBindings        []models.NetworkBinding
+	hosts                  []models.Host
+	hostBindings           []models.HostBinding
+	crossNamespacePolicies []models.CrossNamespacePolicy
+	ruleTemplates          []models.RuleTemplate
+	namespacePostures      []models.NamespacePosture
+}
+
+// PurgeNamespace deletes all netguard resources of a namespace in one transaction, for the
+// namespace deletion controller. IEAgAgRules generated in the namespace or for its address
+// groups are deleted with them. A purge fails without deleting anything while resources of
+// other namespaces reference services or address groups of the namespace. After the commit
+// the deletions are synced to sgroups and IEAgAgRules aggregated from the deleted RuleS2S in
+// other namespaces are recalculated. Purging a namespace without resources is a no-op.
+func (f *NetguardFacade) PurgeNamespace(ctx context.Context, namespace string) (models.NamespacePurgeResult, error) {
+	if namespace == "" {
+		return models.NamespacePurgeResult{}, ErrNamespaceRequired
+	}
+
+	f.ruleS2SMutex.Lock()
+	defer f.ruleS2SMutex.Unlock()
+
+	klog.Infof("🗑️ NAMESPACE_PURGE: Purging namespace %s", namespace)
+
+	var purged namespaceResources
+	err := ports.Write(ctx, f.registry, ports.DeleteWriterOptions, func(writer ports.Writer) error {
+		reader, err := f.registry.ReaderFromWriter(ctx, writer)
+		if err != nil {
+			return errors.Wrap(err, "failed to get reader")
+		}
+		defer reader.Close()
+
+		if err := checkNamespaceDependents(ctx, reader, namespace); err != nil {
+			return err
+		}
+		if purged, err = listNamespaceResources(ctx, reader, namespace); err != nil {
+			return err
+		}
+		if err := purged.delete(ctx, writer); err != nil {
+			return err
+		}
+		return f.addressGroupResourceService.EnqueueSGroupsDeletion(ctx, writer, purged.addressGroups)
+	})
+	if err != nil {
+		return models.NamespacePurgeResult{}, errors.Wrapf(err, "failed to purge namespace %s", namespace)
+	}
+
+	result := purged.result(namespace)
+	if result.Total() == 0 {
+		klog.Infof("✅ NAMESPACE_PURGE: Namespace %s has no resources", namespace)
+		return result, nil
+	}
+
+	f.syncPurgedResources(ctx, purged)
+	f.networkResourceService.ReleaseDeletedNetworks(ctx, purged.networks)
+	f.publishPurgedResources(ctx, purged)
+
+	ruleIDs := make([]models.ResourceIdentifier, 0, len(purged.rules))
+	for _, rule := range purged.rules {
+		ruleIDs = append(ruleIDs, rule.ResourceIdentifier)
+	}
+	if err := f.ruleS2SResourceService.RecalculateIEAgAgRulesOfDeletedRuleS2S(ctx, ruleIDs, "namespace-purge-"+namespace); err != nil {
+		klog.Errorf("❌ NAMESPACE_PURGE: Failed to recalculate IEAgAgRules of RuleS2S deleted from namespace %s: %v", namespace, err)
+	}
+	f.reconcileRuleTemplates(ctx)
+	f.reconcileNamespacePostures(ctx)
+
+	klog.Infof("✅ NAMESPACE_PURGE: Purged namespace %s, deleted %d resources %v", namespace, result.Total(), result.Deleted)
+	return result, nil
+}
+
+// checkNamespaceDependents fails with a DependencyExistsError when resources of other
+// namespaces reference services or address groups of namespace. An empty reference
+// namespace means the namespace of the referencing resource.
+func checkNamespaceDependents(ctx context.Context, reader ports.Reader, namespace string) error {
+	refersTo := func(ref v1beta1.NamespacedObjectReference, owner string) bool {
+		if ref.Namespace == "" {
+			return owner == namespace
+		}
+		return ref.Namespace == namespace
+	}
+	dependent := func(dependencyType string, key string) error {
+		return errors.Wrapf(validation.NewDependencyExistsError("namespace", namespace, dependencyType), "%s references resources of the namespace", key)
+	}
+
+	err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		if rule.Namespace != namespace && (refersTo(rule.ServiceLocalRef, rule.Namespace) || refersTo(rule.ServiceRef, rule.Namespace)) {
+			return dependent("rule_s2s", rule.Key())
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return err
+	}
+
+	err = reader.ListServiceAliases(ctx, func(alias models.ServiceAlias) error {
+		if alias.Namespace != namespace && refersTo(alias.ServiceRef, alias.Namespace) {
+			return dependent("service_alias", alias.Key())
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return err
+	}
+
+	return reader.ListAddressGroupBindings(ctx, func(binding models.AddressGroupBinding) error {
+		if binding.Namespace != namespace && (refersTo(binding.ServiceRef, binding.Namespace) || refersTo(binding.AddressGroupRef, binding.Namespace)) {
+			return dependent("address_group_binding", binding.Key())
+		}
+		return nil
+	}, ports.EmptyScope{})
+}
+
+// listNamespaceResources lists the resources of namespace and the IEAgAgRules of other
+// namespaces generated for its address groups
+func listNamespaceResources(ctx context.Context, reader ports.Reader, namespace string) (namespaceResources, error) {
+	var purged namespaceResources
+	var err error
+	if purged.services, err = listInNamespace(ctx, reader.ListServices, namespace, func(r models.Service) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.serviceAliases, err = listInNamespace(ctx, reader.ListServiceAliases, namespace, func(r models.ServiceAlias) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.addressGroups, err = listInNamespace(ctx, reader.ListAddressGroups, namespace, func(r models.AddressGroup) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.addressGroupBindings, err = listInNamespace(ctx, reader.ListAddressGroupBindings, namespace, func(r models.AddressGroupBinding) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.portMappings, err = listInNamespace(ctx, reader.ListAddressGroupPortMappings, namespace, func(r models.AddressGroupPortMapping) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.bindingPolicies, err = listInNamespace(ctx, reader.ListAddressGroupBindingPolicies, namespace, func(r models.AddressGroupBindingPolicy) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.rules, err = listInNamespace(ctx, reader.ListRuleS2S, namespace, func(r models.RuleS2S) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.ruleExceptions, err = listInNamespace(ctx, reader.ListRuleS2SExceptions, namespace, func(r models.RuleS2SException) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.networks, err = listInNamespace(ctx, reader.ListNetworks, namespace, func(r models.Network) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.networkBindings, err = listInNamespace(ctx, reader.ListNetworkBindings, namespace, func(r models.NetworkBinding) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.hosts, err = listInNamespace(ctx, reader.ListHosts, namespace, func(r models.Host) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.hostBindings, err = listInNamespace(ctx, reader.ListHostBindings, namespace, func(r models.HostBinding) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.crossNamespacePolicies, err = listInNamespace(ctx, reader.ListCrossNamespacePolicies, namespace, func(r models.CrossNamespacePolicy) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.ruleTemplates, err = listInNamespace(ctx, reader.ListRuleTemplates, namespace, func(r models.RuleTemplate) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+	if purged.namespacePostures, err = listInNamespace(ctx, reader.ListNamespacePostures, namespace, func(r models.NamespacePosture) string { return r.Namespace }); err != nil {
+		return purged, err
+	}
+
+	// IEAgAgRules of other namespaces referencing address groups of the namespace are
+	// generated for them, they are not valid without the address groups
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		if rule.Namespace == namespace || rule.AddressGroupLocal.Namespace == namespace || rule.AddressGroup.Namespace == namespace {
+			purged.ieAgAgRules = append(purged.ieAgAgRules, rule)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return purged, errors.Wrap(err, "failed to list IEAgAgRules")
+	}
+	return purged, nil
+}
+
+// listInNamespace lists the resources of a namespace. Resources are filtered by their
+// namespace once more, a reader ignoring the namespace scope doesn't purge other namespaces.
+func listInNamespace[T any](ctx context.Context, list func(context.Context, func(T) error, ports.Scope) error, namespace string, namespaceOf func(T) string) ([]T, error) {
+	var resources []T
+	err := list(ctx, func(resource T) error {
+		if namespaceOf(resource) == namespace {
+			resources = append(resources, resource)
+		}
+		return nil
+	}, ports.NewResourceIdentifierScope(models.ResourceIdentifier{Namespace: namespace}))
+	if err != nil {
+		var zero T
+		return nil, errors.Wrapf(err, "failed to list %T", zero)
+	}
+	return resources, nil
+}
+
+// delete deletes the resources, generated and dependent resources first
+func (p namespaceResources) delete(ctx context.Context, writer ports.Writer) error {
+	deletes := []struct {
+		kind   string
+		ids    []models.ResourceIdentifier
+		delete func(context.Context, []models.ResourceIdentifier, ...ports.Option) error
+	}{
+		{"IEAgAgRules", identifiersOf(p.ieAgAgRules), writer.DeleteIEAgAgRulesByIDs},
+		{"RuleS2SExceptions", identifiersOf(p.ruleExceptions), writer.DeleteRuleS2SExceptionsByIDs},
+		{"RuleS2S", identifiersOf(p.rules), writer.DeleteRuleS2SByIDs},
+		{"HostBindings", identifiersOf(p.hostBindings), writer.DeleteHostBindingsByIDs},
+		{"NetworkBindings", identifiersOf(p.networkBindings), writer.DeleteNetworkBindingsByIDs},
+		{"AddressGroupBindings", identifiersOf(p.addressGroupBindings), writer.DeleteAddressGroupBindingsByIDs},
+		{"AddressGroupBindingPolicies", identifiersOf(p.bindingPolicies), writer.DeleteAddressGroupBindingPoliciesByIDs},
+		{"AddressGroupPortMappings", identifiersOf(p.portMappings), writer.DeleteAddressGroupPortMappingsByIDs},
+		{"ServiceAliases", identifiersOf(p.serviceAliases), writer.DeleteServiceAliasesByIDs},
+		{"Services", identifiersOf(p.services), writer.DeleteServicesByIDs},
+		{"Hosts", identifiersOf(p.hosts), writer.DeleteHostsByIDs},
+		{"Networks", identifiersOf(p.networks), writer.DeleteNetworksByIDs},
+		{"AddressGroups", identifiersOf(p.addressGroups), writer.DeleteAddressGroupsByIDs},
+		{"RuleTemplates", identifiersOf(p.ruleTemplates), writer.DeleteRuleTemplatesByIDs},
+		{"NamespacePostures", identifiersOf(p.namespacePostures), writer.DeleteNamespacePosturesByIDs},
+		{"CrossNamespacePolicies", identifiersOf(p.crossNamespacePolicies), writer.DeleteCrossNamespacePoliciesByIDs},
+	}
+	for _, d := range deletes {
+		if len(d.ids) == 0 {
+			continue
+		}
+		if err := d.delete(ctx, d.ids); err != nil {
+			return errors.Wrapf(err, "failed to delete %d %s", len(d.ids), d.kind)
+		}
+	}
+	return nil
+}
+
+// all returns the slices of the purged resources
+func (p namespaceResources) all() []interface{} {
+	return []interface{}{
+		p.services, p.serviceAliases, p.addressGroups, p.addressGroupBindings, p.portMappings,
+		p.bindingPolicies, p.rules, p.ruleExceptions, p.ieAgAgRules, p.networks, p.networkBindings,
+		p.hosts, p.hostBindings, p.crossNamespacePolicies, p.ruleTemplates, p.namespacePostures,
+	}
+}
+
+// result counts the purged resources per kind
+func (p namespaceResources) result(namespace string) models.NamespacePurgeResult {
+	result := models.NamespacePurgeResult{Namespace: namespace, Deleted: make(map[string]int)}
+	for _, resources := range p.all() {
+		items := reflect.ValueOf(resources)
+		if items.Len() > 0 {
+			result.Deleted[items.Type().Elem().Name()] = items.Len()
+		}
+	}
+	return result
+}
+
+// syncPurgedResources syncs committed deletions of a purge with sgroups, rules first so
+// sgroups doesn't keep rules of deleted address groups
+func (f *NetguardFacade) syncPurgedResources(ctx context.Context, p namespaceResources) {
+	if f.syncManager != nil {
+		batches := []struct {
+			kind     string
+			entities []interfaces.SyncableEntity
+		}{
+			{"IEAgAgRules", syncableOf(p.ieAgAgRules)},
+			{"HostBindings", syncableOf(p.hostBindings)},
+			{"NetworkBindings", syncableOf(p.networkBindings)},
+			{"Hosts", syncableOf(p.hosts)},
+			{"Networks", syncableOf(p.networks)},
+		}
+		for _, batch := range batches {
+			if len(batch.entities) == 0 {
+				continue
+			}
+			if err := f.syncManager.SyncBatch(ctx, batch.entities, types.SyncOperationDelete); err != nil {
+				klog.Errorf("❌ NAMESPACE_PURGE: Failed to sync deletion of %d %s with sgroups: %v", len(batch.entities), batch.kind, err)
+			}
+		}
+	}
+	f.addressGroupResourceService.SyncDeletedAddressGroups(ctx, p.addressGroups)
+}
+
+// publishPurgedResources publishes domain events, change feed events and resource changes
+// of committed deletions of a purge
+func (f *NetguardFacade) publishPurgedResources(ctx context.Context, p namespaceResources) {
+	f.publishServiceChanges(ctx, models.SyncOpDelete, p.services)
+	f.publishAddressGroupChanges(ctx, models.SyncOpDelete, p.addressGroups)
+	f.publishRuleS2SChanges(ctx, models.SyncOpDelete, p.rules)
+
+	for _, resources := range p.all() {
+		f.publishSyncEvents(models.SyncOpDelete, resources)
+		if f.changeData == nil {
+			continue
+		}
+		items := reflect.ValueOf(resources)
+		for i := 0; i < items.Len(); i++ {
+			item := items.Index(i)
+			id := item.FieldByName("ResourceIdentifier").Interface().(models.ResourceIdentifier)
+			f.changeData.PublishChange(models.NewResourceChange(item.Type().Name(), id, item.Interface(), nil))
+		}
+	}
+}
+
+// identifiersOf returns the identifiers of resources
+func identifiersOf[T any](resources []T) []models.ResourceIdentifier {
+	ids := make([]models.ResourceIdentifier, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, reflect.ValueOf(resource).FieldByName("ResourceIdentifier").Interface().(models.ResourceIdentifier))
+	}
+	return ids
+}
+
+// syncableOf returns the resources synced with sgroups
+func syncableOf[T any](resources []T) []interfaces.SyncableEntity {
+	entities := make([]interfaces.SyncableEntity, 0, len(resources))
+	for i := range resources {
+		if entity, ok := any(&resources[i]).(interfaces.SyncableEntity); ok {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
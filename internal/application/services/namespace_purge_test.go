@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/application/services/resources/testutil"
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+// seedPurgeRegistry stores resources of the namespaces team-a and team-b, the IEAgAgRule
+// cross of team-b references an address group of team-a
+func seedPurgeRegistry(t *testing.T, rules ...models.RuleS2S) ports.Registry {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+
+	crossRule := testutil.CreateTestIEAgAgRule("cross", "team-b")
+	crossRule.AddressGroup = models.NewAddressGroupRef("frontend", models.WithNamespace("team-a"))
+
+	upsert := ports.WithSyncOp(models.SyncOpUpsert)
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{
+		testutil.CreateTestService("web", "team-a"),
+		testutil.CreateTestService("api", "team-b"),
+	}, ports.EmptyScope{}, upsert))
+	require.NoError(t, writer.SyncServiceAliases(ctx, []models.ServiceAlias{
+		testutil.CreateTestServiceAlias("web-alias", "team-a", "web"),
+	}, ports.EmptyScope{}, upsert))
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{
+		testutil.CreateTestAddressGroup("frontend", "team-a"),
+		testutil.CreateTestAddressGroup("backend", "team-b"),
+	}, ports.EmptyScope{}, upsert))
+	require.NoError(t, writer.SyncIEAgAgRules(ctx, []models.IEAgAgRule{
+		testutil.CreateTestIEAgAgRule("local", "team-a"),
+		crossRule,
+	}, ports.EmptyScope{}, upsert))
+	require.NoError(t, writer.SyncNetworks(ctx, []models.Network{
+		testutil.CreateTestNetwork("office", "team-a", "10.0.0.0/24"),
+	}, ports.EmptyScope{}, upsert))
+	if len(rules) > 0 {
+		require.NoError(t, writer.SyncRuleS2S(ctx, rules, ports.EmptyScope{}, upsert))
+	}
+	require.NoError(t, writer.Commit())
+	return registry
+}
+
+func TestNetguardFacade_PurgeNamespace(t *testing.T) {
+	ctx := context.Background()
+	id := models.NewResourceIdentifier
+
+	t.Run("DeletesNamespaceResources", func(t *testing.T) {
+		registry := seedPurgeRegistry(t, testutil.CreateTestRuleS2S("web-to-db", "team-a"))
+		facade := NewNetguardFacade(registry, NewConditionManager(registry), testutil.NewMockSyncManager())
+		publisher := &recordingPublisher{}
+		facade.SetEventPublisher(publisher)
+
+		result, err := facade.PurgeNamespace(ctx, "team-a")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{
+			"Service":      1,
+			"ServiceAlias": 1,
+			"AddressGroup": 1,
+			"RuleS2S":      1,
+			"IEAgAgRule":   2,
+			"Network":      1,
+		}, result.Deleted)
+		assert.Len(t, publisher.events, result.Total())
+
+		reader, err := registry.Reader(ctx)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		_, err = reader.GetServiceByID(ctx, id("web", models.WithNamespace("team-a")))
+		assert.ErrorIs(t, err, ports.ErrNotFound)
+		_, err = reader.GetIEAgAgRuleByID(ctx, id("cross", models.WithNamespace("team-b")))
+		assert.ErrorIs(t, err, ports.ErrNotFound)
+		_, err = reader.GetServiceByID(ctx, id("api", models.WithNamespace("team-b")))
+		assert.NoError(t, err)
+		_, err = reader.GetAddressGroupByID(ctx, id("backend", models.WithNamespace("team-b")))
+		assert.NoError(t, err)
+
+		// A purged namespace has nothing left to delete
+		result, err = facade.PurgeNamespace(ctx, "team-a")
+		require.NoError(t, err)
+		assert.Zero(t, result.Total())
+	})
+
+	t.Run("ReferencedFromOtherNamespace", func(t *testing.T) {
+		rule := testutil.CreateTestRuleS2S("to-web", "team-b")
+		rule.ServiceRef = models.NewServiceRef("web", models.WithNamespace("team-a"))
+		registry := seedPurgeRegistry(t, rule)
+		facade := NewNetguardFacade(registry, NewConditionManager(registry), testutil.NewMockSyncManager())
+
+		_, err := facade.PurgeNamespace(ctx, "team-a")
+		var dependency *validation.DependencyExistsError
+		require.ErrorAs(t, err, &dependency)
+		assert.Equal(t, "rule_s2s", dependency.DependencyType)
+
+		// Nothing is deleted by a failed purge
+		reader, err := registry.Reader(ctx)
+		require.NoError(t, err)
+		defer reader.Close()
+		_, err = reader.GetServiceByID(ctx, id("web", models.WithNamespace("team-a")))
+		assert.NoError(t, err)
+	})
+
+	t.Run("NamespaceRequired", func(t *testing.T) {
+		facade := NewNetguardFacade(mem.NewRegistry(), nil, testutil.NewMockSyncManager())
+		_, err := facade.PurgeNamespace(ctx, "")
+		assert.ErrorIs(t, err, ErrNamespaceRequired)
+	})
+}
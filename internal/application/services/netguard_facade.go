@@ -3,17 +3,30 @@ package services
 import (
 	"context"
 	"fmt"
-	"sync"
+	"io"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 
+	"netguard-pg-backend/internal/application/admission"
 	"netguard-pg-backend/internal/application/services/resources"
 	"netguard-pg-backend/internal/application/utils"
+	"netguard-pg-backend/internal/application/validation"
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/logging"
 	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// Loggers of the background jobs and sgroups synchronization driven by the facade
+var (
+	rulesLog = logging.For(logging.SubsystemRules)
+	syncLog  = logging.For(logging.SubsystemSync)
 )
 
 // NetguardFacade provides a unified interface that coordinates all resource services
@@ -39,9 +52,43 @@ type NetguardFacade struct {
 	conditionManager *ConditionManager
 	syncManager      interfaces.SyncManager
 
+	// changeFeed delivers committed Service/AddressGroup changes to Watch subscribers
+	changeFeed *ChangeFeed
+
+	// conditionHistory stores condition status transitions (nil - not recorded)
+	conditionHistory ports.ConditionHistory
+
+	// events receives domain events of committed changes (nil - not published)
+	events ports.EventPublisher
+
+	// changeData streams committed changes with before/after snapshots (nil - disabled)
+	changeData ports.ResourceChangePublisher
+
+	// resourceValidator validates requested writes before they are applied (nil - disabled)
+	resourceValidator ports.ResourceValidator
+
+	// admission throttles bulk operations in favour of interactive ones (nil - disabled)
+	admission *admission.Controller
+
+	// syncDeadLetters keeps permanently failed sgroups syncs (nil - disabled)
+	syncDeadLetters ports.SyncDeadLetterQueue
+
+	// quarantine keeps imported resources that failed validation (nil - disabled)
+	quarantine          ports.Quarantine
+	quarantinePromoters map[string]QuarantinePromoter
+
+	// startupReport is set once the backend has started (nil - still starting)
+	startupReport atomic.Pointer[models.StartupReport]
+
+	// reverseSyncStatus reports reverse synchronization statistics (nil - disabled)
+	reverseSyncStatus func() (interfaces.ReverseSyncStatus, bool)
+
+	// reverseSyncController pauses, resumes and triggers reverse synchronization (nil - disabled)
+	reverseSyncController interfaces.ReverseSyncController
+
 	// 🎯 SEQUENTIAL_PROCESSING: Mutex to serialize RuleS2S operations and prevent PostgreSQL contention
 	// This eliminates database serialization conflicts during complex Cross-RuleS2S aggregation flows
-	ruleS2SMutex sync.Mutex
+	ruleS2SMutex sequentialLock
 }
 
 // ConditionManager is imported from condition_manager.go - no redeclaration needed
@@ -90,6 +137,7 @@ func NewNetguardFacade(
 		registry:                      registry,
 		conditionManager:              conditionManager,
 		syncManager:                   syncManager,
+		changeFeed:                    NewChangeFeed(),
 	}
 
 	// Inject the RuleS2S service into ConditionManager for IEAgAg generation and cleanup
@@ -105,6 +153,9 @@ func NewNetguardFacade(
 		// This extends deadlock prevention to condition batching operations
 		conditionManager.SetSequentialMutex(&facade.ruleS2SMutex)
 		klog.Infof("🔒 DEADLOCK_FIX: Injected sequential processing mutex into ConditionManager")
+
+		// Condition writes change Service/AddressGroup status served from the watch cache
+		conditionManager.SetChangeFeed(facade.changeFeed)
 	}
 
 	// Wire up service dependencies to avoid circular imports
@@ -140,19 +191,40 @@ func (f *NetguardFacade) GetServicesByIDs(ctx context.Context, ids []models.Reso
 }
 
 func (f *NetguardFacade) CreateService(ctx context.Context, service models.Service) error {
-	return f.serviceResourceService.CreateService(ctx, service)
+	if err := f.serviceResourceService.CreateService(ctx, service); err != nil {
+		return err
+	}
+	f.reconcileRuleTemplates(ctx)
+	return nil
 }
 
 func (f *NetguardFacade) UpdateService(ctx context.Context, service models.Service) error {
-	return f.serviceResourceService.UpdateService(ctx, service)
+	if err := f.serviceResourceService.UpdateService(ctx, service); err != nil {
+		return err
+	}
+	f.reconcileRuleTemplates(ctx)
+	return nil
 }
 
 func (f *NetguardFacade) SyncServices(ctx context.Context, services []models.Service, scope ports.Scope) error {
-	return f.serviceResourceService.SyncServices(ctx, services, scope, models.SyncOpUpsert)
+	if err := f.serviceResourceService.SyncServices(ctx, services, scope, models.SyncOpUpsert); err != nil {
+		return err
+	}
+	f.reconcileRuleTemplates(ctx)
+	return nil
 }
 
 func (f *NetguardFacade) DeleteServicesByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
-	return f.serviceResourceService.DeleteServicesByIDs(ctx, ids)
+	if err := f.ruleS2SResourceService.DeleteRuleTemplateRuleS2SForServices(ctx, ids); err != nil {
+		return errors.Wrap(err, "failed to delete generated RuleS2S of deleted services")
+	}
+	if err := f.serviceResourceService.DeleteServicesByIDs(ctx, ids); err != nil {
+		// Restore generated RuleS2S of services that were not deleted
+		f.reconcileRuleTemplates(ctx)
+		return err
+	}
+	f.reconcileRuleTemplates(ctx)
+	return nil
 }
 
 // ServiceAlias operations
@@ -200,20 +272,47 @@ func (f *NetguardFacade) GetAddressGroupsByIDs(ctx context.Context, ids []models
 	return f.addressGroupResourceService.GetAddressGroupsByIDs(ctx, ids)
 }
 
+// AnalyzeAddressGroupImpact reports what would change if the address group is updated
+// to proposed (or deleted when proposed is nil)
+func (f *NetguardFacade) AnalyzeAddressGroupImpact(ctx context.Context, id models.ResourceIdentifier, proposed *models.AddressGroup) (*models.AddressGroupImpact, error) {
+	return f.addressGroupResourceService.AnalyzeAddressGroupImpact(ctx, id, proposed)
+}
+
 func (f *NetguardFacade) CreateAddressGroup(ctx context.Context, addressGroup models.AddressGroup) error {
-	return f.addressGroupResourceService.CreateAddressGroup(ctx, addressGroup)
+	if err := f.addressGroupResourceService.CreateAddressGroup(ctx, addressGroup); err != nil {
+		return err
+	}
+	f.reconcileNamespacePostures(ctx)
+	return nil
 }
 
 func (f *NetguardFacade) UpdateAddressGroup(ctx context.Context, addressGroup models.AddressGroup) error {
-	return f.addressGroupResourceService.UpdateAddressGroup(ctx, addressGroup)
+	if err := f.addressGroupResourceService.UpdateAddressGroup(ctx, addressGroup); err != nil {
+		return err
+	}
+	f.reconcileNamespacePostures(ctx)
+	return nil
 }
 
 func (f *NetguardFacade) SyncAddressGroups(ctx context.Context, addressGroups []models.AddressGroup, scope ports.Scope) error {
-	return f.addressGroupResourceService.SyncAddressGroups(ctx, addressGroups, scope, models.SyncOpUpsert)
+	if err := f.addressGroupResourceService.SyncAddressGroups(ctx, addressGroups, scope, models.SyncOpUpsert); err != nil {
+		return err
+	}
+	f.reconcileNamespacePostures(ctx)
+	return nil
 }
 
 func (f *NetguardFacade) DeleteAddressGroupsByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
-	return f.addressGroupResourceService.DeleteAddressGroupsByIDs(ctx, ids)
+	if err := f.ruleS2SResourceService.DeleteNamespacePostureRulesForAddressGroups(ctx, ids); err != nil {
+		return errors.Wrap(err, "failed to delete baseline deny rules of deleted address groups")
+	}
+	if err := f.addressGroupResourceService.DeleteAddressGroupsByIDs(ctx, ids); err != nil {
+		// Restore baseline deny rules of address groups that were not deleted
+		f.reconcileNamespacePostures(ctx)
+		return err
+	}
+	f.reconcileNamespacePostures(ctx)
+	return nil
 }
 
 // AddressGroupBinding operations
@@ -237,6 +336,7 @@ func (f *NetguardFacade) CreateAddressGroupBinding(ctx context.Context, binding
 
 	// Process conditions for related AddressGroupPortMapping after successful binding creation
 	f.processAddressGroupPortMappingConditionsAfterBinding(ctx, binding)
+	f.publishBindingServices(ctx, []models.AddressGroupBinding{binding})
 
 	return nil
 }
@@ -249,6 +349,7 @@ func (f *NetguardFacade) UpdateAddressGroupBinding(ctx context.Context, binding
 
 	// Process conditions for related AddressGroupPortMapping after successful binding update
 	f.processAddressGroupPortMappingConditionsAfterBinding(ctx, binding)
+	f.publishBindingServices(ctx, []models.AddressGroupBinding{binding})
 
 	return nil
 }
@@ -263,6 +364,7 @@ func (f *NetguardFacade) SyncAddressGroupBindings(ctx context.Context, bindings
 	for _, binding := range bindings {
 		f.processAddressGroupPortMappingConditionsAfterBinding(ctx, binding)
 	}
+	f.publishBindingServices(ctx, bindings)
 
 	return nil
 }
@@ -272,11 +374,19 @@ func (f *NetguardFacade) DeleteAddressGroupBindingsByIDs(ctx context.Context, id
 	defer f.ruleS2SMutex.Unlock()
 
 	klog.V(2).Infof("🔒 SEQUENTIAL_PROCESSING: Starting DeleteAddressGroupBindingsByIDs for %d bindings (serialized to prevent concurrent reactive cleanup)", len(ids))
+	// The bound services are resolved before the bindings are gone
+	bindings, readErr := readCommitted(ctx, f.registry, ids, func(reader ports.Reader, id models.ResourceIdentifier) (*models.AddressGroupBinding, error) {
+		return reader.GetAddressGroupBindingByID(ctx, id)
+	})
+	if readErr != nil {
+		klog.Errorf("Failed to read deleted bindings for change feed: %v", readErr)
+	}
 	err := f.addressGroupResourceService.DeleteAddressGroupBindingsByIDs(ctx, ids)
 	if err != nil {
 		klog.Errorf("❌ SEQUENTIAL_PROCESSING: DeleteAddressGroupBindingsByIDs failed for %d bindings: %v", len(ids), err)
 	} else {
 		klog.V(2).Infof("✅ SEQUENTIAL_PROCESSING: DeleteAddressGroupBindingsByIDs completed for %d bindings", len(ids))
+		f.publishBindingServices(ctx, bindings)
 	}
 	return err
 }
@@ -466,6 +576,185 @@ func (f *NetguardFacade) DeleteIEAgAgRulesByIDs(ctx context.Context, ids []model
 	return err
 }
 
+// GetRuleS2SExceptions returns all RuleS2S exceptions within scope
+func (f *NetguardFacade) GetRuleS2SExceptions(ctx context.Context, scope ports.Scope) ([]models.RuleS2SException, error) {
+	return f.ruleS2SResourceService.GetRuleS2SExceptions(ctx, scope)
+}
+
+// GetRuleS2SExceptionByID returns a RuleS2S exception by ID
+func (f *NetguardFacade) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return f.ruleS2SResourceService.GetRuleS2SExceptionByID(ctx, id)
+}
+
+// RunRuleSchedule periodically generates and removes IEAgAg rules of RuleS2S entering or
+// leaving their validFrom/validUntil window. The first check also applies transitions that
+// happened while the backend was stopped.
+func (f *NetguardFacade) RunRuleSchedule(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastCheck time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			f.ruleS2SMutex.Lock()
+			err := f.ruleS2SResourceService.ApplyValidityTransitions(ctx, lastCheck, now)
+			f.ruleS2SMutex.Unlock()
+			if err != nil {
+				// Transitions are retried on the next tick
+				rulesLog.Error(err, "Failed to apply RuleS2S validity transitions")
+				continue
+			}
+			lastCheck = now
+		}
+	}
+}
+
+// RunRuleGC periodically deletes IEAgAg rules no RuleS2S generates anymore, or only reports them
+// with dry run. Failed passes are logged and retried on the next tick.
+func (f *NetguardFacade) RunRuleGC(ctx context.Context, interval time.Duration, opts resources.RuleGCOptions) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.ruleS2SMutex.Lock()
+			_, err := f.ruleS2SResourceService.CollectOrphanedIEAgAgRules(ctx, opts)
+			f.ruleS2SMutex.Unlock()
+			if err != nil {
+				rulesLog.Error(err, "Failed to collect orphaned IEAgAg rules")
+			}
+		}
+	}
+}
+
+// RebuildIEAgAgRuleIndex builds the aggregation index of IEAgAg rules unless it is already stored,
+// until then RuleS2S deletions and service changes recalculate rules from all RuleS2S
+func (f *NetguardFacade) RebuildIEAgAgRuleIndex(ctx context.Context) error {
+	f.ruleS2SMutex.Lock()
+	defer f.ruleS2SMutex.Unlock()
+	return f.ruleS2SResourceService.RebuildIEAgAgRuleContributionIndex(ctx)
+}
+
+// SetConditionHistory records condition status transitions of all resources in the history
+func (f *NetguardFacade) SetConditionHistory(history ports.ConditionHistory) {
+	f.conditionHistory = history
+	f.conditionManager.SetConditionHistory(history)
+}
+
+// GetConditionTransitions returns the recorded condition transitions matching the query
+// and the ID of the last recorded transition of any resource
+func (f *NetguardFacade) GetConditionTransitions(ctx context.Context, query ports.ConditionTransitionQuery) ([]models.ConditionTransition, int64, error) {
+	if f.conditionHistory == nil {
+		return nil, 0, nil
+	}
+	lastID, err := f.conditionHistory.LastID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	transitions, err := f.conditionHistory.List(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	return transitions, lastID, nil
+}
+
+// RunConditionHistoryCompaction periodically removes condition transitions older than horizon until ctx is done
+func (f *NetguardFacade) RunConditionHistoryCompaction(ctx context.Context, horizon, interval time.Duration) {
+	if f.conditionHistory == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.conditionHistory.Compact(ctx, time.Now().Add(-horizon)); err != nil {
+				klog.Errorf("❌ CONDITION_HISTORY: Failed to compact condition history: %v", err)
+			}
+		}
+	}
+}
+
+// GetIEAgAgRuleContributions returns the stored contributions of RuleS2S to aggregated IEAgAg rules
+func (f *NetguardFacade) GetIEAgAgRuleContributions(ctx context.Context, ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) ([]models.IEAgAgRuleContribution, error) {
+	return f.ruleS2SResourceService.GetIEAgAgRuleContributions(ctx, ruleS2SIDs, ieAgAgRuleIDs)
+}
+
+// WriteRuleGCMetrics writes IEAgAg rule garbage collection statistics in the Prometheus text format
+func (f *NetguardFacade) WriteRuleGCMetrics(w io.Writer) error {
+	return f.ruleS2SResourceService.WriteRuleGCMetrics(w)
+}
+
+// GetCrossNamespacePolicies returns all cross namespace policies within scope
+func (f *NetguardFacade) GetCrossNamespacePolicies(ctx context.Context, scope ports.Scope) ([]models.CrossNamespacePolicy, error) {
+	return f.ruleS2SResourceService.GetCrossNamespacePolicies(ctx, scope)
+}
+
+// GetCrossNamespacePolicyByID returns a cross namespace policy by ID
+func (f *NetguardFacade) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return f.ruleS2SResourceService.GetCrossNamespacePolicyByID(ctx, id)
+}
+
+// GetRuleTemplates returns all rule templates within scope
+func (f *NetguardFacade) GetRuleTemplates(ctx context.Context, scope ports.Scope) ([]models.RuleTemplate, error) {
+	return f.ruleS2SResourceService.GetRuleTemplates(ctx, scope)
+}
+
+// GetRuleTemplateByID returns a rule template by ID
+func (f *NetguardFacade) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return f.ruleS2SResourceService.GetRuleTemplateByID(ctx, id)
+}
+
+// GetNamespacePostures returns all namespace postures within scope
+func (f *NetguardFacade) GetNamespacePostures(ctx context.Context, scope ports.Scope) ([]models.NamespacePosture, error) {
+	return f.ruleS2SResourceService.GetNamespacePostures(ctx, scope)
+}
+
+// GetNamespacePostureByID returns a namespace posture by ID
+func (f *NetguardFacade) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return f.ruleS2SResourceService.GetNamespacePostureByID(ctx, id)
+}
+
+// SimulateTraffic checks whether a connection would be allowed by the stored IEAgAg rules
+func (f *NetguardFacade) SimulateTraffic(ctx context.Context, request models.TrafficSimulationRequest) (*models.TrafficSimulationResult, error) {
+	return f.ruleS2SResourceService.SimulateTraffic(ctx, request)
+}
+
+// reconcileNamespacePostures keeps baseline deny rules of default-deny namespaces in sync with changed
+// address groups. Failures are logged only, the address groups themselves are already committed.
+func (f *NetguardFacade) reconcileNamespacePostures(ctx context.Context) {
+	if err := f.ruleS2SResourceService.ReconcileNamespacePostures(ctx); err != nil {
+		rulesLog.Error(err, "Failed to reconcile baseline deny rules")
+	}
+}
+
+// reconcileRuleTemplates keeps RuleS2S generated from rule templates in sync with changed services.
+// Failures are logged only, the services themselves are already committed.
+func (f *NetguardFacade) reconcileRuleTemplates(ctx context.Context) {
+	if err := f.ruleS2SResourceService.ReconcileRuleTemplates(ctx); err != nil {
+		rulesLog.Error(err, "Failed to reconcile RuleS2S of rule templates")
+	}
+}
+
+// SetLegacyRuleGeneration switches IEAgAgRule generation to the legacy per-RuleS2S engine
+func (f *NetguardFacade) SetLegacyRuleGeneration(legacy bool) {
+	f.ruleS2SResourceService.SetLegacyRuleGeneration(legacy)
+}
+
+// SetRulePriorityCalculator replaces the strategy calculating priorities of generated IEAgAgRules
+func (f *NetguardFacade) SetRulePriorityCalculator(calculator resources.RulePriorityCalculator) {
+	f.ruleS2SResourceService.SetRulePriorityCalculator(calculator)
+}
+
 // Complex rule generation methods
 func (f *NetguardFacade) GenerateIEAgAgRulesFromRuleS2S(ctx context.Context, ruleS2S models.RuleS2S) ([]models.IEAgAgRule, error) {
 	return f.ruleS2SResourceService.GenerateIEAgAgRulesFromRuleS2S(ctx, ruleS2S)
@@ -578,63 +867,40 @@ func (f *NetguardFacade) DeleteNetworkBinding(ctx context.Context, id models.Res
 }
 
 // =============================================================================
-// Host Operations - Direct Registry Access (TODO: Create HostResourceService)
+// Host Operations - delegate to HostResourceService
 // =============================================================================
 
 func (f *NetguardFacade) GetHosts(ctx context.Context, scope ports.Scope) ([]models.Host, error) {
-	reader, err := f.registry.Reader(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create registry reader")
-	}
-	defer reader.Close()
-
-	var hosts []models.Host
-	err = reader.ListHosts(ctx, func(host models.Host) error {
-		hosts = append(hosts, host)
-		return nil
-	}, scope)
-
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to list hosts from registry")
-	}
-
-	return hosts, nil
+	return f.hostResourceService.ListHosts(ctx, scope)
 }
 
 func (f *NetguardFacade) GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error) {
-	reader, err := f.registry.Reader(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create registry reader")
-	}
-	defer reader.Close()
-
-	host, err := reader.GetHostByID(ctx, id)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get host from registry")
-	}
+	return f.hostResourceService.GetHost(ctx, id)
+}
 
-	return host, nil
+func (f *NetguardFacade) GetHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier) ([]models.Host, error) {
+	return f.hostResourceService.GetHostsByIDs(ctx, ids)
 }
 
 func (f *NetguardFacade) CreateHost(ctx context.Context, host models.Host) error {
-	// Use Sync API with Upsert operation
-	return f.Sync(ctx, models.SyncOpUpsert, []models.Host{host})
+	return f.hostResourceService.CreateHost(ctx, &host)
 }
 
 func (f *NetguardFacade) UpdateHost(ctx context.Context, host models.Host) error {
-	// Use Sync API with Upsert operation
-	return f.Sync(ctx, models.SyncOpUpsert, []models.Host{host})
+	return f.hostResourceService.UpdateHost(ctx, &host)
+}
+
+func (f *NetguardFacade) SyncHosts(ctx context.Context, hosts []models.Host, scope ports.Scope) error {
+	return f.hostResourceService.SyncHosts(ctx, hosts, scope, models.SyncOpUpsert)
 }
 
+// DeleteHost deletes the host with its HostBinding and removes it from AddressGroup.spec.hosts
 func (f *NetguardFacade) DeleteHost(ctx context.Context, id models.ResourceIdentifier) error {
-	// Get the host first to pass to sync for deletion
-	host, err := f.GetHostByID(ctx, id)
-	if err != nil {
-		return errors.Wrap(err, "failed to get host for deletion")
-	}
+	return f.hostResourceService.DeleteHost(ctx, id)
+}
 
-	// Use Sync API with Delete operation
-	return f.Sync(ctx, models.SyncOpDelete, []models.Host{*host})
+func (f *NetguardFacade) DeleteHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
+	return f.hostResourceService.DeleteHostsByIDs(ctx, ids)
 }
 
 func (f *NetguardFacade) GetHostBindings(ctx context.Context, scope ports.Scope) ([]models.HostBinding, error) {
@@ -713,6 +979,207 @@ func (f *NetguardFacade) GetSyncStatus(ctx context.Context) (*models.SyncStatus,
 	}, nil
 }
 
+// GetDetailedSyncStatus returns sync status per subject type and per entity.
+// The second return value is false when synchronization with sgroups is disabled.
+func (f *NetguardFacade) GetDetailedSyncStatus(ctx context.Context) (interfaces.DetailedSyncStatus, bool) {
+	provider, ok := f.syncManager.(interfaces.SyncStatusProvider)
+	if !ok || provider == nil {
+		return interfaces.DetailedSyncStatus{}, false
+	}
+	return provider.GetDetailedSyncStatus(), true
+}
+
+// SetReverseSyncStatus sets the source of reverse synchronization statistics. The provider
+// returns false while reverse synchronization is disabled.
+func (f *NetguardFacade) SetReverseSyncStatus(provider func() (interfaces.ReverseSyncStatus, bool)) {
+	f.reverseSyncStatus = provider
+}
+
+// GetReverseSyncStatus returns statistics of the reverse (sgroups -> netguard) synchronization.
+// The second return value is false when reverse synchronization is disabled.
+func (f *NetguardFacade) GetReverseSyncStatus() (interfaces.ReverseSyncStatus, bool) {
+	if f.reverseSyncStatus == nil {
+		return interfaces.ReverseSyncStatus{}, false
+	}
+	return f.reverseSyncStatus()
+}
+
+// SetReverseSyncController sets the controller of reverse synchronization
+func (f *NetguardFacade) SetReverseSyncController(controller interfaces.ReverseSyncController) {
+	f.reverseSyncController = controller
+}
+
+// PauseReverseSync skips changes detected in sgroups until ResumeReverseSync
+func (f *NetguardFacade) PauseReverseSync() error {
+	if f.reverseSyncController == nil {
+		return interfaces.ErrReverseSyncDisabled
+	}
+	return f.reverseSyncController.PauseReverseSync()
+}
+
+// ResumeReverseSync continues processing of changes detected in sgroups
+func (f *NetguardFacade) ResumeReverseSync() error {
+	if f.reverseSyncController == nil {
+		return interfaces.ErrReverseSyncDisabled
+	}
+	return f.reverseSyncController.ResumeReverseSync()
+}
+
+// TriggerReverseSync runs a reverse synchronization cycle immediately and waits for it
+func (f *NetguardFacade) TriggerReverseSync(ctx context.Context) error {
+	if f.reverseSyncController == nil {
+		return interfaces.ErrReverseSyncDisabled
+	}
+	return f.reverseSyncController.TriggerReverseSync(ctx)
+}
+
+// ErrSyncerControlDisabled is returned when synchronization with sgroups is disabled
+// or the sync manager can't pause syncers
+var ErrSyncerControlDisabled = errors.New("syncers can't be controlled, synchronization with sgroups is not enabled")
+
+// ListSyncers returns the sgroups syncers and whether they are enabled
+func (f *NetguardFacade) ListSyncers() ([]interfaces.SyncerState, error) {
+	controller, ok := f.syncManager.(interfaces.SyncerController)
+	if !ok || controller == nil {
+		return nil, ErrSyncerControlDisabled
+	}
+	return controller.SyncerStates(), nil
+}
+
+// SetSyncerEnabled pauses or resumes synchronization of the subject type with all sgroups targets
+func (f *NetguardFacade) SetSyncerEnabled(subjectType types.SyncSubjectType, enabled bool) error {
+	controller, ok := f.syncManager.(interfaces.SyncerController)
+	if !ok || controller == nil {
+		return ErrSyncerControlDisabled
+	}
+	if err := controller.SetSyncerEnabled(subjectType, enabled); err != nil {
+		return err
+	}
+	syncLog.Info("Syncer toggled", "subjectType", subjectType, "enabled", enabled)
+	return nil
+}
+
+// SetOperationLock serializes RuleS2S operations across backend replicas sharing the database
+func (f *NetguardFacade) SetOperationLock(lock ports.OperationLock) {
+	f.ruleS2SMutex.setCluster(lock)
+}
+
+// SetSyncOutbox routes sgroups sync of address groups through the transactional outbox
+func (f *NetguardFacade) SetSyncOutbox(notifier interfaces.SyncOutboxNotifier) {
+	f.addressGroupResourceService.SetSyncOutbox(notifier)
+}
+
+// SetIPAM makes Network CIDRs reserved in the external IPAM before they are persisted
+func (f *NetguardFacade) SetIPAM(ipam ports.IPAM) {
+	f.networkResourceService.SetIPAM(ipam)
+}
+
+// SetStartupReport publishes the report logged on startup
+func (f *NetguardFacade) SetStartupReport(report models.StartupReport) {
+	f.startupReport.Store(&report)
+}
+
+// GetStartupReport returns the report logged on startup.
+// The second return value is false while the backend is still starting.
+func (f *NetguardFacade) GetStartupReport() (models.StartupReport, bool) {
+	report := f.startupReport.Load()
+	if report == nil {
+		return models.StartupReport{}, false
+	}
+	return *report, true
+}
+
+// ErrSchemaNotVersioned is returned when the storage backend has no schema migrations
+var ErrSchemaNotVersioned = errors.New("storage backend has no versioned schema")
+
+// GetSchemaStatus reads the applied schema version of the storage backend and compares it
+// with the version the backend supports
+func (f *NetguardFacade) GetSchemaStatus(ctx context.Context) (models.SchemaStatus, error) {
+	inspector, ok := f.registry.(ports.SchemaInspector)
+	if !ok {
+		return models.SchemaStatus{}, ErrSchemaNotVersioned
+	}
+	return inspector.SchemaStatus(ctx)
+}
+
+// ErrSyncDeadLettersDisabled is returned when the sync dead-letter queue is not configured
+var ErrSyncDeadLettersDisabled = errors.New("sync dead-letter queue is not enabled")
+
+// SetSyncDeadLetters enables ListFailedSyncs and RetryFailedSync
+func (f *NetguardFacade) SetSyncDeadLetters(deadLetters ports.SyncDeadLetterQueue) {
+	f.syncDeadLetters = deadLetters
+}
+
+// ListFailedSyncs returns sgroups syncs moved to the dead-letter queue
+func (f *NetguardFacade) ListFailedSyncs(ctx context.Context) ([]models.SyncOutboxEntry, error) {
+	if f.syncDeadLetters == nil {
+		return nil, ErrSyncDeadLettersDisabled
+	}
+	return f.syncDeadLetters.ListDeadLetters(ctx)
+}
+
+// RetryFailedSync enqueues a fresh sync of the resource of a dead-lettered entry
+// and removes the entry from the dead-letter queue
+func (f *NetguardFacade) RetryFailedSync(ctx context.Context, id int64) error {
+	if f.syncDeadLetters == nil {
+		return ErrSyncDeadLettersDisabled
+	}
+
+	entry, err := f.syncDeadLetters.GetDeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch resource := entry.Resource.(type) {
+	case models.AddressGroup:
+		if err := f.addressGroupResourceService.RequeueSGroupsSync(ctx, resource); err != nil {
+			return errors.Wrapf(err, "failed to requeue sync of dead letter %d", id)
+		}
+	default:
+		return errors.Errorf("retry of %s sync is not supported", entry.Kind)
+	}
+
+	if err := f.syncDeadLetters.RemoveDeadLetter(ctx, id); err != nil && !errors.Is(err, ports.ErrNotFound) {
+		// The fresh sync is already enqueued, a leftover entry only shows up in ListFailedSyncs again
+		return errors.Wrapf(err, "failed to remove dead letter %d", id)
+	}
+	syncLog.Info("Requeued dead-letter sync", "kind", entry.Kind, "entry", id)
+	return nil
+}
+
+// ReportDeadLettered surfaces a dead-lettered sync in resource conditions (outbox.Reporter)
+func (f *NetguardFacade) ReportDeadLettered(ctx context.Context, entry models.SyncOutboxEntry) {
+	if f.conditionManager != nil {
+		f.conditionManager.MarkSyncDeadLettered(ctx, entry)
+	}
+	if id, ok := entry.ResourceIdentifier(); ok && f.events != nil {
+		f.events.Publish(models.NewDomainEvent(models.DomainEventSyncFailed, entry.Kind, id, "SyncFailed",
+			fmt.Sprintf("sgroups %s sync failed after %d attempts: %s", entry.Operation, entry.Attempts, entry.LastError)).Warning())
+	}
+}
+
+// ReportDelivered clears dead-letter conditions of delivered resources (outbox.Reporter)
+func (f *NetguardFacade) ReportDelivered(ctx context.Context, entries []models.SyncOutboxEntry) {
+	if f.conditionManager != nil {
+		f.conditionManager.ClearSyncDeadLettered(ctx, entries)
+	}
+}
+
+// ReportDrift surfaces drift between netguard and sgroups in resource conditions (drift.Reporter)
+func (f *NetguardFacade) ReportDrift(ctx context.Context, subjectType types.SyncSubjectType, drifted []models.ResourceIdentifier) {
+	if f.conditionManager != nil && subjectType == types.SyncSubjectTypeGroups {
+		f.conditionManager.UpdateSyncDriftConditions(ctx, drifted)
+	}
+}
+
+// ReportSGroupsAvailability surfaces circuit breaker state changes of a sgroups instance
+// in conditions of AddressGroups of the namespaces selected by inTarget
+func (f *NetguardFacade) ReportSGroupsAvailability(ctx context.Context, available bool, inTarget func(namespace string) bool) {
+	if f.conditionManager != nil {
+		f.conditionManager.UpdateSGroupsAvailabilityConditions(ctx, available, inTarget)
+	}
+}
+
 // SetSyncStatus sets overall sync status
 func (f *NetguardFacade) SetSyncStatus(ctx context.Context, status models.SyncStatus) error {
 	return nil
@@ -722,28 +1189,171 @@ func (f *NetguardFacade) SetSyncStatus(ctx context.Context, status models.SyncSt
 // Main Sync Method (core gRPC interface compatibility)
 // =============================================================================
 
-// Sync is the main synchronization method used by gRPC endpoints
+// SetAdmission enables priority-based admission of bulk operations
+func (f *NetguardFacade) SetAdmission(controller *admission.Controller) {
+	f.admission = controller
+}
+
+// Admission returns the admission controller, nil if admission is disabled
+func (f *NetguardFacade) Admission() *admission.Controller {
+	return f.admission
+}
+
+// Sync is the main synchronization method used by gRPC endpoints.
+// Bulk requests (see admission.ClassFrom) are applied in small batches, each in
+// its own transaction, yielding to interactive operations between batches.
+// FullSync is never split: every batch would delete resources of the previous ones.
+// Resources are checked against validation.Limits first; deletions only against the
+// request size, so resources created under looser limits can still be removed.
+// The resource validator (external validation webhooks) reviews the whole request before
+// any batch is applied.
 func (f *NetguardFacade) Sync(ctx context.Context, syncOp models.SyncOp, resources interface{}) error {
+	items := reflect.ValueOf(resources)
+	limits := validation.CurrentLimits()
+	if syncOp == models.SyncOpDelete {
+		if items.Kind() == reflect.Slice {
+			if err := limits.ValidateRequestSize(items.Len()); err != nil {
+				return err
+			}
+		}
+	} else if err := limits.ValidateBatch(resources); err != nil {
+		return err
+	}
+	if err := f.validateResources(ctx, syncOp, resources); err != nil {
+		return err
+	}
+
+	batchSize := f.admission.BatchSize()
+	if admission.ClassFrom(ctx) != admission.ClassBulk || syncOp == models.SyncOpFullSync || batchSize <= 0 {
+		return f.syncAndPublish(ctx, syncOp, resources)
+	}
+
+	if items.Kind() != reflect.Slice || items.Len() <= batchSize {
+		return f.syncAndPublish(ctx, syncOp, resources)
+	}
+
+	for start := 0; start < items.Len(); start += batchSize {
+		if start > 0 {
+			if err := f.admission.WaitBulkBatch(ctx); err != nil {
+				return errors.Wrapf(err, "bulk sync interrupted after %d of %d resources", start, items.Len())
+			}
+		}
+		end := start + batchSize
+		if end > items.Len() {
+			end = items.Len()
+		}
+		if err := f.syncAndPublish(ctx, syncOp, items.Slice(start, end).Interface()); err != nil {
+			return errors.Wrapf(err, "bulk sync failed after %d of %d resources", start, items.Len())
+		}
+	}
+	return nil
+}
+
+// syncAndPublish syncs resources and publishes domain events and changes of the committed ones
+func (f *NetguardFacade) syncAndPublish(ctx context.Context, syncOp models.SyncOp, resources interface{}) error {
+	var before []changeDataItem
+	captured := false
+	if f.changeData != nil {
+		before, captured = f.changeDataSnapshots(ctx, resources)
+	}
+
+	if err := f.syncResources(ctx, syncOp, resources); err != nil {
+		return err
+	}
+	f.publishSyncEvents(syncOp, resources)
+	if captured {
+		f.publishResourceChanges(ctx, resources, before)
+	}
+	if syncOp != models.SyncOpDelete {
+		f.finalizeDeletions(ctx, resources)
+	}
+	return nil
+}
+
+// syncResources delegates sync of resources of a single type to the resource service
+func (f *NetguardFacade) syncResources(ctx context.Context, syncOp models.SyncOp, resources interface{}) error {
 
 	// Delegate to appropriate resource service based on resource type with proper syncOp
 	switch typedResources := resources.(type) {
 	case []models.Service:
-
-		return f.serviceResourceService.SyncServices(ctx, typedResources, ports.EmptyScope{}, syncOp)
+		if syncOp == models.SyncOpDelete {
+			// Generated RuleS2S of rule templates must not block the deletion of selected services
+			ids := make([]models.ResourceIdentifier, 0, len(typedResources))
+			for _, service := range typedResources {
+				ids = append(ids, service.ResourceIdentifier)
+			}
+			if err := f.ruleS2SResourceService.DeleteRuleTemplateRuleS2SForServices(ctx, ids); err != nil {
+				return errors.Wrap(err, "failed to delete generated RuleS2S of deleted services")
+			}
+		}
+		if err := f.serviceResourceService.SyncServices(ctx, typedResources, ports.EmptyScope{}, syncOp); err != nil {
+			f.reconcileRuleTemplates(ctx)
+			return err
+		}
+		f.publishServiceChanges(ctx, syncOp, typedResources)
+		f.reconcileRuleTemplates(ctx)
+		return nil
 	case []models.AddressGroup:
-		return f.addressGroupResourceService.SyncAddressGroups(ctx, typedResources, ports.EmptyScope{}, syncOp)
+		var ownerUIDs map[string]bool
+		if syncOp == models.SyncOpDelete {
+			ownerUIDs = f.deletedAddressGroupUIDs(ctx, typedResources)
+			// Baseline deny rules of deleted address groups are removed with external sync
+			ids := make([]models.ResourceIdentifier, 0, len(typedResources))
+			for _, group := range typedResources {
+				ids = append(ids, group.ResourceIdentifier)
+			}
+			if err := f.ruleS2SResourceService.DeleteNamespacePostureRulesForAddressGroups(ctx, ids); err != nil {
+				return errors.Wrap(err, "failed to delete baseline deny rules of deleted address groups")
+			}
+		}
+		if err := f.addressGroupResourceService.SyncAddressGroups(ctx, typedResources, ports.EmptyScope{}, syncOp); err != nil {
+			f.reconcileNamespacePostures(ctx)
+			return err
+		}
+		f.deleteOwnedIEAgAgRules(ctx, ownerUIDs)
+		f.publishAddressGroupChanges(ctx, syncOp, typedResources)
+		f.reconcileNamespacePostures(ctx)
+		return nil
 	case []models.AddressGroupBinding:
-		return f.addressGroupResourceService.SyncAddressGroupBindings(ctx, typedResources, ports.EmptyScope{}, syncOp)
+		if err := f.addressGroupResourceService.SyncAddressGroupBindings(ctx, typedResources, ports.EmptyScope{}, syncOp); err != nil {
+			return err
+		}
+		f.publishBindingServices(ctx, typedResources)
+		return nil
 	case []models.AddressGroupPortMapping:
 		return f.addressGroupResourceService.SyncMultipleAddressGroupPortMappings(ctx, typedResources, ports.EmptyScope{}, syncOp)
 	case []models.RuleS2S:
-		return f.ruleS2SResourceService.SyncRuleS2S(ctx, typedResources, ports.EmptyScope{}, syncOp)
+		var ownerUIDs map[string]bool
+		if syncOp == models.SyncOpDelete {
+			ownerUIDs = f.deletedRuleS2SUIDs(ctx, typedResources)
+		}
+		if err := f.ruleS2SResourceService.SyncRuleS2S(ctx, typedResources, ports.EmptyScope{}, syncOp); err != nil {
+			return err
+		}
+		f.deleteOwnedIEAgAgRules(ctx, ownerUIDs)
+		f.publishRuleS2SChanges(ctx, syncOp, typedResources)
+		return nil
 	case []models.ServiceAlias:
 		return f.serviceResourceService.SyncServiceAliases(ctx, typedResources, ports.EmptyScope{}, syncOp)
 	case []models.AddressGroupBindingPolicy:
 		return f.addressGroupResourceService.SyncAddressGroupBindingPolicies(ctx, typedResources, ports.EmptyScope{}, syncOp)
 	case []models.IEAgAgRule:
+		if syncOp == models.SyncOpDelete {
+			ids := make([]models.ResourceIdentifier, 0, len(typedResources))
+			for _, rule := range typedResources {
+				ids = append(ids, rule.ResourceIdentifier)
+			}
+			return f.ruleS2SResourceService.DeleteIEAgAgRulesByIDs(ctx, ids)
+		}
 		return f.ruleS2SResourceService.SyncIEAgAgRules(ctx, typedResources, ports.EmptyScope{})
+	case []models.RuleS2SException:
+		return f.ruleS2SResourceService.SyncRuleS2SExceptions(ctx, typedResources, ports.EmptyScope{}, syncOp)
+	case []models.CrossNamespacePolicy:
+		return f.ruleS2SResourceService.SyncCrossNamespacePolicies(ctx, typedResources, ports.EmptyScope{}, syncOp)
+	case []models.RuleTemplate:
+		return f.ruleS2SResourceService.SyncRuleTemplates(ctx, typedResources, ports.EmptyScope{}, syncOp)
+	case []models.NamespacePosture:
+		return f.ruleS2SResourceService.SyncNamespacePostures(ctx, typedResources, ports.EmptyScope{}, syncOp)
 	case []models.Network:
 		// Handle different sync operations for Networks
 		for _, network := range typedResources {
@@ -780,21 +1390,15 @@ func (f *NetguardFacade) Sync(ctx context.Context, syncOp models.SyncOp, resourc
 		}
 		return nil
 	case []models.Host:
-		for _, host := range typedResources {
-			switch syncOp {
-			case models.SyncOpDelete:
-				if err := f.hostResourceService.DeleteHost(ctx, host.SelfRef.ResourceIdentifier); err != nil {
-					return errors.Wrapf(err, "failed to delete host %s", host.Key())
-				}
-			case models.SyncOpUpsert, models.SyncOpFullSync:
-				if err := f.hostResourceService.CreateHost(ctx, &host); err != nil {
-					return errors.Wrapf(err, "failed to create host %s", host.Key())
-				}
-			default:
-				return errors.New(fmt.Sprintf("unsupported sync operation for Host: %v", syncOp))
-			}
+		switch syncOp {
+		case models.SyncOpDelete:
+			return f.hostResourceService.SyncHosts(ctx, typedResources, ports.EmptyScope{}, models.SyncOpDelete)
+		case models.SyncOpUpsert, models.SyncOpFullSync:
+			// Existing hosts are updated, the request has no scope to delete missing hosts with
+			return f.hostResourceService.SyncHosts(ctx, typedResources, ports.EmptyScope{}, models.SyncOpUpsert)
+		default:
+			return errors.New(fmt.Sprintf("unsupported sync operation for Host: %v", syncOp))
 		}
-		return nil
 	case []models.HostBinding:
 		for _, hostBinding := range typedResources {
 			switch syncOp {
@@ -816,6 +1420,198 @@ func (f *NetguardFacade) Sync(ctx context.Context, syncOp models.SyncOp, resourc
 	}
 }
 
+// =============================================================================
+// Change Feed (Watch support)
+// =============================================================================
+
+// ChangeFeed returns the feed of committed resource changes used by the Watch RPC
+func (f *NetguardFacade) ChangeFeed() *ChangeFeed {
+	return f.changeFeed
+}
+
+// readCommitted re-reads the resources of ids from the primary, resources deleted since are
+// skipped. Post-commit paths can't read the replica, it may not have replayed the commit yet.
+func readCommitted[T any](ctx context.Context, registry ports.Registry, ids []models.ResourceIdentifier,
+	get func(ports.Reader, models.ResourceIdentifier) (*T, error)) ([]T, error) {
+	reader, err := registry.PrimaryReader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	resources := make([]T, 0, len(ids))
+	for _, id := range ids {
+		resource, err := get(reader, id)
+		if errors.Is(err, ports.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get %s", id.Key())
+		}
+		resources = append(resources, *resource)
+	}
+	return resources, nil
+}
+
+// publishServiceChanges publishes committed services re-read from storage,
+// so watchers receive the persisted state (aggregated address groups, meta)
+func (f *NetguardFacade) publishServiceChanges(ctx context.Context, syncOp models.SyncOp, services []models.Service) {
+	publishServices(ctx, f.changeFeed, f.registry, syncOp, services)
+}
+
+// publishAddressGroupChanges publishes committed address groups re-read from storage
+func (f *NetguardFacade) publishAddressGroupChanges(ctx context.Context, syncOp models.SyncOp, addressGroups []models.AddressGroup) {
+	publishAddressGroups(ctx, f.changeFeed, f.registry, syncOp, addressGroups)
+}
+
+// publishBindingServices publishes the services referenced by changed bindings,
+// their aggregated address groups are recomputed on every binding change
+func (f *NetguardFacade) publishBindingServices(ctx context.Context, bindings []models.AddressGroupBinding) {
+	seen := make(map[string]bool, len(bindings))
+	services := make([]models.Service, 0, len(bindings))
+	for _, binding := range bindings {
+		namespace := binding.ServiceRef.Namespace
+		if namespace == "" {
+			namespace = binding.Namespace
+		}
+		id := models.NewResourceIdentifier(binding.ServiceRef.Name, models.WithNamespace(namespace))
+		if seen[id.Key()] {
+			continue
+		}
+		seen[id.Key()] = true
+		services = append(services, models.Service{SelfRef: models.SelfRef{ResourceIdentifier: id}})
+	}
+	// The services outlive deleted bindings, so they are always published as upserts
+	f.publishServiceChanges(ctx, models.SyncOpUpsert, services)
+}
+
+// publishServices publishes committed services to feed, re-read from the primary
+func publishServices(ctx context.Context, feed *ChangeFeed, registry ports.Registry, syncOp models.SyncOp, services []models.Service) {
+	if feed == nil || len(services) == 0 {
+		return
+	}
+	if syncOp == models.SyncOpDelete {
+		for _, service := range services {
+			feed.Publish(ctx, models.SyncOpDelete, models.Service{SelfRef: service.SelfRef})
+		}
+		return
+	}
+
+	ids := make([]models.ResourceIdentifier, 0, len(services))
+	for _, service := range services {
+		ids = append(ids, service.ResourceIdentifier)
+	}
+	persisted, err := readCommitted(ctx, registry, ids, func(reader ports.Reader, id models.ResourceIdentifier) (*models.Service, error) {
+		return reader.GetServiceByID(ctx, id)
+	})
+	if err != nil {
+		klog.Errorf("Failed to read services for change feed: %v", err)
+		return
+	}
+	for _, service := range persisted {
+		feed.Publish(ctx, models.SyncOpUpsert, service)
+	}
+}
+
+// publishAddressGroups publishes committed address groups to feed, re-read from the primary
+func publishAddressGroups(ctx context.Context, feed *ChangeFeed, registry ports.Registry, syncOp models.SyncOp, addressGroups []models.AddressGroup) {
+	if feed == nil || len(addressGroups) == 0 {
+		return
+	}
+	if syncOp == models.SyncOpDelete {
+		for _, addressGroup := range addressGroups {
+			feed.Publish(ctx, models.SyncOpDelete, models.AddressGroup{SelfRef: addressGroup.SelfRef})
+		}
+		return
+	}
+
+	ids := make([]models.ResourceIdentifier, 0, len(addressGroups))
+	for _, addressGroup := range addressGroups {
+		ids = append(ids, addressGroup.ResourceIdentifier)
+	}
+	persisted, err := readCommitted(ctx, registry, ids, func(reader ports.Reader, id models.ResourceIdentifier) (*models.AddressGroup, error) {
+		return reader.GetAddressGroupByID(ctx, id)
+	})
+	if err != nil {
+		klog.Errorf("Failed to read address groups for change feed: %v", err)
+		return
+	}
+	for _, addressGroup := range persisted {
+		feed.Publish(ctx, models.SyncOpUpsert, addressGroup)
+	}
+}
+
+// publishRuleS2SChanges publishes committed RuleS2S re-read from storage
+func (f *NetguardFacade) publishRuleS2SChanges(ctx context.Context, syncOp models.SyncOp, rules []models.RuleS2S) {
+	if syncOp == models.SyncOpDelete {
+		for _, rule := range rules {
+			f.changeFeed.Publish(ctx, models.SyncOpDelete, models.RuleS2S{SelfRef: rule.SelfRef})
+		}
+		return
+	}
+
+	ids := make([]models.ResourceIdentifier, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ResourceIdentifier)
+	}
+	persisted, err := readCommitted(ctx, f.registry, ids, func(reader ports.Reader, id models.ResourceIdentifier) (*models.RuleS2S, error) {
+		return reader.GetRuleS2SByID(ctx, id)
+	})
+	if err != nil {
+		klog.Errorf("Failed to read RuleS2S for change feed: %v", err)
+		return
+	}
+	for _, rule := range persisted {
+		f.changeFeed.Publish(ctx, models.SyncOpUpsert, rule)
+	}
+}
+
+// =============================================================================
+// Domain events
+// =============================================================================
+
+// SetEventPublisher publishes domain events of committed changes to publisher
+func (f *NetguardFacade) SetEventPublisher(publisher ports.EventPublisher) {
+	f.events = publisher
+	f.ruleS2SResourceService.SetEventPublisher(publisher)
+}
+
+// SetIEAgAgRuleDiffNotifier notifies notifier about committed changes of generated IEAgAgRules
+func (f *NetguardFacade) SetIEAgAgRuleDiffNotifier(notifier ports.IEAgAgRuleDiffNotifier) {
+	f.ruleS2SResourceService.SetIEAgAgRuleDiffNotifier(notifier)
+}
+
+// publishSyncEvents publishes an event per synced resource. Resources removed by FullSync
+// because they were missing from the request are not reported.
+func (f *NetguardFacade) publishSyncEvents(syncOp models.SyncOp, resources interface{}) {
+	items := reflect.ValueOf(resources)
+	if f.events == nil || items.Kind() != reflect.Slice {
+		return
+	}
+
+	eventType, reason := models.DomainEventResourceApplied, "Applied"
+	if syncOp == models.SyncOpDelete {
+		eventType, reason = models.DomainEventResourceDeleted, "Deleted"
+	}
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		if item.Kind() != reflect.Struct {
+			return
+		}
+		field := item.FieldByName("ResourceIdentifier")
+		if !field.IsValid() {
+			return
+		}
+		id, ok := field.Interface().(models.ResourceIdentifier)
+		if !ok {
+			return
+		}
+		kind := item.Type().Name()
+		f.events.Publish(models.NewDomainEvent(eventType, kind, id, reason,
+			fmt.Sprintf("%s %s %s by %s sync", kind, id.Key(), strings.ToLower(reason), syncOp)))
+	}
+}
+
 // ProcessConditionsIfNeeded processes conditions for resources (preserved from original)
 func (f *NetguardFacade) ProcessConditionsIfNeeded(ctx context.Context, resource interface{}, syncOp models.SyncOp) {
 	if f.conditionManager == nil {
@@ -912,19 +1708,19 @@ func (a *addressGroupConditionManagerAdapter) ProcessAddressGroupBindingPolicyCo
 }
 
 func (a *addressGroupConditionManagerAdapter) SaveAddressGroupConditions(ctx context.Context, addressGroup *models.AddressGroup) error {
-	return a.conditionManager.saveAddressGroupConditions(ctx, addressGroup)
+	return a.conditionManager.saveAddressGroupConditions(ctx, addressGroup, nil)
 }
 
 func (a *addressGroupConditionManagerAdapter) SaveAddressGroupBindingConditions(ctx context.Context, binding *models.AddressGroupBinding) error {
-	return a.conditionManager.saveAddressGroupBindingConditions(ctx, binding)
+	return a.conditionManager.saveAddressGroupBindingConditions(ctx, binding, nil)
 }
 
 func (a *addressGroupConditionManagerAdapter) SaveAddressGroupPortMappingConditions(ctx context.Context, mapping *models.AddressGroupPortMapping) error {
-	return a.conditionManager.saveAddressGroupPortMappingConditions(ctx, mapping)
+	return a.conditionManager.saveAddressGroupPortMappingConditions(ctx, mapping, nil)
 }
 
 func (a *addressGroupConditionManagerAdapter) SaveAddressGroupBindingPolicyConditions(ctx context.Context, policy *models.AddressGroupBindingPolicy) error {
-	return a.conditionManager.saveAddressGroupBindingPolicyConditions(ctx, policy)
+	return a.conditionManager.saveAddressGroupBindingPolicyConditions(ctx, policy, nil)
 }
 
 type networkConditionManagerAdapter struct {
@@ -997,9 +1793,9 @@ func (r *ruleConditionManager) SaveResourceConditions(ctx context.Context, resou
 
 	switch typedResource := resource.(type) {
 	case *models.RuleS2S:
-		return r.conditionManager.saveRuleS2SConditions(ctx, typedResource)
+		return r.conditionManager.saveRuleS2SConditions(ctx, typedResource, nil)
 	case *models.IEAgAgRule:
-		return r.conditionManager.saveIEAgAgRuleConditions(ctx, typedResource)
+		return r.conditionManager.saveIEAgAgRuleConditions(ctx, typedResource, nil)
 	default:
 		klog.Warningf("SaveResourceConditions: Unsupported resource type %T", resource)
 		return nil
@@ -1015,7 +1811,13 @@ func (f *NetguardFacade) processAddressGroupPortMappingConditionsAfterBinding(ct
 		Namespace: binding.AddressGroupRef.Namespace,
 	}
 
-	mapping, err := f.addressGroupResourceService.GetAddressGroupPortMappingByID(ctx, mappingID)
+	reader, err := f.registry.PrimaryReader(ctx)
+	if err != nil {
+		klog.Errorf("processAddressGroupPortMappingConditionsAfterBinding: Failed to get reader: %v", err)
+		return
+	}
+	mapping, err := reader.GetAddressGroupPortMappingByID(ctx, mappingID)
+	reader.Close()
 	if err != nil {
 		klog.V(4).Infof("processAddressGroupPortMappingConditionsAfterBinding: No mapping found for %s/%s (this is normal): %v",
 			mappingID.Namespace, mappingID.Name, err)
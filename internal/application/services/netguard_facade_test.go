@@ -7,6 +7,7 @@ import (
 	"netguard-pg-backend/internal/application/services/resources/testutil"
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -181,6 +182,59 @@ func TestNetguardFacade_AddressGroupOperations(t *testing.T) {
 	})
 }
 
+// TestNetguardFacade_AnalyzeAddressGroupImpact tests impact analysis of AddressGroup changes
+func TestNetguardFacade_AnalyzeAddressGroupImpact(t *testing.T) {
+	// Setup
+	mockRegistry := testutil.NewMockRegistry()
+	mockSyncManager := testutil.NewMockSyncManager()
+
+	facade := NewNetguardFacade(mockRegistry, nil, mockSyncManager)
+
+	addressGroup := testutil.CreateTestAddressGroup("test-local-address-group", "test-namespace")
+	ieRule := testutil.CreateTestIEAgAgRule("test-ieagag-rule", "test-namespace")
+	otherRule := testutil.CreateTestIEAgAgRule("other-ieagag-rule", "other-namespace")
+	service := testutil.CreateTestService("test-service", "app-namespace")
+	service.AddressGroups = []models.AddressGroupRef{
+		models.NewAddressGroupRef(addressGroup.Name, models.WithNamespace(addressGroup.Namespace)),
+	}
+
+	mockRegistry.SetupTestData(map[string]interface{}{
+		"addressgroup_" + addressGroup.Key(): &addressGroup,
+		"ieagagrule_" + ieRule.Key():         &ieRule,
+		"ieagagrule_" + otherRule.Key():      &otherRule,
+		"service_" + service.Key():           &service,
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		impact, err := facade.AnalyzeAddressGroupImpact(context.Background(), addressGroup.ResourceIdentifier, nil)
+		require.NoError(t, err)
+		assert.True(t, impact.EnforcementChanged)
+		assert.Equal(t, []models.ResourceIdentifier{ieRule.ResourceIdentifier}, impact.IEAgAgRules)
+		assert.Equal(t, []models.ResourceIdentifier{service.ResourceIdentifier}, impact.Services)
+		assert.Equal(t, []string{"app-namespace", "test-namespace"}, impact.Namespaces)
+		assert.Equal(t, -1, impact.RuleCountDelta())
+	})
+
+	t.Run("UpdateWithoutEnforcementChanges", func(t *testing.T) {
+		proposed := addressGroup
+		impact, err := facade.AnalyzeAddressGroupImpact(context.Background(), addressGroup.ResourceIdentifier, &proposed)
+		require.NoError(t, err)
+		assert.False(t, impact.EnforcementChanged)
+		assert.Empty(t, impact.IEAgAgRules)
+		assert.Equal(t, 0, impact.RuleCountDelta())
+	})
+
+	t.Run("UpdateDefaultAction", func(t *testing.T) {
+		proposed := addressGroup
+		proposed.DefaultAction = models.ActionDrop
+		impact, err := facade.AnalyzeAddressGroupImpact(context.Background(), addressGroup.ResourceIdentifier, &proposed)
+		require.NoError(t, err)
+		assert.True(t, impact.EnforcementChanged)
+		assert.Len(t, impact.IEAgAgRules, 1)
+		assert.Equal(t, 1, impact.RuleCountAfter)
+	})
+}
+
 // TestNetguardFacade_RuleS2SOperations tests RuleS2S operations
 func TestNetguardFacade_RuleS2SOperations(t *testing.T) {
 	// Setup
@@ -515,6 +569,94 @@ func TestNetguardFacade_SyncMethod(t *testing.T) {
 	})
 }
 
+// recordingPublisher collects published domain events
+type recordingPublisher struct {
+	events []models.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(event models.DomainEvent) {
+	p.events = append(p.events, event)
+}
+
+// TestNetguardFacade_SyncPublishesDomainEvents tests events of synced resources
+func TestNetguardFacade_SyncPublishesDomainEvents(t *testing.T) {
+	registry := mem.NewRegistry()
+	facade := NewNetguardFacade(registry, NewConditionManager(registry), testutil.NewMockSyncManager())
+	publisher := &recordingPublisher{}
+	facade.SetEventPublisher(publisher)
+
+	services := []models.Service{
+		testutil.CreateTestService("events-service-1", "test-namespace"),
+		testutil.CreateTestService("events-service-2", "test-namespace"),
+	}
+	require.NoError(t, facade.Sync(context.Background(), models.SyncOpUpsert, services))
+	require.Len(t, publisher.events, 2)
+	for i, event := range publisher.events {
+		assert.Equal(t, models.DomainEventResourceApplied, event.Type)
+		assert.Equal(t, "Service", event.Kind)
+		assert.Equal(t, services[i].ResourceIdentifier, event.ResourceIdentifier)
+		assert.Equal(t, models.DomainEventNormal, event.Severity)
+	}
+
+	publisher.events = nil
+	require.NoError(t, facade.Sync(context.Background(), models.SyncOpDelete, services[:1]))
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, models.DomainEventResourceDeleted, publisher.events[0].Type)
+	assert.Equal(t, "Deleted", publisher.events[0].Reason)
+
+	// Failed syncs publish nothing
+	publisher.events = nil
+	assert.Error(t, facade.Sync(context.Background(), models.SyncOpUpsert, []string{"unsupported"}))
+	assert.Empty(t, publisher.events)
+}
+
+// recordingChangePublisher collects published resource changes
+type recordingChangePublisher struct {
+	changes []models.ResourceChange
+}
+
+func (p *recordingChangePublisher) PublishChange(change models.ResourceChange) {
+	p.changes = append(p.changes, change)
+}
+
+// TestNetguardFacade_SyncPublishesResourceChanges tests before/after snapshots of synced resources
+func TestNetguardFacade_SyncPublishesResourceChanges(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+	facade := NewNetguardFacade(registry, NewConditionManager(registry), testutil.NewMockSyncManager())
+	publisher := &recordingChangePublisher{}
+	facade.SetResourceChangePublisher(publisher)
+
+	service := testutil.CreateTestService("cdc-service", "test-namespace")
+	require.NoError(t, facade.Sync(ctx, models.SyncOpUpsert, []models.Service{service}))
+	require.Len(t, publisher.changes, 1)
+	assert.Equal(t, models.ResourceChangeCreate, publisher.changes[0].Operation)
+	assert.Equal(t, "Service", publisher.changes[0].Kind)
+	assert.Nil(t, publisher.changes[0].Before)
+	require.IsType(t, models.Service{}, publisher.changes[0].After)
+
+	publisher.changes = nil
+	updated := service
+	updated.Description = "updated"
+	require.NoError(t, facade.Sync(ctx, models.SyncOpUpsert, []models.Service{updated}))
+	require.Len(t, publisher.changes, 1)
+	assert.Equal(t, models.ResourceChangeUpdate, publisher.changes[0].Operation)
+	assert.Equal(t, service.Description, publisher.changes[0].Before.(models.Service).Description)
+	assert.Equal(t, "updated", publisher.changes[0].After.(models.Service).Description)
+
+	publisher.changes = nil
+	require.NoError(t, facade.Sync(ctx, models.SyncOpDelete, []models.Service{service}))
+	require.Len(t, publisher.changes, 1)
+	assert.Equal(t, models.ResourceChangeDelete, publisher.changes[0].Operation)
+	assert.Equal(t, "updated", publisher.changes[0].Before.(models.Service).Description)
+	assert.Nil(t, publisher.changes[0].After)
+
+	// Deleting a missing resource changes nothing
+	publisher.changes = nil
+	require.NoError(t, facade.Sync(ctx, models.SyncOpDelete, []models.Service{service}))
+	assert.Empty(t, publisher.changes)
+}
+
 // TestNetguardFacade_SyncStatus tests sync status operations
 func TestNetguardFacade_SyncStatus(t *testing.T) {
 	// Setup
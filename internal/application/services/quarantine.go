@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+var (
+	// ErrQuarantineDisabled is returned when the quarantine of imported resources is not configured
+	ErrQuarantineDisabled = errors.New("quarantine is not enabled")
+
+	// ErrQuarantinePromotionFailed is returned when a promoted resource is rejected again,
+	// the resource stays in the quarantine
+	ErrQuarantinePromotionFailed = errors.New("quarantined resource was rejected")
+)
+
+// QuarantinePromoter imports a reviewed quarantined resource of a source again
+type QuarantinePromoter func(ctx context.Context, resource models.QuarantinedResource) error
+
+// SetQuarantine enables QuarantineResource and the quarantine admin API
+func (f *NetguardFacade) SetQuarantine(quarantine ports.Quarantine) {
+	f.quarantine = quarantine
+}
+
+// SetQuarantinePromoter registers the promoter of resources quarantined by a source,
+// resources of sources without a promoter can only be deleted
+func (f *NetguardFacade) SetQuarantinePromoter(source string, promoter QuarantinePromoter) {
+	if f.quarantinePromoters == nil {
+		f.quarantinePromoters = make(map[string]QuarantinePromoter)
+	}
+	f.quarantinePromoters[source] = promoter
+}
+
+// QuarantineEnabled reports whether rejected imports can be quarantined
+func (f *NetguardFacade) QuarantineEnabled() bool {
+	return f.quarantine != nil
+}
+
+// QuarantineResource keeps an imported resource which failed validation for review
+func (f *NetguardFacade) QuarantineResource(ctx context.Context, resource models.QuarantinedResource) (int64, error) {
+	if f.quarantine == nil {
+		return 0, ErrQuarantineDisabled
+	}
+
+	id, err := f.quarantine.Quarantine(ctx, resource)
+	if err != nil {
+		return 0, err
+	}
+	klog.Warningf("🧪 QUARANTINE: %s %s imported by %s quarantined as %d: %v",
+		resource.Kind, resource.Key(), resource.Source, id, resource.Reasons)
+	return id, nil
+}
+
+// ListQuarantined returns imported resources kept in the quarantine
+func (f *NetguardFacade) ListQuarantined(ctx context.Context) ([]models.QuarantinedResource, error) {
+	if f.quarantine == nil {
+		return nil, ErrQuarantineDisabled
+	}
+	return f.quarantine.ListQuarantined(ctx)
+}
+
+// PromoteQuarantined imports a quarantined resource again through the promoter of its source
+// and removes it from the quarantine. A non-empty document replaces the quarantined one,
+// e.g. with a fixed manifest.
+func (f *NetguardFacade) PromoteQuarantined(ctx context.Context, id int64, document []byte) error {
+	if f.quarantine == nil {
+		return ErrQuarantineDisabled
+	}
+
+	resource, err := f.quarantine.GetQuarantined(ctx, id)
+	if err != nil {
+		return err
+	}
+	promoter, ok := f.quarantinePromoters[resource.Source]
+	if !ok {
+		return errors.Errorf("promotion of resources quarantined by %s is not supported", resource.Source)
+	}
+	if len(document) > 0 {
+		resource.Document = document
+	}
+
+	if err := promoter(ctx, resource); err != nil {
+		// The cause is not wrapped: a missing resource of the promoter is not a missing quarantine entry
+		return fmt.Errorf("%w: %v", ErrQuarantinePromotionFailed, err)
+	}
+
+	if err := f.quarantine.RemoveQuarantined(ctx, id); err != nil && !errors.Is(err, ports.ErrNotFound) {
+		// The resource is already imported, a leftover entry only shows up in ListQuarantined again
+		return errors.Wrapf(err, "failed to remove quarantined resource %d", id)
+	}
+	klog.Infof("✅ QUARANTINE: Promoted %s %s of quarantine entry %d", resource.Kind, resource.Key(), id)
+	return nil
+}
+
+// DeleteQuarantined drops a quarantined resource without importing it
+func (f *NetguardFacade) DeleteQuarantined(ctx context.Context, id int64) error {
+	if f.quarantine == nil {
+		return ErrQuarantineDisabled
+	}
+	return f.quarantine.RemoveQuarantined(ctx, id)
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// SetResourceValidator validates the resources of every Sync with validator before they are applied
+func (f *NetguardFacade) SetResourceValidator(validator ports.ResourceValidator) {
+	f.resourceValidator = validator
+}
+
+// validateResources submits the requested writes with the stored state of the resources to the
+// resource validator. Resources removed by FullSync because they were missing from the request
+// are not reviewed.
+func (f *NetguardFacade) validateResources(ctx context.Context, syncOp models.SyncOp, resources interface{}) error {
+	items := reflect.ValueOf(resources)
+	if f.resourceValidator == nil || items.Kind() != reflect.Slice || items.Len() == 0 {
+		return nil
+	}
+
+	reader, err := f.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader for resource validation")
+	}
+	reviews := make([]models.ResourceReview, 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		var id models.ResourceIdentifier
+		if item.Kind() == reflect.Struct {
+			if field := item.FieldByName("ResourceIdentifier"); field.IsValid() {
+				id, _ = field.Interface().(models.ResourceIdentifier)
+			}
+		}
+		if id.Name == "" {
+			reader.Close()
+			return errors.Errorf("resource %s has no identifier", item.Type().Name())
+		}
+		stored, err := storedResource(ctx, reader, item.Interface())
+		if err != nil {
+			reader.Close()
+			return errors.Wrapf(err, "failed to read stored %s %s for validation", item.Type().Name(), id.Key())
+		}
+
+		var object interface{}
+		if syncOp != models.SyncOpDelete {
+			object = item.Interface()
+		} else if stored == nil {
+			// Deletions of resources that don't exist change nothing
+			continue
+		}
+		reviews = append(reviews, models.NewResourceReview(item.Type().Name(), id, object, stored))
+	}
+	// The validator may call external services, the reader is not held meanwhile
+	reader.Close()
+
+	if len(reviews) == 0 {
+		return nil
+	}
+	return f.resourceValidator.ValidateResources(ctx, reviews)
+}
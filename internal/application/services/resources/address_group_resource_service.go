@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -38,6 +40,7 @@ type AddressGroupResourceService struct {
 	validationService  *ValidationService
 	ruleS2SRegenerator RuleS2SRegenerator
 	hostService        *HostResourceService
+	syncOutbox         interfaces.SyncOutboxNotifier
 }
 
 // RuleS2SRegenerator interface is now defined in interfaces.go to avoid circular dependencies
@@ -65,6 +68,13 @@ func (s *AddressGroupResourceService) SetRuleS2SRegenerator(regenerator RuleS2SR
 	s.ruleS2SRegenerator = regenerator
 }
 
+// SetSyncOutbox makes the service enqueue sgroups sync of address groups to the
+// transactional outbox instead of syncing them after commit. The registry writers
+// must implement ports.SyncOutboxWriter.
+func (s *AddressGroupResourceService) SetSyncOutbox(notifier interfaces.SyncOutboxNotifier) {
+	s.syncOutbox = notifier
+}
+
 // =============================================================================
 // AddressGroup Operations
 // =============================================================================
@@ -121,10 +131,84 @@ func (s *AddressGroupResourceService) GetAddressGroupsByIDs(ctx context.Context,
 	return addressGroups, nil
 }
 
+// AnalyzeAddressGroupImpact estimates which IEAgAgRules, Services and namespaces would be
+// affected by changing the address group. A nil proposed address group means deletion.
+func (s *AddressGroupResourceService) AnalyzeAddressGroupImpact(ctx context.Context, id models.ResourceIdentifier, proposed *models.AddressGroup) (*models.AddressGroupImpact, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	current, err := reader.GetAddressGroupByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get address group %s", id.Key())
+	}
+
+	impact := &models.AddressGroupImpact{
+		AddressGroup:       id,
+		Deleted:            proposed == nil,
+		EnforcementChanged: proposed == nil || addressGroupEnforcementChanged(current, proposed),
+	}
+
+	agKey := id.Key()
+	var rules []models.ResourceIdentifier
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		if rule.AddressGroupLocalKey() == agKey || rule.AddressGroupKey() == agKey {
+			rules = append(rules, rule.ResourceIdentifier)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list IEAgAgRules")
+	}
+
+	impact.RuleCountBefore = len(rules)
+	impact.RuleCountAfter = len(rules)
+	if impact.Deleted {
+		// IEAgAgRules referencing a deleted address group are removed
+		impact.RuleCountAfter = 0
+	}
+
+	// Spec changes that are not enforced (e.g. description) don't affect anything
+	if !impact.EnforcementChanged {
+		return impact, nil
+	}
+
+	var services []models.ResourceIdentifier
+	err = reader.ListServices(ctx, func(service models.Service) error {
+		if serviceReferencesAddressGroup(service, agKey) {
+			services = append(services, service.ResourceIdentifier)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list services")
+	}
+
+	sortResourceIdentifiers(rules)
+	sortResourceIdentifiers(services)
+	impact.IEAgAgRules = rules
+	impact.Services = services
+
+	namespaces := make(map[string]struct{})
+	for _, ids := range [][]models.ResourceIdentifier{rules, services} {
+		for _, ref := range ids {
+			namespaces[ref.Namespace] = struct{}{}
+		}
+	}
+	for namespace := range namespaces {
+		impact.Namespaces = append(impact.Namespaces, namespace)
+	}
+	sort.Strings(impact.Namespaces)
+
+	return impact, nil
+}
+
 // CreateAddressGroup creates a new address group
 func (s *AddressGroupResourceService) CreateAddressGroup(ctx context.Context, addressGroup models.AddressGroup) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -167,6 +251,10 @@ func (s *AddressGroupResourceService) CreateAddressGroup(ctx context.Context, ad
 	} else {
 	}
 
+	if err = s.enqueueSGroupsSync(ctx, writer, []models.AddressGroup{addressGroup}, types.SyncOperationUpsert); err != nil {
+		return err
+	}
+
 	if err = writer.Commit(); err != nil {
 		return errors.Wrap(err, "failed to commit transaction")
 	}
@@ -198,7 +286,7 @@ func (s *AddressGroupResourceService) CreateAddressGroup(ctx context.Context, ad
 // UpdateAddressGroup updates an existing address group
 func (s *AddressGroupResourceService) UpdateAddressGroup(ctx context.Context, addressGroup models.AddressGroup) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -240,6 +328,10 @@ func (s *AddressGroupResourceService) UpdateAddressGroup(ctx context.Context, ad
 		return errors.Wrap(err, "failed to update address group")
 	}
 
+	if err = s.enqueueSGroupsSync(ctx, writer, []models.AddressGroup{addressGroup}, types.SyncOperationUpsert); err != nil {
+		return err
+	}
+
 	if err = writer.Commit(); err != nil {
 		return errors.Wrap(err, "failed to commit transaction")
 	}
@@ -276,11 +368,11 @@ func (s *AddressGroupResourceService) SyncAddressGroups(ctx context.Context, add
 		}
 	}()
 
-	// Load old AddressGroup states before syncing for SGROUP validation
+	// Load old AddressGroup states before syncing for SGROUP validation and included groups changes
 	var oldAddressGroups map[string]*models.AddressGroup
-	if syncOp == models.SyncOpUpsert && s.syncManager != nil {
+	if syncOp != models.SyncOpDelete {
 		oldAddressGroups = make(map[string]*models.AddressGroup)
-		reader, err := s.registry.Reader(ctx)
+		reader, err := s.registry.PrimaryReader(ctx)
 		if err != nil {
 			return errors.Wrap(err, "failed to get reader for old state loading")
 		}
@@ -304,6 +396,13 @@ func (s *AddressGroupResourceService) SyncAddressGroups(ctx context.Context, add
 		return errors.Wrap(err, "failed to sync address groups")
 	}
 
+	// FullSync uses upsert for external systems, DELETE is handled by DeleteAddressGroupsByIDs
+	if syncOp != models.SyncOpDelete {
+		if err = s.enqueueSGroupsSync(ctx, writer, addressGroups, types.SyncOperationUpsert); err != nil {
+			return err
+		}
+	}
+
 	if err = writer.Commit(); err != nil {
 		return errors.Wrap(err, "failed to commit transaction")
 	}
@@ -320,6 +419,21 @@ func (s *AddressGroupResourceService) SyncAddressGroups(ctx context.Context, add
 		}
 	}
 
+	// Rules targeting a parent group cover its included groups, regenerate them when the inclusion changes
+	if s.ruleS2SRegenerator != nil && syncOp != models.SyncOpDelete {
+		var changedIDs []models.ResourceIdentifier
+		for _, newAG := range addressGroups {
+			if includedGroupsChanged(oldAddressGroups[newAG.Key()], newAG) {
+				changedIDs = append(changedIDs, newAG.ResourceIdentifier)
+			}
+		}
+		if len(changedIDs) > 0 {
+			if err := s.ruleS2SRegenerator.RegenerateIEAgAgRulesForAddressGroupInclusions(ctx, changedIDs); err != nil {
+				rulesLog.Error(err, "Failed to regenerate IEAgAg rules after included groups change")
+			}
+		}
+	}
+
 	// Process conditions after successful commit for each address group (skip for DELETE operations)
 	if s.conditionManager != nil && syncOp != models.SyncOpDelete {
 		for i := range addressGroups {
@@ -358,7 +472,7 @@ func (s *AddressGroupResourceService) DeleteAddressGroupsByIDs(ctx context.Conte
 		return nil
 	}
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for validation")
 	}
@@ -462,7 +576,7 @@ func (s *AddressGroupResourceService) DeleteAddressGroupsByIDs(ctx context.Conte
 			}()
 
 			// Update each Network
-			reader2, err := s.registry.Reader(ctx)
+			reader2, err := s.registry.PrimaryReader(ctx)
 			if err != nil {
 				return errors.Wrap(err, "failed to get reader for Network updates")
 			}
@@ -505,6 +619,10 @@ func (s *AddressGroupResourceService) DeleteAddressGroupsByIDs(ctx context.Conte
 		return errors.Wrap(err, "failed to delete address groups from storage")
 	}
 
+	if err = s.enqueueSGroupsSync(ctx, writer, addressGroupsToDelete, types.SyncOperationDelete); err != nil {
+		return err
+	}
+
 	if err = writer.Commit(); err != nil {
 		return errors.Wrap(err, "failed to commit transaction")
 	}
@@ -671,7 +789,7 @@ func (s *AddressGroupResourceService) CreateAddressGroupBinding(ctx context.Cont
 // UpdateAddressGroupBinding updates an existing address group binding
 func (s *AddressGroupResourceService) UpdateAddressGroupBinding(ctx context.Context, binding models.AddressGroupBinding) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -808,7 +926,7 @@ func (s *AddressGroupResourceService) SyncAddressGroupBindings(ctx context.Conte
 // DeleteAddressGroupBindingsByIDs deletes address group bindings by IDs
 func (s *AddressGroupResourceService) DeleteAddressGroupBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
 	// First, get the bindings before deletion to know which AddressGroups need port mapping regeneration
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -850,34 +968,12 @@ func (s *AddressGroupResourceService) DeleteAddressGroupBindingsByIDs(ctx contex
 
 	}
 
-	var writer ports.Writer
-	if registryWithDeletes, ok := s.registry.(interface {
-		WriterForDeletes(context.Context) (ports.Writer, error)
-	}); ok {
-		writer, err = registryWithDeletes.WriterForDeletes(ctx)
-		if err != nil {
-			return errors.Wrap(err, "failed to get delete writer with ReadCommitted isolation")
-		}
-	} else {
-		writer, err = s.registry.Writer(ctx)
-		if err != nil {
-			return errors.Wrap(err, "failed to get writer")
-		}
-	}
-	defer func() {
-		if err != nil {
-			writer.Abort()
-		}
-	}()
-
-	if err = writer.DeleteAddressGroupBindingsByIDs(ctx, ids); err != nil {
+	if err = ports.Write(ctx, s.registry, ports.DeleteWriterOptions, func(writer ports.Writer) error {
+		return writer.DeleteAddressGroupBindingsByIDs(ctx, ids)
+	}); err != nil {
 		return errors.Wrap(err, "failed to delete address group bindings")
 	}
 
-	if err = writer.Commit(); err != nil {
-		return errors.Wrap(err, "failed to commit transaction")
-	}
-
 	if s.ruleS2SRegenerator != nil {
 		// Collect unique service IDs to avoid duplicate notifications
 		serviceIDs := make(map[string]models.ResourceIdentifier)
@@ -902,7 +998,7 @@ func (s *AddressGroupResourceService) DeleteAddressGroupBindingsByIDs(ctx contex
 	// This will remove stale services that no longer have bindings
 	for _, addressGroupRef := range affectedAddressGroups {
 		// Get fresh reader after the deletion transaction
-		freshReader, err := s.registry.Reader(ctx)
+		freshReader, err := s.registry.PrimaryReader(ctx)
 		if err != nil {
 			continue // Don't fail the whole operation
 		}
@@ -1013,7 +1109,7 @@ func (s *AddressGroupResourceService) GetAddressGroupPortMappingsByIDs(ctx conte
 // CreateAddressGroupPortMapping creates a new address group port mapping
 func (s *AddressGroupResourceService) CreateAddressGroupPortMapping(ctx context.Context, mapping models.AddressGroupPortMapping) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1061,7 +1157,7 @@ func (s *AddressGroupResourceService) CreateAddressGroupPortMapping(ctx context.
 // UpdateAddressGroupPortMapping updates an existing address group port mapping
 func (s *AddressGroupResourceService) UpdateAddressGroupPortMapping(ctx context.Context, mapping models.AddressGroupPortMapping) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1283,7 +1379,7 @@ func (s *AddressGroupResourceService) CreateAddressGroupBindingPolicy(ctx contex
 // UpdateAddressGroupBindingPolicy updates an existing address group binding policy
 func (s *AddressGroupResourceService) UpdateAddressGroupBindingPolicy(ctx context.Context, policy models.AddressGroupBindingPolicy) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1458,7 +1554,7 @@ func (s *AddressGroupResourceService) SyncAddressGroupPortMappingsWithSyncOp(ctx
 
 	// Process conditions AFTER successful commit to avoid transaction conflicts
 	if s.conditionManager != nil {
-		reader, err := s.registry.Reader(ctx)
+		reader, err := s.registry.PrimaryReader(ctx)
 		if err != nil {
 			klog.Errorf("Failed to get reader for condition processing: %v", err)
 			return nil // Don't fail the operation
@@ -1495,7 +1591,7 @@ func (s *AddressGroupResourceService) SyncAddressGroupPortMappingsWithSyncOp(ctx
 func (s *AddressGroupResourceService) regenerateCompletePortMappingForAddressGroup(ctx context.Context, addressGroupName, addressGroupNamespace string) error {
 
 	// Get fresh data after binding deletion
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1556,7 +1652,7 @@ func (s *AddressGroupResourceService) regenerateCompletePortMappingForAddressGro
 // This is called when a service's ingress ports are updated to ensure mappings reflect the current ports
 func (s *AddressGroupResourceService) RegeneratePortMappingsForService(ctx context.Context, serviceID models.ResourceIdentifier) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1654,7 +1750,7 @@ func (s *AddressGroupResourceService) RegeneratePortMappingsForService(ctx conte
 // This is called when a Service with spec.addressGroups is created/updated/deleted
 func (s *AddressGroupResourceService) RegeneratePortMappingsForAddressGroup(ctx context.Context, addressGroupID models.ResourceIdentifier) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1880,7 +1976,7 @@ func (s *AddressGroupResourceService) checkPortConflictsBetweenServices(service1
 		}
 
 		// Parse port ranges from ingress port string
-		portRanges, err := validation.ParsePortRanges(ingressPort.Port)
+		portRanges, err := validation.ParseProtocolPortRanges(ingressPort.Protocol, ingressPort.Port)
 		if err != nil {
 			continue
 		}
@@ -1896,7 +1992,7 @@ func (s *AddressGroupResourceService) checkPortConflictsBetweenServices(service1
 			service2Ports[transport] = make([]models.PortRange, 0)
 		}
 
-		portRanges, err := validation.ParsePortRanges(ingressPort.Port)
+		portRanges, err := validation.ParseProtocolPortRanges(ingressPort.Protocol, ingressPort.Port)
 		if err != nil {
 			continue
 		}
@@ -1943,6 +2039,84 @@ func (s *AddressGroupResourceService) syncAddressGroupBindingPolicies(ctx contex
 	return nil
 }
 
+// enqueueSGroupsSync stores sgroups sync of address groups in the writer transaction,
+// so the sync is delivered by the outbox dispatcher even if the process crashes after commit
+func (s *AddressGroupResourceService) enqueueSGroupsSync(ctx context.Context, writer ports.Writer, addressGroups []models.AddressGroup, operation types.SyncOperation) error {
+	if s.syncOutbox == nil || len(addressGroups) == 0 {
+		return nil
+	}
+
+	outboxWriter, ok := writer.(ports.SyncOutboxWriter)
+	if !ok {
+		return errors.New("writer doesn't support sync outbox")
+	}
+
+	entries := make([]models.SyncOutboxEntry, 0, len(addressGroups))
+	for _, addressGroup := range addressGroups {
+		entries = append(entries, models.NewAddressGroupSyncOutboxEntry(addressGroup, operation))
+	}
+	if err := outboxWriter.EnqueueSyncOutbox(ctx, entries); err != nil {
+		return errors.Wrap(err, "failed to enqueue address groups sgroups sync")
+	}
+	return nil
+}
+
+// EnqueueSGroupsDeletion stores the sgroups deletion of address groups deleted by writer in its
+// transaction, it does nothing when the outbox is not enabled
+func (s *AddressGroupResourceService) EnqueueSGroupsDeletion(ctx context.Context, writer ports.Writer, addressGroups []models.AddressGroup) error {
+	return s.enqueueSGroupsSync(ctx, writer, addressGroups, types.SyncOperationDelete)
+}
+
+// SyncDeletedAddressGroups syncs committed deletions of address groups with sgroups, through the
+// outbox when deletions were enqueued by EnqueueSGroupsDeletion
+func (s *AddressGroupResourceService) SyncDeletedAddressGroups(ctx context.Context, addressGroups []models.AddressGroup) {
+	s.syncAddressGroupsWithSGroups(ctx, addressGroups, types.SyncOperationDelete)
+}
+
+// RequeueSGroupsSync enqueues a fresh sgroups sync for an address group whose previous
+// sync was dead-lettered. The current state is synced instead of the stale snapshot:
+// an existing address group is upserted, a missing one is deleted.
+func (s *AddressGroupResourceService) RequeueSGroupsSync(ctx context.Context, snapshot models.AddressGroup) error {
+	if s.syncOutbox == nil {
+		return errors.New("sync outbox is not enabled")
+	}
+
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader")
+	}
+	current, err := reader.GetAddressGroupByID(ctx, snapshot.ResourceIdentifier)
+	reader.Close()
+
+	addressGroup, operation := snapshot, types.SyncOperationDelete
+	switch {
+	case err == nil:
+		addressGroup, operation = *current, types.SyncOperationUpsert
+	case !errors.Is(err, ports.ErrNotFound):
+		return errors.Wrapf(err, "failed to get address group %s", snapshot.Key())
+	}
+
+	writer, err := s.registry.Writer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer")
+	}
+	defer func() {
+		if err != nil {
+			writer.Abort()
+		}
+	}()
+
+	if err = s.enqueueSGroupsSync(ctx, writer, []models.AddressGroup{addressGroup}, operation); err != nil {
+		return err
+	}
+	if err = writer.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit")
+	}
+
+	s.syncOutbox.Notify()
+	return nil
+}
+
 // syncAddressGroupsWithSGroups syncs address groups with external sgroups system
 func (s *AddressGroupResourceService) syncAddressGroupsWithSGroups(ctx context.Context, addressGroups []models.AddressGroup, operation types.SyncOperation) {
 
@@ -1985,12 +2159,15 @@ func (s *AddressGroupResourceService) syncAddressGroupsWithSGroups(ctx context.C
 
 	// Perform batch sync for all syncable address groups
 	if len(syncableEntities) > 0 {
-		if err := s.syncManager.SyncBatch(ctx, syncableEntities, operation); err != nil {
+		if s.syncOutbox != nil {
+			// Already enqueued in the write transaction, let the dispatcher deliver it
+			s.syncOutbox.Notify()
+		} else if err := s.syncManager.SyncBatch(ctx, syncableEntities, operation); err != nil {
 		}
 	}
 
 	if len(allHostReferences) > 0 {
-		reader, err := s.registry.Reader(ctx)
+		reader, err := s.registry.PrimaryReader(ctx)
 		if err != nil {
 			return
 		}
@@ -2108,7 +2285,7 @@ func (s *AddressGroupResourceService) generateCompleteAddressGroupPortMapping(ct
 			}
 
 			// Parse port ranges from ingress port string (supports "80", "8080-9090", "80,443")
-			portRanges, err := validation.ParsePortRanges(ingressPort.Port)
+			portRanges, err := validation.ParseProtocolPortRanges(ingressPort.Protocol, ingressPort.Port)
 			if err != nil {
 				continue // Skip invalid ports
 			}
@@ -2119,6 +2296,11 @@ func (s *AddressGroupResourceService) generateCompleteAddressGroupPortMapping(ct
 			}
 		}
 
+		// Store each protocol as merged ranges, adjacent ranges of a service are one range
+		for transport, portRanges := range servicePorts.Ports {
+			servicePorts.Ports[transport] = validation.MergePortRanges(portRanges)
+		}
+
 		addressGroupPortMapping.AccessPorts[serviceRef] = servicePorts
 	}
 
@@ -2137,7 +2319,7 @@ func (s *AddressGroupResourceService) generateCompleteAddressGroupPortMapping(ct
 
 // FindServicesForAddressGroups finds all services that are bound to given address groups
 func (s *AddressGroupResourceService) FindServicesForAddressGroups(ctx context.Context, addressGroupIDs []models.ResourceIdentifier) ([]models.Service, error) {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get reader")
 	}
@@ -2190,7 +2372,7 @@ func (s *AddressGroupResourceService) FindServicesForAddressGroups(ctx context.C
 func (s *AddressGroupResourceService) synchronizeServiceAddressGroups(ctx context.Context, serviceID models.ResourceIdentifier) error {
 
 	// Step 1: Get current service
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for service sync")
 	}
@@ -2205,19 +2387,9 @@ func (s *AddressGroupResourceService) synchronizeServiceAddressGroups(ctx contex
 	}
 
 
-	var writer ports.Writer
-	if registryWithConditions, ok := s.registry.(interface {
-		WriterForConditions(context.Context) (ports.Writer, error)
-	}); ok {
-		writer, err = registryWithConditions.WriterForConditions(ctx)
-		if err != nil {
-			return errors.Wrap(err, "failed to get condition writer with ReadCommitted isolation for service sync")
-		}
-	} else {
-		writer, err = s.registry.Writer(ctx)
-		if err != nil {
-			return errors.Wrap(err, "failed to get writer for service sync")
-		}
+	writer, err := s.registry.WriterWithOptions(ctx, ports.WriterOptions{Isolation: ports.IsolationReadCommitted})
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer with ReadCommitted isolation for service sync")
 	}
 	defer func() {
 		if err != nil {
@@ -2246,7 +2418,7 @@ func (s *AddressGroupResourceService) updateHostBindingStatusForSyncedAddressGro
 
 	for _, ag := range addressGroups {
 
-		reader, err := s.registry.Reader(ctx)
+		reader, err := s.registry.PrimaryReader(ctx)
 		if err != nil {
 			continue
 		}
@@ -2273,7 +2445,7 @@ func (s *AddressGroupResourceService) updateHostBindingStatusForSyncedAddressGro
 			}
 
 		case models.SyncOpUpsert, models.SyncOpFullSync:
-			reader, err := s.registry.Reader(ctx)
+			reader, err := s.registry.PrimaryReader(ctx)
 			if err != nil {
 				continue
 			}
@@ -2431,7 +2603,7 @@ func (s *AddressGroupResourceService) getHostChanges(newAG models.AddressGroup,
 
 // validateHostsSGroupSync validates a list of hosts with SGROUP
 func (s *AddressGroupResourceService) validateHostsSGroupSync(ctx context.Context, hosts []netguardv1beta1.ObjectReference, agID models.ResourceIdentifier) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for host validation")
 	}
@@ -2472,7 +2644,7 @@ func (s *AddressGroupResourceService) forceSyncRemovedHostsWithSGroup(ctx contex
 		return nil
 	}
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil
 	}
@@ -2510,7 +2682,7 @@ func (s *AddressGroupResourceService) syncSpecHostsWithSGroups(ctx context.Conte
 		return nil
 	}
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil
 	}
@@ -2549,7 +2721,7 @@ func (s *AddressGroupResourceService) syncHostChangesWithSGroup(ctx context.Cont
 	}
 
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil
 	}
@@ -2587,3 +2759,58 @@ func (s *AddressGroupResourceService) syncHostChangesWithSGroup(ctx context.Cont
 
 	return nil
 }
+
+// addressGroupEnforcementChanged reports whether the proposed address group differs from the
+// current one in fields that are synchronized to sgroups
+func addressGroupEnforcementChanged(current, proposed *models.AddressGroup) bool {
+	return current.DefaultAction != proposed.DefaultAction ||
+		current.Logs != proposed.Logs ||
+		current.Trace != proposed.Trace ||
+		!reflect.DeepEqual(current.Networks, proposed.Networks) ||
+		!reflect.DeepEqual(current.Hosts, proposed.Hosts)
+}
+
+// serviceReferencesAddressGroup checks spec and aggregated address groups of the service
+func serviceReferencesAddressGroup(service models.Service, agKey string) bool {
+	for _, ref := range service.AddressGroups {
+		if models.AddressGroupRefKey(ref) == agKey {
+			return true
+		}
+	}
+	for _, ref := range service.AggregatedAddressGroups {
+		if models.AddressGroupRefKey(ref.Ref) == agKey {
+			return true
+		}
+	}
+	return false
+}
+
+func sortResourceIdentifiers(ids []models.ResourceIdentifier) {
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Key() < ids[j].Key()
+	})
+}
+
+// includedGroupsChanged reports whether the included groups of an address group differ from its old state,
+// a new group changes them when it includes any group
+func includedGroupsChanged(oldAG *models.AddressGroup, newAG models.AddressGroup) bool {
+	var oldRefs []models.AddressGroupRef
+	if oldAG != nil {
+		oldRefs = oldAG.IncludedGroupRefs()
+	}
+	newRefs := newAG.IncludedGroupRefs()
+	if len(oldRefs) != len(newRefs) {
+		return true
+	}
+
+	oldKeys := make(map[string]bool, len(oldRefs))
+	for _, ref := range oldRefs {
+		oldKeys[models.AddressGroupRefKey(ref)] = true
+	}
+	for _, ref := range newRefs {
+		if !oldKeys[models.AddressGroupRefKey(ref)] {
+			return true
+		}
+	}
+	return false
+}
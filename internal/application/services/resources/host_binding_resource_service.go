@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"netguard-pg-backend/internal/application/utils"
+	"netguard-pg-backend/internal/application/validation"
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
@@ -127,7 +128,7 @@ func (s *HostBindingResourceService) UpdateHostBinding(ctx context.Context, host
 	}
 
 	// Get reader to validate existing host binding
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -199,7 +200,7 @@ func (s *HostBindingResourceService) DeleteHostBinding(ctx context.Context, id m
 	defer writer.Abort()
 
 	// Check if HostBinding exists before deletion
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -232,7 +233,7 @@ func (s *HostBindingResourceService) DeleteHostBinding(ctx context.Context, id m
 	}
 
 	// Get reader to load the Host
-	readerForHost, err := s.repo.Reader(ctx)
+	readerForHost, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 	} else {
 		defer readerForHost.Close()
@@ -271,7 +272,7 @@ func (s *HostBindingResourceService) DeleteHostBinding(ctx context.Context, id m
 	}
 
 	// Get reader to load the AddressGroup
-	readerForAG, err := s.repo.Reader(ctx)
+	readerForAG, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		// Don't fail the entire operation, just log the warning
 	} else {
@@ -433,7 +434,8 @@ func (s *HostBindingResourceService) validateNamespacedObjectReference(ref v1bet
 		return fmt.Errorf("expected %s reference kind to be %s, got %s", expectedKind, expectedKind, ref.Kind)
 	}
 
-	return nil
+	// The referenced object must be of the netguard API group
+	return validation.ValidateReferenceType(ref.ObjectReference, expectedKind)
 }
 
 // SyncStatusUpdate handles sync status updates for host bindings
@@ -512,7 +514,7 @@ func (s *HostBindingResourceService) getHostBindingByIDWithReader(ctx context.Co
 // getHostBindingByHostID finds a HostBinding that binds the specified Host
 func (s *HostBindingResourceService) getHostBindingByHostID(ctx context.Context, hostID models.ResourceIdentifier) (*models.HostBinding, error) {
 
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"netguard-pg-backend/internal/application/utils"
+	"netguard-pg-backend/internal/application/validation"
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
@@ -51,13 +52,15 @@ func (s *HostResourceService) CreateHost(ctx context.Context, host *models.Host)
 		return fmt.Errorf("invalid host: %w", err)
 	}
 
-	// Check if Host already exists
-	existing, err := s.getHostByID(ctx, host.Key())
-	if err != nil && !errors.Is(err, ports.ErrNotFound) {
-		return fmt.Errorf("failed to check existing host: %w", err)
+	// Check that the Host doesn't exist and its UUID is free
+	reader, err := s.repo.PrimaryReader(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get reader: %w", err)
 	}
-	if existing != nil {
-		return fmt.Errorf("host already exists: %s", host.Key())
+	validationErr := validation.NewDependencyValidator(reader).GetHostValidator().ValidateForCreation(ctx, *host)
+	reader.Close()
+	if validationErr != nil {
+		return validationErr
 	}
 
 	// Initialize metadata
@@ -110,6 +113,22 @@ func (s *HostResourceService) UpdateHost(ctx context.Context, host *models.Host)
 		return fmt.Errorf("invalid host: %w", err)
 	}
 
+	// Check that the Host exists and its UUID is unchanged
+	reader, err := s.repo.PrimaryReader(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get reader: %w", err)
+	}
+	existing, err := reader.GetHostByID(ctx, host.ResourceIdentifier)
+	if err != nil {
+		reader.Close()
+		return fmt.Errorf("failed to get existing host %s: %w", host.Key(), err)
+	}
+	validationErr := validation.NewDependencyValidator(reader).GetHostValidator().ValidateForUpdate(ctx, *existing, *host)
+	reader.Close()
+	if validationErr != nil {
+		return validationErr
+	}
+
 	// Update metadata
 	host.GetMeta().TouchOnWrite(fmt.Sprintf("%d", time.Now().UnixNano()))
 
@@ -168,7 +187,7 @@ func (s *HostResourceService) DeleteHost(ctx context.Context, id models.Resource
 
 	if existing.IsBound && existing.AddressGroupRef != nil && existing.BindingRef == nil {
 
-		reader, err := s.repo.Reader(ctx)
+		reader, err := s.repo.PrimaryReader(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to get reader: %w", err)
 		}
@@ -269,7 +288,7 @@ func (s *HostResourceService) DeleteHost(ctx context.Context, id models.Resource
 	}
 
 	if addressGroupToSync != nil && s.syncManager != nil {
-		reader, err := s.repo.Reader(ctx)
+		reader, err := s.repo.PrimaryReader(ctx)
 		if err == nil {
 			if updatedAG, err := reader.GetAddressGroupByID(ctx, models.ResourceIdentifier{
 				Name:      addressGroupToSync.Name,
@@ -293,6 +312,38 @@ func (s *HostResourceService) GetHost(ctx context.Context, id models.ResourceIde
 	return s.getHostByID(ctx, id.Key())
 }
 
+// GetHostsByIDs retrieves Hosts by resource identifiers, missing hosts are skipped
+func (s *HostResourceService) GetHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier) ([]models.Host, error) {
+	reader, err := s.repo.Reader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reader: %w", err)
+	}
+	defer reader.Close()
+
+	var hosts []models.Host
+	for _, id := range ids {
+		host, err := reader.GetHostByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, ports.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get host %s: %w", id.Key(), err)
+		}
+		hosts = append(hosts, *host)
+	}
+	return hosts, nil
+}
+
+// DeleteHostsByIDs deletes Hosts with their HostBindings and AddressGroup memberships
+func (s *HostResourceService) DeleteHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
+	for _, id := range ids {
+		if err := s.DeleteHost(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete host %s: %w", id.Key(), err)
+		}
+	}
+	return nil
+}
+
 // ListHosts retrieves all Hosts within a scope
 func (s *HostResourceService) ListHosts(ctx context.Context, scope ports.Scope) ([]models.Host, error) {
 
@@ -450,7 +501,7 @@ func (s *HostResourceService) SyncStatusUpdate(ctx context.Context, resourceType
 // findHostBindingByHostID finds a HostBinding that binds the specified Host
 func (s *HostResourceService) findHostBindingByHostID(ctx context.Context, hostID models.ResourceIdentifier) (*models.HostBinding, error) {
 
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -483,7 +534,7 @@ func (s *HostResourceService) findHostBindingByHostID(ctx context.Context, hostI
 
 // getHostByID retrieves a host by its key using Reader pattern
 func (s *HostResourceService) getHostByID(ctx context.Context, id string) (*models.Host, error) {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,94 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// IEAgAgRuleEngine generates IEAgAgRules for RuleS2S. Every path producing
+// IEAgAgRules (condition processing, regeneration, recalculation, the
+// Generate API) uses the engine of the service, so they never disagree on
+// ports, logs or the namespace of generated rules.
+type IEAgAgRuleEngine interface {
+	// Generate returns keys of the expected rules and the rules themselves.
+	// Rules with excluded ids don't contribute to the result.
+	Generate(ctx context.Context, reader ports.Reader, rules []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, error)
+	// GenerateIndexed works as Generate and also returns which RuleS2S contribute to which
+	// generated rules. Only candidates contribute ports, nil candidates mean all RuleS2S.
+	GenerateIndexed(ctx context.Context, reader ports.Reader, rules, candidates []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, []models.IEAgAgRuleContribution, error)
+}
+
+// aggregatedRuleEngine aggregates ports of all RuleS2S contributing to the same
+// AddressGroup pair into one IEAgAgRule placed in the namespace of the receiving AddressGroup
+type aggregatedRuleEngine struct {
+	service *RuleS2SResourceService
+}
+
+func (e aggregatedRuleEngine) Generate(ctx context.Context, reader ports.Reader, rules []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, error) {
+	return e.service.generateAggregatedIEAgAgRules(ctx, reader, rules, excludeRuleIDs...)
+}
+
+func (e aggregatedRuleEngine) GenerateIndexed(ctx context.Context, reader ports.Reader, rules, candidates []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, []models.IEAgAgRuleContribution, error) {
+	return e.service.generateIndexedAggregatedIEAgAgRules(ctx, reader, rules, candidates, excludeRuleIDs...)
+}
+
+// legacyRuleEngine generates rules of every RuleS2S independently in the RuleS2S
+// namespace with logs disabled. Kept for installations relying on the old output.
+type legacyRuleEngine struct {
+	service *RuleS2SResourceService
+}
+
+func (e legacyRuleEngine) Generate(ctx context.Context, reader ports.Reader, rules []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, error) {
+	expected, generated, _, err := e.GenerateIndexed(ctx, reader, rules, nil, excludeRuleIDs...)
+	return expected, generated, err
+}
+
+// GenerateIndexed ignores candidates: every rule is generated from its own RuleS2S only
+func (e legacyRuleEngine) GenerateIndexed(ctx context.Context, reader ports.Reader, rules, _ []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, []models.IEAgAgRuleContribution, error) {
+	excluded := make(map[string]bool, len(excludeRuleIDs))
+	for _, id := range excludeRuleIDs {
+		excluded[id.Key()] = true
+	}
+
+	expected := make(map[string]bool)
+	var generated []models.IEAgAgRule
+	var contributions []models.IEAgAgRuleContribution
+	for _, rule := range rules {
+		if excluded[rule.ResourceIdentifier.Key()] || !rule.IsActiveAt(time.Now()) {
+			continue
+		}
+		ruleIEAgAgRules, err := e.service.generateIEAgAgRulesForRuleS2S(ctx, reader, rule)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "failed to generate IEAgAgRules for RuleS2S %s", rule.Key())
+		}
+		for _, ieRule := range ruleIEAgAgRules {
+			contributions = append(contributions, models.IEAgAgRuleContribution{
+				RuleS2S:    rule.ResourceIdentifier,
+				IEAgAgRule: ieRule.ResourceIdentifier,
+				Ports:      rulePortDestinations(ieRule),
+			})
+			if expected[ieRule.Key()] {
+				continue
+			}
+			expected[ieRule.Key()] = true
+			generated = append(generated, ieRule)
+		}
+	}
+	return expected, generated, contributions, nil
+}
+
+// rulePortDestinations returns the destination ports of the rule
+func rulePortDestinations(rule models.IEAgAgRule) []string {
+	var destinations []string
+	for _, portSpec := range rule.Ports {
+		if portSpec.Destination != "" {
+			destinations = append(destinations, portSpec.Destination)
+		}
+	}
+	return destinations
+}
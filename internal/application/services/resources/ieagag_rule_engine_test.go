@@ -0,0 +1,164 @@
+package resources
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+// newRuleEngineTestRegistry stores a client service, a web service on port 80 in web-ag and
+// a web-tls service on port 443 in tlsAddressGroup, plus one Ready RuleS2S from the client to each of them
+func newRuleEngineTestRegistry(t *testing.T, tlsAddressGroup string) (ports.Registry, []models.RuleS2S) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+
+	newService := func(name, addressGroup string, port string) models.Service {
+		return models.Service{
+			SelfRef:      models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("app"))),
+			IngressPorts: []models.IngressPort{{Protocol: models.TCP, Port: port}},
+			AggregatedAddressGroups: []models.AddressGroupReference{
+				{Ref: models.NewAddressGroupRef(addressGroup, models.WithNamespace("app")), Source: models.AddressGroupSourceSpec},
+			},
+		}
+	}
+	newRule := func(name, target string) models.RuleS2S {
+		rule := models.RuleS2S{
+			SelfRef:         models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("app"))),
+			Traffic:         models.EGRESS,
+			ServiceLocalRef: models.NewServiceRef("client", models.WithNamespace("app")),
+			ServiceRef:      models.NewServiceRef(target, models.WithNamespace("app")),
+		}
+		rule.Meta.SetReadyCondition(metav1.ConditionTrue, models.ReasonReady, "ready")
+		return rule
+	}
+
+	addressGroups := []models.AddressGroup{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("client-ag", models.WithNamespace("app")))},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web-ag", models.WithNamespace("app")))},
+	}
+	if tlsAddressGroup != "web-ag" {
+		addressGroups = append(addressGroups, models.AddressGroup{
+			SelfRef: models.NewSelfRef(models.NewResourceIdentifier(tlsAddressGroup, models.WithNamespace("app"))),
+		})
+	}
+	services := []models.Service{
+		newService("client", "client-ag", "8080"),
+		newService("web", "web-ag", "80"),
+		newService("web-tls", tlsAddressGroup, "443"),
+	}
+	rules := []models.RuleS2S{newRule("to-web", "web"), newRule("to-web-tls", "web-tls")}
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncAddressGroups(ctx, addressGroups, nil))
+	require.NoError(t, writer.SyncServices(ctx, services, nil))
+	require.NoError(t, writer.SyncRuleS2S(ctx, rules, nil))
+	require.NoError(t, writer.Commit())
+
+	return registry, rules
+}
+
+func generateWithEngine(t *testing.T, service *RuleS2SResourceService, rules []models.RuleS2S) []models.IEAgAgRule {
+	ctx := context.Background()
+	reader, err := service.registry.Reader(ctx)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	_, generated, err := service.ruleEngine.Generate(ctx, reader, rules)
+	require.NoError(t, err)
+	sort.Slice(generated, func(i, j int) bool { return generated[i].Key() < generated[j].Key() })
+	return generated
+}
+
+func TestRuleS2SResourceService_AggregatedRuleEngine(t *testing.T) {
+	registry, rules := newRuleEngineTestRegistry(t, "web-ag")
+	service := NewRuleS2SResourceService(registry, nil, nil)
+
+	_, isAggregated := service.ruleEngine.(aggregatedRuleEngine)
+	require.True(t, isAggregated, "aggregated engine must be the default")
+
+	generated := generateWithEngine(t, service, rules)
+	require.Len(t, generated, 1, "rules of the same AddressGroup pair must be aggregated")
+
+	rule := generated[0]
+	assert.Equal(t, "app", rule.Namespace)
+	assert.Equal(t, "client-ag", rule.AddressGroupLocal.Name)
+	assert.Equal(t, "web-ag", rule.AddressGroup.Name)
+	assert.True(t, rule.Logs)
+	require.Len(t, rule.Ports, 1)
+	assert.ElementsMatch(t, []string{"80", "443"}, strings.Split(rule.Ports[0].Destination, ","))
+}
+
+func TestRuleS2SResourceService_LegacyRuleEngine(t *testing.T) {
+	registry, rules := newRuleEngineTestRegistry(t, "tls-ag")
+	service := NewRuleS2SResourceService(registry, nil, nil)
+
+	service.SetLegacyRuleGeneration(true)
+	_, isLegacy := service.ruleEngine.(legacyRuleEngine)
+	require.True(t, isLegacy)
+
+	generated := generateWithEngine(t, service, rules)
+	require.Len(t, generated, 2)
+
+	var destinations []string
+	for _, rule := range generated {
+		assert.Equal(t, "app", rule.Namespace, "legacy rules are placed in the RuleS2S namespace")
+		assert.False(t, rule.Logs, "legacy rules have logs disabled")
+		require.Len(t, rule.Ports, 1)
+		destinations = append(destinations, rule.Ports[0].Destination)
+	}
+	assert.ElementsMatch(t, []string{"80", "443"}, destinations)
+
+	service.SetLegacyRuleGeneration(false)
+	_, isAggregated := service.ruleEngine.(aggregatedRuleEngine)
+	assert.True(t, isAggregated, "disabling legacy generation must restore the aggregated engine")
+	for _, rule := range generateWithEngine(t, service, rules) {
+		assert.True(t, rule.Logs)
+	}
+}
+
+func TestLegacyRuleEngine_GenerateIndexed(t *testing.T) {
+	registry, rules := newRuleEngineTestRegistry(t, "web-ag")
+	service := NewRuleS2SResourceService(registry, nil, nil)
+	engine := legacyRuleEngine{service: service}
+
+	ctx := context.Background()
+	reader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	expected, generated, contributions, err := engine.GenerateIndexed(ctx, reader, rules, nil, rules[1].ResourceIdentifier)
+	require.NoError(t, err)
+	require.Len(t, generated, 1, "excluded RuleS2S must not generate rules")
+	assert.True(t, expected[generated[0].Key()])
+	require.Len(t, contributions, 1)
+	assert.Equal(t, rules[0].ResourceIdentifier, contributions[0].RuleS2S)
+	assert.Equal(t, []string{"80"}, contributions[0].Ports)
+}
+
+func TestRuleS2SResourceService_GenerateIEAgAgRulesFromRuleS2S_UsesEngine(t *testing.T) {
+	registry, rules := newRuleEngineTestRegistry(t, "web-ag")
+	service := NewRuleS2SResourceService(registry, nil, nil)
+	ctx := context.Background()
+
+	generated, err := service.GenerateIEAgAgRulesFromRuleS2S(ctx, rules[0])
+	require.NoError(t, err)
+	require.Len(t, generated, 1)
+	assert.ElementsMatch(t, []string{"80", "443"}, strings.Split(generated[0].Ports[0].Destination, ","),
+		"aggregated engine must include ports of all contributing RuleS2S")
+
+	service.SetLegacyRuleGeneration(true)
+	generated, err = service.GenerateIEAgAgRulesFromRuleS2S(ctx, rules[0])
+	require.NoError(t, err)
+	require.Len(t, generated, 1)
+	assert.Equal(t, "80", generated[0].Ports[0].Destination, "legacy engine must only use ports of the RuleS2S itself")
+}
@@ -21,6 +21,10 @@ type RuleS2SRegenerator interface {
 	// Called when AddressGroupBinding is created/updated/deleted
 	RegenerateIEAgAgRulesForAddressGroupBinding(ctx context.Context, bindingID models.ResourceIdentifier) error
 
+	// RegenerateIEAgAgRulesForAddressGroupInclusions regenerates IEAgAg rules affected by changed AddressGroup.IncludedGroups
+	// Called when AddressGroups including other groups are created/updated
+	RegenerateIEAgAgRulesForAddressGroupInclusions(ctx context.Context, addressGroupIDs []models.ResourceIdentifier) error
+
 	// 🎯 NEW: NotifyServiceAddressGroupsChanged triggers RuleS2S condition recalculation when Service.AddressGroups changes
 	// This method enables the reactive dependency chain: AddressGroupBinding → Service.AddressGroups → RuleS2S conditions
 	// Called after updateServiceAddressGroups successfully updates a Service
@@ -10,6 +10,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"netguard-pg-backend/internal/application/utils"
+	"netguard-pg-backend/internal/application/validation"
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/sync/interfaces"
@@ -84,6 +85,11 @@ func (s *NetworkBindingResourceService) CreateNetworkBinding(ctx context.Context
 		return fmt.Errorf("address group validation failed: %w", err)
 	}
 
+	// Validate that the Network CIDR doesn't overlap networks of the AddressGroup
+	if err := validation.NewNetworkBindingValidator(reader).ValidateCIDROverlap(ctx, *binding); err != nil {
+		return fmt.Errorf("network CIDR validation failed: %w", err)
+	}
+
 	// Check if NetworkBinding already exists
 	existing, err := s.getNetworkBindingByIDWithReader(ctx, reader, binding.Key())
 	if err != nil && !errors.Is(err, ports.ErrNotFound) {
@@ -164,6 +170,11 @@ func (s *NetworkBindingResourceService) UpdateNetworkBinding(ctx context.Context
 
 	// Check if Network or AddressGroup references have changed
 	if existing.NetworkRef.Name != binding.NetworkRef.Name || existing.AddressGroupRef.Name != binding.AddressGroupRef.Name {
+		// Validate that the Network CIDR doesn't overlap networks of the new AddressGroup
+		if err := s.validateCIDROverlap(ctx, binding); err != nil {
+			return fmt.Errorf("network CIDR validation failed: %w", err)
+		}
+
 		// Convert existing ObjectReference to ResourceIdentifier
 		existingNetworkRef := models.ResourceIdentifier{Name: existing.NetworkRef.Name, Namespace: existing.Namespace}
 		existingAddressGroupRef := models.ResourceIdentifier{Name: existing.AddressGroupRef.Name, Namespace: existing.Namespace}
@@ -333,7 +344,7 @@ func (s *NetworkBindingResourceService) ListNetworkBindings(ctx context.Context,
 // Helper methods
 
 func (s *NetworkBindingResourceService) getNetworkBindingByID(ctx context.Context, id string) (*models.NetworkBinding, error) {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -352,7 +363,13 @@ func (s *NetworkBindingResourceService) getNetworkBindingByID(ctx context.Contex
 }
 
 func (s *NetworkBindingResourceService) validateNetwork(ctx context.Context, networkRef models.ResourceIdentifier) error {
-	network, err := s.networkResourceService.GetNetwork(ctx, networkRef)
+	reader, err := s.repo.PrimaryReader(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get reader: %w", err)
+	}
+	defer reader.Close()
+
+	network, err := reader.GetNetworkByID(ctx, networkRef)
 	if err != nil {
 		return fmt.Errorf("failed to get network: %w", err)
 	}
@@ -363,7 +380,7 @@ func (s *NetworkBindingResourceService) validateNetwork(ctx context.Context, net
 }
 
 func (s *NetworkBindingResourceService) validateAddressGroup(ctx context.Context, addressGroupRef models.ResourceIdentifier) error {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -379,6 +396,17 @@ func (s *NetworkBindingResourceService) validateAddressGroup(ctx context.Context
 	return nil
 }
 
+// validateCIDROverlap validates that the Network CIDR doesn't overlap networks bound to the AddressGroup
+func (s *NetworkBindingResourceService) validateCIDROverlap(ctx context.Context, binding *models.NetworkBinding) error {
+	reader, err := s.repo.PrimaryReader(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get reader: %w", err)
+	}
+	defer reader.Close()
+
+	return validation.NewNetworkBindingValidator(reader).ValidateCIDROverlap(ctx, *binding)
+}
+
 // validateAddressGroupWithReader validates AddressGroup using provided reader (same session)
 func (s *NetworkBindingResourceService) validateAddressGroupWithReader(ctx context.Context, reader ports.Reader, addressGroupRef models.ResourceIdentifier) error {
 	addressGroup, err := reader.GetAddressGroupByID(ctx, addressGroupRef)
@@ -408,7 +436,7 @@ func (s *NetworkBindingResourceService) getNetworkBindingByIDWithReader(ctx cont
 // getFreshAddressGroupFromDatabase reads the latest AddressGroup data from database
 // This ensures sgroups synchronization uses the most up-to-date Networks field
 func (s *NetworkBindingResourceService) getFreshAddressGroupFromDatabase(ctx context.Context, addressGroupRef models.ResourceIdentifier) (*models.AddressGroup, error) {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -427,7 +455,7 @@ func (s *NetworkBindingResourceService) getFreshAddressGroupFromDatabase(ctx con
 
 // updateAddressGroupNetworks updates the Networks.Items field in AddressGroup
 func (s *NetworkBindingResourceService) updateAddressGroupNetworks(ctx context.Context, addressGroupRef, networkRef models.ResourceIdentifier, binding *models.NetworkBinding, add bool) error {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -443,7 +471,7 @@ func (s *NetworkBindingResourceService) updateAddressGroupNetworks(ctx context.C
 	}
 
 	// Get the Network
-	network, err := s.networkResourceService.GetNetwork(ctx, networkRef)
+	network, err := reader.GetNetworkByID(ctx, networkRef)
 	if err != nil {
 		return fmt.Errorf("failed to get network: %w", err)
 	}
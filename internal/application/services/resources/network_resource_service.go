@@ -29,6 +29,7 @@ type NetworkResourceService struct {
 	retryConfig      utils.RetryConfig
 	syncManager      interfaces.SyncManager
 	conditionManager NetworkConditionManagerInterface
+	ipam             ports.IPAM
 }
 
 // NewNetworkResourceService creates a new NetworkResourceService
@@ -46,11 +47,19 @@ func NewNetworkResourceService(
 	}
 }
 
+// SetIPAM makes the service reserve Network CIDRs in the external IPAM before
+// persisting them and release them when Networks are deleted
+func (s *NetworkResourceService) SetIPAM(ipam ports.IPAM) {
+	s.ipam = ipam
+}
+
 // CreateNetwork creates a new Network with business logic validation
 func (s *NetworkResourceService) CreateNetwork(ctx context.Context, network *models.Network) error {
-	// Validate CIDR format
-	if err := s.validateCIDR(network.CIDR); err != nil {
-		return fmt.Errorf("invalid CIDR: %w", err)
+	// Validate CIDR format; networks without CIDR get a free range from IPAM
+	if network.CIDR != "" || s.ipam == nil {
+		if err := s.validateCIDR(network); err != nil {
+			return fmt.Errorf("invalid CIDR: %w", err)
+		}
 	}
 
 	// Check if Network already exists
@@ -62,6 +71,18 @@ func (s *NetworkResourceService) CreateNetwork(ctx context.Context, network *mod
 		return fmt.Errorf("network already exists: %s", network.Key())
 	}
 
+	// Reserve the CIDR in IPAM, the reservation is released if the network is not persisted
+	allocated, err := s.allocateCIDR(ctx, network)
+	if err != nil {
+		return err
+	}
+	persisted := false
+	defer func() {
+		if allocated && !persisted {
+			s.releaseCIDR(ctx, network.ResourceIdentifier, network.CIDR)
+		}
+	}()
+
 	// Initialize metadata
 	network.GetMeta().TouchOnCreate()
 
@@ -81,6 +102,7 @@ func (s *NetworkResourceService) CreateNetwork(ctx context.Context, network *mod
 	if err := writer.Commit(); err != nil {
 		return fmt.Errorf("failed to commit network creation: %w", err)
 	}
+	persisted = true
 
 	// Sync with external systems
 	syncErr := s.syncNetworkWithExternal(ctx, network, types.SyncOperationUpsert)
@@ -100,7 +122,7 @@ func (s *NetworkResourceService) CreateNetwork(ctx context.Context, network *mod
 // UpdateNetwork updates an existing Network with business logic validation
 func (s *NetworkResourceService) UpdateNetwork(ctx context.Context, network *models.Network) error {
 	// Validate CIDR format
-	if err := s.validateCIDR(network.CIDR); err != nil {
+	if err := s.validateCIDR(network); err != nil {
 		return fmt.Errorf("invalid CIDR: %w", err)
 	}
 
@@ -116,11 +138,26 @@ func (s *NetworkResourceService) UpdateNetwork(ctx context.Context, network *mod
 	// Check if Network is bound and prevent certain changes
 	if existing.IsBound {
 		// Prevent changing CIDR when bound
-		if existing.CIDR != network.CIDR {
+		if !models.CIDRsEqual(existing.CIDR, network.CIDR) {
 			return fmt.Errorf("cannot change CIDR when network is bound")
 		}
 	}
 
+	// Reserve the new CIDR in IPAM, the old one is released once the update is committed
+	cidrChanged := !models.CIDRsEqual(existing.CIDR, network.CIDR)
+	allocated := false
+	if cidrChanged {
+		if allocated, err = s.allocateCIDR(ctx, network); err != nil {
+			return err
+		}
+	}
+	persisted := false
+	defer func() {
+		if allocated && !persisted {
+			s.releaseCIDR(ctx, network.ResourceIdentifier, network.CIDR)
+		}
+	}()
+
 	// Update metadata
 	network.GetMeta().TouchOnWrite(fmt.Sprintf("%d", time.Now().UnixNano()))
 
@@ -140,6 +177,10 @@ func (s *NetworkResourceService) UpdateNetwork(ctx context.Context, network *mod
 	if err := writer.Commit(); err != nil {
 		return fmt.Errorf("failed to commit network update: %w", err)
 	}
+	persisted = true
+	if allocated {
+		s.releaseCIDR(ctx, existing.ResourceIdentifier, existing.CIDR)
+	}
 
 	// Sync with external systems
 	syncErr := s.syncNetworkWithExternal(ctx, network, types.SyncOperationUpsert)
@@ -228,6 +269,9 @@ func (s *NetworkResourceService) DeleteNetwork(ctx context.Context, id models.Re
 		return fmt.Errorf("failed to commit network deletion: %w", err)
 	}
 
+	// Free the address range of the network in IPAM
+	s.releaseCIDR(ctx, existing.ResourceIdentifier, existing.CIDR)
+
 
 	// Sync deletion with external systems
 	err = s.syncNetworkWithExternal(ctx, existing, types.SyncOperationDelete)
@@ -262,7 +306,7 @@ func (s *NetworkResourceService) GetNetwork(ctx context.Context, id models.Resou
 
 // GetAddressGroup retrieves an AddressGroup by ID
 func (s *NetworkResourceService) GetAddressGroup(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -431,13 +475,8 @@ func (s *NetworkResourceService) UpdateNetworkBinding(ctx context.Context, netwo
 		return fmt.Errorf("failed to commit network binding: %w", err)
 	}
 
-	// Sync with SGROUP
-	if s.syncManager != nil {
-		if syncErr := s.syncManager.SyncEntity(ctx, network, types.SyncOperationUpsert); syncErr != nil {
-			// Don't fail the operation, sync can be retried later
-		} else {
-		}
-	}
+	// Sync with SGROUP immediately, binding changes must not be debounced
+	s.forceSyncNetworkWithSGroups(ctx, network)
 
 	return nil
 }
@@ -487,13 +526,8 @@ func (s *NetworkResourceService) RemoveNetworkBinding(ctx context.Context, netwo
 		return fmt.Errorf("failed to commit network unbinding: %w", err)
 	}
 
-	// Sync with SGROUP
-	if s.syncManager != nil {
-		if syncErr := s.syncManager.SyncEntity(ctx, network, types.SyncOperationUpsert); syncErr != nil {
-			// Don't fail the operation, sync can be retried later
-		} else {
-		}
-	}
+	// Sync with SGROUP immediately, binding changes must not be debounced
+	s.forceSyncNetworkWithSGroups(ctx, network)
 
 	return nil
 }
@@ -502,7 +536,7 @@ func (s *NetworkResourceService) RemoveNetworkBinding(ctx context.Context, netwo
 
 // removeNetworkFromAddressGroup removes a network from AddressGroup.Networks field
 func (s *NetworkResourceService) removeNetworkFromAddressGroup(ctx context.Context, addressGroupRef, networkRef models.ResourceIdentifier) error {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -549,7 +583,7 @@ func (s *NetworkResourceService) removeNetworkFromAddressGroup(ctx context.Conte
 }
 
 func (s *NetworkResourceService) getNetworkByID(ctx context.Context, id string) (*models.Network, error) {
-	reader, err := s.repo.Reader(ctx)
+	reader, err := s.repo.PrimaryReader(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -568,20 +602,59 @@ func (s *NetworkResourceService) getNetworkByID(ctx context.Context, id string)
 	return network, err
 }
 
-func (s *NetworkResourceService) validateCIDR(cidr string) error {
-	// Basic CIDR validation - in a real implementation, you'd use a proper IP/CIDR library
-	if cidr == "" {
+// validateCIDR validates the IPv4 or IPv6 CIDR of the network and stores it in the
+// canonical form, so equal ranges are detected regardless of how they were written
+func (s *NetworkResourceService) validateCIDR(network *models.Network) error {
+	if network.CIDR == "" {
 		return fmt.Errorf("CIDR cannot be empty")
 	}
 
-	// Simple format check
-	if !strings.Contains(cidr, "/") {
-		return fmt.Errorf("invalid CIDR format: %s", cidr)
+	normalized, err := models.NormalizeCIDR(network.CIDR)
+	if err != nil {
+		return fmt.Errorf("invalid CIDR format: %s: %w", network.CIDR, err)
 	}
+	network.CIDR = normalized
 
 	return nil
 }
 
+// allocateCIDR reserves the CIDR of the network in IPAM, networks without CIDR get a
+// free range assigned. Returns false when IPAM is not configured.
+func (s *NetworkResourceService) allocateCIDR(ctx context.Context, network *models.Network) (bool, error) {
+	if s.ipam == nil {
+		return false, nil
+	}
+
+	cidr, err := s.ipam.Allocate(ctx, ports.IPAMAllocation{Owner: network.ResourceIdentifier, CIDR: network.CIDR})
+	if err != nil {
+		if network.CIDR == "" {
+			return false, fmt.Errorf("failed to allocate CIDR in IPAM: %w", err)
+		}
+		return false, fmt.Errorf("failed to reserve CIDR %s in IPAM: %w", network.CIDR, err)
+	}
+	network.CIDR = cidr
+	return true, nil
+}
+
+// releaseCIDR frees the address range held by the network in IPAM. Failures are only
+// logged: the network change is already committed and the range can be freed in IPAM.
+func (s *NetworkResourceService) releaseCIDR(ctx context.Context, owner models.ResourceIdentifier, cidr string) {
+	if s.ipam == nil || cidr == "" {
+		return
+	}
+
+	if err := s.ipam.Release(ctx, ports.IPAMAllocation{Owner: owner, CIDR: cidr}); err != nil {
+		klog.Errorf("Failed to release CIDR %s of network %s in IPAM: %v", cidr, owner.Key(), err)
+	}
+}
+
+// ReleaseDeletedNetworks frees the address ranges of committed deletions of networks in IPAM
+func (s *NetworkResourceService) ReleaseDeletedNetworks(ctx context.Context, networks []models.Network) {
+	for _, network := range networks {
+		s.releaseCIDR(ctx, network.ResourceIdentifier, network.CIDR)
+	}
+}
+
 // syncNetworkWithExternal syncs a Network with external systems
 func (s *NetworkResourceService) syncNetworkWithExternal(ctx context.Context, network *models.Network, operation types.SyncOperation) error {
 	syncKey := fmt.Sprintf("%s-%s", operation, network.Key())
@@ -613,6 +686,18 @@ func (s *NetworkResourceService) syncNetworkWithExternal(ctx context.Context, ne
 	return nil
 }
 
+// forceSyncNetworkWithSGroups syncs the Network with sgroups bypassing debouncing.
+// Failures are only logged, the binding change is committed and the sync can be retried later.
+func (s *NetworkResourceService) forceSyncNetworkWithSGroups(ctx context.Context, network *models.Network) {
+	if s.syncManager == nil {
+		return
+	}
+
+	if err := s.syncManager.SyncEntityForced(ctx, network, types.SyncOperationUpsert); err != nil {
+		klog.Errorf("Failed to force sync network %s with sgroups: %v", network.Key(), err)
+	}
+}
+
 // syncAddressGroupWithExternal syncs an AddressGroup with external systems
 func (s *NetworkResourceService) syncAddressGroupWithExternal(ctx context.Context, addressGroup *models.AddressGroup, operation string) error {
 	syncKey := fmt.Sprintf("%s-%s", operation, addressGroup.Key())
@@ -378,3 +378,60 @@ func TestNetworkResourceService_ErrorHandling(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+// fakeIPAM keeps owners of allocated CIDRs
+type fakeIPAM struct {
+	owners map[string]string
+	free   string
+}
+
+func (f *fakeIPAM) Allocate(_ context.Context, allocation ports.IPAMAllocation) (string, error) {
+	cidr := allocation.CIDR
+	if cidr == "" {
+		cidr = f.free
+	}
+	if owner, held := f.owners[cidr]; held && owner != allocation.Owner.Key() {
+		return "", ports.ErrIPAMConflict
+	}
+	f.owners[cidr] = allocation.Owner.Key()
+	return cidr, nil
+}
+
+func (f *fakeIPAM) Release(_ context.Context, allocation ports.IPAMAllocation) error {
+	if f.owners[allocation.CIDR] == allocation.Owner.Key() {
+		delete(f.owners, allocation.CIDR)
+	}
+	return nil
+}
+
+func TestNetworkResourceService_IPAM(t *testing.T) {
+	mockRegistry := testutil.NewMockRegistry()
+	service := NewNetworkResourceService(mockRegistry, testutil.NewMockSyncManager(), testutil.NewMockConditionManager())
+	ipam := &fakeIPAM{owners: map[string]string{}, free: "10.20.0.0/24"}
+	service.SetIPAM(ipam)
+	ctx := context.Background()
+
+	// Networks without CIDR get a free range
+	allocated := testutil.CreateTestNetwork("allocated", "test-namespace", "")
+	require.NoError(t, service.CreateNetwork(ctx, &allocated))
+	created, err := service.GetNetwork(ctx, allocated.SelfRef.ResourceIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, "10.20.0.0/24", created.CIDR)
+
+	// Conflicting CIDRs are rejected before persisting
+	conflicting := testutil.CreateTestNetwork("conflicting", "test-namespace", "10.20.0.0/24")
+	err = service.CreateNetwork(ctx, &conflicting)
+	require.ErrorIs(t, err, ports.ErrIPAMConflict)
+	_, err = service.GetNetwork(ctx, conflicting.SelfRef.ResourceIdentifier)
+	assert.Error(t, err)
+
+	// CIDR changes move the allocation
+	updated := *created
+	updated.CIDR = "10.30.0.0/24"
+	require.NoError(t, service.UpdateNetwork(ctx, &updated))
+	assert.Equal(t, map[string]string{"10.30.0.0/24": "test-namespace/allocated"}, ipam.owners)
+
+	// Deletion releases the allocation
+	require.NoError(t, service.DeleteNetwork(ctx, updated.SelfRef.ResourceIdentifier))
+	assert.Empty(t, ipam.owners)
+}
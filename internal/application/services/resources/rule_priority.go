@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// RulePriorityCalculator calculates the priority of the IEAgAgRules generated from a RuleS2S.
+// sgroups applies rules with a lower priority first.
+type RulePriorityCalculator interface {
+	RulePriority(rule *models.RuleS2S) int32
+}
+
+// RulePriorityStrategy is the default RulePriorityCalculator. The ACCEPT priority of a rule is
+// taken from the first match of its policy tier (models.RulePolicyTierLabel), namespace and
+// traffic direction, Default otherwise. DROP rules keep their offset to ACCEPT rules, so they
+// are still applied first. An explicit RuleS2S priority always wins.
+type RulePriorityStrategy struct {
+	Default    int32
+	Tiers      map[string]int32
+	Namespaces map[string]int32
+	Traffic    map[models.Traffic]int32
+}
+
+var _ RulePriorityCalculator = RulePriorityStrategy{}
+
+// DefaultRulePriorityStrategy returns the strategy of the fixed action priorities
+// (models.RulePriorityAccept, models.RulePriorityDrop)
+func DefaultRulePriorityStrategy() RulePriorityStrategy {
+	return RulePriorityStrategy{Default: models.RulePriorityAccept}
+}
+
+// RulePriority returns the priority of the IEAgAgRules generated from the rule
+func (p RulePriorityStrategy) RulePriority(rule *models.RuleS2S) int32 {
+	if rule.Priority != 0 {
+		return rule.Priority
+	}
+
+	priority := p.acceptPriority(rule)
+	if rule.EffectiveAction() == models.ActionDrop {
+		priority -= models.RulePriorityAccept - models.RulePriorityDrop
+	}
+	return priority
+}
+
+func (p RulePriorityStrategy) acceptPriority(rule *models.RuleS2S) int32 {
+	if tier := rule.PolicyTier(); tier != "" {
+		if priority, ok := p.Tiers[tier]; ok {
+			return priority
+		}
+	}
+	if priority, ok := p.Namespaces[rule.Namespace]; ok {
+		return priority
+	}
+	if priority, ok := p.Traffic[rule.Traffic]; ok {
+		return priority
+	}
+	if p.Default == 0 {
+		return models.RulePriorityAccept
+	}
+	return p.Default
+}
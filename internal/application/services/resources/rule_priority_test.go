@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+func TestRulePriorityStrategy_RulePriority(t *testing.T) {
+	strategy := RulePriorityStrategy{
+		Default:    200,
+		Tiers:      map[string]int32{"platform": 20},
+		Namespaces: map[string]int32{"payments": 60},
+		Traffic:    map[models.Traffic]int32{models.EGRESS: 300},
+	}
+
+	newRule := func(namespace string, traffic models.Traffic, action models.RuleAction, tier string) *models.RuleS2S {
+		rule := &models.RuleS2S{
+			SelfRef: models.NewSelfRef(models.NewResourceIdentifier("rule", models.WithNamespace(namespace))),
+			Traffic: traffic,
+			Action:  action,
+		}
+		if tier != "" {
+			rule.Meta.Labels = map[string]string{models.RulePolicyTierLabel: tier}
+		}
+		return rule
+	}
+
+	tests := []struct {
+		name     string
+		rule     *models.RuleS2S
+		expected int32
+	}{
+		{"default", newRule("default", models.INGRESS, "", ""), 200},
+		{"default drop keeps the offset", newRule("default", models.INGRESS, models.ActionDrop, ""), 150},
+		{"traffic", newRule("default", models.EGRESS, models.ActionAccept, ""), 300},
+		{"namespace before traffic", newRule("payments", models.EGRESS, models.ActionAccept, ""), 60},
+		{"tier before namespace", newRule("payments", models.EGRESS, models.ActionAccept, "platform"), 20},
+		{"unknown tier", newRule("payments", models.INGRESS, models.ActionAccept, "unknown"), 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, strategy.RulePriority(tt.rule))
+		})
+	}
+
+	explicit := newRule("payments", models.INGRESS, models.ActionDrop, "platform")
+	explicit.Priority = 7
+	assert.Equal(t, int32(7), strategy.RulePriority(explicit), "explicit priority must win")
+}
+
+func TestDefaultRulePriorityStrategy(t *testing.T) {
+	strategy := DefaultRulePriorityStrategy()
+
+	for _, action := range []models.RuleAction{"", models.ActionAccept, models.ActionDrop} {
+		rule := &models.RuleS2S{Traffic: models.INGRESS, Action: action}
+		assert.Equal(t, models.RulePriorityForAction(action), strategy.RulePriority(rule),
+			"default strategy must keep the action priorities of %q", action)
+	}
+}
@@ -32,6 +32,27 @@ func extractAddressGroupRefs(aggregated []models.AddressGroupReference) []models
 	return refs
 }
 
+// serviceAddressGroupRefs returns the address groups of a service (spec + bindings, without duplicates)
+// followed by the groups they include transitively, so rules targeting a parent group cover its child groups
+func serviceAddressGroupRefs(ctx context.Context, reader ports.Reader, service *models.Service) ([]models.AddressGroupRef, error) {
+	refs := extractAddressGroupRefs(service.GetAggregatedAddressGroups())
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	expanded, err := models.ExpandAddressGroupRefs(refs, func(ref models.AddressGroupRef) (*models.AddressGroup, error) {
+		group, err := reader.GetAddressGroupByID(ctx, models.NewResourceIdentifier(ref.Name, models.WithNamespace(ref.Namespace)))
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, nil
+		}
+		return group, err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to expand included address groups of service %s", service.Key())
+	}
+	return expanded, nil
+}
+
 // populateServiceAddressGroups populates Service.AddressGroups from AddressGroupBindings
 // This is the critical function for Phase 5 - Service → AddressGroup dual registration
 func (s *RuleS2SResourceService) populateServiceAddressGroups(
@@ -106,26 +127,28 @@ func (s *RuleS2SResourceService) extractAggregationGroupsFromRuleS2S(ctx context
 		return nil, errors.Wrapf(err, "failed to get target service %s", targetServiceID.Key())
 	}
 
-	// Extract ports based on traffic direction
-	var portsSource *models.Service
-	if rule.Traffic == models.INGRESS {
-		portsSource = localService
-	} else {
-		portsSource = targetService
-	}
+	// Ports of the service selected by the rule and its extra ports
+	rulePorts := rule.RulePorts(localService, targetService)
 
 	var groups []AggregationGroup
 
 	// 🎯 STORY-001: Use AggregatedAddressGroups (spec + bindings) instead of AddressGroups (spec only)
-	localAGs := extractAddressGroupRefs(localService.AggregatedAddressGroups)
-	targetAGs := extractAddressGroupRefs(targetService.AggregatedAddressGroups)
+	// with the address groups they include
+	localAGs, err := serviceAddressGroupRefs(ctx, reader, localService)
+	if err != nil {
+		return nil, err
+	}
+	targetAGs, err := serviceAddressGroupRefs(ctx, reader, targetService)
+	if err != nil {
+		return nil, err
+	}
 
 	// Generate aggregation groups for all AG combinations and protocols
 	for _, localAG := range localAGs {
 		for _, targetAG := range targetAGs {
 			// Check what protocols this service supports
 			protocolsSupported := make(map[models.TransportProtocol]bool)
-			for _, port := range portsSource.IngressPorts {
+			for _, port := range rulePorts {
 				protocolsSupported[port.Protocol] = true
 			}
 
@@ -0,0 +1,107 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// =============================================================================
+// CrossNamespacePolicy Operations
+// =============================================================================
+
+// GetCrossNamespacePolicies returns all cross namespace policies within scope
+func (s *RuleS2SResourceService) GetCrossNamespacePolicies(ctx context.Context, scope ports.Scope) ([]models.CrossNamespacePolicy, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	var policies []models.CrossNamespacePolicy
+	err = reader.ListCrossNamespacePolicies(ctx, func(policy models.CrossNamespacePolicy) error {
+		policies = append(policies, policy)
+		return nil
+	}, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list cross namespace policies")
+	}
+	return policies, nil
+}
+
+// GetCrossNamespacePolicyByID returns cross namespace policy by ID
+func (s *RuleS2SResourceService) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	return reader.GetCrossNamespacePolicyByID(ctx, id)
+}
+
+// SyncCrossNamespacePolicies synchronizes cross namespace policies. Policies still required by
+// cross namespace RuleS2S can't be deleted or lose the namespaces of those rules.
+func (s *RuleS2SResourceService) SyncCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, scope ports.Scope, syncOp models.SyncOp) error {
+	if err := s.validateCrossNamespacePolicies(ctx, policies, syncOp); err != nil {
+		return err
+	}
+
+	writer, err := s.registry.Writer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer")
+	}
+	defer func() {
+		if err != nil {
+			writer.Abort()
+		}
+	}()
+
+	if err = writer.SyncCrossNamespacePolicies(ctx, policies, scope, ports.WithSyncOp(syncOp)); err != nil {
+		return errors.Wrap(err, "failed to sync cross namespace policies")
+	}
+
+	if err = writer.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	rulesLog.V(1).Info("Cross-namespace policies committed", "syncOp", syncOp, "policies", len(policies))
+	return nil
+}
+
+// validateCrossNamespacePolicies validates policies for creation, update or deletion
+func (s *RuleS2SResourceService) validateCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, syncOp models.SyncOp) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader for validation")
+	}
+	defer reader.Close()
+
+	validator := validation.NewDependencyValidator(reader).GetCrossNamespacePolicyValidator()
+	for _, policy := range policies {
+		if syncOp == models.SyncOpDelete {
+			if err := validator.CheckDependencies(ctx, policy.ResourceIdentifier); err != nil {
+				return err
+			}
+			continue
+		}
+
+		existing, err := reader.GetCrossNamespacePolicyByID(ctx, policy.ResourceIdentifier)
+		switch {
+		case err == nil:
+			err = validator.ValidateForUpdate(ctx, *existing, policy)
+		case errors.Is(err, ports.ErrNotFound):
+			err = validator.ValidateForCreation(ctx, policy)
+		default:
+			return errors.Wrapf(err, "failed to get cross namespace policy %s", policy.Key())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// =============================================================================
+// RuleS2SException Operations
+// =============================================================================
+
+// GetRuleS2SExceptions returns all RuleS2S exceptions within scope
+func (s *RuleS2SResourceService) GetRuleS2SExceptions(ctx context.Context, scope ports.Scope) ([]models.RuleS2SException, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	var exceptions []models.RuleS2SException
+	err = reader.ListRuleS2SExceptions(ctx, func(exception models.RuleS2SException) error {
+		exceptions = append(exceptions, exception)
+		return nil
+	}, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list RuleS2S exceptions")
+	}
+	return exceptions, nil
+}
+
+// GetRuleS2SExceptionByID returns RuleS2S exception by ID
+func (s *RuleS2SResourceService) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	return reader.GetRuleS2SExceptionByID(ctx, id)
+}
+
+// SyncRuleS2SExceptions synchronizes RuleS2S exceptions and recalculates the aggregated IEAgAg rules they cut
+func (s *RuleS2SResourceService) SyncRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException, scope ports.Scope, syncOp models.SyncOp) error {
+	if syncOp != models.SyncOpDelete {
+		if err := s.validateRuleS2SExceptions(ctx, exceptions); err != nil {
+			return err
+		}
+	}
+
+	writer, err := s.registry.Writer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer")
+	}
+	defer func() {
+		if err != nil {
+			writer.Abort()
+		}
+	}()
+
+	if err = writer.SyncRuleS2SExceptions(ctx, exceptions, scope, ports.WithSyncOp(syncOp)); err != nil {
+		return errors.Wrap(err, "failed to sync RuleS2S exceptions")
+	}
+
+	if err = writer.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	rulesLog.V(1).Info("Recalculating IEAgAg rules after RuleS2S exceptions change", "syncOp", syncOp, "exceptions", len(exceptions))
+	if err := s.RecalculateAllAffectedIEAgAgRules(ctx, "RuleS2SException change"); err != nil {
+		return errors.Wrap(err, "failed to recalculate IEAgAg rules after RuleS2S exception change")
+	}
+
+	return nil
+}
+
+// validateRuleS2SExceptions validates exceptions for creation or update depending on their existence
+func (s *RuleS2SResourceService) validateRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader for validation")
+	}
+	defer reader.Close()
+
+	validator := validation.NewDependencyValidator(reader).GetRuleS2SExceptionValidator()
+	for _, exception := range exceptions {
+		existing, err := reader.GetRuleS2SExceptionByID(ctx, exception.ResourceIdentifier)
+		switch {
+		case err == nil:
+			err = validator.ValidateForUpdate(ctx, *existing, exception)
+		case errors.Is(err, ports.ErrNotFound):
+			err = validator.ValidateForCreation(ctx, exception)
+		default:
+			return errors.Wrapf(err, "failed to get RuleS2S exception %s", exception.Key())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listRuleS2SExceptions loads all exceptions for one aggregation pass
+func (s *RuleS2SResourceService) listRuleS2SExceptions(ctx context.Context, reader ports.Reader) ([]models.RuleS2SException, error) {
+	var exceptions []models.RuleS2SException
+	err := reader.ListRuleS2SExceptions(ctx, func(exception models.RuleS2SException) error {
+		exceptions = append(exceptions, exception)
+		return nil
+	}, ports.EmptyScope{})
+	return exceptions, err
+}
+
+// subtractExceptionPorts removes the ports excluded by matching exceptions from the aggregated ports
+// of an AG combination. Port ranges are split, ICMP types are removed by their text.
+func subtractExceptionPorts(
+	aggregatedPorts []string,
+	exceptions []models.RuleS2SException,
+	traffic models.Traffic,
+	localAG, targetAG models.AddressGroupRef,
+	protocol models.TransportProtocol,
+) []string {
+	var excludedRanges []models.PortRange
+	excludedTypes := make(map[string]bool)
+	matched := false
+
+	for _, exception := range exceptions {
+		if !exception.Matches(traffic, localAG, targetAG, protocol) {
+			continue
+		}
+		matched = true
+		aggregationLog.V(1).Info("Applying RuleS2S exception", "exception", exception.Key(),
+			"protocol", protocol, "ports", exception.Ports)
+
+		if exception.ExcludesAllPorts() {
+			return nil
+		}
+		if !protocol.HasPorts() {
+			matches, err := models.ParseICMPSpec(exception.Ports)
+			if err != nil {
+				continue
+			}
+			for _, match := range matches {
+				excludedTypes[match.String()] = true
+			}
+			continue
+		}
+		ranges, err := validation.ParsePortRanges(exception.Ports)
+		if err != nil {
+			continue
+		}
+		excludedRanges = append(excludedRanges, ranges...)
+	}
+
+	if !matched {
+		return aggregatedPorts
+	}
+
+	var result []string
+	for _, port := range aggregatedPorts {
+		if !protocol.HasPorts() {
+			if !excludedTypes[port] {
+				result = append(result, port)
+			}
+			continue
+		}
+		ranges, err := validation.ParsePortRanges(port)
+		if err != nil {
+			// Ports that can't be parsed are kept as aggregated
+			result = append(result, port)
+			continue
+		}
+		for _, portRange := range validation.SubtractPortRanges(ranges, excludedRanges) {
+			result = append(result, validation.FormatPortRange(portRange))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// RuleGCOptions configures one garbage collection pass over IEAgAgRules
+type RuleGCOptions struct {
+	// DryRun only reports orphaned rules without deleting them
+	DryRun bool
+	// MaxDeletionRatio refuses to delete when orphans exceed this share of all rules, 0 disables the guard
+	MaxDeletionRatio float64
+	// MinRulesForRatio is the smallest rule count the ratio is computed against, so small rule sets
+	// are not emptied by one pass
+	MinRulesForRatio int
+}
+
+// RuleGCReport is the result of one garbage collection pass
+type RuleGCReport struct {
+	TotalRules int
+	Orphans    []models.ResourceIdentifier
+	Deleted    int
+	Refused    bool // The safety threshold was exceeded and nothing was deleted
+}
+
+// ruleGCStats accumulates garbage collection results for /metrics
+type ruleGCStats struct {
+	mu           sync.Mutex
+	runs         int64
+	failures     int64
+	refused      int64
+	deleted      int64
+	flagged      int64
+	lastOrphans  int
+	lastRuleSize int
+	lastRun      time.Time
+}
+
+// CollectOrphanedIEAgAgRules recomputes the expected IEAgAgRules from all RuleS2S and removes
+// stored rules no RuleS2S generates anymore. Only rules labeled as generated from RuleS2S are
+// collected: baseline deny rules of NamespacePostures and rules written directly (SyncIEAgAgRules)
+// are never orphans. With DryRun orphans are only reported.
+func (s *RuleS2SResourceService) CollectOrphanedIEAgAgRules(ctx context.Context, opts RuleGCOptions) (report *RuleGCReport, err error) {
+	defer func() { s.gcStats.record(report, err) }()
+
+	// Rules are only collected if they are orphaned in a single snapshot, a RuleS2S committed
+	// between listing the rules and listing RuleS2S does not orphan its rules
+	reader, err := s.registry.ReaderAtSnapshot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader for rule GC")
+	}
+
+	var existingRules []models.IEAgAgRule
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		if rule.IsGeneratedFromRuleS2S() && !rule.IsBaselineDeny() {
+			existingRules = append(existingRules, rule)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		reader.Close()
+		return nil, errors.Wrap(err, "failed to list IEAgAg rules")
+	}
+
+	expectedRules, contributions, err := s.generateAllIEAgAgRules(ctx, reader)
+	reader.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	// The full generation also heals the aggregation index used by incremental recalculation
+	if err := s.replaceIEAgAgRuleContributionIndex(ctx, contributions); err != nil {
+		rulesLog.Error(err, "Failed to refresh aggregation index")
+	}
+
+	expected := make(map[string]bool, len(expectedRules))
+	for _, rule := range expectedRules {
+		expected[rule.Key()] = true
+	}
+
+	report = &RuleGCReport{TotalRules: len(existingRules)}
+	var orphans []models.IEAgAgRule
+	for _, rule := range existingRules {
+		if !expected[rule.Key()] {
+			orphans = append(orphans, rule)
+			report.Orphans = append(report.Orphans, rule.ResourceIdentifier)
+		}
+	}
+
+	if len(report.Orphans) == 0 {
+		rulesLog.V(2).Info("No orphaned IEAgAg rules", "rules", report.TotalRules)
+		return report, nil
+	}
+
+	if opts.DryRun {
+		for _, id := range report.Orphans {
+			rulesLog.Info("IEAgAg rule is orphaned (dry run, not deleted)", "rule", id.Key())
+		}
+		return report, nil
+	}
+
+	// Below MinRulesForRatio rules the ratio is not representative, a pass then deletes at most
+	// the share of MinRulesForRatio allowed by MaxDeletionRatio
+	deletionRatio := float64(len(report.Orphans)) / float64(max(report.TotalRules, opts.MinRulesForRatio))
+	if opts.MaxDeletionRatio > 0 && deletionRatio > opts.MaxDeletionRatio {
+		report.Refused = true
+		rulesLog.Error(nil, "Refusing to delete orphaned IEAgAg rules, deletion ratio exceeds the limit",
+			"orphans", len(report.Orphans), "rules", report.TotalRules,
+			"ratio", deletionRatio, "maxRatio", opts.MaxDeletionRatio)
+		return report, nil
+	}
+
+	rulesLog.Info("Deleting orphaned IEAgAg rules", "orphans", len(report.Orphans), "rules", report.TotalRules)
+	deleted, err := s.deleteOrphanedIEAgAgRules(ctx, orphans)
+	if err != nil {
+		return report, errors.Wrap(err, "failed to delete orphaned IEAgAg rules")
+	}
+	if len(deleted) < len(orphans) {
+		rulesLog.Info("IEAgAg rules are no longer orphaned, kept", "kept", len(orphans)-len(deleted))
+	}
+	s.syncIEAgAgRuleDeletions(ctx, deleted)
+	report.Deleted = len(deleted)
+	return report, nil
+}
+
+// deleteOrphanedIEAgAgRules deletes orphans of a snapshot in one writer transaction. Their aggregation
+// keys are locked first and the orphans are checked again against RuleS2S read after locking: a RuleS2S
+// committed since the snapshot keeps its rules, one committed later recalculates them after the deletion.
+// Returns the deleted rules.
+func (s *RuleS2SResourceService) deleteOrphanedIEAgAgRules(ctx context.Context, orphans []models.IEAgAgRule) ([]models.IEAgAgRule, error) {
+	keys := make([]AggregationKey, 0, len(orphans))
+	ids := make([]models.ResourceIdentifier, 0, len(orphans))
+	for _, rule := range orphans {
+		keys = append(keys, ieAgAgRuleAggregationKey(rule))
+		ids = append(ids, rule.ResourceIdentifier)
+	}
+
+	var deleted []models.IEAgAgRule
+	unlock := func() {}
+	defer func() { unlock() }()
+	err := ports.Write(ctx, s.registry, ports.DeleteWriterOptions, func(writer ports.Writer) error {
+		unlock()
+		release, err := lockAggregationKeys(ctx, writer, keys)
+		if err != nil {
+			return err
+		}
+		unlock = release
+
+		reader, err := s.registry.ReaderFromWriter(ctx, writer)
+		if err != nil {
+			return errors.Wrap(err, "failed to get reader from writer")
+		}
+		defer reader.Close()
+
+		stored, err := getIEAgAgRulesByIDs(ctx, reader, ids)
+		if err != nil {
+			return err
+		}
+		expectedRules, _, err := s.generateAllIEAgAgRules(ctx, reader)
+		if err != nil {
+			return err
+		}
+		expected := make(map[string]bool, len(expectedRules))
+		for _, rule := range expectedRules {
+			expected[rule.Key()] = true
+		}
+
+		deleted = deleted[:0]
+		deletedIDs := make([]models.ResourceIdentifier, 0, len(stored))
+		for _, rule := range stored {
+			if rule.IsGeneratedFromRuleS2S() && !rule.IsBaselineDeny() && !expected[rule.Key()] {
+				deleted = append(deleted, rule)
+				deletedIDs = append(deletedIDs, rule.ResourceIdentifier)
+			}
+		}
+		if len(deletedIDs) == 0 {
+			return nil
+		}
+		return writer.DeleteIEAgAgRulesByIDs(ctx, deletedIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// generateAllIEAgAgRules generates the IEAgAgRules of all RuleS2S of the reader
+func (s *RuleS2SResourceService) generateAllIEAgAgRules(ctx context.Context, reader ports.Reader) ([]models.IEAgAgRule, []models.IEAgAgRuleContribution, error) {
+	var allRuleS2S []models.RuleS2S
+	err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		allRuleS2S = append(allRuleS2S, rule)
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list RuleS2S")
+	}
+
+	_, rules, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, allRuleS2S, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate expected IEAgAg rules")
+	}
+	return rules, contributions, nil
+}
+
+// WriteRuleGCMetrics writes garbage collection statistics in the Prometheus text format
+func (s *RuleS2SResourceService) WriteRuleGCMetrics(w io.Writer) error {
+	return s.gcStats.write(w)
+}
+
+// record adds the result of a pass to the statistics
+func (st *ruleGCStats) record(report *RuleGCReport, err error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.runs++
+	st.lastRun = time.Now()
+	if err != nil {
+		st.failures++
+	}
+	if report == nil {
+		return
+	}
+	st.lastRuleSize = report.TotalRules
+	st.lastOrphans = len(report.Orphans)
+	st.deleted += int64(report.Deleted)
+	if report.Refused {
+		st.refused++
+	}
+	if report.Deleted == 0 && !report.Refused && err == nil {
+		st.flagged += int64(len(report.Orphans))
+	}
+}
+
+// write writes the statistics in the Prometheus text format
+func (st *ruleGCStats) write(w io.Writer) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	// Zero until the first pass
+	lastRun := 0.0
+	if !st.lastRun.IsZero() {
+		lastRun = float64(st.lastRun.Unix())
+	}
+
+	metrics := []struct {
+		name, help, kind string
+		value            float64
+	}{
+		{"netguard_rule_gc_runs_total", "Garbage collection passes over IEAgAg rules", "counter", float64(st.runs)},
+		{"netguard_rule_gc_failures_total", "Garbage collection passes that failed", "counter", float64(st.failures)},
+		{"netguard_rule_gc_refused_total", "Garbage collection passes refused by the safety threshold", "counter", float64(st.refused)},
+		{"netguard_rule_gc_deleted_total", "Orphaned IEAgAg rules deleted by garbage collection", "counter", float64(st.deleted)},
+		{"netguard_rule_gc_flagged_total", "Orphaned IEAgAg rules reported without deletion in dry run", "counter", float64(st.flagged)},
+		{"netguard_rule_gc_orphans", "Orphaned IEAgAg rules found by the last pass", "gauge", float64(st.lastOrphans)},
+		{"netguard_rule_gc_rules", "IEAgAg rules checked by the last pass", "gauge", float64(st.lastRuleSize)},
+		{"netguard_rule_gc_last_run_timestamp_seconds", "Unix time of the last garbage collection pass", "gauge", lastRun},
+	}
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %g\n", metric.name, metric.help, metric.name, metric.kind, metric.name, metric.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// newRuleGCTestService stores the rules generated from the RuleS2S of newRuleEngineTestRegistry
+// together with extra IEAgAgRules
+func newRuleGCTestService(t *testing.T, extra ...models.IEAgAgRule) (*RuleS2SResourceService, ports.Registry) {
+	ctx := context.Background()
+	registry, rules := newRuleEngineTestRegistry(t, "web-ag")
+	service := NewRuleS2SResourceService(registry, nil, nil)
+
+	generated := generateWithEngine(t, service, rules)
+	require.NotEmpty(t, generated)
+	for _, rule := range generated {
+		require.True(t, rule.IsGeneratedFromRuleS2S(), "generated rules must be labeled")
+	}
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncIEAgAgRules(ctx, append(generated, extra...), nil))
+	require.NoError(t, writer.Commit())
+	return service, registry
+}
+
+func newStoredIEAgAgRule(name string, labels map[string]string) models.IEAgAgRule {
+	rule := models.IEAgAgRule{
+		SelfRef:           models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("app"))),
+		Traffic:           models.EGRESS,
+		Transport:         models.TCP,
+		AddressGroupLocal: models.NewAddressGroupRef("client-ag", models.WithNamespace("app")),
+		AddressGroup:      models.NewAddressGroupRef("web-ag", models.WithNamespace("app")),
+		Ports:             []models.PortSpec{{Destination: "22"}},
+		Action:            models.ActionAccept,
+	}
+	rule.Meta.Labels = labels
+	return rule
+}
+
+func storedIEAgAgRuleNames(t *testing.T, registry ports.Registry) []string {
+	ctx := context.Background()
+	reader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	var names []string
+	require.NoError(t, reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		names = append(names, rule.Name)
+		return nil
+	}, ports.EmptyScope{}))
+	return names
+}
+
+func TestCollectOrphanedIEAgAgRules_Orphans(t *testing.T) {
+	service, registry := newRuleGCTestService(t,
+		newStoredIEAgAgRule("orphan", models.GeneratedFromRuleS2SLabels()),
+		newStoredIEAgAgRule("manual", nil),
+		newStoredIEAgAgRule("baseline", map[string]string{
+			models.IEAgAgRuleGeneratedLabel: "RuleS2S",
+			models.NamespacePostureLabel:    "app",
+		}),
+	)
+
+	report, err := service.CollectOrphanedIEAgAgRules(context.Background(), RuleGCOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.TotalRules, "only rules generated from RuleS2S are checked")
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, "orphan", report.Orphans[0].Name)
+	assert.Equal(t, 1, report.Deleted)
+	assert.False(t, report.Refused)
+
+	names := storedIEAgAgRuleNames(t, registry)
+	assert.NotContains(t, names, "orphan")
+	assert.Contains(t, names, "manual", "rules written directly must not be collected")
+	assert.Contains(t, names, "baseline", "baseline deny rules must not be collected")
+	assert.Len(t, names, 3)
+}
+
+func TestCollectOrphanedIEAgAgRules_DryRun(t *testing.T) {
+	service, registry := newRuleGCTestService(t, newStoredIEAgAgRule("orphan", models.GeneratedFromRuleS2SLabels()))
+
+	report, err := service.CollectOrphanedIEAgAgRules(context.Background(), RuleGCOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Len(t, report.Orphans, 1)
+	assert.Equal(t, 0, report.Deleted)
+	assert.Contains(t, storedIEAgAgRuleNames(t, registry), "orphan", "dry run must not delete orphans")
+}
+
+func TestCollectOrphanedIEAgAgRules_RatioGuard(t *testing.T) {
+	newOrphans := func(count int) []models.IEAgAgRule {
+		orphans := make([]models.IEAgAgRule, 0, count)
+		for i := 0; i < count; i++ {
+			orphans = append(orphans, newStoredIEAgAgRule(fmt.Sprintf("orphan-%d", i), models.GeneratedFromRuleS2SLabels()))
+		}
+		return orphans
+	}
+	opts := RuleGCOptions{MaxDeletionRatio: 0.5, MinRulesForRatio: 10}
+
+	// 6 of 7 rules are orphans: the ratio is computed against min rules and still exceeds the limit
+	service, registry := newRuleGCTestService(t, newOrphans(6)...)
+	report, err := service.CollectOrphanedIEAgAgRules(context.Background(), opts)
+	require.NoError(t, err)
+	assert.Equal(t, 7, report.TotalRules)
+	assert.True(t, report.Refused, "the guard must apply to small rule sets")
+	assert.Equal(t, 0, report.Deleted)
+	assert.Len(t, storedIEAgAgRuleNames(t, registry), 7)
+
+	// 4 orphans are within 50% of min rules
+	service, registry = newRuleGCTestService(t, newOrphans(4)...)
+	report, err = service.CollectOrphanedIEAgAgRules(context.Background(), opts)
+	require.NoError(t, err)
+	assert.False(t, report.Refused)
+	assert.Equal(t, 4, report.Deleted)
+	assert.Len(t, storedIEAgAgRuleNames(t, registry), 1)
+
+	// Large rule sets use the share of all rules
+	service, _ = newRuleGCTestService(t, newOrphans(20)...)
+	report, err = service.CollectOrphanedIEAgAgRules(context.Background(), opts)
+	require.NoError(t, err)
+	assert.True(t, report.Refused)
+}
+
+func TestDeleteOrphanedIEAgAgRules_VerifiesAfterLocking(t *testing.T) {
+	ctx := context.Background()
+	service, registry := newRuleGCTestService(t, newStoredIEAgAgRule("orphan", models.GeneratedFromRuleS2SLabels()))
+
+	reader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	var stored []models.IEAgAgRule
+	require.NoError(t, reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		stored = append(stored, rule)
+		return nil
+	}, ports.EmptyScope{}))
+	reader.Close()
+	require.Greater(t, len(stored), 1)
+
+	// A stale snapshot reports every rule as orphaned, rules still generated by RuleS2S are kept
+	deleted, err := service.deleteOrphanedIEAgAgRules(ctx, stored)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "orphan", deleted[0].Name)
+
+	names := storedIEAgAgRuleNames(t, registry)
+	assert.NotContains(t, names, "orphan")
+	assert.Len(t, names, len(stored)-1)
+}
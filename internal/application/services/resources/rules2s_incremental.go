@@ -0,0 +1,366 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// =============================================================================
+// Incremental IEAgAgRule Recalculation
+// =============================================================================
+
+// RebuildIEAgAgRuleContributionIndex regenerates the aggregation index of IEAgAgRules from all RuleS2S
+// without changing the rules. Until the index is built, recalculations fall back to the full ones.
+// An index that was already stored is maintained with the rules and is used as is.
+func (s *RuleS2SResourceService) RebuildIEAgAgRuleContributionIndex(ctx context.Context) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader for aggregation index")
+	}
+	defer reader.Close()
+
+	indexReader, ok := reader.(ports.IEAgAgRuleContributionReader)
+	if !ok {
+		rulesLog.Info("Registry does not store the aggregation index, incremental recalculation disabled")
+		return nil
+	}
+	built, err := indexReader.IEAgAgRuleContributionIndexBuilt(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to check aggregation index")
+	}
+	if built {
+		s.contributionIndexReady.Store(true)
+		rulesLog.Info("Using the stored aggregation index")
+		return nil
+	}
+
+	var allRuleS2S []models.RuleS2S
+	err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		allRuleS2S = append(allRuleS2S, rule)
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list RuleS2S")
+	}
+
+	_, _, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, allRuleS2S, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate IEAgAg rules")
+	}
+	return s.replaceIEAgAgRuleContributionIndex(ctx, contributions)
+}
+
+// GetIEAgAgRuleContributions explains the aggregation of IEAgAgRules: it returns the stored contributions
+// of the RuleS2S and to the IEAgAgRules ordered by rule, all contributions when both lists are empty
+func (s *RuleS2SResourceService) GetIEAgAgRuleContributions(ctx context.Context, ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) ([]models.IEAgAgRuleContribution, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	indexReader, ok := reader.(ports.IEAgAgRuleContributionReader)
+	if !ok {
+		return nil, errors.New("registry doesn't store the aggregation index")
+	}
+
+	var contributions []models.IEAgAgRuleContribution
+	err = indexReader.ListIEAgAgRuleContributions(ctx, func(contribution models.IEAgAgRuleContribution) error {
+		contributions = append(contributions, contribution)
+		return nil
+	}, ruleS2SIDs, ieAgAgRuleIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list IEAgAg rule contributions")
+	}
+
+	sort.Slice(contributions, func(i, j int) bool { return contributions[i].Key() < contributions[j].Key() })
+	return contributions, nil
+}
+
+// replaceIEAgAgRuleContributionIndex stores contributions generated from all RuleS2S as the whole index
+func (s *RuleS2SResourceService) replaceIEAgAgRuleContributionIndex(ctx context.Context, contributions []models.IEAgAgRuleContribution) error {
+	writer, err := s.registry.Writer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer for aggregation index")
+	}
+	indexWriter, ok := writer.(ports.IEAgAgRuleContributionWriter)
+	if !ok {
+		writer.Abort()
+		return nil
+	}
+	if err := indexWriter.ReplaceIEAgAgRuleContributions(ctx, nil, contributions); err != nil {
+		writer.Abort()
+		return errors.Wrap(err, "failed to replace aggregation index")
+	}
+	if err := writer.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit aggregation index")
+	}
+
+	s.contributionIndexReady.Store(true)
+	rulesLog.Info("Stored aggregation index", "contributions", len(contributions))
+	return nil
+}
+
+// indexIEAgAgRuleContributions replaces the index of the aggregated rules the contributions were generated for
+// in the writer transaction. The contributions must come from a generation over all RuleS2S, cleared rules
+// lose all their contributions.
+func (s *RuleS2SResourceService) indexIEAgAgRuleContributions(ctx context.Context, writer ports.Writer, contributions []models.IEAgAgRuleContribution, cleared ...models.ResourceIdentifier) error {
+	indexWriter, ok := writer.(ports.IEAgAgRuleContributionWriter)
+	if !ok {
+		return nil
+	}
+
+	replaced := append([]models.ResourceIdentifier{}, cleared...)
+	seen := make(map[string]bool, len(replaced))
+	for _, id := range replaced {
+		seen[id.Key()] = true
+	}
+	for _, contribution := range contributions {
+		if !seen[contribution.IEAgAgRule.Key()] {
+			seen[contribution.IEAgAgRule.Key()] = true
+			replaced = append(replaced, contribution.IEAgAgRule)
+		}
+	}
+	if len(replaced) == 0 {
+		return nil
+	}
+
+	if err := indexWriter.ReplaceIEAgAgRuleContributions(ctx, replaced, contributions); err != nil {
+		return errors.Wrap(err, "failed to update aggregation index")
+	}
+	return nil
+}
+
+// recalculateIEAgAgRulesIncrementally recalculates only the aggregation groups of the changed RuleS2S.
+// Changed RuleS2S were created, updated, deleted or their services changed. The groups they contributed
+// to are taken from the aggregation index, the groups they contribute to now are generated from them,
+// and only the RuleS2S indexed for those groups take part in the aggregation. It reports false without
+// doing anything when the index is not available, callers fall back to a full recalculation then.
+func (s *RuleS2SResourceService) recalculateIEAgAgRulesIncrementally(ctx context.Context, changed []models.ResourceIdentifier, reason string) (bool, error) {
+	if !s.contributionIndexReady.Load() {
+		return false, nil
+	}
+
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get reader for incremental recalculation")
+	}
+	_, ok := reader.(ports.IEAgAgRuleContributionReader)
+	reader.Close()
+	if !ok {
+		return false, nil
+	}
+
+	startTime := time.Now()
+	var affectedGroups int
+
+	// The groups are found and regenerated in the writer transaction, again once their keys are locked
+	err = s.recalculateWithLocks(ctx, reason, func(reader ports.Reader) (*RuleOperations, error) {
+		indexReader, ok := reader.(ports.IEAgAgRuleContributionReader)
+		if !ok {
+			return nil, errors.New("writer reader doesn't read the aggregation index")
+		}
+
+		// Phases 1-3: Aggregation groups of the changed RuleS2S and their candidate contributors
+		affected, candidates, err := s.indexedAggregationGroups(ctx, reader, indexReader, changed)
+		if err != nil {
+			return nil, err
+		}
+
+		affectedGroups = len(affected)
+		if len(affected) == 0 {
+			return &RuleOperations{}, nil
+		}
+
+		affectedIDs := make([]models.ResourceIdentifier, 0, len(affected))
+		for _, id := range affected {
+			affectedIDs = append(affectedIDs, id)
+		}
+
+		// Phase 4: Regenerate the affected groups from the candidates only
+		_, freshRules, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, candidates, candidates)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate IEAgAg rules of affected aggregation groups")
+		}
+
+		var affectedFresh []models.IEAgAgRule
+		for _, rule := range freshRules {
+			if _, ok := affected[rule.Key()]; ok {
+				affectedFresh = append(affectedFresh, rule)
+			}
+		}
+		var affectedContributions []models.IEAgAgRuleContribution
+		for _, contribution := range contributions {
+			if _, ok := affected[contribution.IEAgAgRule.Key()]; ok {
+				affectedContributions = append(affectedContributions, contribution)
+			}
+		}
+
+		var existingRules []models.IEAgAgRule
+		for _, id := range affectedIDs {
+			rule, err := reader.GetIEAgAgRuleByID(ctx, id)
+			if err != nil {
+				if errors.Is(err, ports.ErrNotFound) {
+					continue
+				}
+				return nil, errors.Wrapf(err, "failed to get IEAgAg rule %s", id.Key())
+			}
+			// Baseline deny rules are owned by NamespacePosture reconciliation
+			if !rule.IsBaselineDeny() {
+				existingRules = append(existingRules, *rule)
+			}
+		}
+
+		rulesLog.V(1).Info("Changed RuleS2S affect aggregation groups",
+			"changed", len(changed), "affectedGroups", len(affectedIDs), "candidates", len(candidates))
+
+		// Phase 5: Apply the difference and store the new contributions of the affected groups
+		operations := s.calculateRuleOperations(existingRules, affectedFresh)
+		rulesLog.V(1).Info("IEAgAg rule operations needed",
+			"create", len(operations.toCreate), "update", len(operations.toUpdate), "delete", len(operations.toDelete))
+
+		operations.indexContributions(affectedIDs, affectedContributions)
+		return operations, nil
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to execute incremental rule operations for reason: %s", reason)
+	}
+
+	if affectedGroups == 0 {
+		rulesLog.V(1).Info("No aggregation groups affected by changed RuleS2S", "changed", len(changed), "reason", reason)
+		return true, nil
+	}
+	rulesLog.Info("Recalculated aggregation groups", "groups", affectedGroups, "duration", time.Since(startTime), "reason", reason)
+	return true, nil
+}
+
+// indexedAggregationGroups returns the aggregation groups the changed RuleS2S contributed to before the change
+// according to the index and contribute to now, along with the RuleS2S taking part in their aggregation:
+// the existing changed RuleS2S and every RuleS2S indexed for the groups
+func (s *RuleS2SResourceService) indexedAggregationGroups(ctx context.Context, reader ports.Reader, indexReader ports.IEAgAgRuleContributionReader, changed []models.ResourceIdentifier) (map[string]models.ResourceIdentifier, []models.RuleS2S, error) {
+	// Phase 1: Aggregation groups the changed RuleS2S contributed to before the change
+	affected := make(map[string]models.ResourceIdentifier)
+	err := indexReader.ListIEAgAgRuleContributions(ctx, func(contribution models.IEAgAgRuleContribution) error {
+		affected[contribution.IEAgAgRule.Key()] = contribution.IEAgAgRule
+		return nil
+	}, changed, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list contributions of changed RuleS2S")
+	}
+
+	// Phase 2: Aggregation groups the remaining changed RuleS2S contribute to now
+	changedRules, err := listRuleS2SByIDs(ctx, reader, changed)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(changedRules) > 0 {
+		_, _, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, changedRules, changedRules)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate IEAgAg rules of changed RuleS2S")
+		}
+		for _, contribution := range contributions {
+			affected[contribution.IEAgAgRule.Key()] = contribution.IEAgAgRule
+		}
+	}
+	if len(affected) == 0 {
+		return affected, changedRules, nil
+	}
+
+	affectedIDs := make([]models.ResourceIdentifier, 0, len(affected))
+	for _, id := range affected {
+		affectedIDs = append(affectedIDs, id)
+	}
+
+	// Phase 3: Every RuleS2S indexed for the affected groups is a candidate contributor
+	candidateIDs := make(map[string]models.ResourceIdentifier)
+	err = indexReader.ListIEAgAgRuleContributions(ctx, func(contribution models.IEAgAgRuleContribution) error {
+		candidateIDs[contribution.RuleS2S.Key()] = contribution.RuleS2S
+		return nil
+	}, nil, affectedIDs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list contributions of affected aggregation groups")
+	}
+	for _, rule := range changedRules {
+		delete(candidateIDs, rule.Key())
+	}
+	unchangedIDs := make([]models.ResourceIdentifier, 0, len(candidateIDs))
+	for _, id := range candidateIDs {
+		unchangedIDs = append(unchangedIDs, id)
+	}
+	candidates, err := listRuleS2SByIDs(ctx, reader, unchangedIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return affected, append(candidates, changedRules...), nil
+}
+
+// indexedCandidates returns the RuleS2S that can contribute to the aggregation groups of the rules according
+// to the stored contributions. Nil makes the generation check every RuleS2S, it is returned while the index
+// is not available.
+func (s *RuleS2SResourceService) indexedCandidates(ctx context.Context, reader ports.Reader, rules []models.RuleS2S) ([]models.RuleS2S, error) {
+	if !s.contributionIndexReady.Load() || len(rules) == 0 {
+		return nil, nil
+	}
+	indexReader, ok := reader.(ports.IEAgAgRuleContributionReader)
+	if !ok {
+		return nil, nil
+	}
+
+	ids := make([]models.ResourceIdentifier, len(rules))
+	for i, rule := range rules {
+		ids[i] = rule.ResourceIdentifier
+	}
+	_, candidates, err := s.indexedAggregationGroups(ctx, reader, indexReader, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find candidate contributors in aggregation index")
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	return candidates, nil
+}
+
+// listRuleS2SByIDs returns the existing RuleS2S of the identifiers
+func listRuleS2SByIDs(ctx context.Context, reader ports.Reader, ids []models.ResourceIdentifier) ([]models.RuleS2S, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var rules []models.RuleS2S
+	err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		rules = append(rules, rule)
+		return nil
+	}, ports.NewResourceIdentifierScope(ids...))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list RuleS2S")
+	}
+	return rules, nil
+}
+
+// recalculateIEAgAgRulesForDeletedService recalculates the aggregation groups of the RuleS2S referencing
+// a deleted service, without the aggregation index all rules are recalculated
+func (s *RuleS2SResourceService) recalculateIEAgAgRulesForDeletedService(ctx context.Context, reader ports.Reader, serviceID models.ResourceIdentifier) error {
+	reason := fmt.Sprintf("service %s deleted", serviceID.Key())
+
+	var referencing []models.ResourceIdentifier
+	err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		if rule.ServiceRefKey() == serviceID.Key() || rule.ServiceLocalRefKey() == serviceID.Key() {
+			referencing = append(referencing, rule.ResourceIdentifier)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return errors.Wrap(err, "failed to find rules referencing service")
+	}
+
+	if incremental, err := s.recalculateIEAgAgRulesIncrementally(ctx, referencing, reason); incremental || err != nil {
+		return err
+	}
+	return s.RecalculateAllAffectedIEAgAgRules(ctx, reason)
+}
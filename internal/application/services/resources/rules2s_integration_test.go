@@ -63,11 +63,15 @@ func TestRuleS2SToIEAgAgGeneration_IntegrationFlow(t *testing.T) {
 
 	// Create test RuleS2S
 	rule := testutil.CreateTestRuleS2S("test-rule", "default")
-	rule.ServiceLocalRef.Name = "web-alias"
-	rule.ServiceRef.Name = "db-alias"
+	rule.ServiceLocalRef.Name = "web-service"
+	rule.ServiceRef.Name = "db-service"
 	rule.Traffic = models.INGRESS
 	rule.Trace = true // Enable trace (will be copied to generated IEAgAg rule Logs)
 
+	// Aggregated rules are generated from the stored RuleS2S
+	testData["rules2s_default/test-rule"] = &rule
+	mockRegistry.SetupTestData(testData)
+
 	t.Run("GenerateIEAgAgRulesFromRuleS2S", func(t *testing.T) {
 		// Generate IEAgAg rules from RuleS2S
 		generatedRules, err := service.GenerateIEAgAgRulesFromRuleS2S(ctx, rule)
@@ -98,7 +102,7 @@ func TestRuleS2SToIEAgAgGeneration_IntegrationFlow(t *testing.T) {
 
 			// Verify restored fields
 			assert.Equal(t, models.ActionAccept, ieRule.Action, "Action should be Accept")
-			assert.False(t, ieRule.Logs, "Logs should be disabled by default")
+			assert.True(t, ieRule.Logs, "Aggregated rules should have logs enabled")
 			assert.True(t, ieRule.Trace, "Trace should be enabled")
 			assert.Equal(t, int32(100), ieRule.Priority, "Priority should be 100")
 
@@ -165,17 +169,18 @@ func TestRuleS2SToIEAgAgGeneration_IntegrationFlow(t *testing.T) {
 
 		multiPortAlias := testutil.CreateTestServiceAlias("multi-port-alias", "default", "multi-port-service")
 
+		// Create rule referencing multi-port service
+		multiPortRule := testutil.CreateTestRuleS2S("multi-port-rule", "default")
+		multiPortRule.ServiceLocalRef.Name = "multi-port-service"
+		multiPortRule.ServiceRef.Name = "db-service"
+		multiPortRule.Traffic = models.INGRESS
+
 		// Add new test data to mock registry
 		testData["service_default/multi-port-service"] = &multiPortService
 		testData["servicealias_default/multi-port-alias"] = &multiPortAlias
+		testData["rules2s_default/multi-port-rule"] = &multiPortRule
 		mockRegistry.SetupTestData(testData)
 
-		// Create rule referencing multi-port service
-		multiPortRule := testutil.CreateTestRuleS2S("multi-port-rule", "default")
-		multiPortRule.ServiceLocalRef.Name = "multi-port-alias"
-		multiPortRule.ServiceRef.Name = "db-alias"
-		multiPortRule.Traffic = models.INGRESS
-
 		rules, err := service.GenerateIEAgAgRulesFromRuleS2S(ctx, multiPortRule)
 		require.NoError(t, err)
 
@@ -194,10 +199,8 @@ func TestRuleS2SToIEAgAgGeneration_IntegrationFlow(t *testing.T) {
 		portStr := tcpRule.Ports[0].Destination
 		t.Logf("Aggregated ports: %s", portStr)
 
-		// Verify ports are comma-separated and sorted
-		assert.Contains(t, portStr, "8080", "Should contain port 8080")
-		assert.Contains(t, portStr, "8081", "Should contain port 8081")
-		assert.Contains(t, portStr, "8082", "Should contain port 8082")
+		// Verify consecutive ports are merged into a range
+		assert.Contains(t, portStr, "8080-8082", "Should merge ports 8080-8082 into a range")
 		assert.Contains(t, portStr, ",", "Ports should be comma-separated")
 	})
 }
@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// ieAgAgRuleOwners resolves the owners of generated IEAgAgRules: the contributing RuleS2S and both
+// AddressGroups of the rule. AddressGroup UIDs are read once per generation.
+type ieAgAgRuleOwners struct {
+	reader           ports.Reader
+	addressGroupUIDs map[string]string
+}
+
+func newIEAgAgRuleOwners(reader ports.Reader) *ieAgAgRuleOwners {
+	return &ieAgAgRuleOwners{reader: reader, addressGroupUIDs: make(map[string]string)}
+}
+
+// references returns the owner references of a rule in namespace generated from ruleS2S.
+// Kubernetes GC deletes a dependent once all its owners are gone, so the RuleS2S are owners only
+// when all of them can be referenced from the namespace of the rule. Either address group alone
+// invalidates the rule, the address groups of the namespace are always owners.
+func (o *ieAgAgRuleOwners) references(ctx context.Context, namespace string, ruleS2S []models.RuleS2S, localAG, targetAG models.AddressGroupRef) []metav1.OwnerReference {
+	owners := make([]models.ResourceOwner, 0, len(ruleS2S)+2)
+	for _, rule := range ruleS2S {
+		if rule.Namespace != namespace || rule.Meta.UID == "" {
+			owners = owners[:0]
+			break
+		}
+		owners = append(owners, models.ResourceOwner{Kind: models.OwnerKindRuleS2S, ID: rule.ResourceIdentifier, UID: rule.Meta.UID})
+	}
+	for _, ref := range []models.AddressGroupRef{localAG, targetAG} {
+		id := models.NewResourceIdentifier(ref.Name, models.WithNamespace(ref.Namespace))
+		owners = append(owners, models.ResourceOwner{Kind: models.OwnerKindAddressGroup, ID: id, UID: o.addressGroupUID(ctx, id)})
+	}
+	return models.IEAgAgRuleOwnerReferences(namespace, owners)
+}
+
+// addressGroupUID returns the UID of an address group, empty when it can't be read
+func (o *ieAgAgRuleOwners) addressGroupUID(ctx context.Context, id models.ResourceIdentifier) string {
+	if uid, ok := o.addressGroupUIDs[id.Key()]; ok {
+		return uid
+	}
+	var uid string
+	if group, err := o.reader.GetAddressGroupByID(ctx, id); err == nil {
+		uid = group.Meta.UID
+	} else if !errors.Is(err, ports.ErrNotFound) {
+		rulesLog.Error(err, "Failed to read address group, it is not an owner", "addressGroup", id.Key())
+	}
+	o.addressGroupUIDs[id.Key()] = uid
+	return uid
+}
+
+// DeleteIEAgAgRulesOwnedBy deletes the IEAgAgRules whose owners are all among the deleted owners,
+// the same dependents Kubernetes GC removes when the owners are deleted
+func (s *RuleS2SResourceService) DeleteIEAgAgRulesOwnedBy(ctx context.Context, ownerUIDs map[string]bool) error {
+	if len(ownerUIDs) == 0 {
+		return nil
+	}
+
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader")
+	}
+	var owned []models.ResourceIdentifier
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		if rule.OwnedOnlyBy(ownerUIDs) {
+			owned = append(owned, rule.ResourceIdentifier)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	reader.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to list IEAgAg rules")
+	}
+
+	if len(owned) == 0 {
+		return nil
+	}
+	rulesLog.Info("Deleting IEAgAg rules of deleted owners", "rules", len(owned))
+	return s.DeleteIEAgAgRulesByIDs(ctx, owned)
+}
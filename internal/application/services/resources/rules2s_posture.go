@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// =============================================================================
+// NamespacePosture Operations
+// =============================================================================
+
+// GetNamespacePostures returns all namespace postures within scope
+func (s *RuleS2SResourceService) GetNamespacePostures(ctx context.Context, scope ports.Scope) ([]models.NamespacePosture, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	var postures []models.NamespacePosture
+	err = reader.ListNamespacePostures(ctx, func(posture models.NamespacePosture) error {
+		postures = append(postures, posture)
+		return nil
+	}, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list namespace postures")
+	}
+	return postures, nil
+}
+
+// GetNamespacePostureByID returns namespace posture by ID
+func (s *RuleS2SResourceService) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	return reader.GetNamespacePostureByID(ctx, id)
+}
+
+// SyncNamespacePostures synchronizes namespace postures and reconciles the baseline deny rules
+func (s *RuleS2SResourceService) SyncNamespacePostures(ctx context.Context, postures []models.NamespacePosture, scope ports.Scope, syncOp models.SyncOp) error {
+	if syncOp != models.SyncOpDelete {
+		if err := s.validateNamespacePostures(ctx, postures); err != nil {
+			return err
+		}
+	}
+
+	writer, err := s.registry.Writer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer")
+	}
+	defer func() {
+		if err != nil {
+			writer.Abort()
+		}
+	}()
+
+	if err = writer.SyncNamespacePostures(ctx, postures, scope, ports.WithSyncOp(syncOp)); err != nil {
+		return errors.Wrap(err, "failed to sync namespace postures")
+	}
+
+	if err = writer.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	rulesLog.V(1).Info("Reconciling baseline deny rules", "syncOp", syncOp, "postures", len(postures))
+	if err := s.ReconcileNamespacePostures(ctx); err != nil {
+		return errors.Wrap(err, "failed to reconcile baseline deny rules of namespace postures")
+	}
+
+	return nil
+}
+
+// ReconcileNamespacePostures brings the baseline deny IEAgAg rules in line with the namespace
+// postures and the address groups of their namespaces. Default-deny namespaces get a deny rule
+// for every pair of their address groups, rules of other namespaces are removed.
+func (s *RuleS2SResourceService) ReconcileNamespacePostures(ctx context.Context) error {
+	return s.reconcileNamespacePostures(ctx, nil)
+}
+
+// DeleteNamespacePostureRulesForAddressGroups removes the baseline deny rules of address groups that
+// are about to be deleted, so the deletion is synced to sgroups like any other rule removal
+func (s *RuleS2SResourceService) DeleteNamespacePostureRulesForAddressGroups(ctx context.Context, addressGroupIDs []models.ResourceIdentifier) error {
+	excluded := make(map[string]bool, len(addressGroupIDs))
+	for _, id := range addressGroupIDs {
+		excluded[id.Key()] = true
+	}
+	return s.reconcileNamespacePostures(ctx, excluded)
+}
+
+// reconcileNamespacePostures reconciles baseline deny rules ignoring the excluded address groups
+func (s *RuleS2SResourceService) reconcileNamespacePostures(ctx context.Context, excludedAddressGroups map[string]bool) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader")
+	}
+
+	denyNamespaces := make(map[string]bool)
+	err = reader.ListNamespacePostures(ctx, func(posture models.NamespacePosture) error {
+		if posture.IsDefaultDeny() {
+			denyNamespaces[posture.Namespace] = true
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		reader.Close()
+		return errors.Wrap(err, "failed to list namespace postures")
+	}
+
+	var addressGroups []models.AddressGroup
+	err = reader.ListAddressGroups(ctx, func(group models.AddressGroup) error {
+		if denyNamespaces[group.Namespace] && !excludedAddressGroups[group.Key()] {
+			addressGroups = append(addressGroups, group)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		reader.Close()
+		return errors.Wrap(err, "failed to list address groups")
+	}
+
+	var existing []models.IEAgAgRule
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		if rule.IsBaselineDeny() {
+			existing = append(existing, rule)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	reader.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to list IEAgAg rules")
+	}
+
+	var desired []models.IEAgAgRule
+	for namespace := range denyNamespaces {
+		desired = append(desired, models.BaselineDenyRules(namespace, addressGroups)...)
+	}
+
+	operations := s.calculateRuleOperations(existing, desired)
+	rulesLog.V(1).Info("Baseline deny rule operations needed",
+		"create", len(operations.toCreate), "update", len(operations.toUpdate), "delete", len(operations.toDelete))
+
+	return s.executeRuleOperations(ctx, operations, "NamespacePosture reconciliation")
+}
+
+// validateNamespacePostures validates postures for creation or update depending on their existence
+func (s *RuleS2SResourceService) validateNamespacePostures(ctx context.Context, postures []models.NamespacePosture) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader for validation")
+	}
+	defer reader.Close()
+
+	validator := validation.NewDependencyValidator(reader).GetNamespacePostureValidator()
+	for _, posture := range postures {
+		existing, err := reader.GetNamespacePostureByID(ctx, posture.ResourceIdentifier)
+		switch {
+		case err == nil:
+			err = validator.ValidateForUpdate(ctx, *existing, posture)
+		case errors.Is(err, ports.ErrNotFound):
+			err = validator.ValidateForCreation(ctx, posture)
+		default:
+			return errors.Wrapf(err, "failed to get namespace posture %s", posture.Key())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
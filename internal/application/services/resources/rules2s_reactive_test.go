@@ -60,11 +60,15 @@ func TestRuleS2SReactiveGeneration(t *testing.T) {
 
 	// Create RuleS2S
 	rule := testutil.CreateTestRuleS2S("test-rule", "default")
-	rule.ServiceLocalRef.Name = "web-alias"
-	rule.ServiceRef.Name = "db-alias"
+	rule.ServiceLocalRef.Name = "web-service"
+	rule.ServiceRef.Name = "db-service"
 	rule.Traffic = models.INGRESS
 	rule.Trace = true
 
+	// Aggregated rules are generated from the stored RuleS2S
+	testData["rules2s_default/test-rule"] = &rule
+	mockRegistry.SetupTestData(testData)
+
 	t.Run("ServicePortChange_TriggersRegeneration", func(t *testing.T) {
 		// Generate initial IEAgAg rules
 		initialRules, err := service.GenerateIEAgAgRulesFromRuleS2S(ctx, rule)
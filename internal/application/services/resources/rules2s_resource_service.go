@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,14 +18,23 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+	"netguard-pg-backend/internal/logging"
 	"netguard-pg-backend/internal/sync/interfaces"
 	"netguard-pg-backend/internal/sync/types"
 )
 
 // aggregationMutexes contains mutexes for synchronizing aggregation operations
-// This prevents race conditions when multiple RuleS2S operations affect the same aggregation groups
+// This prevents race conditions when multiple RuleS2S operations affect the same aggregation groups.
+// They are used only with registries that can't lock aggregation keys in the database.
 var aggregationMutexes = sync.Map{}
 
+// aggregationLog is the logger of cross-RuleS2S port aggregation. It is very
+// chatty, so its verbosity is configured separately (logger.subsystems.aggregation).
+var aggregationLog = logging.For(logging.SubsystemAggregation)
+
+// rulesLog is the logger of IEAgAgRule generation from RuleS2S, templates and postures
+var rulesLog = logging.For(logging.SubsystemRules)
+
 // AggregationKey uniquely identifies an aggregated rule for synchronization
 type AggregationKey struct {
 	Traffic      string
@@ -33,15 +43,62 @@ type AggregationKey struct {
 	Protocol     string
 }
 
+// String returns the lock key of the aggregated rule
+func (k AggregationKey) String() string {
+	return fmt.Sprintf("%s-%s-%s-%s", k.Traffic, k.LocalAGName, k.TargetAGName, k.Protocol)
+}
+
 // getAggregationMutex returns a mutex for a specific aggregation key
 func getAggregationMutex(key AggregationKey) *sync.Mutex {
-	mutexKey := fmt.Sprintf("%s-%s-%s-%s",
-		key.Traffic, key.LocalAGName, key.TargetAGName, key.Protocol)
-
-	mutex, _ := aggregationMutexes.LoadOrStore(mutexKey, &sync.Mutex{})
+	mutex, _ := aggregationMutexes.LoadOrStore(key.String(), &sync.Mutex{})
 	return mutex.(*sync.Mutex)
 }
 
+// lockAggregationKeys serializes aggregation of the keys. Writers of a shared database lock the keys in
+// the database until the end of their transaction, so all replicas are serialized; otherwise the keys are
+// locked in this process only. The returned function releases the in-process locks.
+func lockAggregationKeys(ctx context.Context, writer ports.Writer, keys []AggregationKey) (func(), error) {
+	keyStrs := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key.String()] {
+			seen[key.String()] = true
+			keyStrs = append(keyStrs, key.String())
+		}
+	}
+	sort.Strings(keyStrs) // Sort for deterministic lock order
+
+	if locker, ok := writer.(ports.AggregationLocker); ok {
+		if err := locker.LockAggregationKeys(ctx, keyStrs); err != nil {
+			return nil, errors.Wrap(err, "failed to lock aggregation groups")
+		}
+		return func() {}, nil
+	}
+
+	mutexes := make(map[string]*sync.Mutex, len(keys))
+	for _, key := range keys {
+		mutexes[key.String()] = getAggregationMutex(key)
+	}
+	for _, keyStr := range keyStrs {
+		mutexes[keyStr].Lock()
+	}
+	return func() {
+		for _, keyStr := range keyStrs {
+			mutexes[keyStr].Unlock()
+		}
+	}, nil
+}
+
+// AggregationMutexCount returns the number of aggregation keys that have a mutex allocated
+func AggregationMutexCount() int {
+	count := 0
+	aggregationMutexes.Range(func(_, _ interface{}) bool {
+		count++
+		return true
+	})
+	return count
+}
+
 // ContributingRule represents a RuleS2S that contributes to an IEAgAgRule aggregation
 // This is the core structure for cross-RuleS2S port aggregation logic
 type ContributingRule struct {
@@ -54,6 +111,16 @@ type RuleS2SResourceService struct {
 	registry         ports.Registry
 	syncManager      interfaces.SyncManager
 	conditionManager ConditionManager // Interface for condition management
+	ruleEngine       IEAgAgRuleEngine // Generates IEAgAgRules for every API path
+	gcStats          ruleGCStats      // Results of orphaned IEAgAgRule garbage collection
+	// Aggregation index of IEAgAgRules is complete, recalculations can be incremental
+	contributionIndexReady atomic.Bool
+	// events receives IEAgAgRule regeneration events (nil - not published)
+	events ports.EventPublisher
+	// diffNotifier receives per-namespace diffs of committed IEAgAgRule changes (nil - disabled)
+	diffNotifier ports.IEAgAgRuleDiffNotifier
+	// priorityCalculator calculates priorities of generated IEAgAgRules, DefaultRulePriorityStrategy when nil
+	priorityCalculator RulePriorityCalculator
 }
 
 // ConditionManager interface for handling resource conditions
@@ -64,11 +131,47 @@ type ConditionManager interface {
 
 // NewRuleS2SResourceService creates a new RuleS2SResourceService
 func NewRuleS2SResourceService(registry ports.Registry, syncManager interfaces.SyncManager, conditionManager ConditionManager) *RuleS2SResourceService {
-	return &RuleS2SResourceService{
+	s := &RuleS2SResourceService{
 		registry:         registry,
 		syncManager:      syncManager,
 		conditionManager: conditionManager,
 	}
+	s.ruleEngine = aggregatedRuleEngine{service: s}
+	return s
+}
+
+// SetEventPublisher publishes events of regenerated IEAgAgRules to publisher
+func (s *RuleS2SResourceService) SetEventPublisher(publisher ports.EventPublisher) {
+	s.events = publisher
+}
+
+// SetIEAgAgRuleDiffNotifier notifies notifier about committed IEAgAgRule changes grouped by namespace
+func (s *RuleS2SResourceService) SetIEAgAgRuleDiffNotifier(notifier ports.IEAgAgRuleDiffNotifier) {
+	s.diffNotifier = notifier
+}
+
+// SetLegacyRuleGeneration switches IEAgAgRule generation of all API paths to the
+// legacy per-RuleS2S engine (no port aggregation, RuleS2S namespace, logs disabled)
+func (s *RuleS2SResourceService) SetLegacyRuleGeneration(legacy bool) {
+	if legacy {
+		s.ruleEngine = legacyRuleEngine{service: s}
+		rulesLog.Info("Using legacy per-RuleS2S IEAgAgRule generation")
+		return
+	}
+	s.ruleEngine = aggregatedRuleEngine{service: s}
+}
+
+// SetRulePriorityCalculator replaces the strategy calculating priorities of generated IEAgAgRules
+func (s *RuleS2SResourceService) SetRulePriorityCalculator(calculator RulePriorityCalculator) {
+	s.priorityCalculator = calculator
+}
+
+// rulePriority returns the priority of the IEAgAgRules generated from the rule
+func (s *RuleS2SResourceService) rulePriority(rule *models.RuleS2S) int32 {
+	if s.priorityCalculator == nil {
+		return DefaultRulePriorityStrategy().RulePriority(rule)
+	}
+	return s.priorityCalculator.RulePriority(rule)
 }
 
 // =============================================================================
@@ -130,7 +233,7 @@ func (s *RuleS2SResourceService) GetRuleS2SByIDs(ctx context.Context, ids []mode
 // CreateRuleS2S creates a new RuleS2S with IEAgAgRule generation
 func (s *RuleS2SResourceService) CreateRuleS2S(ctx context.Context, rule models.RuleS2S) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -185,7 +288,7 @@ func (s *RuleS2SResourceService) CreateRuleS2S(ctx context.Context, rule models.
 // UpdateRuleS2S updates an existing RuleS2S
 func (s *RuleS2SResourceService) UpdateRuleS2S(ctx context.Context, rule models.RuleS2S) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -345,10 +448,10 @@ func (s *RuleS2SResourceService) SyncRuleS2S(ctx context.Context, rules []models
 
 // DeleteRuleS2SByIDs deletes RuleS2S by IDs and triggers targeted IEAgAg rule cleanup
 func (s *RuleS2SResourceService) DeleteRuleS2SByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
-	klog.Infof("🗑️ RULES2S_DELETE: Starting deletion of %d RuleS2S with targeted cleanup", len(ids))
+	rulesLog.V(1).Info("Deleting RuleS2S", "count", len(ids))
 
 	// Validate dependencies for each RuleS2S
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for validation")
 	}
@@ -364,13 +467,18 @@ func (s *RuleS2SResourceService) DeleteRuleS2SByIDs(ctx context.Context, ids []m
 	}
 
 
-	// 🎯 CRITICAL FIX: Capture IEAgAgRules that are referenced by RuleS2S being deleted BEFORE deletion
+	// 🎯 CRITICAL FIX: Capture IEAgAgRules that are referenced by RuleS2S being deleted BEFORE deletion.
+	// With the aggregation index the affected rules are found from the index after the deletion.
 	var referencedIEAgAgRules []models.ResourceIdentifier
-	for _, id := range ids {
+	referenceIDs := ids
+	if s.contributionIndexReady.Load() {
+		referenceIDs = nil
+	}
+	for _, id := range referenceIDs {
 		rule, err := reader.GetRuleS2SByID(ctx, id)
 		if err != nil {
 			if errors.Is(err, ports.ErrNotFound) {
-				klog.Infof("🔍 RULES2S_DELETE: RuleS2S %s already deleted, skipping", id.Key())
+				rulesLog.V(2).Info("RuleS2S already deleted, skipping", "rule", id.Key())
 				continue
 			}
 			return errors.Wrapf(err, "failed to get RuleS2S %s for cleanup", id.Key())
@@ -385,44 +493,44 @@ func (s *RuleS2SResourceService) DeleteRuleS2SByIDs(ctx context.Context, ids []m
 					Name:      ieagagRef.Name,
 				}
 				referencedIEAgAgRules = append(referencedIEAgAgRules, refID)
-				klog.Infof("🎯 RULES2S_DELETE: RuleS2S %s references IEAgAgRule %s for cleanup", id.Key(), refID.Key())
+				rulesLog.V(2).Info("RuleS2S references IEAgAgRule for cleanup", "rule", id.Key(), "ieAgAgRule", refID.Key())
 			}
 		} else {
 			// 🚨 CRITICAL FALLBACK: If IEAgAgRuleRefs is empty, generate expected rules and check their existence
-			klog.Warningf("🔍 FALLBACK_CLEANUP: RuleS2S %s has no IEAgAgRuleRefs, using fallback rule generation for cleanup", id.Key())
+			rulesLog.V(1).Info("RuleS2S has no IEAgAgRuleRefs, generating its rules for cleanup", "rule", id.Key())
 
 			expectedRules, err := s.GenerateIEAgAgRulesFromRuleS2SWithReader(ctx, reader, *rule)
 			if err != nil {
-				klog.Errorf("❌ FALLBACK_CLEANUP: Failed to generate expected rules for RuleS2S %s: %v", id.Key(), err)
+				rulesLog.Error(err, "Failed to generate expected IEAgAgRules for cleanup", "rule", id.Key())
 				// Continue with other RuleS2S - don't fail entire operation
 				continue
 			}
 
-			klog.Infof("🔍 FALLBACK_CLEANUP: Generated %d expected IEAgAgRules for RuleS2S %s", len(expectedRules), id.Key())
+			rulesLog.V(2).Info("Generated expected IEAgAgRules for cleanup", "rule", id.Key(), "count", len(expectedRules))
 
 			// Check which expected rules actually exist in database
 			for _, expectedRule := range expectedRules {
 				existingRule, err := reader.GetIEAgAgRuleByID(ctx, expectedRule.ResourceIdentifier)
 				if err != nil {
 					if errors.Is(err, ports.ErrNotFound) {
-						klog.Infof("  📋 FALLBACK_CLEANUP: Expected IEAgAgRule %s not found, skipping", expectedRule.Key())
+						rulesLog.V(3).Info("Expected IEAgAgRule not found, skipping", "ieAgAgRule", expectedRule.Key())
 						continue
 					}
-					klog.Errorf("❌ FALLBACK_CLEANUP: Error checking IEAgAgRule %s: %v", expectedRule.Key(), err)
+					rulesLog.Error(err, "Failed to read expected IEAgAgRule", "ieAgAgRule", expectedRule.Key())
 					continue
 				}
 
 				if existingRule != nil {
 					referencedIEAgAgRules = append(referencedIEAgAgRules, expectedRule.ResourceIdentifier)
-					klog.Infof("✅ FALLBACK_CLEANUP: Found existing IEAgAgRule %s for cleanup", expectedRule.Key())
+					rulesLog.V(2).Info("Found existing IEAgAgRule for cleanup", "ieAgAgRule", expectedRule.Key())
 				}
 			}
 
-			klog.Infof("🎯 FALLBACK_CLEANUP: RuleS2S %s fallback found %d existing IEAgAgRules for cleanup", id.Key(), len(expectedRules))
+			rulesLog.V(2).Info("Generated IEAgAgRules checked for cleanup", "rule", id.Key(), "count", len(expectedRules))
 		}
 	}
 
-	klog.Infof("🎯 RULES2S_DELETE: Found %d IEAgAgRules referenced by RuleS2S being deleted", len(referencedIEAgAgRules))
+	rulesLog.V(1).Info("Found IEAgAgRules referenced by deleted RuleS2S", "count", len(referencedIEAgAgRules))
 
 	writer, err := s.registry.Writer(ctx)
 	if err != nil {
@@ -443,36 +551,65 @@ func (s *RuleS2SResourceService) DeleteRuleS2SByIDs(ctx context.Context, ids []m
 		return errors.Wrap(err, "failed to commit transaction")
 	}
 
-	klog.Infof("✅ RULES2S_DELETE: Successfully deleted %d RuleS2S", len(ids))
+	rulesLog.Info("Deleted RuleS2S", "count", len(ids))
+
+	if referenceIDs == nil {
+		reason := fmt.Sprintf("rules2s-deletion-cleanup-%d-rules", len(ids))
+		incremental, err := s.recalculateIEAgAgRulesIncrementally(ctx, ids, reason)
+		if err != nil {
+			rulesLog.Error(err, "Incremental IEAgAgRule cleanup failed after RuleS2S deletion")
+			// Don't fail the deletion for recalculation errors, just log them
+		}
+		if !incremental {
+			if err := s.RecalculateAllAffectedIEAgAgRules(ctx, reason); err != nil {
+				rulesLog.Error(err, "IEAgAgRule cleanup failed after RuleS2S deletion")
+			}
+		}
+		return nil
+	}
 
 	// Step 2: 🎯 CRITICAL FIX: Targeted cleanup for ONLY the affected IEAgAgRules
 	// This prevents the massive DELETE operation bug by only affecting rules that were
 	// actually generated by the deleted RuleS2S
 	if len(referencedIEAgAgRules) > 0 {
-		klog.Infof("🔄 RULES2S_DELETE: Triggering targeted cleanup for %d affected IEAgAgRules", len(referencedIEAgAgRules))
+		rulesLog.V(1).Info("Recalculating IEAgAgRules of deleted RuleS2S", "count", len(referencedIEAgAgRules))
 
-		// 🔍 DEBUG: Log each referenced IEAgAgRule before cleanup
-		for i, ruleID := range referencedIEAgAgRules {
-			klog.Infof("  📋 RULES2S_DELETE[%d]: Referenced IEAgAgRule: %s", i+1, ruleID.Key())
+		for _, ruleID := range referencedIEAgAgRules {
+			rulesLog.V(3).Info("Referenced IEAgAgRule", "ieAgAgRule", ruleID.Key())
 		}
 
 		// Use targeted cleanup that only affects specific referenced rules
 		reason := fmt.Sprintf("rules2s-deletion-cleanup-%d-rules", len(ids))
-		klog.Infof("🚀 RULES2S_DELETE: CALLING RecalculateTargetedIEAgAgRules with reason: %s", reason)
-
 		if err := s.RecalculateTargetedIEAgAgRules(ctx, referencedIEAgAgRules, reason); err != nil {
-			klog.Errorf("⚠️ RULES2S_DELETE: Targeted cleanup failed after deletion: %v", err)
+			rulesLog.Error(err, "Targeted IEAgAgRule cleanup failed after RuleS2S deletion", "reason", reason)
 			// Don't fail the deletion for recalculation errors, just log them
 		} else {
-			klog.Infof("✅ RULES2S_DELETE: Targeted cleanup completed successfully")
+			rulesLog.V(1).Info("Targeted IEAgAgRule cleanup completed", "reason", reason)
 		}
 	} else {
-		klog.Infof("✅ RULES2S_DELETE: No IEAgAgRules referenced by deleted RuleS2S, no cleanup needed")
+		rulesLog.V(1).Info("No IEAgAgRules referenced by deleted RuleS2S")
 	}
 
 	return nil
 }
 
+// RecalculateIEAgAgRulesOfDeletedRuleS2S recalculates IEAgAgRules aggregated from RuleS2S deleted
+// outside of DeleteRuleS2SByIDs, e.g. by a namespace purge. With the aggregation index only the
+// groups of the deleted RuleS2S are recalculated, otherwise all IEAgAgRules.
+func (s *RuleS2SResourceService) RecalculateIEAgAgRulesOfDeletedRuleS2S(ctx context.Context, ids []models.ResourceIdentifier, reason string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	incremental, err := s.recalculateIEAgAgRulesIncrementally(ctx, ids, reason)
+	if err != nil {
+		rulesLog.Error(err, "Incremental IEAgAgRule cleanup failed, recalculating all IEAgAgRules", "reason", reason)
+	}
+	if incremental && err == nil {
+		return nil
+	}
+	return s.RecalculateAllAffectedIEAgAgRules(ctx, reason)
+}
+
 // =============================================================================
 // IEAgAgRule Operations
 // =============================================================================
@@ -578,57 +715,58 @@ func (s *RuleS2SResourceService) DeleteIEAgAgRulesByIDs(ctx context.Context, ids
 	klog.Infof("🗑️ IEAGAG_DELETE: Starting deletion of %d IEAgAgRules with external sync", len(ids))
 
 	// CRITICAL FIX: First get the rules to delete for external sync BEFORE deletion
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for external sync preparation")
 	}
-	defer reader.Close()
+	rulesToDelete, err := getIEAgAgRulesByIDs(ctx, reader, ids)
+	reader.Close()
+	if err != nil {
+		return err
+	}
 
-	var rulesToDelete []models.IEAgAgRule
-	for _, id := range ids {
-		rule, err := reader.GetIEAgAgRuleByID(ctx, id)
-		if err != nil {
-			klog.Warningf("⚠️ IEAGAG_DELETE: Rule %s not found for deletion (may already be deleted): %v", id.Key(), err)
-			continue // Continue with other rules
-		}
-		rulesToDelete = append(rulesToDelete, *rule)
-		klog.Infof("  📋 IEAGAG_DELETE: Prepared rule for deletion: %s", rule.SelfRef.Key())
+	keys := make([]AggregationKey, 0, len(rulesToDelete))
+	for _, rule := range rulesToDelete {
+		keys = append(keys, ieAgAgRuleAggregationKey(rule))
 	}
 
-	// 🔧 SERIALIZATION_FIX: Use WriterForDeletes to reduce serialization conflicts during concurrent delete operations
-	var writer ports.Writer
-	if registryWithDeletes, ok := s.registry.(interface {
-		WriterForDeletes(context.Context) (ports.Writer, error)
-	}); ok {
-		klog.V(2).Infof("🔧 SERIALIZATION_FIX: Using WriterForDeletes with ReadCommitted isolation for %d rules", len(ids))
-		writer, err = registryWithDeletes.WriterForDeletes(ctx)
+	// 🔧 SERIALIZATION_FIX: Delete with ReadCommitted isolation and retry serialization
+	// conflicts of concurrent delete operations. The aggregation keys of the rules are locked
+	// in the writer transaction, the rules synced as deleted are read again after locking.
+	klog.Infof("🗄️ IEAGAG_DELETE: Deleting %d rules from backend", len(ids))
+	unlock := func() {}
+	defer func() { unlock() }()
+	if err = ports.Write(ctx, s.registry, ports.DeleteWriterOptions, func(writer ports.Writer) error {
+		unlock()
+		release, err := lockAggregationKeys(ctx, writer, keys)
 		if err != nil {
-			return errors.Wrap(err, "failed to get delete writer with ReadCommitted isolation")
+			return err
 		}
-	} else {
-		klog.V(2).Infof("🔧 SERIALIZATION_FIX: WriterForDeletes not available, using standard writer for %d rules", len(ids))
-		writer, err = s.registry.Writer(ctx)
+		unlock = release
+
+		reader, err := s.registry.ReaderFromWriter(ctx, writer)
 		if err != nil {
-			return errors.Wrap(err, "failed to get writer")
+			return errors.Wrap(err, "failed to get reader from writer")
 		}
-	}
-	defer func() {
+		rulesToDelete, err = getIEAgAgRulesByIDs(ctx, reader, ids)
+		reader.Close()
 		if err != nil {
-			writer.Abort()
+			return err
 		}
-	}()
-
-	// Delete from backend
-	klog.Infof("🗄️ IEAGAG_DELETE: Deleting %d rules from backend", len(ids))
-	if err = writer.DeleteIEAgAgRulesByIDs(ctx, ids); err != nil {
+		return writer.DeleteIEAgAgRulesByIDs(ctx, ids)
+	}); err != nil {
 		return errors.Wrap(err, "failed to delete IEAgAgRules from backend")
 	}
 
-	if err = writer.Commit(); err != nil {
-		return errors.Wrap(err, "failed to commit backend deletion transaction")
-	}
-
 	// 🔄 CRITICAL FIX: Sync deletions to external systems (SGROUP)
+	s.syncIEAgAgRuleDeletions(ctx, rulesToDelete)
+
+	klog.Infof("✅ IEAGAG_DELETE: Completed deletion of %d IEAgAgRules (backend + external sync)", len(ids))
+	return nil
+}
+
+// syncIEAgAgRuleDeletions syncs deleted IEAgAgRules to external systems, failures are only logged
+func (s *RuleS2SResourceService) syncIEAgAgRuleDeletions(ctx context.Context, rulesToDelete []models.IEAgAgRule) {
 	klog.Infof("🔄 IEAGAG_DELETE: Syncing deletion of %d rules to external systems", len(rulesToDelete))
 	if s.syncManager != nil {
 		for _, rule := range rulesToDelete {
@@ -642,9 +780,24 @@ func (s *RuleS2SResourceService) DeleteIEAgAgRulesByIDs(ctx context.Context, ids
 	} else {
 		klog.Warningf("⚠️ IEAGAG_DELETE: syncManager is nil - external sync SKIPPED for %d deleted rules", len(rulesToDelete))
 	}
+}
 
-	klog.Infof("✅ IEAGAG_DELETE: Completed deletion of %d IEAgAgRules (backend + external sync)", len(ids))
-	return nil
+// getIEAgAgRulesByIDs returns the existing IEAgAgRules of the identifiers
+func getIEAgAgRulesByIDs(ctx context.Context, reader ports.Reader, ids []models.ResourceIdentifier) ([]models.IEAgAgRule, error) {
+	var rules []models.IEAgAgRule
+	for _, id := range ids {
+		rule, err := reader.GetIEAgAgRuleByID(ctx, id)
+		if errors.Is(err, ports.ErrNotFound) {
+			klog.Warningf("⚠️ IEAGAG_DELETE: Rule %s not found for deletion (may already be deleted)", id.Key())
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get IEAgAgRule %s", id.Key())
+		}
+		rules = append(rules, *rule)
+		klog.Infof("  📋 IEAGAG_DELETE: Prepared rule for deletion: %s", rule.SelfRef.Key())
+	}
+	return rules, nil
 }
 
 // =============================================================================
@@ -653,7 +806,7 @@ func (s *RuleS2SResourceService) DeleteIEAgAgRulesByIDs(ctx context.Context, ids
 
 // GenerateIEAgAgRulesFromRuleS2S generates IEAgAgRules from a RuleS2S
 func (s *RuleS2SResourceService) GenerateIEAgAgRulesFromRuleS2S(ctx context.Context, ruleS2S models.RuleS2S) ([]models.IEAgAgRule, error) {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get reader")
 	}
@@ -664,6 +817,13 @@ func (s *RuleS2SResourceService) GenerateIEAgAgRulesFromRuleS2S(ctx context.Cont
 
 // GenerateIEAgAgRulesFromRuleS2SWithReader generates IEAgAgRules using existing reader
 func (s *RuleS2SResourceService) GenerateIEAgAgRulesFromRuleS2SWithReader(ctx context.Context, reader ports.Reader, ruleS2S models.RuleS2S) ([]models.IEAgAgRule, error) {
+	_, rules, err := s.ruleEngine.Generate(ctx, reader, []models.RuleS2S{ruleS2S})
+	return rules, err
+}
+
+// generateIEAgAgRulesForRuleS2S generates IEAgAgRules of a single RuleS2S without
+// cross-rule aggregation (legacy engine)
+func (s *RuleS2SResourceService) generateIEAgAgRulesForRuleS2S(ctx context.Context, reader ports.Reader, ruleS2S models.RuleS2S) ([]models.IEAgAgRule, error) {
 
 	localServiceID := models.ResourceIdentifier{
 		Name:      ruleS2S.ServiceLocalRef.Name,
@@ -683,25 +843,26 @@ func (s *RuleS2SResourceService) GenerateIEAgAgRulesFromRuleS2SWithReader(ctx co
 		return nil, errors.Wrapf(err, "failed to get target service %s", ruleS2S.ServiceRef.Name)
 	}
 
-	// Extract ports based on traffic direction
-	var portsSource *models.Service
-	if ruleS2S.Traffic == models.INGRESS {
-		portsSource = localService
-	} else {
-		portsSource = targetService
-	}
-
-	allPorts := s.extractPortsFromService(*portsSource)
+	// Ports of the service selected by the rule (traffic direction by default) and its extra ports
+	allPorts := ruleS2S.RulePorts(localService, targetService)
 	var generatedRules []models.IEAgAgRule
 
 	// 🎯 STORY-001: Use AggregatedAddressGroups (spec + bindings) instead of AddressGroups (spec only)
-	localAGs := extractAddressGroupRefs(localService.AggregatedAddressGroups)
-	targetAGs := extractAddressGroupRefs(targetService.AggregatedAddressGroups)
+	// with the address groups they include
+	localAGs, err := serviceAddressGroupRefs(ctx, reader, localService)
+	if err != nil {
+		return nil, err
+	}
+	targetAGs, err := serviceAddressGroupRefs(ctx, reader, targetService)
+	if err != nil {
+		return nil, err
+	}
+	owners := newIEAgAgRuleOwners(reader)
 
 	for _, localAG := range localAGs {
 		for _, targetAG := range targetAGs {
 			// Group by protocol
-			for _, protocol := range []models.TransportProtocol{models.TCP, models.UDP} {
+			for _, protocol := range models.TransportProtocols() {
 				var protocolPorts []models.IngressPort
 				for _, port := range allPorts {
 					if port.Protocol == protocol {
@@ -726,10 +887,14 @@ func (s *RuleS2SResourceService) GenerateIEAgAgRulesFromRuleS2SWithReader(ctx co
 					AddressGroupLocal: localAG,
 					AddressGroup:      targetAG,
 					Ports:             s.convertIngressPortsToPortSpecs(protocolPorts),
-					Action:            models.ActionAccept, // Default action for generated rules
-					Logs:              false,               // Logs disabled by default
-					Trace:             ruleS2S.Trace,       // Preserve trace setting
-					Priority:          100,                 // Default priority for generated rules
+					Action:            ruleS2S.EffectiveAction(),
+					Logs:              false,         // Logs disabled by default
+					Trace:             ruleS2S.Trace, // Preserve trace setting
+					Priority:          s.rulePriority(&ruleS2S),
+					Meta: models.Meta{
+						Labels:          models.GeneratedFromRuleS2SLabels(),
+						OwnerReferences: owners.references(ctx, ruleS2S.Namespace, []models.RuleS2S{ruleS2S}, localAG, targetAG),
+					},
 				}
 
 				generatedRules = append(generatedRules, ieAgAgRule)
@@ -746,7 +911,7 @@ func (s *RuleS2SResourceService) GenerateIEAgAgRulesFromRuleS2SWithReader(ctx co
 
 // FindRuleS2SForServices finds all RuleS2S that reference given services
 func (s *RuleS2SResourceService) FindRuleS2SForServices(ctx context.Context, serviceIDs []models.ResourceIdentifier) ([]models.RuleS2S, error) {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get reader")
 	}
@@ -884,10 +1049,17 @@ func (s *RuleS2SResourceService) UpdateIEAgAgRulesForAffectedServicesWithExclusi
 		rulesToProcess = append(rulesToProcess, rule)
 	}
 
-	_, newIEAgAgRules, err := s.generateAggregatedIEAgAgRules(ctx, reader, rulesToProcess, excludeRuleIDs...)
+	candidates, err := s.indexedCandidates(ctx, reader, rulesToProcess)
+	if err != nil {
+		return err
+	}
+	_, newIEAgAgRules, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, rulesToProcess, candidates, excludeRuleIDs...)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate aggregated IEAgAgRules")
 	}
+	if err := s.indexIEAgAgRuleContributions(ctx, writer, contributions); err != nil {
+		return err
+	}
 
 	if len(newIEAgAgRules) > 0 {
 		if err := s.syncIEAgAgRulesWithReader(ctx, writer, reader, newIEAgAgRules, syncOp); err != nil {
@@ -921,10 +1093,17 @@ func (s *RuleS2SResourceService) UpdateIEAgAgRulesForAffectedServices(ctx contex
 		rulesToProcess = append(rulesToProcess, rule)
 	}
 
-	_, newIEAgAgRules, err := s.generateAggregatedIEAgAgRules(ctx, reader, rulesToProcess)
+	candidates, err := s.indexedCandidates(ctx, reader, rulesToProcess)
+	if err != nil {
+		return err
+	}
+	_, newIEAgAgRules, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, rulesToProcess, candidates)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate aggregated IEAgAgRules")
 	}
+	if err := s.indexIEAgAgRuleContributions(ctx, writer, contributions); err != nil {
+		return err
+	}
 
 	// Update IEAgAgRules
 	if len(newIEAgAgRules) > 0 {
@@ -1074,7 +1253,7 @@ func (s *RuleS2SResourceService) syncIEAgAgRulesWithReader(ctx context.Context,
 func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForService(ctx context.Context, serviceID models.ResourceIdentifier) error {
 
 	// Get reader to find affected RuleS2S
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1084,7 +1263,7 @@ func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForService(ctx context.Con
 	changedService, err := reader.GetServiceByID(ctx, serviceID)
 	if err != nil {
 		if errors.Is(err, ports.ErrNotFound) {
-			return s.RecalculateAllAffectedIEAgAgRules(ctx, fmt.Sprintf("service %s deleted", serviceID.Key()))
+			return s.recalculateIEAgAgRulesForDeletedService(ctx, reader, serviceID)
 		}
 		return errors.Wrap(err, "failed to get changed service")
 	}
@@ -1139,7 +1318,7 @@ func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForService(ctx context.Con
 func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForServiceAlias(ctx context.Context, serviceAliasID models.ResourceIdentifier) error {
 
 	// Get reader
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1176,7 +1355,7 @@ func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForServiceAlias(ctx contex
 func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForAddressGroupBinding(ctx context.Context, bindingID models.ResourceIdentifier) error {
 
 	// Get reader
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1240,8 +1419,18 @@ func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForAddressGroupBinding(ctx
 	return s.regenerateIEAgAgRulesForRuleS2SList(ctx, affectedRules)
 }
 
+// RegenerateIEAgAgRulesForAddressGroupInclusions recalculates IEAgAg rules after address groups changed
+// their included groups, any group may be included transitively so all rules are recalculated
+func (s *RuleS2SResourceService) RegenerateIEAgAgRulesForAddressGroupInclusions(ctx context.Context, addressGroupIDs []models.ResourceIdentifier) error {
+	keys := make([]string, len(addressGroupIDs))
+	for i, id := range addressGroupIDs {
+		keys[i] = id.Key()
+	}
+	return s.RecalculateAllAffectedIEAgAgRules(ctx, fmt.Sprintf("included groups of address groups %s changed", strings.Join(keys, ", ")))
+}
+
 func (s *RuleS2SResourceService) NotifyServiceAddressGroupsChanged(ctx context.Context, serviceID models.ResourceIdentifier) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -1251,8 +1440,9 @@ func (s *RuleS2SResourceService) NotifyServiceAddressGroupsChanged(ctx context.C
 	service, serviceErr := reader.GetServiceByID(ctx, serviceID)
 	if serviceErr == nil {
 		// 🎯 STORY-001: Log AggregatedAddressGroups (spec + bindings) instead of AddressGroups (spec only)
-		agRefs := make([]string, len(service.AggregatedAddressGroups))
-		for i, agRef := range service.AggregatedAddressGroups {
+		aggregated := service.GetAggregatedAddressGroups()
+		agRefs := make([]string, len(aggregated))
+		for i, agRef := range aggregated {
 			agRefs[i] = fmt.Sprintf("%s/%s (source=%s)", agRef.Ref.Namespace, agRef.Ref.Name, agRef.Source)
 		}
 	} else {
@@ -1352,7 +1542,6 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 
 	// PHASE 0: Collect all aggregation keys that will be affected and acquire locks
 	affectedKeys := make(map[string]AggregationKey)
-	mutexes := make(map[string]*sync.Mutex)
 
 	// First pass: identify all aggregation groups that will be affected
 	for _, rule := range rules {
@@ -1370,32 +1559,22 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 					TargetAGName: group.TargetAG.Name,
 					Protocol:     protocol,
 				}
-				keyStr := fmt.Sprintf("%s-%s-%s-%s", key.Traffic, key.LocalAGName, key.TargetAGName, key.Protocol)
-				affectedKeys[keyStr] = key
-				mutexes[keyStr] = getAggregationMutex(key)
+				affectedKeys[key.String()] = key
 			}
 		}
 	}
 
 	// Acquire all locks in deterministic order to prevent deadlock
-	keyStrs := make([]string, 0, len(affectedKeys))
-	for keyStr := range affectedKeys {
-		keyStrs = append(keyStrs, keyStr)
+	keys := make([]AggregationKey, 0, len(affectedKeys))
+	for _, key := range affectedKeys {
+		keys = append(keys, key)
 	}
-	sort.Strings(keyStrs) // Sort for deterministic lock order
-
-	for _, keyStr := range keyStrs {
-		mutex := mutexes[keyStr]
-		mutex.Lock()
+	unlock, err := lockAggregationKeys(ctx, writer, keys)
+	if err != nil {
+		return err
 	}
-
 	// Ensure all locks are released
-	defer func() {
-		for _, keyStr := range keyStrs {
-			mutex := mutexes[keyStr]
-			mutex.Unlock()
-		}
-	}()
+	defer unlock()
 
 	// PHASE 1: Find all aggregation groups affected by the input rules (MUST do this first!)
 	affectedGroups := make(map[string]AggregationGroup)
@@ -1428,7 +1607,11 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 	}
 
 	// Get only rules that match our affected aggregation groups
-	err := reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		// Baseline deny rules are owned by NamespacePosture reconciliation
+		if rule.IsBaselineDeny() {
+			return nil
+		}
 		// Only include rules that are actually related to our affected aggregation groups
 		localAGKey := getAGKey(rule.AddressGroupLocal)
 		targetAGKey := getAGKey(rule.AddressGroup)
@@ -1463,8 +1646,13 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 		}
 	}
 
-	// PHASE 3: Use aggregated generation on the complete set for proper port aggregation
-	expectedRulesSet, allNewRules, err := s.generateAggregatedIEAgAgRules(ctx, reader, allContributingRules)
+	// PHASE 3: Use aggregated generation on the complete set for proper port aggregation,
+	// the aggregation index limits the RuleS2S checked for every combination
+	candidates, err := s.indexedCandidates(ctx, reader, allContributingRules)
+	if err != nil {
+		return err
+	}
+	expectedRulesSet, allNewRules, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, allContributingRules, candidates)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate aggregated IEAgAg rules")
 	}
@@ -1484,6 +1672,7 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 		}
 	}
 
+	var obsoleteRuleIDs []models.ResourceIdentifier
 	if len(obsoleteRules) > 0 {
 		totalSystemRuleCount := 0
 		reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
@@ -1498,7 +1687,6 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 		}
 
 		// Extract IDs for deletion
-		var obsoleteRuleIDs []models.ResourceIdentifier
 		for _, rule := range obsoleteRules {
 			obsoleteRuleIDs = append(obsoleteRuleIDs, rule.ResourceIdentifier)
 		}
@@ -1508,6 +1696,11 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 		}
 	}
 
+	// Obsolete rules lose all their contributions
+	if err := s.indexIEAgAgRuleContributions(ctx, writer, contributions, obsoleteRuleIDs...); err != nil {
+		return err
+	}
+
 	if err := s.applyTimingFixToIEAgAgRules(ctx, writer, allNewRules); err != nil {
 		return errors.Wrap(err, "failed to apply universal timing fix to IEAgAg rules")
 	}
@@ -1531,6 +1724,14 @@ func (s *RuleS2SResourceService) updateIEAgAgRulesForRuleS2SWithReader(ctx conte
 // 🎯 CROSS-RULES2S AGGREGATION ENGINE (Phase 1 Implementation) - COMPLETE REWRITE
 // This replaces the old per-RuleS2S approach with proper cross-RuleS2S aggregation
 func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Context, reader ports.Reader, rules []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, error) {
+	expectedRules, newRules, _, err := s.generateIndexedAggregatedIEAgAgRules(ctx, reader, rules, nil, excludeRuleIDs...)
+	return expectedRules, newRules, err
+}
+
+// generateIndexedAggregatedIEAgAgRules works as generateAggregatedIEAgAgRules, only candidates contribute
+// ports to the aggregated rules (all RuleS2S for nil candidates). The contributions of every processed
+// aggregation group are returned as well, also for groups left without ports by RuleS2SExceptions.
+func (s *RuleS2SResourceService) generateIndexedAggregatedIEAgAgRules(ctx context.Context, reader ports.Reader, rules, candidates []models.RuleS2S, excludeRuleIDs ...models.ResourceIdentifier) (map[string]bool, []models.IEAgAgRule, []models.IEAgAgRuleContribution, error) {
 
 	// Create exclusion map for fast lookup
 	excludeMap := make(map[string]bool)
@@ -1539,6 +1740,13 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 		klog.Infof("🚫 EXCLUSION_FILTER: Excluding RuleS2S %s from aggregation", id.Key())
 	}
 
+	// RuleS2SException cut ports out of the aggregated rules of their AG combinations
+	exceptions, err := s.listRuleS2SExceptions(ctx, reader)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to list RuleS2S exceptions")
+	}
+	owners := newIEAgAgRuleOwners(reader)
+
 	// Phase 1: Process unique AG combinations across ALL RuleS2S, not per individual rule
 	type ruleGroupMetadata struct {
 		traffic   models.Traffic
@@ -1551,6 +1759,7 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 
 	expectedRules := make(map[string]bool)
 	var newRules []models.IEAgAgRule
+	var contributions []models.IEAgAgRuleContribution
 	processedCombinations := make(map[string]bool) // Track processed AG+Protocol combinations
 
 	// Phase 2: For each rule, find all contributing RuleS2S and aggregate
@@ -1564,6 +1773,11 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 			continue
 		}
 
+		// Rules outside of their validFrom/validUntil window don't generate IEAgAg rules
+		if !currentRule.IsActiveAt(time.Now()) {
+			continue
+		}
+
 		// Get services for current rule (using same reader session for consistency)
 		localService, targetService, err := s.getServicesForRuleWithReader(ctx, reader, &currentRule)
 		if err != nil {
@@ -1571,33 +1785,38 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 		}
 
 		// 🎯 STORY-001: Use AggregatedAddressGroups (spec + bindings) instead of AddressGroups (spec only)
-		localAGs := extractAddressGroupRefs(localService.AggregatedAddressGroups)
-		targetAGs := extractAddressGroupRefs(targetService.AggregatedAddressGroups)
+		// with the address groups they include
+		localAGs, err := serviceAddressGroupRefs(ctx, reader, localService)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		targetAGs, err := serviceAddressGroupRefs(ctx, reader, targetService)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 
 		// Generate IEAgAg rules for each AG combination with cross-RuleS2S aggregation
 		for _, localAG := range localAGs {
 			for _, targetAG := range targetAGs {
-				var portsSource *models.Service
-				if currentRule.Traffic == models.INGRESS {
-					portsSource = localService
-				} else {
-					portsSource = targetService
-				}
-
 				// Collect protocols that actually have ports
 				protocolsWithPorts := make(map[models.TransportProtocol]bool)
-				for _, port := range portsSource.IngressPorts {
+				for _, port := range currentRule.RulePorts(localService, targetService) {
 					protocolsWithPorts[port.Protocol] = true
 				}
 
+				action := currentRule.EffectiveAction()
+				priority := s.rulePriority(&currentRule)
+
 				// Only process protocols that actually have ports
 				for protocol := range protocolsWithPorts {
-					// Create unique combination key
-					combinationKey := fmt.Sprintf("%s|%s|%s|%s",
+					// Create unique combination key, rules of different actions and priorities are aggregated separately
+					combinationKey := fmt.Sprintf("%s|%s|%s|%s|%s|%d",
 						currentRule.Traffic,
 						s.addressGroupRefKey(localAG),
 						s.addressGroupRefKey(targetAG),
-						protocol)
+						protocol,
+						action,
+						priority)
 
 					// Skip if we already processed this combination
 					if processedCombinations[combinationKey] {
@@ -1606,12 +1825,13 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 					processedCombinations[combinationKey] = true
 
 					// CLOUD-187: Pass protocol parameter to filter ports by TCP/UDP
-				contributingRules, err := s.findContributingRuleS2S(ctx, &currentRule, localService, targetService, excludeMap, protocol)
+				contributingRules, err := s.findContributingRuleS2S(ctx, &currentRule, localService, targetService, excludeMap, protocol, candidates)
 					if err != nil {
 						continue
 					}
 
 					aggregatedPorts := s.aggregatePortsWithProtocol(ctx, reader, contributingRules, protocol)
+					aggregatedPorts = subtractExceptionPorts(aggregatedPorts, exceptions, currentRule.Traffic, localAG, targetAG, protocol)
 
 					ruleS2SList := make([]models.RuleS2S, len(contributingRules))
 					for i, cr := range contributingRules {
@@ -1619,25 +1839,30 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 					}
 					aggregatedTrace := s.aggregateTraceValue(ruleS2SList)
 
+					// Cross namespace RuleS2S produce rules outside of their namespace, in the namespace of the receiver
+					ruleNamespace := ieAgAgRuleNamespace(currentRule.Traffic, localAG, targetAG)
+
+					aggregatedRuleID := models.NewResourceIdentifier(
+						s.generateRuleNameWithPriority(string(currentRule.Traffic), localAG.Name, targetAG.Name, string(protocol), action, priority),
+						models.WithNamespace(ruleNamespace))
+					for _, cr := range contributingRules {
+						contributions = append(contributions, models.IEAgAgRuleContribution{
+							RuleS2S:    cr.RuleS2S.ResourceIdentifier,
+							IEAgAgRule: aggregatedRuleID,
+							Ports:      cr.Ports,
+						})
+					}
+
 					if len(aggregatedPorts) == 0 {
-						ruleName := s.generateRuleName(string(currentRule.Traffic), localAG.Name, targetAG.Name, string(protocol))
-						err := s.cleanupOrphanedIEAgAgRule(ctx, reader, ruleName, currentRule.Namespace, combinationKey)
+						ruleName := aggregatedRuleID.Name
+						err := s.cleanupOrphanedIEAgAgRule(ctx, reader, ruleName, ruleNamespace, combinationKey)
 						if err != nil {
 							klog.Errorf("    ❌ CROSS_AGGREGATION: Failed to cleanup orphaned rule %s: %v", ruleName, err)
 						}
 						continue
 					}
 
-					ruleName := s.generateRuleName(string(currentRule.Traffic), localAG.Name, targetAG.Name, string(protocol))
-
-					var ruleNamespace string
-					if currentRule.Traffic == models.INGRESS {
-						// For ingress, rule goes in the local AG namespace (receiver)
-						ruleNamespace = localAG.Namespace
-					} else {
-						// For egress, rule goes in the target AG namespace (receiver)
-						ruleNamespace = targetAG.Namespace
-					}
+					ruleName := aggregatedRuleID.Name
 
 					ieRule := models.IEAgAgRule{
 						SelfRef: models.SelfRef{
@@ -1655,10 +1880,14 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 								Destination: strings.Join(aggregatedPorts, ","), // Single aggregated port string
 							},
 						},
-						Action:   models.ActionAccept,
+						Action:   action,
 						Logs:     true,
 						Trace:    aggregatedTrace,
-						Priority: int32(100),
+						Priority: priority,
+						Meta: models.Meta{
+							Labels:          models.GeneratedFromRuleS2SLabels(),
+							OwnerReferences: owners.references(ctx, ruleNamespace, ruleS2SList, localAG, targetAG),
+						},
 					}
 
 					expectedRules[ieRule.Key()] = true
@@ -1668,11 +1897,20 @@ func (s *RuleS2SResourceService) generateAggregatedIEAgAgRules(ctx context.Conte
 		}
 	}
 
-	return expectedRules, newRules, nil
+	return expectedRules, newRules, contributions, nil
 }
 
 // Helper methods
 
+// ieAgAgRuleNamespace returns the namespace of an aggregated IEAgAg rule: for ingress the rule goes
+// in the local AG namespace, for egress in the target AG namespace (the receiver of the traffic)
+func ieAgAgRuleNamespace(traffic models.Traffic, localAG, targetAG models.AddressGroupRef) string {
+	if traffic == models.INGRESS {
+		return localAG.Namespace
+	}
+	return targetAG.Namespace
+}
+
 // cleanupOrphanedIEAgAgRule deletes an existing IEAgAg rule when aggregation results in empty ports
 // This implements the reference controller cleanup logic from lines 892-925
 func (s *RuleS2SResourceService) cleanupOrphanedIEAgAgRule(ctx context.Context, reader ports.Reader, ruleName, namespace string, combinationKey string) error {
@@ -1740,52 +1978,55 @@ func (s *RuleS2SResourceService) cleanupOrphanedIEAgAgRule(ctx context.Context,
 // This implements the complete reference architecture pattern where ANY change that affects
 // IEAgAg rules triggers the same comprehensive recalculation logic
 func (s *RuleS2SResourceService) RecalculateAllAffectedIEAgAgRules(ctx context.Context, reason string) error {
-	reader, err := s.registry.Reader(ctx)
-	if err != nil {
-		return errors.Wrap(err, "failed to get reader for universal recalculation")
-	}
-	defer reader.Close()
-
-	// Phase 1: Get ALL existing IEAgAg rules
-	var existingRules []models.IEAgAgRule
-	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
-		existingRules = append(existingRules, rule)
-		return nil
-	}, ports.EmptyScope{})
-	if err != nil {
-		return errors.Wrap(err, "failed to list existing IEAgAg rules")
-	}
+	// Existing rules and RuleS2S are read in the writer transaction once the aggregation keys of
+	// the changed rules are locked, the comparison builds on concurrent recalculations of the keys
+	err := s.recalculateWithLocks(ctx, reason, func(reader ports.Reader) (*RuleOperations, error) {
+		// Phase 1: Get ALL existing IEAgAg rules
+		var existingRules []models.IEAgAgRule
+		err := reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+			// Baseline deny rules are owned by NamespacePosture reconciliation
+			if !rule.IsBaselineDeny() {
+				existingRules = append(existingRules, rule)
+			}
+			return nil
+		}, ports.EmptyScope{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list existing IEAgAg rules")
+		}
 
-	klog.Infof("  📊 UNIVERSAL_RECALC: Found %d existing IEAgAg rules to evaluate", len(existingRules))
+		klog.Infof("  📊 UNIVERSAL_RECALC: Found %d existing IEAgAg rules to evaluate", len(existingRules))
 
-	// Phase 2: Get ALL RuleS2S for recalculation
-	var allRuleS2S []models.RuleS2S
-	err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
-		allRuleS2S = append(allRuleS2S, rule)
-		return nil
-	}, ports.EmptyScope{})
-	if err != nil {
-		return errors.Wrap(err, "failed to list all RuleS2S")
-	}
+		// Phase 2: Get ALL RuleS2S for recalculation
+		var allRuleS2S []models.RuleS2S
+		err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+			allRuleS2S = append(allRuleS2S, rule)
+			return nil
+		}, ports.EmptyScope{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list all RuleS2S")
+		}
 
-	klog.Infof("  📋 UNIVERSAL_RECALC: Found %d total RuleS2S for aggregation calculations", len(allRuleS2S))
+		klog.Infof("  📋 UNIVERSAL_RECALC: Found %d total RuleS2S for aggregation calculations", len(allRuleS2S))
 
-	// Phase 3: Generate fresh aggregated rules using existing cross-RuleS2S engine
-	// Pass ALL RuleS2S to the aggregation engine for proper cross-rule aggregation
-	_, freshRules, err := s.generateAggregatedIEAgAgRules(ctx, reader, allRuleS2S)
-	if err != nil {
-		return errors.Wrap(err, "failed to generate fresh aggregated IEAgAg rules")
-	}
+		// Phase 3: Generate fresh aggregated rules using existing cross-RuleS2S engine
+		// Pass ALL RuleS2S to the aggregation engine for proper cross-rule aggregation
+		_, freshRules, contributions, err := s.ruleEngine.GenerateIndexed(ctx, reader, allRuleS2S, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate fresh aggregated IEAgAg rules")
+		}
 
-	klog.Infof("  🆕 UNIVERSAL_RECALC: Generated %d fresh aggregated rules", len(freshRules))
+		klog.Infof("  🆕 UNIVERSAL_RECALC: Generated %d fresh aggregated rules", len(freshRules))
 
-	// Phase 4: Compare existing vs fresh rules and determine operations
-	operations := s.calculateRuleOperations(existingRules, freshRules)
-	klog.Infof("  📈 UNIVERSAL_RECALC: Operations needed - Create: %d, Update: %d, Delete: %d",
-		len(operations.toCreate), len(operations.toUpdate), len(operations.toDelete))
+		// Phase 4: Compare existing vs fresh rules and determine operations
+		operations := s.calculateRuleOperations(existingRules, freshRules)
+		klog.Infof("  📈 UNIVERSAL_RECALC: Operations needed - Create: %d, Update: %d, Delete: %d",
+			len(operations.toCreate), len(operations.toUpdate), len(operations.toDelete))
 
-	// Phase 5: Execute operations with proper external sync
-	if err := s.executeRuleOperations(ctx, operations, reason); err != nil {
+		// The full generation is the whole aggregation index
+		operations.indexContributions(nil, contributions)
+		return operations, nil
+	})
+	if err != nil {
 		return errors.Wrapf(err, "failed to execute rule operations for reason: %s", reason)
 	}
 
@@ -1801,80 +2042,87 @@ func (s *RuleS2SResourceService) RecalculateIEAgAgRulesForAffectedRuleS2S(ctx co
 		return nil
 	}
 
-	reader, err := s.registry.Reader(ctx)
-	if err != nil {
-		return errors.Wrap(err, "failed to get reader for scoped recalculation")
+	affectedIDs := make([]models.ResourceIdentifier, 0, len(affectedRules))
+	for _, rule := range affectedRules {
+		affectedIDs = append(affectedIDs, rule.ResourceIdentifier)
+	}
+	if incremental, err := s.recalculateIEAgAgRulesIncrementally(ctx, affectedIDs, reason); incremental || err != nil {
+		return err
 	}
-	defer reader.Close()
 
 	// Log affected RuleS2S for debugging
 	for i, rule := range affectedRules {
 		klog.Infof("  📄 SCOPED_RECALC: Affected RuleS2S[%d]: %s (traffic: %s)", i, rule.Key(), rule.Traffic)
 	}
 
-	var existingRules []models.IEAgAgRule
-	affectedServices := make(map[string]bool)
+	err := s.recalculateWithLocks(ctx, reason, func(reader ports.Reader) (*RuleOperations, error) {
+		var existingRules []models.IEAgAgRule
+		affectedServices := make(map[string]bool)
 
-	// Collect all services involved in affected RuleS2S
-	for _, rule := range affectedRules {
-		// Get the services for this RuleS2S to find potentially affected IEAgAgRules
-		localService, targetService, err := s.getServicesForRuleWithReader(ctx, reader, &rule)
-		if err != nil {
-			klog.Errorf("  ⚠️ SCOPED_RECALC: Failed to get services for affected RuleS2S %s: %v", rule.Key(), err)
-			continue
-		}
+		// Collect all services involved in affected RuleS2S
+		for _, rule := range affectedRules {
+			// Get the services for this RuleS2S to find potentially affected IEAgAgRules
+			localService, targetService, err := s.getServicesForRuleWithReader(ctx, reader, &rule)
+			if err != nil {
+				klog.Errorf("  ⚠️ SCOPED_RECALC: Failed to get services for affected RuleS2S %s: %v", rule.Key(), err)
+				continue
+			}
 
-		// Track all services involved (for finding related IEAgAgRules)
-		affectedServices[localService.Key()] = true
-		affectedServices[targetService.Key()] = true
+			// Track all services involved (for finding related IEAgAgRules)
+			affectedServices[localService.Key()] = true
+			affectedServices[targetService.Key()] = true
 
-		klog.V(4).Infof("  📋 SCOPED_RECALC: Affected RuleS2S %s involves services %s and %s",
-			rule.Key(), localService.Key(), targetService.Key())
-	}
+			klog.V(4).Infof("  📋 SCOPED_RECALC: Affected RuleS2S %s involves services %s and %s",
+				rule.Key(), localService.Key(), targetService.Key())
+		}
 
-	// Find existing IEAgAgRules that involve any of the affected services
-	// This captures rules that might need to be updated or deleted based on service changes
-	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
-		// Check if this IEAgAgRule involves any affected service (via AddressGroups)
-		for serviceKey := range affectedServices {
-			serviceNamespace := strings.Split(serviceKey, "/")[0]
-			if rule.AddressGroupLocal.Namespace == serviceNamespace ||
-				rule.AddressGroup.Namespace == serviceNamespace {
-				existingRules = append(existingRules, rule)
-				klog.V(4).Infof("  📊 SCOPED_RECALC: Including existing IEAgAg rule %s (involves affected service namespace %s)", rule.Key(), serviceNamespace)
-				break
+		// Find existing IEAgAgRules that involve any of the affected services
+		// This captures rules that might need to be updated or deleted based on service changes
+		err := reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+			// Baseline deny rules are owned by NamespacePosture reconciliation
+			if rule.IsBaselineDeny() {
+				return nil
 			}
+			// Check if this IEAgAgRule involves any affected service (via AddressGroups)
+			for serviceKey := range affectedServices {
+				serviceNamespace := strings.Split(serviceKey, "/")[0]
+				if rule.AddressGroupLocal.Namespace == serviceNamespace ||
+					rule.AddressGroup.Namespace == serviceNamespace {
+					existingRules = append(existingRules, rule)
+					klog.V(4).Infof("  📊 SCOPED_RECALC: Including existing IEAgAg rule %s (involves affected service namespace %s)", rule.Key(), serviceNamespace)
+					break
+				}
+			}
+			return nil
+		}, ports.EmptyScope{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list existing IEAgAg rules for affected services")
 		}
-		return nil
-	}, ports.EmptyScope{})
-	if err != nil {
-		return errors.Wrap(err, "failed to list existing IEAgAg rules for affected services")
-	}
 
-	// Phase 2: Get ALL RuleS2S for cross-aggregation (still need all for proper aggregation)
-	var allRuleS2S []models.RuleS2S
-	err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
-		allRuleS2S = append(allRuleS2S, rule)
-		return nil
-	}, ports.EmptyScope{})
-	if err != nil {
-		return errors.Wrap(err, "failed to list all RuleS2S for cross-aggregation")
-	}
-
-	// Phase 3: Generate fresh aggregated rules using existing cross-RuleS2S engine
-	// We still need ALL RuleS2S for proper cross-rule aggregation accuracy
-	_, freshRules, err := s.generateAggregatedIEAgAgRules(ctx, reader, allRuleS2S)
-	if err != nil {
-		return errors.Wrap(err, "failed to generate fresh aggregated IEAgAg rules for scoped recalculation")
-	}
+		// Phase 2: Get ALL RuleS2S for cross-aggregation (still need all for proper aggregation)
+		var allRuleS2S []models.RuleS2S
+		err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+			allRuleS2S = append(allRuleS2S, rule)
+			return nil
+		}, ports.EmptyScope{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list all RuleS2S for cross-aggregation")
+		}
 
-	// Phase 5: Compare scoped existing vs fresh rules and determine operations
-	operations := s.calculateRuleOperations(existingRules, freshRules)
-	klog.Infof("  📈 SCOPED_RECALC: Operations needed - Create: %d, Update: %d, Delete: %d",
-		len(operations.toCreate), len(operations.toUpdate), len(operations.toDelete))
+		// Phase 3: Generate fresh aggregated rules using existing cross-RuleS2S engine
+		// We still need ALL RuleS2S for proper cross-rule aggregation accuracy
+		_, freshRules, err := s.ruleEngine.Generate(ctx, reader, allRuleS2S)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate fresh aggregated IEAgAg rules for scoped recalculation")
+		}
 
-	// Phase 6: Execute operations with proper external sync
-	if err := s.executeRuleOperations(ctx, operations, reason); err != nil {
+		// Phase 5: Compare scoped existing vs fresh rules and determine operations
+		operations := s.calculateRuleOperations(existingRules, freshRules)
+		klog.Infof("  📈 SCOPED_RECALC: Operations needed - Create: %d, Update: %d, Delete: %d",
+			len(operations.toCreate), len(operations.toUpdate), len(operations.toDelete))
+		return operations, nil
+	})
+	if err != nil {
 		return errors.Wrapf(err, "failed to execute scoped rule operations for reason: %s", reason)
 	}
 
@@ -1892,86 +2140,81 @@ func (s *RuleS2SResourceService) RecalculateTargetedIEAgAgRules(ctx context.Cont
 
 	startTime := time.Now()
 
-	reader, err := s.registry.Reader(ctx)
-	if err != nil {
-		return errors.Wrap(err, "failed to get reader for targeted recalculation")
-	}
-	defer reader.Close()
-
-	// Phase 1: Get ONLY the specific existing IEAgAg rules that were referenced by deleted RuleS2S
-	var existingTargetedRules []models.IEAgAgRule
-	for _, ruleID := range targetedIEAgAgRuleIDs {
-		existingRule, err := reader.GetIEAgAgRuleByID(ctx, ruleID)
-		if err != nil {
-			if errors.Is(err, ports.ErrNotFound) {
-				klog.Infof("  📋 TARGETED_RECALC: IEAgAgRule %s already deleted, skipping", ruleID.Key())
-				continue
+	err := s.recalculateWithLocks(ctx, reason, func(reader ports.Reader) (*RuleOperations, error) {
+		// Phase 1: Get ONLY the specific existing IEAgAg rules that were referenced by deleted RuleS2S
+		var existingTargetedRules []models.IEAgAgRule
+		for _, ruleID := range targetedIEAgAgRuleIDs {
+			existingRule, err := reader.GetIEAgAgRuleByID(ctx, ruleID)
+			if err != nil {
+				if errors.Is(err, ports.ErrNotFound) {
+					klog.Infof("  📋 TARGETED_RECALC: IEAgAgRule %s already deleted, skipping", ruleID.Key())
+					continue
+				}
+				return nil, errors.Wrapf(err, "failed to get existing IEAgAgRule %s", ruleID.Key())
 			}
-			return errors.Wrapf(err, "failed to get existing IEAgAgRule %s", ruleID.Key())
+			existingTargetedRules = append(existingTargetedRules, *existingRule)
+			klog.Infof("  📊 TARGETED_RECALC: Including existing IEAgAg rule %s for evaluation", ruleID.Key())
 		}
-		existingTargetedRules = append(existingTargetedRules, *existingRule)
-		klog.Infof("  📊 TARGETED_RECALC: Including existing IEAgAg rule %s for evaluation", ruleID.Key())
-	}
-
-	klog.Infof("  📊 TARGETED_RECALC: Found %d existing targeted IEAgAg rules to evaluate", len(existingTargetedRules))
-
-	// Phase 2: Get ALL remaining RuleS2S for fresh calculations (excludes deleted ones)
-	var allRemainingRuleS2S []models.RuleS2S
-	err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
-		allRemainingRuleS2S = append(allRemainingRuleS2S, rule)
-		return nil
-	}, ports.EmptyScope{})
-	if err != nil {
-		return errors.Wrap(err, "failed to list remaining RuleS2S")
-	}
-
-	klog.Infof("  📋 TARGETED_RECALC: Found %d remaining RuleS2S for fresh calculations", len(allRemainingRuleS2S))
 
-	// Phase 3: Generate fresh aggregated rules using remaining RuleS2S
-	_, allFreshRules, err := s.generateAggregatedIEAgAgRules(ctx, reader, allRemainingRuleS2S)
-	if err != nil {
-		return errors.Wrap(err, "failed to generate fresh aggregated IEAgAg rules for targeted recalculation")
-	}
+		klog.Infof("  📊 TARGETED_RECALC: Found %d existing targeted IEAgAg rules to evaluate", len(existingTargetedRules))
 
-	klog.Infof("  🆕 TARGETED_RECALC: Generated %d fresh aggregated rules from remaining RuleS2S", len(allFreshRules))
-
-	// Phase 4: Filter fresh rules to only include those that match our targeted rule patterns
-	// We need to check which of the fresh rules correspond to the same 4-tuple patterns as our targeted rules
-	freshTargetedRules := make([]models.IEAgAgRule, 0)
-	targetedPatterns := make(map[string]bool)
+		// Phase 2: Get ALL remaining RuleS2S for fresh calculations (excludes deleted ones)
+		var allRemainingRuleS2S []models.RuleS2S
+		err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+			allRemainingRuleS2S = append(allRemainingRuleS2S, rule)
+			return nil
+		}, ports.EmptyScope{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list remaining RuleS2S")
+		}
 
-	// Create pattern map from existing targeted rules
-	for _, existingRule := range existingTargetedRules {
-		pattern := fmt.Sprintf("%s:%s:%s:%s",
-			existingRule.Traffic,
-			existingRule.AddressGroupLocal.Name,
-			existingRule.AddressGroup.Name,
-			existingRule.Transport)
-		targetedPatterns[pattern] = true
-	}
+		klog.Infof("  📋 TARGETED_RECALC: Found %d remaining RuleS2S for fresh calculations", len(allRemainingRuleS2S))
 
-	// Filter fresh rules to only those matching targeted patterns
-	for _, freshRule := range allFreshRules {
-		pattern := fmt.Sprintf("%s:%s:%s:%s",
-			freshRule.Traffic,
-			freshRule.AddressGroupLocal.Name,
-			freshRule.AddressGroup.Name,
-			freshRule.Transport)
-		if targetedPatterns[pattern] {
-			freshTargetedRules = append(freshTargetedRules, freshRule)
-			klog.Infof("  🎯 TARGETED_RECALC: Fresh rule %s matches targeted pattern %s", freshRule.Key(), pattern)
+		// Phase 3: Generate fresh aggregated rules using remaining RuleS2S
+		_, allFreshRules, err := s.ruleEngine.Generate(ctx, reader, allRemainingRuleS2S)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate fresh aggregated IEAgAg rules for targeted recalculation")
+		}
+
+		klog.Infof("  🆕 TARGETED_RECALC: Generated %d fresh aggregated rules from remaining RuleS2S", len(allFreshRules))
+
+		// Phase 4: Filter fresh rules to only include those that match our targeted rule patterns
+		// We need to check which of the fresh rules correspond to the same 4-tuple patterns as our targeted rules
+		freshTargetedRules := make([]models.IEAgAgRule, 0)
+		targetedPatterns := make(map[string]bool)
+
+		// Create pattern map from existing targeted rules
+		for _, existingRule := range existingTargetedRules {
+			pattern := fmt.Sprintf("%s:%s:%s:%s",
+				existingRule.Traffic,
+				existingRule.AddressGroupLocal.Name,
+				existingRule.AddressGroup.Name,
+				existingRule.Transport)
+			targetedPatterns[pattern] = true
+		}
+
+		// Filter fresh rules to only those matching targeted patterns
+		for _, freshRule := range allFreshRules {
+			pattern := fmt.Sprintf("%s:%s:%s:%s",
+				freshRule.Traffic,
+				freshRule.AddressGroupLocal.Name,
+				freshRule.AddressGroup.Name,
+				freshRule.Transport)
+			if targetedPatterns[pattern] {
+				freshTargetedRules = append(freshTargetedRules, freshRule)
+				klog.Infof("  🎯 TARGETED_RECALC: Fresh rule %s matches targeted pattern %s", freshRule.Key(), pattern)
+			}
 		}
-	}
-
-	klog.Infof("  🎯 TARGETED_RECALC: Filtered to %d fresh rules matching targeted patterns", len(freshTargetedRules))
 
-	// Phase 5: Compare existing targeted vs fresh targeted rules and determine operations
-	operations := s.calculateRuleOperations(existingTargetedRules, freshTargetedRules)
-	klog.Infof("  📈 TARGETED_RECALC: Operations needed - Create: %d, Update: %d, Delete: %d",
-		len(operations.toCreate), len(operations.toUpdate), len(operations.toDelete))
+		klog.Infof("  🎯 TARGETED_RECALC: Filtered to %d fresh rules matching targeted patterns", len(freshTargetedRules))
 
-	// Phase 6: Execute operations with proper external sync
-	if err := s.executeRuleOperations(ctx, operations, reason); err != nil {
+		// Phase 5: Compare existing targeted vs fresh targeted rules and determine operations
+		operations := s.calculateRuleOperations(existingTargetedRules, freshTargetedRules)
+		klog.Infof("  📈 TARGETED_RECALC: Operations needed - Create: %d, Update: %d, Delete: %d",
+			len(operations.toCreate), len(operations.toUpdate), len(operations.toDelete))
+		return operations, nil
+	})
+	if err != nil {
 		return errors.Wrapf(err, "failed to execute targeted rule operations for reason: %s", reason)
 	}
 
@@ -1987,6 +2230,78 @@ type RuleOperations struct {
 	toCreate []models.IEAgAgRule
 	toUpdate []models.IEAgAgRule
 	toDelete []models.IEAgAgRule
+	replaced []models.IEAgAgRule // Stored states of toUpdate, index by index
+
+	// Aggregation index stored in the same transaction as the rules
+	updateIndex   bool
+	indexedRules  []models.ResourceIdentifier // Aggregated rules whose contributions are replaced, nil replaces the whole index
+	contributions []models.IEAgAgRuleContribution
+}
+
+// indexContributions replaces the contributions to the aggregated rules together with the operations,
+// nil rules replace the whole aggregation index
+func (o *RuleOperations) indexContributions(rules []models.ResourceIdentifier, contributions []models.IEAgAgRuleContribution) {
+	o.updateIndex = true
+	o.indexedRules = rules
+	o.contributions = contributions
+}
+
+// empty reports whether the operations change neither rules nor the aggregation index
+func (o *RuleOperations) empty() bool {
+	return len(o.toCreate) == 0 && len(o.toUpdate) == 0 && len(o.toDelete) == 0 && !o.updateIndex
+}
+
+// aggregationKeys returns the lock keys of the rules the operations change
+func (o *RuleOperations) aggregationKeys() []AggregationKey {
+	var keys []AggregationKey
+	for _, rules := range [][]models.IEAgAgRule{o.toCreate, o.toUpdate, o.toDelete} {
+		for _, rule := range rules {
+			keys = append(keys, ieAgAgRuleAggregationKey(rule))
+		}
+	}
+	return keys
+}
+
+// restrictTo drops the changes of rules outside keys
+func (o *RuleOperations) restrictTo(keys []AggregationKey) {
+	locked := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		locked[key.String()] = true
+	}
+	keep := func(rule models.IEAgAgRule) bool {
+		return locked[ieAgAgRuleAggregationKey(rule).String()]
+	}
+
+	o.toCreate = filterIEAgAgRules(o.toCreate, keep)
+	o.toDelete = filterIEAgAgRules(o.toDelete, keep)
+	var updated, replaced []models.IEAgAgRule
+	for i, rule := range o.toUpdate {
+		if keep(rule) {
+			updated = append(updated, rule)
+			replaced = append(replaced, o.replaced[i])
+		}
+	}
+	o.toUpdate, o.replaced = updated, replaced
+}
+
+// ieAgAgRuleAggregationKey returns the lock key of the aggregation group of an aggregated rule
+func ieAgAgRuleAggregationKey(rule models.IEAgAgRule) AggregationKey {
+	return AggregationKey{
+		Traffic:      string(rule.Traffic),
+		LocalAGName:  rule.AddressGroupLocal.Name,
+		TargetAGName: rule.AddressGroup.Name,
+		Protocol:     string(rule.Transport),
+	}
+}
+
+func filterIEAgAgRules(rules []models.IEAgAgRule, keep func(models.IEAgAgRule) bool) []models.IEAgAgRule {
+	var kept []models.IEAgAgRule
+	for _, rule := range rules {
+		if keep(rule) {
+			kept = append(kept, rule)
+		}
+	}
+	return kept
 }
 
 // calculateRuleOperations determines what operations are needed by comparing existing and fresh rules
@@ -2017,6 +2332,7 @@ func (s *RuleS2SResourceService) calculateRuleOperations(existing []models.IEAgA
 			if s.needsUpdate(existingRule, freshRule) {
 				klog.Infof("    🔄 UNIVERSAL_RECALC: Rule %s needs UPDATE (ports changed)", key)
 				operations.toUpdate = append(operations.toUpdate, *freshRule)
+				operations.replaced = append(operations.replaced, *existingRule)
 			} else {
 				klog.V(2).Infof("    ✅ UNIVERSAL_RECALC: Rule %s unchanged", key)
 			}
@@ -2051,13 +2367,18 @@ func (s *RuleS2SResourceService) needsUpdate(existing *models.IEAgAgRule, fresh
 		}
 	}
 
+	// Owner references follow the contributing RuleS2S
+	if !models.OwnerReferencesEqual(existing.Meta.OwnerReferences, fresh.Meta.OwnerReferences) {
+		return true
+	}
+
 	// Could add other field comparisons here if needed (action, transport, etc.)
 	return false
 }
 
 // executeRuleOperations performs the calculated operations with proper external sync
 func (s *RuleS2SResourceService) executeRuleOperations(ctx context.Context, operations *RuleOperations, reason string) error {
-	if len(operations.toCreate) == 0 && len(operations.toUpdate) == 0 && len(operations.toDelete) == 0 {
+	if operations.empty() {
 		klog.Infof("  ✅ UNIVERSAL_RECALC: No operations needed (reason: %s)", reason)
 		return nil
 	}
@@ -2066,12 +2387,59 @@ func (s *RuleS2SResourceService) executeRuleOperations(ctx context.Context, oper
 	if err != nil {
 		return errors.Wrap(err, "failed to get writer for operations")
 	}
-	defer func() {
-		if err != nil {
-			writer.Abort()
-		}
-	}()
+	defer writer.Abort()
+
+	return s.commitRuleOperations(ctx, writer, operations, reason)
+}
+
+// recalculateWithLocks applies the operations of a recalculation of aggregated IEAgAgRules in one
+// ReadCommitted writer. The first calculation finds the aggregation keys to change, the keys are locked
+// in the writer transaction and the operations are calculated again through its reader, so they build
+// on what concurrent recalculations of the keys committed. Changes of keys that were not locked are left
+// to the recalculations holding their locks.
+func (s *RuleS2SResourceService) recalculateWithLocks(ctx context.Context, reason string, calculate func(reader ports.Reader) (*RuleOperations, error)) error {
+	writer, err := s.registry.WriterWithOptions(ctx, ports.WriterOptions{Isolation: ports.IsolationReadCommitted})
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer for recalculation")
+	}
+	defer writer.Abort()
 
+	reader, err := s.registry.ReaderFromWriter(ctx, writer)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader from writer")
+	}
+	defer reader.Close()
+
+	planned, err := calculate(reader)
+	if err != nil {
+		return err
+	}
+	if planned.empty() {
+		klog.Infof("  ✅ UNIVERSAL_RECALC: No operations needed (reason: %s)", reason)
+		return nil
+	}
+
+	keys := planned.aggregationKeys()
+	unlock, err := lockAggregationKeys(ctx, writer, keys)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	operations, err := calculate(reader)
+	if err != nil {
+		return err
+	}
+	operations.restrictTo(keys)
+	if operations.empty() {
+		klog.Infof("  ✅ UNIVERSAL_RECALC: No operations needed after locking (reason: %s)", reason)
+		return nil
+	}
+	return s.commitRuleOperations(ctx, writer, operations, reason)
+}
+
+// commitRuleOperations performs the operations in writer and commits them
+func (s *RuleS2SResourceService) commitRuleOperations(ctx context.Context, writer ports.Writer, operations *RuleOperations, reason string) error {
 	// Execute deletions first
 	if len(operations.toDelete) > 0 {
 		deleteIDs := make([]models.ResourceIdentifier, len(operations.toDelete))
@@ -2129,14 +2497,49 @@ func (s *RuleS2SResourceService) executeRuleOperations(ctx context.Context, oper
 		}
 	}
 
+	// The contributions are committed with the rules, a crash never leaves the index behind them
+	indexStored := false
+	if indexWriter, ok := writer.(ports.IEAgAgRuleContributionWriter); ok && operations.updateIndex {
+		if err := indexWriter.ReplaceIEAgAgRuleContributions(ctx, operations.indexedRules, operations.contributions); err != nil {
+			return errors.Wrap(err, "failed to update aggregation index")
+		}
+		indexStored = true
+	}
+
 	// Commit all operations
 	if err := writer.Commit(); err != nil {
 		return errors.Wrap(err, "failed to commit universal recalculation operations")
 	}
 
+	if indexStored && operations.indexedRules == nil {
+		s.contributionIndexReady.Store(true)
+	}
+
+	s.publishRegeneratedRules(operations, reason)
+	if s.diffNotifier != nil {
+		if diffs := models.NewIEAgAgRuleDiffs(operations.toCreate, operations.replaced, operations.toUpdate, operations.toDelete, reason, time.Now()); len(diffs) > 0 {
+			s.diffNotifier.NotifyIEAgAgRuleDiffs(diffs)
+		}
+	}
 	return nil
 }
 
+// publishRegeneratedRules publishes an event per committed IEAgAgRule change of a recalculation
+func (s *RuleS2SResourceService) publishRegeneratedRules(operations *RuleOperations, reason string) {
+	if s.events == nil {
+		return
+	}
+	publish := func(rules []models.IEAgAgRule, eventReason string) {
+		for _, rule := range rules {
+			s.events.Publish(models.NewDomainEvent(models.DomainEventIEAgAgRuleRegenerated, "IEAgAgRule", rule.ResourceIdentifier,
+				eventReason, fmt.Sprintf("IEAgAgRule %s %s by recalculation: %s", rule.Key(), strings.ToLower(eventReason), reason)))
+		}
+	}
+	publish(operations.toCreate, "Created")
+	publish(operations.toUpdate, "Updated")
+	publish(operations.toDelete, "Deleted")
+}
+
 func (s *RuleS2SResourceService) findAllRelatedRuleS2S(ctx context.Context, reader ports.Reader, serviceID models.ResourceIdentifier) ([]models.RuleS2S, error) {
 	var relatedRules []models.RuleS2S
 
@@ -2175,7 +2578,7 @@ func (s *RuleS2SResourceService) findAllRelatedRuleS2S(ctx context.Context, read
 // triggerPostCreationIEAgAgRuleGeneration handles timing issues where AddressGroupBindings existed before RuleS2S creation
 func (s *RuleS2SResourceService) triggerPostCreationIEAgAgRuleGeneration(ctx context.Context, rule models.RuleS2S) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -2219,10 +2622,6 @@ func (s *RuleS2SResourceService) triggerPostCreationIEAgAgRuleGeneration(ctx con
 	return nil
 }
 
-func (s *RuleS2SResourceService) extractPortsFromService(service models.Service) []models.IngressPort {
-	return service.IngressPorts
-}
-
 // findContributingRuleS2S finds all RuleS2S that contribute to the same IEAgAg rule aggregation
 // Based on reference lines 603-654
 // CLOUD-187: Added protocol parameter to filter ports by TCP/UDP
@@ -2233,61 +2632,70 @@ func (s *RuleS2SResourceService) findContributingRuleS2S(
 	targetService *models.Service,
 	excludeMap map[string]bool,
 	protocol models.TransportProtocol,
+	candidates []models.RuleS2S,
 ) ([]ContributingRule, error) {
-	klog.Infof("🔍 CROSS_AGGREGATION: Finding contributing RuleS2S for current rule %s (local: %s, target: %s)",
-		currentRule.Key(), localService.Key(), targetService.Key())
+	aggregationLog.V(1).Info("Finding contributing RuleS2S",
+		"rule", currentRule.Key(), "localService", localService.Key(), "targetService", targetService.Key())
 
 	// Get all RuleS2S for cross-rule comparison
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get registry reader")
 	}
 
-	var allRules []models.RuleS2S
-	if err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
-		allRules = append(allRules, rule)
-		return nil
-	}, ports.EmptyScope{}); err != nil {
-		return nil, errors.Wrap(err, "failed to list all RuleS2S")
+	// Incremental recalculation knows the possible contributors from the aggregation index
+	allRules := candidates
+	if allRules == nil {
+		if err := reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+			allRules = append(allRules, rule)
+			return nil
+		}, ports.EmptyScope{}); err != nil {
+			return nil, errors.Wrap(err, "failed to list all RuleS2S")
+		}
 	}
 
 	var contributingRules []ContributingRule
 
 	for _, rule := range allRules {
 		if excludeMap[rule.ResourceIdentifier.Key()] {
-			klog.Infof("  🚫 EXCLUSION_FILTER: Skipping excluded rule %s from contribution check", rule.Key())
+			aggregationLog.V(2).Info("Skipping excluded RuleS2S from contribution check", "rule", rule.Key())
 			continue
 		}
 
 		if !rule.Meta.IsReady() {
-			klog.Infof("  🚫 READY_FILTER: Skipping inactive (Ready=False) RuleS2S %s from contribution", rule.Key())
+			aggregationLog.V(2).Info("Skipping inactive (Ready=False) RuleS2S from contribution", "rule", rule.Key())
+			continue
+		}
+
+		if !rule.IsActiveAt(time.Now()) {
+			aggregationLog.V(2).Info("Skipping RuleS2S outside of its validity window from contribution", "rule", rule.Key())
 			continue
 		}
 
 		// No deletion checking needed in our backend implementation
 		// CLOUD-187: Pass protocol parameter to filter ports
-		contributes, ports, err := s.checkIfRuleContributes(ctx, &rule, currentRule, localService, targetService, protocol)
+		contributes, ports, err := s.checkIfRuleContributes(ctx, reader, &rule, currentRule, localService, targetService, protocol)
 		if err != nil {
-			klog.Errorf("  ❌ CROSS_AGGREGATION: Error checking rule contribution for %s: %v", rule.Key(), err)
+			aggregationLog.Error(err, "Failed to check RuleS2S contribution", "rule", rule.Key())
 			continue
 		}
 
 		if contributes && len(ports) > 0 {
-			klog.Infof("  ✅ CROSS_AGGREGATION: Found contributing rule %s (namespace: %s, ports: %s)",
-				rule.Key(), rule.Namespace, strings.Join(ports, ","))
+			aggregationLog.V(2).Info("Found contributing RuleS2S",
+				"rule", rule.Key(), "namespace", rule.Namespace, "ports", strings.Join(ports, ","))
 
 			contributingRules = append(contributingRules, ContributingRule{
 				RuleS2S: &rule,
 				Ports:   ports,
 			})
 		} else {
-			klog.V(2).Infof("  ⏭️ CROSS_AGGREGATION: Rule %s does not contribute (contributes=%v, ports=%d)",
-				rule.Key(), contributes, len(ports))
+			aggregationLog.V(3).Info("RuleS2S does not contribute",
+				"rule", rule.Key(), "contributes", contributes, "ports", len(ports))
 		}
 	}
 
-	klog.Infof("🎯 CROSS_AGGREGATION: Found %d contributing rules for current rule %s",
-		len(contributingRules), currentRule.Key())
+	aggregationLog.V(1).Info("Found contributing RuleS2S",
+		"count", len(contributingRules), "rule", currentRule.Key())
 
 	return contributingRules, nil
 }
@@ -2298,14 +2706,14 @@ func (s *RuleS2SResourceService) servicesHaveSameAddressGroups(
 	service1 *models.Service,
 	service2 *models.Service,
 ) bool {
-	klog.V(2).Infof("🔍 COMPARE_SERVICES: Comparing AddressGroups between services %s and %s",
-		service1.Key(), service2.Key())
+	aggregationLog.V(3).Info("Comparing AddressGroups between services",
+		"service1", service1.Key(), "service2", service2.Key())
 
 	// First check lengths
 	if len(service1.AddressGroups) != len(service2.AddressGroups) {
-		klog.V(2).Infof("  ❌ COMPARE_SERVICES: Different AG counts - %s has %d, %s has %d",
-			service1.Key(), len(service1.AddressGroups),
-			service2.Key(), len(service2.AddressGroups))
+		aggregationLog.V(3).Info("Services have different AddressGroup counts",
+			"service1", service1.Key(), "count1", len(service1.AddressGroups),
+			"service2", service2.Key(), "count2", len(service2.AddressGroups))
 		return false
 	}
 
@@ -2314,65 +2722,89 @@ func (s *RuleS2SResourceService) servicesHaveSameAddressGroups(
 	for _, ag := range service1.AddressGroups {
 		key := s.addressGroupRefKey(ag)
 		agMap[key] = true
-		klog.V(2).Infof("  📍 COMPARE_SERVICES: Service1 AddressGroup: %s", key)
+		aggregationLog.V(4).Info("Service1 AddressGroup", "addressGroup", key)
 	}
 
 	// Check if all AddressGroups from service2 exist in service1
 	for _, ag := range service2.AddressGroups {
 		key := s.addressGroupRefKey(ag)
-		klog.V(2).Infof("  📍 COMPARE_SERVICES: Service2 AddressGroup: %s", key)
+		aggregationLog.V(4).Info("Service2 AddressGroup", "addressGroup", key)
 		if !agMap[key] {
-			klog.V(2).Infof("  ❌ COMPARE_SERVICES: AddressGroup %s from %s not found in %s",
-				key, service2.Key(), service1.Key())
+			aggregationLog.V(3).Info("AddressGroup not found in other service",
+				"addressGroup", key, "service", service2.Key(), "otherService", service1.Key())
 			return false
 		}
 	}
 
-	klog.V(2).Infof("  ✅ COMPARE_SERVICES: Services %s and %s have identical AddressGroups",
-		service1.Key(), service2.Key())
+	aggregationLog.V(3).Info("Services have identical AddressGroups",
+		"service1", service1.Key(), "service2", service2.Key())
 	return true
 }
 
-// aggregatePortsWithProtocol aggregates ports from all contributing RuleS2S for a specific protocol
-// Based on reference implementation lines 789-808: simple map-based deduplication without service re-fetching
+// aggregatePortsWithProtocol aggregates ports from all contributing RuleS2S for a specific protocol.
+// Port strings are parsed into ranges, overlapping and adjacent ranges are merged so that
+// "80", "80-90" and "91" become a single "80-91" range. ICMP types are deduplicated as is.
 func (s *RuleS2SResourceService) aggregatePortsWithProtocol(
 	ctx context.Context,
 	reader ports.Reader,
 	contributingRules []ContributingRule,
 	protocol models.TransportProtocol,
 ) []string {
-	klog.Infof("🔀 PORT_AGGREGATION: Aggregating ports for protocol %s from %d contributing rules (using reference pattern)",
-		protocol, len(contributingRules))
+	aggregationLog.V(1).Info("Aggregating ports from contributing RuleS2S",
+		"protocol", protocol, "contributingRules", len(contributingRules))
 
-	// Simple deduplication using map[string]bool like reference implementation (lines 793-798)
-	portSet := make(map[string]bool)
+	var portRanges []models.PortRange
+	// ICMP types and ports that can't be parsed are deduplicated by their text
+	unparsed := make(map[string]bool)
 
 	// Process ALL pre-populated ports from ContributingRule.Ports (NO PROTOCOL FILTERING)
 	// Following reference implementation exactly - just aggregate all ports
 	for _, rule := range contributingRules {
-		klog.V(2).Infof("  📦 PORT_AGGREGATION: Processing rule %s with %d pre-populated ports",
-			rule.RuleS2S.Key(), len(rule.Ports))
+		aggregationLog.V(3).Info("Processing contributing RuleS2S",
+			"rule", rule.RuleS2S.Key(), "ports", len(rule.Ports))
 
-		// 🚀 REFERENCE MATCH: Aggregate ALL ports without protocol filtering (reference lines 795-798)
 		for _, port := range rule.Ports {
-			portSet[port] = true
-			klog.V(3).Infof("    ➕ PORT_AGGREGATION: Added port %s from rule %s", port, rule.RuleS2S.Key())
+			if !protocol.HasPorts() {
+				// ICMP types are not ranges, they are only deduplicated
+				matches, err := models.ParseICMPSpec(port)
+				if err != nil {
+					unparsed[port] = true
+					continue
+				}
+				for _, match := range matches {
+					unparsed[match.String()] = true
+				}
+				continue
+			}
+			ranges, err := validation.ParsePortRanges(port)
+			if err != nil {
+				aggregationLog.Error(err, "Failed to parse port, keeping it unmerged", "port", port, "rule", rule.RuleS2S.Key())
+				unparsed[port] = true
+				continue
+			}
+			portRanges = append(portRanges, ranges...)
+			aggregationLog.V(4).Info("Added port", "port", port, "rule", rule.RuleS2S.Key())
 		}
 
-		klog.V(2).Infof("    ✅ PORT_AGGREGATION: Rule %s contributed %d ports",
-			rule.RuleS2S.Key(), len(rule.Ports))
+		aggregationLog.V(3).Info("RuleS2S contributed ports",
+			"rule", rule.RuleS2S.Key(), "ports", len(rule.Ports))
 	}
 
-	// Convert set to sorted slice (same as reference)
+	// Merged ranges are ordered by port number
 	var aggregatedPorts []string
-	for port := range portSet {
-		aggregatedPorts = append(aggregatedPorts, port)
+	for _, portRange := range validation.MergePortRanges(portRanges) {
+		aggregatedPorts = append(aggregatedPorts, validation.FormatPortRange(portRange))
 	}
 
-	sort.Strings(aggregatedPorts)
+	var rawPorts []string
+	for port := range unparsed {
+		rawPorts = append(rawPorts, port)
+	}
+	sort.Strings(rawPorts)
+	aggregatedPorts = append(aggregatedPorts, rawPorts...)
 
-	klog.Infof("🎯 PORT_AGGREGATION: Final aggregated ports for protocol %s: %s (%d unique ports)",
-		protocol, strings.Join(aggregatedPorts, ","), len(aggregatedPorts))
+	aggregationLog.V(1).Info("Aggregated ports",
+		"protocol", protocol, "ports", strings.Join(aggregatedPorts, ","), "count", len(aggregatedPorts))
 
 	return aggregatedPorts
 }
@@ -2382,38 +2814,51 @@ func (s *RuleS2SResourceService) aggregatePortsWithProtocol(
 // CLOUD-187: Added protocol parameter to filter ports by TCP/UDP
 func (s *RuleS2SResourceService) checkIfRuleContributes(
 	ctx context.Context,
+	reader ports.Reader,
 	candidateRule *models.RuleS2S,
 	currentRule *models.RuleS2S,
 	localService *models.Service,
 	targetService *models.Service,
 	protocol models.TransportProtocol,
 ) (bool, []string, error) {
-	klog.Infof("🔍 CHECK_CONTRIBUTION: Checking if rule %s contributes to aggregation with rule %s",
-		candidateRule.Key(), currentRule.Key())
+	aggregationLog.V(3).Info("Checking RuleS2S contribution",
+		"candidate", candidateRule.Key(), "rule", currentRule.Key())
 
 	// Check if traffic direction matches
 	if candidateRule.Traffic != currentRule.Traffic {
-		klog.Infof("  ❌ CHECK_CONTRIBUTION: Traffic mismatch - candidate: %s, current: %s",
-			candidateRule.Traffic, currentRule.Traffic)
+		aggregationLog.V(3).Info("Traffic mismatch",
+			"candidateTraffic", candidateRule.Traffic, "traffic", currentRule.Traffic)
+		return false, nil, nil
+	}
+
+	// ACCEPT and DROP rules never aggregate into the same IEAgAg rule
+	if candidateRule.EffectiveAction() != currentRule.EffectiveAction() {
+		aggregationLog.V(3).Info("Action mismatch",
+			"candidateAction", candidateRule.EffectiveAction(), "action", currentRule.EffectiveAction())
+		return false, nil, nil
+	}
+
+	// Rules of different priorities are applied at different positions, so they don't aggregate either
+	if candidatePriority, priority := s.rulePriority(candidateRule), s.rulePriority(currentRule); candidatePriority != priority {
+		aggregationLog.V(3).Info("Priority mismatch",
+			"candidatePriority", candidatePriority, "priority", priority)
 		return false, nil, nil
 	}
 
 	// Get services for candidate rule to compare AddressGroups
 	candidateLocalService, candidateTargetService, err := s.getServicesForRule(ctx, candidateRule)
 	if err != nil {
-		klog.Errorf("  ❌ CHECK_CONTRIBUTION: Failed to get services for candidate rule %s: %v",
-			candidateRule.Key(), err)
+		aggregationLog.Error(err, "Failed to get services for candidate RuleS2S", "candidate", candidateRule.Key())
 		return false, nil, err
 	}
 
 	// Generate all AddressGroup combinations for current rule
-	currentCombinations := s.generateAGCombinations(localService, targetService, currentRule.Traffic)
-	candidateCombinations := s.generateAGCombinations(candidateLocalService, candidateTargetService, candidateRule.Traffic)
+	currentCombinations := s.generateAGCombinations(ctx, reader, currentRule, localService, targetService)
+	candidateCombinations := s.generateAGCombinations(ctx, reader, candidateRule, candidateLocalService, candidateTargetService)
 
-	klog.Infof("  🔍 CHECK_CONTRIBUTION: Current rule %s generates %d AG combinations: %v",
-		currentRule.Key(), len(currentCombinations), currentCombinations)
-	klog.Infof("  🔍 CHECK_CONTRIBUTION: Candidate rule %s generates %d AG combinations: %v",
-		candidateRule.Key(), len(candidateCombinations), candidateCombinations)
+	aggregationLog.V(4).Info("AddressGroup combinations",
+		"rule", currentRule.Key(), "combinations", currentCombinations,
+		"candidate", candidateRule.Key(), "candidateCombinations", candidateCombinations)
 
 	// Find overlapping combinations (same traffic direction and same localAG→targetAG pair)
 	hasOverlap := false
@@ -2423,7 +2868,7 @@ func (s *RuleS2SResourceService) checkIfRuleContributes(
 			if currentCombo == candidateCombo {
 				hasOverlap = true
 				overlappingCombination = currentCombo
-				klog.Infof("  ✅ CHECK_CONTRIBUTION: Found overlapping combination: %s", currentCombo)
+				aggregationLog.V(4).Info("Found overlapping combination", "combination", currentCombo)
 				break
 			}
 		}
@@ -2433,30 +2878,24 @@ func (s *RuleS2SResourceService) checkIfRuleContributes(
 	}
 
 	if !hasOverlap {
-		klog.Infof("  ❌ CHECK_CONTRIBUTION: No overlapping AG combinations for rule %s", candidateRule.Key())
+		aggregationLog.V(3).Info("No overlapping AddressGroup combinations", "candidate", candidateRule.Key())
 		return false, nil, nil
 	}
 
-	klog.Infof("  ✅ CHECK_CONTRIBUTION: Rules share combination '%s' - aggregation possible", overlappingCombination)
+	aggregationLog.V(3).Info("Rules share combination, aggregation possible", "combination", overlappingCombination)
 
-	// Extract ports based on traffic direction (same logic as reference)
-	var ports []string
 	// Extract ports based on traffic direction (following reference implementation)
 	// INGRESS: use local service ports (service receiving traffic)
 	// EGRESS: use target service ports (service receiving traffic)
+	// The rule can override the service and add extra ports
 	// CLOUD-187: Pass protocol parameter to filter ports
-	if strings.ToLower(string(candidateRule.Traffic)) == "ingress" {
-		ports = s.extractPortStringsFromService(*candidateLocalService, protocol)
-		klog.Infof("  📍 DEBUG_PORT_EXTRACTION: INGRESS rule %s - extracting ports from LOCAL service %s: %s",
-			candidateRule.Key(), candidateLocalService.Key(), strings.Join(ports, ","))
-	} else {
-		ports = s.extractPortStringsFromService(*candidateTargetService, protocol)
-		klog.Infof("  📍 DEBUG_PORT_EXTRACTION: EGRESS rule %s - extracting ports from TARGET service %s: %s",
-			candidateRule.Key(), candidateTargetService.Key(), strings.Join(ports, ","))
-	}
+	ports := s.extractRulePortStrings(candidateRule, candidateLocalService, candidateTargetService, protocol)
+	aggregationLog.V(4).Info("Extracted rule ports",
+		"candidate", candidateRule.Key(), "localServicePorts", candidateRule.UsesLocalServicePorts(),
+		"extraPorts", len(candidateRule.ExtraPorts), "ports", strings.Join(ports, ","))
 
-	klog.Infof("  ✅ CHECK_CONTRIBUTION: Rule %s contributes %d ports: %s",
-		candidateRule.Key(), len(ports), strings.Join(ports, ","))
+	aggregationLog.V(3).Info("RuleS2S contributes ports",
+		"candidate", candidateRule.Key(), "count", len(ports), "ports", strings.Join(ports, ","))
 
 	return true, ports, nil
 }
@@ -2521,21 +2960,22 @@ func (s *RuleS2SResourceService) getServicesForRuleWithReader(
 // 🚀 CRITICAL FIX: This method fixes the Cross-RuleS2S aggregation bug by ensuring services have
 // their AddressGroups field properly populated from AddressGroupBinding relationships
 
-// extractPortStringsFromService extracts port strings from a service's ingress ports
+// extractRulePortStrings extracts port strings of the ports opened by a rule
 // Based on reference lines 777-786 - returns []string for cross-RuleS2S aggregation
 // CLOUD-187: Filter ports by protocol to separate TCP and UDP
-func (s *RuleS2SResourceService) extractPortStringsFromService(
-	service models.Service,
+func (s *RuleS2SResourceService) extractRulePortStrings(
+	rule *models.RuleS2S,
+	localService, targetService *models.Service,
 	protocol models.TransportProtocol,
 ) []string {
 	var ports []string
-	for _, port := range service.IngressPorts {
+	for _, port := range rule.RulePorts(localService, targetService) {
 		if port.Protocol == protocol {
 			ports = append(ports, port.Port)
 		}
 	}
-	klog.V(2).Infof("  📦 EXTRACT_PORTS: Service %s has %d ingress ports for protocol %s: %s",
-		service.Key(), len(ports), protocol, strings.Join(ports, ","))
+	aggregationLog.V(3).Info("RuleS2S ports of protocol",
+		"rule", rule.Key(), "protocol", protocol, "ports", strings.Join(ports, ","))
 	return ports
 }
 
@@ -2583,6 +3023,24 @@ func (s *RuleS2SResourceService) generateRuleName(trafficDirection, localAGName,
 		uuid)
 }
 
+// generateRuleNameWithAction creates the rule name of an action. ACCEPT rules keep the
+// original names, DROP rules get their own names so both can exist for the same combination.
+func (s *RuleS2SResourceService) generateRuleNameWithAction(trafficDirection, localAGName, targetAGName, protocol string, action models.RuleAction) string {
+	if action == models.ActionDrop {
+		protocol = fmt.Sprintf("%s-%s", protocol, strings.ToLower(string(action)))
+	}
+	return s.generateRuleName(trafficDirection, localAGName, targetAGName, protocol)
+}
+
+// generateRuleNameWithPriority creates the rule name of an action and priority. Rules with the
+// default priority of the action keep the names of generateRuleNameWithAction.
+func (s *RuleS2SResourceService) generateRuleNameWithPriority(trafficDirection, localAGName, targetAGName, protocol string, action models.RuleAction, priority int32) string {
+	if priority != models.RulePriorityForAction(action) {
+		protocol = fmt.Sprintf("%s-p%d", protocol, priority)
+	}
+	return s.generateRuleNameWithAction(trafficDirection, localAGName, targetAGName, protocol, action)
+}
+
 // generateAggregatedRuleName generates UUID-based rule names for aggregated rules using the original logic
 func (s *RuleS2SResourceService) generateAggregatedRuleName(traffic models.Traffic, localAG, targetAG models.AddressGroupRef, protocol models.TransportProtocol) string {
 	return s.generateRuleName(string(traffic), localAG.Name, targetAG.Name, string(protocol))
@@ -2590,7 +3048,7 @@ func (s *RuleS2SResourceService) generateAggregatedRuleName(traffic models.Traff
 
 // generateRuleNameForRuleS2S generates rule name for a specific RuleS2S (backward compatibility)
 func (s *RuleS2SResourceService) generateRuleNameForRuleS2S(rule models.RuleS2S, localAG, targetAG models.AddressGroupRef, protocol models.TransportProtocol) string {
-	return s.generateRuleName(string(rule.Traffic), localAG.Name, targetAG.Name, string(protocol))
+	return s.generateRuleNameWithPriority(string(rule.Traffic), localAG.Name, targetAG.Name, string(protocol), rule.EffectiveAction(), s.rulePriority(&rule))
 }
 
 func (s *RuleS2SResourceService) addressGroupRefKey(ref models.AddressGroupRef) string {
@@ -2673,17 +3131,19 @@ func (s *RuleS2SResourceService) ruleContributesToAggregationGroup(ctx context.C
 		return false, nil // Skip if service not found
 	}
 
-	// Extract ports based on traffic direction
-	var portsSource *models.Service
-	if rule.Traffic == models.INGRESS {
-		portsSource = localService
-	} else {
-		portsSource = targetService
-	}
+	// Ports of the service selected by the rule and its extra ports
+	rulePorts := rule.RulePorts(localService, targetService)
 
 	// 🎯 STORY-001: Use AggregatedAddressGroups (spec + bindings) instead of AddressGroups (spec only)
-	localAGs := extractAddressGroupRefs(localService.AggregatedAddressGroups)
-	targetAGs := extractAddressGroupRefs(targetService.AggregatedAddressGroups)
+	// with the address groups they include
+	localAGs, err := serviceAddressGroupRefs(ctx, reader, localService)
+	if err != nil {
+		return false, err
+	}
+	targetAGs, err := serviceAddressGroupRefs(ctx, reader, targetService)
+	if err != nil {
+		return false, err
+	}
 
 	// Check if this rule would generate IEAgAg rules for the same AG combinations and protocol
 	for _, localAG := range localAGs {
@@ -2694,7 +3154,7 @@ func (s *RuleS2SResourceService) ruleContributesToAggregationGroup(ctx context.C
 
 				// Check if the service has ports for the specified protocol
 				hasProtocolPorts := false
-				for _, port := range portsSource.IngressPorts {
+				for _, port := range rulePorts {
 					if port.Protocol == group.Protocol {
 						hasProtocolPorts = true
 						break
@@ -2902,39 +3362,36 @@ func (s *RuleS2SResourceService) applyTimingFixConditionsOnly(ctx context.Contex
 // generateAGCombinations generates all localAG→targetAG combinations for a rule
 // This matches the reference implementation's nested loop: for localAG, for targetAG
 func (s *RuleS2SResourceService) generateAGCombinations(
+	ctx context.Context,
+	reader ports.Reader,
+	rule *models.RuleS2S,
 	localService, targetService *models.Service,
-	traffic models.Traffic,
 ) []string {
 	var combinations []string
+	traffic := rule.Traffic
 
-	klog.V(2).Infof("  🔧 GENERATE_COMBINATIONS: Starting for traffic=%s, local=%s, target=%s",
-		traffic, localService.Key(), targetService.Key())
-
-	// Get all protocols that have actual ports in the relevant service (for port extraction)
-	var portsSource *models.Service
-	if traffic == models.INGRESS {
-		portsSource = localService
-	} else {
-		portsSource = targetService
-	}
-
-	klog.V(2).Infof("  🔧 GENERATE_COMBINATIONS: Using ports from %s service: %s",
-		traffic, portsSource.Key())
+	aggregationLog.V(3).Info("Generating AddressGroup combinations",
+		"traffic", traffic, "localService", localService.Key(), "targetService", targetService.Key(),
+		"localServicePorts", rule.UsesLocalServicePorts(), "extraPorts", len(rule.ExtraPorts))
 
 	// Collect protocols that actually have ports
 	protocolsWithPorts := make(map[models.TransportProtocol]bool)
-	for _, port := range portsSource.IngressPorts {
+	for _, port := range rule.RulePorts(localService, targetService) {
 		protocolsWithPorts[port.Protocol] = true
-		klog.V(2).Infof("  🔧 GENERATE_COMBINATIONS: Found port %s with protocol %s",
-			port.Port, port.Protocol)
 	}
 
-	klog.V(2).Infof("  🔧 GENERATE_COMBINATIONS: Detected protocols: %v",
-		protocolsWithPorts)
-
 	// 🎯 STORY-001: Use AggregatedAddressGroups (spec + bindings) instead of AddressGroups (spec only)
-	localAGs := extractAddressGroupRefs(localService.AggregatedAddressGroups)
-	targetAGs := extractAddressGroupRefs(targetService.AggregatedAddressGroups)
+	// with the address groups they include
+	localAGs, err := serviceAddressGroupRefs(ctx, reader, localService)
+	if err != nil {
+		aggregationLog.Error(err, "Failed to read AddressGroups of local service", "service", localService.Key())
+		return nil
+	}
+	targetAGs, err := serviceAddressGroupRefs(ctx, reader, targetService)
+	if err != nil {
+		aggregationLog.Error(err, "Failed to read AddressGroups of target service", "service", targetService.Key())
+		return nil
+	}
 
 	// Generate combinations for each localAG x targetAG x protocol
 	// This mirrors reference implementation lines 427-428: for localAG, for targetAG
@@ -2948,13 +3405,11 @@ func (s *RuleS2SResourceService) generateAGCombinations(
 					targetAG.Namespace, targetAG.Name,
 					protocol)
 				combinations = append(combinations, combination)
-				klog.V(2).Infof("  🔧 GENERATE_COMBINATIONS: Generated combination: %s", combination)
 			}
 		}
 	}
 
-	klog.V(2).Infof("  🔧 GENERATE_COMBINATIONS: Final combinations (%d total): %v",
-		len(combinations), combinations)
+	aggregationLog.V(4).Info("Generated AddressGroup combinations", "combinations", combinations)
 
 	return combinations
 }
@@ -2977,7 +3432,7 @@ func (s *RuleS2SResourceService) CleanupIEAgAgRulesForRuleS2S(ctx context.Contex
 	// ENHANCED: We now also capture existing rules before regeneration and sync deletions to sgroups
 
 	// Step 1: Find the services this RuleS2S was connecting
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for cleanup")
 	}
@@ -3062,7 +3517,7 @@ func (s *RuleS2SResourceService) CleanupIEAgAgRulesForRuleS2S(ctx context.Contex
 		klog.Infof("🔄 CleanupIEAgAgRulesForRuleS2S: Checking for deleted rules to sync to sgroups")
 
 		// Get new reader to see post-regeneration state
-		newReader, err := s.registry.Reader(ctx)
+		newReader, err := s.registry.PrimaryReader(ctx)
 		if err != nil {
 			klog.Errorf("⚠️ CleanupIEAgAgRulesForRuleS2S: Failed to get reader for post-regeneration sync: %v", err)
 		} else {
@@ -381,7 +381,7 @@ func TestRuleS2SResourceService_SyncRuleS2S(t *testing.T) {
 			service := NewRuleS2SResourceService(mockRegistry, mockSyncManager, mockConditionManager)
 
 			// Execute
-			err := service.SyncRuleS2S(context.Background(), tt.rules, tt.scope)
+			err := service.SyncRuleS2S(context.Background(), tt.rules, tt.scope, models.SyncOpUpsert)
 
 			// Assert
 			if tt.expectError {
@@ -589,7 +589,7 @@ func TestRuleS2SResourceService_ErrorHandling(t *testing.T) {
 		_, err := service.GetRuleS2S(context.Background(), ports.EmptyScope{})
 		assert.Error(t, err)
 
-		err = service.SyncRuleS2S(context.Background(), []models.RuleS2S{testutil.CreateTestRuleS2S("test-rule-s2s", "test-namespace")}, ports.EmptyScope{})
+		err = service.SyncRuleS2S(context.Background(), []models.RuleS2S{testutil.CreateTestRuleS2S("test-rule-s2s", "test-namespace")}, ports.EmptyScope{}, models.SyncOpUpsert)
 		assert.Error(t, err)
 
 		_, err = service.GetIEAgAgRules(context.Background(), ports.EmptyScope{})
@@ -628,7 +628,7 @@ func TestRuleS2SResourceService_Lifecycle(t *testing.T) {
 		testRule := testutil.CreateTestRuleS2S("test-rule-s2s", "test-namespace")
 
 		// Sync RuleS2S
-		err := service.SyncRuleS2S(context.Background(), []models.RuleS2S{testRule}, ports.EmptyScope{})
+		err := service.SyncRuleS2S(context.Background(), []models.RuleS2S{testRule}, ports.EmptyScope{}, models.SyncOpUpsert)
 		require.NoError(t, err)
 
 		// Verify RuleS2S exists
@@ -672,3 +672,39 @@ func TestRuleS2SResourceService_Lifecycle(t *testing.T) {
 		assert.Error(t, err) // Should not be found
 	})
 }
+
+func TestRuleOperations_RestrictToLockedKeys(t *testing.T) {
+	rule := func(name, localAG string) models.IEAgAgRule {
+		return models.IEAgAgRule{
+			SelfRef:           models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("app"))),
+			Traffic:           models.EGRESS,
+			Transport:         models.TCP,
+			AddressGroupLocal: models.NewAddressGroupRef(localAG, models.WithNamespace("app")),
+			AddressGroup:      models.NewAddressGroupRef("server-ag", models.WithNamespace("app")),
+		}
+	}
+	planned := &RuleOperations{
+		toCreate: []models.IEAgAgRule{rule("created", "a-ag")},
+		toDelete: []models.IEAgAgRule{rule("deleted", "a-ag")},
+	}
+	keys := planned.aggregationKeys()
+
+	// Rules of one aggregation group share the key, locking it twice must not block
+	unlock, err := lockAggregationKeys(context.Background(), nil, keys)
+	require.NoError(t, err)
+	defer unlock()
+
+	operations := &RuleOperations{
+		toCreate: []models.IEAgAgRule{rule("created", "a-ag"), rule("concurrent", "b-ag")},
+		toUpdate: []models.IEAgAgRule{rule("updated", "b-ag"), rule("deleted", "a-ag")},
+		replaced: []models.IEAgAgRule{rule("updated", "b-ag"), rule("deleted", "a-ag")},
+	}
+	operations.restrictTo(keys)
+
+	require.Len(t, operations.toCreate, 1)
+	assert.Equal(t, "created", operations.toCreate[0].Name)
+	require.Len(t, operations.toUpdate, 1)
+	assert.Equal(t, "deleted", operations.toUpdate[0].Name)
+	require.Len(t, operations.replaced, 1)
+	assert.Equal(t, "deleted", operations.replaced[0].Name)
+}
@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// SimulateTraffic checks whether a connection would be allowed by the stored IEAgAg rules.
+// Egress rules of the source and ingress rules of the destination are walked in priority order,
+// the first matching rule decides its direction, without a match the default action of the local
+// address group applies. The connection is accepted when both directions accept it.
+func (s *RuleS2SResourceService) SimulateTraffic(ctx context.Context, request models.TrafficSimulationRequest) (*models.TrafficSimulationResult, error) {
+	if !request.Transport.IsValid() {
+		return nil, errors.Errorf("invalid transport %q", request.Transport)
+	}
+	maxPort := 65535
+	if !request.Transport.HasPorts() {
+		maxPort = 255
+	}
+	if request.Port < 0 || request.Port > maxPort {
+		return nil, errors.Errorf("port %d is out of range for transport %s", request.Port, request.Transport)
+	}
+
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	source, err := resolveTrafficEndpoint(ctx, reader, request.Source)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid source")
+	}
+	destination, err := resolveTrafficEndpoint(ctx, reader, request.Destination)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid destination")
+	}
+
+	sourceKey := models.AddressGroupRefKey(models.NewAddressGroupRef(source.Name, models.WithNamespace(source.Namespace)))
+	destinationKey := models.AddressGroupRefKey(models.NewAddressGroupRef(destination.Name, models.WithNamespace(destination.Namespace)))
+
+	var egressRules, ingressRules []models.IEAgAgRule
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		if rule.Transport != request.Transport {
+			return nil
+		}
+		localKey := models.AddressGroupRefKey(rule.AddressGroupLocal)
+		targetKey := models.AddressGroupRefKey(rule.AddressGroup)
+		switch {
+		case rule.Traffic == models.EGRESS && localKey == sourceKey && targetKey == destinationKey:
+			egressRules = append(egressRules, rule)
+		case rule.Traffic == models.INGRESS && localKey == destinationKey && targetKey == sourceKey:
+			ingressRules = append(ingressRules, rule)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list IEAgAg rules")
+	}
+
+	egress := simulateTrafficSide(models.EGRESS, source, destination, egressRules, request)
+	ingress := simulateTrafficSide(models.INGRESS, destination, source, ingressRules, request)
+
+	result := &models.TrafficSimulationResult{
+		Sides:   []models.TrafficSimulationSide{egress, ingress},
+		Verdict: models.ActionDrop,
+	}
+	if egress.Verdict == models.ActionAccept && ingress.Verdict == models.ActionAccept {
+		result.Verdict = models.ActionAccept
+	}
+	return result, nil
+}
+
+// resolveTrafficEndpoint returns the address group of a simulated endpoint
+func resolveTrafficEndpoint(ctx context.Context, reader ports.Reader, endpoint models.TrafficEndpoint) (*models.AddressGroup, error) {
+	var id models.ResourceIdentifier
+	switch {
+	case endpoint.AddressGroup != nil:
+		id = models.NewResourceIdentifier(endpoint.AddressGroup.Name, models.WithNamespace(endpoint.AddressGroup.Namespace))
+	case endpoint.Host != nil:
+		host, err := reader.GetHostByID(ctx, *endpoint.Host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get host %s", endpoint.Host.Key())
+		}
+		if !host.IsBound || host.AddressGroupRef == nil {
+			return nil, errors.Errorf("host %s is not bound to an address group", host.Key())
+		}
+		id, err = hostAddressGroupID(ctx, reader, host)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("address group or host must be specified")
+	}
+
+	group, err := reader.GetAddressGroupByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get address group %s", id.Key())
+	}
+	return group, nil
+}
+
+// hostAddressGroupID returns the address group a bound host belongs to. The host status only keeps
+// the name of the group, its namespace comes from the HostBinding and defaults to the host namespace.
+func hostAddressGroupID(ctx context.Context, reader ports.Reader, host *models.Host) (models.ResourceIdentifier, error) {
+	namespace := host.Namespace
+	if host.BindingRef != nil {
+		bindingID := models.NewResourceIdentifier(host.BindingRef.Name, models.WithNamespace(host.Namespace))
+		binding, err := reader.GetHostBindingByID(ctx, bindingID)
+		switch {
+		case err == nil:
+			if binding.AddressGroupRef.Namespace != "" {
+				namespace = binding.AddressGroupRef.Namespace
+			}
+		case !errors.Is(err, ports.ErrNotFound):
+			return models.ResourceIdentifier{}, errors.Wrapf(err, "failed to get host binding %s", bindingID.Key())
+		}
+	}
+	return models.NewResourceIdentifier(host.AddressGroupRef.Name, models.WithNamespace(namespace)), nil
+}
+
+// simulateTrafficSide walks the rules of one direction in evaluation order: lower priority first,
+// DROP before ACCEPT and by name for equal priorities
+func simulateTrafficSide(traffic models.Traffic, local, target *models.AddressGroup, rules []models.IEAgAgRule, request models.TrafficSimulationRequest) models.TrafficSimulationSide {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority != rules[j].Priority {
+			return rules[i].Priority < rules[j].Priority
+		}
+		if rules[i].Action != rules[j].Action {
+			return rules[i].Action == models.ActionDrop
+		}
+		return rules[i].Key() < rules[j].Key()
+	})
+
+	side := models.TrafficSimulationSide{
+		Traffic:           traffic,
+		AddressGroupLocal: models.NewAddressGroupRef(local.Name, models.WithNamespace(local.Namespace)),
+		AddressGroup:      models.NewAddressGroupRef(target.Name, models.WithNamespace(target.Namespace)),
+	}
+
+	for _, rule := range rules {
+		if !ruleMatchesPort(rule, request.Transport, request.Port) {
+			continue
+		}
+		match := models.TrafficRuleMatch{
+			Rule:     rule.ResourceIdentifier,
+			Action:   rule.Action,
+			Priority: rule.Priority,
+			Ports:    rulePortsString(rule),
+			Decisive: len(side.Matches) == 0,
+		}
+		if match.Decisive {
+			side.Verdict = rule.Action
+		}
+		side.Matches = append(side.Matches, match)
+	}
+
+	if len(side.Matches) == 0 {
+		side.DefaultAction = true
+		side.Verdict = local.DefaultAction
+		if side.Verdict != models.ActionDrop {
+			// Address groups without default action are synced to sgroups as ACCEPT
+			side.Verdict = models.ActionAccept
+		}
+	}
+	return side
+}
+
+// ruleMatchesPort reports whether the rule covers the port, rules without ports cover all ports
+func ruleMatchesPort(rule models.IEAgAgRule, transport models.TransportProtocol, port int) bool {
+	hasPorts := false
+	for _, portSpec := range rule.Ports {
+		if portSpec.Destination == "" {
+			continue
+		}
+		hasPorts = true
+
+		if !transport.HasPorts() {
+			matches, err := models.ParseICMPSpec(portSpec.Destination)
+			if err != nil {
+				continue
+			}
+			for _, match := range matches {
+				if match.Type == port {
+					return true
+				}
+			}
+			continue
+		}
+
+		ranges, err := validation.ParsePortRanges(portSpec.Destination)
+		if err != nil {
+			continue
+		}
+		for _, portRange := range ranges {
+			if port >= portRange.Start && port <= portRange.End {
+				return true
+			}
+		}
+	}
+	return !hasPorts
+}
+
+// rulePortsString joins the destination ports of the rule
+func rulePortsString(rule models.IEAgAgRule) string {
+	return strings.Join(rulePortDestinations(rule), ",")
+}
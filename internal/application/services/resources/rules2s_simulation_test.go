@@ -0,0 +1,282 @@
+package resources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/readers"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/writers"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func newSimulatedRule(name string, traffic models.Traffic, local, target models.AddressGroupRef, action models.RuleAction, priority int32, ports string) models.IEAgAgRule {
+	rule := models.IEAgAgRule{
+		SelfRef:           models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("app"))),
+		Traffic:           traffic,
+		Transport:         models.TCP,
+		AddressGroupLocal: local,
+		AddressGroup:      target,
+		Action:            action,
+		Priority:          priority,
+	}
+	if ports != "" {
+		rule.Ports = []models.PortSpec{{Destination: ports}}
+	}
+	return rule
+}
+
+// newSimulationTestRegistry stores the client AddressGroup in namespace app, the db AddressGroup with
+// default action DROP in namespace data and a host of namespace app bound to the db group
+func newSimulationTestRegistry(t *testing.T, rules []models.IEAgAgRule) ports.Registry {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+
+	client := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("client", models.WithNamespace("app")))}
+	client.DefaultAction = models.ActionAccept
+	db := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("db", models.WithNamespace("data")))}
+	db.DefaultAction = models.ActionDrop
+
+	host := models.NewHost("db-host", "app", "uuid")
+	host.IsBound = true
+	host.BindingRef = &v1beta1.ObjectReference{Kind: "HostBinding", Name: "db-host-binding"}
+	host.AddressGroupRef = &v1beta1.ObjectReference{Kind: "AddressGroup", Name: "db"}
+	binding := models.NewHostBinding("db-host-binding", "app",
+		v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "db-host"}, Namespace: "app"},
+		v1beta1.NamespacedObjectReference{ObjectReference: v1beta1.ObjectReference{Name: "db"}, Namespace: "data"})
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{client, db}, nil))
+	require.NoError(t, writer.SyncHosts(ctx, []models.Host{*host}, nil))
+	require.NoError(t, writer.SyncHostBindings(ctx, []models.HostBinding{*binding}, nil))
+	require.NoError(t, writer.SyncIEAgAgRules(ctx, rules, nil))
+	require.NoError(t, writer.Commit())
+	return registry
+}
+
+func TestRuleS2SResourceService_SimulateTraffic(t *testing.T) {
+	clientRef := models.NewAddressGroupRef("client", models.WithNamespace("app"))
+	dbRef := models.NewAddressGroupRef("db", models.WithNamespace("data"))
+	registry := newSimulationTestRegistry(t, []models.IEAgAgRule{
+		newSimulatedRule("egress-db", models.EGRESS, clientRef, dbRef, models.ActionAccept, 100, "5432"),
+		newSimulatedRule("ingress-db", models.INGRESS, dbRef, clientRef, models.ActionAccept, 100, "5432"),
+	})
+	service := NewRuleS2SResourceService(registry, nil, nil)
+	ctx := context.Background()
+
+	request := models.TrafficSimulationRequest{
+		Source:      models.TrafficEndpoint{AddressGroup: &clientRef},
+		Destination: models.TrafficEndpoint{AddressGroup: &dbRef},
+		Transport:   models.TCP,
+		Port:        5432,
+	}
+	result, err := service.SimulateTraffic(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, models.ActionAccept, result.Verdict)
+	require.Len(t, result.Sides, 2)
+	assert.Equal(t, "egress-db", result.Sides[0].Matches[0].Rule.Name)
+	assert.Equal(t, "ingress-db", result.Sides[1].Matches[0].Rule.Name)
+
+	// Without a matching ingress rule the default action DROP of the db group applies
+	request.Port = 80
+	result, err = service.SimulateTraffic(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, models.ActionDrop, result.Verdict)
+	assert.True(t, result.Sides[0].DefaultAction)
+	assert.Equal(t, models.ActionAccept, result.Sides[0].Verdict)
+	assert.True(t, result.Sides[1].DefaultAction)
+	assert.Equal(t, models.ActionDrop, result.Sides[1].Verdict)
+
+	// Host endpoints resolve to the address group of their binding, also in another namespace
+	hostID := models.NewResourceIdentifier("db-host", models.WithNamespace("app"))
+	request.Destination = models.TrafficEndpoint{Host: &hostID}
+	request.Port = 5432
+	result, err = service.SimulateTraffic(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, models.ActionAccept, result.Verdict)
+	assert.Equal(t, dbRef.Namespace, result.Sides[0].AddressGroup.Namespace)
+
+	_, err = service.SimulateTraffic(ctx, models.TrafficSimulationRequest{
+		Source: models.TrafficEndpoint{AddressGroup: &clientRef}, Destination: models.TrafficEndpoint{AddressGroup: &dbRef},
+		Transport: models.TCP, Port: 70000,
+	})
+	assert.Error(t, err, "port out of range must be rejected")
+
+	_, err = service.SimulateTraffic(ctx, models.TrafficSimulationRequest{
+		Source: models.TrafficEndpoint{AddressGroup: &clientRef}, Transport: models.TCP, Port: 80,
+	})
+	assert.Error(t, err, "endpoint without address group or host must be rejected")
+}
+
+// simulationWriter writes the resources of a simulation, implemented by ports.Writer and the
+// PostgreSQL writer of a transaction
+type simulationWriter interface {
+	SyncAddressGroups(ctx context.Context, groups []models.AddressGroup, scope ports.Scope, opts ...ports.Option) error
+	SyncIEAgAgRules(ctx context.Context, rules []models.IEAgAgRule, scope ports.Scope, opts ...ports.Option) error
+}
+
+// storeSimulationRules writes the client and db AddressGroups and rules of both directions on
+// port 5432: egress is accepted, ingress is accepted at priority 10 above a DROP at priority 50
+func storeSimulationRules(t *testing.T, ctx context.Context, writer simulationWriter) {
+	clientRef := models.NewAddressGroupRef("client", models.WithNamespace("app"))
+	dbRef := models.NewAddressGroupRef("db", models.WithNamespace("data"))
+	groups := []models.AddressGroup{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("client", models.WithNamespace("app"))), DefaultAction: models.ActionAccept},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("db", models.WithNamespace("data"))), DefaultAction: models.ActionDrop},
+	}
+	rules := []models.IEAgAgRule{
+		newSimulatedRule("egress-db", models.EGRESS, clientRef, dbRef, models.ActionAccept, models.RulePriorityAccept, "5432"),
+		newSimulatedRule("ingress-allow", models.INGRESS, dbRef, clientRef, models.ActionAccept, 10, "5432"),
+		newSimulatedRule("ingress-deny", models.INGRESS, dbRef, clientRef, models.ActionDrop, models.RulePriorityDrop, "5432"),
+	}
+	upsert := ports.WithSyncOp(models.SyncOpUpsert)
+	require.NoError(t, writer.SyncAddressGroups(ctx, groups, ports.EmptyScope{}, upsert))
+	require.NoError(t, writer.SyncIEAgAgRules(ctx, rules, ports.EmptyScope{}, upsert))
+}
+
+// txRegistry serves the reader of a test transaction, the only registry method SimulateTraffic uses
+type txRegistry struct {
+	ports.Registry
+	reader ports.Reader
+}
+
+func (r txRegistry) Reader(context.Context) (ports.Reader, error) {
+	return r.reader, nil
+}
+
+// TestRuleS2SResourceService_SimulateTraffic_StoredRules simulates rules read back from storage,
+// the priorities must survive it for the ACCEPT at 10 to decide before the DROP at 50
+func TestRuleS2SResourceService_SimulateTraffic_StoredRules(t *testing.T) {
+	clientRef := models.NewAddressGroupRef("client", models.WithNamespace("app"))
+	dbRef := models.NewAddressGroupRef("db", models.WithNamespace("data"))
+	request := models.TrafficSimulationRequest{
+		Source:      models.TrafficEndpoint{AddressGroup: &clientRef},
+		Destination: models.TrafficEndpoint{AddressGroup: &dbRef},
+		Transport:   models.TCP,
+		Port:        5432,
+	}
+	simulate := func(t *testing.T, ctx context.Context, registry ports.Registry) {
+		result, err := NewRuleS2SResourceService(registry, nil, nil).SimulateTraffic(ctx, request)
+		require.NoError(t, err)
+		assert.Equal(t, models.ActionAccept, result.Verdict)
+		ingress := result.Sides[1]
+		require.Len(t, ingress.Matches, 2)
+		assert.Equal(t, "ingress-allow", ingress.Matches[0].Rule.Name)
+		assert.Equal(t, int32(10), ingress.Matches[0].Priority)
+		assert.True(t, ingress.Matches[0].Decisive)
+		assert.Equal(t, "ingress-deny", ingress.Matches[1].Rule.Name)
+		assert.Equal(t, models.RulePriorityDrop, ingress.Matches[1].Priority)
+	}
+
+	t.Run("embedded file", func(t *testing.T) {
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "netguard.db")
+		registry, err := mem.NewFileRegistry(path)
+		require.NoError(t, err)
+		writer, err := registry.Writer(ctx)
+		require.NoError(t, err)
+		storeSimulationRules(t, ctx, writer)
+		require.NoError(t, writer.Commit())
+
+		// The rules are simulated as loaded from the file by a new registry
+		reopened, err := mem.NewFileRegistry(path)
+		require.NoError(t, err)
+		simulate(t, ctx, reopened)
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		uri := os.Getenv("TEST_PG_URI")
+		if uri == "" {
+			t.Skip("TEST_PG_URI not set, skipping PostgreSQL tests")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		pool, err := pgxpool.New(ctx, uri)
+		require.NoError(t, err)
+		defer pool.Close()
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback(context.Background()) }()
+
+		storeSimulationRules(t, ctx, writers.NewWriter(nil, tx, ctx))
+		simulate(t, ctx, txRegistry{reader: readers.NewReader(nil, nil, tx, ctx)})
+	})
+}
+
+func TestSimulateTrafficSide_Order(t *testing.T) {
+	local := &models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("client", models.WithNamespace("app")))}
+	target := &models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("db", models.WithNamespace("data")))}
+	localRef := models.NewAddressGroupRef("client", models.WithNamespace("app"))
+	targetRef := models.NewAddressGroupRef("db", models.WithNamespace("data"))
+	request := models.TrafficSimulationRequest{Transport: models.TCP, Port: 5432}
+
+	rules := []models.IEAgAgRule{
+		newSimulatedRule("b-accept", models.EGRESS, localRef, targetRef, models.ActionAccept, 100, "5432"),
+		newSimulatedRule("a-accept", models.EGRESS, localRef, targetRef, models.ActionAccept, 100, ""),
+		newSimulatedRule("drop", models.EGRESS, localRef, targetRef, models.ActionDrop, 100, "5432"),
+		newSimulatedRule("late-accept", models.EGRESS, localRef, targetRef, models.ActionAccept, 200, "5432"),
+		newSimulatedRule("early-accept", models.EGRESS, localRef, targetRef, models.ActionAccept, 50, "5432"),
+		newSimulatedRule("other-port", models.EGRESS, localRef, targetRef, models.ActionDrop, 10, "80"),
+	}
+
+	side := simulateTrafficSide(models.EGRESS, local, target, rules, request)
+
+	var order []string
+	for _, match := range side.Matches {
+		order = append(order, match.Rule.Name)
+	}
+	assert.Equal(t, []string{"early-accept", "drop", "a-accept", "b-accept", "late-accept"}, order,
+		"rules must be ordered by priority, DROP first and by name")
+	assert.True(t, side.Matches[0].Decisive)
+	assert.False(t, side.Matches[1].Decisive)
+	assert.Equal(t, models.ActionAccept, side.Verdict)
+	assert.False(t, side.DefaultAction)
+
+	side = simulateTrafficSide(models.EGRESS, local, target, nil, request)
+	assert.True(t, side.DefaultAction)
+	assert.Equal(t, models.ActionAccept, side.Verdict, "address group without default action accepts")
+}
+
+func TestRuleMatchesPort(t *testing.T) {
+	withPorts := func(transport models.TransportProtocol, ports ...string) models.IEAgAgRule {
+		rule := models.IEAgAgRule{Transport: transport}
+		for _, port := range ports {
+			rule.Ports = append(rule.Ports, models.PortSpec{Destination: port})
+		}
+		return rule
+	}
+
+	tests := []struct {
+		name      string
+		rule      models.IEAgAgRule
+		transport models.TransportProtocol
+		port      int
+		expected  bool
+	}{
+		{"no ports cover all", withPorts(models.TCP), models.TCP, 22, true},
+		{"empty destination covers all", withPorts(models.TCP, ""), models.TCP, 22, true},
+		{"single port", withPorts(models.TCP, "80"), models.TCP, 80, true},
+		{"list", withPorts(models.TCP, "80,443"), models.TCP, 443, true},
+		{"range", withPorts(models.TCP, "8000-8100"), models.TCP, 8080, true},
+		{"outside of range", withPorts(models.TCP, "8000-8100"), models.TCP, 8101, false},
+		{"second port spec", withPorts(models.TCP, "80", "443"), models.TCP, 443, true},
+		{"invalid spec does not match", withPorts(models.TCP, "abc"), models.TCP, 80, false},
+		{"icmp type", withPorts(models.ICMP, "0,8"), models.ICMP, 8, true},
+		{"other icmp type", withPorts(models.ICMP, "0,8"), models.ICMP, 3, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ruleMatchesPort(tt.rule, tt.transport, tt.port))
+		})
+	}
+}
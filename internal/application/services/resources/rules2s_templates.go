@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// =============================================================================
+// RuleTemplate Operations
+// =============================================================================
+
+// GetRuleTemplates returns all rule templates within scope
+func (s *RuleS2SResourceService) GetRuleTemplates(ctx context.Context, scope ports.Scope) ([]models.RuleTemplate, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	var templates []models.RuleTemplate
+	err = reader.ListRuleTemplates(ctx, func(template models.RuleTemplate) error {
+		templates = append(templates, template)
+		return nil
+	}, scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list rule templates")
+	}
+	return templates, nil
+}
+
+// GetRuleTemplateByID returns rule template by ID
+func (s *RuleS2SResourceService) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	reader, err := s.registry.Reader(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reader")
+	}
+	defer reader.Close()
+
+	return reader.GetRuleTemplateByID(ctx, id)
+}
+
+// SyncRuleTemplates synchronizes rule templates and reconciles the RuleS2S generated from them
+func (s *RuleS2SResourceService) SyncRuleTemplates(ctx context.Context, templates []models.RuleTemplate, scope ports.Scope, syncOp models.SyncOp) error {
+	if syncOp != models.SyncOpDelete {
+		if err := s.validateRuleTemplates(ctx, templates); err != nil {
+			return err
+		}
+	}
+
+	writer, err := s.registry.Writer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer")
+	}
+	defer func() {
+		if err != nil {
+			writer.Abort()
+		}
+	}()
+
+	if err = writer.SyncRuleTemplates(ctx, templates, scope, ports.WithSyncOp(syncOp)); err != nil {
+		return errors.Wrap(err, "failed to sync rule templates")
+	}
+
+	if err = writer.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	rulesLog.V(1).Info("Reconciling generated RuleS2S of rule templates", "syncOp", syncOp, "templates", len(templates))
+	if err := s.ReconcileRuleTemplates(ctx); err != nil {
+		return errors.Wrap(err, "failed to reconcile RuleS2S of rule templates")
+	}
+
+	return nil
+}
+
+// ReconcileRuleTemplates brings the RuleS2S generated from rule templates in line with the current
+// templates and services: rules of new service pairs are created, rules of templates or services
+// that no longer match are deleted. Pairs already covered by a manually created RuleS2S or by another
+// template are skipped, templates are applied in namespace/name order.
+func (s *RuleS2SResourceService) ReconcileRuleTemplates(ctx context.Context) error {
+	return s.reconcileRuleTemplates(ctx, nil)
+}
+
+// DeleteRuleTemplateRuleS2SForServices deletes the generated RuleS2S referencing services that are
+// about to be deleted, so template rules never block the deletion of a selected service
+func (s *RuleS2SResourceService) DeleteRuleTemplateRuleS2SForServices(ctx context.Context, serviceIDs []models.ResourceIdentifier) error {
+	excluded := make(map[string]bool, len(serviceIDs))
+	for _, id := range serviceIDs {
+		excluded[id.Key()] = true
+	}
+	return s.reconcileRuleTemplates(ctx, excluded)
+}
+
+// reconcileRuleTemplates reconciles generated RuleS2S ignoring the excluded services
+func (s *RuleS2SResourceService) reconcileRuleTemplates(ctx context.Context, excludedServices map[string]bool) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader")
+	}
+
+	var templates []models.RuleTemplate
+	err = reader.ListRuleTemplates(ctx, func(template models.RuleTemplate) error {
+		templates = append(templates, template)
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		reader.Close()
+		return errors.Wrap(err, "failed to list rule templates")
+	}
+
+	var services []models.Service
+	err = reader.ListServices(ctx, func(service models.Service) error {
+		if !excludedServices[service.Key()] {
+			services = append(services, service)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		reader.Close()
+		return errors.Wrap(err, "failed to list services")
+	}
+
+	generated := make(map[string]models.RuleS2S)
+	covered := make(map[string]bool)
+	err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		if rule.RuleTemplateName() != "" {
+			generated[rule.Key()] = rule
+		} else {
+			covered[ruleS2SSpecKey(rule)] = true
+		}
+		return nil
+	}, ports.EmptyScope{})
+	reader.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to list RuleS2S")
+	}
+
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Key() < templates[j].Key() })
+	sort.Slice(services, func(i, j int) bool { return services[i].Key() < services[j].Key() })
+
+	desired := make(map[string]models.RuleS2S)
+	for i := range templates {
+		template := &templates[i]
+		for l := range services {
+			if !template.SelectsLocal(&services[l]) {
+				continue
+			}
+			for t := range services {
+				if l == t || !template.SelectsTarget(&services[t]) {
+					continue
+				}
+				rule := template.GenerateRuleS2S(&services[l], &services[t])
+				specKey := ruleS2SSpecKey(rule)
+				if covered[specKey] {
+					continue
+				}
+				covered[specKey] = true
+				desired[rule.Key()] = rule
+			}
+		}
+	}
+
+	var stale []models.ResourceIdentifier
+	for key, rule := range generated {
+		if _, ok := desired[key]; !ok {
+			stale = append(stale, rule.ResourceIdentifier)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].Key() < stale[j].Key() })
+
+	var upserts []models.RuleS2S
+	for key, rule := range desired {
+		existing, ok := generated[key]
+		if ok && !generatedRuleChanged(existing, rule) {
+			continue
+		}
+		if ok {
+			// Keep metadata of the stored rule, only the template label is owned by the engine
+			templateName := rule.RuleTemplateName()
+			rule.Meta = existing.Meta
+			rule.Meta.Labels = make(map[string]string, len(existing.Meta.Labels))
+			for k, v := range existing.Meta.Labels {
+				rule.Meta.Labels[k] = v
+			}
+			rule.Meta.Labels[models.RuleTemplateLabel] = templateName
+		}
+		upserts = append(upserts, rule)
+	}
+	sort.Slice(upserts, func(i, j int) bool { return upserts[i].Key() < upserts[j].Key() })
+
+	if len(stale) > 0 {
+		rulesLog.Info("Deleting generated RuleS2S that no longer match their templates", "rules", len(stale))
+		if err := s.DeleteRuleS2SByIDs(ctx, stale); err != nil {
+			return errors.Wrap(err, "failed to delete stale generated RuleS2S")
+		}
+	}
+
+	if len(upserts) > 0 {
+		rulesLog.Info("Upserting generated RuleS2S", "rules", len(upserts))
+		if err := s.SyncRuleS2S(ctx, upserts, ports.EmptyScope{}, models.SyncOpUpsert); err != nil {
+			return errors.Wrap(err, "failed to upsert generated RuleS2S")
+		}
+	}
+
+	return nil
+}
+
+// validateRuleTemplates validates templates for creation or update depending on their existence
+func (s *RuleS2SResourceService) validateRuleTemplates(ctx context.Context, templates []models.RuleTemplate) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader for validation")
+	}
+	defer reader.Close()
+
+	validator := validation.NewDependencyValidator(reader).GetRuleTemplateValidator()
+	for _, template := range templates {
+		existing, err := reader.GetRuleTemplateByID(ctx, template.ResourceIdentifier)
+		switch {
+		case err == nil:
+			err = validator.ValidateForUpdate(ctx, *existing, template)
+		case errors.Is(err, ports.ErrNotFound):
+			err = validator.ValidateForCreation(ctx, template)
+		default:
+			return errors.Wrapf(err, "failed to get rule template %s", template.Key())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ruleS2SSpecKey identifies RuleS2S with the same traffic and services
+func ruleS2SSpecKey(rule models.RuleS2S) string {
+	return string(rule.Traffic) + "|" + rule.ServiceLocalRef.Namespace + "/" + rule.ServiceLocalRef.Name +
+		"|" + rule.ServiceRef.Namespace + "/" + rule.ServiceRef.Name
+}
+
+// generatedRuleChanged reports whether a stored generated rule differs from the rule its template generates now
+func generatedRuleChanged(existing, fresh models.RuleS2S) bool {
+	return existing.Action != fresh.Action ||
+		existing.Trace != fresh.Trace ||
+		existing.RuleTemplateName() != fresh.RuleTemplateName()
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"netguard-pg-backend/internal/domain/models"
 )
 
 // TestGenerateRuleName_Core tests the core UUID generation function in isolation
@@ -132,3 +134,32 @@ func TestGenerateRuleName_ParameterSensitivity(t *testing.T) {
 		})
 	}
 }
+
+// TestGenerateRuleNameWithAction verifies ACCEPT rules keep their names and DROP rules get their own
+func TestGenerateRuleNameWithAction(t *testing.T) {
+	service := &RuleS2SResourceService{}
+
+	accept := service.generateRuleNameWithAction("INGRESS", "web", "db", "TCP", models.ActionAccept)
+	assert.Equal(t, service.generateRuleName("INGRESS", "web", "db", "TCP"), accept, "ACCEPT names must not change")
+	assert.Equal(t, accept, service.generateRuleNameWithAction("INGRESS", "web", "db", "TCP", ""))
+
+	drop := service.generateRuleNameWithAction("INGRESS", "web", "db", "TCP", models.ActionDrop)
+	assert.NotEqual(t, accept, drop, "DROP and ACCEPT rules of the same combination need different names")
+	assert.True(t, strings.HasPrefix(drop, "ing-"))
+}
+
+// TestGenerateRuleNameWithPriority verifies rules with the default priority of the action keep their names
+func TestGenerateRuleNameWithPriority(t *testing.T) {
+	service := &RuleS2SResourceService{}
+
+	accept := service.generateRuleNameWithAction("INGRESS", "web", "db", "TCP", models.ActionAccept)
+	assert.Equal(t, accept, service.generateRuleNameWithPriority("INGRESS", "web", "db", "TCP", models.ActionAccept, models.RulePriorityAccept))
+
+	drop := service.generateRuleNameWithAction("INGRESS", "web", "db", "TCP", models.ActionDrop)
+	assert.Equal(t, drop, service.generateRuleNameWithPriority("INGRESS", "web", "db", "TCP", models.ActionDrop, models.RulePriorityDrop))
+
+	prioritized := service.generateRuleNameWithPriority("INGRESS", "web", "db", "TCP", models.ActionAccept, 20)
+	assert.NotEqual(t, accept, prioritized, "rules of different priorities of the same combination need different names")
+	assert.NotEqual(t, drop, service.generateRuleNameWithPriority("INGRESS", "web", "db", "TCP", models.ActionDrop, 20))
+	assert.True(t, strings.HasPrefix(prioritized, "ing-"))
+}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// ApplyValidityTransitions recalculates IEAgAg rules of RuleS2S that entered or left their
+// validFrom/validUntil window after from and not later than to. Generated rules of expired
+// RuleS2S are deleted and the deletion is synced to sgroups with the other rule operations.
+func (s *RuleS2SResourceService) ApplyValidityTransitions(ctx context.Context, from, to time.Time) error {
+	reader, err := s.registry.PrimaryReader(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get reader")
+	}
+
+	var affected []models.RuleS2S
+	err = reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		if rule.ValidityChangedBetween(from, to) {
+			affected = append(affected, rule)
+		}
+		return nil
+	}, ports.EmptyScope{})
+	reader.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to list RuleS2S")
+	}
+
+	if len(affected) == 0 {
+		return nil
+	}
+
+	for _, rule := range affected {
+		state := "left"
+		if rule.IsActiveAt(to) {
+			state = "entered"
+		}
+		rulesLog.Info("RuleS2S validity window changed", "rule", rule.Key(), "state", state)
+	}
+
+	return s.RecalculateIEAgAgRulesForAffectedRuleS2S(ctx, affected, "RuleS2S validity window")
+}
@@ -119,7 +119,7 @@ func (s *ServiceResourceService) GetServicesByIDs(ctx context.Context, ids []mod
 // CreateService creates a new service
 func (s *ServiceResourceService) CreateService(ctx context.Context, service models.Service) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -172,7 +172,7 @@ func (s *ServiceResourceService) CreateService(ctx context.Context, service mode
 // UpdateService updates an existing service
 func (s *ServiceResourceService) UpdateService(ctx context.Context, service models.Service) error {
 
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -304,7 +304,7 @@ func (s *ServiceResourceService) SyncServices(ctx context.Context, services []mo
 	var removedAddressGroups []models.ResourceIdentifier // Track removed AGs for cleanup
 
 	if s.portMappingRegenerator != nil {
-		reader, readerErr := s.registry.Reader(ctx)
+		reader, readerErr := s.registry.PrimaryReader(ctx)
 		if readerErr == nil {
 			defer reader.Close()
 
@@ -370,7 +370,7 @@ func (s *ServiceResourceService) SyncServices(ctx context.Context, services []mo
 	// CRITICAL: Validate services BEFORE commit to catch port conflicts early
 	// This prevents invalid Services from being persisted to the database
 	if syncOp != models.SyncOpDelete {
-		reader, readerErr := s.registry.Reader(ctx)
+		reader, readerErr := s.registry.PrimaryReader(ctx)
 		if readerErr != nil {
 			writer.Abort()
 			return errors.Wrap(readerErr, "failed to get reader for pre-commit validation")
@@ -407,7 +407,7 @@ func (s *ServiceResourceService) SyncServices(ctx context.Context, services []mo
 	// CRITICAL: Validate services BEFORE commit to catch port conflicts early
 	// This prevents invalid Services from being persisted to the database
 	if syncOp != models.SyncOpDelete {
-		reader, readerErr := s.registry.Reader(ctx)
+		reader, readerErr := s.registry.PrimaryReader(ctx)
 		if readerErr != nil {
 			writer.Abort()
 			return errors.Wrap(readerErr, "failed to get reader for pre-commit validation")
@@ -505,50 +505,62 @@ func (s *ServiceResourceService) SyncServices(ctx context.Context, services []mo
 // DeleteServicesByIDs deletes services by IDs with dependency validation
 func (s *ServiceResourceService) DeleteServicesByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
 	// 1. Get reader for validation
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for validation")
 	}
 	defer reader.Close()
 
-	// 2. Validate dependencies for each service
+	// 2. Validate dependencies of all services at once
 	validator := validation.NewDependencyValidator(reader)
 	serviceValidator := validator.GetServiceValidator()
 
-	for _, id := range ids {
-		if err := serviceValidator.CheckDependencies(ctx, id); err != nil {
-			return errors.Wrapf(err, "cannot delete Service %s", id.Key())
-		}
+	if err := serviceValidator.CheckDependenciesOfServices(ctx, ids); err != nil {
+		return errors.Wrap(err, "cannot delete Services")
 	}
 
+	// 3. Proceed with deletion
+	writer, err := s.registry.Writer(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get writer")
+	}
+	defer func() {
+		if err != nil {
+			writer.Abort()
+		}
+	}()
+
+	// Writers deleting services in set-based statements also remove them from all port
+	// mappings, the port mappings are not regenerated per address group then
+	if cascade, ok := writer.(ports.ServiceCascadeDeleter); ok {
+		var changed int
+		if changed, err = cascade.DeleteServicesCascade(ctx, ids); err != nil {
+			return errors.Wrap(err, "failed to delete services")
+		}
+		if err = writer.Commit(); err != nil {
+			return errors.Wrap(err, "failed to commit transaction")
+		}
+		klog.V(2).Infof("🗑️ DeleteServicesByIDs: deleted %d services, removed them from %d port mappings", len(ids), changed)
+		return nil
+	}
 
-	// 3. For each service to be deleted, regenerate port mappings for its AddressGroups
+	// For each service to be deleted, regenerate port mappings for its AddressGroups
 	for _, id := range ids {
-		service, err := reader.GetServiceByID(ctx, id)
-		if err != nil {
+		var service *models.Service
+		if service, err = reader.GetServiceByID(ctx, id); err != nil {
 			if errors.Is(err, ports.ErrNotFound) {
+				err = nil
 				continue // Service doesn't exist, skip
 			}
 			return errors.Wrapf(err, "failed to get service %s before deletion", id.Key())
 		}
 
 		// Regenerate port mappings for all AddressGroups to remove this service
-		if err := s.syncPortMappingsForServiceSpecAGs(ctx, service); err != nil {
+		if err = s.syncPortMappingsForServiceSpecAGs(ctx, service); err != nil {
 			return errors.Wrapf(err, "failed to sync port mappings before deleting service %s", id.Key())
 		}
 	}
 
-	// 4. Proceed with deletion
-	writer, err := s.registry.Writer(ctx)
-	if err != nil {
-		return errors.Wrap(err, "failed to get writer")
-	}
-	defer func() {
-		if err != nil {
-			writer.Abort()
-		}
-	}()
-
 	if err = writer.DeleteServicesByIDs(ctx, ids); err != nil {
 		return errors.Wrap(err, "failed to delete services")
 	}
@@ -756,7 +768,7 @@ func (s *ServiceResourceService) SyncServiceAliases(ctx context.Context, aliases
 // DeleteServiceAliasesByIDs deletes service aliases by IDs with dependency validation
 func (s *ServiceResourceService) DeleteServiceAliasesByIDs(ctx context.Context, ids []models.ResourceIdentifier) error {
 	// First validate that all ServiceAliases can be safely deleted
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for dependency validation")
 	}
@@ -894,7 +906,7 @@ func (s *ServiceResourceService) syncPortMappingsForServiceSpecAGs(ctx context.C
 
 // FindServicesForAddressGroups finds all services that are bound to given address groups
 func (s *ServiceResourceService) FindServicesForAddressGroups(ctx context.Context, addressGroupIDs []models.ResourceIdentifier) ([]models.Service, error) {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get reader")
 	}
@@ -944,7 +956,7 @@ func (s *ServiceResourceService) FindServicesForAddressGroups(ctx context.Contex
 // reprocessDependentResourceConditions finds and re-processes conditions for resources that depend on the deleted service
 // This will update their status to reflect broken references
 func (s *ServiceResourceService) reprocessDependentResourceConditions(ctx context.Context, deletedServiceID models.ResourceIdentifier) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader for dependent resource processing")
 	}
@@ -3,6 +3,7 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
 	"netguard-pg-backend/internal/domain/models"
@@ -19,9 +20,9 @@ type MockRegistry struct {
 	sharedData map[string]interface{} // Shared data store
 }
 
+// ReaderWithReadCommitted returns a reader of the shared data, it always sees committed writes
 func (m *MockRegistry) ReaderWithReadCommitted(ctx context.Context) (ports.Reader, error) {
-	//TODO implement me
-	panic("implement me")
+	return m.Reader(ctx)
 }
 
 // NewMockRegistry creates a new mock registry for testing
@@ -73,6 +74,25 @@ func (m *MockRegistry) Writer(ctx context.Context) (ports.Writer, error) {
 	return writer, nil
 }
 
+// ReaderAtSnapshot returns a regular reader, the mock has no concurrent commits
+func (m *MockRegistry) ReaderAtSnapshot(ctx context.Context) (ports.Reader, error) {
+	return m.Reader(ctx)
+}
+
+// PrimaryReader returns a regular reader, the mock has no replica
+func (m *MockRegistry) PrimaryReader(ctx context.Context) (ports.Reader, error) {
+	return m.Reader(ctx)
+}
+
+// ReaderAtReplicaSnapshot returns a regular reader, the mock has no replica
+func (m *MockRegistry) ReaderAtReplicaSnapshot(ctx context.Context) (ports.Reader, error) {
+	return m.Reader(ctx)
+}
+
+func (m *MockRegistry) WriterWithOptions(ctx context.Context, _ ports.WriterOptions) (ports.Writer, error) {
+	return m.Writer(ctx)
+}
+
 func (m *MockRegistry) ReaderFromWriter(ctx context.Context, writer ports.Writer) (ports.Reader, error) {
 	mockWriter, ok := writer.(*MockWriter)
 	if !ok {
@@ -134,8 +154,14 @@ func (m *MockRegistry) SetupTestData(data map[string]interface{}) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Apply data to shared data store
+	// Apply data to shared data store, resources are copied so that changes made by the
+	// tested services don't leak into the shared fixtures
 	for k, v := range data {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Elem().Kind() == reflect.Struct {
+			copied := reflect.New(rv.Elem().Type())
+			copied.Elem().Set(rv.Elem())
+			v = copied.Interface()
+		}
 		m.sharedData[k] = v
 	}
 }
@@ -152,7 +178,8 @@ func (r *MockReader) GetServiceByID(ctx context.Context, id models.ResourceIdent
 	key := fmt.Sprintf("service_%s", id.Key())
 	if svc, exists := r.data[key]; exists {
 		if service, ok := svc.(*models.Service); ok {
-			return service, nil
+			aggregated := withSpecAddressGroups(*service)
+			return &aggregated, nil
 		}
 	}
 	return nil, ports.ErrNotFound
@@ -161,7 +188,7 @@ func (r *MockReader) GetServiceByID(ctx context.Context, id models.ResourceIdent
 func (r *MockReader) ListServices(ctx context.Context, consume func(models.Service) error, scope ports.Scope) error {
 	for key, value := range r.data {
 		if service, ok := value.(*models.Service); ok && key[:8] == "service_" {
-			if err := consume(*service); err != nil {
+			if err := consume(withSpecAddressGroups(*service)); err != nil {
 				return err
 			}
 		}
@@ -169,6 +196,19 @@ func (r *MockReader) ListServices(ctx context.Context, consume func(models.Servi
 	return nil
 }
 
+// withSpecAddressGroups fills the aggregated address groups of a service stored without
+// them from its spec, like registries aggregate them when services are read
+func withSpecAddressGroups(service models.Service) models.Service {
+	if len(service.AggregatedAddressGroups) > 0 {
+		return service
+	}
+	for _, ref := range service.AddressGroups {
+		service.AggregatedAddressGroups = append(service.AggregatedAddressGroups,
+			models.AddressGroupReference{Ref: ref, Source: models.AddressGroupSourceSpec})
+	}
+	return service
+}
+
 // AddressGroup operations
 func (r *MockReader) GetAddressGroupByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
 	key := fmt.Sprintf("addressgroup_%s", id.Key())
@@ -381,6 +421,98 @@ func (r *MockReader) GetHostBindingByID(ctx context.Context, id models.ResourceI
 	return nil, ports.ErrNotFound
 }
 
+func (r *MockReader) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	for key, value := range r.data {
+		if len(key) >= 17 && key[:17] == "rules2sexception_" {
+			if exception, ok := value.(*models.RuleS2SException); ok {
+				if err := consume(*exception); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *MockReader) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	key := fmt.Sprintf("rules2sexception_%s", id.Key())
+	if exception, exists := r.data[key]; exists {
+		if exceptionObj, ok := exception.(*models.RuleS2SException); ok {
+			return exceptionObj, nil
+		}
+	}
+	return nil, ports.ErrNotFound
+}
+
+func (r *MockReader) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	for key, value := range r.data {
+		if len(key) >= 17 && key[:17] == "namespaceposture_" {
+			if posture, ok := value.(*models.NamespacePosture); ok {
+				if err := consume(*posture); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *MockReader) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	key := fmt.Sprintf("namespaceposture_%s", id.Key())
+	if posture, exists := r.data[key]; exists {
+		if postureObj, ok := posture.(*models.NamespacePosture); ok {
+			return postureObj, nil
+		}
+	}
+	return nil, ports.ErrNotFound
+}
+
+func (r *MockReader) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	for key, value := range r.data {
+		if len(key) >= 13 && key[:13] == "ruletemplate_" {
+			if template, ok := value.(*models.RuleTemplate); ok {
+				if err := consume(*template); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *MockReader) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	key := fmt.Sprintf("ruletemplate_%s", id.Key())
+	if template, exists := r.data[key]; exists {
+		if templateObj, ok := template.(*models.RuleTemplate); ok {
+			return templateObj, nil
+		}
+	}
+	return nil, ports.ErrNotFound
+}
+
+func (r *MockReader) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	for key, value := range r.data {
+		if len(key) >= 21 && key[:21] == "crossnamespacepolicy_" {
+			if policy, ok := value.(*models.CrossNamespacePolicy); ok {
+				if err := consume(*policy); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *MockReader) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	key := fmt.Sprintf("crossnamespacepolicy_%s", id.Key())
+	if policy, exists := r.data[key]; exists {
+		if policyObj, ok := policy.(*models.CrossNamespacePolicy); ok {
+			return policyObj, nil
+		}
+	}
+	return nil, ports.ErrNotFound
+}
+
 func (r *MockReader) Close() error {
 	return nil
 }
@@ -572,6 +704,74 @@ func (w *MockWriter) DeleteHostBindingsByIDs(ctx context.Context, ids []models.R
 	return nil
 }
 
+func (w *MockWriter) SyncRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException, scope ports.Scope, opts ...ports.Option) error {
+	for i := range exceptions {
+		key := fmt.Sprintf("rules2sexception_%s", exceptions[i].Key())
+		w.data[key] = &exceptions[i]
+	}
+	return nil
+}
+
+func (w *MockWriter) DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	for _, id := range ids {
+		key := fmt.Sprintf("rules2sexception_%s", id.Key())
+		delete(w.data, key)
+		w.deletedKeys[key] = true // Track deletion
+	}
+	return nil
+}
+
+func (w *MockWriter) SyncNamespacePostures(ctx context.Context, postures []models.NamespacePosture, scope ports.Scope, opts ...ports.Option) error {
+	for i := range postures {
+		key := fmt.Sprintf("namespaceposture_%s", postures[i].Key())
+		w.data[key] = &postures[i]
+	}
+	return nil
+}
+
+func (w *MockWriter) DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	for _, id := range ids {
+		key := fmt.Sprintf("namespaceposture_%s", id.Key())
+		delete(w.data, key)
+		w.deletedKeys[key] = true // Track deletion
+	}
+	return nil
+}
+
+func (w *MockWriter) SyncRuleTemplates(ctx context.Context, templates []models.RuleTemplate, scope ports.Scope, opts ...ports.Option) error {
+	for i := range templates {
+		key := fmt.Sprintf("ruletemplate_%s", templates[i].Key())
+		w.data[key] = &templates[i]
+	}
+	return nil
+}
+
+func (w *MockWriter) DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	for _, id := range ids {
+		key := fmt.Sprintf("ruletemplate_%s", id.Key())
+		delete(w.data, key)
+		w.deletedKeys[key] = true // Track deletion
+	}
+	return nil
+}
+
+func (w *MockWriter) SyncCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, scope ports.Scope, opts ...ports.Option) error {
+	for i := range policies {
+		key := fmt.Sprintf("crossnamespacepolicy_%s", policies[i].Key())
+		w.data[key] = &policies[i]
+	}
+	return nil
+}
+
+func (w *MockWriter) DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	for _, id := range ids {
+		key := fmt.Sprintf("crossnamespacepolicy_%s", id.Key())
+		delete(w.data, key)
+		w.deletedKeys[key] = true // Track deletion
+	}
+	return nil
+}
+
 func (w *MockWriter) Commit() error {
 	w.committed = true
 
@@ -104,13 +104,7 @@ var TestFixtures = struct {
 			},
 		},
 		DefaultAction: models.ActionAccept,
-		Networks: []models.NetworkItem{
-			{
-				Name:      "test-network",
-				Namespace: "test-namespace",
-				CIDR:      "10.0.0.0/24",
-			},
-		},
+		// Networks are added by NetworkBindings, an address group is created without them
 		Trace: false,
 		Logs:  false,
 		Meta: models.Meta{
@@ -331,6 +325,7 @@ func CreateTestRuleS2S(name, namespace string) models.RuleS2S {
 		Meta: models.Meta{
 			CreationTS: metav1.NewTime(time.Now()),
 			Generation: 1,
+			Conditions: []metav1.Condition{{Type: models.ConditionReady, Status: metav1.ConditionTrue}},
 		},
 	}
 }
@@ -29,7 +29,7 @@ func NewValidationService(registry ports.Registry, syncManager interfaces.SyncMa
 
 // ValidateServiceForCreation validates a service for creation
 func (s *ValidationService) ValidateServiceForCreation(ctx context.Context, service models.Service) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -47,7 +47,7 @@ func (s *ValidationService) ValidateServiceForCreation(ctx context.Context, serv
 
 // ValidateServiceForUpdate validates a service for update
 func (s *ValidationService) ValidateServiceForUpdate(ctx context.Context, oldService, newService models.Service) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -65,7 +65,7 @@ func (s *ValidationService) ValidateServiceForUpdate(ctx context.Context, oldSer
 
 // ValidateServiceForDeletion validates a service for deletion
 func (s *ValidationService) ValidateServiceForDeletion(ctx context.Context, service models.Service) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -88,7 +88,7 @@ func (s *ValidationService) ValidateServiceForDeletion(ctx context.Context, serv
 
 // ValidateAddressGroupForCreation validates an address group for creation
 func (s *ValidationService) ValidateAddressGroupForCreation(ctx context.Context, addressGroup models.AddressGroup) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -113,7 +113,7 @@ func (s *ValidationService) ValidateAddressGroupForCreation(ctx context.Context,
 
 // ValidateAddressGroupForUpdate validates an address group for update
 func (s *ValidationService) ValidateAddressGroupForUpdate(ctx context.Context, oldAddressGroup, newAddressGroup models.AddressGroup) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -142,7 +142,7 @@ func (s *ValidationService) ValidateAddressGroupForUpdate(ctx context.Context, o
 
 // ValidateAddressGroupForDeletion validates an address group for deletion
 func (s *ValidationService) ValidateAddressGroupForDeletion(ctx context.Context, addressGroup models.AddressGroup) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -164,7 +164,7 @@ func (s *ValidationService) ValidateAddressGroupForDeletion(ctx context.Context,
 
 // ValidateAddressGroupBindingForCreation validates an address group binding for creation
 func (s *ValidationService) ValidateAddressGroupBindingForCreation(ctx context.Context, binding models.AddressGroupBinding) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -182,7 +182,7 @@ func (s *ValidationService) ValidateAddressGroupBindingForCreation(ctx context.C
 
 // ValidateAddressGroupBindingForUpdate validates an address group binding for update
 func (s *ValidationService) ValidateAddressGroupBindingForUpdate(ctx context.Context, oldBinding, newBinding models.AddressGroupBinding) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -200,7 +200,7 @@ func (s *ValidationService) ValidateAddressGroupBindingForUpdate(ctx context.Con
 
 // ValidateAddressGroupBindingForDeletion validates an address group binding for deletion
 func (s *ValidationService) ValidateAddressGroupBindingForDeletion(ctx context.Context, binding models.AddressGroupBinding) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -223,7 +223,7 @@ func (s *ValidationService) ValidateAddressGroupBindingForDeletion(ctx context.C
 
 // ValidateAddressGroupPortMappingForCreation validates an address group port mapping for creation
 func (s *ValidationService) ValidateAddressGroupPortMappingForCreation(ctx context.Context, mapping models.AddressGroupPortMapping) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -241,7 +241,7 @@ func (s *ValidationService) ValidateAddressGroupPortMappingForCreation(ctx conte
 
 // ValidateAddressGroupPortMappingForUpdate validates an address group port mapping for update
 func (s *ValidationService) ValidateAddressGroupPortMappingForUpdate(ctx context.Context, oldMapping, newMapping models.AddressGroupPortMapping) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -259,7 +259,7 @@ func (s *ValidationService) ValidateAddressGroupPortMappingForUpdate(ctx context
 
 // ValidateAddressGroupPortMappingForDeletion validates an address group port mapping for deletion
 func (s *ValidationService) ValidateAddressGroupPortMappingForDeletion(ctx context.Context, mapping models.AddressGroupPortMapping) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -282,7 +282,7 @@ func (s *ValidationService) ValidateAddressGroupPortMappingForDeletion(ctx conte
 
 // ValidateRuleS2SForCreation validates a RuleS2S for creation
 func (s *ValidationService) ValidateRuleS2SForCreation(ctx context.Context, rule models.RuleS2S) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -300,7 +300,7 @@ func (s *ValidationService) ValidateRuleS2SForCreation(ctx context.Context, rule
 
 // ValidateRuleS2SForUpdate validates a RuleS2S for update
 func (s *ValidationService) ValidateRuleS2SForUpdate(ctx context.Context, oldRule, newRule models.RuleS2S) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -318,7 +318,7 @@ func (s *ValidationService) ValidateRuleS2SForUpdate(ctx context.Context, oldRul
 
 // ValidateRuleS2SForDeletion validates a RuleS2S for deletion
 func (s *ValidationService) ValidateRuleS2SForDeletion(ctx context.Context, rule models.RuleS2S) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -341,7 +341,7 @@ func (s *ValidationService) ValidateRuleS2SForDeletion(ctx context.Context, rule
 
 // ValidateServiceAliasForCreation validates a service alias for creation
 func (s *ValidationService) ValidateServiceAliasForCreation(ctx context.Context, alias models.ServiceAlias) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -359,7 +359,7 @@ func (s *ValidationService) ValidateServiceAliasForCreation(ctx context.Context,
 
 // ValidateServiceAliasForUpdate validates a service alias for update
 func (s *ValidationService) ValidateServiceAliasForUpdate(ctx context.Context, oldAlias, newAlias models.ServiceAlias) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -377,7 +377,7 @@ func (s *ValidationService) ValidateServiceAliasForUpdate(ctx context.Context, o
 
 // ValidateServiceAliasForDeletion validates a service alias for deletion
 func (s *ValidationService) ValidateServiceAliasForDeletion(ctx context.Context, alias models.ServiceAlias) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -400,7 +400,7 @@ func (s *ValidationService) ValidateServiceAliasForDeletion(ctx context.Context,
 
 // ValidateAddressGroupBindingPolicyForCreation validates an address group binding policy for creation
 func (s *ValidationService) ValidateAddressGroupBindingPolicyForCreation(ctx context.Context, policy models.AddressGroupBindingPolicy) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -418,7 +418,7 @@ func (s *ValidationService) ValidateAddressGroupBindingPolicyForCreation(ctx con
 
 // ValidateAddressGroupBindingPolicyForUpdate validates an address group binding policy for update
 func (s *ValidationService) ValidateAddressGroupBindingPolicyForUpdate(ctx context.Context, oldPolicy, newPolicy models.AddressGroupBindingPolicy) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -436,7 +436,7 @@ func (s *ValidationService) ValidateAddressGroupBindingPolicyForUpdate(ctx conte
 
 // ValidateAddressGroupBindingPolicyForDeletion validates an address group binding policy for deletion
 func (s *ValidationService) ValidateAddressGroupBindingPolicyForDeletion(ctx context.Context, policy models.AddressGroupBindingPolicy) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -459,7 +459,7 @@ func (s *ValidationService) ValidateAddressGroupBindingPolicyForDeletion(ctx con
 
 // ValidateNetworkForCreation validates a network for creation
 func (s *ValidationService) ValidateNetworkForCreation(ctx context.Context, network models.Network) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -477,7 +477,7 @@ func (s *ValidationService) ValidateNetworkForCreation(ctx context.Context, netw
 
 // ValidateNetworkForUpdate validates a network for update
 func (s *ValidationService) ValidateNetworkForUpdate(ctx context.Context, oldNetwork, newNetwork models.Network) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -495,7 +495,7 @@ func (s *ValidationService) ValidateNetworkForUpdate(ctx context.Context, oldNet
 
 // ValidateNetworkForDeletion validates a network for deletion
 func (s *ValidationService) ValidateNetworkForDeletion(ctx context.Context, network models.Network) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -518,7 +518,7 @@ func (s *ValidationService) ValidateNetworkForDeletion(ctx context.Context, netw
 
 // ValidateNetworkBindingForCreation validates a network binding for creation
 func (s *ValidationService) ValidateNetworkBindingForCreation(ctx context.Context, binding models.NetworkBinding) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -536,7 +536,7 @@ func (s *ValidationService) ValidateNetworkBindingForCreation(ctx context.Contex
 
 // ValidateNetworkBindingForUpdate validates a network binding for update
 func (s *ValidationService) ValidateNetworkBindingForUpdate(ctx context.Context, oldBinding, newBinding models.NetworkBinding) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -554,7 +554,7 @@ func (s *ValidationService) ValidateNetworkBindingForUpdate(ctx context.Context,
 
 // ValidateNetworkBindingForDeletion validates a network binding for deletion
 func (s *ValidationService) ValidateNetworkBindingForDeletion(ctx context.Context, binding models.NetworkBinding) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
@@ -685,7 +685,7 @@ func (s *ValidationService) ValidateRuleS2SForCreationWithReader(ctx context.Con
 
 // ValidateResourceDependencies validates dependencies between resources
 func (s *ValidationService) ValidateResourceDependencies(ctx context.Context, resource interface{}) error {
-	reader, err := s.registry.Reader(ctx)
+	reader, err := s.registry.PrimaryReader(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to get reader")
 	}
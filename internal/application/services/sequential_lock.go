@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// operationUnlockTimeout bounds the release of the cluster operation lock
+const operationUnlockTimeout = 5 * time.Second
+
+// sequentialLock serializes RuleS2S operations. Within the process it is a mutex, with a cluster
+// lock (see NetguardFacade.SetOperationLock) it also serializes them across backend replicas.
+type sequentialLock struct {
+	mu      sync.Mutex
+	cluster ports.OperationLock
+}
+
+var _ sync.Locker = &sequentialLock{}
+
+// Lock takes the process mutex, then the cluster lock. An operation is still serialized within
+// the replica when the cluster lock can't be taken, it fails anyway while the database is down.
+func (l *sequentialLock) Lock() {
+	l.mu.Lock()
+	if l.cluster == nil {
+		return
+	}
+	if err := l.cluster.Lock(context.Background()); err != nil {
+		klog.Errorf("❌ SEQUENTIAL_PROCESSING: Failed to take cluster operation lock, serializing within this replica only: %v", err)
+	}
+}
+
+// Unlock releases the cluster lock, then the process mutex
+func (l *sequentialLock) Unlock() {
+	if l.cluster != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), operationUnlockTimeout)
+		if err := l.cluster.Unlock(ctx); err != nil {
+			klog.Errorf("❌ SEQUENTIAL_PROCESSING: Failed to release cluster operation lock: %v", err)
+		}
+		cancel()
+	}
+	l.mu.Unlock()
+}
+
+// setCluster makes the lock serialize operations across replicas
+func (l *sequentialLock) setCluster(cluster ports.OperationLock) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cluster = cluster
+}
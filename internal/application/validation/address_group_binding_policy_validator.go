@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
 
 	"github.com/pkg/errors"
 )
@@ -21,11 +22,13 @@ func (v *AddressGroupBindingPolicyValidator) ValidateReferences(ctx context.Cont
 	serviceValidator := NewServiceValidator(v.reader)
 	addressGroupValidator := NewAddressGroupValidator(v.reader)
 
-	// Create ResourceIdentifier from NamespacedObjectReference
-	serviceID := models.NewResourceIdentifier(policy.ServiceRef.Name, models.WithNamespace(policy.ServiceRef.Namespace))
-	if err := serviceValidator.ValidateExists(ctx, serviceID); err != nil {
+	// References must point to objects of the expected kind and API group
+	if err := ValidateReferenceType(policy.ServiceRef.ObjectReference, "Service"); err != nil {
 		return errors.Wrapf(err, "invalid service reference in policy %s", policy.Key())
 	}
+	if err := ValidateReferenceType(policy.AddressGroupRef.ObjectReference, "AddressGroup"); err != nil {
+		return errors.Wrapf(err, "invalid address group reference in policy %s", policy.Key())
+	}
 
 	// Create ResourceIdentifier from NamespacedObjectReference
 	agID := models.NewResourceIdentifier(policy.AddressGroupRef.Name, models.WithNamespace(policy.AddressGroupRef.Namespace))
@@ -33,6 +36,24 @@ func (v *AddressGroupBindingPolicyValidator) ValidateReferences(ctx context.Cont
 		return errors.Wrapf(err, "invalid address group reference in policy %s", policy.Key())
 	}
 
+	if policy.AllowsServiceNamespace() {
+		// Only global address groups may be used by every Service of a namespace
+		addressGroup, err := v.reader.GetAddressGroupByID(ctx, agID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get address group of policy %s", policy.Key())
+		}
+		if !addressGroup.IsGlobal() {
+			return fmt.Errorf("policy %s without a service name must reference a global address group, %s is not labeled %s=true",
+				policy.Key(), agID.Key(), models.AddressGroupGlobalLabel)
+		}
+	} else {
+		// Create ResourceIdentifier from NamespacedObjectReference
+		serviceID := models.NewResourceIdentifier(policy.ServiceRef.Name, models.WithNamespace(policy.ServiceRef.Namespace))
+		if err := serviceValidator.ValidateExists(ctx, serviceID); err != nil {
+			return errors.Wrapf(err, "invalid service reference in policy %s", policy.Key())
+		}
+	}
+
 	// Проверяем, что политика находится в том же namespace, что и AddressGroup
 	if policy.Namespace != policy.AddressGroupRef.Namespace {
 		return fmt.Errorf("policy namespace '%s' must match address group namespace '%s'",
@@ -42,6 +63,58 @@ func (v *AddressGroupBindingPolicyValidator) ValidateReferences(ctx context.Cont
 	return nil
 }
 
+// HasBindingPolicy reports whether an AddressGroupBindingPolicy allows the service to bind to the address group.
+// Policies live in the namespace of the address group, so only that namespace is listed. A policy naming the
+// service allows it, a policy without a service name allows every Service of its namespace if the group is global.
+func (v *AddressGroupBindingPolicyValidator) HasBindingPolicy(ctx context.Context, addressGroupID, serviceID models.ResourceIdentifier) (bool, error) {
+	scope := ports.ResourceIdentifierScope{
+		Identifiers: []models.ResourceIdentifier{{Namespace: addressGroupID.Namespace}},
+	}
+
+	found, namespaceAllowed := false, false
+	err := v.reader.ListAddressGroupBindingPolicies(ctx, func(policy models.AddressGroupBindingPolicy) error {
+		if policy.Namespace != addressGroupID.Namespace {
+			return nil
+		}
+		agID := models.NewResourceIdentifier(policy.AddressGroupRef.Name, models.WithNamespace(refNamespace(policy.AddressGroupRef.Namespace, policy.Namespace)))
+		if agID != addressGroupID {
+			return nil
+		}
+		svcID := models.NewResourceIdentifier(policy.ServiceRef.Name, models.WithNamespace(refNamespace(policy.ServiceRef.Namespace, policy.Namespace)))
+		switch {
+		case svcID == serviceID:
+			found = true
+		case policy.AllowsServiceNamespace() && svcID.Namespace == serviceID.Namespace:
+			namespaceAllowed = true
+		}
+		return nil
+	}, scope)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to list address group binding policies")
+	}
+	if found || !namespaceAllowed {
+		return found, nil
+	}
+
+	// The group may have lost the global label since the policy was created
+	addressGroup, err := v.reader.GetAddressGroupByID(ctx, addressGroupID)
+	if errors.Is(err, ports.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get address group %s", addressGroupID.Key())
+	}
+	return addressGroup.IsGlobal(), nil
+}
+
+// refNamespace returns the namespace of a reference, references without a namespace point to the owner namespace
+func refNamespace(refNamespace, ownerNamespace string) string {
+	if refNamespace == "" {
+		return ownerNamespace
+	}
+	return refNamespace
+}
+
 // ValidateForCreation validates an address group binding policy before creation
 func (v *AddressGroupBindingPolicyValidator) ValidateForCreation(ctx context.Context, policy *models.AddressGroupBindingPolicy) error {
 	// PHASE 1: Check for duplicate entity (CRITICAL FIX for overwrite issue)
@@ -146,13 +219,15 @@ func (v *AddressGroupBindingPolicyValidator) ValidateForUpdate(ctx context.Conte
 	// Проверяем, что ссылка на сервис не изменилась
 	if oldPolicy.ServiceRef.Namespace != newPolicy.ServiceRef.Namespace ||
 		oldPolicy.ServiceRef.Name != newPolicy.ServiceRef.Name {
-		return fmt.Errorf("cannot change service reference after creation")
+		return NewImmutableFieldError(v.BaseValidator.entityType, newPolicy.Key(), "cannot change service reference after creation",
+			"serviceRef", oldPolicy.ServiceRef, newPolicy.ServiceRef)
 	}
 
 	// Проверяем, что ссылка на address group не изменилась
 	if oldPolicy.AddressGroupRef.Namespace != newPolicy.AddressGroupRef.Namespace ||
 		oldPolicy.AddressGroupRef.Name != newPolicy.AddressGroupRef.Name {
-		return fmt.Errorf("cannot change address group reference after creation")
+		return NewImmutableFieldError(v.BaseValidator.entityType, newPolicy.Key(), "cannot change address group reference after creation",
+			"addressGroupRef", oldPolicy.AddressGroupRef, newPolicy.AddressGroupRef)
 	}
 
 	return nil
@@ -173,8 +248,10 @@ func (v *AddressGroupBindingPolicyValidator) CheckDependencies(ctx context.Conte
 	hasBindings := false
 	err = v.reader.ListAddressGroupBindings(ctx, func(binding models.AddressGroupBinding) error {
 		// Проверяем, ссылается ли привязка на тот же сервис и группу адресов, что и политика
-		if binding.ServiceRefKey() == policy.ServiceRefKey() &&
-			binding.AddressGroupRefKey() == policy.AddressGroupRefKey() {
+		// (политика без имени сервиса разрешает все сервисы своего namespace)
+		sameService := binding.ServiceRefKey() == policy.ServiceRefKey() ||
+			policy.AllowsServiceNamespace() && binding.ServiceRef.Namespace == policy.ServiceRef.Namespace
+		if sameService && binding.AddressGroupRefKey() == policy.AddressGroupRefKey() {
 			hasBindings = true
 			return fmt.Errorf("binding found") // Используем ошибку для прерывания цикла
 		}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"netguard-pg-backend/internal/domain/models"
-	"netguard-pg-backend/internal/domain/ports"
 
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
@@ -33,7 +32,7 @@ func CreateNewPortMapping(addressGroupID models.ResourceIdentifier, service mode
 	for i, ingressPort := range service.IngressPorts {
 		klog.Infof("🔧 Processing port %d: Protocol=%s, Port=%s", i, ingressPort.Protocol, ingressPort.Port)
 
-		portRanges, err := ParsePortRanges(ingressPort.Port)
+		portRanges, err := ParseProtocolPortRanges(ingressPort.Protocol, ingressPort.Port)
 		if err != nil {
 			klog.Errorf("❌ Failed to parse port %s: %v", ingressPort.Port, err)
 			continue
@@ -92,7 +91,7 @@ func UpdatePortMapping(
 	for i, ingressPort := range service.IngressPorts {
 		klog.Infof("🔧 Processing port %d: Protocol=%s, Port=%s", i, ingressPort.Protocol, ingressPort.Port)
 
-		portRanges, err := ParsePortRanges(ingressPort.Port)
+		portRanges, err := ParseProtocolPortRanges(ingressPort.Protocol, ingressPort.Port)
 		if err != nil {
 			klog.Errorf("❌ Failed to parse port %s: %v", ingressPort.Port, err)
 			continue
@@ -125,71 +124,11 @@ func UpdatePortMapping(
 	return &updatedMapping
 }
 
-// CheckPortOverlaps checks for port overlaps in a port mapping
+// CheckPortOverlaps checks for port overlaps of a service within its ports and with the
+// other services of a port mapping, all conflicts are reported in a PortConflictError
 func CheckPortOverlaps(service models.Service, portMapping models.AddressGroupPortMapping) error {
-	// Create a map of service ports by protocol
-	servicePorts := make(map[models.TransportProtocol][]models.PortRange)
-
-	for _, ingressPort := range service.IngressPorts {
-		portRanges, err := ParsePortRanges(ingressPort.Port)
-		if err != nil {
-			return fmt.Errorf("invalid port in service %s: %w", service.Key(), err)
-		}
-
-		// ✨ OPTIMIZATION: Use optimized overlap checking within current port set
-		if err := CheckPortRangeOverlapsOptimized(portRanges, string(ingressPort.Protocol)); err != nil {
-			return fmt.Errorf("port conflict detected within port specification: %s port %s - %v",
-				ingressPort.Protocol, ingressPort.Port, err)
-		}
-
-		servicePorts[ingressPort.Protocol] = append(servicePorts[ingressPort.Protocol], portRanges...)
-	}
-
-	// ✨ OPTIMIZATION: Use optimized overlap checking within same protocol for service
-	for protocol, ranges := range servicePorts {
-		if err := CheckPortRangeOverlapsOptimized(ranges, string(protocol)); err != nil {
-			return fmt.Errorf("port conflict detected within service %s: %v", service.Key(), err)
-		}
-	}
-
-	// Check for overlaps with existing services in the port mapping
-	for existingServiceRef, existingServicePorts := range portMapping.AccessPorts {
-		// Skip the current service
-		if models.ServiceRefKey(existingServiceRef) == service.Key() {
-			continue
-		}
-
-		// ✨ OPTIMIZATION: Check each protocol using optimized algorithm
-		for protocol, serviceRanges := range servicePorts {
-			existingRanges := existingServicePorts.Ports[protocol]
-			if len(existingRanges) == 0 {
-			continue
-		}
-			// Combine all ranges for this protocol and check for overlaps
-			allRanges := make([]models.PortRange, 0, len(serviceRanges)+len(existingRanges))
-			allRanges = append(allRanges, serviceRanges...)
-			allRanges = append(allRanges, existingRanges...)
-
-			// Use optimized overlap detection - if there are overlaps, identify which services
-			if err := CheckPortRangeOverlapsOptimized(allRanges, string(protocol)); err != nil {
-				// Enhanced error reporting: identify which specific services have conflicts
-				for _, serviceRange := range serviceRanges {
-					for _, existingRange := range existingRanges {
-						if DoPortRangesOverlap(serviceRange, existingRange) {
-							return fmt.Errorf("%s port range %d-%d in service %s overlaps with existing port range %d-%d in service %s",
-								protocol, serviceRange.Start, serviceRange.End, service.Key(),
-								existingRange.Start, existingRange.End, models.ServiceRefKey(existingServiceRef))
-						}
-					}
-				}
-				// Fallback error if we can't identify specific overlap
-				return fmt.Errorf("%s port conflict between service %s and existing service %s",
-					protocol, service.Key(), models.ServiceRefKey(existingServiceRef))
-			}
-		}
-	}
-
-	return nil
+	conflicts, err := FindPortConflicts(service, portMapping)
+	return portConflictError(service, conflicts, err)
 }
 
 // ValidateExists checks if an address group binding exists
@@ -204,6 +143,14 @@ func (v *AddressGroupBindingValidator) ValidateReferences(ctx context.Context, b
 	serviceValidator := NewServiceValidator(v.reader)
 	addressGroupValidator := NewAddressGroupValidator(v.reader)
 
+	// References must point to objects of the expected kind and API group
+	if err := ValidateReferenceType(binding.ServiceRef.ObjectReference, "Service"); err != nil {
+		return errors.Wrapf(err, "invalid service reference in address group binding %s", binding.Key())
+	}
+	if err := ValidateReferenceType(binding.AddressGroupRef.ObjectReference, "AddressGroup"); err != nil {
+		return errors.Wrapf(err, "invalid address group reference in address group binding %s", binding.Key())
+	}
+
 	// Create ResourceIdentifier from ObjectReference
 	// 🔧 CRITICAL FIX: Use ServiceRef.Namespace instead of binding.Namespace for cross-namespace support
 	serviceID := models.NewResourceIdentifier(binding.ServiceRef.Name, models.WithNamespace(binding.ServiceRef.Namespace))
@@ -350,37 +297,15 @@ func (v *AddressGroupBindingValidator) ValidateForCreation(ctx context.Context,
 			addressGroup.Name, addressGroup.Namespace, binding.Name, binding.Namespace)
 
 		// Check for AddressGroupBindingPolicy in AddressGroup's namespace
-		policyFound := false
-
-		// Create scope for AddressGroup's namespace
-		namespaceScope := ports.ResourceIdentifierScope{
-			Identifiers: []models.ResourceIdentifier{
-				{Namespace: addressGroup.Namespace},
-			},
-		}
-
-		err := v.reader.ListAddressGroupBindingPolicies(ctx, func(policy models.AddressGroupBindingPolicy) error {
-			// Check that policy references the required AddressGroup and Service
-			if policy.AddressGroupRefKey() == binding.AddressGroupRefKey() &&
-				policy.ServiceRefKey() == binding.ServiceRefKey() {
-				policyFound = true
-				klog.Infof("🔧 FIX: Found required policy %s in namespace %s for cross-namespace binding %s",
-					policy.Name, policy.Namespace, binding.Key())
-				return fmt.Errorf("policy found") // Use error to break the loop
-			}
-			return nil
-		}, namespaceScope)
-
-		// Ignore "policy found" error as it's not a real error
-		if err != nil && err.Error() != "policy found" {
+		policyFound, err := NewAddressGroupBindingPolicyValidator(v.reader).HasBindingPolicy(ctx, addressGroup.ResourceIdentifier, service.ResourceIdentifier)
+		if err != nil {
 			klog.Errorf("🔧 FIX: Failed to check for binding policies: %v", err)
 			return fmt.Errorf("failed to check for binding policies: %v", err)
 		}
 
 		if !policyFound {
 			klog.Errorf("🔧 FIX: Cross-namespace binding blocked - no policy found")
-			return fmt.Errorf("cross-namespace binding not allowed: no AddressGroupBindingPolicy found in namespace %s that references both AddressGroup %s and Service %s",
-				addressGroup.Namespace, binding.AddressGroupRef.Name, binding.ServiceRef.Name)
+			return crossNamespaceBindingError(addressGroup, binding.AddressGroupRef.Name, binding.ServiceRef.Name)
 		}
 
 		klog.Infof("✅ Cross-namespace binding policy validation passed for binding %s", binding.Key())
@@ -392,7 +317,7 @@ func (v *AddressGroupBindingValidator) ValidateForCreation(ctx context.Context,
 		// Port mapping exists - check for port overlaps with this new service
 		if err := CheckPortOverlaps(*service, *portMapping); err != nil {
 			klog.Errorf("🔧 FIX: Port conflict detected for binding %s: %v", binding.Key(), err)
-			return fmt.Errorf("port conflict detected: %v", err)
+			return fmt.Errorf("port conflict detected: %w", err)
 		}
 	}
 
@@ -428,22 +353,9 @@ func (v *AddressGroupBindingValidator) ValidateForPostCommit(ctx context.Context
 			addressGroup.Namespace, binding.ServiceRef.Namespace)
 
 		// Check for required policy
-		policyFound := false
-		namespaceScope := ports.ResourceIdentifierScope{
-			Identifiers: []models.ResourceIdentifier{
-				{Namespace: addressGroup.Namespace},
-			},
-		}
-		err := v.reader.ListAddressGroupBindingPolicies(ctx, func(policy models.AddressGroupBindingPolicy) error {
-			if policy.AddressGroupRefKey() == binding.AddressGroupRefKey() &&
-				policy.ServiceRefKey() == binding.ServiceRefKey() {
-				policyFound = true
-				return fmt.Errorf("policy found")
-			}
-			return nil
-		}, namespaceScope)
-
-		if err != nil && err.Error() != "policy found" {
+		serviceID := models.NewResourceIdentifier(binding.ServiceRef.Name, models.WithNamespace(refNamespace(binding.ServiceRef.Namespace, binding.Namespace)))
+		policyFound, err := NewAddressGroupBindingPolicyValidator(v.reader).HasBindingPolicy(ctx, addressGroup.ResourceIdentifier, serviceID)
+		if err != nil {
 			return fmt.Errorf("failed to check for binding policies: %v", err)
 		}
 
@@ -525,12 +437,14 @@ func (v *AddressGroupBindingValidator) ValidateForUpdate(ctx context.Context, ol
 
 	// Check that service reference hasn't changed (fallback validation)
 	if oldBinding.ServiceRefKey() != newBinding.ServiceRefKey() {
-		return fmt.Errorf("cannot change service reference after creation")
+		return NewImmutableFieldError(v.BaseValidator.entityType, newBinding.Key(), "cannot change service reference after creation",
+			"serviceRef", oldBinding.ServiceRef, newBinding.ServiceRef)
 	}
 
 	// Check that address group reference hasn't changed (fallback validation)
 	if oldBinding.AddressGroupRefKey() != newBinding.AddressGroupRefKey() {
-		return fmt.Errorf("cannot change address group reference after creation")
+		return NewImmutableFieldError(v.BaseValidator.entityType, newBinding.Key(), "cannot change address group reference after creation",
+			"addressGroupRef", oldBinding.AddressGroupRef, newBinding.AddressGroupRef)
 	}
 
 	// Получаем address group для проверки namespace
@@ -547,33 +461,14 @@ func (v *AddressGroupBindingValidator) ValidateForUpdate(ctx context.Context, ol
 	// Если AddressGroup находится в другом namespace, чем Binding/Service
 	if addressGroup.Namespace != newBinding.Namespace {
 		// Проверяем наличие политики в namespace AddressGroup
-		policyFound := false
-
-		// Создаем скоуп для namespace адресной группы
-		namespaceScope := ports.ResourceIdentifierScope{
-			Identifiers: []models.ResourceIdentifier{
-				{Namespace: addressGroup.Namespace},
-			},
-		}
-
-		err := v.reader.ListAddressGroupBindingPolicies(ctx, func(policy models.AddressGroupBindingPolicy) error {
-			// Проверяем, что политика ссылается на нужные AddressGroup и Service
-			if policy.AddressGroupRefKey() == newBinding.AddressGroupRefKey() &&
-				policy.ServiceRefKey() == newBinding.ServiceRefKey() {
-				policyFound = true
-				return fmt.Errorf("policy found") // Используем ошибку для прерывания цикла
-			}
-			return nil
-		}, namespaceScope)
-
-		// Игнорируем ошибку "policy found", так как это не настоящая ошибка
-		if err != nil && err.Error() != "policy found" {
+		serviceID := models.NewResourceIdentifier(newBinding.ServiceRef.Name, models.WithNamespace(refNamespace(newBinding.ServiceRef.Namespace, newBinding.Namespace)))
+		policyFound, err := NewAddressGroupBindingPolicyValidator(v.reader).HasBindingPolicy(ctx, addressGroup.ResourceIdentifier, serviceID)
+		if err != nil {
 			return errors.Wrap(err, "failed to check for binding policies")
 		}
 
 		if !policyFound {
-			return fmt.Errorf("cross-namespace binding not allowed: no AddressGroupBindingPolicy found in namespace %s that references both AddressGroup %s and Service %s",
-				addressGroup.Namespace, newBinding.AddressGroupRef.Name, newBinding.ServiceRef.Name)
+			return crossNamespaceBindingError(addressGroup, newBinding.AddressGroupRef.Name, newBinding.ServiceRef.Name)
 		}
 	}
 
@@ -614,3 +509,13 @@ func (v *AddressGroupBindingValidator) CheckDependencies(ctx context.Context, id
 	// Log the dependency check for consistency with other validators
 	return nil
 }
+
+// crossNamespaceBindingError describes a binding that needs an AddressGroupBindingPolicy
+func crossNamespaceBindingError(addressGroup *models.AddressGroup, addressGroupName, serviceName string) error {
+	if addressGroup.IsGlobal() {
+		return fmt.Errorf("binding to global address group not allowed: no AddressGroupBindingPolicy found in namespace %s that references both AddressGroup %s and Service %s",
+			addressGroup.Namespace, addressGroupName, serviceName)
+	}
+	return fmt.Errorf("cross-namespace binding not allowed: no AddressGroupBindingPolicy found in namespace %s that references both AddressGroup %s and Service %s",
+		addressGroup.Namespace, addressGroupName, serviceName)
+}
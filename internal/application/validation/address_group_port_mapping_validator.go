@@ -35,52 +35,40 @@ func (v *AddressGroupPortMappingValidator) ValidateReferences(ctx context.Contex
 
 // CheckInternalPortOverlaps проверяет перекрытия портов между сервисами в маппинге портов
 func (v *AddressGroupPortMappingValidator) CheckInternalPortOverlaps(mapping models.AddressGroupPortMapping) error {
-	// Создаем карты для хранения диапазонов портов по протоколу
-	tcpRanges := make(map[string][]models.PortRange)
-	udpRanges := make(map[string][]models.PortRange)
+	// Создаем карты для хранения диапазонов портов по протоколу и сервису
+	protocolRanges := make(map[models.TransportProtocol]map[string][]models.PortRange)
 
 	// Проверяем каждый сервис в маппинге
 	for serviceRef, servicePorts := range mapping.AccessPorts {
 		serviceName := models.ServiceRefKey(serviceRef)
 
-		// Проверяем TCP порты
-		for _, portRange := range servicePorts.Ports[models.TCP] {
-			// Проверяем перекрытия с TCP портами других сервисов
-			for otherService, ranges := range tcpRanges {
-				if otherService == serviceName {
-					continue // Пропускаем проверку с тем же сервисом
-				}
-
-				for _, existingRange := range ranges {
-					if DoPortRangesOverlap(portRange, existingRange) {
-						return fmt.Errorf("TCP port range %d-%d for service %s overlaps with existing port range %d-%d for service %s",
-							portRange.Start, portRange.End, serviceName, existingRange.Start, existingRange.End, otherService)
-					}
-				}
+		// Проверяем порты каждого протокола (TCP, UDP, SCTP)
+		for _, protocol := range models.TransportProtocols() {
+			if !protocol.HasPorts() {
+				continue // ICMP не занимает портов
+			}
+			if protocolRanges[protocol] == nil {
+				protocolRanges[protocol] = make(map[string][]models.PortRange)
 			}
 
-			// Добавляем диапазон портов в карту
-			tcpRanges[serviceName] = append(tcpRanges[serviceName], portRange)
-		}
-
-		// Проверяем UDP порты
-		for _, portRange := range servicePorts.Ports[models.UDP] {
-			// Проверяем перекрытия с UDP портами других сервисов
-			for otherService, ranges := range udpRanges {
-				if otherService == serviceName {
-					continue // Пропускаем проверку с тем же сервисом
-				}
+			for _, portRange := range servicePorts.Ports[protocol] {
+				// Проверяем перекрытия с портами других сервисов того же протокола
+				for otherService, ranges := range protocolRanges[protocol] {
+					if otherService == serviceName {
+						continue // Пропускаем проверку с тем же сервисом
+					}
 
-				for _, existingRange := range ranges {
-					if DoPortRangesOverlap(portRange, existingRange) {
-						return fmt.Errorf("UDP port range %d-%d for service %s overlaps with existing port range %d-%d for service %s",
-							portRange.Start, portRange.End, serviceName, existingRange.Start, existingRange.End, otherService)
+					for _, existingRange := range ranges {
+						if DoPortRangesOverlap(portRange, existingRange) {
+							return fmt.Errorf("%s port range %d-%d for service %s overlaps with existing port range %d-%d for service %s",
+								protocol, portRange.Start, portRange.End, serviceName, existingRange.Start, existingRange.End, otherService)
+						}
 					}
 				}
-			}
 
-			// Добавляем диапазон портов в карту
-			udpRanges[serviceName] = append(udpRanges[serviceName], portRange)
+				// Добавляем диапазон портов в карту
+				protocolRanges[protocol][serviceName] = append(protocolRanges[protocol][serviceName], portRange)
+			}
 		}
 	}
 
@@ -3,7 +3,7 @@ package validation
 import (
 	"context"
 	"fmt"
-	"net"
+	"strings"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
@@ -23,6 +23,42 @@ func (v *AddressGroupValidator) ValidateExists(ctx context.Context, id models.Re
 
 // ValidateReferences checks if all references in an address group are valid
 func (v *AddressGroupValidator) ValidateReferences(ctx context.Context, group models.AddressGroup) error {
+	return v.validateIncludedGroups(ctx, group)
+}
+
+// validateIncludedGroups checks that included address groups exist and don't form an inclusion cycle
+func (v *AddressGroupValidator) validateIncludedGroups(ctx context.Context, group models.AddressGroup) error {
+	if len(group.IncludedGroups) == 0 {
+		return nil
+	}
+
+	groupKey := group.Key()
+	for _, ref := range group.IncludedGroupRefs() {
+		if ref.Name == "" {
+			return fmt.Errorf("included group of address group %s must have a name", groupKey)
+		}
+		if models.AddressGroupRefKey(ref) == groupKey {
+			return fmt.Errorf("address group %s cannot include itself", groupKey)
+		}
+		if err := v.ValidateExists(ctx, models.NewResourceIdentifier(ref.Name, models.WithNamespace(ref.Namespace))); err != nil {
+			return errors.Wrapf(err, "invalid included group reference in address group %s", groupKey)
+		}
+	}
+
+	// The stored version of the validated group is replaced by the new one
+	cycle, err := models.AddressGroupInclusionCycle(group, func(ref models.AddressGroupRef) (*models.AddressGroup, error) {
+		included, err := v.reader.GetAddressGroupByID(ctx, models.NewResourceIdentifier(ref.Name, models.WithNamespace(ref.Namespace)))
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, nil
+		}
+		return included, err
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to check included groups of address group %s", groupKey)
+	}
+	if cycle != nil {
+		return fmt.Errorf("address group %s forms an inclusion cycle: %s", groupKey, strings.Join(cycle, " -> "))
+	}
 	return nil
 }
 
@@ -37,27 +73,28 @@ func (v *AddressGroupValidator) ValidateForCreation(ctx context.Context, group m
 		return ""
 	}
 
+	errs := NewValidationErrors(v.BaseValidator.entityType, group.Key())
+	// An existing address group is reported alone with the detailed EntityAlreadyExistsError, its
+	// references are not looked up
 	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, group.ResourceIdentifier, keyExtractor); err != nil {
-		return err // Return the detailed EntityAlreadyExistsError with logging and context
+		errs.Add("metadata.name", err)
+		return errs.Err()
 	}
 
 	// Networks can't be modified or added during creation
-	if group.Networks != nil && len(group.Networks) > 0 {
-		return fmt.Errorf("networks can't be modified or added during creation")
+	if len(group.Networks) > 0 {
+		errs.Add("networks", fmt.Errorf("networks can't be modified or added during creation"))
 	}
 
 	// PHASE 2.5: Validate host references (existence and format)
-	if err := v.validateHostReferences(ctx, group.Hosts, group.ResourceIdentifier); err != nil {
-		return err
-	}
+	errs.Add("spec.hosts", v.validateHostReferences(ctx, group.Hosts, group.ResourceIdentifier))
 
 	// PHASE 2.6: Validate host exclusivity (NEW: ensure hosts don't belong to other AddressGroups)
-	if err := v.validateHostExclusivity(ctx, group.Hosts, group.ResourceIdentifier); err != nil {
-		return err
-	}
+	errs.Add("spec.hosts", v.validateHostExclusivity(ctx, group.Hosts, group.ResourceIdentifier))
 
 	// PHASE 3: Validate references (existing validation)
-	return v.ValidateReferences(ctx, group)
+	errs.Add("", v.ValidateReferences(ctx, group))
+	return errs.Err()
 }
 
 // ValidateForPostCommit validates an address group after it has been committed to database
@@ -75,23 +112,19 @@ func (v *AddressGroupValidator) ValidateForPostCommit(ctx context.Context, group
 
 // ValidateForUpdate validates an address group before update
 func (v *AddressGroupValidator) ValidateForUpdate(ctx context.Context, oldGroup, newGroup models.AddressGroup) error {
-	if err := v.validateNetworks(newGroup.Networks); err != nil {
-		return err
-	}
+	errs := NewValidationErrors(v.BaseValidator.entityType, newGroup.Key())
+	errs.Add("networks", v.validateNetworks(newGroup.Networks))
 
 	// Validate host references (existence and format) for new or changed hosts
-	if err := v.validateHostReferences(ctx, newGroup.Hosts, newGroup.ResourceIdentifier); err != nil {
-		return err
-	}
+	errs.Add("spec.hosts", v.validateHostReferences(ctx, newGroup.Hosts, newGroup.ResourceIdentifier))
 
 	// Validate host exclusivity for new or changed hosts
-	if err := v.validateHostExclusivity(ctx, newGroup.Hosts, newGroup.ResourceIdentifier); err != nil {
-		return err
-	}
+	errs.Add("spec.hosts", v.validateHostExclusivity(ctx, newGroup.Hosts, newGroup.ResourceIdentifier))
 
 	// For address groups, the validation for update is the same as for creation
 	// We might add specific update validation rules in the future if needed
-	return v.ValidateReferences(ctx, newGroup)
+	errs.Add("", v.ValidateReferences(ctx, newGroup))
+	return errs.Err()
 }
 
 // validateNetworks validates the Networks field of an AddressGroup
@@ -106,11 +139,18 @@ func (v *AddressGroupValidator) validateNetworks(networks []models.NetworkItem)
 			return fmt.Errorf("network item %d (%s): CIDR is required", i, network.Name)
 		}
 
-		// Validate CIDR format
-		if _, _, err := net.ParseCIDR(network.CIDR); err != nil {
+		// Validate CIDR format, IPv4 and IPv6 networks can be mixed in a dual-stack group
+		if _, err := models.ParseCIDR(network.CIDR); err != nil {
 			return fmt.Errorf("network item %d (%s): invalid CIDR format '%s': %v", i, network.Name, network.CIDR, err)
 		}
 
+		// Networks of the same family must not overlap
+		for _, previous := range networks[:i] {
+			if overlap, err := models.CIDRsOverlap(network.CIDR, previous.CIDR); err == nil && overlap {
+				return fmt.Errorf("network item %d (%s): CIDR %s overlaps CIDR %s of network %s", i, network.Name, network.CIDR, previous.CIDR, previous.Name)
+			}
+		}
+
 		if network.Kind == "" {
 			return fmt.Errorf("network item %d (%s): kind is required", i, network.Name)
 		}
@@ -237,7 +277,7 @@ func (v *AddressGroupValidator) CheckDependencies(ctx context.Context, id models
 	hasServices := false
 	err := v.reader.ListServices(ctx, func(service models.Service) error {
 		for _, agRef := range service.AddressGroups {
-			if models.AddressGroupRefKey(agRef) == id.Key() {
+			if agRef.Name == id.Name && agRef.Namespace == id.Namespace {
 				hasServices = true
 				break
 			}
@@ -270,5 +310,25 @@ func (v *AddressGroupValidator) CheckDependencies(ctx context.Context, id models
 		return NewDependencyExistsError("address_group", id.Key(), "address_group_binding")
 	}
 
+	// Check AddressGroups including the address group to be deleted
+	isIncluded := false
+	err = v.reader.ListAddressGroups(ctx, func(group models.AddressGroup) error {
+		for _, ref := range group.IncludedGroupRefs() {
+			if ref.Name == id.Name && ref.Namespace == id.Namespace {
+				isIncluded = true
+				break
+			}
+		}
+		return nil
+	}, ports.EmptyScope{})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to check including address groups")
+	}
+
+	if isIncluded {
+		return NewDependencyExistsError("address_group", id.Key(), "address_group")
+	}
+
 	return nil
 }
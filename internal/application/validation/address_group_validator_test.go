@@ -103,9 +103,7 @@ func (m *MockReaderForAddressGroupValidator) ListServices(ctx context.Context, c
 				ResourceIdentifier: models.NewResourceIdentifier("test-service"),
 			},
 			AddressGroups: []models.AddressGroupRef{
-				{
-					ResourceIdentifier: models.NewResourceIdentifier(m.addressGroupID),
-				},
+				models.NewAddressGroupRef(m.addressGroupID),
 			},
 		}
 		return consume(service)
@@ -131,9 +129,7 @@ func (m *MockReaderForAddressGroupValidator) ListAddressGroupBindings(ctx contex
 			SelfRef: models.SelfRef{
 				ResourceIdentifier: models.NewResourceIdentifier("test-binding"),
 			},
-			AddressGroupRef: models.AddressGroupRef{
-				ResourceIdentifier: models.NewResourceIdentifier(m.addressGroupID),
-			},
+			AddressGroupRef: models.NewAddressGroupRef(m.addressGroupID),
 		}
 		return consume(binding)
 	}
@@ -220,3 +216,55 @@ func (m *MockReaderForAddressGroupValidator) GetNetworkByID(ctx context.Context,
 func (m *MockReaderForAddressGroupValidator) GetNetworkBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.NetworkBinding, error) {
 	return nil, fmt.Errorf("network binding not found")
 }
+
+func (m *MockReaderForAddressGroupValidator) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForAddressGroupValidator) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return nil, validation.NewEntityNotFoundError("RuleS2SException", id.Key())
+}
+
+func (m *MockReaderForAddressGroupValidator) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForAddressGroupValidator) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return nil, validation.NewEntityNotFoundError("CrossNamespacePolicy", id.Key())
+}
+
+func (m *MockReaderForAddressGroupValidator) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForAddressGroupValidator) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return nil, validation.NewEntityNotFoundError("RuleTemplate", id.Key())
+}
+
+func (m *MockReaderForAddressGroupValidator) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForAddressGroupValidator) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return nil, validation.NewEntityNotFoundError("NamespacePosture", id.Key())
+}
+
+func (m *MockReaderForAddressGroupValidator) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForAddressGroupValidator) GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error) {
+	return nil, validation.NewEntityNotFoundError("Host", id.Key())
+}
+
+func (m *MockReaderForAddressGroupValidator) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForAddressGroupValidator) GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error) {
+	return nil, validation.NewEntityNotFoundError("HostBinding", id.Key())
+}
+
+func (m *MockReaderForAddressGroupValidator) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Network, error) {
+	return nil, validation.NewEntityNotFoundError("Network", cidr)
+}
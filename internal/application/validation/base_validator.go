@@ -213,7 +213,7 @@ func (v *BaseValidator) ValidateSpecNotChangedWhenReady(oldObj, newObj interface
 	if !reflect.DeepEqual(oldSpec, newSpec) {
 		// Check if the Ready condition is true in the old object
 		if v.IsReadyConditionTrue(oldObj) {
-			return fmt.Errorf("spec cannot be changed when Ready condition is true")
+			return NewImmutableFieldError(v.entityType, entityKey(newObj), "spec cannot be changed when Ready condition is true", "", oldSpec, newSpec)
 		}
 	}
 	return nil
@@ -226,7 +226,7 @@ func (v *BaseValidator) ValidateFieldNotChangedWhenReady(fieldName string, oldOb
 	if !reflect.DeepEqual(oldValue, newValue) {
 		// Check if the Ready condition is true in the old object
 		if v.IsReadyConditionTrue(oldObj) {
-			return fmt.Errorf("cannot change %s when Ready condition is true", fieldName)
+			return NewImmutableFieldError(v.entityType, entityKey(newObj), fmt.Sprintf("cannot change %s when Ready condition is true", fieldName), fieldName, oldValue, newValue)
 		}
 	}
 	return nil
@@ -300,8 +300,30 @@ func (v *BaseValidator) ValidateObjectReferenceNotChangedWhenReady(oldObj, newOb
 
 		// Check if the Ready condition is true in the old object
 		if v.IsReadyConditionTrue(oldObj) {
-			return fmt.Errorf("cannot change %s when Ready condition is true", fieldName)
+			return &ImmutableFieldError{
+				EntityType: v.entityType,
+				EntityID:   entityKey(newObj),
+				Reason:     fmt.Sprintf("cannot change %s when Ready condition is true", fieldName),
+				Changes:    DiffFields(fieldName, objectReferenceFields(oldRef), objectReferenceFields(newRef)),
+			}
 		}
 	}
 	return nil
 }
+
+// referenceFields holds the compared fields of an ObjectReferencer
+type referenceFields struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+}
+
+func objectReferenceFields(ref ObjectReferencer) referenceFields {
+	return referenceFields{
+		APIVersion: ref.GetAPIVersion(),
+		Kind:       ref.GetKind(),
+		Name:       ref.GetName(),
+		Namespace:  ref.GetNamespace(),
+	}
+}
@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	"github.com/pkg/errors"
+)
+
+// CrossNamespacePolicyValidator validates CrossNamespacePolicy resources
+type CrossNamespacePolicyValidator struct {
+	*BaseValidator
+	reader ports.Reader
+}
+
+// NewCrossNamespacePolicyValidator creates a new cross namespace policy validator
+func NewCrossNamespacePolicyValidator(reader ports.Reader) *CrossNamespacePolicyValidator {
+	return &CrossNamespacePolicyValidator{
+		BaseValidator: NewBaseValidator(reader, "CrossNamespacePolicy", func(ctx context.Context, consume func(entity interface{}) error, scope ports.Scope) error {
+			return reader.ListCrossNamespacePolicies(ctx, func(policy models.CrossNamespacePolicy) error {
+				return consume(&policy)
+			}, scope)
+		}),
+		reader: reader,
+	}
+}
+
+// ValidateExists checks if a cross namespace policy exists
+func (v *CrossNamespacePolicyValidator) ValidateExists(ctx context.Context, id models.ResourceIdentifier) error {
+	return v.BaseValidator.ValidateExists(ctx, id, func(entity interface{}) string {
+		return entity.(*models.CrossNamespacePolicy).Key()
+	})
+}
+
+// ValidateSpec checks that the policy is namespaced and allows a non-empty list of distinct namespaces
+func (v *CrossNamespacePolicyValidator) ValidateSpec(policy models.CrossNamespacePolicy) error {
+	if policy.Namespace == "" {
+		return fmt.Errorf("cross namespace policy %s must be namespaced", policy.Name)
+	}
+	if len(policy.AllowedNamespaces) == 0 {
+		return fmt.Errorf("allowedNamespaces of cross namespace policy %s cannot be empty", policy.Key())
+	}
+
+	seen := make(map[string]bool, len(policy.AllowedNamespaces))
+	for _, namespace := range policy.AllowedNamespaces {
+		if namespace == "" {
+			return fmt.Errorf("allowedNamespaces of cross namespace policy %s cannot contain an empty namespace", policy.Key())
+		}
+		if seen[namespace] {
+			return fmt.Errorf("namespace %s is listed twice in allowedNamespaces of cross namespace policy %s", namespace, policy.Key())
+		}
+		seen[namespace] = true
+	}
+	return nil
+}
+
+// ValidateReferenceAllowed checks that a policy of the target namespace allows references from the source namespace
+func (v *CrossNamespacePolicyValidator) ValidateReferenceAllowed(ctx context.Context, sourceNamespace, targetNamespace string) error {
+	allowed, err := v.referenceAllowed(ctx, sourceNamespace, targetNamespace)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return fmt.Errorf("no CrossNamespacePolicy in namespace %s allows references from namespace %s", targetNamespace, sourceNamespace)
+	}
+	return nil
+}
+
+// ValidateForCreation validates a cross namespace policy for creation
+func (v *CrossNamespacePolicyValidator) ValidateForCreation(ctx context.Context, policy models.CrossNamespacePolicy) error {
+	if err := CurrentLimits().ValidateName("CrossNamespacePolicy", policy.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	keyExtractor := func(entity interface{}) string {
+		if p, ok := entity.(*models.CrossNamespacePolicy); ok {
+			return p.Key()
+		}
+		return ""
+	}
+
+	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, policy.ResourceIdentifier, keyExtractor); err != nil {
+		return err
+	}
+
+	return v.ValidateSpec(policy)
+}
+
+// ValidateForUpdate validates a cross namespace policy for update.
+// Namespaces can't be removed from the allow-list while their RuleS2S depend on the policy.
+func (v *CrossNamespacePolicyValidator) ValidateForUpdate(ctx context.Context, oldPolicy, newPolicy models.CrossNamespacePolicy) error {
+	if err := v.ValidateExists(ctx, oldPolicy.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	if err := v.ValidateSpec(newPolicy); err != nil {
+		return err
+	}
+
+	return v.checkDependentRules(ctx, newPolicy.ResourceIdentifier, &newPolicy)
+}
+
+// CheckDependencies checks that no RuleS2S relies on the policy before its deletion
+func (v *CrossNamespacePolicyValidator) CheckDependencies(ctx context.Context, id models.ResourceIdentifier) error {
+	return v.checkDependentRules(ctx, id, nil)
+}
+
+// checkDependentRules checks that cross namespace RuleS2S referencing Services of the policy namespace stay
+// allowed when the policy is replaced by replacement, nil replacement means the policy is deleted
+func (v *CrossNamespacePolicyValidator) checkDependentRules(ctx context.Context, id models.ResourceIdentifier, replacement *models.CrossNamespacePolicy) error {
+	policies, err := v.namespacePolicies(ctx, id.Namespace)
+	if err != nil {
+		return err
+	}
+	remaining := make([]models.CrossNamespacePolicy, 0, len(policies)+1)
+	for _, policy := range policies {
+		if policy.Key() != id.Key() {
+			remaining = append(remaining, policy)
+		}
+	}
+	if replacement != nil {
+		remaining = append(remaining, *replacement)
+	}
+
+	var dependentRule string
+	err = v.reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		if dependentRule != "" || !rule.IsCrossNamespace() || rule.ServiceRef.Namespace != id.Namespace {
+			return nil
+		}
+		if !anyPolicyAllows(remaining, rule.Namespace) {
+			dependentRule = rule.Key()
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return errors.Wrap(err, "failed to check rule s2s")
+	}
+
+	if dependentRule != "" {
+		return errors.Wrapf(NewDependencyExistsError("cross_namespace_policy", id.Key(), "rule_s2s"),
+			"rule s2s %s references a service in namespace %s", dependentRule, id.Namespace)
+	}
+	return nil
+}
+
+// referenceAllowed reports whether a policy of the target namespace allows the source namespace
+func (v *CrossNamespacePolicyValidator) referenceAllowed(ctx context.Context, sourceNamespace, targetNamespace string) (bool, error) {
+	policies, err := v.namespacePolicies(ctx, targetNamespace)
+	if err != nil {
+		return false, err
+	}
+	return anyPolicyAllows(policies, sourceNamespace), nil
+}
+
+// namespacePolicies lists the cross namespace policies of a namespace
+func (v *CrossNamespacePolicyValidator) namespacePolicies(ctx context.Context, namespace string) ([]models.CrossNamespacePolicy, error) {
+	var policies []models.CrossNamespacePolicy
+	err := v.reader.ListCrossNamespacePolicies(ctx, func(policy models.CrossNamespacePolicy) error {
+		policies = append(policies, policy)
+		return nil
+	}, ports.NewResourceIdentifierScope(models.NewResourceIdentifier("", models.WithNamespace(namespace))))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list cross namespace policies")
+	}
+	return policies, nil
+}
+
+// anyPolicyAllows reports whether one of the policies allows references from the namespace
+func anyPolicyAllows(policies []models.CrossNamespacePolicy, namespace string) bool {
+	for i := range policies {
+		if policies[i].Allows(namespace) {
+			return true
+		}
+	}
+	return false
+}
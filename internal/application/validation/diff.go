@@ -0,0 +1,165 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FieldChange is a field whose value differs between the old and the new object
+type FieldChange struct {
+	// Path is the field path, e.g. "serviceRef.name" or "ports[0].port"
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// String returns the change as "path: old -> new"
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, formatFieldValue(c.Old), formatFieldValue(c.New))
+}
+
+// DiffFields returns the leaf fields that differ between the old and the new value.
+// Struct fields are named by their json tags (lowerCamelCase field names if untagged),
+// unexported fields are ignored. Paths are prefixed with path unless it is empty.
+func DiffFields(path string, oldValue, newValue interface{}) []FieldChange {
+	var changes []FieldChange
+	diffValues(path, reflect.ValueOf(oldValue), reflect.ValueOf(newValue), &changes)
+	if len(changes) == 0 && !reflect.DeepEqual(oldValue, newValue) {
+		// Values differ only in unexported fields, report the whole value
+		changes = append(changes, FieldChange{Path: path, Old: oldValue, New: newValue})
+	}
+	return changes
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func diffValues(path string, oldValue, newValue reflect.Value, changes *[]FieldChange) {
+	if !oldValue.IsValid() || !newValue.IsValid() {
+		if oldValue.IsValid() != newValue.IsValid() {
+			*changes = append(*changes, FieldChange{Path: path, Old: valueInterface(oldValue), New: valueInterface(newValue)})
+		}
+		return
+	}
+	if oldValue.Type() != newValue.Type() {
+		*changes = append(*changes, FieldChange{Path: path, Old: oldValue.Interface(), New: newValue.Interface()})
+		return
+	}
+
+	switch oldValue.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if oldValue.IsNil() || newValue.IsNil() {
+			if oldValue.IsNil() != newValue.IsNil() {
+				*changes = append(*changes, FieldChange{Path: path, Old: valueInterface(oldValue), New: valueInterface(newValue)})
+			}
+			return
+		}
+		diffValues(path, oldValue.Elem(), newValue.Elem(), changes)
+
+	case reflect.Struct:
+		if oldValue.Type() == timeType {
+			if !oldValue.Interface().(time.Time).Equal(newValue.Interface().(time.Time)) {
+				*changes = append(*changes, FieldChange{Path: path, Old: oldValue.Interface(), New: newValue.Interface()})
+			}
+			return
+		}
+		for i := 0; i < oldValue.NumField(); i++ {
+			field := oldValue.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, inline := jsonFieldName(field)
+			if name == "-" {
+				continue
+			}
+			fieldPath := joinFieldPath(path, name)
+			if inline {
+				fieldPath = path
+			}
+			diffValues(fieldPath, oldValue.Field(i), newValue.Field(i), changes)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if oldValue.Len() != newValue.Len() {
+			*changes = append(*changes, FieldChange{Path: path, Old: oldValue.Interface(), New: newValue.Interface()})
+			return
+		}
+		for i := 0; i < oldValue.Len(); i++ {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), oldValue.Index(i), newValue.Index(i), changes)
+		}
+
+	case reflect.Map:
+		keys := make(map[string]reflect.Value)
+		for _, key := range append(oldValue.MapKeys(), newValue.MapKeys()...) {
+			keys[fmt.Sprint(key.Interface())] = key
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			key := keys[name]
+			diffValues(fmt.Sprintf("%s[%s]", path, name), oldValue.MapIndex(key), newValue.MapIndex(key), changes)
+		}
+
+	default:
+		if !reflect.DeepEqual(oldValue.Interface(), newValue.Interface()) {
+			*changes = append(*changes, FieldChange{Path: path, Old: oldValue.Interface(), New: newValue.Interface()})
+		}
+	}
+}
+
+// jsonFieldName returns the json name of a struct field and whether it is inlined
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" && (field.Anonymous || strings.Contains(options, "inline")) {
+		return "", true
+	}
+	if name == "" {
+		r, size := utf8.DecodeRuneInString(field.Name)
+		name = string(unicode.ToLower(r)) + field.Name[size:]
+	}
+	return name, false
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func valueInterface(v reflect.Value) interface{} {
+	if !v.IsValid() || (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func formatFieldValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "<none>"
+	case string:
+		return fmt.Sprintf("%q", value)
+	case fmt.Stringer:
+		return fmt.Sprintf("%q", value.String())
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return "<none>"
+		}
+		return formatFieldValue(rv.Elem().Interface())
+	}
+	if rv.Kind() == reflect.String {
+		return fmt.Sprintf("%q", rv.String())
+	}
+	return fmt.Sprintf("%+v", v)
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func TestDiffFields(t *testing.T) {
+	oldRef := netguardv1beta1.NamespacedObjectReference{
+		ObjectReference: netguardv1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "Service", Name: "web"},
+		Namespace:       "default",
+	}
+	newRef := oldRef
+	newRef.Name = "api"
+	newRef.Namespace = "team-a"
+
+	changes := DiffFields("serviceRef", oldRef, newRef)
+	assert.Equal(t, []FieldChange{
+		{Path: "serviceRef.name", Old: "web", New: "api"},
+		{Path: "serviceRef.namespace", Old: "default", New: "team-a"},
+	}, changes)
+	assert.Equal(t, `serviceRef.name: "web" -> "api"`, changes[0].String())
+
+	assert.Empty(t, DiffFields("serviceRef", oldRef, oldRef))
+}
+
+func TestDiffFields_NestedCollections(t *testing.T) {
+	type spec struct {
+		Ports  []string
+		Labels map[string]string
+		Owner  *models.ResourceIdentifier
+	}
+	oldSpec := spec{
+		Ports:  []string{"80", "443"},
+		Labels: map[string]string{"app": "web", "tier": "front"},
+	}
+	newSpec := spec{
+		Ports:  []string{"80", "8443"},
+		Labels: map[string]string{"app": "web", "team": "a"},
+		Owner:  &models.ResourceIdentifier{Name: "owner"},
+	}
+
+	changes := DiffFields("", oldSpec, newSpec)
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+	assert.Equal(t, []string{"ports[1]", "labels[team]", "labels[tier]", "owner"}, paths)
+	assert.Equal(t, `owner: <none> -> {Name:owner Namespace:}`, changes[3].String())
+}
+
+func TestBaseValidator_ImmutableFieldError(t *testing.T) {
+	validator := NewBaseValidator(nil, "AddressGroupBinding", nil)
+
+	oldBinding := models.AddressGroupBinding{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier("binding", models.WithNamespace("default"))),
+		ServiceRef: netguardv1beta1.NamespacedObjectReference{
+			ObjectReference: netguardv1beta1.ObjectReference{Kind: "Service", Name: "web"},
+			Namespace:       "default",
+		},
+	}
+	oldBinding.Meta.SetReadyCondition(metav1.ConditionTrue, "Ready", "binding is ready")
+	newBinding := oldBinding
+	newBinding.ServiceRef.Name = "api"
+
+	err := validator.ValidateObjectReferencesNotChangedWhenReady(oldBinding, newBinding, []ObjectReferenceComparison{{
+		OldRef:    &NamespacedObjectReferenceAdapter{Ref: oldBinding.ServiceRef},
+		NewRef:    &NamespacedObjectReferenceAdapter{Ref: newBinding.ServiceRef},
+		FieldName: "serviceRef",
+	}})
+	require.Error(t, err)
+
+	var immutable *ImmutableFieldError
+	require.True(t, errors.As(err, &immutable))
+	assert.Equal(t, "default/binding", immutable.EntityID)
+	assert.Equal(t, []FieldChange{{Path: "serviceRef.name", Old: "web", New: "api"}}, immutable.Changes)
+	assert.Contains(t, err.Error(), "cannot change serviceRef when Ready condition is true")
+	assert.Contains(t, err.Error(), `serviceRef.name: "web" -> "api"`)
+}
@@ -2,6 +2,8 @@ package validation
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 )
 
 // ValidationError represents a generic validation error
@@ -115,3 +117,55 @@ func NewValidationConflictError(entityType, entityID, conflictType, conflictDeta
 		AffectedEntities: affectedEntities,
 	}
 }
+
+// ImmutableFieldError is returned when an update changes fields that must not change
+type ImmutableFieldError struct {
+	EntityType string
+	EntityID   string
+	Reason     string        // Why the fields cannot be changed
+	Changes    []FieldChange // Fields changed by the update
+}
+
+func (e *ImmutableFieldError) Error() string {
+	msg := e.Reason
+	if e.EntityID != "" {
+		msg = fmt.Sprintf("%s %s: %s", e.EntityType, e.EntityID, e.Reason)
+	}
+	if len(e.Changes) == 0 {
+		return msg
+	}
+	changes := make([]string, 0, len(e.Changes))
+	for _, change := range e.Changes {
+		changes = append(changes, change.String())
+	}
+	return fmt.Sprintf("%s (changed fields: %s)", msg, strings.Join(changes, ", "))
+}
+
+// NewImmutableFieldError creates an error reporting fields of path changed from oldValue to newValue
+func NewImmutableFieldError(entityType, entityID, reason, path string, oldValue, newValue interface{}) *ImmutableFieldError {
+	return &ImmutableFieldError{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Reason:     reason,
+		Changes:    DiffFields(path, oldValue, newValue),
+	}
+}
+
+// entityKey returns the key of a domain object passed by value or by pointer, empty if it has none
+func entityKey(obj interface{}) string {
+	type keyer interface{ Key() string }
+	if k, ok := obj.(keyer); ok {
+		return k.Key()
+	}
+	// Key is often declared on the pointer receiver
+	v := reflect.ValueOf(obj)
+	if !v.IsValid() || v.Kind() == reflect.Ptr {
+		return ""
+	}
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	if k, ok := ptr.Interface().(keyer); ok {
+		return k.Key()
+	}
+	return ""
+}
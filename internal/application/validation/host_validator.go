@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"context"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	"github.com/pkg/errors"
+)
+
+// HostValidator validates Host resources
+type HostValidator struct {
+	*BaseValidator
+	reader ports.Reader
+}
+
+// NewHostValidator creates a new host validator
+func NewHostValidator(reader ports.Reader) *HostValidator {
+	return &HostValidator{
+		BaseValidator: NewBaseValidator(reader, "Host", func(ctx context.Context, consume func(entity interface{}) error, scope ports.Scope) error {
+			return reader.ListHosts(ctx, func(host models.Host) error {
+				return consume(&host)
+			}, scope)
+		}),
+		reader: reader,
+	}
+}
+
+// ValidateExists checks if a host exists
+func (v *HostValidator) ValidateExists(ctx context.Context, id models.ResourceIdentifier) error {
+	return v.BaseValidator.ValidateExists(ctx, id, func(entity interface{}) string {
+		return entity.(*models.Host).Key()
+	})
+}
+
+// ValidateUUIDUniqueness validates that no other host is registered for the same agent UUID
+func (v *HostValidator) ValidateUUIDUniqueness(ctx context.Context, host models.Host) error {
+	if host.UUID == "" {
+		return nil
+	}
+
+	var owner string
+	err := v.reader.ListHosts(ctx, func(existing models.Host) error {
+		if existing.UUID == host.UUID && existing.Key() != host.Key() {
+			owner = existing.Key()
+		}
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to check UUID uniqueness for %s", host.UUID)
+	}
+
+	if owner != "" {
+		return errors.Errorf("UUID '%s' is already used by host %s", host.UUID, owner)
+	}
+	return nil
+}
+
+// ValidateForCreation validates a host for creation
+func (v *HostValidator) ValidateForCreation(ctx context.Context, host models.Host) error {
+	if err := CurrentLimits().ValidateName("Host", host.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	keyExtractor := func(entity interface{}) string {
+		if h, ok := entity.(*models.Host); ok {
+			return h.Key()
+		}
+		return ""
+	}
+
+	errs := NewValidationErrors(v.BaseValidator.entityType, host.Key())
+	// An existing host is reported alone with the detailed EntityAlreadyExistsError, its
+	// references are not looked up
+	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, host.ResourceIdentifier, keyExtractor); err != nil {
+		errs.Add("metadata.name", err)
+		return errs.Err()
+	}
+
+	if host.UUID == "" {
+		errs.Add("spec.uuid", errors.New("host UUID cannot be empty"))
+	} else {
+		errs.Add("spec.uuid", v.ValidateUUIDUniqueness(ctx, host))
+	}
+
+	return errs.Err()
+}
+
+// ValidateForUpdate validates a host for update.
+// The UUID identifies the agent in sgroups and can't be changed.
+func (v *HostValidator) ValidateForUpdate(ctx context.Context, oldHost, newHost models.Host) error {
+	errs := NewValidationErrors(v.BaseValidator.entityType, newHost.Key())
+	errs.Add("", v.ValidateExists(ctx, oldHost.ResourceIdentifier))
+
+	if oldHost.Name != newHost.Name || oldHost.Namespace != newHost.Namespace {
+		errs.Add("metadata", NewImmutableFieldError(v.BaseValidator.entityType, oldHost.Key(), "host name and namespace cannot be changed",
+			"", oldHost.ResourceIdentifier, newHost.ResourceIdentifier))
+	}
+
+	if oldHost.UUID != newHost.UUID {
+		errs.Add("spec.uuid", NewImmutableFieldError(v.BaseValidator.entityType, newHost.Key(), "cannot change host UUID after creation",
+			"uuid", oldHost.UUID, newHost.UUID))
+	}
+
+	return errs.Err()
+}
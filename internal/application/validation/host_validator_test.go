@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+func newHostValidatorWithHosts(t *testing.T, hosts ...models.Host) *HostValidator {
+	repo := mem.NewRegistry()
+	ctx := context.Background()
+
+	writer, err := repo.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncHosts(ctx, hosts, ports.EmptyScope{}, ports.WithSyncOp(models.SyncOpUpsert)))
+	require.NoError(t, writer.Commit())
+
+	reader, err := repo.Reader(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { reader.Close() })
+	return NewHostValidator(reader)
+}
+
+func TestHostValidator_ValidateForCreation(t *testing.T) {
+	ctx := context.Background()
+	validator := newHostValidatorWithHosts(t, *models.NewHost("agent-1", "default", "uuid-1"))
+
+	require.NoError(t, validator.ValidateForCreation(ctx, *models.NewHost("agent-2", "default", "uuid-2")))
+
+	assert.Error(t, validator.ValidateForCreation(ctx, *models.NewHost("agent-1", "default", "uuid-3")), "duplicate host")
+
+	err := validator.ValidateForCreation(ctx, *models.NewHost("agent-2", "default", "uuid-1"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "default/agent-1", "UUID is used by another host")
+
+	assert.Error(t, validator.ValidateForCreation(ctx, *models.NewHost("agent-2", "default", "")), "UUID is required")
+}
+
+func TestHostValidator_ValidateForUpdate(t *testing.T) {
+	ctx := context.Background()
+	existing := *models.NewHost("agent-1", "default", "uuid-1")
+	validator := newHostValidatorWithHosts(t, existing)
+
+	updated := existing
+	updated.HostName = "agent-1.example.com"
+	require.NoError(t, validator.ValidateForUpdate(ctx, existing, updated))
+
+	updated.UUID = "uuid-2"
+	var immutableErr *ImmutableFieldError
+	require.ErrorAs(t, validator.ValidateForUpdate(ctx, existing, updated), &immutableErr)
+
+	missing := *models.NewHost("agent-2", "default", "uuid-2")
+	assert.Error(t, validator.ValidateForUpdate(ctx, missing, missing), "host must exist")
+}
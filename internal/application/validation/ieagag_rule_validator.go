@@ -67,6 +67,26 @@ func (v *IEAgAgRuleValidator) ValidatePortSpec(ctx context.Context, portSpec mod
 	return nil
 }
 
+// validateRulePortSpec проверяет спецификацию портов с учетом транспорта правила
+// У ICMP правил вместо портов назначения указываются типы ICMP
+func (v *IEAgAgRuleValidator) validateRulePortSpec(ctx context.Context, transport models.TransportProtocol, portSpec models.PortSpec) error {
+	if transport != "" && !transport.IsValid() {
+		return errors.Errorf("unsupported transport protocol '%s'", transport)
+	}
+
+	if transport.HasPorts() {
+		return v.ValidatePortSpec(ctx, portSpec)
+	}
+
+	if portSpec.Source != "" {
+		return errors.New("ICMP rules cannot have source ports")
+	}
+	if _, err := models.ParseICMPSpec(portSpec.Destination); err != nil {
+		return errors.Wrapf(err, "invalid ICMP types")
+	}
+	return nil
+}
+
 // ValidateForCreation валидирует правило перед созданием
 func (v *IEAgAgRuleValidator) ValidateForCreation(ctx context.Context, rule models.IEAgAgRule) error {
 	// PHASE 1: Check for duplicate entity (CRITICAL FIX for overwrite issue)
@@ -89,7 +109,7 @@ func (v *IEAgAgRuleValidator) ValidateForCreation(ctx context.Context, rule mode
 
 	// PHASE 3: Validate ports (existing validation)
 	for _, port := range rule.Ports {
-		if err := v.ValidatePortSpec(ctx, port); err != nil {
+		if err := v.validateRulePortSpec(ctx, rule.Transport, port); err != nil {
 			return errors.Wrapf(err, "invalid port specification in rule %s", rule.Key())
 		}
 	}
@@ -110,7 +130,7 @@ func (v *IEAgAgRuleValidator) ValidateForPostCommit(ctx context.Context, rule mo
 
 	// PHASE 3: Validate ports (existing validation)
 	for _, port := range rule.Ports {
-		if err := v.ValidatePortSpec(ctx, port); err != nil {
+		if err := v.validateRulePortSpec(ctx, rule.Transport, port); err != nil {
 			return errors.Wrapf(err, "invalid port specification in rule %s", rule.Key())
 		}
 	}
@@ -133,23 +153,28 @@ func (v *IEAgAgRuleValidator) ValidateForUpdate(ctx context.Context, oldRule, ne
 	// Проверяем immutable поля только если правило в состоянии Ready (проверяем ПЕРВЫМИ)
 	if v.isRuleReady(oldRule) {
 		if oldRule.Transport != newRule.Transport {
-			return errors.New("Transport field is immutable when rule is in Ready state")
+			return NewImmutableFieldError(v.entityType, newRule.Key(), "Transport field is immutable when rule is in Ready state",
+				"transport", oldRule.Transport, newRule.Transport)
 		}
 
 		if oldRule.Traffic != newRule.Traffic {
-			return errors.New("Traffic field is immutable when rule is in Ready state")
+			return NewImmutableFieldError(v.entityType, newRule.Key(), "Traffic field is immutable when rule is in Ready state",
+				"traffic", oldRule.Traffic, newRule.Traffic)
 		}
 
 		if oldRule.AddressGroupLocalKey() != newRule.AddressGroupLocalKey() {
-			return errors.New("AddressGroupLocal field is immutable when rule is in Ready state")
+			return NewImmutableFieldError(v.entityType, newRule.Key(), "AddressGroupLocal field is immutable when rule is in Ready state",
+				"addressGroupLocal", oldRule.AddressGroupLocal, newRule.AddressGroupLocal)
 		}
 
 		if oldRule.AddressGroupKey() != newRule.AddressGroupKey() {
-			return errors.New("AddressGroup field is immutable when rule is in Ready state")
+			return NewImmutableFieldError(v.entityType, newRule.Key(), "AddressGroup field is immutable when rule is in Ready state",
+				"addressGroup", oldRule.AddressGroup, newRule.AddressGroup)
 		}
 
 		if oldRule.Action != newRule.Action {
-			return errors.New("Action field is immutable when rule is in Ready state")
+			return NewImmutableFieldError(v.entityType, newRule.Key(), "Action field is immutable when rule is in Ready state",
+				"action", oldRule.Action, newRule.Action)
 		}
 	}
 
@@ -160,7 +185,7 @@ func (v *IEAgAgRuleValidator) ValidateForUpdate(ctx context.Context, oldRule, ne
 
 	// Валидация портов
 	for _, port := range newRule.Ports {
-		if err := v.ValidatePortSpec(ctx, port); err != nil {
+		if err := v.validateRulePortSpec(ctx, newRule.Transport, port); err != nil {
 			return errors.Wrapf(err, "invalid port specification in rule %s", newRule.Key())
 		}
 	}
@@ -195,6 +195,58 @@ func (m *MockReaderForIEAgAgRuleValidator) GetNetworkBindingByID(ctx context.Con
 	return nil, fmt.Errorf("network binding not found")
 }
 
+func (m *MockReaderForIEAgAgRuleValidator) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return nil, validation.NewEntityNotFoundError("RuleS2SException", id.Key())
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return nil, validation.NewEntityNotFoundError("CrossNamespacePolicy", id.Key())
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return nil, validation.NewEntityNotFoundError("RuleTemplate", id.Key())
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return nil, validation.NewEntityNotFoundError("NamespacePosture", id.Key())
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error) {
+	return nil, validation.NewEntityNotFoundError("Host", id.Key())
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error) {
+	return nil, validation.NewEntityNotFoundError("HostBinding", id.Key())
+}
+
+func (m *MockReaderForIEAgAgRuleValidator) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Network, error) {
+	return nil, validation.NewEntityNotFoundError("Network", cidr)
+}
+
 // TestIEAgAgRuleValidator_ValidateExists tests the ValidateExists method of IEAgAgRuleValidator
 func TestIEAgAgRuleValidator_ValidateExists(t *testing.T) {
 	// Create a custom mock reader that returns a rule for the test ID
@@ -240,12 +292,8 @@ func TestIEAgAgRuleValidator_ValidateReferences(t *testing.T) {
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 		},
-		AddressGroupLocal: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-local-ag"),
-		},
-		AddressGroup: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-ag"),
-		},
+		AddressGroupLocal: models.NewAddressGroupRef("test-local-ag"),
+		AddressGroup:      models.NewAddressGroupRef("test-ag"),
 	}
 
 	// Test when all references are valid
@@ -357,14 +405,10 @@ func TestIEAgAgRuleValidator_ValidateForCreation(t *testing.T) {
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 		},
-		Transport: models.TCP,
-		Traffic:   models.INGRESS,
-		AddressGroupLocal: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-local-ag"),
-		},
-		AddressGroup: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-ag"),
-		},
+		Transport:         models.TCP,
+		Traffic:           models.INGRESS,
+		AddressGroupLocal: models.NewAddressGroupRef("test-local-ag"),
+		AddressGroup:      models.NewAddressGroupRef("test-ag"),
 		Ports: []models.PortSpec{
 			{
 				Destination: "80",
@@ -418,14 +462,10 @@ func TestIEAgAgRuleValidator_ValidateForUpdate(t *testing.T) {
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 		},
-		Transport: models.TCP,
-		Traffic:   models.INGRESS,
-		AddressGroupLocal: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-local-ag"),
-		},
-		AddressGroup: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-ag"),
-		},
+		Transport:         models.TCP,
+		Traffic:           models.INGRESS,
+		AddressGroupLocal: models.NewAddressGroupRef("test-local-ag"),
+		AddressGroup:      models.NewAddressGroupRef("test-ag"),
 		Ports: []models.PortSpec{
 			{
 				Destination: "80",
@@ -477,9 +517,7 @@ func TestIEAgAgRuleValidator_ValidateForUpdate(t *testing.T) {
 
 	// Test invalid update (changing address group local)
 	invalidAddressGroupLocalRule := oldRule
-	invalidAddressGroupLocalRule.AddressGroupLocal = models.AddressGroupRef{
-		ResourceIdentifier: models.NewResourceIdentifier("different-local-ag"),
-	}
+	invalidAddressGroupLocalRule.AddressGroupLocal = models.NewAddressGroupRef("different-local-ag")
 	err = validator.ValidateForUpdate(context.Background(), oldRule, invalidAddressGroupLocalRule)
 	if err == nil {
 		t.Error("Expected error for changing address group local, got nil")
@@ -487,9 +525,7 @@ func TestIEAgAgRuleValidator_ValidateForUpdate(t *testing.T) {
 
 	// Test invalid update (changing address group)
 	invalidAddressGroupRule := oldRule
-	invalidAddressGroupRule.AddressGroup = models.AddressGroupRef{
-		ResourceIdentifier: models.NewResourceIdentifier("different-ag"),
-	}
+	invalidAddressGroupRule.AddressGroup = models.NewAddressGroupRef("different-ag")
 	err = validator.ValidateForUpdate(context.Background(), oldRule, invalidAddressGroupRule)
 	if err == nil {
 		t.Error("Expected error for changing address group, got nil")
@@ -521,16 +557,12 @@ func TestIEAgAgRuleValidator_ImmutableFields(t *testing.T) {
 
 	// Create rule in Ready state
 	readyRule := models.IEAgAgRule{
-		SelfRef:   models.NewSelfRef(models.NewResourceIdentifier("test-rule")),
-		Transport: models.TransportProtocol("TCP"),
-		Traffic:   models.Traffic("INGRESS"),
-		AddressGroupLocal: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("local-ag"),
-		},
-		AddressGroup: models.AddressGroupRef{
-			ResourceIdentifier: models.NewResourceIdentifier("target-ag"),
-		},
-		Action: models.ActionAccept,
+		SelfRef:           models.NewSelfRef(models.NewResourceIdentifier("test-rule")),
+		Transport:         models.TransportProtocol("TCP"),
+		Traffic:           models.Traffic("INGRESS"),
+		AddressGroupLocal: models.NewAddressGroupRef("local-ag"),
+		AddressGroup:      models.NewAddressGroupRef("target-ag"),
+		Action:            models.ActionAccept,
 		Ports: []models.PortSpec{
 			{Destination: "80"},
 		},
@@ -570,7 +602,7 @@ func TestIEAgAgRuleValidator_ImmutableFields(t *testing.T) {
 
 	// Test AddressGroupLocal immutable
 	modifiedRule = readyRule
-	modifiedRule.AddressGroupLocal.ResourceIdentifier = models.NewResourceIdentifier("different-ag")
+	modifiedRule.AddressGroupLocal = models.NewAddressGroupRef("different-ag")
 	err = validator.ValidateForUpdate(context.Background(), readyRule, modifiedRule)
 	if err == nil || !strings.Contains(err.Error(), "AddressGroupLocal field is immutable") {
 		t.Errorf("Expected AddressGroupLocal immutable error, got: %v", err)
@@ -2,6 +2,7 @@ package validation_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"netguard-pg-backend/internal/application/validation"
@@ -105,7 +106,7 @@ func TestIntegration_AddressGroupBindingReferences(t *testing.T) {
 		SelfRef: models.SelfRef{ResourceIdentifier: serviceID},
 	}
 
-	addressGroupID := models.NewResourceIdentifier("test-address-group")
+	addressGroupID := models.NewResourceIdentifier("test-address-group", models.WithNamespace("test-ns"))
 	addressGroup := models.AddressGroup{
 		SelfRef: models.SelfRef{ResourceIdentifier: addressGroupID},
 	}
@@ -152,7 +153,7 @@ func TestIntegration_AddressGroupBindingReferences(t *testing.T) {
 		t.Errorf("Expected no error for valid references and matching namespace, got %v", err)
 	}
 
-	// Test ValidateReferences with mismatched namespace
+	// Test a binding from another namespace without an AddressGroupBindingPolicy
 	bindingWithMismatchedNS := models.AddressGroupBinding{
 		SelfRef: models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("test-binding", models.WithNamespace("other-ns"))},
 		ServiceRef: models.NewServiceRef(
@@ -164,9 +165,11 @@ func TestIntegration_AddressGroupBindingReferences(t *testing.T) {
 			models.WithNamespace(addressGroupID.Namespace),
 		),
 	}
-	err = bindingValidator.ValidateReferences(context.Background(), bindingWithMismatchedNS)
+	err = bindingValidator.ValidateForCreation(context.Background(), &bindingWithMismatchedNS)
 	if err == nil {
 		t.Error("Expected error for mismatched namespaces, got nil")
+	} else if !strings.Contains(err.Error(), "cross-namespace binding not allowed") {
+		t.Errorf("Expected cross-namespace policy error, got %v", err)
 	}
 
 	// Test ValidateReferences with invalid service reference
@@ -209,17 +212,17 @@ func TestIntegration_AddressGroupBindingValidateForCreation(t *testing.T) {
 	defer reader.Close()
 
 	// Create test data
-	serviceID := models.NewResourceIdentifier("test-service")
+	serviceID := models.NewResourceIdentifier("test-service", models.WithNamespace("default"))
 	service := models.Service{
 		SelfRef: models.SelfRef{ResourceIdentifier: serviceID},
 	}
 
-	addressGroupID := models.NewResourceIdentifier("test-address-group")
+	addressGroupID := models.NewResourceIdentifier("test-address-group", models.WithNamespace("default"))
 	addressGroup := models.AddressGroup{
 		SelfRef: models.SelfRef{ResourceIdentifier: addressGroupID},
 	}
 
-	bindingID := models.NewResourceIdentifier("test-binding")
+	bindingID := models.NewResourceIdentifier("test-binding", models.WithNamespace("default"))
 	binding := models.AddressGroupBinding{
 		SelfRef: models.SelfRef{ResourceIdentifier: bindingID},
 		ServiceRef: models.NewServiceRef(
@@ -275,3 +278,76 @@ func TestIntegration_AddressGroupBindingValidateForCreation(t *testing.T) {
 		t.Error("Expected error for invalid binding, got nil")
 	}
 }
+
+// TestIntegration_AddressGroupBindingGlobalAddressGroup tests that a binding to a global AddressGroup
+// of another namespace requires an AddressGroupBindingPolicy of the group namespace
+func TestIntegration_AddressGroupBindingGlobalAddressGroup(t *testing.T) {
+	// Arrange
+	registry := mem.NewRegistry()
+	reader, err := registry.Reader(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get reader: %v", err)
+	}
+	defer reader.Close()
+
+	service := models.Service{
+		SelfRef: models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("web", models.WithNamespace("app"))},
+	}
+	addressGroup := models.AddressGroup{
+		SelfRef: models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("shared-ag", models.WithNamespace("infra"))},
+		Meta:    models.Meta{Labels: map[string]string{models.AddressGroupGlobalLabel: "true"}},
+	}
+
+	writer, err := registry.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	err = writer.SyncServices(context.Background(), []models.Service{service}, nil)
+	if err != nil {
+		t.Fatalf("Failed to sync services: %v", err)
+	}
+	err = writer.SyncAddressGroups(context.Background(), []models.AddressGroup{addressGroup}, nil)
+	if err != nil {
+		t.Fatalf("Failed to sync address groups: %v", err)
+	}
+	err = writer.Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	bindingValidator := validation.NewDependencyValidator(reader).GetAddressGroupBindingValidator()
+	binding := models.AddressGroupBinding{
+		SelfRef:         models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("web-shared", models.WithNamespace("app"))},
+		ServiceRef:      models.NewServiceRef("web", models.WithNamespace("app")),
+		AddressGroupRef: models.NewAddressGroupRef("shared-ag", models.WithNamespace("infra")),
+	}
+
+	// Act & Assert
+	err = bindingValidator.ValidateForCreation(context.Background(), &binding)
+	if err == nil || !strings.Contains(err.Error(), "binding to global address group not allowed") {
+		t.Errorf("Expected global address group policy error, got %v", err)
+	}
+
+	writer, err = registry.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	policy := models.AddressGroupBindingPolicy{
+		SelfRef:         models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("allow-web", models.WithNamespace("infra"))},
+		AddressGroupRef: models.NewAddressGroupRef("shared-ag", models.WithNamespace("infra")),
+		ServiceRef:      models.NewServiceRef("web", models.WithNamespace("app")),
+	}
+	err = writer.SyncAddressGroupBindingPolicies(context.Background(), []models.AddressGroupBindingPolicy{policy}, nil)
+	if err != nil {
+		t.Fatalf("Failed to sync address group binding policies: %v", err)
+	}
+	err = writer.Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	err = bindingValidator.ValidateForCreation(context.Background(), &binding)
+	if err != nil {
+		t.Errorf("Expected no error for a binding allowed by a policy, got %v", err)
+	}
+}
@@ -26,16 +26,14 @@ func TestIntegration_RuleS2SValidation(t *testing.T) {
 		SelfRef: models.SelfRef{ResourceIdentifier: serviceID},
 	}
 
-	aliasID1 := models.NewResourceIdentifier("test-alias-1")
-	alias1 := models.ServiceAlias{
-		SelfRef:    models.SelfRef{ResourceIdentifier: aliasID1},
-		ServiceRef: models.NewServiceRef(serviceID.Name, models.WithNamespace(serviceID.Namespace)),
+	localServiceID := models.NewResourceIdentifier("test-local-service")
+	localService := models.Service{
+		SelfRef: models.SelfRef{ResourceIdentifier: localServiceID},
 	}
 
-	aliasID2 := models.NewResourceIdentifier("test-alias-2")
-	alias2 := models.ServiceAlias{
-		SelfRef:    models.SelfRef{ResourceIdentifier: aliasID2},
-		ServiceRef: models.NewServiceRef(serviceID.Name, models.WithNamespace(serviceID.Namespace)),
+	targetServiceID := models.NewResourceIdentifier("test-target-service")
+	targetService := models.Service{
+		SelfRef: models.SelfRef{ResourceIdentifier: targetServiceID},
 	}
 
 	ruleID := models.NewResourceIdentifier("test-rule")
@@ -44,18 +42,18 @@ func TestIntegration_RuleS2SValidation(t *testing.T) {
 		ServiceLocalRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID1.Name,
+				Kind:       "Service",
+				Name:       localServiceID.Name,
 			},
-			Namespace: aliasID1.Namespace,
+			Namespace: localServiceID.Namespace,
 		},
 		ServiceRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID2.Name,
+				Kind:       "Service",
+				Name:       targetServiceID.Name,
 			},
-			Namespace: aliasID2.Namespace,
+			Namespace: targetServiceID.Namespace,
 		},
 	}
 
@@ -64,14 +62,10 @@ func TestIntegration_RuleS2SValidation(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to get writer: %v", err)
 	}
-	err = writer.SyncServices(context.Background(), []models.Service{service}, nil)
+	err = writer.SyncServices(context.Background(), []models.Service{service, localService, targetService}, nil)
 	if err != nil {
 		t.Fatalf("Failed to sync services: %v", err)
 	}
-	err = writer.SyncServiceAliases(context.Background(), []models.ServiceAlias{alias1, alias2}, nil)
-	if err != nil {
-		t.Fatalf("Failed to sync service aliases: %v", err)
-	}
 	err = writer.SyncRuleS2S(context.Background(), []models.RuleS2S{rule}, nil)
 	if err != nil {
 		t.Fatalf("Failed to sync rules: %v", err)
@@ -121,16 +115,14 @@ func TestIntegration_RuleS2SReferences(t *testing.T) {
 		SelfRef: models.SelfRef{ResourceIdentifier: serviceID},
 	}
 
-	aliasID1 := models.NewResourceIdentifier("test-alias-1")
-	alias1 := models.ServiceAlias{
-		SelfRef:    models.SelfRef{ResourceIdentifier: aliasID1},
-		ServiceRef: models.NewServiceRef(serviceID.Name, models.WithNamespace(serviceID.Namespace)),
+	localServiceID := models.NewResourceIdentifier("test-local-service")
+	localService := models.Service{
+		SelfRef: models.SelfRef{ResourceIdentifier: localServiceID},
 	}
 
-	aliasID2 := models.NewResourceIdentifier("test-alias-2")
-	alias2 := models.ServiceAlias{
-		SelfRef:    models.SelfRef{ResourceIdentifier: aliasID2},
-		ServiceRef: models.NewServiceRef(serviceID.Name, models.WithNamespace(serviceID.Namespace)),
+	targetServiceID := models.NewResourceIdentifier("test-target-service")
+	targetService := models.Service{
+		SelfRef: models.SelfRef{ResourceIdentifier: targetServiceID},
 	}
 
 	ruleID := models.NewResourceIdentifier("test-rule")
@@ -139,18 +131,18 @@ func TestIntegration_RuleS2SReferences(t *testing.T) {
 		ServiceLocalRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID1.Name,
+				Kind:       "Service",
+				Name:       localServiceID.Name,
 			},
-			Namespace: aliasID1.Namespace,
+			Namespace: localServiceID.Namespace,
 		},
 		ServiceRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID2.Name,
+				Kind:       "Service",
+				Name:       targetServiceID.Name,
 			},
-			Namespace: aliasID2.Namespace,
+			Namespace: targetServiceID.Namespace,
 		},
 	}
 
@@ -159,14 +151,10 @@ func TestIntegration_RuleS2SReferences(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to get writer: %v", err)
 	}
-	err = writer.SyncServices(context.Background(), []models.Service{service}, nil)
+	err = writer.SyncServices(context.Background(), []models.Service{service, localService, targetService}, nil)
 	if err != nil {
 		t.Fatalf("Failed to sync services: %v", err)
 	}
-	err = writer.SyncServiceAliases(context.Background(), []models.ServiceAlias{alias1, alias2}, nil)
-	if err != nil {
-		t.Fatalf("Failed to sync service aliases: %v", err)
-	}
 	err = writer.Commit()
 	if err != nil {
 		t.Fatalf("Failed to commit: %v", err)
@@ -189,18 +177,18 @@ func TestIntegration_RuleS2SReferences(t *testing.T) {
 		ServiceLocalRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       "non-existent-alias",
+				Kind:       "Service",
+				Name:       "non-existent-service",
 			},
 			Namespace: "default",
 		},
 		ServiceRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID2.Name,
+				Kind:       "Service",
+				Name:       targetServiceID.Name,
 			},
-			Namespace: aliasID2.Namespace,
+			Namespace: targetServiceID.Namespace,
 		},
 	}
 	err = ruleValidator.ValidateReferences(context.Background(), invalidLocalRule)
@@ -214,16 +202,16 @@ func TestIntegration_RuleS2SReferences(t *testing.T) {
 		ServiceLocalRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID1.Name,
+				Kind:       "Service",
+				Name:       localServiceID.Name,
 			},
-			Namespace: aliasID1.Namespace,
+			Namespace: localServiceID.Namespace,
 		},
 		ServiceRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       "non-existent-alias",
+				Kind:       "Service",
+				Name:       "non-existent-service",
 			},
 			Namespace: "default",
 		},
@@ -250,16 +238,14 @@ func TestIntegration_RuleS2SValidateForCreation(t *testing.T) {
 		SelfRef: models.SelfRef{ResourceIdentifier: serviceID},
 	}
 
-	aliasID1 := models.NewResourceIdentifier("test-alias-1")
-	alias1 := models.ServiceAlias{
-		SelfRef:    models.SelfRef{ResourceIdentifier: aliasID1},
-		ServiceRef: models.NewServiceRef(serviceID.Name, models.WithNamespace(serviceID.Namespace)),
+	localServiceID := models.NewResourceIdentifier("test-local-service")
+	localService := models.Service{
+		SelfRef: models.SelfRef{ResourceIdentifier: localServiceID},
 	}
 
-	aliasID2 := models.NewResourceIdentifier("test-alias-2")
-	alias2 := models.ServiceAlias{
-		SelfRef:    models.SelfRef{ResourceIdentifier: aliasID2},
-		ServiceRef: models.NewServiceRef(serviceID.Name, models.WithNamespace(serviceID.Namespace)),
+	targetServiceID := models.NewResourceIdentifier("test-target-service")
+	targetService := models.Service{
+		SelfRef: models.SelfRef{ResourceIdentifier: targetServiceID},
 	}
 
 	ruleID := models.NewResourceIdentifier("test-rule")
@@ -268,18 +254,18 @@ func TestIntegration_RuleS2SValidateForCreation(t *testing.T) {
 		ServiceLocalRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID1.Name,
+				Kind:       "Service",
+				Name:       localServiceID.Name,
 			},
-			Namespace: aliasID1.Namespace,
+			Namespace: localServiceID.Namespace,
 		},
 		ServiceRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID2.Name,
+				Kind:       "Service",
+				Name:       targetServiceID.Name,
 			},
-			Namespace: aliasID2.Namespace,
+			Namespace: targetServiceID.Namespace,
 		},
 	}
 
@@ -288,14 +274,10 @@ func TestIntegration_RuleS2SValidateForCreation(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to get writer: %v", err)
 	}
-	err = writer.SyncServices(context.Background(), []models.Service{service}, nil)
+	err = writer.SyncServices(context.Background(), []models.Service{service, localService, targetService}, nil)
 	if err != nil {
 		t.Fatalf("Failed to sync services: %v", err)
 	}
-	err = writer.SyncServiceAliases(context.Background(), []models.ServiceAlias{alias1, alias2}, nil)
-	if err != nil {
-		t.Fatalf("Failed to sync service aliases: %v", err)
-	}
 	err = writer.Commit()
 	if err != nil {
 		t.Fatalf("Failed to commit: %v", err)
@@ -318,18 +300,18 @@ func TestIntegration_RuleS2SValidateForCreation(t *testing.T) {
 		ServiceLocalRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       "non-existent-alias",
+				Kind:       "Service",
+				Name:       "non-existent-service",
 			},
 			Namespace: "default",
 		},
 		ServiceRef: v1beta1.NamespacedObjectReference{
 			ObjectReference: v1beta1.ObjectReference{
 				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "ServiceAlias",
-				Name:       aliasID2.Name,
+				Kind:       "Service",
+				Name:       targetServiceID.Name,
 			},
-			Namespace: aliasID2.Namespace,
+			Namespace: targetServiceID.Namespace,
 		},
 	}
 	err = ruleValidator.ValidateForCreation(context.Background(), invalidRule)
@@ -179,7 +179,7 @@ func TestIntegration_ServiceAliasValidateForCreation(t *testing.T) {
 	aliasValidator := validator.GetServiceAliasValidator()
 
 	// Act & Assert
-	// Test when namespace is not specified (should be auto-filled)
+	// Test when the alias namespace is not populated by the mutation webhook
 	aliasWithoutNS := &models.ServiceAlias{
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
@@ -188,13 +188,8 @@ func TestIntegration_ServiceAliasValidateForCreation(t *testing.T) {
 	}
 
 	err = aliasValidator.ValidateForCreation(context.Background(), aliasWithoutNS)
-	if err != nil {
-		t.Errorf("Expected no error, got %v", err)
-	}
-
-	// Check that namespace was set from service
-	if aliasWithoutNS.Namespace != "test-ns" {
-		t.Errorf("Expected namespace to be 'test-ns', got '%s'", aliasWithoutNS.Namespace)
+	if err == nil {
+		t.Error("Expected error for alias without namespace, got nil")
 	}
 
 	// Test when namespace is specified and matches service namespace
@@ -226,7 +221,7 @@ func TestIntegration_ServiceAliasValidateForCreation(t *testing.T) {
 	// Test with invalid service reference
 	invalidAlias := &models.ServiceAlias{
 		SelfRef: models.SelfRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-alias-4"),
+			ResourceIdentifier: models.NewResourceIdentifier("test-alias-4", models.WithNamespace("default")),
 		},
 		ServiceRef: models.NewServiceRef("non-existent-service", models.WithNamespace("default")),
 	}
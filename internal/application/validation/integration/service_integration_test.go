@@ -2,6 +2,8 @@ package validation_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"netguard-pg-backend/internal/application/validation"
@@ -141,6 +143,91 @@ func TestIntegration_ServiceDependencies(t *testing.T) {
 	}
 }
 
+// TestIntegration_ServiceDependenciesOfServices tests that aliases and RuleS2S block the
+// deletion of their services in every namespace
+func TestIntegration_ServiceDependenciesOfServices(t *testing.T) {
+	// Arrange
+	registry := mem.NewRegistry()
+	reader, err := registry.Reader(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get reader: %v", err)
+	}
+	defer reader.Close()
+
+	webID := models.NewResourceIdentifier("web", models.WithNamespace("team-a"))
+	apiID := models.NewResourceIdentifier("api", models.WithNamespace("team-b"))
+	services := []models.Service{
+		{SelfRef: models.SelfRef{ResourceIdentifier: webID}},
+		{SelfRef: models.SelfRef{ResourceIdentifier: apiID}},
+		{SelfRef: models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("web", models.WithNamespace("team-b"))}},
+	}
+	aliases := []models.ServiceAlias{
+		{
+			// The service reference namespace defaults to the alias namespace
+			SelfRef:    models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("api-alias", models.WithNamespace("team-b"))},
+			ServiceRef: models.NewServiceRef("api"),
+		},
+		{
+			// An alias of a service with the same name in another namespace
+			SelfRef:    models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("web-alias", models.WithNamespace("team-b"))},
+			ServiceRef: models.NewServiceRef("web", models.WithNamespace("team-b")),
+		},
+	}
+	rules := []models.RuleS2S{
+		{
+			SelfRef:         models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("web-to-api", models.WithNamespace("team-a"))},
+			Traffic:         models.EGRESS,
+			ServiceLocalRef: models.NewServiceRef("web"),
+			ServiceRef:      models.NewServiceRef("api", models.WithNamespace("team-b")),
+		},
+	}
+
+	writer, err := registry.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	if err := writer.SyncServices(context.Background(), services, nil); err != nil {
+		t.Fatalf("Failed to sync services: %v", err)
+	}
+	if err := writer.SyncServiceAliases(context.Background(), aliases, nil); err != nil {
+		t.Fatalf("Failed to sync service aliases: %v", err)
+	}
+	if err := writer.SyncRuleS2S(context.Background(), rules, nil); err != nil {
+		t.Fatalf("Failed to sync rules: %v", err)
+	}
+	if err := writer.Commit(); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	serviceValidator := validation.NewDependencyValidator(reader).GetServiceValidator()
+
+	// Act & Assert
+	// A service with the same name in another namespace isn't referenced
+	otherID := models.NewResourceIdentifier("web", models.WithNamespace("team-c"))
+	if err := serviceValidator.CheckDependenciesOfServices(context.Background(), []models.ResourceIdentifier{otherID}); err != nil {
+		t.Errorf("Expected no error for an unreferenced service, got %v", err)
+	}
+
+	// The local service of a rule is referenced in the rule namespace
+	err = serviceValidator.CheckDependenciesOfServices(context.Background(), []models.ResourceIdentifier{webID})
+	var dependencyErr *validation.DependencyExistsError
+	if !errors.As(err, &dependencyErr) {
+		t.Fatalf("Expected DependencyExistsError, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "team-a/web") || !strings.Contains(err.Error(), "rule_s2s") {
+		t.Errorf("Expected rule_s2s dependency of team-a/web, got %v", err)
+	}
+
+	// The alias of the second service is found in its own namespace
+	err = serviceValidator.CheckDependenciesOfServices(context.Background(), []models.ResourceIdentifier{otherID, apiID})
+	if !errors.As(err, &dependencyErr) {
+		t.Fatalf("Expected DependencyExistsError, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "team-b/api") {
+		t.Errorf("Expected dependency of team-b/api, got %v", err)
+	}
+}
+
 // TestIntegration_ServiceReferences tests the ValidateReferences method of ServiceValidator
 func TestIntegration_ServiceReferences(t *testing.T) {
 	// Arrange
@@ -210,3 +297,149 @@ func TestIntegration_ServiceReferences(t *testing.T) {
 		t.Error("Expected error for invalid references, got nil")
 	}
 }
+
+// TestIntegration_ServiceGlobalAddressGroupReferences tests that a Service uses a global AddressGroup
+// of another namespace only when an AddressGroupBindingPolicy of the group namespace allows it
+func TestIntegration_ServiceGlobalAddressGroupReferences(t *testing.T) {
+	// Arrange
+	registry := mem.NewRegistry()
+	reader, err := registry.Reader(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get reader: %v", err)
+	}
+	defer reader.Close()
+
+	localGroup := models.AddressGroup{
+		SelfRef: models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("local-ag", models.WithNamespace("app"))},
+	}
+	sharedGroup := models.AddressGroup{
+		SelfRef: models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("shared-ag", models.WithNamespace("infra"))},
+		Meta:    models.Meta{Labels: map[string]string{models.AddressGroupGlobalLabel: "true"}},
+	}
+	otherGroup := models.AddressGroup{
+		SelfRef: models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("other-ag", models.WithNamespace("infra"))},
+	}
+
+	writer, err := registry.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	err = writer.SyncAddressGroups(context.Background(), []models.AddressGroup{localGroup, sharedGroup, otherGroup}, nil)
+	if err != nil {
+		t.Fatalf("Failed to sync address groups: %v", err)
+	}
+	err = writer.Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	serviceValidator := validation.NewDependencyValidator(reader).GetServiceValidator()
+	serviceWith := func(agRef models.AddressGroupRef) models.Service {
+		return models.Service{
+			SelfRef:       models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("web", models.WithNamespace("app"))},
+			AddressGroups: []models.AddressGroupRef{agRef},
+		}
+	}
+
+	// Act & Assert
+	// AddressGroups of the service namespace need no policy, with or without an explicit namespace
+	if err := serviceValidator.ValidateReferences(context.Background(), serviceWith(models.NewAddressGroupRef("local-ag"))); err != nil {
+		t.Errorf("Expected no error for a same-namespace reference, got %v", err)
+	}
+	if err := serviceValidator.ValidateReferences(context.Background(), serviceWith(models.NewAddressGroupRef("local-ag", models.WithNamespace("app")))); err != nil {
+		t.Errorf("Expected no error for a same-namespace reference, got %v", err)
+	}
+
+	// Any AddressGroup of another namespace requires a policy, global or not
+	otherRef := models.NewAddressGroupRef("other-ag", models.WithNamespace("infra"))
+	err = serviceValidator.ValidateReferences(context.Background(), serviceWith(otherRef))
+	if err == nil || !strings.Contains(err.Error(), "cannot use address group infra/other-ag of another namespace") {
+		t.Errorf("Expected address group policy error for a non-global AddressGroup, got %v", err)
+	}
+	sharedRef := models.NewAddressGroupRef("shared-ag", models.WithNamespace("infra"))
+	err = serviceValidator.ValidateReferences(context.Background(), serviceWith(sharedRef))
+	if err == nil || !strings.Contains(err.Error(), "cannot use address group infra/shared-ag of another namespace") {
+		t.Errorf("Expected address group policy error for a global AddressGroup, got %v", err)
+	}
+
+	// A policy in another namespace than the AddressGroup does not count
+	writer, err = registry.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	misplacedPolicy := models.AddressGroupBindingPolicy{
+		SelfRef:         models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("allow-web", models.WithNamespace("app"))},
+		AddressGroupRef: sharedRef,
+		ServiceRef:      models.NewServiceRef("web", models.WithNamespace("app")),
+	}
+	err = writer.SyncAddressGroupBindingPolicies(context.Background(), []models.AddressGroupBindingPolicy{misplacedPolicy}, nil)
+	if err != nil {
+		t.Fatalf("Failed to sync address group binding policies: %v", err)
+	}
+	err = writer.Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+	if err := serviceValidator.ValidateReferences(context.Background(), serviceWith(sharedRef)); err == nil {
+		t.Error("Expected error for a policy outside of the AddressGroup namespace, got nil")
+	}
+
+	// A policy of the AddressGroup namespace allows the reference
+	writer, err = registry.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	policy := models.AddressGroupBindingPolicy{
+		SelfRef:         models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("allow-web", models.WithNamespace("infra"))},
+		AddressGroupRef: models.NewAddressGroupRef("shared-ag"),
+		ServiceRef:      models.NewServiceRef("web", models.WithNamespace("app")),
+	}
+	err = writer.SyncAddressGroupBindingPolicies(context.Background(), []models.AddressGroupBindingPolicy{policy}, nil)
+	if err != nil {
+		t.Fatalf("Failed to sync address group binding policies: %v", err)
+	}
+	err = writer.Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+	if err := serviceValidator.ValidateReferences(context.Background(), serviceWith(sharedRef)); err != nil {
+		t.Errorf("Expected no error for a global AddressGroup allowed by a policy, got %v", err)
+	}
+
+	// A policy without a service name allows every Service of its namespace, for global AddressGroups only
+	writer, err = registry.Writer(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	namespacePolicies := []models.AddressGroupBindingPolicy{
+		{
+			SelfRef:         models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("allow-app-shared", models.WithNamespace("infra"))},
+			AddressGroupRef: models.NewAddressGroupRef("shared-ag", models.WithNamespace("infra")),
+			ServiceRef:      models.NewServiceRef("", models.WithNamespace("app")),
+		},
+		{
+			SelfRef:         models.SelfRef{ResourceIdentifier: models.NewResourceIdentifier("allow-app-other", models.WithNamespace("infra"))},
+			AddressGroupRef: otherRef,
+			ServiceRef:      models.NewServiceRef("", models.WithNamespace("app")),
+		},
+	}
+	err = writer.SyncAddressGroupBindingPolicies(context.Background(), namespacePolicies, nil)
+	if err != nil {
+		t.Fatalf("Failed to sync address group binding policies: %v", err)
+	}
+	err = writer.Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+	apiWith := func(agRef models.AddressGroupRef) models.Service {
+		service := serviceWith(agRef)
+		service.Name = "api"
+		return service
+	}
+	if err := serviceValidator.ValidateReferences(context.Background(), apiWith(sharedRef)); err != nil {
+		t.Errorf("Expected no error for a global AddressGroup allowed for the namespace, got %v", err)
+	}
+	if err := serviceValidator.ValidateReferences(context.Background(), apiWith(otherRef)); err == nil {
+		t.Error("Expected error for a non-global AddressGroup allowed only for the namespace, got nil")
+	}
+}
@@ -0,0 +1,164 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// Limits bounds the size of resources and requests, so pathological inputs can't
+// degrade aggregation for everyone. Zero disables a limit.
+type Limits struct {
+	MaxPortsPerService         int
+	MaxAddressGroupsPerService int
+	MaxResourcesPerRequest     int
+	MaxNameLength              int
+}
+
+// DefaultLimits returns limits that comfortably fit real-world configurations
+func DefaultLimits() Limits {
+	return Limits{
+		MaxPortsPerService:         1000,
+		MaxAddressGroupsPerService: 100,
+		MaxResourcesPerRequest:     10000,
+		MaxNameLength:              253,
+	}
+}
+
+var currentLimits atomic.Pointer[Limits]
+
+func init() {
+	limits := DefaultLimits()
+	currentLimits.Store(&limits)
+}
+
+// SetLimits replaces the limits enforced by validators
+func SetLimits(limits Limits) {
+	currentLimits.Store(&limits)
+}
+
+// CurrentLimits returns the limits enforced by validators
+func CurrentLimits() Limits {
+	return *currentLimits.Load()
+}
+
+// LimitExceededError is returned when a resource or request exceeds a configured limit
+type LimitExceededError struct {
+	EntityType string // Empty for limits of the whole request
+	EntityID   string
+	Field      string // Limited field, e.g. "spec.ingressPorts"
+	Limit      int
+	Actual     int
+}
+
+func (e *LimitExceededError) Error() string {
+	if e.EntityType == "" {
+		return fmt.Sprintf("%s exceeds limit: %d > %d", e.Field, e.Actual, e.Limit)
+	}
+	return fmt.Sprintf("%s %s: %s exceeds limit: %d > %d", e.EntityType, e.EntityID, e.Field, e.Actual, e.Limit)
+}
+
+// exceeds reports whether actual is over an enabled limit
+func exceeds(limit, actual int) bool {
+	return limit > 0 && actual > limit
+}
+
+// ValidateRequestSize checks the number of resources in a single request
+func (l Limits) ValidateRequestSize(count int) error {
+	if exceeds(l.MaxResourcesPerRequest, count) {
+		return &LimitExceededError{Field: "resources", Limit: l.MaxResourcesPerRequest, Actual: count}
+	}
+	return nil
+}
+
+// ValidateName checks the name and namespace length of a resource
+func (l Limits) ValidateName(entityType string, id models.ResourceIdentifier) error {
+	if exceeds(l.MaxNameLength, len(id.Name)) {
+		return &LimitExceededError{EntityType: entityType, EntityID: id.Key(), Field: "metadata.name", Limit: l.MaxNameLength, Actual: len(id.Name)}
+	}
+	if exceeds(l.MaxNameLength, len(id.Namespace)) {
+		return &LimitExceededError{EntityType: entityType, EntityID: id.Key(), Field: "metadata.namespace", Limit: l.MaxNameLength, Actual: len(id.Namespace)}
+	}
+	return nil
+}
+
+// ValidateService checks the name, port and address group counts of a service.
+// Ports are counted as port ranges, "80,443,8000-9000" holds three.
+func (l Limits) ValidateService(service models.Service) error {
+	if err := l.ValidateName("Service", service.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	if l.MaxPortsPerService > 0 {
+		ports := 0
+		for _, ingressPort := range service.IngressPorts {
+			ranges, err := ParsePortRanges(ingressPort.Port)
+			if err != nil {
+				// Malformed ports are reported by port validation
+				ports++
+				continue
+			}
+			ports += len(ranges)
+		}
+		if exceeds(l.MaxPortsPerService, ports) {
+			return &LimitExceededError{EntityType: "Service", EntityID: service.Key(), Field: "spec.ingressPorts", Limit: l.MaxPortsPerService, Actual: ports}
+		}
+	}
+
+	if exceeds(l.MaxAddressGroupsPerService, len(service.AddressGroups)) {
+		return &LimitExceededError{EntityType: "Service", EntityID: service.Key(), Field: "spec.addressGroups", Limit: l.MaxAddressGroupsPerService, Actual: len(service.AddressGroups)}
+	}
+	return nil
+}
+
+// ValidateBatch checks the size of a slice of resources passed to Sync and the limits
+// of every resource in it
+func (l Limits) ValidateBatch(resources interface{}) error {
+	items := reflect.ValueOf(resources)
+	if items.Kind() != reflect.Slice {
+		return nil
+	}
+	if err := l.ValidateRequestSize(items.Len()); err != nil {
+		return err
+	}
+
+	for i := 0; i < items.Len(); i++ {
+		switch resource := items.Index(i).Interface().(type) {
+		case models.Service:
+			if err := l.ValidateService(resource); err != nil {
+				return err
+			}
+		default:
+			id, ok := resourceIdentifier(items.Index(i))
+			if !ok {
+				continue
+			}
+			if err := l.ValidateName(items.Index(i).Type().Name(), id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var resourceIdentifierType = reflect.TypeOf(models.ResourceIdentifier{})
+
+// resourceIdentifier returns the identifier embedded in a domain object, if it has one
+func resourceIdentifier(v reflect.Value) (models.ResourceIdentifier, bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return models.ResourceIdentifier{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return models.ResourceIdentifier{}, false
+	}
+	field := v.FieldByName("ResourceIdentifier")
+	if !field.IsValid() || field.Type() != resourceIdentifierType {
+		return models.ResourceIdentifier{}, false
+	}
+	return field.Interface().(models.ResourceIdentifier), true
+}
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+func limitedService(name string, ports []string, addressGroups int) models.Service {
+	service := models.Service{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("default"))),
+	}
+	for _, port := range ports {
+		service.IngressPorts = append(service.IngressPorts, models.IngressPort{Protocol: models.TCP, Port: port})
+	}
+	for i := 0; i < addressGroups; i++ {
+		service.AddressGroups = append(service.AddressGroups, models.NewAddressGroupRef("ag"))
+	}
+	return service
+}
+
+func TestLimits_ValidateService(t *testing.T) {
+	limits := Limits{MaxPortsPerService: 3, MaxAddressGroupsPerService: 2, MaxNameLength: 10}
+
+	require.NoError(t, limits.ValidateService(limitedService("web", []string{"80,443", "8000-9000"}, 2)))
+
+	err := limits.ValidateService(limitedService("web", []string{"80,443", "8000-9000", "22"}, 0))
+	var limitErr *LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "spec.ingressPorts", limitErr.Field)
+	assert.Equal(t, 4, limitErr.Actual)
+	assert.Equal(t, "default/web", limitErr.EntityID)
+
+	err = limits.ValidateService(limitedService("web", nil, 3))
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "spec.addressGroups", limitErr.Field)
+
+	err = limits.ValidateService(limitedService(strings.Repeat("a", 11), nil, 0))
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "metadata.name", limitErr.Field)
+
+	assert.NoError(t, Limits{}.ValidateService(limitedService(strings.Repeat("a", 300), []string{"1,2,3,4,5"}, 10)),
+		"zero limits are disabled")
+}
+
+func TestLimits_ValidateBatch(t *testing.T) {
+	limits := Limits{MaxResourcesPerRequest: 2, MaxNameLength: 10}
+
+	networks := []models.Network{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("net", models.WithNamespace("default")))},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier(strings.Repeat("n", 11), models.WithNamespace("default")))},
+	}
+	err := limits.ValidateBatch(networks)
+	var limitErr *LimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "Network", limitErr.EntityType)
+	assert.Equal(t, "metadata.name", limitErr.Field)
+
+	err = limits.ValidateBatch(append(networks[:1], networks[0], networks[0]))
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "resources", limitErr.Field)
+	assert.Equal(t, 3, limitErr.Actual)
+	assert.Equal(t, "resources exceeds limit: 3 > 2", err.Error())
+
+	assert.NoError(t, limits.ValidateBatch(networks[:1]))
+	assert.NoError(t, limits.ValidateBatch(nil))
+}
@@ -0,0 +1,94 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	"github.com/pkg/errors"
+)
+
+// NamespacePostureValidator validates NamespacePosture resources
+type NamespacePostureValidator struct {
+	*BaseValidator
+	reader ports.Reader
+}
+
+// NewNamespacePostureValidator creates a new namespace posture validator
+func NewNamespacePostureValidator(reader ports.Reader) *NamespacePostureValidator {
+	return &NamespacePostureValidator{
+		BaseValidator: NewBaseValidator(reader, "NamespacePosture", func(ctx context.Context, consume func(entity interface{}) error, scope ports.Scope) error {
+			return reader.ListNamespacePostures(ctx, func(posture models.NamespacePosture) error {
+				return consume(&posture)
+			}, scope)
+		}),
+		reader: reader,
+	}
+}
+
+// ValidateExists checks if a namespace posture exists
+func (v *NamespacePostureValidator) ValidateExists(ctx context.Context, id models.ResourceIdentifier) error {
+	return v.BaseValidator.ValidateExists(ctx, id, func(entity interface{}) string {
+		return entity.(*models.NamespacePosture).Key()
+	})
+}
+
+// ValidateSpec checks that the posture is namespaced and has a known mode
+func (v *NamespacePostureValidator) ValidateSpec(posture models.NamespacePosture) error {
+	if posture.Namespace == "" {
+		return fmt.Errorf("namespace posture %s must be namespaced", posture.Name)
+	}
+	if !posture.Mode.IsValid() {
+		return fmt.Errorf("invalid mode %q in namespace posture %s: must be %s or %s",
+			posture.Mode, posture.Key(), models.PostureDefaultAllow, models.PostureDefaultDeny)
+	}
+	return nil
+}
+
+// ValidateForCreation validates a namespace posture for creation, a namespace has at most one posture
+func (v *NamespacePostureValidator) ValidateForCreation(ctx context.Context, posture models.NamespacePosture) error {
+	if err := CurrentLimits().ValidateName("NamespacePosture", posture.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	keyExtractor := func(entity interface{}) string {
+		if p, ok := entity.(*models.NamespacePosture); ok {
+			return p.Key()
+		}
+		return ""
+	}
+
+	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, posture.ResourceIdentifier, keyExtractor); err != nil {
+		return err
+	}
+
+	if err := v.ValidateSpec(posture); err != nil {
+		return err
+	}
+
+	var existing string
+	err := v.reader.ListNamespacePostures(ctx, func(other models.NamespacePosture) error {
+		if other.Namespace == posture.Namespace && existing == "" {
+			existing = other.Key()
+		}
+		return nil
+	}, ports.NewResourceIdentifierScope(models.NewResourceIdentifier("", models.WithNamespace(posture.Namespace))))
+	if err != nil {
+		return errors.Wrap(err, "failed to list namespace postures")
+	}
+	if existing != "" {
+		return fmt.Errorf("namespace %s already has namespace posture %s", posture.Namespace, existing)
+	}
+	return nil
+}
+
+// ValidateForUpdate validates a namespace posture for update
+func (v *NamespacePostureValidator) ValidateForUpdate(ctx context.Context, oldPosture, newPosture models.NamespacePosture) error {
+	if err := v.ValidateExists(ctx, oldPosture.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	return v.ValidateSpec(newPosture)
+}
@@ -34,6 +34,14 @@ func (v *NetworkBindingValidator) ValidateExists(ctx context.Context, id models.
 
 // ValidateReferences validates that referenced Network and AddressGroup exist
 func (v *NetworkBindingValidator) ValidateReferences(ctx context.Context, binding models.NetworkBinding) error {
+	// References must point to objects of the expected kind and API group
+	if err := ValidateReferenceType(binding.NetworkRef, "Network"); err != nil {
+		return errors.Wrapf(err, "invalid network reference in binding %s", binding.Key())
+	}
+	if err := ValidateReferenceType(binding.AddressGroupRef, "AddressGroup"); err != nil {
+		return errors.Wrapf(err, "invalid address group reference in binding %s", binding.Key())
+	}
+
 	// Validate Network reference
 	networkID := models.ResourceIdentifier{Name: binding.NetworkRef.Name, Namespace: binding.Namespace}
 	network, err := v.reader.GetNetworkByID(ctx, networkID)
@@ -57,6 +65,45 @@ func (v *NetworkBindingValidator) ValidateReferences(ctx context.Context, bindin
 	return nil
 }
 
+// ValidateCIDROverlap validates that the CIDR of the bound network doesn't overlap
+// the networks already bound to the address group
+func (v *NetworkBindingValidator) ValidateCIDROverlap(ctx context.Context, binding models.NetworkBinding) error {
+	networkID := models.ResourceIdentifier{Name: binding.NetworkRef.Name, Namespace: binding.Namespace}
+	network, err := v.reader.GetNetworkByID(ctx, networkID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get network %s", networkID.Key())
+	}
+	addressGroupID := models.ResourceIdentifier{Name: binding.AddressGroupRef.Name, Namespace: binding.Namespace}
+	addressGroup, err := v.reader.GetAddressGroupByID(ctx, addressGroupID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get address group %s", addressGroupID.Key())
+	}
+	if network == nil || addressGroup == nil {
+		return nil // Missing references are reported by ValidateReferences
+	}
+
+	if _, err := models.ParseCIDR(network.CIDR); err != nil {
+		return errors.Wrapf(err, "invalid CIDR of network %s", network.Key())
+	}
+
+	// IPv4 and IPv6 networks never overlap, a group can be bound to both
+	for _, item := range addressGroup.Networks {
+		if item.Name == network.Name && item.Namespace == network.Namespace {
+			continue
+		}
+		overlap, err := models.CIDRsOverlap(network.CIDR, item.CIDR)
+		if err != nil {
+			continue // Malformed items can't be matched and are left to the address group owner
+		}
+		if overlap {
+			return errors.Errorf("CIDR %s of network %s overlaps CIDR %s of network %s in address group %s",
+				network.CIDR, network.Key(), item.CIDR, item.Name, addressGroup.Key())
+		}
+	}
+
+	return nil
+}
+
 // ValidateForCreation validates a network binding for creation
 func (v *NetworkBindingValidator) ValidateForCreation(ctx context.Context, binding models.NetworkBinding) error {
 	// PHASE 1: Check for duplicate entity (CRITICAL FIX for overwrite issue)
@@ -77,7 +124,8 @@ func (v *NetworkBindingValidator) ValidateForCreation(ctx context.Context, bindi
 		return err
 	}
 
-	return nil
+	// PHASE 3: Networks of an address group must not overlap
+	return v.ValidateCIDROverlap(ctx, binding)
 }
 
 // ValidateForPostCommit validates a network binding after it has been committed to database
@@ -108,7 +156,8 @@ func (v *NetworkBindingValidator) ValidateForUpdate(ctx context.Context, oldBind
 
 	// Check if name or namespace changed (should not be allowed)
 	if oldBinding.Name != newBinding.Name || oldBinding.Namespace != newBinding.Namespace {
-		return errors.New("network binding name and namespace cannot be changed")
+		return NewImmutableFieldError(v.BaseValidator.entityType, oldBinding.Key(), "network binding name and namespace cannot be changed",
+			"", oldBinding.ResourceIdentifier, newBinding.ResourceIdentifier)
 	}
 
 	// If network reference changed, validate that the new network is not already bound
@@ -124,6 +173,10 @@ func (v *NetworkBindingValidator) ValidateForUpdate(ctx context.Context, oldBind
 		}
 	}
 
+	if oldBinding.NetworkRef.Name != newBinding.NetworkRef.Name || oldBinding.AddressGroupRef.Name != newBinding.AddressGroupRef.Name {
+		return v.ValidateCIDROverlap(ctx, newBinding)
+	}
+
 	return nil
 }
 
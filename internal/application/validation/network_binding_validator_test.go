@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func newNetworkBindingValidatorWithNetworks(t *testing.T, bound []models.NetworkItem, networks ...models.Network) *NetworkBindingValidator {
+	repo := mem.NewRegistry()
+	ctx := context.Background()
+
+	addressGroup := models.AddressGroup{
+		SelfRef:  models.NewSelfRef(models.NewResourceIdentifier("ag", models.WithNamespace("default"))),
+		Networks: bound,
+	}
+
+	writer, err := repo.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncNetworks(ctx, networks, ports.EmptyScope{}, ports.WithSyncOp(models.SyncOpUpsert)))
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{addressGroup}, ports.EmptyScope{}, ports.WithSyncOp(models.SyncOpUpsert)))
+	require.NoError(t, writer.Commit())
+
+	reader, err := repo.Reader(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { reader.Close() })
+	return NewNetworkBindingValidator(reader)
+}
+
+func newTestNetworkBinding(name, network string) models.NetworkBinding {
+	return *models.NewNetworkBinding(name, "default",
+		v1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "Network", Name: network},
+		v1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "AddressGroup", Name: "ag"})
+}
+
+func TestNetworkBindingValidator_ValidateCIDROverlap(t *testing.T) {
+	ctx := context.Background()
+	validator := newNetworkBindingValidatorWithNetworks(t,
+		[]models.NetworkItem{{Name: "bound", CIDR: "10.0.0.0/16", Namespace: "default"}},
+		*models.NewNetwork("bound", "default", "10.0.0.0/16"),
+		*models.NewNetwork("inner", "default", "10.0.1.0/24"),
+		*models.NewNetwork("outer", "default", "10.0.0.0/8"),
+		*models.NewNetwork("separate", "default", "10.1.0.0/24"),
+		*models.NewNetwork("v6", "default", "fd00::/64"),
+	)
+
+	require.NoError(t, validator.ValidateCIDROverlap(ctx, newTestNetworkBinding("separate", "separate")))
+	require.NoError(t, validator.ValidateCIDROverlap(ctx, newTestNetworkBinding("bound", "bound")), "network doesn't overlap itself")
+	require.NoError(t, validator.ValidateCIDROverlap(ctx, newTestNetworkBinding("v6", "v6")), "dual-stack address group")
+
+	for _, network := range []string{"inner", "outer"} {
+		err := validator.ValidateCIDROverlap(ctx, newTestNetworkBinding(network, network))
+		require.Error(t, err, network)
+		assert.Contains(t, err.Error(), "network bound")
+	}
+
+	assert.Error(t, validator.ValidateForCreation(ctx, newTestNetworkBinding("inner", "inner")))
+}
+
+func TestNetworkBindingValidator_ValidateCIDROverlap_RebindListedNetwork(t *testing.T) {
+	ctx := context.Background()
+	validator := newNetworkBindingValidatorWithNetworks(t,
+		[]models.NetworkItem{
+			{Name: "bound", CIDR: "10.0.0.0/16", Namespace: "default"},
+			{Name: "bound", CIDR: "10.0.0.0/16", Namespace: "other"},
+		},
+		*models.NewNetwork("bound", "default", "10.0.0.0/16"),
+	)
+
+	// The listed item of the network itself is skipped, an item of the same name in another namespace is not
+	err := validator.ValidateCIDROverlap(ctx, newTestNetworkBinding("rebind", "bound"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "network bound in address group")
+}
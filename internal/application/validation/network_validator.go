@@ -2,7 +2,6 @@ package validation
 
 import (
 	"context"
-	"net"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
@@ -41,8 +40,8 @@ func (v *NetworkValidator) ValidateCIDR(cidr string) error {
 		return errors.New("CIDR cannot be empty")
 	}
 
-	_, _, err := net.ParseCIDR(cidr)
-	if err != nil {
+	// IPv4 and IPv6 CIDRs are accepted
+	if _, err := models.ParseCIDR(cidr); err != nil {
 		return errors.Wrapf(err, "invalid CIDR format: %s", cidr)
 	}
 
@@ -51,6 +50,11 @@ func (v *NetworkValidator) ValidateCIDR(cidr string) error {
 
 // ValidateCIDRUniqueness validates that CIDR is unique across all networks
 func (v *NetworkValidator) ValidateCIDRUniqueness(ctx context.Context, cidr string, excludeNetwork *models.ResourceIdentifier) error {
+	// Stored CIDRs are normalized, so "fd00:0::/64" and "fd00::/64" are the same range
+	if normalized, err := models.NormalizeCIDR(cidr); err == nil {
+		cidr = normalized
+	}
+
 	// Search for existing network with the same CIDR
 	existingNetwork, err := v.reader.GetNetworkByCIDR(ctx, cidr)
 	if err != nil {
@@ -83,47 +87,47 @@ func (v *NetworkValidator) ValidateForCreation(ctx context.Context, network mode
 		return ""
 	}
 
+	errs := NewValidationErrors(v.BaseValidator.entityType, network.Key())
+	// An existing network is reported alone with the detailed EntityAlreadyExistsError, its
+	// references are not looked up
 	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, network.ResourceIdentifier, keyExtractor); err != nil {
-		return err // Return the detailed EntityAlreadyExistsError with logging and context
+		errs.Add("metadata.name", err)
+		return errs.Err()
 	}
 
 	// PHASE 2: Validate CIDR format (existing validation)
+	// PHASE 3: Validate CIDR uniqueness, only a valid CIDR can overlap others
 	if err := v.ValidateCIDR(network.CIDR); err != nil {
-		return err
+		errs.Add("spec.cidr", err)
+	} else {
+		errs.Add("spec.cidr", v.ValidateCIDRUniqueness(ctx, network.CIDR, nil))
 	}
 
-	// PHASE 3: Validate CIDR uniqueness
-	if err := v.ValidateCIDRUniqueness(ctx, network.CIDR, nil); err != nil {
-		return err
-	}
-
-	return nil
+	return errs.Err()
 }
 
 // ValidateForUpdate validates a network for update
 func (v *NetworkValidator) ValidateForUpdate(ctx context.Context, oldNetwork, newNetwork models.Network) error {
-	// Validate CIDR format
-	if err := v.ValidateCIDR(newNetwork.CIDR); err != nil {
-		return err
-	}
+	errs := NewValidationErrors(v.BaseValidator.entityType, newNetwork.Key())
 
-	// Validate CIDR uniqueness (exclude current network from check)
-	networkID := &models.ResourceIdentifier{Name: newNetwork.Name, Namespace: newNetwork.Namespace}
-	if err := v.ValidateCIDRUniqueness(ctx, newNetwork.CIDR, networkID); err != nil {
-		return err
+	// Validate CIDR format and uniqueness (exclude current network from check)
+	if err := v.ValidateCIDR(newNetwork.CIDR); err != nil {
+		errs.Add("spec.cidr", err)
+	} else {
+		networkID := &models.ResourceIdentifier{Name: newNetwork.Name, Namespace: newNetwork.Namespace}
+		errs.Add("spec.cidr", v.ValidateCIDRUniqueness(ctx, newNetwork.CIDR, networkID))
 	}
 
 	// Check if network exists
-	if err := v.ValidateExists(ctx, models.ResourceIdentifier{Name: oldNetwork.Name, Namespace: oldNetwork.Namespace}); err != nil {
-		return err
-	}
+	errs.Add("", v.ValidateExists(ctx, models.ResourceIdentifier{Name: oldNetwork.Name, Namespace: oldNetwork.Namespace}))
 
 	// Check if name or namespace changed (should not be allowed)
 	if oldNetwork.Name != newNetwork.Name || oldNetwork.Namespace != newNetwork.Namespace {
-		return errors.New("network name and namespace cannot be changed")
+		errs.Add("metadata", NewImmutableFieldError(v.BaseValidator.entityType, oldNetwork.Key(), "network name and namespace cannot be changed",
+			"", oldNetwork.ResourceIdentifier, newNetwork.ResourceIdentifier))
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // CheckDependencies checks if the network can be deleted
@@ -0,0 +1,172 @@
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// PortConflict is an overlap of a port range of a service with a port range of another
+// service of the same address group, or with another port of the service itself
+type PortConflict struct {
+	// AddressGroup is the key of the address group of the conflict, empty for conflicts
+	// within the ports of the service
+	AddressGroup string
+	Protocol     models.TransportProtocol
+	Service      string
+	// Port is the ingress port of the service the conflicting range was parsed from,
+	// e.g. "80,8000-8100"
+	Port               string
+	Range              models.PortRange
+	ConflictingService string
+	// ConflictingPort is the ingress port of the conflicting range for conflicts within the
+	// service, ranges of other services are only known from the port mapping
+	ConflictingPort  string
+	ConflictingRange models.PortRange
+}
+
+// String describes the conflicting service and port pair
+func (c PortConflict) String() string {
+	if c.ConflictingService == c.Service {
+		return fmt.Sprintf("%s port range %s (port %s) in service %s overlaps with port range %s (port %s)",
+			c.Protocol, FormatPortRange(c.Range), c.Port, c.Service, FormatPortRange(c.ConflictingRange), c.ConflictingPort)
+	}
+	message := fmt.Sprintf("%s port range %d-%d in service %s overlaps with existing port range %d-%d in service %s",
+		c.Protocol, c.Range.Start, c.Range.End, c.Service, c.ConflictingRange.Start, c.ConflictingRange.End, c.ConflictingService)
+	if c.AddressGroup != "" {
+		message += " of address group " + c.AddressGroup
+	}
+	return message
+}
+
+// PortConflictError reports all port conflicts of a service
+type PortConflictError struct {
+	Service   string
+	Conflicts []PortConflict
+}
+
+func (e *PortConflictError) Error() string {
+	if len(e.Conflicts) == 1 {
+		return e.Conflicts[0].String()
+	}
+	conflicts := make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		conflicts = append(conflicts, conflict.String())
+	}
+	return fmt.Sprintf("service %s has %d port conflicts: %s", e.Service, len(e.Conflicts), strings.Join(conflicts, "; "))
+}
+
+// servicePortRange is a port range of an ingress port of a service
+type servicePortRange struct {
+	protocol models.TransportProtocol
+	port     string
+	models.PortRange
+}
+
+// FindPortConflicts returns the conflicts of the ports of a service within themselves and
+// with the ports of the other services of the address groups of the port mappings. Port
+// strings with lists and ranges ("80,8000-8100") are compared range by range of the same
+// protocol, ICMP has no ports. The service itself is skipped in the mappings, so they may
+// already contain its previous ports. An error is returned for invalid ports.
+func FindPortConflicts(service models.Service, portMappings ...models.AddressGroupPortMapping) ([]PortConflict, error) {
+	var ranges []servicePortRange
+	for _, ingressPort := range service.IngressPorts {
+		portRanges, err := ParseProtocolPortRanges(ingressPort.Protocol, ingressPort.Port)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port in service %s: %w", service.Key(), err)
+		}
+		for _, portRange := range portRanges {
+			ranges = append(ranges, servicePortRange{protocol: ingressPort.Protocol, port: ingressPort.Port, PortRange: portRange})
+		}
+	}
+	// Sorted by protocol and start, overlapping ranges of a protocol are adjacent
+	sort.SliceStable(ranges, func(i, j int) bool {
+		if ranges[i].protocol != ranges[j].protocol {
+			return ranges[i].protocol < ranges[j].protocol
+		}
+		return ranges[i].Start < ranges[j].Start
+	})
+
+	var conflicts []PortConflict
+	for i, current := range ranges {
+		for _, next := range ranges[i+1:] {
+			if next.protocol != current.protocol || next.Start > current.End {
+				break
+			}
+			conflicts = append(conflicts, PortConflict{
+				Protocol:           current.protocol,
+				Service:            service.Key(),
+				Port:               current.port,
+				Range:              current.PortRange,
+				ConflictingService: service.Key(),
+				ConflictingPort:    next.port,
+				ConflictingRange:   next.PortRange,
+			})
+		}
+	}
+
+	for _, portMapping := range portMappings {
+		conflicts = append(conflicts, addressGroupPortConflicts(service, ranges, portMapping)...)
+	}
+	return conflicts, nil
+}
+
+// addressGroupPortConflicts returns the conflicts of the port ranges of a service with the
+// other services of a port mapping, ordered by service
+func addressGroupPortConflicts(service models.Service, ranges []servicePortRange, portMapping models.AddressGroupPortMapping) []PortConflict {
+	otherServices := make([]models.ServiceRef, 0, len(portMapping.AccessPorts))
+	for serviceRef := range portMapping.AccessPorts {
+		if serviceRef.Name != service.Name || serviceRef.Namespace != service.Namespace {
+			otherServices = append(otherServices, serviceRef)
+		}
+	}
+	sort.Slice(otherServices, func(i, j int) bool {
+		return serviceRefID(otherServices[i]).Key() < serviceRefID(otherServices[j]).Key()
+	})
+
+	var addressGroup string
+	if portMapping.Name != "" {
+		addressGroup = portMapping.Key()
+	}
+	var conflicts []PortConflict
+	for _, serviceRef := range otherServices {
+		existingPorts := portMapping.AccessPorts[serviceRef].Ports
+		for _, current := range ranges {
+			for _, existingRange := range existingPorts[current.protocol] {
+				if !DoPortRangesOverlap(current.PortRange, existingRange) {
+					continue
+				}
+				conflicts = append(conflicts, PortConflict{
+					AddressGroup:       addressGroup,
+					Protocol:           current.protocol,
+					Service:            service.Key(),
+					Port:               current.port,
+					Range:              current.PortRange,
+					ConflictingService: serviceRefID(serviceRef).Key(),
+					ConflictingRange:   existingRange,
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+// serviceRefID returns the identifier of a port mapping service, its key has no leading
+// separator for references without a namespace
+func serviceRefID(ref models.ServiceRef) models.ResourceIdentifier {
+	return models.NewResourceIdentifier(ref.Name, models.WithNamespace(ref.Namespace))
+}
+
+// portConflictError returns the conflicts of a service as a PortConflictError, nil without
+// conflicts
+func portConflictError(service models.Service, conflicts []PortConflict, err error) error {
+	if err != nil {
+		return err
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return &PortConflictError{Service: service.Key(), Conflicts: conflicts}
+}
@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+func TestFindPortConflicts(t *testing.T) {
+	service := models.Service{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("app"))),
+		IngressPorts: []models.IngressPort{
+			{Protocol: models.TCP, Port: "80,8000-8100"},
+			{Protocol: models.UDP, Port: "53"},
+			{Protocol: models.ICMP, Port: "8"},
+		},
+	}
+	portMapping := models.AddressGroupPortMapping{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier("ag", models.WithNamespace("app"))),
+		AccessPorts: map[models.ServiceRef]models.ServicePorts{
+			// The previous ports of the service itself are not conflicts
+			models.NewServiceRef("web", models.WithNamespace("app")): {Ports: models.ProtocolPorts{
+				models.TCP: {{Start: 80, End: 80}},
+			}},
+			models.NewServiceRef("api", models.WithNamespace("app")): {Ports: models.ProtocolPorts{
+				models.TCP: {{Start: 8080, End: 8080}, {Start: 9000, End: 9000}},
+				models.UDP: {{Start: 80, End: 80}},
+			}},
+			models.NewServiceRef("dns", models.WithNamespace("infra")): {Ports: models.ProtocolPorts{
+				models.UDP: {{Start: 50, End: 60}},
+			}},
+		},
+	}
+
+	conflicts, err := FindPortConflicts(service, portMapping)
+	require.NoError(t, err)
+	assert.Equal(t, []PortConflict{
+		{AddressGroup: "app/ag", Protocol: models.TCP, Service: "app/web", Port: "80,8000-8100",
+			Range: models.PortRange{Start: 8000, End: 8100}, ConflictingService: "app/api",
+			ConflictingRange: models.PortRange{Start: 8080, End: 8080}},
+		{AddressGroup: "app/ag", Protocol: models.UDP, Service: "app/web", Port: "53",
+			Range: models.PortRange{Start: 53, End: 53}, ConflictingService: "infra/dns",
+			ConflictingRange: models.PortRange{Start: 50, End: 60}},
+	}, conflicts)
+
+	err = CheckPortOverlaps(service, portMapping)
+	var conflictErr *PortConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Len(t, conflictErr.Conflicts, 2)
+	assert.Contains(t, err.Error(), "service app/web has 2 port conflicts")
+}
+
+func TestFindPortConflicts_WithinService(t *testing.T) {
+	service := models.Service{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("app"))),
+		IngressPorts: []models.IngressPort{
+			{Protocol: models.TCP, Port: "8000-8100"},
+			{Protocol: models.TCP, Port: "443,8080"},
+			{Protocol: models.UDP, Port: "8080"},
+		},
+	}
+
+	conflicts, err := FindPortConflicts(service)
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "app/web", conflicts[0].ConflictingService)
+	assert.Equal(t, "8000-8100", conflicts[0].Port)
+	assert.Equal(t, "443,8080", conflicts[0].ConflictingPort)
+
+	_, err = FindPortConflicts(models.Service{IngressPorts: []models.IngressPort{{Protocol: models.TCP, Port: "http"}}})
+	assert.ErrorContains(t, err, "invalid port")
+}
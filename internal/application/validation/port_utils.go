@@ -44,12 +44,12 @@ func ParsePortRanges(port string) ([]models.PortRange, error) {
 				return nil, fmt.Errorf("invalid port range format '%s', expected format is 'start-end'", item)
 			}
 
-			start, err := strconv.Atoi(parts[0])
+			start, err := strconv.Atoi(strings.TrimSpace(parts[0]))
 			if err != nil {
 				return nil, fmt.Errorf("invalid start port '%s': must be a number between 0 and 65535", parts[0])
 			}
 
-			end, err := strconv.Atoi(parts[1])
+			end, err := strconv.Atoi(strings.TrimSpace(parts[1]))
 			if err != nil {
 				return nil, fmt.Errorf("invalid end port '%s': must be a number between 0 and 65535", parts[1])
 			}
@@ -93,6 +93,23 @@ func ParsePortRanges(port string) ([]models.PortRange, error) {
 	return result, nil
 }
 
+// ParseProtocolPortRanges преобразует порты протокола в слайс PortRange
+// Для ICMP проверяются типы и коды ICMP ("0,3/4,8"), портов у ICMP нет и возвращается nil
+func ParseProtocolPortRanges(protocol models.TransportProtocol, port string) ([]models.PortRange, error) {
+	if protocol != "" && !protocol.IsValid() {
+		return nil, fmt.Errorf("unsupported transport protocol '%s'", protocol)
+	}
+
+	if !protocol.HasPorts() {
+		if _, err := models.ParseICMPSpec(port); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	return ParsePortRanges(port)
+}
+
 // ParsePortRange преобразует строковое представление порта в PortRange
 // Для обратной совместимости возвращает только первый порт из списка
 func ParsePortRange(port string) (models.PortRange, error) {
@@ -112,6 +129,73 @@ func DoPortRangesOverlap(a, b models.PortRange) bool {
 	return a.Start <= b.End && a.End >= b.Start
 }
 
+// MergePortRanges объединяет перекрывающиеся и смежные диапазоны портов
+// Результат отсортирован по начальному порту, исходный слайс не изменяется
+func MergePortRanges(ranges []models.PortRange) []models.PortRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sortedRanges := make([]models.PortRange, len(ranges))
+	copy(sortedRanges, ranges)
+	sort.Slice(sortedRanges, func(i, j int) bool {
+		if sortedRanges[i].Start != sortedRanges[j].Start {
+			return sortedRanges[i].Start < sortedRanges[j].Start
+		}
+		return sortedRanges[i].End < sortedRanges[j].End
+	})
+
+	merged := []models.PortRange{sortedRanges[0]}
+	for _, portRange := range sortedRanges[1:] {
+		last := &merged[len(merged)-1]
+		// Смежные диапазоны (80-89 и 90-99) тоже объединяются
+		if portRange.Start <= last.End+1 {
+			if portRange.End > last.End {
+				last.End = portRange.End
+			}
+			continue
+		}
+		merged = append(merged, portRange)
+	}
+
+	return merged
+}
+
+// SubtractPortRanges вычитает диапазоны excluded из ranges и возвращает объединенный остаток
+func SubtractPortRanges(ranges, excluded []models.PortRange) []models.PortRange {
+	excluded = MergePortRanges(excluded)
+
+	var result []models.PortRange
+	for _, portRange := range MergePortRanges(ranges) {
+		start := portRange.Start
+		for _, ex := range excluded {
+			if ex.End < start || ex.Start > portRange.End {
+				continue
+			}
+			if ex.Start > start {
+				result = append(result, models.PortRange{Start: start, End: ex.Start - 1})
+			}
+			start = ex.End + 1
+			if start > portRange.End {
+				break
+			}
+		}
+		if start <= portRange.End {
+			result = append(result, models.PortRange{Start: start, End: portRange.End})
+		}
+	}
+
+	return result
+}
+
+// FormatPortRange возвращает строковое представление диапазона портов: "80" или "8000-8100"
+func FormatPortRange(portRange models.PortRange) string {
+	if portRange.Start == portRange.End {
+		return strconv.Itoa(portRange.Start)
+	}
+	return fmt.Sprintf("%d-%d", portRange.Start, portRange.End)
+}
+
 // CheckPortRangeOverlapsOptimized проверяет перекрытие портов с использованием оптимизированного алгоритма сортировки
 // Это реализация паттерна из k8s-controller для улучшения производительности
 func CheckPortRangeOverlapsOptimized(ranges []models.PortRange, protocol string) error {
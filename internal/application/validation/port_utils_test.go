@@ -209,3 +209,117 @@ func TestDoPortRangesOverlap(t *testing.T) {
 		})
 	}
 }
+
+func TestMergePortRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		ranges   []models.PortRange
+		expected []models.PortRange
+	}{
+		{
+			name:     "Empty",
+			ranges:   nil,
+			expected: nil,
+		},
+		{
+			name:     "Duplicate ports",
+			ranges:   []models.PortRange{{Start: 443, End: 443}, {Start: 80, End: 80}, {Start: 443, End: 443}},
+			expected: []models.PortRange{{Start: 80, End: 80}, {Start: 443, End: 443}},
+		},
+		{
+			name:     "Overlapping ranges",
+			ranges:   []models.PortRange{{Start: 8050, End: 8100}, {Start: 8000, End: 8060}, {Start: 8010, End: 8020}},
+			expected: []models.PortRange{{Start: 8000, End: 8100}},
+		},
+		{
+			name:     "Adjacent ranges",
+			ranges:   []models.PortRange{{Start: 80, End: 90}, {Start: 91, End: 100}, {Start: 102, End: 102}},
+			expected: []models.PortRange{{Start: 80, End: 100}, {Start: 102, End: 102}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MergePortRanges(tt.ranges)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected MergePortRanges(%v) = %v, got %v", tt.ranges, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSubtractPortRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		ranges   []models.PortRange
+		excluded []models.PortRange
+		expected []models.PortRange
+	}{
+		{
+			name:     "Nothing excluded",
+			ranges:   []models.PortRange{{Start: 443, End: 443}, {Start: 80, End: 80}},
+			expected: []models.PortRange{{Start: 80, End: 80}, {Start: 443, End: 443}},
+		},
+		{
+			name:     "Whole port excluded",
+			ranges:   []models.PortRange{{Start: 80, End: 80}, {Start: 443, End: 443}},
+			excluded: []models.PortRange{{Start: 80, End: 80}},
+			expected: []models.PortRange{{Start: 443, End: 443}},
+		},
+		{
+			name:     "Range split",
+			ranges:   []models.PortRange{{Start: 8000, End: 8100}},
+			excluded: []models.PortRange{{Start: 8050, End: 8060}, {Start: 8000, End: 8000}},
+			expected: []models.PortRange{{Start: 8001, End: 8049}, {Start: 8061, End: 8100}},
+		},
+		{
+			name:     "Everything excluded",
+			ranges:   []models.PortRange{{Start: 8000, End: 8100}},
+			excluded: []models.PortRange{{Start: 1, End: 65535}},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SubtractPortRanges(tt.ranges, tt.excluded)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected SubtractPortRanges(%v, %v) = %v, got %v", tt.ranges, tt.excluded, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFormatPortRange(t *testing.T) {
+	if result := FormatPortRange(models.PortRange{Start: 80, End: 80}); result != "80" {
+		t.Errorf("Expected '80', got '%s'", result)
+	}
+	if result := FormatPortRange(models.PortRange{Start: 8000, End: 8100}); result != "8000-8100" {
+		t.Errorf("Expected '8000-8100', got '%s'", result)
+	}
+}
+
+func TestParseProtocolPortRanges(t *testing.T) {
+	ranges, err := ParseProtocolPortRanges(models.SCTP, "3868,5000-5010")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ranges) != 2 {
+		t.Errorf("Expected 2 SCTP port ranges, got %v", ranges)
+	}
+
+	ranges, err = ParseProtocolPortRanges(models.ICMP, "0,3/4,8")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ranges != nil {
+		t.Errorf("Expected no port ranges for ICMP, got %v", ranges)
+	}
+
+	if _, err := ParseProtocolPortRanges(models.ICMP, "300"); err == nil {
+		t.Error("Expected error for invalid ICMP type")
+	}
+	if _, err := ParseProtocolPortRanges("GRE", "80"); err == nil {
+		t.Error("Expected error for unsupported protocol")
+	}
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// referenceAPIVersions are the served versions of the netguard API group, references of
+// clients of both versions point to the same objects
+var referenceAPIVersions = []string{
+	netguardv1beta1.SchemeGroupVersion.String(),
+	netguardv1beta1.GroupName + "/v1",
+}
+
+// ReferenceError is a reference to an object of an unexpected kind or API group
+type ReferenceError struct {
+	Name       string
+	Kind       string
+	APIVersion string
+	// Reason says which value is expected, e.g. "kind 'Hosts' must be 'Host'"
+	Reason string
+}
+
+func (e *ReferenceError) Error() string {
+	return fmt.Sprintf("reference %s: %s", e.Name, e.Reason)
+}
+
+// ValidateReferenceType checks that a reference points to an object of one of kinds of the
+// netguard API group. Kind and apiVersion may be empty: clients that only send names rely
+// on the kind of the field, but mismatched values such as a Host of another API group are
+// rejected instead of being resolved by name.
+func ValidateReferenceType(ref netguardv1beta1.ObjectReference, kinds ...string) error {
+	if ref.Kind != "" && !slices.Contains(kinds, ref.Kind) {
+		return &ReferenceError{
+			Name:       ref.Name,
+			Kind:       ref.Kind,
+			APIVersion: ref.APIVersion,
+			Reason:     fmt.Sprintf("kind '%s' must be %s", ref.Kind, quoteAlternatives(kinds)),
+		}
+	}
+
+	if ref.APIVersion == "" || slices.Contains(referenceAPIVersions, ref.APIVersion) {
+		return nil
+	}
+	reason := fmt.Sprintf("apiVersion '%s' must be %s", ref.APIVersion, quoteAlternatives(referenceAPIVersions))
+	if gv, err := schema.ParseGroupVersion(ref.APIVersion); err == nil && gv.Group != netguardv1beta1.GroupName {
+		reason = fmt.Sprintf("apiVersion '%s' must be of API group '%s', e.g. '%s'",
+			ref.APIVersion, netguardv1beta1.GroupName, netguardv1beta1.SchemeGroupVersion.String())
+	}
+	return &ReferenceError{Name: ref.Name, Kind: ref.Kind, APIVersion: ref.APIVersion, Reason: reason}
+}
+
+// quoteAlternatives returns "'A'" or "one of 'A', 'B'"
+func quoteAlternatives(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, "'"+value+"'")
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return "one of " + strings.Join(quoted, ", ")
+}
@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func TestValidateReferenceType(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     netguardv1beta1.ObjectReference
+		kinds   []string
+		wantErr string
+	}{
+		{"Matching", netguardv1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "Host", Name: "h"}, []string{"Host"}, ""},
+		{"ServedVersion", netguardv1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1", Kind: "Host", Name: "h"}, []string{"Host"}, ""},
+		{"NameOnly", netguardv1beta1.ObjectReference{Name: "h"}, []string{"Host"}, ""},
+		{"OneOfKinds", netguardv1beta1.ObjectReference{Kind: "ServiceAlias", Name: "s"}, []string{"Service", "ServiceAlias"}, ""},
+		{"WrongKind", netguardv1beta1.ObjectReference{Kind: "Hosts", Name: "h"}, []string{"Host"},
+			"reference h: kind 'Hosts' must be 'Host'"},
+		{"WrongKinds", netguardv1beta1.ObjectReference{Kind: "AddressGroup", Name: "s"}, []string{"Service", "ServiceAlias"},
+			"kind 'AddressGroup' must be one of 'Service', 'ServiceAlias'"},
+		{"WrongGroup", netguardv1beta1.ObjectReference{APIVersion: "provider.sgroups.io/v1alpha1", Kind: "Host", Name: "h"}, []string{"Host"},
+			"apiVersion 'provider.sgroups.io/v1alpha1' must be of API group 'netguard.sgroups.io', e.g. 'netguard.sgroups.io/v1beta1'"},
+		{"CoreGroup", netguardv1beta1.ObjectReference{APIVersion: "v1", Kind: "Host", Name: "h"}, []string{"Host"},
+			"must be of API group 'netguard.sgroups.io'"},
+		{"UnknownVersion", netguardv1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v2", Kind: "Host", Name: "h"}, []string{"Host"},
+			"apiVersion 'netguard.sgroups.io/v2' must be one of 'netguard.sgroups.io/v1beta1', 'netguard.sgroups.io/v1'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateReferenceType(tt.ref, tt.kinds...)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var refErr *ReferenceError
+			assert.ErrorAs(t, err, &refErr)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
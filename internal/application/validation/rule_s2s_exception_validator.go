@@ -0,0 +1,120 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	"github.com/pkg/errors"
+)
+
+// RuleS2SExceptionValidator validates RuleS2SException resources
+type RuleS2SExceptionValidator struct {
+	*BaseValidator
+	reader ports.Reader
+}
+
+// NewRuleS2SExceptionValidator creates a new RuleS2S exception validator
+func NewRuleS2SExceptionValidator(reader ports.Reader) *RuleS2SExceptionValidator {
+	return &RuleS2SExceptionValidator{
+		BaseValidator: NewBaseValidator(reader, "RuleS2SException", func(ctx context.Context, consume func(entity interface{}) error, scope ports.Scope) error {
+			return reader.ListRuleS2SExceptions(ctx, func(exception models.RuleS2SException) error {
+				return consume(&exception)
+			}, scope)
+		}),
+		reader: reader,
+	}
+}
+
+// ValidateExists checks if a RuleS2S exception exists
+func (v *RuleS2SExceptionValidator) ValidateExists(ctx context.Context, id models.ResourceIdentifier) error {
+	return v.BaseValidator.ValidateExists(ctx, id, func(entity interface{}) string {
+		return entity.(*models.RuleS2SException).Key()
+	})
+}
+
+// ValidateSpec checks traffic, transport and the excluded ports of the exception
+func (v *RuleS2SExceptionValidator) ValidateSpec(exception models.RuleS2SException) error {
+	if exception.Traffic != models.INGRESS && exception.Traffic != models.EGRESS {
+		return fmt.Errorf("invalid traffic %q in rule s2s exception %s: must be %s or %s",
+			exception.Traffic, exception.Key(), models.INGRESS, models.EGRESS)
+	}
+
+	if !exception.Transport.IsValid() {
+		return fmt.Errorf("invalid transport %q in rule s2s exception %s", exception.Transport, exception.Key())
+	}
+
+	if exception.ExcludesAllPorts() {
+		return nil
+	}
+	if _, err := ParseProtocolPortRanges(exception.Transport, exception.Ports); err != nil {
+		return errors.Wrapf(err, "invalid ports %q in rule s2s exception %s", exception.Ports, exception.Key())
+	}
+	return nil
+}
+
+// ValidateReferences checks that both address groups of the exception exist
+func (v *RuleS2SExceptionValidator) ValidateReferences(ctx context.Context, exception models.RuleS2SException) error {
+	addressGroupValidator := NewAddressGroupValidator(v.reader)
+
+	localAGID := models.NewResourceIdentifier(exception.AddressGroupLocal.Name, models.WithNamespace(exception.AddressGroupLocal.Namespace))
+	if err := addressGroupValidator.ValidateExists(ctx, localAGID); err != nil {
+		return errors.Wrapf(err, "invalid local address group reference in rule s2s exception %s", exception.Key())
+	}
+
+	agID := models.NewResourceIdentifier(exception.AddressGroup.Name, models.WithNamespace(exception.AddressGroup.Namespace))
+	if err := addressGroupValidator.ValidateExists(ctx, agID); err != nil {
+		return errors.Wrapf(err, "invalid address group reference in rule s2s exception %s", exception.Key())
+	}
+
+	return nil
+}
+
+// ValidateForCreation validates a RuleS2S exception for creation
+func (v *RuleS2SExceptionValidator) ValidateForCreation(ctx context.Context, exception models.RuleS2SException) error {
+	if err := CurrentLimits().ValidateName("RuleS2SException", exception.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	keyExtractor := func(entity interface{}) string {
+		if e, ok := entity.(*models.RuleS2SException); ok {
+			return e.Key()
+		}
+		return ""
+	}
+
+	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, exception.ResourceIdentifier, keyExtractor); err != nil {
+		return err
+	}
+
+	if err := v.ValidateSpec(exception); err != nil {
+		return err
+	}
+
+	return v.ValidateReferences(ctx, exception)
+}
+
+// ValidateForUpdate validates a RuleS2S exception for update.
+// The address groups and traffic identify the excluded IEAgAg rules and can't be changed.
+func (v *RuleS2SExceptionValidator) ValidateForUpdate(ctx context.Context, oldException, newException models.RuleS2SException) error {
+	if err := v.ValidateExists(ctx, oldException.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	if oldException.Traffic != newException.Traffic {
+		return NewImmutableFieldError(v.BaseValidator.entityType, newException.Key(), "cannot change traffic after creation",
+			"traffic", oldException.Traffic, newException.Traffic)
+	}
+	if oldException.AddressGroupLocalKey() != newException.AddressGroupLocalKey() {
+		return NewImmutableFieldError(v.BaseValidator.entityType, newException.Key(), "cannot change addressGroupLocal after creation",
+			"addressGroupLocal", oldException.AddressGroupLocal, newException.AddressGroupLocal)
+	}
+	if oldException.AddressGroupKey() != newException.AddressGroupKey() {
+		return NewImmutableFieldError(v.BaseValidator.entityType, newException.Key(), "cannot change addressGroup after creation",
+			"addressGroup", oldException.AddressGroup, newException.AddressGroup)
+	}
+
+	return v.ValidateSpec(newException)
+}
@@ -3,6 +3,7 @@ package validation
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"netguard-pg-backend/internal/domain/models"
 	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
@@ -22,6 +23,14 @@ func (v *RuleS2SValidator) ValidateReferences(ctx context.Context, rule models.R
 	localServiceValidator := NewServiceValidator(v.reader)
 	serviceValidator := NewServiceValidator(v.reader)
 
+	// Rules reference services, references of older rules point to their aliases
+	if err := ValidateReferenceType(rule.ServiceLocalRef.ObjectReference, "Service", "ServiceAlias"); err != nil {
+		return errors.Wrapf(err, "invalid service local reference in rule s2s %s", rule.Key())
+	}
+	if err := ValidateReferenceType(rule.ServiceRef.ObjectReference, "Service", "ServiceAlias"); err != nil {
+		return errors.Wrapf(err, "invalid service reference in rule s2s %s", rule.Key())
+	}
+
 	// Create ResourceIdentifier from NamespacedObjectReference
 	localServiceID := models.NewResourceIdentifier(rule.ServiceLocalRef.Name, models.WithNamespace(rule.ServiceLocalRef.Namespace))
 	if err := localServiceValidator.ValidateExists(ctx, localServiceID); err != nil {
@@ -71,6 +80,42 @@ func (v *RuleS2SValidator) ValidateNoDuplicates(ctx context.Context, rule models
 	return nil
 }
 
+// ValidateAction checks that the action of generated IEAgAg rules is ACCEPT or DROP, empty means ACCEPT
+func (v *RuleS2SValidator) ValidateAction(rule models.RuleS2S) error {
+	if rule.Action != "" && !rule.Action.IsValid() {
+		return fmt.Errorf("invalid action %q in rule s2s %s: must be %s or %s",
+			rule.Action, rule.Key(), models.ActionAccept, models.ActionDrop)
+	}
+	return nil
+}
+
+// ValidatePortOverrides checks the ports source and the extra ports of a rule s2s
+func (v *RuleS2SValidator) ValidatePortOverrides(rule models.RuleS2S) error {
+	if !rule.PortsSource.IsValid() {
+		return fmt.Errorf("invalid ports source %q in rule s2s %s: must be %s or %s",
+			rule.PortsSource, rule.Key(), models.PortsSourceLocal, models.PortsSourceTarget)
+	}
+
+	for _, port := range rule.ExtraPorts {
+		if port.Protocol == "" {
+			return fmt.Errorf("protocol of extra port %q in rule s2s %s cannot be empty", port.Port, rule.Key())
+		}
+		if _, err := ParseProtocolPortRanges(port.Protocol, port.Port); err != nil {
+			return errors.Wrapf(err, "invalid extra port %q in rule s2s %s", port.Port, rule.Key())
+		}
+	}
+	return nil
+}
+
+// ValidateValidityWindow checks that validUntil is after validFrom when both are set
+func (v *RuleS2SValidator) ValidateValidityWindow(rule models.RuleS2S) error {
+	if !rule.ValidFrom.IsZero() && !rule.ValidUntil.IsZero() && !rule.ValidUntil.After(rule.ValidFrom) {
+		return fmt.Errorf("validUntil %s must be after validFrom %s in rule s2s %s",
+			rule.ValidUntil.Format(time.RFC3339), rule.ValidFrom.Format(time.RFC3339), rule.Key())
+	}
+	return nil
+}
+
 // ValidateNamespaceRules checks namespace rules for RuleS2S
 func (v *RuleS2SValidator) ValidateNamespaceRules(ctx context.Context, rule models.RuleS2S) error {
 	// 1. Check that ServiceLocalRef is in the same namespace as the rule
@@ -94,6 +139,14 @@ func (v *RuleS2SValidator) ValidateNamespaceRules(ctx context.Context, rule mode
 		}
 	}
 
+	// 3. A ServiceRef in another namespace must be allowed by a CrossNamespacePolicy of that namespace
+	if rule.IsCrossNamespace() {
+		policyValidator := NewCrossNamespacePolicyValidator(v.reader)
+		if err := policyValidator.ValidateReferenceAllowed(ctx, rule.Namespace, rule.ServiceRef.Namespace); err != nil {
+			return errors.Wrapf(err, "invalid cross namespace service reference in rule s2s %s", rule.Key())
+		}
+	}
+
 	return nil
 }
 
@@ -108,26 +161,27 @@ func (v *RuleS2SValidator) ValidateForCreation(ctx context.Context, rule models.
 		return ""
 	}
 
+	errs := NewValidationErrors(v.BaseValidator.entityType, rule.Key())
+	// An existing rule is reported alone with the detailed EntityAlreadyExistsError, its
+	// references are not looked up
 	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, rule.ResourceIdentifier, keyExtractor); err != nil {
-		return err // Return the detailed EntityAlreadyExistsError with logging and context
+		errs.Add("metadata.name", err)
+		return errs.Err()
 	}
 
-	// PHASE 2: Validate namespace rules (existing validation)
-	if err := v.ValidateNamespaceRules(ctx, rule); err != nil {
-		return err
-	}
+	// PHASE 2: Validate action, port overrides, validity window and namespace rules
+	errs.Add("spec.action", v.ValidateAction(rule))
+	errs.Add("spec.ports", v.ValidatePortOverrides(rule))
+	errs.Add("spec.validity", v.ValidateValidityWindow(rule))
+	errs.Add("spec", v.ValidateNamespaceRules(ctx, rule))
 
 	// PHASE 3: Validate references (existing validation)
-	if err := v.ValidateReferences(ctx, rule); err != nil {
-		return err
-	}
+	errs.Add("spec", v.ValidateReferences(ctx, rule))
 
 	// PHASE 4: Check for business logic duplicates (existing validation)
-	if err := v.ValidateNoDuplicates(ctx, rule); err != nil {
-		return err
-	}
+	errs.Add("spec", v.ValidateNoDuplicates(ctx, rule))
 
-	return nil
+	return errs.Err()
 }
 
 // ValidateForPostCommit validates a rule s2s after it has been committed to database
@@ -191,29 +245,47 @@ func (v *RuleS2SValidator) ValidateForUpdate(ctx context.Context, oldRule, newRu
 
 	// Continue with existing validation logic
 
+	errs := NewValidationErrors(v.BaseValidator.entityType, newRule.Key())
+
+	// Ports source and extra ports can change, the IEAgAg rules are regenerated
+	errs.Add("spec.ports", v.ValidatePortOverrides(newRule))
+
+	// The validity window can change, the scheduler picks up the new bounds
+	errs.Add("spec.validity", v.ValidateValidityWindow(newRule))
+
 	// Validate namespace rules
-	if err := v.ValidateNamespaceRules(ctx, newRule); err != nil {
-		return err
-	}
+	errs.Add("spec", v.ValidateNamespaceRules(ctx, newRule))
 
 	// Validate references
-	if err := v.ValidateReferences(ctx, newRule); err != nil {
-		return err
-	}
+	errs.Add("spec", v.ValidateReferences(ctx, newRule))
 
 	// Check that traffic direction hasn't changed (fallback validation)
 	if oldRule.Traffic != newRule.Traffic {
-		return fmt.Errorf("cannot change traffic direction after creation")
+		errs.Add("spec.traffic", NewImmutableFieldError(v.BaseValidator.entityType, newRule.Key(), "cannot change traffic direction after creation",
+			"traffic", oldRule.Traffic, newRule.Traffic))
+	}
+
+	// Generated IEAgAg rules are named by action, so the action can't change after creation
+	if oldRule.EffectiveAction() != newRule.EffectiveAction() {
+		errs.Add("spec.action", NewImmutableFieldError(v.BaseValidator.entityType, newRule.Key(), "cannot change action after creation",
+			"action", oldRule.EffectiveAction(), newRule.EffectiveAction()))
 	}
 
 	// Check that service local reference hasn't changed
 	if oldRule.ServiceLocalRefKey() != newRule.ServiceLocalRefKey() {
-		return fmt.Errorf("cannot change local service reference after creation")
+		errs.Add("spec.serviceLocalRef", NewImmutableFieldError(v.BaseValidator.entityType, newRule.Key(), "cannot change local service reference after creation",
+			"serviceLocalRef", oldRule.ServiceLocalRef, newRule.ServiceLocalRef))
 	}
 
 	// Check that service reference hasn't changed
 	if oldRule.ServiceRefKey() != newRule.ServiceRefKey() {
-		return fmt.Errorf("cannot change target service reference after creation")
+		errs.Add("spec.serviceRef", NewImmutableFieldError(v.BaseValidator.entityType, newRule.Key(), "cannot change target service reference after creation",
+			"serviceRef", oldRule.ServiceRef, newRule.ServiceRef))
+	}
+
+	// Duplicates are only looked up for an otherwise valid rule
+	if err := errs.Err(); err != nil {
+		return err
 	}
 
 	// Check for duplicates if any of the key fields changed
@@ -56,12 +56,8 @@ func TestRuleS2SValidator_ValidateReferences(t *testing.T) {
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 		},
-		ServiceLocalRef: models.ServiceAliasRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-local-alias"),
-		},
-		ServiceRef: models.ServiceAliasRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
-		},
+		ServiceLocalRef: models.NewServiceRef("test-local-alias"),
+		ServiceRef:      models.NewServiceRef("test-alias"),
 	}
 
 	// Test when all references are valid
@@ -101,13 +97,9 @@ func TestRuleS2SValidator_ValidateNoDuplicates(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias"),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias"),
+				ServiceRef:      models.NewServiceRef("test-alias"),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.hasDuplicateRule = false
@@ -120,24 +112,16 @@ func TestRuleS2SValidator_ValidateNoDuplicates(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias"),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias"),
+				ServiceRef:      models.NewServiceRef("test-alias"),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.hasDuplicateRule = true
 				reader.duplicateRuleKey = "duplicate-rule"
 				reader.duplicateRuleTraffic = models.Traffic("ingress")
-				reader.duplicateServiceLocalRef = models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias"),
-				}
-				reader.duplicateServiceRef = models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
-				}
+				reader.duplicateServiceLocalRef = models.NewServiceRef("test-local-alias")
+				reader.duplicateServiceRef = models.NewServiceRef("test-alias")
 			},
 			wantErr: true,
 			errMsg:  "duplicate RuleS2S detected",
@@ -148,13 +132,9 @@ func TestRuleS2SValidator_ValidateNoDuplicates(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias"),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias"),
+				ServiceRef:      models.NewServiceRef("test-alias"),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.listRuleS2SError = fmt.Errorf("database error")
@@ -197,13 +177,9 @@ func TestRuleS2SValidator_ValidateForCreation(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -220,13 +196,9 @@ func TestRuleS2SValidator_ValidateForCreation(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("other-namespace")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("other-namespace")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -244,13 +216,9 @@ func TestRuleS2SValidator_ValidateForCreation(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("non-existent-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("non-existent-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = false
@@ -267,13 +235,9 @@ func TestRuleS2SValidator_ValidateForCreation(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("non-existent-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("non-existent-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -290,13 +254,9 @@ func TestRuleS2SValidator_ValidateForCreation(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -306,12 +266,8 @@ func TestRuleS2SValidator_ValidateForCreation(t *testing.T) {
 				reader.hasDuplicateRule = true
 				reader.duplicateRuleKey = "duplicate-rule"
 				reader.duplicateRuleTraffic = models.Traffic("ingress")
-				reader.duplicateServiceLocalRef = models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				}
-				reader.duplicateServiceRef = models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				}
+				reader.duplicateServiceLocalRef = models.NewServiceRef("test-local-alias", models.WithNamespace("default"))
+				reader.duplicateServiceRef = models.NewServiceRef("test-alias", models.WithNamespace("default"))
 			},
 			wantErr: true,
 			errMsg:  "duplicate RuleS2S detected",
@@ -352,25 +308,17 @@ func TestRuleS2SValidator_ValidateForUpdate(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			newRule: models.RuleS2S{
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -387,25 +335,17 @@ func TestRuleS2SValidator_ValidateForUpdate(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			newRule: models.RuleS2S{
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("other-namespace")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("other-namespace")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -423,25 +363,17 @@ func TestRuleS2SValidator_ValidateForUpdate(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			newRule: models.RuleS2S{
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("non-existent-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("non-existent-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -458,25 +390,17 @@ func TestRuleS2SValidator_ValidateForUpdate(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			newRule: models.RuleS2S{
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("egress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("egress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -494,25 +418,17 @@ func TestRuleS2SValidator_ValidateForUpdate(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("old-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("old-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			newRule: models.RuleS2S{
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("new-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("new-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -530,25 +446,17 @@ func TestRuleS2SValidator_ValidateForUpdate(t *testing.T) {
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("old-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("old-alias", models.WithNamespace("default")),
 			},
 			newRule: models.RuleS2S{
 				SelfRef: models.SelfRef{
 					ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("default")),
 				},
-				Traffic: models.Traffic("ingress"),
-				ServiceLocalRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("default")),
-				},
-				ServiceRef: models.ServiceAliasRef{
-					ResourceIdentifier: models.NewResourceIdentifier("new-alias", models.WithNamespace("default")),
-				},
+				Traffic:         models.Traffic("ingress"),
+				ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("default")),
+				ServiceRef:      models.NewServiceRef("new-alias", models.WithNamespace("default")),
 			},
 			setupMocks: func(reader *MockReaderForRuleS2SValidator) {
 				reader.serviceLocalAliasExists = true
@@ -596,12 +504,8 @@ func TestRuleS2SValidator_ValidateNamespaceRules(t *testing.T) {
 			SelfRef: models.SelfRef{
 				ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("namespace1")),
 			},
-			ServiceLocalRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("namespace2")),
-			},
-			ServiceRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
-			},
+			ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("namespace2")),
+			ServiceRef:      models.NewServiceRef("test-alias"),
 		}
 
 		err := validator.ValidateNamespaceRules(context.Background(), rule)
@@ -626,12 +530,8 @@ func TestRuleS2SValidator_ValidateNamespaceRules(t *testing.T) {
 			SelfRef: models.SelfRef{
 				ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("namespace1")),
 			},
-			ServiceLocalRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("namespace1")),
-			},
-			ServiceRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier("test-alias"), // No namespace
-			},
+			ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("namespace1")),
+			ServiceRef:      models.NewServiceRef("test-alias"), // No namespace
 		}
 
 		err := validator.ValidateNamespaceRules(context.Background(), rule)
@@ -654,6 +554,12 @@ func TestRuleS2SValidator_ValidateNamespaceRules(t *testing.T) {
 			serviceLocalAliasID:     "test-local-alias",
 			serviceAliasExists:      true,
 			serviceAliasID:          "test-alias",
+			crossNamespacePolicies: []models.CrossNamespacePolicy{
+				{
+					SelfRef:           models.NewSelfRef(models.NewResourceIdentifier("allow-namespace1", models.WithNamespace("namespace2"))),
+					AllowedNamespaces: []string{"namespace1"},
+				},
+			},
 		}
 
 		validator := validation.NewRuleS2SValidator(mockReader)
@@ -661,12 +567,8 @@ func TestRuleS2SValidator_ValidateNamespaceRules(t *testing.T) {
 			SelfRef: models.SelfRef{
 				ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("namespace1")),
 			},
-			ServiceLocalRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier("test-local-alias", models.WithNamespace("namespace1")),
-			},
-			ServiceRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("namespace2")),
-			},
+			ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("namespace1")),
+			ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("namespace2")),
 		}
 
 		err := validator.ValidateNamespaceRules(context.Background(), rule)
@@ -674,6 +576,30 @@ func TestRuleS2SValidator_ValidateNamespaceRules(t *testing.T) {
 			t.Errorf("Expected no error for valid namespaces, got %v", err)
 		}
 	})
+
+	// Test case 4: Cross namespace reference not allowed by a CrossNamespacePolicy
+	t.Run("Cross namespace reference without policy", func(t *testing.T) {
+		mockReader := &MockReaderForRuleS2SValidator{
+			serviceLocalAliasExists: true,
+			serviceLocalAliasID:     "test-local-alias",
+			serviceAliasExists:      true,
+			serviceAliasID:          "test-alias",
+		}
+
+		validator := validation.NewRuleS2SValidator(mockReader)
+		rule := models.RuleS2S{
+			SelfRef: models.SelfRef{
+				ResourceIdentifier: models.NewResourceIdentifier("test-rule", models.WithNamespace("namespace1")),
+			},
+			ServiceLocalRef: models.NewServiceRef("test-local-alias", models.WithNamespace("namespace1")),
+			ServiceRef:      models.NewServiceRef("test-alias", models.WithNamespace("namespace2")),
+		}
+
+		err := validator.ValidateNamespaceRules(context.Background(), rule)
+		if err == nil || !strings.Contains(err.Error(), "no CrossNamespacePolicy") {
+			t.Errorf("Expected CrossNamespacePolicy error, got %v", err)
+		}
+	})
 }
 
 // MockReaderForRuleS2SValidator is a specialized mock for testing RuleS2SValidator
@@ -690,9 +616,12 @@ type MockReaderForRuleS2SValidator struct {
 	hasDuplicateRule         bool
 	duplicateRuleKey         string
 	duplicateRuleTraffic     models.Traffic
-	duplicateServiceLocalRef models.ServiceAliasRef
-	duplicateServiceRef      models.ServiceAliasRef
+	duplicateServiceLocalRef models.ServiceRef
+	duplicateServiceRef      models.ServiceRef
 	listRuleS2SError         error
+
+	// For cross namespace references
+	crossNamespacePolicies []models.CrossNamespacePolicy
 }
 
 func (m *MockReaderForRuleS2SValidator) ListAddressGroupBindingPolicies(ctx context.Context, consume func(models.AddressGroupBindingPolicy) error, scope ports.Scope) error {
@@ -718,6 +647,14 @@ func (m *MockReaderForRuleS2SValidator) Close() error {
 }
 
 func (m *MockReaderForRuleS2SValidator) ListServices(ctx context.Context, consume func(models.Service) error, scope ports.Scope) error {
+	// Rules reference services, the configured (alias) references resolve to services as well
+	for _, id := range m.scopeIdentifiers(scope) {
+		if resolved, ok := m.resolveServiceRef(id); ok {
+			if err := consume(models.Service{SelfRef: models.NewSelfRef(resolved)}); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -770,54 +707,57 @@ func (m *MockReaderForRuleS2SValidator) ListRuleS2S(ctx context.Context, consume
 }
 
 func (m *MockReaderForRuleS2SValidator) ListServiceAliases(ctx context.Context, consume func(models.ServiceAlias) error, scope ports.Scope) error {
-	// Check if we're looking for specific service aliases
-	if scope != nil && !scope.IsEmpty() {
-		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
-			for _, id := range ris.Identifiers {
-				// Check for service local alias
-				if m.serviceLocalAliasExists && (id.Key() == m.serviceLocalAliasID ||
-					(id.Name == m.serviceLocalAliasID && (id.Namespace == "" || id.Namespace == "default"))) {
-					alias := models.ServiceAlias{
-						SelfRef: models.SelfRef{
-							ResourceIdentifier: id,
-						},
-					}
-					if err := consume(alias); err != nil {
-						return err
-					}
-				}
-
-				// Check for service alias
-				if m.serviceAliasExists && (id.Key() == m.serviceAliasID ||
-					(id.Name == m.serviceAliasID && (id.Namespace == "" || id.Namespace == m.serviceAliasNamespace || m.serviceAliasNamespace == ""))) {
-					namespace := id.Namespace
-					if namespace == "" && m.serviceAliasNamespace != "" {
-						namespace = m.serviceAliasNamespace
-					}
-					alias := models.ServiceAlias{
-						SelfRef: models.SelfRef{
-							ResourceIdentifier: models.ResourceIdentifier{
-								Name:      id.Name,
-								Namespace: namespace,
-							},
-						},
-					}
-					if err := consume(alias); err != nil {
-						return err
-					}
-				}
+	for _, id := range m.scopeIdentifiers(scope) {
+		if resolved, ok := m.resolveServiceRef(id); ok {
+			if err := consume(models.ServiceAlias{SelfRef: models.NewSelfRef(resolved)}); err != nil {
+				return err
 			}
 		}
 	}
 	return nil
 }
 
+// scopeIdentifiers returns the identifiers of a ResourceIdentifierScope, nothing is listed for other scopes
+func (m *MockReaderForRuleS2SValidator) scopeIdentifiers(scope ports.Scope) []models.ResourceIdentifier {
+	if scope == nil || scope.IsEmpty() {
+		return nil
+	}
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok {
+		return ris.Identifiers
+	}
+	return nil
+}
+
+// resolveServiceRef returns the identifier of the configured local or target service (alias) matching id
+func (m *MockReaderForRuleS2SValidator) resolveServiceRef(id models.ResourceIdentifier) (models.ResourceIdentifier, bool) {
+	// Check for service local alias
+	if m.serviceLocalAliasExists && (id.Key() == m.serviceLocalAliasID ||
+		(id.Name == m.serviceLocalAliasID && (id.Namespace == "" || id.Namespace == "default"))) {
+		return id, true
+	}
+
+	// Check for service alias
+	if m.serviceAliasExists && (id.Key() == m.serviceAliasID ||
+		(id.Name == m.serviceAliasID && (id.Namespace == "" || id.Namespace == m.serviceAliasNamespace || m.serviceAliasNamespace == ""))) {
+		namespace := id.Namespace
+		if namespace == "" && m.serviceAliasNamespace != "" {
+			namespace = m.serviceAliasNamespace
+		}
+		return models.ResourceIdentifier{Name: id.Name, Namespace: namespace}, true
+	}
+
+	return models.ResourceIdentifier{}, false
+}
+
 func (m *MockReaderForRuleS2SValidator) GetSyncStatus(ctx context.Context) (*models.SyncStatus, error) {
 	return nil, nil
 }
 
 func (m *MockReaderForRuleS2SValidator) GetServiceByID(ctx context.Context, id models.ResourceIdentifier) (*models.Service, error) {
-	return nil, fmt.Errorf("service not found")
+	if resolved, ok := m.resolveServiceRef(id); ok {
+		return &models.Service{SelfRef: models.NewSelfRef(resolved)}, nil
+	}
+	return nil, fmt.Errorf("service with id %s not found", id.Key())
 }
 
 func (m *MockReaderForRuleS2SValidator) GetAddressGroupByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
@@ -844,33 +784,9 @@ func (m *MockReaderForRuleS2SValidator) GetRuleS2SByID(ctx context.Context, id m
 }
 
 func (m *MockReaderForRuleS2SValidator) GetServiceAliasByID(ctx context.Context, id models.ResourceIdentifier) (*models.ServiceAlias, error) {
-	// Check for service local alias
-	if m.serviceLocalAliasExists && (id.Key() == m.serviceLocalAliasID ||
-		(id.Name == m.serviceLocalAliasID && (id.Namespace == "" || id.Namespace == "default"))) {
-		return &models.ServiceAlias{
-			SelfRef: models.SelfRef{
-				ResourceIdentifier: id,
-			},
-		}, nil
-	}
-
-	// Check for service alias
-	if m.serviceAliasExists && (id.Key() == m.serviceAliasID ||
-		(id.Name == m.serviceAliasID && (id.Namespace == "" || id.Namespace == m.serviceAliasNamespace || m.serviceAliasNamespace == ""))) {
-		namespace := id.Namespace
-		if namespace == "" && m.serviceAliasNamespace != "" {
-			namespace = m.serviceAliasNamespace
-		}
-		return &models.ServiceAlias{
-			SelfRef: models.SelfRef{
-				ResourceIdentifier: models.ResourceIdentifier{
-					Name:      id.Name,
-					Namespace: namespace,
-				},
-			},
-		}, nil
+	if resolved, ok := m.resolveServiceRef(id); ok {
+		return &models.ServiceAlias{SelfRef: models.NewSelfRef(resolved)}, nil
 	}
-
 	return nil, fmt.Errorf("service alias with id %s not found", id.Key())
 }
 
@@ -889,3 +805,60 @@ func (m *MockReaderForRuleS2SValidator) GetNetworkByID(ctx context.Context, id m
 func (m *MockReaderForRuleS2SValidator) GetNetworkBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.NetworkBinding, error) {
 	return nil, fmt.Errorf("network binding not found")
 }
+
+func (m *MockReaderForRuleS2SValidator) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForRuleS2SValidator) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return nil, validation.NewEntityNotFoundError("RuleS2SException", id.Key())
+}
+
+func (m *MockReaderForRuleS2SValidator) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	for _, policy := range m.crossNamespacePolicies {
+		if err := consume(policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockReaderForRuleS2SValidator) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return nil, validation.NewEntityNotFoundError("CrossNamespacePolicy", id.Key())
+}
+
+func (m *MockReaderForRuleS2SValidator) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForRuleS2SValidator) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return nil, validation.NewEntityNotFoundError("RuleTemplate", id.Key())
+}
+
+func (m *MockReaderForRuleS2SValidator) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForRuleS2SValidator) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return nil, validation.NewEntityNotFoundError("NamespacePosture", id.Key())
+}
+
+func (m *MockReaderForRuleS2SValidator) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForRuleS2SValidator) GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error) {
+	return nil, validation.NewEntityNotFoundError("Host", id.Key())
+}
+
+func (m *MockReaderForRuleS2SValidator) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForRuleS2SValidator) GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error) {
+	return nil, validation.NewEntityNotFoundError("HostBinding", id.Key())
+}
+
+func (m *MockReaderForRuleS2SValidator) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Network, error) {
+	return nil, validation.NewEntityNotFoundError("Network", cidr)
+}
@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// ruleTemplateNameSuffixLength is the length of the "-<hash>" suffix of generated RuleS2S names
+const ruleTemplateNameSuffixLength = 11
+
+// RuleTemplateValidator validates RuleTemplate resources
+type RuleTemplateValidator struct {
+	*BaseValidator
+	reader ports.Reader
+}
+
+// NewRuleTemplateValidator creates a new rule template validator
+func NewRuleTemplateValidator(reader ports.Reader) *RuleTemplateValidator {
+	return &RuleTemplateValidator{
+		BaseValidator: NewBaseValidator(reader, "RuleTemplate", func(ctx context.Context, consume func(entity interface{}) error, scope ports.Scope) error {
+			return reader.ListRuleTemplates(ctx, func(template models.RuleTemplate) error {
+				return consume(&template)
+			}, scope)
+		}),
+		reader: reader,
+	}
+}
+
+// ValidateExists checks if a rule template exists
+func (v *RuleTemplateValidator) ValidateExists(ctx context.Context, id models.ResourceIdentifier) error {
+	return v.BaseValidator.ValidateExists(ctx, id, func(entity interface{}) string {
+		return entity.(*models.RuleTemplate).Key()
+	})
+}
+
+// ValidateSpec checks that the template is namespaced and has traffic, action and both selectors set
+func (v *RuleTemplateValidator) ValidateSpec(template models.RuleTemplate) error {
+	if template.Namespace == "" {
+		return fmt.Errorf("rule template %s must be namespaced", template.Name)
+	}
+
+	if template.Traffic != models.INGRESS && template.Traffic != models.EGRESS {
+		return fmt.Errorf("invalid traffic %q in rule template %s: must be %s or %s",
+			template.Traffic, template.Key(), models.INGRESS, models.EGRESS)
+	}
+
+	if template.Action != "" && template.Action != models.ActionAccept && template.Action != models.ActionDrop {
+		return fmt.Errorf("invalid action %q in rule template %s: must be %s or %s",
+			template.Action, template.Key(), models.ActionAccept, models.ActionDrop)
+	}
+
+	if err := validateServiceSelector(template, "localServiceSelector", template.LocalServiceSelector); err != nil {
+		return err
+	}
+	return validateServiceSelector(template, "targetServiceSelector", template.TargetServiceSelector)
+}
+
+// ValidateForCreation validates a rule template for creation.
+// Names of generated RuleS2S extend the template name, so they must fit the name limit as well.
+func (v *RuleTemplateValidator) ValidateForCreation(ctx context.Context, template models.RuleTemplate) error {
+	if err := CurrentLimits().ValidateName("RuleTemplate", template.ResourceIdentifier); err != nil {
+		return err
+	}
+	generatedID := models.NewResourceIdentifier(template.Name+strings.Repeat("x", ruleTemplateNameSuffixLength),
+		models.WithNamespace(template.Namespace))
+	if err := CurrentLimits().ValidateName("RuleS2S", generatedID); err != nil {
+		return fmt.Errorf("name of rule template %s is too long for the names of generated rules: %w", template.Key(), err)
+	}
+
+	keyExtractor := func(entity interface{}) string {
+		if t, ok := entity.(*models.RuleTemplate); ok {
+			return t.Key()
+		}
+		return ""
+	}
+
+	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, template.ResourceIdentifier, keyExtractor); err != nil {
+		return err
+	}
+
+	return v.ValidateSpec(template)
+}
+
+// ValidateForUpdate validates a rule template for update.
+// Selectors, traffic and action may change, generated rules are reconciled afterwards.
+func (v *RuleTemplateValidator) ValidateForUpdate(ctx context.Context, oldTemplate, newTemplate models.RuleTemplate) error {
+	if err := v.ValidateExists(ctx, oldTemplate.ResourceIdentifier); err != nil {
+		return err
+	}
+
+	return v.ValidateSpec(newTemplate)
+}
+
+// validateServiceSelector checks that a selector of the template has at least one label with a non-empty key
+func validateServiceSelector(template models.RuleTemplate, field string, selector map[string]string) error {
+	if len(selector) == 0 {
+		return fmt.Errorf("%s of rule template %s cannot be empty", field, template.Key())
+	}
+	for key := range selector {
+		if key == "" {
+			return fmt.Errorf("%s of rule template %s cannot contain an empty label key", field, template.Key())
+		}
+	}
+	return nil
+}
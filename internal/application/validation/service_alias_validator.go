@@ -20,6 +20,10 @@ func (v *ServiceAliasValidator) ValidateExists(ctx context.Context, id models.Re
 func (v *ServiceAliasValidator) ValidateReferences(ctx context.Context, alias models.ServiceAlias) error {
 	serviceValidator := NewServiceValidator(v.reader)
 
+	if err := ValidateReferenceType(alias.ServiceRef.ObjectReference, "Service"); err != nil {
+		return errors.Wrapf(err, "invalid service reference in service alias %s", alias.Key())
+	}
+
 	// Create ResourceIdentifier from ServiceRef - namespace should be already populated by mutation webhook
 	serviceID := models.NewResourceIdentifier(alias.ServiceRef.Name, models.WithNamespace(alias.ServiceRef.Namespace))
 	if err := serviceValidator.ValidateExists(ctx, serviceID); err != nil {
@@ -138,7 +142,8 @@ func (v *ServiceAliasValidator) ValidateForUpdate(ctx context.Context, oldAlias,
 
 	// Check that service reference hasn't changed (fallback validation)
 	if oldAlias.ServiceRefKey() != newAlias.ServiceRefKey() {
-		return fmt.Errorf("cannot change service reference after creation")
+		return NewImmutableFieldError(v.BaseValidator.entityType, newAlias.Key(), "cannot change service reference after creation",
+			"serviceRef", oldAlias.ServiceRef, newAlias.ServiceRef)
 	}
 
 	return nil
@@ -56,9 +56,7 @@ func TestServiceAliasValidator_ValidateReferences(t *testing.T) {
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("test-ns")),
 		},
-		ServiceRef: models.ServiceRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-service"),
-		},
+		ServiceRef: models.NewServiceRef("test-service", models.WithNamespace("test-ns")),
 	}
 
 	err := validator.ValidateReferences(context.Background(), alias)
@@ -71,9 +69,7 @@ func TestServiceAliasValidator_ValidateReferences(t *testing.T) {
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("other-ns")),
 		},
-		ServiceRef: models.ServiceRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-service"),
-		},
+		ServiceRef: models.NewServiceRef("test-service", models.WithNamespace("test-ns")),
 	}
 
 	err = validator.ValidateReferences(context.Background(), aliasMismatchedNS)
@@ -100,39 +96,30 @@ func TestServiceAliasValidator_ValidateForCreation(t *testing.T) {
 
 	validator := validation.NewServiceAliasValidator(mockReader)
 
-	// Test when namespace is not specified (should be auto-filled)
-	aliasWithoutNS := &models.ServiceAlias{
+	// Test when the namespaces are populated by the mutation webhook
+	aliasWithNS := &models.ServiceAlias{
 		SelfRef: models.SelfRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
-		},
-		ServiceRef: models.ServiceRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-service"),
+			ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("test-ns")),
 		},
+		ServiceRef: models.NewServiceRef("test-service", models.WithNamespace("test-ns")),
 	}
 
-	err := validator.ValidateForCreation(context.Background(), aliasWithoutNS)
+	err := validator.ValidateForCreation(context.Background(), aliasWithNS)
 	if err != nil {
-		t.Errorf("Expected no error, got %v", err)
-	}
-
-	// Check that namespace was set from service
-	if aliasWithoutNS.Namespace != "test-ns" {
-		t.Errorf("Expected namespace to be 'test-ns', got '%s'", aliasWithoutNS.Namespace)
+		t.Errorf("Expected no error for matching namespaces, got %v", err)
 	}
 
-	// Test when namespace is specified and matches service namespace
-	aliasWithMatchingNS := &models.ServiceAlias{
+	// Test when the alias namespace is not populated
+	aliasWithoutNS := &models.ServiceAlias{
 		SelfRef: models.SelfRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("test-ns")),
-		},
-		ServiceRef: models.ServiceRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-service"),
+			ResourceIdentifier: models.NewResourceIdentifier("test-alias"),
 		},
+		ServiceRef: models.NewServiceRef("test-service", models.WithNamespace("test-ns")),
 	}
 
-	err = validator.ValidateForCreation(context.Background(), aliasWithMatchingNS)
-	if err != nil {
-		t.Errorf("Expected no error for matching namespaces, got %v", err)
+	err = validator.ValidateForCreation(context.Background(), aliasWithoutNS)
+	if err == nil {
+		t.Error("Expected error for alias without namespace, got nil")
 	}
 
 	// Test when namespace is specified but doesn't match service namespace
@@ -140,9 +127,7 @@ func TestServiceAliasValidator_ValidateForCreation(t *testing.T) {
 		SelfRef: models.SelfRef{
 			ResourceIdentifier: models.NewResourceIdentifier("test-alias", models.WithNamespace("other-ns")),
 		},
-		ServiceRef: models.ServiceRef{
-			ResourceIdentifier: models.NewResourceIdentifier("test-service"),
-		},
+		ServiceRef: models.NewServiceRef("test-service", models.WithNamespace("test-ns")),
 	}
 
 	err = validator.ValidateForCreation(context.Background(), aliasWithMismatchedNS)
@@ -152,7 +137,7 @@ func TestServiceAliasValidator_ValidateForCreation(t *testing.T) {
 
 	// Test when service reference is invalid
 	mockReader.serviceExists = false
-	err = validator.ValidateForCreation(context.Background(), aliasWithoutNS)
+	err = validator.ValidateForCreation(context.Background(), aliasWithNS)
 	if err == nil {
 		t.Error("Expected error for invalid service reference, got nil")
 	}
@@ -206,7 +191,7 @@ func (m *MockReaderForServiceAliasValidator) ListServices(ctx context.Context, c
 	if m.serviceExists {
 		service := models.Service{
 			SelfRef: models.SelfRef{
-				ResourceIdentifier: models.NewResourceIdentifier(m.serviceID),
+				ResourceIdentifier: models.NewResourceIdentifier(m.serviceID, models.WithNamespace(m.serviceNamespace)),
 			},
 		}
 		return consume(service)
@@ -232,12 +217,8 @@ func (m *MockReaderForServiceAliasValidator) ListRuleS2S(ctx context.Context, co
 			SelfRef: models.SelfRef{
 				ResourceIdentifier: models.NewResourceIdentifier("test-rule"),
 			},
-			ServiceLocalRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier(m.aliasID),
-			},
-			ServiceRef: models.ServiceAliasRef{
-				ResourceIdentifier: models.NewResourceIdentifier("other-alias"),
-			},
+			ServiceLocalRef: models.NewServiceRef(m.aliasID),
+			ServiceRef:      models.NewServiceRef("other-alias"),
 		}
 		return consume(rule)
 	}
@@ -261,7 +242,7 @@ func (m *MockReaderForServiceAliasValidator) GetSyncStatus(ctx context.Context)
 }
 
 func (m *MockReaderForServiceAliasValidator) GetServiceByID(ctx context.Context, id models.ResourceIdentifier) (*models.Service, error) {
-	if m.serviceExists && id.Key() == m.serviceID {
+	if m.serviceExists && id.Key() == models.NewResourceIdentifier(m.serviceID, models.WithNamespace(m.serviceNamespace)).Key() {
 		return &models.Service{
 			SelfRef: models.SelfRef{
 				ResourceIdentifier: models.NewResourceIdentifier(m.serviceID, models.WithNamespace(m.serviceNamespace)),
@@ -293,9 +274,7 @@ func (m *MockReaderForServiceAliasValidator) GetServiceAliasByID(ctx context.Con
 			SelfRef: models.SelfRef{
 				ResourceIdentifier: models.NewResourceIdentifier(m.aliasID),
 			},
-			ServiceRef: models.ServiceRef{
-				ResourceIdentifier: models.NewResourceIdentifier(m.serviceID),
-			},
+			ServiceRef: models.NewServiceRef(m.serviceID),
 		}, nil
 	}
 	return nil, fmt.Errorf("service alias not found")
@@ -334,3 +313,55 @@ func (m *MockReaderForServiceAliasValidator) GetNetworkByID(ctx context.Context,
 func (m *MockReaderForServiceAliasValidator) GetNetworkBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.NetworkBinding, error) {
 	return nil, fmt.Errorf("network binding not found")
 }
+
+func (m *MockReaderForServiceAliasValidator) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceAliasValidator) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return nil, validation.NewEntityNotFoundError("RuleS2SException", id.Key())
+}
+
+func (m *MockReaderForServiceAliasValidator) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceAliasValidator) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return nil, validation.NewEntityNotFoundError("CrossNamespacePolicy", id.Key())
+}
+
+func (m *MockReaderForServiceAliasValidator) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceAliasValidator) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return nil, validation.NewEntityNotFoundError("RuleTemplate", id.Key())
+}
+
+func (m *MockReaderForServiceAliasValidator) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceAliasValidator) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return nil, validation.NewEntityNotFoundError("NamespacePosture", id.Key())
+}
+
+func (m *MockReaderForServiceAliasValidator) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceAliasValidator) GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error) {
+	return nil, validation.NewEntityNotFoundError("Host", id.Key())
+}
+
+func (m *MockReaderForServiceAliasValidator) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceAliasValidator) GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error) {
+	return nil, validation.NewEntityNotFoundError("HostBinding", id.Key())
+}
+
+func (m *MockReaderForServiceAliasValidator) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Network, error) {
+	return nil, validation.NewEntityNotFoundError("Network", cidr)
+}
@@ -18,85 +18,108 @@ func (v *ServiceValidator) ValidateExists(ctx context.Context, id models.Resourc
 	})
 }
 
-// ValidateReferences checks if all references in a service are valid
+// ValidateReferences checks if all references in a service are valid, every invalid
+// address group reference is reported
 func (v *ServiceValidator) ValidateReferences(ctx context.Context, service models.Service) error {
-	agValidator := NewAddressGroupValidator(v.reader)
+	errs := NewValidationErrors(v.BaseValidator.entityType, service.Key())
+	for i, agRef := range service.AddressGroups {
+		errs.Add(fmt.Sprintf("spec.addressGroups[%d]", i), v.validateAddressGroupReference(ctx, service, agRef))
+	}
+	return errs.Err()
+}
 
-	for _, agRef := range service.AddressGroups {
-		if err := agValidator.ValidateExists(ctx, models.ResourceIdentifier{Name: agRef.Name, Namespace: agRef.Namespace}); err != nil {
-			return errors.Wrapf(err, "invalid address group reference in service %s", service.Key())
-		}
+// validateAddressGroupReference checks that a reference of a service is an AddressGroup
+// reference, that the address group exists and that one of another namespace is allowed
+// by a binding policy
+func (v *ServiceValidator) validateAddressGroupReference(ctx context.Context, service models.Service, agRef models.AddressGroupRef) error {
+	if err := ValidateReferenceType(agRef.ObjectReference, "AddressGroup"); err != nil {
+		return errors.Wrapf(err, "invalid address group reference in service %s", service.Key())
+	}
+	// References without a namespace point to the service namespace
+	agID := models.NewResourceIdentifier(agRef.Name, models.WithNamespace(refNamespace(agRef.Namespace, service.Namespace)))
+	if err := NewAddressGroupValidator(v.reader).ValidateExists(ctx, agID); err != nil {
+		return errors.Wrapf(err, "invalid address group reference in service %s", service.Key())
 	}
 
+	// Address groups of the service namespace need no policy
+	if agID.Namespace == service.Namespace {
+		return nil
+	}
+
+	// Address groups of other namespaces, global or not, are used by a Service only when a policy allows it
+	allowed, err := NewAddressGroupBindingPolicyValidator(v.reader).HasBindingPolicy(ctx, agID, service.ResourceIdentifier)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check binding policy of service %s", service.Key())
+	}
+	if !allowed {
+		return fmt.Errorf("service %s cannot use address group %s of another namespace: no AddressGroupBindingPolicy in namespace %s allows it",
+			service.Key(), agID.Key(), agID.Namespace)
+	}
 	return nil
 }
 
 // ValidateNoDuplicateAddressGroups checks that Service.Spec.AddressGroups contains no duplicate AddressGroups
 func (v *ServiceValidator) ValidateNoDuplicateAddressGroups(addressGroups []models.AddressGroupRef) error {
 	seen := make(map[string]bool)
+	reported := make(map[string]bool)
+	errs := NewValidationErrors(v.BaseValidator.entityType, "")
 
-	for _, ag := range addressGroups {
+	for i, ag := range addressGroups {
 		// Create unique key: namespace/name
 		key := fmt.Sprintf("%s/%s", ag.Namespace, ag.Name)
 
-		if seen[key] {
-			return fmt.Errorf("duplicate AddressGroup in spec.addressGroups: %s", key)
+		// Every duplicated AddressGroup is reported once, at its first repetition
+		if seen[key] && !reported[key] {
+			errs.Add(fmt.Sprintf("spec.addressGroups[%d]", i), fmt.Errorf("duplicate AddressGroup in spec.addressGroups: %s", key))
+			reported[key] = true
 		}
 		seen[key] = true
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // ValidateNoDuplicatePorts проверяет отсутствие дубликатов или перекрытий портов в сервисе
 func (v *ServiceValidator) ValidateNoDuplicatePorts(ingressPorts []models.IngressPort) error {
-	// Создаем слайсы для хранения диапазонов портов по протоколам
-	tcpRanges := []models.PortRange{}
-	udpRanges := []models.PortRange{}
+	// Создаем карту для хранения диапазонов портов по протоколам
+	protocolRanges := make(map[models.TransportProtocol][]models.PortRange)
+	errs := NewValidationErrors(v.BaseValidator.entityType, "")
 
-	for _, port := range ingressPorts {
-		// Парсим строку порта в несколько PortRange
-		portRanges, err := ParsePortRanges(port.Port)
+	for i, port := range ingressPorts {
+		field := fmt.Sprintf("spec.ingressPorts[%d]", i)
+
+		// Парсим строку порта в несколько PortRange (для ICMP проверяются типы ICMP)
+		portRanges, err := ParseProtocolPortRanges(port.Protocol, port.Port)
 		if err != nil {
-			return fmt.Errorf("invalid port %s: %w", port.Port, err)
+			errs.Add(field, fmt.Errorf("invalid port %s: %w", port.Port, err))
+			continue
 		}
 
 		// Проверяем на перекрытия внутри текущего набора портов
 		for i, range1 := range portRanges {
 			for j, range2 := range portRanges {
-				if i != j && DoPortRangesOverlap(range1, range2) {
-					return fmt.Errorf("port conflict detected within port specification: %s port %s has overlapping ranges %d-%d and %d-%d",
-						port.Protocol, port.Port, range1.Start, range1.End, range2.Start, range2.End)
+				if i < j && DoPortRangesOverlap(range1, range2) {
+					errs.Add(field, fmt.Errorf("port conflict detected within port specification: %s port %s has overlapping ranges %d-%d and %d-%d",
+						port.Protocol, port.Port, range1.Start, range1.End, range2.Start, range2.End))
 				}
 			}
 		}
 
 		// Проверяем на перекрытия с существующими портами того же протокола
-		var existingRanges []models.PortRange
-		if port.Protocol == models.TCP {
-			existingRanges = tcpRanges
-		} else if port.Protocol == models.UDP {
-			existingRanges = udpRanges
-		}
-
 		for _, newRange := range portRanges {
-			for _, existingRange := range existingRanges {
+			for _, existingRange := range protocolRanges[port.Protocol] {
 				if DoPortRangesOverlap(newRange, existingRange) {
-					return fmt.Errorf("port conflict detected: %s port range %d-%d overlaps with existing port range %d-%d",
-						port.Protocol, newRange.Start, newRange.End, existingRange.Start, existingRange.End)
+					errs.Add(field, fmt.Errorf("port conflict detected: %s port range %d-%d overlaps with existing port range %d-%d",
+						port.Protocol, newRange.Start, newRange.End, existingRange.Start, existingRange.End))
 				}
 			}
 		}
 
-		// Добавляем эти диапазоны портов в соответствующий слайс
-		if port.Protocol == models.TCP {
-			tcpRanges = append(tcpRanges, portRanges...)
-		} else if port.Protocol == models.UDP {
-			udpRanges = append(udpRanges, portRanges...)
-		}
+		// Добавляем эти диапазоны портов в карту протокола
+		protocolRanges[port.Protocol] = append(protocolRanges[port.Protocol], portRanges...)
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // ValidateWithoutDuplicateCheck validates service without checking for duplicate entity
@@ -104,26 +127,34 @@ func (v *ServiceValidator) ValidateNoDuplicatePorts(ingressPorts []models.Ingres
 // 1. SyncServices - BEFORE commit to catch validation errors early
 // 2. ConditionManager - AFTER commit to set status conditions
 func (v *ServiceValidator) ValidateWithoutDuplicateCheck(ctx context.Context, service models.Service) error {
+	// PHASE 0: Validate size limits before the expensive checks
+	if err := CurrentLimits().ValidateService(service); err != nil {
+		return err
+	}
+
 	// PHASE 1: Skip duplicate entity check
 	// For SyncServices: entity may not exist yet or may be updating
 	// For ConditionManager: entity already committed to database
 
+	errs := NewValidationErrors(v.BaseValidator.entityType, service.Key())
+
 	// PHASE 2: Validate references (existing validation)
-	if err := v.ValidateReferences(ctx, service); err != nil {
-		return err
-	}
+	errs.Add("spec.addressGroups", v.ValidateReferences(ctx, service))
 
-	// PHASE 3: Validate internal port consistency (existing validation)
-	if err := v.ValidateNoDuplicatePorts(service.IngressPorts); err != nil {
-		return err
-	}
+	// PHASE 3-4: Validate internal port consistency and port conflicts with other services
+	v.validatePorts(ctx, errs, service)
 
-	// PHASE 4: Validate port conflicts with other services (CRITICAL)
-	if err := v.CheckPortOverlaps(ctx, service); err != nil {
-		return err
-	}
+	return errs.Err()
+}
 
-	return nil
+// validatePorts adds violations of the ingress ports of a service. Conflicts with ports of
+// other services are only checked for valid ports, invalid ones would be reported twice.
+func (v *ServiceValidator) validatePorts(ctx context.Context, errs *ValidationErrors, service models.Service) {
+	if err := v.ValidateNoDuplicatePorts(service.IngressPorts); err != nil {
+		errs.Add("spec.ingressPorts", err)
+		return
+	}
+	errs.Add("spec.ingressPorts", v.CheckPortOverlaps(ctx, service))
 }
 
 // ValidateForPostCommit is deprecated, use ValidateWithoutDuplicateCheck instead
@@ -133,70 +164,49 @@ func (v *ServiceValidator) ValidateForPostCommit(ctx context.Context, service mo
 }
 
 // CheckPortOverlaps проверяет перекрытие портов между сервисом и существующими сервисами в AddressGroup
+// из spec.addressGroups
 func (v *ServiceValidator) CheckPortOverlaps(ctx context.Context, service models.Service) error {
-	// Для каждой AddressGroup, к которой привязан сервис
+	addressGroupIDs := make([]models.ResourceIdentifier, 0, len(service.AddressGroups))
 	for _, agRef := range service.AddressGroups {
-		// Получаем AddressGroupPortMapping
-		portMapping, err := v.reader.GetAddressGroupPortMappingByID(ctx, models.ResourceIdentifier{Name: agRef.Name, Namespace: agRef.Namespace})
-		if err != nil {
-			// Если портмаппинг не найден, пропускаем проверку для этой AddressGroup
-			continue
-		}
+		addressGroupIDs = append(addressGroupIDs, models.ResourceIdentifier{Name: agRef.Name, Namespace: agRef.Namespace})
+	}
+	return v.checkAddressGroupsPortOverlaps(ctx, service, addressGroupIDs)
+}
 
-		// Проверяем, что портмаппинг не nil и AccessPorts не nil
-		if portMapping == nil || portMapping.AccessPorts == nil {
+// checkAddressGroupsPortOverlaps проверяет перекрытие портов сервиса с другими сервисами
+// AddressGroups и сообщает обо всех конфликтах сразу. AddressGroups без портмаппинга
+// пропускаются, повторяющиеся проверяются один раз.
+func (v *ServiceValidator) checkAddressGroupsPortOverlaps(ctx context.Context, service models.Service, addressGroupIDs []models.ResourceIdentifier) error {
+	seen := make(map[string]bool, len(addressGroupIDs))
+	var portMappings []models.AddressGroupPortMapping
+	for _, agID := range addressGroupIDs {
+		if seen[agID.Key()] {
 			continue
 		}
+		seen[agID.Key()] = true
 
-		// Создаем карту портов сервиса по протоколам
-		servicePorts := make(map[models.TransportProtocol][]models.PortRange)
-		for _, ingressPort := range service.IngressPorts {
-			portRange, err := ParsePortRange(ingressPort.Port)
-			if err != nil {
-				return fmt.Errorf("invalid port in service %s: %w", service.Key(), err)
-			}
-			servicePorts[ingressPort.Protocol] = append(servicePorts[ingressPort.Protocol], portRange)
-		}
-
-		// Проверяем на перекрытия с существующими сервисами в портмаппинге
-		for serviceRef, existingServicePorts := range portMapping.AccessPorts {
-			// Пропускаем текущий сервис (для обновлений)
-			if models.ServiceRefKey(serviceRef) == service.Key() {
-				continue
-			}
-
-			// Проверяем, что existingServicePorts.Ports не nil
-			if existingServicePorts.Ports == nil {
-				continue
-			}
-
-			// Проверяем TCP порты
-			for _, serviceRange := range servicePorts[models.TCP] {
-				for _, tcpPortRange := range existingServicePorts.Ports[models.TCP] {
-					if DoPortRangesOverlap(serviceRange, tcpPortRange) {
-						return fmt.Errorf("TCP port range %d-%d in service %s overlaps with existing port range %d-%d in service %s",
-							serviceRange.Start, serviceRange.End, service.Key(), tcpPortRange.Start, tcpPortRange.End, models.ServiceRefKey(serviceRef))
-					}
-				}
-			}
-
-			// Проверяем UDP порты
-			for _, serviceRange := range servicePorts[models.UDP] {
-				for _, udpPortRange := range existingServicePorts.Ports[models.UDP] {
-					if DoPortRangesOverlap(serviceRange, udpPortRange) {
-						return fmt.Errorf("UDP port range %d-%d in service %s overlaps with existing port range %d-%d in service %s",
-							serviceRange.Start, serviceRange.End, service.Key(), udpPortRange.Start, udpPortRange.End, models.ServiceRefKey(serviceRef))
-					}
-				}
-			}
+		portMapping, err := v.reader.GetAddressGroupPortMappingByID(ctx, agID)
+		if err != nil || portMapping == nil || portMapping.AccessPorts == nil {
+			// Если портмаппинг не найден, пропускаем проверку для этой AddressGroup
+			continue
 		}
+		portMappings = append(portMappings, *portMapping)
+	}
+	if len(portMappings) == 0 {
+		return nil
 	}
 
-	return nil
+	conflicts, err := FindPortConflicts(service, portMappings...)
+	return portConflictError(service, conflicts, err)
 }
 
 // ValidateForCreation validates a service before creation
 func (v *ServiceValidator) ValidateForCreation(ctx context.Context, service models.Service) error {
+	// PHASE 0: Validate size limits before the expensive checks
+	if err := CurrentLimits().ValidateService(service); err != nil {
+		return err
+	}
+
 	// PHASE 1: Check for duplicate entity (CRITICAL FIX for overwrite issue)
 	// This prevents creation of entities with the same namespace/name combination
 	keyExtractor := func(entity interface{}) string {
@@ -206,83 +216,112 @@ func (v *ServiceValidator) ValidateForCreation(ctx context.Context, service mode
 		return ""
 	}
 
+	errs := NewValidationErrors(v.BaseValidator.entityType, service.Key())
+	// An existing service is reported alone with the detailed EntityAlreadyExistsError, its
+	// references are not looked up
 	if err := v.BaseValidator.ValidateEntityDoesNotExistForCreation(ctx, service.ResourceIdentifier, keyExtractor); err != nil {
-		return err // Return the detailed EntityAlreadyExistsError with logging and context
+		errs.Add("metadata.name", err)
+		return errs.Err()
 	}
 
 	// PHASE 2.5: Validate no duplicate AddressGroups
-	if err := v.ValidateNoDuplicateAddressGroups(service.AddressGroups); err != nil {
-		return err
-	}
+	errs.Add("spec.addressGroups", v.ValidateNoDuplicateAddressGroups(service.AddressGroups))
 
 	// PHASE 3: Validate references
-	if err := v.ValidateReferences(ctx, service); err != nil {
-		return err
-	}
-
-	// PHASE 4: Validate internal port consistency (existing validation)
-	if err := v.ValidateNoDuplicatePorts(service.IngressPorts); err != nil {
-		return err
-	}
+	errs.Add("spec.addressGroups", v.ValidateReferences(ctx, service))
 
-	// PHASE 5: Validate port conflicts with other services (existing validation)
-	if err := v.CheckPortOverlaps(ctx, service); err != nil {
-		return err
-	}
+	// PHASE 4-5: Validate internal port consistency and port conflicts with other services
+	v.validatePorts(ctx, errs, service)
 
-	return nil
+	return errs.Err()
 }
 
 // CheckBindingsPortOverlaps проверяет перекрытие портов во всех AddressGroupPortMappings,
 // которые ссылаются на сервис через AddressGroupBindings
 func (v *ServiceValidator) CheckBindingsPortOverlaps(ctx context.Context, service models.Service) error {
-	// Находим все AddressGroupBindings, которые ссылаются на этот сервис
-	var addressGroupIDs []models.ResourceIdentifier
-	err := v.reader.ListAddressGroupBindings(ctx, func(binding models.AddressGroupBinding) error {
-		if binding.ServiceRefKey() == service.Key() {
-			// Create ResourceIdentifier from NamespacedObjectReference
-			agID := models.NewResourceIdentifier(binding.AddressGroupRef.Name, models.WithNamespace(binding.AddressGroupRef.Namespace))
-			addressGroupIDs = append(addressGroupIDs, agID)
-		}
-		return nil
-	}, nil)
+	addressGroupIDs, err := v.boundAddressGroups(ctx, service)
+	if err != nil {
+		return err
+	}
+	return v.checkAddressGroupsPortOverlaps(ctx, service, addressGroupIDs)
+}
 
+// CheckBoundPortOverlaps проверяет перекрытие портов сервиса в AddressGroups из spec.addressGroups
+// и в AddressGroups, к которым сервис привязан через AddressGroupBindings. Все конфликты
+// сообщаются одной ошибкой.
+func (v *ServiceValidator) CheckBoundPortOverlaps(ctx context.Context, service models.Service) error {
+	addressGroupIDs := make([]models.ResourceIdentifier, 0, len(service.AddressGroups))
+	for _, agRef := range service.AddressGroups {
+		addressGroupIDs = append(addressGroupIDs, models.NewResourceIdentifier(agRef.Name, models.WithNamespace(refNamespace(agRef.Namespace, service.Namespace))))
+	}
+	boundIDs, err := v.boundAddressGroups(ctx, service)
 	if err != nil {
-		return errors.Wrap(err, "failed to list address group bindings")
+		return err
 	}
+	return v.checkAddressGroupsPortOverlaps(ctx, service, append(addressGroupIDs, boundIDs...))
+}
 
-	// Проверяем перекрытие портов в каждом AddressGroupPortMapping
-	for _, agID := range addressGroupIDs {
-		portMapping, err := v.reader.GetAddressGroupPortMappingByID(ctx, agID)
-		if err != nil || portMapping == nil {
-			// Если портмаппинг не найден или nil, пропускаем проверку для этой AddressGroup
-			continue
+// boundAddressGroups возвращает AddressGroups, к которым сервис привязан через AddressGroupBindings.
+// Биндинги читаются только из namespace, где они могут находиться: namespace сервиса и
+// namespaces AddressGroups, уже привязанных к сервису
+func (v *ServiceValidator) boundAddressGroups(ctx context.Context, service models.Service) ([]models.ResourceIdentifier, error) {
+	var addressGroupIDs []models.ResourceIdentifier
+	for _, namespace := range v.bindingNamespaces(ctx, service) {
+		var scope ports.Scope
+		if namespace != "" {
+			scope = ports.ResourceIdentifierScope{Identifiers: []models.ResourceIdentifier{{Namespace: namespace}}}
 		}
-
-		// Создаем временную копию портмаппинга для проверки
-		tempMapping := *portMapping
-
-		// Удаляем текущий сервис из временной копии
-		//if tempMapping.AccessPorts != nil {
-		//	delete(tempMapping.AccessPorts, models.ServiceRef{ResourceIdentifier: service.ResourceIdentifier})
-		//}
-
-		// Проверяем перекрытие портов
-		if err := CheckPortOverlaps(service, tempMapping); err != nil {
-			return err
+		err := v.reader.ListAddressGroupBindings(ctx, func(binding models.AddressGroupBinding) error {
+			if namespace != "" && binding.Namespace != namespace {
+				return nil
+			}
+			if models.RefersTo(binding.ServiceRef, binding.Namespace, service.ResourceIdentifier) {
+				agID := models.NewResourceIdentifier(binding.AddressGroupRef.Name, models.WithNamespace(refNamespace(binding.AddressGroupRef.Namespace, binding.Namespace)))
+				addressGroupIDs = append(addressGroupIDs, agID)
+			}
+			return nil
+		}, scope)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list address group bindings")
 		}
 	}
+	return addressGroupIDs, nil
+}
 
-	return nil
+// bindingNamespaces возвращает namespace сервиса и namespaces AddressGroups, привязанных к нему
+// биндингами по данным сервиса и его сохраненной версии. Ошибка чтения сохраненной версии
+// оставляет только известные namespaces
+func (v *ServiceValidator) bindingNamespaces(ctx context.Context, service models.Service) []string {
+	namespaces := []string{service.Namespace}
+	seen := map[string]bool{service.Namespace: true}
+	addBound := func(aggregated []models.AddressGroupReference) {
+		for _, entry := range aggregated {
+			namespace := refNamespace(entry.Ref.Namespace, service.Namespace)
+			if entry.Source == models.AddressGroupSourceBinding && !seen[namespace] {
+				seen[namespace] = true
+				namespaces = append(namespaces, namespace)
+			}
+		}
+	}
+	addBound(service.AggregatedAddressGroups)
+	if stored, err := v.reader.GetServiceByID(ctx, service.ResourceIdentifier); err == nil && stored != nil {
+		addBound(stored.AggregatedAddressGroups)
+	}
+	return namespaces
 }
 
 // ValidateForUpdate валидирует сервис перед обновлением
 func (v *ServiceValidator) ValidateForUpdate(ctx context.Context, oldService, newService models.Service) error {
-	// Validate no duplicate AddressGroups in updated spec
-	if err := v.ValidateNoDuplicateAddressGroups(newService.AddressGroups); err != nil {
+	// Проверяем ограничения размера до дорогих проверок
+	if err := CurrentLimits().ValidateService(newService); err != nil {
 		return err
 	}
 
+	errs := NewValidationErrors(v.BaseValidator.entityType, newService.Key())
+
+	// Validate no duplicate AddressGroups in updated spec
+	errs.Add("spec.addressGroups", v.ValidateNoDuplicateAddressGroups(newService.AddressGroups))
+
 	// 🎯 SERVICE BUSINESS RULE: Services CAN modify ports and description when Ready=True
 	// This matches k8s-controller service_webhook.go behavior - NO Ready=True spec blocking
 	// Only AddressGroupBinding, ServiceAlias, RuleS2S have spec immutability when Ready=True
@@ -290,13 +329,12 @@ func (v *ServiceValidator) ValidateForUpdate(ctx context.Context, oldService, ne
 	// Continue with existing validation logic (port overlaps, duplicates, references)
 
 	// Проверяем ссылки
-	if err := v.ValidateReferences(ctx, newService); err != nil {
-		return err
-	}
+	errs.Add("spec.addressGroups", v.ValidateReferences(ctx, newService))
 
-	// Проверяем на дубликаты портов внутри сервиса
+	// Проверяем на дубликаты портов внутри сервиса, перекрытия проверяются только для корректных портов
 	if err := v.ValidateNoDuplicatePorts(newService.IngressPorts); err != nil {
-		return err
+		errs.Add("spec.ingressPorts", err)
+		return errs.Err()
 	}
 
 	// Проверяем, изменились ли порты или AddressGroups
@@ -305,53 +343,107 @@ func (v *ServiceValidator) ValidateForUpdate(ctx context.Context, oldService, ne
 
 	if portsChanged || addressGroupsChanged {
 		// Проверяем перекрытие портов в AddressGroups, к которым привязан сервис
-		if err := v.CheckPortOverlaps(ctx, newService); err != nil {
-			return err
+		addressGroupIDs := make([]models.ResourceIdentifier, 0, len(newService.AddressGroups))
+		for _, agRef := range newService.AddressGroups {
+			addressGroupIDs = append(addressGroupIDs, models.ResourceIdentifier{Name: agRef.Name, Namespace: agRef.Namespace})
 		}
 
-		// Дополнительно проверяем все AddressGroupBindings, которые ссылаются на этот сервис
+		// Дополнительно проверяем все AddressGroupBindings, которые ссылаются на этот сервис:
+		// конфликты в AddressGroups из spec и из биндингов сообщаются одной ошибкой
 		if portsChanged {
-			if err := v.CheckBindingsPortOverlaps(ctx, newService); err != nil {
-				return err
+			boundIDs, err := v.boundAddressGroups(ctx, newService)
+			if err != nil {
+				errs.Add("spec.ingressPorts", err)
+				return errs.Err()
 			}
+			addressGroupIDs = append(addressGroupIDs, boundIDs...)
 		}
+		errs.Add("spec.ingressPorts", v.checkAddressGroupsPortOverlaps(ctx, newService, addressGroupIDs))
 	}
 
-	return nil
+	return errs.Err()
 }
 
 // CheckDependencies checks if there are dependencies before deleting a service
 func (v *ServiceValidator) CheckDependencies(ctx context.Context, id models.ResourceIdentifier) error {
-	// PHASE 1: Check ServiceAliases referencing the service to be deleted
-	hasAliases := false
-	err := v.reader.ListServiceAliases(ctx, func(alias models.ServiceAlias) error {
-		if alias.ServiceRefKey() == id.Key() {
-			hasAliases = true
+	return v.CheckDependenciesOfServices(ctx, []models.ResourceIdentifier{id})
+}
+
+// CheckDependenciesOfServices checks if there are dependencies before deleting services.
+// Aliases are listed once for the namespaces of all services and rules once for all
+// namespaces, the first dependency found in the order of ids is returned.
+func (v *ServiceValidator) CheckDependenciesOfServices(ctx context.Context, ids []models.ResourceIdentifier) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// PHASE 1: Check ServiceAliases referencing the services to be deleted
+	// Aliases live in the namespace of their service, every namespace is listed once
+	withAliases := make(map[models.ResourceIdentifier]bool)
+	listed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if listed[id.Namespace] {
+			continue
+		}
+		listed[id.Namespace] = true
+
+		// Services without a namespace can't be narrowed down to a namespace scope
+		var scope ports.Scope
+		if id.Namespace != "" {
+			scope = ports.ResourceIdentifierScope{Identifiers: []models.ResourceIdentifier{{Namespace: id.Namespace}}}
+		}
+		err := v.reader.ListServiceAliases(ctx, func(alias models.ServiceAlias) error {
+			for _, id := range ids {
+				if models.RefersTo(alias.ServiceRef, alias.Namespace, id) {
+					withAliases[id] = true
+				}
+			}
+			return nil
+		}, scope)
+
+		if err != nil {
+			return errors.Wrap(err, "failed to check service aliases")
+		}
+	}
+
+	// PHASE 2: Check RuleS2S referencing the services as local or target one, rules may
+	// reference services of other namespaces
+	withRules := make(map[models.ResourceIdentifier]bool)
+	err := v.reader.ListRuleS2S(ctx, func(rule models.RuleS2S) error {
+		for _, id := range ids {
+			if models.RefersTo(rule.ServiceLocalRef, rule.Namespace, id) || models.RefersTo(rule.ServiceRef, rule.Namespace, id) {
+				withRules[id] = true
+			}
 		}
 		return nil
-	}, nil)
+	}, ports.EmptyScope{})
 
 	if err != nil {
-		return errors.Wrap(err, "failed to check service aliases")
+		return errors.Wrap(err, "failed to check rule s2s")
 	}
 
-	if hasAliases {
-		return NewDependencyExistsError("service", id.Key(), "service_alias")
-	}
+	for _, id := range ids {
+		if withAliases[id] {
+			return NewDependencyExistsError("service", id.Key(), "service_alias")
+		}
+		if withRules[id] {
+			return NewDependencyExistsError("service", id.Key(), "rule_s2s")
+		}
 
-	// PHASE 2: Check if service has any associated AddressGroups (from spec or bindings)
-	service, err := v.reader.GetServiceByID(ctx, id)
-	if err != nil {
-		if errors.Is(err, ports.ErrNotFound) {
-			// Service doesn't exist, nothing to check
-			return nil
+		// PHASE 3: Check if service has any associated AddressGroups (from spec or bindings)
+		service, err := v.reader.GetServiceByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, ports.ErrNotFound) {
+				// Service doesn't exist, nothing to check
+				continue
+			}
+			return errors.Wrap(err, "failed to get service for dependency check")
 		}
-		return errors.Wrap(err, "failed to get service for dependency check")
-	}
 
-	// If xAggregatedAddressGroups is not empty, cannot delete
-	if len(service.AggregatedAddressGroups) > 0 {
-		return NewDependencyExistsError("service", id.Key(), "address_groups")
+		// If xAggregatedAddressGroups is not empty, cannot delete
+		if len(service.AggregatedAddressGroups) > 0 {
+			return NewDependencyExistsError("service", id.Key(), "address_groups")
+		}
 	}
 
 	return nil
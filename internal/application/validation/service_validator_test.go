@@ -50,6 +50,7 @@ func TestServiceValidator_CheckDependencies(t *testing.T) {
 
 	validator := validation.NewServiceValidator(mockReader)
 	serviceID := models.NewResourceIdentifier("test-service")
+	mockReader.AddService(models.Service{SelfRef: models.NewSelfRef(serviceID)})
 
 	// Test when no dependencies exist
 	err := validator.CheckDependencies(context.Background(), serviceID)
@@ -69,9 +70,15 @@ func TestServiceValidator_CheckDependencies(t *testing.T) {
 		t.Errorf("Expected DependencyExistsError, got %T", err)
 	}
 
-	// Test when address group binding dependency exists
+	// Test when address group binding dependency exists, bindings are aggregated into the service
 	mockReader.hasAliases = false
 	mockReader.hasBindings = true
+	mockReader.AddService(models.Service{
+		SelfRef: models.NewSelfRef(serviceID),
+		AggregatedAddressGroups: []models.AddressGroupReference{
+			{Ref: models.NewAddressGroupRef("test-ag"), Source: models.AddressGroupSourceBinding},
+		},
+	})
 	err = validator.CheckDependencies(context.Background(), serviceID)
 	if err == nil {
 		t.Error("Expected error for address group binding dependency, got nil")
@@ -785,3 +792,35 @@ func (m *MockReaderForServiceValidator) ListHosts(ctx context.Context, consume f
 func (m *MockReaderForServiceValidator) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Network, error) {
 	return nil, validation.NewEntityNotFoundError("Network", cidr)
 }
+
+func (m *MockReaderForServiceValidator) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceValidator) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return nil, validation.NewEntityNotFoundError("RuleS2SException", id.Key())
+}
+
+func (m *MockReaderForServiceValidator) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceValidator) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return nil, validation.NewEntityNotFoundError("CrossNamespacePolicy", id.Key())
+}
+
+func (m *MockReaderForServiceValidator) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceValidator) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return nil, validation.NewEntityNotFoundError("RuleTemplate", id.Key())
+}
+
+func (m *MockReaderForServiceValidator) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return nil
+}
+
+func (m *MockReaderForServiceValidator) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return nil, validation.NewEntityNotFoundError("NamespacePosture", id.Key())
+}
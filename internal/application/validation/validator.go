@@ -75,6 +75,31 @@ func (v *DependencyValidator) GetNetworkBindingValidator() *NetworkBindingValida
 	return NewNetworkBindingValidator(v.reader)
 }
 
+// GetHostValidator returns a validator for hosts
+func (v *DependencyValidator) GetHostValidator() *HostValidator {
+	return NewHostValidator(v.reader)
+}
+
+// GetRuleS2SExceptionValidator returns a validator for RuleS2S exceptions
+func (v *DependencyValidator) GetRuleS2SExceptionValidator() *RuleS2SExceptionValidator {
+	return NewRuleS2SExceptionValidator(v.reader)
+}
+
+// GetCrossNamespacePolicyValidator returns a validator for cross namespace policies
+func (v *DependencyValidator) GetCrossNamespacePolicyValidator() *CrossNamespacePolicyValidator {
+	return NewCrossNamespacePolicyValidator(v.reader)
+}
+
+// GetRuleTemplateValidator returns a validator for rule templates
+func (v *DependencyValidator) GetRuleTemplateValidator() *RuleTemplateValidator {
+	return NewRuleTemplateValidator(v.reader)
+}
+
+// GetNamespacePostureValidator returns a validator for namespace postures
+func (v *DependencyValidator) GetNamespacePostureValidator() *NamespacePostureValidator {
+	return NewNamespacePostureValidator(v.reader)
+}
+
 // ServiceValidator provides methods for validating services
 type ServiceValidator struct {
 	reader        ports.Reader
@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FieldViolation is a failed check of a resource field
+type FieldViolation struct {
+	Field string // Path of the field, e.g. "spec.ingressPorts[1]", empty for the whole resource
+	Err   error
+}
+
+// String returns the field path and the reason of the violation
+func (v FieldViolation) String() string {
+	if v.Field == "" {
+		return v.Err.Error()
+	}
+	return v.Field + ": " + v.Err.Error()
+}
+
+// ValidationErrors collects all failed checks of a resource, so a request reports every
+// violation at once instead of the first one. It unwraps to the collected errors:
+// errors.As finds an EntityAlreadyExistsError or a LimitExceededError among them.
+type ValidationErrors struct {
+	EntityType string
+	EntityID   string
+	Violations []FieldViolation
+}
+
+// NewValidationErrors creates an empty collector of the violations of a resource
+func NewValidationErrors(entityType, entityID string) *ValidationErrors {
+	return &ValidationErrors{EntityType: entityType, EntityID: entityID}
+}
+
+// Add records err as a violation of field, nil errors are ignored. Violations collected
+// by nested checks keep their own field paths, field is used for those without one.
+func (e *ValidationErrors) Add(field string, err error) {
+	if err == nil {
+		return
+	}
+	var nested *ValidationErrors
+	if errors.As(err, &nested) && nested != e {
+		for _, violation := range nested.Violations {
+			if violation.Field == "" {
+				violation.Field = field
+			}
+			e.Violations = append(e.Violations, violation)
+		}
+		return
+	}
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Err: err})
+}
+
+// Err returns the collected violations, nil without violations
+func (e *ValidationErrors) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationErrors) Error() string {
+	// A single violation keeps the message of its check
+	if len(e.Violations) == 1 {
+		return e.Violations[0].Err.Error()
+	}
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.String())
+	}
+	return fmt.Sprintf("%s %s has %d validation errors: %s", e.EntityType, e.EntityID, len(e.Violations), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the collected errors
+func (e *ValidationErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		errs = append(errs, violation.Err)
+	}
+	return errs
+}
@@ -0,0 +1,111 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+func TestFieldViolation_String(t *testing.T) {
+	assert.Equal(t, "spec.cidr: invalid", FieldViolation{Field: "spec.cidr", Err: errors.New("invalid")}.String())
+	assert.Equal(t, "invalid", FieldViolation{Err: errors.New("invalid")}.String())
+}
+
+func TestValidationErrors_Add(t *testing.T) {
+	errs := NewValidationErrors("Service", "app/web")
+	errs.Add("spec.ingressPorts", nil)
+	require.NoError(t, errs.Err(), "nil errors must not be collected")
+
+	errs.Add("spec.ingressPorts", errors.New("invalid port"))
+	require.Error(t, errs.Err())
+	assert.Equal(t, "invalid port", errs.Err().Error(), "a single violation keeps the message of its check")
+
+	errs.Add("spec.addressGroups", errors.New("unknown address group"))
+	assert.Equal(t, "Service app/web has 2 validation errors: spec.ingressPorts: invalid port; spec.addressGroups: unknown address group",
+		errs.Err().Error())
+}
+
+func TestValidationErrors_AddNested(t *testing.T) {
+	nested := NewValidationErrors("Service", "app/web")
+	nested.Add("", errors.New("whole resource"))
+	nested.Add("spec.ingressPorts[1]", errors.New("invalid port"))
+
+	errs := NewValidationErrors("Service", "app/web")
+	errs.Add("spec", nested.Err())
+
+	assert.Equal(t, []FieldViolation{
+		{Field: "spec", Err: nested.Violations[0].Err},
+		{Field: "spec.ingressPorts[1]", Err: nested.Violations[1].Err},
+	}, errs.Violations, "nested violations keep their fields, those without one get the outer field")
+}
+
+func TestValidationErrors_Unwrap(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	limit := &LimitExceededError{EntityType: "Service", EntityID: "app/web", Field: "spec.ingressPorts", Limit: 1, Actual: 2}
+	exists := NewEntityAlreadyExistsError("Service", "app/web", nil, "", "")
+
+	errs := NewValidationErrors("Service", "app/web")
+	errs.Add("metadata.name", exists)
+	errs.Add("spec.ingressPorts", limit)
+	errs.Add("spec", sentinel)
+	err := errs.Err()
+
+	assert.Equal(t, []error{exists, limit, sentinel}, errs.Unwrap())
+	assert.ErrorIs(t, err, sentinel)
+
+	var foundLimit *LimitExceededError
+	require.True(t, errors.As(err, &foundLimit))
+	assert.Equal(t, limit, foundLimit)
+
+	var foundExists *EntityAlreadyExistsError
+	require.True(t, errors.As(err, &foundExists))
+	assert.Equal(t, exists, foundExists)
+}
+
+// lookupCountingReader counts the address group lookups of a validator
+type lookupCountingReader struct {
+	ports.Reader
+	addressGroupLookups int
+}
+
+func (r *lookupCountingReader) GetAddressGroupByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
+	r.addressGroupLookups++
+	return r.Reader.GetAddressGroupByID(ctx, id)
+}
+
+func TestServiceValidator_ValidateForCreation_ExistingServiceSkipsReferences(t *testing.T) {
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+	service := models.Service{
+		SelfRef:       models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("app"))),
+		AddressGroups: []models.AddressGroupRef{models.NewAddressGroupRef("missing", models.WithNamespace("app"))},
+	}
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{service}, nil))
+	require.NoError(t, writer.Commit())
+
+	memReader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	defer memReader.Close()
+	reader := &lookupCountingReader{Reader: memReader}
+
+	err = NewServiceValidator(reader).ValidateForCreation(ctx, service)
+	require.Error(t, err)
+
+	var violations *ValidationErrors
+	require.True(t, errors.As(err, &violations))
+	require.Len(t, violations.Violations, 1, "an existing service must be reported alone")
+	assert.Equal(t, "metadata.name", violations.Violations[0].Field)
+	var exists *EntityAlreadyExistsError
+	assert.True(t, errors.As(err, &exists))
+	assert.Zero(t, reader.addressGroupLookups, "references of an existing service must not be looked up")
+}
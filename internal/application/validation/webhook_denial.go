@@ -0,0 +1,48 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WebhookViolation is a reason of a webhook denial, Resource and Field are empty when the
+// webhook rejected the request as a whole
+type WebhookViolation struct {
+	Resource string // kind/namespace/name, e.g. "Service/app/web"
+	Field    string
+	Message  string
+}
+
+// String returns the resource, the field and the reason of the violation
+func (v WebhookViolation) String() string {
+	prefix := v.Resource
+	if v.Field != "" {
+		prefix += " " + v.Field
+	}
+	if prefix == "" {
+		return v.Message
+	}
+	return prefix + ": " + v.Message
+}
+
+// WebhookDeniedError is a write rejected by an external validation webhook
+type WebhookDeniedError struct {
+	Webhook    string
+	Message    string
+	Violations []WebhookViolation
+}
+
+func (e *WebhookDeniedError) Error() string {
+	message := fmt.Sprintf("validation webhook %s denied the request", e.Webhook)
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+	if len(e.Violations) == 0 {
+		return message
+	}
+	violations := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		violations = append(violations, violation.String())
+	}
+	return message + " (" + strings.Join(violations, "; ") + ")"
+}
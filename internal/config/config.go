@@ -5,11 +5,17 @@ import (
 	"crypto/x509"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"netguard-pg-backend/internal/infrastructure/ipam"
+	"netguard-pg-backend/internal/infrastructure/repositories/mysql"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg"
+	"netguard-pg-backend/internal/infrastructure/repositories/readcache"
+	"netguard-pg-backend/internal/infrastructure/repositories/tenancy"
 	syncConfig "netguard-pg-backend/internal/sync/config"
 )
 
@@ -26,15 +32,41 @@ const (
 	VerifyModeVerify        = "verify"
 )
 
+// Транспорты потока изменений ресурсов
+const (
+	ChangeDataTransportKafka = "kafka"
+	ChangeDataTransportNATS  = "nats"
+)
+
 type (
 	// Config - основная конфигурация приложения
 	Config struct {
-		App         `yaml:"app"`
-		Settings    `yaml:"settings"`
-		Log         `yaml:"logger"`
-		Authn       `yaml:"authn"`
-		Sync        SyncConfig                         `yaml:"sync"`
-		ReverseSync syncConfig.ReverseSyncSystemConfig `yaml:"reverse_sync"`
+		App              `yaml:"app"`
+		Settings         `yaml:"settings"`
+		Log              `yaml:"logger"`
+		Authn            `yaml:"authn"`
+		Debug            `yaml:"debug"`
+		ChangeFeed       `yaml:"change-feed"`
+		ConditionHistory `yaml:"condition-history"`
+		Events           `yaml:"events"`
+		ChangeData       `yaml:"change-data"`
+		RuleWebhook      `yaml:"ieagag-rule-webhook"`
+		ValidationHooks  `yaml:"validation-webhooks"`
+		RuleSchedule     `yaml:"rule-schedule"`
+		RuleGC           `yaml:"rule-gc"`
+		Finalizer        `yaml:"deletion-finalizer"`
+		Revalidation     `yaml:"revalidation"`
+		Leader           `yaml:"leader-election"`
+		Admission        `yaml:"admission"`
+		IPAM             `yaml:"ipam"`
+		Limits           `yaml:"limits"`
+		RulePriority     `yaml:"rule-priority"`
+		Postgres         pg.PoolConfig                      `yaml:"postgres"`
+		MySQL            mysql.Config                       `yaml:"mysql"`
+		ReadCache        readcache.Config                   `yaml:"read-cache"`
+		Tenancy          tenancy.Config                     `yaml:"tenancy"`
+		Sync             SyncConfig                         `yaml:"sync"`
+		ReverseSync      syncConfig.ReverseSyncSystemConfig `yaml:"reverse_sync"`
 	}
 
 	// App - конфигурация приложения
@@ -45,7 +77,11 @@ type (
 
 	// Log - конфигурация логирования
 	Log struct {
-		Level string `yaml:"log-level" env:"LOG_LEVEL"`
+		Level  string `yaml:"log-level" env:"LOG_LEVEL"`
+		Format string `yaml:"format" env:"LOG_FORMAT"` // text или json
+		// Subsystems - уровень детализации по подсистемам (aggregation, sync, ...).
+		// Отрицательное значение отключает info-логи подсистемы.
+		Subsystems map[string]int `yaml:"subsystems"`
 	}
 
 	// Settings - основные настройки
@@ -53,6 +89,234 @@ type (
 		SGroupGRPCAddress string `yaml:"sgroup-grpc-address" env:"SGROUP_GRPC_ADDRESS"`
 		HTTPAddr          string `yaml:"http-addr" env:"HTTP_ADDR"`
 		GRPCAddr          string `yaml:"grpc-addr" env:"GRPC_ADDR"`
+		// LegacyRuleGeneration - генерация IEAgAgRule по каждому RuleS2S отдельно
+		// (без агрегации портов, в namespace RuleS2S, без логов), как в старых версиях
+		LegacyRuleGeneration bool `yaml:"legacy-rule-generation" env:"LEGACY_RULE_GENERATION"`
+	}
+
+	// Debug - отладочные HTTP endpoints (/debug/pprof, /debug/goroutines, /debug/state)
+	Debug struct {
+		Enabled bool `yaml:"enabled" env:"DEBUG_ENDPOINTS_ENABLED"`
+	}
+
+	// ChangeFeed - журнал изменений для Watch: события хранятся horizon и позволяют
+	// клиентам возобновлять watch с более старой ревизии без полного relist.
+	// В PostgreSQL журнал общий для всех реплик: каждая реплика читает его раз в
+	// tail-interval и доставляет своим watch-подписчикам изменения всех реплик
+	ChangeFeed struct {
+		Horizon            time.Duration `yaml:"horizon" env:"CHANGE_FEED_HORIZON"`
+		CompactionInterval time.Duration `yaml:"compaction-interval" env:"CHANGE_FEED_COMPACTION_INTERVAL"`
+		TailInterval       time.Duration `yaml:"tail-interval" env:"CHANGE_FEED_TAIL_INTERVAL"`
+	}
+
+	// ConditionHistory - история смен статуса conditions ресурсов (например, когда и почему
+	// RuleS2S перешел из Ready=True в Ready=False); записи старше horizon удаляются
+	ConditionHistory struct {
+		Horizon            time.Duration `yaml:"horizon" env:"CONDITION_HISTORY_HORIZON"`
+		CompactionInterval time.Duration `yaml:"compaction-interval" env:"CONDITION_HISTORY_COMPACTION_INTERVAL"`
+	}
+
+	// Events - доменные события: применение и удаление ресурсов, перегенерация IEAgAgRule,
+	// окончательная ошибка синхронизации с sgroups. События публикуются асинхронно после
+	// фиксации изменений; если приемники не успевают и буфер buffer-size заполнен, новые
+	// события отбрасываются. Каждый приемник включается отдельно
+	Events struct {
+		Enabled    bool             `yaml:"enabled" env:"EVENTS_ENABLED"`
+		BufferSize int              `yaml:"buffer-size" env:"EVENTS_BUFFER_SIZE"`
+		Log        EventsLog        `yaml:"log"`
+		Webhook    EventsWebhook    `yaml:"webhook"`
+		Kubernetes EventsKubernetes `yaml:"kubernetes"`
+	}
+
+	// EventsLog - запись событий в лог
+	EventsLog struct {
+		Enabled bool `yaml:"enabled" env:"EVENTS_LOG_ENABLED"`
+	}
+
+	// EventsWebhook - отправка событий POST-запросом с JSON-телом на url
+	EventsWebhook struct {
+		Enabled bool              `yaml:"enabled" env:"EVENTS_WEBHOOK_ENABLED"`
+		URL     string            `yaml:"url" env:"EVENTS_WEBHOOK_URL"`
+		Headers map[string]string `yaml:"headers"`
+		Timeout time.Duration     `yaml:"timeout" env:"EVENTS_WEBHOOK_TIMEOUT"`
+	}
+
+	// EventsKubernetes - запись событий как Kubernetes Events ресурсов netguard.
+	// Пустой kubeconfig - используется конфигурация пода (in-cluster)
+	EventsKubernetes struct {
+		Enabled    bool   `yaml:"enabled" env:"EVENTS_KUBERNETES_ENABLED"`
+		Kubeconfig string `yaml:"kubeconfig" env:"EVENTS_KUBERNETES_KUBECONFIG"`
+		Component  string `yaml:"component" env:"EVENTS_KUBERNETES_COMPONENT"`
+	}
+
+	// ChangeData - поток зафиксированных изменений ресурсов (состояние до и после изменения)
+	// в Kafka (через Kafka REST Proxy) или NATS для внешних систем: SIEM, CMDB, аналитика
+	// правил. Изменения реплики отправляются в порядке фиксации; при переполнении буфера
+	// или после max-attempts неудачных попыток изменение отбрасывается
+	ChangeData struct {
+		Enabled bool `yaml:"enabled" env:"CHANGE_DATA_ENABLED"`
+		// Transport - kafka или nats
+		Transport     string          `yaml:"transport" env:"CHANGE_DATA_TRANSPORT"`
+		BufferSize    int             `yaml:"buffer-size" env:"CHANGE_DATA_BUFFER_SIZE"`
+		MaxAttempts   int             `yaml:"max-attempts" env:"CHANGE_DATA_MAX_ATTEMPTS"`
+		RetryInterval time.Duration   `yaml:"retry-interval" env:"CHANGE_DATA_RETRY_INTERVAL"`
+		Timeout       time.Duration   `yaml:"timeout" env:"CHANGE_DATA_TIMEOUT"`
+		Kafka         ChangeDataKafka `yaml:"kafka"`
+		NATS          ChangeDataNATS  `yaml:"nats"`
+	}
+
+	// ChangeDataKafka - запись в topic через Kafka REST Proxy (API v2), ключ записи -
+	// kind/namespace/name, поэтому изменения ресурса попадают в одну партицию
+	ChangeDataKafka struct {
+		RESTProxyURL string            `yaml:"rest-proxy-url" env:"CHANGE_DATA_KAFKA_REST_PROXY_URL"`
+		Topic        string            `yaml:"topic" env:"CHANGE_DATA_KAFKA_TOPIC"`
+		Headers      map[string]string `yaml:"headers"`
+	}
+
+	// ChangeDataNATS - публикация в subject <subject-prefix>.<Kind>
+	ChangeDataNATS struct {
+		URL           string `yaml:"url" env:"CHANGE_DATA_NATS_URL"`
+		SubjectPrefix string `yaml:"subject-prefix" env:"CHANGE_DATA_NATS_SUBJECT_PREFIX"`
+		User          string `yaml:"user" env:"CHANGE_DATA_NATS_USER"`
+		Password      string `yaml:"password" env:"CHANGE_DATA_NATS_PASSWORD"`
+		Token         string `yaml:"token" env:"CHANGE_DATA_NATS_TOKEN"`
+	}
+
+	// RuleWebhook - уведомление о смене сгенерированных IEAgAgRule: изменения, зафиксированные
+	// за interval, объединяются и отправляются POST-запросом с JSON-диффом (созданные,
+	// измененные с состоянием до и после, удаленные правила) отдельно по каждому namespace.
+	// Пустой namespaces - уведомлять обо всех namespace. Неотправленный дифф повторяется
+	// вместе со следующими изменениями
+	RuleWebhook struct {
+		Enabled    bool              `yaml:"enabled" env:"IEAGAG_RULE_WEBHOOK_ENABLED"`
+		URL        string            `yaml:"url" env:"IEAGAG_RULE_WEBHOOK_URL"`
+		Headers    map[string]string `yaml:"headers"`
+		Timeout    time.Duration     `yaml:"timeout" env:"IEAGAG_RULE_WEBHOOK_TIMEOUT"`
+		Interval   time.Duration     `yaml:"interval" env:"IEAGAG_RULE_WEBHOOK_INTERVAL"`
+		Namespaces []string          `yaml:"namespaces" env:"IEAGAG_RULE_WEBHOOK_NAMESPACES"`
+	}
+
+	// ValidationHooks - внешние вебхуки проверки записей. Ресурсы каждого Sync клиентов
+	// backend (gRPC, HTTP, /v2/apply) до применения отправляются POST-запросом с JSON-телом
+	// на вебхуки, выбравшие их kind, namespace и операцию; отказ вебхука отклоняет весь запрос.
+	// Так политики admission webhook Kubernetes действуют и для клиентов в обход aggregated API.
+	// Вебхуки вызываются по порядку
+	ValidationHooks struct {
+		Enabled  bool                `yaml:"enabled" env:"VALIDATION_WEBHOOKS_ENABLED"`
+		Webhooks []ValidationWebhook `yaml:"webhooks"`
+	}
+
+	// ValidationWebhook - вебхук проверки записей. Пустые kinds и namespaces - все kind и
+	// namespace, пустой operations - create и update. failure-policy (Fail или Ignore)
+	// определяет, отклоняется ли запрос, если вебхук недоступен или ответил ошибкой
+	ValidationWebhook struct {
+		Name          string            `yaml:"name"`
+		URL           string            `yaml:"url"`
+		Headers       map[string]string `yaml:"headers"`
+		Timeout       time.Duration     `yaml:"timeout"`
+		Kinds         []string          `yaml:"kinds"`
+		Namespaces    []string          `yaml:"namespaces"`
+		Operations    []string          `yaml:"operations"`
+		FailurePolicy string            `yaml:"failure-policy"`
+	}
+
+	// RuleSchedule - проверка окон действия RuleS2S (validFrom/validUntil): правила,
+	// вошедшие в окно или вышедшие из него, пересчитываются не позже чем через interval
+	RuleSchedule struct {
+		Interval time.Duration `yaml:"interval" env:"RULE_SCHEDULE_INTERVAL"`
+	}
+
+	// Finalizer - завершение удалений через финализаторы: ресурсы с deletionTimestamp,
+	// у которых остался только финализатор бэкенда, удаляются (каскад и удаление в sgroups),
+	// неудавшиеся удаления повторяются раз в interval
+	Finalizer struct {
+		Interval time.Duration `yaml:"interval" env:"DELETION_FINALIZER_INTERVAL"`
+	}
+
+	// Revalidation - фоновая перепроверка условий: ресурсы, у которых изменились зависимости
+	// (например, AddressGroup или Service удалены в обход бэкенда), заново получают
+	// Validated/Ready не позже чем через interval, а не только при собственной записи
+	Revalidation struct {
+		Enabled  bool          `yaml:"enabled" env:"REVALIDATION_ENABLED"`
+		Interval time.Duration `yaml:"interval" env:"REVALIDATION_INTERVAL"`
+	}
+
+	// RuleGC - периодическая сборка мусора IEAgAgRule: сгенерированные из RuleS2S правила,
+	// которые не порождает ни одно RuleS2S, удаляются (или только помечаются в логах при dry-run,
+	// по умолчанию). Правила, записанные напрямую, не собираются. Если доля сирот превышает
+	// max-deletion-ratio, удаление не выполняется; при числе правил меньше min-rules доля
+	// считается от min-rules
+	RuleGC struct {
+		Enabled          bool          `yaml:"enabled" env:"RULE_GC_ENABLED"`
+		Interval         time.Duration `yaml:"interval" env:"RULE_GC_INTERVAL"`
+		DryRun           bool          `yaml:"dry-run" env:"RULE_GC_DRY_RUN"`
+		MaxDeletionRatio float64       `yaml:"max-deletion-ratio" env:"RULE_GC_MAX_DELETION_RATIO"`
+		MinRules         int           `yaml:"min-rules" env:"RULE_GC_MIN_RULES"`
+	}
+
+	// Leader - выбор лидера среди реплик: reverse sync, drift detection, сборка мусора,
+	// проверка окон действия RuleS2S, перепроверка условий и компактизация журнала изменений
+	// работают только на реплике, удерживающей advisory lock lock-name в PostgreSQL. Запросы
+	// обслуживают все реплики. Реплики без лидерства пытаются захватить lock каждые retry-interval
+	Leader struct {
+		Enabled       bool          `yaml:"enabled" env:"LEADER_ELECTION_ENABLED"`
+		LockName      string        `yaml:"lock-name" env:"LEADER_ELECTION_LOCK_NAME"`
+		RetryInterval time.Duration `yaml:"retry-interval" env:"LEADER_ELECTION_RETRY_INTERVAL"`
+	}
+
+	// Admission - приоритизация массовых операций (bulk apply, импорт, массовое удаление).
+	// Массовые запросы выполняются ограниченным числом параллельно, небольшими
+	// транзакциями и уступают интерактивным операциям между пакетами
+	Admission struct {
+		Enabled bool `yaml:"enabled" env:"ADMISSION_ENABLED"`
+		// BulkThreshold - запрос с большим числом ресурсов считается массовым
+		BulkThreshold int `yaml:"bulk-threshold" env:"ADMISSION_BULK_THRESHOLD"`
+		// BulkConcurrency - сколько массовых запросов выполняется одновременно
+		BulkConcurrency int `yaml:"bulk-concurrency" env:"ADMISSION_BULK_CONCURRENCY"`
+		// BulkBatchSize - число ресурсов в одной транзакции массового запроса
+		BulkBatchSize int `yaml:"bulk-batch-size" env:"ADMISSION_BULK_BATCH_SIZE"`
+		// BulkBatchPause - пауза между пакетами массового запроса
+		BulkBatchPause time.Duration `yaml:"bulk-batch-pause" env:"ADMISSION_BULK_BATCH_PAUSE"`
+		// InteractiveYield - сколько пакет ждет завершения интерактивных операций
+		InteractiveYield time.Duration `yaml:"interactive-yield" env:"ADMISSION_INTERACTIVE_YIELD"`
+	}
+
+	// IPAM - внешняя система управления адресами (NetBox). CIDR сетей резервируются
+	// в IPAM перед сохранением и освобождаются при удалении сети, сети без CIDR
+	// получают свободный диапазон из пула
+	IPAM struct {
+		Enabled bool `yaml:"enabled" env:"IPAM_ENABLED"`
+		// Type - тип IPAM, поддерживается netbox
+		Type   string            `yaml:"type" env:"IPAM_TYPE"`
+		NetBox ipam.NetBoxConfig `yaml:"netbox"`
+	}
+
+	// Limits - ограничения размера ресурсов и запросов, чтобы патологические входные
+	// данные не замедляли агрегацию для всех. 0 отключает ограничение
+	Limits struct {
+		// MaxPortsPerService - число диапазонов портов сервиса ("80,443" - два)
+		MaxPortsPerService         int `yaml:"max-ports-per-service" env:"LIMITS_MAX_PORTS_PER_SERVICE"`
+		MaxAddressGroupsPerService int `yaml:"max-address-groups-per-service" env:"LIMITS_MAX_ADDRESS_GROUPS_PER_SERVICE"`
+		// MaxResourcesPerRequest - число ресурсов в одном Sync запросе или манифесте /v2/apply
+		MaxResourcesPerRequest int `yaml:"max-resources-per-request" env:"LIMITS_MAX_RESOURCES_PER_REQUEST"`
+		// MaxNameLength - длина имени и namespace ресурса
+		MaxNameLength int `yaml:"max-name-length" env:"LIMITS_MAX_NAME_LENGTH"`
+		// MaxGRPCRecvMessageSize / MaxGRPCSendMessageSize - размер gRPC запроса и ответа
+		// в байтах, 0 - значения gRPC по умолчанию (4MiB и без ограничения)
+		MaxGRPCRecvMessageSize int `yaml:"max-grpc-recv-message-size" env:"LIMITS_MAX_GRPC_RECV_MESSAGE_SIZE"`
+		MaxGRPCSendMessageSize int `yaml:"max-grpc-send-message-size" env:"LIMITS_MAX_GRPC_SEND_MESSAGE_SIZE"`
+	}
+
+	// RulePriority - стратегия приоритетов IEAgAgRule, сгенерированных из RuleS2S.
+	// Приоритет разрешающих правил берется из первого совпадения: уровень политики
+	// (метка netguard.sgroups.io/policy-tier), namespace, направление трафика, иначе default.
+	// Запрещающие правила остаются на 50 раньше разрешающих, явный priority RuleS2S важнее стратегии
+	RulePriority struct {
+		Default    int32            `yaml:"default" env:"RULE_PRIORITY_DEFAULT"`
+		Tiers      map[string]int32 `yaml:"tiers"`
+		Namespaces map[string]int32 `yaml:"namespaces"`
+		// Traffic - приоритет по направлению трафика: INGRESS, EGRESS
+		Traffic map[string]int32 `yaml:"traffic"`
 	}
 
 	// Authn - конфигурация аутентификации
@@ -83,6 +347,52 @@ func NewConfig(path string) (*Config, error) {
 	cfg.App.Name = "netguard-pg-backend"
 	cfg.App.Version = "v1.0.0"
 	cfg.Log.Level = "info"
+	cfg.Log.Format = "text"
+	cfg.ChangeFeed.Horizon = time.Hour
+	cfg.ChangeFeed.CompactionInterval = 5 * time.Minute
+	cfg.ChangeFeed.TailInterval = 200 * time.Millisecond
+	cfg.ConditionHistory.Horizon = 7 * 24 * time.Hour
+	cfg.ConditionHistory.CompactionInterval = time.Hour
+	cfg.Events.BufferSize = 1000
+	cfg.Events.Log.Enabled = true
+	cfg.Events.Webhook.Timeout = 5 * time.Second
+	cfg.Events.Kubernetes.Component = "netguard-pg-backend"
+	cfg.ChangeData.Transport = ChangeDataTransportKafka
+	cfg.ChangeData.BufferSize = 10000
+	cfg.ChangeData.MaxAttempts = 5
+	cfg.ChangeData.RetryInterval = time.Second
+	cfg.ChangeData.Timeout = 5 * time.Second
+	cfg.ChangeData.Kafka.Topic = "netguard-changes"
+	cfg.ChangeData.NATS.SubjectPrefix = "netguard.changes"
+	cfg.RuleWebhook.Timeout = 5 * time.Second
+	cfg.RuleWebhook.Interval = 5 * time.Second
+	cfg.RuleSchedule.Interval = 30 * time.Second
+	cfg.Finalizer.Interval = 30 * time.Second
+	cfg.Revalidation.Enabled = true
+	cfg.Revalidation.Interval = time.Minute
+	cfg.RuleGC.Enabled = true
+	cfg.RuleGC.DryRun = true
+	cfg.RuleGC.Interval = 10 * time.Minute
+	cfg.RuleGC.MaxDeletionRatio = 0.8
+	cfg.RuleGC.MinRules = 10
+	cfg.Leader.LockName = "netguard-background-jobs"
+	cfg.Leader.RetryInterval = 5 * time.Second
+	cfg.Admission.Enabled = true
+	cfg.Admission.BulkThreshold = 100
+	cfg.Admission.BulkConcurrency = 2
+	cfg.Admission.BulkBatchSize = 50
+	cfg.Admission.BulkBatchPause = 50 * time.Millisecond
+	cfg.Admission.InteractiveYield = 500 * time.Millisecond
+	cfg.Limits.MaxPortsPerService = 1000
+	cfg.Limits.MaxAddressGroupsPerService = 100
+	cfg.Limits.MaxResourcesPerRequest = 10000
+	cfg.Limits.MaxNameLength = 253
+	cfg.RulePriority.Default = 100
+	cfg.Postgres = pg.DefaultPoolConfig()
+	cfg.MySQL = mysql.DefaultConfig()
+	cfg.ReadCache = readcache.DefaultConfig()
+	cfg.IPAM.Type = ipam.TypeNetBox
+	cfg.IPAM.NetBox = ipam.DefaultNetBoxConfig()
 	cfg.Settings.HTTPAddr = ":8080"
 	cfg.Settings.GRPCAddr = ":9090"
 	cfg.Settings.SGroupGRPCAddress = "localhost:9007"
@@ -187,6 +497,32 @@ func (c *Config) GetTransportCredentials() (credentials.TransportCredentials, er
 	}
 }
 
+// validate проверяет настройки потока изменений ресурсов
+func (c *ChangeData) validate() error {
+	if c.BufferSize <= 0 {
+		return fmt.Errorf("buffer size must be positive")
+	}
+	if c.MaxAttempts <= 0 {
+		return fmt.Errorf("max attempts must be positive")
+	}
+	if c.RetryInterval <= 0 || c.Timeout <= 0 {
+		return fmt.Errorf("retry interval and timeout must be positive")
+	}
+	switch c.Transport {
+	case ChangeDataTransportKafka:
+		if c.Kafka.RESTProxyURL == "" || c.Kafka.Topic == "" {
+			return fmt.Errorf("kafka rest proxy url and topic are required")
+		}
+	case ChangeDataTransportNATS:
+		if c.NATS.URL == "" || c.NATS.SubjectPrefix == "" {
+			return fmt.Errorf("nats url and subject prefix are required")
+		}
+	default:
+		return fmt.Errorf("unknown transport %q", c.Transport)
+	}
+	return nil
+}
+
 // Validate валидирует конфигурацию
 func (c *Config) Validate() error {
 	if c.Settings.SGroupGRPCAddress == "" {
@@ -199,6 +535,139 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.ChangeFeed.Horizon <= 0 {
+		return fmt.Errorf("change feed horizon must be positive")
+	}
+	if c.ChangeFeed.CompactionInterval <= 0 {
+		return fmt.Errorf("change feed compaction interval must be positive")
+	}
+	if c.ChangeFeed.TailInterval <= 0 {
+		return fmt.Errorf("change feed tail interval must be positive")
+	}
+	if c.ConditionHistory.Horizon <= 0 {
+		return fmt.Errorf("condition history horizon must be positive")
+	}
+	if c.ConditionHistory.CompactionInterval <= 0 {
+		return fmt.Errorf("condition history compaction interval must be positive")
+	}
+	if c.Events.Enabled {
+		if c.Events.BufferSize <= 0 {
+			return fmt.Errorf("events buffer size must be positive")
+		}
+		if c.Events.Webhook.Enabled {
+			if c.Events.Webhook.URL == "" {
+				return fmt.Errorf("events webhook url is required")
+			}
+			if c.Events.Webhook.Timeout <= 0 {
+				return fmt.Errorf("events webhook timeout must be positive")
+			}
+		}
+	}
+	if c.ChangeData.Enabled {
+		if err := c.ChangeData.validate(); err != nil {
+			return fmt.Errorf("change data config validation failed: %w", err)
+		}
+	}
+	if c.RuleWebhook.Enabled {
+		if c.RuleWebhook.URL == "" {
+			return fmt.Errorf("ieagag rule webhook url is required")
+		}
+		if c.RuleWebhook.Timeout <= 0 || c.RuleWebhook.Interval <= 0 {
+			return fmt.Errorf("ieagag rule webhook timeout and interval must be positive")
+		}
+	}
+	if c.ValidationHooks.Enabled {
+		if len(c.ValidationHooks.Webhooks) == 0 {
+			return fmt.Errorf("validation webhooks are enabled without webhooks")
+		}
+		for _, webhook := range c.ValidationHooks.Webhooks {
+			if webhook.Name == "" || webhook.URL == "" {
+				return fmt.Errorf("validation webhook name and url are required")
+			}
+			if webhook.Timeout < 0 {
+				return fmt.Errorf("validation webhook %s timeout cannot be negative", webhook.Name)
+			}
+		}
+	}
+	if c.RuleSchedule.Interval <= 0 {
+		return fmt.Errorf("rule schedule interval must be positive")
+	}
+	if c.Finalizer.Interval <= 0 {
+		return fmt.Errorf("deletion finalizer interval must be positive")
+	}
+	if c.Revalidation.Enabled && c.Revalidation.Interval <= 0 {
+		return fmt.Errorf("revalidation interval must be positive")
+	}
+	if c.RuleGC.Enabled {
+		if c.RuleGC.Interval <= 0 {
+			return fmt.Errorf("rule gc interval must be positive")
+		}
+		if c.RuleGC.MaxDeletionRatio < 0 || c.RuleGC.MaxDeletionRatio > 1 {
+			return fmt.Errorf("rule gc max deletion ratio must be between 0 and 1")
+		}
+		if c.RuleGC.MinRules < 0 {
+			return fmt.Errorf("rule gc min rules cannot be negative")
+		}
+	}
+	if c.Leader.Enabled {
+		if c.Leader.LockName == "" {
+			return fmt.Errorf("leader election lock name cannot be empty")
+		}
+		if c.Leader.RetryInterval <= 0 {
+			return fmt.Errorf("leader election retry interval must be positive")
+		}
+	}
+
+	if c.Admission.Enabled {
+		if c.Admission.BulkThreshold <= 0 {
+			return fmt.Errorf("admission bulk threshold must be positive")
+		}
+		if c.Admission.BulkConcurrency <= 0 {
+			return fmt.Errorf("admission bulk concurrency must be positive")
+		}
+		if c.Admission.BulkBatchSize <= 0 {
+			return fmt.Errorf("admission bulk batch size must be positive")
+		}
+	}
+
+	if c.Limits.MaxPortsPerService < 0 || c.Limits.MaxAddressGroupsPerService < 0 ||
+		c.Limits.MaxResourcesPerRequest < 0 || c.Limits.MaxNameLength < 0 ||
+		c.Limits.MaxGRPCRecvMessageSize < 0 || c.Limits.MaxGRPCSendMessageSize < 0 {
+		return fmt.Errorf("limits must not be negative")
+	}
+
+	for traffic := range c.RulePriority.Traffic {
+		if traffic != "INGRESS" && traffic != "EGRESS" {
+			return fmt.Errorf("unknown rule priority traffic: %s", traffic)
+		}
+	}
+
+	if err := c.Postgres.Validate(); err != nil {
+		return fmt.Errorf("postgres config validation failed: %w", err)
+	}
+	if err := c.MySQL.Validate(); err != nil {
+		return fmt.Errorf("mysql config validation failed: %w", err)
+	}
+	if c.ReadCache.Enabled {
+		if err := c.ReadCache.Validate(); err != nil {
+			return fmt.Errorf("read cache config validation failed: %w", err)
+		}
+	}
+	if c.Tenancy.Enabled {
+		if err := c.Tenancy.Validate(); err != nil {
+			return fmt.Errorf("tenancy config validation failed: %w", err)
+		}
+	}
+
+	if c.IPAM.Enabled {
+		if c.IPAM.Type != ipam.TypeNetBox {
+			return fmt.Errorf("unknown IPAM type: %s", c.IPAM.Type)
+		}
+		if err := c.IPAM.NetBox.Validate(); err != nil {
+			return fmt.Errorf("ipam config validation failed: %w", err)
+		}
+	}
+
 	// Validate reverse sync configuration
 	if err := c.ReverseSync.Validate(); err != nil {
 		return fmt.Errorf("reverse sync config validation failed: %w", err)
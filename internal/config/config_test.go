@@ -0,0 +1,61 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewConfig_LegacyRuleGeneration(t *testing.T) {
+	cfg, err := NewConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Settings.LegacyRuleGeneration {
+		t.Error("legacy rule generation must be disabled by default")
+	}
+
+	cfg, err = NewConfig(writeConfigFile(t, "settings:\n  legacy-rule-generation: true\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Settings.LegacyRuleGeneration {
+		t.Error("settings.legacy-rule-generation must enable legacy rule generation")
+	}
+
+	t.Setenv("LEGACY_RULE_GENERATION", "true")
+	cfg, err = NewConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Settings.LegacyRuleGeneration {
+		t.Error("LEGACY_RULE_GENERATION must enable legacy rule generation")
+	}
+}
+
+func TestNewConfig_RuleGCDefaultsToDryRun(t *testing.T) {
+	cfg, err := NewConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.RuleGC.Enabled && !cfg.RuleGC.DryRun {
+		t.Error("rule GC must not delete rules unless dry-run is disabled explicitly")
+	}
+
+	cfg, err = NewConfig(writeConfigFile(t, "rule-gc:\n  dry-run: false\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.RuleGC.DryRun {
+		t.Error("rule-gc.dry-run: false must enable deletion")
+	}
+}
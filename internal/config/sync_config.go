@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
+
 	"netguard-pg-backend/internal/sync/clients"
+	"netguard-pg-backend/internal/sync/drift"
 	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
 )
 
 // SyncConfig holds configuration for the synchronization system
@@ -19,11 +23,43 @@ type SyncConfig struct {
 	// Retry holds retry configuration
 	Retry interfaces.RetryConfig `yaml:"retry"`
 
+	// RetryOverrides overrides retry configuration per subject type (Groups, Networks, Hosts, IEAgAgRules).
+	// Omitted fields are taken from Retry.
+	RetryOverrides map[types.SyncSubjectType]interfaces.RetryConfig `yaml:"retry_overrides"`
+
 	// Debounce holds debouncing configuration
 	Debounce DebounceConfig `yaml:"debounce"`
 
+	// Batching coalesces SyncEntity calls of the listed subject types into bulk syncs
+	Batching interfaces.BatchingConfig `yaml:"batching"`
+
 	// Cleanup holds cleanup configuration
 	Cleanup CleanupConfig `yaml:"cleanup"`
+
+	// Outbox holds transactional outbox configuration
+	Outbox OutboxConfig `yaml:"outbox"`
+
+	// Drift holds configuration of the drift detection between the database and sgroups
+	Drift DriftConfig `yaml:"drift"`
+
+	// Targets holds additional sgroups instances by name
+	Targets map[string]clients.SGroupsConfig `yaml:"targets"`
+
+	// Namespaces routes resources of a namespace to a sgroups target:
+	// "default" (sgroups above), "none" (not synchronized) or a name from Targets
+	Namespaces map[string]string `yaml:"namespaces"`
+
+	// Scope limits synchronization to included namespaces and label-selected resources,
+	// when only part of the cluster is managed by sgroups
+	Scope interfaces.SyncScope `yaml:"scope"`
+
+	// DisabledSyncers are subject types whose changes are not synchronized, e.g. Hosts during
+	// sgroups maintenance. Syncers are also enabled and disabled at runtime by the admin API.
+	DisabledSyncers []types.SyncSubjectType `yaml:"disabled_syncers"`
+
+	// Shadow records requests that would be sent to sgroups instead of sending them,
+	// to validate the mapping of a new deployment before going live
+	Shadow clients.ShadowConfig `yaml:"shadow"`
 }
 
 // DebounceConfig holds debouncing configuration
@@ -41,6 +77,45 @@ type CleanupConfig struct {
 	MaxAge time.Duration `yaml:"max_age" env:"SYNC_CLEANUP_MAX_AGE"`
 }
 
+// OutboxConfig holds transactional outbox configuration.
+// When enabled, sgroups sync operations are stored in the same transaction as
+// the resource change and delivered by a background dispatcher with retries.
+type OutboxConfig struct {
+	// Enabled determines if sync operations go through the outbox
+	Enabled bool `yaml:"enabled" env:"SYNC_OUTBOX_ENABLED"`
+
+	// PollInterval is the interval between outbox scans
+	PollInterval time.Duration `yaml:"poll_interval" env:"SYNC_OUTBOX_POLL_INTERVAL"`
+
+	// BatchSize is the maximum number of entries delivered at once
+	BatchSize int `yaml:"batch_size" env:"SYNC_OUTBOX_BATCH_SIZE"`
+
+	// Lease is the time a claimed entry is hidden from other dispatchers
+	Lease time.Duration `yaml:"lease" env:"SYNC_OUTBOX_LEASE"`
+
+	// MaxBackoff limits the delay between redeliveries of a failed entry
+	MaxBackoff time.Duration `yaml:"max_backoff" env:"SYNC_OUTBOX_MAX_BACKOFF"`
+
+	// MaxAttempts is the number of failed deliveries after which an entry is
+	// moved to the dead-letter queue, 0 retries forever
+	MaxAttempts int `yaml:"max_attempts" env:"SYNC_OUTBOX_MAX_ATTEMPTS"`
+}
+
+// DriftConfig holds configuration of the periodic drift detection.
+// The detector compares AddressGroups, Networks, Hosts and IEAgAgRules stored in
+// the database with the state of the default sgroups instance.
+type DriftConfig struct {
+	// Enabled determines if drift detection runs
+	Enabled bool `yaml:"enabled" env:"SYNC_DRIFT_ENABLED"`
+
+	// Interval is the interval between drift checks
+	Interval time.Duration `yaml:"interval" env:"SYNC_DRIFT_INTERVAL"`
+
+	// Policy is "report", "repair" (re-sync drifted resources) or
+	// "prune" (repair and delete objects unknown to netguard from sgroups)
+	Policy drift.Policy `yaml:"policy" env:"SYNC_DRIFT_POLICY"`
+}
+
 // DefaultSyncConfig returns default synchronization configuration
 func DefaultSyncConfig() SyncConfig {
 	return SyncConfig{
@@ -51,14 +126,33 @@ func DefaultSyncConfig() SyncConfig {
 			InitialDelay:  100,  // 100ms
 			MaxDelay:      5000, // 5s
 			BackoffFactor: 2.0,
+			Jitter:        0.2,
 		},
 		Debounce: DebounceConfig{
 			Time: 5 * time.Second,
 		},
+		Batching: interfaces.BatchingConfig{
+			Window:       200 * time.Millisecond,
+			MaxBatchSize: 500,
+			SubjectTypes: []types.SyncSubjectType{types.SyncSubjectTypeGroups},
+		},
 		Cleanup: CleanupConfig{
 			Interval: 10 * time.Minute,
 			MaxAge:   1 * time.Hour,
 		},
+		Outbox: OutboxConfig{
+			Enabled:      true,
+			PollInterval: 5 * time.Second,
+			BatchSize:    100,
+			Lease:        1 * time.Minute,
+			MaxBackoff:   5 * time.Minute,
+			MaxAttempts:  20,
+		},
+		Drift: DriftConfig{
+			Enabled:  false,
+			Interval: 10 * time.Minute,
+			Policy:   drift.PolicyReport,
+		},
 	}
 }
 
@@ -72,6 +166,18 @@ func (c *SyncConfig) Validate() error {
 		return fmt.Errorf("sgroups GRPC address is required when sync is enabled")
 	}
 
+	if c.SGroups.CircuitBreaker.FailureThreshold < 0 || c.SGroups.CircuitBreaker.OpenTimeout < 0 {
+		return fmt.Errorf("sgroups circuit_breaker failure_threshold and open_timeout must be >= 0")
+	}
+
+	if c.SGroups.Connection.CheckInterval < 0 || c.SGroups.Connection.InitialBackoff < 0 || c.SGroups.Connection.MaxBackoff < 0 {
+		return fmt.Errorf("sgroups connection check_interval, initial_backoff and max_backoff must be >= 0")
+	}
+
+	if c.Shadow.Keep < 0 {
+		return fmt.Errorf("shadow keep must be >= 0")
+	}
+
 	if c.Retry.MaxRetries < 0 {
 		return fmt.Errorf("retry max_retries must be >= 0")
 	}
@@ -88,10 +194,34 @@ func (c *SyncConfig) Validate() error {
 		return fmt.Errorf("retry backoff_factor must be > 1.0")
 	}
 
+	if c.Retry.Jitter < 0 || c.Retry.Jitter > 1 {
+		return fmt.Errorf("retry jitter must be between 0 and 1")
+	}
+
+	for subjectType, override := range c.RetryOverrides {
+		if override.MaxRetries < 0 || override.InitialDelay < 0 || override.MaxDelay < 0 {
+			return fmt.Errorf("retry override for %s must not be negative", subjectType)
+		}
+		if override.BackoffFactor != 0 && override.BackoffFactor <= 1.0 {
+			return fmt.Errorf("retry override backoff_factor for %s must be > 1.0", subjectType)
+		}
+		if override.Jitter < 0 || override.Jitter > 1 {
+			return fmt.Errorf("retry override jitter for %s must be between 0 and 1", subjectType)
+		}
+	}
+
 	if c.Debounce.Time <= 0 {
 		return fmt.Errorf("debounce time must be > 0")
 	}
 
+	if c.Batching.Window < 0 {
+		return fmt.Errorf("batching window must be >= 0")
+	}
+
+	if c.Batching.MaxBatchSize < 0 {
+		return fmt.Errorf("batching max_batch_size must be >= 0")
+	}
+
 	if c.Cleanup.Interval <= 0 {
 		return fmt.Errorf("cleanup interval must be > 0")
 	}
@@ -100,5 +230,91 @@ func (c *SyncConfig) Validate() error {
 		return fmt.Errorf("cleanup max_age must be > 0")
 	}
 
+	for name, target := range c.Targets {
+		if name == types.SyncTargetDefault || name == types.SyncTargetNone {
+			return fmt.Errorf("sync target name %q is reserved", name)
+		}
+		if target.GRPCAddress == "" {
+			return fmt.Errorf("sync target %s GRPC address is required", name)
+		}
+	}
+
+	for namespace, target := range c.Namespaces {
+		if target == types.SyncTargetDefault || target == types.SyncTargetNone {
+			continue
+		}
+		if _, exists := c.Targets[target]; !exists {
+			return fmt.Errorf("namespace %s is routed to unknown sync target %s", namespace, target)
+		}
+	}
+
+	if c.Scope.LabelSelector != "" {
+		if _, err := labels.Parse(c.Scope.LabelSelector); err != nil {
+			return fmt.Errorf("scope label_selector is invalid: %w", err)
+		}
+	}
+
+	excluded := make(map[string]bool, len(c.Scope.ExcludeNamespaces))
+	for _, namespace := range c.Scope.ExcludeNamespaces {
+		excluded[namespace] = true
+	}
+	for _, namespace := range c.Scope.IncludeNamespaces {
+		if excluded[namespace] {
+			return fmt.Errorf("namespace %s is both included in and excluded from the sync scope", namespace)
+		}
+	}
+
+	if err := validateSyncedSubjectTypes("scope subject_types", c.Scope.SubjectTypes); err != nil {
+		return err
+	}
+
+	if err := validateSyncedSubjectTypes("disabled_syncers", c.DisabledSyncers); err != nil {
+		return err
+	}
+
+	if c.Outbox.Enabled {
+		if c.Outbox.PollInterval <= 0 {
+			return fmt.Errorf("outbox poll_interval must be > 0")
+		}
+
+		if c.Outbox.BatchSize <= 0 {
+			return fmt.Errorf("outbox batch_size must be > 0")
+		}
+
+		if c.Outbox.Lease <= 0 {
+			return fmt.Errorf("outbox lease must be > 0")
+		}
+
+		if c.Outbox.MaxBackoff <= 0 {
+			return fmt.Errorf("outbox max_backoff must be > 0")
+		}
+
+		if c.Outbox.MaxAttempts < 0 {
+			return fmt.Errorf("outbox max_attempts must be >= 0")
+		}
+	}
+
+	if c.Drift.Enabled {
+		if c.Drift.Interval <= 0 {
+			return fmt.Errorf("drift interval must be > 0")
+		}
+
+		if !c.Drift.Policy.Valid() {
+			return fmt.Errorf("drift policy must be one of report, repair, prune")
+		}
+	}
+
+	return nil
+}
+
+// validateSyncedSubjectTypes checks that every subject type has a sgroups syncer
+func validateSyncedSubjectTypes(field string, subjectTypes []types.SyncSubjectType) error {
+	for _, subjectType := range subjectTypes {
+		switch subjectType {
+		case types.SyncSubjectTypeGroups, types.SyncSubjectTypeNetworks, types.SyncSubjectTypeHosts, types.SyncSubjectTypeIEAgAgRules:
+		default:
+			return fmt.Errorf("%s: %s is not synchronized with sgroups", field, subjectType)
+		}
+	}
 	return nil
 }
@@ -20,6 +20,12 @@ func (p *AddressGroupBindingPolicy) ServiceRefKey() string {
 	return p.ServiceRef.Namespace + "/" + p.ServiceRef.Name
 }
 
+// AllowsServiceNamespace reports whether the policy has no service name and allows every Service
+// of the ServiceRef namespace, such policies are accepted for global address groups only
+func (p *AddressGroupBindingPolicy) AllowsServiceNamespace() bool {
+	return p.ServiceRef.Name == ""
+}
+
 // AddressGroupRefKey returns the key for the AddressGroupRef (namespace/name)
 func (p *AddressGroupBindingPolicy) AddressGroupRefKey() string {
 	if p.AddressGroupRef.Namespace == "" {
@@ -0,0 +1,22 @@
+package models
+
+// AddressGroupImpact describes how a proposed AddressGroup change would affect enforcement
+type AddressGroupImpact struct {
+	AddressGroup ResourceIdentifier
+	// Deleted is true when the impact of deleting the AddressGroup is analyzed
+	Deleted bool
+	// EnforcementChanged is false when the proposed spec doesn't change anything enforced in sgroups
+	EnforcementChanged bool
+
+	IEAgAgRules []ResourceIdentifier // IEAgAgRules whose enforcement would change
+	Services    []ResourceIdentifier // Services bound to the AddressGroup
+	Namespaces  []string             // Namespaces of affected IEAgAgRules and Services
+
+	RuleCountBefore int // IEAgAgRules referencing the AddressGroup now
+	RuleCountAfter  int // Estimated IEAgAgRules referencing the AddressGroup after the change
+}
+
+// RuleCountDelta returns the estimated change of the IEAgAgRule count
+func (i AddressGroupImpact) RuleCountDelta() int {
+	return i.RuleCountAfter - i.RuleCountBefore
+}
@@ -0,0 +1,82 @@
+package models
+
+// AddressGroupLookup returns an address group by reference, nil without error when it doesn't exist
+type AddressGroupLookup func(ref AddressGroupRef) (*AddressGroup, error)
+
+// IncludedGroupRefs returns the included groups with the namespace of the group
+// filled in for references that omit it
+func (ag *AddressGroup) IncludedGroupRefs() []AddressGroupRef {
+	refs := make([]AddressGroupRef, 0, len(ag.IncludedGroups))
+	for _, ref := range ag.IncludedGroups {
+		if ref.Namespace == "" {
+			ref.Namespace = ag.Namespace
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// ExpandAddressGroupRefs returns the refs followed by all groups they include transitively.
+// Every group is returned once, so inclusion cycles end the expansion instead of looping,
+// missing groups are kept but not expanded.
+func ExpandAddressGroupRefs(refs []AddressGroupRef, lookup AddressGroupLookup) ([]AddressGroupRef, error) {
+	visited := make(map[string]bool, len(refs))
+	var expanded []AddressGroupRef
+
+	queue := append([]AddressGroupRef(nil), refs...)
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+
+		key := AddressGroupRefKey(ref)
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		expanded = append(expanded, ref)
+
+		group, err := lookup(ref)
+		if err != nil {
+			return nil, err
+		}
+		if group != nil {
+			queue = append(queue, group.IncludedGroupRefs()...)
+		}
+	}
+	return expanded, nil
+}
+
+// AddressGroupInclusionCycle returns the keys of the inclusion path leading from the group
+// back to itself, or nil when the group isn't part of a cycle
+func AddressGroupInclusionCycle(group AddressGroup, lookup AddressGroupLookup) ([]string, error) {
+	rootKey := AddressGroupRefKey(NewAddressGroupRef(group.Name, WithNamespace(group.Namespace)))
+	visited := map[string]bool{rootKey: true}
+
+	var walk func(current *AddressGroup, path []string) ([]string, error)
+	walk = func(current *AddressGroup, path []string) ([]string, error) {
+		for _, ref := range current.IncludedGroupRefs() {
+			key := AddressGroupRefKey(ref)
+			if key == rootKey {
+				return append(path, key), nil
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			child, err := lookup(ref)
+			if err != nil {
+				return nil, err
+			}
+			if child == nil {
+				continue
+			}
+			cycle, err := walk(child, append(path, key))
+			if cycle != nil || err != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return walk(&group, []string{rootKey})
+}
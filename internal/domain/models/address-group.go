@@ -91,9 +91,23 @@ type AddressGroup struct {
 	// aggregated from both spec.hosts and HostBinding resources
 	AggregatedHosts []HostReference `json:"aggregatedHosts,omitempty"`
 
+	// IncludedGroups are child address groups, rules targeting this group also cover
+	// every group included transitively
+	IncludedGroups []AddressGroupRef `json:"includedGroups,omitempty"`
+
 	Meta Meta
 }
 
+// AddressGroupGlobalLabel marks an address group as global. Services of other namespaces use any
+// address group only when an AddressGroupBindingPolicy of the group namespace allows it, for a global
+// one the policy may also allow every Service of a namespace (see AllowsServiceNamespace)
+const AddressGroupGlobalLabel = "netguard.sgroups.io/global"
+
+// IsGlobal reports whether the address group is labeled as global
+func (ag *AddressGroup) IsGlobal() bool {
+	return ag.Meta.Labels[AddressGroupGlobalLabel] == "true"
+}
+
 // AddressGroupRef represents a reference to an AddressGroup
 type AddressGroupRef = netguardv1beta1.NamespacedObjectReference
 
@@ -207,6 +221,33 @@ func (ag *AddressGroup) Key() string {
 	return fmt.Sprintf("%s/%s", ag.Namespace, ag.Name)
 }
 
+// IPFamilies returns the IP families of the address group networks in IPv4, IPv6 order
+func (ag *AddressGroup) IPFamilies() []IPFamily {
+	var hasIPv4, hasIPv6 bool
+	for _, network := range ag.Networks {
+		switch family, _ := CIDRFamily(network.CIDR); family {
+		case IPFamilyIPv4:
+			hasIPv4 = true
+		case IPFamilyIPv6:
+			hasIPv6 = true
+		}
+	}
+
+	var families []IPFamily
+	if hasIPv4 {
+		families = append(families, IPFamilyIPv4)
+	}
+	if hasIPv6 {
+		families = append(families, IPFamilyIPv6)
+	}
+	return families
+}
+
+// IsDualStack returns true if the address group has both IPv4 and IPv6 networks
+func (ag *AddressGroup) IsDualStack() bool {
+	return len(ag.IPFamilies()) == 2
+}
+
 // GetGeneration returns the generation of the address group
 func (ag *AddressGroup) GetGeneration() int64 {
 	return ag.Meta.Generation
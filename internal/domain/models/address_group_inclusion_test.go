@@ -0,0 +1,85 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newInclusionLookup(groups ...AddressGroup) AddressGroupLookup {
+	byKey := make(map[string]*AddressGroup, len(groups))
+	for i := range groups {
+		byKey[groups[i].Key()] = &groups[i]
+	}
+	return func(ref AddressGroupRef) (*AddressGroup, error) {
+		return byKey[AddressGroupRefKey(ref)], nil
+	}
+}
+
+func newIncludingGroup(name string, included ...string) AddressGroup {
+	group := AddressGroup{SelfRef: NewSelfRef(NewResourceIdentifier(name, WithNamespace("default")))}
+	for _, child := range included {
+		// Included groups without namespace are looked up in the namespace of the group
+		group.IncludedGroups = append(group.IncludedGroups, NewAddressGroupRef(child))
+	}
+	return group
+}
+
+func TestExpandAddressGroupRefs(t *testing.T) {
+	lookup := newInclusionLookup(
+		newIncludingGroup("all", "web", "db"),
+		newIncludingGroup("web", "web-canary"),
+		newIncludingGroup("db", "web"),
+		newIncludingGroup("web-canary"),
+	)
+
+	expanded, err := ExpandAddressGroupRefs([]AddressGroupRef{NewAddressGroupRef("all", WithNamespace("default"))}, lookup)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var keys []string
+	for _, ref := range expanded {
+		keys = append(keys, AddressGroupRefKey(ref))
+	}
+	expected := []string{"default/all", "default/web", "default/db", "default/web-canary"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected %v, got %v", expected, keys)
+	}
+}
+
+func TestExpandAddressGroupRefs_Cycle(t *testing.T) {
+	lookup := newInclusionLookup(newIncludingGroup("a", "b"), newIncludingGroup("b", "a"))
+
+	expanded, err := ExpandAddressGroupRefs([]AddressGroupRef{NewAddressGroupRef("a", WithNamespace("default"))}, lookup)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(expanded) != 2 {
+		t.Errorf("Expected the cycle to expand to 2 groups, got %d", len(expanded))
+	}
+}
+
+func TestAddressGroupInclusionCycle(t *testing.T) {
+	lookup := newInclusionLookup(newIncludingGroup("b", "c"), newIncludingGroup("c", "a"), newIncludingGroup("d"))
+
+	cycle, err := AddressGroupInclusionCycle(newIncludingGroup("a", "d", "b"), lookup)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"default/a", "default/b", "default/c", "default/a"}
+	if !reflect.DeepEqual(cycle, expected) {
+		t.Errorf("Expected cycle %v, got %v", expected, cycle)
+	}
+
+	cycle, err = AddressGroupInclusionCycle(newIncludingGroup("a", "d"), lookup)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cycle != nil {
+		t.Errorf("Expected no cycle, got %v", cycle)
+	}
+
+	if cycle, _ := AddressGroupInclusionCycle(newIncludingGroup("a", "a"), lookup); cycle == nil {
+		t.Error("Expected a group including itself to be a cycle")
+	}
+}
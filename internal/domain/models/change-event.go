@@ -0,0 +1,80 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Kinds of resources published to the change feed
+const (
+	ChangeKindService      = "Service"
+	ChangeKindAddressGroup = "AddressGroup"
+	ChangeKindRuleS2S      = "RuleS2S"
+)
+
+// ChangeEvent describes a single committed resource change
+type ChangeEvent struct {
+	// Revision is a monotonic number assigned by the change feed
+	Revision uint64
+	// SyncOp is Upsert for created/updated resources and Delete for removed ones
+	SyncOp SyncOp
+	// Resource holds the changed resource (Service, AddressGroup, RuleS2S)
+	Resource interface{}
+	// Timestamp is the time the change was published
+	Timestamp time.Time
+}
+
+// Kind returns the kind of the changed resource, empty for unsupported resources
+func (e ChangeEvent) Kind() string {
+	switch e.Resource.(type) {
+	case Service:
+		return ChangeKindService
+	case AddressGroup:
+		return ChangeKindAddressGroup
+	case RuleS2S:
+		return ChangeKindRuleS2S
+	default:
+		return ""
+	}
+}
+
+// ResourceID returns identifier of the changed resource
+func (e ChangeEvent) ResourceID() ResourceIdentifier {
+	switch resource := e.Resource.(type) {
+	case Service:
+		return resource.ResourceIdentifier
+	case AddressGroup:
+		return resource.ResourceIdentifier
+	case RuleS2S:
+		return resource.ResourceIdentifier
+	default:
+		return ResourceIdentifier{}
+	}
+}
+
+// DecodeChangeResource restores a change event resource from its JSON representation
+func DecodeChangeResource(kind string, payload []byte) (interface{}, error) {
+	switch kind {
+	case ChangeKindService:
+		var service Service
+		if err := json.Unmarshal(payload, &service); err != nil {
+			return nil, err
+		}
+		return service, nil
+	case ChangeKindAddressGroup:
+		var addressGroup AddressGroup
+		if err := json.Unmarshal(payload, &addressGroup); err != nil {
+			return nil, err
+		}
+		return addressGroup, nil
+	case ChangeKindRuleS2S:
+		var rule RuleS2S
+		if err := json.Unmarshal(payload, &rule); err != nil {
+			return nil, err
+		}
+		return rule, nil
+	default:
+		return nil, fmt.Errorf("unsupported change kind: %s", kind)
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionTransition records a change of the status of a resource condition
+type ConditionTransition struct {
+	// ID is assigned by the condition history in recording order
+	ID   int64
+	Kind string // Kind of the resource, e.g. RuleS2S
+	ResourceIdentifier
+	Type string
+	// From is empty when the condition appears for the first time
+	From    metav1.ConditionStatus
+	To      metav1.ConditionStatus
+	Reason  string
+	Message string
+	Time    time.Time
+}
+
+// ConditionTransitions returns transitions of conditions whose status differs between before and
+// after. Changes of reason or message without a status change are not transitions.
+func ConditionTransitions(kind string, id ResourceIdentifier, before, after []metav1.Condition, now time.Time) []ConditionTransition {
+	previous := make(map[string]metav1.ConditionStatus, len(before))
+	for _, condition := range before {
+		previous[condition.Type] = condition.Status
+	}
+
+	var transitions []ConditionTransition
+	for _, condition := range after {
+		from, existed := previous[condition.Type]
+		if existed && from == condition.Status {
+			continue
+		}
+		at := condition.LastTransitionTime.Time
+		if at.IsZero() {
+			at = now
+		}
+		transitions = append(transitions, ConditionTransition{
+			Kind:               kind,
+			ResourceIdentifier: id,
+			Type:               condition.Type,
+			From:               from,
+			To:                 condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			Time:               at,
+		})
+	}
+	return transitions
+}
+
+// Degraded reports whether the resource got worse: a problem condition (Error, AddressGroupsConflict)
+// became true or another condition stopped being true
+func (t ConditionTransition) Degraded() bool {
+	switch t.Type {
+	case ConditionError, ConditionAddressGroupsConflict:
+		return t.To == metav1.ConditionTrue
+	default:
+		return t.To != metav1.ConditionTrue
+	}
+}
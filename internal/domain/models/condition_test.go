@@ -2,6 +2,7 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -249,3 +250,50 @@ func TestConditionHelpers(t *testing.T) {
 		t.Errorf("Expected status %s, got %s", metav1.ConditionTrue, errorCondition.Status)
 	}
 }
+
+// TestConditionTransitions проверяет выделение смен статуса условий
+func TestConditionTransitions(t *testing.T) {
+	now := time.Now()
+	flipped := metav1.NewTime(now.Add(-time.Minute))
+	id := NewResourceIdentifier("web", WithNamespace("default"))
+
+	before := []metav1.Condition{
+		{Type: ConditionReady, Status: metav1.ConditionTrue, Reason: ReasonReady},
+		{Type: ConditionSynced, Status: metav1.ConditionTrue, Reason: ReasonSynced},
+	}
+	after := []metav1.Condition{
+		{Type: ConditionReady, Status: metav1.ConditionFalse, Reason: ReasonNotReady, Message: "service not found", LastTransitionTime: flipped},
+		{Type: ConditionSynced, Status: metav1.ConditionTrue, Reason: ReasonSynced, Message: "message changed"},
+		{Type: ConditionValidated, Status: metav1.ConditionTrue},
+	}
+
+	transitions := ConditionTransitions("RuleS2S", id, before, after, now)
+	if len(transitions) != 2 {
+		t.Fatalf("Expected 2 transitions, got %d", len(transitions))
+	}
+
+	ready := transitions[0]
+	if ready.Kind != "RuleS2S" || ready.ResourceIdentifier != id || ready.Type != ConditionReady {
+		t.Errorf("Unexpected transition target %+v", ready)
+	}
+	if ready.From != metav1.ConditionTrue || ready.To != metav1.ConditionFalse || ready.Message != "service not found" {
+		t.Errorf("Unexpected Ready transition %+v", ready)
+	}
+	if !ready.Time.Equal(flipped.Time) {
+		t.Errorf("Expected transition time %v, got %v", flipped.Time, ready.Time)
+	}
+
+	// Новое условие переходит из пустого статуса, время берется текущее
+	validated := transitions[1]
+	if validated.Type != ConditionValidated || validated.From != "" || !validated.Time.Equal(now) {
+		t.Errorf("Unexpected Validated transition %+v", validated)
+	}
+
+	if !ready.Degraded() || validated.Degraded() {
+		t.Errorf("Expected only the Ready transition to be a degradation")
+	}
+	conflict := ConditionTransition{Type: ConditionAddressGroupsConflict, To: metav1.ConditionTrue}
+	if !conflict.Degraded() {
+		t.Errorf("Expected a conflict to be a degradation")
+	}
+}
@@ -17,6 +17,9 @@ const (
 
 	// ConditionError indicates that there is an error with the resource
 	ConditionError string = "Error"
+
+	// ConditionAddressGroupsConflict indicates that a Service has address groups registered more than once
+	ConditionAddressGroupsConflict string = "AddressGroupsConflict"
 )
 
 // Standard condition reasons
@@ -30,6 +33,12 @@ const (
 	ReasonSynced      string = "Synced"
 	ReasonSyncFailed  string = "SyncFailed"
 	ReasonSyncPending string = "SyncPending"
+	// ReasonSyncDeadLettered - sync to sgroups exhausted retries and waits in the dead-letter queue
+	ReasonSyncDeadLettered string = "SyncDeadLettered"
+	// ReasonSyncDrift - state in sgroups differs from the netguard database
+	ReasonSyncDrift string = "SyncDrift"
+	// ReasonSGroupsUnavailable - the sgroups circuit breaker is open, syncs fail fast
+	ReasonSGroupsUnavailable string = "SGroupsUnavailable"
 
 	// Validation reasons
 	ReasonValidated        string = "Validated"
@@ -42,6 +51,10 @@ const (
 	ReasonConfigurationError string = "ConfigurationError"
 	ReasonDependencyError    string = "DependencyError"
 	ReasonCleanupError       string = "CleanupError"
+
+	// Address group registration reasons
+	ReasonDuplicateRegistration string = "DuplicateRegistration"
+	ReasonNoConflicts           string = "NoConflicts"
 )
 
 // NewReadyCondition creates a new Ready condition
@@ -88,6 +101,17 @@ func NewErrorCondition(reason, message string) metav1.Condition {
 	}
 }
 
+// NewAddressGroupsConflictCondition creates a new AddressGroupsConflict condition
+func NewAddressGroupsConflictCondition(status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               ConditionAddressGroupsConflict,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
 // SetReadyCondition sets Ready condition on Meta
 func (m *Meta) SetReadyCondition(status metav1.ConditionStatus, reason, message string) {
 	condition := NewReadyCondition(status, reason, message)
@@ -112,6 +136,12 @@ func (m *Meta) SetErrorCondition(reason, message string) {
 	m.SetCondition(condition)
 }
 
+// SetAddressGroupsConflictCondition sets AddressGroupsConflict condition on Meta
+func (m *Meta) SetAddressGroupsConflictCondition(status metav1.ConditionStatus, reason, message string) {
+	condition := NewAddressGroupsConflictCondition(status, reason, message)
+	m.SetCondition(condition)
+}
+
 // ClearErrorCondition removes Error condition from Meta
 func (m *Meta) ClearErrorCondition() {
 	if m == nil || m.Conditions == nil {
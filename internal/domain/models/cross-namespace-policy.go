@@ -0,0 +1,29 @@
+package models
+
+// CrossNamespacePolicy allows RuleS2S of the listed namespaces to reference Services
+// of the policy namespace
+type CrossNamespacePolicy struct {
+	SelfRef
+	AllowedNamespaces []string // Namespaces whose RuleS2S may reference Services of the policy namespace
+	Meta              Meta
+}
+
+// Allows reports whether RuleS2S of the namespace may reference Services of the policy namespace
+func (p *CrossNamespacePolicy) Allows(namespace string) bool {
+	for _, allowed := range p.AllowedNamespaces {
+		if allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// CrossNamespacePolicyRef represents a reference to a CrossNamespacePolicy
+type CrossNamespacePolicyRef struct {
+	ResourceIdentifier
+}
+
+// NewCrossNamespacePolicyRef creates a new CrossNamespacePolicyRef
+func NewCrossNamespacePolicyRef(name string, opts ...ResourceIdentifierOption) CrossNamespacePolicyRef {
+	return CrossNamespacePolicyRef{ResourceIdentifier: NewResourceIdentifier(name, opts...)}
+}
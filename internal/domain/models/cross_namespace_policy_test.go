@@ -0,0 +1,39 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestCrossNamespacePolicy_Allows(t *testing.T) {
+	policy := CrossNamespacePolicy{
+		SelfRef:           NewSelfRef(NewResourceIdentifier("from-frontend", WithNamespace("backend"))),
+		AllowedNamespaces: []string{"frontend", "monitoring"},
+	}
+
+	if !policy.Allows("frontend") || !policy.Allows("monitoring") {
+		t.Error("Expected the policy to allow the listed namespaces")
+	}
+	if policy.Allows("backend-test") {
+		t.Error("Expected the policy to reject namespaces missing from the allow-list")
+	}
+}
+
+func TestRuleS2S_IsCrossNamespace(t *testing.T) {
+	rule := RuleS2S{SelfRef: NewSelfRef(NewResourceIdentifier("web-to-db", WithNamespace("frontend")))}
+
+	rule.ServiceRef = NewServiceRef("db", WithNamespace("backend"))
+	if !rule.IsCrossNamespace() {
+		t.Error("Expected a ServiceRef in another namespace to be cross namespace")
+	}
+
+	rule.ServiceRef = NewServiceRef("db", WithNamespace("frontend"))
+	if rule.IsCrossNamespace() {
+		t.Error("Expected a ServiceRef in the rule namespace not to be cross namespace")
+	}
+
+	// An empty ServiceRef namespace means the rule namespace
+	rule.ServiceRef = NewServiceRef("db")
+	if rule.IsCrossNamespace() {
+		t.Error("Expected a ServiceRef without namespace not to be cross namespace")
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+// Types of domain events
+const (
+	// DomainEventResourceApplied - a resource was created or updated
+	DomainEventResourceApplied = "ResourceApplied"
+	// DomainEventResourceDeleted - a resource was deleted
+	DomainEventResourceDeleted = "ResourceDeleted"
+	// DomainEventIEAgAgRuleRegenerated - an IEAgAgRule was created, updated or removed by a recalculation
+	DomainEventIEAgAgRuleRegenerated = "IEAgAgRuleRegenerated"
+	// DomainEventSyncFailed - a sync of a resource with sgroups failed permanently
+	DomainEventSyncFailed = "SyncFailed"
+)
+
+// Severities of domain events, they match Kubernetes event types
+const (
+	DomainEventNormal  = "Normal"
+	DomainEventWarning = "Warning"
+)
+
+// DomainEvent describes something that happened to a resource, it is delivered to event sinks
+// (Kubernetes Events, webhook, log) after the change is committed
+type DomainEvent struct {
+	Type string
+	Kind string // Kind of the resource, e.g. Service
+	ResourceIdentifier
+	// Reason is a short CamelCase cause, e.g. Created or Deleted
+	Reason   string
+	Message  string
+	Severity string
+	Time     time.Time
+}
+
+// NewDomainEvent creates a Normal event of a resource stamped with the current time
+func NewDomainEvent(eventType, kind string, id ResourceIdentifier, reason, message string) DomainEvent {
+	return DomainEvent{
+		Type:               eventType,
+		Kind:               kind,
+		ResourceIdentifier: id,
+		Reason:             reason,
+		Message:            message,
+		Severity:           DomainEventNormal,
+		Time:               time.Now(),
+	}
+}
+
+// Warning marks the event as a Warning
+func (e DomainEvent) Warning() DomainEvent {
+	e.Severity = DomainEventWarning
+	return e
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrTransportNotSupported is returned when a rule transport can't be expressed in sgroups
+var ErrTransportNotSupported = errors.New("transport is not supported by sgroups")
+
+// ICMPMatch matches an ICMP message type and optionally its code
+type ICMPMatch struct {
+	Type int
+	// Code is -1 when all codes of the type match
+	Code int
+}
+
+// String returns the ICMP match in the "type" or "type/code" form
+func (m ICMPMatch) String() string {
+	if m.Code < 0 {
+		return strconv.Itoa(m.Type)
+	}
+	return fmt.Sprintf("%d/%d", m.Type, m.Code)
+}
+
+// ParseICMPSpec parses a comma-separated list of ICMP types with optional codes: "0,3/4,8"
+func ParseICMPSpec(spec string) ([]ICMPMatch, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, fmt.Errorf("ICMP types cannot be empty")
+	}
+
+	var matches []ICMPMatch
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		typePart, codePart, hasCode := strings.Cut(item, "/")
+		icmpType, err := parseICMPNumber(typePart)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ICMP type '%s': %w", item, err)
+		}
+		match := ICMPMatch{Type: icmpType, Code: -1}
+		if hasCode {
+			if match.Code, err = parseICMPNumber(codePart); err != nil {
+				return nil, fmt.Errorf("invalid ICMP code '%s': %w", item, err)
+			}
+		}
+		matches = append(matches, match)
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no ICMP types found in '%s'", spec)
+	}
+	return matches, nil
+}
+
+// ICMPTypes returns the sorted unique ICMP types of the matches.
+// sgroups matches ICMP by type only, codes narrow nothing there.
+func ICMPTypes(matches []ICMPMatch) []uint32 {
+	seen := make(map[int]bool)
+	var types []uint32
+	for _, match := range matches {
+		if !seen[match.Type] {
+			seen[match.Type] = true
+			types = append(types, uint32(match.Type))
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+func parseICMPNumber(value string) (int, error) {
+	number, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("must be a number between 0 and 255")
+	}
+	if number < 0 || number > 255 {
+		return 0, fmt.Errorf("%d is out of valid range (0-255)", number)
+	}
+	return number, nil
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func TestParseICMPSpec(t *testing.T) {
+	matches, err := ParseICMPSpec("8, 3/4,0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []ICMPMatch{{Type: 8, Code: -1}, {Type: 3, Code: 4}, {Type: 0, Code: -1}}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Errorf("Expected %v, got %v", expected, matches)
+	}
+	if matches[1].String() != "3/4" {
+		t.Errorf("Expected '3/4', got '%s'", matches[1].String())
+	}
+
+	for _, spec := range []string{"", "256", "3/256", "echo", "1-5"} {
+		if _, err := ParseICMPSpec(spec); err == nil {
+			t.Errorf("Expected error for ICMP spec '%s'", spec)
+		}
+	}
+
+	if types := ICMPTypes(append(matches, ICMPMatch{Type: 3, Code: 1})); !reflect.DeepEqual(types, []uint32{0, 3, 8}) {
+		t.Errorf("Expected unique sorted types, got %v", types)
+	}
+}
+
+func TestIEAgAgRule_ToSGroupsProtoTransports(t *testing.T) {
+	rule := IEAgAgRule{
+		SelfRef:           NewSelfRef(NewResourceIdentifier("icmp", WithNamespace("default"))),
+		Transport:         ICMP,
+		Traffic:           INGRESS,
+		AddressGroupLocal: v1beta1.NamespacedObjectReference{Namespace: "default", ObjectReference: v1beta1.ObjectReference{Name: "local"}},
+		AddressGroup:      v1beta1.NamespacedObjectReference{Namespace: "default", ObjectReference: v1beta1.ObjectReference{Name: "remote"}},
+		Ports:             []PortSpec{{Destination: "8,0/0"}},
+		Action:            ActionAccept,
+	}
+
+	proto, err := rule.ToSGroupsProto()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	icmpRule, ok := proto.(*pb.IESgSgIcmpRule)
+	if !ok {
+		t.Fatalf("Expected *pb.IESgSgIcmpRule, got %T", proto)
+	}
+	if icmpRule.GetSgLocal() != "default/local" || icmpRule.GetSG() != "default/remote" {
+		t.Errorf("Unexpected security groups %s -> %s", icmpRule.GetSgLocal(), icmpRule.GetSG())
+	}
+	if !reflect.DeepEqual(icmpRule.GetICMP().GetTypes(), []uint32{0, 8}) {
+		t.Errorf("Expected ICMP types [0 8], got %v", icmpRule.GetICMP().GetTypes())
+	}
+
+	rule.Transport = SCTP
+	if _, err := rule.ToSGroupsProto(); !errors.Is(err, ErrTransportNotSupported) {
+		t.Errorf("Expected ErrTransportNotSupported for SCTP, got %v", err)
+	}
+}
@@ -0,0 +1,16 @@
+package models
+
+// IEAgAgRuleContribution records that a RuleS2S contributes ports to an aggregated IEAgAgRule.
+// The identifier of an aggregated rule is derived from its aggregation key (traffic, address
+// groups, protocol and action), so the contributions of a RuleS2S name the aggregation groups
+// to recompute when the RuleS2S or its services change.
+type IEAgAgRuleContribution struct {
+	RuleS2S    ResourceIdentifier // Contributing RuleS2S
+	IEAgAgRule ResourceIdentifier // Aggregated rule receiving the ports
+	Ports      []string           // Ports contributed before exception ports are subtracted
+}
+
+// Key returns the unique key of the contribution
+func (c IEAgAgRuleContribution) Key() string {
+	return c.IEAgAgRule.Key() + "<" + c.RuleS2S.Key()
+}
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// IEAgAgRuleUpdate is an IEAgAgRule replaced by a recalculation
+type IEAgAgRuleUpdate struct {
+	Before IEAgAgRule
+	After  IEAgAgRule
+}
+
+// IEAgAgRuleDiff describes committed changes of the generated IEAgAgRules of one namespace
+type IEAgAgRuleDiff struct {
+	Namespace string
+	Created   []IEAgAgRule
+	Updated   []IEAgAgRuleUpdate
+	Deleted   []IEAgAgRule
+	// Reasons are the causes of the recalculations merged into the diff
+	Reasons []string
+	Time    time.Time
+}
+
+// ieagagRuleStates holds the first known and the latest state of a rule, nil when absent
+type ieagagRuleStates struct {
+	before, after *IEAgAgRule
+}
+
+// NewIEAgAgRuleDiffs groups changes of one recalculation by the namespace of the rules.
+// updatedBefore holds the replaced states of updatedAfter, index by index.
+func NewIEAgAgRuleDiffs(created, updatedBefore, updatedAfter, deleted []IEAgAgRule, reason string, now time.Time) []IEAgAgRuleDiff {
+	states := make(map[string]map[string]*ieagagRuleStates)
+	set := func(rule IEAgAgRule, before, after bool) {
+		namespace := states[rule.Namespace]
+		if namespace == nil {
+			namespace = make(map[string]*ieagagRuleStates)
+			states[rule.Namespace] = namespace
+		}
+		state := namespace[rule.Key()]
+		if state == nil {
+			state = &ieagagRuleStates{}
+			namespace[rule.Key()] = state
+		}
+		if before {
+			state.before = &rule
+		}
+		if after {
+			state.after = &rule
+		}
+	}
+	for _, rule := range created {
+		set(rule, false, true)
+	}
+	for i := range updatedAfter {
+		if i < len(updatedBefore) {
+			set(updatedBefore[i], true, false)
+		}
+		set(updatedAfter[i], false, true)
+	}
+	for _, rule := range deleted {
+		set(rule, true, false)
+	}
+
+	diffs := make([]IEAgAgRuleDiff, 0, len(states))
+	for namespace, rules := range states {
+		diff := IEAgAgRuleDiff{Namespace: namespace, Reasons: []string{reason}, Time: now}
+		diff.fill(rules)
+		if !diff.Empty() {
+			diffs = append(diffs, diff)
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Namespace < diffs[j].Namespace })
+	return diffs
+}
+
+// Merge folds a later diff of the same namespace into d: a rule created and deleted in between
+// disappears, an updated rule keeps its first state before and its last state after
+func (d *IEAgAgRuleDiff) Merge(later IEAgAgRuleDiff) {
+	rules := d.states()
+	for key, state := range later.states() {
+		current, ok := rules[key]
+		if !ok {
+			rules[key] = state
+			continue
+		}
+		current.after = state.after
+	}
+	d.fill(rules)
+
+	for _, reason := range later.Reasons {
+		if !containsString(d.Reasons, reason) {
+			d.Reasons = append(d.Reasons, reason)
+		}
+	}
+	if later.Time.After(d.Time) {
+		d.Time = later.Time
+	}
+}
+
+// Empty reports whether the diff has no changes
+func (d IEAgAgRuleDiff) Empty() bool {
+	return len(d.Created) == 0 && len(d.Updated) == 0 && len(d.Deleted) == 0
+}
+
+func (d IEAgAgRuleDiff) states() map[string]*ieagagRuleStates {
+	rules := make(map[string]*ieagagRuleStates, len(d.Created)+len(d.Updated)+len(d.Deleted))
+	for i := range d.Created {
+		rules[d.Created[i].Key()] = &ieagagRuleStates{after: &d.Created[i]}
+	}
+	for i := range d.Updated {
+		rules[d.Updated[i].After.Key()] = &ieagagRuleStates{before: &d.Updated[i].Before, after: &d.Updated[i].After}
+	}
+	for i := range d.Deleted {
+		rules[d.Deleted[i].Key()] = &ieagagRuleStates{before: &d.Deleted[i]}
+	}
+	return rules
+}
+
+// fill replaces the changes of the diff with the rule states, ordered by rule key
+func (d *IEAgAgRuleDiff) fill(rules map[string]*ieagagRuleStates) {
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	d.Created, d.Updated, d.Deleted = nil, nil, nil
+	for _, key := range keys {
+		state := rules[key]
+		switch {
+		case state.before == nil && state.after == nil:
+		case state.before == nil:
+			d.Created = append(d.Created, *state.after)
+		case state.after == nil:
+			d.Deleted = append(d.Deleted, *state.before)
+		default:
+			d.Updated = append(d.Updated, IEAgAgRuleUpdate{Before: *state.before, After: *state.after})
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
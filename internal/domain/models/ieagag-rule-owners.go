@@ -0,0 +1,76 @@
+package models
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// Kinds of the owners of generated IEAgAgRules
+const (
+	OwnerKindRuleS2S      = "RuleS2S"
+	OwnerKindAddressGroup = "AddressGroup"
+)
+
+// ResourceOwner is a resource a generated IEAgAgRule is derived from
+type ResourceOwner struct {
+	Kind string
+	ID   ResourceIdentifier
+	UID  string
+}
+
+// IEAgAgRuleOwnerReferences returns the owner references of a rule in namespace. Kubernetes resolves
+// owner references in the namespace of the dependent only, so owners of other namespaces are skipped,
+// as are owners without UID. The references are unique and sorted by kind and name.
+func IEAgAgRuleOwnerReferences(namespace string, owners []ResourceOwner) []metav1.OwnerReference {
+	seen := make(map[string]bool, len(owners))
+	var references []metav1.OwnerReference
+	for _, owner := range owners {
+		if owner.UID == "" || owner.ID.Namespace != namespace || seen[owner.UID] {
+			continue
+		}
+		seen[owner.UID] = true
+		references = append(references, metav1.OwnerReference{
+			APIVersion: v1beta1.SchemeGroupVersion.String(),
+			Kind:       owner.Kind,
+			Name:       owner.ID.Name,
+			UID:        k8stypes.UID(owner.UID),
+		})
+	}
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].Kind != references[j].Kind {
+			return references[i].Kind < references[j].Kind
+		}
+		return references[i].Name < references[j].Name
+	})
+	return references
+}
+
+// OwnedOnlyBy reports whether the rule has owners and all of them have one of the UIDs
+func (r *IEAgAgRule) OwnedOnlyBy(uids map[string]bool) bool {
+	if len(r.Meta.OwnerReferences) == 0 {
+		return false
+	}
+	for _, owner := range r.Meta.OwnerReferences {
+		if !uids[string(owner.UID)] {
+			return false
+		}
+	}
+	return true
+}
+
+// OwnerReferencesEqual reports whether two sorted lists of owner references name the same owners
+func OwnerReferencesEqual(a, b []metav1.OwnerReference) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].UID != b[i].UID || a[i].Kind != b[i].Kind || a[i].Name != b[i].Name || a[i].APIVersion != b[i].APIVersion {
+			return false
+		}
+	}
+	return true
+}
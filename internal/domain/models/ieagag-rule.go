@@ -25,6 +25,19 @@ type IEAgAgRule struct {
 	Meta              Meta
 }
 
+// IEAgAgRuleGeneratedLabel marks IEAgAgRules generated from RuleS2S, only such rules are collected by rule GC
+const IEAgAgRuleGeneratedLabel = "netguard.sgroups.io/generated-from"
+
+// IsGeneratedFromRuleS2S reports whether the rule was generated from RuleS2S
+func (r *IEAgAgRule) IsGeneratedFromRuleS2S() bool {
+	return r.Meta.Labels[IEAgAgRuleGeneratedLabel] == "RuleS2S"
+}
+
+// GeneratedFromRuleS2SLabels returns the labels of a rule generated from RuleS2S
+func GeneratedFromRuleS2SLabels() map[string]string {
+	return map[string]string{IEAgAgRuleGeneratedLabel: "RuleS2S"}
+}
+
 // AddressGroupLocalKey returns the key for the AddressGroupLocal (namespace/name)
 func (r *IEAgAgRule) AddressGroupLocalKey() string {
 	if r.AddressGroupLocal.Namespace == "" {
@@ -55,6 +68,28 @@ const (
 	ActionDrop   RuleAction = "DROP"
 )
 
+// IsValid reports whether the action is a known rule action
+func (a RuleAction) IsValid() bool {
+	return a == ActionAccept || a == ActionDrop
+}
+
+// Приоритеты сгенерированных правил: sgroups применяет правила с меньшим приоритетом раньше,
+// поэтому запрещающие правила идут перед разрешающими, а базовые запрещающие правила
+// NamespacePosture применяются последними
+const (
+	RulePriorityDrop         int32 = 50
+	RulePriorityAccept       int32 = 100
+	RulePriorityBaselineDeny int32 = 200
+)
+
+// RulePriorityForAction возвращает приоритет сгенерированного правила для действия
+func RulePriorityForAction(action RuleAction) int32 {
+	if action == ActionDrop {
+		return RulePriorityDrop
+	}
+	return RulePriorityAccept
+}
+
 // IEAgAgRuleRef представляет ссылку на IEAgAgRule
 type IEAgAgRuleRef struct {
 	ResourceIdentifier
@@ -72,6 +107,11 @@ func (r *IEAgAgRule) GetSyncSubjectType() types.SyncSubjectType {
 	return types.SyncSubjectTypeIEAgAgRules
 }
 
+// GetNamespace returns the namespace of the IEAgAgRule
+func (r *IEAgAgRule) GetNamespace() string {
+	return r.Namespace
+}
+
 // GetSyncKey returns a unique key for the IEAgAgRule
 func (r *IEAgAgRule) GetSyncKey() string {
 	if r.Namespace != "" {
@@ -93,6 +133,11 @@ func (r *IEAgAgRule) ToSGroupsProto() (interface{}, error) {
 		transport = common.Networks_NetIP_TCP
 	case UDP:
 		transport = common.Networks_NetIP_UDP
+	case ICMP:
+		// ICMP rules are converted to IESgSgIcmpRule below
+	case SCTP:
+		// sgroups transports are TCP and UDP only
+		return nil, fmt.Errorf("%w: %s", ErrTransportNotSupported, r.Transport)
 	default:
 		transport = common.Networks_NetIP_TCP // default to TCP
 	}
@@ -144,6 +189,10 @@ func (r *IEAgAgRule) ToSGroupsProto() (interface{}, error) {
 		sgLocal = r.AddressGroupLocal.Name
 	}
 
+	if r.Transport == ICMP {
+		return r.toSGroupsIcmpProto(sg, sgLocal, traffic, action)
+	}
+
 	// Convert to single sgroups protobuf rule (batch aggregation will be handled by syncer)
 	pbRule := &pb.IESgSgRule{
 		Transport: transport,
@@ -154,9 +203,48 @@ func (r *IEAgAgRule) ToSGroupsProto() (interface{}, error) {
 		Logs:      r.Logs,
 		Action:    action,
 		Trace:     r.Trace,
+		Priority:  r.sgroupsPriority(),
 	}
 
 	// Return single rule element (not wrapped in SyncIESgSgRules)
 	// Batch aggregation will be handled by IEAgAgRuleSyncer.SyncBatch()
 	return pbRule, nil
 }
+
+// toSGroupsIcmpProto converts an ICMP IEAgAgRule to sgroups IESgSgIcmpRule.
+// Ports hold the ICMP types, sgroups matches types only so ICMP codes are not sent.
+func (r *IEAgAgRule) toSGroupsIcmpProto(sg, sgLocal string, traffic common.Traffic, action pb.RuleAction) (*pb.IESgSgIcmpRule, error) {
+	var matches []ICMPMatch
+	for _, port := range r.Ports {
+		if port.Destination == "" {
+			continue
+		}
+		parsed, err := ParseICMPSpec(port.Destination)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ICMP types of rule %s: %w", r.Key(), err)
+		}
+		matches = append(matches, parsed...)
+	}
+
+	return &pb.IESgSgIcmpRule{
+		SG:      sg,
+		SgLocal: sgLocal,
+		Traffic: traffic,
+		ICMP: &common.ICMP{
+			IPv:   common.IpAddrFamily_IPv4,
+			Types: ICMPTypes(matches),
+		},
+		Logs:     r.Logs,
+		Trace:    r.Trace,
+		Action:   action,
+		Priority: r.sgroupsPriority(),
+	}, nil
+}
+
+// sgroupsPriority returns the sgroups priority of the rule, nil leaves the sgroups default
+func (r *IEAgAgRule) sgroupsPriority() *pb.RulePriority {
+	if r.Priority == 0 {
+		return nil
+	}
+	return &pb.RulePriority{Value: &pb.RulePriority_Some{Some: r.Priority}}
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func diffTestRule(name, namespace, port string) IEAgAgRule {
+	return IEAgAgRule{
+		SelfRef: NewSelfRef(NewResourceIdentifier(name, WithNamespace(namespace))),
+		Ports:   []PortSpec{{Destination: port}},
+	}
+}
+
+// TestNewIEAgAgRuleDiffs проверяет группировку изменений правил по namespace
+func TestNewIEAgAgRuleDiffs(t *testing.T) {
+	now := time.Now()
+	diffs := NewIEAgAgRuleDiffs(
+		[]IEAgAgRule{diffTestRule("a", "prod", "80")},
+		[]IEAgAgRule{diffTestRule("b", "dev", "80")},
+		[]IEAgAgRule{diffTestRule("b", "dev", "80,443")},
+		[]IEAgAgRule{diffTestRule("c", "prod", "22")},
+		"service changed", now)
+
+	if len(diffs) != 2 {
+		t.Fatalf("Expected 2 namespace diffs, got %d", len(diffs))
+	}
+	// Диффы упорядочены по namespace
+	dev, prod := diffs[0], diffs[1]
+	if dev.Namespace != "dev" || prod.Namespace != "prod" {
+		t.Fatalf("Unexpected namespaces %s, %s", dev.Namespace, prod.Namespace)
+	}
+	if len(dev.Updated) != 1 || dev.Updated[0].Before.Ports[0].Destination != "80" || dev.Updated[0].After.Ports[0].Destination != "80,443" {
+		t.Errorf("Expected update of b from 80 to 80,443, got %+v", dev.Updated)
+	}
+	if len(prod.Created) != 1 || prod.Created[0].Name != "a" || len(prod.Deleted) != 1 || prod.Deleted[0].Name != "c" {
+		t.Errorf("Expected a created and c deleted in prod, got %+v", prod)
+	}
+	if len(prod.Reasons) != 1 || prod.Reasons[0] != "service changed" {
+		t.Errorf("Unexpected reasons %v", prod.Reasons)
+	}
+}
+
+// TestIEAgAgRuleDiff_Merge проверяет объединение последовательных диффов namespace
+func TestIEAgAgRuleDiff_Merge(t *testing.T) {
+	now := time.Now()
+	first := NewIEAgAgRuleDiffs(
+		[]IEAgAgRule{diffTestRule("a", "prod", "80")},
+		[]IEAgAgRule{diffTestRule("b", "prod", "80")},
+		[]IEAgAgRule{diffTestRule("b", "prod", "443")},
+		nil, "first", now)[0]
+	second := NewIEAgAgRuleDiffs(
+		nil,
+		[]IEAgAgRule{diffTestRule("b", "prod", "443")},
+		[]IEAgAgRule{diffTestRule("b", "prod", "8443")},
+		[]IEAgAgRule{diffTestRule("a", "prod", "80")},
+		"second", now.Add(time.Second))[0]
+
+	first.Merge(second)
+
+	// Созданное и удаленное в промежутке правило не попадает в дифф
+	if len(first.Created) != 0 || len(first.Deleted) != 0 {
+		t.Errorf("Expected a to disappear, got created %+v, deleted %+v", first.Created, first.Deleted)
+	}
+	// Измененное правило сохраняет первое исходное и последнее новое состояние
+	if len(first.Updated) != 1 || first.Updated[0].Before.Ports[0].Destination != "80" || first.Updated[0].After.Ports[0].Destination != "8443" {
+		t.Errorf("Expected update of b from 80 to 8443, got %+v", first.Updated)
+	}
+	if len(first.Reasons) != 2 || !first.Time.Equal(now.Add(time.Second)) {
+		t.Errorf("Unexpected reasons %v or time %v", first.Reasons, first.Time)
+	}
+}
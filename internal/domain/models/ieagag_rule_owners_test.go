@@ -0,0 +1,55 @@
+package models
+
+import "testing"
+
+// TestIEAgAgRuleOwnerReferences проверяет отбор, уникальность и порядок владельцев правила
+func TestIEAgAgRuleOwnerReferences(t *testing.T) {
+	owners := []ResourceOwner{
+		{Kind: OwnerKindRuleS2S, ID: NewResourceIdentifier("web", WithNamespace("prod")), UID: "uid-web"},
+		{Kind: OwnerKindAddressGroup, ID: NewResourceIdentifier("frontend", WithNamespace("prod")), UID: "uid-frontend"},
+		{Kind: OwnerKindAddressGroup, ID: NewResourceIdentifier("frontend", WithNamespace("prod")), UID: "uid-frontend"},
+		// Владельцы других namespace и без UID не ссылаются на правило
+		{Kind: OwnerKindAddressGroup, ID: NewResourceIdentifier("backend", WithNamespace("dev")), UID: "uid-backend"},
+		{Kind: OwnerKindRuleS2S, ID: NewResourceIdentifier("api", WithNamespace("prod"))},
+	}
+
+	references := IEAgAgRuleOwnerReferences("prod", owners)
+	if len(references) != 2 {
+		t.Fatalf("Expected 2 owner references, got %v", references)
+	}
+	if references[0].Kind != OwnerKindAddressGroup || references[0].Name != "frontend" {
+		t.Errorf("Expected address group frontend first, got %v", references[0])
+	}
+	if references[1].Kind != OwnerKindRuleS2S || references[1].UID != "uid-web" {
+		t.Errorf("Expected RuleS2S web second, got %v", references[1])
+	}
+	if references[1].APIVersion != "netguard.sgroups.io/v1beta1" {
+		t.Errorf("Unexpected API version %s", references[1].APIVersion)
+	}
+
+	if !OwnerReferencesEqual(references, IEAgAgRuleOwnerReferences("prod", owners)) {
+		t.Errorf("Expected equal owner references")
+	}
+	if OwnerReferencesEqual(references, references[:1]) {
+		t.Errorf("Expected different owner references")
+	}
+}
+
+// TestIEAgAgRule_OwnedOnlyBy проверяет удаление правил только вместе со всеми владельцами
+func TestIEAgAgRule_OwnedOnlyBy(t *testing.T) {
+	rule := IEAgAgRule{}
+	if rule.OwnedOnlyBy(map[string]bool{"uid-web": true}) {
+		t.Errorf("Expected a rule without owners to be kept")
+	}
+
+	rule.Meta.OwnerReferences = IEAgAgRuleOwnerReferences("prod", []ResourceOwner{
+		{Kind: OwnerKindRuleS2S, ID: NewResourceIdentifier("web", WithNamespace("prod")), UID: "uid-web"},
+		{Kind: OwnerKindRuleS2S, ID: NewResourceIdentifier("api", WithNamespace("prod")), UID: "uid-api"},
+	})
+	if rule.OwnedOnlyBy(map[string]bool{"uid-web": true}) {
+		t.Errorf("Expected a rule with a remaining owner to be kept")
+	}
+	if !rule.OwnedOnlyBy(map[string]bool{"uid-web": true, "uid-api": true}) {
+		t.Errorf("Expected a rule of deleted owners to be deleted")
+	}
+}
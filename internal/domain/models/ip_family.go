@@ -0,0 +1,75 @@
+package models
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// IPFamily is the IP address family of a CIDR
+type IPFamily string
+
+const (
+	IPFamilyIPv4 IPFamily = "IPv4"
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
+// ParseCIDR parses an IPv4 or IPv6 CIDR. IPv4-mapped IPv6 prefixes (::ffff:10.0.0.0/104)
+// are rejected: the same range must be declared as an IPv4 CIDR.
+func ParseCIDR(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix.Addr().Is4In6() {
+		return netip.Prefix{}, fmt.Errorf("IPv4-mapped IPv6 CIDR %s is not supported, use the IPv4 CIDR", cidr)
+	}
+	if prefix.Addr().Zone() != "" {
+		return netip.Prefix{}, fmt.Errorf("CIDR %s must not have an IPv6 zone", cidr)
+	}
+	return prefix, nil
+}
+
+// NormalizeCIDR returns the canonical form of a CIDR: host bits are cleared and
+// IPv6 addresses are compressed, so equal ranges always have the same text
+func NormalizeCIDR(cidr string) (string, error) {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	return prefix.Masked().String(), nil
+}
+
+// CIDRFamily returns the IP family of a CIDR
+func CIDRFamily(cidr string) (IPFamily, error) {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	if prefix.Addr().Is4() {
+		return IPFamilyIPv4, nil
+	}
+	return IPFamilyIPv6, nil
+}
+
+// CIDRsEqual reports whether two CIDRs denote the same range, invalid CIDRs are compared as text
+func CIDRsEqual(a, b string) bool {
+	normalizedA, errA := NormalizeCIDR(a)
+	normalizedB, errB := NormalizeCIDR(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return normalizedA == normalizedB
+}
+
+// CIDRsOverlap reports whether two CIDRs share addresses. CIDRs of different families never overlap.
+func CIDRsOverlap(a, b string) (bool, error) {
+	prefixA, err := ParseCIDR(a)
+	if err != nil {
+		return false, err
+	}
+	prefixB, err := ParseCIDR(b)
+	if err != nil {
+		return false, err
+	}
+	return prefixA.Overlaps(prefixB), nil
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"testing"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+)
+
+func TestNormalizeCIDR(t *testing.T) {
+	tests := []struct {
+		cidr     string
+		expected string
+		valid    bool
+	}{
+		{"10.0.0.0/24", "10.0.0.0/24", true},
+		{"10.0.0.7/24", "10.0.0.0/24", true},
+		{"FD00:0:0::1/64", "fd00::/64", true},
+		{"2001:db8::/32", "2001:db8::/32", true},
+		{"::ffff:10.0.0.0/104", "", false},
+		{"fe80::%eth0/64", "", false},
+		{"10.0.0.0", "", false},
+		{"10.0.0.0/33", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cidr, func(t *testing.T) {
+			normalized, err := NormalizeCIDR(tt.cidr)
+			if tt.valid != (err == nil) {
+				t.Fatalf("Expected valid=%t, got error %v", tt.valid, err)
+			}
+			if normalized != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.0.0/24", "10.0.1.0/24", false},
+		{"fd00::/48", "fd00:0:0:1::/64", true},
+		{"fd00::/64", "fd00:0:0:1::/64", false},
+		{"0.0.0.0/0", "::/0", false},
+	}
+
+	for _, tt := range tests {
+		overlap, err := CIDRsOverlap(tt.a, tt.b)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s and %s: %v", tt.a, tt.b, err)
+		}
+		if overlap != tt.expected {
+			t.Errorf("Expected overlap of %s and %s to be %t", tt.a, tt.b, tt.expected)
+		}
+	}
+}
+
+func TestAddressGroup_IPFamilies(t *testing.T) {
+	ag := AddressGroup{Networks: []NetworkItem{{Name: "ns/v6", CIDR: "fd00::/64"}}}
+	if families := ag.IPFamilies(); len(families) != 1 || families[0] != IPFamilyIPv6 {
+		t.Errorf("Expected [IPv6], got %v", families)
+	}
+	if ag.IsDualStack() {
+		t.Error("Expected single-stack address group")
+	}
+
+	ag.Networks = append(ag.Networks, NetworkItem{Name: "ns/v4", CIDR: "10.0.0.0/24"})
+	if families := ag.IPFamilies(); len(families) != 2 || families[0] != IPFamilyIPv4 {
+		t.Errorf("Expected [IPv4 IPv6], got %v", families)
+	}
+	if !ag.IsDualStack() {
+		t.Error("Expected dual-stack address group")
+	}
+}
+
+func TestNetwork_ToSGroupsProtoNormalizesIPv6(t *testing.T) {
+	network := NewNetwork("v6", "default", "FD00:0:0::/64")
+	if network.IPFamily() != IPFamilyIPv6 {
+		t.Errorf("Expected IPv6 family, got '%s'", network.IPFamily())
+	}
+
+	proto, err := network.ToSGroupsProto()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cidr := proto.(*pb.Network).GetNetwork().GetCIDR(); cidr != "fd00::/64" {
+		t.Errorf("Expected canonical CIDR 'fd00::/64', got '%s'", cidr)
+	}
+}
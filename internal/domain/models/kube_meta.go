@@ -5,6 +5,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// BackendSyncFinalizer keeps a resource marked for deletion until the backend has removed
+// its dependents and its sgroups state. The backend removes it together with the resource
+// once no other finalizers are left.
+const BackendSyncFinalizer = "netguard.sgroups.io/backend-sync"
+
 // Meta stores Kubernetes-specific metadata that must survive round-trip
 // through the aggregated API server and backend storage.
 // All fields are optional and may be empty when the object is first created
@@ -28,6 +33,14 @@ type Meta struct {
 	// Finalizers is a list of finalizers that must be processed before the object can be deleted
 	Finalizers []string `json:"finalizers,omitempty"`
 
+	// DeletionTimestamp records the deletion intent of a resource with finalizers.
+	// The resource stays readable until all finalizers are removed
+	DeletionTimestamp *metav1.Time `json:"deletionTimestamp,omitempty"`
+
+	// OwnerReferences lists the resources the resource depends on, e.g. the RuleS2S
+	// and AddressGroups of a generated IEAgAgRule
+	OwnerReferences []metav1.OwnerReference `json:"ownerReferences,omitempty"`
+
 	// Status management - формируется Backend, отображается в Status клиентам
 	Conditions         []metav1.Condition `json:"conditions,omitempty"`
 	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
@@ -179,3 +192,72 @@ func (m *Meta) RemoveManagedFieldsByManager(manager string) {
 	}
 	m.ManagedFields = filtered
 }
+
+// IsDeleting reports whether deletion of the resource was requested
+func (m *Meta) IsDeleting() bool {
+	return m != nil && m.DeletionTimestamp != nil
+}
+
+// MarkDeleting records the deletion intent, the first deletion timestamp is kept
+func (m *Meta) MarkDeleting(now metav1.Time) {
+	if m == nil || m.DeletionTimestamp != nil {
+		return
+	}
+	m.DeletionTimestamp = &now
+}
+
+// HasFinalizer reports whether the finalizer is set
+func (m *Meta) HasFinalizer(finalizer string) bool {
+	if m == nil {
+		return false
+	}
+	for _, f := range m.Finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// AddFinalizer adds the finalizer unless it is already set
+func (m *Meta) AddFinalizer(finalizer string) {
+	if m == nil || m.HasFinalizer(finalizer) {
+		return
+	}
+	m.Finalizers = append(m.Finalizers, finalizer)
+}
+
+// RemoveFinalizer removes the finalizer
+func (m *Meta) RemoveFinalizer(finalizer string) {
+	if m == nil {
+		return
+	}
+	filtered := make([]string, 0, len(m.Finalizers))
+	for _, f := range m.Finalizers {
+		if f != finalizer {
+			filtered = append(filtered, f)
+		}
+	}
+	m.Finalizers = filtered
+}
+
+// PendingFinalizers returns the finalizers the backend has to wait for before
+// it completes the deletion: all of them except BackendSyncFinalizer
+func (m *Meta) PendingFinalizers() []string {
+	if m == nil {
+		return nil
+	}
+	var pending []string
+	for _, f := range m.Finalizers {
+		if f != BackendSyncFinalizer {
+			pending = append(pending, f)
+		}
+	}
+	return pending
+}
+
+// ReadyToFinalize reports whether the resource is marked for deletion and only
+// the backend finalization is left
+func (m *Meta) ReadyToFinalize() bool {
+	return m.IsDeleting() && len(m.PendingFinalizers()) == 0
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+)
+
+// NamespacePostureLabel marks baseline deny IEAgAg rules, the value is the namespace of the posture
+const NamespacePostureLabel = "netguard.sgroups.io/namespace-posture"
+
+// NamespacePostureMode is the default traffic posture of a namespace
+type NamespacePostureMode string
+
+const (
+	// PostureDefaultAllow leaves the namespace to the rules generated from RuleS2S
+	PostureDefaultAllow NamespacePostureMode = "DefaultAllow"
+	// PostureDefaultDeny adds baseline deny rules between all AddressGroups of the namespace
+	PostureDefaultDeny NamespacePostureMode = "DefaultDeny"
+)
+
+// IsValid reports whether the mode is a known posture mode
+func (m NamespacePostureMode) IsValid() bool {
+	return m == PostureDefaultAllow || m == PostureDefaultDeny
+}
+
+// NamespacePosture defines the default traffic posture of its namespace
+type NamespacePosture struct {
+	SelfRef
+	Mode NamespacePostureMode
+	Meta Meta
+}
+
+// IsDefaultDeny reports whether the posture denies traffic not accepted by RuleS2S
+func (p *NamespacePosture) IsDefaultDeny() bool {
+	return p.Mode == PostureDefaultDeny
+}
+
+// BaselineDenyRules returns the baseline deny IEAgAg rules of a namespace: one rule per traffic
+// direction and transport for every pair of its address groups. The rules have the lowest priority,
+// so accept rules generated from RuleS2S are applied before them.
+func BaselineDenyRules(namespace string, addressGroups []AddressGroup) []IEAgAgRule {
+	var groups []AddressGroup
+	for _, group := range addressGroups {
+		if group.Namespace == namespace {
+			groups = append(groups, group)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+
+	var rules []IEAgAgRule
+	for _, local := range groups {
+		for _, target := range groups {
+			for _, traffic := range []Traffic{INGRESS, EGRESS} {
+				for _, transport := range []TransportProtocol{TCP, UDP} {
+					rules = append(rules, baselineDenyRule(namespace, traffic, transport, local, target))
+				}
+			}
+		}
+	}
+	return rules
+}
+
+// baselineDenyRule returns the baseline deny rule between two address groups
+func baselineDenyRule(namespace string, traffic Traffic, transport TransportProtocol, local, target AddressGroup) IEAgAgRule {
+	hash := sha256.Sum256([]byte(string(traffic) + "|" + string(transport) + "|" + local.Key() + "|" + target.Key()))
+	name := "posture-deny-" + hex.EncodeToString(hash[:])[:16]
+
+	return IEAgAgRule{
+		SelfRef:           NewSelfRef(NewResourceIdentifier(name, WithNamespace(namespace))),
+		Transport:         transport,
+		Traffic:           traffic,
+		AddressGroupLocal: NewAddressGroupRef(local.Name, WithNamespace(local.Namespace)),
+		AddressGroup:      NewAddressGroupRef(target.Name, WithNamespace(target.Namespace)),
+		Action:            ActionDrop,
+		Priority:          RulePriorityBaselineDeny,
+		Meta: Meta{
+			Labels: map[string]string{NamespacePostureLabel: namespace},
+			OwnerReferences: IEAgAgRuleOwnerReferences(namespace, []ResourceOwner{
+				{Kind: OwnerKindAddressGroup, ID: local.ResourceIdentifier, UID: local.Meta.UID},
+				{Kind: OwnerKindAddressGroup, ID: target.ResourceIdentifier, UID: target.Meta.UID},
+			}),
+		},
+	}
+}
+
+// IsBaselineDeny reports whether the rule is a baseline deny rule of a NamespacePosture
+func (r *IEAgAgRule) IsBaselineDeny() bool {
+	return r.Meta.Labels[NamespacePostureLabel] != ""
+}
+
+// NamespacePostureRef represents a reference to a NamespacePosture
+type NamespacePostureRef struct {
+	ResourceIdentifier
+}
+
+// NewNamespacePostureRef creates a new NamespacePostureRef
+func NewNamespacePostureRef(name string, opts ...ResourceIdentifierOption) NamespacePostureRef {
+	return NamespacePostureRef{ResourceIdentifier: NewResourceIdentifier(name, opts...)}
+}
@@ -0,0 +1,18 @@
+package models
+
+// NamespacePurgeResult describes the resources deleted by a namespace purge
+type NamespacePurgeResult struct {
+	Namespace string
+	// Deleted is the number of deleted resources per kind, e.g. "Service", kinds without
+	// deleted resources are omitted
+	Deleted map[string]int
+}
+
+// Total returns the number of deleted resources of all kinds
+func (r NamespacePurgeResult) Total() int {
+	total := 0
+	for _, count := range r.Deleted {
+		total += count
+	}
+	return total
+}
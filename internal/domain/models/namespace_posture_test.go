@@ -0,0 +1,45 @@
+package models
+
+import "testing"
+
+func TestBaselineDenyRules(t *testing.T) {
+	groups := []AddressGroup{
+		{SelfRef: NewSelfRef(NewResourceIdentifier("web", WithNamespace("prod")))},
+		{SelfRef: NewSelfRef(NewResourceIdentifier("db", WithNamespace("prod")))},
+		{SelfRef: NewSelfRef(NewResourceIdentifier("web", WithNamespace("dev")))},
+	}
+
+	rules := BaselineDenyRules("prod", groups)
+
+	// 2 groups give 4 ordered pairs, each with 2 traffic directions and 2 transports
+	if len(rules) != 16 {
+		t.Fatalf("Expected 16 baseline rules, got %d", len(rules))
+	}
+
+	names := make(map[string]bool)
+	for _, rule := range rules {
+		if rule.Namespace != "prod" || rule.AddressGroupLocal.Namespace != "prod" || rule.AddressGroup.Namespace != "prod" {
+			t.Errorf("Unexpected rule outside of namespace prod: %+v", rule)
+		}
+		if rule.Action != ActionDrop || rule.Priority != RulePriorityBaselineDeny || !rule.IsBaselineDeny() {
+			t.Errorf("Expected baseline deny rule, got %+v", rule)
+		}
+		if names[rule.Name] {
+			t.Errorf("Duplicate baseline rule name %s", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+
+	again := BaselineDenyRules("prod", []AddressGroup{groups[1], groups[0]})
+	for i := range rules {
+		if rules[i].Name != again[i].Name {
+			t.Errorf("Expected deterministic rules, got %s and %s", rules[i].Name, again[i].Name)
+		}
+	}
+}
+
+func TestBaselineDenyPriority(t *testing.T) {
+	if RulePriorityBaselineDeny <= RulePriorityAccept || RulePriorityBaselineDeny <= RulePriorityDrop {
+		t.Errorf("Baseline deny rules must be applied after generated rules")
+	}
+}
@@ -84,6 +84,12 @@ func (n *Network) ClearBinding() {
 	n.AddressGroupRef = nil
 }
 
+// IPFamily returns the IP family of the network CIDR, empty if the CIDR is invalid
+func (n *Network) IPFamily() IPFamily {
+	family, _ := CIDRFamily(n.CIDR)
+	return family
+}
+
 // IsReady returns true if the network is ready
 func (n *Network) IsReady() bool {
 	return n.Meta.IsReady()
@@ -168,10 +174,16 @@ func (n *Network) ToSGroupsProto() (interface{}, error) {
 	}
 
 	// Convert to single sgroups protobuf element (batch aggregation will be handled by syncer)
+	// sgroups compares CIDRs as text, IPv6 ranges are sent in the canonical form
+	cidr := n.CIDR
+	if normalized, err := NormalizeCIDR(cidr); err == nil {
+		cidr = normalized
+	}
+
 	protoNetwork := &pb.Network{
 		Name: networkName,
 		Network: &common.Networks_NetIP{
-			CIDR: n.CIDR,
+			CIDR: cidr,
 		},
 	}
 
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+// Sources of quarantined resources
+const (
+	// QuarantineSourceApply - manifests of /v2/apply which failed to decode
+	QuarantineSourceApply = "apply"
+	// QuarantineSourceReverseSync - SGROUP hosts whose IP addresses failed validation
+	QuarantineSourceReverseSync = "reverse-sync"
+)
+
+// QuarantinedResource is an imported resource which failed validation. It is kept
+// for review instead of being dropped and can be promoted (imported again) once fixed.
+type QuarantinedResource struct {
+	ID int64
+	// Source is the importer which rejected the resource (see QuarantineSource* constants)
+	Source string
+	// Kind is the kind of the resource as it was imported, empty if unknown
+	Kind string
+	// ResourceIdentifier is empty if the document has no name
+	ResourceIdentifier
+	// Reasons are the validation failures of the last import
+	Reasons []string
+	// Document is the resource as it was imported (YAML or JSON)
+	Document []byte
+	// Occurrences is the number of imports rejected since the resource was quarantined
+	Occurrences int
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+}
+
+// SameResource reports whether both entries quarantine the same resource of the same source,
+// so a repeated import updates the entry. Resources without a name are never the same.
+func (q QuarantinedResource) SameResource(other QuarantinedResource) bool {
+	return q.Name != "" &&
+		q.Source == other.Source &&
+		q.Kind == other.Kind &&
+		q.ResourceIdentifier == other.ResourceIdentifier
+}
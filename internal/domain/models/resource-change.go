@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Operations of resource changes published to the change-data feed
+const (
+	ResourceChangeCreate = "create"
+	ResourceChangeUpdate = "update"
+	ResourceChangeDelete = "delete"
+)
+
+// ResourceChange is a committed change of a resource with its stored state before and after the change
+type ResourceChange struct {
+	Kind string // Kind of the resource, e.g. Service
+	ResourceIdentifier
+	Operation string
+	// Before is nil for created resources, After is nil for deleted ones
+	Before interface{}
+	After  interface{}
+	Time   time.Time
+}
+
+// NewResourceChange derives the operation of a change from the snapshots
+func NewResourceChange(kind string, id ResourceIdentifier, before, after interface{}) ResourceChange {
+	operation := ResourceChangeUpdate
+	switch {
+	case after == nil:
+		operation = ResourceChangeDelete
+	case before == nil:
+		operation = ResourceChangeCreate
+	}
+	return ResourceChange{
+		Kind:               kind,
+		ResourceIdentifier: id,
+		Operation:          operation,
+		Before:             before,
+		After:              after,
+		Time:               time.Now(),
+	}
+}
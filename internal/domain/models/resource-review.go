@@ -0,0 +1,31 @@
+package models
+
+// ResourceReview is a requested write of a resource submitted to external validation before
+// it is applied. Operation is one of the resource change operations (create, update, delete).
+type ResourceReview struct {
+	Kind string // Kind of the resource, e.g. Service
+	ResourceIdentifier
+	Operation string
+	// Object is the requested state, nil for deletions. OldObject is the stored state, nil
+	// for created resources
+	Object    interface{}
+	OldObject interface{}
+}
+
+// NewResourceReview derives the operation of a review from the requested and stored states
+func NewResourceReview(kind string, id ResourceIdentifier, object, oldObject interface{}) ResourceReview {
+	operation := ResourceChangeUpdate
+	switch {
+	case object == nil:
+		operation = ResourceChangeDelete
+	case oldObject == nil:
+		operation = ResourceChangeCreate
+	}
+	return ResourceReview{
+		Kind:               kind,
+		ResourceIdentifier: id,
+		Operation:          operation,
+		Object:             object,
+		OldObject:          oldObject,
+	}
+}
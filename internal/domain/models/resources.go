@@ -3,6 +3,8 @@ package models
 import (
 	"fmt"
 	"time"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
 // ResourceIdentifier uniquely identifies a resource by name and namespace
@@ -48,7 +50,17 @@ func NewSelfRef(identifier ResourceIdentifier) SelfRef {
 	return SelfRef{ResourceIdentifier: identifier}
 }
 
-// TransportProtocol represents the transport protocol (TCP, UDP)
+// RefersTo compares an object reference with an identifier, an empty reference
+// namespace means the namespace of the referencing object
+func RefersTo(ref v1beta1.NamespacedObjectReference, ownerNamespace string, id ResourceIdentifier) bool {
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = ownerNamespace
+	}
+	return ref.Name == id.Name && namespace == id.Namespace
+}
+
+// TransportProtocol represents the transport protocol (TCP, UDP, SCTP, ICMP)
 type TransportProtocol string
 
 const (
@@ -56,8 +68,32 @@ const (
 	TCP TransportProtocol = "TCP"
 	// UDP protocol
 	UDP TransportProtocol = "UDP"
+	// SCTP protocol
+	SCTP TransportProtocol = "SCTP"
+	// ICMP protocol, ports hold ICMP types and codes ("0,3/4,8")
+	ICMP TransportProtocol = "ICMP"
 )
 
+// TransportProtocols returns all supported transport protocols
+func TransportProtocols() []TransportProtocol {
+	return []TransportProtocol{TCP, UDP, SCTP, ICMP}
+}
+
+// IsValid returns true for supported transport protocols
+func (p TransportProtocol) IsValid() bool {
+	switch p {
+	case TCP, UDP, SCTP, ICMP:
+		return true
+	}
+	return false
+}
+
+// HasPorts returns true if the protocol addresses services by ports.
+// ICMP has no ports, its rules match ICMP types instead.
+func (p TransportProtocol) HasPorts() bool {
+	return p != ICMP
+}
+
 // Traffic represents the direction of traffic (ingress, egress)
 type Traffic string
 
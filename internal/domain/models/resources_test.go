@@ -265,3 +265,28 @@ func TestSyncStatus(t *testing.T) {
 		t.Errorf("Expected updated at %v, got %v", now, status.UpdatedAt)
 	}
 }
+
+func TestRefersTo(t *testing.T) {
+	id := NewResourceIdentifier("web", WithNamespace("team-a"))
+
+	tests := []struct {
+		name           string
+		ref            v1beta1.NamespacedObjectReference
+		ownerNamespace string
+		expected       bool
+	}{
+		{"same namespace", NewServiceRef("web", WithNamespace("team-a")), "team-b", true},
+		{"owner namespace", NewServiceRef("web"), "team-a", true},
+		{"other namespace", NewServiceRef("web", WithNamespace("team-b")), "team-a", false},
+		{"other owner namespace", NewServiceRef("web"), "team-b", false},
+		{"other name", NewServiceRef("api", WithNamespace("team-a")), "team-a", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RefersTo(tt.ref, tt.ownerNamespace, id); got != tt.expected {
+				t.Errorf("RefersTo() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
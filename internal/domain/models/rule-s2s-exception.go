@@ -0,0 +1,46 @@
+package models
+
+// RuleS2SException excludes ports from the IEAgAg rules aggregated between two address groups,
+// the ports are removed even when contributing RuleS2S exist
+type RuleS2SException struct {
+	SelfRef
+	Traffic           Traffic
+	AddressGroupLocal AddressGroupRef   // Local address group of the excluded rules
+	AddressGroup      AddressGroupRef   // Remote address group of the excluded rules
+	Transport         TransportProtocol // Protocol of the excluded ports
+	Ports             string            // Excluded ports ("80,8000-8100") or ICMP types, empty excludes all ports of the protocol
+	Meta              Meta
+}
+
+// AddressGroupLocalKey returns the key for the AddressGroupLocal (namespace/name)
+func (e *RuleS2SException) AddressGroupLocalKey() string {
+	return AddressGroupRefKey(e.AddressGroupLocal)
+}
+
+// AddressGroupKey returns the key for the AddressGroup (namespace/name)
+func (e *RuleS2SException) AddressGroupKey() string {
+	return AddressGroupRefKey(e.AddressGroup)
+}
+
+// ExcludesAllPorts reports whether the exception removes all ports of its protocol
+func (e *RuleS2SException) ExcludesAllPorts() bool {
+	return e.Ports == ""
+}
+
+// Matches reports whether the exception applies to IEAgAg rules of the combination
+func (e *RuleS2SException) Matches(traffic Traffic, localAG, targetAG AddressGroupRef, transport TransportProtocol) bool {
+	return e.Traffic == traffic &&
+		e.Transport == transport &&
+		e.AddressGroupLocalKey() == AddressGroupRefKey(localAG) &&
+		e.AddressGroupKey() == AddressGroupRefKey(targetAG)
+}
+
+// RuleS2SExceptionRef represents a reference to a RuleS2SException
+type RuleS2SExceptionRef struct {
+	ResourceIdentifier
+}
+
+// NewRuleS2SExceptionRef creates a new RuleS2SExceptionRef
+func NewRuleS2SExceptionRef(name string, opts ...ResourceIdentifierOption) RuleS2SExceptionRef {
+	return RuleS2SExceptionRef{ResourceIdentifier: NewResourceIdentifier(name, opts...)}
+}
@@ -1,9 +1,28 @@
 package models
 
 import (
+	"time"
+
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
+// PortsSource selects the service whose ports a RuleS2S opens
+type PortsSource string
+
+const (
+	// PortsSourceAuto selects the service by traffic direction: local for INGRESS, target for EGRESS
+	PortsSourceAuto PortsSource = ""
+	// PortsSourceLocal opens the ports of the local service
+	PortsSourceLocal PortsSource = "LOCAL"
+	// PortsSourceTarget opens the ports of the target service
+	PortsSourceTarget PortsSource = "TARGET"
+)
+
+// IsValid reports whether the ports source is known
+func (p PortsSource) IsValid() bool {
+	return p == PortsSourceAuto || p == PortsSourceLocal || p == PortsSourceTarget
+}
+
 // RuleS2S represents a rule between two services
 type RuleS2S struct {
 	SelfRef
@@ -12,9 +31,75 @@ type RuleS2S struct {
 	ServiceRef      v1beta1.NamespacedObjectReference   // Full object reference with apiVersion, kind, name, namespace
 	IEAgAgRuleRefs  []v1beta1.NamespacedObjectReference // Full object references for created IEAGAG rules
 	Trace           bool                                // Whether to enable trace
+	Action          RuleAction                          // Action of generated IEAgAg rules, empty means ACCEPT
+	PortsSource     PortsSource                         // Service whose ports are opened, empty selects by traffic
+	ExtraPorts      []IngressPort                       // Ports opened in addition to the service ports
+	ValidFrom       time.Time                           // Rule generates IEAgAg rules from this time, zero - immediately
+	ValidUntil      time.Time                           // Rule stops generating IEAgAg rules at this time, zero - never
+	Priority        int32                               // Priority of generated IEAgAg rules, 0 - calculated by the priority strategy
 	Meta            Meta
 }
 
+// RulePolicyTierLabel assigns a RuleS2S to a policy tier of the rule priority strategy
+const RulePolicyTierLabel = "netguard.sgroups.io/policy-tier"
+
+// EffectiveAction returns the action of generated IEAgAg rules, ACCEPT when not set
+func (r *RuleS2S) EffectiveAction() RuleAction {
+	if r.Action == "" {
+		return ActionAccept
+	}
+	return r.Action
+}
+
+// IsActiveAt reports whether the time is within the validity window of the rule
+func (r *RuleS2S) IsActiveAt(t time.Time) bool {
+	if !r.ValidFrom.IsZero() && t.Before(r.ValidFrom) {
+		return false
+	}
+	return r.ValidUntil.IsZero() || t.Before(r.ValidUntil)
+}
+
+// ValidityChangedBetween reports whether the rule entered or left its validity window
+// after from and not later than to
+func (r *RuleS2S) ValidityChangedBetween(from, to time.Time) bool {
+	within := func(t time.Time) bool {
+		return !t.IsZero() && t.After(from) && !t.After(to)
+	}
+	return within(r.ValidFrom) || within(r.ValidUntil)
+}
+
+// UsesLocalServicePorts reports whether the rule opens the ports of the local service
+func (r *RuleS2S) UsesLocalServicePorts() bool {
+	switch r.PortsSource {
+	case PortsSourceLocal:
+		return true
+	case PortsSourceTarget:
+		return false
+	default:
+		return r.Traffic == INGRESS
+	}
+}
+
+// RulePorts returns the ports opened by the rule: the ports of the service selected by
+// PortsSource followed by ExtraPorts
+func (r *RuleS2S) RulePorts(localService, targetService *Service) []IngressPort {
+	portsService := targetService
+	if r.UsesLocalServicePorts() {
+		portsService = localService
+	}
+
+	var ports []IngressPort
+	if portsService != nil {
+		ports = append(ports, portsService.IngressPorts...)
+	}
+	return append(ports, r.ExtraPorts...)
+}
+
+// PolicyTier returns the policy tier of the rule, empty when the rule has no tier label
+func (r *RuleS2S) PolicyTier() string {
+	return r.Meta.Labels[RulePolicyTierLabel]
+}
+
 // ServiceLocalRefKey returns the key for the ServiceLocalRef (namespace/name)
 func (r *RuleS2S) ServiceLocalRefKey() string {
 	if r.ServiceLocalRef.Namespace == "" {
@@ -31,6 +116,12 @@ func (r *RuleS2S) ServiceRefKey() string {
 	return r.ServiceRef.Namespace + "/" + r.ServiceRef.Name
 }
 
+// IsCrossNamespace reports whether ServiceRef points to a Service outside of the rule namespace,
+// an empty ServiceRef namespace means the rule namespace
+func (r *RuleS2S) IsCrossNamespace() bool {
+	return r.Namespace != "" && r.ServiceRef.Namespace != "" && r.ServiceRef.Namespace != r.Namespace
+}
+
 // RuleS2SRef represents a reference to a RuleS2S
 type RuleS2SRef struct {
 	ResourceIdentifier
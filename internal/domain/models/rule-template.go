@@ -0,0 +1,76 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// RuleTemplateLabel marks RuleS2S generated from a RuleTemplate, the value is the template name
+const RuleTemplateLabel = "netguard.sgroups.io/rule-template"
+
+// RuleTemplate expands into RuleS2S between every pair of services of its namespace selected
+// by the local and target selectors
+type RuleTemplate struct {
+	SelfRef
+	Traffic               Traffic
+	LocalServiceSelector  map[string]string // Labels of the local services of the generated RuleS2S
+	TargetServiceSelector map[string]string // Labels of the target services of the generated RuleS2S
+	Action                RuleAction        // Action of the generated RuleS2S, empty means ACCEPT
+	Trace                 bool
+	Meta                  Meta
+}
+
+// MatchesLabels reports whether labels contain all labels of a selector, an empty selector matches nothing
+func MatchesLabels(selector, labels map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// SelectsLocal reports whether the service is a local service of the template
+func (t *RuleTemplate) SelectsLocal(service *Service) bool {
+	return service.Namespace == t.Namespace && MatchesLabels(t.LocalServiceSelector, service.Meta.Labels)
+}
+
+// SelectsTarget reports whether the service is a target service of the template
+func (t *RuleTemplate) SelectsTarget(service *Service) bool {
+	return service.Namespace == t.Namespace && MatchesLabels(t.TargetServiceSelector, service.Meta.Labels)
+}
+
+// GenerateRuleS2S returns the RuleS2S of the template between a local and a target service.
+// The name is derived from the template and both services, so the same pair always gets the same rule.
+func (t *RuleTemplate) GenerateRuleS2S(local, target *Service) RuleS2S {
+	hash := sha256.Sum256([]byte(string(t.Traffic) + "|" + local.Key() + "|" + target.Key()))
+	name := t.Name + "-" + hex.EncodeToString(hash[:])[:10]
+
+	return RuleS2S{
+		SelfRef:         NewSelfRef(NewResourceIdentifier(name, WithNamespace(t.Namespace))),
+		Traffic:         t.Traffic,
+		ServiceLocalRef: NewServiceRef(local.Name, WithNamespace(local.Namespace)),
+		ServiceRef:      NewServiceRef(target.Name, WithNamespace(target.Namespace)),
+		Trace:           t.Trace,
+		Action:          t.Action,
+		Meta:            Meta{Labels: map[string]string{RuleTemplateLabel: t.Name}},
+	}
+}
+
+// RuleTemplateName returns the name of the RuleTemplate the rule was generated from, empty for other rules
+func (r *RuleS2S) RuleTemplateName() string {
+	return r.Meta.Labels[RuleTemplateLabel]
+}
+
+// RuleTemplateRef represents a reference to a RuleTemplate
+type RuleTemplateRef struct {
+	ResourceIdentifier
+}
+
+// NewRuleTemplateRef creates a new RuleTemplateRef
+func NewRuleTemplateRef(name string, opts ...ResourceIdentifierOption) RuleTemplateRef {
+	return RuleTemplateRef{ResourceIdentifier: NewResourceIdentifier(name, opts...)}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"testing"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+)
+
+func TestRuleS2S_EffectiveAction(t *testing.T) {
+	rule := RuleS2S{}
+	if rule.EffectiveAction() != ActionAccept {
+		t.Errorf("Expected ACCEPT for empty action, got %s", rule.EffectiveAction())
+	}
+	rule.Action = ActionDrop
+	if rule.EffectiveAction() != ActionDrop {
+		t.Errorf("Expected DROP, got %s", rule.EffectiveAction())
+	}
+	if RuleAction("REJECT").IsValid() {
+		t.Error("Expected REJECT to be invalid")
+	}
+}
+
+func TestRulePriorityForAction(t *testing.T) {
+	if RulePriorityForAction(ActionDrop) >= RulePriorityForAction(ActionAccept) {
+		t.Errorf("Expected DROP priority %d to be applied before ACCEPT priority %d",
+			RulePriorityForAction(ActionDrop), RulePriorityForAction(ActionAccept))
+	}
+	if RulePriorityForAction("") != RulePriorityAccept {
+		t.Errorf("Expected ACCEPT priority for empty action, got %d", RulePriorityForAction(""))
+	}
+}
+
+func TestIEAgAgRule_ToSGroupsProtoActionAndPriority(t *testing.T) {
+	rule := IEAgAgRule{
+		SelfRef:   NewSelfRef(NewResourceIdentifier("drop", WithNamespace("default"))),
+		Transport: TCP,
+		Traffic:   INGRESS,
+		Ports:     []PortSpec{{Destination: "80"}},
+		Action:    ActionDrop,
+		Priority:  RulePriorityDrop,
+	}
+
+	proto, err := rule.ToSGroupsProto()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sgRule := proto.(*pb.IESgSgRule)
+	if sgRule.GetAction() != pb.RuleAction_DROP {
+		t.Errorf("Expected DROP action, got %s", sgRule.GetAction())
+	}
+	if sgRule.GetPriority().GetSome() != RulePriorityDrop {
+		t.Errorf("Expected priority %d, got %v", RulePriorityDrop, sgRule.GetPriority())
+	}
+
+	rule.Priority = 0
+	proto, err = rule.ToSGroupsProto()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if proto.(*pb.IESgSgRule).GetPriority() != nil {
+		t.Error("Expected no priority for rules without priority")
+	}
+}
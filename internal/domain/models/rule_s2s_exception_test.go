@@ -0,0 +1,34 @@
+package models
+
+import (
+	"testing"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func TestRuleS2SException_Matches(t *testing.T) {
+	agRef := func(namespace, name string) AddressGroupRef {
+		return v1beta1.NamespacedObjectReference{
+			ObjectReference: v1beta1.ObjectReference{Kind: "AddressGroup", Name: name},
+			Namespace:       namespace,
+		}
+	}
+	web, db := agRef("default", "web"), agRef("default", "db")
+	exception := RuleS2SException{Traffic: INGRESS, AddressGroupLocal: web, AddressGroup: db, Transport: TCP, Ports: "5432"}
+
+	if !exception.Matches(INGRESS, web, db, TCP) {
+		t.Error("Expected the exception to match its combination")
+	}
+	if exception.Matches(EGRESS, web, db, TCP) {
+		t.Error("Expected the exception not to match another traffic direction")
+	}
+	if exception.Matches(INGRESS, db, web, TCP) {
+		t.Error("Expected the exception not to match swapped address groups")
+	}
+	if exception.Matches(INGRESS, web, db, UDP) {
+		t.Error("Expected the exception not to match another transport")
+	}
+	if exception.ExcludesAllPorts() {
+		t.Error("Expected the exception with ports not to exclude all ports")
+	}
+}
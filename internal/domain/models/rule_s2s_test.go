@@ -0,0 +1,62 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRuleS2S_RulePorts(t *testing.T) {
+	local := &Service{IngressPorts: []IngressPort{{Protocol: TCP, Port: "80"}}}
+	target := &Service{IngressPorts: []IngressPort{{Protocol: TCP, Port: "5432"}}}
+	extra := IngressPort{Protocol: UDP, Port: "53"}
+
+	tests := []struct {
+		name     string
+		rule     RuleS2S
+		expected []IngressPort
+	}{
+		{"ingress uses local ports", RuleS2S{Traffic: INGRESS}, local.IngressPorts},
+		{"egress uses target ports", RuleS2S{Traffic: EGRESS}, target.IngressPorts},
+		{"egress with local override", RuleS2S{Traffic: EGRESS, PortsSource: PortsSourceLocal}, local.IngressPorts},
+		{"ingress with target override", RuleS2S{Traffic: INGRESS, PortsSource: PortsSourceTarget}, target.IngressPorts},
+		{"extra ports are added", RuleS2S{Traffic: INGRESS, ExtraPorts: []IngressPort{extra}},
+			[]IngressPort{local.IngressPorts[0], extra}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if ports := tt.rule.RulePorts(local, target); !reflect.DeepEqual(ports, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, ports)
+			}
+		})
+	}
+
+	if PortsSource("BOTH").IsValid() {
+		t.Error("Expected BOTH to be an invalid ports source")
+	}
+}
+
+func TestRuleS2S_IsActiveAt(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	rule := RuleS2S{ValidFrom: now.Add(-time.Hour), ValidUntil: now.Add(time.Hour)}
+
+	if !(&RuleS2S{}).IsActiveAt(now) {
+		t.Error("Expected a rule without validity window to be active")
+	}
+	if !rule.IsActiveAt(now) {
+		t.Error("Expected the rule to be active within its window")
+	}
+	if rule.IsActiveAt(now.Add(-2 * time.Hour)) {
+		t.Error("Expected the rule to be inactive before validFrom")
+	}
+	if rule.IsActiveAt(now.Add(time.Hour)) {
+		t.Error("Expected the rule to be inactive at validUntil")
+	}
+
+	if !rule.ValidityChangedBetween(now, now.Add(time.Hour)) {
+		t.Error("Expected validUntil to be a transition within (now, now+1h]")
+	}
+	if rule.ValidityChangedBetween(now.Add(-time.Hour), now) {
+		t.Error("Expected validFrom at the start of the interval not to be a transition")
+	}
+}
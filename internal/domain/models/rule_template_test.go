@@ -0,0 +1,72 @@
+package models
+
+import "testing"
+
+func newLabeledService(name string, labels map[string]string) *Service {
+	return &Service{
+		SelfRef: NewSelfRef(NewResourceIdentifier(name, WithNamespace("default"))),
+		Meta:    Meta{Labels: labels},
+	}
+}
+
+func TestMatchesLabels(t *testing.T) {
+	labels := map[string]string{"app": "web", "tier": "frontend"}
+
+	if !MatchesLabels(map[string]string{"app": "web"}, labels) {
+		t.Errorf("Expected subset selector to match")
+	}
+	if MatchesLabels(map[string]string{"app": "db"}, labels) {
+		t.Errorf("Expected selector with another value not to match")
+	}
+	if MatchesLabels(nil, labels) {
+		t.Errorf("Expected empty selector to match nothing")
+	}
+}
+
+func TestRuleTemplate_Selects(t *testing.T) {
+	template := RuleTemplate{
+		SelfRef:               NewSelfRef(NewResourceIdentifier("web-to-db", WithNamespace("default"))),
+		LocalServiceSelector:  map[string]string{"app": "web"},
+		TargetServiceSelector: map[string]string{"app": "db"},
+	}
+
+	web := newLabeledService("web", map[string]string{"app": "web"})
+	if !template.SelectsLocal(web) || template.SelectsTarget(web) {
+		t.Errorf("Expected web to be selected as local service only")
+	}
+
+	other := newLabeledService("web", map[string]string{"app": "web"})
+	other.Namespace = "other"
+	if template.SelectsLocal(other) {
+		t.Errorf("Expected services of other namespaces not to be selected")
+	}
+}
+
+func TestRuleTemplate_GenerateRuleS2S(t *testing.T) {
+	template := RuleTemplate{
+		SelfRef: NewSelfRef(NewResourceIdentifier("web-to-db", WithNamespace("default"))),
+		Traffic: EGRESS,
+		Action:  ActionDrop,
+		Trace:   true,
+	}
+	web := newLabeledService("web", nil)
+	db := newLabeledService("db", nil)
+
+	rule := template.GenerateRuleS2S(web, db)
+	if rule.Namespace != "default" || rule.Traffic != EGRESS || rule.Action != ActionDrop || !rule.Trace {
+		t.Errorf("Unexpected generated rule %+v", rule)
+	}
+	if rule.ServiceLocalRef.Name != "web" || rule.ServiceRef.Name != "db" {
+		t.Errorf("Unexpected services of generated rule: %s -> %s", rule.ServiceLocalRef.Name, rule.ServiceRef.Name)
+	}
+	if rule.RuleTemplateName() != "web-to-db" {
+		t.Errorf("RuleTemplateName() = %q, want web-to-db", rule.RuleTemplateName())
+	}
+
+	if again := template.GenerateRuleS2S(web, db); again.Name != rule.Name {
+		t.Errorf("Expected the same name for the same pair, got %s and %s", rule.Name, again.Name)
+	}
+	if reversed := template.GenerateRuleS2S(db, web); reversed.Name == rule.Name {
+		t.Errorf("Expected different names for reversed pairs, got %s", reversed.Name)
+	}
+}
@@ -0,0 +1,32 @@
+package models
+
+import "fmt"
+
+// SchemaStatus compares the schema version of the database with the version the backend
+// was built against. Migrations are applied by a separate goose job, the backend only
+// checks the result.
+type SchemaStatus struct {
+	// Version is the last applied migration, 0 if no migration was applied
+	Version int64 `json:"version"`
+	// SupportedVersion is the last migration known to the backend
+	SupportedVersion int64 `json:"supportedVersion"`
+}
+
+// Compatible reports whether the backend can serve the database schema
+func (s SchemaStatus) Compatible() bool {
+	return s.Version == s.SupportedVersion
+}
+
+// Err explains why the backend cannot serve the database schema, nil if it can
+func (s SchemaStatus) Err() error {
+	switch {
+	case s.Version < s.SupportedVersion:
+		return fmt.Errorf("database schema version %d is older than version %d of the backend, apply the pending migrations",
+			s.Version, s.SupportedVersion)
+	case s.Version > s.SupportedVersion:
+		return fmt.Errorf("database schema version %d is newer than version %d of the backend, upgrade the backend",
+			s.Version, s.SupportedVersion)
+	default:
+		return nil
+	}
+}
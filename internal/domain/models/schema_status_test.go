@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaStatus(t *testing.T) {
+	current := SchemaStatus{Version: 36, SupportedVersion: 36}
+	assert.True(t, current.Compatible())
+	assert.NoError(t, current.Err())
+
+	// Миграции еще не применены
+	older := SchemaStatus{Version: 35, SupportedVersion: 36}
+	assert.False(t, older.Compatible())
+	assert.ErrorContains(t, older.Err(), "apply the pending migrations")
+
+	// Бэкенд не обновлен после миграций
+	newer := SchemaStatus{Version: 37, SupportedVersion: 36}
+	assert.False(t, newer.Compatible())
+	assert.ErrorContains(t, newer.Err(), "upgrade the backend")
+}
@@ -0,0 +1,79 @@
+package models
+
+import "strconv"
+
+// SelectorAttributes returns the labels and the field selector values of a resource.
+// Field names follow the Kubernetes representation (metadata.name, spec.traffic, ...).
+// ok is false for resources of unsupported kinds.
+func SelectorAttributes(resource interface{}) (labels map[string]string, fields map[string]string, ok bool) {
+	var (
+		id   ResourceIdentifier
+		meta Meta
+	)
+	fields = map[string]string{}
+
+	switch r := resource.(type) {
+	case Service:
+		id, meta = r.ResourceIdentifier, r.Meta
+	case AddressGroup:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.defaultAction"] = string(r.DefaultAction)
+	case AddressGroupBinding:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.serviceRef.name"] = r.ServiceRef.Name
+		fields["spec.serviceRef.namespace"] = r.ServiceRef.Namespace
+		fields["spec.addressGroupRef.name"] = r.AddressGroupRef.Name
+		fields["spec.addressGroupRef.namespace"] = r.AddressGroupRef.Namespace
+	case AddressGroupPortMapping:
+		id, meta = r.ResourceIdentifier, r.Meta
+	case RuleS2S:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.traffic"] = string(r.Traffic)
+		fields["spec.action"] = string(r.Action)
+		fields["spec.serviceLocalRef.name"] = r.ServiceLocalRef.Name
+		fields["spec.serviceLocalRef.namespace"] = r.ServiceLocalRef.Namespace
+		fields["spec.serviceRef.name"] = r.ServiceRef.Name
+		fields["spec.serviceRef.namespace"] = r.ServiceRef.Namespace
+	case ServiceAlias:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.serviceRef.name"] = r.ServiceRef.Name
+		fields["spec.serviceRef.namespace"] = r.ServiceRef.Namespace
+	case AddressGroupBindingPolicy:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.serviceRef.name"] = r.ServiceRef.Name
+		fields["spec.serviceRef.namespace"] = r.ServiceRef.Namespace
+		fields["spec.addressGroupRef.name"] = r.AddressGroupRef.Name
+		fields["spec.addressGroupRef.namespace"] = r.AddressGroupRef.Namespace
+	case IEAgAgRule:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.traffic"] = string(r.Traffic)
+		fields["spec.transport"] = string(r.Transport)
+		fields["spec.action"] = string(r.Action)
+		fields["spec.addressGroupLocal.name"] = r.AddressGroupLocal.Name
+		fields["spec.addressGroupLocal.namespace"] = r.AddressGroupLocal.Namespace
+		fields["spec.addressGroup.name"] = r.AddressGroup.Name
+		fields["spec.addressGroup.namespace"] = r.AddressGroup.Namespace
+	case Network:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.cidr"] = r.CIDR
+		fields["status.isBound"] = strconv.FormatBool(r.IsBound)
+	case NetworkBinding:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.networkRef.name"] = r.NetworkRef.Name
+		fields["spec.addressGroupRef.name"] = r.AddressGroupRef.Name
+	case Host:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.uuid"] = r.UUID
+		fields["status.isBound"] = strconv.FormatBool(r.IsBound)
+	case HostBinding:
+		id, meta = r.ResourceIdentifier, r.Meta
+		fields["spec.hostRef.name"] = r.HostRef.Name
+		fields["spec.addressGroupRef.name"] = r.AddressGroupRef.Name
+	default:
+		return nil, nil, false
+	}
+
+	fields["metadata.name"] = id.Name
+	fields["metadata.namespace"] = id.Namespace
+	return meta.Labels, fields, true
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"sort"
+	"testing"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// Field selectors are validated by the apiserver against v1beta1.SelectableFields and evaluated
+// by the backend on SelectorAttributes, both must list the same fields
+func TestSelectorAttributes_MatchSelectableFields(t *testing.T) {
+	resources := map[string]interface{}{
+		"Service":                   Service{},
+		"AddressGroup":              AddressGroup{},
+		"AddressGroupBinding":       AddressGroupBinding{},
+		"AddressGroupPortMapping":   AddressGroupPortMapping{},
+		"RuleS2S":                   RuleS2S{},
+		"ServiceAlias":              ServiceAlias{},
+		"AddressGroupBindingPolicy": AddressGroupBindingPolicy{},
+		"IEAgAgRule":                IEAgAgRule{},
+		"Network":                   Network{},
+		"NetworkBinding":            NetworkBinding{},
+		"Host":                      Host{},
+		"HostBinding":               HostBinding{},
+	}
+	if len(resources) != len(v1beta1.SelectableFields) {
+		t.Fatalf("expected %d kinds, v1beta1 declares %d", len(resources), len(v1beta1.SelectableFields))
+	}
+
+	for kind, resource := range resources {
+		_, fields, ok := SelectorAttributes(resource)
+		if !ok {
+			t.Errorf("%s: not supported", kind)
+			continue
+		}
+		got := make([]string, 0, len(fields))
+		for field := range fields {
+			got = append(got, field)
+		}
+		expected := append([]string{"metadata.name", "metadata.namespace"}, v1beta1.SelectableFields[kind]...)
+		sort.Strings(got)
+		sort.Strings(expected)
+		if len(got) != len(expected) {
+			t.Errorf("%s: expected fields %v, got %v", kind, expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != expected[i] {
+				t.Errorf("%s: expected fields %v, got %v", kind, expected, got)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"sort"
+)
+
+// AddressGroupRegistrationConflict describes an address group registered for a Service more than once
+type AddressGroupRegistrationConflict struct {
+	Ref     AddressGroupRef
+	Sources []AddressGroupRegistrationSource
+}
+
+// AggregateAddressGroups merges the address groups registered in Service.spec and by AddressGroupBindings.
+// Spec groups come first in spec order, binding groups follow ordered by namespace/name. A group registered
+// more than once is kept once with its first source (spec wins over binding) and is reported as a conflict.
+func AggregateAddressGroups(specRefs, bindingRefs []AddressGroupRef) ([]AddressGroupReference, []AddressGroupRegistrationConflict) {
+	sortedBindings := append([]AddressGroupRef(nil), bindingRefs...)
+	sort.SliceStable(sortedBindings, func(i, j int) bool {
+		return AddressGroupRefKey(sortedBindings[i]) < AddressGroupRefKey(sortedBindings[j])
+	})
+
+	var aggregated []AddressGroupReference
+	sources := make(map[string][]AddressGroupRegistrationSource)
+	var conflictKeys []string
+
+	add := func(ref AddressGroupRef, source AddressGroupRegistrationSource) {
+		key := AddressGroupRefKey(ref)
+		if registered, exists := sources[key]; exists {
+			if len(registered) == 1 {
+				conflictKeys = append(conflictKeys, key)
+			}
+			sources[key] = append(registered, source)
+			return
+		}
+		sources[key] = []AddressGroupRegistrationSource{source}
+		aggregated = append(aggregated, AddressGroupReference{Ref: ref, Source: source})
+	}
+
+	for _, ref := range specRefs {
+		add(ref, AddressGroupSourceSpec)
+	}
+	for _, ref := range sortedBindings {
+		add(ref, AddressGroupSourceBinding)
+	}
+
+	var conflicts []AddressGroupRegistrationConflict
+	for _, key := range conflictKeys {
+		for _, entry := range aggregated {
+			if AddressGroupRefKey(entry.Ref) == key {
+				conflicts = append(conflicts, AddressGroupRegistrationConflict{Ref: entry.Ref, Sources: sources[key]})
+				break
+			}
+		}
+	}
+	return aggregated, conflicts
+}
+
+// GetAggregatedAddressGroups returns the address groups of the service from spec and bindings
+// without duplicates and in deterministic order. Spec groups are taken from AddressGroups,
+// binding groups from the binding entries of AggregatedAddressGroups. An empty non-nil
+// AggregatedAddressGroups stays empty and non-nil.
+func (s *Service) GetAggregatedAddressGroups() []AddressGroupReference {
+	aggregated, _ := AggregateAddressGroups(s.specAddressGroupRefs(), s.bindingAddressGroupRefs())
+	if aggregated == nil && s.AggregatedAddressGroups != nil {
+		return []AddressGroupReference{}
+	}
+	return aggregated
+}
+
+// AddressGroupRegistrationConflicts returns the address groups registered for the service more than once,
+// either both in spec and by an AddressGroupBinding or by several bindings
+func (s *Service) AddressGroupRegistrationConflicts() []AddressGroupRegistrationConflict {
+	_, conflicts := AggregateAddressGroups(s.specAddressGroupRefs(), s.bindingAddressGroupRefs())
+	return conflicts
+}
+
+// specAddressGroupRefs returns the spec address groups of the service, spec entries of
+// AggregatedAddressGroups missing from AddressGroups (stale or partial objects) are kept after them
+func (s *Service) specAddressGroupRefs() []AddressGroupRef {
+	refs := append([]AddressGroupRef(nil), s.AddressGroups...)
+	seen := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		seen[AddressGroupRefKey(ref)] = true
+	}
+	for _, entry := range s.AggregatedAddressGroups {
+		if entry.Source == AddressGroupSourceSpec && !seen[AddressGroupRefKey(entry.Ref)] {
+			seen[AddressGroupRefKey(entry.Ref)] = true
+			refs = append(refs, entry.Ref)
+		}
+	}
+	return refs
+}
+
+// bindingAddressGroupRefs returns the address groups registered for the service by AddressGroupBindings
+func (s *Service) bindingAddressGroupRefs() []AddressGroupRef {
+	var refs []AddressGroupRef
+	for _, entry := range s.AggregatedAddressGroups {
+		if entry.Source == AddressGroupSourceBinding {
+			refs = append(refs, entry.Ref)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newAggregatedRef(name string, source AddressGroupRegistrationSource) AddressGroupReference {
+	return AddressGroupReference{Ref: NewAddressGroupRef(name, WithNamespace("default")), Source: source}
+}
+
+func aggregatedKeys(refs []AddressGroupReference) []string {
+	var keys []string
+	for _, ref := range refs {
+		keys = append(keys, AddressGroupRefKey(ref.Ref)+"@"+string(ref.Source))
+	}
+	return keys
+}
+
+func TestService_GetAggregatedAddressGroups(t *testing.T) {
+	service := Service{
+		AddressGroups: []AddressGroupRef{
+			NewAddressGroupRef("web", WithNamespace("default")),
+			NewAddressGroupRef("api", WithNamespace("default")),
+		},
+		// Binding entries arrive in arbitrary order and may repeat spec groups
+		AggregatedAddressGroups: []AddressGroupReference{
+			newAggregatedRef("web", AddressGroupSourceSpec),
+			newAggregatedRef("db", AddressGroupSourceBinding),
+			newAggregatedRef("web", AddressGroupSourceBinding),
+			newAggregatedRef("api", AddressGroupSourceSpec),
+			newAggregatedRef("cache", AddressGroupSourceBinding),
+		},
+	}
+
+	expected := []string{
+		"default/web@spec",
+		"default/api@spec",
+		"default/cache@binding",
+		"default/db@binding",
+	}
+	if keys := aggregatedKeys(service.GetAggregatedAddressGroups()); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("GetAggregatedAddressGroups() = %v, want %v", keys, expected)
+	}
+
+	conflicts := service.AddressGroupRegistrationConflicts()
+	if len(conflicts) != 1 {
+		t.Fatalf("Expected one conflict, got %v", conflicts)
+	}
+	if AddressGroupRefKey(conflicts[0].Ref) != "default/web" ||
+		!reflect.DeepEqual(conflicts[0].Sources, []AddressGroupRegistrationSource{AddressGroupSourceSpec, AddressGroupSourceBinding}) {
+		t.Errorf("Unexpected conflict %+v", conflicts[0])
+	}
+}
+
+func TestService_GetAggregatedAddressGroups_SpecOnly(t *testing.T) {
+	// Without aggregated data (memory backend) the spec groups are returned
+	service := Service{AddressGroups: []AddressGroupRef{NewAddressGroupRef("web", WithNamespace("default"))}}
+
+	if keys := aggregatedKeys(service.GetAggregatedAddressGroups()); !reflect.DeepEqual(keys, []string{"default/web@spec"}) {
+		t.Errorf("GetAggregatedAddressGroups() = %v", keys)
+	}
+	if conflicts := service.AddressGroupRegistrationConflicts(); len(conflicts) != 0 {
+		t.Errorf("Expected no conflicts, got %v", conflicts)
+	}
+}
+
+func TestService_GetAggregatedAddressGroups_Empty(t *testing.T) {
+	// Without any address groups nil stays nil and an empty list stays empty
+	if refs := (&Service{}).GetAggregatedAddressGroups(); refs != nil {
+		t.Errorf("GetAggregatedAddressGroups() = %v, want nil", refs)
+	}
+	service := Service{AggregatedAddressGroups: []AddressGroupReference{}}
+	if refs := service.GetAggregatedAddressGroups(); refs == nil || len(refs) != 0 {
+		t.Errorf("GetAggregatedAddressGroups() = %#v, want an empty non-nil slice", refs)
+	}
+}
+
+func TestAggregateAddressGroups_RepeatedBindings(t *testing.T) {
+	db := NewAddressGroupRef("db", WithNamespace("default"))
+
+	aggregated, conflicts := AggregateAddressGroups(nil, []AddressGroupRef{db, db})
+	if keys := aggregatedKeys(aggregated); !reflect.DeepEqual(keys, []string{"default/db@binding"}) {
+		t.Errorf("AggregateAddressGroups() = %v", keys)
+	}
+	if len(conflicts) != 1 || len(conflicts[0].Sources) != 2 {
+		t.Errorf("Expected one conflict of two bindings, got %+v", conflicts)
+	}
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// StartupReport describes how the backend was started: storage, synchronization,
+// authentication, listeners and enabled features
+type StartupReport struct {
+	App       string    `json:"app"`
+	Version   string    `json:"version"`
+	StartedAt time.Time `json:"startedAt"`
+
+	// StorageBackend is "memory" or "postgres"
+	StorageBackend string `json:"storageBackend"`
+	// SchemaVersion is the last applied migration, 0 if unknown or not applicable
+	SchemaVersion int64 `json:"schemaVersion"`
+
+	// Syncers are the registered sgroups syncers per sync target, empty if sync is disabled
+	Syncers []StartupSyncer `json:"syncers"`
+
+	ReverseSync StartupReverseSync `json:"reverseSync"`
+
+	// AuthMode is the authentication type of the sgroups connection
+	AuthMode string `json:"authMode"`
+
+	GRPCAddr string `json:"grpcAddr"`
+	HTTPAddr string `json:"httpAddr"`
+
+	// Features are optional features by name and whether they are enabled
+	Features map[string]bool `json:"features"`
+}
+
+// StartupSyncer lists subject types synchronized to a sgroups target
+type StartupSyncer struct {
+	Target       string                  `json:"target"`
+	SubjectTypes []types.SyncSubjectType `json:"subjectTypes"`
+}
+
+// StartupReverseSync describes the SGROUP -> NETGUARD synchronization
+type StartupReverseSync struct {
+	Enabled   bool `json:"enabled"`
+	AutoStart bool `json:"autoStart"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// SyncOutboxEntry is a pending sgroups sync operation stored in the same
+// transaction as the resource change, so it is delivered even after a crash
+type SyncOutboxEntry struct {
+	ID int64
+	// Kind is the kind of the resource (see ChangeKind* constants)
+	Kind string
+	// Operation is the sgroups sync operation
+	Operation types.SyncOperation
+	// Resource holds a snapshot of the resource taken at enqueue time
+	Resource interface{}
+	// Attempts is the number of failed delivery attempts
+	Attempts int
+	// LastError is the error of the last failed delivery attempt
+	LastError string
+	CreatedAt time.Time
+	// FailedAt is the time the entry was moved to the dead-letter queue
+	FailedAt time.Time
+}
+
+// NewAddressGroupSyncOutboxEntry creates an outbox entry for an AddressGroup
+func NewAddressGroupSyncOutboxEntry(addressGroup AddressGroup, operation types.SyncOperation) SyncOutboxEntry {
+	return SyncOutboxEntry{
+		Kind:      ChangeKindAddressGroup,
+		Operation: operation,
+		Resource:  addressGroup,
+		CreatedAt: time.Now(),
+	}
+}
+
+// ResourceIdentifier returns the identifier of the synced resource
+func (e SyncOutboxEntry) ResourceIdentifier() (ResourceIdentifier, bool) {
+	switch resource := e.Resource.(type) {
+	case AddressGroup:
+		return resource.ResourceIdentifier, true
+	default:
+		return ResourceIdentifier{}, false
+	}
+}
@@ -0,0 +1,18 @@
+package models
+
+// Tenant is a group of namespaces owned by one client. Resources of a tenant-scoped
+// request are limited to the namespaces of its tenant.
+type Tenant struct {
+	Name       string
+	Namespaces []string
+}
+
+// Owns reports whether the namespace belongs to the tenant
+func (t Tenant) Owns(namespace string) bool {
+	for _, ns := range t.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package models
+
+// TrafficEndpoint is the source or destination of a simulated connection,
+// either an address group or a host bound to an address group
+type TrafficEndpoint struct {
+	AddressGroup *AddressGroupRef
+	Host         *ResourceIdentifier
+}
+
+// TrafficSimulationRequest describes a connection to check against the stored IEAgAg rules
+type TrafficSimulationRequest struct {
+	Source      TrafficEndpoint
+	Destination TrafficEndpoint
+	Transport   TransportProtocol
+	Port        int // Destination port, ICMP type for ICMP
+}
+
+// TrafficRuleMatch is an IEAgAg rule matching a simulated connection
+type TrafficRuleMatch struct {
+	Rule     ResourceIdentifier
+	Action   RuleAction
+	Priority int32
+	Ports    string
+	Decisive bool // The first matching rule decides the verdict of its direction
+}
+
+// TrafficSimulationSide is the evaluation of a simulated connection in one traffic direction
+type TrafficSimulationSide struct {
+	Traffic           Traffic
+	AddressGroupLocal AddressGroupRef
+	AddressGroup      AddressGroupRef
+	Matches           []TrafficRuleMatch
+	Verdict           RuleAction
+	DefaultAction     bool // No rule matched, the verdict is the default action of the local address group
+}
+
+// TrafficSimulationResult holds the rule chains of both directions and the final verdict.
+// A connection is accepted when egress of the source and ingress of the destination accept it.
+type TrafficSimulationResult struct {
+	Sides   []TrafficSimulationSide
+	Verdict RuleAction
+}
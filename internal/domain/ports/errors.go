@@ -6,4 +6,14 @@ import "errors"
 var (
 	// ErrNotFound is returned when the requested entity is not found
 	ErrNotFound = errors.New("entity not found")
+
+	// ErrRevisionCompacted is returned when change log events after the requested revision were compacted
+	ErrRevisionCompacted = errors.New("revision has been compacted")
+
+	// ErrReferenceViolation is returned when a change would leave a resource referencing a missing one
+	ErrReferenceViolation = errors.New("resource reference violation")
+
+	// ErrTenantViolation is returned when a tenant-scoped writer would change resources
+	// outside of the tenant namespaces
+	ErrTenantViolation = errors.New("resource outside of tenant namespaces")
 )
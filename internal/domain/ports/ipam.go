@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// ErrIPAMConflict is returned when a CIDR overlaps an address range owned by another resource
+var ErrIPAMConflict = errors.New("CIDR conflicts with the address plan")
+
+// IPAMAllocation describes an address range held in the external IPAM by a Network
+type IPAMAllocation struct {
+	// Owner is the Network the range is allocated for
+	Owner models.ResourceIdentifier
+	// CIDR is the requested range; empty requests a free range from the IPAM pool
+	CIDR string
+}
+
+// IPAM is an external IP address management system (NetBox-style) that owns the
+// address plan. Networks reserve their CIDRs in it before they are persisted.
+type IPAM interface {
+	// Allocate reserves the CIDR of the allocation for its owner, or picks a free
+	// range when the CIDR is empty, and returns the reserved CIDR. Allocating a range
+	// already held by the same owner succeeds. Ranges overlapping another owner's
+	// allocation fail with ErrIPAMConflict.
+	Allocate(ctx context.Context, allocation IPAMAllocation) (string, error)
+
+	// Release frees the range held by the owner. Releasing a missing allocation succeeds.
+	Release(ctx context.Context, allocation IPAMAllocation) error
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/patterns"
@@ -21,9 +22,19 @@ type (
 	// This allows PostgreSQL backend to only update conditions in k8s_metadata, not the main resource table
 	ConditionOnlyOperation struct{}
 
+	// ConditionTransitionQuery selects condition transitions of ConditionHistory
+	ConditionTransitionQuery struct {
+		Kind     string                     // Empty - transitions of all kinds
+		Resource *models.ResourceIdentifier // Nil - transitions of all resources of the kind
+		AfterID  int64                      // Only transitions recorded after this one
+		Limit    int                        // Zero - no limit
+	}
+
 	// ReaderNoClose defines read operations without close
 	ReaderNoClose interface {
-		// List methods with scope
+		// List methods with scope. Resources are passed to consume one by one while they are
+		// read, a list of any size is not accumulated in memory. Returning an error from
+		// consume stops the list.
 		ListServices(ctx context.Context, consume func(models.Service) error, scope Scope) error
 		ListAddressGroups(ctx context.Context, consume func(models.AddressGroup) error, scope Scope) error
 		ListAddressGroupBindings(ctx context.Context, consume func(models.AddressGroupBinding) error, scope Scope) error
@@ -36,6 +47,10 @@ type (
 		ListNetworkBindings(ctx context.Context, consume func(models.NetworkBinding) error, scope Scope) error
 		ListHosts(ctx context.Context, consume func(models.Host) error, scope Scope) error
 		ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope Scope) error
+		ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope Scope) error
+		ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope Scope) error
+		ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope Scope) error
+		ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope Scope) error
 		GetSyncStatus(ctx context.Context) (*models.SyncStatus, error)
 
 		// Get methods with ResourceIdentifier
@@ -52,6 +67,10 @@ type (
 		GetNetworkBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.NetworkBinding, error)
 		GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error)
 		GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error)
+		GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error)
+		GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error)
+		GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error)
+		GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error)
 	}
 
 	// Reader defines read operations
@@ -75,6 +94,10 @@ type (
 		SyncNetworkBindings(ctx context.Context, bindings []models.NetworkBinding, scope Scope, opts ...Option) error
 		SyncHosts(ctx context.Context, hosts []models.Host, scope Scope, opts ...Option) error
 		SyncHostBindings(ctx context.Context, bindings []models.HostBinding, scope Scope, opts ...Option) error
+		SyncRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException, scope Scope, opts ...Option) error
+		SyncCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, scope Scope, opts ...Option) error
+		SyncRuleTemplates(ctx context.Context, templates []models.RuleTemplate, scope Scope, opts ...Option) error
+		SyncNamespacePostures(ctx context.Context, postures []models.NamespacePosture, scope Scope, opts ...Option) error
 
 		// Delete methods with ResourceIdentifier
 		DeleteServicesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
@@ -89,20 +112,207 @@ type (
 		DeleteNetworkBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
 		DeleteHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
 		DeleteHostBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
+		DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
+		DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
+		DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
+		DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...Option) error
 
 		Commit() error
 		Abort()
 	}
 
+	// ChangeLog persists change feed events so watchers can resume from older revisions
+	ChangeLog interface {
+		// Append stores an event, events are appended in revision order
+		Append(ctx context.Context, event models.ChangeEvent) error
+		// Since returns events with revision greater than the given one in revision order.
+		// ErrRevisionCompacted is returned if some of those events were already compacted.
+		Since(ctx context.Context, revision uint64) ([]models.ChangeEvent, error)
+		// Stream passes the events of Since to consume one by one as they are read. Events are
+		// not accumulated: a slow consumer holds back reading the log, an error of consume
+		// stops the stream and is returned.
+		Stream(ctx context.Context, revision uint64, consume func(models.ChangeEvent) error) error
+		// LastRevision returns the highest revision ever stored (including compacted events)
+		LastRevision(ctx context.Context) (uint64, error)
+		// Compact removes events published before the given time
+		Compact(ctx context.Context, before time.Time) error
+	}
+
+	// SharedChangeLog is implemented by change logs shared by backend replicas. Revisions are
+	// allocated by the log in commit order, so every replica can tail events published by others.
+	SharedChangeLog interface {
+		ChangeLog
+		// AppendNext stores an event under the next revision and returns the revision
+		AppendNext(ctx context.Context, event models.ChangeEvent) (uint64, error)
+	}
+
+	// ConditionHistory stores transitions of resource conditions
+	ConditionHistory interface {
+		// Record stores transitions, IDs are assigned in recording order
+		Record(ctx context.Context, transitions []models.ConditionTransition) error
+		// List returns the matching transitions in ID order
+		List(ctx context.Context, query ConditionTransitionQuery) ([]models.ConditionTransition, error)
+		// LastID returns the ID of the last recorded transition
+		LastID(ctx context.Context) (int64, error)
+		// Compact removes transitions recorded before the given time
+		Compact(ctx context.Context, before time.Time) error
+	}
+
+	// EventPublisher delivers domain events to event sinks. Publish never blocks the caller,
+	// events may be dropped when the sinks fall behind.
+	EventPublisher interface {
+		Publish(event models.DomainEvent)
+	}
+
+	// ResourceChangePublisher streams committed resource changes to downstream consumers.
+	// PublishChange never blocks the caller.
+	ResourceChangePublisher interface {
+		PublishChange(change models.ResourceChange)
+	}
+
+	// ResourceValidator validates requested writes before they are applied, e.g. by external
+	// validation webhooks. A returned error rejects the whole request.
+	ResourceValidator interface {
+		ValidateResources(ctx context.Context, reviews []models.ResourceReview) error
+	}
+
+	// IEAgAgRuleDiffNotifier is notified about committed changes of generated IEAgAgRules.
+	// NotifyIEAgAgRuleDiffs never blocks the caller.
+	IEAgAgRuleDiffNotifier interface {
+		NotifyIEAgAgRuleDiffs(diffs []models.IEAgAgRuleDiff)
+	}
+
+	// SyncOutboxWriter is implemented by writers able to store sgroups sync
+	// operations in the same transaction as the resource changes
+	SyncOutboxWriter interface {
+		// EnqueueSyncOutbox stores entries, they become visible after Commit
+		EnqueueSyncOutbox(ctx context.Context, entries []models.SyncOutboxEntry) error
+	}
+
+	// AggregationLocker is implemented by writers able to lock aggregation groups until the end of their
+	// transaction, so backend replicas sharing the database never aggregate the same group concurrently
+	AggregationLocker interface {
+		// LockAggregationKeys blocks until all keys are locked, the locks are released on Commit or Abort
+		LockAggregationKeys(ctx context.Context, keys []string) error
+	}
+
+	// ServiceCascadeDeleter is implemented by writers deleting services together with the state
+	// derived from them in set-based statements, the number of statements does not grow with
+	// the number of services
+	ServiceCascadeDeleter interface {
+		// DeleteServicesCascade deletes the services and removes their access ports from all
+		// address group port mappings, it returns the number of changed port mappings
+		DeleteServicesCascade(ctx context.Context, ids []models.ResourceIdentifier) (int, error)
+	}
+
+	// IEAgAgRuleContributionReader is implemented by readers storing the aggregation index of
+	// IEAgAgRules: which RuleS2S contribute ports to which aggregated rules
+	IEAgAgRuleContributionReader interface {
+		// ListIEAgAgRuleContributions returns contributions of the given RuleS2S and contributions
+		// to the given IEAgAgRules, all contributions are returned when both lists are empty
+		ListIEAgAgRuleContributions(ctx context.Context, consume func(models.IEAgAgRuleContribution) error, ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) error
+		// IEAgAgRuleContributionIndexBuilt reports whether the index was ever built from all RuleS2S
+		IEAgAgRuleContributionIndexBuilt(ctx context.Context) (bool, error)
+	}
+
+	// IEAgAgRuleContributionWriter is implemented by writers storing the aggregation index of IEAgAgRules
+	IEAgAgRuleContributionWriter interface {
+		// ReplaceIEAgAgRuleContributions replaces all contributions to the given IEAgAgRules,
+		// nil ieAgAgRuleIDs replace the whole index and mark it as built
+		ReplaceIEAgAgRuleContributions(ctx context.Context, ieAgAgRuleIDs []models.ResourceIdentifier, contributions []models.IEAgAgRuleContribution) error
+	}
+
+	// LeaderLock is held by at most one backend replica at a time
+	LeaderLock interface {
+		// TryAcquire takes the lock if it is free and reports whether this replica holds it
+		TryAcquire(ctx context.Context) (bool, error)
+		// Held reports whether the lock is still held, it is lost with the database connection
+		Held(ctx context.Context) (bool, error)
+		// Release gives the lock up
+		Release(ctx context.Context) error
+	}
+
+	// OperationLock serializes operations spanning several transactions across backend replicas
+	OperationLock interface {
+		// Lock blocks until the lock is taken or ctx is done
+		Lock(ctx context.Context) error
+		// Unlock releases the lock taken by Lock
+		Unlock(ctx context.Context) error
+	}
+
+	// SyncOutbox stores pending sgroups sync operations until they are delivered
+	SyncOutbox interface {
+		// Claim returns up to limit due entries in enqueue order and hides them
+		// from other claims for lease, so a crashed dispatcher doesn't lose them
+		Claim(ctx context.Context, limit int, lease time.Duration) ([]models.SyncOutboxEntry, error)
+		// Complete removes delivered entries
+		Complete(ctx context.Context, ids ...int64) error
+		// Retry records a failed delivery attempt and schedules the next one
+		Retry(ctx context.Context, id int64, cause error, next time.Time) error
+	}
+
+	// SyncDeadLetterQueue keeps sgroups sync operations that failed permanently,
+	// they are not retried until explicitly requeued
+	SyncDeadLetterQueue interface {
+		// DeadLetter moves an outbox entry to the dead-letter queue
+		DeadLetter(ctx context.Context, id int64, cause error) error
+		// ListDeadLetters returns dead-lettered entries in enqueue order
+		ListDeadLetters(ctx context.Context) ([]models.SyncOutboxEntry, error)
+		// GetDeadLetter returns a dead-lettered entry, ErrNotFound for unknown ids
+		GetDeadLetter(ctx context.Context, id int64) (models.SyncOutboxEntry, error)
+		// RemoveDeadLetter drops the entry, e.g. after a fresh sync of the resource was enqueued
+		RemoveDeadLetter(ctx context.Context, id int64) error
+	}
+
+	// Quarantine keeps imported resources which failed validation until they are
+	// promoted or deleted by an operator
+	Quarantine interface {
+		// Quarantine stores the resource and returns its id. A resource already quarantined
+		// (see QuarantinedResource.SameResource) is updated and its occurrences are counted.
+		Quarantine(ctx context.Context, resource models.QuarantinedResource) (int64, error)
+		// ListQuarantined returns quarantined resources in quarantine order
+		ListQuarantined(ctx context.Context) ([]models.QuarantinedResource, error)
+		// GetQuarantined returns a quarantined resource, ErrNotFound for unknown ids
+		GetQuarantined(ctx context.Context, id int64) (models.QuarantinedResource, error)
+		// RemoveQuarantined drops the resource, e.g. after it was promoted
+		RemoveQuarantined(ctx context.Context, id int64) error
+	}
+
+	// SchemaInspector is implemented by registries with versioned schema migrations
+	SchemaInspector interface {
+		// SchemaStatus returns the applied and the supported schema version
+		SchemaStatus(ctx context.Context) (models.SchemaStatus, error)
+	}
+
 	// Registry defines the registry interface
 	Registry interface {
 		Subject() patterns.Subject
 		Writer(ctx context.Context) (Writer, error)
+		// WriterWithOptions returns a writer with the isolation level and timeout of opts.
+		// Writes fail with ErrSerializationFailure on conflicts with concurrent transactions and
+		// with ErrTransactionTimeout on lock and transaction timeouts, Write runs them again up
+		// to opts.MaxAttempts times.
+		WriterWithOptions(ctx context.Context, opts WriterOptions) (Writer, error)
+		// Reader returns a reader for API lists and gets, it may read a lagging replica and
+		// serve cached lookups
 		Reader(ctx context.Context) (Reader, error)
+		// PrimaryReader returns a reader of the primary that bypasses read caches, for reads
+		// that decide writes: validation, recalculation, GC and publishing of committed changes
+		PrimaryReader(ctx context.Context) (Reader, error)
 		// ReaderFromWriter returns a reader that can see changes made in the current transaction
 		ReaderFromWriter(ctx context.Context, writer Writer) (Reader, error)
 		// ReaderWithReadCommitted returns a reader with ReadCommitted isolation for Cross-RuleS2S aggregation
 		ReaderWithReadCommitted(ctx context.Context) (Reader, error)
+		// ReaderAtSnapshot returns a reader pinned to a consistent snapshot: all its reads see
+		// the same commits however long it is used, for operations reading several kinds such
+		// as drift detection, rule GC and universal recalculation. Close releases the
+		// snapshot, a reader held open delays the cleanup of rows changed after it. The
+		// snapshot is taken on the primary, writes may be based on it.
+		ReaderAtSnapshot(ctx context.Context) (Reader, error)
+		// ReaderAtReplicaSnapshot is ReaderAtSnapshot on the read replica when one is
+		// configured, the snapshot may lag behind the primary. It is for pure reads such as
+		// drift reporting, nothing read from it may be written back.
+		ReaderAtReplicaSnapshot(ctx context.Context) (Reader, error)
 		Close() error
 	}
 )
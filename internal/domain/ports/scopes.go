@@ -50,3 +50,32 @@ func NewResourceIdentifierScope(identifiers ...models.ResourceIdentifier) Resour
 		Identifiers: identifiers,
 	}
 }
+
+// SelectorScope narrows a scope with label and field selectors in the Kubernetes selector syntax
+type SelectorScope struct {
+	Scope         Scope
+	LabelSelector string
+	FieldSelector string
+}
+
+// IsEmpty returns true if neither the inner scope nor the selectors narrow the scope
+func (s SelectorScope) IsEmpty() bool {
+	return (s.Scope == nil || s.Scope.IsEmpty()) && s.LabelSelector == "" && s.FieldSelector == ""
+}
+
+// String returns a string representation of SelectorScope
+func (s SelectorScope) String() string {
+	inner := "empty"
+	if s.Scope != nil {
+		inner = s.Scope.String()
+	}
+	return fmt.Sprintf("selector(%s, labels=%q, fields=%q)", inner, s.LabelSelector, s.FieldSelector)
+}
+
+// NewSelectorScope narrows scope with the selectors, scope is returned as is without selectors
+func NewSelectorScope(scope Scope, labelSelector, fieldSelector string) Scope {
+	if labelSelector == "" && fieldSelector == "" {
+		return scope
+	}
+	return SelectorScope{Scope: scope, LabelSelector: labelSelector, FieldSelector: fieldSelector}
+}
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+type tenantKey struct{}
+
+// WithTenant scopes ctx to the tenant: readers and writers of a tenant-aware registry
+// created with it only see and change resources of the tenant namespaces
+func WithTenant(ctx context.Context, tenant models.Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant of ctx, contexts without a tenant are unrestricted
+func TenantFromContext(ctx context.Context) (models.Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(models.Tenant)
+	return tenant, ok
+}
@@ -0,0 +1,93 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// IsolationLevel is the transaction isolation level of a writer
+type IsolationLevel string
+
+const (
+	// IsolationDefault uses the isolation level configured for the registry
+	IsolationDefault IsolationLevel = ""
+	// IsolationReadCommitted sees data committed by other transactions during the
+	// transaction. It is the least sensitive to concurrent writes, e.g. for deletes and
+	// condition updates of resources created by other transactions.
+	IsolationReadCommitted IsolationLevel = "read-committed"
+	// IsolationRepeatableRead reads a snapshot taken at the first query of the transaction
+	IsolationRepeatableRead IsolationLevel = "repeatable-read"
+	// IsolationSerializable fails transactions that could not run one after another
+	IsolationSerializable IsolationLevel = "serializable"
+)
+
+var (
+	// ErrSerializationFailure is returned when a transaction failed because of concurrent
+	// transactions, e.g. a serialization failure or a deadlock. Running it again may succeed.
+	ErrSerializationFailure = errors.New("transaction serialization failure")
+
+	// ErrTransactionTimeout is returned when a transaction waited too long for a lock or
+	// ran out of its timeout. Running it again may succeed once the contention is over.
+	ErrTransactionTimeout = errors.New("transaction timeout")
+)
+
+// IsRetryable reports whether a transaction that failed with err can be run again
+func IsRetryable(err error) bool {
+	return errors.Is(err, ErrSerializationFailure) || errors.Is(err, ErrTransactionTimeout)
+}
+
+// WriterOptions configures a writer of Registry.WriterWithOptions
+type WriterOptions struct {
+	Isolation IsolationLevel
+	// Timeout bounds the transaction from begin to commit, zero uses the registry default
+	Timeout time.Duration
+	// MaxAttempts bounds how often Write runs a transaction that failed with a retryable
+	// error, values below one run it once
+	MaxAttempts int
+}
+
+// DeleteWriterOptions are the writer options of delete operations. Deletes of concurrent
+// controllers touch the same aggregated resources, ReadCommitted with retries avoids
+// failing them on serialization conflicts.
+var DeleteWriterOptions = WriterOptions{Isolation: IsolationReadCommitted, MaxAttempts: 3}
+
+// retryDelay is the delay before the first retry, it doubles with every attempt
+const retryDelay = 10 * time.Millisecond
+
+// Write runs fn in a writer of registry created with opts and commits it. Transactions
+// failing with a retryable error in fn or on commit are aborted and run again up to
+// opts.MaxAttempts times, fn must not have side effects outside of the writer.
+func Write(ctx context.Context, registry Registry, opts WriterOptions, fn func(Writer) error) error {
+	attempts := max(opts.MaxAttempts, 1)
+	delay := retryDelay
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = write(ctx, registry, opts, fn); err == nil || !IsRetryable(err) || attempt >= attempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func write(ctx context.Context, registry Registry, opts WriterOptions, fn func(Writer) error) error {
+	writer, err := registry.WriterWithOptions(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if err := fn(writer); err != nil {
+		writer.Abort()
+		return err
+	}
+	if err := writer.Commit(); err != nil {
+		writer.Abort()
+		return err
+	}
+	return nil
+}
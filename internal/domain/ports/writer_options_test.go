@@ -0,0 +1,77 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type fakeWriter struct {
+	Writer
+	commitErr error
+	aborted   bool
+}
+
+func (w *fakeWriter) Commit() error { return w.commitErr }
+func (w *fakeWriter) Abort()        { w.aborted = true }
+
+// fakeRegistry fails the commits of the first failures writers with commitErr
+type fakeRegistry struct {
+	Registry
+	failures  int
+	commitErr error
+	opts      []WriterOptions
+	writers   []*fakeWriter
+}
+
+func (r *fakeRegistry) WriterWithOptions(_ context.Context, opts WriterOptions) (Writer, error) {
+	w := &fakeWriter{}
+	if len(r.writers) < r.failures {
+		w.commitErr = r.commitErr
+	}
+	r.opts = append(r.opts, opts)
+	r.writers = append(r.writers, w)
+	return w, nil
+}
+
+func TestWrite(t *testing.T) {
+	conflict := fmt.Errorf("%w: could not serialize access", ErrSerializationFailure)
+	tests := []struct {
+		name      string
+		failures  int
+		commitErr error
+		wantErr   error
+		attempts  int
+	}{
+		{"Committed", 0, nil, nil, 1},
+		{"RetriedConflict", 2, conflict, nil, 3},
+		{"ConflictAfterMaxAttempts", 3, conflict, ErrSerializationFailure, 3},
+		{"OtherErrorNotRetried", 1, ErrReferenceViolation, ErrReferenceViolation, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := &fakeRegistry{failures: tt.failures, commitErr: tt.commitErr}
+			calls := 0
+			err := Write(context.Background(), registry, DeleteWriterOptions, func(Writer) error {
+				calls++
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("Write() error = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.attempts || len(registry.writers) != tt.attempts {
+				t.Errorf("Write() ran %d transactions, want %d", calls, tt.attempts)
+			}
+			for i, w := range registry.writers {
+				if registry.opts[i] != DeleteWriterOptions {
+					t.Errorf("writer %d options = %+v, want %+v", i, registry.opts[i], DeleteWriterOptions)
+				}
+				if w.aborted != (w.commitErr != nil) {
+					t.Errorf("writer %d aborted = %v with commit error %v", i, w.aborted, w.commitErr)
+				}
+			}
+		})
+	}
+}
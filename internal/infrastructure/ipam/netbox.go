@@ -0,0 +1,278 @@
+package ipam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+const (
+	// TypeNetBox is the IPAM type of NetBox
+	TypeNetBox = "netbox"
+
+	// ownerPrefix marks NetBox prefixes allocated for NETGUARD networks,
+	// the description of such prefixes is "netguard:<namespace>/<name>"
+	ownerPrefix = "netguard:"
+
+	// statusContainer is the status of NetBox prefixes that only group child prefixes
+	statusContainer = "container"
+)
+
+// NetBoxConfig configures the NetBox IPAM adapter
+type NetBoxConfig struct {
+	// URL is the NetBox base URL, e.g. https://netbox.example.com
+	URL string `yaml:"url" env:"IPAM_NETBOX_URL"`
+	// Token is the NetBox API token
+	Token string `yaml:"token" env:"IPAM_NETBOX_TOKEN"`
+	// PoolPrefixID is the NetBox prefix free ranges are allocated from for networks without CIDR.
+	// Zero disables allocation, networks must then specify their CIDR.
+	PoolPrefixID int `yaml:"pool-prefix-id" env:"IPAM_NETBOX_POOL_PREFIX_ID"`
+	// PrefixLength is the length of ranges allocated from the pool
+	PrefixLength int `yaml:"prefix-length" env:"IPAM_NETBOX_PREFIX_LENGTH"`
+	// Timeout limits every NetBox API request
+	Timeout time.Duration `yaml:"timeout" env:"IPAM_NETBOX_TIMEOUT"`
+}
+
+// DefaultNetBoxConfig returns the default NetBox adapter configuration
+func DefaultNetBoxConfig() NetBoxConfig {
+	return NetBoxConfig{
+		PrefixLength: 24,
+		Timeout:      10 * time.Second,
+	}
+}
+
+// Validate validates the NetBox adapter configuration
+func (c NetBoxConfig) Validate() error {
+	if c.URL == "" {
+		return fmt.Errorf("netbox url is required")
+	}
+	if _, err := url.ParseRequestURI(c.URL); err != nil {
+		return fmt.Errorf("invalid netbox url: %w", err)
+	}
+	if c.Token == "" {
+		return fmt.Errorf("netbox token is required")
+	}
+	if c.PoolPrefixID < 0 {
+		return fmt.Errorf("netbox pool prefix id must not be negative")
+	}
+	if c.PoolPrefixID > 0 && (c.PrefixLength <= 0 || c.PrefixLength > 128) {
+		return fmt.Errorf("netbox prefix length must be between 1 and 128")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("netbox timeout must be positive")
+	}
+	return nil
+}
+
+// NetBox implements ports.IPAM on top of the NetBox prefixes API.
+// Every network holds one NetBox prefix whose description names the network.
+type NetBox struct {
+	config NetBoxConfig
+	client *http.Client
+}
+
+var _ ports.IPAM = (*NetBox)(nil)
+
+// NewNetBox creates a new NetBox IPAM adapter
+func NewNetBox(config NetBoxConfig) (*NetBox, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	config.URL = strings.TrimRight(config.URL, "/")
+	return &NetBox{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// netboxPrefix is a NetBox prefix as returned by the API
+type netboxPrefix struct {
+	ID          int    `json:"id"`
+	Prefix      string `json:"prefix"`
+	Description string `json:"description"`
+	Status      struct {
+		Value string `json:"value"`
+	} `json:"status"`
+}
+
+// netboxPrefixList is a page of NetBox prefixes
+type netboxPrefixList struct {
+	Count   int            `json:"count"`
+	Results []netboxPrefix `json:"results"`
+}
+
+// Allocate reserves the CIDR in NetBox or allocates a free range from the pool prefix
+func (n *NetBox) Allocate(ctx context.Context, allocation ports.IPAMAllocation) (string, error) {
+	owner := ownerDescription(allocation)
+
+	if allocation.CIDR == "" {
+		return n.allocateFromPool(ctx, owner)
+	}
+
+	prefix, err := netip.ParsePrefix(allocation.CIDR)
+	if err != nil {
+		return "", fmt.Errorf("invalid CIDR %s: %w", allocation.CIDR, err)
+	}
+	cidr := prefix.Masked().String()
+
+	// Ranges inside the requested one and ranges containing it overlap
+	overlapping, err := n.listPrefixes(ctx, url.Values{"within_include": {cidr}})
+	if err != nil {
+		return "", err
+	}
+	containing, err := n.listPrefixes(ctx, url.Values{"contains": {cidr}})
+	if err != nil {
+		return "", err
+	}
+
+	for _, existing := range append(overlapping, containing...) {
+		if existing.Description == owner {
+			if existing.Prefix == cidr {
+				return cidr, nil
+			}
+			continue
+		}
+		if existing.Status.Value == statusContainer && existing.Prefix != cidr {
+			continue
+		}
+		return "", fmt.Errorf("%w: %s overlaps %s (%s)", ports.ErrIPAMConflict, cidr, existing.Prefix, describeOwner(existing))
+	}
+
+	created, err := n.createPrefix(ctx, n.config.URL+"/api/ipam/prefixes/", map[string]interface{}{
+		"prefix":      cidr,
+		"status":      "active",
+		"description": owner,
+	})
+	if err != nil {
+		return "", err
+	}
+	return created.Prefix, nil
+}
+
+// allocateFromPool allocates a free range of the configured length from the pool prefix
+func (n *NetBox) allocateFromPool(ctx context.Context, owner string) (string, error) {
+	if n.config.PoolPrefixID == 0 {
+		return "", fmt.Errorf("CIDR is required: netbox pool prefix is not configured")
+	}
+
+	// A retried allocation returns the range already held by the network
+	held, err := n.listPrefixes(ctx, url.Values{"description": {owner}})
+	if err != nil {
+		return "", err
+	}
+	if len(held) > 0 {
+		return held[0].Prefix, nil
+	}
+
+	created, err := n.createPrefix(ctx, fmt.Sprintf("%s/api/ipam/prefixes/%d/available-prefixes/", n.config.URL, n.config.PoolPrefixID), map[string]interface{}{
+		"prefix_length": n.config.PrefixLength,
+		"status":        "active",
+		"description":   owner,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to allocate /%d from netbox prefix %d: %w", n.config.PrefixLength, n.config.PoolPrefixID, err)
+	}
+	return created.Prefix, nil
+}
+
+// Release deletes the NetBox prefix held by the network
+func (n *NetBox) Release(ctx context.Context, allocation ports.IPAMAllocation) error {
+	query := url.Values{"description": {ownerDescription(allocation)}}
+	if allocation.CIDR != "" {
+		prefix, err := netip.ParsePrefix(allocation.CIDR)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %s: %w", allocation.CIDR, err)
+		}
+		query.Set("prefix", prefix.Masked().String())
+	}
+
+	held, err := n.listPrefixes(ctx, query)
+	if err != nil {
+		return err
+	}
+	for _, prefix := range held {
+		endpoint := fmt.Sprintf("%s/api/ipam/prefixes/%d/", n.config.URL, prefix.ID)
+		if err := n.do(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+			return fmt.Errorf("failed to delete netbox prefix %s: %w", prefix.Prefix, err)
+		}
+	}
+	return nil
+}
+
+// listPrefixes returns NetBox prefixes matching the query
+func (n *NetBox) listPrefixes(ctx context.Context, query url.Values) ([]netboxPrefix, error) {
+	query.Set("limit", "1000")
+	var list netboxPrefixList
+	if err := n.do(ctx, http.MethodGet, n.config.URL+"/api/ipam/prefixes/?"+query.Encode(), nil, &list); err != nil {
+		return nil, fmt.Errorf("failed to list netbox prefixes: %w", err)
+	}
+	return list.Results, nil
+}
+
+// createPrefix creates a NetBox prefix at the endpoint
+func (n *NetBox) createPrefix(ctx context.Context, endpoint string, body map[string]interface{}) (*netboxPrefix, error) {
+	var created netboxPrefix
+	if err := n.do(ctx, http.MethodPost, endpoint, body, &created); err != nil {
+		return nil, fmt.Errorf("failed to create netbox prefix: %w", err)
+	}
+	return &created, nil
+}
+
+// do performs a NetBox API request, decoding the response into out when it is not nil
+func (n *NetBox) do(ctx context.Context, method, endpoint string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Token "+n.config.Token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("netbox returned %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// ownerDescription returns the NetBox prefix description marking the network
+func ownerDescription(allocation ports.IPAMAllocation) string {
+	return ownerPrefix + allocation.Owner.Key()
+}
+
+// describeOwner names the holder of a NetBox prefix for conflict errors
+func describeOwner(prefix netboxPrefix) string {
+	if owner, ok := strings.CutPrefix(prefix.Description, ownerPrefix); ok {
+		return "network " + owner
+	}
+	return fmt.Sprintf("netbox prefix %d", prefix.ID)
+}
@@ -0,0 +1,220 @@
+package ipam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// fakeNetBox serves the subset of the NetBox prefixes API used by the adapter
+type fakeNetBox struct {
+	mu       sync.Mutex
+	nextID   int
+	prefixes map[int]netboxPrefix
+}
+
+func newFakeNetBox(t *testing.T, prefixes ...netboxPrefix) (*fakeNetBox, *NetBox) {
+	fake := &fakeNetBox{nextID: 100, prefixes: make(map[int]netboxPrefix)}
+	for _, prefix := range prefixes {
+		fake.prefixes[prefix.ID] = prefix
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(server.Close)
+
+	config := DefaultNetBoxConfig()
+	config.URL = server.URL
+	config.Token = "secret"
+	config.PoolPrefixID = 1
+	adapter, err := NewNetBox(config)
+	require.NoError(t, err)
+	return fake, adapter
+}
+
+func (f *fakeNetBox) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Token secret" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/ipam/prefixes/")
+	switch {
+	case r.Method == http.MethodGet && path == "":
+		var results []netboxPrefix
+		for _, prefix := range f.prefixes {
+			if f.matches(prefix, r.URL.Query()) {
+				results = append(results, prefix)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(netboxPrefixList{Count: len(results), Results: results})
+	case r.Method == http.MethodPost && path == "":
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.create(w, body["prefix"].(string), body["description"].(string))
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/available-prefixes/"):
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		pool := netip.MustParsePrefix(f.prefixes[1].Prefix)
+		length := int(body["prefix_length"].(float64))
+		for candidate := netip.PrefixFrom(pool.Addr(), length); pool.Contains(candidate.Addr()); {
+			if !f.overlapsAny(candidate) {
+				f.create(w, candidate.String(), body["description"].(string))
+				return
+			}
+			next := candidate.Addr()
+			for i := 0; i < 1<<(32-length); i++ {
+				next = next.Next()
+			}
+			candidate = netip.PrefixFrom(next, length)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		id, _ := strconv.Atoi(strings.TrimSuffix(path, "/"))
+		delete(f.prefixes, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeNetBox) matches(prefix netboxPrefix, query map[string][]string) bool {
+	current := netip.MustParsePrefix(prefix.Prefix)
+	if value := query["within_include"]; len(value) > 0 {
+		parent := netip.MustParsePrefix(value[0])
+		if !(parent.Contains(current.Addr()) && current.Bits() >= parent.Bits()) {
+			return false
+		}
+	}
+	if value := query["contains"]; len(value) > 0 {
+		child := netip.MustParsePrefix(value[0])
+		if !(current.Contains(child.Addr()) && current.Bits() <= child.Bits()) {
+			return false
+		}
+	}
+	if value := query["description"]; len(value) > 0 && prefix.Description != value[0] {
+		return false
+	}
+	if value := query["prefix"]; len(value) > 0 && prefix.Prefix != value[0] {
+		return false
+	}
+	return true
+}
+
+func (f *fakeNetBox) overlapsAny(candidate netip.Prefix) bool {
+	for id, prefix := range f.prefixes {
+		if id != 1 && netip.MustParsePrefix(prefix.Prefix).Overlaps(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeNetBox) create(w http.ResponseWriter, cidr, description string) {
+	f.nextID++
+	prefix := netboxPrefix{ID: f.nextID, Prefix: cidr, Description: description}
+	prefix.Status.Value = "active"
+	f.prefixes[prefix.ID] = prefix
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(prefix)
+}
+
+func (f *fakeNetBox) held() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var held []string
+	for _, prefix := range f.prefixes {
+		if prefix.Status.Value != statusContainer {
+			held = append(held, fmt.Sprintf("%s=%s", prefix.Prefix, prefix.Description))
+		}
+	}
+	return held
+}
+
+func allocation(name, cidr string) ports.IPAMAllocation {
+	return ports.IPAMAllocation{Owner: models.NewResourceIdentifier(name, models.WithNamespace("ns")), CIDR: cidr}
+}
+
+func poolPrefix() netboxPrefix {
+	pool := netboxPrefix{ID: 1, Prefix: "10.0.0.0/16", Description: "netguard pool"}
+	pool.Status.Value = statusContainer
+	return pool
+}
+
+func TestNetBox_AllocateRequestedCIDR(t *testing.T) {
+	foreign := netboxPrefix{ID: 2, Prefix: "10.0.8.0/21", Description: "datacenter"}
+	foreign.Status.Value = "active"
+	fake, adapter := newFakeNetBox(t, poolPrefix(), foreign)
+	ctx := context.Background()
+
+	cidr, err := adapter.Allocate(ctx, allocation("web", "10.0.1.7/24"))
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.1.0/24", cidr, "CIDR is reserved masked")
+
+	cidr, err = adapter.Allocate(ctx, allocation("web", "10.0.1.0/24"))
+	require.NoError(t, err, "allocation is idempotent for the same network")
+	assert.Equal(t, "10.0.1.0/24", cidr)
+
+	_, err = adapter.Allocate(ctx, allocation("db", "10.0.1.128/25"))
+	require.ErrorIs(t, err, ports.ErrIPAMConflict)
+	assert.Contains(t, err.Error(), "network ns/web")
+
+	_, err = adapter.Allocate(ctx, allocation("db", "10.0.9.0/24"))
+	require.ErrorIs(t, err, ports.ErrIPAMConflict, "ranges outside NETGUARD are part of the address plan")
+
+	_, err = adapter.Allocate(ctx, allocation("db", "10.0.0.0/16"))
+	require.ErrorIs(t, err, ports.ErrIPAMConflict, "the pool prefix itself is not allocatable")
+
+	assert.ElementsMatch(t, []string{"10.0.1.0/24=netguard:ns/web", "10.0.8.0/21=datacenter"}, fake.held())
+}
+
+func TestNetBox_AllocateFromPoolAndRelease(t *testing.T) {
+	fake, adapter := newFakeNetBox(t, poolPrefix())
+	ctx := context.Background()
+
+	first, err := adapter.Allocate(ctx, allocation("web", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/24", first)
+
+	second, err := adapter.Allocate(ctx, allocation("db", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.1.0/24", second)
+
+	again, err := adapter.Allocate(ctx, allocation("web", ""))
+	require.NoError(t, err)
+	assert.Equal(t, first, again, "a retried allocation returns the held range")
+
+	require.NoError(t, adapter.Release(ctx, allocation("web", first)))
+	require.NoError(t, adapter.Release(ctx, allocation("web", first)), "releasing a missing allocation succeeds")
+	assert.Equal(t, []string{"10.0.1.0/24=netguard:ns/db"}, fake.held())
+}
+
+func TestNetBoxConfig_Validate(t *testing.T) {
+	config := DefaultNetBoxConfig()
+	assert.Error(t, config.Validate(), "url is required")
+
+	config.URL = "https://netbox.example.com"
+	assert.Error(t, config.Validate(), "token is required")
+
+	config.Token = "secret"
+	require.NoError(t, config.Validate())
+
+	config.PoolPrefixID = 7
+	config.PrefixLength = 0
+	assert.Error(t, config.Validate())
+}
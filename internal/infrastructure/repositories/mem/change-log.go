@@ -0,0 +1,106 @@
+package mem
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// ChangeLog is an in-memory implementation of ports.ChangeLog
+type ChangeLog struct {
+	mu                sync.RWMutex
+	events            []models.ChangeEvent
+	lastRevision      uint64
+	compactedRevision uint64
+	// persist writes the state of a persisted registry after changes
+	persist persistFunc
+}
+
+var _ ports.ChangeLog = &ChangeLog{}
+
+// NewChangeLog creates a new in-memory change log
+func NewChangeLog() *ChangeLog {
+	return &ChangeLog{}
+}
+
+// ChangeLog returns the change log stored with the registry resources
+func (r *Registry) ChangeLog() *ChangeLog {
+	return r.changeLog
+}
+
+// Append stores an event
+func (l *ChangeLog) Append(_ context.Context, event models.ChangeEvent) error {
+	l.mu.Lock()
+	l.events = append(l.events, event)
+	if event.Revision > l.lastRevision {
+		l.lastRevision = event.Revision
+	}
+	l.mu.Unlock()
+	return l.persist.run()
+}
+
+// Since returns events with revision greater than the given one
+func (l *ChangeLog) Since(_ context.Context, revision uint64) ([]models.ChangeEvent, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if revision < l.compactedRevision {
+		return nil, ports.ErrRevisionCompacted
+	}
+
+	var events []models.ChangeEvent
+	for _, event := range l.events {
+		if event.Revision > revision {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// Stream passes events with revision greater than the given one to consume. Appends and
+// compactions don't modify stored events, consume runs without holding the lock.
+func (l *ChangeLog) Stream(ctx context.Context, revision uint64, consume func(models.ChangeEvent) error) error {
+	l.mu.RLock()
+	if revision < l.compactedRevision {
+		l.mu.RUnlock()
+		return ports.ErrRevisionCompacted
+	}
+	events := l.events
+	l.mu.RUnlock()
+
+	for _, event := range events {
+		if event.Revision <= revision {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := consume(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LastRevision returns the highest revision ever stored
+func (l *ChangeLog) LastRevision(_ context.Context) (uint64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.lastRevision, nil
+}
+
+// Compact removes events published before the given time
+func (l *ChangeLog) Compact(_ context.Context, before time.Time) error {
+	l.mu.Lock()
+	i := 0
+	for i < len(l.events) && l.events[i].Timestamp.Before(before) {
+		l.compactedRevision = l.events[i].Revision
+		i++
+	}
+	l.events = append([]models.ChangeEvent(nil), l.events[i:]...)
+	l.mu.Unlock()
+	return l.persist.run()
+}
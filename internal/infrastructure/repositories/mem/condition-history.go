@@ -0,0 +1,93 @@
+package mem
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// ConditionHistory is an in-memory implementation of ports.ConditionHistory
+type ConditionHistory struct {
+	mu          sync.RWMutex
+	transitions []conditionTransitionRecord
+	lastID      int64
+	// persist writes the state of a persisted registry after changes
+	persist persistFunc
+}
+
+type conditionTransitionRecord struct {
+	transition models.ConditionTransition
+	recordedAt time.Time
+}
+
+var _ ports.ConditionHistory = &ConditionHistory{}
+
+// NewConditionHistory creates a new in-memory condition history
+func NewConditionHistory() *ConditionHistory {
+	return &ConditionHistory{}
+}
+
+// ConditionHistory returns the condition history stored with the registry resources
+func (r *Registry) ConditionHistory() *ConditionHistory {
+	return r.conditions
+}
+
+// Record stores transitions
+func (h *ConditionHistory) Record(_ context.Context, transitions []models.ConditionTransition) error {
+	h.mu.Lock()
+	now := time.Now()
+	for _, transition := range transitions {
+		h.lastID++
+		transition.ID = h.lastID
+		h.transitions = append(h.transitions, conditionTransitionRecord{transition: transition, recordedAt: now})
+	}
+	h.mu.Unlock()
+	return h.persist.run()
+}
+
+// List returns the matching transitions in ID order
+func (h *ConditionHistory) List(_ context.Context, query ports.ConditionTransitionQuery) ([]models.ConditionTransition, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var transitions []models.ConditionTransition
+	for _, record := range h.transitions {
+		transition := record.transition
+		if transition.ID <= query.AfterID {
+			continue
+		}
+		if query.Kind != "" && transition.Kind != query.Kind {
+			continue
+		}
+		if query.Resource != nil && transition.ResourceIdentifier != *query.Resource {
+			continue
+		}
+		transitions = append(transitions, transition)
+		if query.Limit > 0 && len(transitions) == query.Limit {
+			break
+		}
+	}
+	return transitions, nil
+}
+
+// LastID returns the ID of the last recorded transition
+func (h *ConditionHistory) LastID(_ context.Context) (int64, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.lastID, nil
+}
+
+// Compact removes transitions recorded before the given time
+func (h *ConditionHistory) Compact(_ context.Context, before time.Time) error {
+	h.mu.Lock()
+	i := 0
+	for i < len(h.transitions) && h.transitions[i].recordedAt.Before(before) {
+		i++
+	}
+	h.transitions = append([]conditionTransitionRecord(nil), h.transitions[i:]...)
+	h.mu.Unlock()
+	return h.persist.run()
+}
@@ -20,6 +20,12 @@ type MemDB struct {
 	networkBindings             map[string]models.NetworkBinding
 	hosts                       map[string]models.Host
 	hostBindings                map[string]models.HostBinding
+	ruleS2SExceptions           map[string]models.RuleS2SException
+	namespacePostures           map[string]models.NamespacePosture
+	ruleTemplates               map[string]models.RuleTemplate
+	crossNamespacePolicies      map[string]models.CrossNamespacePolicy
+	ieAgAgRuleContributions     map[string]models.IEAgAgRuleContribution
+	contributionIndexBuilt      bool
 	syncStatus                  models.SyncStatus
 	mu                          sync.RWMutex
 }
@@ -39,6 +45,11 @@ func NewMemDB() *MemDB {
 		networkBindings:             make(map[string]models.NetworkBinding),
 		hosts:                       make(map[string]models.Host),
 		hostBindings:                make(map[string]models.HostBinding),
+		ruleS2SExceptions:           make(map[string]models.RuleS2SException),
+		namespacePostures:           make(map[string]models.NamespacePosture),
+		ruleTemplates:               make(map[string]models.RuleTemplate),
+		crossNamespacePolicies:      make(map[string]models.CrossNamespacePolicy),
+		ieAgAgRuleContributions:     make(map[string]models.IEAgAgRuleContribution),
 	}
 }
 
@@ -271,3 +282,162 @@ func (db *MemDB) SetHostBindings(bindings map[string]models.HostBinding) {
 	defer db.mu.Unlock()
 	db.hostBindings = bindings
 }
+
+// GetRuleS2SExceptions returns all RuleS2S exceptions
+func (db *MemDB) GetRuleS2SExceptions() map[string]models.RuleS2SException {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	result := make(map[string]models.RuleS2SException, len(db.ruleS2SExceptions))
+	for k, v := range db.ruleS2SExceptions {
+		result[k] = v
+	}
+	return result
+}
+
+// SetRuleS2SExceptions sets the RuleS2S exceptions
+func (db *MemDB) SetRuleS2SExceptions(exceptions map[string]models.RuleS2SException) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.ruleS2SExceptions = exceptions
+}
+
+// GetNamespacePostures returns all namespace postures
+func (db *MemDB) GetNamespacePostures() map[string]models.NamespacePosture {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	result := make(map[string]models.NamespacePosture, len(db.namespacePostures))
+	for k, v := range db.namespacePostures {
+		result[k] = v
+	}
+	return result
+}
+
+// SetNamespacePostures sets the namespace postures
+func (db *MemDB) SetNamespacePostures(postures map[string]models.NamespacePosture) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.namespacePostures = postures
+}
+
+// GetRuleTemplates returns all rule templates
+func (db *MemDB) GetRuleTemplates() map[string]models.RuleTemplate {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	result := make(map[string]models.RuleTemplate, len(db.ruleTemplates))
+	for k, v := range db.ruleTemplates {
+		result[k] = v
+	}
+	return result
+}
+
+// SetRuleTemplates sets the rule templates
+func (db *MemDB) SetRuleTemplates(templates map[string]models.RuleTemplate) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.ruleTemplates = templates
+}
+
+// GetCrossNamespacePolicies returns all cross namespace policies
+func (db *MemDB) GetCrossNamespacePolicies() map[string]models.CrossNamespacePolicy {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	result := make(map[string]models.CrossNamespacePolicy, len(db.crossNamespacePolicies))
+	for k, v := range db.crossNamespacePolicies {
+		result[k] = v
+	}
+	return result
+}
+
+// SetCrossNamespacePolicies sets the cross namespace policies
+func (db *MemDB) SetCrossNamespacePolicies(policies map[string]models.CrossNamespacePolicy) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.crossNamespacePolicies = policies
+}
+
+// GetIEAgAgRuleContributions returns the aggregation index of IEAgAg rules
+func (db *MemDB) GetIEAgAgRuleContributions() map[string]models.IEAgAgRuleContribution {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	result := make(map[string]models.IEAgAgRuleContribution, len(db.ieAgAgRuleContributions))
+	for k, v := range db.ieAgAgRuleContributions {
+		result[k] = v
+	}
+	return result
+}
+
+// SetIEAgAgRuleContributions sets the aggregation index of IEAgAg rules
+func (db *MemDB) SetIEAgAgRuleContributions(contributions map[string]models.IEAgAgRuleContribution) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.ieAgAgRuleContributions = contributions
+}
+
+// MarkIEAgAgRuleContributionsBuilt marks the aggregation index as built from all RuleS2S
+func (db *MemDB) MarkIEAgAgRuleContributionsBuilt() {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.contributionIndexBuilt = true
+}
+
+// IEAgAgRuleContributionsBuilt reports whether the aggregation index was built from all RuleS2S
+func (db *MemDB) IEAgAgRuleContributionsBuilt() bool {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.contributionIndexBuilt
+}
+
+// clone returns a database sharing the tables of db. Tables are replaced as a whole and never
+// modified in place, setting a table of the clone leaves db unchanged.
+func (db *MemDB) clone() *MemDB {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return &MemDB{
+		services:                    db.services,
+		serviceAliases:              db.serviceAliases,
+		addressGroups:               db.addressGroups,
+		addressGroupBindings:        db.addressGroupBindings,
+		addressGroupPortMappings:    db.addressGroupPortMappings,
+		addressGroupBindingPolicies: db.addressGroupBindingPolicies,
+		ruleS2S:                     db.ruleS2S,
+		ieAgAgRules:                 db.ieAgAgRules,
+		networks:                    db.networks,
+		networkBindings:             db.networkBindings,
+		hosts:                       db.hosts,
+		hostBindings:                db.hostBindings,
+		ruleS2SExceptions:           db.ruleS2SExceptions,
+		namespacePostures:           db.namespacePostures,
+		ruleTemplates:               db.ruleTemplates,
+		crossNamespacePolicies:      db.crossNamespacePolicies,
+		ieAgAgRuleContributions:     db.ieAgAgRuleContributions,
+		contributionIndexBuilt:      db.contributionIndexBuilt,
+		syncStatus:                  db.syncStatus,
+	}
+}
+
+// replace sets all tables of db to the tables of staged
+func (db *MemDB) replace(staged *MemDB) {
+	staged.mu.RLock()
+	defer staged.mu.RUnlock()
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.services = staged.services
+	db.serviceAliases = staged.serviceAliases
+	db.addressGroups = staged.addressGroups
+	db.addressGroupBindings = staged.addressGroupBindings
+	db.addressGroupPortMappings = staged.addressGroupPortMappings
+	db.addressGroupBindingPolicies = staged.addressGroupBindingPolicies
+	db.ruleS2S = staged.ruleS2S
+	db.ieAgAgRules = staged.ieAgAgRules
+	db.networks = staged.networks
+	db.networkBindings = staged.networkBindings
+	db.hosts = staged.hosts
+	db.hostBindings = staged.hostBindings
+	db.ruleS2SExceptions = staged.ruleS2SExceptions
+	db.namespacePostures = staged.namespacePostures
+	db.ruleTemplates = staged.ruleTemplates
+	db.crossNamespacePolicies = staged.crossNamespacePolicies
+	db.ieAgAgRuleContributions = staged.ieAgAgRuleContributions
+	db.contributionIndexBuilt = staged.contributionIndexBuilt
+	db.syncStatus = staged.syncStatus
+}
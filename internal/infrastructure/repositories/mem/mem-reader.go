@@ -5,6 +5,7 @@ import (
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 type reader struct {
@@ -18,6 +19,10 @@ func (r *reader) Close() error {
 }
 
 func (r *reader) ListServices(ctx context.Context, consume func(models.Service) error, scope ports.Scope) error {
+	list := readstats.Begin("Service", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var services map[string]models.Service
 	var bindings map[string]models.AddressGroupBinding
 
@@ -41,6 +46,7 @@ func (r *reader) ListServices(ctx context.Context, consume func(models.Service)
 				// If only namespace is set, return all services in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, service := range services {
+						list.Scan()
 						if service.Namespace == id.Namespace {
 							// Create a copy of the service to avoid modifying the original
 							serviceCopy := service
@@ -70,6 +76,7 @@ func (r *reader) ListServices(ctx context.Context, consume func(models.Service)
 
 				// Otherwise, look for the service by exact key
 				if service, ok := services[id.Key()]; ok {
+					list.Scan()
 					// Create a copy of the service to avoid modifying the original
 					serviceCopy := service
 
@@ -97,6 +104,7 @@ func (r *reader) ListServices(ctx context.Context, consume func(models.Service)
 		}
 	}
 	for _, service := range services {
+		list.Scan()
 		// Create a copy of the service to avoid modifying the original
 		serviceCopy := service
 
@@ -123,6 +131,10 @@ func (r *reader) ListServices(ctx context.Context, consume func(models.Service)
 }
 
 func (r *reader) ListAddressGroups(ctx context.Context, consume func(models.AddressGroup) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroup", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var addressGroups map[string]models.AddressGroup
 
 	// Use data from writer if available
@@ -137,6 +149,7 @@ func (r *reader) ListAddressGroups(ctx context.Context, consume func(models.Addr
 				// If only namespace is set, return all address groups in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, addressGroup := range addressGroups {
+						list.Scan()
 						if addressGroup.Namespace == id.Namespace {
 							if err := consume(addressGroup); err != nil {
 								return err
@@ -148,6 +161,7 @@ func (r *reader) ListAddressGroups(ctx context.Context, consume func(models.Addr
 
 				// Otherwise, look for the address group by exact key
 				if addressGroup, ok := addressGroups[id.Key()]; ok {
+					list.Scan()
 					if err := consume(addressGroup); err != nil {
 						return err
 					}
@@ -157,6 +171,7 @@ func (r *reader) ListAddressGroups(ctx context.Context, consume func(models.Addr
 		}
 	}
 	for _, addressGroup := range addressGroups {
+		list.Scan()
 		if err := consume(addressGroup); err != nil {
 			return err
 		}
@@ -165,6 +180,10 @@ func (r *reader) ListAddressGroups(ctx context.Context, consume func(models.Addr
 }
 
 func (r *reader) ListAddressGroupBindings(ctx context.Context, consume func(models.AddressGroupBinding) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroupBinding", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var bindings map[string]models.AddressGroupBinding
 
 	// Use data from writer if available
@@ -179,6 +198,7 @@ func (r *reader) ListAddressGroupBindings(ctx context.Context, consume func(mode
 				// If only namespace is set, return all bindings in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, binding := range bindings {
+						list.Scan()
 						if binding.Namespace == id.Namespace {
 							if err := consume(binding); err != nil {
 								return err
@@ -190,6 +210,7 @@ func (r *reader) ListAddressGroupBindings(ctx context.Context, consume func(mode
 
 				// Otherwise, look for the binding by exact key
 				if binding, ok := bindings[id.Key()]; ok {
+					list.Scan()
 					if err := consume(binding); err != nil {
 						return err
 					}
@@ -199,6 +220,7 @@ func (r *reader) ListAddressGroupBindings(ctx context.Context, consume func(mode
 		}
 	}
 	for _, binding := range bindings {
+		list.Scan()
 		if err := consume(binding); err != nil {
 			return err
 		}
@@ -207,6 +229,10 @@ func (r *reader) ListAddressGroupBindings(ctx context.Context, consume func(mode
 }
 
 func (r *reader) ListAddressGroupPortMappings(ctx context.Context, consume func(models.AddressGroupPortMapping) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroupPortMapping", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var mappings map[string]models.AddressGroupPortMapping
 
 	// Use data from writer if available
@@ -221,6 +247,7 @@ func (r *reader) ListAddressGroupPortMappings(ctx context.Context, consume func(
 				// If only namespace is set, return all port mappings in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, mapping := range mappings {
+						list.Scan()
 						if mapping.Namespace == id.Namespace {
 							if err := consume(mapping); err != nil {
 								return err
@@ -232,6 +259,7 @@ func (r *reader) ListAddressGroupPortMappings(ctx context.Context, consume func(
 
 				// Otherwise, look for the port mapping by exact key
 				if mapping, ok := mappings[id.Key()]; ok {
+					list.Scan()
 					if err := consume(mapping); err != nil {
 						return err
 					}
@@ -241,6 +269,7 @@ func (r *reader) ListAddressGroupPortMappings(ctx context.Context, consume func(
 		}
 	}
 	for _, mapping := range mappings {
+		list.Scan()
 		if err := consume(mapping); err != nil {
 			return err
 		}
@@ -249,6 +278,10 @@ func (r *reader) ListAddressGroupPortMappings(ctx context.Context, consume func(
 }
 
 func (r *reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) error, scope ports.Scope) error {
+	list := readstats.Begin("RuleS2S", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var rules map[string]models.RuleS2S
 
 	// Use data from writer if available
@@ -263,6 +296,7 @@ func (r *reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) e
 				// If only namespace is set, return all rules in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, rule := range rules {
+						list.Scan()
 						if rule.Namespace == id.Namespace {
 							if err := consume(rule); err != nil {
 								return err
@@ -274,6 +308,7 @@ func (r *reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) e
 
 				// Otherwise, look for the rule by exact key
 				if rule, ok := rules[id.Key()]; ok {
+					list.Scan()
 					if err := consume(rule); err != nil {
 						return err
 					}
@@ -283,6 +318,7 @@ func (r *reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) e
 		}
 	}
 	for _, rule := range rules {
+		list.Scan()
 		if err := consume(rule); err != nil {
 			return err
 		}
@@ -291,6 +327,10 @@ func (r *reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) e
 }
 
 func (r *reader) ListServiceAliases(ctx context.Context, consume func(models.ServiceAlias) error, scope ports.Scope) error {
+	list := readstats.Begin("ServiceAlias", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var aliases map[string]models.ServiceAlias
 
 	// Use data from writer if available
@@ -305,6 +345,7 @@ func (r *reader) ListServiceAliases(ctx context.Context, consume func(models.Ser
 				// If only namespace is set, return all aliases in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, alias := range aliases {
+						list.Scan()
 						if alias.Namespace == id.Namespace {
 							if err := consume(alias); err != nil {
 								return err
@@ -316,6 +357,7 @@ func (r *reader) ListServiceAliases(ctx context.Context, consume func(models.Ser
 
 				// Otherwise, look for the alias by exact key
 				if alias, ok := aliases[id.Key()]; ok {
+					list.Scan()
 					if err := consume(alias); err != nil {
 						return err
 					}
@@ -325,6 +367,7 @@ func (r *reader) ListServiceAliases(ctx context.Context, consume func(models.Ser
 		}
 	}
 	for _, alias := range aliases {
+		list.Scan()
 		if err := consume(alias); err != nil {
 			return err
 		}
@@ -469,6 +512,10 @@ func (r *reader) GetServiceAliasByID(ctx context.Context, id models.ResourceIden
 }
 
 func (r *reader) ListAddressGroupBindingPolicies(ctx context.Context, consume func(models.AddressGroupBindingPolicy) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroupBindingPolicy", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var policies map[string]models.AddressGroupBindingPolicy
 
 	// Use data from writer if available
@@ -483,6 +530,7 @@ func (r *reader) ListAddressGroupBindingPolicies(ctx context.Context, consume fu
 				// Если установлен только namespace, возвращаем все политики в этом namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, policy := range policies {
+						list.Scan()
 						if policy.Namespace == id.Namespace {
 							if err := consume(policy); err != nil {
 								return err
@@ -494,6 +542,7 @@ func (r *reader) ListAddressGroupBindingPolicies(ctx context.Context, consume fu
 
 				// Иначе ищем политику по точному ключу
 				if policy, ok := policies[id.Key()]; ok {
+					list.Scan()
 					if err := consume(policy); err != nil {
 						return err
 					}
@@ -503,6 +552,7 @@ func (r *reader) ListAddressGroupBindingPolicies(ctx context.Context, consume fu
 		}
 	}
 	for _, policy := range policies {
+		list.Scan()
 		if err := consume(policy); err != nil {
 			return err
 		}
@@ -527,6 +577,10 @@ func (r *reader) GetAddressGroupBindingPolicyByID(ctx context.Context, id models
 }
 
 func (r *reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAgRule) error, scope ports.Scope) error {
+	list := readstats.Begin("IEAgAgRule", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var rules map[string]models.IEAgAgRule
 
 	// Use data from writer if available
@@ -541,6 +595,7 @@ func (r *reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAg
 				// If only namespace is set, return all rules in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, rule := range rules {
+						list.Scan()
 						if rule.Namespace == id.Namespace {
 							if err := consume(rule); err != nil {
 								return err
@@ -552,6 +607,7 @@ func (r *reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAg
 
 				// Otherwise, look for the rule by exact key
 				if rule, ok := rules[id.Key()]; ok {
+					list.Scan()
 					if err := consume(rule); err != nil {
 						return err
 					}
@@ -561,6 +617,7 @@ func (r *reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAg
 		}
 	}
 	for _, rule := range rules {
+		list.Scan()
 		if err := consume(rule); err != nil {
 			return err
 		}
@@ -587,6 +644,10 @@ func (r *reader) GetIEAgAgRuleByID(ctx context.Context, id models.ResourceIdenti
 }
 
 func (r *reader) ListNetworks(ctx context.Context, consume func(models.Network) error, scope ports.Scope) error {
+	list := readstats.Begin("Network", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var networks map[string]models.Network
 
 	// Use data from writer if available
@@ -602,6 +663,7 @@ func (r *reader) ListNetworks(ctx context.Context, consume func(models.Network)
 				// If only namespace is set, return all networks in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, network := range networks {
+						list.Scan()
 						if network.Namespace == id.Namespace {
 							if err := consume(network); err != nil {
 								return err
@@ -613,6 +675,7 @@ func (r *reader) ListNetworks(ctx context.Context, consume func(models.Network)
 
 				// Otherwise, look for the network by exact key
 				if network, ok := networks[id.Key()]; ok {
+					list.Scan()
 					if err := consume(network); err != nil {
 						return err
 					}
@@ -624,6 +687,7 @@ func (r *reader) ListNetworks(ctx context.Context, consume func(models.Network)
 
 	// If no scope or empty scope, return all networks
 	for _, network := range networks {
+		list.Scan()
 		if err := consume(network); err != nil {
 			return err
 		}
@@ -679,6 +743,10 @@ func (r *reader) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Net
 }
 
 func (r *reader) ListNetworkBindings(ctx context.Context, consume func(models.NetworkBinding) error, scope ports.Scope) error {
+	list := readstats.Begin("NetworkBinding", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var bindings map[string]models.NetworkBinding
 
 	// Use data from writer if available
@@ -694,6 +762,7 @@ func (r *reader) ListNetworkBindings(ctx context.Context, consume func(models.Ne
 				// If only namespace is set, return all network bindings in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, binding := range bindings {
+						list.Scan()
 						if binding.Namespace == id.Namespace {
 							if err := consume(binding); err != nil {
 								return err
@@ -705,6 +774,7 @@ func (r *reader) ListNetworkBindings(ctx context.Context, consume func(models.Ne
 
 				// Otherwise, look for the network binding by exact key
 				if binding, ok := bindings[id.Key()]; ok {
+					list.Scan()
 					if err := consume(binding); err != nil {
 						return err
 					}
@@ -716,6 +786,7 @@ func (r *reader) ListNetworkBindings(ctx context.Context, consume func(models.Ne
 
 	// If no scope or empty scope, return all network bindings
 	for _, binding := range bindings {
+		list.Scan()
 		if err := consume(binding); err != nil {
 			return err
 		}
@@ -743,6 +814,10 @@ func (r *reader) GetNetworkBindingByID(ctx context.Context, id models.ResourceId
 }
 
 func (r *reader) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	list := readstats.Begin("Host", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var hosts map[string]models.Host
 
 	// Use data from writer if available
@@ -758,6 +833,7 @@ func (r *reader) ListHosts(ctx context.Context, consume func(models.Host) error,
 				// If only namespace is set, return all hosts in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, host := range hosts {
+						list.Scan()
 						if host.Namespace == id.Namespace {
 							if err := consume(host); err != nil {
 								return err
@@ -769,6 +845,7 @@ func (r *reader) ListHosts(ctx context.Context, consume func(models.Host) error,
 
 				// Otherwise, look for the host by exact key
 				if host, ok := hosts[id.Key()]; ok {
+					list.Scan()
 					if err := consume(host); err != nil {
 						return err
 					}
@@ -780,6 +857,7 @@ func (r *reader) ListHosts(ctx context.Context, consume func(models.Host) error,
 
 	// If no scope or empty scope, return all hosts
 	for _, host := range hosts {
+		list.Scan()
 		if err := consume(host); err != nil {
 			return err
 		}
@@ -806,6 +884,10 @@ func (r *reader) GetHostByID(ctx context.Context, id models.ResourceIdentifier)
 }
 
 func (r *reader) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	list := readstats.Begin("HostBinding", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	var hostBindings map[string]models.HostBinding
 
 	// Use data from writer if available
@@ -821,6 +903,7 @@ func (r *reader) ListHostBindings(ctx context.Context, consume func(models.HostB
 				// If only namespace is set, return all host bindings in that namespace
 				if id.Name == "" && id.Namespace != "" {
 					for _, hostBinding := range hostBindings {
+						list.Scan()
 						if hostBinding.Namespace == id.Namespace {
 							if err := consume(hostBinding); err != nil {
 								return err
@@ -832,6 +915,7 @@ func (r *reader) ListHostBindings(ctx context.Context, consume func(models.HostB
 
 				// Otherwise, look for the host binding by exact key
 				if hostBinding, ok := hostBindings[id.Key()]; ok {
+					list.Scan()
 					if err := consume(hostBinding); err != nil {
 						return err
 					}
@@ -843,6 +927,7 @@ func (r *reader) ListHostBindings(ctx context.Context, consume func(models.HostB
 
 	// If no scope or empty scope, return all host bindings
 	for _, hostBinding := range hostBindings {
+		list.Scan()
 		if err := consume(hostBinding); err != nil {
 			return err
 		}
@@ -867,3 +952,305 @@ func (r *reader) GetHostBindingByID(ctx context.Context, id models.ResourceIdent
 
 	return nil, ports.ErrNotFound
 }
+
+func (r *reader) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	list := readstats.Begin("RuleS2SException", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	var exceptions map[string]models.RuleS2SException
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.ruleS2SExceptions != nil {
+		exceptions = r.writer.ruleS2SExceptions
+	} else {
+		exceptions = r.registry.db.GetRuleS2SExceptions()
+	}
+
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+		for _, id := range ris.Identifiers {
+			// If only namespace is set, return all exceptions in that namespace
+			if id.Name == "" && id.Namespace != "" {
+				for _, exception := range exceptions {
+					list.Scan()
+					if exception.Namespace == id.Namespace {
+						if err := consume(exception); err != nil {
+							return err
+						}
+					}
+				}
+				continue
+			}
+
+			if exception, ok := exceptions[id.Key()]; ok {
+				list.Scan()
+				if err := consume(exception); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, exception := range exceptions {
+		list.Scan()
+		if err := consume(exception); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	var exceptions map[string]models.RuleS2SException
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.ruleS2SExceptions != nil {
+		exceptions = r.writer.ruleS2SExceptions
+	} else {
+		exceptions = r.registry.db.GetRuleS2SExceptions()
+	}
+
+	if exception, ok := exceptions[id.Key()]; ok {
+		return &exception, nil
+	}
+
+	return nil, ports.ErrNotFound
+}
+
+func (r *reader) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	list := readstats.Begin("NamespacePosture", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	var postures map[string]models.NamespacePosture
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.namespacePostures != nil {
+		postures = r.writer.namespacePostures
+	} else {
+		postures = r.registry.db.GetNamespacePostures()
+	}
+
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+		for _, id := range ris.Identifiers {
+			// If only namespace is set, return all postures in that namespace
+			if id.Name == "" && id.Namespace != "" {
+				for _, posture := range postures {
+					list.Scan()
+					if posture.Namespace == id.Namespace {
+						if err := consume(posture); err != nil {
+							return err
+						}
+					}
+				}
+				continue
+			}
+
+			if posture, ok := postures[id.Key()]; ok {
+				list.Scan()
+				if err := consume(posture); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, posture := range postures {
+		list.Scan()
+		if err := consume(posture); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	var postures map[string]models.NamespacePosture
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.namespacePostures != nil {
+		postures = r.writer.namespacePostures
+	} else {
+		postures = r.registry.db.GetNamespacePostures()
+	}
+
+	if posture, ok := postures[id.Key()]; ok {
+		return &posture, nil
+	}
+
+	return nil, ports.ErrNotFound
+}
+
+func (r *reader) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	list := readstats.Begin("RuleTemplate", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	var templates map[string]models.RuleTemplate
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.ruleTemplates != nil {
+		templates = r.writer.ruleTemplates
+	} else {
+		templates = r.registry.db.GetRuleTemplates()
+	}
+
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+		for _, id := range ris.Identifiers {
+			// If only namespace is set, return all templates in that namespace
+			if id.Name == "" && id.Namespace != "" {
+				for _, template := range templates {
+					list.Scan()
+					if template.Namespace == id.Namespace {
+						if err := consume(template); err != nil {
+							return err
+						}
+					}
+				}
+				continue
+			}
+
+			if template, ok := templates[id.Key()]; ok {
+				list.Scan()
+				if err := consume(template); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, template := range templates {
+		list.Scan()
+		if err := consume(template); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	var templates map[string]models.RuleTemplate
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.ruleTemplates != nil {
+		templates = r.writer.ruleTemplates
+	} else {
+		templates = r.registry.db.GetRuleTemplates()
+	}
+
+	if template, ok := templates[id.Key()]; ok {
+		return &template, nil
+	}
+
+	return nil, ports.ErrNotFound
+}
+
+func (r *reader) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	list := readstats.Begin("CrossNamespacePolicy", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	var policies map[string]models.CrossNamespacePolicy
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.crossNamespacePolicies != nil {
+		policies = r.writer.crossNamespacePolicies
+	} else {
+		policies = r.registry.db.GetCrossNamespacePolicies()
+	}
+
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+		for _, id := range ris.Identifiers {
+			// If only namespace is set, return all policies in that namespace
+			if id.Name == "" && id.Namespace != "" {
+				for _, policy := range policies {
+					list.Scan()
+					if policy.Namespace == id.Namespace {
+						if err := consume(policy); err != nil {
+							return err
+						}
+					}
+				}
+				continue
+			}
+
+			if policy, ok := policies[id.Key()]; ok {
+				list.Scan()
+				if err := consume(policy); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, policy := range policies {
+		list.Scan()
+		if err := consume(policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	var policies map[string]models.CrossNamespacePolicy
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.crossNamespacePolicies != nil {
+		policies = r.writer.crossNamespacePolicies
+	} else {
+		policies = r.registry.db.GetCrossNamespacePolicies()
+	}
+
+	if policy, ok := policies[id.Key()]; ok {
+		return &policy, nil
+	}
+
+	return nil, ports.ErrNotFound
+}
+
+func (r *reader) ListIEAgAgRuleContributions(ctx context.Context, consume func(models.IEAgAgRuleContribution) error, ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) error {
+	var contributions map[string]models.IEAgAgRuleContribution
+
+	// Use data from writer if available
+	if r.writer != nil && r.writer.ieAgAgRuleContributions != nil {
+		contributions = r.writer.ieAgAgRuleContributions
+	} else {
+		contributions = r.registry.db.GetIEAgAgRuleContributions()
+	}
+
+	all := len(ruleS2SIDs) == 0 && len(ieAgAgRuleIDs) == 0
+	selected := make(map[string]bool, len(ruleS2SIDs)+len(ieAgAgRuleIDs))
+	for _, id := range ruleS2SIDs {
+		selected["s2s:"+id.Key()] = true
+	}
+	for _, id := range ieAgAgRuleIDs {
+		selected["ieagag:"+id.Key()] = true
+	}
+
+	for _, contribution := range contributions {
+		if !all && !selected["s2s:"+contribution.RuleS2S.Key()] && !selected["ieagag:"+contribution.IEAgAgRule.Key()] {
+			continue
+		}
+		if err := consume(contribution); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) IEAgAgRuleContributionIndexBuilt(ctx context.Context) (bool, error) {
+	if r.writer != nil && r.writer.contributionIndexBuilt {
+		return true, nil
+	}
+	return r.registry.db.IEAgAgRuleContributionsBuilt(), nil
+}
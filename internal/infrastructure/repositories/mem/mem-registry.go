@@ -3,6 +3,7 @@ package mem
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"netguard-pg-backend/internal/domain/ports"
@@ -11,17 +12,33 @@ import (
 
 // Registry is an in-memory implementation of the Registry interface
 type Registry struct {
-	db     *MemDB
-	mu     sync.RWMutex
-	subj   patterns.Subject
-	closed bool
+	db         *MemDB
+	mu         sync.RWMutex
+	subj       patterns.Subject
+	outbox     *SyncOutbox
+	quarantine *Quarantine
+	changeLog  *ChangeLog
+	conditions *ConditionHistory
+	// store persists committed resources, nil keeps them in memory only
+	store *fileStore
+	// snapshots persists resources periodically, nil without snapshots
+	snapshots *snapshotter
+	// commits counts committed writers
+	commits atomic.Uint64
+	// commitMu makes commits atomic for snapshot readers, commits hold it exclusively
+	commitMu sync.RWMutex
+	closed   bool
 }
 
 // NewRegistry creates a new in-memory registry
 func NewRegistry() *Registry {
 	return &Registry{
-		db:   NewMemDB(),
-		subj: &subject{},
+		db:         NewMemDB(),
+		subj:       &subject{},
+		outbox:     NewSyncOutbox(),
+		quarantine: NewQuarantine(),
+		changeLog:  NewChangeLog(),
+		conditions: NewConditionHistory(),
 	}
 }
 
@@ -43,6 +60,12 @@ func (r *Registry) Writer(ctx context.Context) (ports.Writer, error) {
 	}, nil
 }
 
+// WriterWithOptions returns a writer like Writer, commits of the in-memory registry are
+// serialized by the database lock and never fail with ports.ErrSerializationFailure
+func (r *Registry) WriterWithOptions(ctx context.Context, _ ports.WriterOptions) (ports.Writer, error) {
+	return r.Writer(ctx)
+}
+
 // Reader returns a new reader
 func (r *Registry) Reader(ctx context.Context) (ports.Reader, error) {
 	r.mu.RLock()
@@ -76,6 +99,11 @@ func (r *Registry) ReaderFromWriter(ctx context.Context, w ports.Writer) (ports.
 	}, nil
 }
 
+// PrimaryReader is Reader, the in-memory registry has no replica
+func (r *Registry) PrimaryReader(ctx context.Context) (ports.Reader, error) {
+	return r.Reader(ctx)
+}
+
 // ReaderWithReadCommitted returns a reader with ReadCommitted isolation for Cross-RuleS2S aggregation
 // For in-memory implementation, this behaves the same as a regular reader since there's no transaction isolation
 func (r *Registry) ReaderWithReadCommitted(ctx context.Context) (ports.Reader, error) {
@@ -84,6 +112,54 @@ func (r *Registry) ReaderWithReadCommitted(ctx context.Context) (ports.Reader, e
 	return r.Reader(ctx)
 }
 
+// ReaderAtReplicaSnapshot is ReaderAtSnapshot, the in-memory registry has no replica
+func (r *Registry) ReaderAtReplicaSnapshot(ctx context.Context) (ports.Reader, error) {
+	return r.ReaderAtSnapshot(ctx)
+}
+
+// ReaderAtSnapshot returns a reader of copies of all resources taken between two commits,
+// later commits are not visible to it
+func (r *Registry) ReaderAtSnapshot(ctx context.Context) (ports.Reader, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return nil, errors.New("registry is closed")
+	}
+
+	r.commitMu.RLock()
+	defer r.commitMu.RUnlock()
+	db := r.db
+	// The reader prefers the resources of its writer over the database, a writer that is
+	// never committed holds the snapshot
+	snapshot := &writer{
+		registry:                    r,
+		ctx:                         ctx,
+		services:                    db.GetServices(),
+		addressGroups:               db.GetAddressGroups(),
+		addressGroupBindings:        db.GetAddressGroupBindings(),
+		addressGroupPortMappings:    db.GetAddressGroupPortMappings(),
+		addressGroupBindingPolicies: db.GetAddressGroupBindingPolicies(),
+		ruleS2S:                     db.GetRuleS2S(),
+		serviceAliases:              db.GetServiceAliases(),
+		ieAgAgRules:                 db.GetIEAgAgRules(),
+		networks:                    db.GetNetworks(),
+		networkBindings:             db.GetNetworkBindings(),
+		hosts:                       db.GetHosts(),
+		hostBindings:                db.GetHostBindings(),
+		ruleS2SExceptions:           db.GetRuleS2SExceptions(),
+		namespacePostures:           db.GetNamespacePostures(),
+		ruleTemplates:               db.GetRuleTemplates(),
+		crossNamespacePolicies:      db.GetCrossNamespacePolicies(),
+		ieAgAgRuleContributions:     db.GetIEAgAgRuleContributions(),
+		contributionIndexBuilt:      db.IEAgAgRuleContributionsBuilt(),
+	}
+	return &reader{
+		registry: r,
+		ctx:      ctx,
+		writer:   snapshot,
+	}, nil
+}
+
 // Close closes the registry
 func (r *Registry) Close() error {
 	r.mu.Lock()
@@ -92,6 +168,9 @@ func (r *Registry) Close() error {
 		return nil
 	}
 	r.closed = true
+	if r.snapshots != nil {
+		return r.snapshot()
+	}
 	return nil
 }
 
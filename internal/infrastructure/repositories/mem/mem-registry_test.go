@@ -531,3 +531,53 @@ func TestMemRegistryAbort(t *testing.T) {
 		t.Fatalf("Expected 0 services, got %d", len(foundServices))
 	}
 }
+
+func TestMemRegistryReaderAtSnapshot(t *testing.T) {
+	registry := NewRegistry()
+	defer registry.Close()
+
+	ctx := context.Background()
+	sync := func(names ...string) {
+		writer, err := registry.Writer(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get writer: %v", err)
+		}
+		var services []models.Service
+		for _, name := range names {
+			services = append(services, models.Service{
+				SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("default"))),
+			})
+		}
+		if err := writer.SyncServices(ctx, services, ports.EmptyScope{}); err != nil {
+			t.Fatalf("Failed to sync services: %v", err)
+		}
+		if err := writer.Commit(); err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+	}
+
+	sync("web")
+	snapshot, err := registry.ReaderAtSnapshot(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get snapshot reader: %v", err)
+	}
+	defer snapshot.Close()
+
+	// Commits after the snapshot are not visible to it
+	sync("web", "db")
+
+	var names []string
+	err = snapshot.ListServices(ctx, func(service models.Service) error {
+		names = append(names, service.Name)
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		t.Fatalf("Failed to list services: %v", err)
+	}
+	if len(names) != 1 || names[0] != "web" {
+		t.Errorf("Snapshot reader listed %v, want only web", names)
+	}
+	if _, err := snapshot.GetServiceByID(ctx, models.NewResourceIdentifier("db", models.WithNamespace("default"))); err == nil {
+		t.Error("Snapshot reader found a service committed after the snapshot")
+	}
+}
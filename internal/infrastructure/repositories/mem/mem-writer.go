@@ -24,6 +24,13 @@ type writer struct {
 	networkBindings             map[string]models.NetworkBinding
 	hosts                       map[string]models.Host
 	hostBindings                map[string]models.HostBinding
+	ruleS2SExceptions           map[string]models.RuleS2SException
+	namespacePostures           map[string]models.NamespacePosture
+	ruleTemplates               map[string]models.RuleTemplate
+	crossNamespacePolicies      map[string]models.CrossNamespacePolicy
+	ieAgAgRuleContributions     map[string]models.IEAgAgRuleContribution
+	contributionIndexBuilt      bool
+	outboxEntries               []models.SyncOutboxEntry
 }
 
 func (w *writer) SyncServices(ctx context.Context, services []models.Service, scope ports.Scope, opts ...ports.Option) error {
@@ -704,37 +711,46 @@ func (w *writer) SyncAddressGroupBindingPolicies(ctx context.Context, policies [
 }
 
 func (w *writer) Commit() error {
+	w.registry.commitMu.Lock()
+	defer w.registry.commitMu.Unlock()
+
+	// A persisted registry stages the commit and swaps it in after it is written to the file,
+	// a failed write leaves the committed state unchanged
+	db := w.registry.db
+	if w.registry.store != nil {
+		db = db.clone()
+	}
 
 	if w.services != nil {
 		for _, _ = range w.services {
 		}
-		w.registry.db.SetServices(w.services)
+		db.SetServices(w.services)
 	} else {
 	}
 
 	if w.serviceAliases != nil {
-		w.registry.db.SetServiceAliases(w.serviceAliases)
+		db.SetServiceAliases(w.serviceAliases)
 	}
 	if w.addressGroups != nil {
 		for _, _ = range w.addressGroups {
 		}
-		w.registry.db.SetAddressGroups(w.addressGroups)
+		db.SetAddressGroups(w.addressGroups)
 	}
 	if w.addressGroupBindings != nil {
-		w.registry.db.SetAddressGroupBindings(w.addressGroupBindings)
+		db.SetAddressGroupBindings(w.addressGroupBindings)
 	} else {
 	}
 	if w.addressGroupPortMappings != nil {
-		w.registry.db.SetAddressGroupPortMappings(w.addressGroupPortMappings)
+		db.SetAddressGroupPortMappings(w.addressGroupPortMappings)
 	}
 	if w.addressGroupBindingPolicies != nil {
-		w.registry.db.SetAddressGroupBindingPolicies(w.addressGroupBindingPolicies)
+		db.SetAddressGroupBindingPolicies(w.addressGroupBindingPolicies)
 	}
 	if w.ruleS2S != nil {
-		w.registry.db.SetRuleS2S(w.ruleS2S)
+		db.SetRuleS2S(w.ruleS2S)
 	}
 	if w.ieAgAgRules != nil {
-		w.registry.db.SetIEAgAgRules(w.ieAgAgRules)
+		db.SetIEAgAgRules(w.ieAgAgRules)
 	}
 
 	if w.networks != nil {
@@ -744,27 +760,100 @@ func (w *writer) Commit() error {
 			if network.AddressGroupRef != nil {
 			}
 		}
-		w.registry.db.SetNetworks(w.networks)
+		db.SetNetworks(w.networks)
 	}
 
 	if w.networkBindings != nil {
 		for _, _ = range w.networkBindings {
 		}
-		w.registry.db.SetNetworkBindings(w.networkBindings)
+		db.SetNetworkBindings(w.networkBindings)
 	}
 
 	if w.hosts != nil {
-		w.registry.db.SetHosts(w.hosts)
+		db.SetHosts(w.hosts)
 	}
 
 	if w.hostBindings != nil {
-		w.registry.db.SetHostBindings(w.hostBindings)
+		db.SetHostBindings(w.hostBindings)
+	}
+
+	if w.ruleS2SExceptions != nil {
+		db.SetRuleS2SExceptions(w.ruleS2SExceptions)
+	}
+
+	if w.namespacePostures != nil {
+		db.SetNamespacePostures(w.namespacePostures)
+	}
+
+	if w.ruleTemplates != nil {
+		db.SetRuleTemplates(w.ruleTemplates)
 	}
 
-	w.registry.db.SetSyncStatus(models.SyncStatus{
+	if w.crossNamespacePolicies != nil {
+		db.SetCrossNamespacePolicies(w.crossNamespacePolicies)
+	}
+	if w.ieAgAgRuleContributions != nil {
+		db.SetIEAgAgRuleContributions(w.ieAgAgRuleContributions)
+	}
+	if w.contributionIndexBuilt {
+		db.MarkIEAgAgRuleContributionsBuilt()
+	}
+	db.SetSyncStatus(models.SyncStatus{
 		UpdatedAt: time.Now(),
 	})
 
+	if w.registry.store != nil {
+		if err := w.registry.store.save(func() (snapshot, error) {
+			return w.registry.stateSnapshot(db, w.outboxEntries)
+		}); err != nil {
+			return err
+		}
+		w.registry.db.replace(db)
+	}
+	if len(w.outboxEntries) > 0 {
+		w.registry.outbox.enqueue(w.outboxEntries)
+		w.outboxEntries = nil
+	}
+
+	w.registry.commits.Add(1)
+	return nil
+}
+
+// ReplaceIEAgAgRuleContributions replaces contributions to the given IEAgAg rules, nil ids replace the whole index
+// and mark it as built
+func (w *writer) ReplaceIEAgAgRuleContributions(ctx context.Context, ieAgAgRuleIDs []models.ResourceIdentifier, contributions []models.IEAgAgRuleContribution) error {
+	if ieAgAgRuleIDs == nil {
+		w.contributionIndexBuilt = true
+	}
+	if w.ieAgAgRuleContributions == nil || ieAgAgRuleIDs == nil {
+		w.ieAgAgRuleContributions = make(map[string]models.IEAgAgRuleContribution)
+		if ieAgAgRuleIDs != nil {
+			// Copy existing index
+			for k, v := range w.registry.db.GetIEAgAgRuleContributions() {
+				w.ieAgAgRuleContributions[k] = v
+			}
+		}
+	}
+
+	replaced := make(map[string]bool, len(ieAgAgRuleIDs))
+	for _, id := range ieAgAgRuleIDs {
+		replaced[id.Key()] = true
+	}
+	for k, contribution := range w.ieAgAgRuleContributions {
+		if replaced[contribution.IEAgAgRule.Key()] {
+			delete(w.ieAgAgRuleContributions, k)
+		}
+	}
+
+	for _, contribution := range contributions {
+		w.ieAgAgRuleContributions[contribution.Key()] = contribution
+	}
+	return nil
+}
+
+// EnqueueSyncOutbox buffers sgroups sync operations until Commit
+func (w *writer) EnqueueSyncOutbox(ctx context.Context, entries []models.SyncOutboxEntry) error {
+	w.outboxEntries = append(w.outboxEntries, entries...)
 	return nil
 }
 
@@ -785,6 +874,49 @@ func (w *writer) DeleteServicesByIDs(ctx context.Context, ids []models.ResourceI
 	return nil
 }
 
+// DeleteServicesCascade deletes services by IDs and removes them from the access ports of
+// all port mappings
+func (w *writer) DeleteServicesCascade(ctx context.Context, ids []models.ResourceIdentifier) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	if w.addressGroupPortMappings == nil {
+		w.addressGroupPortMappings = make(map[string]models.AddressGroupPortMapping)
+		for k, v := range w.registry.db.GetAddressGroupPortMappings() {
+			w.addressGroupPortMappings[k] = v
+		}
+	}
+
+	deleted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		deleted[id.Namespace+"/"+id.Name] = true
+	}
+	changed := 0
+	for key, mapping := range w.addressGroupPortMappings {
+		var accessPorts map[models.ServiceRef]models.ServicePorts
+		for ref, ports := range mapping.AccessPorts {
+			if deleted[models.ServiceRefKey(ref)] {
+				continue
+			}
+			if accessPorts == nil {
+				accessPorts = make(map[models.ServiceRef]models.ServicePorts, len(mapping.AccessPorts))
+			}
+			accessPorts[ref] = ports
+		}
+		if len(accessPorts) == len(mapping.AccessPorts) {
+			continue
+		}
+		if accessPorts == nil {
+			accessPorts = make(map[models.ServiceRef]models.ServicePorts)
+		}
+		mapping.AccessPorts = accessPorts
+		w.addressGroupPortMappings[key] = mapping
+		changed++
+	}
+
+	return changed, w.DeleteServicesByIDs(ctx, ids)
+}
+
 // DeleteAddressGroupsByIDs deletes address groups by IDs
 func (w *writer) DeleteAddressGroupsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
 	if w.addressGroups == nil {
@@ -1468,6 +1600,302 @@ func (w *writer) DeleteHostBindingsByIDs(ctx context.Context, ids []models.Resou
 	return nil
 }
 
+func (w *writer) SyncRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException, scope ports.Scope, opts ...ports.Option) error {
+	// Определение операции (по умолчанию FullSync)
+	syncOp := models.SyncOpFullSync
+
+	// Извлечение опций
+	for _, opt := range opts {
+		if so, ok := opt.(ports.SyncOption); ok {
+			syncOp = so.Operation
+		}
+	}
+
+	// Инициализация карты, если она еще не создана
+	if w.ruleS2SExceptions == nil {
+		w.ruleS2SExceptions = make(map[string]models.RuleS2SException)
+		// Всегда копируем существующие исключения, чтобы иметь полную карту для работы
+		for k, v := range w.registry.db.GetRuleS2SExceptions() {
+			w.ruleS2SExceptions[k] = v
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpFullSync:
+		// Если scope не пустой, удаляем только исключения в указанной области
+		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+			for _, id := range ris.Identifiers {
+				delete(w.ruleS2SExceptions, id.Key())
+			}
+		} else {
+			w.ruleS2SExceptions = make(map[string]models.RuleS2SException)
+		}
+		fallthrough
+
+	case models.SyncOpUpsert:
+		// Добавляем или обновляем исключения
+		for _, exception := range exceptions {
+			if existing, ok := w.ruleS2SExceptions[exception.Key()]; ok {
+				if exception.Meta.CreationTS.IsZero() {
+					exception.Meta.CreationTS = existing.Meta.CreationTS
+				}
+				if exception.Meta.UID == "" {
+					exception.Meta.UID = existing.Meta.UID
+				}
+			}
+			ensureMetaFill(&exception.Meta)
+			w.ruleS2SExceptions[exception.Key()] = exception
+		}
+
+	case models.SyncOpDelete:
+		// Удаляем исключения
+		for _, exception := range exceptions {
+			delete(w.ruleS2SExceptions, exception.Key())
+		}
+	}
+
+	return nil
+}
+
+func (w *writer) DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	// Инициализация карты, если она еще не создана
+	if w.ruleS2SExceptions == nil {
+		w.ruleS2SExceptions = make(map[string]models.RuleS2SException)
+		for k, v := range w.registry.db.GetRuleS2SExceptions() {
+			w.ruleS2SExceptions[k] = v
+		}
+	}
+
+	// Удаляем исключения по идентификаторам
+	for _, id := range ids {
+		delete(w.ruleS2SExceptions, id.Key())
+	}
+
+	return nil
+}
+
+func (w *writer) SyncNamespacePostures(ctx context.Context, postures []models.NamespacePosture, scope ports.Scope, opts ...ports.Option) error {
+	// Определение операции (по умолчанию FullSync)
+	syncOp := models.SyncOpFullSync
+
+	// Извлечение опций
+	for _, opt := range opts {
+		if so, ok := opt.(ports.SyncOption); ok {
+			syncOp = so.Operation
+		}
+	}
+
+	// Инициализация карты, если она еще не создана
+	if w.namespacePostures == nil {
+		w.namespacePostures = make(map[string]models.NamespacePosture)
+		// Всегда копируем существующие режимы неймспейсов, чтобы иметь полную карту для работы
+		for k, v := range w.registry.db.GetNamespacePostures() {
+			w.namespacePostures[k] = v
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpFullSync:
+		// Если scope не пустой, удаляем только режимы неймспейсов в указанной области
+		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+			for _, id := range ris.Identifiers {
+				delete(w.namespacePostures, id.Key())
+			}
+		} else {
+			w.namespacePostures = make(map[string]models.NamespacePosture)
+		}
+		fallthrough
+
+	case models.SyncOpUpsert:
+		// Добавляем или обновляем режимы неймспейсов
+		for _, posture := range postures {
+			if existing, ok := w.namespacePostures[posture.Key()]; ok {
+				if posture.Meta.CreationTS.IsZero() {
+					posture.Meta.CreationTS = existing.Meta.CreationTS
+				}
+				if posture.Meta.UID == "" {
+					posture.Meta.UID = existing.Meta.UID
+				}
+			}
+			ensureMetaFill(&posture.Meta)
+			w.namespacePostures[posture.Key()] = posture
+		}
+
+	case models.SyncOpDelete:
+		// Удаляем режимы неймспейсов
+		for _, posture := range postures {
+			delete(w.namespacePostures, posture.Key())
+		}
+	}
+
+	return nil
+}
+
+func (w *writer) DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	// Инициализация карты, если она еще не создана
+	if w.namespacePostures == nil {
+		w.namespacePostures = make(map[string]models.NamespacePosture)
+		for k, v := range w.registry.db.GetNamespacePostures() {
+			w.namespacePostures[k] = v
+		}
+	}
+
+	// Удаляем режимы неймспейсов по идентификаторам
+	for _, id := range ids {
+		delete(w.namespacePostures, id.Key())
+	}
+
+	return nil
+}
+
+func (w *writer) SyncRuleTemplates(ctx context.Context, templates []models.RuleTemplate, scope ports.Scope, opts ...ports.Option) error {
+	// Определение операции (по умолчанию FullSync)
+	syncOp := models.SyncOpFullSync
+
+	// Извлечение опций
+	for _, opt := range opts {
+		if so, ok := opt.(ports.SyncOption); ok {
+			syncOp = so.Operation
+		}
+	}
+
+	// Инициализация карты, если она еще не создана
+	if w.ruleTemplates == nil {
+		w.ruleTemplates = make(map[string]models.RuleTemplate)
+		// Всегда копируем существующие шаблоны, чтобы иметь полную карту для работы
+		for k, v := range w.registry.db.GetRuleTemplates() {
+			w.ruleTemplates[k] = v
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpFullSync:
+		// Если scope не пустой, удаляем только шаблоны в указанной области
+		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+			for _, id := range ris.Identifiers {
+				delete(w.ruleTemplates, id.Key())
+			}
+		} else {
+			w.ruleTemplates = make(map[string]models.RuleTemplate)
+		}
+		fallthrough
+
+	case models.SyncOpUpsert:
+		// Добавляем или обновляем шаблоны
+		for _, template := range templates {
+			if existing, ok := w.ruleTemplates[template.Key()]; ok {
+				if template.Meta.CreationTS.IsZero() {
+					template.Meta.CreationTS = existing.Meta.CreationTS
+				}
+				if template.Meta.UID == "" {
+					template.Meta.UID = existing.Meta.UID
+				}
+			}
+			ensureMetaFill(&template.Meta)
+			w.ruleTemplates[template.Key()] = template
+		}
+
+	case models.SyncOpDelete:
+		// Удаляем шаблоны
+		for _, template := range templates {
+			delete(w.ruleTemplates, template.Key())
+		}
+	}
+
+	return nil
+}
+
+func (w *writer) DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	// Инициализация карты, если она еще не создана
+	if w.ruleTemplates == nil {
+		w.ruleTemplates = make(map[string]models.RuleTemplate)
+		for k, v := range w.registry.db.GetRuleTemplates() {
+			w.ruleTemplates[k] = v
+		}
+	}
+
+	// Удаляем шаблоны по идентификаторам
+	for _, id := range ids {
+		delete(w.ruleTemplates, id.Key())
+	}
+
+	return nil
+}
+
+func (w *writer) SyncCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, scope ports.Scope, opts ...ports.Option) error {
+	// Определение операции (по умолчанию FullSync)
+	syncOp := models.SyncOpFullSync
+
+	// Извлечение опций
+	for _, opt := range opts {
+		if so, ok := opt.(ports.SyncOption); ok {
+			syncOp = so.Operation
+		}
+	}
+
+	// Инициализация карты, если она еще не создана
+	if w.crossNamespacePolicies == nil {
+		w.crossNamespacePolicies = make(map[string]models.CrossNamespacePolicy)
+		// Всегда копируем существующие политики, чтобы иметь полную карту для работы
+		for k, v := range w.registry.db.GetCrossNamespacePolicies() {
+			w.crossNamespacePolicies[k] = v
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpFullSync:
+		// Если scope не пустой, удаляем только политики в указанной области
+		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() {
+			for _, id := range ris.Identifiers {
+				delete(w.crossNamespacePolicies, id.Key())
+			}
+		} else {
+			w.crossNamespacePolicies = make(map[string]models.CrossNamespacePolicy)
+		}
+		fallthrough
+
+	case models.SyncOpUpsert:
+		// Добавляем или обновляем политики
+		for _, policy := range policies {
+			if existing, ok := w.crossNamespacePolicies[policy.Key()]; ok {
+				if policy.Meta.CreationTS.IsZero() {
+					policy.Meta.CreationTS = existing.Meta.CreationTS
+				}
+				if policy.Meta.UID == "" {
+					policy.Meta.UID = existing.Meta.UID
+				}
+			}
+			ensureMetaFill(&policy.Meta)
+			w.crossNamespacePolicies[policy.Key()] = policy
+		}
+
+	case models.SyncOpDelete:
+		// Удаляем политики
+		for _, policy := range policies {
+			delete(w.crossNamespacePolicies, policy.Key())
+		}
+	}
+
+	return nil
+}
+
+func (w *writer) DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	// Инициализация карты, если она еще не создана
+	if w.crossNamespacePolicies == nil {
+		w.crossNamespacePolicies = make(map[string]models.CrossNamespacePolicy)
+		for k, v := range w.registry.db.GetCrossNamespacePolicies() {
+			w.crossNamespacePolicies[k] = v
+		}
+	}
+
+	// Удаляем политики по идентификаторам
+	for _, id := range ids {
+		delete(w.crossNamespacePolicies, id.Key())
+	}
+
+	return nil
+}
+
 func (w *writer) Abort() {
 	w.services = nil
 	w.addressGroups = nil
@@ -1481,4 +1909,11 @@ func (w *writer) Abort() {
 	w.networkBindings = nil
 	w.hosts = nil
 	w.hostBindings = nil
+	w.ruleS2SExceptions = nil
+	w.namespacePostures = nil
+	w.ruleTemplates = nil
+	w.crossNamespacePolicies = nil
+	w.ieAgAgRuleContributions = nil
+	w.contributionIndexBuilt = false
+	w.outboxEntries = nil
 }
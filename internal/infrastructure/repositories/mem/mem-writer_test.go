@@ -2,6 +2,7 @@ package mem
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"netguard-pg-backend/internal/domain/models"
@@ -426,3 +427,187 @@ func TestSyncServicesWithDifferentOperations(t *testing.T) {
 		}
 	})
 }
+
+func TestReplaceIEAgAgRuleContributions(t *testing.T) {
+	registry := NewRegistry()
+	defer registry.Close()
+
+	ctx := context.Background()
+
+	id := func(name string) models.ResourceIdentifier {
+		return models.NewResourceIdentifier(name, models.WithNamespace("default"))
+	}
+	replace := func(ieAgAgRuleIDs []models.ResourceIdentifier, contributions ...models.IEAgAgRuleContribution) {
+		writer, err := registry.Writer(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get writer: %v", err)
+		}
+		if err := writer.(ports.IEAgAgRuleContributionWriter).ReplaceIEAgAgRuleContributions(ctx, ieAgAgRuleIDs, contributions); err != nil {
+			t.Fatalf("Failed to replace contributions: %v", err)
+		}
+		if err := writer.Commit(); err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+	}
+	list := func(ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) map[string]bool {
+		reader, err := registry.Reader(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get reader: %v", err)
+		}
+		defer reader.Close()
+
+		keys := make(map[string]bool)
+		err = reader.(ports.IEAgAgRuleContributionReader).ListIEAgAgRuleContributions(ctx, func(c models.IEAgAgRuleContribution) error {
+			keys[c.Key()] = true
+			return nil
+		}, ruleS2SIDs, ieAgAgRuleIDs)
+		if err != nil {
+			t.Fatalf("Failed to list contributions: %v", err)
+		}
+		return keys
+	}
+
+	built := func() bool {
+		reader, err := registry.Reader(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get reader: %v", err)
+		}
+		defer reader.Close()
+
+		built, err := reader.(ports.IEAgAgRuleContributionReader).IEAgAgRuleContributionIndexBuilt(ctx)
+		if err != nil {
+			t.Fatalf("Failed to check index: %v", err)
+		}
+		return built
+	}
+
+	webToDB := models.IEAgAgRuleContribution{RuleS2S: id("web-db"), IEAgAgRule: id("ing-tcp"), Ports: []string{"5432"}}
+	apiToDB := models.IEAgAgRuleContribution{RuleS2S: id("api-db"), IEAgAgRule: id("ing-tcp"), Ports: []string{"5432", "6432"}}
+	webToCache := models.IEAgAgRuleContribution{RuleS2S: id("web-db"), IEAgAgRule: id("ing-udp")}
+
+	// Partial replacement doesn't build the index
+	replace([]models.ResourceIdentifier{id("ing-udp")}, webToCache)
+	if built() {
+		t.Fatal("Expected index not to be built by partial replacement")
+	}
+
+	// nil replaces the whole index and marks it as built
+	replace(nil, webToDB, apiToDB, webToCache)
+	if keys := list(nil, nil); len(keys) != 3 {
+		t.Fatalf("Expected 3 contributions, got %v", keys)
+	}
+	if !built() {
+		t.Fatal("Expected index to be built")
+	}
+
+	// Only contributions of the replaced rule change
+	replace([]models.ResourceIdentifier{id("ing-tcp")}, apiToDB)
+	keys := list(nil, nil)
+	if len(keys) != 2 || !keys[apiToDB.Key()] || !keys[webToCache.Key()] {
+		t.Errorf("Unexpected contributions after replace: %v", keys)
+	}
+
+	// Contributions are selected by RuleS2S or by IEAgAg rule
+	if keys := list([]models.ResourceIdentifier{id("web-db")}, nil); len(keys) != 1 || !keys[webToCache.Key()] {
+		t.Errorf("Unexpected contributions of web-db: %v", keys)
+	}
+	if keys := list([]models.ResourceIdentifier{id("web-db")}, []models.ResourceIdentifier{id("ing-tcp")}); len(keys) != 2 {
+		t.Errorf("Unexpected contributions of web-db or ing-tcp: %v", keys)
+	}
+
+	// Contributed ports are kept
+	reader, err := registry.Reader(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get reader: %v", err)
+	}
+	defer reader.Close()
+	err = reader.(ports.IEAgAgRuleContributionReader).ListIEAgAgRuleContributions(ctx, func(c models.IEAgAgRuleContribution) error {
+		if c.Key() == apiToDB.Key() && strings.Join(c.Ports, ",") != "5432,6432" {
+			t.Errorf("Unexpected ports of %s: %v", c.Key(), c.Ports)
+		}
+		return nil
+	}, nil, []models.ResourceIdentifier{id("ing-tcp")})
+	if err != nil {
+		t.Fatalf("Failed to list contributions: %v", err)
+	}
+}
+
+func TestDeleteServicesCascade(t *testing.T) {
+	registry := NewRegistry()
+	defer registry.Close()
+
+	ctx := context.Background()
+
+	web := models.NewResourceIdentifier("web", models.WithNamespace("default"))
+	api := models.NewResourceIdentifier("api", models.WithNamespace("default"))
+	accessPorts := func(ids ...models.ResourceIdentifier) map[models.ServiceRef]models.ServicePorts {
+		result := make(map[models.ServiceRef]models.ServicePorts)
+		for _, id := range ids {
+			result[models.NewServiceRef(id.Name, models.WithNamespace(id.Namespace))] = models.ServicePorts{}
+		}
+		return result
+	}
+	frontend := models.AddressGroupPortMapping{
+		SelfRef:     models.NewSelfRef(models.NewResourceIdentifier("frontend", models.WithNamespace("default"))),
+		AccessPorts: accessPorts(web, api),
+	}
+	backend := models.AddressGroupPortMapping{
+		SelfRef:     models.NewSelfRef(models.NewResourceIdentifier("backend", models.WithNamespace("default"))),
+		AccessPorts: accessPorts(api),
+	}
+
+	writer, err := registry.Writer(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	services := []models.Service{
+		{SelfRef: models.NewSelfRef(web)},
+		{SelfRef: models.NewSelfRef(api)},
+	}
+	if err := writer.SyncServices(ctx, services, ports.EmptyScope{}); err != nil {
+		t.Fatalf("Failed to sync services: %v", err)
+	}
+	if err := writer.SyncAddressGroupPortMappings(ctx, []models.AddressGroupPortMapping{frontend, backend}, ports.EmptyScope{}); err != nil {
+		t.Fatalf("Failed to sync port mappings: %v", err)
+	}
+	if err := writer.Commit(); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	writer, err = registry.Writer(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get writer: %v", err)
+	}
+	changed, err := writer.(ports.ServiceCascadeDeleter).DeleteServicesCascade(ctx, []models.ResourceIdentifier{web})
+	if err != nil {
+		t.Fatalf("Failed to delete services: %v", err)
+	}
+	if changed != 1 {
+		t.Errorf("Expected 1 changed port mapping, got %d", changed)
+	}
+	if err := writer.Commit(); err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	reader, err := registry.Reader(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get reader: %v", err)
+	}
+	defer reader.Close()
+
+	if _, err := reader.GetServiceByID(ctx, web); err != ports.ErrNotFound {
+		t.Errorf("Expected deleted service to be not found, got %v", err)
+	}
+	if _, err := reader.GetServiceByID(ctx, api); err != nil {
+		t.Errorf("Expected service api to be kept, got %v", err)
+	}
+	for _, mapping := range []models.AddressGroupPortMapping{frontend, backend} {
+		stored, err := reader.GetAddressGroupPortMappingByID(ctx, mapping.ResourceIdentifier)
+		if err != nil {
+			t.Fatalf("Failed to get port mapping %s: %v", mapping.Key(), err)
+		}
+		if len(stored.AccessPorts) != 1 {
+			t.Errorf("Expected only api in port mapping %s, got %v", mapping.Key(), stored.AccessPorts)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package mem
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Quarantine is an in-memory implementation of ports.Quarantine
+type Quarantine struct {
+	mu        sync.Mutex
+	nextID    int64
+	resources []models.QuarantinedResource
+}
+
+var _ ports.Quarantine = &Quarantine{}
+
+// NewQuarantine creates an empty quarantine
+func NewQuarantine() *Quarantine {
+	return &Quarantine{}
+}
+
+// Quarantine returns the quarantine of resources imported into the registry
+func (r *Registry) Quarantine() *Quarantine {
+	return r.quarantine
+}
+
+// Quarantine stores the resource or updates the entry of the same resource
+func (q *Quarantine) Quarantine(_ context.Context, resource models.QuarantinedResource) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	for i := range q.resources {
+		if !q.resources[i].SameResource(resource) {
+			continue
+		}
+		q.resources[i].Reasons = resource.Reasons
+		q.resources[i].Document = resource.Document
+		q.resources[i].Occurrences++
+		q.resources[i].LastSeenAt = now
+		return q.resources[i].ID, nil
+	}
+
+	q.nextID++
+	resource.ID = q.nextID
+	resource.Occurrences = 1
+	resource.FirstSeenAt = now
+	resource.LastSeenAt = now
+	q.resources = append(q.resources, resource)
+	return resource.ID, nil
+}
+
+// ListQuarantined returns quarantined resources
+func (q *Quarantine) ListQuarantined(_ context.Context) ([]models.QuarantinedResource, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	resources := make([]models.QuarantinedResource, len(q.resources))
+	copy(resources, q.resources)
+	return resources, nil
+}
+
+// GetQuarantined returns a quarantined resource by id
+func (q *Quarantine) GetQuarantined(_ context.Context, id int64) (models.QuarantinedResource, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, resource := range q.resources {
+		if resource.ID == id {
+			return resource, nil
+		}
+	}
+	return models.QuarantinedResource{}, ports.ErrNotFound
+}
+
+// RemoveQuarantined removes a resource from the quarantine
+func (q *Quarantine) RemoveQuarantined(_ context.Context, id int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, resource := range q.resources {
+		if resource.ID == id {
+			q.resources = append(q.resources[:i], q.resources[i+1:]...)
+			return nil
+		}
+	}
+	return ports.ErrNotFound
+}
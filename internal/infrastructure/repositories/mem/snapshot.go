@@ -0,0 +1,455 @@
+package mem
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// snapshotVersion is the layout version of the embedded storage file. It grows with
+// incompatible layout changes, a backend refuses files of a newer layout instead of
+// dropping the resources it does not know.
+const snapshotVersion = 2
+
+// snapshot is the content of the embedded storage file
+type snapshot struct {
+	Version                     int
+	Services                    map[string]models.Service
+	ServiceAliases              map[string]models.ServiceAlias
+	AddressGroups               map[string]models.AddressGroup
+	AddressGroupBindings        map[string]models.AddressGroupBinding
+	AddressGroupPortMappings    map[string]models.AddressGroupPortMapping
+	AddressGroupBindingPolicies map[string]models.AddressGroupBindingPolicy
+	RuleS2S                     map[string]models.RuleS2S
+	IEAgAgRules                 map[string]models.IEAgAgRule
+	Networks                    map[string]models.Network
+	NetworkBindings             map[string]models.NetworkBinding
+	Hosts                       map[string]models.Host
+	HostBindings                map[string]models.HostBinding
+	RuleS2SExceptions           map[string]models.RuleS2SException
+	NamespacePostures           map[string]models.NamespacePosture
+	RuleTemplates               map[string]models.RuleTemplate
+	CrossNamespacePolicies      map[string]models.CrossNamespacePolicy
+	IEAgAgRuleContributions     map[string]models.IEAgAgRuleContribution
+	ContributionIndexBuilt      bool
+	SyncStatus                  models.SyncStatus
+	Outbox                      outboxSnapshot
+	ChangeLog                   changeLogSnapshot
+	ConditionHistory            conditionHistorySnapshot
+}
+
+// outboxSnapshot is the sync outbox in the file, pending entries are due after a restart
+type outboxSnapshot struct {
+	NextID      int64
+	Entries     []resourceEntrySnapshot
+	DeadLetters []resourceEntrySnapshot
+}
+
+// resourceEntrySnapshot is a sync outbox entry in the file. The resource is stored as JSON
+// like in the sync_outbox table, gob does not encode interface values of unregistered types.
+type resourceEntrySnapshot struct {
+	Entry   models.SyncOutboxEntry
+	Payload []byte
+}
+
+// changeLogSnapshot is the change log in the file
+type changeLogSnapshot struct {
+	Events            []changeEventSnapshot
+	LastRevision      uint64
+	CompactedRevision uint64
+}
+
+// changeEventSnapshot is a change event in the file with the resource stored as JSON
+type changeEventSnapshot struct {
+	Event   models.ChangeEvent
+	Kind    string
+	Payload []byte
+}
+
+// conditionHistorySnapshot is the condition history in the file
+type conditionHistorySnapshot struct {
+	Transitions []conditionTransitionSnapshot
+	LastID      int64
+}
+
+type conditionTransitionSnapshot struct {
+	Transition models.ConditionTransition
+	RecordedAt time.Time
+}
+
+// persistFunc writes the state of a persisted registry, nil keeps the state in memory only
+type persistFunc func() error
+
+func (p persistFunc) run() error {
+	if p == nil {
+		return nil
+	}
+	return p()
+}
+
+// fileStore persists the resources of a registry in a single file
+type fileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// snapshotter writes periodic snapshots of a registry
+type snapshotter struct {
+	store *fileStore
+	// saved is the commit count of the last snapshot
+	saved atomic.Uint64
+}
+
+// NewFileRegistry creates an in-memory registry persisted to the file at path, for
+// single-node and edge deployments without PostgreSQL. The state is loaded from the file
+// if it exists. Every commit is written to the file before it becomes visible, a commit
+// that can't be written fails and leaves the state unchanged. The sync outbox, change log
+// and condition history are stored in the file as well and written after every change.
+func NewFileRegistry(path string) (*Registry, error) {
+	r := NewRegistry()
+	r.store = &fileStore{path: path}
+	if err := r.store.load(r); err != nil {
+		return nil, err
+	}
+	r.outbox.persist = r.persist
+	r.changeLog.persist = r.persist
+	r.conditions.persist = r.persist
+	return r, nil
+}
+
+// NewSnapshotRegistry creates an in-memory registry restored from the snapshot at path,
+// empty if there is no snapshot yet. RunSnapshots writes snapshots while the registry is
+// used and Close writes the last one, commits between two snapshots are lost on a crash.
+func NewSnapshotRegistry(path string) (*Registry, error) {
+	r := NewRegistry()
+	r.snapshots = &snapshotter{store: &fileStore{path: path}}
+	if err := r.snapshots.store.load(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// RunSnapshots writes a snapshot every interval if resources were committed since the
+// last one, until ctx is done
+func (r *Registry) RunSnapshots(ctx context.Context, interval time.Duration) {
+	if r.snapshots == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.snapshot(); err != nil {
+				klog.Errorf("❌ MEM_SNAPSHOT: Failed to write snapshot: %v", err)
+			}
+		}
+	}
+}
+
+// snapshot writes a snapshot if resources were committed since the last one
+func (r *Registry) snapshot() error {
+	commits := r.commits.Load()
+	if commits == r.snapshots.saved.Load() {
+		return nil
+	}
+	r.commitMu.RLock()
+	defer r.commitMu.RUnlock()
+	if err := r.snapshots.store.save(func() (snapshot, error) { return r.stateSnapshot(r.db, nil) }); err != nil {
+		return err
+	}
+	r.snapshots.saved.Store(commits)
+	return nil
+}
+
+// persist writes the committed state of a file registry after changes outside of commits
+func (r *Registry) persist() error {
+	r.commitMu.RLock()
+	defer r.commitMu.RUnlock()
+	return r.store.save(func() (snapshot, error) { return r.stateSnapshot(r.db, nil) })
+}
+
+// stateSnapshot returns the state of the registry with the resources of db and the
+// outbox entries pending in a commit
+func (r *Registry) stateSnapshot(db *MemDB, pending []models.SyncOutboxEntry) (snapshot, error) {
+	snap := snapshot{
+		Version:                     snapshotVersion,
+		Services:                    db.GetServices(),
+		ServiceAliases:              db.GetServiceAliases(),
+		AddressGroups:               db.GetAddressGroups(),
+		AddressGroupBindings:        db.GetAddressGroupBindings(),
+		AddressGroupPortMappings:    db.GetAddressGroupPortMappings(),
+		AddressGroupBindingPolicies: db.GetAddressGroupBindingPolicies(),
+		RuleS2S:                     db.GetRuleS2S(),
+		IEAgAgRules:                 db.GetIEAgAgRules(),
+		Networks:                    db.GetNetworks(),
+		NetworkBindings:             db.GetNetworkBindings(),
+		Hosts:                       db.GetHosts(),
+		HostBindings:                db.GetHostBindings(),
+		RuleS2SExceptions:           db.GetRuleS2SExceptions(),
+		NamespacePostures:           db.GetNamespacePostures(),
+		RuleTemplates:               db.GetRuleTemplates(),
+		CrossNamespacePolicies:      db.GetCrossNamespacePolicies(),
+		IEAgAgRuleContributions:     db.GetIEAgAgRuleContributions(),
+		ContributionIndexBuilt:      db.IEAgAgRuleContributionsBuilt(),
+		SyncStatus:                  db.GetSyncStatus(),
+	}
+	var err error
+	if snap.Outbox, err = r.outbox.snapshot(pending); err != nil {
+		return snapshot{}, err
+	}
+	if snap.ChangeLog, err = r.changeLog.snapshot(); err != nil {
+		return snapshot{}, err
+	}
+	snap.ConditionHistory = r.conditions.snapshot()
+	return snap, nil
+}
+
+// snapshot returns the entries of the outbox followed by the entries pending in a commit,
+// numbered like enqueue does
+func (o *SyncOutbox) snapshot(pending []models.SyncOutboxEntry) (outboxSnapshot, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	snap := outboxSnapshot{NextID: o.nextID}
+	for _, entry := range o.entries {
+		stored, err := newResourceEntrySnapshot(entry.SyncOutboxEntry)
+		if err != nil {
+			return outboxSnapshot{}, err
+		}
+		snap.Entries = append(snap.Entries, stored)
+	}
+	for _, entry := range pending {
+		snap.NextID++
+		entry.ID = snap.NextID
+		stored, err := newResourceEntrySnapshot(entry)
+		if err != nil {
+			return outboxSnapshot{}, err
+		}
+		snap.Entries = append(snap.Entries, stored)
+	}
+	for _, entry := range o.deadLetters {
+		stored, err := newResourceEntrySnapshot(entry)
+		if err != nil {
+			return outboxSnapshot{}, err
+		}
+		snap.DeadLetters = append(snap.DeadLetters, stored)
+	}
+	return snap, nil
+}
+
+func (o *SyncOutbox) restore(snap outboxSnapshot) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := time.Now()
+	o.nextID = snap.NextID
+	o.entries = nil
+	for _, stored := range snap.Entries {
+		entry, err := stored.entry()
+		if err != nil {
+			return err
+		}
+		o.entries = append(o.entries, outboxEntry{SyncOutboxEntry: entry, dueAt: now})
+	}
+	o.deadLetters = nil
+	for _, stored := range snap.DeadLetters {
+		entry, err := stored.entry()
+		if err != nil {
+			return err
+		}
+		o.deadLetters = append(o.deadLetters, entry)
+	}
+	return nil
+}
+
+func newResourceEntrySnapshot(entry models.SyncOutboxEntry) (resourceEntrySnapshot, error) {
+	payload, err := json.Marshal(entry.Resource)
+	if err != nil {
+		return resourceEntrySnapshot{}, errors.Wrapf(err, "failed to marshal sync outbox entry %d", entry.ID)
+	}
+	entry.Resource = nil
+	return resourceEntrySnapshot{Entry: entry, Payload: payload}, nil
+}
+
+func (s resourceEntrySnapshot) entry() (models.SyncOutboxEntry, error) {
+	resource, err := models.DecodeChangeResource(s.Entry.Kind, s.Payload)
+	if err != nil {
+		return models.SyncOutboxEntry{}, errors.Wrapf(err, "failed to decode sync outbox entry %d", s.Entry.ID)
+	}
+	entry := s.Entry
+	entry.Resource = resource
+	return entry, nil
+}
+
+func (l *ChangeLog) snapshot() (changeLogSnapshot, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	snap := changeLogSnapshot{LastRevision: l.lastRevision, CompactedRevision: l.compactedRevision}
+	for _, event := range l.events {
+		payload, err := json.Marshal(event.Resource)
+		if err != nil {
+			return changeLogSnapshot{}, errors.Wrapf(err, "failed to marshal change event %d", event.Revision)
+		}
+		kind := event.Kind()
+		event.Resource = nil
+		snap.Events = append(snap.Events, changeEventSnapshot{Event: event, Kind: kind, Payload: payload})
+	}
+	return snap, nil
+}
+
+func (l *ChangeLog) restore(snap changeLogSnapshot) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = nil
+	for _, stored := range snap.Events {
+		resource, err := models.DecodeChangeResource(stored.Kind, stored.Payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode change event %d", stored.Event.Revision)
+		}
+		event := stored.Event
+		event.Resource = resource
+		l.events = append(l.events, event)
+	}
+	l.lastRevision = snap.LastRevision
+	l.compactedRevision = snap.CompactedRevision
+	return nil
+}
+
+func (h *ConditionHistory) snapshot() conditionHistorySnapshot {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	snap := conditionHistorySnapshot{LastID: h.lastID}
+	for _, record := range h.transitions {
+		snap.Transitions = append(snap.Transitions, conditionTransitionSnapshot{
+			Transition: record.transition,
+			RecordedAt: record.recordedAt,
+		})
+	}
+	return snap
+}
+
+func (h *ConditionHistory) restore(snap conditionHistorySnapshot) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.transitions = nil
+	for _, stored := range snap.Transitions {
+		h.transitions = append(h.transitions, conditionTransitionRecord{
+			transition: stored.Transition,
+			recordedAt: stored.RecordedAt,
+		})
+	}
+	h.lastID = snap.LastID
+}
+
+func (s *fileStore) load(r *Registry) error {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to read embedded storage file")
+	}
+
+	var snap snapshot
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&snap); err != nil {
+		return errors.Wrapf(err, "failed to decode embedded storage file %s", s.path)
+	}
+	if snap.Version > snapshotVersion {
+		return errors.Errorf("embedded storage file %s has layout version %d, this backend supports up to %d",
+			s.path, snap.Version, snapshotVersion)
+	}
+
+	db := r.db
+	db.SetServices(orEmpty(snap.Services))
+	db.SetServiceAliases(orEmpty(snap.ServiceAliases))
+	db.SetAddressGroups(orEmpty(snap.AddressGroups))
+	db.SetAddressGroupBindings(orEmpty(snap.AddressGroupBindings))
+	db.SetAddressGroupPortMappings(orEmpty(snap.AddressGroupPortMappings))
+	db.SetAddressGroupBindingPolicies(orEmpty(snap.AddressGroupBindingPolicies))
+	db.SetRuleS2S(orEmpty(snap.RuleS2S))
+	db.SetIEAgAgRules(orEmpty(snap.IEAgAgRules))
+	db.SetNetworks(orEmpty(snap.Networks))
+	db.SetNetworkBindings(orEmpty(snap.NetworkBindings))
+	db.SetHosts(orEmpty(snap.Hosts))
+	db.SetHostBindings(orEmpty(snap.HostBindings))
+	db.SetRuleS2SExceptions(orEmpty(snap.RuleS2SExceptions))
+	db.SetNamespacePostures(orEmpty(snap.NamespacePostures))
+	db.SetRuleTemplates(orEmpty(snap.RuleTemplates))
+	db.SetCrossNamespacePolicies(orEmpty(snap.CrossNamespacePolicies))
+	db.SetIEAgAgRuleContributions(orEmpty(snap.IEAgAgRuleContributions))
+	if snap.ContributionIndexBuilt {
+		db.MarkIEAgAgRuleContributionsBuilt()
+	}
+	db.SetSyncStatus(snap.SyncStatus)
+
+	if err := r.outbox.restore(snap.Outbox); err != nil {
+		return errors.Wrapf(err, "failed to restore sync outbox from %s", s.path)
+	}
+	if err := r.changeLog.restore(snap.ChangeLog); err != nil {
+		return errors.Wrapf(err, "failed to restore change log from %s", s.path)
+	}
+	r.conditions.restore(snap.ConditionHistory)
+	return nil
+}
+
+// save writes the state returned by build. The file is replaced atomically, a crash leaves
+// the state of the previous save. Saves run one after another and build the state when
+// they run, the last save contains all changes.
+func (s *fileStore) save(build func() (snapshot, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap, err := build()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&snap); err != nil {
+		return errors.Wrap(err, "failed to encode embedded storage")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create embedded storage file")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to write embedded storage file")
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to sync embedded storage file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to close embedded storage file")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), s.path), "failed to replace embedded storage file")
+}
+
+// orEmpty keeps the maps of the database non-nil for kinds missing in the file
+func orEmpty[V any](m map[string]V) map[string]V {
+	if m == nil {
+		return make(map[string]V)
+	}
+	return m
+}
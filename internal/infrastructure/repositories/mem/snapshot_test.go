@@ -0,0 +1,240 @@
+package mem
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+func TestFileRegistry_PersistsCommittedResources(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "netguard.db")
+
+	registry, err := NewFileRegistry(path)
+	require.NoError(t, err)
+
+	service := models.Service{
+		SelfRef:      models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("default"))),
+		Description:  "Web service",
+		IngressPorts: []models.IngressPort{{Protocol: models.TCP, Port: "80"}},
+		Meta:         models.Meta{Labels: map[string]string{"app": "web"}},
+	}
+	group := models.AddressGroup{
+		SelfRef:       models.NewSelfRef(models.NewResourceIdentifier("frontend", models.WithNamespace("default"))),
+		DefaultAction: models.ActionAccept,
+	}
+	mapping := models.AddressGroupPortMapping{
+		SelfRef: group.SelfRef,
+		AccessPorts: map[models.ServiceRef]models.ServicePorts{
+			models.NewServiceRef("web", models.WithNamespace("default")): {
+				Ports: models.ProtocolPorts{models.TCP: []models.PortRange{{Start: 80, End: 80}}},
+			},
+		},
+	}
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{service}, ports.EmptyScope{}))
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{group}, ports.EmptyScope{}))
+	require.NoError(t, writer.SyncAddressGroupPortMappings(ctx, []models.AddressGroupPortMapping{mapping}, ports.EmptyScope{}))
+	require.NoError(t, writer.Commit())
+	require.NoError(t, registry.Close())
+
+	// A restarted backend reads the committed resources from the file
+	restarted, err := NewFileRegistry(path)
+	require.NoError(t, err)
+	reader, err := restarted.Reader(ctx)
+	require.NoError(t, err)
+
+	stored, err := reader.GetServiceByID(ctx, service.ResourceIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, "Web service", stored.Description)
+	assert.Equal(t, "web", stored.Meta.Labels["app"])
+
+	storedMapping, err := reader.GetAddressGroupPortMappingByID(ctx, mapping.ResourceIdentifier)
+	require.NoError(t, err)
+	assert.Len(t, storedMapping.AccessPorts, 1)
+}
+
+func TestFileRegistry_RejectsNewerLayout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "netguard.db")
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(&snapshot{Version: snapshotVersion + 1}))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+	_, err := NewFileRegistry(path)
+	assert.ErrorContains(t, err, "layout version")
+}
+
+func TestSnapshotRegistry_RestoresLastSnapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "netguard.snapshot")
+
+	registry, err := NewSnapshotRegistry(path)
+	require.NoError(t, err)
+
+	// Without commits there is nothing to snapshot
+	require.NoError(t, registry.snapshot())
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	network := models.Network{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier("office", models.WithNamespace("default"))),
+		CIDR:    "10.0.0.0/24",
+	}
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncNetworks(ctx, []models.Network{network}, ports.EmptyScope{}))
+	require.NoError(t, writer.Commit())
+
+	// Commits are kept in memory until the next snapshot, Close writes the last one
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, registry.Close())
+
+	restored, err := NewSnapshotRegistry(path)
+	require.NoError(t, err)
+	reader, err := restored.Reader(ctx)
+	require.NoError(t, err)
+	stored, err := reader.GetNetworkByID(ctx, network.ResourceIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/24", stored.CIDR)
+}
+
+func TestFileRegistry_FailedWriteLeavesStateUnchanged(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "storage")
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	path := filepath.Join(dir, "netguard.db")
+
+	registry, err := NewFileRegistry(path)
+	require.NoError(t, err)
+
+	group := models.AddressGroup{
+		SelfRef:       models.NewSelfRef(models.NewResourceIdentifier("frontend", models.WithNamespace("default"))),
+		DefaultAction: models.ActionAccept,
+	}
+	commit := func() error {
+		writer, err := registry.Writer(ctx)
+		require.NoError(t, err)
+		require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{group}, ports.EmptyScope{}))
+		require.NoError(t, writer.(ports.SyncOutboxWriter).EnqueueSyncOutbox(ctx, []models.SyncOutboxEntry{
+			models.NewAddressGroupSyncOutboxEntry(group, types.SyncOperationUpsert),
+		}))
+		return writer.Commit()
+	}
+
+	// The file can't be written without its directory
+	require.NoError(t, os.RemoveAll(dir))
+	require.Error(t, commit())
+
+	reader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	_, err = reader.GetAddressGroupByID(ctx, group.ResourceIdentifier)
+	assert.ErrorIs(t, err, ports.ErrNotFound, "a commit that was not written must not be visible")
+	assert.Zero(t, registry.SyncOutbox().Len(), "outbox entries of a failed commit must not be enqueued")
+
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	require.NoError(t, commit())
+	stored, err := reader.GetAddressGroupByID(ctx, group.ResourceIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, models.ActionAccept, stored.DefaultAction)
+	assert.Equal(t, 1, registry.SyncOutbox().Len())
+}
+
+func TestFileRegistry_PersistsOutboxChangeLogAndConditionHistory(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "netguard.db")
+
+	registry, err := NewFileRegistry(path)
+	require.NoError(t, err)
+
+	group := models.AddressGroup{
+		SelfRef:       models.NewSelfRef(models.NewResourceIdentifier("frontend", models.WithNamespace("default"))),
+		DefaultAction: models.ActionAccept,
+	}
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{group}, ports.EmptyScope{}))
+	require.NoError(t, writer.(ports.SyncOutboxWriter).EnqueueSyncOutbox(ctx, []models.SyncOutboxEntry{
+		models.NewAddressGroupSyncOutboxEntry(group, types.SyncOperationUpsert),
+		models.NewAddressGroupSyncOutboxEntry(group, types.SyncOperationDelete),
+	}))
+	require.NoError(t, writer.Commit())
+
+	outbox := registry.SyncOutbox()
+	claimed, err := outbox.Claim(ctx, 2, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	require.NoError(t, outbox.DeadLetter(ctx, claimed[1].ID, assert.AnError))
+
+	require.NoError(t, registry.ChangeLog().Append(ctx, models.ChangeEvent{
+		Revision:  7,
+		SyncOp:    models.SyncOpFullSync,
+		Resource:  group,
+		Timestamp: time.Now(),
+	}))
+	transition := models.ConditionTransition{
+		Kind:               "AddressGroup",
+		ResourceIdentifier: group.ResourceIdentifier,
+		Type:               "Ready",
+		From:               "False",
+		To:                 "True",
+		Time:               time.Now(),
+	}
+	require.NoError(t, registry.ConditionHistory().Record(ctx, []models.ConditionTransition{transition}))
+
+	// Changes outside of commits are written without closing the registry
+	restarted, err := NewFileRegistry(path)
+	require.NoError(t, err)
+
+	// Claims of the previous process are released
+	pending, err := restarted.SyncOutbox().Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, claimed[0].ID, pending[0].ID)
+	assert.Equal(t, group, pending[0].Resource)
+	deadLetters, err := restarted.SyncOutbox().ListDeadLetters(ctx)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, assert.AnError.Error(), deadLetters[0].LastError)
+
+	revision, err := restarted.ChangeLog().LastRevision(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), revision)
+	events, err := restarted.ChangeLog().Since(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, group, events[0].Resource)
+
+	transitions, err := restarted.ConditionHistory().List(ctx, ports.ConditionTransitionQuery{})
+	require.NoError(t, err)
+	require.Len(t, transitions, 1)
+	assert.Equal(t, "Ready", transitions[0].Type)
+	lastID, err := restarted.ConditionHistory().LastID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), lastID)
+
+	// New entries continue the numbering of the stored ones
+	writer, err = restarted.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.(ports.SyncOutboxWriter).EnqueueSyncOutbox(ctx, []models.SyncOutboxEntry{
+		models.NewAddressGroupSyncOutboxEntry(group, types.SyncOperationUpsert),
+	}))
+	require.NoError(t, writer.Commit())
+	pending, err = restarted.SyncOutbox().Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, pending, 1, "the restored entry is claimed")
+	assert.Equal(t, int64(3), pending[0].ID)
+}
@@ -0,0 +1,197 @@
+package mem
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// SyncOutbox is an in-memory implementation of ports.SyncOutbox
+type SyncOutbox struct {
+	mu          sync.Mutex
+	nextID      int64
+	entries     []outboxEntry
+	deadLetters []models.SyncOutboxEntry
+	// persist writes the state of a persisted registry after deliveries, claims are not
+	// persisted and are released by a restart
+	persist persistFunc
+}
+
+type outboxEntry struct {
+	models.SyncOutboxEntry
+	dueAt time.Time
+}
+
+var (
+	_ ports.SyncOutbox          = &SyncOutbox{}
+	_ ports.SyncDeadLetterQueue = &SyncOutbox{}
+)
+
+// NewSyncOutbox creates an empty outbox
+func NewSyncOutbox() *SyncOutbox {
+	return &SyncOutbox{}
+}
+
+// SyncOutbox returns the outbox filled by the registry writers
+func (r *Registry) SyncOutbox() *SyncOutbox {
+	return r.outbox
+}
+
+// enqueue stores entries committed by a writer
+func (o *SyncOutbox) enqueue(entries []models.SyncOutboxEntry) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := time.Now()
+	for _, entry := range entries {
+		o.nextID++
+		entry.ID = o.nextID
+		o.entries = append(o.entries, outboxEntry{SyncOutboxEntry: entry, dueAt: now})
+	}
+}
+
+// Claim returns up to limit due entries and postpones them for lease
+func (o *SyncOutbox) Claim(_ context.Context, limit int, lease time.Duration) ([]models.SyncOutboxEntry, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := time.Now()
+	var claimed []models.SyncOutboxEntry
+	for i := range o.entries {
+		if len(claimed) >= limit {
+			break
+		}
+		if o.entries[i].dueAt.After(now) {
+			continue
+		}
+		o.entries[i].dueAt = now.Add(lease)
+		claimed = append(claimed, o.entries[i].SyncOutboxEntry)
+	}
+	return claimed, nil
+}
+
+// Complete removes delivered entries
+func (o *SyncOutbox) Complete(_ context.Context, ids ...int64) error {
+	o.mu.Lock()
+	done := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		done[id] = true
+	}
+	kept := o.entries[:0]
+	for _, entry := range o.entries {
+		if !done[entry.ID] {
+			kept = append(kept, entry)
+		}
+	}
+	o.entries = kept
+	o.mu.Unlock()
+	return o.persist.run()
+}
+
+// Retry records a failed delivery attempt
+func (o *SyncOutbox) Retry(_ context.Context, id int64, cause error, next time.Time) error {
+	if err := o.retry(id, cause, next); err != nil {
+		return err
+	}
+	return o.persist.run()
+}
+
+func (o *SyncOutbox) retry(id int64, cause error, next time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := range o.entries {
+		if o.entries[i].ID == id {
+			o.entries[i].Attempts++
+			if cause != nil {
+				o.entries[i].LastError = cause.Error()
+			}
+			o.entries[i].dueAt = next
+			return nil
+		}
+	}
+	return ports.ErrNotFound
+}
+
+// DeadLetter moves an entry to the dead-letter queue
+func (o *SyncOutbox) DeadLetter(_ context.Context, id int64, cause error) error {
+	if err := o.deadLetter(id, cause); err != nil {
+		return err
+	}
+	return o.persist.run()
+}
+
+func (o *SyncOutbox) deadLetter(id int64, cause error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := range o.entries {
+		if o.entries[i].ID != id {
+			continue
+		}
+		entry := o.entries[i].SyncOutboxEntry
+		entry.Attempts++
+		if cause != nil {
+			entry.LastError = cause.Error()
+		}
+		entry.FailedAt = time.Now()
+		o.entries = append(o.entries[:i], o.entries[i+1:]...)
+		o.deadLetters = append(o.deadLetters, entry)
+		return nil
+	}
+	return ports.ErrNotFound
+}
+
+// ListDeadLetters returns dead-lettered entries
+func (o *SyncOutbox) ListDeadLetters(_ context.Context) ([]models.SyncOutboxEntry, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	entries := make([]models.SyncOutboxEntry, len(o.deadLetters))
+	copy(entries, o.deadLetters)
+	return entries, nil
+}
+
+// GetDeadLetter returns a dead-lettered entry by id
+func (o *SyncOutbox) GetDeadLetter(_ context.Context, id int64) (models.SyncOutboxEntry, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, entry := range o.deadLetters {
+		if entry.ID == id {
+			return entry, nil
+		}
+	}
+	return models.SyncOutboxEntry{}, ports.ErrNotFound
+}
+
+// RemoveDeadLetter removes an entry from the dead-letter queue
+func (o *SyncOutbox) RemoveDeadLetter(_ context.Context, id int64) error {
+	if err := o.removeDeadLetter(id); err != nil {
+		return err
+	}
+	return o.persist.run()
+}
+
+func (o *SyncOutbox) removeDeadLetter(id int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i, entry := range o.deadLetters {
+		if entry.ID == id {
+			o.deadLetters = append(o.deadLetters[:i], o.deadLetters[i+1:]...)
+			return nil
+		}
+	}
+	return ports.ErrNotFound
+}
+
+// Len returns the number of pending entries
+func (o *SyncOutbox) Len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.entries)
+}
@@ -0,0 +1,73 @@
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Config configures the MySQL/MariaDB registry and its connection pool
+type Config struct {
+	// DSN is the data source name of go-sql-driver/mysql, e.g.
+	// netguard:secret@tcp(mysql:3306)/netguard. Empty disables the MySQL registry.
+	DSN             string        `yaml:"dsn" env:"MYSQL_DSN"`
+	MaxOpenConns    int           `yaml:"max-open-conns" env:"MYSQL_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max-idle-conns" env:"MYSQL_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn-max-lifetime" env:"MYSQL_CONN_MAX_LIFETIME"`
+	// WriteIsolation is the isolation level of writers that do not request one, one of
+	// read-committed, repeatable-read or serializable
+	WriteIsolation string `yaml:"write-isolation" env:"MYSQL_WRITE_ISOLATION"`
+	// TxTimeout bounds a writer transaction from begin to commit. Zero leaves transactions
+	// to the server side timeouts.
+	TxTimeout time.Duration `yaml:"tx-timeout" env:"MYSQL_TX_TIMEOUT"`
+}
+
+// DefaultConfig returns the configuration sized like the PostgreSQL pool
+func DefaultConfig() Config {
+	return Config{
+		MaxOpenConns:    50,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 2 * time.Hour,
+		WriteIsolation:  string(ports.IsolationRepeatableRead),
+		TxTimeout:       2 * time.Minute,
+	}
+}
+
+// Validate validates the configuration
+func (c Config) Validate() error {
+	if c.MaxOpenConns <= 0 {
+		return fmt.Errorf("mysql max open conns must be positive")
+	}
+	if c.MaxIdleConns < 0 || c.MaxIdleConns > c.MaxOpenConns {
+		return fmt.Errorf("mysql max idle conns must be between 0 and max open conns")
+	}
+	if c.ConnMaxLifetime <= 0 {
+		return fmt.Errorf("mysql connection lifetime must be positive")
+	}
+	if c.TxTimeout < 0 {
+		return fmt.Errorf("mysql tx timeout cannot be negative")
+	}
+	if _, err := txIsoLevel(ports.IsolationLevel(c.WriteIsolation)); err != nil {
+		return fmt.Errorf("mysql write isolation: %w", err)
+	}
+	return nil
+}
+
+// txIsoLevel maps a writer isolation level to the database/sql one, the default level
+// is repeatable read like the InnoDB default
+func txIsoLevel(level ports.IsolationLevel) (sql.IsolationLevel, error) {
+	switch level {
+	case ports.IsolationDefault, ports.IsolationRepeatableRead:
+		return sql.LevelRepeatableRead, nil
+	case ports.IsolationReadCommitted:
+		return sql.LevelReadCommitted, nil
+	case ports.IsolationSerializable:
+		return sql.LevelSerializable, nil
+	default:
+		return 0, errors.Errorf("unsupported transaction isolation level %q", level)
+	}
+}
@@ -0,0 +1,347 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// reader reads the database or a transaction. Lists pass rows to consume while the query is
+// read, the aggregated address groups of services and hosts of address groups are read
+// before, a connection or transaction runs one query at a time.
+type reader struct {
+	q querier
+	// tx is the read-only transaction owned by the reader, nil for readers of the database
+	// and of a writer
+	tx *sql.Tx
+}
+
+// Close rolls back the read-only transaction of the reader
+func (r *reader) Close() error {
+	if r.tx != nil {
+		return r.tx.Rollback()
+	}
+	return nil
+}
+
+func (r *reader) ListServices(ctx context.Context, consume func(models.Service) error, scope ports.Scope) error {
+	bound, err := boundAddressGroups(ctx, r.q, scope)
+	if err != nil {
+		return err
+	}
+	return services.list(ctx, r.q, scope, func(service models.Service) error {
+		aggregateAddressGroups(&service, bound)
+		return consume(service)
+	})
+}
+
+func (r *reader) GetServiceByID(ctx context.Context, id models.ResourceIdentifier) (*models.Service, error) {
+	bound, err := boundAddressGroups(ctx, r.q, ports.NewResourceIdentifierScope(id))
+	if err != nil {
+		return nil, err
+	}
+	service, err := services.get(ctx, r.q, id)
+	if err != nil {
+		return nil, err
+	}
+	aggregateAddressGroups(service, bound)
+	return service, nil
+}
+
+func (r *reader) ListAddressGroups(ctx context.Context, consume func(models.AddressGroup) error, scope ports.Scope) error {
+	members, err := readHostMembers(ctx, r.q, scope)
+	if err != nil {
+		return err
+	}
+	return addressGroups.list(ctx, r.q, scope, func(group models.AddressGroup) error {
+		members.aggregate(&group)
+		return consume(group)
+	})
+}
+
+func (r *reader) GetAddressGroupByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
+	members, err := readHostMembers(ctx, r.q, ports.NewResourceIdentifierScope(id))
+	if err != nil {
+		return nil, err
+	}
+	group, err := addressGroups.get(ctx, r.q, id)
+	if err != nil {
+		return nil, err
+	}
+	members.aggregate(group)
+	return group, nil
+}
+
+func (r *reader) ListAddressGroupBindings(ctx context.Context, consume func(models.AddressGroupBinding) error, scope ports.Scope) error {
+	return addressGroupBindings.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetAddressGroupBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupBinding, error) {
+	return addressGroupBindings.get(ctx, r.q, id)
+}
+
+func (r *reader) ListAddressGroupPortMappings(ctx context.Context, consume func(models.AddressGroupPortMapping) error, scope ports.Scope) error {
+	return addressGroupPortMappings.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetAddressGroupPortMappingByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupPortMapping, error) {
+	return addressGroupPortMappings.get(ctx, r.q, id)
+}
+
+func (r *reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) error, scope ports.Scope) error {
+	return ruleS2S.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetRuleS2SByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2S, error) {
+	return ruleS2S.get(ctx, r.q, id)
+}
+
+func (r *reader) ListServiceAliases(ctx context.Context, consume func(models.ServiceAlias) error, scope ports.Scope) error {
+	return serviceAliases.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetServiceAliasByID(ctx context.Context, id models.ResourceIdentifier) (*models.ServiceAlias, error) {
+	return serviceAliases.get(ctx, r.q, id)
+}
+
+func (r *reader) ListAddressGroupBindingPolicies(ctx context.Context, consume func(models.AddressGroupBindingPolicy) error, scope ports.Scope) error {
+	return addressGroupBindingPolicies.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetAddressGroupBindingPolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupBindingPolicy, error) {
+	return addressGroupBindingPolicies.get(ctx, r.q, id)
+}
+
+func (r *reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAgRule) error, scope ports.Scope) error {
+	return ieAgAgRules.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetIEAgAgRuleByID(ctx context.Context, id models.ResourceIdentifier) (*models.IEAgAgRule, error) {
+	return ieAgAgRules.get(ctx, r.q, id)
+}
+
+func (r *reader) ListNetworks(ctx context.Context, consume func(models.Network) error, scope ports.Scope) error {
+	return networks.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetNetworkByID(ctx context.Context, id models.ResourceIdentifier) (*models.Network, error) {
+	return networks.get(ctx, r.q, id)
+}
+
+// GetNetworkByCIDR gets a network by CIDR (for uniqueness validation)
+func (r *reader) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Network, error) {
+	var namespace, name string
+	err := r.q.QueryRowContext(ctx, "SELECT namespace, name FROM networks WHERE cidr = ? ORDER BY namespace, name LIMIT 1", cidr).Scan(&namespace, &name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ports.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to look up network by CIDR")
+	}
+	return networks.get(ctx, r.q, models.NewResourceIdentifier(name, models.WithNamespace(namespace)))
+}
+
+func (r *reader) ListNetworkBindings(ctx context.Context, consume func(models.NetworkBinding) error, scope ports.Scope) error {
+	return networkBindings.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetNetworkBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.NetworkBinding, error) {
+	return networkBindings.get(ctx, r.q, id)
+}
+
+func (r *reader) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	return hosts.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error) {
+	return hosts.get(ctx, r.q, id)
+}
+
+func (r *reader) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	return hostBindings.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error) {
+	return hostBindings.get(ctx, r.q, id)
+}
+
+func (r *reader) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return ruleS2SExceptions.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return ruleS2SExceptions.get(ctx, r.q, id)
+}
+
+func (r *reader) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	return crossNamespacePolicies.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return crossNamespacePolicies.get(ctx, r.q, id)
+}
+
+func (r *reader) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return ruleTemplates.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return ruleTemplates.get(ctx, r.q, id)
+}
+
+func (r *reader) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return namespacePostures.list(ctx, r.q, scope, consume)
+}
+
+func (r *reader) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return namespacePostures.get(ctx, r.q, id)
+}
+
+// GetSyncStatus gets the sync status (singleton pattern)
+func (r *reader) GetSyncStatus(ctx context.Context) (*models.SyncStatus, error) {
+	var status models.SyncStatus
+	err := r.q.QueryRowContext(ctx, "SELECT updated_at FROM sync_status WHERE id = 1").Scan(&status.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ports.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan sync status")
+	}
+	return &status, nil
+}
+
+// boundAddressGroups returns the existing address groups bound to the services of scope by
+// AddressGroupBindings, by service key in binding order
+func boundAddressGroups(ctx context.Context, q querier, scope ports.Scope) (map[string][]models.AddressGroupRef, error) {
+	query := `SELECT agb.service_namespace, agb.service_name, ag.namespace, ag.name
+		FROM address_group_bindings agb
+		JOIN address_groups ag ON ag.namespace = agb.address_group_namespace AND ag.name = agb.address_group_name`
+	filter, args := scopeFilter(scope, "agb.service_namespace", "agb.service_name")
+	if filter != "" {
+		query += " WHERE " + filter
+	}
+	query += " ORDER BY agb.namespace, agb.name"
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read address groups of bindings")
+	}
+	defer rows.Close()
+
+	bound := make(map[string][]models.AddressGroupRef)
+	for rows.Next() {
+		var service, group models.ResourceIdentifier
+		if err := rows.Scan(&service.Namespace, &service.Name, &group.Namespace, &group.Name); err != nil {
+			return nil, errors.Wrap(err, "failed to scan address group of binding")
+		}
+		bound[service.Key()] = append(bound[service.Key()], models.NewAddressGroupRef(group.Name, models.WithNamespace(group.Namespace)))
+	}
+	return bound, errors.Wrap(rows.Err(), "failed to read address groups of bindings")
+}
+
+// aggregateAddressGroups sets the address groups of the service spec and of its bindings
+// like the aggregate_service_address_groups function of the PostgreSQL schema
+func aggregateAddressGroups(service *models.Service, bound map[string][]models.AddressGroupRef) {
+	aggregated := make([]models.AddressGroupReference, 0, len(service.AddressGroups)+len(bound[service.Key()]))
+	for _, ref := range service.AddressGroups {
+		aggregated = append(aggregated, models.AddressGroupReference{Ref: ref, Source: models.AddressGroupSourceSpec})
+	}
+	for _, ref := range bound[service.Key()] {
+		aggregated = append(aggregated, models.AddressGroupReference{Ref: ref, Source: models.AddressGroupSourceBinding})
+	}
+	service.AggregatedAddressGroups = aggregated
+}
+
+// hostMembers are the hosts of the address groups of a scope
+type hostMembers struct {
+	// uuids are the UUIDs of the hosts of the address group namespaces by host key
+	uuids map[string]string
+	// bound are the hosts bound to address groups by HostBindings, by address group key
+	bound map[string][]models.HostReference
+}
+
+// readHostMembers reads the hosts of the address groups of scope
+func readHostMembers(ctx context.Context, q querier, scope ports.Scope) (*hostMembers, error) {
+	members := &hostMembers{uuids: make(map[string]string), bound: make(map[string][]models.HostReference)}
+
+	// Spec hosts are in the namespace of their address group
+	query := "SELECT namespace, name, uuid FROM hosts"
+	filter, args := scopeFilter(namespacesOf(scope), "namespace", "name")
+	if filter != "" {
+		query += " WHERE " + filter
+	}
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read host UUIDs")
+	}
+	for rows.Next() {
+		var host models.ResourceIdentifier
+		var uuid string
+		if err := rows.Scan(&host.Namespace, &host.Name, &uuid); err != nil {
+			rows.Close()
+			return nil, errors.Wrap(err, "failed to scan host UUID")
+		}
+		members.uuids[host.Key()] = uuid
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read host UUIDs")
+	}
+
+	query = `SELECT hb.address_group_namespace, hb.address_group_name, h.name, h.uuid
+		FROM host_bindings hb
+		JOIN hosts h ON h.namespace = hb.host_namespace AND h.name = hb.host_name`
+	filter, args = scopeFilter(scope, "hb.address_group_namespace", "hb.address_group_name")
+	if filter != "" {
+		query += " WHERE " + filter
+	}
+	query += " ORDER BY hb.namespace, hb.name"
+	rows, err = q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read hosts of bindings")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var group models.ResourceIdentifier
+		var name, uuid string
+		if err := rows.Scan(&group.Namespace, &group.Name, &name, &uuid); err != nil {
+			return nil, errors.Wrap(err, "failed to scan host of binding")
+		}
+		ref := v1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "Host", Name: name}
+		members.bound[group.Key()] = append(members.bound[group.Key()], models.NewHostReference(ref, uuid, models.HostSourceBinding))
+	}
+	return members, errors.Wrap(rows.Err(), "failed to read hosts of bindings")
+}
+
+// aggregate sets the hosts of the address group spec and of its bindings like the
+// aggregate_address_group_hosts function of the PostgreSQL schema
+func (m *hostMembers) aggregate(group *models.AddressGroup) {
+	aggregated := make([]models.HostReference, 0, len(group.Hosts)+len(m.bound[group.Key()]))
+	for _, ref := range group.Hosts {
+		host := models.NewResourceIdentifier(ref.Name, models.WithNamespace(group.Namespace))
+		aggregated = append(aggregated, models.NewHostReference(ref, m.uuids[host.Key()], models.HostSourceSpec))
+	}
+	aggregated = append(aggregated, m.bound[group.Key()]...)
+	group.AggregatedHosts = aggregated
+}
+
+// namespacesOf widens the identifiers of scope to their namespaces
+func namespacesOf(scope ports.Scope) ports.Scope {
+	if selector, ok := scope.(ports.SelectorScope); ok {
+		scope = selector.Scope
+	}
+	ris, ok := scope.(ports.ResourceIdentifierScope)
+	if !ok || ris.IsEmpty() {
+		return ports.EmptyScope{}
+	}
+	namespaces := make([]models.ResourceIdentifier, 0, len(ris.Identifiers))
+	for _, id := range ris.Identifiers {
+		namespaces = append(namespaces, models.NewResourceIdentifier("", models.WithNamespace(id.Namespace)))
+	}
+	return ports.NewResourceIdentifierScope(namespaces...)
+}
@@ -0,0 +1,261 @@
+// Package mysql implements ports.Registry on MySQL 8 and MariaDB 10.6 or newer. Resources
+// are stored per kind, the aggregated address groups of services and hosts of address
+// groups are computed when they are read. The schema is migrated by goose from
+// migrations/mysql, see SupportedVersion.
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/patterns"
+)
+
+// SupportedVersion is the last migration of the migrations/mysql directory the backend was
+// built against, the backend refuses to serve a database with another schema version
+const SupportedVersion int64 = 1
+
+// versionTable is the goose version table
+const versionTable = "goose_db_version"
+
+// Compile-time check that Registry implements ports.Registry
+var (
+	_ ports.Registry        = (*Registry)(nil)
+	_ ports.SchemaInspector = (*Registry)(nil)
+)
+
+// Registry implements ports.Registry on a MySQL or MariaDB database
+type Registry struct {
+	subject patterns.Subject
+	db      *sql.DB
+	// isolation is the isolation level of writers without an explicit one
+	isolation ports.IsolationLevel
+	// txTimeout bounds writer transactions without an explicit timeout, zero is unbounded
+	txTimeout time.Duration
+	mu        sync.RWMutex
+}
+
+// NewRegistry opens and pings the database of cfg.DSN. Times are read and written in UTC.
+func NewRegistry(ctx context.Context, cfg Config) (*Registry, error) {
+	dsn, err := mysql.ParseDSN(cfg.DSN)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to parse MySQL DSN")
+	}
+	dsn.ParseTime = true
+	dsn.Loc = time.UTC
+
+	connector, err := mysql.NewConnector(dsn)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create MySQL connector")
+	}
+	db := sql.OpenDB(connector)
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, errors.WithMessage(err, "failed to ping MySQL")
+	}
+
+	return &Registry{
+		subject:   &subject{},
+		db:        db,
+		isolation: ports.IsolationLevel(cfg.WriteIsolation),
+		txTimeout: cfg.TxTimeout,
+	}, nil
+}
+
+// Subject returns the registry's subject for observer pattern
+func (r *Registry) Subject() patterns.Subject {
+	return r.subject
+}
+
+func (r *Registry) database() (*sql.DB, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.db == nil {
+		return nil, errors.New("registry is closed")
+	}
+	return r.db, nil
+}
+
+// Writer creates a writer with the configured write isolation level
+func (r *Registry) Writer(ctx context.Context) (ports.Writer, error) {
+	return r.WriterWithOptions(ctx, ports.WriterOptions{})
+}
+
+// WriterWithOptions creates a writer with the isolation level and timeout of opts, zero
+// values use the write isolation and tx timeout of the mysql config section. Deadlocks are
+// returned as ports.ErrSerializationFailure, lock wait timeouts and an exceeded transaction
+// timeout as ports.ErrTransactionTimeout.
+func (r *Registry) WriterWithOptions(ctx context.Context, opts ports.WriterOptions) (ports.Writer, error) {
+	db, err := r.database()
+	if err != nil {
+		return nil, err
+	}
+
+	isolation := opts.Isolation
+	if isolation == ports.IsolationDefault {
+		isolation = r.isolation
+	}
+	level, err := txIsoLevel(isolation)
+	if err != nil {
+		return nil, err
+	}
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = r.txTimeout
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	// database/sql rolls the transaction back once ctx is done
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: level})
+	if err != nil {
+		return nil, transactionError(errors.WithMessage(err, "failed to begin transaction"))
+	}
+	return &writer{tx: tx, ctx: ctx, deadline: deadline}, nil
+}
+
+// Reader creates a reader of the database, every query sees the commits done before it
+func (r *Registry) Reader(ctx context.Context) (ports.Reader, error) {
+	db, err := r.database()
+	if err != nil {
+		return nil, err
+	}
+	return &reader{q: db}, nil
+}
+
+// PrimaryReader is Reader, the registry has no read replica nor read cache
+func (r *Registry) PrimaryReader(ctx context.Context) (ports.Reader, error) {
+	return r.Reader(ctx)
+}
+
+// ReaderFromWriter creates a reader that uses the same transaction as the writer
+func (r *Registry) ReaderFromWriter(ctx context.Context, w ports.Writer) (ports.Reader, error) {
+	mysqlWriter, ok := w.(*writer)
+	if !ok {
+		return nil, errors.Errorf("writer %T is not a MySQL writer", w)
+	}
+	return &reader{q: mysqlWriter.tx}, nil
+}
+
+// ReaderWithReadCommitted creates a reader of a ReadCommitted read-only transaction, its
+// queries see data committed by other transactions before each of them
+func (r *Registry) ReaderWithReadCommitted(ctx context.Context) (ports.Reader, error) {
+	return r.readOnlyReader(ctx, sql.LevelReadCommitted)
+}
+
+// ReaderAtSnapshot creates a reader of a RepeatableRead read-only transaction, its queries
+// see the snapshot taken at the first one
+func (r *Registry) ReaderAtSnapshot(ctx context.Context) (ports.Reader, error) {
+	return r.readOnlyReader(ctx, sql.LevelRepeatableRead)
+}
+
+// ReaderAtReplicaSnapshot is ReaderAtSnapshot, the registry has no read replica
+func (r *Registry) ReaderAtReplicaSnapshot(ctx context.Context) (ports.Reader, error) {
+	return r.ReaderAtSnapshot(ctx)
+}
+
+func (r *Registry) readOnlyReader(ctx context.Context, level sql.IsolationLevel) (ports.Reader, error) {
+	db, err := r.database()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: level, ReadOnly: true})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to begin read-only transaction for reader")
+	}
+	return &reader{q: tx, tx: tx}, nil
+}
+
+// Close closes the registry and its connections
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.db == nil {
+		return nil
+	}
+	err := r.db.Close()
+	r.db = nil
+	return err
+}
+
+// SchemaVersion returns the last migration applied by goose, 0 if no migration was applied
+func (r *Registry) SchemaVersion(ctx context.Context) (int64, error) {
+	db, err := r.database()
+	if err != nil {
+		return 0, err
+	}
+
+	var tables int
+	err = db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?",
+		versionTable).Scan(&tables)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to look up goose version table")
+	}
+	if tables == 0 {
+		return 0, nil
+	}
+
+	var version int64
+	if err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version_id), 0) FROM "+versionTable+" WHERE is_applied").Scan(&version); err != nil {
+		return 0, errors.Wrap(err, "failed to read schema version")
+	}
+	return version, nil
+}
+
+// SchemaStatus returns the applied schema version and the version the backend supports
+func (r *Registry) SchemaStatus(ctx context.Context) (models.SchemaStatus, error) {
+	version, err := r.SchemaVersion(ctx)
+	if err != nil {
+		return models.SchemaStatus{}, err
+	}
+	return models.SchemaStatus{Version: version, SupportedVersion: SupportedVersion}, nil
+}
+
+// subject implements the patterns.Subject interface with basic functionality
+type subject struct {
+	observers []interface{}
+	mu        sync.RWMutex
+}
+
+func (s *subject) Subscribe(observer interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.observers = append(s.observers, observer)
+	return nil
+}
+
+func (s *subject) Unsubscribe(observer interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, o := range s.observers {
+		if o == observer {
+			s.observers = append(s.observers[:i], s.observers[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("observer not found")
+}
+
+func (s *subject) Notify(event interface{}) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, o := range s.observers {
+		if handler, ok := o.(func(interface{})); ok {
+			handler(event)
+		}
+	}
+}
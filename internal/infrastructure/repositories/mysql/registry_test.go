@@ -0,0 +1,142 @@
+package mysql
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// testWriter opens a writer on the migrated database of TEST_MYSQL_DSN, it is aborted after
+// the test. The test is skipped when the variable is not set.
+func testWriter(t *testing.T) (context.Context, *Registry, ports.Writer) {
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("TEST_MYSQL_DSN not set, skipping MySQL tests")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	cfg := DefaultConfig()
+	cfg.DSN = dsn
+	registry, err := NewRegistry(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = registry.Close() })
+
+	status, err := registry.SchemaStatus(ctx)
+	require.NoError(t, err)
+	require.NoError(t, status.Err())
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	t.Cleanup(writer.Abort)
+	return ctx, registry, writer
+}
+
+func namespaceScope(namespace string) ports.Scope {
+	return ports.NewResourceIdentifierScope(models.NewResourceIdentifier("", models.WithNamespace(namespace)))
+}
+
+func TestRegistry_SyncScopes(t *testing.T) {
+	ctx, registry, writer := testWriter(t)
+	reader, err := registry.ReaderFromWriter(ctx, writer)
+	require.NoError(t, err)
+
+	network := func(name, cidr string) models.Network {
+		return models.Network{SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("mysql-test"))), CIDR: cidr}
+	}
+	require.NoError(t, writer.SyncNetworks(ctx, []models.Network{network("a", "10.0.0.0/24"), network("b", "10.0.1.0/24")}, namespaceScope("mysql-test")))
+
+	a, err := reader.GetNetworkByID(ctx, models.NewResourceIdentifier("a", models.WithNamespace("mysql-test")))
+	require.NoError(t, err)
+	assert.NotEmpty(t, a.Meta.UID)
+
+	// Upsert keeps the other networks and the UID of updated ones
+	updated := network("a", "10.0.0.0/24")
+	updated.Meta.Labels = map[string]string{"tier": "web"}
+	require.NoError(t, writer.SyncNetworks(ctx, []models.Network{updated}, ports.EmptyScope{}, ports.WithSyncOp(models.SyncOpUpsert)))
+	got, err := reader.GetNetworkByCIDR(ctx, "10.0.0.0/24")
+	require.NoError(t, err)
+	assert.Equal(t, a.Meta.UID, got.Meta.UID)
+	assert.Equal(t, "web", got.Meta.Labels["tier"])
+
+	// FullSync of the namespace removes the networks missing in it
+	require.NoError(t, writer.SyncNetworks(ctx, []models.Network{network("b", "10.0.1.0/24")}, namespaceScope("mysql-test")))
+	_, err = reader.GetNetworkByID(ctx, models.NewResourceIdentifier("a", models.WithNamespace("mysql-test")))
+	assert.ErrorIs(t, err, ports.ErrNotFound)
+
+	var names []string
+	require.NoError(t, reader.ListNetworks(ctx, func(n models.Network) error {
+		names = append(names, n.Name)
+		return nil
+	}, namespaceScope("mysql-test")))
+	assert.Equal(t, []string{"b"}, names)
+
+	require.NoError(t, writer.DeleteNetworksByIDs(ctx, []models.ResourceIdentifier{models.NewResourceIdentifier("b", models.WithNamespace("mysql-test"))}))
+	_, err = reader.GetNetworkByCIDR(ctx, "10.0.1.0/24")
+	assert.ErrorIs(t, err, ports.ErrNotFound)
+}
+
+func TestRegistry_AggregatesBindings(t *testing.T) {
+	ctx, registry, writer := testWriter(t)
+	reader, err := registry.ReaderFromWriter(ctx, writer)
+	require.NoError(t, err)
+
+	id := func(name string) models.ResourceIdentifier {
+		return models.NewResourceIdentifier(name, models.WithNamespace("mysql-test"))
+	}
+	ref := func(kind, name string) v1beta1.NamespacedObjectReference {
+		return v1beta1.NamespacedObjectReference{
+			ObjectReference: v1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: kind, Name: name},
+			Namespace:       "mysql-test",
+		}
+	}
+
+	require.NoError(t, writer.SyncHosts(ctx, []models.Host{
+		{SelfRef: models.NewSelfRef(id("spec-host")), UUID: "uuid-spec"},
+		{SelfRef: models.NewSelfRef(id("bound-host")), UUID: "uuid-bound"},
+	}, namespaceScope("mysql-test")))
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{
+		{
+			SelfRef:       models.NewSelfRef(id("web")),
+			DefaultAction: models.ActionAccept,
+			Hosts:         []v1beta1.ObjectReference{{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "Host", Name: "spec-host"}},
+		},
+		{SelfRef: models.NewSelfRef(id("db")), DefaultAction: models.ActionAccept},
+	}, namespaceScope("mysql-test")))
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{
+		{SelfRef: models.NewSelfRef(id("api")), AddressGroups: []models.AddressGroupRef{ref("AddressGroup", "web")}},
+	}, namespaceScope("mysql-test")))
+	require.NoError(t, writer.SyncAddressGroupBindings(ctx, []models.AddressGroupBinding{
+		{SelfRef: models.NewSelfRef(id("api-db")), ServiceRef: ref("Service", "api"), AddressGroupRef: ref("AddressGroup", "db")},
+	}, namespaceScope("mysql-test")))
+	require.NoError(t, writer.SyncHostBindings(ctx, []models.HostBinding{
+		{SelfRef: models.NewSelfRef(id("web-bound")), HostRef: ref("Host", "bound-host"), AddressGroupRef: ref("AddressGroup", "web")},
+	}, namespaceScope("mysql-test")))
+
+	service, err := reader.GetServiceByID(ctx, id("api"))
+	require.NoError(t, err)
+	require.Len(t, service.AggregatedAddressGroups, 2)
+	assert.Equal(t, "web", service.AggregatedAddressGroups[0].Ref.Name)
+	assert.Equal(t, models.AddressGroupSourceSpec, service.AggregatedAddressGroups[0].Source)
+	assert.Equal(t, "db", service.AggregatedAddressGroups[1].Ref.Name)
+	assert.Equal(t, models.AddressGroupSourceBinding, service.AggregatedAddressGroups[1].Source)
+
+	var web models.AddressGroup
+	require.NoError(t, reader.ListAddressGroups(ctx, func(group models.AddressGroup) error {
+		web = group
+		return nil
+	}, ports.NewResourceIdentifierScope(id("web"))))
+	require.Len(t, web.AggregatedHosts, 2)
+	assert.Equal(t, models.NewHostReference(web.Hosts[0], "uuid-spec", models.HostSourceSpec), web.AggregatedHosts[0])
+	assert.Equal(t, "uuid-bound", web.AggregatedHosts[1].UUID)
+	assert.Equal(t, models.HostSourceBinding, web.AggregatedHosts[1].Source)
+}
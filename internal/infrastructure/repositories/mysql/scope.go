@@ -0,0 +1,65 @@
+package mysql
+
+import (
+	"strings"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// scopeFilter builds a WHERE condition selecting the rows of scope by the namespace and name
+// columns, an empty condition selects all rows. Identifiers without a name select their whole
+// namespace, selector scopes are filtered by their inner scope.
+func scopeFilter(scope ports.Scope, namespaceColumn, nameColumn string) (string, []any) {
+	if scope == nil || scope.IsEmpty() {
+		return "", nil
+	}
+
+	switch s := scope.(type) {
+	case ports.SelectorScope:
+		return scopeFilter(s.Scope, namespaceColumn, nameColumn)
+	case ports.ResourceIdentifierScope:
+		var namespaces []any
+		var pairs []models.ResourceIdentifier
+		for _, id := range s.Identifiers {
+			if id.Name == "" {
+				namespaces = append(namespaces, id.Namespace)
+			} else {
+				pairs = append(pairs, id)
+			}
+		}
+
+		var conditions []string
+		var args []any
+		if len(pairs) > 0 {
+			condition, pairArgs := identifiersFilter(pairs, namespaceColumn, nameColumn)
+			conditions = append(conditions, condition)
+			args = append(args, pairArgs...)
+		}
+		if len(namespaces) > 0 {
+			conditions = append(conditions, namespaceColumn+" IN ("+placeholders(len(namespaces))+")")
+			args = append(args, namespaces...)
+		}
+		return "(" + strings.Join(conditions, " OR ") + ")", args
+	default:
+		return "", nil
+	}
+}
+
+// identifiersFilter builds a WHERE condition selecting the rows of ids by namespace and name,
+// InnoDB resolves the row constructor list with the primary key
+func identifiersFilter(ids []models.ResourceIdentifier, namespaceColumn, nameColumn string) (string, []any) {
+	args := make([]any, 0, 2*len(ids))
+	for _, id := range ids {
+		args = append(args, id.Namespace, id.Name)
+	}
+	tuples := strings.TrimSuffix(strings.Repeat("(?, ?), ", len(ids)), ", ")
+	return "(" + namespaceColumn + ", " + nameColumn + ") IN (" + tuples + ")", args
+}
+
+func scopeString(scope ports.Scope) string {
+	if scope == nil {
+		return "empty"
+	}
+	return scope.String()
+}
@@ -0,0 +1,58 @@
+package mysql
+
+import (
+	"testing"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+func TestScopeFilter_EmptyScopeSelectsAll(t *testing.T) {
+	for _, scope := range []ports.Scope{nil, ports.EmptyScope{}, ports.NewResourceIdentifierScope()} {
+		if filter, args := scopeFilter(scope, "namespace", "name"); filter != "" || args != nil {
+			t.Errorf("filter of %v = %q %v, want no filter", scope, filter, args)
+		}
+	}
+}
+
+func TestScopeFilter_NamespaceIdentifiers(t *testing.T) {
+	scope := ports.NewResourceIdentifierScope(
+		models.NewResourceIdentifier("web", models.WithNamespace("default")),
+		models.NewResourceIdentifier("db", models.WithNamespace("default")),
+		models.NewResourceIdentifier("", models.WithNamespace("prod")),
+	)
+
+	filter, args := scopeFilter(scope, "s.namespace", "s.name")
+
+	want := "((s.namespace, s.name) IN ((?, ?), (?, ?)) OR s.namespace IN (?))"
+	if filter != want {
+		t.Errorf("filter = %q, want %q", filter, want)
+	}
+	if len(args) != 5 || args[0] != "default" || args[1] != "web" || args[4] != "prod" {
+		t.Errorf("args = %v, want the identifier pairs and the prod namespace", args)
+	}
+}
+
+func TestScopeFilter_SelectorScopeFiltersInnerScope(t *testing.T) {
+	inner := ports.NewResourceIdentifierScope(models.NewResourceIdentifier("web", models.WithNamespace("default")))
+	scope := ports.NewSelectorScope(inner, "app=web", "")
+
+	filter, args := scopeFilter(scope, "namespace", "name")
+
+	if filter != "((namespace, name) IN ((?, ?)))" || len(args) != 2 {
+		t.Errorf("filter = %q %v, want the filter of the inner scope", filter, args)
+	}
+}
+
+func TestNamespacesOf(t *testing.T) {
+	scope := ports.NewResourceIdentifierScope(models.NewResourceIdentifier("web", models.WithNamespace("default")))
+
+	filter, args := scopeFilter(namespacesOf(scope), "namespace", "name")
+
+	if filter != "(namespace IN (?))" || len(args) != 1 || args[0] != "default" {
+		t.Errorf("filter = %q %v, want the namespace of the identifier", filter, args)
+	}
+	if !namespacesOf(ports.EmptyScope{}).IsEmpty() {
+		t.Error("namespaces of the empty scope must select all namespaces")
+	}
+}
@@ -0,0 +1,257 @@
+package mysql
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/gob"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
+)
+
+// identifiersBatch bounds the identifiers of a single statement, two placeholders each
+const identifiersBatch = 1000
+
+// querier runs statements on the database or in a transaction
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// table stores the resources of a kind. The resource is gob-encoded in the data column like
+// in the snapshots of the in-memory registry, columns are copies of its fields the registry
+// filters on.
+type table[T any] struct {
+	// name is the SQL table, kind the resource kind of the read statistics
+	name string
+	kind string
+	id   func(*T) models.ResourceIdentifier
+	meta func(*T) *models.Meta
+	// columns are stored next to data, values returns them in the same order
+	columns []string
+	values  func(*T) []any
+}
+
+// list passes the resources of scope to consume in key order while they are read
+func (t table[T]) list(ctx context.Context, q querier, scope ports.Scope, consume func(T) error) error {
+	stats := readstats.Begin(t.kind, scope)
+	defer stats.End()
+	consume = readstats.Returned(stats, consume)
+
+	query := "SELECT data FROM " + t.name
+	filter, args := scopeFilter(scope, "namespace", "name")
+	if filter != "" {
+		query += " WHERE " + filter
+	}
+	query += " ORDER BY namespace, name"
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list %s", t.name)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		stats.Scan()
+		item, err := t.scan(rows)
+		if err != nil {
+			return err
+		}
+		if err := consume(item); err != nil {
+			return err
+		}
+	}
+	return errors.Wrapf(rows.Err(), "failed to list %s", t.name)
+}
+
+// get returns the resource of id, ports.ErrNotFound if there is none
+func (t table[T]) get(ctx context.Context, q querier, id models.ResourceIdentifier) (*T, error) {
+	row := q.QueryRowContext(ctx, "SELECT data FROM "+t.name+" WHERE namespace = ? AND name = ?", id.Namespace, id.Name)
+	item, err := t.scan(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ports.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (t table[T]) scan(row interface{ Scan(dest ...any) error }) (T, error) {
+	var data []byte
+	if err := row.Scan(&data); err != nil {
+		var item T
+		return item, err
+	}
+	return t.decode(data)
+}
+
+// encode returns the data column of item
+func (t table[T]) encode(item *T) ([]byte, error) {
+	var data bytes.Buffer
+	if err := gob.NewEncoder(&data).Encode(item); err != nil {
+		return nil, errors.Wrapf(err, "failed to encode %s %s", t.kind, t.id(item).Key())
+	}
+	return data.Bytes(), nil
+}
+
+// decode returns the resource of a data column
+func (t table[T]) decode(data []byte) (T, error) {
+	var item T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&item); err != nil {
+		return item, errors.Wrapf(err, "failed to decode %s", t.kind)
+	}
+	return item, nil
+}
+
+// sync applies the sync operation of opts, FullSync by default. FullSync replaces the
+// resources of scope, all resources with an empty scope, Upsert keeps the other resources
+// and Delete removes the given ones. UID and creation time of existing resources are kept.
+func (t table[T]) sync(ctx context.Context, q querier, items []T, scope ports.Scope, opts ...ports.Option) error {
+	op := models.SyncOpFullSync
+	for _, opt := range opts {
+		if so, ok := opt.(ports.SyncOption); ok {
+			op = so.Operation
+		}
+	}
+
+	ids := make([]models.ResourceIdentifier, len(items))
+	for i := range items {
+		ids[i] = t.id(&items[i])
+	}
+	if op == models.SyncOpDelete {
+		return t.delete(ctx, q, ids)
+	}
+
+	existing, err := t.metas(ctx, q, ids)
+	if err != nil {
+		return err
+	}
+	if op == models.SyncOpFullSync {
+		query := "DELETE FROM " + t.name
+		filter, args := scopeFilter(scope, "namespace", "name")
+		if filter != "" {
+			query += " WHERE " + filter
+		}
+		if _, err := q.ExecContext(ctx, query, args...); err != nil {
+			return errors.Wrapf(err, "failed to delete %s in scope %s", t.name, scopeString(scope))
+		}
+	}
+
+	columns := append([]string{"namespace", "name", "uid", "created_at", "data"}, t.columns...)
+	updates := make([]string, 0, len(columns)-3)
+	for _, column := range columns[4:] {
+		updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", column, column))
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON DUPLICATE KEY UPDATE %s",
+		t.name, strings.Join(columns, ", "), placeholders(len(columns)), strings.Join(updates, ", "))
+
+	for i := range items {
+		item := &items[i]
+		id := ids[i]
+		meta := t.meta(item)
+		if prev, ok := existing[id.Key()]; ok {
+			if meta.CreationTS.IsZero() {
+				meta.CreationTS = metav1.NewTime(prev.CreationTS)
+			}
+			if meta.UID == "" {
+				meta.UID = prev.UID
+			}
+		}
+		ensureMetaFill(meta)
+
+		data, err := t.encode(item)
+		if err != nil {
+			return err
+		}
+		args := append([]any{id.Namespace, id.Name, meta.UID, meta.CreationTS.Time.UTC(), data}, t.extraValues(item)...)
+		if _, err := q.ExecContext(ctx, query, args...); err != nil {
+			return errors.Wrapf(err, "failed to upsert %s %s", t.kind, id.Key())
+		}
+	}
+	return nil
+}
+
+func (t table[T]) extraValues(item *T) []any {
+	if t.values == nil {
+		return nil
+	}
+	return t.values(item)
+}
+
+// storedMeta is the part of Meta kept across full syncs
+type storedMeta struct {
+	UID        string
+	CreationTS time.Time
+}
+
+// metas returns the stored metadata of the existing resources of ids by key
+func (t table[T]) metas(ctx context.Context, q querier, ids []models.ResourceIdentifier) (map[string]storedMeta, error) {
+	metas := make(map[string]storedMeta, len(ids))
+	for len(ids) > 0 {
+		batch := ids[:min(len(ids), identifiersBatch)]
+		ids = ids[len(batch):]
+
+		filter, args := identifiersFilter(batch, "namespace", "name")
+		rows, err := q.QueryContext(ctx, "SELECT namespace, name, uid, created_at FROM "+t.name+" WHERE "+filter, args...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s metadata", t.name)
+		}
+		for rows.Next() {
+			var id models.ResourceIdentifier
+			var meta storedMeta
+			if err := rows.Scan(&id.Namespace, &id.Name, &meta.UID, &meta.CreationTS); err != nil {
+				rows.Close()
+				return nil, errors.Wrapf(err, "failed to scan %s metadata", t.name)
+			}
+			metas[id.Key()] = meta
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s metadata", t.name)
+		}
+	}
+	return metas, nil
+}
+
+// delete removes the resources of ids, unknown ids are ignored
+func (t table[T]) delete(ctx context.Context, q querier, ids []models.ResourceIdentifier) error {
+	for len(ids) > 0 {
+		batch := ids[:min(len(ids), identifiersBatch)]
+		ids = ids[len(batch):]
+
+		filter, args := identifiersFilter(batch, "namespace", "name")
+		if _, err := q.ExecContext(ctx, "DELETE FROM "+t.name+" WHERE "+filter, args...); err != nil {
+			return errors.Wrapf(err, "failed to delete %s", t.name)
+		}
+	}
+	return nil
+}
+
+// ensureMetaFill guarantees that Meta has UID, CreationTS and Generation like the in-memory
+// registry, every write gets a new ResourceVersion
+func ensureMetaFill(m *models.Meta) {
+	if m.UID == "" {
+		m.TouchOnCreate()
+	}
+	if m.CreationTS.IsZero() {
+		m.CreationTS = metav1.Now()
+	}
+	m.ResourceVersion = fmt.Sprintf("%d", time.Now().UnixNano())
+	if m.Generation == 0 {
+		m.Generation = 1
+	}
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/migrate"
+)
+
+func TestSupportedVersion_IsLastMigration(t *testing.T) {
+	migrations, err := migrate.LoadMigrations("../../../../migrations/mysql")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	assert.Equal(t, migrations[len(migrations)-1].Version, SupportedVersion,
+		"SupportedVersion must be bumped together with a new MySQL migration")
+}
+
+func TestTable_EncodeDecodeRoundtrip(t *testing.T) {
+	service := models.NewServiceRef("web", models.WithNamespace("default"))
+	mapping := models.AddressGroupPortMapping{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier("ag", models.WithNamespace("default"))),
+		AccessPorts: map[models.ServiceRef]models.ServicePorts{
+			service: {Ports: models.ProtocolPorts{models.TCP: {{Start: 80, End: 80}}}},
+		},
+		Meta: models.Meta{
+			UID:        "uid-1",
+			Labels:     map[string]string{"app": "web"},
+			Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Synced"}},
+		},
+	}
+
+	data, err := addressGroupPortMappings.encode(&mapping)
+	require.NoError(t, err)
+	decoded, err := addressGroupPortMappings.decode(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, mapping.ResourceIdentifier, decoded.ResourceIdentifier)
+	assert.Equal(t, mapping.AccessPorts, decoded.AccessPorts)
+	assert.Equal(t, "web", decoded.Meta.Labels["app"])
+	require.Len(t, decoded.Meta.Conditions, 1)
+	assert.Equal(t, "Synced", decoded.Meta.Conditions[0].Reason)
+}
+
+func TestAggregateAddressGroups(t *testing.T) {
+	service := models.Service{
+		SelfRef:       models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("default"))),
+		AddressGroups: []models.AddressGroupRef{models.NewAddressGroupRef("spec", models.WithNamespace("default"))},
+	}
+	bound := map[string][]models.AddressGroupRef{
+		"default/web": {models.NewAddressGroupRef("bound", models.WithNamespace("shared"))},
+	}
+
+	aggregateAddressGroups(&service, bound)
+
+	require.Len(t, service.AggregatedAddressGroups, 2)
+	assert.Equal(t, "spec", service.AggregatedAddressGroups[0].Ref.Name)
+	assert.Equal(t, models.AddressGroupSourceSpec, service.AggregatedAddressGroups[0].Source)
+	assert.Equal(t, "shared", service.AggregatedAddressGroups[1].Ref.Namespace)
+	assert.Equal(t, models.AddressGroupSourceBinding, service.AggregatedAddressGroups[1].Source)
+}
+
+func TestTransactionError(t *testing.T) {
+	assert.ErrorIs(t, transactionError(&mysql.MySQLError{Number: 1213}), ports.ErrSerializationFailure)
+	assert.ErrorIs(t, transactionError(&mysql.MySQLError{Number: 1205}), ports.ErrTransactionTimeout)
+	assert.False(t, ports.IsRetryable(transactionError(&mysql.MySQLError{Number: 1062})))
+}
@@ -0,0 +1,117 @@
+package mysql
+
+import (
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// Tables of the resource kinds, see migrations/mysql
+var (
+	services = table[models.Service]{
+		name: "services", kind: "Service",
+		id:   func(s *models.Service) models.ResourceIdentifier { return s.ResourceIdentifier },
+		meta: func(s *models.Service) *models.Meta { return &s.Meta },
+	}
+	addressGroups = table[models.AddressGroup]{
+		name: "address_groups", kind: "AddressGroup",
+		id:   func(ag *models.AddressGroup) models.ResourceIdentifier { return ag.ResourceIdentifier },
+		meta: func(ag *models.AddressGroup) *models.Meta { return &ag.Meta },
+	}
+	addressGroupBindings = table[models.AddressGroupBinding]{
+		name: "address_group_bindings", kind: "AddressGroupBinding",
+		id:      func(b *models.AddressGroupBinding) models.ResourceIdentifier { return b.ResourceIdentifier },
+		meta:    func(b *models.AddressGroupBinding) *models.Meta { return &b.Meta },
+		columns: []string{"service_namespace", "service_name", "address_group_namespace", "address_group_name"},
+		values: func(b *models.AddressGroupBinding) []any {
+			return []any{
+				refNamespace(b.ServiceRef, b.Namespace), b.ServiceRef.Name,
+				refNamespace(b.AddressGroupRef, b.Namespace), b.AddressGroupRef.Name,
+			}
+		},
+	}
+	addressGroupPortMappings = table[models.AddressGroupPortMapping]{
+		name: "address_group_port_mappings", kind: "AddressGroupPortMapping",
+		id:   func(m *models.AddressGroupPortMapping) models.ResourceIdentifier { return m.ResourceIdentifier },
+		meta: func(m *models.AddressGroupPortMapping) *models.Meta { return &m.Meta },
+	}
+	ruleS2S = table[models.RuleS2S]{
+		name: "rule_s2s", kind: "RuleS2S",
+		id:   func(r *models.RuleS2S) models.ResourceIdentifier { return r.ResourceIdentifier },
+		meta: func(r *models.RuleS2S) *models.Meta { return &r.Meta },
+	}
+	serviceAliases = table[models.ServiceAlias]{
+		name: "service_aliases", kind: "ServiceAlias",
+		id:   func(a *models.ServiceAlias) models.ResourceIdentifier { return a.ResourceIdentifier },
+		meta: func(a *models.ServiceAlias) *models.Meta { return &a.Meta },
+	}
+	addressGroupBindingPolicies = table[models.AddressGroupBindingPolicy]{
+		name: "address_group_binding_policies", kind: "AddressGroupBindingPolicy",
+		id:   func(p *models.AddressGroupBindingPolicy) models.ResourceIdentifier { return p.ResourceIdentifier },
+		meta: func(p *models.AddressGroupBindingPolicy) *models.Meta { return &p.Meta },
+	}
+	ieAgAgRules = table[models.IEAgAgRule]{
+		name: "ie_ag_ag_rules", kind: "IEAgAgRule",
+		id:   func(r *models.IEAgAgRule) models.ResourceIdentifier { return r.ResourceIdentifier },
+		meta: func(r *models.IEAgAgRule) *models.Meta { return &r.Meta },
+	}
+	networks = table[models.Network]{
+		name: "networks", kind: "Network",
+		id:      func(n *models.Network) models.ResourceIdentifier { return n.ResourceIdentifier },
+		meta:    func(n *models.Network) *models.Meta { return &n.Meta },
+		columns: []string{"cidr"},
+		values:  func(n *models.Network) []any { return []any{n.CIDR} },
+	}
+	networkBindings = table[models.NetworkBinding]{
+		name: "network_bindings", kind: "NetworkBinding",
+		id:   func(b *models.NetworkBinding) models.ResourceIdentifier { return b.ResourceIdentifier },
+		meta: func(b *models.NetworkBinding) *models.Meta { return &b.Meta },
+	}
+	hosts = table[models.Host]{
+		name: "hosts", kind: "Host",
+		id:      func(h *models.Host) models.ResourceIdentifier { return h.ResourceIdentifier },
+		meta:    func(h *models.Host) *models.Meta { return &h.Meta },
+		columns: []string{"uuid"},
+		values:  func(h *models.Host) []any { return []any{h.UUID} },
+	}
+	hostBindings = table[models.HostBinding]{
+		name: "host_bindings", kind: "HostBinding",
+		id:      func(b *models.HostBinding) models.ResourceIdentifier { return b.ResourceIdentifier },
+		meta:    func(b *models.HostBinding) *models.Meta { return &b.Meta },
+		columns: []string{"host_namespace", "host_name", "address_group_namespace", "address_group_name"},
+		values: func(b *models.HostBinding) []any {
+			return []any{
+				refNamespace(b.HostRef, b.Namespace), b.HostRef.Name,
+				refNamespace(b.AddressGroupRef, b.Namespace), b.AddressGroupRef.Name,
+			}
+		},
+	}
+	ruleS2SExceptions = table[models.RuleS2SException]{
+		name: "rule_s2s_exceptions", kind: "RuleS2SException",
+		id:   func(e *models.RuleS2SException) models.ResourceIdentifier { return e.ResourceIdentifier },
+		meta: func(e *models.RuleS2SException) *models.Meta { return &e.Meta },
+	}
+	crossNamespacePolicies = table[models.CrossNamespacePolicy]{
+		name: "cross_namespace_policies", kind: "CrossNamespacePolicy",
+		id:   func(p *models.CrossNamespacePolicy) models.ResourceIdentifier { return p.ResourceIdentifier },
+		meta: func(p *models.CrossNamespacePolicy) *models.Meta { return &p.Meta },
+	}
+	ruleTemplates = table[models.RuleTemplate]{
+		name: "rule_templates", kind: "RuleTemplate",
+		id:   func(t *models.RuleTemplate) models.ResourceIdentifier { return t.ResourceIdentifier },
+		meta: func(t *models.RuleTemplate) *models.Meta { return &t.Meta },
+	}
+	namespacePostures = table[models.NamespacePosture]{
+		name: "namespace_postures", kind: "NamespacePosture",
+		id:   func(p *models.NamespacePosture) models.ResourceIdentifier { return p.ResourceIdentifier },
+		meta: func(p *models.NamespacePosture) *models.Meta { return &p.Meta },
+	}
+)
+
+// refNamespace returns the namespace of ref, references without one point into the
+// namespace of the referencing resource
+func refNamespace(ref v1beta1.NamespacedObjectReference, namespace string) string {
+	if ref.Namespace == "" {
+		return namespace
+	}
+	return ref.Namespace
+}
@@ -0,0 +1,230 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// MySQL error numbers of retryable transaction failures
+const (
+	errLockDeadlock    = 1213 // ER_LOCK_DEADLOCK
+	errLockWaitTimeout = 1205 // ER_LOCK_WAIT_TIMEOUT
+)
+
+// transactionError marks failures of concurrent transactions: deadlocks, which InnoDB also
+// reports for serializable conflicts, with ports.ErrSerializationFailure, lock wait timeouts
+// and an exceeded transaction deadline with ports.ErrTransactionTimeout
+func transactionError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case errLockDeadlock:
+			return fmt.Errorf("%w: %w", ports.ErrSerializationFailure, err)
+		case errLockWaitTimeout:
+			return fmt.Errorf("%w: %w", ports.ErrTransactionTimeout, err)
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %w", ports.ErrTransactionTimeout, err)
+	}
+	return err
+}
+
+// writer writes in a transaction, the changes become visible on Commit
+type writer struct {
+	tx  *sql.Tx
+	ctx context.Context
+	// deadline bounds the statements and the commit of the transaction, zero is unbounded
+	deadline time.Time
+	// changed is set by the first write, commits of writers without changes leave the
+	// sync status alone
+	changed bool
+}
+
+// run executes op in the transaction before its deadline, deadlocks and timeouts are
+// returned as retryable ports errors
+func (w *writer) run(ctx context.Context, op func(ctx context.Context, q querier) error) error {
+	w.changed = true
+	if !w.deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, w.deadline)
+		defer cancel()
+	}
+	return transactionError(op(ctx, w.tx))
+}
+
+// Commit records the time of the change in the sync status and commits the transaction
+func (w *writer) Commit() error {
+	if w.changed {
+		err := w.run(w.ctx, func(ctx context.Context, q querier) error {
+			_, err := q.ExecContext(ctx,
+				"INSERT INTO sync_status (id, updated_at) VALUES (1, ?) ON DUPLICATE KEY UPDATE updated_at = VALUES(updated_at)",
+				time.Now().UTC())
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if !w.deadline.IsZero() && time.Now().After(w.deadline) {
+		_ = w.tx.Rollback()
+		return fmt.Errorf("%w: %w", ports.ErrTransactionTimeout, context.DeadlineExceeded)
+	}
+	return transactionError(w.tx.Commit())
+}
+
+// Abort rolls the transaction back
+func (w *writer) Abort() {
+	_ = w.tx.Rollback()
+}
+
+func (w *writer) SyncServices(ctx context.Context, items []models.Service, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return services.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteServicesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return services.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncAddressGroups(ctx context.Context, items []models.AddressGroup, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return addressGroups.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteAddressGroupsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return addressGroups.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncAddressGroupBindings(ctx context.Context, items []models.AddressGroupBinding, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error {
+		return addressGroupBindings.sync(ctx, q, items, scope, opts...)
+	})
+}
+
+func (w *writer) DeleteAddressGroupBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return addressGroupBindings.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncAddressGroupPortMappings(ctx context.Context, items []models.AddressGroupPortMapping, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error {
+		return addressGroupPortMappings.sync(ctx, q, items, scope, opts...)
+	})
+}
+
+func (w *writer) DeleteAddressGroupPortMappingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return addressGroupPortMappings.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncRuleS2S(ctx context.Context, items []models.RuleS2S, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return ruleS2S.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteRuleS2SByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return ruleS2S.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncServiceAliases(ctx context.Context, items []models.ServiceAlias, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return serviceAliases.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteServiceAliasesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return serviceAliases.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncAddressGroupBindingPolicies(ctx context.Context, items []models.AddressGroupBindingPolicy, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error {
+		return addressGroupBindingPolicies.sync(ctx, q, items, scope, opts...)
+	})
+}
+
+func (w *writer) DeleteAddressGroupBindingPoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return addressGroupBindingPolicies.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncIEAgAgRules(ctx context.Context, items []models.IEAgAgRule, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return ieAgAgRules.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteIEAgAgRulesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return ieAgAgRules.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncNetworks(ctx context.Context, items []models.Network, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return networks.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteNetworksByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return networks.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncNetworkBindings(ctx context.Context, items []models.NetworkBinding, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return networkBindings.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteNetworkBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return networkBindings.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncHosts(ctx context.Context, items []models.Host, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return hosts.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return hosts.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncHostBindings(ctx context.Context, items []models.HostBinding, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return hostBindings.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteHostBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return hostBindings.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncRuleS2SExceptions(ctx context.Context, items []models.RuleS2SException, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error {
+		return ruleS2SExceptions.sync(ctx, q, items, scope, opts...)
+	})
+}
+
+func (w *writer) DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return ruleS2SExceptions.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncCrossNamespacePolicies(ctx context.Context, items []models.CrossNamespacePolicy, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error {
+		return crossNamespacePolicies.sync(ctx, q, items, scope, opts...)
+	})
+}
+
+func (w *writer) DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return crossNamespacePolicies.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncRuleTemplates(ctx context.Context, items []models.RuleTemplate, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return ruleTemplates.sync(ctx, q, items, scope, opts...) })
+}
+
+func (w *writer) DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return ruleTemplates.delete(ctx, q, ids) })
+}
+
+func (w *writer) SyncNamespacePostures(ctx context.Context, items []models.NamespacePosture, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error {
+		return namespacePostures.sync(ctx, q, items, scope, opts...)
+	})
+}
+
+func (w *writer) DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context, q querier) error { return namespacePostures.delete(ctx, q, ids) })
+}
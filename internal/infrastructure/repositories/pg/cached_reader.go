@@ -0,0 +1,63 @@
+package pg
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/readers"
+	"netguard-pg-backend/internal/infrastructure/repositories/readcache"
+)
+
+// cachedReader serves the hot lookups of aggregation from the registry read cache,
+// all other reads go to the embedded reader
+type cachedReader struct {
+	*readers.Reader
+	cache *readcache.Cache
+}
+
+// GetServiceByID returns the service from the read cache or PostgreSQL
+func (r *cachedReader) GetServiceByID(ctx context.Context, id models.ResourceIdentifier) (*models.Service, error) {
+	return readcache.Lookup(r.cache, "Service", id, cloneService, func() (*models.Service, error) {
+		return r.Reader.GetServiceByID(ctx, id)
+	})
+}
+
+// GetAddressGroupByID returns the address group from the read cache or PostgreSQL
+func (r *cachedReader) GetAddressGroupByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
+	return readcache.Lookup(r.cache, "AddressGroup", id, cloneAddressGroup, func() (*models.AddressGroup, error) {
+		return r.Reader.GetAddressGroupByID(ctx, id)
+	})
+}
+
+func cloneService(service *models.Service) *models.Service {
+	clone := *service
+	clone.IngressPorts = slices.Clone(service.IngressPorts)
+	clone.AddressGroups = slices.Clone(service.AddressGroups)
+	clone.AggregatedAddressGroups = slices.Clone(service.AggregatedAddressGroups)
+	clone.Meta = cloneMeta(service.Meta)
+	return &clone
+}
+
+func cloneAddressGroup(group *models.AddressGroup) *models.AddressGroup {
+	clone := group.DeepCopy().(*models.AddressGroup)
+	clone.IncludedGroups = slices.Clone(group.IncludedGroups)
+	clone.Meta = cloneMeta(group.Meta)
+	return clone
+}
+
+// cloneMeta copies the maps and slices of meta, callers set conditions of the returned resources
+func cloneMeta(meta models.Meta) models.Meta {
+	meta.Labels = maps.Clone(meta.Labels)
+	meta.Annotations = maps.Clone(meta.Annotations)
+	meta.ManagedFields = slices.Clone(meta.ManagedFields)
+	meta.Finalizers = slices.Clone(meta.Finalizers)
+	meta.OwnerReferences = slices.Clone(meta.OwnerReferences)
+	meta.Conditions = slices.Clone(meta.Conditions)
+	if meta.DeletionTimestamp != nil {
+		deletionTimestamp := *meta.DeletionTimestamp
+		meta.DeletionTimestamp = &deletionTimestamp
+	}
+	return meta
+}
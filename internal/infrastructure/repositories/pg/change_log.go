@@ -0,0 +1,191 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// ChangeLog is a PostgreSQL implementation of ports.ChangeLog (tables change_log, change_log_state)
+type ChangeLog struct {
+	pool *pgxpool.Pool
+}
+
+var _ ports.SharedChangeLog = &ChangeLog{}
+
+// changeLogLockKey is the advisory lock serializing revision allocation of AppendNext
+const changeLogLockKey = "netguard-change-log"
+
+// NewChangeLog creates a change log on top of the connection pool
+func NewChangeLog(pool *pgxpool.Pool) *ChangeLog {
+	return &ChangeLog{pool: pool}
+}
+
+// ChangeLog returns the change log stored in the registry database
+func (r *Registry) ChangeLog() *ChangeLog {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return NewChangeLog(r.pool)
+}
+
+// Append stores an event
+func (l *ChangeLog) Append(ctx context.Context, event models.ChangeEvent) error {
+	payload, err := json.Marshal(event.Resource)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal change event resource")
+	}
+
+	id := event.ResourceID()
+	_, err = l.pool.Exec(ctx, `
+		INSERT INTO change_log (revision, kind, sync_op, namespace, name, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		int64(event.Revision), event.Kind(), int(event.SyncOp), id.Namespace, id.Name, payload, event.Timestamp)
+	if err != nil {
+		return errors.Wrapf(err, "failed to append change event %d", event.Revision)
+	}
+	return nil
+}
+
+// AppendNext stores an event under the next revision. Revisions are allocated under a transaction
+// advisory lock, so an event becomes visible only after all events with lower revisions.
+func (l *ChangeLog) AppendNext(ctx context.Context, event models.ChangeEvent) (uint64, error) {
+	payload, err := json.Marshal(event.Resource)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to marshal change event resource")
+	}
+
+	tx, err := l.pool.Begin(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to begin change log transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, changeLogLockKey); err != nil {
+		return 0, errors.Wrap(err, "failed to lock change log")
+	}
+
+	id := event.ResourceID()
+	var revision int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO change_log (revision, kind, sync_op, namespace, name, payload, created_at)
+		SELECT GREATEST(
+			COALESCE((SELECT MAX(revision) FROM change_log), 0),
+			COALESCE((SELECT compacted_revision FROM change_log_state WHERE id), 0)) + 1,
+			$1, $2, $3, $4, $5, $6
+		RETURNING revision`,
+		event.Kind(), int(event.SyncOp), id.Namespace, id.Name, payload, event.Timestamp).Scan(&revision)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to append change event")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, errors.Wrapf(err, "failed to commit change event %d", revision)
+	}
+	return uint64(revision), nil
+}
+
+// Since returns events with revision greater than the given one
+func (l *ChangeLog) Since(ctx context.Context, revision uint64) ([]models.ChangeEvent, error) {
+	var events []models.ChangeEvent
+	err := l.Stream(ctx, revision, func(event models.ChangeEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Stream passes events with revision greater than the given one to consume as the rows
+// arrive. The connection is held until the stream ends, rows not consumed yet stay in the
+// socket buffers, so a slow consumer throttles PostgreSQL instead of filling the memory.
+func (l *ChangeLog) Stream(ctx context.Context, revision uint64, consume func(models.ChangeEvent) error) error {
+	compacted, err := l.compactedRevision(ctx)
+	if err != nil {
+		return err
+	}
+	if revision < compacted {
+		return ports.ErrRevisionCompacted
+	}
+
+	rows, err := l.pool.Query(ctx, `
+		SELECT revision, kind, sync_op, payload, created_at
+		FROM change_log
+		WHERE revision > $1
+		ORDER BY revision`, int64(revision))
+	if err != nil {
+		return errors.Wrap(err, "failed to query change log")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rev     int64
+			kind    string
+			syncOp  int
+			payload []byte
+			event   models.ChangeEvent
+		)
+		if err := rows.Scan(&rev, &kind, &syncOp, &payload, &event.Timestamp); err != nil {
+			return errors.Wrap(err, "failed to scan change log row")
+		}
+		resource, err := models.DecodeChangeResource(kind, payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode change event %d", rev)
+		}
+		event.Revision = uint64(rev)
+		event.SyncOp = models.SyncOp(syncOp)
+		event.Resource = resource
+		if err := consume(event); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(rows.Err(), "failed to read change log")
+}
+
+// LastRevision returns the highest revision ever stored
+func (l *ChangeLog) LastRevision(ctx context.Context) (uint64, error) {
+	var last int64
+	err := l.pool.QueryRow(ctx, `
+		SELECT GREATEST(
+			COALESCE((SELECT MAX(revision) FROM change_log), 0),
+			COALESCE((SELECT compacted_revision FROM change_log_state WHERE id), 0))`).Scan(&last)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get last change log revision")
+	}
+	return uint64(last), nil
+}
+
+// Compact removes events published before the given time and remembers the
+// highest removed revision, so watchers resuming from older revisions relist
+func (l *ChangeLog) Compact(ctx context.Context, before time.Time) error {
+	_, err := l.pool.Exec(ctx, `
+		WITH removed AS (
+			DELETE FROM change_log WHERE created_at < $1 RETURNING revision
+		)
+		INSERT INTO change_log_state (id, compacted_revision)
+		SELECT TRUE, MAX(revision) FROM removed HAVING COUNT(*) > 0
+		ON CONFLICT (id) DO UPDATE
+		SET compacted_revision = GREATEST(change_log_state.compacted_revision, EXCLUDED.compacted_revision)`,
+		before)
+	if err != nil {
+		return errors.Wrap(err, "failed to compact change log")
+	}
+	return nil
+}
+
+func (l *ChangeLog) compactedRevision(ctx context.Context) (uint64, error) {
+	var compacted int64
+	err := l.pool.QueryRow(ctx, `
+		SELECT COALESCE((SELECT compacted_revision FROM change_log_state WHERE id), 0)`).Scan(&compacted)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get compacted change log revision")
+	}
+	return uint64(compacted), nil
+}
@@ -0,0 +1,139 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// conditionHistoryLockKey is the advisory lock making IDs of recorded transitions follow commit order
+const conditionHistoryLockKey = "netguard-condition-history"
+
+// ConditionHistory is a PostgreSQL implementation of ports.ConditionHistory (table condition_transitions)
+type ConditionHistory struct {
+	pool *pgxpool.Pool
+}
+
+var _ ports.ConditionHistory = &ConditionHistory{}
+
+// NewConditionHistory creates a condition history on top of the connection pool
+func NewConditionHistory(pool *pgxpool.Pool) *ConditionHistory {
+	return &ConditionHistory{pool: pool}
+}
+
+// ConditionHistory returns the condition history stored in the registry database
+func (r *Registry) ConditionHistory() *ConditionHistory {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return NewConditionHistory(r.pool)
+}
+
+// Record stores transitions. IDs are allocated under a transaction advisory lock, so readers
+// following the history by ID never skip a transition committed later with a lower ID.
+func (h *ConditionHistory) Record(ctx context.Context, transitions []models.ConditionTransition) error {
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	tx, err := h.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin condition history transaction")
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, conditionHistoryLockKey); err != nil {
+		return errors.Wrap(err, "failed to lock condition history")
+	}
+
+	batch := &pgx.Batch{}
+	for _, transition := range transitions {
+		batch.Queue(`
+			INSERT INTO condition_transitions (kind, namespace, name, condition_type, from_status, to_status, reason, message, transitioned_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			transition.Kind, transition.Namespace, transition.Name, transition.Type,
+			string(transition.From), string(transition.To), transition.Reason, transition.Message, transition.Time)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return errors.Wrap(err, "failed to record condition transitions")
+	}
+	return errors.Wrap(tx.Commit(ctx), "failed to commit condition transitions")
+}
+
+// List returns the matching transitions in ID order
+func (h *ConditionHistory) List(ctx context.Context, query ports.ConditionTransitionQuery) ([]models.ConditionTransition, error) {
+	var namespace, name *string
+	if query.Resource != nil {
+		namespace, name = &query.Resource.Namespace, &query.Resource.Name
+	}
+	var limit *int
+	if query.Limit > 0 {
+		limit = &query.Limit
+	}
+
+	// The table is partitioned by namespace, a plain namespace condition lets the history
+	// of a resource scan its partition only
+	resourceFilter := `AND ($3::text IS NULL OR namespace = $3) AND ($4::text IS NULL OR name = $4)`
+	if query.Resource != nil {
+		resourceFilter = `AND namespace = $3 AND name = $4`
+	}
+	rows, err := h.pool.Query(ctx, `
+		SELECT id, kind, namespace, name, condition_type, from_status, to_status, reason, message, transitioned_at
+		FROM condition_transitions
+		WHERE id > $1
+		  AND ($2::text IS NULL OR kind = $2)
+		  `+resourceFilter+`
+		ORDER BY id
+		LIMIT $5`,
+		query.AfterID, nullableString(query.Kind), namespace, name, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query condition transitions")
+	}
+	defer rows.Close()
+
+	var transitions []models.ConditionTransition
+	for rows.Next() {
+		var (
+			transition models.ConditionTransition
+			from, to   string
+		)
+		if err := rows.Scan(&transition.ID, &transition.Kind, &transition.Namespace, &transition.Name, &transition.Type,
+			&from, &to, &transition.Reason, &transition.Message, &transition.Time); err != nil {
+			return nil, errors.Wrap(err, "failed to scan condition transition")
+		}
+		transition.From, transition.To = metav1.ConditionStatus(from), metav1.ConditionStatus(to)
+		transitions = append(transitions, transition)
+	}
+	return transitions, errors.Wrap(rows.Err(), "failed to read condition transitions")
+}
+
+// LastID returns the ID of the last recorded transition
+func (h *ConditionHistory) LastID(ctx context.Context) (int64, error) {
+	var last int64
+	if err := h.pool.QueryRow(ctx, `SELECT COALESCE(MAX(id), 0) FROM condition_transitions`).Scan(&last); err != nil {
+		return 0, errors.Wrap(err, "failed to get last condition transition")
+	}
+	return last, nil
+}
+
+// Compact removes transitions recorded before the given time
+func (h *ConditionHistory) Compact(ctx context.Context, before time.Time) error {
+	if _, err := h.pool.Exec(ctx, `DELETE FROM condition_transitions WHERE recorded_at < $1`, before); err != nil {
+		return errors.Wrap(err, "failed to compact condition history")
+	}
+	return nil
+}
+
+// nullableString maps an empty filter to NULL
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
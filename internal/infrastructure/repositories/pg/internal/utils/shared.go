@@ -14,7 +14,9 @@ import (
 )
 
 // BuildScopeFilter builds WHERE clause and arguments for scope filtering
-// This is used across all resource readers for consistent scoping
+// This is used across all resource readers for consistent scoping.
+// Identifiers are passed as arrays so the clause text does not depend on their number:
+// a list query keeps one prepared statement in the statement cache for any scope.
 func BuildScopeFilter(scope ports.Scope, tableAlias string) (string, []interface{}) {
 	if scope == nil || scope.IsEmpty() {
 		return "", nil
@@ -26,28 +28,29 @@ func BuildScopeFilter(scope ports.Scope, tableAlias string) (string, []interface
 			return "", nil
 		}
 
-		// Build IN clauses for namespace and name pairs
-		var conditions []string
-		var args []interface{}
-		argIndex := 1
-
+		// Identifiers without name select their whole namespace
+		var namespaces []string
+		var pairs []models.ResourceIdentifier
 		for _, id := range s.Identifiers {
 			if id.Name == "" {
-				condition := fmt.Sprintf("(%s.namespace = $%d)",
-					tableAlias, argIndex)
-				conditions = append(conditions, condition)
-				args = append(args, id.Namespace)
-				argIndex += 1
+				namespaces = append(namespaces, id.Namespace)
 			} else {
-				// Handle specific resource filtering (namespace + name)
-				condition := fmt.Sprintf("(%s.namespace = $%d AND %s.name = $%d)",
-					tableAlias, argIndex, tableAlias, argIndex+1)
-				conditions = append(conditions, condition)
-				args = append(args, id.Namespace, id.Name)
-				argIndex += 2
+				pairs = append(pairs, id)
 			}
 		}
 
+		var conditions []string
+		var args []interface{}
+		if len(pairs) > 0 {
+			condition, pairArgs := BuildIdentifiersFilter(pairs, tableAlias, 1)
+			conditions = append(conditions, condition)
+			args = append(args, pairArgs...)
+		}
+		if len(namespaces) > 0 {
+			conditions = append(conditions, fmt.Sprintf("%s.namespace = ANY($%d::text[])", tableAlias, len(args)+1))
+			args = append(args, namespaces)
+		}
+
 		return "(" + strings.Join(conditions, " OR ") + ")", args
 
 	default:
@@ -55,6 +58,34 @@ func BuildScopeFilter(scope ports.Scope, tableAlias string) (string, []interface
 	}
 }
 
+// BuildIdentifiersFilter builds a WHERE clause selecting the rows of ids by namespace and name,
+// the arguments are numbered from argIndex. An empty tableAlias uses unqualified columns.
+// Like BuildScopeFilter the clause passes the identifiers as two arrays: any number of
+// identifiers is one indexed query with a constant text instead of an OR chain.
+func BuildIdentifiersFilter(ids []models.ResourceIdentifier, tableAlias string, argIndex int) (string, []interface{}) {
+	namespace, name := "namespace", "name"
+	if tableAlias != "" {
+		namespace, name = tableAlias+".namespace", tableAlias+".name"
+	}
+	namespaces, names := SplitIdentifiers(ids)
+
+	// The namespace array prunes the partitions of tables partitioned by namespace,
+	// the row comparison with the unnested identifiers is not used for pruning
+	return fmt.Sprintf("(%s = ANY($%d::text[]) AND (%s, %s) IN (SELECT * FROM unnest($%d::text[], $%d::text[])))",
+		namespace, argIndex, namespace, name, argIndex, argIndex+1), []interface{}{namespaces, names}
+}
+
+// SplitIdentifiers returns namespaces and names of the identifiers as parallel arrays
+func SplitIdentifiers(ids []models.ResourceIdentifier) ([]string, []string) {
+	namespaces := make([]string, len(ids))
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		namespaces[i] = id.Namespace
+		names[i] = id.Name
+	}
+	return namespaces, names
+}
+
 // MarshalLabelsAnnotations marshals labels and annotations to JSONB
 func MarshalLabelsAnnotations(labels, annotations map[string]string) ([]byte, []byte, error) {
 	var labelsJSON, annotationsJSON []byte
@@ -101,7 +132,7 @@ func UnmarshalLabelsAnnotations(labelsJSON, annotationsJSON []byte) (map[string]
 }
 
 // ConvertK8sMetadata converts PostgreSQL K8s metadata to domain Meta
-func ConvertK8sMetadata(resourceVersionStr string, labelsJSON, annotationsJSON []byte, conditionsJSON []byte, createdAt, updatedAt time.Time) (models.Meta, error) {
+func ConvertK8sMetadata(resourceVersionStr string, labelsJSON, annotationsJSON []byte, conditionsJSON []byte, finalizers []string, deletionTimestamp *time.Time, ownerReferencesJSON []byte, createdAt, updatedAt time.Time) (models.Meta, error) {
 	meta := models.Meta{
 		ResourceVersion: resourceVersionStr,
 	}
@@ -122,6 +153,24 @@ func ConvertK8sMetadata(resourceVersionStr string, labelsJSON, annotationsJSON [
 		}
 		meta.Conditions = conditions
 	}
+	// Finalizers and the deletion intent
+	if len(finalizers) > 0 {
+		meta.Finalizers = finalizers
+	}
+	if deletionTimestamp != nil {
+		deletedAt := metav1.NewTime(*deletionTimestamp)
+		meta.DeletionTimestamp = &deletedAt
+	}
+	if len(ownerReferencesJSON) > 0 {
+		var ownerReferences []metav1.OwnerReference
+		if err := json.Unmarshal(ownerReferencesJSON, &ownerReferences); err != nil {
+			return meta, errors.Wrap(err, "failed to unmarshal owner references")
+		}
+		if len(ownerReferences) > 0 {
+			meta.OwnerReferences = ownerReferences
+		}
+	}
+
 	// Convert timestamps
 	meta.CreationTS = metav1.NewTime(createdAt)
 
@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+func identifiers(n int) []models.ResourceIdentifier {
+	ids := make([]models.ResourceIdentifier, n)
+	for i := range ids {
+		ids[i] = models.NewResourceIdentifier(fmt.Sprintf("rule-%d", i), models.WithNamespace("default"))
+	}
+	return ids
+}
+
+func TestBuildScopeFilter_ConstantQueryForAnyNumberOfIdentifiers(t *testing.T) {
+	one, oneArgs := BuildScopeFilter(ports.NewResourceIdentifierScope(identifiers(1)...), "r")
+	many, manyArgs := BuildScopeFilter(ports.NewResourceIdentifierScope(identifiers(500)...), "r")
+
+	if one != many {
+		t.Fatalf("filter of 500 identifiers = %q, want the filter of one identifier %q", many, one)
+	}
+	if len(oneArgs) != 2 || len(manyArgs) != 2 {
+		t.Fatalf("filters have %d and %d arguments, want namespace and name arrays", len(oneArgs), len(manyArgs))
+	}
+	if names := manyArgs[1].([]string); len(names) != 500 || names[499] != "rule-499" {
+		t.Errorf("name array has %d names, want 500", len(names))
+	}
+}
+
+func TestBuildScopeFilter_NamespaceIdentifiers(t *testing.T) {
+	scope := ports.NewResourceIdentifierScope(
+		models.NewResourceIdentifier("web", models.WithNamespace("default")),
+		models.NewResourceIdentifier("", models.WithNamespace("prod")),
+	)
+
+	filter, args := BuildScopeFilter(scope, "s")
+
+	want := "((s.namespace = ANY($1::text[]) AND (s.namespace, s.name) IN (SELECT * FROM unnest($1::text[], $2::text[]))) OR s.namespace = ANY($3::text[]))"
+	if filter != want {
+		t.Errorf("filter = %q, want %q", filter, want)
+	}
+	if len(args) != 3 || args[2].([]string)[0] != "prod" {
+		t.Errorf("args = %v, want pair arrays and the prod namespace", args)
+	}
+}
+
+func TestBuildIdentifiersFilter_UnqualifiedColumns(t *testing.T) {
+	filter, args := BuildIdentifiersFilter(identifiers(3), "", 2)
+
+	want := "(namespace = ANY($2::text[]) AND (namespace, name) IN (SELECT * FROM unnest($2::text[], $3::text[])))"
+	if filter != want {
+		t.Errorf("filter = %q, want %q", filter, want)
+	}
+	if len(args) != 2 {
+		t.Errorf("args = %v, want namespace and name arrays", args)
+	}
+}
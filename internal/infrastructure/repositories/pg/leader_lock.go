@@ -0,0 +1,101 @@
+package pg
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// LeaderLock is a PostgreSQL implementation of ports.LeaderLock: a session advisory lock held on a
+// dedicated pool connection, so the lock is released by the database when the replica dies
+type LeaderLock struct {
+	pool *pgxpool.Pool
+	name string
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+var _ ports.LeaderLock = &LeaderLock{}
+
+// NewLeaderLock creates an advisory lock with the given name on top of the connection pool
+func NewLeaderLock(pool *pgxpool.Pool, name string) *LeaderLock {
+	return &LeaderLock{pool: pool, name: name}
+}
+
+// LeaderLock returns the leader lock with the given name in the registry database
+func (r *Registry) LeaderLock(name string) *LeaderLock {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return NewLeaderLock(r.pool, name)
+}
+
+// TryAcquire takes the advisory lock without waiting
+func (l *LeaderLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		return true, nil
+	}
+
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to acquire connection for leader lock")
+	}
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtextextended($1, 0))`, l.name).Scan(&acquired); err != nil {
+		conn.Release()
+		return false, errors.Wrapf(err, "failed to try leader lock %s", l.name)
+	}
+	if !acquired {
+		conn.Release()
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Held checks the connection holding the lock, a broken connection loses the lock
+func (l *LeaderLock) Held(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return false, nil
+	}
+	if err := l.conn.Ping(ctx); err != nil {
+		// The session may be gone together with the lock, never return the connection to the pool
+		_ = l.conn.Conn().Close(context.Background())
+		l.conn.Release()
+		l.conn = nil
+		return false, errors.Wrapf(err, "lost connection holding leader lock %s", l.name)
+	}
+	return true, nil
+}
+
+// Release unlocks the advisory lock and returns its connection to the pool
+func (l *LeaderLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+	conn := l.conn
+	l.conn = nil
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_unlock(hashtextextended($1, 0))`, l.name); err != nil {
+		_ = conn.Conn().Close(context.Background())
+		conn.Release()
+		return errors.Wrapf(err, "failed to release leader lock %s", l.name)
+	}
+	conn.Release()
+	return nil
+}
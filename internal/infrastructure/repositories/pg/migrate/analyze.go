@@ -0,0 +1,205 @@
+package migrate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// PostgreSQL lock modes taken by migration statements
+const (
+	LockNone                 = ""
+	LockShareUpdateExclusive = "SHARE UPDATE EXCLUSIVE"
+	LockShare                = "SHARE"
+	LockShareRowExclusive    = "SHARE ROW EXCLUSIVE"
+	LockRowExclusive         = "ROW EXCLUSIVE"
+	LockAccessExclusive      = "ACCESS EXCLUSIVE"
+)
+
+// Impact describes the expected effect of a statement on the live database
+type Impact struct {
+	// Kind is the statement kind, e.g. "ALTER TABLE" or "UPDATE"
+	Kind string `json:"kind"`
+	// Tables are the existing tables the statement touches
+	Tables []string `json:"tables,omitempty"`
+	// Lock is the strongest lock taken on Tables
+	Lock string `json:"lock,omitempty"`
+	// Blocks describes blocked concurrent access: "reads and writes", "writes" or empty
+	Blocks string `json:"blocks,omitempty"`
+	// Scan is true if the statement reads the whole table while holding the lock
+	Scan bool `json:"scan,omitempty"`
+	// Rewrite is true if the statement rewrites the whole table
+	Rewrite bool `json:"rewrite,omitempty"`
+	// Destructive is true if the statement removes or modifies existing data
+	Destructive bool `json:"destructive,omitempty"`
+	// Notes explain the estimation
+	Notes []string `json:"notes,omitempty"`
+}
+
+var (
+	whitespace = regexp.MustCompile(`\s+`)
+
+	identifier   = `((?:"[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)(?:\.(?:"[^"]+"|[A-Za-z_][A-Za-z0-9_$]*))?)`
+	alterTable   = regexp.MustCompile(`(?i)^ALTER TABLE (?:IF EXISTS )?(?:ONLY )?` + identifier)
+	createIndex  = regexp.MustCompile(`(?i)^CREATE (?:UNIQUE )?INDEX (CONCURRENTLY )?(?:IF NOT EXISTS )?(?:` + identifier + ` )?ON (?:ONLY )?` + identifier)
+	dropTable    = regexp.MustCompile(`(?i)^DROP TABLE (?:IF EXISTS )?(.+?)(?: CASCADE| RESTRICT)?$`)
+	truncate     = regexp.MustCompile(`(?i)^TRUNCATE (?:TABLE )?(?:ONLY )?(.+?)(?: RESTART IDENTITY| CONTINUE IDENTITY)?(?: CASCADE| RESTRICT)?$`)
+	update       = regexp.MustCompile(`(?i)^UPDATE (?:ONLY )?` + identifier)
+	deleteFrom   = regexp.MustCompile(`(?i)^DELETE FROM (?:ONLY )?` + identifier)
+	insertInto   = regexp.MustCompile(`(?i)^INSERT INTO ` + identifier)
+	triggerOn    = regexp.MustCompile(`(?i)^(?:CREATE (?:OR REPLACE )?|DROP )TRIGGER (?:IF EXISTS )?.*? ON (?:ONLY )?` + identifier)
+	references   = regexp.MustCompile(`(?i)REFERENCES ` + identifier)
+	createTable  = regexp.MustCompile(`(?i)^CREATE (?:UNLOGGED )?TABLE`)
+	addColumn    = regexp.MustCompile(`(?i)ADD COLUMN`)
+	storedColumn = regexp.MustCompile(`(?i)GENERATED ALWAYS AS .* STORED`)
+	alterType    = regexp.MustCompile(`(?i)ALTER COLUMN \S+ (?:SET DATA )?TYPE`)
+	setNotNull   = regexp.MustCompile(`(?i)ALTER COLUMN \S+ SET NOT NULL`)
+	addCheck     = regexp.MustCompile(`(?i)ADD (?:CONSTRAINT \S+ )?(?:CHECK|UNIQUE|PRIMARY KEY)`)
+	addFK        = regexp.MustCompile(`(?i)ADD (?:CONSTRAINT \S+ )?FOREIGN KEY`)
+	dropColumn   = regexp.MustCompile(`(?i)DROP COLUMN`)
+	validate     = regexp.MustCompile(`(?i)VALIDATE CONSTRAINT`)
+)
+
+// Analyze estimates locks and data impact of a single statement.
+// Tables created by the same statement are not reported.
+func Analyze(statement string) Impact {
+	sql := whitespace.ReplaceAllString(strings.TrimSpace(statement), " ")
+	upper := strings.ToUpper(sql)
+
+	switch {
+	case alterTable.MatchString(sql):
+		return analyzeAlterTable(sql)
+
+	case createIndex.MatchString(sql):
+		m := createIndex.FindStringSubmatch(sql)
+		if m[1] != "" {
+			return Impact{Kind: "CREATE INDEX CONCURRENTLY", Tables: []string{m[3]}, Lock: LockShareUpdateExclusive, Scan: true,
+				Notes: []string{"concurrent build doesn't block writes but can't run inside a transaction"}}
+		}
+		return Impact{Kind: "CREATE INDEX", Tables: []string{m[3]}, Lock: LockShare, Blocks: "writes", Scan: true}
+
+	case createTable.MatchString(sql):
+		impact := Impact{Kind: "CREATE TABLE"}
+		if tables := referencedTables(sql); len(tables) > 0 {
+			impact.Tables = tables
+			impact.Lock = LockShareRowExclusive
+			impact.Blocks = "writes"
+			impact.Notes = []string{"foreign keys briefly lock referenced tables"}
+		}
+		return impact
+
+	case dropTable.MatchString(sql):
+		return Impact{Kind: "DROP TABLE", Tables: splitList(dropTable.FindStringSubmatch(sql)[1]), Lock: LockAccessExclusive,
+			Blocks: "reads and writes", Destructive: true}
+
+	case truncate.MatchString(sql):
+		return Impact{Kind: "TRUNCATE", Tables: splitList(truncate.FindStringSubmatch(sql)[1]), Lock: LockAccessExclusive,
+			Blocks: "reads and writes", Destructive: true}
+
+	case update.MatchString(sql):
+		return Impact{Kind: "UPDATE", Tables: []string{update.FindStringSubmatch(sql)[1]}, Lock: LockRowExclusive, Destructive: true,
+			Notes: []string{"updated rows are locked until commit"}}
+
+	case deleteFrom.MatchString(sql):
+		return Impact{Kind: "DELETE", Tables: []string{deleteFrom.FindStringSubmatch(sql)[1]}, Lock: LockRowExclusive, Destructive: true,
+			Notes: []string{"deleted rows are locked until commit"}}
+
+	case insertInto.MatchString(sql):
+		return Impact{Kind: "INSERT", Tables: []string{insertInto.FindStringSubmatch(sql)[1]}, Lock: LockRowExclusive}
+
+	case triggerOn.MatchString(sql):
+		kind := "CREATE TRIGGER"
+		lock := LockShareRowExclusive
+		blocks := "writes"
+		if strings.HasPrefix(upper, "DROP") {
+			kind = "DROP TRIGGER"
+			lock = LockAccessExclusive
+			blocks = "reads and writes"
+		}
+		return Impact{Kind: kind, Tables: []string{triggerOn.FindStringSubmatch(sql)[1]}, Lock: lock, Blocks: blocks}
+
+	case strings.HasPrefix(upper, "DO "):
+		return Impact{Kind: "DO", Notes: []string{"procedural block, impact is not analyzed"}}
+	}
+
+	return Impact{Kind: statementKind(upper)}
+}
+
+func analyzeAlterTable(sql string) Impact {
+	impact := Impact{
+		Kind:   "ALTER TABLE",
+		Tables: []string{alterTable.FindStringSubmatch(sql)[1]},
+		Lock:   LockAccessExclusive,
+		Blocks: "reads and writes",
+	}
+	notValid := strings.Contains(strings.ToUpper(sql), "NOT VALID")
+
+	switch {
+	case validate.MatchString(sql):
+		impact.Lock = LockShareUpdateExclusive
+		impact.Blocks = ""
+		impact.Scan = true
+	case addFK.MatchString(sql) && !addColumn.MatchString(sql):
+		// Adding a foreign key locks the table and the referenced one with SHARE ROW EXCLUSIVE
+		impact.Lock = LockShareRowExclusive
+		impact.Blocks = "writes"
+		impact.Tables = append(impact.Tables, referencedTables(sql)...)
+		if notValid {
+			impact.Notes = append(impact.Notes, "NOT VALID skips validation of existing rows")
+		} else {
+			impact.Scan = true
+			impact.Notes = append(impact.Notes, "existing rows are validated while the lock is held")
+		}
+	}
+
+	if storedColumn.MatchString(sql) || alterType.MatchString(sql) {
+		impact.Rewrite = true
+		impact.Notes = append(impact.Notes, "the table is rewritten while the lock is held")
+	}
+	if setNotNull.MatchString(sql) || (addCheck.MatchString(sql) && !notValid) {
+		impact.Scan = true
+	}
+	if dropColumn.MatchString(sql) {
+		impact.Destructive = true
+	}
+	return impact
+}
+
+// referencedTables returns tables referenced by foreign keys of the statement
+func referencedTables(sql string) []string {
+	var tables []string
+	seen := make(map[string]bool)
+	for _, m := range references.FindAllStringSubmatch(sql, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			tables = append(tables, m[1])
+		}
+	}
+	return tables
+}
+
+func splitList(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// statementKind returns the leading keywords of a statement, e.g. "CREATE FUNCTION"
+func statementKind(upper string) string {
+	words := strings.Fields(upper)
+	kind := make([]string, 0, 2)
+	for _, word := range words {
+		switch word {
+		case "OR", "REPLACE":
+			continue
+		}
+		kind = append(kind, word)
+		if len(kind) == 2 {
+			break
+		}
+	}
+	return strings.Join(kind, " ")
+}
@@ -0,0 +1,67 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		expected  Impact
+	}{
+		{
+			name:      "validated foreign key",
+			statement: "ALTER TABLE bindings ADD CONSTRAINT fk FOREIGN KEY (a) REFERENCES services(name)",
+			expected: Impact{Kind: "ALTER TABLE", Tables: []string{"bindings", "services"}, Lock: LockShareRowExclusive,
+				Blocks: "writes", Scan: true, Notes: []string{"existing rows are validated while the lock is held"}},
+		},
+		{
+			name:      "not valid foreign key",
+			statement: "ALTER TABLE bindings ADD CONSTRAINT fk FOREIGN KEY (a) REFERENCES services(name) NOT VALID",
+			expected: Impact{Kind: "ALTER TABLE", Tables: []string{"bindings", "services"}, Lock: LockShareRowExclusive,
+				Blocks: "writes", Notes: []string{"NOT VALID skips validation of existing rows"}},
+		},
+		{
+			name:      "stored generated column",
+			statement: "ALTER TABLE rules\nADD COLUMN ns TEXT GENERATED ALWAYS AS (ref->>'namespace') STORED",
+			expected: Impact{Kind: "ALTER TABLE", Tables: []string{"rules"}, Lock: LockAccessExclusive,
+				Blocks: "reads and writes", Rewrite: true, Notes: []string{"the table is rewritten while the lock is held"}},
+		},
+		{
+			name:      "drop column",
+			statement: "ALTER TABLE rules DROP COLUMN ns",
+			expected: Impact{Kind: "ALTER TABLE", Tables: []string{"rules"}, Lock: LockAccessExclusive,
+				Blocks: "reads and writes", Destructive: true},
+		},
+		{
+			name:      "index",
+			statement: "CREATE INDEX idx_rules_ns ON rules (ns)",
+			expected:  Impact{Kind: "CREATE INDEX", Tables: []string{"rules"}, Lock: LockShare, Blocks: "writes", Scan: true},
+		},
+		{
+			name:      "new table",
+			statement: "CREATE TABLE outbox (id BIGSERIAL PRIMARY KEY)",
+			expected:  Impact{Kind: "CREATE TABLE"},
+		},
+		{
+			name:      "delete",
+			statement: "DELETE FROM outbox WHERE id < 10",
+			expected: Impact{Kind: "DELETE", Tables: []string{"outbox"}, Lock: LockRowExclusive, Destructive: true,
+				Notes: []string{"deleted rows are locked until commit"}},
+		},
+		{
+			name:      "function",
+			statement: "CREATE OR REPLACE FUNCTION f() RETURNS TRIGGER AS $$ BEGIN RETURN NEW; END $$ LANGUAGE plpgsql",
+			expected:  Impact{Kind: "CREATE FUNCTION"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Analyze(tt.statement))
+		})
+	}
+}
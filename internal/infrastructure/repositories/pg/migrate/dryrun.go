@@ -0,0 +1,303 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// DefaultVersionTable is the goose version table used by the migration job
+const DefaultVersionTable = "netguard_db_ver"
+
+// StatementReport is the dry run result of a single statement
+type StatementReport struct {
+	SQL string `json:"sql"`
+	Impact
+	// Rows is the number of rows the statement touches, -1 if unknown
+	Rows int64 `json:"rows"`
+	// RowsEstimated is true if Rows is a planner or statistics estimate
+	RowsEstimated bool `json:"rowsEstimated,omitempty"`
+}
+
+// MigrationReport is the dry run result of a pending migration
+type MigrationReport struct {
+	Migration
+	Statements []StatementReport `json:"statements"`
+	// Blocking is true if any statement blocks reads or writes of an existing table
+	Blocking bool `json:"blocking"`
+	// Destructive is true if any statement removes or modifies existing data
+	Destructive bool `json:"destructive"`
+}
+
+// Report is the dry run result
+type Report struct {
+	Time           time.Time         `json:"time"`
+	Database       string            `json:"database"`
+	DatabaseSize   int64             `json:"databaseSize"`
+	CurrentVersion int64             `json:"currentVersion"`
+	Pending        []MigrationReport `json:"pending"`
+	// LastArchivedWAL is the time of the last archived WAL segment, nil if WAL archiving is not used
+	LastArchivedWAL *time.Time `json:"lastArchivedWal,omitempty"`
+	// BackupRecommended is true if pending migrations remove or modify existing data
+	BackupRecommended bool `json:"backupRecommended"`
+}
+
+// DryRun reports pending migrations of dir against the database without applying them
+func DryRun(ctx context.Context, conn *pgx.Conn, dir, versionTable string) (*Report, error) {
+	migrations, err := LoadMigrations(dir)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := appliedVersions(ctx, conn, versionTable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read goose versions: %w", err)
+	}
+
+	report := &Report{Time: time.Now()}
+	err = conn.QueryRow(ctx, `SELECT current_database(), pg_database_size(current_database())`).
+		Scan(&report.Database, &report.DatabaseSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database size: %w", err)
+	}
+	report.LastArchivedWAL, err = lastArchivedWAL(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAL archiver status: %w", err)
+	}
+
+	for _, migration := range migrations {
+		if applied[migration.Version] {
+			if migration.Version > report.CurrentVersion {
+				report.CurrentVersion = migration.Version
+			}
+			continue
+		}
+
+		pending := MigrationReport{Migration: migration}
+		for _, statement := range migration.Statements {
+			statementReport := StatementReport{SQL: statement, Impact: Analyze(statement)}
+			statementReport.Rows, statementReport.RowsEstimated = affectedRows(ctx, conn, statement, statementReport.Impact)
+
+			pending.Blocking = pending.Blocking || statementReport.Blocks != ""
+			pending.Destructive = pending.Destructive || statementReport.Destructive
+			pending.Statements = append(pending.Statements, statementReport)
+		}
+		report.BackupRecommended = report.BackupRecommended || pending.Destructive
+		report.Pending = append(report.Pending, pending)
+	}
+	return report, nil
+}
+
+// appliedVersions returns versions applied according to the goose version table.
+// A version is applied if its latest row is marked applied.
+func appliedVersions(ctx context.Context, conn *pgx.Conn, versionTable string) (map[int64]bool, error) {
+	var exists bool
+	if err := conn.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, versionTable).Scan(&exists); err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]bool)
+	if !exists {
+		return applied, nil
+	}
+
+	query := fmt.Sprintf(`SELECT DISTINCT ON (version_id) version_id, is_applied FROM %s ORDER BY version_id, id DESC`,
+		pgx.Identifier{versionTable}.Sanitize())
+	rows, err := conn.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int64
+		var isApplied bool
+		if err := rows.Scan(&version, &isApplied); err != nil {
+			return nil, err
+		}
+		if isApplied {
+			applied[version] = true
+		}
+	}
+	return applied, rows.Err()
+}
+
+func lastArchivedWAL(ctx context.Context, conn *pgx.Conn) (*time.Time, error) {
+	var archived *time.Time
+	if err := conn.QueryRow(ctx, `SELECT last_archived_time FROM pg_stat_archiver`).Scan(&archived); err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
+// affectedRows estimates the rows touched by a statement. DML is explained inside a
+// rolled back transaction, other statements touch all rows of their first table.
+func affectedRows(ctx context.Context, conn *pgx.Conn, statement string, impact Impact) (int64, bool) {
+	if len(impact.Tables) == 0 {
+		return 0, false
+	}
+
+	switch impact.Kind {
+	case "UPDATE", "DELETE", "INSERT":
+		if rows, err := explainRows(ctx, conn, statement); err == nil {
+			return rows, true
+		}
+		// The statement may depend on changes of previous statements, fall back to the table size
+	}
+
+	rows, estimated, err := tableRows(ctx, conn, impact.Tables[0])
+	if err != nil {
+		return -1, false
+	}
+	return rows, estimated
+}
+
+// explainRows returns the planner estimate of rows modified by a DML statement
+func explainRows(ctx context.Context, conn *pgx.Conn, statement string) (int64, error) {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var plan []byte
+	if err := tx.QueryRow(ctx, "EXPLAIN (FORMAT JSON) "+statement).Scan(&plan); err != nil {
+		return 0, err
+	}
+
+	type node struct {
+		NodeType string  `json:"Node Type"`
+		Rows     float64 `json:"Plan Rows"`
+		Plans    []node  `json:"Plans"`
+	}
+	var explained []struct {
+		Plan node `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explained); err != nil {
+		return 0, err
+	}
+	if len(explained) == 0 {
+		return 0, errors.New("empty plan")
+	}
+
+	// ModifyTable returns no rows, the modified rows come from its input
+	root := explained[0].Plan
+	if root.NodeType == "ModifyTable" && len(root.Plans) > 0 {
+		return int64(root.Plans[0].Rows), nil
+	}
+	return int64(root.Rows), nil
+}
+
+// tableRows returns the number of table rows: the statistics estimate, or an exact
+// count for tables never analyzed. Tables created by pending migrations have no rows.
+func tableRows(ctx context.Context, conn *pgx.Conn, table string) (int64, bool, error) {
+	var reltuples *float64
+	err := conn.QueryRow(ctx, `SELECT c.reltuples FROM pg_class c WHERE c.oid = to_regclass($1)`, table).Scan(&reltuples)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if reltuples != nil && *reltuples >= 0 {
+		return int64(*reltuples), true, nil
+	}
+
+	var count int64
+	if err := conn.QueryRow(ctx, fmt.Sprintf(`SELECT count(*) FROM %s`, table)).Scan(&count); err != nil {
+		return 0, false, err
+	}
+	return count, false, nil
+}
+
+// WriteText writes a human readable report
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Database %s (%s), current migration version %d\n", r.Database, formatBytes(r.DatabaseSize), r.CurrentVersion)
+	if r.LastArchivedWAL != nil {
+		fmt.Fprintf(&b, "WAL archiving: last segment archived at %s\n", r.LastArchivedWAL.Format(time.RFC3339))
+	} else {
+		b.WriteString("WAL archiving: not configured, take a backup before disruptive migrations\n")
+	}
+	if len(r.Pending) == 0 {
+		b.WriteString("No pending migrations\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+	if r.BackupRecommended {
+		b.WriteString("⚠️  Backup recommended: pending migrations remove or modify existing data\n")
+	}
+
+	for _, migration := range r.Pending {
+		fmt.Fprintf(&b, "\n%s", migration.Name)
+		var flags []string
+		if migration.Blocking {
+			flags = append(flags, "blocking")
+		}
+		if migration.Destructive {
+			flags = append(flags, "destructive")
+		}
+		if len(flags) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(flags, ", "))
+		}
+		b.WriteString("\n")
+
+		for i, statement := range migration.Statements {
+			fmt.Fprintf(&b, "  %d. %s\n", i+1, indent(statement.SQL, "     "))
+			if statement.Lock != "" {
+				fmt.Fprintf(&b, "     lock: %s on %s", statement.Lock, strings.Join(statement.Tables, ", "))
+				if statement.Blocks != "" {
+					fmt.Fprintf(&b, ", blocks %s", statement.Blocks)
+				}
+				b.WriteString("\n")
+			}
+			if len(statement.Tables) > 0 {
+				fmt.Fprintf(&b, "     rows: %s", formatRows(statement.Rows, statement.RowsEstimated))
+				if statement.Scan {
+					b.WriteString(", full table scan")
+				}
+				if statement.Rewrite {
+					b.WriteString(", table rewrite")
+				}
+				b.WriteString("\n")
+			}
+			for _, note := range statement.Notes {
+				fmt.Fprintf(&b, "     note: %s\n", note)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func indent(sql, prefix string) string {
+	return strings.ReplaceAll(sql, "\n", "\n"+prefix)
+}
+
+func formatRows(rows int64, estimated bool) string {
+	switch {
+	case rows < 0:
+		return "unknown"
+	case estimated:
+		return fmt.Sprintf("~%d", rows)
+	}
+	return fmt.Sprintf("%d", rows)
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
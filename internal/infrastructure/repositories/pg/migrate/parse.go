@@ -0,0 +1,160 @@
+// Package migrate inspects goose migrations before they are applied.
+//
+// The dry run compares migration files with the goose version table of the
+// live database and reports, for every pending migration, the SQL to be
+// executed, the locks it takes and the number of rows it touches, so
+// disruptive migrations can be scheduled and backed up in advance. Nothing
+// is executed except catalog queries and EXPLAIN inside a rolled back
+// transaction.
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Migration is a goose SQL migration file
+type Migration struct {
+	Version int64  `json:"version"`
+	Name    string `json:"name"`
+	// Statements are the statements of the Up section
+	Statements []string `json:"-"`
+}
+
+// LoadMigrations reads goose SQL migrations of dir ordered by version
+func LoadMigrations(dir string) ([]Migration, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(paths))
+	for _, path := range paths {
+		name := filepath.Base(path)
+		prefix, _, found := strings.Cut(name, "_")
+		if !found {
+			continue
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			// Not a versioned migration
+			continue
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", name, err)
+		}
+		migrations = append(migrations, Migration{
+			Version:    version,
+			Name:       name,
+			Statements: SplitStatements(upSection(string(content))),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// upSection returns the part of a goose migration between "+goose Up" and "+goose Down"
+func upSection(content string) string {
+	var b strings.Builder
+	inUp := false
+	for _, line := range strings.SplitAfter(content, "\n") {
+		annotation := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(annotation, "-- +goose Up"):
+			inUp = true
+			continue
+		case strings.HasPrefix(annotation, "-- +goose Down"):
+			inUp = false
+			continue
+		case strings.HasPrefix(annotation, "-- +goose"):
+			// StatementBegin/End only group statements sent to the server at once
+			continue
+		}
+		if inUp {
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+// SplitStatements splits SQL into statements on semicolons outside of comments,
+// quoted strings and dollar-quoted bodies. Comments are removed.
+func SplitStatements(sql string) []string {
+	var statements []string
+	var current strings.Builder
+
+	flush := func() {
+		if statement := strings.TrimSpace(current.String()); statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+	}
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+			current.WriteByte('\n')
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end + 3
+			}
+			current.WriteByte(' ')
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(sql) && sql[end] != c {
+				end++
+			}
+			current.WriteString(sql[i:min(end+1, len(sql))])
+			i = end
+		case c == '$':
+			tag, body := dollarQuoted(sql[i:])
+			if tag == "" {
+				current.WriteByte(c)
+				continue
+			}
+			current.WriteString(body)
+			i += len(body) - 1
+		case c == ';':
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+	return statements
+}
+
+// dollarQuoted returns the tag and the whole $tag$...$tag$ body starting at s
+func dollarQuoted(s string) (string, string) {
+	end := strings.IndexByte(s[1:], '$')
+	if end < 0 {
+		return "", ""
+	}
+	tag := s[:end+2]
+	for _, r := range tag[1 : len(tag)-1] {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return "", ""
+		}
+	}
+	closing := strings.Index(s[len(tag):], tag)
+	if closing < 0 {
+		return tag, s
+	}
+	return tag, s[:len(tag)+closing+len(tag)]
+}
@@ -0,0 +1,46 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	sql := `-- leading comment
+CREATE TABLE a (id INT, note TEXT DEFAULT 'a;b'); /* block; comment */
+CREATE FUNCTION f() RETURNS TRIGGER AS $body$
+BEGIN
+    RETURN NEW; -- inside the body
+END;
+$body$ LANGUAGE plpgsql;
+DO $$ BEGIN PERFORM 1; END $$;`
+
+	statements := SplitStatements(sql)
+	require.Len(t, statements, 3)
+	assert.Equal(t, "CREATE TABLE a (id INT, note TEXT DEFAULT 'a;b')", statements[0])
+	assert.Contains(t, statements[1], "RETURN NEW; -- inside the body")
+	assert.Equal(t, "DO $$ BEGIN PERFORM 1; END $$", statements[2])
+}
+
+func TestLoadMigrations(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	write("002_second.sql", "-- +goose Up\nALTER TABLE a ADD COLUMN b INT;\n-- +goose Down\nALTER TABLE a DROP COLUMN b;\n")
+	write("001_first.sql", "-- +goose Up\n-- +goose StatementBegin\nCREATE TABLE a (id INT);\n-- +goose StatementEnd\n")
+	write("README.sql", "SELECT 1;")
+
+	migrations, err := LoadMigrations(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+
+	assert.EqualValues(t, 1, migrations[0].Version)
+	assert.Equal(t, []string{"CREATE TABLE a (id INT)"}, migrations[0].Statements)
+	assert.EqualValues(t, 2, migrations[1].Version)
+	assert.Equal(t, []string{"ALTER TABLE a ADD COLUMN b INT"}, migrations[1].Statements, "Down section must be skipped")
+}
@@ -0,0 +1,54 @@
+package migrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeferredReferenceForeignKeys checks the ON DELETE actions of migration 022 against
+// the application cascade logic
+func TestDeferredReferenceForeignKeys(t *testing.T) {
+	migrations, err := LoadMigrations("../../../../../migrations")
+	require.NoError(t, err)
+
+	var statements []string
+	for _, m := range migrations {
+		if m.Version == 22 {
+			statements = m.Statements
+		}
+	}
+	require.NotEmpty(t, statements, "migration 022 not found")
+
+	constraint := func(name string) string {
+		for _, statement := range statements {
+			if strings.Contains(statement, "ADD CONSTRAINT "+name) {
+				return statement
+			}
+		}
+		return ""
+	}
+
+	tests := []struct {
+		constraint string
+		onDelete   string
+	}{
+		{constraint: "address_group_bindings_service_namespace_service_name_fkey", onDelete: "ON DELETE NO ACTION"},
+		{constraint: "address_group_bindings_address_group_fkey", onDelete: "ON DELETE CASCADE"},
+		{constraint: "service_aliases_service_namespace_service_name_fkey", onDelete: "ON DELETE NO ACTION"},
+		{constraint: "rule_s2s_service_local_fkey", onDelete: "ON DELETE NO ACTION"},
+		{constraint: "rule_s2s_service_fkey", onDelete: "ON DELETE NO ACTION"},
+		{constraint: "rule_s2s_service_local_alias_fkey", onDelete: "ON DELETE NO ACTION"},
+		{constraint: "rule_s2s_service_alias_fkey", onDelete: "ON DELETE NO ACTION"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			statement := constraint(tt.constraint)
+			require.NotEmpty(t, statement)
+			assert.Contains(t, statement, tt.onDelete)
+			assert.Contains(t, statement, "DEFERRABLE INITIALLY DEFERRED")
+		})
+	}
+}
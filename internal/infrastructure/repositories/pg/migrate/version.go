@@ -0,0 +1,6 @@
+package migrate
+
+// SupportedVersion is the last migration of the migrations directory the backend was built
+// against. It grows with every new migration, the backend refuses to serve a database with
+// another schema version.
+const SupportedVersion int64 = 40
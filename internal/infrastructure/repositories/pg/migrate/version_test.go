@@ -0,0 +1,16 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedVersion_IsLastMigration(t *testing.T) {
+	migrations, err := LoadMigrations("../../../../../migrations")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	assert.Equal(t, migrations[len(migrations)-1].Version, SupportedVersion,
+		"SupportedVersion must be bumped together with a new migration")
+}
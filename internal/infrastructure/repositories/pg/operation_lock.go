@@ -0,0 +1,74 @@
+package pg
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// OperationLock is a PostgreSQL implementation of ports.OperationLock: a session advisory lock taken
+// on a dedicated pool connection for the duration of the operation
+type OperationLock struct {
+	pool *pgxpool.Pool
+	name string
+
+	mu   sync.Mutex
+	conn *pgxpool.Conn
+}
+
+var _ ports.OperationLock = &OperationLock{}
+
+// NewOperationLock creates an advisory lock with the given name on top of the connection pool
+func NewOperationLock(pool *pgxpool.Pool, name string) *OperationLock {
+	return &OperationLock{pool: pool, name: name}
+}
+
+// OperationLock returns the operation lock with the given name in the registry database
+func (r *Registry) OperationLock(name string) *OperationLock {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return NewOperationLock(r.pool, name)
+}
+
+// Lock waits for the advisory lock. The caller serializes Lock and Unlock within the process.
+func (l *OperationLock) Lock(ctx context.Context) error {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to acquire connection for operation lock")
+	}
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock(hashtextextended($1, 0))`, l.name); err != nil {
+		// A canceled wait may leave the lock request behind, never return the connection to the pool
+		_ = conn.Conn().Close(context.Background())
+		conn.Release()
+		return errors.Wrapf(err, "failed to take operation lock %s", l.name)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.conn = conn
+	return nil
+}
+
+// Unlock releases the advisory lock and returns its connection to the pool
+func (l *OperationLock) Unlock(ctx context.Context) error {
+	l.mu.Lock()
+	conn := l.conn
+	l.conn = nil
+	l.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_unlock(hashtextextended($1, 0))`, l.name); err != nil {
+		// Closing the session releases the lock as well
+		_ = conn.Conn().Close(context.Background())
+		conn.Release()
+		return errors.Wrapf(err, "failed to release operation lock %s", l.name)
+	}
+	conn.Release()
+	return nil
+}
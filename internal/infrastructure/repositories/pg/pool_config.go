@@ -0,0 +1,156 @@
+package pg
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Query exec modes of PoolConfig.QueryExecMode, named like the default_query_exec_mode
+// connection string parameter of pgx
+const (
+	// QueryExecModeCacheStatement prepares every query once per connection and executes the
+	// prepared statement afterwards, one round trip per repeated query
+	QueryExecModeCacheStatement = "cache_statement"
+	// QueryExecModeCacheDescribe caches only the statement descriptions, for poolers
+	// such as PgBouncer in transaction mode that do not keep prepared statements
+	QueryExecModeCacheDescribe = "cache_describe"
+	// QueryExecModeExec and QueryExecModeSimpleProtocol cache nothing
+	QueryExecModeExec           = "exec"
+	QueryExecModeSimpleProtocol = "simple_protocol"
+)
+
+// PoolConfig tunes the PostgreSQL connection pool and the statement cache of its connections
+type PoolConfig struct {
+	MaxConns        int32         `yaml:"max-conns" env:"PG_MAX_CONNS"`
+	MinConns        int32         `yaml:"min-conns" env:"PG_MIN_CONNS"`
+	MaxConnLifetime time.Duration `yaml:"max-conn-lifetime" env:"PG_MAX_CONN_LIFETIME"`
+	MaxConnIdleTime time.Duration `yaml:"max-conn-idle-time" env:"PG_MAX_CONN_IDLE_TIME"`
+	// QueryExecMode is one of the QueryExecMode constants
+	QueryExecMode string `yaml:"query-exec-mode" env:"PG_QUERY_EXEC_MODE"`
+	// StatementCacheCapacity is the number of prepared statements or descriptions cached per
+	// connection. Zero disables the cache, queries are then executed without preparing them.
+	StatementCacheCapacity int `yaml:"statement-cache-capacity" env:"PG_STATEMENT_CACHE_CAPACITY"`
+	// ReplicaURI is the connection URI of a read replica. Reader queries go to a pool of the
+	// replica sized like the primary pool, writes stay on the primary. Empty reads the primary.
+	ReplicaURI string `yaml:"replica-uri" env:"PG_REPLICA_URI"`
+	// WriteIsolation is the isolation level of writers that do not request one, one of
+	// read-committed, repeatable-read or serializable
+	WriteIsolation string `yaml:"write-isolation" env:"PG_WRITE_ISOLATION"`
+	// TxTimeout bounds a writer transaction from begin to commit, including the wait for a
+	// pool connection. Zero leaves transactions to the server side timeouts.
+	TxTimeout time.Duration `yaml:"tx-timeout" env:"PG_TX_TIMEOUT"`
+	// StatementTimeout, LockTimeout and IdleInTransactionTimeout are the statement_timeout,
+	// lock_timeout and idle_in_transaction_session_timeout of the connections, zero disables them
+	StatementTimeout         time.Duration `yaml:"statement-timeout" env:"PG_STATEMENT_TIMEOUT"`
+	LockTimeout              time.Duration `yaml:"lock-timeout" env:"PG_LOCK_TIMEOUT"`
+	IdleInTransactionTimeout time.Duration `yaml:"idle-in-transaction-timeout" env:"PG_IDLE_IN_TRANSACTION_TIMEOUT"`
+	// SlowQueryThreshold is the duration from which queries are logged with their SQL, zero
+	// disables the slow query log. Durations of all queries are exported as metrics.
+	SlowQueryThreshold time.Duration `yaml:"slow-query-threshold" env:"PG_SLOW_QUERY_THRESHOLD"`
+}
+
+// DefaultPoolConfig returns the pool configuration sized for concurrent condition processing
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		MaxConns:               50,
+		MinConns:               5,
+		MaxConnLifetime:        2 * time.Hour,
+		MaxConnIdleTime:        15 * time.Minute,
+		QueryExecMode:          QueryExecModeCacheStatement,
+		StatementCacheCapacity: 512,
+		WriteIsolation:         string(ports.IsolationRepeatableRead),
+		// RuleS2S flows run many statements, the transaction outlasts a single statement
+		TxTimeout:                2 * time.Minute,
+		StatementTimeout:         60 * time.Second,
+		LockTimeout:              30 * time.Second,
+		IdleInTransactionTimeout: 2 * time.Minute,
+		SlowQueryThreshold:       time.Second,
+	}
+}
+
+// Validate validates the pool configuration
+func (c PoolConfig) Validate() error {
+	if c.MaxConns <= 0 {
+		return fmt.Errorf("postgres max conns must be positive")
+	}
+	if c.MinConns < 0 || c.MinConns > c.MaxConns {
+		return fmt.Errorf("postgres min conns must be between 0 and max conns")
+	}
+	if c.MaxConnLifetime <= 0 || c.MaxConnIdleTime <= 0 {
+		return fmt.Errorf("postgres connection lifetime and idle time must be positive")
+	}
+	if c.StatementCacheCapacity < 0 {
+		return fmt.Errorf("postgres statement cache capacity cannot be negative")
+	}
+	if _, err := c.queryExecMode(); err != nil {
+		return err
+	}
+	if c.TxTimeout < 0 || c.StatementTimeout < 0 || c.LockTimeout < 0 || c.IdleInTransactionTimeout < 0 {
+		return fmt.Errorf("postgres timeouts cannot be negative")
+	}
+	if c.SlowQueryThreshold < 0 {
+		return fmt.Errorf("postgres slow query threshold cannot be negative")
+	}
+	if _, err := txIsoLevel(ports.IsolationLevel(c.WriteIsolation)); err != nil {
+		return fmt.Errorf("postgres write isolation: %w", err)
+	}
+	return nil
+}
+
+// applyTo sets the pool and statement cache settings of conf
+func (c PoolConfig) applyTo(conf *pgxpool.Config) error {
+	mode, err := c.queryExecMode()
+	if err != nil {
+		return err
+	}
+
+	conf.MaxConns = c.MaxConns
+	conf.MinConns = c.MinConns
+	conf.MaxConnLifetime = c.MaxConnLifetime
+	conf.MaxConnIdleTime = c.MaxConnIdleTime
+
+	// The cached modes fall back to executing unprepared queries without a cache
+	if c.StatementCacheCapacity == 0 && (mode == pgx.QueryExecModeCacheStatement || mode == pgx.QueryExecModeCacheDescribe) {
+		mode = pgx.QueryExecModeExec
+	}
+	conf.ConnConfig.DefaultQueryExecMode = mode
+	conf.ConnConfig.StatementCacheCapacity = 0
+	conf.ConnConfig.DescriptionCacheCapacity = 0
+	switch mode {
+	case pgx.QueryExecModeCacheStatement:
+		conf.ConnConfig.StatementCacheCapacity = c.StatementCacheCapacity
+	case pgx.QueryExecModeCacheDescribe:
+		conf.ConnConfig.DescriptionCacheCapacity = c.StatementCacheCapacity
+	}
+	return nil
+}
+
+// runtimeParams returns the session timeouts of the connections in milliseconds
+func (c PoolConfig) runtimeParams() map[string]string {
+	return map[string]string{
+		"statement_timeout":                   strconv.FormatInt(c.StatementTimeout.Milliseconds(), 10),
+		"idle_in_transaction_session_timeout": strconv.FormatInt(c.IdleInTransactionTimeout.Milliseconds(), 10),
+		"lock_timeout":                        strconv.FormatInt(c.LockTimeout.Milliseconds(), 10),
+	}
+}
+
+func (c PoolConfig) queryExecMode() (pgx.QueryExecMode, error) {
+	switch c.QueryExecMode {
+	case QueryExecModeCacheStatement:
+		return pgx.QueryExecModeCacheStatement, nil
+	case QueryExecModeCacheDescribe:
+		return pgx.QueryExecModeCacheDescribe, nil
+	case QueryExecModeExec:
+		return pgx.QueryExecModeExec, nil
+	case QueryExecModeSimpleProtocol:
+		return pgx.QueryExecModeSimpleProtocol, nil
+	default:
+		return 0, fmt.Errorf("unsupported postgres query exec mode %q", c.QueryExecMode)
+	}
+}
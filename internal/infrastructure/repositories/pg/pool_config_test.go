@@ -0,0 +1,56 @@
+package pg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+func TestPoolConfig_ApplyTo(t *testing.T) {
+	conf, err := pgxpool.ParseConfig("postgres://netguard@localhost:5432/netguard")
+	require.NoError(t, err)
+
+	poolConfig := DefaultPoolConfig()
+	poolConfig.MaxConns = 20
+	require.NoError(t, poolConfig.Validate())
+	require.NoError(t, poolConfig.applyTo(conf))
+	assert.Equal(t, int32(20), conf.MaxConns)
+	assert.Equal(t, pgx.QueryExecModeCacheStatement, conf.ConnConfig.DefaultQueryExecMode)
+	assert.Equal(t, 512, conf.ConnConfig.StatementCacheCapacity)
+
+	// Without capacity the cached modes execute unprepared queries
+	poolConfig.StatementCacheCapacity = 0
+	require.NoError(t, poolConfig.applyTo(conf))
+	assert.Equal(t, pgx.QueryExecModeExec, conf.ConnConfig.DefaultQueryExecMode)
+	assert.Zero(t, conf.ConnConfig.StatementCacheCapacity)
+
+	poolConfig.QueryExecMode = "prepare"
+	assert.Error(t, poolConfig.Validate())
+}
+
+func TestPoolConfig_WriteIsolation(t *testing.T) {
+	poolConfig := DefaultPoolConfig()
+	isoLevel, err := txIsoLevel(ports.IsolationLevel(poolConfig.WriteIsolation))
+	require.NoError(t, err)
+	assert.Equal(t, pgx.RepeatableRead, isoLevel)
+
+	poolConfig.WriteIsolation = "snapshot"
+	assert.Error(t, poolConfig.Validate())
+}
+
+func TestPoolConfig_RuntimeParams(t *testing.T) {
+	poolConfig := DefaultPoolConfig()
+	poolConfig.LockTimeout = 0
+	params := poolConfig.runtimeParams()
+	assert.Equal(t, "60000", params["statement_timeout"])
+	assert.Equal(t, "0", params["lock_timeout"], "zero disables the lock timeout")
+
+	poolConfig.TxTimeout = -time.Second
+	assert.Error(t, poolConfig.Validate())
+}
@@ -0,0 +1,121 @@
+package pg
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Quarantine is a PostgreSQL implementation of ports.Quarantine (table quarantined_resources)
+type Quarantine struct {
+	pool *pgxpool.Pool
+}
+
+var _ ports.Quarantine = &Quarantine{}
+
+// NewQuarantine creates a quarantine on top of the connection pool
+func NewQuarantine(pool *pgxpool.Pool) *Quarantine {
+	return &Quarantine{pool: pool}
+}
+
+// Quarantine returns the quarantine of resources imported into the registry
+func (r *Registry) Quarantine() *Quarantine {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return NewQuarantine(r.pool)
+}
+
+// Quarantine stores the resource. The partial unique index on named resources
+// turns a repeated import into an update of the entry.
+func (q *Quarantine) Quarantine(ctx context.Context, resource models.QuarantinedResource) (int64, error) {
+	reasons := resource.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+	var id int64
+	err := q.pool.QueryRow(ctx, `
+		INSERT INTO quarantined_resources (source, kind, namespace, name, reasons, document)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (source, kind, namespace, name) WHERE name <> '' DO UPDATE
+		SET reasons = EXCLUDED.reasons,
+		    document = EXCLUDED.document,
+		    occurrences = quarantined_resources.occurrences + 1,
+		    last_seen_at = NOW()
+		RETURNING id`,
+		resource.Source, resource.Kind, resource.Namespace, resource.Name, reasons, string(resource.Document)).Scan(&id)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to quarantine %s %s", resource.Kind, resource.Key())
+	}
+	return id, nil
+}
+
+// ListQuarantined returns quarantined resources
+func (q *Quarantine) ListQuarantined(ctx context.Context) ([]models.QuarantinedResource, error) {
+	rows, err := q.pool.Query(ctx, `
+		SELECT id, source, kind, namespace, name, reasons, document, occurrences, first_seen_at, last_seen_at
+		FROM quarantined_resources
+		ORDER BY id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list quarantined resources")
+	}
+	defer rows.Close()
+
+	var resources []models.QuarantinedResource
+	for rows.Next() {
+		resource, err := scanQuarantined(rows)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read quarantined resources")
+	}
+	return resources, nil
+}
+
+// GetQuarantined returns a quarantined resource by id
+func (q *Quarantine) GetQuarantined(ctx context.Context, id int64) (models.QuarantinedResource, error) {
+	row := q.pool.QueryRow(ctx, `
+		SELECT id, source, kind, namespace, name, reasons, document, occurrences, first_seen_at, last_seen_at
+		FROM quarantined_resources
+		WHERE id = $1`, id)
+	resource, err := scanQuarantined(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.QuarantinedResource{}, ports.ErrNotFound
+	}
+	return resource, err
+}
+
+// RemoveQuarantined removes a resource from the quarantine
+func (q *Quarantine) RemoveQuarantined(ctx context.Context, id int64) error {
+	tag, err := q.pool.Exec(ctx, `DELETE FROM quarantined_resources WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove quarantined resource %d", id)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrNotFound
+	}
+	return nil
+}
+
+func scanQuarantined(row pgx.Row) (models.QuarantinedResource, error) {
+	var (
+		resource models.QuarantinedResource
+		document string
+	)
+	if err := row.Scan(&resource.ID, &resource.Source, &resource.Kind, &resource.Namespace, &resource.Name,
+		&resource.Reasons, &document, &resource.Occurrences, &resource.FirstSeenAt, &resource.LastSeenAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resource, err
+		}
+		return resource, errors.Wrap(err, "failed to scan quarantined resource row")
+	}
+	resource.Document = []byte(document)
+	return resource, nil
+}
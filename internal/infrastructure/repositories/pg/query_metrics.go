@@ -0,0 +1,184 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"k8s.io/klog/v2"
+)
+
+// queryDurationBuckets are the upper bounds of the query duration histogram in seconds
+var queryDurationBuckets = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10}
+
+// queryStats are the totals of the queries of a statement
+type queryStats struct {
+	count   uint64
+	errors  uint64
+	rows    uint64
+	seconds float64
+	// buckets counts queries per duration bucket, the last one is +Inf
+	buckets []uint64
+}
+
+// queryTracer records durations and row counts of the queries of the registry pools per
+// statement and logs queries slower than slowThreshold. It is the pgx tracer of all
+// connections, so reads of the modular readers, writer statements and transaction control
+// are all measured.
+type queryTracer struct {
+	// slowThreshold is the duration from which queries are logged, zero disables the log
+	slowThreshold time.Duration
+
+	mu    sync.Mutex
+	stats map[string]*queryStats
+}
+
+var _ pgx.QueryTracer = (*queryTracer)(nil)
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	sql string
+	at  time.Time
+}
+
+func newQueryTracer(slowThreshold time.Duration) *queryTracer {
+	return &queryTracer{slowThreshold: slowThreshold, stats: make(map[string]*queryStats)}
+}
+
+// TraceQueryStart remembers the start of a query
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{sql: data.SQL, at: time.Now()})
+}
+
+// TraceQueryEnd records a finished query, for Query it is called when the rows are closed
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	t.record(start.sql, time.Since(start.at), data.CommandTag.RowsAffected(), data.Err)
+}
+
+func (t *queryTracer) record(sql string, duration time.Duration, rows int64, err error) {
+	statement := statementName(sql)
+
+	t.mu.Lock()
+	stats, exists := t.stats[statement]
+	if !exists {
+		stats = &queryStats{buckets: make([]uint64, len(queryDurationBuckets)+1)}
+		t.stats[statement] = stats
+	}
+	stats.count++
+	if err != nil {
+		stats.errors++
+	}
+	stats.rows += uint64(max(rows, 0))
+	stats.seconds += duration.Seconds()
+	stats.buckets[sort.SearchFloat64s(queryDurationBuckets, duration.Seconds())]++
+	t.mu.Unlock()
+
+	if t.slowThreshold > 0 && duration >= t.slowThreshold {
+		klog.Warningf("🐢 PG_SLOW_QUERY: %s took %s, %d rows (err: %v): %s",
+			statement, duration.Round(time.Millisecond), rows, err, compactSQL(sql, 300))
+	}
+}
+
+// WriteMetrics writes the query metrics in the Prometheus text format
+func (t *queryTracer) WriteMetrics(w io.Writer) error {
+	t.mu.Lock()
+	statements := make([]string, 0, len(t.stats))
+	snapshot := make(map[string]queryStats, len(t.stats))
+	for statement, stats := range t.stats {
+		statements = append(statements, statement)
+		copied := *stats
+		copied.buckets = append([]uint64(nil), stats.buckets...)
+		snapshot[statement] = copied
+	}
+	t.mu.Unlock()
+	sort.Strings(statements)
+
+	if _, err := fmt.Fprint(w, "# HELP netguard_pg_query_duration_seconds Duration of PostgreSQL queries\n# TYPE netguard_pg_query_duration_seconds histogram\n"); err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		stats := snapshot[statement]
+		var cumulative uint64
+		for i, bound := range queryDurationBuckets {
+			cumulative += stats.buckets[i]
+			if _, err := fmt.Fprintf(w, "netguard_pg_query_duration_seconds_bucket{statement=%q,le=\"%g\"} %d\n", statement, bound, cumulative); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "netguard_pg_query_duration_seconds_bucket{statement=%q,le=\"+Inf\"} %d\nnetguard_pg_query_duration_seconds_sum{statement=%q} %g\nnetguard_pg_query_duration_seconds_count{statement=%q} %d\n",
+			statement, stats.count, statement, stats.seconds, statement, stats.count); err != nil {
+			return err
+		}
+	}
+
+	counters := []struct {
+		name, help string
+		value      func(queryStats) uint64
+	}{
+		{"netguard_pg_query_rows_total", "Rows returned or affected by PostgreSQL queries", func(s queryStats) uint64 { return s.rows }},
+		{"netguard_pg_query_errors_total", "Failed PostgreSQL queries", func(s queryStats) uint64 { return s.errors }},
+	}
+	for _, counter := range counters {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", counter.name, counter.help, counter.name); err != nil {
+			return err
+		}
+		for _, statement := range statements {
+			if _, err := fmt.Fprintf(w, "%s{statement=%q} %d\n", counter.name, statement, counter.value(snapshot[statement])); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// statementName returns the label of a query: its command and the first table it reads or
+// writes, e.g. "select ie_ag_ag_rules". Arguments are never part of the label, so the
+// number of labels is bounded by the statements of the repository.
+func statementName(sql string) string {
+	fields := strings.Fields(strings.ToLower(sql))
+	if len(fields) == 0 {
+		return "unknown"
+	}
+	command := fields[0]
+	switch command {
+	case "select", "insert", "update", "delete", "with":
+	default:
+		return command
+	}
+
+	for i, field := range fields {
+		var table string
+		switch {
+		case command == "update" && i == 1:
+			table = field
+		case (field == "from" || field == "into") && i+1 < len(fields):
+			table = fields[i+1]
+		default:
+			continue
+		}
+		table = strings.Trim(table, "(),;")
+		if table != "" && table != "select" {
+			return command + " " + table
+		}
+	}
+	return command
+}
+
+// compactSQL collapses the whitespace of sql and cuts it to limit characters for the log
+func compactSQL(sql string, limit int) string {
+	compact := strings.Join(strings.Fields(sql), " ")
+	if len(compact) > limit {
+		return compact[:limit] + "..."
+	}
+	return compact
+}
@@ -0,0 +1,43 @@
+package pg
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementName(t *testing.T) {
+	tests := map[string]string{
+		"\n\t\tSELECT r.namespace, r.name FROM ie_ag_ag_rules r WHERE r.namespace = $1": "select ie_ag_ag_rules",
+		"INSERT INTO services (namespace, name) VALUES ($1, $2)":                        "insert services",
+		"UPDATE k8s_metadata SET conditions = $1":                                       "update k8s_metadata",
+		"DELETE FROM change_log WHERE created_at < $1":                                  "delete change_log",
+		"SELECT EXISTS (SELECT 1 FROM ieagag_rule_contribution_index)":                  "select ieagag_rule_contribution_index",
+		"SELECT pg_advisory_xact_lock($1)":                                              "select",
+		"begin isolation level repeatable read":                                         "begin",
+	}
+	for sql, want := range tests {
+		assert.Equal(t, want, statementName(sql), sql)
+	}
+}
+
+func TestQueryTracer_WriteMetrics(t *testing.T) {
+	tracer := newQueryTracer(0)
+	tracer.record("SELECT * FROM ie_ag_ag_rules", 3*time.Millisecond, 120, nil)
+	tracer.record("SELECT * FROM ie_ag_ag_rules", 2*time.Second, 80, nil)
+	tracer.record("DELETE FROM services WHERE namespace = $1", time.Millisecond, 0, errors.New("lock timeout"))
+
+	var buf bytes.Buffer
+	require.NoError(t, tracer.WriteMetrics(&buf))
+	metrics := buf.String()
+
+	assert.Contains(t, metrics, `netguard_pg_query_duration_seconds_bucket{statement="select ie_ag_ag_rules",le="0.005"} 1`)
+	assert.Contains(t, metrics, `netguard_pg_query_duration_seconds_bucket{statement="select ie_ag_ag_rules",le="5"} 2`)
+	assert.Contains(t, metrics, `netguard_pg_query_duration_seconds_count{statement="select ie_ag_ag_rules"} 2`)
+	assert.Contains(t, metrics, `netguard_pg_query_rows_total{statement="select ie_ag_ag_rules"} 200`)
+	assert.Contains(t, metrics, `netguard_pg_query_errors_total{statement="delete services"} 1`)
+}
@@ -158,3 +158,48 @@ func (r *reader) ListHostBindings(ctx context.Context, consume func(models.HostB
 func (r *reader) GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error) {
 	return r.modularReader.GetHostBindingByID(ctx, id)
 }
+
+// RuleS2SException methods - delegated to readers/rule_s2s_exception.go
+func (r *reader) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return r.modularReader.ListRuleS2SExceptions(ctx, consume, scope)
+}
+
+func (r *reader) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return r.modularReader.GetRuleS2SExceptionByID(ctx, id)
+}
+
+// NamespacePosture methods - delegated to readers/namespace_posture.go
+func (r *reader) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return r.modularReader.ListNamespacePostures(ctx, consume, scope)
+}
+
+func (r *reader) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return r.modularReader.GetNamespacePostureByID(ctx, id)
+}
+
+// IEAgAgRuleContribution methods - delegated to readers/ieagag_rule_contribution.go
+func (r *reader) ListIEAgAgRuleContributions(ctx context.Context, consume func(models.IEAgAgRuleContribution) error, ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) error {
+	return r.modularReader.ListIEAgAgRuleContributions(ctx, consume, ruleS2SIDs, ieAgAgRuleIDs)
+}
+
+func (r *reader) IEAgAgRuleContributionIndexBuilt(ctx context.Context) (bool, error) {
+	return r.modularReader.IEAgAgRuleContributionIndexBuilt(ctx)
+}
+
+// RuleTemplate methods - delegated to readers/rule_template.go
+func (r *reader) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return r.modularReader.ListRuleTemplates(ctx, consume, scope)
+}
+
+func (r *reader) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return r.modularReader.GetRuleTemplateByID(ctx, id)
+}
+
+// CrossNamespacePolicy methods - delegated to readers/cross_namespace_policy.go
+func (r *reader) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	return r.modularReader.ListCrossNamespacePolicies(ctx, consume, scope)
+}
+
+func (r *reader) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return r.modularReader.GetCrossNamespacePolicyByID(ctx, id)
+}
@@ -12,13 +12,18 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 // ListAddressGroups lists address groups with K8s metadata support
 func (r *Reader) ListAddressGroups(ctx context.Context, consume func(models.AddressGroup) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroup", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
-		SELECT ag.namespace, ag.name, ag.default_action, ag.logs, ag.trace, ag.description, ag.networks, ag.hosts, ag.aggregated_hosts,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+		SELECT ag.namespace, ag.name, ag.default_action, ag.logs, ag.trace, ag.description, ag.networks, ag.hosts, ag.aggregated_hosts, ag.included_groups,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_groups ag
 		INNER JOIN k8s_metadata m ON ag.resource_version = m.resource_version`
@@ -38,6 +43,7 @@ func (r *Reader) ListAddressGroups(ctx context.Context, consume func(models.Addr
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		addressGroup, err := r.scanAddressGroup(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan address group")
@@ -54,8 +60,8 @@ func (r *Reader) ListAddressGroups(ctx context.Context, consume func(models.Addr
 // GetAddressGroupByID gets an address group by ID
 func (r *Reader) GetAddressGroupByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
 	query := `
-		SELECT ag.namespace, ag.name, ag.default_action, ag.logs, ag.trace, ag.description, ag.networks, ag.hosts, ag.aggregated_hosts,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+		SELECT ag.namespace, ag.name, ag.default_action, ag.logs, ag.trace, ag.description, ag.networks, ag.hosts, ag.aggregated_hosts, ag.included_groups,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_groups ag
 		INNER JOIN k8s_metadata m ON ag.resource_version = m.resource_version
@@ -77,7 +83,10 @@ func (r *Reader) GetAddressGroupByID(ctx context.Context, id models.ResourceIden
 // scanAddressGroup scans an address group from pgx.Rows
 func (r *Reader) scanAddressGroup(rows pgx.Rows) (models.AddressGroup, error) {
 	var addressGroup models.AddressGroup
-	var labelsJSON, annotationsJSON, conditionsJSON, networksJSON, hostsJSON, aggregatedHostsJSON []byte
+	var labelsJSON, annotationsJSON, conditionsJSON, networksJSON, hostsJSON, aggregatedHostsJSON, includedGroupsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 	var description string
@@ -92,10 +101,14 @@ func (r *Reader) scanAddressGroup(rows pgx.Rows) (models.AddressGroup, error) {
 		&networksJSON,
 		&hostsJSON,
 		&aggregatedHostsJSON,
+		&includedGroupsJSON,
 		&resourceVersion,
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -122,8 +135,14 @@ func (r *Reader) scanAddressGroup(rows pgx.Rows) (models.AddressGroup, error) {
 		}
 	}
 
+	if len(includedGroupsJSON) > 0 {
+		if err := json.Unmarshal(includedGroupsJSON, &addressGroup.IncludedGroups); err != nil {
+			return addressGroup, errors.Wrap(err, "failed to unmarshal included_groups")
+		}
+	}
+
 	// Convert K8s metadata (convert int64 to string)
-	addressGroup.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	addressGroup.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return addressGroup, err
 	}
@@ -143,7 +162,10 @@ func (r *Reader) scanAddressGroup(rows pgx.Rows) (models.AddressGroup, error) {
 // scanAddressGroupRow scans an address group from pgx.Row
 func (r *Reader) scanAddressGroupRow(row pgx.Row) (*models.AddressGroup, error) {
 	var addressGroup models.AddressGroup
-	var labelsJSON, annotationsJSON, conditionsJSON, networksJSON, hostsJSON, aggregatedHostsJSON []byte
+	var labelsJSON, annotationsJSON, conditionsJSON, networksJSON, hostsJSON, aggregatedHostsJSON, includedGroupsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time
 	var resourceVersion int64
 	var description string
@@ -158,10 +180,14 @@ func (r *Reader) scanAddressGroupRow(row pgx.Row) (*models.AddressGroup, error)
 		&networksJSON,
 		&hostsJSON,
 		&aggregatedHostsJSON,
+		&includedGroupsJSON,
 		&resourceVersion,
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -188,8 +214,14 @@ func (r *Reader) scanAddressGroupRow(row pgx.Row) (*models.AddressGroup, error)
 		}
 	}
 
+	if len(includedGroupsJSON) > 0 {
+		if err := json.Unmarshal(includedGroupsJSON, &addressGroup.IncludedGroups); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal included_groups")
+		}
+	}
+
 	// Convert K8s metadata (convert int64 to string)
-	addressGroup.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	addressGroup.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
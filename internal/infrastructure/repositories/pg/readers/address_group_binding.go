@@ -12,14 +12,19 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 // ListAddressGroupBindings lists address group bindings with K8s metadata support
 func (r *Reader) ListAddressGroupBindings(ctx context.Context, consume func(models.AddressGroupBinding) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroupBinding", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT agb.namespace, agb.name, agb.service_namespace, agb.service_name,
 			   agb.address_group_namespace, agb.address_group_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_group_bindings agb
 		INNER JOIN k8s_metadata m ON agb.resource_version = m.resource_version`
@@ -51,6 +56,7 @@ func (r *Reader) ListAddressGroupBindings(ctx context.Context, consume func(mode
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		binding, err := r.scanAddressGroupBinding(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan address group binding")
@@ -69,7 +75,7 @@ func (r *Reader) GetAddressGroupBindingByID(ctx context.Context, id models.Resou
 	query := `
 		SELECT agb.namespace, agb.name, agb.service_namespace, agb.service_name,
 			   agb.address_group_namespace, agb.address_group_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_group_bindings agb
 		INNER JOIN k8s_metadata m ON agb.resource_version = m.resource_version
@@ -92,6 +98,9 @@ func (r *Reader) GetAddressGroupBindingByID(ctx context.Context, id models.Resou
 func (r *Reader) scanAddressGroupBinding(rows pgx.Rows) (models.AddressGroupBinding, error) {
 	var binding models.AddressGroupBinding
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 	var serviceNamespace, serviceName string
@@ -108,6 +117,9 @@ func (r *Reader) scanAddressGroupBinding(rows pgx.Rows) (models.AddressGroupBind
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -116,7 +128,7 @@ func (r *Reader) scanAddressGroupBinding(rows pgx.Rows) (models.AddressGroupBind
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	binding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	binding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return binding, err
 	}
@@ -135,6 +147,9 @@ func (r *Reader) scanAddressGroupBinding(rows pgx.Rows) (models.AddressGroupBind
 func (r *Reader) scanAddressGroupBindingRow(row pgx.Row) (*models.AddressGroupBinding, error) {
 	var binding models.AddressGroupBinding
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 	var serviceNamespace, serviceName string
@@ -151,6 +166,9 @@ func (r *Reader) scanAddressGroupBindingRow(row pgx.Row) (*models.AddressGroupBi
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -159,7 +177,7 @@ func (r *Reader) scanAddressGroupBindingRow(row pgx.Row) (*models.AddressGroupBi
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	binding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	binding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
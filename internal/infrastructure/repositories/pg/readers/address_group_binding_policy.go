@@ -12,13 +12,18 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 // ListAddressGroupBindingPolicies lists address group binding policies with K8s metadata support
 func (r *Reader) ListAddressGroupBindingPolicies(ctx context.Context, consume func(models.AddressGroupBindingPolicy) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroupBindingPolicy", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT agbp.namespace, agbp.name, agbp.address_group_ref, agbp.service_ref,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_group_binding_policies agbp
 		INNER JOIN k8s_metadata m ON agbp.resource_version = m.resource_version`
@@ -38,6 +43,7 @@ func (r *Reader) ListAddressGroupBindingPolicies(ctx context.Context, consume fu
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		policy, err := r.scanAddressGroupBindingPolicy(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan address group binding policy")
@@ -55,7 +61,7 @@ func (r *Reader) ListAddressGroupBindingPolicies(ctx context.Context, consume fu
 func (r *Reader) GetAddressGroupBindingPolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupBindingPolicy, error) {
 	query := `
 		SELECT agbp.namespace, agbp.name, agbp.address_group_ref, agbp.service_ref,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_group_binding_policies agbp
 		INNER JOIN k8s_metadata m ON agbp.resource_version = m.resource_version
@@ -78,6 +84,9 @@ func (r *Reader) GetAddressGroupBindingPolicyByID(ctx context.Context, id models
 func (r *Reader) scanAddressGroupBindingPolicy(rows pgx.Rows) (models.AddressGroupBindingPolicy, error) {
 	var policy models.AddressGroupBindingPolicy
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -94,6 +103,9 @@ func (r *Reader) scanAddressGroupBindingPolicy(rows pgx.Rows) (models.AddressGro
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -102,7 +114,7 @@ func (r *Reader) scanAddressGroupBindingPolicy(rows pgx.Rows) (models.AddressGro
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	policy.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	policy.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return policy, err
 	}
@@ -130,6 +142,9 @@ func (r *Reader) scanAddressGroupBindingPolicy(rows pgx.Rows) (models.AddressGro
 func (r *Reader) scanAddressGroupBindingPolicyRow(row pgx.Row) (*models.AddressGroupBindingPolicy, error) {
 	var policy models.AddressGroupBindingPolicy
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -146,6 +161,9 @@ func (r *Reader) scanAddressGroupBindingPolicyRow(row pgx.Row) (*models.AddressG
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -154,7 +172,7 @@ func (r *Reader) scanAddressGroupBindingPolicyRow(row pgx.Row) (*models.AddressG
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	policy.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	policy.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -11,13 +11,18 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 // ListAddressGroupPortMappings lists address group port mappings with K8s metadata support
 func (r *Reader) ListAddressGroupPortMappings(ctx context.Context, consume func(models.AddressGroupPortMapping) error, scope ports.Scope) error {
+	list := readstats.Begin("AddressGroupPortMapping", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT agpm.namespace, agpm.name, agpm.access_ports,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_group_port_mappings agpm
 		INNER JOIN k8s_metadata m ON agpm.resource_version = m.resource_version`
@@ -37,6 +42,7 @@ func (r *Reader) ListAddressGroupPortMappings(ctx context.Context, consume func(
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		mapping, err := r.scanAddressGroupPortMapping(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan address group port mapping")
@@ -54,7 +60,7 @@ func (r *Reader) ListAddressGroupPortMappings(ctx context.Context, consume func(
 func (r *Reader) GetAddressGroupPortMappingByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupPortMapping, error) {
 	query := `
 		SELECT agpm.namespace, agpm.name, agpm.access_ports,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM address_group_port_mappings agpm
 		INNER JOIN k8s_metadata m ON agpm.resource_version = m.resource_version
@@ -77,6 +83,9 @@ func (r *Reader) GetAddressGroupPortMappingByID(ctx context.Context, id models.R
 func (r *Reader) scanAddressGroupPortMapping(rows pgx.Rows) (models.AddressGroupPortMapping, error) {
 	var mapping models.AddressGroupPortMapping
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 	var accessPortsJSON []byte
@@ -89,6 +98,9 @@ func (r *Reader) scanAddressGroupPortMapping(rows pgx.Rows) (models.AddressGroup
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -103,7 +115,7 @@ func (r *Reader) scanAddressGroupPortMapping(rows pgx.Rows) (models.AddressGroup
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	mapping.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	mapping.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return mapping, err
 	}
@@ -118,6 +130,9 @@ func (r *Reader) scanAddressGroupPortMapping(rows pgx.Rows) (models.AddressGroup
 func (r *Reader) scanAddressGroupPortMappingRow(row pgx.Row) (*models.AddressGroupPortMapping, error) {
 	var mapping models.AddressGroupPortMapping
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 	var accessPortsJSON []byte
@@ -130,6 +145,9 @@ func (r *Reader) scanAddressGroupPortMappingRow(row pgx.Row) (*models.AddressGro
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -144,7 +162,7 @@ func (r *Reader) scanAddressGroupPortMappingRow(row pgx.Row) (*models.AddressGro
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	mapping.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	mapping.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
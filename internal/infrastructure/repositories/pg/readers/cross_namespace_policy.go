@@ -0,0 +1,112 @@
+package readers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
+)
+
+const crossNamespacePolicyColumns = `
+		SELECT p.namespace, p.name, p.allowed_namespaces,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
+		       m.created_at, m.updated_at
+		FROM cross_namespace_policies p
+		INNER JOIN k8s_metadata m ON p.resource_version = m.resource_version`
+
+// ListCrossNamespacePolicies lists cross namespace policies with K8s metadata support
+func (r *Reader) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	list := readstats.Begin("CrossNamespacePolicy", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	query := crossNamespacePolicyColumns
+
+	// Apply scope filtering
+	whereClause, args := utils.BuildScopeFilter(scope, "p")
+	if whereClause != "" {
+		query += " WHERE " + whereClause
+	}
+
+	query += " ORDER BY p.namespace, p.name"
+
+	rows, err := r.query(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to query cross namespace policies")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		list.Scan()
+		policy, err := r.scanCrossNamespacePolicy(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := consume(*policy); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetCrossNamespacePolicyByID gets a cross namespace policy by ID
+func (r *Reader) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	query := crossNamespacePolicyColumns + `
+		WHERE p.namespace = $1 AND p.name = $2`
+
+	policy, err := r.scanCrossNamespacePolicy(r.queryRow(ctx, query, id.Namespace, id.Name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// scanCrossNamespacePolicy scans a cross namespace policy from pgx.Row or pgx.Rows
+func (r *Reader) scanCrossNamespacePolicy(row pgx.Row) (*models.CrossNamespacePolicy, error) {
+	var policy models.CrossNamespacePolicy
+	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
+	var createdAt, updatedAt time.Time // Temporary variables for timestamps
+	var resourceVersion int64          // Scan as int64 from database
+
+	err := row.Scan(
+		&policy.Namespace,
+		&policy.Name,
+		&policy.AllowedNamespaces,
+		&resourceVersion,
+		&labelsJSON,
+		&annotationsJSON,
+		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan cross namespace policy row")
+	}
+
+	// Parse and set metadata
+	policy.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse cross namespace policy metadata")
+	}
+
+	return &policy, nil
+}
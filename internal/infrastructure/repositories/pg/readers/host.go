@@ -12,18 +12,23 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
 // ListHosts lists hosts with K8s metadata support
 func (r *Reader) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	list := readstats.Begin("Host", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT h.namespace, h.name, h.uuid,
 		       h.host_name_sync, h.address_group_name, h.is_bound,
 		       h.binding_ref_namespace, h.binding_ref_name,
 		       h.address_group_ref_namespace, h.address_group_ref_name,
 		       h.ip_list,
-		       m.resource_version, m.labels, m.annotations, m.conditions,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 		       m.created_at, m.updated_at
 		FROM hosts h
 		INNER JOIN k8s_metadata m ON h.resource_version = m.resource_version`
@@ -43,6 +48,7 @@ func (r *Reader) ListHosts(ctx context.Context, consume func(models.Host) error,
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		host, err := r.scanHost(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan host")
@@ -64,7 +70,7 @@ func (r *Reader) GetHostByID(ctx context.Context, id models.ResourceIdentifier)
 		       h.binding_ref_namespace, h.binding_ref_name,
 		       h.address_group_ref_namespace, h.address_group_ref_name,
 		       h.ip_list,
-		       m.resource_version, m.labels, m.annotations, m.conditions,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 		       m.created_at, m.updated_at
 		FROM hosts h
 		INNER JOIN k8s_metadata m ON h.resource_version = m.resource_version
@@ -87,6 +93,9 @@ func (r *Reader) GetHostByID(ctx context.Context, id models.ResourceIdentifier)
 func (r *Reader) scanHost(rows pgx.Rows) (models.Host, error) {
 	var host models.Host
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var ipListJSON []byte              // JSON field for ip_list
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
@@ -114,6 +123,9 @@ func (r *Reader) scanHost(rows pgx.Rows) (models.Host, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -161,7 +173,7 @@ func (r *Reader) scanHost(rows pgx.Rows) (models.Host, error) {
 	}
 
 	// Parse and set metadata
-	host.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	host.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return models.Host{}, errors.Wrap(err, "failed to parse host metadata")
 	}
@@ -173,6 +185,9 @@ func (r *Reader) scanHost(rows pgx.Rows) (models.Host, error) {
 func (r *Reader) scanHostRow(row pgx.Row) (*models.Host, error) {
 	var host models.Host
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var ipListJSON []byte              // JSON field for ip_list
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
@@ -200,6 +215,9 @@ func (r *Reader) scanHostRow(row pgx.Row) (*models.Host, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -248,7 +266,7 @@ func (r *Reader) scanHostRow(row pgx.Row) (*models.Host, error) {
 	}
 
 	// Parse and set metadata
-	host.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	host.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse host metadata")
 	}
@@ -11,16 +11,21 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
 // ListHostBindings lists host bindings with K8s metadata support
 func (r *Reader) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	list := readstats.Begin("HostBinding", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT hb.namespace, hb.name, 
 		       hb.host_namespace, hb.host_name,
 		       hb.address_group_namespace, hb.address_group_name,
-		       m.resource_version, m.labels, m.annotations, m.conditions,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 		       m.created_at, m.updated_at
 		FROM host_bindings hb
 		INNER JOIN k8s_metadata m ON hb.resource_version = m.resource_version`
@@ -40,6 +45,7 @@ func (r *Reader) ListHostBindings(ctx context.Context, consume func(models.HostB
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		hostBinding, err := r.scanHostBinding(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan host binding")
@@ -59,7 +65,7 @@ func (r *Reader) GetHostBindingByID(ctx context.Context, id models.ResourceIdent
 		SELECT hb.namespace, hb.name, 
 		       hb.host_namespace, hb.host_name,
 		       hb.address_group_namespace, hb.address_group_name,
-		       m.resource_version, m.labels, m.annotations, m.conditions,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 		       m.created_at, m.updated_at
 		FROM host_bindings hb
 		INNER JOIN k8s_metadata m ON hb.resource_version = m.resource_version
@@ -82,6 +88,9 @@ func (r *Reader) GetHostBindingByID(ctx context.Context, id models.ResourceIdent
 func (r *Reader) scanHostBinding(rows pgx.Rows) (models.HostBinding, error) {
 	var hostBinding models.HostBinding
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -100,6 +109,9 @@ func (r *Reader) scanHostBinding(rows pgx.Rows) (models.HostBinding, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -128,7 +140,7 @@ func (r *Reader) scanHostBinding(rows pgx.Rows) (models.HostBinding, error) {
 	}
 
 	// Parse and set metadata
-	hostBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	hostBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return models.HostBinding{}, errors.Wrap(err, "failed to parse host binding metadata")
 	}
@@ -140,6 +152,9 @@ func (r *Reader) scanHostBinding(rows pgx.Rows) (models.HostBinding, error) {
 func (r *Reader) scanHostBindingRow(row pgx.Row) (*models.HostBinding, error) {
 	var hostBinding models.HostBinding
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -158,6 +173,9 @@ func (r *Reader) scanHostBindingRow(row pgx.Row) (*models.HostBinding, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -186,7 +204,7 @@ func (r *Reader) scanHostBindingRow(row pgx.Row) (*models.HostBinding, error) {
 	}
 
 	// Parse and set metadata
-	hostBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	hostBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse host binding metadata")
 	}
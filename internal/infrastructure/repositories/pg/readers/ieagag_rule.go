@@ -12,17 +12,22 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
 // ListIEAgAgRules lists IEAgAgRule resources with K8s metadata support
 func (r *Reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAgRule) error, scope ports.Scope) error {
+	list := readstats.Begin("IEAgAgRule", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT ier.namespace, ier.name, ier.transport, ier.traffic, ier.action,
 		       ier.address_group_local_namespace, ier.address_group_local_name,
 		       ier.address_group_namespace, ier.address_group_name, ier.ports,
-		       ier.trace,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+		       ier.priority, ier.logs, ier.trace,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM ie_ag_ag_rules ier
 		INNER JOIN k8s_metadata m ON ier.resource_version = m.resource_version`
@@ -42,6 +47,7 @@ func (r *Reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAg
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		ieagagRule, err := r.scanIEAgAgRule(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan ieagag rule")
@@ -61,8 +67,8 @@ func (r *Reader) GetIEAgAgRuleByID(ctx context.Context, id models.ResourceIdenti
 		SELECT ier.namespace, ier.name, ier.transport, ier.traffic, ier.action,
 		       ier.address_group_local_namespace, ier.address_group_local_name,
 		       ier.address_group_namespace, ier.address_group_name, ier.ports,
-		       ier.trace,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+		       ier.priority, ier.logs, ier.trace,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM ie_ag_ag_rules ier
 		INNER JOIN k8s_metadata m ON ier.resource_version = m.resource_version
@@ -85,6 +91,9 @@ func (r *Reader) GetIEAgAgRuleByID(ctx context.Context, id models.ResourceIdenti
 func (r *Reader) scanIEAgAgRule(rows pgx.Rows) (models.IEAgAgRule, error) {
 	var ieagagRule models.IEAgAgRule
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -95,6 +104,8 @@ func (r *Reader) scanIEAgAgRule(rows pgx.Rows) (models.IEAgAgRule, error) {
 	var addressGroupLocalNamespace, addressGroupLocalName string // AddressGroupLocal fields
 	var addressGroupNamespace, addressGroupName string           // AddressGroup fields
 	var portsJSON []byte                                         // JSONB for array of PortSpec
+	var priority int32                                           // Priority field
+	var logs bool                                                // Logs field
 	var trace bool                                               // Trace field
 
 	err := rows.Scan(
@@ -108,11 +119,16 @@ func (r *Reader) scanIEAgAgRule(rows pgx.Rows) (models.IEAgAgRule, error) {
 		&addressGroupNamespace,
 		&addressGroupName,
 		&portsJSON,
+		&priority,
+		&logs,
 		&trace,
 		&resourceVersion,
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -121,7 +137,7 @@ func (r *Reader) scanIEAgAgRule(rows pgx.Rows) (models.IEAgAgRule, error) {
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	ieagagRule.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	ieagagRule.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return ieagagRule, err
 	}
@@ -129,10 +145,12 @@ func (r *Reader) scanIEAgAgRule(rows pgx.Rows) (models.IEAgAgRule, error) {
 	// Set SelfRef
 	ieagagRule.SelfRef = models.NewSelfRef(models.NewResourceIdentifier(ieagagRule.Name, models.WithNamespace(ieagagRule.Namespace)))
 
-	// Set enum fields and trace
+	// Set enum fields, priority, logs and trace
 	ieagagRule.Transport = models.TransportProtocol(transport)
 	ieagagRule.Traffic = models.Traffic(traffic)
 	ieagagRule.Action = models.RuleAction(action)
+	ieagagRule.Priority = priority
+	ieagagRule.Logs = logs
 	ieagagRule.Trace = trace
 
 	// Build NamespacedObjectReference from separate namespace/name columns
@@ -172,6 +190,9 @@ func (r *Reader) scanIEAgAgRule(rows pgx.Rows) (models.IEAgAgRule, error) {
 func (r *Reader) scanIEAgAgRuleRow(row pgx.Row) (*models.IEAgAgRule, error) {
 	var ieagagRule models.IEAgAgRule
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -182,6 +203,8 @@ func (r *Reader) scanIEAgAgRuleRow(row pgx.Row) (*models.IEAgAgRule, error) {
 	var addressGroupLocalNamespace, addressGroupLocalName string // AddressGroupLocal fields
 	var addressGroupNamespace, addressGroupName string           // AddressGroup fields
 	var portsJSON []byte                                         // JSONB for array of PortSpec
+	var priority int32                                           // Priority field
+	var logs bool                                                // Logs field
 	var trace bool                                               // Trace field
 
 	err := row.Scan(
@@ -195,11 +218,16 @@ func (r *Reader) scanIEAgAgRuleRow(row pgx.Row) (*models.IEAgAgRule, error) {
 		&addressGroupNamespace,
 		&addressGroupName,
 		&portsJSON,
+		&priority,
+		&logs,
 		&trace,
 		&resourceVersion,
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -208,7 +236,7 @@ func (r *Reader) scanIEAgAgRuleRow(row pgx.Row) (*models.IEAgAgRule, error) {
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	ieagagRule.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	ieagagRule.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -216,10 +244,12 @@ func (r *Reader) scanIEAgAgRuleRow(row pgx.Row) (*models.IEAgAgRule, error) {
 	// Set SelfRef
 	ieagagRule.SelfRef = models.NewSelfRef(models.NewResourceIdentifier(ieagagRule.Name, models.WithNamespace(ieagagRule.Namespace)))
 
-	// Set enum fields and trace
+	// Set enum fields, priority, logs and trace
 	ieagagRule.Transport = models.TransportProtocol(transport)
 	ieagagRule.Traffic = models.Traffic(traffic)
 	ieagagRule.Action = models.RuleAction(action)
+	ieagagRule.Priority = priority
+	ieagagRule.Logs = logs
 	ieagagRule.Trace = trace
 
 	// Build NamespacedObjectReference from separate namespace/name columns
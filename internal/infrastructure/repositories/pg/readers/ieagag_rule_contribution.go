@@ -0,0 +1,68 @@
+package readers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+)
+
+// ListIEAgAgRuleContributions lists the aggregation index of IEAgAg rules for the given RuleS2S and IEAgAg rules
+func (r *Reader) ListIEAgAgRuleContributions(ctx context.Context, consume func(models.IEAgAgRuleContribution) error, ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) error {
+	query := `
+		SELECT rule_s2s_namespace, rule_s2s_name, ieagag_rule_namespace, ieagag_rule_name, ports
+		FROM ieagag_rule_contributions`
+
+	var conditions []string
+	var args []interface{}
+	if len(ruleS2SIDs) > 0 {
+		namespaces, names := utils.SplitIdentifiers(ruleS2SIDs)
+		args = append(args, namespaces, names)
+		conditions = append(conditions, fmt.Sprintf(
+			"(rule_s2s_namespace, rule_s2s_name) IN (SELECT * FROM unnest($%d::text[], $%d::text[]))", len(args)-1, len(args)))
+	}
+	if len(ieAgAgRuleIDs) > 0 {
+		namespaces, names := utils.SplitIdentifiers(ieAgAgRuleIDs)
+		args = append(args, namespaces, names)
+		conditions = append(conditions, fmt.Sprintf(
+			"(ieagag_rule_namespace, ieagag_rule_name) IN (SELECT * FROM unnest($%d::text[], $%d::text[]))", len(args)-1, len(args)))
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " OR ")
+	}
+
+	rows, err := r.query(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to query IEAgAg rule contributions")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contribution models.IEAgAgRuleContribution
+		if err := rows.Scan(
+			&contribution.RuleS2S.Namespace, &contribution.RuleS2S.Name,
+			&contribution.IEAgAgRule.Namespace, &contribution.IEAgAgRule.Name,
+			&contribution.Ports,
+		); err != nil {
+			return errors.Wrap(err, "failed to scan IEAgAg rule contribution")
+		}
+		if err := consume(contribution); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// IEAgAgRuleContributionIndexBuilt reports whether the aggregation index was built from all RuleS2S
+func (r *Reader) IEAgAgRuleContributionIndexBuilt(ctx context.Context) (bool, error) {
+	var built bool
+	if err := r.queryRow(ctx, `SELECT EXISTS (SELECT 1 FROM ieagag_rule_contribution_index)`).Scan(&built); err != nil {
+		return false, errors.Wrap(err, "failed to check IEAgAg rule contribution index")
+	}
+	return built, nil
+}
@@ -0,0 +1,104 @@
+package readers_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/readers"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/writers"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// beginTestTx opens a transaction on the migrated database of TEST_PG_URI, it is rolled back
+// after the test. The test is skipped when the variable is not set.
+func beginTestTx(t *testing.T) (context.Context, pgx.Tx) {
+	uri := os.Getenv("TEST_PG_URI")
+	if uri == "" {
+		t.Skip("TEST_PG_URI not set, skipping PostgreSQL tests")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	pool, err := pgxpool.New(ctx, uri)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	tx, err := pool.Begin(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tx.Rollback(context.Background()) })
+	return ctx, tx
+}
+
+func addressGroupRef(name string) v1beta1.NamespacedObjectReference {
+	return v1beta1.NamespacedObjectReference{
+		ObjectReference: v1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "AddressGroup", Name: name},
+		Namespace:       "roundtrip",
+	}
+}
+
+func TestIEAgAgRule_WriterReaderRoundtrip(t *testing.T) {
+	ctx, tx := beginTestTx(t)
+	writer := writers.NewWriter(nil, tx, ctx)
+	reader := readers.NewReader(nil, nil, tx, ctx)
+
+	groups := []models.AddressGroup{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("roundtrip"))), DefaultAction: models.ActionAccept},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("db", models.WithNamespace("roundtrip"))), DefaultAction: models.ActionAccept},
+	}
+	require.NoError(t, writer.SyncAddressGroups(ctx, groups, ports.EmptyScope{}))
+
+	rules := []models.IEAgAgRule{
+		{
+			SelfRef:           models.NewSelfRef(models.NewResourceIdentifier("deny", models.WithNamespace("roundtrip"))),
+			Transport:         models.TCP,
+			Traffic:           models.INGRESS,
+			AddressGroupLocal: addressGroupRef("db"),
+			AddressGroup:      addressGroupRef("web"),
+			Ports:             []models.PortSpec{{Destination: "5432"}},
+			Action:            models.ActionDrop,
+			Logs:              true,
+			Priority:          models.RulePriorityDrop,
+		},
+		{
+			SelfRef:           models.NewSelfRef(models.NewResourceIdentifier("allow", models.WithNamespace("roundtrip"))),
+			Transport:         models.TCP,
+			Traffic:           models.INGRESS,
+			AddressGroupLocal: addressGroupRef("db"),
+			AddressGroup:      addressGroupRef("web"),
+			Ports:             []models.PortSpec{{Destination: "5432"}},
+			Action:            models.ActionAccept,
+			Trace:             true,
+			Priority:          10,
+		},
+	}
+	require.NoError(t, writer.SyncIEAgAgRules(ctx, rules, ports.EmptyScope{}))
+
+	deny, err := reader.GetIEAgAgRuleByID(ctx, rules[0].ResourceIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, models.ActionDrop, deny.Action)
+	assert.Equal(t, models.RulePriorityDrop, deny.Priority)
+	assert.True(t, deny.Logs)
+	assert.False(t, deny.Trace)
+
+	listed := map[string]models.IEAgAgRule{}
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		listed[rule.Name] = rule
+		return nil
+	}, ports.NewResourceIdentifierScope(models.NewResourceIdentifier("", models.WithNamespace("roundtrip"))))
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.EqualValues(t, 10, listed["allow"].Priority)
+	assert.False(t, listed["allow"].Logs)
+	assert.True(t, listed["allow"].Trace)
+	assert.Equal(t, models.RulePriorityDrop, listed["deny"].Priority)
+}
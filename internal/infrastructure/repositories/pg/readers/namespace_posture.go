@@ -0,0 +1,115 @@
+package readers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
+)
+
+const namespacePostureColumns = `
+		SELECT p.namespace, p.name, p.mode,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
+		       m.created_at, m.updated_at
+		FROM namespace_postures p
+		INNER JOIN k8s_metadata m ON p.resource_version = m.resource_version`
+
+// ListNamespacePostures lists namespace postures with K8s metadata support
+func (r *Reader) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	list := readstats.Begin("NamespacePosture", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	query := namespacePostureColumns
+
+	// Apply scope filtering
+	whereClause, args := utils.BuildScopeFilter(scope, "p")
+	if whereClause != "" {
+		query += " WHERE " + whereClause
+	}
+
+	query += " ORDER BY p.namespace, p.name"
+
+	rows, err := r.query(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to query namespace postures")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		list.Scan()
+		posture, err := r.scanNamespacePosture(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := consume(*posture); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetNamespacePostureByID gets a namespace posture by ID
+func (r *Reader) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	query := namespacePostureColumns + `
+		WHERE p.namespace = $1 AND p.name = $2`
+
+	posture, err := r.scanNamespacePosture(r.queryRow(ctx, query, id.Namespace, id.Name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return posture, nil
+}
+
+// scanNamespacePosture scans a namespace posture from pgx.Row or pgx.Rows
+func (r *Reader) scanNamespacePosture(row pgx.Row) (*models.NamespacePosture, error) {
+	var posture models.NamespacePosture
+	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
+	var createdAt, updatedAt time.Time // Temporary variables for timestamps
+	var resourceVersion int64          // Scan as int64 from database
+	var mode string
+
+	err := row.Scan(
+		&posture.Namespace,
+		&posture.Name,
+		&mode,
+		&resourceVersion,
+		&labelsJSON,
+		&annotationsJSON,
+		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan namespace posture row")
+	}
+
+	posture.Mode = models.NamespacePostureMode(mode)
+
+	// Parse and set metadata
+	posture.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse namespace posture metadata")
+	}
+
+	return &posture, nil
+}
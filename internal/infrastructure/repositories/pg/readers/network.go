@@ -11,16 +11,21 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
 // ListNetworks lists networks with K8s metadata support
 func (r *Reader) ListNetworks(ctx context.Context, consume func(models.Network) error, scope ports.Scope) error {
+	list := readstats.Begin("Network", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT n.namespace, n.name, n.cidr::text, n.network_items, n.is_bound,
 		       n.binding_ref_namespace, n.binding_ref_name,
 		       n.address_group_ref_namespace, n.address_group_ref_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM networks n
 		INNER JOIN k8s_metadata m ON n.resource_version = m.resource_version`
@@ -40,6 +45,7 @@ func (r *Reader) ListNetworks(ctx context.Context, consume func(models.Network)
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		network, err := r.scanNetwork(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan network")
@@ -59,7 +65,7 @@ func (r *Reader) GetNetworkByID(ctx context.Context, id models.ResourceIdentifie
 		SELECT n.namespace, n.name, n.cidr::text, n.network_items, n.is_bound,
 		       n.binding_ref_namespace, n.binding_ref_name,
 		       n.address_group_ref_namespace, n.address_group_ref_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM networks n
 		INNER JOIN k8s_metadata m ON n.resource_version = m.resource_version
@@ -82,6 +88,9 @@ func (r *Reader) GetNetworkByID(ctx context.Context, id models.ResourceIdentifie
 func (r *Reader) scanNetwork(rows pgx.Rows) (models.Network, error) {
 	var network models.Network
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -106,6 +115,9 @@ func (r *Reader) scanNetwork(rows pgx.Rows) (models.Network, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -114,7 +126,7 @@ func (r *Reader) scanNetwork(rows pgx.Rows) (models.Network, error) {
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	network.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	network.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return network, err
 	}
@@ -152,6 +164,9 @@ func (r *Reader) scanNetwork(rows pgx.Rows) (models.Network, error) {
 func (r *Reader) scanNetworkRow(row pgx.Row) (*models.Network, error) {
 	var network models.Network
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -176,6 +191,9 @@ func (r *Reader) scanNetworkRow(row pgx.Row) (*models.Network, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -184,7 +202,7 @@ func (r *Reader) scanNetworkRow(row pgx.Row) (*models.Network, error) {
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	network.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	network.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +242,7 @@ func (r *Reader) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Net
 		SELECT n.namespace, n.name, n.cidr::text, n.network_items, n.is_bound,
 		       n.binding_ref_namespace, n.binding_ref_name,
 		       n.address_group_ref_namespace, n.address_group_ref_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM networks n
 		INNER JOIN k8s_metadata m ON n.resource_version = m.resource_version
@@ -11,16 +11,21 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
 // ListNetworkBindings lists network bindings with K8s metadata support
 func (r *Reader) ListNetworkBindings(ctx context.Context, consume func(models.NetworkBinding) error, scope ports.Scope) error {
+	list := readstats.Begin("NetworkBinding", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT nb.namespace, nb.name,
 		       nb.network_namespace, nb.network_name,
 		       nb.address_group_namespace, nb.address_group_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM network_bindings nb
 		INNER JOIN k8s_metadata m ON nb.resource_version = m.resource_version`
@@ -40,6 +45,7 @@ func (r *Reader) ListNetworkBindings(ctx context.Context, consume func(models.Ne
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		networkBinding, err := r.scanNetworkBinding(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan network binding")
@@ -59,7 +65,7 @@ func (r *Reader) GetNetworkBindingByID(ctx context.Context, id models.ResourceId
 		SELECT nb.namespace, nb.name,
 		       nb.network_namespace, nb.network_name,
 		       nb.address_group_namespace, nb.address_group_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM network_bindings nb
 		INNER JOIN k8s_metadata m ON nb.resource_version = m.resource_version
@@ -82,6 +88,9 @@ func (r *Reader) GetNetworkBindingByID(ctx context.Context, id models.ResourceId
 func (r *Reader) scanNetworkBinding(rows pgx.Rows) (models.NetworkBinding, error) {
 	var networkBinding models.NetworkBinding
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -100,6 +109,9 @@ func (r *Reader) scanNetworkBinding(rows pgx.Rows) (models.NetworkBinding, error
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -108,7 +120,7 @@ func (r *Reader) scanNetworkBinding(rows pgx.Rows) (models.NetworkBinding, error
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	networkBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	networkBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return networkBinding, err
 	}
@@ -140,6 +152,9 @@ func (r *Reader) scanNetworkBinding(rows pgx.Rows) (models.NetworkBinding, error
 func (r *Reader) scanNetworkBindingRow(row pgx.Row) (*models.NetworkBinding, error) {
 	var networkBinding models.NetworkBinding
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -158,6 +173,9 @@ func (r *Reader) scanNetworkBindingRow(row pgx.Row) (*models.NetworkBinding, err
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -166,7 +184,7 @@ func (r *Reader) scanNetworkBindingRow(row pgx.Row) (*models.NetworkBinding, err
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	networkBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	networkBinding.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
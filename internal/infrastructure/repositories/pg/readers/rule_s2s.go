@@ -12,14 +12,19 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 // ListRuleS2S lists RuleS2S resources with K8s metadata support
 func (r *Reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) error, scope ports.Scope) error {
+	list := readstats.Begin("RuleS2S", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT rs.namespace, rs.name, rs.traffic,
-		       rs.service_local_ref, rs.service_ref, rs.ieagag_rule_refs, rs.trace,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+		       rs.service_local_ref, rs.service_ref, rs.ieagag_rule_refs, rs.trace, rs.action, rs.ports_source, rs.extra_ports, rs.valid_from, rs.valid_until, rs.priority,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM rule_s2s rs
 		INNER JOIN k8s_metadata m ON rs.resource_version = m.resource_version`
@@ -39,6 +44,7 @@ func (r *Reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) e
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		ruleS2S, err := r.scanRuleS2S(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan rule s2s")
@@ -56,8 +62,8 @@ func (r *Reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) e
 func (r *Reader) GetRuleS2SByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2S, error) {
 	query := `
 		SELECT rs.namespace, rs.name, rs.traffic,
-		       rs.service_local_ref, rs.service_ref, rs.ieagag_rule_refs, rs.trace,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+		       rs.service_local_ref, rs.service_ref, rs.ieagag_rule_refs, rs.trace, rs.action, rs.ports_source, rs.extra_ports, rs.valid_from, rs.valid_until, rs.priority,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM rule_s2s rs
 		INNER JOIN k8s_metadata m ON rs.resource_version = m.resource_version
@@ -80,6 +86,9 @@ func (r *Reader) GetRuleS2SByID(ctx context.Context, id models.ResourceIdentifie
 func (r *Reader) scanRuleS2S(rows pgx.Rows) (models.RuleS2S, error) {
 	var ruleS2S models.RuleS2S
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -88,6 +97,11 @@ func (r *Reader) scanRuleS2S(rows pgx.Rows) (models.RuleS2S, error) {
 	var serviceLocalRefJSON, serviceRefJSON []byte // JSONB columns
 	var ieagagRuleRefsJSON []byte                  // IEAgAg rule refs array
 	var trace bool                                 // Trace field
+	var action string                              // Rule action enum as string
+	var portsSource string                         // Ports source enum as string
+	var extraPortsJSON []byte                      // Extra ports JSONB
+	var validFrom, validUntil *time.Time           // Nullable validity window
+	var priority int32                             // Explicit priority, 0 - calculated
 
 	err := rows.Scan(
 		&ruleS2S.Namespace,
@@ -97,10 +111,19 @@ func (r *Reader) scanRuleS2S(rows pgx.Rows) (models.RuleS2S, error) {
 		&serviceRefJSON,
 		&ieagagRuleRefsJSON,
 		&trace,
+		&action,
+		&portsSource,
+		&extraPortsJSON,
+		&validFrom,
+		&validUntil,
+		&priority,
 		&resourceVersion,
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -109,7 +132,7 @@ func (r *Reader) scanRuleS2S(rows pgx.Rows) (models.RuleS2S, error) {
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	ruleS2S.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	ruleS2S.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return ruleS2S, err
 	}
@@ -117,6 +140,20 @@ func (r *Reader) scanRuleS2S(rows pgx.Rows) (models.RuleS2S, error) {
 	ruleS2S.SelfRef = models.NewSelfRef(models.NewResourceIdentifier(ruleS2S.Name, models.WithNamespace(ruleS2S.Namespace)))
 	ruleS2S.Traffic = models.Traffic(traffic)
 	ruleS2S.Trace = trace
+	ruleS2S.Action = models.RuleAction(action)
+	ruleS2S.PortsSource = models.PortsSource(portsSource)
+	ruleS2S.Priority = priority
+	if validFrom != nil {
+		ruleS2S.ValidFrom = *validFrom
+	}
+	if validUntil != nil {
+		ruleS2S.ValidUntil = *validUntil
+	}
+
+	ruleS2S.ExtraPorts, err = utils.ParseIngressPorts(extraPortsJSON)
+	if err != nil {
+		return ruleS2S, errors.Wrap(err, "failed to parse extra_ports")
+	}
 
 	// Unmarshal JSONB ObjectReferences
 	if len(serviceLocalRefJSON) > 0 {
@@ -145,6 +182,9 @@ func (r *Reader) scanRuleS2S(rows pgx.Rows) (models.RuleS2S, error) {
 func (r *Reader) scanRuleS2SRow(row pgx.Row) (*models.RuleS2S, error) {
 	var ruleS2S models.RuleS2S
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -153,6 +193,11 @@ func (r *Reader) scanRuleS2SRow(row pgx.Row) (*models.RuleS2S, error) {
 	var serviceLocalRefJSON, serviceRefJSON []byte // JSONB columns
 	var ieagagRuleRefsJSON []byte                  // IEAgAg rule refs array
 	var trace bool                                 // Trace field
+	var action string                              // Rule action enum as string
+	var portsSource string                         // Ports source enum as string
+	var extraPortsJSON []byte                      // Extra ports JSONB
+	var validFrom, validUntil *time.Time           // Nullable validity window
+	var priority int32                             // Explicit priority, 0 - calculated
 
 	err := row.Scan(
 		&ruleS2S.Namespace,
@@ -162,10 +207,19 @@ func (r *Reader) scanRuleS2SRow(row pgx.Row) (*models.RuleS2S, error) {
 		&serviceRefJSON,
 		&ieagagRuleRefsJSON,
 		&trace,
+		&action,
+		&portsSource,
+		&extraPortsJSON,
+		&validFrom,
+		&validUntil,
+		&priority,
 		&resourceVersion,
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -174,7 +228,7 @@ func (r *Reader) scanRuleS2SRow(row pgx.Row) (*models.RuleS2S, error) {
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	ruleS2S.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	ruleS2S.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +236,20 @@ func (r *Reader) scanRuleS2SRow(row pgx.Row) (*models.RuleS2S, error) {
 	ruleS2S.SelfRef = models.NewSelfRef(models.NewResourceIdentifier(ruleS2S.Name, models.WithNamespace(ruleS2S.Namespace)))
 	ruleS2S.Traffic = models.Traffic(traffic)
 	ruleS2S.Trace = trace
+	ruleS2S.Action = models.RuleAction(action)
+	ruleS2S.PortsSource = models.PortsSource(portsSource)
+	ruleS2S.Priority = priority
+	if validFrom != nil {
+		ruleS2S.ValidFrom = *validFrom
+	}
+	if validUntil != nil {
+		ruleS2S.ValidUntil = *validUntil
+	}
+
+	ruleS2S.ExtraPorts, err = utils.ParseIngressPorts(extraPortsJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse extra_ports")
+	}
 
 	// Unmarshal JSONB ObjectReferences
 	if len(serviceLocalRefJSON) > 0 {
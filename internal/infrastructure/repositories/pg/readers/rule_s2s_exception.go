@@ -0,0 +1,141 @@
+package readers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+const ruleS2SExceptionColumns = `
+		SELECT e.namespace, e.name, e.traffic,
+		       e.address_group_local_namespace, e.address_group_local_name,
+		       e.address_group_namespace, e.address_group_name,
+		       e.transport, e.ports,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
+		       m.created_at, m.updated_at
+		FROM rule_s2s_exceptions e
+		INNER JOIN k8s_metadata m ON e.resource_version = m.resource_version`
+
+// ListRuleS2SExceptions lists RuleS2S exceptions with K8s metadata support
+func (r *Reader) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	list := readstats.Begin("RuleS2SException", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	query := ruleS2SExceptionColumns
+
+	// Apply scope filtering
+	whereClause, args := utils.BuildScopeFilter(scope, "e")
+	if whereClause != "" {
+		query += " WHERE " + whereClause
+	}
+
+	query += " ORDER BY e.namespace, e.name"
+
+	rows, err := r.query(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to query rule s2s exceptions")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		list.Scan()
+		exception, err := r.scanRuleS2SException(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := consume(*exception); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetRuleS2SExceptionByID gets a RuleS2S exception by ID
+func (r *Reader) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	query := ruleS2SExceptionColumns + `
+		WHERE e.namespace = $1 AND e.name = $2`
+
+	exception, err := r.scanRuleS2SException(r.queryRow(ctx, query, id.Namespace, id.Name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return exception, nil
+}
+
+// scanRuleS2SException scans a RuleS2S exception from pgx.Row or pgx.Rows
+func (r *Reader) scanRuleS2SException(row pgx.Row) (*models.RuleS2SException, error) {
+	var exception models.RuleS2SException
+	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
+	var createdAt, updatedAt time.Time // Temporary variables for timestamps
+	var resourceVersion int64          // Scan as int64 from database
+	var traffic, transport string
+	var localAGNamespace, localAGName, targetAGNamespace, targetAGName string
+
+	err := row.Scan(
+		&exception.Namespace,
+		&exception.Name,
+		&traffic,
+		&localAGNamespace,
+		&localAGName,
+		&targetAGNamespace,
+		&targetAGName,
+		&transport,
+		&exception.Ports,
+		&resourceVersion,
+		&labelsJSON,
+		&annotationsJSON,
+		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan rule s2s exception row")
+	}
+
+	exception.Traffic = models.Traffic(traffic)
+	exception.Transport = models.TransportProtocol(transport)
+	exception.AddressGroupLocal = addressGroupRef(localAGNamespace, localAGName)
+	exception.AddressGroup = addressGroupRef(targetAGNamespace, targetAGName)
+
+	// Parse and set metadata
+	exception.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse rule s2s exception metadata")
+	}
+
+	return &exception, nil
+}
+
+// addressGroupRef builds an AddressGroup reference from its namespace and name
+func addressGroupRef(namespace, name string) models.AddressGroupRef {
+	return v1beta1.NamespacedObjectReference{
+		ObjectReference: v1beta1.ObjectReference{
+			APIVersion: "netguard.sgroups.io/v1beta1",
+			Kind:       "AddressGroup",
+			Name:       name,
+		},
+		Namespace: namespace,
+	}
+}
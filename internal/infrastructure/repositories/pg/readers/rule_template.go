@@ -0,0 +1,130 @@
+package readers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
+)
+
+const ruleTemplateColumns = `
+		SELECT t.namespace, t.name, t.traffic,
+		       t.local_service_selector, t.target_service_selector,
+		       t.action, t.trace,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
+		       m.created_at, m.updated_at
+		FROM rule_templates t
+		INNER JOIN k8s_metadata m ON t.resource_version = m.resource_version`
+
+// ListRuleTemplates lists rule templates with K8s metadata support
+func (r *Reader) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	list := readstats.Begin("RuleTemplate", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
+	query := ruleTemplateColumns
+
+	// Apply scope filtering
+	whereClause, args := utils.BuildScopeFilter(scope, "t")
+	if whereClause != "" {
+		query += " WHERE " + whereClause
+	}
+
+	query += " ORDER BY t.namespace, t.name"
+
+	rows, err := r.query(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to query rule templates")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		list.Scan()
+		template, err := r.scanRuleTemplate(rows)
+		if err != nil {
+			return err
+		}
+
+		if err := consume(*template); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetRuleTemplateByID gets a rule template by ID
+func (r *Reader) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	query := ruleTemplateColumns + `
+		WHERE t.namespace = $1 AND t.name = $2`
+
+	template, err := r.scanRuleTemplate(r.queryRow(ctx, query, id.Namespace, id.Name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ports.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// scanRuleTemplate scans a rule template from pgx.Row or pgx.Rows
+func (r *Reader) scanRuleTemplate(row pgx.Row) (*models.RuleTemplate, error) {
+	var template models.RuleTemplate
+	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
+	var localSelectorJSON, targetSelectorJSON []byte
+	var createdAt, updatedAt time.Time // Temporary variables for timestamps
+	var resourceVersion int64          // Scan as int64 from database
+	var traffic, action string
+
+	err := row.Scan(
+		&template.Namespace,
+		&template.Name,
+		&traffic,
+		&localSelectorJSON,
+		&targetSelectorJSON,
+		&action,
+		&template.Trace,
+		&resourceVersion,
+		&labelsJSON,
+		&annotationsJSON,
+		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to scan rule template row")
+	}
+
+	template.Traffic = models.Traffic(traffic)
+	template.Action = models.RuleAction(action)
+	if err := json.Unmarshal(localSelectorJSON, &template.LocalServiceSelector); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal local service selector")
+	}
+	if err := json.Unmarshal(targetSelectorJSON, &template.TargetServiceSelector); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal target service selector")
+	}
+
+	// Parse and set metadata
+	template.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse rule template metadata")
+	}
+
+	return &template, nil
+}
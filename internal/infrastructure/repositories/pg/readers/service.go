@@ -13,6 +13,7 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 // addressGroupRefJSON is an intermediate structure for JSONB unmarshaling
@@ -32,10 +33,14 @@ type aggregatedAddressGroupRefJSON struct {
 
 // ListServices lists services with K8s metadata support and relationship loading
 func (r *Reader) ListServices(ctx context.Context, consume func(models.Service) error, scope ports.Scope) error {
+	list := readstats.Begin("Service", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT s.namespace, s.name, s.description, s.ingress_ports,
 		       s.address_groups, s.aggregated_address_groups,
-		       m.resource_version, m.labels, m.annotations, m.conditions,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 		       m.created_at, m.updated_at
 		FROM services s
 		INNER JOIN k8s_metadata m ON s.resource_version = m.resource_version`
@@ -67,6 +72,7 @@ func (r *Reader) ListServices(ctx context.Context, consume func(models.Service)
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		service, err := r.scanService(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan service")
@@ -85,7 +91,7 @@ func (r *Reader) GetServiceByID(ctx context.Context, id models.ResourceIdentifie
 	query := `
 		SELECT s.namespace, s.name, s.description, s.ingress_ports,
 		       s.address_groups, s.aggregated_address_groups,
-		       m.resource_version, m.labels, m.annotations, m.conditions,
+		       m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 		       m.created_at, m.updated_at
 		FROM services s
 		INNER JOIN k8s_metadata m ON s.resource_version = m.resource_version
@@ -122,6 +128,9 @@ func (r *Reader) scanService(rows pgx.Rows) (models.Service, error) {
 	var addressGroupsJSON, aggregatedAddressGroupsJSON []byte
 	var ingressPortsJSON []byte
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -136,6 +145,9 @@ func (r *Reader) scanService(rows pgx.Rows) (models.Service, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -181,8 +193,8 @@ func (r *Reader) scanService(rows pgx.Rows) (models.Service, error) {
 		}
 	}
 
-	// Convert K8s metadata (convert int64 to string) - skip finalizers for now
-	service.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	// Convert K8s metadata (convert int64 to string)
+	service.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return service, err
 	}
@@ -199,6 +211,9 @@ func (r *Reader) scanServiceRow(row pgx.Row) (*models.Service, error) {
 	var addressGroupsJSON, aggregatedAddressGroupsJSON []byte
 	var ingressPortsJSON []byte
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 
@@ -213,6 +228,9 @@ func (r *Reader) scanServiceRow(row pgx.Row) (*models.Service, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -256,8 +274,8 @@ func (r *Reader) scanServiceRow(row pgx.Row) (*models.Service, error) {
 		}
 	}
 
-	// Convert K8s metadata (convert int64 to string) - skip finalizers for now
-	service.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	// Convert K8s metadata (convert int64 to string)
+	service.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
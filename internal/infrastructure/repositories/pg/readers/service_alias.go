@@ -11,13 +11,18 @@ import (
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+	"netguard-pg-backend/internal/infrastructure/repositories/readstats"
 )
 
 // ListServiceAliases lists service aliases with K8s metadata support
 func (r *Reader) ListServiceAliases(ctx context.Context, consume func(models.ServiceAlias) error, scope ports.Scope) error {
+	list := readstats.Begin("ServiceAlias", scope)
+	defer list.End()
+	consume = readstats.Returned(list, consume)
+
 	query := `
 		SELECT sa.namespace, sa.name, sa.service_namespace, sa.service_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM service_aliases sa
 		INNER JOIN k8s_metadata m ON sa.resource_version = m.resource_version`
@@ -37,6 +42,7 @@ func (r *Reader) ListServiceAliases(ctx context.Context, consume func(models.Ser
 	defer rows.Close()
 
 	for rows.Next() {
+		list.Scan()
 		serviceAlias, err := r.scanServiceAlias(rows)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan service alias")
@@ -54,7 +60,7 @@ func (r *Reader) ListServiceAliases(ctx context.Context, consume func(models.Ser
 func (r *Reader) GetServiceAliasByID(ctx context.Context, id models.ResourceIdentifier) (*models.ServiceAlias, error) {
 	query := `
 		SELECT sa.namespace, sa.name, sa.service_namespace, sa.service_name,
-			   m.resource_version, m.labels, m.annotations, m.conditions,
+			   m.resource_version, m.labels, m.annotations, m.conditions, m.finalizers, m.deletion_timestamp, m.owner_references,
 			   m.created_at, m.updated_at
 		FROM service_aliases sa
 		INNER JOIN k8s_metadata m ON sa.resource_version = m.resource_version
@@ -77,6 +83,9 @@ func (r *Reader) GetServiceAliasByID(ctx context.Context, id models.ResourceIden
 func (r *Reader) scanServiceAlias(rows pgx.Rows) (models.ServiceAlias, error) {
 	var serviceAlias models.ServiceAlias
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 	var serviceNamespace, serviceName string
@@ -90,6 +99,9 @@ func (r *Reader) scanServiceAlias(rows pgx.Rows) (models.ServiceAlias, error) {
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -98,7 +110,7 @@ func (r *Reader) scanServiceAlias(rows pgx.Rows) (models.ServiceAlias, error) {
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	serviceAlias.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	serviceAlias.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return serviceAlias, err
 	}
@@ -116,6 +128,9 @@ func (r *Reader) scanServiceAlias(rows pgx.Rows) (models.ServiceAlias, error) {
 func (r *Reader) scanServiceAliasRow(row pgx.Row) (*models.ServiceAlias, error) {
 	var serviceAlias models.ServiceAlias
 	var labelsJSON, annotationsJSON, conditionsJSON []byte
+	var finalizers []string
+	var deletionTimestamp *time.Time
+	var ownerReferencesJSON []byte
 	var createdAt, updatedAt time.Time // Temporary variables for timestamps
 	var resourceVersion int64          // Scan as int64 from database
 	var serviceNamespace, serviceName string
@@ -129,6 +144,9 @@ func (r *Reader) scanServiceAliasRow(row pgx.Row) (*models.ServiceAlias, error)
 		&labelsJSON,
 		&annotationsJSON,
 		&conditionsJSON,
+		&finalizers,
+		&deletionTimestamp,
+		&ownerReferencesJSON,
 		&createdAt,
 		&updatedAt,
 	)
@@ -137,7 +155,7 @@ func (r *Reader) scanServiceAliasRow(row pgx.Row) (*models.ServiceAlias, error)
 	}
 
 	// Convert K8s metadata (convert int64 to string)
-	serviceAlias.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, createdAt, updatedAt)
+	serviceAlias.Meta, err = utils.ConvertK8sMetadata(fmt.Sprintf("%d", resourceVersion), labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON, createdAt, updatedAt)
 	if err != nil {
 		return nil, err
 	}
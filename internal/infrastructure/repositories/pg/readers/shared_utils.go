@@ -2,50 +2,14 @@ package readers
 
 import (
 	"encoding/json"
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"netguard-pg-backend/internal/domain/models"
-	"netguard-pg-backend/internal/domain/ports"
 )
 
-// buildScopeFilter builds WHERE clause and arguments for scope filtering
-func (r *Reader) buildScopeFilter(scope ports.Scope, tableAlias string) (string, []interface{}) {
-	if scope.IsEmpty() {
-		return "", nil
-	}
-
-	switch s := scope.(type) {
-	case ports.ResourceIdentifierScope:
-		if len(s.Identifiers) == 0 {
-			return "", nil
-		}
-
-		// Build IN clauses for namespace and name pairs
-		var conditions []string
-		var args []interface{}
-		argIndex := 1
-
-		for _, id := range s.Identifiers {
-			condition := fmt.Sprintf("(%s.namespace = $%d AND %s.name = $%d)",
-				tableAlias, argIndex, tableAlias, argIndex+1)
-			conditions = append(conditions, condition)
-			args = append(args, id.Namespace, id.Name)
-			argIndex += 2
-		}
-
-		return "(" + strings.Join(conditions, " OR ") + ")", args
-
-	default:
-		// For other scope types, return empty filter
-		return "", nil
-	}
-}
-
 // parseIngressPorts converts JSONB ingress ports to domain IngressPort slice
 func (r *Reader) parseIngressPorts(ingressPortsJSON []byte) ([]models.IngressPort, error) {
 	if len(ingressPortsJSON) == 0 {
@@ -2,6 +2,7 @@ package pg
 
 import (
 	"context"
+	"io"
 	"net/url"
 	"sync"
 	"time"
@@ -12,20 +13,23 @@ import (
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/migrate"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/readers"
 	"netguard-pg-backend/internal/infrastructure/repositories/pg/writers"
+	"netguard-pg-backend/internal/infrastructure/repositories/readcache"
 	"netguard-pg-backend/internal/patterns"
 )
 
-// readCommittedReader wraps a readers.Reader and properly manages ReadCommitted transaction lifecycle
-type readCommittedReader struct {
+// txReader wraps a readers.Reader and properly manages the lifecycle of its read-only
+// transaction (ReadCommitted or snapshot readers)
+type txReader struct {
 	*readers.Reader // Embed the reader to inherit all interface methods
 	tx              pgx.Tx
 	ctx             context.Context
 }
 
-// Close properly closes the ReadCommitted transaction
-func (r *readCommittedReader) Close() error {
+// Close properly closes the read-only transaction
+func (r *txReader) Close() error {
 	if r.tx != nil {
 		// For read-only transactions, we should rollback (which is effectively a commit for read-only)
 		return r.tx.Rollback(r.ctx)
@@ -34,7 +38,10 @@ func (r *readCommittedReader) Close() error {
 }
 
 // Compile-time check that Registry implements ports.Registry
-var _ ports.Registry = (*Registry)(nil)
+var (
+	_ ports.Registry        = (*Registry)(nil)
+	_ ports.SchemaInspector = (*Registry)(nil)
+)
 
 // PostgreSQLWriter interface for accessing transaction from writer
 type PostgreSQLWriter interface {
@@ -47,38 +54,80 @@ type PostgreSQLWriter interface {
 type Registry struct {
 	subject patterns.Subject
 	pool    *pgxpool.Pool // Simple pool reference instead of atomic
-	mu      sync.RWMutex  // Protect pool access
+	// replica serves Reader when a read replica is configured, nil otherwise
+	replica *pgxpool.Pool
+	// cache serves hot lookups of Reader when set, committed writes invalidate it
+	cache *readcache.Cache
+	// isolation is the isolation level of writers without an explicit one
+	isolation ports.IsolationLevel
+	// txTimeout bounds writer transactions without an explicit timeout, zero is unbounded
+	txTimeout time.Duration
+	// queries records the queries of the primary and replica pools
+	queries *queryTracer
+	mu      sync.RWMutex // Protect pool access
 }
 
 // NewRegistryFromPG creates registry from Postgres (simplified approach)
-func NewRegistryFromPG(ctx context.Context, dbURL url.URL) (ports.Registry, error) {
+func NewRegistryFromPG(ctx context.Context, dbURL url.URL, poolConfig PoolConfig) (ports.Registry, error) {
+	queries := newQueryTracer(poolConfig.SlowQueryThreshold)
+	pool, err := newPool(ctx, dbURL, poolConfig, queries, false)
+	if err != nil {
+		return nil, err
+	}
 
+	ret := &Registry{
+		subject: &simpleSubject{
+			observers: make([]interface{}, 0),
+		},
+		pool:      pool, // Simple assignment instead of atomic store
+		isolation: ports.IsolationLevel(poolConfig.WriteIsolation),
+		txTimeout: poolConfig.TxTimeout,
+		queries:   queries,
+	}
+
+	// 📖 READ_REPLICA: list-heavy Reader traffic goes to the replica, writes and
+	// reads inside write transactions stay on the primary
+	if poolConfig.ReplicaURI != "" {
+		replicaURL, err := url.Parse(poolConfig.ReplicaURI)
+		if err != nil {
+			pool.Close()
+			return nil, errors.WithMessage(err, "NewRegistryFromPG parse replica URI")
+		}
+		ret.replica, err = newPool(ctx, *replicaURL, poolConfig, queries, true)
+		if err != nil {
+			pool.Close()
+			return nil, errors.WithMessage(err, "NewRegistryFromPG replica")
+		}
+	}
+
+	return ret, nil
+}
+
+// newPool opens and pings a connection pool, readOnly pools reject writes
+func newPool(ctx context.Context, dbURL url.URL, poolConfig PoolConfig, tracer pgx.QueryTracer, readOnly bool) (*pgxpool.Pool, error) {
 	conf, err := pgxpool.ParseConfig(dbURL.String())
 	if err != nil {
 		return nil, errors.WithMessage(err, "NewRegistryFromPG parse config")
 	}
 
-	// 🎯 TIMEOUT_FIX: Optimize connection pool for high concurrency and condition operations
-	// Increase connection limits for heavy reactive flows and concurrent condition processing
-	conf.MaxConns = 50                        // Increased from default ~4 to handle concurrent condition operations
-	conf.MinConns = 5                         // Keep minimum connections warm
-	conf.MaxConnLifetime = 2 * time.Hour      // Longer lifetime to reduce connection churn
-	conf.MaxConnIdleTime = 15 * time.Minute   // Reasonable idle time
+	// 🎯 TIMEOUT_FIX: Pool limits and the statement cache come from the postgres config section,
+	// hot reads (GetServiceByID, ListIEAgAgRules) reuse their prepared statements per connection
+	if err := poolConfig.applyTo(conf); err != nil {
+		return nil, errors.WithMessage(err, "NewRegistryFromPG pool config")
+	}
 	conf.HealthCheckPeriod = 30 * time.Second // Regular health checks
 
 	// 🔧 OPTIMIZED_FIX: Aggressive timeout settings for better concurrent performance
 	conf.ConnConfig.ConnectTimeout = 5 * time.Second // Faster connection timeout
+	conf.ConnConfig.Tracer = tracer
 
-	// 🎯 BUSINESS_FLOW_FIX: Adjusted PostgreSQL timeouts for complex business flows
-	// Previous: 10s statement, 15s idle transaction - TOO AGGRESSIVE for RuleS2S complex flows
-	// New: Balanced approach - prevent hung connections while allowing complex business logic
-	conf.ConnConfig.RuntimeParams = map[string]string{
-		"statement_timeout":                   "60000",  // 60 second statement timeout (complex queries)
-		"idle_in_transaction_session_timeout": "120000", // 2 minute idle transaction timeout (business flows)
-		"lock_timeout":                        "30000",  // 30 second lock timeout (reduced contention)
+	// 🎯 BUSINESS_FLOW_FIX: PostgreSQL timeouts for complex business flows come from the
+	// postgres config section - prevent hung connections while allowing complex business logic
+	conf.ConnConfig.RuntimeParams = poolConfig.runtimeParams()
+	if readOnly {
+		conf.ConnConfig.RuntimeParams["default_transaction_read_only"] = "on"
 	}
 
-
 	pool, err := pgxpool.NewWithConfig(ctx, conf)
 	if err != nil {
 		return nil, errors.WithMessage(err, "NewRegistryFromPG create pool")
@@ -90,27 +139,19 @@ func NewRegistryFromPG(ctx context.Context, dbURL url.URL) (ports.Registry, erro
 		return nil, errors.WithMessage(err, "NewRegistryFromPG ping")
 	}
 
-
-	ret := &Registry{
-		subject: &simpleSubject{
-			observers: make([]interface{}, 0),
-		},
-		pool: pool, // Simple assignment instead of atomic store
-	}
-
-	return ret, nil
+	return pool, nil
 }
 
 // NewRegistryFromURI creates a PostgreSQL registry from a connection URI
 // Wrapper for sgroups-style function (migrations handled separately via Job)
-func NewRegistryFromURI(ctx context.Context, uri string) (*Registry, error) {
+func NewRegistryFromURI(ctx context.Context, uri string, poolConfig PoolConfig) (*Registry, error) {
 	// Parse URI and delegate to NewRegistryFromPG
 	dbURL, err := url.Parse(uri)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to parse PostgreSQL URI")
 	}
 
-	registry, err := NewRegistryFromPG(ctx, *dbURL)
+	registry, err := NewRegistryFromPG(ctx, *dbURL, poolConfig)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to create PostgreSQL registry")
 	}
@@ -128,58 +169,52 @@ func (r *Registry) Subject() patterns.Subject {
 	return r.subject
 }
 
-// Writer creates a new PostgreSQL writer (simplified approach)
+// Writer creates a new PostgreSQL writer with the configured write isolation level
 func (r *Registry) Writer(ctx context.Context) (ports.Writer, error) {
+	return r.WriterWithOptions(ctx, ports.WriterOptions{})
+}
+
+// WriterWithOptions creates a new PostgreSQL writer with the isolation level and timeout of
+// opts, zero values use the write isolation and tx timeout of the postgres config section.
+// Serialization failures and deadlocks are returned as ports.ErrSerializationFailure, lock
+// and statement timeouts and an exceeded transaction timeout as ports.ErrTransactionTimeout.
+func (r *Registry) WriterWithOptions(ctx context.Context, opts ports.WriterOptions) (ports.Writer, error) {
 	r.mu.RLock()
-	pool := r.pool
+	pool, cache, isolation, timeout := r.pool, r.cache, r.isolation, r.txTimeout
 	r.mu.RUnlock()
 
 	if pool == nil {
 		return nil, errors.New("registry pool is nil")
 	}
-
-	txOpts := pgx.TxOptions{
-		IsoLevel:   pgx.RepeatableRead,
-		AccessMode: pgx.ReadWrite,
+	if opts.Isolation != ports.IsolationDefault {
+		isolation = opts.Isolation
 	}
-
-	tx, err := pool.BeginTx(ctx, txOpts)
+	isoLevel, err := txIsoLevel(isolation)
 	if err != nil {
-		return nil, errors.WithMessage(err, "failed to begin transaction")
+		return nil, err
 	}
-
-	// Use only the modular writer - eliminate complex writer wrapper
-	modularWriter := writers.NewWriter(r, tx, ctx)
-
-	return &simpleWriter{
-		tx:            tx,
-		ctx:           ctx,
-		modularWriter: modularWriter,
-	}, nil
-}
-
-// 🔧 PRODUCTION FIX: WriterForConditions creates a writer with ReadCommitted isolation
-// This allows condition sync operations to see data committed by other transactions
-// avoiding UID conflicts where ConditionManager can't find the service that was just created
-func (r *Registry) WriterForConditions(ctx context.Context) (ports.Writer, error) {
-	r.mu.RLock()
-	pool := r.pool
-	r.mu.RUnlock()
-
-	if pool == nil {
-		return nil, errors.New("registry pool is nil")
+	if opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
 	}
 
-	// 🚨 CRITICAL: Use ReadCommitted instead of RepeatableRead
-	// This allows seeing committed data from other transactions
 	txOpts := pgx.TxOptions{
-		IsoLevel:   pgx.ReadCommitted, // Can see committed data from other transactions
+		IsoLevel:   isoLevel,
 		AccessMode: pgx.ReadWrite,
 	}
 
-	tx, err := pool.BeginTx(ctx, txOpts)
+	// ⏱️ TX_TIMEOUT: waiting for a pool connection counts against the transaction timeout,
+	// an exhausted pool fails the RPC instead of hanging it
+	var tx pgx.Tx
+	err = runBefore(ctx, deadline, func(ctx context.Context) (err error) {
+		tx, err = pool.BeginTx(ctx, txOpts)
+		return err
+	})
 	if err != nil {
-		return nil, errors.WithMessage(err, "failed to begin transaction for conditions")
+		return nil, errors.WithMessage(err, "failed to begin transaction")
 	}
 
 	// Use only the modular writer - eliminate complex writer wrapper
@@ -189,44 +224,54 @@ func (r *Registry) WriterForConditions(ctx context.Context) (ports.Writer, error
 		tx:            tx,
 		ctx:           ctx,
 		modularWriter: modularWriter,
+		cache:         cache,
+		deadline:      deadline,
 	}, nil
 }
 
-// 🔧 SERIALIZATION_FIX: WriterForDeletes creates a writer with ReadCommitted isolation for delete operations
-// This reduces serialization conflict sensitivity during concurrent DELETE operations
-func (r *Registry) WriterForDeletes(ctx context.Context) (ports.Writer, error) {
+// txIsoLevel maps an isolation level to the pgx transaction isolation level,
+// an empty level is the RepeatableRead of the default writer
+func txIsoLevel(isolation ports.IsolationLevel) (pgx.TxIsoLevel, error) {
+	switch isolation {
+	case ports.IsolationDefault, ports.IsolationRepeatableRead:
+		return pgx.RepeatableRead, nil
+	case ports.IsolationReadCommitted:
+		return pgx.ReadCommitted, nil
+	case ports.IsolationSerializable:
+		return pgx.Serializable, nil
+	default:
+		return "", errors.Errorf("unsupported transaction isolation level %q", isolation)
+	}
+}
+
+// Reader creates a new PostgreSQL reader using the dedicated readers module.
+// With a read replica the reader queries the replica and may lag behind the primary,
+// PrimaryReader, ReaderWithReadCommitted, ReaderAtSnapshot and ReaderFromWriter always read
+// the primary.
+func (r *Registry) Reader(ctx context.Context) (ports.Reader, error) {
 	r.mu.RLock()
 	pool := r.pool
+	if r.replica != nil {
+		pool = r.replica
+	}
+	cache := r.cache
 	r.mu.RUnlock()
 
 	if pool == nil {
 		return nil, errors.New("registry pool is nil")
 	}
 
-	// 🚨 CRITICAL: Use ReadCommitted instead of RepeatableRead for delete operations
-	// This allows seeing committed data from other transactions and reduces serialization conflicts
-	txOpts := pgx.TxOptions{
-		IsoLevel:   pgx.ReadCommitted, // Less sensitive to concurrent access
-		AccessMode: pgx.ReadWrite,
-	}
-
-	tx, err := pool.BeginTx(ctx, txOpts)
-	if err != nil {
-		return nil, errors.WithMessage(err, "failed to begin transaction for deletes")
+	// Use the proper readers.Reader instead of duplicating code
+	reader := readers.NewReader(r, pool, nil, ctx)
+	if cache != nil {
+		return &cachedReader{Reader: reader, cache: cache}, nil
 	}
-
-	// Use only the modular writer - eliminate complex writer wrapper
-	modularWriter := writers.NewWriter(r, tx, ctx)
-
-	return &simpleWriter{
-		tx:            tx,
-		ctx:           ctx,
-		modularWriter: modularWriter,
-	}, nil
+	return reader, nil
 }
 
-// Reader creates a new PostgreSQL reader using the dedicated readers module
-func (r *Registry) Reader(ctx context.Context) (ports.Reader, error) {
+// PrimaryReader creates a reader of the primary pool without the read cache, it sees every
+// commit made before its queries
+func (r *Registry) PrimaryReader(ctx context.Context) (ports.Reader, error) {
 	r.mu.RLock()
 	pool := r.pool
 	r.mu.RUnlock()
@@ -234,10 +279,24 @@ func (r *Registry) Reader(ctx context.Context) (ports.Reader, error) {
 	if pool == nil {
 		return nil, errors.New("registry pool is nil")
 	}
+	return readers.NewReader(r, pool, nil, ctx), nil
+}
 
-	// Use the proper readers.Reader instead of duplicating code
-	reader := readers.NewReader(r, pool, nil, ctx)
-	return reader, nil
+// SetReadCache makes Reader serve hot lookups from cache, writers of the registry
+// invalidate it when they commit
+func (r *Registry) SetReadCache(cache *readcache.Cache) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache = cache
+}
+
+// WriteQueryMetrics writes duration, row and error metrics of the registry queries per
+// statement in the Prometheus text format
+func (r *Registry) WriteQueryMetrics(w io.Writer) error {
+	if r.queries == nil {
+		return nil
+	}
+	return r.queries.WriteMetrics(w)
 }
 
 // 🔧 CROSS-RULES2S FIX: ReaderWithReadCommitted creates a reader with ReadCommitted isolation
@@ -267,13 +326,53 @@ func (r *Registry) ReaderWithReadCommitted(ctx context.Context) (ports.Reader, e
 
 	// Create a transaction-aware reader that properly manages the transaction lifecycle
 	baseReader := readers.NewReader(r, pool, tx, ctx)
-	return &readCommittedReader{
+	return &txReader{
 		Reader: baseReader,
 		tx:     tx,
 		ctx:    ctx,
 	}, nil
 }
 
+// ReaderAtSnapshot creates a reader of a RepeatableRead read-only transaction on the
+// primary, its queries see the snapshot taken at the first one. It bypasses the read cache,
+// cached lookups could be newer than the snapshot.
+func (r *Registry) ReaderAtSnapshot(ctx context.Context) (ports.Reader, error) {
+	r.mu.RLock()
+	pool := r.pool
+	r.mu.RUnlock()
+
+	return r.snapshotReader(ctx, pool)
+}
+
+// ReaderAtReplicaSnapshot creates a snapshot reader like ReaderAtSnapshot that reads the
+// replica when one is configured
+func (r *Registry) ReaderAtReplicaSnapshot(ctx context.Context) (ports.Reader, error) {
+	r.mu.RLock()
+	pool := r.pool
+	if r.replica != nil {
+		pool = r.replica
+	}
+	r.mu.RUnlock()
+
+	return r.snapshotReader(ctx, pool)
+}
+
+func (r *Registry) snapshotReader(ctx context.Context, pool *pgxpool.Pool) (ports.Reader, error) {
+	if pool == nil {
+		return nil, errors.New("registry pool is nil")
+	}
+
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to begin snapshot transaction for reader")
+	}
+	return &txReader{
+		Reader: readers.NewReader(r, pool, tx, ctx),
+		tx:     tx,
+		ctx:    ctx,
+	}, nil
+}
+
 // ReaderFromWriter creates a reader that uses the same transaction as the writer
 func (r *Registry) ReaderFromWriter(ctx context.Context, w ports.Writer) (ports.Reader, error) {
 	r.mu.RLock()
@@ -304,20 +403,95 @@ func (r *Registry) Close() error {
 		r.pool.Close()
 		r.pool = nil
 	}
+	if r.replica != nil {
+		r.replica.Close()
+		r.replica = nil
+	}
 
 	return nil
 }
 
+// PoolStat returns connection pool statistics, nil if the registry is closed
+func (r *Registry) PoolStat() *pgxpool.Stat {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.pool == nil {
+		return nil
+	}
+	return r.pool.Stat()
+}
+
+// SchemaVersion returns the last migration applied by goose, 0 if no migration was applied
+func (r *Registry) SchemaVersion(ctx context.Context) (int64, error) {
+	r.mu.RLock()
+	pool := r.pool
+	r.mu.RUnlock()
+
+	if pool == nil {
+		return 0, errors.New("registry is closed")
+	}
+
+	var exists bool
+	if err := pool.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, migrate.DefaultVersionTable).Scan(&exists); err != nil {
+		return 0, errors.Wrap(err, "failed to look up goose version table")
+	}
+	if !exists {
+		return 0, nil
+	}
+
+	var version int64
+	query := `SELECT COALESCE(MAX(version_id), 0) FROM ` + pgx.Identifier{migrate.DefaultVersionTable}.Sanitize() + ` WHERE is_applied`
+	if err := pool.QueryRow(ctx, query).Scan(&version); err != nil {
+		return 0, errors.Wrap(err, "failed to read schema version")
+	}
+	return version, nil
+}
+
+// SchemaStatus returns the applied schema version and the version the backend supports
+func (r *Registry) SchemaStatus(ctx context.Context) (models.SchemaStatus, error) {
+	version, err := r.SchemaVersion(ctx)
+	if err != nil {
+		return models.SchemaStatus{}, err
+	}
+	return models.SchemaStatus{Version: version, SupportedVersion: migrate.SupportedVersion}, nil
+}
+
 // simpleWriter implements a simplified PostgreSQL writer
 type simpleWriter struct {
 	tx            pgx.Tx
 	ctx           context.Context
 	modularWriter *writers.Writer
+	cache         *readcache.Cache
+	// deadline bounds the statements and the commit of the transaction, zero is unbounded
+	deadline time.Time
+}
+
+// run executes op of the transaction before its deadline
+func (w *simpleWriter) run(ctx context.Context, op func(context.Context) error) error {
+	return runBefore(ctx, w.deadline, op)
+}
+
+// runBefore executes op with ctx bounded by deadline unless it is zero, deadlocks and
+// timeouts are returned as retryable ports errors
+func runBefore(ctx context.Context, deadline time.Time, op func(context.Context) error) error {
+	if !deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+	return writers.TransactionError(op(ctx))
 }
 
 // Implement required Writer interface methods
 func (w *simpleWriter) Commit() error {
-	return w.tx.Commit(w.ctx)
+	if err := w.run(w.ctx, w.tx.Commit); err != nil {
+		return writers.ReferenceViolation(err)
+	}
+	if w.cache != nil {
+		w.cache.Invalidate()
+	}
+	return nil
 }
 
 func (w *simpleWriter) Abort() {
@@ -330,100 +504,178 @@ func (w *simpleWriter) Close() error {
 
 // Delegate all resource methods to modular writer
 func (w *simpleWriter) SyncServices(ctx context.Context, services []models.Service, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncServices(ctx, services, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.SyncServices(ctx, services, scope, opts...) })
 }
 
 func (w *simpleWriter) DeleteServicesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteServicesByIDs(ctx, ids, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteServicesByIDs(ctx, ids, opts...) })
 }
 
 // Delegate all resource methods to modular writer (implementing all required methods)
 func (w *simpleWriter) SyncAddressGroups(ctx context.Context, groups []models.AddressGroup, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncAddressGroups(ctx, groups, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.SyncAddressGroups(ctx, groups, scope, opts...) })
 }
 
 func (w *simpleWriter) DeleteAddressGroupsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteAddressGroupsByIDs(ctx, ids, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteAddressGroupsByIDs(ctx, ids, opts...) })
 }
 
 func (w *simpleWriter) SyncAddressGroupBindings(ctx context.Context, bindings []models.AddressGroupBinding, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncAddressGroupBindings(ctx, bindings, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncAddressGroupBindings(ctx, bindings, scope, opts...)
+	})
 }
 
 func (w *simpleWriter) DeleteAddressGroupBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteAddressGroupBindingsByIDs(ctx, ids, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.DeleteAddressGroupBindingsByIDs(ctx, ids, opts...)
+	})
 }
 
 func (w *simpleWriter) SyncAddressGroupPortMappings(ctx context.Context, mappings []models.AddressGroupPortMapping, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncAddressGroupPortMappings(ctx, mappings, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncAddressGroupPortMappings(ctx, mappings, scope, opts...)
+	})
 }
 
 func (w *simpleWriter) DeleteAddressGroupPortMappingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteAddressGroupPortMappingsByIDs(ctx, ids, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.DeleteAddressGroupPortMappingsByIDs(ctx, ids, opts...)
+	})
 }
 
 func (w *simpleWriter) SyncRuleS2S(ctx context.Context, rules []models.RuleS2S, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncRuleS2S(ctx, rules, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.SyncRuleS2S(ctx, rules, scope, opts...) })
 }
 
 func (w *simpleWriter) DeleteRuleS2SByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteRuleS2SByIDs(ctx, ids) // modularWriter doesn't accept opts
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteRuleS2SByIDs(ctx, ids) }) // modularWriter doesn't accept opts
 }
 
 func (w *simpleWriter) SyncServiceAliases(ctx context.Context, aliases []models.ServiceAlias, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncServiceAliases(ctx, aliases, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncServiceAliases(ctx, aliases, scope, opts...)
+	})
 }
 
 func (w *simpleWriter) DeleteServiceAliasesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteServiceAliasesByIDs(ctx, ids, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteServiceAliasesByIDs(ctx, ids, opts...) })
 }
 
 func (w *simpleWriter) SyncAddressGroupBindingPolicies(ctx context.Context, policies []models.AddressGroupBindingPolicy, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncAddressGroupBindingPolicies(ctx, policies, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncAddressGroupBindingPolicies(ctx, policies, scope, opts...)
+	})
 }
 
 func (w *simpleWriter) DeleteAddressGroupBindingPoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteAddressGroupBindingPoliciesByIDs(ctx, ids, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.DeleteAddressGroupBindingPoliciesByIDs(ctx, ids, opts...)
+	})
 }
 
 func (w *simpleWriter) SyncIEAgAgRules(ctx context.Context, rules []models.IEAgAgRule, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncIEAgAgRules(ctx, rules, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.SyncIEAgAgRules(ctx, rules, scope, opts...) })
 }
 
 func (w *simpleWriter) DeleteIEAgAgRulesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteIEAgAgRulesByIDs(ctx, ids) // modularWriter doesn't accept opts
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteIEAgAgRulesByIDs(ctx, ids) }) // modularWriter doesn't accept opts
 }
 
 func (w *simpleWriter) SyncNetworks(ctx context.Context, networks []models.Network, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncNetworks(ctx, networks, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.SyncNetworks(ctx, networks, scope, opts...) })
 }
 
 func (w *simpleWriter) DeleteNetworksByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteNetworksByIDs(ctx, ids) // modularWriter doesn't accept opts
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteNetworksByIDs(ctx, ids) }) // modularWriter doesn't accept opts
 }
 
 func (w *simpleWriter) SyncNetworkBindings(ctx context.Context, bindings []models.NetworkBinding, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncNetworkBindings(ctx, bindings, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncNetworkBindings(ctx, bindings, scope, opts...)
+	})
 }
 
 func (w *simpleWriter) DeleteNetworkBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteNetworkBindingsByIDs(ctx, ids) // modularWriter doesn't accept opts
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteNetworkBindingsByIDs(ctx, ids) }) // modularWriter doesn't accept opts
 }
 
 func (w *simpleWriter) SyncHosts(ctx context.Context, hosts []models.Host, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncHosts(ctx, hosts, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.SyncHosts(ctx, hosts, scope, opts...) })
 }
 
 func (w *simpleWriter) DeleteHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteHostsByIDs(ctx, ids)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteHostsByIDs(ctx, ids) })
 }
 
 func (w *simpleWriter) SyncHostBindings(ctx context.Context, hostBindings []models.HostBinding, scope ports.Scope, opts ...ports.Option) error {
-	return w.modularWriter.SyncHostBindings(ctx, hostBindings, scope, opts...)
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncHostBindings(ctx, hostBindings, scope, opts...)
+	})
 }
 
 func (w *simpleWriter) DeleteHostBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
-	return w.modularWriter.DeleteHostBindingsByIDs(ctx, ids)
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteHostBindingsByIDs(ctx, ids) })
+}
+
+func (w *simpleWriter) SyncRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncRuleS2SExceptions(ctx, exceptions, scope, opts...)
+	})
+}
+
+func (w *simpleWriter) DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteRuleS2SExceptionsByIDs(ctx, ids) })
+}
+
+func (w *simpleWriter) SyncNamespacePostures(ctx context.Context, postures []models.NamespacePosture, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncNamespacePostures(ctx, postures, scope, opts...)
+	})
+}
+
+func (w *simpleWriter) DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteNamespacePosturesByIDs(ctx, ids) })
+}
+
+func (w *simpleWriter) DeleteServicesCascade(ctx context.Context, ids []models.ResourceIdentifier) (int, error) {
+	var changed int
+	err := w.run(ctx, func(ctx context.Context) error {
+		var err error
+		changed, err = w.modularWriter.DeleteServicesCascade(ctx, ids)
+		return err
+	})
+	return changed, err
+}
+
+func (w *simpleWriter) LockAggregationKeys(ctx context.Context, keys []string) error {
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.LockAggregationKeys(ctx, keys) })
+}
+
+func (w *simpleWriter) ReplaceIEAgAgRuleContributions(ctx context.Context, ieAgAgRuleIDs []models.ResourceIdentifier, contributions []models.IEAgAgRuleContribution) error {
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.ReplaceIEAgAgRuleContributions(ctx, ieAgAgRuleIDs, contributions)
+	})
+}
+
+func (w *simpleWriter) SyncRuleTemplates(ctx context.Context, templates []models.RuleTemplate, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncRuleTemplates(ctx, templates, scope, opts...)
+	})
+}
+
+func (w *simpleWriter) DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteRuleTemplatesByIDs(ctx, ids) })
+}
+
+func (w *simpleWriter) SyncCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, scope ports.Scope, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error {
+		return w.modularWriter.SyncCrossNamespacePolicies(ctx, policies, scope, opts...)
+	})
+}
+
+func (w *simpleWriter) DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.run(ctx, func(ctx context.Context) error { return w.modularWriter.DeleteCrossNamespacePoliciesByIDs(ctx, ids) })
 }
 
 func (w *simpleWriter) UpdateSyncStatus(ctx context.Context) error {
@@ -0,0 +1,214 @@
+package pg
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// SyncOutbox is a PostgreSQL implementation of ports.SyncOutbox (table sync_outbox)
+type SyncOutbox struct {
+	pool *pgxpool.Pool
+}
+
+var (
+	_ ports.SyncOutbox          = &SyncOutbox{}
+	_ ports.SyncDeadLetterQueue = &SyncOutbox{}
+)
+
+// NewSyncOutbox creates an outbox on top of the connection pool
+func NewSyncOutbox(pool *pgxpool.Pool) *SyncOutbox {
+	return &SyncOutbox{pool: pool}
+}
+
+// SyncOutbox returns the outbox filled by the registry writers
+func (r *Registry) SyncOutbox() *SyncOutbox {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return NewSyncOutbox(r.pool)
+}
+
+// EnqueueSyncOutbox stores sgroups sync operations in the writer transaction
+func (w *simpleWriter) EnqueueSyncOutbox(ctx context.Context, entries []models.SyncOutboxEntry) error {
+	return w.modularWriter.EnqueueSyncOutbox(ctx, entries)
+}
+
+// Claim returns up to limit due entries and postpones them for lease.
+// SKIP LOCKED lets several backend replicas dispatch the same outbox.
+func (o *SyncOutbox) Claim(ctx context.Context, limit int, lease time.Duration) ([]models.SyncOutboxEntry, error) {
+	rows, err := o.pool.Query(ctx, `
+		WITH due AS (
+			SELECT id FROM sync_outbox
+			WHERE next_attempt_at <= NOW()
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE sync_outbox o
+		SET next_attempt_at = NOW() + $2::interval
+		FROM due
+		WHERE o.id = due.id
+		RETURNING o.id, o.kind, o.operation, o.payload, o.attempts, o.last_error, o.created_at`,
+		limit, lease.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim sync outbox entries")
+	}
+	defer rows.Close()
+
+	var entries []models.SyncOutboxEntry
+	for rows.Next() {
+		var (
+			entry     models.SyncOutboxEntry
+			operation string
+			payload   []byte
+		)
+		if err := rows.Scan(&entry.ID, &entry.Kind, &operation, &payload, &entry.Attempts, &entry.LastError, &entry.CreatedAt); err != nil {
+			return nil, errors.Wrap(err, "failed to scan sync outbox row")
+		}
+		resource, err := models.DecodeChangeResource(entry.Kind, payload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode sync outbox entry %d", entry.ID)
+		}
+		entry.Operation = types.SyncOperation(operation)
+		entry.Resource = resource
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read sync outbox")
+	}
+
+	// UPDATE ... RETURNING doesn't preserve the CTE order
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	return entries, nil
+}
+
+// Complete removes delivered entries
+func (o *SyncOutbox) Complete(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := o.pool.Exec(ctx, `DELETE FROM sync_outbox WHERE id = ANY($1)`, ids); err != nil {
+		return errors.Wrap(err, "failed to complete sync outbox entries")
+	}
+	return nil
+}
+
+// Retry records a failed delivery attempt
+func (o *SyncOutbox) Retry(ctx context.Context, id int64, cause error, next time.Time) error {
+	lastError := ""
+	if cause != nil {
+		lastError = cause.Error()
+	}
+	tag, err := o.pool.Exec(ctx, `
+		UPDATE sync_outbox
+		SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+		WHERE id = $1`, id, lastError, next)
+	if err != nil {
+		return errors.Wrapf(err, "failed to reschedule sync outbox entry %d", id)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrNotFound
+	}
+	return nil
+}
+
+// DeadLetter moves an entry to sync_outbox_dead_letters keeping its id
+func (o *SyncOutbox) DeadLetter(ctx context.Context, id int64, cause error) error {
+	lastError := ""
+	if cause != nil {
+		lastError = cause.Error()
+	}
+	tag, err := o.pool.Exec(ctx, `
+		WITH moved AS (
+			DELETE FROM sync_outbox WHERE id = $1
+			RETURNING id, kind, operation, payload, attempts, created_at
+		)
+		INSERT INTO sync_outbox_dead_letters (id, kind, operation, payload, attempts, last_error, created_at)
+		SELECT id, kind, operation, payload, attempts + 1, $2, created_at FROM moved`, id, lastError)
+	if err != nil {
+		return errors.Wrapf(err, "failed to dead-letter sync outbox entry %d", id)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrNotFound
+	}
+	return nil
+}
+
+// ListDeadLetters returns dead-lettered entries
+func (o *SyncOutbox) ListDeadLetters(ctx context.Context) ([]models.SyncOutboxEntry, error) {
+	rows, err := o.pool.Query(ctx, `
+		SELECT id, kind, operation, payload, attempts, last_error, created_at, failed_at
+		FROM sync_outbox_dead_letters
+		ORDER BY id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sync dead letters")
+	}
+	defer rows.Close()
+
+	var entries []models.SyncOutboxEntry
+	for rows.Next() {
+		entry, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read sync dead letters")
+	}
+	return entries, nil
+}
+
+// GetDeadLetter returns a dead-lettered entry by id
+func (o *SyncOutbox) GetDeadLetter(ctx context.Context, id int64) (models.SyncOutboxEntry, error) {
+	row := o.pool.QueryRow(ctx, `
+		SELECT id, kind, operation, payload, attempts, last_error, created_at, failed_at
+		FROM sync_outbox_dead_letters
+		WHERE id = $1`, id)
+	entry, err := scanDeadLetter(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.SyncOutboxEntry{}, ports.ErrNotFound
+	}
+	return entry, err
+}
+
+// RemoveDeadLetter removes an entry from the dead-letter queue
+func (o *SyncOutbox) RemoveDeadLetter(ctx context.Context, id int64) error {
+	tag, err := o.pool.Exec(ctx, `DELETE FROM sync_outbox_dead_letters WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove sync dead letter %d", id)
+	}
+	if tag.RowsAffected() == 0 {
+		return ports.ErrNotFound
+	}
+	return nil
+}
+
+func scanDeadLetter(row pgx.Row) (models.SyncOutboxEntry, error) {
+	var (
+		entry     models.SyncOutboxEntry
+		operation string
+		payload   []byte
+	)
+	if err := row.Scan(&entry.ID, &entry.Kind, &operation, &payload, &entry.Attempts, &entry.LastError, &entry.CreatedAt, &entry.FailedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entry, err
+		}
+		return entry, errors.Wrap(err, "failed to scan sync dead letter row")
+	}
+	resource, err := models.DecodeChangeResource(entry.Kind, payload)
+	if err != nil {
+		return entry, errors.Wrapf(err, "failed to decode sync dead letter %d", entry.ID)
+	}
+	entry.Operation = types.SyncOperation(operation)
+	entry.Resource = resource
+	return entry, nil
+}
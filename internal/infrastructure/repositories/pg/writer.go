@@ -167,3 +167,47 @@ func (w *writer) SyncHostBindings(ctx context.Context, hostBindings []models.Hos
 func (w *writer) DeleteHostBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
 	return w.modularWriter.DeleteHostBindingsByIDs(ctx, ids)
 }
+
+func (w *writer) SyncRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException, scope ports.Scope, opts ...ports.Option) error {
+	return w.modularWriter.SyncRuleS2SExceptions(ctx, exceptions, scope, opts...)
+}
+
+func (w *writer) DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.modularWriter.DeleteRuleS2SExceptionsByIDs(ctx, ids)
+}
+
+func (w *writer) SyncNamespacePostures(ctx context.Context, postures []models.NamespacePosture, scope ports.Scope, opts ...ports.Option) error {
+	return w.modularWriter.SyncNamespacePostures(ctx, postures, scope, opts...)
+}
+
+func (w *writer) DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.modularWriter.DeleteNamespacePosturesByIDs(ctx, ids)
+}
+
+func (w *writer) DeleteServicesCascade(ctx context.Context, ids []models.ResourceIdentifier) (int, error) {
+	return w.modularWriter.DeleteServicesCascade(ctx, ids)
+}
+
+func (w *writer) LockAggregationKeys(ctx context.Context, keys []string) error {
+	return w.modularWriter.LockAggregationKeys(ctx, keys)
+}
+
+func (w *writer) ReplaceIEAgAgRuleContributions(ctx context.Context, ieAgAgRuleIDs []models.ResourceIdentifier, contributions []models.IEAgAgRuleContribution) error {
+	return w.modularWriter.ReplaceIEAgAgRuleContributions(ctx, ieAgAgRuleIDs, contributions)
+}
+
+func (w *writer) SyncRuleTemplates(ctx context.Context, templates []models.RuleTemplate, scope ports.Scope, opts ...ports.Option) error {
+	return w.modularWriter.SyncRuleTemplates(ctx, templates, scope, opts...)
+}
+
+func (w *writer) DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.modularWriter.DeleteRuleTemplatesByIDs(ctx, ids)
+}
+
+func (w *writer) SyncCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, scope ports.Scope, opts ...ports.Option) error {
+	return w.modularWriter.SyncCrossNamespacePolicies(ctx, policies, scope, opts...)
+}
+
+func (w *writer) DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	return w.modularWriter.DeleteCrossNamespacePoliciesByIDs(ctx, ids)
+}
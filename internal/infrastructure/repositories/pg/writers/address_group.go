@@ -5,12 +5,12 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/pkg/errors"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
 	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 )
 
@@ -25,33 +25,27 @@ func (w *Writer) SyncAddressGroups(ctx context.Context, addressGroups []models.A
 	}
 
 	if isConditionOnly {
-		// Use type assertion to check if registry supports condition operations
-		if conditionRegistry, ok := w.registry.(interface {
-			WriterForConditions(ctx context.Context) (ports.Writer, error)
-		}); ok {
-			// Create a fresh writer with ReadCommitted isolation that can see committed data
-			freshWriter, err := conditionRegistry.WriterForConditions(ctx)
-			if err != nil {
-				return errors.Wrap(err, "failed to create fresh writer for condition operations")
-			}
-			defer freshWriter.Abort() // Ensure cleanup
+		// Create a fresh writer with ReadCommitted isolation that can see committed data
+		freshWriter, err := w.registry.WriterWithOptions(ctx, ports.WriterOptions{Isolation: ports.IsolationReadCommitted})
+		if err != nil {
+			return errors.Wrap(err, "failed to create fresh writer for condition operations")
+		}
+		defer freshWriter.Abort() // Ensure cleanup
 
-			var filteredOpts []ports.Option
-			for _, opt := range opts {
-				if _, ok := opt.(ports.ConditionOnlyOperation); !ok {
-					filteredOpts = append(filteredOpts, opt)
-				}
-			}
-			if err := freshWriter.SyncAddressGroups(ctx, addressGroups, scope, filteredOpts...); err != nil {
-				return errors.Wrap(err, "failed to sync address groups with fresh writer")
+		var filteredOpts []ports.Option
+		for _, opt := range opts {
+			if _, ok := opt.(ports.ConditionOnlyOperation); !ok {
+				filteredOpts = append(filteredOpts, opt)
 			}
-			// Commit the fresh transaction
-			if err := freshWriter.Commit(); err != nil {
-				return errors.Wrap(err, "failed to commit fresh writer transaction")
-			}
-			return nil
 		}
-		return errors.New("registry does not support condition operations")
+		if err := freshWriter.SyncAddressGroups(ctx, addressGroups, scope, filteredOpts...); err != nil {
+			return errors.Wrap(err, "failed to sync address groups with fresh writer")
+		}
+		// Commit the fresh transaction
+		if err := freshWriter.Commit(); err != nil {
+			return errors.Wrap(err, "failed to commit fresh writer transaction")
+		}
+		return nil
 	}
 	// Handle scoped sync - delete existing resources in scope first
 	if !scope.IsEmpty() {
@@ -104,6 +98,16 @@ func (w *Writer) upsertAddressGroup(ctx context.Context, ag models.AddressGroup)
 		return errors.Wrap(err, "failed to marshal hosts")
 	}
 
+	// Marshal IncludedGroups field, nil slice becomes empty array
+	includedGroups := ag.IncludedGroups
+	if includedGroups == nil {
+		includedGroups = []models.AddressGroupRef{}
+	}
+	includedGroupsJSON, err := json.Marshal(includedGroups)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal included_groups")
+	}
+
 	// First, check if address group exists and get existing resource version
 	var existingResourceVersion sql.NullInt64
 	existingQuery := `SELECT resource_version FROM address_groups WHERE namespace = $1 AND name = $2`
@@ -132,11 +136,14 @@ func (w *Writer) upsertAddressGroup(ctx context.Context, ag models.AddressGroup)
 			return errors.Wrapf(err, "failed to create K8s metadata for address group %s/%s", ag.Namespace, ag.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, ag.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of address group %s", ag.Key())
+	}
 
 	// Then, upsert the address group using the resource version (including Networks and Hosts fields)
 	addressGroupQuery := `
-		INSERT INTO address_groups (namespace, name, default_action, logs, trace, description, networks, hosts, resource_version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO address_groups (namespace, name, default_action, logs, trace, description, networks, hosts, included_groups, resource_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		ON CONFLICT (namespace, name) DO UPDATE SET
 			default_action = $3,
 			logs = $4,
@@ -144,7 +151,8 @@ func (w *Writer) upsertAddressGroup(ctx context.Context, ag models.AddressGroup)
 			description = $6,
 			networks = $7,
 			hosts = $8,
-			resource_version = $9`
+			included_groups = $9,
+			resource_version = $10`
 
 	if err := w.exec(ctx, addressGroupQuery,
 		ag.Namespace,
@@ -155,6 +163,7 @@ func (w *Writer) upsertAddressGroup(ctx context.Context, ag models.AddressGroup)
 		"",
 		networksJSON,
 		hostsJSON,
+		includedGroupsJSON,
 		resourceVersion,
 	); err != nil {
 		return errors.Wrapf(err, "failed to upsert address group %s/%s", ag.Namespace, ag.Name)
@@ -190,20 +199,9 @@ func (w *Writer) deleteAddressGroupsByIdentifiers(ctx context.Context, identifie
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range identifiers {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(identifiers, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM address_groups WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM address_groups WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete address groups by identifiers")
@@ -297,6 +295,9 @@ func (w *Writer) upsertAddressGroupBinding(ctx context.Context, binding models.A
 			return errors.Wrapf(err, "failed to create K8s metadata for address group binding %s/%s", binding.Namespace, binding.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, binding.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of address group binding %s", binding.Key())
+	}
 
 	// Then, upsert the address group binding using the resource version
 	bindingQuery := `
@@ -351,20 +352,9 @@ func (w *Writer) deleteAddressGroupBindingsByIdentifiers(ctx context.Context, id
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range identifiers {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(identifiers, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM address_group_bindings WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM address_group_bindings WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete address group bindings by identifiers")
@@ -443,6 +433,9 @@ func (w *Writer) upsertAddressGroupPortMapping(ctx context.Context, mapping mode
 			return errors.Wrapf(err, "failed to create K8s metadata for address group port mapping %s/%s", mapping.Namespace, mapping.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, mapping.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of address group port mapping %s", mapping.Key())
+	}
 
 	// Then, upsert the address group port mapping using the resource version
 	portMappingQuery := `
@@ -491,20 +484,9 @@ func (w *Writer) deleteAddressGroupPortMappingsByIdentifiers(ctx context.Context
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range identifiers {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(identifiers, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM address_group_port_mappings WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM address_group_port_mappings WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete address group port mappings by identifiers")
@@ -576,6 +558,9 @@ func (w *Writer) upsertAddressGroupBindingPolicy(ctx context.Context, policy mod
 			return errors.Wrapf(err, "failed to create K8s metadata for address group binding policy %s/%s", policy.Namespace, policy.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, policy.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of address group binding policy %s", policy.Key())
+	}
 
 	addressGroupRefJSON, err := json.Marshal(policy.AddressGroupRef)
 	if err != nil {
@@ -648,20 +633,9 @@ func (w *Writer) DeleteAddressGroupBindingPoliciesByIDs(ctx context.Context, ids
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range ids {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(ids, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM address_group_binding_policies WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM address_group_binding_policies WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete address group binding policies by identifiers")
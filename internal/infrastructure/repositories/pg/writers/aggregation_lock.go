@@ -0,0 +1,26 @@
+package writers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// aggregationLockPrefix separates aggregation locks from other advisory locks of the database
+const aggregationLockPrefix = "netguard-aggregation:"
+
+// LockAggregationKeys takes transaction scoped advisory locks of the aggregation keys. Keys are locked
+// in sorted order so concurrent transactions can't deadlock on them; hash collisions only serialize
+// unrelated keys.
+func (w *Writer) LockAggregationKeys(ctx context.Context, keys []string) error {
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		if _, err := w.tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, aggregationLockPrefix+key); err != nil {
+			return errors.Wrapf(err, "failed to lock aggregation key %s", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package writers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
+)
+
+// upsertBatchRows bounds the rows of one multi-row statement.
+// PostgreSQL accepts at most 65535 bind parameters, the widest batched row has 12 of them.
+const upsertBatchRows = 1000
+
+// batchUpsert describes a resource table written by upsertBatch
+type batchUpsert struct {
+	// table is the resource table keyed by (namespace, name)
+	table string
+	// columns are the resource columns between name and resource_version
+	columns []string
+	// identity stores UID and Generation in k8s_metadata. A missing UID is taken from the
+	// stored resource or generated for a new one.
+	identity bool
+}
+
+// batchRow is a resource written by upsertBatch
+type batchRow struct {
+	id models.ResourceIdentifier
+	// meta points into the synced slice, the resolved UID is visible to the caller
+	meta *models.Meta
+	// values of batchUpsert.columns
+	values []interface{}
+}
+
+// storedResource is the stored row of a batched resource
+type storedResource struct {
+	resourceVersion int64
+	uid             string
+}
+
+// upsertBatch writes rows with multi-row INSERT ... ON CONFLICT DO UPDATE statements,
+// a few statements per upsertBatchRows resources instead of several per resource.
+// When a resource repeats the last occurrence wins, like the former per-row upserts.
+func (w *Writer) upsertBatch(ctx context.Context, spec batchUpsert, rows []batchRow) error {
+	rows = lastOccurrences(rows)
+	for start := 0; start < len(rows); start += upsertBatchRows {
+		end := min(start+upsertBatchRows, len(rows))
+		if err := w.upsertBatchChunk(ctx, spec, rows[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) upsertBatchChunk(ctx context.Context, spec batchUpsert, rows []batchRow) error {
+	stored, err := w.storedResources(ctx, spec.table, rows)
+	if err != nil {
+		return err
+	}
+
+	newRows := 0
+	for _, row := range rows {
+		if _, ok := stored[row.id.Key()]; !ok {
+			newRows++
+		}
+	}
+	newVersions, err := w.allocateResourceVersions(ctx, newRows)
+	if err != nil {
+		return err
+	}
+
+	resourceVersions := make([]int64, len(rows))
+	for i, row := range rows {
+		existing, ok := stored[row.id.Key()]
+		if ok {
+			resourceVersions[i] = existing.resourceVersion
+		} else {
+			resourceVersions[i], newVersions = newVersions[0], newVersions[1:]
+		}
+		if spec.identity && row.meta.UID == "" {
+			if ok && existing.uid != "" {
+				row.meta.UID = existing.uid
+			} else {
+				row.meta.TouchOnCreate()
+			}
+		}
+	}
+
+	if err := w.upsertMetadataBatch(ctx, spec.identity, rows, resourceVersions); err != nil {
+		return errors.Wrapf(err, "failed to upsert K8s metadata of %d rows of %s", len(rows), spec.table)
+	}
+
+	columns := append(append([]string{"namespace", "name"}, spec.columns...), "resource_version")
+	args := make([]interface{}, 0, len(rows)*len(columns))
+	for i, row := range rows {
+		args = append(append(append(args, row.id.Namespace, row.id.Name), row.values...), resourceVersions[i])
+	}
+	updates := make([]string, 0, len(columns)-2)
+	for _, column := range columns[2:] {
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES %s
+		ON CONFLICT (namespace, name) DO UPDATE SET %s`,
+		spec.table, strings.Join(columns, ", "), valuesPlaceholders(len(rows), len(columns)), strings.Join(updates, ", "))
+	if err := w.exec(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to upsert %d rows of %s", len(rows), spec.table)
+	}
+	return nil
+}
+
+// storedResources returns the stored rows of table for rows, by resource key
+func (w *Writer) storedResources(ctx context.Context, table string, rows []batchRow) (map[string]storedResource, error) {
+	ids := make([]models.ResourceIdentifier, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.id)
+	}
+	whereClause, args := utils.BuildIdentifiersFilter(ids, "t", 1)
+	query := fmt.Sprintf(`
+		SELECT t.namespace, t.name, t.resource_version, COALESCE(km.uid::text, '')
+		FROM %s t
+		LEFT JOIN k8s_metadata km ON km.resource_version = t.resource_version
+		WHERE %s`,
+		table, whereClause)
+
+	result, err := w.tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query stored rows of %s", table)
+	}
+	defer result.Close()
+
+	stored := make(map[string]storedResource, len(rows))
+	for result.Next() {
+		var namespace, name string
+		var resource storedResource
+		if err := result.Scan(&namespace, &name, &resource.resourceVersion, &resource.uid); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan stored row of %s", table)
+		}
+		stored[models.NewResourceIdentifier(name, models.WithNamespace(namespace)).Key()] = resource
+	}
+	if err := result.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read stored rows of %s", table)
+	}
+	return stored, nil
+}
+
+// allocateResourceVersions reserves the resource versions of n new k8s_metadata rows
+func (w *Writer) allocateResourceVersions(ctx context.Context, n int) ([]int64, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	result, err := w.tx.Query(ctx, `
+		SELECT nextval(pg_get_serial_sequence('k8s_metadata', 'resource_version'))
+		FROM generate_series(1, $1)`, n)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to allocate resource versions")
+	}
+	defer result.Close()
+
+	versions := make([]int64, 0, n)
+	for result.Next() {
+		var version int64
+		if err := result.Scan(&version); err != nil {
+			return nil, errors.Wrap(err, "failed to scan resource version")
+		}
+		versions = append(versions, version)
+	}
+	if err := result.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to allocate resource versions")
+	}
+	if len(versions) != n {
+		return nil, errors.Errorf("allocated %d resource versions instead of %d", len(versions), n)
+	}
+	return versions, nil
+}
+
+// upsertMetadataBatch writes the k8s_metadata rows of rows with their resource versions,
+// the same fields the per-row upserts wrote including the lifecycle metadata
+func (w *Writer) upsertMetadataBatch(ctx context.Context, identity bool, rows []batchRow, resourceVersions []int64) error {
+	columns := []string{"resource_version", "labels", "annotations", "conditions", "finalizers", "deletion_timestamp", "owner_references"}
+	if identity {
+		columns = append(columns, "uid", "generation")
+	}
+
+	args := make([]interface{}, 0, len(rows)*len(columns))
+	for i, row := range rows {
+		labelsJSON, annotationsJSON, err := w.marshalLabelsAnnotations(row.meta.Labels, row.meta.Annotations)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal K8s metadata of %s", row.id.Key())
+		}
+		conditionsJSON, err := json.Marshal(row.meta.Conditions)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal conditions of %s", row.id.Key())
+		}
+		finalizers := row.meta.Finalizers
+		if finalizers == nil {
+			finalizers = []string{}
+		}
+		var deletionTimestamp interface{}
+		if row.meta.DeletionTimestamp != nil {
+			deletionTimestamp = row.meta.DeletionTimestamp.Time
+		}
+		ownerReferences := row.meta.OwnerReferences
+		if ownerReferences == nil {
+			ownerReferences = []metav1.OwnerReference{}
+		}
+		ownerReferencesJSON, err := json.Marshal(ownerReferences)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal owner references of %s", row.id.Key())
+		}
+
+		args = append(args, resourceVersions[i], labelsJSON, annotationsJSON, conditionsJSON, finalizers, deletionTimestamp, ownerReferencesJSON)
+		if identity {
+			args = append(args, row.meta.UID, row.meta.Generation)
+		}
+	}
+
+	updates := []string{"updated_at = NOW()"}
+	for _, column := range columns[1:] {
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO k8s_metadata (%s)
+		VALUES %s
+		ON CONFLICT (resource_version) DO UPDATE SET %s`,
+		strings.Join(columns, ", "), valuesPlaceholders(len(rows), len(columns)), strings.Join(updates, ", "))
+	return w.exec(ctx, query, args...)
+}
+
+// lastOccurrences keeps the last row of every resource in the order of first occurrence:
+// a multi-row ON CONFLICT DO UPDATE cannot update the same row twice
+func lastOccurrences(rows []batchRow) []batchRow {
+	positions := make(map[string]int, len(rows))
+	unique := make([]batchRow, 0, len(rows))
+	for _, row := range rows {
+		if i, ok := positions[row.id.Key()]; ok {
+			unique[i] = row
+			continue
+		}
+		positions[row.id.Key()] = len(unique)
+		unique = append(unique, row)
+	}
+	return unique
+}
+
+// valuesPlaceholders returns the VALUES list "($1, $2), ($3, $4)" of rows with width parameters
+func valuesPlaceholders(rows, width int) string {
+	var b strings.Builder
+	param := 1
+	for r := 0; r < rows; r++ {
+		if r > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteByte('(')
+		for c := 0; c < width; c++ {
+			if c > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "$%d", param)
+			param++
+		}
+		b.WriteByte(')')
+	}
+	return b.String()
+}
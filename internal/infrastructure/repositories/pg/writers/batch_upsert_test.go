@@ -0,0 +1,32 @@
+package writers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+func TestValuesPlaceholders(t *testing.T) {
+	assert.Equal(t, "($1, $2, $3)", valuesPlaceholders(1, 3))
+	assert.Equal(t, "($1, $2), ($3, $4), ($5, $6)", valuesPlaceholders(3, 2))
+}
+
+func TestLastOccurrences(t *testing.T) {
+	web := models.NewResourceIdentifier("web", models.WithNamespace("prod"))
+	api := models.NewResourceIdentifier("api", models.WithNamespace("prod"))
+
+	rows := lastOccurrences([]batchRow{
+		{id: web, values: []interface{}{"v1"}},
+		{id: api, values: []interface{}{"v1"}},
+		{id: web, values: []interface{}{"v2"}},
+	})
+
+	// A multi-row upsert must not contain a resource twice, the last version wins
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, web, rows[0].id)
+		assert.Equal(t, []interface{}{"v2"}, rows[0].values)
+		assert.Equal(t, api, rows[1].id)
+	}
+}
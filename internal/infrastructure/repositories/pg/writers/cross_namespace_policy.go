@@ -0,0 +1,139 @@
+package writers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// SyncCrossNamespacePolicies syncs cross namespace policies to PostgreSQL with K8s metadata support
+func (w *Writer) SyncCrossNamespacePolicies(ctx context.Context, policies []models.CrossNamespacePolicy, scope ports.Scope, options ...ports.Option) error {
+	// Extract sync operation from options
+	syncOp := models.SyncOpUpsert // Default operation
+	for _, opt := range options {
+		if syncOption, ok := opt.(ports.SyncOption); ok {
+			syncOp = syncOption.Operation
+			break
+		}
+	}
+
+	// Handle scoped sync - delete existing resources in scope first (for non-DELETE operations)
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() && syncOp != models.SyncOpDelete {
+		if err := w.DeleteCrossNamespacePoliciesByIDs(ctx, ris.Identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete cross namespace policies in scope")
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpDelete:
+		identifiers := make([]models.ResourceIdentifier, 0, len(policies))
+		for _, policy := range policies {
+			identifiers = append(identifiers, policy.ResourceIdentifier)
+		}
+		if err := w.DeleteCrossNamespacePoliciesByIDs(ctx, identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete cross namespace policies")
+		}
+	case models.SyncOpUpsert, models.SyncOpFullSync:
+		for _, policy := range policies {
+			if err := w.upsertCrossNamespacePolicy(ctx, policy); err != nil {
+				return errors.Wrapf(err, "failed to upsert cross namespace policy %s", policy.Key())
+			}
+		}
+	default:
+		return errors.Errorf("unsupported sync operation: %v", syncOp)
+	}
+
+	return nil
+}
+
+// upsertCrossNamespacePolicy inserts or updates a cross namespace policy with K8s metadata
+func (w *Writer) upsertCrossNamespacePolicy(ctx context.Context, policy models.CrossNamespacePolicy) error {
+	// Marshal K8s metadata
+	labelsJSON, annotationsJSON, err := w.marshalLabelsAnnotations(policy.Meta.Labels, policy.Meta.Annotations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal K8s metadata")
+	}
+
+	conditionsJSON, err := json.Marshal(policy.Meta.Conditions)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal conditions")
+	}
+
+	// First, check if the policy exists and get existing resource version
+	var existingResourceVersion sql.NullInt64
+	existingQuery := `SELECT resource_version FROM cross_namespace_policies WHERE namespace = $1 AND name = $2`
+	_ = w.tx.QueryRow(ctx, existingQuery, policy.Namespace, policy.Name).Scan(&existingResourceVersion)
+
+	var resourceVersion int64
+	if existingResourceVersion.Valid {
+		metadataQuery := `
+			UPDATE k8s_metadata
+			SET labels = $1, annotations = $2, conditions = $3, updated_at = NOW()
+			WHERE resource_version = $4
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON, existingResourceVersion.Int64).Scan(&resourceVersion)
+	} else {
+		metadataQuery := `
+			INSERT INTO k8s_metadata (labels, annotations, conditions)
+			VALUES ($1, $2, $3)
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON).Scan(&resourceVersion)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to save K8s metadata for cross namespace policy %s", policy.Key())
+	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, policy.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of cross namespace policy %s", policy.Key())
+	}
+
+	allowedNamespaces := policy.AllowedNamespaces
+	if allowedNamespaces == nil {
+		allowedNamespaces = []string{}
+	}
+
+	query := `
+		INSERT INTO cross_namespace_policies (namespace, name, allowed_namespaces, resource_version)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (namespace, name) DO UPDATE SET
+			allowed_namespaces = EXCLUDED.allowed_namespaces,
+			resource_version = EXCLUDED.resource_version`
+
+	_, err = w.tx.Exec(ctx, query, policy.Namespace, policy.Name, allowedNamespaces, resourceVersion)
+	if err != nil {
+		return errors.Wrapf(err, "failed to upsert cross namespace policy %s", policy.Key())
+	}
+
+	return nil
+}
+
+// DeleteCrossNamespacePoliciesByIDs deletes cross namespace policies by their resource identifiers
+func (w *Writer) DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, options ...ports.Option) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Build IN clause for (namespace, name) pairs
+	var values []string
+	var args []interface{}
+	argIndex := 1
+
+	for _, id := range ids {
+		values = append(values, fmt.Sprintf("($%d, $%d)", argIndex, argIndex+1))
+		args = append(args, id.Namespace, id.Name)
+		argIndex += 2
+	}
+
+	query := fmt.Sprintf(`DELETE FROM cross_namespace_policies WHERE (namespace, name) IN (%s)`, strings.Join(values, ","))
+	if _, err := w.tx.Exec(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "failed to delete cross namespace policies by IDs")
+	}
+
+	return nil
+}
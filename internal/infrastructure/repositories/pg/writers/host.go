@@ -105,6 +105,9 @@ func (w *Writer) upsertHost(ctx context.Context, host models.Host) error {
 			return errors.Wrapf(err, "failed to insert K8s metadata for host %s/%s", host.Namespace, host.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, host.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of host %s", host.Key())
+	}
 
 	// Prepare nullable status fields
 	var hostNameSync, addressGroupName *string
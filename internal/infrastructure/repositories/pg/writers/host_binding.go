@@ -104,6 +104,9 @@ func (w *Writer) upsertHostBinding(ctx context.Context, hostBinding models.HostB
 			return errors.Wrapf(err, "failed to insert K8s metadata for host binding %s/%s", hostBinding.Namespace, hostBinding.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, hostBinding.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of host binding %s", hostBinding.Key())
+	}
 
 	// UPSERT host binding record
 	hostBindingQuery := `
@@ -2,16 +2,15 @@ package writers
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
 )
 
 // SyncIEAgAgRules syncs IEAgAgRule resources to PostgreSQL with K8s metadata support
@@ -42,7 +41,17 @@ func (w *Writer) SyncIEAgAgRules(ctx context.Context, rules []models.IEAgAgRule,
 		}
 	}
 
-	// Upsert all provided rules
+	// Upsert all provided rules in batches
+	if err := w.upsertIEAgAgRules(ctx, rules); err != nil {
+		return errors.Wrap(err, "failed to upsert ieagag rules")
+	}
+
+	return nil
+}
+
+// upsertIEAgAgRules inserts or updates ieagag rules with full K8s metadata support (table name: ie_ag_ag_rules)
+func (w *Writer) upsertIEAgAgRules(ctx context.Context, rules []models.IEAgAgRule) error {
+	rows := make([]batchRow, 0, len(rules))
 	for i := range rules {
 		// Initialize metadata fields (UID, Generation, ObservedGeneration)
 		// This is what Memory backend does via ensureMetaFill() -> TouchOnCreate()
@@ -51,103 +60,46 @@ func (w *Writer) SyncIEAgAgRules(ctx context.Context, rules []models.IEAgAgRule,
 		if rules[i].Meta.UID == "" {
 			rules[i].Meta.TouchOnCreate()
 		}
-
-		if err := w.upsertIEAgAgRule(ctx, rules[i]); err != nil {
-			return errors.Wrapf(err, "failed to upsert ieagag rule %s/%s", rules[i].Namespace, rules[i].Name)
-		}
-	}
-
-	return nil
-}
-
-// upsertIEAgAgRule inserts or updates an ieagag rule with full K8s metadata support
-func (w *Writer) upsertIEAgAgRule(ctx context.Context, rule models.IEAgAgRule) error {
-	// Marshal K8s metadata
-	labelsJSON, annotationsJSON, err := w.marshalLabelsAnnotations(rule.Meta.Labels, rule.Meta.Annotations)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal K8s metadata")
-	}
-
-	conditionsJSON, err := json.Marshal(rule.Meta.Conditions)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal conditions")
-	}
-
-	var existingResourceVersion sql.NullInt64
-	existingQuery := `SELECT resource_version FROM ie_ag_ag_rules WHERE namespace = $1 AND name = $2`
-	_ = w.tx.QueryRow(ctx, existingQuery, rule.Namespace, rule.Name).Scan(&existingResourceVersion)
-
-	var resourceVersion int64
-	if existingResourceVersion.Valid {
-		// UPDATE existing K8s metadata
-		metadataQuery := `
-			UPDATE k8s_metadata
-			SET labels = $1, annotations = $2, conditions = $3, updated_at = NOW()
-			WHERE resource_version = $4
-			RETURNING resource_version`
-		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON, existingResourceVersion.Int64).Scan(&resourceVersion)
-		if err != nil {
-			return errors.Wrapf(err, "failed to update K8s metadata for ieagag rule %s/%s", rule.Namespace, rule.Name)
-		}
-	} else {
-		metadataQuery := `
-			INSERT INTO k8s_metadata (labels, annotations, finalizers, conditions)
-			VALUES ($1, $2, '{}', $3)
-			RETURNING resource_version`
-		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON).Scan(&resourceVersion)
-		if err != nil {
-			return errors.Wrapf(err, "failed to create K8s metadata for ieagag rule %s/%s", rule.Namespace, rule.Name)
-		}
-	}
-
-	// Marshal ports array to JSON
-	var portsJSON []byte
-	if len(rule.Ports) > 0 {
-		portsJSON, err = json.Marshal(rule.Ports)
-		if err != nil {
-			return errors.Wrap(err, "failed to marshal ports")
+		rule := &rules[i]
+
+		// Marshal ports array to JSON
+		portsJSON := []byte("[]")
+		if len(rule.Ports) > 0 {
+			var err error
+			portsJSON, err = json.Marshal(rule.Ports)
+			if err != nil {
+				return errors.Wrapf(err, "failed to marshal ports of ieagag rule %s/%s", rule.Namespace, rule.Name)
+			}
 		}
-	} else {
-		portsJSON = []byte("[]")
-	}
-
-	// Then, upsert the ieagag rule using the resource version (table name: ie_ag_ag_rules)
-	ruleQuery := `
-		INSERT INTO ie_ag_ag_rules (namespace, name, transport, traffic,
-			address_group_local_namespace, address_group_local_name,
-			address_group_namespace, address_group_name,
-			ports, action, trace, resource_version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-		ON CONFLICT (namespace, name) DO UPDATE SET
-			transport = $3,
-			traffic = $4,
-			address_group_local_namespace = $5,
-			address_group_local_name = $6,
-			address_group_namespace = $7,
-			address_group_name = $8,
-			ports = $9,
-			action = $10,
-			trace = $11,
-			resource_version = $12`
-
-	if err := w.exec(ctx, ruleQuery,
-		rule.Namespace,
-		rule.Name,
-		string(rule.Transport),
-		string(rule.Traffic),
-		rule.AddressGroupLocal.Namespace,
-		rule.AddressGroupLocal.Name,
-		rule.AddressGroup.Namespace,
-		rule.AddressGroup.Name,
-		portsJSON,
-		string(rule.Action),
-		rule.Trace,
-		resourceVersion,
-	); err != nil {
-		return errors.Wrapf(err, "failed to upsert ieagag rule %s/%s", rule.Namespace, rule.Name)
-	}
 
-	return nil
+		rows = append(rows, batchRow{
+			id:   rule.ResourceIdentifier,
+			meta: &rule.Meta,
+			values: []interface{}{
+				string(rule.Transport),
+				string(rule.Traffic),
+				rule.AddressGroupLocal.Namespace,
+				rule.AddressGroupLocal.Name,
+				rule.AddressGroup.Namespace,
+				rule.AddressGroup.Name,
+				portsJSON,
+				string(rule.Action),
+				rule.Priority,
+				rule.Logs,
+				rule.Trace,
+			},
+		})
+	}
+
+	return w.upsertBatch(ctx, batchUpsert{
+		table: "ie_ag_ag_rules",
+		columns: []string{
+			"transport", "traffic",
+			"address_group_local_namespace", "address_group_local_name",
+			"address_group_namespace", "address_group_name",
+			"ports", "action", "priority", "logs", "trace",
+		},
+	}, rows)
 }
 
 // updateIEAgAgRuleConditionsOnly updates only the conditions in k8s_metadata for condition-only operations
@@ -211,20 +163,9 @@ func (w *Writer) DeleteIEAgAgRulesByIDs(ctx context.Context, ids []models.Resour
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range ids {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(ids, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM ie_ag_ag_rules WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM ie_ag_ag_rules WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete ieagag rules by identifiers")
@@ -0,0 +1,63 @@
+package writers
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// ReplaceIEAgAgRuleContributions replaces the aggregation index of the given IEAgAg rules in the writer transaction,
+// nil ids replace the whole index and mark it as built
+func (w *Writer) ReplaceIEAgAgRuleContributions(ctx context.Context, ieAgAgRuleIDs []models.ResourceIdentifier, contributions []models.IEAgAgRuleContribution) error {
+	// Not counted in affected rows: the index is derived from RuleS2S and is not a part of the synced state
+	if ieAgAgRuleIDs == nil {
+		if _, err := w.tx.Exec(ctx, `DELETE FROM ieagag_rule_contributions`); err != nil {
+			return errors.Wrap(err, "failed to clear IEAgAg rule contributions")
+		}
+		_, err := w.tx.Exec(ctx, `
+			INSERT INTO ieagag_rule_contribution_index (id, built_at)
+			VALUES (1, NOW())
+			ON CONFLICT (id) DO UPDATE SET built_at = EXCLUDED.built_at`)
+		if err != nil {
+			return errors.Wrap(err, "failed to mark IEAgAg rule contribution index as built")
+		}
+	} else if len(ieAgAgRuleIDs) > 0 {
+		namespaces := make([]string, len(ieAgAgRuleIDs))
+		names := make([]string, len(ieAgAgRuleIDs))
+		for i, id := range ieAgAgRuleIDs {
+			namespaces[i] = id.Namespace
+			names[i] = id.Name
+		}
+		_, err := w.tx.Exec(ctx, `
+			DELETE FROM ieagag_rule_contributions
+			WHERE (ieagag_rule_namespace, ieagag_rule_name) IN (SELECT * FROM unnest($1::text[], $2::text[]))`,
+			namespaces, names)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete IEAgAg rule contributions")
+		}
+	}
+
+	for _, contribution := range contributions {
+		_, err := w.tx.Exec(ctx, `
+			INSERT INTO ieagag_rule_contributions (rule_s2s_namespace, rule_s2s_name, ieagag_rule_namespace, ieagag_rule_name, ports)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT DO NOTHING`,
+			contribution.RuleS2S.Namespace, contribution.RuleS2S.Name,
+			contribution.IEAgAgRule.Namespace, contribution.IEAgAgRule.Name,
+			contributionPorts(contribution))
+		if err != nil {
+			return errors.Wrapf(err, "failed to store IEAgAg rule contribution %s", contribution.Key())
+		}
+	}
+	return nil
+}
+
+// contributionPorts returns ports of the contribution as a non-nil array for the NOT NULL column
+func contributionPorts(contribution models.IEAgAgRuleContribution) []string {
+	if contribution.Ports == nil {
+		return []string{}
+	}
+	return contribution.Ports
+}
@@ -0,0 +1,137 @@
+package writers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// SyncNamespacePostures syncs namespace postures to PostgreSQL with K8s metadata support
+func (w *Writer) SyncNamespacePostures(ctx context.Context, postures []models.NamespacePosture, scope ports.Scope, options ...ports.Option) error {
+	// Extract sync operation from options
+	syncOp := models.SyncOpUpsert // Default operation
+	for _, opt := range options {
+		if syncOption, ok := opt.(ports.SyncOption); ok {
+			syncOp = syncOption.Operation
+			break
+		}
+	}
+
+	// Handle scoped sync - delete existing resources in scope first (for non-DELETE operations)
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() && syncOp != models.SyncOpDelete {
+		if err := w.DeleteNamespacePosturesByIDs(ctx, ris.Identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete namespace postures in scope")
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpDelete:
+		identifiers := make([]models.ResourceIdentifier, 0, len(postures))
+		for _, posture := range postures {
+			identifiers = append(identifiers, posture.ResourceIdentifier)
+		}
+		if err := w.DeleteNamespacePosturesByIDs(ctx, identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete namespace postures")
+		}
+	case models.SyncOpUpsert, models.SyncOpFullSync:
+		for _, posture := range postures {
+			if err := w.upsertNamespacePosture(ctx, posture); err != nil {
+				return errors.Wrapf(err, "failed to upsert namespace posture %s", posture.Key())
+			}
+		}
+	default:
+		return errors.Errorf("unsupported sync operation: %v", syncOp)
+	}
+
+	return nil
+}
+
+// upsertNamespacePosture inserts or updates a namespace posture with K8s metadata
+func (w *Writer) upsertNamespacePosture(ctx context.Context, posture models.NamespacePosture) error {
+	// Marshal K8s metadata
+	labelsJSON, annotationsJSON, err := w.marshalLabelsAnnotations(posture.Meta.Labels, posture.Meta.Annotations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal K8s metadata")
+	}
+
+	conditionsJSON, err := json.Marshal(posture.Meta.Conditions)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal conditions")
+	}
+
+	// First, check if the posture exists and get existing resource version
+	var existingResourceVersion sql.NullInt64
+	existingQuery := `SELECT resource_version FROM namespace_postures WHERE namespace = $1 AND name = $2`
+	_ = w.tx.QueryRow(ctx, existingQuery, posture.Namespace, posture.Name).Scan(&existingResourceVersion)
+
+	var resourceVersion int64
+	if existingResourceVersion.Valid {
+		metadataQuery := `
+			UPDATE k8s_metadata
+			SET labels = $1, annotations = $2, conditions = $3, updated_at = NOW()
+			WHERE resource_version = $4
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON, existingResourceVersion.Int64).Scan(&resourceVersion)
+	} else {
+		metadataQuery := `
+			INSERT INTO k8s_metadata (labels, annotations, conditions)
+			VALUES ($1, $2, $3)
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON).Scan(&resourceVersion)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to save K8s metadata for namespace posture %s", posture.Key())
+	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, posture.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of namespace posture %s", posture.Key())
+	}
+
+	query := `
+		INSERT INTO namespace_postures (namespace, name, mode, resource_version)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (namespace, name) DO UPDATE SET
+			mode = EXCLUDED.mode,
+			resource_version = EXCLUDED.resource_version`
+
+	_, err = w.tx.Exec(ctx, query,
+		posture.Namespace, posture.Name, string(posture.Mode),
+		resourceVersion,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to upsert namespace posture %s", posture.Key())
+	}
+
+	return nil
+}
+
+// DeleteNamespacePosturesByIDs deletes namespace postures by their resource identifiers
+func (w *Writer) DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, options ...ports.Option) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Build IN clause for (namespace, name) pairs
+	var values []string
+	var args []interface{}
+	argIndex := 1
+
+	for _, id := range ids {
+		values = append(values, fmt.Sprintf("($%d, $%d)", argIndex, argIndex+1))
+		args = append(args, id.Namespace, id.Name)
+		argIndex += 2
+	}
+
+	query := fmt.Sprintf(`DELETE FROM namespace_postures WHERE (namespace, name) IN (%s)`, strings.Join(values, ","))
+	if _, err := w.tx.Exec(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "failed to delete namespace postures by IDs")
+	}
+
+	return nil
+}
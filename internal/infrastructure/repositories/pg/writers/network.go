@@ -12,6 +12,7 @@ import (
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
 )
 
 // CIDRAlreadyExistsError represents a CIDR uniqueness violation error
@@ -42,6 +43,39 @@ func isUniqueViolation(err error, constraintName string) bool {
 	return false
 }
 
+// ReferenceViolation converts a PostgreSQL foreign_key_violation into ports.ErrReferenceViolation.
+// Reference constraints are deferred, so violations are reported by the commit.
+func ReferenceViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return fmt.Errorf("%w: %s (%s)", ports.ErrReferenceViolation, pgErr.Detail, pgErr.ConstraintName)
+	}
+	return err
+}
+
+// TransactionError marks PostgreSQL serialization_failure and deadlock_detected errors
+// with ports.ErrSerializationFailure, lock_not_available of lock_timeout, query_canceled of
+// statement_timeout and an exceeded transaction deadline with ports.ErrTransactionTimeout.
+// Both mean the failed transaction can be run again.
+func TransactionError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", "40P01":
+			return fmt.Errorf("%w: %w", ports.ErrSerializationFailure, err)
+		case "55P03", "57014":
+			return fmt.Errorf("%w: %w", ports.ErrTransactionTimeout, err)
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%w: %w", ports.ErrTransactionTimeout, err)
+	}
+	return err
+}
+
 // SyncNetworks syncs networks to PostgreSQL with K8s metadata support
 func (w *Writer) SyncNetworks(ctx context.Context, networks []models.Network, scope ports.Scope, options ...ports.Option) error {
 	// Handle scoped sync - delete existing resources in scope first
@@ -110,6 +144,9 @@ func (w *Writer) upsertNetwork(ctx context.Context, network models.Network) erro
 			return errors.Wrapf(err, "failed to create K8s metadata for network %s/%s", network.Namespace, network.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, network.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of network %s", network.Key())
+	}
 
 	// Create network items with single CIDR entry
 	networkItems := []map[string]interface{}{
@@ -201,20 +238,9 @@ func (w *Writer) DeleteNetworksByIDs(ctx context.Context, ids []models.ResourceI
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range ids {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(ids, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM networks WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM networks WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete networks by identifiers")
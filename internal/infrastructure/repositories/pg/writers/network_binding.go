@@ -5,12 +5,12 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/pkg/errors"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
 )
 
 // SyncNetworkBindings syncs network bindings to PostgreSQL with K8s metadata support
@@ -81,6 +81,9 @@ func (w *Writer) upsertNetworkBinding(ctx context.Context, binding models.Networ
 			return errors.Wrapf(err, "failed to create K8s metadata for network binding %s/%s", binding.Namespace, binding.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, binding.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of network binding %s", binding.Key())
+	}
 
 	// Then, upsert the network binding using the resource version
 	bindingQuery := `
@@ -135,20 +138,9 @@ func (w *Writer) DeleteNetworkBindingsByIDs(ctx context.Context, ids []models.Re
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range ids {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(ids, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM network_bindings WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM network_bindings WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete network bindings by identifiers")
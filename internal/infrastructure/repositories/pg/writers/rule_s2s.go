@@ -5,13 +5,13 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
 )
 
 // SyncRuleS2S syncs RuleS2S resources to PostgreSQL with K8s metadata support
@@ -120,6 +120,9 @@ func (w *Writer) upsertRuleS2S(ctx context.Context, rule models.RuleS2S) error {
 			return errors.Wrapf(err, "failed to create K8s metadata for rule s2s %s/%s", rule.Namespace, rule.Name)
 		}
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, rule.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of rule s2s %s", rule.Key())
+	}
 
 	// Marshal reference fields to JSON
 	serviceLocalRefJSON, err := json.Marshal(rule.ServiceLocalRef)
@@ -143,17 +146,37 @@ func (w *Writer) upsertRuleS2S(ctx context.Context, rule models.RuleS2S) error {
 		ieagagRuleRefsJSON = []byte("[]")
 	}
 
+	extraPortsJSON, err := w.marshalIngressPorts(rule.ExtraPorts)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal extra_ports")
+	}
+
+	// Prepare nullable validity window
+	var validFrom, validUntil *time.Time
+	if !rule.ValidFrom.IsZero() {
+		validFrom = &rule.ValidFrom
+	}
+	if !rule.ValidUntil.IsZero() {
+		validUntil = &rule.ValidUntil
+	}
+
 	// Then, upsert the rule s2s using the resource version
 	ruleQuery := `
-		INSERT INTO rule_s2s (namespace, name, traffic, service_local_ref, service_ref, ieagag_rule_refs, trace, resource_version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO rule_s2s (namespace, name, traffic, service_local_ref, service_ref, ieagag_rule_refs, trace, action, ports_source, extra_ports, valid_from, valid_until, priority, resource_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		ON CONFLICT (namespace, name) DO UPDATE SET
 			traffic = $3,
 			service_local_ref = $4,
 			service_ref = $5,
 			ieagag_rule_refs = $6,
 			trace = $7,
-			resource_version = $8`
+			action = $8,
+			ports_source = $9,
+			extra_ports = $10,
+			valid_from = $11,
+			valid_until = $12,
+			priority = $13,
+			resource_version = $14`
 
 	if err := w.exec(ctx, ruleQuery,
 		rule.Namespace,
@@ -163,6 +186,12 @@ func (w *Writer) upsertRuleS2S(ctx context.Context, rule models.RuleS2S) error {
 		serviceRefJSON,
 		ieagagRuleRefsJSON,
 		rule.Trace,
+		string(rule.EffectiveAction()),
+		string(rule.PortsSource),
+		extraPortsJSON,
+		validFrom,
+		validUntil,
+		rule.Priority,
 		resourceVersion,
 	); err != nil {
 		return errors.Wrapf(err, "failed to upsert rule s2s %s/%s", rule.Namespace, rule.Name)
@@ -198,20 +227,9 @@ func (w *Writer) DeleteRuleS2SByIDs(ctx context.Context, ids []models.ResourceId
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range ids {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(ids, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM rule_s2s WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM rule_s2s WHERE %s`, whereClause)
 
 	// Execute the delete and capture the result
 	result, err := w.tx.Exec(ctx, query, args...)
@@ -0,0 +1,150 @@
+package writers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// SyncRuleS2SExceptions syncs RuleS2S exceptions to PostgreSQL with K8s metadata support
+func (w *Writer) SyncRuleS2SExceptions(ctx context.Context, exceptions []models.RuleS2SException, scope ports.Scope, options ...ports.Option) error {
+	// Extract sync operation from options
+	syncOp := models.SyncOpUpsert // Default operation
+	for _, opt := range options {
+		if syncOption, ok := opt.(ports.SyncOption); ok {
+			syncOp = syncOption.Operation
+			break
+		}
+	}
+
+	// Handle scoped sync - delete existing resources in scope first (for non-DELETE operations)
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() && syncOp != models.SyncOpDelete {
+		if err := w.DeleteRuleS2SExceptionsByIDs(ctx, ris.Identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete rule s2s exceptions in scope")
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpDelete:
+		identifiers := make([]models.ResourceIdentifier, 0, len(exceptions))
+		for _, exception := range exceptions {
+			identifiers = append(identifiers, exception.ResourceIdentifier)
+		}
+		if err := w.DeleteRuleS2SExceptionsByIDs(ctx, identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete rule s2s exceptions")
+		}
+	case models.SyncOpUpsert, models.SyncOpFullSync:
+		for _, exception := range exceptions {
+			if err := w.upsertRuleS2SException(ctx, exception); err != nil {
+				return errors.Wrapf(err, "failed to upsert rule s2s exception %s", exception.Key())
+			}
+		}
+	default:
+		return errors.Errorf("unsupported sync operation: %v", syncOp)
+	}
+
+	return nil
+}
+
+// upsertRuleS2SException inserts or updates a RuleS2S exception with K8s metadata
+func (w *Writer) upsertRuleS2SException(ctx context.Context, exception models.RuleS2SException) error {
+	// Marshal K8s metadata
+	labelsJSON, annotationsJSON, err := w.marshalLabelsAnnotations(exception.Meta.Labels, exception.Meta.Annotations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal K8s metadata")
+	}
+
+	conditionsJSON, err := json.Marshal(exception.Meta.Conditions)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal conditions")
+	}
+
+	// First, check if the exception exists and get existing resource version
+	var existingResourceVersion sql.NullInt64
+	existingQuery := `SELECT resource_version FROM rule_s2s_exceptions WHERE namespace = $1 AND name = $2`
+	_ = w.tx.QueryRow(ctx, existingQuery, exception.Namespace, exception.Name).Scan(&existingResourceVersion)
+
+	var resourceVersion int64
+	if existingResourceVersion.Valid {
+		metadataQuery := `
+			UPDATE k8s_metadata
+			SET labels = $1, annotations = $2, conditions = $3, updated_at = NOW()
+			WHERE resource_version = $4
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON, existingResourceVersion.Int64).Scan(&resourceVersion)
+	} else {
+		metadataQuery := `
+			INSERT INTO k8s_metadata (labels, annotations, conditions)
+			VALUES ($1, $2, $3)
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON).Scan(&resourceVersion)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to save K8s metadata for rule s2s exception %s", exception.Key())
+	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, exception.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of rule s2s exception %s", exception.Key())
+	}
+
+	query := `
+		INSERT INTO rule_s2s_exceptions (
+			namespace, name, traffic,
+			address_group_local_namespace, address_group_local_name,
+			address_group_namespace, address_group_name,
+			transport, ports, resource_version
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (namespace, name) DO UPDATE SET
+			traffic = EXCLUDED.traffic,
+			address_group_local_namespace = EXCLUDED.address_group_local_namespace,
+			address_group_local_name = EXCLUDED.address_group_local_name,
+			address_group_namespace = EXCLUDED.address_group_namespace,
+			address_group_name = EXCLUDED.address_group_name,
+			transport = EXCLUDED.transport,
+			ports = EXCLUDED.ports,
+			resource_version = EXCLUDED.resource_version`
+
+	_, err = w.tx.Exec(ctx, query,
+		exception.Namespace, exception.Name, string(exception.Traffic),
+		exception.AddressGroupLocal.Namespace, exception.AddressGroupLocal.Name,
+		exception.AddressGroup.Namespace, exception.AddressGroup.Name,
+		string(exception.Transport), exception.Ports,
+		resourceVersion,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to upsert rule s2s exception %s", exception.Key())
+	}
+
+	return nil
+}
+
+// DeleteRuleS2SExceptionsByIDs deletes RuleS2S exceptions by their resource identifiers
+func (w *Writer) DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, options ...ports.Option) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Build IN clause for (namespace, name) pairs
+	var values []string
+	var args []interface{}
+	argIndex := 1
+
+	for _, id := range ids {
+		values = append(values, fmt.Sprintf("($%d, $%d)", argIndex, argIndex+1))
+		args = append(args, id.Namespace, id.Name)
+		argIndex += 2
+	}
+
+	query := fmt.Sprintf(`DELETE FROM rule_s2s_exceptions WHERE (namespace, name) IN (%s)`, strings.Join(values, ","))
+	if _, err := w.tx.Exec(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "failed to delete rule s2s exceptions by IDs")
+	}
+
+	return nil
+}
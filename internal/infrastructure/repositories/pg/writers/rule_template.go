@@ -0,0 +1,163 @@
+package writers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// SyncRuleTemplates syncs rule templates to PostgreSQL with K8s metadata support
+func (w *Writer) SyncRuleTemplates(ctx context.Context, templates []models.RuleTemplate, scope ports.Scope, options ...ports.Option) error {
+	// Extract sync operation from options
+	syncOp := models.SyncOpUpsert // Default operation
+	for _, opt := range options {
+		if syncOption, ok := opt.(ports.SyncOption); ok {
+			syncOp = syncOption.Operation
+			break
+		}
+	}
+
+	// Handle scoped sync - delete existing resources in scope first (for non-DELETE operations)
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok && !ris.IsEmpty() && syncOp != models.SyncOpDelete {
+		if err := w.DeleteRuleTemplatesByIDs(ctx, ris.Identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete rule templates in scope")
+		}
+	}
+
+	switch syncOp {
+	case models.SyncOpDelete:
+		identifiers := make([]models.ResourceIdentifier, 0, len(templates))
+		for _, template := range templates {
+			identifiers = append(identifiers, template.ResourceIdentifier)
+		}
+		if err := w.DeleteRuleTemplatesByIDs(ctx, identifiers); err != nil {
+			return errors.Wrap(err, "failed to delete rule templates")
+		}
+	case models.SyncOpUpsert, models.SyncOpFullSync:
+		for _, template := range templates {
+			if err := w.upsertRuleTemplate(ctx, template); err != nil {
+				return errors.Wrapf(err, "failed to upsert rule template %s", template.Key())
+			}
+		}
+	default:
+		return errors.Errorf("unsupported sync operation: %v", syncOp)
+	}
+
+	return nil
+}
+
+// upsertRuleTemplate inserts or updates a rule template with K8s metadata
+func (w *Writer) upsertRuleTemplate(ctx context.Context, template models.RuleTemplate) error {
+	// Marshal K8s metadata
+	labelsJSON, annotationsJSON, err := w.marshalLabelsAnnotations(template.Meta.Labels, template.Meta.Annotations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal K8s metadata")
+	}
+
+	conditionsJSON, err := json.Marshal(template.Meta.Conditions)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal conditions")
+	}
+
+	// First, check if the template exists and get existing resource version
+	var existingResourceVersion sql.NullInt64
+	existingQuery := `SELECT resource_version FROM rule_templates WHERE namespace = $1 AND name = $2`
+	_ = w.tx.QueryRow(ctx, existingQuery, template.Namespace, template.Name).Scan(&existingResourceVersion)
+
+	var resourceVersion int64
+	if existingResourceVersion.Valid {
+		metadataQuery := `
+			UPDATE k8s_metadata
+			SET labels = $1, annotations = $2, conditions = $3, updated_at = NOW()
+			WHERE resource_version = $4
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON, existingResourceVersion.Int64).Scan(&resourceVersion)
+	} else {
+		metadataQuery := `
+			INSERT INTO k8s_metadata (labels, annotations, conditions)
+			VALUES ($1, $2, $3)
+			RETURNING resource_version`
+		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON).Scan(&resourceVersion)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to save K8s metadata for rule template %s", template.Key())
+	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, template.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of rule template %s", template.Key())
+	}
+
+	localSelectorJSON, err := json.Marshal(selectorOrEmpty(template.LocalServiceSelector))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal local service selector")
+	}
+	targetSelectorJSON, err := json.Marshal(selectorOrEmpty(template.TargetServiceSelector))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal target service selector")
+	}
+
+	query := `
+		INSERT INTO rule_templates (
+			namespace, name, traffic,
+			local_service_selector, target_service_selector,
+			action, trace, resource_version
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (namespace, name) DO UPDATE SET
+			traffic = EXCLUDED.traffic,
+			local_service_selector = EXCLUDED.local_service_selector,
+			target_service_selector = EXCLUDED.target_service_selector,
+			action = EXCLUDED.action,
+			trace = EXCLUDED.trace,
+			resource_version = EXCLUDED.resource_version`
+
+	_, err = w.tx.Exec(ctx, query,
+		template.Namespace, template.Name, string(template.Traffic),
+		localSelectorJSON, targetSelectorJSON,
+		string(template.Action), template.Trace,
+		resourceVersion,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "failed to upsert rule template %s", template.Key())
+	}
+
+	return nil
+}
+
+// DeleteRuleTemplatesByIDs deletes rule templates by their resource identifiers
+func (w *Writer) DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, options ...ports.Option) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Build IN clause for (namespace, name) pairs
+	var values []string
+	var args []interface{}
+	argIndex := 1
+
+	for _, id := range ids {
+		values = append(values, fmt.Sprintf("($%d, $%d)", argIndex, argIndex+1))
+		args = append(args, id.Namespace, id.Name)
+		argIndex += 2
+	}
+
+	query := fmt.Sprintf(`DELETE FROM rule_templates WHERE (namespace, name) IN (%s)`, strings.Join(values, ","))
+	if _, err := w.tx.Exec(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "failed to delete rule templates by IDs")
+	}
+
+	return nil
+}
+
+// selectorOrEmpty returns an empty selector instead of nil, so it is stored as a JSON object
+func selectorOrEmpty(selector map[string]string) map[string]string {
+	if selector == nil {
+		return map[string]string{}
+	}
+	return selector
+}
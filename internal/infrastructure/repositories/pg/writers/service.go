@@ -2,15 +2,14 @@ package writers
 
 import (
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/pkg/errors"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
 )
 
 // addressGroupRefJSON represents the JSON structure for address group references in the database
@@ -70,25 +69,10 @@ func (w *Writer) SyncServices(ctx context.Context, services []models.Service, sc
 			return errors.Wrap(err, "failed to delete services")
 		}
 	case models.SyncOpUpsert, models.SyncOpFullSync:
-		// For UPSERT/FULLSYNC operations, upsert all provided services
-		for i := range services {
-			// For UPDATE operations, preserve existing UID from database
-			// Only call TouchOnCreate() for truly new resources
-			// Check if this service already exists and get its UID
-			if services[i].Meta.UID == "" {
-				existingUID, err := w.getExistingServiceUID(ctx, services[i].Namespace, services[i].Name)
-				if err == nil && existingUID != "" {
-					// Resource exists, preserve existing UID
-					services[i].Meta.UID = existingUID
-				} else {
-					// New resource, generate UID
-					services[i].Meta.TouchOnCreate()
-				}
-			}
-
-			if err := w.upsertService(ctx, services[i]); err != nil {
-				return errors.Wrapf(err, "failed to upsert service %s/%s", services[i].Namespace, services[i].Name)
-			}
+		// For UPSERT/FULLSYNC operations, upsert all provided services in batches.
+		// Existing services keep their stored UID, only new ones get a generated UID.
+		if err := w.upsertServices(ctx, services); err != nil {
+			return errors.Wrap(err, "failed to upsert services")
 		}
 	default:
 		return errors.Errorf("unsupported sync operation: %v", syncOp)
@@ -97,123 +81,49 @@ func (w *Writer) SyncServices(ctx context.Context, services []models.Service, sc
 	return nil
 }
 
-// upsertService inserts or updates a service with full K8s metadata support
-func (w *Writer) upsertService(ctx context.Context, service models.Service) error {
-	// Marshal ingress ports to JSON
-	ingressPortsJSON, err := w.marshalIngressPorts(service.IngressPorts)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal ingress ports")
-	}
-
-	// Marshal address_groups to JSON using intermediate structure
-	var addressGroupsJSON []byte
-	if len(service.AddressGroups) > 0 {
-		// Convert domain AddressGroups to intermediate JSON structure for database
-		agRefs := make([]addressGroupRefJSON, len(service.AddressGroups))
-		for i, ag := range service.AddressGroups {
-			agRefs[i] = addressGroupRefJSON{
-				APIVersion: "netguard.sgroups.io/v1beta1",
-				Kind:       "AddressGroup",
-				Name:       ag.Name,
-				Namespace:  ag.Namespace,
-			}
-		}
-		var err error
-		addressGroupsJSON, err = json.Marshal(agRefs)
-		if err != nil {
-			return errors.Wrap(err, "failed to marshal address_groups")
-		}
-	} else {
-		addressGroupsJSON = []byte("[]")
-	}
-
-	// Marshal K8s metadata
-	labelsJSON, annotationsJSON, err := w.marshalLabelsAnnotations(service.Meta.Labels, service.Meta.Annotations)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal K8s metadata")
-	}
+// upsertServices inserts or updates services with full K8s metadata support
+func (w *Writer) upsertServices(ctx context.Context, services []models.Service) error {
+	rows := make([]batchRow, 0, len(services))
+	for i := range services {
+		service := &services[i]
 
-	conditionsJSON, err := json.Marshal(service.Meta.Conditions)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal conditions")
-	}
-
-	// First, check if service exists and get existing resource version
-	var existingResourceVersion sql.NullInt64
-	existingQuery := `SELECT resource_version FROM services WHERE namespace = $1 AND name = $2`
-	err = w.tx.QueryRow(ctx, existingQuery, service.Namespace, service.Name).Scan(&existingResourceVersion)
-
-	// Note: sql.ErrNoRows is expected for new services, not an actual error
-	if err != nil {
-		if err != sql.ErrNoRows && err.Error() != "no rows in result set" {
-			return errors.Wrapf(err, "failed to check existing service %s/%s", service.Namespace, service.Name)
-		}
-		// Reset err to nil for sql.ErrNoRows or "no rows in result set"
-		err = nil
-	}
-
-	var resourceVersion int64
-	if existingResourceVersion.Valid {
-		// UPDATE existing K8s metadata with UID and Generation
-		metadataQuery := `
-			UPDATE k8s_metadata
-			SET labels = $1, annotations = $2, conditions = $3, uid = $4, generation = $5, updated_at = NOW()
-			WHERE resource_version = $6
-			RETURNING resource_version`
-		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON, service.Meta.UID, service.Meta.Generation, existingResourceVersion.Int64).Scan(&resourceVersion)
+		// Marshal ingress ports to JSON
+		ingressPortsJSON, err := w.marshalIngressPorts(service.IngressPorts)
 		if err != nil {
-			return errors.Wrapf(err, "failed to update K8s metadata for service %s/%s", service.Namespace, service.Name)
+			return errors.Wrapf(err, "failed to marshal ingress ports of service %s/%s", service.Namespace, service.Name)
 		}
-	} else {
-		// INSERT new K8s metadata with UID and Generation from TouchOnCreate()
-		metadataQuery := `
-			INSERT INTO k8s_metadata (labels, annotations, finalizers, conditions, uid, generation)
-			VALUES ($1, $2, '{}', $3, $4, $5)
-			RETURNING resource_version`
-		err = w.tx.QueryRow(ctx, metadataQuery, labelsJSON, annotationsJSON, conditionsJSON, service.Meta.UID, service.Meta.Generation).Scan(&resourceVersion)
-		if err != nil {
-			return errors.Wrapf(err, "failed to create K8s metadata for service %s/%s", service.Namespace, service.Name)
+
+		// Marshal address_groups to JSON using intermediate structure
+		addressGroupsJSON := []byte("[]")
+		if len(service.AddressGroups) > 0 {
+			// Convert domain AddressGroups to intermediate JSON structure for database
+			agRefs := make([]addressGroupRefJSON, len(service.AddressGroups))
+			for i, ag := range service.AddressGroups {
+				agRefs[i] = addressGroupRefJSON{
+					APIVersion: "netguard.sgroups.io/v1beta1",
+					Kind:       "AddressGroup",
+					Name:       ag.Name,
+					Namespace:  ag.Namespace,
+				}
+			}
+			addressGroupsJSON, err = json.Marshal(agRefs)
+			if err != nil {
+				return errors.Wrapf(err, "failed to marshal address_groups of service %s/%s", service.Namespace, service.Name)
+			}
 		}
-	}
 
-	// Then, upsert the service using the resource version
-	serviceQuery := `
-		INSERT INTO services (namespace, name, description, ingress_ports, address_groups, resource_version)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		ON CONFLICT (namespace, name) DO UPDATE SET
-			description = $3,
-			ingress_ports = $4,
-			address_groups = $5,
-			resource_version = $6`
-
-	if err := w.exec(ctx, serviceQuery,
-		service.Namespace,
-		service.Name,
-		service.Description,
-		ingressPortsJSON,
-		addressGroupsJSON,
-		resourceVersion,
-	); err != nil {
-		return errors.Wrapf(err, "failed to upsert service %s/%s", service.Namespace, service.Name)
+		rows = append(rows, batchRow{
+			id:     service.ResourceIdentifier,
+			meta:   &service.Meta,
+			values: []interface{}{service.Description, ingressPortsJSON, addressGroupsJSON},
+		})
 	}
 
-	return nil
-}
-
-// getExistingServiceUID retrieves the UID of an existing service from the database
-func (w *Writer) getExistingServiceUID(ctx context.Context, namespace, name string) (string, error) {
-	var uid string
-	query := `
-		SELECT km.uid
-		FROM services s
-		JOIN k8s_metadata km ON s.resource_version = km.resource_version
-		WHERE s.namespace = $1 AND s.name = $2`
-
-	err := w.tx.QueryRow(ctx, query, namespace, name).Scan(&uid)
-	if err != nil {
-		return "", err // sql.ErrNoRows expected for new services
-	}
-	return uid, nil
+	return w.upsertBatch(ctx, batchUpsert{
+		table:    "services",
+		columns:  []string{"description", "ingress_ports", "address_groups"},
+		identity: true,
+	}, rows)
 }
 
 // updateServiceConditionsOnly updates only the conditions in k8s_metadata for condition-only operations
@@ -273,20 +183,9 @@ func (w *Writer) deleteServicesByIdentifiers(ctx context.Context, identifiers []
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range identifiers {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(identifiers, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM services WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM services WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete services by identifiers")
@@ -386,6 +285,9 @@ func (w *Writer) upsertServiceAlias(ctx context.Context, alias models.ServiceAli
 	if err != nil {
 		return errors.Wrapf(err, "failed to create K8s metadata for service alias %s/%s", alias.Namespace, alias.Name)
 	}
+	if err := w.saveLifecycleMeta(ctx, resourceVersion, alias.Meta); err != nil {
+		return errors.Wrapf(err, "failed to save lifecycle metadata of service alias %s", alias.Key())
+	}
 
 	// Then, upsert the service alias using the resource version
 	serviceAliasQuery := `
@@ -435,26 +337,51 @@ func (w *Writer) DeleteServicesByIDs(ctx context.Context, ids []models.ResourceI
 	return w.deleteServicesByIdentifiers(ctx, ids)
 }
 
+// DeleteServicesCascade deletes services and removes their entries from the access ports of
+// all port mappings in two statements. Access ports are keyed by "namespace/name" of the
+// service, so the entries are removed by the key array without decoding the mappings.
+func (w *Writer) DeleteServicesCascade(ctx context.Context, ids []models.ResourceIdentifier) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id.Namespace + "/" + id.Name
+	}
+
+	var changed int
+	err := w.tx.QueryRow(ctx, `
+		WITH mappings AS (
+			UPDATE address_group_port_mappings
+			SET access_ports = access_ports - $1::text[]
+			WHERE access_ports ?| $1::text[]
+			RETURNING resource_version
+		), metadata AS (
+			UPDATE k8s_metadata SET updated_at = NOW()
+			WHERE resource_version IN (SELECT resource_version FROM mappings)
+		)
+		SELECT COUNT(*) FROM mappings`, keys).Scan(&changed)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to remove deleted services from port mappings")
+	}
+	w.addAffectedRows(int64(changed))
+
+	if err := w.deleteServicesByIdentifiers(ctx, ids); err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
 // deleteServiceAliasesByIdentifiers deletes specific service aliases by their identifiers (internal helper for SyncServiceAliases)
 func (w *Writer) deleteServiceAliasesByIdentifiers(ctx context.Context, identifiers []models.ResourceIdentifier) error {
 	if len(identifiers) == 0 {
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range identifiers {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(identifiers, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM service_aliases WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM service_aliases WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete service aliases by identifiers")
@@ -469,20 +396,9 @@ func (w *Writer) DeleteServiceAliasesByIDs(ctx context.Context, ids []models.Res
 		return nil
 	}
 
-	// Build parameter placeholders and collect args
-	var conditions []string
-	var args []interface{}
-	argIndex := 1
-
-	for _, id := range ids {
-		conditions = append(conditions, fmt.Sprintf("(namespace = $%d AND name = $%d)", argIndex, argIndex+1))
-		args = append(args, id.Namespace, id.Name)
-		argIndex += 2
-	}
-
+	whereClause, args := utils.BuildIdentifiersFilter(ids, "", 1)
 	query := fmt.Sprintf(`
-		DELETE FROM service_aliases WHERE %s`,
-		strings.Join(conditions, " OR "))
+		DELETE FROM service_aliases WHERE %s`, whereClause)
 
 	if err := w.exec(ctx, query, args...); err != nil {
 		return errors.Wrap(err, "failed to delete service aliases by identifiers")
@@ -1,57 +1,22 @@
 package writers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/pg/internal/utils"
 )
 
-// buildScopeFilter builds WHERE clause and arguments for scope filtering
+// buildScopeFilter builds WHERE clause and arguments for scope filtering, the same
+// clause as the readers use for their scopes
 func (w *Writer) buildScopeFilter(scope ports.Scope, tableAlias string) (string, []interface{}) {
-	if scope.IsEmpty() {
-		return "", nil
-	}
-
-	switch s := scope.(type) {
-	case ports.ResourceIdentifierScope:
-		if len(s.Identifiers) == 0 {
-			return "", nil
-		}
-
-		// Build IN clauses for namespace and name pairs
-		var conditions []string
-		var args []interface{}
-		argIndex := 1
-
-		for _, id := range s.Identifiers {
-			// Handle namespace-only filtering (when name is empty, filter only by namespace)
-			if id.Name == "" {
-				condition := fmt.Sprintf("(%s.namespace = $%d)",
-					tableAlias, argIndex)
-				conditions = append(conditions, condition)
-				args = append(args, id.Namespace)
-				argIndex += 1
-			} else {
-				// Handle specific resource filtering (namespace + name)
-				condition := fmt.Sprintf("(%s.namespace = $%d AND %s.name = $%d)",
-					tableAlias, argIndex, tableAlias, argIndex+1)
-				conditions = append(conditions, condition)
-				args = append(args, id.Namespace, id.Name)
-				argIndex += 2
-			}
-		}
-
-		return "(" + strings.Join(conditions, " OR ") + ")", args
-
-	default:
-		// For other scope types, return empty filter
-		return "", nil
-	}
+	return utils.BuildScopeFilter(scope, tableAlias)
 }
 
 // marshalIngressPorts converts domain IngressPort slice to JSONB
@@ -123,3 +88,32 @@ func (w *Writer) marshalAccessPorts(accessPorts map[models.ServiceRef]models.Ser
 
 	return json.Marshal(jsonMap)
 }
+
+// saveLifecycleMeta stores the finalizers, the deletion timestamp and the owner references
+// of a resource in its K8s metadata
+func (w *Writer) saveLifecycleMeta(ctx context.Context, resourceVersion int64, meta models.Meta) error {
+	finalizers := meta.Finalizers
+	if finalizers == nil {
+		finalizers = []string{}
+	}
+
+	var deletionTimestamp interface{}
+	if meta.DeletionTimestamp != nil {
+		deletionTimestamp = meta.DeletionTimestamp.Time
+	}
+
+	ownerReferences := meta.OwnerReferences
+	if ownerReferences == nil {
+		ownerReferences = []metav1.OwnerReference{}
+	}
+	ownerReferencesJSON, err := json.Marshal(ownerReferences)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal owner references")
+	}
+
+	query := `
+		UPDATE k8s_metadata
+		SET finalizers = $1, deletion_timestamp = $2, owner_references = $3
+		WHERE resource_version = $4`
+	return w.exec(ctx, query, finalizers, deletionTimestamp, ownerReferencesJSON, resourceVersion)
+}
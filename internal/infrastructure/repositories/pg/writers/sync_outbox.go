@@ -0,0 +1,30 @@
+package writers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// EnqueueSyncOutbox stores sgroups sync operations in the writer transaction
+func (w *Writer) EnqueueSyncOutbox(ctx context.Context, entries []models.SyncOutboxEntry) error {
+	for _, entry := range entries {
+		payload, err := json.Marshal(entry.Resource)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal %s outbox entry", entry.Kind)
+		}
+
+		// Not counted in affected rows: the outbox is not a part of the synced state
+		_, err = w.tx.Exec(ctx, `
+			INSERT INTO sync_outbox (kind, operation, payload, created_at, next_attempt_at)
+			VALUES ($1, $2, $3, $4, $4)`,
+			entry.Kind, string(entry.Operation), payload, entry.CreatedAt)
+		if err != nil {
+			return errors.Wrapf(err, "failed to enqueue %s %s sync", entry.Kind, entry.Operation)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package writers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+func TestTransactionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"Deadlock", &pgconn.PgError{Code: "40P01"}, ports.ErrSerializationFailure},
+		{"SerializationFailure", &pgconn.PgError{Code: "40001"}, ports.ErrSerializationFailure},
+		{"LockTimeout", &pgconn.PgError{Code: "55P03"}, ports.ErrTransactionTimeout},
+		{"StatementTimeout", &pgconn.PgError{Code: "57014"}, ports.ErrTransactionTimeout},
+		{"TransactionDeadline", context.DeadlineExceeded, ports.ErrTransactionTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := TransactionError(errors.Wrap(tt.err, "failed to sync services"))
+			assert.ErrorIs(t, err, tt.want)
+			assert.True(t, ports.IsRetryable(err))
+		})
+	}
+
+	uniqueViolation := &pgconn.PgError{Code: "23505"}
+	assert.Same(t, uniqueViolation, TransactionError(uniqueViolation))
+	assert.False(t, ports.IsRetryable(TransactionError(context.Canceled)))
+	assert.NoError(t, TransactionError(nil))
+}
@@ -0,0 +1,216 @@
+// Package readcache caches hot lookups of the repository readers by resource identifier,
+// such as GetServiceByID during aggregation. Entries expire after a TTL and the whole cache
+// is invalidated when a write transaction commits: database triggers update resources of
+// other kinds (bindings update the aggregated address groups of services), so a write
+// cannot tell which cached resources it changed. Writes of other replicas and a lagging
+// read replica are only bounded by the TTL.
+package readcache
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// Config configures the read cache
+type Config struct {
+	Enabled bool `yaml:"enabled" env:"READ_CACHE_ENABLED"`
+	// TTL bounds how long a cached resource can miss writes of other replicas
+	TTL time.Duration `yaml:"ttl" env:"READ_CACHE_TTL"`
+	// MaxEntries bounds the cached resources per kind
+	MaxEntries int `yaml:"max-entries" env:"READ_CACHE_MAX_ENTRIES"`
+}
+
+// DefaultConfig returns the default read cache configuration, the cache is disabled
+func DefaultConfig() Config {
+	return Config{
+		TTL:        5 * time.Second,
+		MaxEntries: 10000,
+	}
+}
+
+// Validate validates the read cache configuration
+func (c Config) Validate() error {
+	if c.TTL <= 0 {
+		return fmt.Errorf("read cache ttl must be positive")
+	}
+	if c.MaxEntries <= 0 {
+		return fmt.Errorf("read cache max entries must be positive")
+	}
+	return nil
+}
+
+type entry struct {
+	value   any
+	expires time.Time
+}
+
+type kindCache struct {
+	entries               map[string]entry
+	hits, misses, evicted int64
+}
+
+// KindStats are the lookup totals of a resource kind
+type KindStats struct {
+	Kind    string `json:"kind"`
+	Entries int    `json:"entries"`
+	Hits    int64  `json:"hits"`
+	Misses  int64  `json:"misses"`
+	Evicted int64  `json:"evicted"`
+}
+
+// Cache is a per kind cache of resources keyed by resource identifier
+type Cache struct {
+	config Config
+	now    func() time.Time
+
+	mu sync.Mutex
+	// generation grows with every invalidation. Loads started before an invalidation
+	// are not stored, they may have read the state before the write.
+	generation    uint64
+	kinds         map[string]*kindCache
+	invalidations int64
+}
+
+// New creates an empty cache
+func New(config Config) *Cache {
+	return &Cache{
+		config: config,
+		now:    time.Now,
+		kinds:  make(map[string]*kindCache),
+	}
+}
+
+func (c *Cache) kind(kind string) *kindCache {
+	k, ok := c.kinds[kind]
+	if !ok {
+		k = &kindCache{entries: make(map[string]entry)}
+		c.kinds[kind] = k
+	}
+	return k
+}
+
+// get returns the cached resource and the generation a load on a miss must be stored with
+func (c *Cache) get(kind, key string) (any, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := c.kind(kind)
+	if e, ok := k.entries[key]; ok {
+		if c.now().Before(e.expires) {
+			k.hits++
+			return e.value, c.generation, true
+		}
+		delete(k.entries, key)
+	}
+	k.misses++
+	return nil, c.generation, false
+}
+
+// put stores a resource loaded at generation unless the cache was invalidated meanwhile
+func (c *Cache) put(kind, key string, value any, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	k := c.kind(kind)
+	if _, ok := k.entries[key]; !ok && len(k.entries) >= c.config.MaxEntries {
+		now := c.now()
+		for existing, e := range k.entries {
+			if !now.Before(e.expires) {
+				delete(k.entries, existing)
+			}
+		}
+		// Without expired entries an arbitrary entry makes room
+		for existing := range k.entries {
+			if len(k.entries) < c.config.MaxEntries {
+				break
+			}
+			delete(k.entries, existing)
+			k.evicted++
+		}
+	}
+	k.entries[key] = entry{value: value, expires: c.now().Add(c.config.TTL)}
+}
+
+// Invalidate drops all cached resources, it is called after a write transaction commits
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.invalidations++
+	for _, k := range c.kinds {
+		k.entries = make(map[string]entry)
+	}
+}
+
+// Lookup returns the resource of kind with id from the cache or loads it. Callers get
+// their own copy made by clone, they may modify it. Load errors such as ports.ErrNotFound
+// are returned without caching.
+func Lookup[T any](c *Cache, kind string, id models.ResourceIdentifier, clone func(*T) *T, load func() (*T, error)) (*T, error) {
+	key := id.Key()
+	value, generation, ok := c.get(kind, key)
+	if ok {
+		return clone(value.(*T)), nil
+	}
+
+	loaded, err := load()
+	if err != nil || loaded == nil {
+		return loaded, err
+	}
+	c.put(kind, key, clone(loaded), generation)
+	return loaded, nil
+}
+
+// Stats returns the lookup totals sorted by kind and the number of invalidations
+func (c *Cache) Stats() ([]KindStats, int64) {
+	c.mu.Lock()
+	stats := make([]KindStats, 0, len(c.kinds))
+	for kind, k := range c.kinds {
+		stats = append(stats, KindStats{Kind: kind, Entries: len(k.entries), Hits: k.hits, Misses: k.misses, Evicted: k.evicted})
+	}
+	invalidations := c.invalidations
+	c.mu.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Kind < stats[j].Kind })
+	return stats, invalidations
+}
+
+// WriteMetrics writes cache metrics in the Prometheus text format
+func WriteMetrics(w io.Writer, c *Cache) error {
+	metrics := []struct {
+		name, help, kind string
+		value            func(KindStats) float64
+	}{
+		{"netguard_read_cache_hits_total", "Reader lookups served from the read cache", "counter",
+			func(s KindStats) float64 { return float64(s.Hits) }},
+		{"netguard_read_cache_misses_total", "Reader lookups loaded from storage", "counter",
+			func(s KindStats) float64 { return float64(s.Misses) }},
+		{"netguard_read_cache_evictions_total", "Cached resources evicted to stay within max entries", "counter",
+			func(s KindStats) float64 { return float64(s.Evicted) }},
+		{"netguard_read_cache_entries", "Resources in the read cache", "gauge",
+			func(s KindStats) float64 { return float64(s.Entries) }},
+	}
+
+	stats, invalidations := c.Stats()
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind); err != nil {
+			return err
+		}
+		for _, s := range stats {
+			if _, err := fmt.Fprintf(w, "%s{kind=%q} %g\n", metric.name, s.Kind, metric.value(s)); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(w, "# HELP netguard_read_cache_invalidations_total Read cache invalidations by committed writes\n"+
+		"# TYPE netguard_read_cache_invalidations_total counter\nnetguard_read_cache_invalidations_total %d\n", invalidations)
+	return err
+}
@@ -0,0 +1,104 @@
+package readcache
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+type resource struct {
+	Value string
+}
+
+func cloneResource(r *resource) *resource {
+	clone := *r
+	return &clone
+}
+
+func TestLookup(t *testing.T) {
+	cache := New(Config{Enabled: true, TTL: time.Second, MaxEntries: 10})
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	id := models.NewResourceIdentifier("web", models.WithNamespace("prod"))
+	loads := 0
+	load := func() (*resource, error) {
+		loads++
+		return &resource{Value: "v1"}, nil
+	}
+
+	first, err := Lookup(cache, "Service", id, cloneResource, load)
+	require.NoError(t, err)
+	first.Value = "modified"
+
+	// Callers get copies, modifying one does not change the cached resource
+	second, err := Lookup(cache, "Service", id, cloneResource, load)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", second.Value)
+	assert.Equal(t, 1, loads)
+
+	now = now.Add(time.Second)
+	_, err = Lookup(cache, "Service", id, cloneResource, load)
+	require.NoError(t, err)
+	assert.Equal(t, 2, loads, "expired resources must be loaded again")
+
+	cache.Invalidate()
+	_, err = Lookup(cache, "Service", id, cloneResource, load)
+	require.NoError(t, err)
+	assert.Equal(t, 3, loads, "invalidated resources must be loaded again")
+
+	// Missing resources are not cached
+	missing := models.NewResourceIdentifier("missing", models.WithNamespace("prod"))
+	for i := 0; i < 2; i++ {
+		_, err = Lookup(cache, "Service", missing, cloneResource, func() (*resource, error) { return nil, ports.ErrNotFound })
+		assert.ErrorIs(t, err, ports.ErrNotFound)
+	}
+
+	stats, invalidations := cache.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, KindStats{Kind: "Service", Entries: 1, Hits: 1, Misses: 5}, stats[0])
+	assert.Equal(t, int64(1), invalidations)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteMetrics(&buf, cache))
+	assert.Contains(t, buf.String(), `netguard_read_cache_hits_total{kind="Service"} 1`)
+	assert.Contains(t, buf.String(), "netguard_read_cache_invalidations_total 1")
+}
+
+func TestLookup_LoadDuringInvalidationIsNotCached(t *testing.T) {
+	cache := New(Config{Enabled: true, TTL: time.Minute, MaxEntries: 10})
+	id := models.NewResourceIdentifier("web", models.WithNamespace("prod"))
+
+	// A write commits while the resource is loaded, the loaded state may be outdated
+	_, err := Lookup(cache, "Service", id, cloneResource, func() (*resource, error) {
+		cache.Invalidate()
+		return &resource{Value: "stale"}, nil
+	})
+	require.NoError(t, err)
+
+	loaded, err := Lookup(cache, "Service", id, cloneResource, func() (*resource, error) {
+		return &resource{Value: "fresh"}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fresh", loaded.Value)
+}
+
+func TestPut_EvictsToMaxEntries(t *testing.T) {
+	cache := New(Config{Enabled: true, TTL: time.Minute, MaxEntries: 2})
+	for _, name := range []string{"a", "b", "c"} {
+		id := models.NewResourceIdentifier(name, models.WithNamespace("prod"))
+		_, err := Lookup(cache, "AddressGroup", id, cloneResource, func() (*resource, error) { return &resource{Value: name}, nil })
+		require.NoError(t, err)
+	}
+
+	stats, _ := cache.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, 2, stats[0].Entries)
+	assert.Equal(t, int64(1), stats[0].Evicted)
+}
@@ -0,0 +1,201 @@
+// Package readstats records how many rows List calls of the repositories scanned and
+// returned per resource, scope type and calling code path. The in-memory reader filters
+// scoped lists by scanning whole tables, PostgreSQL filters them in SQL, so its scanned
+// rows are the rows the query returned. Unscoped lists read whole tables: callers with
+// many rows listed under the "empty" scope still do ListAll-and-filter.
+package readstats
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Scope types of List calls
+const (
+	ScopeEmpty       = "empty"
+	ScopeNamespace   = "namespace"
+	ScopeIdentifiers = "identifiers"
+	ScopeOther       = "other"
+)
+
+// ScopeType returns the scope type label of a List call
+func ScopeType(scope ports.Scope) string {
+	if scope == nil || scope.IsEmpty() {
+		return ScopeEmpty
+	}
+	ris, ok := scope.(ports.ResourceIdentifierScope)
+	if !ok {
+		return ScopeOther
+	}
+	for _, id := range ris.Identifiers {
+		if id.Name == "" {
+			return ScopeNamespace
+		}
+	}
+	return ScopeIdentifiers
+}
+
+// ListStats are the totals of List calls of a resource with a scope type from a caller
+type ListStats struct {
+	Resource string `json:"resource"`
+	Scope    string `json:"scope"`
+	// Caller is the first function outside the repositories that called List
+	Caller   string `json:"caller"`
+	Calls    int64  `json:"calls"`
+	Scanned  int64  `json:"scanned"`
+	Returned int64  `json:"returned"`
+}
+
+// Selectivity returns the share of scanned rows that were returned, 1 if nothing was scanned
+func (s ListStats) Selectivity() float64 {
+	if s.Scanned == 0 {
+		return 1
+	}
+	return float64(s.Returned) / float64(s.Scanned)
+}
+
+type listKey struct {
+	resource, scope, caller string
+}
+
+// Recorder accumulates ListStats
+type Recorder struct {
+	mu    sync.Mutex
+	lists map[listKey]*ListStats
+}
+
+// NewRecorder creates an empty recorder
+func NewRecorder() *Recorder {
+	return &Recorder{lists: make(map[listKey]*ListStats)}
+}
+
+var defaultRecorder = NewRecorder()
+
+// Default returns the recorder of the repository readers
+func Default() *Recorder {
+	return defaultRecorder
+}
+
+// List counts rows of a single List call
+type List struct {
+	recorder *Recorder
+	key      listKey
+	scanned  int64
+	returned int64
+}
+
+// Begin starts counting a List call of the resource on the default recorder
+func Begin(resource string, scope ports.Scope) *List {
+	return defaultRecorder.begin(resource, scope, caller())
+}
+
+func (r *Recorder) begin(resource string, scope ports.Scope, caller string) *List {
+	return &List{recorder: r, key: listKey{resource: resource, scope: ScopeType(scope), caller: caller}}
+}
+
+// Scan counts a row read from storage
+func (l *List) Scan() {
+	l.scanned++
+}
+
+// Returned wraps consume to count the rows passed to it
+func Returned[T any](l *List, consume func(T) error) func(T) error {
+	return func(item T) error {
+		l.returned++
+		return consume(item)
+	}
+}
+
+// End records the call
+func (l *List) End() {
+	r := l.recorder
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, exists := r.lists[l.key]
+	if !exists {
+		stats = &ListStats{Resource: l.key.resource, Scope: l.key.scope, Caller: l.key.caller}
+		r.lists[l.key] = stats
+	}
+	stats.Calls++
+	stats.Scanned += l.scanned
+	stats.Returned += l.returned
+}
+
+// Stats returns the recorded totals sorted by resource, scope and caller
+func (r *Recorder) Stats() []ListStats {
+	r.mu.Lock()
+	stats := make([]ListStats, 0, len(r.lists))
+	for _, s := range r.lists {
+		stats = append(stats, *s)
+	}
+	r.mu.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Resource != stats[j].Resource {
+			return stats[i].Resource < stats[j].Resource
+		}
+		if stats[i].Scope != stats[j].Scope {
+			return stats[i].Scope < stats[j].Scope
+		}
+		return stats[i].Caller < stats[j].Caller
+	})
+	return stats
+}
+
+const (
+	repositoriesPackage = "netguard-pg-backend/internal/infrastructure/repositories/"
+	modulePrefix        = "netguard-pg-backend/internal/"
+)
+
+// caller returns the first function on the stack outside the repositories
+func caller() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !strings.HasPrefix(frame.Function, repositoriesPackage) {
+			return strings.TrimPrefix(frame.Function, modulePrefix)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// WriteMetrics writes List call metrics in the Prometheus text format
+func WriteMetrics(w io.Writer, r *Recorder) error {
+	metrics := []struct {
+		name, help, kind string
+		value            func(ListStats) float64
+	}{
+		{"netguard_reader_list_calls_total", "Repository List calls", "counter",
+			func(s ListStats) float64 { return float64(s.Calls) }},
+		{"netguard_reader_list_rows_scanned_total", "Rows read from storage by List calls", "counter",
+			func(s ListStats) float64 { return float64(s.Scanned) }},
+		{"netguard_reader_list_rows_returned_total", "Rows returned by List calls", "counter",
+			func(s ListStats) float64 { return float64(s.Returned) }},
+		{"netguard_reader_list_selectivity", "Share of scanned rows returned by List calls", "gauge",
+			func(s ListStats) float64 { return s.Selectivity() }},
+	}
+
+	stats := r.Stats()
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind); err != nil {
+			return err
+		}
+		for _, s := range stats {
+			if _, err := fmt.Fprintf(w, "%s{resource=%q,scope=%q,caller=%q} %g\n", metric.name, s.Resource, s.Scope, s.Caller, metric.value(s)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
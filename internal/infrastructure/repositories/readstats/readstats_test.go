@@ -0,0 +1,50 @@
+package readstats
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+func TestScopeType(t *testing.T) {
+	assert.Equal(t, ScopeEmpty, ScopeType(nil))
+	assert.Equal(t, ScopeEmpty, ScopeType(ports.EmptyScope{}))
+	assert.Equal(t, ScopeNamespace, ScopeType(ports.NewResourceIdentifierScope(models.ResourceIdentifier{Namespace: "default"})))
+	assert.Equal(t, ScopeIdentifiers, ScopeType(ports.NewResourceIdentifierScope(models.NewResourceIdentifier("ag", models.WithNamespace("default")))))
+}
+
+func TestRecorder(t *testing.T) {
+	recorder := NewRecorder()
+	for i := 0; i < 2; i++ {
+		list := recorder.begin("AddressGroup", ports.EmptyScope{}, "services.ListAll")
+		consume := Returned(list, func(int) error { return nil })
+		for row := 0; row < 4; row++ {
+			list.Scan()
+			if row%2 == 0 {
+				require.NoError(t, consume(row))
+			}
+		}
+		list.End()
+	}
+
+	stats := recorder.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, ListStats{Resource: "AddressGroup", Scope: ScopeEmpty, Caller: "services.ListAll", Calls: 2, Scanned: 8, Returned: 4}, stats[0])
+	assert.Equal(t, 0.5, stats[0].Selectivity())
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteMetrics(&buf, recorder))
+	assert.Contains(t, buf.String(), `netguard_reader_list_rows_scanned_total{resource="AddressGroup",scope="empty",caller="services.ListAll"} 8`)
+	assert.Contains(t, buf.String(), `netguard_reader_list_selectivity{resource="AddressGroup",scope="empty",caller="services.ListAll"} 0.5`)
+}
+
+func TestBegin_CallerOutsideRepositories(t *testing.T) {
+	// Functions of the repositories, this test included, are skipped
+	list := Begin("Network", ports.EmptyScope{})
+	assert.Equal(t, "testing.tRunner", list.key.caller)
+}
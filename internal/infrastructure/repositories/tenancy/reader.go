@@ -0,0 +1,243 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// reader hides resources outside of the tenant namespaces: lists skip them and gets
+// return ports.ErrNotFound as for missing resources
+type reader struct {
+	inner  ports.Reader
+	tenant models.Tenant
+}
+
+var (
+	_ ports.Reader                       = (*reader)(nil)
+	_ ports.IEAgAgRuleContributionReader = (*reader)(nil)
+)
+
+func newReader(inner ports.Reader, tenant models.Tenant) *reader {
+	return &reader{inner: inner, tenant: tenant}
+}
+
+// Close closes the reader
+func (r *reader) Close() error {
+	return r.inner.Close()
+}
+
+// GetSyncStatus returns the sync status of the registry
+func (r *reader) GetSyncStatus(ctx context.Context) (*models.SyncStatus, error) {
+	return r.inner.GetSyncStatus(ctx)
+}
+
+func (r *reader) ListServices(ctx context.Context, consume func(models.Service) error, scope ports.Scope) error {
+	return r.inner.ListServices(ctx, owned(r.tenant, func(v models.Service) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetServiceByID(ctx context.Context, id models.ResourceIdentifier) (*models.Service, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetServiceByID(ctx, id)
+}
+
+func (r *reader) ListAddressGroups(ctx context.Context, consume func(models.AddressGroup) error, scope ports.Scope) error {
+	return r.inner.ListAddressGroups(ctx, owned(r.tenant, func(v models.AddressGroup) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetAddressGroupByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetAddressGroupByID(ctx, id)
+}
+
+func (r *reader) ListAddressGroupBindings(ctx context.Context, consume func(models.AddressGroupBinding) error, scope ports.Scope) error {
+	return r.inner.ListAddressGroupBindings(ctx, owned(r.tenant, func(v models.AddressGroupBinding) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetAddressGroupBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupBinding, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetAddressGroupBindingByID(ctx, id)
+}
+
+func (r *reader) ListAddressGroupPortMappings(ctx context.Context, consume func(models.AddressGroupPortMapping) error, scope ports.Scope) error {
+	return r.inner.ListAddressGroupPortMappings(ctx, owned(r.tenant, func(v models.AddressGroupPortMapping) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetAddressGroupPortMappingByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupPortMapping, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetAddressGroupPortMappingByID(ctx, id)
+}
+
+func (r *reader) ListRuleS2S(ctx context.Context, consume func(models.RuleS2S) error, scope ports.Scope) error {
+	return r.inner.ListRuleS2S(ctx, owned(r.tenant, func(v models.RuleS2S) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetRuleS2SByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2S, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetRuleS2SByID(ctx, id)
+}
+
+func (r *reader) ListServiceAliases(ctx context.Context, consume func(models.ServiceAlias) error, scope ports.Scope) error {
+	return r.inner.ListServiceAliases(ctx, owned(r.tenant, func(v models.ServiceAlias) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetServiceAliasByID(ctx context.Context, id models.ResourceIdentifier) (*models.ServiceAlias, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetServiceAliasByID(ctx, id)
+}
+
+func (r *reader) ListAddressGroupBindingPolicies(ctx context.Context, consume func(models.AddressGroupBindingPolicy) error, scope ports.Scope) error {
+	return r.inner.ListAddressGroupBindingPolicies(ctx, owned(r.tenant, func(v models.AddressGroupBindingPolicy) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetAddressGroupBindingPolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroupBindingPolicy, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetAddressGroupBindingPolicyByID(ctx, id)
+}
+
+func (r *reader) ListIEAgAgRules(ctx context.Context, consume func(models.IEAgAgRule) error, scope ports.Scope) error {
+	return r.inner.ListIEAgAgRules(ctx, owned(r.tenant, func(v models.IEAgAgRule) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetIEAgAgRuleByID(ctx context.Context, id models.ResourceIdentifier) (*models.IEAgAgRule, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetIEAgAgRuleByID(ctx, id)
+}
+
+func (r *reader) ListNetworks(ctx context.Context, consume func(models.Network) error, scope ports.Scope) error {
+	return r.inner.ListNetworks(ctx, owned(r.tenant, func(v models.Network) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetNetworkByID(ctx context.Context, id models.ResourceIdentifier) (*models.Network, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetNetworkByID(ctx, id)
+}
+
+func (r *reader) ListNetworkBindings(ctx context.Context, consume func(models.NetworkBinding) error, scope ports.Scope) error {
+	return r.inner.ListNetworkBindings(ctx, owned(r.tenant, func(v models.NetworkBinding) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetNetworkBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.NetworkBinding, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetNetworkBindingByID(ctx, id)
+}
+
+func (r *reader) ListHosts(ctx context.Context, consume func(models.Host) error, scope ports.Scope) error {
+	return r.inner.ListHosts(ctx, owned(r.tenant, func(v models.Host) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetHostByID(ctx context.Context, id models.ResourceIdentifier) (*models.Host, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetHostByID(ctx, id)
+}
+
+func (r *reader) ListHostBindings(ctx context.Context, consume func(models.HostBinding) error, scope ports.Scope) error {
+	return r.inner.ListHostBindings(ctx, owned(r.tenant, func(v models.HostBinding) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetHostBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.HostBinding, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetHostBindingByID(ctx, id)
+}
+
+func (r *reader) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	return r.inner.ListRuleS2SExceptions(ctx, owned(r.tenant, func(v models.RuleS2SException) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetRuleS2SExceptionByID(ctx, id)
+}
+
+func (r *reader) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	return r.inner.ListCrossNamespacePolicies(ctx, owned(r.tenant, func(v models.CrossNamespacePolicy) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetCrossNamespacePolicyByID(ctx, id)
+}
+
+func (r *reader) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	return r.inner.ListRuleTemplates(ctx, owned(r.tenant, func(v models.RuleTemplate) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetRuleTemplateByID(ctx, id)
+}
+
+func (r *reader) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	return r.inner.ListNamespacePostures(ctx, owned(r.tenant, func(v models.NamespacePosture) string { return v.Namespace }, consume), scope)
+}
+
+func (r *reader) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	if !r.tenant.Owns(id.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return r.inner.GetNamespacePostureByID(ctx, id)
+}
+
+func (r *reader) GetNetworkByCIDR(ctx context.Context, cidr string) (*models.Network, error) {
+	network, err := r.inner.GetNetworkByCIDR(ctx, cidr)
+	if err != nil {
+		return nil, err
+	}
+	if !r.tenant.Owns(network.Namespace) {
+		return nil, ports.ErrNotFound
+	}
+	return network, nil
+}
+
+// ListIEAgAgRuleContributions lists the contributions to the IEAgAgRules of the tenant,
+// including the ones of RuleS2S of other namespaces needed to recalculate them
+func (r *reader) ListIEAgAgRuleContributions(ctx context.Context, consume func(models.IEAgAgRuleContribution) error, ruleS2SIDs, ieAgAgRuleIDs []models.ResourceIdentifier) error {
+	contributions, ok := r.inner.(ports.IEAgAgRuleContributionReader)
+	if !ok {
+		return errors.New("reader doesn't support IEAgAgRule contributions")
+	}
+	return contributions.ListIEAgAgRuleContributions(ctx,
+		owned(r.tenant, func(c models.IEAgAgRuleContribution) string { return c.IEAgAgRule.Namespace }, consume),
+		ruleS2SIDs, ieAgAgRuleIDs)
+}
+
+// IEAgAgRuleContributionIndexBuilt reports whether the contribution index was built
+func (r *reader) IEAgAgRuleContributionIndexBuilt(ctx context.Context) (bool, error) {
+	contributions, ok := r.inner.(ports.IEAgAgRuleContributionReader)
+	if !ok {
+		return false, errors.New("reader doesn't support IEAgAgRule contributions")
+	}
+	return contributions.IEAgAgRuleContributionIndexBuilt(ctx)
+}
@@ -0,0 +1,119 @@
+package tenancy
+
+import (
+	"context"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// Registry scopes the readers and writers of a registry to the tenant of their context
+type Registry struct {
+	ports.Registry
+}
+
+// inspectedRegistry keeps the schema status of registries with versioned schemas
+type inspectedRegistry struct {
+	*Registry
+	ports.SchemaInspector
+}
+
+// NewRegistry wraps registry, readers and writers of contexts without a tenant are the
+// ones of registry
+func NewRegistry(registry ports.Registry) ports.Registry {
+	r := &Registry{Registry: registry}
+	if inspector, ok := registry.(ports.SchemaInspector); ok {
+		return &inspectedRegistry{Registry: r, SchemaInspector: inspector}
+	}
+	return r
+}
+
+// Reader returns a reader of the tenant of ctx
+func (r *Registry) Reader(ctx context.Context) (ports.Reader, error) {
+	return scopeReader(ctx, r.Registry.Reader)
+}
+
+// PrimaryReader returns a primary reader of the tenant of ctx
+func (r *Registry) PrimaryReader(ctx context.Context) (ports.Reader, error) {
+	return scopeReader(ctx, r.Registry.PrimaryReader)
+}
+
+// ReaderWithReadCommitted returns a ReadCommitted reader of the tenant of ctx
+func (r *Registry) ReaderWithReadCommitted(ctx context.Context) (ports.Reader, error) {
+	return scopeReader(ctx, r.Registry.ReaderWithReadCommitted)
+}
+
+// ReaderAtSnapshot returns a snapshot reader of the tenant of ctx
+func (r *Registry) ReaderAtSnapshot(ctx context.Context) (ports.Reader, error) {
+	return scopeReader(ctx, r.Registry.ReaderAtSnapshot)
+}
+
+// ReaderAtReplicaSnapshot returns a replica snapshot reader of the tenant of ctx
+func (r *Registry) ReaderAtReplicaSnapshot(ctx context.Context) (ports.Reader, error) {
+	return scopeReader(ctx, r.Registry.ReaderAtReplicaSnapshot)
+}
+
+// ReaderFromWriter returns a reader of the writer transaction, readers of tenant writers
+// are scoped to the tenant of the writer
+func (r *Registry) ReaderFromWriter(ctx context.Context, writer ports.Writer) (ports.Reader, error) {
+	scoped, ok := writer.(scopedWriter)
+	if !ok {
+		return scopeReader(ctx, func(ctx context.Context) (ports.Reader, error) {
+			return r.Registry.ReaderFromWriter(ctx, writer)
+		})
+	}
+	inner, tenant := scoped.scope()
+	reader, err := r.Registry.ReaderFromWriter(ctx, inner)
+	if err != nil {
+		return nil, err
+	}
+	return newReader(reader, tenant), nil
+}
+
+// Writer returns a writer of the tenant of ctx
+func (r *Registry) Writer(ctx context.Context) (ports.Writer, error) {
+	return scopeWriter(ctx, r.Registry, r.Registry.Writer)
+}
+
+// WriterWithOptions returns a writer of the tenant of ctx with opts
+func (r *Registry) WriterWithOptions(ctx context.Context, opts ports.WriterOptions) (ports.Writer, error) {
+	return scopeWriter(ctx, r.Registry, func(ctx context.Context) (ports.Writer, error) {
+		return r.Registry.WriterWithOptions(ctx, opts)
+	})
+}
+
+func scopeReader(ctx context.Context, open func(context.Context) (ports.Reader, error)) (ports.Reader, error) {
+	reader, err := open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenant, ok := ports.TenantFromContext(ctx)
+	if !ok {
+		return reader, nil
+	}
+	return newReader(reader, tenant), nil
+}
+
+// scopeWriter opens a writer of the tenant of ctx, registry reads the unscoped
+// dependents of resources in the writer transaction
+func scopeWriter(ctx context.Context, registry ports.Registry, open func(context.Context) (ports.Writer, error)) (ports.Writer, error) {
+	writer, err := open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenant, ok := ports.TenantFromContext(ctx)
+	if !ok {
+		return writer, nil
+	}
+	return newWriter(writer, registry, tenant), nil
+}
+
+// owned keeps the consumed resources of the tenant namespaces
+func owned[T any](tenant models.Tenant, namespace func(T) string, consume func(T) error) func(T) error {
+	return func(resource T) error {
+		if !tenant.Owns(namespace(resource)) {
+			return nil
+		}
+		return consume(resource)
+	}
+}
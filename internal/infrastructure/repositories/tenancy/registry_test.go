@@ -0,0 +1,179 @@
+package tenancy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+)
+
+var (
+	teamA = models.Tenant{Name: "team-a", Namespaces: []string{"a-prod", "a-dev"}}
+	teamB = models.Tenant{Name: "team-b", Namespaces: []string{"b-prod"}}
+)
+
+func service(namespace, name string) models.Service {
+	return models.Service{SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(namespace)))}
+}
+
+// seededRegistry returns a tenant-aware registry with services of both tenants and an
+// AddressGroup of team-b bound to a service of team-a
+func seededRegistry(t *testing.T) ports.Registry {
+	ctx := context.Background()
+	registry := NewRegistry(mem.NewRegistry())
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{
+		service("a-prod", "web"), service("a-dev", "web"), service("b-prod", "web"),
+	}, ports.EmptyScope{}))
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("shared", models.WithNamespace("b-prod")))},
+	}, ports.EmptyScope{}))
+	require.NoError(t, writer.SyncAddressGroupBindings(ctx, []models.AddressGroupBinding{{
+		SelfRef:         models.NewSelfRef(models.NewResourceIdentifier("web-shared", models.WithNamespace("a-prod"))),
+		ServiceRef:      models.NewServiceRef("web", models.WithNamespace("a-prod")),
+		AddressGroupRef: models.NewAddressGroupRef("shared", models.WithNamespace("b-prod")),
+	}}, ports.EmptyScope{}))
+	require.NoError(t, writer.Commit())
+	return registry
+}
+
+func listServices(t *testing.T, reader ports.Reader) []string {
+	var keys []string
+	require.NoError(t, reader.ListServices(context.Background(), func(s models.Service) error {
+		keys = append(keys, s.Key())
+		return nil
+	}, ports.EmptyScope{}))
+	return keys
+}
+
+func TestRegistry_ReadersHideOtherTenants(t *testing.T) {
+	registry := seededRegistry(t)
+	ctx := ports.WithTenant(context.Background(), teamA)
+
+	reader, err := registry.Reader(ctx)
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.ElementsMatch(t, []string{"a-prod/web", "a-dev/web"}, listServices(t, reader))
+
+	_, err = reader.GetServiceByID(ctx, models.NewResourceIdentifier("web", models.WithNamespace("b-prod")))
+	assert.ErrorIs(t, err, ports.ErrNotFound)
+	found, err := reader.GetServiceByID(ctx, models.NewResourceIdentifier("web", models.WithNamespace("a-dev")))
+	require.NoError(t, err)
+	assert.Equal(t, "a-dev", found.Namespace)
+
+	// Contexts without a tenant are unrestricted
+	unrestricted, err := registry.Reader(context.Background())
+	require.NoError(t, err)
+	defer unrestricted.Close()
+	assert.Len(t, listServices(t, unrestricted), 3)
+}
+
+func TestRegistry_WritersRejectOtherTenants(t *testing.T) {
+	registry := seededRegistry(t)
+	ctx := ports.WithTenant(context.Background(), teamB)
+	otherID := models.NewResourceIdentifier("web", models.WithNamespace("a-prod"))
+
+	tests := []struct {
+		name  string
+		write func(ports.Writer) error
+	}{
+		{"SyncOtherNamespace", func(w ports.Writer) error {
+			return w.SyncServices(ctx, []models.Service{service("a-prod", "web")}, ports.NewResourceIdentifierScope(otherID))
+		}},
+		{"SyncAllNamespaces", func(w ports.Writer) error {
+			return w.SyncServices(ctx, nil, ports.EmptyScope{})
+		}},
+		{"SyncSelectorOfAllNamespaces", func(w ports.Writer) error {
+			return w.SyncServices(ctx, nil, ports.NewSelectorScope(ports.EmptyScope{}, "app=web", ""))
+		}},
+		{"DeleteOtherNamespace", func(w ports.Writer) error {
+			return w.DeleteServicesByIDs(ctx, []models.ResourceIdentifier{otherID})
+		}},
+		{"CascadeOtherNamespace", func(w ports.Writer) error {
+			_, err := w.(ports.ServiceCascadeDeleter).DeleteServicesCascade(ctx, []models.ResourceIdentifier{otherID})
+			return err
+		}},
+		{"CascadeAddressGroupToOtherTenant", func(w ports.Writer) error {
+			// The binding of team-a would be deleted with the AddressGroup of team-b
+			return w.DeleteAddressGroupsByIDs(ctx, []models.ResourceIdentifier{
+				models.NewResourceIdentifier("shared", models.WithNamespace("b-prod")),
+			})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer, err := registry.Writer(ctx)
+			require.NoError(t, err)
+			defer writer.Abort()
+			assert.ErrorIs(t, tt.write(writer), ports.ErrTenantViolation)
+		})
+	}
+
+	reader, err := registry.Reader(context.Background())
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.Len(t, listServices(t, reader), 3)
+}
+
+func TestRegistry_WritesOwnNamespaces(t *testing.T) {
+	registry := seededRegistry(t)
+	ctx := ports.WithTenant(context.Background(), teamB)
+	id := models.NewResourceIdentifier("web", models.WithNamespace("b-prod"))
+
+	writer, err := registry.Writer(ctx)
+	require.NoError(t, err)
+	_, isLocker := writer.(ports.AggregationLocker)
+	assert.False(t, isLocker, "writers keep the aggregation locking of the registry writers")
+
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{service("b-prod", "api")},
+		ports.NewResourceIdentifierScope(models.NewResourceIdentifier("api", models.WithNamespace("b-prod")))))
+	require.NoError(t, writer.SyncServices(ctx, []models.Service{service("b-prod", "db")}, ports.EmptyScope{},
+		ports.WithSyncOp(models.SyncOpUpsert)))
+	require.NoError(t, writer.DeleteServicesByIDs(ctx, []models.ResourceIdentifier{id}))
+
+	// AddressGroups without dependents of other tenants can be deleted
+	local := models.NewResourceIdentifier("local", models.WithNamespace("b-prod"))
+	require.NoError(t, writer.SyncAddressGroups(ctx, []models.AddressGroup{{SelfRef: models.NewSelfRef(local)}},
+		ports.NewResourceIdentifierScope(local)))
+	require.NoError(t, writer.DeleteAddressGroupsByIDs(ctx, []models.ResourceIdentifier{local}))
+
+	// Readers of the transaction stay scoped to the tenant of the writer
+	reader, err := registry.ReaderFromWriter(context.Background(), writer)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"b-prod/api", "b-prod/db"}, listServices(t, reader))
+	require.NoError(t, reader.Close())
+	require.NoError(t, writer.Commit())
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := TenantConfig{Name: "team-a", Namespaces: []string{"a-prod"}, Token: "secret-a"}
+	tests := []struct {
+		name    string
+		tenants []TenantConfig
+		wantErr string
+	}{
+		{"Valid", []TenantConfig{valid, {Name: "team-b", Namespaces: []string{"b-prod"}, Token: "secret-b"}}, ""},
+		{"NoTenants", nil, "at least one tenant"},
+		{"DuplicateName", []TenantConfig{valid, {Name: "team-a", Namespaces: []string{"b-prod"}, Token: "secret-b"}}, "duplicate tenant"},
+		{"SharedToken", []TenantConfig{valid, {Name: "team-b", Namespaces: []string{"b-prod"}, Token: "secret-a"}}, "reuses the token"},
+		{"SharedNamespace", []TenantConfig{valid, {Name: "team-b", Namespaces: []string{"a-prod"}, Token: "secret-b"}}, "belongs to tenants"},
+		{"NoNamespaces", []TenantConfig{{Name: "team-a", Token: "secret-a"}}, "has no namespaces"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Config{Enabled: true, Tenants: tt.tenants}.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
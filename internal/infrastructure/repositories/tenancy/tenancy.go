@@ -0,0 +1,84 @@
+// Package tenancy isolates tenants, groups of namespaces, in the repository. Readers and
+// writers created with a tenant in the context (ports.WithTenant) only list and get
+// resources of the tenant namespaces, and their writes fail with ports.ErrTenantViolation
+// before anything outside of them is changed. The tenant is captured when a reader or a
+// writer is created, so everything done with them, including cascades of the services
+// such as recalculated IEAgAgRules and regenerated port mappings, is checked. Contexts
+// without a tenant, e.g. of background jobs, use the registry unrestricted.
+package tenancy
+
+import (
+	"fmt"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// TenantConfig configures a tenant and its credentials
+type TenantConfig struct {
+	Name       string   `yaml:"name"`
+	Namespaces []string `yaml:"namespaces"`
+	// Token is the bearer token of the tenant in the authorization metadata of requests
+	Token string `yaml:"token"`
+}
+
+// Config configures tenant isolation
+type Config struct {
+	Enabled bool `yaml:"enabled" env:"TENANCY_ENABLED"`
+	// RequireToken rejects requests without a tenant token, otherwise they are unrestricted
+	RequireToken bool           `yaml:"require-token" env:"TENANCY_REQUIRE_TOKEN"`
+	Tenants      []TenantConfig `yaml:"tenants"`
+}
+
+// Validate validates the tenancy configuration: names and tokens are unique and every
+// namespace belongs to one tenant at most
+func (c Config) Validate() error {
+	if len(c.Tenants) == 0 {
+		return fmt.Errorf("tenancy requires at least one tenant")
+	}
+	names := make(map[string]bool, len(c.Tenants))
+	tokens := make(map[string]bool, len(c.Tenants))
+	owners := make(map[string]string)
+	for _, tenant := range c.Tenants {
+		if tenant.Name == "" {
+			return fmt.Errorf("tenant name must not be empty")
+		}
+		if names[tenant.Name] {
+			return fmt.Errorf("duplicate tenant %s", tenant.Name)
+		}
+		names[tenant.Name] = true
+
+		if tenant.Token == "" {
+			return fmt.Errorf("tenant %s has no token", tenant.Name)
+		}
+		if tokens[tenant.Token] {
+			return fmt.Errorf("tenant %s reuses the token of another tenant", tenant.Name)
+		}
+		tokens[tenant.Token] = true
+
+		if len(tenant.Namespaces) == 0 {
+			return fmt.Errorf("tenant %s has no namespaces", tenant.Name)
+		}
+		for _, namespace := range tenant.Namespaces {
+			if namespace == "" {
+				return fmt.Errorf("tenant %s has an empty namespace", tenant.Name)
+			}
+			if owner, exists := owners[namespace]; exists {
+				return fmt.Errorf("namespace %s belongs to tenants %s and %s", namespace, owner, tenant.Name)
+			}
+			owners[namespace] = tenant.Name
+		}
+	}
+	return nil
+}
+
+// TenantsByToken returns the tenants keyed by their tokens
+func (c Config) TenantsByToken() map[string]models.Tenant {
+	tenants := make(map[string]models.Tenant, len(c.Tenants))
+	for _, tenant := range c.Tenants {
+		tenants[tenant.Token] = models.Tenant{
+			Name:       tenant.Name,
+			Namespaces: append([]string(nil), tenant.Namespaces...),
+		}
+	}
+	return tenants
+}
@@ -0,0 +1,459 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+)
+
+// scopedWriter is a writer of a tenant, ReaderFromWriter unwraps it
+type scopedWriter interface {
+	scope() (ports.Writer, models.Tenant)
+}
+
+// writer rejects changes outside of the tenant namespaces with ports.ErrTenantViolation
+// before passing them to the wrapped writer. Syncs are checked by their resources and
+// their scope: a full sync of an empty scope replaces resources of all namespaces and is
+// rejected. Deletions of AddressGroups are also rejected while resources of other
+// tenants depend on them, the database would cascade the deletion to these resources.
+type writer struct {
+	inner ports.Writer
+	// registry opens unscoped readers of the inner transaction
+	registry ports.Registry
+	tenant   models.Tenant
+}
+
+// lockingWriter is a writer of registries locking aggregation keys in the database
+type lockingWriter struct {
+	*writer
+	locker ports.AggregationLocker
+}
+
+var (
+	_ ports.Writer                       = (*writer)(nil)
+	_ ports.SyncOutboxWriter             = (*writer)(nil)
+	_ ports.ServiceCascadeDeleter        = (*writer)(nil)
+	_ ports.IEAgAgRuleContributionWriter = (*writer)(nil)
+	_ ports.AggregationLocker            = (*lockingWriter)(nil)
+)
+
+// newWriter wraps inner, aggregation locking is only kept when inner supports it: without
+// it the services lock aggregation keys in the process
+func newWriter(inner ports.Writer, registry ports.Registry, tenant models.Tenant) ports.Writer {
+	w := &writer{inner: inner, registry: registry, tenant: tenant}
+	if locker, ok := inner.(ports.AggregationLocker); ok {
+		return &lockingWriter{writer: w, locker: locker}
+	}
+	return w
+}
+
+func (w *writer) scope() (ports.Writer, models.Tenant) {
+	return w.inner, w.tenant
+}
+
+// check fails for identifiers outside of the tenant namespaces
+func (w *writer) check(ids ...models.ResourceIdentifier) error {
+	for _, id := range ids {
+		if !w.tenant.Owns(id.Namespace) {
+			return fmt.Errorf("%w: %s is not in the namespaces of tenant %s", ports.ErrTenantViolation, id.Key(), w.tenant.Name)
+		}
+	}
+	return nil
+}
+
+// checkScope fails for sync scopes reaching outside of the tenant namespaces. Resources
+// in a non-empty scope are replaced, an empty scope only replaces resources of all
+// namespaces in a full sync.
+func (w *writer) checkScope(scope ports.Scope, fullSync bool) error {
+	switch s := scope.(type) {
+	case ports.ResourceIdentifierScope:
+		if !s.IsEmpty() {
+			return w.check(s.Identifiers...)
+		}
+	case ports.SelectorScope:
+		if s.Scope != nil && !s.Scope.IsEmpty() {
+			return w.checkScope(s.Scope, fullSync)
+		}
+	}
+	if !fullSync {
+		return nil
+	}
+	return fmt.Errorf("%w: full sync of scope %s of tenant %s covers all namespaces", ports.ErrTenantViolation, scopeString(scope), w.tenant.Name)
+}
+
+// checkAddressGroupDependents fails when resources of other tenants reference the
+// AddressGroups of ids. The dependents are read unscoped in the writer transaction:
+// readers of the tenant don't see them, the database cascades the deletion to them.
+func (w *writer) checkAddressGroupDependents(ctx context.Context, ids []models.ResourceIdentifier) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	deleted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		deleted[id.Key()] = true
+	}
+
+	reader, err := w.registry.ReaderFromWriter(ctx, w.inner)
+	if err != nil {
+		return fmt.Errorf("failed to read dependents of address groups: %w", err)
+	}
+	defer reader.Close()
+
+	var dependent *models.ResourceIdentifier
+	// foreign records the first resource of another tenant referencing a deleted group
+	foreign := func(id models.ResourceIdentifier, refs ...models.AddressGroupRef) error {
+		if w.tenant.Owns(id.Namespace) {
+			return nil
+		}
+		for _, ref := range refs {
+			if deleted[models.AddressGroupRefKey(ref)] {
+				dependent = &id
+				return errStopDependents
+			}
+		}
+		return nil
+	}
+
+	lists := []func() error{
+		func() error {
+			return reader.ListAddressGroupBindings(ctx, func(b models.AddressGroupBinding) error {
+				return foreign(b.ResourceIdentifier, b.AddressGroupRef)
+			}, ports.EmptyScope{})
+		},
+		func() error {
+			return reader.ListIEAgAgRules(ctx, func(r models.IEAgAgRule) error {
+				return foreign(r.ResourceIdentifier, r.AddressGroupLocal, r.AddressGroup)
+			}, ports.EmptyScope{})
+		},
+		func() error {
+			return reader.ListHostBindings(ctx, func(b models.HostBinding) error {
+				return foreign(b.ResourceIdentifier, b.AddressGroupRef)
+			}, ports.EmptyScope{})
+		},
+		func() error {
+			return reader.ListRuleS2SExceptions(ctx, func(e models.RuleS2SException) error {
+				return foreign(e.ResourceIdentifier, e.AddressGroupLocal, e.AddressGroup)
+			}, ports.EmptyScope{})
+		},
+	}
+	for _, list := range lists {
+		if err := list(); err != nil && !errors.Is(err, errStopDependents) {
+			return fmt.Errorf("failed to read dependents of address groups: %w", err)
+		}
+		if dependent != nil {
+			return fmt.Errorf("%w: %s of another tenant depends on the address groups deleted by tenant %s",
+				ports.ErrTenantViolation, dependent.Key(), w.tenant.Name)
+		}
+	}
+	return nil
+}
+
+// errStopDependents stops listing dependents once one of another tenant is found
+var errStopDependents = errors.New("dependent of another tenant found")
+
+func scopeString(scope ports.Scope) string {
+	if scope == nil {
+		return "empty"
+	}
+	return scope.String()
+}
+
+// checkSync checks the resources and the scope of a sync, syncs without an operation
+// are full syncs
+func checkSync[T any](w *writer, resources []T, id func(T) models.ResourceIdentifier, scope ports.Scope, opts []ports.Option) error {
+	for _, resource := range resources {
+		if err := w.check(id(resource)); err != nil {
+			return err
+		}
+	}
+	syncOp := models.SyncOpFullSync
+	for _, opt := range opts {
+		if so, ok := opt.(ports.SyncOption); ok {
+			syncOp = so.Operation
+		}
+	}
+	return w.checkScope(scope, syncOp == models.SyncOpFullSync)
+}
+
+func (w *writer) SyncServices(ctx context.Context, resources []models.Service, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.Service) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncServices(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteServicesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteServicesByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncAddressGroups(ctx context.Context, resources []models.AddressGroup, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.AddressGroup) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncAddressGroups(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteAddressGroupsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	if err := w.checkAddressGroupDependents(ctx, ids); err != nil {
+		return err
+	}
+	return w.inner.DeleteAddressGroupsByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncAddressGroupBindings(ctx context.Context, resources []models.AddressGroupBinding, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.AddressGroupBinding) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncAddressGroupBindings(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteAddressGroupBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteAddressGroupBindingsByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncAddressGroupPortMappings(ctx context.Context, resources []models.AddressGroupPortMapping, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.AddressGroupPortMapping) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncAddressGroupPortMappings(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteAddressGroupPortMappingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteAddressGroupPortMappingsByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncRuleS2S(ctx context.Context, resources []models.RuleS2S, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.RuleS2S) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncRuleS2S(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteRuleS2SByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteRuleS2SByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncServiceAliases(ctx context.Context, resources []models.ServiceAlias, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.ServiceAlias) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncServiceAliases(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteServiceAliasesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteServiceAliasesByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncAddressGroupBindingPolicies(ctx context.Context, resources []models.AddressGroupBindingPolicy, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.AddressGroupBindingPolicy) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncAddressGroupBindingPolicies(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteAddressGroupBindingPoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteAddressGroupBindingPoliciesByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncIEAgAgRules(ctx context.Context, resources []models.IEAgAgRule, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.IEAgAgRule) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncIEAgAgRules(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteIEAgAgRulesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteIEAgAgRulesByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncNetworks(ctx context.Context, resources []models.Network, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.Network) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncNetworks(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteNetworksByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteNetworksByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncNetworkBindings(ctx context.Context, resources []models.NetworkBinding, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.NetworkBinding) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncNetworkBindings(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteNetworkBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteNetworkBindingsByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncHosts(ctx context.Context, resources []models.Host, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.Host) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncHosts(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteHostsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteHostsByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncHostBindings(ctx context.Context, resources []models.HostBinding, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.HostBinding) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncHostBindings(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteHostBindingsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteHostBindingsByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncRuleS2SExceptions(ctx context.Context, resources []models.RuleS2SException, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.RuleS2SException) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncRuleS2SExceptions(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteRuleS2SExceptionsByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteRuleS2SExceptionsByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncCrossNamespacePolicies(ctx context.Context, resources []models.CrossNamespacePolicy, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.CrossNamespacePolicy) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncCrossNamespacePolicies(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteCrossNamespacePoliciesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteCrossNamespacePoliciesByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncRuleTemplates(ctx context.Context, resources []models.RuleTemplate, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.RuleTemplate) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncRuleTemplates(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteRuleTemplatesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteRuleTemplatesByIDs(ctx, ids, opts...)
+}
+
+func (w *writer) SyncNamespacePostures(ctx context.Context, resources []models.NamespacePosture, scope ports.Scope, opts ...ports.Option) error {
+	if err := checkSync(w, resources, func(v models.NamespacePosture) models.ResourceIdentifier { return v.ResourceIdentifier }, scope, opts); err != nil {
+		return err
+	}
+	return w.inner.SyncNamespacePostures(ctx, resources, scope, opts...)
+}
+
+func (w *writer) DeleteNamespacePosturesByIDs(ctx context.Context, ids []models.ResourceIdentifier, opts ...ports.Option) error {
+	if err := w.check(ids...); err != nil {
+		return err
+	}
+	return w.inner.DeleteNamespacePosturesByIDs(ctx, ids, opts...)
+}
+
+// Commit commits the transaction
+func (w *writer) Commit() error {
+	return w.inner.Commit()
+}
+
+// Abort aborts the transaction
+func (w *writer) Abort() {
+	w.inner.Abort()
+}
+
+// EnqueueSyncOutbox stores sgroups syncs of the transaction, the synced resources were
+// checked when they were written
+func (w *writer) EnqueueSyncOutbox(ctx context.Context, entries []models.SyncOutboxEntry) error {
+	outbox, ok := w.inner.(ports.SyncOutboxWriter)
+	if !ok {
+		return errors.New("writer doesn't support sync outbox")
+	}
+	return outbox.EnqueueSyncOutbox(ctx, entries)
+}
+
+// DeleteServicesCascade deletes services of the tenant and their access ports
+func (w *writer) DeleteServicesCascade(ctx context.Context, ids []models.ResourceIdentifier) (int, error) {
+	cascade, ok := w.inner.(ports.ServiceCascadeDeleter)
+	if !ok {
+		return 0, errors.New("writer doesn't support cascade service deletion")
+	}
+	if err := w.check(ids...); err != nil {
+		return 0, err
+	}
+	return cascade.DeleteServicesCascade(ctx, ids)
+}
+
+// ReplaceIEAgAgRuleContributions replaces contributions to IEAgAgRules of the tenant, a
+// rebuild of the whole index is rejected
+func (w *writer) ReplaceIEAgAgRuleContributions(ctx context.Context, ieAgAgRuleIDs []models.ResourceIdentifier, contributions []models.IEAgAgRuleContribution) error {
+	index, ok := w.inner.(ports.IEAgAgRuleContributionWriter)
+	if !ok {
+		return errors.New("writer doesn't support IEAgAgRule contributions")
+	}
+	if ieAgAgRuleIDs == nil {
+		return fmt.Errorf("%w: tenant %s can't rebuild the IEAgAgRule contribution index", ports.ErrTenantViolation, w.tenant.Name)
+	}
+	if err := w.check(ieAgAgRuleIDs...); err != nil {
+		return err
+	}
+	return index.ReplaceIEAgAgRuleContributions(ctx, ieAgAgRuleIDs, contributions)
+}
+
+// LockAggregationKeys locks aggregation keys in the database
+func (w *lockingWriter) LockAggregationKeys(ctx context.Context, keys []string) error {
+	return w.locker.LockAggregationKeys(ctx, keys)
+}
@@ -0,0 +1,122 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// CertManagerInjectAnnotations mark webhook configurations whose caBundle is injected by cert-manager
+var CertManagerInjectAnnotations = []string{
+	"cert-manager.io/inject-ca-from",
+	"cert-manager.io/inject-ca-from-secret",
+	"cert-manager.io/inject-apiserver-ca",
+}
+
+// CAInjector writes the CA of the webhook certificate into the caBundle of the webhook
+// configurations. It is the alternative to cert-manager CA injection: configurations annotated
+// for cert-manager are left to cert-manager.
+type CAInjector struct {
+	caFile     string
+	validating []string
+	mutating   []string
+	client     kubernetes.Interface
+}
+
+// NewCAInjector creates an injector using the in-cluster configuration
+func NewCAInjector(caFile string, validating, mutating []string) (*CAInjector, error) {
+	clientConfig, err := restclient.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("load kubernetes client configuration: %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create kubernetes client: %w", err)
+	}
+	return newCAInjector(kubeClient, caFile, validating, mutating), nil
+}
+
+func newCAInjector(client kubernetes.Interface, caFile string, validating, mutating []string) *CAInjector {
+	return &CAInjector{
+		caFile:     caFile,
+		validating: validating,
+		mutating:   mutating,
+		client:     client,
+	}
+}
+
+// Inject reads the CA file and updates the webhook configurations with a different caBundle
+func (i *CAInjector) Inject(ctx context.Context) error {
+	caBundle, err := os.ReadFile(i.caFile)
+	if err != nil {
+		return fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	for _, name := range i.validating {
+		config, err := i.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get validating webhook configuration %s: %w", name, err)
+		}
+		if managedByCertManager(config.ObjectMeta) {
+			klog.V(2).Infof("Validating webhook configuration %s is injected by cert-manager, skipping", name)
+			continue
+		}
+		changed := false
+		for j := range config.Webhooks {
+			if !bytes.Equal(config.Webhooks[j].ClientConfig.CABundle, caBundle) {
+				config.Webhooks[j].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if _, err := i.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Update(ctx, config, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update validating webhook configuration %s: %w", name, err)
+		}
+		klog.Infof("🔐 Injected CA bundle into validating webhook configuration %s", name)
+	}
+
+	for _, name := range i.mutating {
+		config, err := i.client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get mutating webhook configuration %s: %w", name, err)
+		}
+		if managedByCertManager(config.ObjectMeta) {
+			klog.V(2).Infof("Mutating webhook configuration %s is injected by cert-manager, skipping", name)
+			continue
+		}
+		changed := false
+		for j := range config.Webhooks {
+			if !bytes.Equal(config.Webhooks[j].ClientConfig.CABundle, caBundle) {
+				config.Webhooks[j].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if _, err := i.client.AdmissionregistrationV1().MutatingWebhookConfigurations().Update(ctx, config, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update mutating webhook configuration %s: %w", name, err)
+		}
+		klog.Infof("🔐 Injected CA bundle into mutating webhook configuration %s", name)
+	}
+
+	return nil
+}
+
+// managedByCertManager reports whether cert-manager injects the caBundle of the configuration
+func managedByCertManager(meta metav1.ObjectMeta) bool {
+	for _, annotation := range CertManagerInjectAnnotations {
+		if meta.Annotations[annotation] != "" {
+			return true
+		}
+	}
+	return false
+}
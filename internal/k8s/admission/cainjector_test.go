@@ -0,0 +1,58 @@
+package admission
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCAInjector_SkipsCertManagerConfigurations(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.crt")
+	writeCertificate(t, caFile, filepath.Join(dir, "ca.key"), "ca")
+	caBundle, err := os.ReadFile(caFile)
+	if err != nil {
+		t.Fatalf("read CA: %v", err)
+	}
+
+	client := fake.NewSimpleClientset(
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "netguard-validator"},
+			Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "service.netguard.sgroups.io"}},
+		},
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "netguard-mutator",
+				Annotations: map[string]string{"cert-manager.io/inject-ca-from": "netguard-system/netguard-webhook-cert"},
+			},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{{Name: "service.netguard.sgroups.io"}},
+		},
+	)
+	injector := newCAInjector(client, caFile, []string{"netguard-validator"}, []string{"netguard-mutator"})
+
+	ctx := context.Background()
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+
+	validating, err := client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, "netguard-validator", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get validating configuration: %v", err)
+	}
+	if string(validating.Webhooks[0].ClientConfig.CABundle) != string(caBundle) {
+		t.Error("expected the CA bundle to be injected into the validating configuration")
+	}
+
+	mutating, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, "netguard-mutator", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get mutating configuration: %v", err)
+	}
+	if len(mutating.Webhooks[0].ClientConfig.CABundle) != 0 {
+		t.Error("expected the cert-manager configuration to be left to cert-manager")
+	}
+}
@@ -0,0 +1,117 @@
+package admission
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog/v2"
+)
+
+// certReloadDelay groups the file events of one secret update: kubelet swaps the ..data
+// symlink of a mounted secret and writes several files at once
+const certReloadDelay = 500 * time.Millisecond
+
+// CertWatcher serves the webhook TLS certificate and reloads it when the files change,
+// so rotated TLS secrets are picked up without restarting the pod
+type CertWatcher struct {
+	certFile string
+	keyFile  string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	onReload []func()
+}
+
+// NewCertWatcher loads the certificate, an invalid pair is an error
+func NewCertWatcher(certFile, keyFile string) (*CertWatcher, error) {
+	w := &CertWatcher{certFile: certFile, keyFile: keyFile}
+	if err := w.load(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// OnReload registers a callback called after the certificate is reloaded
+func (w *CertWatcher) OnReload(callback func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.onReload = append(w.onReload, callback)
+}
+
+// GetCertificate implements tls.Config.GetCertificate
+func (w *CertWatcher) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.cert, nil
+}
+
+func (w *CertWatcher) load() error {
+	cert, err := tls.LoadX509KeyPair(w.certFile, w.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	w.mu.Lock()
+	w.cert = &cert
+	w.mu.Unlock()
+	return nil
+}
+
+// Watch reloads the certificate on changes of the certificate directories until ctx is done.
+// A pair that fails to load (e.g. the key is not written yet) keeps the previous certificate.
+func (w *CertWatcher) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// Каталоги, а не файлы: при обновлении секрета файлы заменяются через symlink
+	dirs := map[string]bool{filepath.Dir(w.certFile): true, filepath.Dir(w.keyFile): true}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+	}
+
+	timer := time.NewTimer(certReloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			timer.Reset(certReloadDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			klog.Errorf("❌ Certificate watcher error: %v", err)
+		case <-timer.C:
+			if err := w.load(); err != nil {
+				klog.Errorf("❌ Failed to reload webhook certificate, keeping the previous one: %v", err)
+				continue
+			}
+			klog.Infof("🔐 Reloaded webhook certificate from %s", w.certFile)
+
+			w.mu.RLock()
+			callbacks := w.onReload
+			w.mu.RUnlock()
+			for _, callback := range callbacks {
+				callback()
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	runtimejson "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	netguardv1 "netguard-pg-backend/internal/k8s/apis/netguard/v1"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// ConversionReview is the apiextensions.k8s.io/v1 ConversionReview sent to conversion webhooks.
+// Only the fields used by the webhook are declared, apiextensions is not a dependency of the module.
+type ConversionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *ConversionRequest  `json:"request,omitempty"`
+	Response        *ConversionResponse `json:"response,omitempty"`
+}
+
+// ConversionRequest holds the objects to convert
+type ConversionRequest struct {
+	UID               types.UID              `json:"uid"`
+	DesiredAPIVersion string                 `json:"desiredAPIVersion"`
+	Objects           []runtime.RawExtension `json:"objects"`
+}
+
+// ConversionResponse holds the converted objects in the order of the request
+type ConversionResponse struct {
+	UID              types.UID              `json:"uid"`
+	ConvertedObjects []runtime.RawExtension `json:"convertedObjects"`
+	Result           metav1.Status          `json:"result"`
+}
+
+// ConversionWebhook converts netguard objects between v1beta1 and v1 through the hub version
+type ConversionWebhook struct {
+	scheme       *runtime.Scheme
+	deserializer runtime.Decoder
+	serializer   runtime.Encoder
+}
+
+// NewConversionWebhook creates a conversion webhook for the served netguard versions
+func NewConversionWebhook() *ConversionWebhook {
+	scheme := runtime.NewScheme()
+	netguardv1beta1.Install(scheme)
+	netguardv1.Install(scheme)
+
+	return &ConversionWebhook{
+		scheme:       scheme,
+		deserializer: serializer.NewCodecFactory(scheme).UniversalDeserializer(),
+		serializer:   runtimejson.NewSerializerWithOptions(runtimejson.DefaultMetaFactory, scheme, scheme, runtimejson.SerializerOptions{}),
+	}
+}
+
+// Convert converts all objects of the request to the desired version, the first failure fails the whole request
+func (c *ConversionWebhook) Convert(req *ConversionRequest) *ConversionResponse {
+	resp := &ConversionResponse{UID: req.UID}
+
+	desired, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = conversionFailure(fmt.Sprintf("invalid desired API version %s: %v", req.DesiredAPIVersion, err))
+		return resp
+	}
+	if desired.Group != netguardv1.GroupName || !c.scheme.IsVersionRegistered(desired) {
+		resp.Result = conversionFailure(fmt.Sprintf("unsupported desired API version %s", req.DesiredAPIVersion))
+		return resp
+	}
+
+	converted := make([]runtime.RawExtension, 0, len(req.Objects))
+	for i, object := range req.Objects {
+		raw, err := c.convertObject(object.Raw, desired)
+		if err != nil {
+			resp.Result = conversionFailure(fmt.Sprintf("object %d: %v", i, err))
+			return resp
+		}
+		converted = append(converted, runtime.RawExtension{Raw: raw})
+	}
+
+	resp.ConvertedObjects = converted
+	resp.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return resp
+}
+
+func (c *ConversionWebhook) convertObject(raw []byte, desired schema.GroupVersion) ([]byte, error) {
+	object, gvk, err := c.deserializer.Decode(raw, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode: %w", err)
+	}
+	if gvk.GroupVersion() == desired {
+		return raw, nil
+	}
+
+	out, err := c.scheme.ConvertToVersion(object, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to %s: %w", gvk.Kind, desired, err)
+	}
+
+	var buf bytes.Buffer
+	if err := c.serializer.Encode(out, &buf); err != nil {
+		return nil, fmt.Errorf("failed to encode: %w", err)
+	}
+	return bytes.TrimSpace(buf.Bytes()), nil
+}
+
+func conversionFailure(message string) metav1.Status {
+	return metav1.Status{Status: metav1.StatusFailure, Message: message}
+}
+
+// handleConversion handles ConversionReview requests
+func (s *WebhookServer) handleConversion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		klog.Errorf("Failed to read request body: %v", err)
+		http.Error(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	var review ConversionReview
+	if err := json.Unmarshal(body, &review); err != nil {
+		klog.Errorf("Failed to decode conversion review: %v", err)
+		http.Error(w, "Failed to decode conversion review", http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		klog.Error("Conversion review request is nil")
+		http.Error(w, "Conversion review request is nil", http.StatusBadRequest)
+		return
+	}
+
+	response := s.conversionWebhook.Convert(review.Request)
+	if response.Result.Status != metav1.StatusSuccess {
+		klog.Errorf("❌ Conversion to %s failed: %s", review.Request.DesiredAPIVersion, response.Result.Message)
+	} else {
+		klog.V(1).Infof("conversion webhook converted %d objects to %s", len(response.ConvertedObjects), review.Request.DesiredAPIVersion)
+	}
+
+	responseBytes, err := json.Marshal(&ConversionReview{TypeMeta: review.TypeMeta, Response: response})
+	if err != nil {
+		klog.Errorf("Failed to encode conversion review response: %v", err)
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(responseBytes); err != nil {
+		klog.Errorf("Failed to write response: %v", err)
+	}
+}
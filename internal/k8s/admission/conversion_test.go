@@ -0,0 +1,75 @@
+package admission
+
+import (
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	netguardv1 "netguard-pg-backend/internal/k8s/apis/netguard/v1"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func TestConversionWebhook_ConvertsBetweenVersions(t *testing.T) {
+	service := netguardv1beta1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: netguardv1beta1.SchemeGroupVersion.String(), Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: netguardv1beta1.ServiceSpec{
+			Description:  "frontend",
+			IngressPorts: []netguardv1beta1.IngressPort{{Protocol: netguardv1beta1.ProtocolTCP, Port: "80"}},
+		},
+	}
+	raw, err := json.Marshal(service)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	webhook := NewConversionWebhook()
+	resp := webhook.Convert(&ConversionRequest{
+		UID:               "uid",
+		DesiredAPIVersion: netguardv1.SchemeGroupVersion.String(),
+		Objects:           []runtime.RawExtension{{Raw: raw}},
+	})
+	if resp.Result.Status != metav1.StatusSuccess || len(resp.ConvertedObjects) != 1 {
+		t.Fatalf("expected one converted object, got %+v", resp)
+	}
+
+	var converted netguardv1.Service
+	if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, &converted); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if converted.APIVersion != netguardv1.SchemeGroupVersion.String() || converted.Kind != "Service" {
+		t.Errorf("expected a v1 Service, got %s %s", converted.APIVersion, converted.Kind)
+	}
+	if converted.Name != "web" || converted.Spec.Description != "frontend" || len(converted.Spec.IngressPorts) != 1 {
+		t.Errorf("object was not preserved: %+v", converted)
+	}
+
+	// Back to v1beta1
+	back := webhook.Convert(&ConversionRequest{
+		UID:               "uid",
+		DesiredAPIVersion: netguardv1beta1.SchemeGroupVersion.String(),
+		Objects:           resp.ConvertedObjects,
+	})
+	if back.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("expected the conversion back to succeed, got %+v", back.Result)
+	}
+	var roundTrip netguardv1beta1.Service
+	if err := json.Unmarshal(back.ConvertedObjects[0].Raw, &roundTrip); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if roundTrip.APIVersion != netguardv1beta1.SchemeGroupVersion.String() || roundTrip.Spec.IngressPorts[0].Port != "80" {
+		t.Errorf("unexpected round trip result %+v", roundTrip)
+	}
+}
+
+func TestConversionWebhook_RejectsUnknownVersion(t *testing.T) {
+	resp := NewConversionWebhook().Convert(&ConversionRequest{UID: "uid", DesiredAPIVersion: "netguard.sgroups.io/v2"})
+	if resp.Result.Status != metav1.StatusFailure {
+		t.Fatalf("expected a failure, got %+v", resp.Result)
+	}
+	if resp.UID != "uid" {
+		t.Errorf("expected the request UID in the response, got %s", resp.UID)
+	}
+}
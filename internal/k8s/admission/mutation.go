@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
+	"sort"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,6 +16,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
 	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 	clientscheme "netguard-pg-backend/pkg/k8s/clientset/versioned/scheme"
 )
@@ -227,6 +232,9 @@ func (w *MutationWebhook) mutateRuleS2S(ctx context.Context, req *admissionv1.Ad
 		})
 	}
 
+	// Fill defaults and normalize ports so the stored object is canonical
+	patches = append(patches, ruleS2SDefaultsPatches(rule.Spec)...)
+
 	return w.createPatchResponse(req.UID, patches)
 }
 
@@ -317,9 +325,145 @@ func (w *MutationWebhook) mutateIEAgAgRule(ctx context.Context, req *admissionv1
 	// Add finalizer for graceful deletion
 	patches = append(patches, w.addFinalizer(&rule, "netguard.sgroups.io/backend-sync")...)
 
+	// Fill defaults and normalize ports so the stored object is canonical
+	patches = append(patches, ieAgAgRuleDefaultsPatches(rule.Namespace, rule.Spec)...)
+
 	return w.createPatchResponse(req.UID, patches)
 }
 
+// ruleS2SDefaultsPatches fills the action and the ports source the backend assumes when they
+// are not set and normalizes the port strings of extra ports
+func ruleS2SDefaultsPatches(spec netguardv1beta1.RuleS2SSpec) []map[string]interface{} {
+	var patches []map[string]interface{}
+
+	if spec.Action == "" {
+		patches = append(patches, addPatch("/spec/action", netguardv1beta1.ActionAccept))
+	}
+
+	if spec.PortsSource == "" {
+		portsSource := models.PortsSourceTarget
+		if models.Traffic(spec.Traffic) == models.INGRESS {
+			portsSource = models.PortsSourceLocal
+		}
+		patches = append(patches, addPatch("/spec/portsSource", string(portsSource)))
+	}
+
+	if len(spec.ExtraPorts) > 0 {
+		normalized := make([]netguardv1beta1.IngressPort, len(spec.ExtraPorts))
+		changed := false
+		for i, port := range spec.ExtraPorts {
+			normalized[i] = port
+			normalized[i].Port = normalizePortString(models.TransportProtocol(port.Protocol), port.Port)
+			changed = changed || normalized[i].Port != port.Port
+		}
+		if changed {
+			patches = append(patches, addPatch("/spec/extraPorts", normalized))
+		}
+	}
+
+	return patches
+}
+
+// ieAgAgRuleDefaultsPatches places unqualified address group references in the namespace of the
+// rule, fills the action and the priority generated rules get and normalizes the ports
+func ieAgAgRuleDefaultsPatches(namespace string, spec netguardv1beta1.IEAgAgRuleSpec) []map[string]interface{} {
+	var patches []map[string]interface{}
+
+	if spec.AddressGroupLocal.Namespace == "" {
+		patches = append(patches, addPatch("/spec/addressGroupLocal/namespace", namespace))
+	}
+	if spec.AddressGroup.Namespace == "" {
+		patches = append(patches, addPatch("/spec/addressGroup/namespace", namespace))
+	}
+
+	action := spec.Action
+	if action == "" {
+		action = netguardv1beta1.ActionAccept
+		patches = append(patches, addPatch("/spec/action", action))
+	}
+	if spec.Priority == 0 {
+		patches = append(patches, addPatch("/spec/priority", models.RulePriorityForAction(models.RuleAction(action))))
+	}
+
+	if normalized, changed := normalizePortSpecs(spec.Ports); changed {
+		patches = append(patches, addPatch("/spec/ports", normalized))
+	}
+
+	return patches
+}
+
+// normalizePortString returns the canonical form of a port string: ranges sorted, merged and
+// formatted as "80,443,8000-8100". Invalid strings are returned as is and rejected by validation
+func normalizePortString(protocol models.TransportProtocol, port string) string {
+	if !protocol.HasPorts() {
+		return strings.ReplaceAll(port, " ", "")
+	}
+
+	ranges, err := validation.ParsePortRanges(port)
+	if err != nil {
+		return port
+	}
+
+	formatted := make([]string, 0, len(ranges))
+	for _, portRange := range validation.MergePortRanges(ranges) {
+		formatted = append(formatted, validation.FormatPortRange(portRange))
+	}
+	return strings.Join(formatted, ",")
+}
+
+// normalizePortSpecs turns single port ranges into ports, drops duplicates and sorts the ports
+func normalizePortSpecs(specs []netguardv1beta1.PortSpec) ([]netguardv1beta1.PortSpec, bool) {
+	if len(specs) == 0 {
+		return specs, false
+	}
+
+	bounds := func(spec netguardv1beta1.PortSpec) (int32, int32) {
+		if spec.PortRange != nil {
+			return spec.PortRange.From, spec.PortRange.To
+		}
+		return spec.Port, spec.Port
+	}
+
+	normalized := make([]netguardv1beta1.PortSpec, 0, len(specs))
+	seen := make(map[[2]int32]bool, len(specs))
+	for _, spec := range specs {
+		from, to := bounds(spec)
+		if spec.PortRange != nil && spec.Port != 0 {
+			// Ambiguous spec is left to validation
+			normalized = append(normalized, spec)
+			continue
+		}
+		if seen[[2]int32{from, to}] {
+			continue
+		}
+		seen[[2]int32{from, to}] = true
+		if from == to {
+			normalized = append(normalized, netguardv1beta1.PortSpec{Port: from})
+		} else {
+			normalized = append(normalized, netguardv1beta1.PortSpec{PortRange: &netguardv1beta1.PortRange{From: from, To: to}})
+		}
+	}
+	sort.SliceStable(normalized, func(i, j int) bool {
+		fromI, toI := bounds(normalized[i])
+		fromJ, toJ := bounds(normalized[j])
+		if fromI != fromJ {
+			return fromI < fromJ
+		}
+		return toI < toJ
+	})
+
+	return normalized, !reflect.DeepEqual(normalized, specs)
+}
+
+// addPatch creates a JSON patch setting the value at path, "add" also replaces an existing value
+func addPatch(path string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"op":    "add",
+		"path":  path,
+		"value": value,
+	}
+}
+
 // Helper functions for common mutations
 
 // addManagedByLabel adds the managed-by label
@@ -0,0 +1,164 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"netguard-pg-backend/internal/domain/models"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// patchValues runs the mutation webhook and returns the patched values by path
+func patchValues(t *testing.T, kind string, obj runtime.Object) map[string]interface{} {
+	t.Helper()
+
+	webhook, err := NewMutationWebhook()
+	if err != nil {
+		t.Fatalf("NewMutationWebhook: %v", err)
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	resp := webhook.Handle(context.Background(), &admissionv1.AdmissionRequest{
+		UID:       "uid",
+		Kind:      metav1.GroupVersionKind{Group: "netguard.sgroups.io", Version: "v1beta1", Kind: kind},
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	})
+	if !resp.Allowed {
+		t.Fatalf("mutation denied: %v", resp.Result)
+	}
+
+	var patches []map[string]interface{}
+	if err := json.Unmarshal(resp.Patch, &patches); err != nil {
+		t.Fatalf("unmarshal patch: %v", err)
+	}
+	values := make(map[string]interface{}, len(patches))
+	for _, patch := range patches {
+		values[patch["path"].(string)] = patch["value"]
+	}
+	return values
+}
+
+func TestMutateRuleS2S_FillsDefaultsAndNormalizesPorts(t *testing.T) {
+	rule := &netguardv1beta1.RuleS2S{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "RuleS2S"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web-to-db", Namespace: "default"},
+		Spec: netguardv1beta1.RuleS2SSpec{
+			Traffic:         netguardv1beta1.INGRESS,
+			ServiceLocalRef: netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: "db"}},
+			ServiceRef:      netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: "web"}},
+			ExtraPorts:      []netguardv1beta1.IngressPort{{Protocol: netguardv1beta1.ProtocolTCP, Port: "443, 80,81-90"}},
+		},
+	}
+
+	values := patchValues(t, "RuleS2S", rule)
+
+	if values["/spec/action"] != string(netguardv1beta1.ActionAccept) {
+		t.Errorf("expected default action ACCEPT, got %v", values["/spec/action"])
+	}
+	if values["/spec/portsSource"] != string(models.PortsSourceLocal) {
+		t.Errorf("expected ports source LOCAL for INGRESS, got %v", values["/spec/portsSource"])
+	}
+	extraPorts, ok := values["/spec/extraPorts"].([]interface{})
+	if !ok || len(extraPorts) != 1 {
+		t.Fatalf("expected normalized extra ports, got %v", values["/spec/extraPorts"])
+	}
+	if port := extraPorts[0].(map[string]interface{})["port"]; port != "80-90,443" {
+		t.Errorf("expected port string 80-90,443, got %v", port)
+	}
+}
+
+func TestMutateRuleS2S_KeepsExplicitValues(t *testing.T) {
+	rule := &netguardv1beta1.RuleS2S{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "RuleS2S"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web-to-db", Namespace: "default"},
+		Spec: netguardv1beta1.RuleS2SSpec{
+			Traffic:     netguardv1beta1.EGRESS,
+			Action:      netguardv1beta1.ActionDrop,
+			PortsSource: string(models.PortsSourceLocal),
+			ExtraPorts:  []netguardv1beta1.IngressPort{{Protocol: netguardv1beta1.ProtocolTCP, Port: "80,443"}},
+		},
+	}
+
+	values := patchValues(t, "RuleS2S", rule)
+
+	for _, path := range []string{"/spec/action", "/spec/portsSource", "/spec/extraPorts"} {
+		if value, ok := values[path]; ok {
+			t.Errorf("expected %s to be kept, got patch %v", path, value)
+		}
+	}
+}
+
+func TestMutateIEAgAgRule_FillsDefaultsAndNormalizesPorts(t *testing.T) {
+	rule := &netguardv1beta1.IEAgAgRule{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "IEAgAgRule"},
+		ObjectMeta: metav1.ObjectMeta{Name: "ing-tcp-db-web", Namespace: "default"},
+		Spec: netguardv1beta1.IEAgAgRuleSpec{
+			Transport:         netguardv1beta1.ProtocolTCP,
+			Traffic:           netguardv1beta1.INGRESS,
+			AddressGroupLocal: netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: "db"}},
+			AddressGroup:      netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: "web"}, Namespace: "frontend"},
+			Ports: []netguardv1beta1.PortSpec{
+				{Port: 443},
+				{PortRange: &netguardv1beta1.PortRange{From: 80, To: 80}},
+				{Port: 443},
+			},
+		},
+	}
+
+	values := patchValues(t, "IEAgAgRule", rule)
+
+	if values["/spec/addressGroupLocal/namespace"] != "default" {
+		t.Errorf("expected local address group in the rule namespace, got %v", values["/spec/addressGroupLocal/namespace"])
+	}
+	if _, ok := values["/spec/addressGroup/namespace"]; ok {
+		t.Errorf("expected explicit address group namespace to be kept")
+	}
+	if values["/spec/action"] != string(netguardv1beta1.ActionAccept) {
+		t.Errorf("expected default action ACCEPT, got %v", values["/spec/action"])
+	}
+	if values["/spec/priority"] != float64(models.RulePriorityAccept) {
+		t.Errorf("expected priority %d, got %v", models.RulePriorityAccept, values["/spec/priority"])
+	}
+
+	ports, ok := values["/spec/ports"].([]interface{})
+	if !ok || len(ports) != 2 {
+		t.Fatalf("expected 2 normalized ports, got %v", values["/spec/ports"])
+	}
+	if port := ports[0].(map[string]interface{})["port"]; port != float64(80) {
+		t.Errorf("expected first port 80, got %v", port)
+	}
+	if port := ports[1].(map[string]interface{})["port"]; port != float64(443) {
+		t.Errorf("expected second port 443, got %v", port)
+	}
+}
+
+func TestMutateIEAgAgRule_DropPriority(t *testing.T) {
+	rule := &netguardv1beta1.IEAgAgRule{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "IEAgAgRule"},
+		ObjectMeta: metav1.ObjectMeta{Name: "ing-tcp-db-web", Namespace: "default"},
+		Spec: netguardv1beta1.IEAgAgRuleSpec{
+			Transport: netguardv1beta1.ProtocolTCP,
+			Traffic:   netguardv1beta1.INGRESS,
+			Action:    netguardv1beta1.ActionDrop,
+			Ports:     []netguardv1beta1.PortSpec{{Port: 22}},
+		},
+	}
+
+	values := patchValues(t, "IEAgAgRule", rule)
+
+	if values["/spec/priority"] != float64(models.RulePriorityDrop) {
+		t.Errorf("expected priority %d, got %v", models.RulePriorityDrop, values["/spec/priority"])
+	}
+	if _, ok := values["/spec/ports"]; ok {
+		t.Errorf("expected canonical ports to be kept")
+	}
+}
@@ -0,0 +1,263 @@
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// PolicyFailurePolicy defines what happens when a policy expression can't be evaluated
+type PolicyFailurePolicy string
+
+const (
+	// PolicyFailurePolicyFail denies the request (default)
+	PolicyFailurePolicyFail PolicyFailurePolicy = "Fail"
+	// PolicyFailurePolicyIgnore skips the policy
+	PolicyFailurePolicyIgnore PolicyFailurePolicy = "Ignore"
+)
+
+// PolicyViolationCause is the type of the status causes returned for violated policies
+const PolicyViolationCause metav1.CauseType = "PolicyViolation"
+
+// Policy is a cluster admin policy: a CEL expression evaluated against incoming netguard objects.
+// The expression must return true for the request to be admitted. It can use the variables:
+//
+//	object    - the incoming object (null on DELETE)
+//	oldObject - the existing object (null on CREATE)
+//	request   - {operation, kind, namespace, name, username, groups}
+//
+// Example: kinds [IEAgAgRule], namespaces [prod],
+// expression "object.spec.addressGroup.name != 'internet'"
+type Policy struct {
+	// Name identifies the policy in denial reasons
+	Name string `json:"name"`
+	// Kinds the policy applies to, all kinds when empty
+	Kinds []string `json:"kinds,omitempty"`
+	// Namespaces the policy applies to, all namespaces when empty
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Operations the policy applies to (CREATE, UPDATE, DELETE), CREATE and UPDATE when empty
+	Operations []string `json:"operations,omitempty"`
+	// Expression is the CEL expression returning bool
+	Expression string `json:"expression"`
+	// Message is returned when the policy is violated
+	Message string `json:"message,omitempty"`
+	// FailurePolicy is applied when the expression fails at runtime, Fail when empty
+	FailurePolicy PolicyFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// PolicyFile is the format of the policy file
+type PolicyFile struct {
+	Policies []Policy `json:"policies"`
+}
+
+// LoadPolicies reads policies from a YAML or JSON file
+func LoadPolicies(path string) ([]Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	var file PolicyFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", path, err)
+	}
+	return file.Policies, nil
+}
+
+// compiledPolicy is a policy with its compiled CEL program
+type compiledPolicy struct {
+	Policy
+	program cel.Program
+}
+
+// PolicyEngine evaluates cluster admin policies against admission requests
+type PolicyEngine struct {
+	policies []compiledPolicy
+}
+
+// NewPolicyEngine compiles the policies, a policy that doesn't compile to a bool expression is an error
+func NewPolicyEngine(policies []Policy) (*PolicyEngine, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	engine := &PolicyEngine{}
+	names := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if policy.Name == "" {
+			return nil, fmt.Errorf("policy name is required")
+		}
+		if names[policy.Name] {
+			return nil, fmt.Errorf("duplicate policy %s", policy.Name)
+		}
+		names[policy.Name] = true
+
+		switch policy.FailurePolicy {
+		case "":
+			policy.FailurePolicy = PolicyFailurePolicyFail
+		case PolicyFailurePolicyFail, PolicyFailurePolicyIgnore:
+		default:
+			return nil, fmt.Errorf("policy %s: unknown failure policy %s", policy.Name, policy.FailurePolicy)
+		}
+		if len(policy.Operations) == 0 {
+			policy.Operations = []string{string(admissionv1.Create), string(admissionv1.Update)}
+		}
+
+		ast, issues := env.Compile(policy.Expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("policy %s: %w", policy.Name, issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("policy %s: expression must return bool, got %s", policy.Name, ast.OutputType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: %w", policy.Name, err)
+		}
+		engine.policies = append(engine.policies, compiledPolicy{Policy: policy, program: program})
+	}
+	return engine, nil
+}
+
+// Len returns the number of policies
+func (e *PolicyEngine) Len() int {
+	return len(e.policies)
+}
+
+// Evaluate returns a cause for every policy the request violates
+func (e *PolicyEngine) Evaluate(req *admissionv1.AdmissionRequest) ([]metav1.StatusCause, error) {
+	var applicable []compiledPolicy
+	for _, policy := range e.policies {
+		if policy.appliesTo(req) {
+			applicable = append(applicable, policy)
+		}
+	}
+	if len(applicable) == 0 {
+		return nil, nil
+	}
+
+	object, err := decodeRaw(req.Object.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode object: %w", err)
+	}
+	oldObject, err := decodeRaw(req.OldObject.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode old object: %w", err)
+	}
+	vars := map[string]interface{}{
+		"object":    object,
+		"oldObject": oldObject,
+		"request": map[string]interface{}{
+			"operation": string(req.Operation),
+			"kind":      req.Kind.Kind,
+			"namespace": req.Namespace,
+			"name":      req.Name,
+			"username":  req.UserInfo.Username,
+			"groups":    req.UserInfo.Groups,
+		},
+	}
+
+	var causes []metav1.StatusCause
+	for _, policy := range applicable {
+		out, _, err := policy.program.Eval(vars)
+		if err == nil {
+			allowed, ok := out.Value().(bool)
+			if !ok {
+				err = fmt.Errorf("expression returned %s, expected bool", out.Type().TypeName())
+			} else if allowed {
+				continue
+			} else {
+				causes = append(causes, metav1.StatusCause{
+					Type:    PolicyViolationCause,
+					Field:   policy.Name,
+					Message: policy.message(),
+				})
+				continue
+			}
+		}
+
+		if policy.FailurePolicy == PolicyFailurePolicyIgnore {
+			continue
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    PolicyViolationCause,
+			Field:   policy.Name,
+			Message: fmt.Sprintf("policy evaluation failed: %v", err),
+		})
+	}
+	return causes, nil
+}
+
+// appliesTo reports whether the policy selects the request
+func (p compiledPolicy) appliesTo(req *admissionv1.AdmissionRequest) bool {
+	return matches(p.Kinds, req.Kind.Kind) &&
+		matches(p.Namespaces, req.Namespace) &&
+		matches(p.Operations, string(req.Operation))
+}
+
+func (p compiledPolicy) message() string {
+	if p.Message != "" {
+		return p.Message
+	}
+	return fmt.Sprintf("violates policy %s: %s", p.Name, p.Expression)
+}
+
+// matches reports whether value is in values, an empty list matches everything
+func matches(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeRaw decodes a raw object into generic JSON values, an empty object is null
+func decodeRaw(raw []byte) (interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var object interface{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// policyDenialResponse denies the request with a cause for every violated policy
+func policyDenialResponse(req *admissionv1.AdmissionRequest, causes []metav1.StatusCause) *admissionv1.AdmissionResponse {
+	reasons := make([]string, 0, len(causes))
+	for _, cause := range causes {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+	}
+	return &admissionv1.AdmissionResponse{
+		UID:     req.UID,
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusForbidden,
+			Reason:  metav1.StatusReasonForbidden,
+			Message: fmt.Sprintf("%s %s/%s denied by policy: %s", req.Kind.Kind, req.Namespace, req.Name, strings.Join(reasons, "; ")),
+			Details: &metav1.StatusDetails{
+				Name:   req.Name,
+				Kind:   req.Kind.Kind,
+				Causes: causes,
+			},
+		},
+	}
+}
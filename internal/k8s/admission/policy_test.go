@@ -0,0 +1,151 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func ieAgAgRuleRequest(t *testing.T, namespace, addressGroup string) *admissionv1.AdmissionRequest {
+	t.Helper()
+
+	rule := netguardv1beta1.IEAgAgRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "egr-tcp-web-internet", Namespace: namespace},
+		Spec: netguardv1beta1.IEAgAgRuleSpec{
+			Transport:    netguardv1beta1.ProtocolTCP,
+			Traffic:      netguardv1beta1.EGRESS,
+			AddressGroup: netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: addressGroup}},
+		},
+	}
+	raw, err := json.Marshal(rule)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return &admissionv1.AdmissionRequest{
+		UID:       "uid",
+		Kind:      metav1.GroupVersionKind{Kind: "IEAgAgRule"},
+		Operation: admissionv1.Create,
+		Namespace: namespace,
+		Name:      rule.Name,
+		Object:    runtime.RawExtension{Raw: raw},
+	}
+}
+
+func TestPolicyEngine_DeniesViolatingObject(t *testing.T) {
+	engine, err := NewPolicyEngine([]Policy{{
+		Name:       "prod-no-internet",
+		Kinds:      []string{"IEAgAgRule"},
+		Namespaces: []string{"prod"},
+		Expression: "object.spec.addressGroup.name != 'internet'",
+		Message:    "rules in prod must not target the internet address group",
+	}})
+	if err != nil {
+		t.Fatalf("NewPolicyEngine: %v", err)
+	}
+
+	causes, err := engine.Evaluate(ieAgAgRuleRequest(t, "prod", "internet"))
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if len(causes) != 1 || causes[0].Field != "prod-no-internet" || causes[0].Type != PolicyViolationCause {
+		t.Fatalf("expected a prod-no-internet violation, got %+v", causes)
+	}
+
+	for _, req := range []*admissionv1.AdmissionRequest{
+		ieAgAgRuleRequest(t, "prod", "backend"),
+		ieAgAgRuleRequest(t, "dev", "internet"),
+	} {
+		causes, err := engine.Evaluate(req)
+		if err != nil {
+			t.Fatalf("Evaluate: %v", err)
+		}
+		if len(causes) != 0 {
+			t.Errorf("expected %s/%s to be allowed, got %+v", req.Namespace, req.Name, causes)
+		}
+	}
+}
+
+func TestPolicyEngine_FailurePolicy(t *testing.T) {
+	engine, err := NewPolicyEngine([]Policy{
+		{Name: "strict", Expression: "object.spec.missing == 'x'"},
+		{Name: "lenient", Expression: "object.spec.missing == 'x'", FailurePolicy: PolicyFailurePolicyIgnore},
+	})
+	if err != nil {
+		t.Fatalf("NewPolicyEngine: %v", err)
+	}
+
+	causes, err := engine.Evaluate(ieAgAgRuleRequest(t, "prod", "internet"))
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if len(causes) != 1 || causes[0].Field != "strict" {
+		t.Fatalf("expected only the strict policy to deny, got %+v", causes)
+	}
+}
+
+func TestNewPolicyEngine_RejectsInvalidPolicies(t *testing.T) {
+	for name, policy := range map[string]Policy{
+		"syntax":         {Name: "p", Expression: "object.spec.("},
+		"not bool":       {Name: "p", Expression: "'text'"},
+		"no name":        {Expression: "true"},
+		"failure policy": {Name: "p", Expression: "true", FailurePolicy: "Maybe"},
+	} {
+		if _, err := NewPolicyEngine([]Policy{policy}); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestLoadPolicies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policies.yaml")
+	data := `policies:
+- name: prod-no-internet
+  kinds: [IEAgAgRule]
+  namespaces: [prod]
+  expression: object.spec.addressGroup.name != 'internet'
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	policies, err := LoadPolicies(path)
+	if err != nil {
+		t.Fatalf("LoadPolicies: %v", err)
+	}
+	if len(policies) != 1 || policies[0].Name != "prod-no-internet" || policies[0].Namespaces[0] != "prod" {
+		t.Fatalf("unexpected policies %+v", policies)
+	}
+}
+
+func TestValidationWebhook_PolicyDenial(t *testing.T) {
+	engine, err := NewPolicyEngine([]Policy{{
+		Name:       "prod-no-internet",
+		Kinds:      []string{"IEAgAgRule"},
+		Expression: "object.spec.addressGroup.name != 'internet'",
+	}})
+	if err != nil {
+		t.Fatalf("NewPolicyEngine: %v", err)
+	}
+	webhook := NewValidationWebhook(nil)
+	webhook.SetPolicyEngine(engine)
+
+	resp := webhook.ValidateAdmissionReview(context.Background(), ieAgAgRuleRequest(t, "prod", "internet"))
+	if resp.Allowed {
+		t.Fatal("expected the request to be denied")
+	}
+	if resp.Result.Reason != metav1.StatusReasonForbidden || len(resp.Result.Details.Causes) != 1 {
+		t.Fatalf("expected a forbidden status with one cause, got %+v", resp.Result)
+	}
+	if !strings.Contains(resp.Result.Message, "prod-no-internet") {
+		t.Errorf("expected the policy name in the message, got %s", resp.Result.Message)
+	}
+}
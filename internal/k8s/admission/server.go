@@ -26,7 +26,10 @@ type WebhookServer struct {
 	server            *http.Server
 	validationWebhook *ValidationWebhook
 	mutationWebhook   *MutationWebhook
+	conversionWebhook *ConversionWebhook
 	decoder           runtime.Decoder
+	certWatcher       *CertWatcher
+	caInjector        *CAInjector
 }
 
 // WebhookServerConfig configuration for webhook server
@@ -37,6 +40,15 @@ type WebhookServerConfig struct {
 	KeyFile     string `yaml:"key_file" env:"WEBHOOK_KEY_FILE" env-default:"/etc/certs/tls.key"`
 	TLSEnabled  bool   `yaml:"tls_enabled" env:"WEBHOOK_TLS_ENABLED" env-default:"true"`
 
+	// PolicyFile is a YAML file with CEL policies evaluated by the validation webhook
+	PolicyFile string `yaml:"policy_file" env:"WEBHOOK_POLICY_FILE"`
+
+	// CA injection without cert-manager: the CA file is written into the caBundle of the listed
+	// webhook configurations at startup and on every certificate reload
+	CAFile                   string   `yaml:"ca_file" env:"WEBHOOK_CA_FILE"`
+	ValidatingWebhookConfigs []string `yaml:"validating_webhook_configs" env:"WEBHOOK_VALIDATING_CONFIGS" env-separator:","`
+	MutatingWebhookConfigs   []string `yaml:"mutating_webhook_configs" env:"WEBHOOK_MUTATING_CONFIGS" env-separator:","`
+
 	// Timeouts
 	ReadTimeout  time.Duration `yaml:"read_timeout" env:"WEBHOOK_READ_TIMEOUT" env-default:"10s"`
 	WriteTimeout time.Duration `yaml:"write_timeout" env:"WEBHOOK_WRITE_TIMEOUT" env-default:"10s"`
@@ -48,6 +60,20 @@ func NewWebhookServer(config WebhookServerConfig, backendClient client.BackendCl
 	// Create validation webhook
 	validationWebhook := NewValidationWebhook(backendClient)
 
+	// Load cluster admin policies
+	if config.PolicyFile != "" {
+		policies, err := LoadPolicies(config.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		engine, err := NewPolicyEngine(policies)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile policies: %w", err)
+		}
+		validationWebhook.SetPolicyEngine(engine)
+		klog.Infof("Loaded %d admission policies from %s", engine.Len(), config.PolicyFile)
+	}
+
 	// Create mutation webhook
 	mutationWebhook, err := NewMutationWebhook()
 	if err != nil {
@@ -71,12 +97,14 @@ func NewWebhookServer(config WebhookServerConfig, backendClient client.BackendCl
 	server := &WebhookServer{
 		validationWebhook: validationWebhook,
 		mutationWebhook:   mutationWebhook,
+		conversionWebhook: NewConversionWebhook(),
 		decoder:           decoder,
 	}
 
 	// Register handlers
 	mux.HandleFunc("/validate", server.handleValidation)
 	mux.HandleFunc("/mutate", server.handleMutation)
+	mux.HandleFunc("/convert", server.handleConversion)
 	mux.HandleFunc("/healthz", server.handleHealth)
 	mux.HandleFunc("/readyz", server.handleReady)
 
@@ -88,17 +116,26 @@ func NewWebhookServer(config WebhookServerConfig, backendClient client.BackendCl
 		IdleTimeout:  config.IdleTimeout,
 	}
 
-	// Configure TLS if enabled
+	// Configure TLS if enabled, the certificate is reloaded when the secret is rotated
 	if config.TLSEnabled {
-		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		certWatcher, err := NewCertWatcher(config.CertFile, config.KeyFile)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+			return nil, err
 		}
+		server.certWatcher = certWatcher
 
 		httpServer.TLSConfig = &tls.Config{
-			Certificates: []tls.Certificate{cert},
-			MinVersion:   tls.VersionTLS12,
+			GetCertificate: certWatcher.GetCertificate,
+			MinVersion:     tls.VersionTLS12,
+		}
+	}
+
+	if config.CAFile != "" && len(config.ValidatingWebhookConfigs)+len(config.MutatingWebhookConfigs) > 0 {
+		caInjector, err := NewCAInjector(config.CAFile, config.ValidatingWebhookConfigs, config.MutatingWebhookConfigs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CA injector: %w", err)
 		}
+		server.caInjector = caInjector
 	}
 
 	server.server = httpServer
@@ -109,6 +146,27 @@ func NewWebhookServer(config WebhookServerConfig, backendClient client.BackendCl
 func (s *WebhookServer) Start(ctx context.Context) error {
 	klog.Infof("Starting webhook server on %s", s.server.Addr)
 
+	if s.caInjector != nil {
+		if err := s.caInjector.Inject(ctx); err != nil {
+			klog.Errorf("❌ Failed to inject CA bundle: %v", err)
+		}
+	}
+
+	if s.certWatcher != nil {
+		if s.caInjector != nil {
+			s.certWatcher.OnReload(func() {
+				if err := s.caInjector.Inject(ctx); err != nil {
+					klog.Errorf("❌ Failed to inject CA bundle: %v", err)
+				}
+			})
+		}
+		go func() {
+			if err := s.certWatcher.Watch(ctx); err != nil {
+				klog.Errorf("❌ Webhook certificate reload disabled: %v", err)
+			}
+		}()
+	}
+
 	// Start server in goroutine
 	errChan := make(chan error, 1)
 	go func() {
@@ -329,6 +387,10 @@ func (c *WebhookServerConfig) Validate() error {
 		}
 	}
 
+	if len(c.ValidatingWebhookConfigs)+len(c.MutatingWebhookConfigs) > 0 && c.CAFile == "" {
+		return fmt.Errorf("ca_file is required to inject the CA bundle into webhook configurations")
+	}
+
 	if c.ReadTimeout <= 0 {
 		return fmt.Errorf("read_timeout must be positive")
 	}
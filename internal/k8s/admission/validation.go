@@ -19,6 +19,7 @@ import (
 // ValidationWebhook реализует валидацию ресурсов через backend валидаторы
 type ValidationWebhook struct {
 	backendClient client.BackendClient
+	policies      *PolicyEngine
 }
 
 func NewValidationWebhook(backendClient client.BackendClient) *ValidationWebhook {
@@ -27,9 +28,25 @@ func NewValidationWebhook(backendClient client.BackendClient) *ValidationWebhook
 	}
 }
 
+// SetPolicyEngine включает проверку запросов политиками администратора кластера
+func (w *ValidationWebhook) SetPolicyEngine(policies *PolicyEngine) {
+	w.policies = policies
+}
+
 func (w *ValidationWebhook) ValidateAdmissionReview(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
 	// 🔍 COMPREHENSIVE WEBHOOK TRACING - Start
 
+	// Политики администратора проверяются до валидации ресурса
+	if w.policies != nil {
+		causes, err := w.policies.Evaluate(req)
+		if err != nil {
+			return w.errorResponse(req.UID, fmt.Sprintf("Failed to evaluate policies: %v", err))
+		}
+		if len(causes) > 0 {
+			return policyDenialResponse(req, causes)
+		}
+	}
+
 	var response *admissionv1.AdmissionResponse
 	switch req.Kind.Kind {
 	case "Service":
@@ -134,9 +151,25 @@ func (w *ValidationWebhook) validateService(ctx context.Context, req *admissionv
 		}
 	}
 
+	// Проверяем конфликты портов с другими сервисами в портмаппингах привязанных AddressGroups
+	if err := checkServicePortConflicts(ctx, serviceValidator, service); err != nil {
+		return w.errorResponse(req.UID, fmt.Sprintf("Service port conflict: %v", err))
+	}
+
 	return w.allowResponse(req.UID, "Service validation passed")
 }
 
+// checkServicePortConflicts отклоняет сервис, если его IngressPorts перекрываются с портами
+// других сервисов в AddressGroupPortMapping любой привязанной AddressGroup
+// (через spec.addressGroups или AddressGroupBinding). В ошибке перечисляются все конфликты.
+func checkServicePortConflicts(ctx context.Context, serviceValidator *validation.ServiceValidator, service netguardv1beta1.Service) error {
+	domainService := convertServiceToDomain(service)
+	for _, ref := range service.Spec.AddressGroups {
+		domainService.AddressGroups = append(domainService.AddressGroups, models.NewAddressGroupRef(ref.Name, models.WithNamespace(ref.Namespace)))
+	}
+	return serviceValidator.CheckBoundPortOverlaps(ctx, domainService)
+}
+
 func (w *ValidationWebhook) validateAddressGroup(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
 	// 🔧 FIX: Handle DELETE operations separately - no object to unmarshal
 	if req.Operation == admissionv1.Delete {
@@ -0,0 +1,161 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/application/validation"
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// newPortConflictReader stores the address group infra/shared with db (TCP 5432) in its port mapping and
+// the service app/web bound to it by a binding of the address group namespace
+func newPortConflictReader(t *testing.T) ports.Reader {
+	t.Helper()
+	ctx := context.Background()
+	registry := mem.NewRegistry()
+
+	shared := models.NewAddressGroupRef("shared", models.WithNamespace("infra"))
+	web := models.Service{
+		SelfRef:      models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("app"))),
+		IngressPorts: []models.IngressPort{{Protocol: models.TCP, Port: "80"}},
+		AggregatedAddressGroups: []models.AddressGroupReference{
+			{Ref: shared, Source: models.AddressGroupSourceBinding},
+		},
+	}
+	bindings := []models.AddressGroupBinding{
+		{
+			SelfRef:         models.NewSelfRef(models.NewResourceIdentifier("web-shared", models.WithNamespace("infra"))),
+			ServiceRef:      models.NewServiceRef("web", models.WithNamespace("app")),
+			AddressGroupRef: shared,
+		},
+		{
+			SelfRef:         models.NewSelfRef(models.NewResourceIdentifier("api-private", models.WithNamespace("app"))),
+			ServiceRef:      models.NewServiceRef("api"),
+			AddressGroupRef: models.NewAddressGroupRef("private"),
+		},
+	}
+	newMapping := func(name, namespace, service string, port int) models.AddressGroupPortMapping {
+		return models.AddressGroupPortMapping{
+			SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(namespace))),
+			AccessPorts: map[models.ServiceRef]models.ServicePorts{
+				models.NewServiceRef(service, models.WithNamespace(namespace)): {
+					Ports: models.ProtocolPorts{models.TCP: []models.PortRange{{Start: port, End: port}}},
+				},
+			},
+		}
+	}
+
+	writer, err := registry.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.SyncServices(ctx, []models.Service{web}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.SyncAddressGroupBindings(ctx, bindings, nil); err != nil {
+		t.Fatal(err)
+	}
+	mappings := []models.AddressGroupPortMapping{
+		newMapping("shared", "infra", "db", 5432),
+		newMapping("private", "app", "cache", 6379),
+	}
+	if err := writer.SyncAddressGroupPortMappings(ctx, mappings, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := registry.Reader(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { reader.Close() })
+	return reader
+}
+
+// scopeRecordingReader records the scopes AddressGroupBindings are listed with
+type scopeRecordingReader struct {
+	ports.Reader
+	bindingScopes []ports.Scope
+}
+
+func (r *scopeRecordingReader) ListAddressGroupBindings(ctx context.Context, consume func(models.AddressGroupBinding) error, scope ports.Scope) error {
+	r.bindingScopes = append(r.bindingScopes, scope)
+	return r.Reader.ListAddressGroupBindings(ctx, consume, scope)
+}
+
+func admissionService(name string, port string, addressGroups ...netguardv1beta1.NamespacedObjectReference) netguardv1beta1.Service {
+	return netguardv1beta1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "app"},
+		Spec: netguardv1beta1.ServiceSpec{
+			IngressPorts:  []netguardv1beta1.IngressPort{{Protocol: netguardv1beta1.ProtocolTCP, Port: port}},
+			AddressGroups: addressGroups,
+		},
+	}
+}
+
+func TestCheckServicePortConflicts(t *testing.T) {
+	ctx := context.Background()
+	serviceValidator := validation.NewServiceValidator(newPortConflictReader(t))
+
+	if err := checkServicePortConflicts(ctx, serviceValidator, admissionService("web", "80")); err != nil {
+		t.Fatalf("expected no conflict, got %v", err)
+	}
+
+	err := checkServicePortConflicts(ctx, serviceValidator, admissionService("web", "5000-6000"))
+	if err == nil {
+		t.Fatal("expected a conflict in the address group bound from another namespace")
+	}
+	if !strings.Contains(err.Error(), "infra/db") || !strings.Contains(err.Error(), "infra/shared") {
+		t.Errorf("conflict must name the service and address group, got %v", err)
+	}
+	var conflictErr *validation.PortConflictError
+	if !errors.As(err, &conflictErr) || len(conflictErr.Conflicts) != 1 {
+		t.Fatalf("expected conflicts of validation.FindPortConflicts, got %#v", err)
+	}
+	if conflict := conflictErr.Conflicts[0]; conflict.Port != "5000-6000" || conflict.ConflictingRange != (models.PortRange{Start: 5432, End: 5432}) {
+		t.Errorf("unexpected conflict %+v", conflict)
+	}
+
+	// Spec address groups are checked as well, the empty namespace is the service namespace
+	private := netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: "private"}}
+	err = checkServicePortConflicts(ctx, serviceValidator, admissionService("worker", "6379", private))
+	if err == nil || !strings.Contains(err.Error(), "app/cache") {
+		t.Fatalf("expected a conflict with app/cache, got %v", err)
+	}
+
+	// Bindings of other services don't contribute their address groups
+	if err := checkServicePortConflicts(ctx, serviceValidator, admissionService("worker", "6379")); err != nil {
+		t.Fatalf("expected no conflict for a service without address groups, got %v", err)
+	}
+}
+
+func TestCheckServicePortConflicts_ScopesBindings(t *testing.T) {
+	reader := &scopeRecordingReader{Reader: newPortConflictReader(t)}
+	serviceValidator := validation.NewServiceValidator(reader)
+
+	if err := checkServicePortConflicts(context.Background(), serviceValidator, admissionService("web", "5432")); err == nil {
+		t.Fatal("expected a conflict")
+	}
+
+	var namespaces []string
+	for _, scope := range reader.bindingScopes {
+		identifierScope, ok := scope.(ports.ResourceIdentifierScope)
+		if !ok || len(identifierScope.Identifiers) != 1 || identifierScope.Identifiers[0].Name != "" {
+			t.Fatalf("bindings must be listed by namespace, got scope %#v", scope)
+		}
+		namespaces = append(namespaces, identifierScope.Identifiers[0].Namespace)
+	}
+	if strings.Join(namespaces, ",") != "app,infra" {
+		t.Errorf("expected bindings of the service and bound address group namespaces, got %v", namespaces)
+	}
+}
@@ -0,0 +1,292 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// Hub → v1 conversion of lists. The generated conversion casts the items, so they would keep the
+// apiVersion the storage sets for the hub (v1beta1); the items are converted one by one instead
+// and get the v1 TypeMeta.
+
+// itemTypeMeta returns the v1 TypeMeta of a list item, items without TypeMeta stay without it
+func itemTypeMeta(in metav1.TypeMeta) metav1.TypeMeta {
+	if in.Kind == "" {
+		return in
+	}
+	return metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: in.Kind}
+}
+
+// Convert_v1beta1_ServiceList_To_v1_ServiceList converts a hub ServiceList to v1
+func Convert_v1beta1_ServiceList_To_v1_ServiceList(in *v1beta1.ServiceList, out *ServiceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]Service, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_Service_To_v1_Service(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_AddressGroupList_To_v1_AddressGroupList converts a hub AddressGroupList to v1
+func Convert_v1beta1_AddressGroupList_To_v1_AddressGroupList(in *v1beta1.AddressGroupList, out *AddressGroupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]AddressGroup, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_AddressGroup_To_v1_AddressGroup(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_AddressGroupBindingList_To_v1_AddressGroupBindingList converts a hub AddressGroupBindingList to v1
+func Convert_v1beta1_AddressGroupBindingList_To_v1_AddressGroupBindingList(in *v1beta1.AddressGroupBindingList, out *AddressGroupBindingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]AddressGroupBinding, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_AddressGroupBinding_To_v1_AddressGroupBinding(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_AddressGroupPortMappingList_To_v1_AddressGroupPortMappingList converts a hub AddressGroupPortMappingList to v1
+func Convert_v1beta1_AddressGroupPortMappingList_To_v1_AddressGroupPortMappingList(in *v1beta1.AddressGroupPortMappingList, out *AddressGroupPortMappingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]AddressGroupPortMapping, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_AddressGroupPortMapping_To_v1_AddressGroupPortMapping(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_RuleS2SList_To_v1_RuleS2SList converts a hub RuleS2SList to v1
+func Convert_v1beta1_RuleS2SList_To_v1_RuleS2SList(in *v1beta1.RuleS2SList, out *RuleS2SList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]RuleS2S, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_RuleS2S_To_v1_RuleS2S(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_ServiceAliasList_To_v1_ServiceAliasList converts a hub ServiceAliasList to v1
+func Convert_v1beta1_ServiceAliasList_To_v1_ServiceAliasList(in *v1beta1.ServiceAliasList, out *ServiceAliasList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]ServiceAlias, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_ServiceAlias_To_v1_ServiceAlias(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_AddressGroupBindingPolicyList_To_v1_AddressGroupBindingPolicyList converts a hub AddressGroupBindingPolicyList to v1
+func Convert_v1beta1_AddressGroupBindingPolicyList_To_v1_AddressGroupBindingPolicyList(in *v1beta1.AddressGroupBindingPolicyList, out *AddressGroupBindingPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]AddressGroupBindingPolicy, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_AddressGroupBindingPolicy_To_v1_AddressGroupBindingPolicy(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_IEAgAgRuleList_To_v1_IEAgAgRuleList converts a hub IEAgAgRuleList to v1
+func Convert_v1beta1_IEAgAgRuleList_To_v1_IEAgAgRuleList(in *v1beta1.IEAgAgRuleList, out *IEAgAgRuleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]IEAgAgRule, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_IEAgAgRule_To_v1_IEAgAgRule(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworkList_To_v1_NetworkList converts a hub NetworkList to v1
+func Convert_v1beta1_NetworkList_To_v1_NetworkList(in *v1beta1.NetworkList, out *NetworkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]Network, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_Network_To_v1_Network(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworkBindingList_To_v1_NetworkBindingList converts a hub NetworkBindingList to v1
+func Convert_v1beta1_NetworkBindingList_To_v1_NetworkBindingList(in *v1beta1.NetworkBindingList, out *NetworkBindingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]NetworkBinding, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_NetworkBinding_To_v1_NetworkBinding(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_HostList_To_v1_HostList converts a hub HostList to v1
+func Convert_v1beta1_HostList_To_v1_HostList(in *v1beta1.HostList, out *HostList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]Host, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_Host_To_v1_Host(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_HostBindingList_To_v1_HostBindingList converts a hub HostBindingList to v1
+func Convert_v1beta1_HostBindingList_To_v1_HostBindingList(in *v1beta1.HostBindingList, out *HostBindingList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]HostBinding, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_HostBinding_To_v1_HostBinding(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_AddressGroupsSpecList_To_v1_AddressGroupsSpecList converts a hub AddressGroupsSpecList to v1
+func Convert_v1beta1_AddressGroupsSpecList_To_v1_AddressGroupsSpecList(in *v1beta1.AddressGroupsSpecList, out *AddressGroupsSpecList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]AddressGroupsSpec, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_AddressGroupsSpec_To_v1_AddressGroupsSpec(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_RuleS2SDstOwnRefSpecList_To_v1_RuleS2SDstOwnRefSpecList converts a hub RuleS2SDstOwnRefSpecList to v1
+func Convert_v1beta1_RuleS2SDstOwnRefSpecList_To_v1_RuleS2SDstOwnRefSpecList(in *v1beta1.RuleS2SDstOwnRefSpecList, out *RuleS2SDstOwnRefSpecList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]RuleS2SDstOwnRefSpec, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_RuleS2SDstOwnRefSpec_To_v1_RuleS2SDstOwnRefSpec(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_AccessPortsSpecList_To_v1_AccessPortsSpecList converts a hub AccessPortsSpecList to v1
+func Convert_v1beta1_AccessPortsSpecList_To_v1_AccessPortsSpecList(in *v1beta1.AccessPortsSpecList, out *AccessPortsSpecList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]AccessPortsSpec, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_AccessPortsSpec_To_v1_AccessPortsSpec(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
+
+// Convert_v1beta1_NetworksSpecList_To_v1_NetworksSpecList converts a hub NetworksSpecList to v1
+func Convert_v1beta1_NetworksSpecList_To_v1_NetworksSpecList(in *v1beta1.NetworksSpecList, out *NetworksSpecList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]NetworksSpec, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_NetworksSpec_To_v1_NetworksSpec(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+		out.Items[i].TypeMeta = itemTypeMeta(in.Items[i].TypeMeta)
+	}
+	return nil
+}
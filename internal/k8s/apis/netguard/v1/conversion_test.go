@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	v1beta1.Install(scheme)
+	Install(scheme)
+	return scheme
+}
+
+func TestConvertList_ItemsGetV1TypeMeta(t *testing.T) {
+	scheme := newScheme(t)
+
+	// Storage returns hub objects with the v1beta1 TypeMeta
+	hub := &v1beta1.ServiceList{Items: []v1beta1.Service{{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       v1beta1.ServiceSpec{Description: "frontend"},
+	}}}
+
+	out := &ServiceList{}
+	if err := scheme.Convert(hub, out, nil); err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	if len(out.Items) != 1 {
+		t.Fatalf("expected one item, got %d", len(out.Items))
+	}
+	item := out.Items[0]
+	if item.APIVersion != SchemeGroupVersion.String() || item.Kind != "Service" {
+		t.Errorf("expected the v1 TypeMeta, got %s %s", item.APIVersion, item.Kind)
+	}
+	if item.Name != "web" || item.Spec.Description != "frontend" {
+		t.Errorf("item was not preserved: %+v", item)
+	}
+}
+
+func TestConvertToVersion_RoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+
+	in := &v1beta1.AddressGroup{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "AddressGroup"},
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+		Spec:       v1beta1.AddressGroupSpec{DefaultAction: v1beta1.ActionAccept, Logs: true},
+	}
+
+	out, err := scheme.ConvertToVersion(in, SchemeGroupVersion)
+	if err != nil {
+		t.Fatalf("ConvertToVersion v1: %v", err)
+	}
+	ag, ok := out.(*AddressGroup)
+	if !ok {
+		t.Fatalf("expected *v1.AddressGroup, got %T", out)
+	}
+	if ag.APIVersion != SchemeGroupVersion.String() || ag.Spec.DefaultAction != ActionAccept || !ag.Spec.Logs {
+		t.Errorf("unexpected v1 object %+v", ag)
+	}
+
+	back, err := scheme.ConvertToVersion(ag, v1beta1.SchemeGroupVersion)
+	if err != nil {
+		t.Fatalf("ConvertToVersion v1beta1: %v", err)
+	}
+	if got := back.(*v1beta1.AddressGroup); got.Name != "backend" || got.Spec.DefaultAction != v1beta1.ActionAccept {
+		t.Errorf("unexpected round trip result %+v", got)
+	}
+}
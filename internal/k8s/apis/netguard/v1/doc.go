@@ -0,0 +1,14 @@
+// +k8s:deepcopy-gen=package,register
+// +k8s:conversion-gen=netguard-pg-backend/internal/k8s/apis/netguard/v1beta1
+// +k8s:defaulter-gen=TypeMeta
+// +k8s:openapi-gen=true
+// +groupName=netguard.sgroups.io
+
+// Package v1 contains API schema definitions for the netguard v1 API group.
+//
+// v1 is served next to v1beta1 during the version migration. Objects are stored and handled
+// in the internal (hub) version, which reuses the v1beta1 structs; v1 is a spoke converted
+// to and from the hub by the generated conversion functions. v1 starts with the same schema as
+// v1beta1; fields that diverge later get hand-written Convert_* functions, which
+// conversion-gen picks up instead of the generated ones.
+package v1
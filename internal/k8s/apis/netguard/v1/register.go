@@ -0,0 +1,78 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+// GroupName is the group name used in this package
+const GroupName = "netguard.sgroups.io"
+
+// SchemeGroupVersion is group version used to register these objects
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1"}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+var (
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder      = runtime.NewSchemeBuilder(addKnownTypes, RegisterDefaults, addFieldLabelConversionFuncs)
+	localSchemeBuilder = &SchemeBuilder
+	// AddToScheme adds the types and the conversions to the hub of this group-version to the given scheme.
+	AddToScheme = localSchemeBuilder.AddToScheme
+)
+
+// addKnownTypes adds the set of types defined in this package to the supplied scheme.
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&Service{},
+		&ServiceList{},
+		&AddressGroup{},
+		&AddressGroupList{},
+		&AddressGroupBinding{},
+		&AddressGroupBindingList{},
+		&AddressGroupPortMapping{},
+		&AddressGroupPortMappingList{},
+		&RuleS2S{},
+		&RuleS2SList{},
+		&ServiceAlias{},
+		&ServiceAliasList{},
+		&AddressGroupBindingPolicy{},
+		&AddressGroupBindingPolicyList{},
+		&IEAgAgRule{},
+		&IEAgAgRuleList{},
+		&AddressGroupsSpec{},
+		&AddressGroupsSpecList{},
+		&RuleS2SDstOwnRefSpec{},
+		&RuleS2SDstOwnRefSpecList{},
+		&AccessPortsSpec{},
+		&AccessPortsSpecList{},
+		&NetworksSpec{},
+		&NetworksSpecList{},
+		&Network{},
+		&NetworkList{},
+		&NetworkBinding{},
+		&NetworkBindingList{},
+		&Host{},
+		&HostList{},
+		&HostBinding{},
+		&HostBindingList{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+// addFieldLabelConversionFuncs registers the selectable fields of the kinds, they are the same as in v1beta1
+func addFieldLabelConversionFuncs(scheme *runtime.Scheme) error {
+	for kind := range v1beta1.SelectableFields {
+		if err := scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind(kind), v1beta1.FieldLabelConversionFunc(kind)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package v1beta1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SelectableFields lists the fields supported by field selectors of each kind
+// in addition to metadata.name and metadata.namespace
+var SelectableFields = map[string][]string{
+	"Service":      {},
+	"AddressGroup": {"spec.defaultAction"},
+	"AddressGroupBinding": {
+		"spec.serviceRef.name", "spec.serviceRef.namespace",
+		"spec.addressGroupRef.name", "spec.addressGroupRef.namespace",
+	},
+	"AddressGroupPortMapping": {},
+	"RuleS2S": {
+		"spec.traffic", "spec.action",
+		"spec.serviceLocalRef.name", "spec.serviceLocalRef.namespace",
+		"spec.serviceRef.name", "spec.serviceRef.namespace",
+	},
+	"ServiceAlias": {"spec.serviceRef.name", "spec.serviceRef.namespace"},
+	"AddressGroupBindingPolicy": {
+		"spec.serviceRef.name", "spec.serviceRef.namespace",
+		"spec.addressGroupRef.name", "spec.addressGroupRef.namespace",
+	},
+	"IEAgAgRule": {
+		"spec.traffic", "spec.transport", "spec.action",
+		"spec.addressGroupLocal.name", "spec.addressGroupLocal.namespace",
+		"spec.addressGroup.name", "spec.addressGroup.namespace",
+	},
+	"Network":        {"spec.cidr", "status.isBound"},
+	"NetworkBinding": {"spec.networkRef.name", "spec.addressGroupRef.name"},
+	"Host":           {"spec.uuid", "status.isBound"},
+	"HostBinding":    {"spec.hostRef.name", "spec.addressGroupRef.name"},
+}
+
+// IsSelectableField reports whether field selectors of kind support the field
+func IsSelectableField(kind, field string) bool {
+	if field == "metadata.name" || field == "metadata.namespace" {
+		return true
+	}
+	for _, selectable := range SelectableFields[kind] {
+		if selectable == field {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldLabelConversionFunc accepts the selectable fields of kind
+func FieldLabelConversionFunc(kind string) runtime.FieldLabelConversionFunc {
+	return func(label, value string) (string, string, error) {
+		if !IsSelectableField(kind, label) {
+			return "", "", fmt.Errorf("field label not supported for %s: %s", kind, label)
+		}
+		return label, value, nil
+	}
+}
+
+// addFieldLabelConversionFuncs registers the selectable fields so field selectors
+// with spec fields pass the request validation of the apiserver
+func addFieldLabelConversionFuncs(scheme *runtime.Scheme) error {
+	for kind := range SelectableFields {
+		if err := scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind(kind), FieldLabelConversionFunc(kind)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
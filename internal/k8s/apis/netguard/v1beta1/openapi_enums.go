@@ -110,6 +110,15 @@ func modifyStructFieldsWithEnums(defs map[string]common.OpenAPIDefinition) {
 				trafficProp.SchemaProps.Description = "Traffic direction (INGRESS or EGRESS)"
 				r2sSpec.Schema.Properties["traffic"] = trafficProp
 			}
+			if actionProp, ok := r2sSpec.Schema.Properties["action"]; ok {
+				actionProp.SchemaProps.Enum = []interface{}{"ACCEPT", "DROP"}
+				actionProp.SchemaProps.Description = "Action of the generated IEAgAg rules (ACCEPT or DROP)"
+				r2sSpec.Schema.Properties["action"] = actionProp
+			}
+			if portsSourceProp, ok := r2sSpec.Schema.Properties["portsSource"]; ok {
+				portsSourceProp.SchemaProps.Enum = []interface{}{"LOCAL", "TARGET"}
+				r2sSpec.Schema.Properties["portsSource"] = portsSourceProp
+			}
 
 			defs["netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.RuleS2SSpec"] = r2sSpec
 		}
@@ -22,7 +22,7 @@ func Resource(resource string) schema.GroupResource {
 
 var (
 	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
-	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes, addKnownTypesInternal)
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes, addKnownTypesInternal, RegisterDefaults, addFieldLabelConversionFuncs)
 	// AddToScheme adds the types in this group-version to the given scheme.
 	AddToScheme = SchemeBuilder.AddToScheme
 )
@@ -10,6 +10,7 @@ import (
 // TransportProtocol represents protocols for transport layer
 // +kubebuilder:validation:Enum=TCP;UDP
 // +k8s:openapi-gen=true
+// +enum
 type TransportProtocol string
 
 const (
@@ -20,6 +21,7 @@ const (
 // Traffic represents traffic direction for rules
 // +kubebuilder:validation:Enum=INGRESS;EGRESS
 // +k8s:openapi-gen=true
+// +enum
 type Traffic string
 
 const (
@@ -32,6 +34,7 @@ const (
 // RuleAction represents the action to take for a rule
 // +kubebuilder:validation:Enum=ACCEPT;DROP
 // +k8s:openapi-gen=true
+// +enum
 type RuleAction string
 
 const (
@@ -63,6 +66,7 @@ type Service struct {
 // ServiceSpec defines the desired state of Service
 type ServiceSpec struct {
 	// Description of the service
+	// +k8s:validation:maxLength=512
 	// +optional
 	Description string `json:"description,omitempty"`
 
@@ -81,10 +85,12 @@ type IngressPort struct {
 	// +kubebuilder:validation:Enum=TCP;UDP
 	Protocol TransportProtocol `json:"protocol"`
 
-	// Port or port range (e.g., "80", "8080-9090")
+	// Port or port range (e.g., "80", "8080-9090"), a comma separated list of them
+	// +k8s:validation:pattern="^\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?(\\s*,\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?)*\\s*$"
 	Port string `json:"port"`
 
 	// Description of this port configuration
+	// +k8s:validation:maxLength=512
 	// +optional
 	Description string `json:"description,omitempty"`
 }
@@ -94,11 +100,15 @@ type PortRange struct {
 	// From port (inclusive)
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=65535
+	// +k8s:validation:minimum=1
+	// +k8s:validation:maximum=65535
 	From int32 `json:"from"`
 
 	// To port (inclusive)
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=65535
+	// +k8s:validation:minimum=1
+	// +k8s:validation:maximum=65535
 	To int32 `json:"to"`
 }
 
@@ -231,6 +241,11 @@ type AddressGroupSpec struct {
 	// Each host can belong to only one AddressGroup
 	// +optional
 	Hosts []ObjectReference `json:"hosts,omitempty"`
+
+	// IncludedGroups are child AddressGroups, rules targeting this group also cover
+	// all groups included transitively. Namespace defaults to the namespace of this group
+	// +optional
+	IncludedGroups []NamespacedObjectReference `json:"includedGroups,omitempty"`
 }
 
 // AddressGroupStatus defines the observed state of AddressGroup
@@ -302,6 +317,7 @@ type NamespacedObjectReference struct {
 
 // HostRegistrationSource represents the source of host registration
 // +kubebuilder:validation:Enum=spec;binding
+// +enum
 type HostRegistrationSource string
 
 const (
@@ -325,6 +341,7 @@ type HostReference struct {
 
 // AddressGroupRegistrationSource represents the source of address group registration
 // +kubebuilder:validation:Enum=spec;binding
+// +enum
 type AddressGroupRegistrationSource string
 
 const (
@@ -347,7 +364,8 @@ type AddressGroupReference struct {
 
 // PortConfig defines a port or port range configuration
 type PortConfig struct {
-	// Port or port range (e.g., "80", "8080-9090")
+	// Port or port range (e.g., "80", "8080-9090"), a comma separated list of them
+	// +k8s:validation:pattern="^\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?(\\s*,\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?)*\\s*$"
 	Port string `json:"port"`
 
 	// Description of this port configuration
@@ -481,6 +499,35 @@ type RuleS2SSpec struct {
 	// Whether to enable trace
 	// +optional
 	Trace bool `json:"trace"`
+
+	// Action of the generated IEAgAg rules (ACCEPT, DROP), ACCEPT when not set
+	// +kubebuilder:validation:Enum=ACCEPT;DROP
+	// +default="ACCEPT"
+	// +optional
+	Action RuleAction `json:"action,omitempty"`
+
+	// PortsSource selects the service whose ports are opened (LOCAL, TARGET).
+	// By default the local service for INGRESS and the target service for EGRESS.
+	// +kubebuilder:validation:Enum=LOCAL;TARGET
+	// +optional
+	PortsSource string `json:"portsSource,omitempty"`
+
+	// ExtraPorts are opened in addition to the ports of the service
+	// +optional
+	ExtraPorts []IngressPort `json:"extraPorts,omitempty"`
+
+	// ValidFrom is the time the rule starts generating IEAgAg rules, immediately when not set
+	// +optional
+	ValidFrom *metav1.Time `json:"validFrom,omitempty"`
+
+	// ValidUntil is the time the generated IEAgAg rules are removed, never when not set
+	// +optional
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+
+	// Priority of the generated IEAgAg rules, lower priorities are applied first.
+	// Calculated by the priority strategy of the backend when not set
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // RuleS2SStatus defines the observed state of RuleS2S
@@ -563,7 +610,8 @@ type AddressGroupBindingPolicySpec struct {
 	// AddressGroupRef is a reference to the AddressGroup resource
 	AddressGroupRef NamespacedObjectReference `json:"addressGroupRef"`
 
-	// ServiceRef is a reference to the Service resource
+	// ServiceRef is a reference to the Service resource. Without a name the policy allows every
+	// Service of the namespace, which is accepted for global AddressGroups only
 	ServiceRef NamespacedObjectReference `json:"serviceRef"`
 }
 
@@ -602,6 +650,7 @@ type IEAgAgRule struct {
 // IEAgAgRuleSpec defines the desired state of IEAgAgRule
 type IEAgAgRuleSpec struct {
 	// Description of the rule
+	// +k8s:validation:maxLength=512
 	// +optional
 	Description string `json:"description,omitempty"`
 
@@ -627,6 +676,7 @@ type IEAgAgRuleSpec struct {
 
 	// Action for the rule (ACCEPT, DROP)
 	// +kubebuilder:validation:Enum=ACCEPT;DROP
+	// +default="ACCEPT"
 	// +optional
 	Action RuleAction `json:"action,omitempty"`
 
@@ -644,6 +694,8 @@ type PortSpec struct {
 	// Port number
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=65535
+	// +k8s:validation:minimum=1
+	// +k8s:validation:maximum=65535
 	// +optional
 	Port int32 `json:"port,omitempty"`
 
@@ -675,6 +727,7 @@ type IEAgAgRuleList struct {
 // NetworkSpec defines the desired state of Network
 type NetworkSpec struct {
 	// CIDR is the IP range in CIDR notation
+	// +k8s:validation:format="cidr"
 	CIDR string `json:"cidr"`
 }
 
@@ -765,6 +818,7 @@ type HostSpec struct {
 	// UUID is the unique identifier of the host
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=`^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$`
+	// +k8s:validation:pattern="^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$"
 	UUID string `json:"uuid"`
 }
 
@@ -493,6 +493,11 @@ func (in *AddressGroupSpec) DeepCopyInto(out *AddressGroupSpec) {
 		*out = make([]ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.IncludedGroups != nil {
+		in, out := &in.IncludedGroups, &out.IncludedGroups
+		*out = make([]NamespacedObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1409,7 +1414,7 @@ func (in *RuleS2S) DeepCopyInto(out *RuleS2S) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -1534,6 +1539,19 @@ func (in *RuleS2SSpec) DeepCopyInto(out *RuleS2SSpec) {
 	*out = *in
 	out.ServiceLocalRef = in.ServiceLocalRef
 	out.ServiceRef = in.ServiceRef
+	if in.ExtraPorts != nil {
+		in, out := &in.ExtraPorts, &out.ExtraPorts
+		*out = make([]IngressPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidFrom != nil {
+		in, out := &in.ValidFrom, &out.ValidFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
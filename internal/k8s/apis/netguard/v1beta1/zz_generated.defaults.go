@@ -29,5 +29,35 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&IEAgAgRule{}, func(obj interface{}) { SetObjectDefaults_IEAgAgRule(obj.(*IEAgAgRule)) })
+	scheme.AddTypeDefaultingFunc(&IEAgAgRuleList{}, func(obj interface{}) { SetObjectDefaults_IEAgAgRuleList(obj.(*IEAgAgRuleList)) })
+	scheme.AddTypeDefaultingFunc(&RuleS2S{}, func(obj interface{}) { SetObjectDefaults_RuleS2S(obj.(*RuleS2S)) })
+	scheme.AddTypeDefaultingFunc(&RuleS2SList{}, func(obj interface{}) { SetObjectDefaults_RuleS2SList(obj.(*RuleS2SList)) })
 	return nil
 }
+
+func SetObjectDefaults_IEAgAgRule(in *IEAgAgRule) {
+	if in.Spec.Action == "" {
+		in.Spec.Action = "ACCEPT"
+	}
+}
+
+func SetObjectDefaults_IEAgAgRuleList(in *IEAgAgRuleList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_IEAgAgRule(a)
+	}
+}
+
+func SetObjectDefaults_RuleS2S(in *RuleS2S) {
+	if in.Spec.Action == "" {
+		in.Spec.Action = "ACCEPT"
+	}
+}
+
+func SetObjectDefaults_RuleS2SList(in *RuleS2SList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_RuleS2S(a)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	common "k8s.io/kube-openapi/pkg/common"
 	spec "k8s.io/kube-openapi/pkg/validation/spec"
+	ptr "k8s.io/utils/ptr"
 )
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
@@ -3211,7 +3212,7 @@ func schema_k8s_apis_netguard_v1beta1_AddressGroupBindingPolicySpec(ref common.R
 					},
 					"serviceRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceRef is a reference to the Service resource",
+							Description: "ServiceRef is a reference to the Service resource. Without a name the policy allows every Service of the namespace, which is accepted for global AddressGroups only",
 							Default:     map[string]interface{}{},
 							Ref:         ref("netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.NamespacedObjectReference"),
 						},
@@ -3541,10 +3542,11 @@ func schema_k8s_apis_netguard_v1beta1_AddressGroupReference(ref common.Reference
 					},
 					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source indicates how this address group was registered",
+							Description: "Source indicates how this address group was registered\n\nPossible enum values:\n - `\"binding\"` indicates the address group was registered via AddressGroupBinding\n - `\"spec\"` indicates the address group was registered via Service.spec.addressGroups",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"binding", "spec"},
 						},
 					},
 				},
@@ -3565,10 +3567,11 @@ func schema_k8s_apis_netguard_v1beta1_AddressGroupSpec(ref common.ReferenceCallb
 				Properties: map[string]spec.Schema{
 					"defaultAction": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default action for the address group",
+							Description: "Default action for the address group\n\nPossible enum values:\n - `\"ACCEPT\"` accepts network packets\n - `\"DROP\"` drops network packets",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"ACCEPT", "DROP"},
 						},
 					},
 					"logs": {
@@ -3601,12 +3604,26 @@ func schema_k8s_apis_netguard_v1beta1_AddressGroupSpec(ref common.ReferenceCallb
 							},
 						},
 					},
+					"includedGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncludedGroups are child AddressGroups, rules targeting this group also cover all groups included transitively. Namespace defaults to the namespace of this group",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.NamespacedObjectReference"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"defaultAction"},
 			},
 		},
 		Dependencies: []string{
-			"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.ObjectReference"},
+			"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.NamespacedObjectReference", "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.ObjectReference"},
 	}
 }
 
@@ -4053,10 +4070,11 @@ func schema_k8s_apis_netguard_v1beta1_HostReference(ref common.ReferenceCallback
 					},
 					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source indicates how this host was registered (spec or binding)",
+							Description: "Source indicates how this host was registered (spec or binding)\n\nPossible enum values:\n - `\"binding\"` indicates the host was registered via HostBinding\n - `\"spec\"` indicates the host was registered via AddressGroup.spec.hosts",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"binding", "spec"},
 						},
 					},
 				},
@@ -4079,6 +4097,7 @@ func schema_k8s_apis_netguard_v1beta1_HostSpec(ref common.ReferenceCallback) com
 						SchemaProps: spec.SchemaProps{
 							Description: "UUID is the unique identifier of the host",
 							Default:     "",
+							Pattern:     "^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4273,24 +4292,27 @@ func schema_k8s_apis_netguard_v1beta1_IEAgAgRuleSpec(ref common.ReferenceCallbac
 					"description": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Description of the rule",
+							MaxLength:   ptr.To[int64](512),
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"transport": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Transport protocol (TCP, UDP, etc.)",
+							Description: "Transport protocol (TCP, UDP, etc.)\n\nPossible enum values:\n - `\"TCP\"`\n - `\"UDP\"`",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"TCP", "UDP"},
 						},
 					},
 					"traffic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Traffic direction (Ingress, Egress)",
+							Description: "Traffic direction (Ingress, Egress)\n\nPossible enum values:\n - `\"EGRESS\"` represents egress traffic\n - `\"INGRESS\"` represents ingress traffic",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"EGRESS", "INGRESS"},
 						},
 					},
 					"addressGroupLocal": {
@@ -4323,9 +4345,11 @@ func schema_k8s_apis_netguard_v1beta1_IEAgAgRuleSpec(ref common.ReferenceCallbac
 					},
 					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Action for the rule (ACCEPT, DROP)",
+							Description: "Action for the rule (ACCEPT, DROP)\n\nPossible enum values:\n - `\"ACCEPT\"` accepts network packets\n - `\"DROP\"` drops network packets",
+							Default:     "ACCEPT",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"ACCEPT", "DROP"},
 						},
 					},
 					"priority": {
@@ -4417,16 +4441,18 @@ func schema_k8s_apis_netguard_v1beta1_IngressPort(ref common.ReferenceCallback)
 				Properties: map[string]spec.Schema{
 					"protocol": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Transport protocol for the rule",
+							Description: "Transport protocol for the rule\n\nPossible enum values:\n - `\"TCP\"`\n - `\"UDP\"`",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"TCP", "UDP"},
 						},
 					},
 					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Port or port range (e.g., \"80\", \"8080-9090\")",
+							Description: "Port or port range (e.g., \"80\", \"8080-9090\"), a comma separated list of them",
 							Default:     "",
+							Pattern:     "^\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?(\\s*,\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?)*\\s*$",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4434,6 +4460,7 @@ func schema_k8s_apis_netguard_v1beta1_IngressPort(ref common.ReferenceCallback)
 					"description": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Description of this port configuration",
+							MaxLength:   ptr.To[int64](512),
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5081,8 +5108,9 @@ func schema_k8s_apis_netguard_v1beta1_PortConfig(ref common.ReferenceCallback) c
 				Properties: map[string]spec.Schema{
 					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Port or port range (e.g., \"80\", \"8080-9090\")",
+							Description: "Port or port range (e.g., \"80\", \"8080-9090\"), a comma separated list of them",
 							Default:     "",
+							Pattern:     "^\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?(\\s*,\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5])(\\s*-\\s*([0-9]{1,4}|[1-5][0-9]{4}|6[0-4][0-9]{3}|65[0-4][0-9]{2}|655[0-2][0-9]|6553[0-5]))?)*\\s*$",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5112,6 +5140,8 @@ func schema_k8s_apis_netguard_v1beta1_PortRange(ref common.ReferenceCallback) co
 						SchemaProps: spec.SchemaProps{
 							Description: "From port (inclusive)",
 							Default:     0,
+							Minimum:     ptr.To[float64](1),
+							Maximum:     ptr.To[float64](65535),
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -5120,6 +5150,8 @@ func schema_k8s_apis_netguard_v1beta1_PortRange(ref common.ReferenceCallback) co
 						SchemaProps: spec.SchemaProps{
 							Description: "To port (inclusive)",
 							Default:     0,
+							Minimum:     ptr.To[float64](1),
+							Maximum:     ptr.To[float64](65535),
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -5141,6 +5173,8 @@ func schema_k8s_apis_netguard_v1beta1_PortSpec(ref common.ReferenceCallback) com
 					"port": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Port number",
+							Minimum:     ptr.To[float64](1),
+							Maximum:     ptr.To[float64](65535),
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -5405,10 +5439,11 @@ func schema_k8s_apis_netguard_v1beta1_RuleS2SSpec(ref common.ReferenceCallback)
 				Properties: map[string]spec.Schema{
 					"traffic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Traffic direction: ingress or egress",
+							Description: "Traffic direction: ingress or egress\n\nPossible enum values:\n - `\"EGRESS\"` represents egress traffic\n - `\"INGRESS\"` represents ingress traffic",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"EGRESS", "INGRESS"},
 						},
 					},
 					"serviceLocalRef": {
@@ -5433,12 +5468,61 @@ func schema_k8s_apis_netguard_v1beta1_RuleS2SSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action of the generated IEAgAg rules (ACCEPT, DROP), ACCEPT when not set\n\nPossible enum values:\n - `\"ACCEPT\"` accepts network packets\n - `\"DROP\"` drops network packets",
+							Default:     "ACCEPT",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"ACCEPT", "DROP"},
+						},
+					},
+					"portsSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PortsSource selects the service whose ports are opened (LOCAL, TARGET). By default the local service for INGRESS and the target service for EGRESS.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"extraPorts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtraPorts are opened in addition to the ports of the service",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.IngressPort"),
+									},
+								},
+							},
+						},
+					},
+					"validFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidFrom is the time the rule starts generating IEAgAg rules, immediately when not set",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"validUntil": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidUntil is the time the generated IEAgAg rules are removed, never when not set",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority of the generated IEAgAg rules, lower priorities are applied first. Calculated by the priority strategy of the backend when not set",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"traffic", "serviceLocalRef", "serviceRef"},
 			},
 		},
 		Dependencies: []string{
-			"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.NamespacedObjectReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.IngressPort", "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1.NamespacedObjectReference"},
 	}
 }
 
@@ -5821,6 +5905,7 @@ func schema_k8s_apis_netguard_v1beta1_ServiceSpec(ref common.ReferenceCallback)
 					"description": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Description of the service",
+							MaxLength:   ptr.To[int64](512),
 							Type:        []string{"string"},
 							Format:      "",
 						},
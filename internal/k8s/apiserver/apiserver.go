@@ -7,6 +7,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/kube-openapi/pkg/common"
 	netutils "k8s.io/utils/net"
 
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -14,6 +16,8 @@ import (
 	genericoptions "k8s.io/apiserver/pkg/server/options"
 	"k8s.io/klog/v2"
 
+	"netguard-pg-backend/internal/domain/models"
+	netguardv1 "netguard-pg-backend/internal/k8s/apis/netguard/v1"
 	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
 	"netguard-pg-backend/pkg/k8s/clientset/versioned/scheme"
 
@@ -22,6 +26,7 @@ import (
 	bindingstorage "netguard-pg-backend/internal/k8s/registry/addressgroupbinding"
 	policybindingstorage "netguard-pg-backend/internal/k8s/registry/addressgroupbindingpolicy"
 	portmappingstorage "netguard-pg-backend/internal/k8s/registry/addressgroupportmapping"
+	"netguard-pg-backend/internal/k8s/registry/base"
 	hoststorage "netguard-pg-backend/internal/k8s/registry/host"
 	hostbindingstorage "netguard-pg-backend/internal/k8s/registry/host_binding"
 	ieagagstorage "netguard-pg-backend/internal/k8s/registry/ieagagrule"
@@ -35,6 +40,22 @@ import (
 	"k8s.io/apiserver/pkg/util/compatibility"
 )
 
+func init() {
+	// v1 is served next to v1beta1 and converted through the internal (hub) version;
+	// v1 is the preferred version in discovery
+	netguardv1.Install(scheme.Scheme)
+	utilruntime.Must(scheme.Scheme.SetVersionPriority(netguardv1.SchemeGroupVersion, netguardv1beta1.SchemeGroupVersion))
+}
+
+// getOpenAPIDefinitions returns the OpenAPI definitions of all served versions
+func getOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+	defs := netguardv1beta1.GetOpenAPIDefinitionsWithEnums(ref)
+	for name, def := range netguardv1.GetOpenAPIDefinitionsWithEnums(ref) {
+		defs[name] = def
+	}
+	return defs
+}
+
 // ExtraConfig holds custom apiserver config
 type ExtraConfig struct {
 }
@@ -128,7 +149,9 @@ func NewNegotiatedSerializerWithoutProtobuf(serializer runtime.NegotiatedSeriali
 	return &negotiatedSerializerWithoutProtobuf{wrapped: serializer}
 }
 
-func NewServer(opts *genericoptions.RecommendedOptions) (*server.GenericAPIServer, error) {
+// NewServer builds the netguard apiserver. configure functions adjust the generic config
+// before opts are applied to it.
+func NewServer(opts *genericoptions.RecommendedOptions, configure ...func(*server.RecommendedConfig) error) (*server.GenericAPIServer, error) {
 	if err := opts.SecureServing.MaybeDefaultWithSelfSignedCerts("localhost", nil, []net.IP{netutils.ParseIPSloppy("127.0.0.1")}); err != nil {
 		return nil, fmt.Errorf("self-signed certs: %w", err)
 	}
@@ -138,18 +161,23 @@ func NewServer(opts *genericoptions.RecommendedOptions) (*server.GenericAPIServe
 
 	// Build the generic apiserver config
 	genericCfg := server.NewRecommendedConfig(standardCodecs)
+	for _, configureFn := range configure {
+		if err := configureFn(genericCfg); err != nil {
+			return nil, fmt.Errorf("configure server: %w", err)
+		}
+	}
 	if err := opts.ApplyTo(genericCfg); err != nil {
 		return nil, fmt.Errorf("apply options: %w", err)
 	}
 
 	// Real OpenAPI configs using generated definitions with enum support
-	genericCfg.OpenAPIConfig = server.DefaultOpenAPIConfig(netguardv1beta1.GetOpenAPIDefinitionsWithEnums, openapi.NewDefinitionNamer(scheme.Scheme))
+	genericCfg.OpenAPIConfig = server.DefaultOpenAPIConfig(getOpenAPIDefinitions, openapi.NewDefinitionNamer(scheme.Scheme))
 	genericCfg.OpenAPIConfig.Info.Title = "Netguard"
-	genericCfg.OpenAPIConfig.Info.Version = "v1beta1"
+	genericCfg.OpenAPIConfig.Info.Version = "v1"
 
-	genericCfg.OpenAPIV3Config = server.DefaultOpenAPIV3Config(netguardv1beta1.GetOpenAPIDefinitionsWithEnums, openapi.NewDefinitionNamer(scheme.Scheme))
+	genericCfg.OpenAPIV3Config = server.DefaultOpenAPIV3Config(getOpenAPIDefinitions, openapi.NewDefinitionNamer(scheme.Scheme))
 	genericCfg.OpenAPIV3Config.Info.Title = "Netguard"
-	genericCfg.OpenAPIV3Config.Info.Version = "v1beta1"
+	genericCfg.OpenAPIV3Config.Info.Version = "v1"
 
 	// Explicit external address to avoid nil listener issues during .Complete().
 	genericCfg.ExternalAddress = "localhost:8443"
@@ -180,8 +208,16 @@ func NewServer(opts *genericoptions.RecommendedOptions) (*server.GenericAPIServe
 		return nil, fmt.Errorf("init backend client: %w", err)
 	}
 
+	// Condition transitions recorded by the backend become Events of the resources
+	if cfg.ConditionEventsEnabled {
+		if err := installConditionEvents(gs, genericCfg.ClientConfig, bClient, cfg.ConditionEventsInterval); err != nil {
+			return nil, fmt.Errorf("install condition events: %w", err)
+		}
+	}
+
 	// ------------------------------------------------------------------
-	// Register API group "netguard.sgroups.io/v1beta1" with real storage.
+	// Register API group "netguard.sgroups.io" with real storage, v1beta1 and v1
+	// share the storage: objects are handled in the hub version and converted per request.
 	// ------------------------------------------------------------------
 
 	// Use standard codecs for APIGroupInfo
@@ -198,6 +234,11 @@ func NewServer(opts *genericoptions.RecommendedOptions) (*server.GenericAPIServe
 	rules2sStore := rules2sstorage.NewRuleS2SStorage(bClient)
 	ieagagStore := ieagagstorage.NewIEAgAgRuleStorage(bClient)
 
+	// Watch этих ресурсов обслуживается потоком изменений backend'а (Watch RPC)
+	agStore.WatchFromBackend(bClient, models.ChangeKindAddressGroup)
+	svcStore.WatchFromBackend(bClient, models.ChangeKindService)
+	rules2sStore.WatchFromBackend(bClient, models.ChangeKindRuleS2S)
+
 	// Use BaseStorage approach for Network resources (supports generateName)
 	networkStore := networkstorage.NewNetworkStorageWithClient(bClient)
 	networkBindingStore := networkbindingstorage.NewNetworkBindingStorageWithClient(bClient)
@@ -206,7 +247,17 @@ func NewServer(opts *genericoptions.RecommendedOptions) (*server.GenericAPIServe
 	hostStore := hoststorage.NewHostStorage(bClient)
 	hostBindingStore := hostbindingstorage.NewHostBindingStorage(bClient)
 
-	apiGroupInfo.VersionedResourcesStorageMap[netguardv1beta1.SchemeGroupVersion.Version] = map[string]rest.Storage{
+	// Spec объектов проверяется по OpenAPI схеме (шаблоны портов, CIDR, enum) до валидаторов и admission webhook'ов
+	openAPIDefs := netguardv1beta1.GetOpenAPIDefinitionsWithEnums(base.OpenAPIReference)
+	for _, store := range []interface {
+		ValidateWithOpenAPI(map[string]common.OpenAPIDefinition) error
+	}{agStore, svcStore, aliasStore, policyStore, bindingStore, pmStore, rules2sStore, ieagagStore, networkStore, networkBindingStore, hostStore, hostBindingStore} {
+		if err := store.ValidateWithOpenAPI(openAPIDefs); err != nil {
+			return nil, fmt.Errorf("OpenAPI validation: %w", err)
+		}
+	}
+
+	storage := map[string]rest.Storage{
 		// Основные ресурсы
 		"addressgroups":               agStore,
 		"services":                    svcStore,
@@ -229,12 +280,18 @@ func NewServer(opts *genericoptions.RecommendedOptions) (*server.GenericAPIServe
 		"addressgroupportmappings/status":    portmappingstorage.NewStatusREST(pmStore),
 		"rules2s/status":                     rules2sstorage.NewStatusREST(rules2sStore),
 		"ieagagrules/status":                 ieagagstorage.NewStatusREST(ieagagStore),
+		"networks/status":                    networkstorage.NewStatusREST(networkStore),
+		"networkbindings/status":             networkbindingstorage.NewStatusREST(networkBindingStore),
+		"hosts/status":                       hoststorage.NewStatusREST(hostStore),
+		"hostbindings/status":                hostbindingstorage.NewStatusREST(hostBindingStore),
 
 		"services/addressgroups":               svcstorage.NewAddressGroupsREST(bClient),
 		"services/rules2sdstownref":            svcstorage.NewRuleS2SDstOwnRefREST(bClient),
 		"addressgroupportmappings/accessports": portmappingstorage.NewAccessPortsREST(bClient),
 		"addressgroups/networks":               agstorage.NewNetworksREST(bClient),
 	}
+	apiGroupInfo.VersionedResourcesStorageMap[netguardv1beta1.SchemeGroupVersion.Version] = storage
+	apiGroupInfo.VersionedResourcesStorageMap[netguardv1.SchemeGroupVersion.Version] = storage
 
 	if err := gs.InstallAPIGroup(&apiGroupInfo); err != nil {
 		return nil, fmt.Errorf("install API group: %w", err)
@@ -0,0 +1,140 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	server "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+	backendclient "netguard-pg-backend/internal/k8s/client"
+	"netguard-pg-backend/pkg/k8s/clientset/versioned/scheme"
+)
+
+// conditionEventsBatch limits the transitions read from the backend per request
+const conditionEventsBatch = 500
+
+// ConditionEventEmitter records condition transitions stored by the backend as Kubernetes Events
+// of the netguard resources, so they are shown by kubectl describe and kubectl get events
+type ConditionEventEmitter struct {
+	backend  backendclient.BackendClient
+	recorder record.EventRecorder
+	interval time.Duration
+
+	// lastID is the last emitted transition, -1 until the position is taken from the backend
+	lastID int64
+}
+
+// NewConditionEventEmitter creates an emitter polling the backend every interval
+func NewConditionEventEmitter(backend backendclient.BackendClient, recorder record.EventRecorder, interval time.Duration) *ConditionEventEmitter {
+	return &ConditionEventEmitter{
+		backend:  backend,
+		recorder: recorder,
+		interval: interval,
+		lastID:   -1,
+	}
+}
+
+// Run emits events of transitions recorded after the start until ctx is done
+func (e *ConditionEventEmitter) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.emit(ctx); err != nil {
+			klog.Warningf("ConditionEvents: failed to read condition transitions, retrying in %s: %v", e.interval, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// emit records events of transitions recorded since the last call
+func (e *ConditionEventEmitter) emit(ctx context.Context) error {
+	if e.lastID < 0 {
+		// History recorded before the start is not replayed
+		_, lastID, err := e.backend.ListConditionTransitions(ctx, 0, 1)
+		if err != nil {
+			return err
+		}
+		e.lastID = lastID
+		return nil
+	}
+
+	for {
+		transitions, _, err := e.backend.ListConditionTransitions(ctx, e.lastID, conditionEventsBatch)
+		if err != nil {
+			return err
+		}
+		for _, transition := range transitions {
+			e.record(transition)
+			e.lastID = transition.ID
+		}
+		if len(transitions) < conditionEventsBatch {
+			return nil
+		}
+	}
+}
+
+func (e *ConditionEventEmitter) record(transition models.ConditionTransition) {
+	object := &corev1.ObjectReference{
+		APIVersion: netguardv1beta1.SchemeGroupVersion.String(),
+		Kind:       transition.Kind,
+		Namespace:  transition.Namespace,
+		Name:       transition.Name,
+	}
+	eventType := corev1.EventTypeNormal
+	if transition.Degraded() {
+		eventType = corev1.EventTypeWarning
+	}
+	reason := transition.Reason
+	if reason == "" {
+		reason = transition.Type + string(transition.To)
+	}
+
+	from := string(transition.From)
+	if from == "" {
+		from = "<none>"
+	}
+	message := fmt.Sprintf("%s: %s -> %s", transition.Type, from, transition.To)
+	if transition.Message != "" {
+		message += ": " + transition.Message
+	}
+	e.recorder.Event(object, eventType, reason, message)
+}
+
+// installConditionEvents starts emitting condition events with the server. Without a connection
+// to the core Kubernetes API events are not emitted.
+func installConditionEvents(gs *server.GenericAPIServer, clientConfig *restclient.Config, backend backendclient.BackendClient, interval time.Duration) error {
+	if clientConfig == nil {
+		klog.Warningf("ConditionEvents: no Kubernetes client configuration, condition events are not emitted")
+		return nil
+	}
+	kubeClient, err := kubernetes.NewForConfig(clientConfig)
+	if err != nil {
+		return fmt.Errorf("create kubernetes client: %w", err)
+	}
+
+	return gs.AddPostStartHook("netguard-condition-events", func(hookContext server.PostStartHookContext) error {
+		broadcaster := record.NewBroadcaster(record.WithContext(hookContext))
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+		recorder := broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "netguard-apiserver"})
+
+		go func() {
+			defer broadcaster.Shutdown()
+			NewConditionEventEmitter(backend, recorder, interval).Run(hookContext)
+		}()
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+package apiserver
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"netguard-pg-backend/internal/domain/models"
+	backendclient "netguard-pg-backend/internal/k8s/client"
+)
+
+// historyBackend serves condition transitions from memory
+type historyBackend struct {
+	*backendclient.MockBackendClient
+	transitions []models.ConditionTransition
+}
+
+func (b *historyBackend) ListConditionTransitions(_ context.Context, afterID int64, limit int) ([]models.ConditionTransition, int64, error) {
+	var result []models.ConditionTransition
+	var lastID int64
+	for _, transition := range b.transitions {
+		lastID = transition.ID
+		if transition.ID > afterID && (limit == 0 || len(result) < limit) {
+			result = append(result, transition)
+		}
+	}
+	return result, lastID, nil
+}
+
+func TestConditionEventEmitter_EmitsNewTransitions(t *testing.T) {
+	ctx := context.Background()
+	id := models.NewResourceIdentifier("web-to-db", models.WithNamespace("app"))
+	backend := &historyBackend{
+		MockBackendClient: backendclient.NewMockBackendClient(),
+		transitions: []models.ConditionTransition{
+			{ID: 1, Kind: "RuleS2S", ResourceIdentifier: id, Type: models.ConditionReady, To: metav1.ConditionTrue, Reason: models.ReasonReady},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	emitter := NewConditionEventEmitter(backend, recorder, 0)
+
+	// Transitions recorded before the start are not emitted
+	if err := emitter.emit(ctx); err != nil {
+		t.Fatalf("emit failed: %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Fatalf("Expected no events for the old history, got %d", len(recorder.Events))
+	}
+
+	backend.transitions = append(backend.transitions, models.ConditionTransition{
+		ID: 2, Kind: "RuleS2S", ResourceIdentifier: id, Type: models.ConditionReady,
+		From: metav1.ConditionTrue, To: metav1.ConditionFalse, Reason: models.ReasonNotReady, Message: "service app/db not found",
+	})
+	if err := emitter.emit(ctx); err != nil {
+		t.Fatalf("emit failed: %v", err)
+	}
+	if len(recorder.Events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(recorder.Events))
+	}
+	if event, expected := <-recorder.Events, "Warning NotReady Ready: True -> False: service app/db not found"; event != expected {
+		t.Errorf("Expected event %q, got %q", expected, event)
+	}
+
+	// Emitted transitions are not repeated
+	if err := emitter.emit(ctx); err != nil {
+		t.Fatalf("emit failed: %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("Expected no repeated events, got %d", len(recorder.Events))
+	}
+}
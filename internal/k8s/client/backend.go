@@ -118,6 +118,12 @@ type BackendClient interface {
 	ListRuleS2SDstOwnRef(ctx context.Context, serviceID models.ResourceIdentifier) ([]models.RuleS2S, error)
 	ListAccessPorts(ctx context.Context, mappingID models.ResourceIdentifier) ([]models.ServicePortsRef, error)
 
+	// История conditions: переходы с ID больше afterID (не больше limit) и ID последнего перехода
+	ListConditionTransitions(ctx context.Context, afterID int64, limit int) ([]models.ConditionTransition, int64, error)
+
+	// Поток изменений ресурсов kinds после sinceRevision (Watch RPC)
+	WatchChanges(ctx context.Context, kinds []string, sinceRevision uint64) (ChangeStream, error)
+
 	// Graceful shutdown
 	Close() error
 }
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+
+	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
+)
+
+// ErrWatchNotSupported возвращается, если backend не поддерживает Watch RPC
+var ErrWatchNotSupported = errors.New("backend doesn't support watch")
+
+// ChangeStream поток изменений ресурсов из Watch RPC backend'а
+type ChangeStream interface {
+	// Recv возвращает следующее событие. Событие без Resource - bookmark:
+	// все изменения до его ревизии доставлены.
+	// ports.ErrRevisionCompacted - ревизия, с которой запрошен watch, уже компактирована;
+	// ErrWatchNotSupported - backend не реализует Watch RPC
+	Recv() (models.ChangeEvent, error)
+}
+
+// WatchChanges открывает поток изменений ресурсов kinds после sinceRevision.
+// Поток закрывается отменой ctx.
+func (c *GRPCBackendClient) WatchChanges(ctx context.Context, kinds []string, sinceRevision uint64) (ChangeStream, error) {
+	stream, err := c.client.Watch(ctx, &netguardpb.WatchReq{
+		Kinds:         kinds,
+		SinceRevision: sinceRevision,
+	})
+	if err != nil {
+		return nil, watchError(err)
+	}
+	return &grpcChangeStream{stream: stream}, nil
+}
+
+type grpcChangeStream struct {
+	stream netguardpb.NetguardService_WatchClient
+}
+
+func (s *grpcChangeStream) Recv() (models.ChangeEvent, error) {
+	event, err := s.stream.Recv()
+	if err != nil {
+		return models.ChangeEvent{}, watchError(err)
+	}
+
+	change := models.ChangeEvent{
+		Revision: event.GetRevision(),
+		SyncOp:   models.ProtoToSyncOp(int32(event.GetSyncOp())),
+	}
+	switch resource := event.GetResource().(type) {
+	case *netguardpb.WatchEvent_Service:
+		change.Resource = convertServiceFromProto(resource.Service)
+	case *netguardpb.WatchEvent_AddressGroup:
+		change.Resource = convertAddressGroupFromProto(resource.AddressGroup)
+	case *netguardpb.WatchEvent_RuleS2S:
+		change.Resource = convertRuleS2SFromProto(resource.RuleS2S)
+	}
+	return change, nil
+}
+
+// watchError переводит gRPC статусы Watch RPC в ошибки клиента
+func watchError(err error) error {
+	switch status.Code(err) {
+	case codes.OutOfRange:
+		return fmt.Errorf("%w: %v", ports.ErrRevisionCompacted, err)
+	case codes.Unimplemented:
+		return ErrWatchNotSupported
+	default:
+		return err
+	}
+}
@@ -15,6 +15,17 @@ type BackendClientConfig struct {
 	ConnectTimeout time.Duration `yaml:"connect_timeout" env:"BACKEND_CONNECT_TIMEOUT" env-default:"10s" env-description:"Connection timeout"`
 	RequestTimeout time.Duration `yaml:"request_timeout" env:"BACKEND_REQUEST_TIMEOUT" env-default:"30s" env-description:"Request timeout"`
 
+	// Пул соединений и keepalive
+	PoolSize            int           `yaml:"pool_size" env:"BACKEND_POOL_SIZE" env-default:"4" env-description:"Number of gRPC connections to backend"`
+	KeepaliveTime       time.Duration `yaml:"keepalive_time" env:"BACKEND_KEEPALIVE_TIME" env-default:"5m" env-description:"Interval of keepalive pings on idle connections"`
+	KeepaliveTimeout    time.Duration `yaml:"keepalive_timeout" env:"BACKEND_KEEPALIVE_TIMEOUT" env-default:"3s" env-description:"Timeout of a keepalive ping acknowledgement"`
+	ReconnectMaxBackoff time.Duration `yaml:"reconnect_max_backoff" env:"BACKEND_RECONNECT_MAX_BACKOFF" env-default:"5s" env-description:"Maximum delay between reconnects to backend"`
+
+	// Повторы запросов
+	AttemptTimeout      time.Duration `yaml:"attempt_timeout" env:"BACKEND_ATTEMPT_TIMEOUT" env-default:"10s" env-description:"Timeout of a single attempt of a request"`
+	RetryInitialBackoff time.Duration `yaml:"retry_initial_backoff" env:"BACKEND_RETRY_INITIAL_BACKOFF" env-default:"100ms" env-description:"Delay before the first retry of a request"`
+	RetryMaxBackoff     time.Duration `yaml:"retry_max_backoff" env:"BACKEND_RETRY_MAX_BACKOFF" env-default:"2s" env-description:"Maximum delay between retries of a request"`
+
 	// Rate Limiting
 	RateLimit float64 `yaml:"rate_limit" env:"BACKEND_RATE_LIMIT" env-default:"100.0" env-description:"Rate limit (requests per second)"`
 	RateBurst int     `yaml:"rate_burst" env:"BACKEND_RATE_BURST" env-default:"200" env-description:"Rate burst size"`
@@ -28,6 +39,14 @@ type BackendClientConfig struct {
 	// Cache
 	CacheDefaultTTL      time.Duration `yaml:"cache_default_ttl" env:"BACKEND_CACHE_DEFAULT_TTL" env-default:"5m" env-description:"Cache default TTL"`
 	CacheCleanupInterval time.Duration `yaml:"cache_cleanup_interval" env:"BACKEND_CACHE_CLEANUP_INTERVAL" env-default:"10m" env-description:"Cache cleanup interval"`
+
+	// Watch Cache
+	WatchCacheEnabled       bool          `yaml:"watch_cache_enabled" env:"BACKEND_WATCH_CACHE_ENABLED" env-default:"true" env-description:"Serve Service/AddressGroup lookups from a watch-driven cache"`
+	WatchCacheRetryInterval time.Duration `yaml:"watch_cache_retry_interval" env:"BACKEND_WATCH_CACHE_RETRY_INTERVAL" env-default:"5s" env-description:"Delay before re-establishing a broken watch"`
+
+	// Condition Events
+	ConditionEventsEnabled  bool          `yaml:"condition_events_enabled" env:"BACKEND_CONDITION_EVENTS_ENABLED" env-default:"true" env-description:"Emit Kubernetes Events for condition transitions recorded by backend"`
+	ConditionEventsInterval time.Duration `yaml:"condition_events_interval" env:"BACKEND_CONDITION_EVENTS_INTERVAL" env-default:"5s" env-description:"Interval of polling backend for condition transitions"`
 }
 
 // LoadBackendClientConfig загружает конфигурацию с помощью cleanenv
@@ -76,6 +95,34 @@ func (c *BackendClientConfig) Validate() error {
 		return fmt.Errorf("request_timeout must be positive")
 	}
 
+	if c.PoolSize <= 0 {
+		return fmt.Errorf("pool_size must be positive")
+	}
+
+	if c.KeepaliveTime <= 0 {
+		return fmt.Errorf("keepalive_time must be positive")
+	}
+
+	if c.KeepaliveTimeout <= 0 {
+		return fmt.Errorf("keepalive_timeout must be positive")
+	}
+
+	if c.ReconnectMaxBackoff <= 0 {
+		return fmt.Errorf("reconnect_max_backoff must be positive")
+	}
+
+	if c.AttemptTimeout <= 0 {
+		return fmt.Errorf("attempt_timeout must be positive")
+	}
+
+	if c.RetryInitialBackoff <= 0 {
+		return fmt.Errorf("retry_initial_backoff must be positive")
+	}
+
+	if c.RetryMaxBackoff < c.RetryInitialBackoff {
+		return fmt.Errorf("retry_max_backoff cannot be less than retry_initial_backoff")
+	}
+
 	if c.RateLimit <= 0 {
 		return fmt.Errorf("rate_limit must be positive")
 	}
@@ -108,5 +155,13 @@ func (c *BackendClientConfig) Validate() error {
 		return fmt.Errorf("cache_cleanup_interval must be positive")
 	}
 
+	if c.WatchCacheEnabled && c.WatchCacheRetryInterval <= 0 {
+		return fmt.Errorf("watch_cache_retry_interval must be positive")
+	}
+
+	if c.ConditionEventsEnabled && c.ConditionEventsInterval <= 0 {
+		return fmt.Errorf("condition_events_interval must be positive")
+	}
+
 	return nil
 }
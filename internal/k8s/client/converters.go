@@ -7,6 +7,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 )
 
 // ManagedFields conversion helpers
@@ -76,6 +77,68 @@ func convertManagedFieldsToProto(managedFields []metav1.ManagedFieldsEntry) []*n
 	return protoFields
 }
 
+// deletionTimestampToProto converts the deletion intent, nil when deletion wasn't requested
+func deletionTimestampToProto(t *metav1.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+// deletionTimestampFromProto converts the deletion intent, nil when deletion wasn't requested
+func deletionTimestampFromProto(ts *timestamppb.Timestamp) *metav1.Time {
+	if ts == nil {
+		return nil
+	}
+	t := metav1.NewTime(ts.AsTime())
+	return &t
+}
+
+// ownerReferencesToProto converts owner references, nil when the resource has no owners
+func ownerReferencesToProto(references []metav1.OwnerReference) []*netguardpb.OwnerReference {
+	if len(references) == 0 {
+		return nil
+	}
+	result := make([]*netguardpb.OwnerReference, 0, len(references))
+	for _, reference := range references {
+		result = append(result, &netguardpb.OwnerReference{
+			ApiVersion:         reference.APIVersion,
+			Kind:               reference.Kind,
+			Name:               reference.Name,
+			Uid:                string(reference.UID),
+			Controller:         reference.Controller != nil && *reference.Controller,
+			BlockOwnerDeletion: reference.BlockOwnerDeletion != nil && *reference.BlockOwnerDeletion,
+		})
+	}
+	return result
+}
+
+// ownerReferencesFromProto converts owner references, nil when the resource has no owners
+func ownerReferencesFromProto(references []*netguardpb.OwnerReference) []metav1.OwnerReference {
+	if len(references) == 0 {
+		return nil
+	}
+	result := make([]metav1.OwnerReference, 0, len(references))
+	for _, reference := range references {
+		owner := metav1.OwnerReference{
+			APIVersion: reference.GetApiVersion(),
+			Kind:       reference.GetKind(),
+			Name:       reference.GetName(),
+			UID:        k8stypes.UID(reference.GetUid()),
+		}
+		if reference.GetController() {
+			controller := true
+			owner.Controller = &controller
+		}
+		if reference.GetBlockOwnerDeletion() {
+			block := true
+			owner.BlockOwnerDeletion = &block
+		}
+		result = append(result, owner)
+	}
+	return result
+}
+
 // Service конверторы
 func convertServiceFromProto(protoSvc *netguardpb.Service) models.Service {
 	service := models.Service{
@@ -93,6 +156,9 @@ func convertServiceFromProto(protoSvc *netguardpb.Service) models.Service {
 		service.Meta = models.Meta{
 			UID:                protoSvc.Meta.Uid,
 			ResourceVersion:    protoSvc.Meta.ResourceVersion,
+			Finalizers:         protoSvc.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(protoSvc.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(protoSvc.Meta.OwnerReferences),
 			Generation:         protoSvc.Meta.Generation,
 			Labels:             protoSvc.Meta.Labels,
 			Annotations:        protoSvc.Meta.Annotations,
@@ -107,23 +173,7 @@ func convertServiceFromProto(protoSvc *netguardpb.Service) models.Service {
 	}
 
 	// Конвертация IngressPorts
-	for _, port := range protoSvc.IngressPorts {
-		var protocol models.TransportProtocol
-		switch port.Protocol {
-		case netguardpb.Networks_NetIP_TCP:
-			protocol = models.TCP
-		case netguardpb.Networks_NetIP_UDP:
-			protocol = models.UDP
-		default:
-			protocol = models.TCP // default
-		}
-
-		service.IngressPorts = append(service.IngressPorts, models.IngressPort{
-			Protocol:    protocol,
-			Port:        port.Port,
-			Description: port.Description,
-		})
-	}
+	service.IngressPorts = convertIngressPortsFromProto(protoSvc.IngressPorts)
 
 	// Конвертация AddressGroups
 	for _, agRef := range protoSvc.AddressGroups {
@@ -164,6 +214,9 @@ func convertServiceToProto(service models.Service) *netguardpb.Service {
 		Meta: &netguardpb.Meta{
 			Uid:             service.Meta.UID,
 			ResourceVersion: service.Meta.ResourceVersion,
+			Finalizers:      service.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(service.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(service.Meta.OwnerReferences),
 			Generation:      service.Meta.Generation,
 			Labels:          service.Meta.Labels,
 			Annotations:     service.Meta.Annotations,
@@ -177,23 +230,7 @@ func convertServiceToProto(service models.Service) *netguardpb.Service {
 	}
 
 	// Конвертация IngressPorts
-	for _, port := range service.IngressPorts {
-		var protocol netguardpb.Networks_NetIP_Transport
-		switch port.Protocol {
-		case models.TCP:
-			protocol = netguardpb.Networks_NetIP_TCP
-		case models.UDP:
-			protocol = netguardpb.Networks_NetIP_UDP
-		default:
-			protocol = netguardpb.Networks_NetIP_TCP // default
-		}
-
-		protoSvc.IngressPorts = append(protoSvc.IngressPorts, &netguardpb.IngressPort{
-			Protocol:    protocol,
-			Port:        port.Port,
-			Description: port.Description,
-		})
-	}
+	protoSvc.IngressPorts = convertIngressPortsToProto(service.IngressPorts)
 
 	// Конвертация AddressGroups
 	for _, agRef := range service.AddressGroups {
@@ -279,6 +316,9 @@ func convertAddressGroupFromProto(protoAG *netguardpb.AddressGroup) models.Addre
 		addressGroup.Meta = models.Meta{
 			UID:                protoAG.Meta.Uid,
 			ResourceVersion:    protoAG.Meta.ResourceVersion,
+			Finalizers:         protoAG.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(protoAG.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(protoAG.Meta.OwnerReferences),
 			Generation:         protoAG.Meta.Generation,
 			Labels:             protoAG.Meta.Labels,
 			Annotations:        protoAG.Meta.Annotations,
@@ -331,6 +371,11 @@ func convertAddressGroupFromProto(protoAG *netguardpb.AddressGroup) models.Addre
 		}
 	}
 
+	// Вложенные группы адресов
+	for _, ref := range protoAG.IncludedGroups {
+		addressGroup.IncludedGroups = append(addressGroup.IncludedGroups, convertAddressGroupRefFromProto(ref))
+	}
+
 	return addressGroup
 }
 
@@ -369,6 +414,9 @@ func convertAddressGroupToProto(addressGroup models.AddressGroup) *netguardpb.Ad
 		Meta: &netguardpb.Meta{
 			Uid:             addressGroup.Meta.UID,
 			ResourceVersion: addressGroup.Meta.ResourceVersion,
+			Finalizers:      addressGroup.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(addressGroup.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(addressGroup.Meta.OwnerReferences),
 			Generation:      addressGroup.Meta.Generation,
 			Labels:          addressGroup.Meta.Labels,
 			Annotations:     addressGroup.Meta.Annotations,
@@ -393,6 +441,16 @@ func convertAddressGroupToProto(addressGroup models.AddressGroup) *netguardpb.Ad
 		}
 	}
 
+	// Вложенные группы адресов
+	for _, ref := range addressGroup.IncludedGroups {
+		protoAG.IncludedGroups = append(protoAG.IncludedGroups, &netguardpb.AddressGroupRef{
+			Identifier: &netguardpb.ResourceIdentifier{
+				Name:      ref.Name,
+				Namespace: ref.Namespace,
+			},
+		})
+	}
+
 	return protoAG
 }
 
@@ -428,6 +486,9 @@ func convertAddressGroupBindingFromProto(protoBinding *netguardpb.AddressGroupBi
 		binding.Meta = models.Meta{
 			UID:                protoBinding.Meta.Uid,
 			ResourceVersion:    protoBinding.Meta.ResourceVersion,
+			Finalizers:         protoBinding.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(protoBinding.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(protoBinding.Meta.OwnerReferences),
 			Generation:         protoBinding.Meta.Generation,
 			Labels:             protoBinding.Meta.Labels,
 			Annotations:        protoBinding.Meta.Annotations,
@@ -480,6 +541,9 @@ func convertAddressGroupBindingToProto(binding models.AddressGroupBinding) *netg
 		protoBinding.Meta = &netguardpb.Meta{
 			Uid:             binding.Meta.UID,
 			ResourceVersion: binding.Meta.ResourceVersion,
+			Finalizers:      binding.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(binding.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(binding.Meta.OwnerReferences),
 			Generation:      binding.Meta.Generation,
 			Labels:          binding.Meta.Labels,
 			Annotations:     binding.Meta.Annotations,
@@ -535,6 +599,9 @@ func convertAddressGroupPortMappingFromProto(proto *netguardpb.AddressGroupPortM
 		mapping.Meta = models.Meta{
 			UID:                proto.Meta.Uid,
 			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
 			Generation:         proto.Meta.Generation,
 			Labels:             proto.Meta.Labels,
 			Annotations:        proto.Meta.Annotations,
@@ -591,6 +658,9 @@ func convertAddressGroupPortMappingToProto(m models.AddressGroupPortMapping) *ne
 		proto.Meta = &netguardpb.Meta{
 			Uid:             m.Meta.UID,
 			ResourceVersion: m.Meta.ResourceVersion,
+			Finalizers:      m.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(m.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(m.Meta.OwnerReferences),
 			Generation:      m.Meta.Generation,
 			Labels:          m.Meta.Labels,
 			Annotations:     m.Meta.Annotations,
@@ -640,7 +710,24 @@ func convertRuleS2SFromProto(proto *netguardpb.RuleS2S) models.RuleS2S {
 			},
 			Namespace: proto.ServiceRef.Identifier.Namespace,
 		},
-		Trace: proto.Trace,
+		Trace:    proto.Trace,
+		Priority: proto.Priority,
+	}
+	if proto.Action != netguardpb.RuleAction_UNDEFINED {
+		rule.Action = models.RuleAction(proto.Action.String())
+	}
+	switch proto.PortsSource {
+	case netguardpb.RuleS2SPortsSource_PORTS_SOURCE_LOCAL:
+		rule.PortsSource = models.PortsSourceLocal
+	case netguardpb.RuleS2SPortsSource_PORTS_SOURCE_TARGET:
+		rule.PortsSource = models.PortsSourceTarget
+	}
+	rule.ExtraPorts = convertIngressPortsFromProto(proto.ExtraPorts)
+	if proto.ValidFrom != nil {
+		rule.ValidFrom = proto.ValidFrom.AsTime()
+	}
+	if proto.ValidUntil != nil {
+		rule.ValidUntil = proto.ValidUntil.AsTime()
 	}
 
 	// Convert IEAgAgRuleRefs
@@ -663,6 +750,9 @@ func convertRuleS2SFromProto(proto *netguardpb.RuleS2S) models.RuleS2S {
 		rule.Meta = models.Meta{
 			UID:                proto.Meta.Uid,
 			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
 			Generation:         proto.Meta.Generation,
 			Labels:             proto.Meta.Labels,
 			Annotations:        proto.Meta.Annotations,
@@ -721,7 +811,22 @@ func convertRuleS2SToProto(m models.RuleS2S) *netguardpb.RuleS2S {
 				Namespace:  m.ServiceRef.Namespace,
 			},
 		},
-		Trace: m.Trace, // Copy trace field to proto
+		Trace:    m.Trace, // Copy trace field to proto
+		Action:   netguardpb.RuleAction(netguardpb.RuleAction_value[string(m.EffectiveAction())]),
+		Priority: m.Priority,
+	}
+	switch m.PortsSource {
+	case models.PortsSourceLocal:
+		proto.PortsSource = netguardpb.RuleS2SPortsSource_PORTS_SOURCE_LOCAL
+	case models.PortsSourceTarget:
+		proto.PortsSource = netguardpb.RuleS2SPortsSource_PORTS_SOURCE_TARGET
+	}
+	proto.ExtraPorts = convertIngressPortsToProto(m.ExtraPorts)
+	if !m.ValidFrom.IsZero() {
+		proto.ValidFrom = timestamppb.New(m.ValidFrom)
+	}
+	if !m.ValidUntil.IsZero() {
+		proto.ValidUntil = timestamppb.New(m.ValidUntil)
 	}
 
 	// Convert IEAgAgRuleRefs
@@ -739,6 +844,9 @@ func convertRuleS2SToProto(m models.RuleS2S) *netguardpb.RuleS2S {
 		proto.Meta = &netguardpb.Meta{
 			Uid:             m.Meta.UID,
 			ResourceVersion: m.Meta.ResourceVersion,
+			Finalizers:      m.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(m.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(m.Meta.OwnerReferences),
 			Generation:      m.Meta.Generation,
 			Labels:          m.Meta.Labels,
 			Annotations:     m.Meta.Annotations,
@@ -775,6 +883,9 @@ func convertServiceAliasFromProto(proto *netguardpb.ServiceAlias) models.Service
 		alias.Meta = models.Meta{
 			UID:                proto.Meta.Uid,
 			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
 			Generation:         proto.Meta.Generation,
 			Labels:             proto.Meta.Labels,
 			Annotations:        proto.Meta.Annotations,
@@ -812,6 +923,9 @@ func convertServiceAliasToProto(m models.ServiceAlias) *netguardpb.ServiceAlias
 		Meta: &netguardpb.Meta{
 			Uid:             m.Meta.UID,
 			ResourceVersion: m.Meta.ResourceVersion,
+			Finalizers:      m.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(m.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(m.Meta.OwnerReferences),
 			Generation:      m.Meta.Generation,
 			Labels:          m.Meta.Labels,
 			Annotations:     m.Meta.Annotations,
@@ -856,6 +970,9 @@ func convertAddressGroupBindingPolicyFromProto(proto *netguardpb.AddressGroupBin
 		policy.Meta = models.Meta{
 			UID:                proto.Meta.Uid,
 			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
 			Generation:         proto.Meta.Generation,
 			Labels:             proto.Meta.Labels,
 			Annotations:        proto.Meta.Annotations,
@@ -905,6 +1022,9 @@ func convertAddressGroupBindingPolicyToProto(m models.AddressGroupBindingPolicy)
 		Meta: &netguardpb.Meta{
 			Uid:             m.Meta.UID,
 			ResourceVersion: m.Meta.ResourceVersion,
+			Finalizers:      m.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(m.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(m.Meta.OwnerReferences),
 			Generation:      m.Meta.Generation,
 			Labels:          m.Meta.Labels,
 			Annotations:     m.Meta.Annotations,
@@ -928,6 +1048,10 @@ func ConvertIEAgAgRuleFromProto(proto *netguardpb.IEAgAgRule) models.IEAgAgRule
 		transport = models.TCP
 	case netguardpb.Networks_NetIP_UDP:
 		transport = models.UDP
+	case netguardpb.Networks_NetIP_SCTP:
+		transport = models.SCTP
+	case netguardpb.Networks_NetIP_ICMP:
+		transport = models.ICMP
 	default:
 		transport = models.TCP // default
 	}
@@ -1001,6 +1125,9 @@ func ConvertIEAgAgRuleFromProto(proto *netguardpb.IEAgAgRule) models.IEAgAgRule
 		rule.Meta = models.Meta{
 			UID:                proto.Meta.Uid,
 			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
 			Generation:         proto.Meta.Generation,
 			Labels:             proto.Meta.Labels,
 			Annotations:        proto.Meta.Annotations,
@@ -1025,6 +1152,10 @@ func convertIEAgAgRuleToProto(m models.IEAgAgRule) *netguardpb.IEAgAgRule {
 		transport = netguardpb.Networks_NetIP_TCP
 	case models.UDP:
 		transport = netguardpb.Networks_NetIP_UDP
+	case models.SCTP:
+		transport = netguardpb.Networks_NetIP_SCTP
+	case models.ICMP:
+		transport = netguardpb.Networks_NetIP_ICMP
 	default:
 		transport = netguardpb.Networks_NetIP_TCP // default
 	}
@@ -1089,6 +1220,9 @@ func convertIEAgAgRuleToProto(m models.IEAgAgRule) *netguardpb.IEAgAgRule {
 		proto.Meta = &netguardpb.Meta{
 			Uid:             m.Meta.UID,
 			ResourceVersion: m.Meta.ResourceVersion,
+			Finalizers:      m.Meta.Finalizers,
+			DeletionTs:      deletionTimestampToProto(m.Meta.DeletionTimestamp),
+			OwnerReferences: ownerReferencesToProto(m.Meta.OwnerReferences),
 			Generation:      m.Meta.Generation,
 			Labels:          m.Meta.Labels,
 			Annotations:     m.Meta.Annotations,
@@ -1100,3 +1234,233 @@ func convertIEAgAgRuleToProto(m models.IEAgAgRule) *netguardpb.IEAgAgRule {
 
 	return proto
 }
+
+// ConvertRuleS2SExceptionFromProto converts protobuf RuleS2SException to domain model
+func ConvertRuleS2SExceptionFromProto(proto *netguardpb.RuleS2SException) models.RuleS2SException {
+	// Конвертация Traffic protobuf enum в string
+	traffic := models.INGRESS
+	if proto.Traffic == netguardpb.Traffic_Egress {
+		traffic = models.EGRESS
+	}
+
+	exception := models.RuleS2SException{
+		SelfRef: models.SelfRef{
+			ResourceIdentifier: models.NewResourceIdentifier(
+				proto.GetSelfRef().GetName(),
+				models.WithNamespace(proto.GetSelfRef().GetNamespace()),
+			),
+		},
+		Traffic:           traffic,
+		AddressGroupLocal: convertAddressGroupRefFromProto(proto.AddressGroupLocal),
+		AddressGroup:      convertAddressGroupRefFromProto(proto.AddressGroup),
+		Transport:         models.TransportProtocol(proto.Transport.String()),
+		Ports:             proto.Ports,
+	}
+
+	// meta
+	if proto.Meta != nil {
+		exception.Meta = models.Meta{
+			UID:                proto.Meta.Uid,
+			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
+			Generation:         proto.Meta.Generation,
+			Labels:             proto.Meta.Labels,
+			Annotations:        proto.Meta.Annotations,
+			Conditions:         models.ProtoConditionsToK8s(proto.Meta.Conditions),
+			ObservedGeneration: proto.Meta.ObservedGeneration,
+		}
+		if proto.Meta.CreationTs != nil {
+			exception.Meta.CreationTS = metav1.NewTime(proto.Meta.CreationTs.AsTime())
+		}
+	}
+
+	return exception
+}
+
+// ConvertCrossNamespacePolicyFromProto converts protobuf CrossNamespacePolicy to domain model
+func ConvertCrossNamespacePolicyFromProto(proto *netguardpb.CrossNamespacePolicy) models.CrossNamespacePolicy {
+	policy := models.CrossNamespacePolicy{
+		SelfRef: models.SelfRef{
+			ResourceIdentifier: models.NewResourceIdentifier(
+				proto.GetSelfRef().GetName(),
+				models.WithNamespace(proto.GetSelfRef().GetNamespace()),
+			),
+		},
+		AllowedNamespaces: proto.AllowedNamespaces,
+	}
+
+	// meta
+	if proto.Meta != nil {
+		policy.Meta = models.Meta{
+			UID:                proto.Meta.Uid,
+			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
+			Generation:         proto.Meta.Generation,
+			Labels:             proto.Meta.Labels,
+			Annotations:        proto.Meta.Annotations,
+			Conditions:         models.ProtoConditionsToK8s(proto.Meta.Conditions),
+			ObservedGeneration: proto.Meta.ObservedGeneration,
+		}
+		if proto.Meta.CreationTs != nil {
+			policy.Meta.CreationTS = metav1.NewTime(proto.Meta.CreationTs.AsTime())
+		}
+	}
+
+	return policy
+}
+
+// ConvertRuleTemplateFromProto converts protobuf RuleTemplate to domain model
+func ConvertRuleTemplateFromProto(proto *netguardpb.RuleTemplate) models.RuleTemplate {
+	// Конвертация Traffic protobuf enum в string
+	traffic := models.INGRESS
+	if proto.Traffic == netguardpb.Traffic_Egress {
+		traffic = models.EGRESS
+	}
+
+	template := models.RuleTemplate{
+		SelfRef: models.SelfRef{
+			ResourceIdentifier: models.NewResourceIdentifier(
+				proto.GetSelfRef().GetName(),
+				models.WithNamespace(proto.GetSelfRef().GetNamespace()),
+			),
+		},
+		Traffic:               traffic,
+		LocalServiceSelector:  proto.LocalServiceSelector,
+		TargetServiceSelector: proto.TargetServiceSelector,
+		Trace:                 proto.Trace,
+	}
+	if proto.Action != netguardpb.RuleAction_UNDEFINED {
+		template.Action = models.RuleAction(proto.Action.String())
+	}
+
+	// meta
+	if proto.Meta != nil {
+		template.Meta = models.Meta{
+			UID:                proto.Meta.Uid,
+			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
+			Generation:         proto.Meta.Generation,
+			Labels:             proto.Meta.Labels,
+			Annotations:        proto.Meta.Annotations,
+			Conditions:         models.ProtoConditionsToK8s(proto.Meta.Conditions),
+			ObservedGeneration: proto.Meta.ObservedGeneration,
+		}
+		if proto.Meta.CreationTs != nil {
+			template.Meta.CreationTS = metav1.NewTime(proto.Meta.CreationTs.AsTime())
+		}
+	}
+
+	return template
+}
+
+// ConvertNamespacePostureFromProto converts protobuf NamespacePosture to domain model
+func ConvertNamespacePostureFromProto(proto *netguardpb.NamespacePosture) models.NamespacePosture {
+	// Конвертация режима protobuf enum в string
+	mode := models.PostureDefaultAllow
+	if proto.Mode == netguardpb.NamespacePostureMode_POSTURE_DEFAULT_DENY {
+		mode = models.PostureDefaultDeny
+	}
+
+	posture := models.NamespacePosture{
+		SelfRef: models.SelfRef{
+			ResourceIdentifier: models.NewResourceIdentifier(
+				proto.GetSelfRef().GetName(),
+				models.WithNamespace(proto.GetSelfRef().GetNamespace()),
+			),
+		},
+		Mode: mode,
+	}
+
+	// meta
+	if proto.Meta != nil {
+		posture.Meta = models.Meta{
+			UID:                proto.Meta.Uid,
+			ResourceVersion:    proto.Meta.ResourceVersion,
+			Finalizers:         proto.Meta.Finalizers,
+			DeletionTimestamp:  deletionTimestampFromProto(proto.Meta.DeletionTs),
+			OwnerReferences:    ownerReferencesFromProto(proto.Meta.OwnerReferences),
+			Generation:         proto.Meta.Generation,
+			Labels:             proto.Meta.Labels,
+			Annotations:        proto.Meta.Annotations,
+			Conditions:         models.ProtoConditionsToK8s(proto.Meta.Conditions),
+			ObservedGeneration: proto.Meta.ObservedGeneration,
+		}
+		if proto.Meta.CreationTs != nil {
+			posture.Meta.CreationTS = metav1.NewTime(proto.Meta.CreationTs.AsTime())
+		}
+	}
+
+	return posture
+}
+
+// convertAddressGroupRefFromProto конвертирует protobuf AddressGroupRef в доменную ссылку
+func convertAddressGroupRefFromProto(ref *netguardpb.AddressGroupRef) models.AddressGroupRef {
+	return v1beta1.NamespacedObjectReference{
+		ObjectReference: v1beta1.ObjectReference{
+			APIVersion: "netguard.sgroups.io/v1beta1",
+			Kind:       "AddressGroup",
+			Name:       ref.GetIdentifier().GetName(),
+		},
+		Namespace: ref.GetIdentifier().GetNamespace(),
+	}
+}
+
+// convertIngressPortsFromProto конвертирует protobuf IngressPorts в доменные
+func convertIngressPortsFromProto(protoPorts []*netguardpb.IngressPort) []models.IngressPort {
+	var ports []models.IngressPort
+	for _, port := range protoPorts {
+		var protocol models.TransportProtocol
+		switch port.Protocol {
+		case netguardpb.Networks_NetIP_TCP:
+			protocol = models.TCP
+		case netguardpb.Networks_NetIP_UDP:
+			protocol = models.UDP
+		case netguardpb.Networks_NetIP_SCTP:
+			protocol = models.SCTP
+		case netguardpb.Networks_NetIP_ICMP:
+			protocol = models.ICMP
+		default:
+			protocol = models.TCP // default
+		}
+
+		ports = append(ports, models.IngressPort{
+			Protocol:    protocol,
+			Port:        port.Port,
+			Description: port.Description,
+		})
+	}
+	return ports
+}
+
+// convertIngressPortsToProto конвертирует доменные IngressPorts в protobuf
+func convertIngressPortsToProto(ports []models.IngressPort) []*netguardpb.IngressPort {
+	var protoPorts []*netguardpb.IngressPort
+	for _, port := range ports {
+		var protocol netguardpb.Networks_NetIP_Transport
+		switch port.Protocol {
+		case models.TCP:
+			protocol = netguardpb.Networks_NetIP_TCP
+		case models.UDP:
+			protocol = netguardpb.Networks_NetIP_UDP
+		case models.SCTP:
+			protocol = netguardpb.Networks_NetIP_SCTP
+		case models.ICMP:
+			protocol = netguardpb.Networks_NetIP_ICMP
+		default:
+			protocol = netguardpb.Networks_NetIP_TCP // default
+		}
+
+		protoPorts = append(protoPorts, &netguardpb.IngressPort{
+			Protocol:    protocol,
+			Port:        port.Port,
+			Description: port.Description,
+		})
+	}
+	return protoPorts
+}
@@ -7,6 +7,7 @@ import (
 
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -29,28 +30,43 @@ import (
 
 type GRPCBackendClient struct {
 	client  netguardpb.NetguardServiceClient
-	conn    *grpc.ClientConn
+	conn    *connPool
 	limiter *rate.Limiter
 	config  BackendClientConfig
 
 	dependencyValidator *validation.DependencyValidator
 	reader              ports.Reader
+
+	// watchCache обслуживает GetService/GetAddressGroup без обращения к backend
+	watchCache     *WatchCache
+	stopWatchCache context.CancelFunc
 }
 
 func NewGRPCBackendClient(config BackendClientConfig) (*GRPCBackendClient, error) {
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
-			Time:                5 * time.Minute,
-			Timeout:             3 * time.Second,
+			Time:                config.KeepaliveTime,
+			Timeout:             config.KeepaliveTimeout,
 			PermitWithoutStream: false,
 		}),
+		// Переподключение к перезапущенному backend не должно ждать стандартные 120s backoff gRPC
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  config.RetryInitialBackoff,
+				Multiplier: backoff.DefaultConfig.Multiplier,
+				Jitter:     backoff.DefaultConfig.Jitter,
+				MaxDelay:   config.ReconnectMaxBackoff,
+			},
+			MinConnectTimeout: config.ConnectTimeout,
+		}),
+		grpc.WithChainUnaryInterceptor(resilienceInterceptor(config, newCircuitBreaker(config))),
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), config.ConnectTimeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, config.Endpoint, opts...)
+	conn, err := dialConnPool(ctx, config.Endpoint, config.PoolSize, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to backend: %w", err)
 	}
@@ -69,10 +85,20 @@ func NewGRPCBackendClient(config BackendClientConfig) (*GRPCBackendClient, error
 	grpcClient.reader = NewGRPCReader(grpcClient)
 	grpcClient.dependencyValidator = validation.NewDependencyValidator(grpcClient.reader)
 
+	if config.WatchCacheEnabled {
+		watchCtx, stop := context.WithCancel(context.Background())
+		grpcClient.watchCache = NewWatchCache(client, config.WatchCacheRetryInterval)
+		grpcClient.stopWatchCache = stop
+		go grpcClient.watchCache.Run(watchCtx)
+	}
+
 	return grpcClient, nil
 }
 
 func (c *GRPCBackendClient) GetService(ctx context.Context, id models.ResourceIdentifier) (*models.Service, error) {
+	if service, ok := c.watchCache.GetService(id); ok {
+		return service, nil
+	}
 	if !c.limiter.Allow() {
 		return nil, fmt.Errorf("rate limit exceeded")
 	}
@@ -98,6 +124,7 @@ func (c *GRPCBackendClient) ListServices(ctx context.Context, scope ports.Scope)
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -111,6 +138,7 @@ func (c *GRPCBackendClient) ListServices(ctx context.Context, scope ports.Scope)
 	}
 	req := &netguardpb.ListServicesReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListServices(ctx, req)
 	if err != nil {
@@ -189,6 +217,9 @@ func (c *GRPCBackendClient) syncService(ctx context.Context, syncOp models.SyncO
 
 func (c *GRPCBackendClient) GetAddressGroup(ctx context.Context, id models.ResourceIdentifier) (*models.AddressGroup, error) {
 	klog.V(4).Infof("GRPCBackendClient.GetAddressGroup ns=%q name=%q", id.Namespace, id.Name)
+	if addressGroup, ok := c.watchCache.GetAddressGroup(id); ok {
+		return addressGroup, nil
+	}
 	if !c.limiter.Allow() {
 		return nil, fmt.Errorf("rate limit exceeded")
 	}
@@ -215,6 +246,7 @@ func (c *GRPCBackendClient) ListAddressGroups(ctx context.Context, scope ports.S
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -228,6 +260,7 @@ func (c *GRPCBackendClient) ListAddressGroups(ctx context.Context, scope ports.S
 	}
 	req := &netguardpb.ListAddressGroupsReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListAddressGroups(ctx, req)
 	if err != nil {
@@ -317,6 +350,7 @@ func (c *GRPCBackendClient) ListAddressGroupBindings(ctx context.Context, scope
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -330,6 +364,7 @@ func (c *GRPCBackendClient) ListAddressGroupBindings(ctx context.Context, scope
 	}
 	req := &netguardpb.ListAddressGroupBindingsReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListAddressGroupBindings(ctx, req)
 	if err != nil {
@@ -426,6 +461,7 @@ func (c *GRPCBackendClient) ListAddressGroupPortMappings(ctx context.Context, sc
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -439,6 +475,7 @@ func (c *GRPCBackendClient) ListAddressGroupPortMappings(ctx context.Context, sc
 	}
 	req := &netguardpb.ListAddressGroupPortMappingsReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListAddressGroupPortMappings(ctx, req)
 	if err != nil {
@@ -528,6 +565,7 @@ func (c *GRPCBackendClient) ListRuleS2S(ctx context.Context, scope ports.Scope)
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -541,6 +579,7 @@ func (c *GRPCBackendClient) ListRuleS2S(ctx context.Context, scope ports.Scope)
 	}
 	req := &netguardpb.ListRuleS2SReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListRuleS2S(ctx, req)
 	if err != nil {
@@ -635,6 +674,7 @@ func (c *GRPCBackendClient) ListServiceAliases(ctx context.Context, scope ports.
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -648,6 +688,7 @@ func (c *GRPCBackendClient) ListServiceAliases(ctx context.Context, scope ports.
 	}
 	req := &netguardpb.ListServiceAliasesReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListServiceAliases(ctx, req)
 	if err != nil {
@@ -745,6 +786,7 @@ func (c *GRPCBackendClient) ListAddressGroupBindingPolicies(ctx context.Context,
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -758,6 +800,7 @@ func (c *GRPCBackendClient) ListAddressGroupBindingPolicies(ctx context.Context,
 	}
 	req := &netguardpb.ListAddressGroupBindingPoliciesReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListAddressGroupBindingPolicies(ctx, req)
 	if err != nil {
@@ -850,6 +893,7 @@ func (c *GRPCBackendClient) ListIEAgAgRules(ctx context.Context, scope ports.Sco
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -863,6 +907,7 @@ func (c *GRPCBackendClient) ListIEAgAgRules(ctx context.Context, scope ports.Sco
 	}
 	req := &netguardpb.ListIEAgAgRulesReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListIEAgAgRules(ctx, req)
 	if err != nil {
@@ -919,6 +964,7 @@ func (c *GRPCBackendClient) ListNetworks(ctx context.Context, scope ports.Scope)
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -932,6 +978,7 @@ func (c *GRPCBackendClient) ListNetworks(ctx context.Context, scope ports.Scope)
 	}
 	req := &netguardpb.ListNetworksReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListNetworks(ctx, req)
 	if err != nil {
@@ -995,6 +1042,7 @@ func (c *GRPCBackendClient) ListNetworkBindings(ctx context.Context, scope ports
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -1008,6 +1056,7 @@ func (c *GRPCBackendClient) ListNetworkBindings(ctx context.Context, scope ports
 	}
 	req := &netguardpb.ListNetworkBindingsReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListNetworkBindings(ctx, req)
 	if err != nil {
@@ -1445,7 +1494,42 @@ func (c *GRPCBackendClient) ListAccessPorts(ctx context.Context, mappingID model
 	return servicePortsRefs, nil
 }
 
+func (c *GRPCBackendClient) ListConditionTransitions(ctx context.Context, afterID int64, limit int) ([]models.ConditionTransition, int64, error) {
+	if !c.limiter.Allow() {
+		return nil, 0, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+
+	resp, err := c.client.ListConditionTransitions(ctx, &netguardpb.ListConditionTransitionsReq{
+		AfterId: afterID,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list condition transitions: %w", err)
+	}
+
+	transitions := make([]models.ConditionTransition, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		transitions = append(transitions, models.ConditionTransition{
+			ID:                 item.GetId(),
+			Kind:               item.GetKind(),
+			ResourceIdentifier: models.NewResourceIdentifier(item.GetIdentifier().GetName(), models.WithNamespace(item.GetIdentifier().GetNamespace())),
+			Type:               item.GetType(),
+			From:               metav1.ConditionStatus(item.GetFromStatus()),
+			To:                 metav1.ConditionStatus(item.GetToStatus()),
+			Reason:             item.GetReason(),
+			Message:            item.GetMessage(),
+			Time:               item.GetTime().AsTime(),
+		})
+	}
+	return transitions, resp.GetLastId(), nil
+}
+
 func (c *GRPCBackendClient) Close() error {
+	if c.stopWatchCache != nil {
+		c.stopWatchCache()
+	}
 	if c.conn != nil {
 		return c.conn.Close()
 	}
@@ -1478,6 +1562,7 @@ func (c *GRPCBackendClient) ListHosts(ctx context.Context, scope ports.Scope) ([
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -1491,6 +1576,7 @@ func (c *GRPCBackendClient) ListHosts(ctx context.Context, scope ports.Scope) ([
 	}
 	req := &netguardpb.ListHostsReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListHosts(ctx, req)
 	if err != nil {
@@ -1554,6 +1640,7 @@ func (c *GRPCBackendClient) ListHostBindings(ctx context.Context, scope ports.Sc
 	}
 	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
 	defer cancel()
+	scope, selector := splitSelectorScope(scope)
 	var identifiers []*netguardpb.ResourceIdentifier
 	if scope != nil {
 		if ris, ok := scope.(ports.ResourceIdentifierScope); ok && len(ris.Identifiers) > 0 {
@@ -1567,6 +1654,7 @@ func (c *GRPCBackendClient) ListHostBindings(ctx context.Context, scope ports.Sc
 	}
 	req := &netguardpb.ListHostBindingsReq{
 		Identifiers: identifiers,
+		Selector:    selector,
 	}
 	resp, err := c.client.ListHostBindings(ctx, req)
 	if err != nil {
@@ -1579,6 +1667,198 @@ func (c *GRPCBackendClient) ListHostBindings(ctx context.Context, scope ports.Sc
 	return hostBindings, nil
 }
 
+func (c *GRPCBackendClient) GetRuleS2SException(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	req := &netguardpb.GetRuleS2SExceptionReq{
+		Identifier: &netguardpb.ResourceIdentifier{
+			Namespace: id.Namespace,
+			Name:      id.Name,
+		},
+	}
+	resp, err := c.client.GetRuleS2SException(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule s2s exception: %w", err)
+	}
+	exception := ConvertRuleS2SExceptionFromProto(resp.RuleS2SException)
+	return &exception, nil
+}
+
+func (c *GRPCBackendClient) ListRuleS2SExceptions(ctx context.Context, scope ports.Scope) ([]models.RuleS2SException, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	var identifiers []*netguardpb.ResourceIdentifier
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok {
+		for _, id := range ris.Identifiers {
+			identifiers = append(identifiers, &netguardpb.ResourceIdentifier{
+				Namespace: id.Namespace,
+				Name:      id.Name,
+			})
+		}
+	}
+	resp, err := c.client.ListRuleS2SExceptions(ctx, &netguardpb.ListRuleS2SExceptionsReq{
+		Identifiers: identifiers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rule s2s exceptions: %w", err)
+	}
+	exceptions := make([]models.RuleS2SException, 0, len(resp.Items))
+	for _, protoException := range resp.Items {
+		exceptions = append(exceptions, ConvertRuleS2SExceptionFromProto(protoException))
+	}
+	return exceptions, nil
+}
+
+func (c *GRPCBackendClient) GetNamespacePosture(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	req := &netguardpb.GetNamespacePostureReq{
+		Identifier: &netguardpb.ResourceIdentifier{
+			Namespace: id.Namespace,
+			Name:      id.Name,
+		},
+	}
+	resp, err := c.client.GetNamespacePosture(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace posture: %w", err)
+	}
+	posture := ConvertNamespacePostureFromProto(resp.NamespacePosture)
+	return &posture, nil
+}
+
+func (c *GRPCBackendClient) ListNamespacePostures(ctx context.Context, scope ports.Scope) ([]models.NamespacePosture, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	var identifiers []*netguardpb.ResourceIdentifier
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok {
+		for _, id := range ris.Identifiers {
+			identifiers = append(identifiers, &netguardpb.ResourceIdentifier{
+				Namespace: id.Namespace,
+				Name:      id.Name,
+			})
+		}
+	}
+	resp, err := c.client.ListNamespacePostures(ctx, &netguardpb.ListNamespacePosturesReq{
+		Identifiers: identifiers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespace postures: %w", err)
+	}
+	postures := make([]models.NamespacePosture, 0, len(resp.Items))
+	for _, protoPosture := range resp.Items {
+		postures = append(postures, ConvertNamespacePostureFromProto(protoPosture))
+	}
+	return postures, nil
+}
+
+func (c *GRPCBackendClient) GetRuleTemplate(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	req := &netguardpb.GetRuleTemplateReq{
+		Identifier: &netguardpb.ResourceIdentifier{
+			Namespace: id.Namespace,
+			Name:      id.Name,
+		},
+	}
+	resp, err := c.client.GetRuleTemplate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule template: %w", err)
+	}
+	template := ConvertRuleTemplateFromProto(resp.RuleTemplate)
+	return &template, nil
+}
+
+func (c *GRPCBackendClient) ListRuleTemplates(ctx context.Context, scope ports.Scope) ([]models.RuleTemplate, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	var identifiers []*netguardpb.ResourceIdentifier
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok {
+		for _, id := range ris.Identifiers {
+			identifiers = append(identifiers, &netguardpb.ResourceIdentifier{
+				Namespace: id.Namespace,
+				Name:      id.Name,
+			})
+		}
+	}
+	resp, err := c.client.ListRuleTemplates(ctx, &netguardpb.ListRuleTemplatesReq{
+		Identifiers: identifiers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rule templates: %w", err)
+	}
+	templates := make([]models.RuleTemplate, 0, len(resp.Items))
+	for _, protoTemplate := range resp.Items {
+		templates = append(templates, ConvertRuleTemplateFromProto(protoTemplate))
+	}
+	return templates, nil
+}
+
+func (c *GRPCBackendClient) GetCrossNamespacePolicy(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	req := &netguardpb.GetCrossNamespacePolicyReq{
+		Identifier: &netguardpb.ResourceIdentifier{
+			Namespace: id.Namespace,
+			Name:      id.Name,
+		},
+	}
+	resp, err := c.client.GetCrossNamespacePolicy(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cross namespace policy: %w", err)
+	}
+	policy := ConvertCrossNamespacePolicyFromProto(resp.CrossNamespacePolicy)
+	return &policy, nil
+}
+
+func (c *GRPCBackendClient) ListCrossNamespacePolicies(ctx context.Context, scope ports.Scope) ([]models.CrossNamespacePolicy, error) {
+	if !c.limiter.Allow() {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+	var identifiers []*netguardpb.ResourceIdentifier
+	if ris, ok := scope.(ports.ResourceIdentifierScope); ok {
+		for _, id := range ris.Identifiers {
+			identifiers = append(identifiers, &netguardpb.ResourceIdentifier{
+				Namespace: id.Namespace,
+				Name:      id.Name,
+			})
+		}
+	}
+	resp, err := c.client.ListCrossNamespacePolicies(ctx, &netguardpb.ListCrossNamespacePoliciesReq{
+		Identifiers: identifiers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cross namespace policies: %w", err)
+	}
+	policies := make([]models.CrossNamespacePolicy, 0, len(resp.Items))
+	for _, protoPolicy := range resp.Items {
+		policies = append(policies, ConvertCrossNamespacePolicyFromProto(protoPolicy))
+	}
+	return policies, nil
+}
+
 func (c *GRPCBackendClient) CreateHostBinding(ctx context.Context, hostBinding *models.HostBinding) error {
 	// Use Sync API for creation
 	hostBindings := []models.HostBinding{*hostBinding}
@@ -1696,12 +1976,15 @@ func convertNetworkFromProto(protoNetwork *netguardpb.Network) models.Network {
 	// Copy meta if provided
 	if protoNetwork.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             protoNetwork.Meta.Uid,
-			ResourceVersion: protoNetwork.Meta.ResourceVersion,
-			Generation:      protoNetwork.Meta.Generation,
-			Labels:          protoNetwork.Meta.Labels,
-			Annotations:     protoNetwork.Meta.Annotations,
-			Conditions:      models.ProtoConditionsToK8s(protoNetwork.Meta.Conditions),
+			UID:               protoNetwork.Meta.Uid,
+			ResourceVersion:   protoNetwork.Meta.ResourceVersion,
+			Finalizers:        protoNetwork.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(protoNetwork.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(protoNetwork.Meta.OwnerReferences),
+			Generation:        protoNetwork.Meta.Generation,
+			Labels:            protoNetwork.Meta.Labels,
+			Annotations:       protoNetwork.Meta.Annotations,
+			Conditions:        models.ProtoConditionsToK8s(protoNetwork.Meta.Conditions),
 		}
 		if protoNetwork.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(protoNetwork.Meta.CreationTs.AsTime())
@@ -1743,6 +2026,9 @@ func convertNetworkToPB(network models.Network) *netguardpb.Network {
 	pbNetwork.Meta = &netguardpb.Meta{
 		Uid:                network.Meta.UID,
 		ResourceVersion:    network.Meta.ResourceVersion,
+		Finalizers:         network.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(network.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(network.Meta.OwnerReferences),
 		Generation:         network.Meta.Generation,
 		Labels:             network.Meta.Labels,
 		Annotations:        network.Meta.Annotations,
@@ -1793,12 +2079,15 @@ func convertNetworkBindingFromProto(protoBinding *netguardpb.NetworkBinding) mod
 	// Copy Meta if presented
 	if protoBinding.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             protoBinding.Meta.Uid,
-			ResourceVersion: protoBinding.Meta.ResourceVersion,
-			Generation:      protoBinding.Meta.Generation,
-			Labels:          protoBinding.Meta.Labels,
-			Annotations:     protoBinding.Meta.Annotations,
-			Conditions:      models.ProtoConditionsToK8s(protoBinding.Meta.Conditions),
+			UID:               protoBinding.Meta.Uid,
+			ResourceVersion:   protoBinding.Meta.ResourceVersion,
+			Finalizers:        protoBinding.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(protoBinding.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(protoBinding.Meta.OwnerReferences),
+			Generation:        protoBinding.Meta.Generation,
+			Labels:            protoBinding.Meta.Labels,
+			Annotations:       protoBinding.Meta.Annotations,
+			Conditions:        models.ProtoConditionsToK8s(protoBinding.Meta.Conditions),
 		}
 		if protoBinding.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(protoBinding.Meta.CreationTs.AsTime())
@@ -1836,6 +2125,9 @@ func convertNetworkBindingToPB(binding models.NetworkBinding) *netguardpb.Networ
 	pbBinding.Meta = &netguardpb.Meta{
 		Uid:                binding.Meta.UID,
 		ResourceVersion:    binding.Meta.ResourceVersion,
+		Finalizers:         binding.Meta.Finalizers,
+		DeletionTs:         deletionTimestampToProto(binding.Meta.DeletionTimestamp),
+		OwnerReferences:    ownerReferencesToProto(binding.Meta.OwnerReferences),
 		Generation:         binding.Meta.Generation,
 		Labels:             binding.Meta.Labels,
 		Annotations:        binding.Meta.Annotations,
@@ -1858,6 +2150,10 @@ func convertIEAgAgRuleFromProto(protoRule *netguardpb.IEAgAgRule) models.IEAgAgR
 		transport = models.TCP
 	case netguardpb.Networks_NetIP_UDP:
 		transport = models.UDP
+	case netguardpb.Networks_NetIP_SCTP:
+		transport = models.SCTP
+	case netguardpb.Networks_NetIP_ICMP:
+		transport = models.ICMP
 	default:
 		transport = models.TCP
 	}
@@ -1934,11 +2230,14 @@ func convertIEAgAgRuleFromProto(protoRule *netguardpb.IEAgAgRule) models.IEAgAgR
 	// Copy Meta if provided
 	if protoRule.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             protoRule.Meta.Uid,
-			ResourceVersion: protoRule.Meta.ResourceVersion,
-			Generation:      protoRule.Meta.Generation,
-			Labels:          protoRule.Meta.Labels,
-			Annotations:     protoRule.Meta.Annotations,
+			UID:               protoRule.Meta.Uid,
+			ResourceVersion:   protoRule.Meta.ResourceVersion,
+			Finalizers:        protoRule.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(protoRule.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(protoRule.Meta.OwnerReferences),
+			Generation:        protoRule.Meta.Generation,
+			Labels:            protoRule.Meta.Labels,
+			Annotations:       protoRule.Meta.Annotations,
 		}
 		if protoRule.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(protoRule.Meta.CreationTs.AsTime())
@@ -1990,11 +2289,14 @@ func convertHostFromProto(protoHost *netguardpb.Host) models.Host {
 	// Copy Meta if provided
 	if protoHost.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             protoHost.Meta.Uid,
-			ResourceVersion: protoHost.Meta.ResourceVersion,
-			Generation:      protoHost.Meta.Generation,
-			Labels:          protoHost.Meta.Labels,
-			Annotations:     protoHost.Meta.Annotations,
+			UID:               protoHost.Meta.Uid,
+			ResourceVersion:   protoHost.Meta.ResourceVersion,
+			Finalizers:        protoHost.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(protoHost.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(protoHost.Meta.OwnerReferences),
+			Generation:        protoHost.Meta.Generation,
+			Labels:            protoHost.Meta.Labels,
+			Annotations:       protoHost.Meta.Annotations,
 		}
 		if protoHost.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(protoHost.Meta.CreationTs.AsTime())
@@ -2057,6 +2359,9 @@ func convertHostToPB(host models.Host) *netguardpb.Host {
 		Meta: &netguardpb.Meta{
 			Uid:                host.Meta.UID,
 			ResourceVersion:    host.Meta.ResourceVersion,
+			Finalizers:         host.Meta.Finalizers,
+			DeletionTs:         deletionTimestampToProto(host.Meta.DeletionTimestamp),
+			OwnerReferences:    ownerReferencesToProto(host.Meta.OwnerReferences),
 			Generation:         host.Meta.Generation,
 			CreationTs:         timestamppb.New(host.Meta.CreationTS.Time),
 			Labels:             host.Meta.Labels,
@@ -2105,11 +2410,14 @@ func convertHostBindingFromProto(protoBinding *netguardpb.HostBinding) models.Ho
 	// Copy Meta if provided
 	if protoBinding.Meta != nil {
 		result.Meta = models.Meta{
-			UID:             protoBinding.Meta.Uid,
-			ResourceVersion: protoBinding.Meta.ResourceVersion,
-			Generation:      protoBinding.Meta.Generation,
-			Labels:          protoBinding.Meta.Labels,
-			Annotations:     protoBinding.Meta.Annotations,
+			UID:               protoBinding.Meta.Uid,
+			ResourceVersion:   protoBinding.Meta.ResourceVersion,
+			Finalizers:        protoBinding.Meta.Finalizers,
+			DeletionTimestamp: deletionTimestampFromProto(protoBinding.Meta.DeletionTs),
+			OwnerReferences:   ownerReferencesFromProto(protoBinding.Meta.OwnerReferences),
+			Generation:        protoBinding.Meta.Generation,
+			Labels:            protoBinding.Meta.Labels,
+			Annotations:       protoBinding.Meta.Annotations,
 		}
 		if protoBinding.Meta.CreationTs != nil {
 			result.Meta.CreationTS = metav1.NewTime(protoBinding.Meta.CreationTs.AsTime())
@@ -2147,6 +2455,9 @@ func convertHostBindingToPB(hostBinding models.HostBinding) *netguardpb.HostBind
 		Meta: &netguardpb.Meta{
 			Uid:                hostBinding.Meta.UID,
 			ResourceVersion:    hostBinding.Meta.ResourceVersion,
+			Finalizers:         hostBinding.Meta.Finalizers,
+			DeletionTs:         deletionTimestampToProto(hostBinding.Meta.DeletionTimestamp),
+			OwnerReferences:    ownerReferencesToProto(hostBinding.Meta.OwnerReferences),
 			Generation:         hostBinding.Meta.Generation,
 			CreationTs:         timestamppb.New(hostBinding.Meta.CreationTS.Time),
 			Labels:             hostBinding.Meta.Labels,
@@ -2161,3 +2472,16 @@ func convertHostBindingToPB(hostBinding models.HostBinding) *netguardpb.HostBind
 func convertSyncOpToPB(syncOp models.SyncOp) netguardpb.SyncOp {
 	return netguardpb.SyncOp(models.SyncOpToProto(syncOp))
 }
+
+// splitSelectorScope отделяет селекторы ports.SelectorScope от вложенного scope:
+// селекторы передаются backend'у в List запросе и применяются на его стороне
+func splitSelectorScope(scope ports.Scope) (ports.Scope, *netguardpb.ListSelector) {
+	selectorScope, ok := scope.(ports.SelectorScope)
+	if !ok {
+		return scope, nil
+	}
+	return selectorScope.Scope, &netguardpb.ListSelector{
+		LabelSelector: selectorScope.LabelSelector,
+		FieldSelector: selectorScope.FieldSelector,
+	}
+}
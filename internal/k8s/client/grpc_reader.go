@@ -269,6 +269,82 @@ func (r *GRPCReader) GetHostBindingByID(ctx context.Context, id models.ResourceI
 	return r.grpcClient.GetHostBinding(ctx, id)
 }
 
+func (r *GRPCReader) ListRuleS2SExceptions(ctx context.Context, consume func(models.RuleS2SException) error, scope ports.Scope) error {
+	exceptions, err := r.grpcClient.ListRuleS2SExceptions(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	for _, exception := range exceptions {
+		if err := consume(exception); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *GRPCReader) GetRuleS2SExceptionByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleS2SException, error) {
+	return r.grpcClient.GetRuleS2SException(ctx, id)
+}
+
+func (r *GRPCReader) ListNamespacePostures(ctx context.Context, consume func(models.NamespacePosture) error, scope ports.Scope) error {
+	postures, err := r.grpcClient.ListNamespacePostures(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	for _, posture := range postures {
+		if err := consume(posture); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *GRPCReader) GetNamespacePostureByID(ctx context.Context, id models.ResourceIdentifier) (*models.NamespacePosture, error) {
+	return r.grpcClient.GetNamespacePosture(ctx, id)
+}
+
+func (r *GRPCReader) ListRuleTemplates(ctx context.Context, consume func(models.RuleTemplate) error, scope ports.Scope) error {
+	templates, err := r.grpcClient.ListRuleTemplates(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templates {
+		if err := consume(template); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *GRPCReader) GetRuleTemplateByID(ctx context.Context, id models.ResourceIdentifier) (*models.RuleTemplate, error) {
+	return r.grpcClient.GetRuleTemplate(ctx, id)
+}
+
+func (r *GRPCReader) ListCrossNamespacePolicies(ctx context.Context, consume func(models.CrossNamespacePolicy) error, scope ports.Scope) error {
+	policies, err := r.grpcClient.ListCrossNamespacePolicies(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if err := consume(policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *GRPCReader) GetCrossNamespacePolicyByID(ctx context.Context, id models.ResourceIdentifier) (*models.CrossNamespacePolicy, error) {
+	return r.grpcClient.GetCrossNamespacePolicy(ctx, id)
+}
+
 // GetNetworkBindingByID реализует ports.Reader интерфейс
 func (r *GRPCReader) GetNetworkBindingByID(ctx context.Context, id models.ResourceIdentifier) (*models.NetworkBinding, error) {
 	return r.grpcClient.GetNetworkBinding(ctx, id)
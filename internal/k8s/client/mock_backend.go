@@ -555,6 +555,16 @@ func (m *MockBackendClient) ListRuleS2SDstOwnRef(ctx context.Context, serviceID
 	}, nil
 }
 
+func (m *MockBackendClient) ListConditionTransitions(ctx context.Context, afterID int64, limit int) ([]models.ConditionTransition, int64, error) {
+	// Мок не хранит историю conditions
+	return nil, 0, nil
+}
+
+func (m *MockBackendClient) WatchChanges(ctx context.Context, kinds []string, sinceRevision uint64) (ChangeStream, error) {
+	// Мок не публикует изменения, watch обслуживается локальным broadcaster'ом
+	return nil, ErrWatchNotSupported
+}
+
 func (m *MockBackendClient) ListAccessPorts(ctx context.Context, mappingID models.ResourceIdentifier) ([]models.ServicePortsRef, error) {
 	// Возвращаем тестовые service ports refs для mock
 	return []models.ServicePortsRef{
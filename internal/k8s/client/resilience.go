@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// ErrCircuitOpen возвращается без обращения к backend, пока circuit breaker открыт
+var ErrCircuitOpen = status.Error(codes.Unavailable, "backend circuit breaker is open")
+
+// connPool распределяет вызовы по нескольким gRPC соединениям с backend (round-robin),
+// чтобы долгие запросы и watch потоки не упирались в лимит потоков одного HTTP/2 соединения
+type connPool struct {
+	conns []*grpc.ClientConn
+	next  atomic.Uint64
+}
+
+func dialConnPool(ctx context.Context, endpoint string, size int, opts ...grpc.DialOption) (*connPool, error) {
+	if size < 1 {
+		size = 1
+	}
+	pool := &connPool{conns: make([]*grpc.ClientConn, 0, size)}
+	for i := 0; i < size; i++ {
+		conn, err := grpc.DialContext(ctx, endpoint, opts...)
+		if err != nil {
+			_ = pool.Close()
+			return nil, err
+		}
+		pool.conns = append(pool.conns, conn)
+	}
+	return pool, nil
+}
+
+func (p *connPool) pick() *grpc.ClientConn {
+	return p.conns[(p.next.Add(1)-1)%uint64(len(p.conns))]
+}
+
+// Invoke реализует grpc.ClientConnInterface
+func (p *connPool) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return p.pick().Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream реализует grpc.ClientConnInterface
+func (p *connPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return p.pick().NewStream(ctx, desc, method, opts...)
+}
+
+func (p *connPool) Close() error {
+	var errs []error
+	for _, conn := range p.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitOpen:
+		return "open"
+	case circuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker перестает обращаться к backend после CBFailureThreshold подряд неудачных вызовов.
+// Через CBTimeout пропускается до CBMaxRequests пробных вызовов: их успех закрывает breaker,
+// любая ошибка снова открывает его.
+type circuitBreaker struct {
+	maxRequests      uint32
+	failureThreshold uint32
+	interval         time.Duration
+	timeout          time.Duration
+	now              func() time.Time
+
+	mu          sync.Mutex
+	state       circuitState
+	failures    uint32
+	requests    uint32
+	successes   uint32
+	openedAt    time.Time
+	countsSince time.Time
+}
+
+func newCircuitBreaker(config BackendClientConfig) *circuitBreaker {
+	return &circuitBreaker{
+		maxRequests:      config.CBMaxRequests,
+		failureThreshold: config.CBFailureThreshold,
+		interval:         config.CBInterval,
+		timeout:          config.CBTimeout,
+		now:              time.Now,
+		countsSince:      time.Now(),
+	}
+}
+
+// allow резервирует вызов backend или возвращает ErrCircuitOpen
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	switch b.state {
+	case circuitClosed:
+		// Счетчик ошибок закрытого breaker сбрасывается каждые CBInterval
+		if b.interval > 0 && now.Sub(b.countsSince) >= b.interval {
+			b.failures = 0
+			b.countsSince = now
+		}
+		return nil
+	case circuitOpen:
+		if now.Sub(b.openedAt) < b.timeout {
+			return ErrCircuitOpen
+		}
+		b.setState(circuitHalfOpen, now)
+	}
+	if b.requests >= b.maxRequests {
+		return ErrCircuitOpen
+	}
+	b.requests++
+	return nil
+}
+
+// record учитывает результат вызова, зарезервированного allow
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	switch b.state {
+	case circuitClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.failureThreshold {
+			b.setState(circuitOpen, now)
+		}
+	case circuitHalfOpen:
+		if failed {
+			b.setState(circuitOpen, now)
+			return
+		}
+		b.successes++
+		if b.successes >= b.maxRequests {
+			b.setState(circuitClosed, now)
+		}
+	}
+}
+
+func (b *circuitBreaker) setState(state circuitState, now time.Time) {
+	if b.state != state {
+		klog.Warningf("⚡ Backend circuit breaker changed from %s to %s", b.state, state)
+	}
+	b.state = state
+	b.failures, b.requests, b.successes = 0, 0, 0
+	b.countsSince = now
+	if state == circuitOpen {
+		b.openedAt = now
+	}
+}
+
+// isBackendUnavailable отделяет недоступность backend от ошибок самих запросов (валидация, not found),
+// только она учитывается circuit breaker и повторяется
+func isBackendUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// resilienceInterceptor ограничивает каждую попытку вызова AttemptTimeout и повторяет вызовы
+// недоступного backend до MaxRetries раз с экспоненциальной задержкой, пока не истек контекст вызова.
+// Повторяются и Sync запросы: upsert и delete по идентификатору ресурса идемпотентны.
+func resilienceInterceptor(config BackendClientConfig, breaker *circuitBreaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		backoff := config.RetryInitialBackoff
+		for attempt := 0; ; attempt++ {
+			if err := breaker.allow(); err != nil {
+				return err
+			}
+
+			attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+			if config.AttemptTimeout > 0 {
+				attemptCtx, cancel = context.WithTimeout(ctx, config.AttemptTimeout)
+			}
+			err := invoker(attemptCtx, method, req, reply, cc, opts...)
+			cancel()
+
+			// Истекший или отмененный контекст вызывающего не говорит о недоступности backend
+			unavailable := isBackendUnavailable(err) && ctx.Err() == nil
+			breaker.record(unavailable)
+			if !unavailable || attempt >= config.MaxRetries {
+				return err
+			}
+
+			klog.V(2).Infof("🔄 Retrying %s in %v after attempt %d failed: %v", method, backoff, attempt+1, err)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w (retry interrupted: %v)", err, ctx.Err())
+			case <-time.After(jitter(backoff)):
+			}
+			backoff = min(backoff*2, config.RetryMaxBackoff)
+		}
+	}
+}
+
+// jitter разносит повторы клиентов во времени в пределах ±20% задержки
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d + time.Duration((rand.Float64()*0.4-0.2)*float64(d))
+}
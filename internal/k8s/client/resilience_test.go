@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testResilienceConfig() BackendClientConfig {
+	return BackendClientConfig{
+		MaxRetries:          2,
+		AttemptTimeout:      time.Second,
+		RetryInitialBackoff: time.Millisecond,
+		RetryMaxBackoff:     2 * time.Millisecond,
+		CBMaxRequests:       1,
+		CBInterval:          time.Minute,
+		CBTimeout:           time.Minute,
+		CBFailureThreshold:  3,
+	}
+}
+
+func TestCircuitBreaker_OpensAndRecovers(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(testResilienceConfig())
+	breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, breaker.allow())
+		breaker.record(true)
+	}
+	assert.ErrorIs(t, breaker.allow(), ErrCircuitOpen, "breaker must open after the failure threshold")
+
+	// После CBTimeout пропускается только CBMaxRequests пробных вызовов
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow())
+	assert.ErrorIs(t, breaker.allow(), ErrCircuitOpen)
+
+	breaker.record(false)
+	assert.Equal(t, circuitClosed, breaker.state)
+	assert.NoError(t, breaker.allow())
+}
+
+func TestResilienceInterceptor_RetriesUnavailableBackend(t *testing.T) {
+	config := testResilienceConfig()
+	interceptor := resilienceInterceptor(config, newCircuitBreaker(config))
+
+	calls := 0
+	err := interceptor(context.Background(), "/Sync", nil, nil, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		if calls < 3 {
+			return status.Error(codes.Unavailable, "connection refused")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	// Ошибки самих запросов не повторяются
+	calls = 0
+	err = interceptor(context.Background(), "/Sync", nil, nil, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.InvalidArgument, "bad port")
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestResilienceInterceptor_FailsFastWhenCircuitIsOpen(t *testing.T) {
+	config := testResilienceConfig()
+	interceptor := resilienceInterceptor(config, newCircuitBreaker(config))
+
+	calls := 0
+	unavailable := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Unavailable, "connection refused")
+	}
+	err := interceptor(context.Background(), "/GetService", nil, nil, nil, unavailable)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 3, calls)
+
+	err = interceptor(context.Background(), "/GetService", nil, nil, nil, unavailable)
+	assert.True(t, errors.Is(err, ErrCircuitOpen), "expected open circuit, got %v", err)
+	assert.Equal(t, 3, calls, "open circuit must not call backend")
+}
@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+
+	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
+)
+
+// WatchCache держит локальную копию Services и AddressGroups, обновляемую
+// через Watch RPC backend'а. Используется вместо Get-запросов на каждый
+// admission request.
+//
+// Объекты хранятся в proto-виде и конвертируются при чтении, поэтому
+// вызывающий код всегда получает независимую копию.
+type WatchCache struct {
+	client        netguardpb.NetguardServiceClient
+	retryInterval time.Duration
+
+	mu            sync.RWMutex
+	services      map[string]*netguardpb.Service
+	addressGroups map[string]*netguardpb.AddressGroup
+
+	synced   atomic.Bool
+	revision atomic.Uint64
+}
+
+// NewWatchCache создает кэш поверх gRPC клиента backend'а
+func NewWatchCache(client netguardpb.NetguardServiceClient, retryInterval time.Duration) *WatchCache {
+	if retryInterval <= 0 {
+		retryInterval = 5 * time.Second
+	}
+	return &WatchCache{
+		client:        client,
+		retryInterval: retryInterval,
+		services:      make(map[string]*netguardpb.Service),
+		addressGroups: make(map[string]*netguardpb.AddressGroup),
+	}
+}
+
+// Run поддерживает кэш в актуальном состоянии до отмены контекста.
+// При обрыве stream'а кэш помечается как несинхронизированный и watch
+// возобновляется с последней полученной ревизии. Полный relist выполняется
+// только если backend уже компактировал эту ревизию.
+func (c *WatchCache) Run(ctx context.Context) {
+	for {
+		err := c.listAndWatch(ctx)
+		c.synced.Store(false)
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(err) == codes.OutOfRange {
+			klog.Warningf("WatchCache: revision %d compacted by backend, relisting", c.revision.Load())
+			c.revision.Store(0)
+			continue
+		}
+		klog.Warningf("WatchCache: watch interrupted, retrying in %s: %v", c.retryInterval, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.retryInterval):
+		}
+	}
+}
+
+// listAndWatch открывает stream и дожидается bookmark'а (подписка на backend'е
+// создана, пропущенные события доставлены). Для нового watch'а после bookmark'а
+// выполняется relist, поэтому изменения между list и watch не теряются.
+func (c *WatchCache) listAndWatch(ctx context.Context) error {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	since := c.revision.Load()
+	stream, err := c.client.Watch(watchCtx, &netguardpb.WatchReq{
+		Kinds:         []string{"Service", "AddressGroup"},
+		SinceRevision: since,
+	})
+	if err != nil {
+		return err
+	}
+
+	for !c.Synced() {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		c.apply(event)
+		if event.GetResource() != nil {
+			continue
+		}
+
+		if since == 0 {
+			if err := c.relist(watchCtx); err != nil {
+				return err
+			}
+		}
+		c.synced.Store(true)
+		servicesCount, groupsCount := c.counts()
+		klog.Infof("WatchCache: synced %d services, %d address groups at revision %d (resumed: %v)",
+			servicesCount, groupsCount, c.revision.Load(), since != 0)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return errors.New("watch stream closed by backend")
+			}
+			return err
+		}
+		c.apply(event)
+	}
+}
+
+func (c *WatchCache) relist(ctx context.Context) error {
+	servicesResp, err := c.client.ListServices(ctx, &netguardpb.ListServicesReq{})
+	if err != nil {
+		return err
+	}
+	groupsResp, err := c.client.ListAddressGroups(ctx, &netguardpb.ListAddressGroupsReq{})
+	if err != nil {
+		return err
+	}
+
+	services := make(map[string]*netguardpb.Service, len(servicesResp.Items))
+	for _, svc := range servicesResp.Items {
+		services[protoKey(svc.GetSelfRef())] = svc
+	}
+	groups := make(map[string]*netguardpb.AddressGroup, len(groupsResp.Items))
+	for _, ag := range groupsResp.Items {
+		groups[protoKey(ag.GetSelfRef())] = ag
+	}
+
+	c.mu.Lock()
+	c.services = services
+	c.addressGroups = groups
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *WatchCache) apply(event *netguardpb.WatchEvent) {
+	c.revision.Store(event.GetRevision())
+	deleted := event.GetSyncOp() == netguardpb.SyncOp_Delete
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Bookmark (событие без ресурса) только обновляет ревизию
+	switch resource := event.GetResource().(type) {
+	case *netguardpb.WatchEvent_Service:
+		key := protoKey(resource.Service.GetSelfRef())
+		if deleted {
+			delete(c.services, key)
+		} else {
+			c.services[key] = resource.Service
+		}
+	case *netguardpb.WatchEvent_AddressGroup:
+		key := protoKey(resource.AddressGroup.GetSelfRef())
+		if deleted {
+			delete(c.addressGroups, key)
+		} else {
+			c.addressGroups[key] = resource.AddressGroup
+		}
+	}
+}
+
+// Synced возвращает true, если кэш прошел relist и получает события
+func (c *WatchCache) Synced() bool {
+	return c != nil && c.synced.Load()
+}
+
+// GetService возвращает Service из кэша. ok=false, если кэш не
+// синхронизирован или объекта в нем нет.
+func (c *WatchCache) GetService(id models.ResourceIdentifier) (*models.Service, bool) {
+	if !c.Synced() {
+		return nil, false
+	}
+	c.mu.RLock()
+	svc, found := c.services[id.Key()]
+	c.mu.RUnlock()
+	if !found {
+		return nil, false
+	}
+	service := convertServiceFromProto(svc)
+	return &service, true
+}
+
+// GetAddressGroup возвращает AddressGroup из кэша. ok=false, если кэш не
+// синхронизирован или объекта в нем нет.
+func (c *WatchCache) GetAddressGroup(id models.ResourceIdentifier) (*models.AddressGroup, bool) {
+	if !c.Synced() {
+		return nil, false
+	}
+	c.mu.RLock()
+	ag, found := c.addressGroups[id.Key()]
+	c.mu.RUnlock()
+	if !found {
+		return nil, false
+	}
+	addressGroup := convertAddressGroupFromProto(ag)
+	return &addressGroup, true
+}
+
+func (c *WatchCache) counts() (int, int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.services), len(c.addressGroups)
+}
+
+func protoKey(id *netguardpb.ResourceIdentifier) string {
+	return models.NewResourceIdentifier(id.GetName(), models.WithNamespace(id.GetNamespace())).Key()
+}
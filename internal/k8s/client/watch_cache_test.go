@@ -0,0 +1,57 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+
+	netguardpb "netguard-pg-backend/protos/pkg/api/netguard"
+)
+
+func TestWatchCache_ApplyEvents(t *testing.T) {
+	cache := NewWatchCache(nil, 0)
+	id := models.NewResourceIdentifier("web", models.WithNamespace("default"))
+
+	// Несинхронизированный кэш не должен отдавать данные
+	cache.apply(&netguardpb.WatchEvent{
+		SyncOp:   netguardpb.SyncOp_Upsert,
+		Revision: 1,
+		Resource: &netguardpb.WatchEvent_Service{Service: &netguardpb.Service{
+			SelfRef:     &netguardpb.ResourceIdentifier{Name: "web", Namespace: "default"},
+			Description: "v1",
+		}},
+	})
+	_, ok := cache.GetService(id)
+	assert.False(t, ok, "unsynced cache must not serve reads")
+
+	cache.synced.Store(true)
+	service, ok := cache.GetService(id)
+	require.True(t, ok)
+	assert.Equal(t, "v1", service.Description)
+
+	// Изменения вызывающего кода не должны попадать в кэш
+	service.Description = "mutated"
+	service, ok = cache.GetService(id)
+	require.True(t, ok)
+	assert.Equal(t, "v1", service.Description)
+
+	cache.apply(&netguardpb.WatchEvent{
+		SyncOp:   netguardpb.SyncOp_Delete,
+		Revision: 2,
+		Resource: &netguardpb.WatchEvent_Service{Service: &netguardpb.Service{
+			SelfRef: &netguardpb.ResourceIdentifier{Name: "web", Namespace: "default"},
+		}},
+	})
+	_, ok = cache.GetService(id)
+	assert.False(t, ok)
+	assert.Equal(t, uint64(2), cache.revision.Load())
+}
+
+func TestWatchCache_NilCacheIsDisabled(t *testing.T) {
+	var cache *WatchCache
+	_, ok := cache.GetAddressGroup(models.NewResourceIdentifier("ag", models.WithNamespace("default")))
+	assert.False(t, ok)
+}
@@ -14,6 +14,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 )
 
@@ -63,6 +64,9 @@ func (s *AddressGroupStorage) ConvertToTable(ctx context.Context, object runtime
 			{Name: "Default Action", Type: "string"},
 			{Name: "Logs", Type: "boolean"},
 			{Name: "Trace", Type: "boolean"},
+			{Name: "Hosts", Type: "integer", Priority: 1},
+			{Name: "SGroup", Type: "string", Priority: 1},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -70,7 +74,16 @@ func (s *AddressGroupStorage) ConvertToTable(ctx context.Context, object runtime
 	addRow := func(ag *netguardv1beta1.AddressGroup) {
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: ag},
-			Cells:  []interface{}{ag.Name, ag.Spec.DefaultAction, ag.Spec.Logs, ag.Spec.Trace, translateTimestampSince(ag.CreationTimestamp)},
+			Cells: []interface{}{
+				ag.Name,
+				string(ag.Spec.DefaultAction),
+				ag.Spec.Logs,
+				ag.Spec.Trace,
+				len(ag.Spec.Hosts),
+				ag.Status.AddressGroupName,
+				utils.ReadyStatus(ag.Status.Conditions),
+				translateTimestampSince(ag.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -82,6 +95,7 @@ func (s *AddressGroupStorage) ConvertToTable(ctx context.Context, object runtime
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
@@ -14,6 +14,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 )
 
@@ -60,22 +61,21 @@ func (s *AddressGroupBindingStorage) ConvertToTable(ctx context.Context, object
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Service", Type: "string"},
 			{Name: "AddressGroup", Type: "string"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
 
 	addRow := func(binding *netguardv1beta1.AddressGroupBinding) {
-		service := "unknown"
-		if binding.Spec.ServiceRef.Name != "" {
-			service = binding.Spec.ServiceRef.Name
-		}
-		addressGroup := "unknown"
-		if binding.Spec.AddressGroupRef.Name != "" {
-			addressGroup = binding.Spec.AddressGroupRef.Name
-		}
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: binding},
-			Cells:  []interface{}{binding.Name, service, addressGroup, translateTimestampSince(binding.CreationTimestamp)},
+			Cells: []interface{}{
+				binding.Name,
+				utils.RefName(binding.Spec.ServiceRef.Name, binding.Spec.ServiceRef.Namespace, binding.Namespace),
+				utils.RefName(binding.Spec.AddressGroupRef.Name, binding.Spec.AddressGroupRef.Namespace, binding.Namespace),
+				utils.ReadyStatus(binding.Status.Conditions),
+				translateTimestampSince(binding.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -87,6 +87,7 @@ func (s *AddressGroupBindingStorage) ConvertToTable(ctx context.Context, object
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
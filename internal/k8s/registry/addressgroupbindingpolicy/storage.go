@@ -14,6 +14,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 )
 
@@ -58,6 +59,9 @@ func (s *AddressGroupBindingPolicyStorage) ConvertToTable(ctx context.Context, o
 	table := &metav1.Table{
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Service", Type: "string"},
+			{Name: "AddressGroup", Type: "string"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -65,7 +69,13 @@ func (s *AddressGroupBindingPolicyStorage) ConvertToTable(ctx context.Context, o
 	addRow := func(policy *netguardv1beta1.AddressGroupBindingPolicy) {
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: policy},
-			Cells:  []interface{}{policy.Name, translateTimestampSince(policy.CreationTimestamp)},
+			Cells: []interface{}{
+				policy.Name,
+				utils.RefName(policy.Spec.ServiceRef.Name, policy.Spec.ServiceRef.Namespace, policy.Namespace),
+				utils.RefName(policy.Spec.AddressGroupRef.Name, policy.Spec.AddressGroupRef.Namespace, policy.Namespace),
+				utils.ReadyStatus(policy.Status.Conditions),
+				translateTimestampSince(policy.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -77,6 +87,7 @@ func (s *AddressGroupBindingPolicyStorage) ConvertToTable(ctx context.Context, o
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
@@ -14,6 +14,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 )
 
@@ -58,6 +59,8 @@ func (s *AddressGroupPortMappingStorage) ConvertToTable(ctx context.Context, obj
 	table := &metav1.Table{
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Services", Type: "integer"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -65,7 +68,12 @@ func (s *AddressGroupPortMappingStorage) ConvertToTable(ctx context.Context, obj
 	addRow := func(mapping *netguardv1beta1.AddressGroupPortMapping) {
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: mapping},
-			Cells:  []interface{}{mapping.Name, translateTimestampSince(mapping.CreationTimestamp)},
+			Cells: []interface{}{
+				mapping.Name,
+				len(mapping.AccessPorts.Items),
+				utils.ReadyStatus(mapping.Status.Conditions),
+				translateTimestampSince(mapping.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -77,6 +85,7 @@ func (s *AddressGroupPortMappingStorage) ConvertToTable(ctx context.Context, obj
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
@@ -0,0 +1,368 @@
+package base
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/client"
+	"netguard-pg-backend/internal/k8s/registry/utils"
+)
+
+const (
+	// backendWatchBuffer is the number of events buffered per watcher
+	backendWatchBuffer = 100
+	// revisionTrackerRetry is the delay before the revision tracker reopens a failed stream
+	revisionTrackerRetry = time.Second
+)
+
+// backendWatch serves watches of a storage from the change feed of the backend
+type backendWatch struct {
+	backend client.BackendClient
+	kind    string
+
+	// revision is the latest feed revision seen by the tracker, valid once synced is set
+	revision atomic.Uint64
+	synced   atomic.Bool
+}
+
+// WatchFromBackend serves Watch from the backend change feed of kind instead of the local
+// broadcaster, so changes made through the backend or another apiserver replica reach watchers
+// and resourceVersion is the feed revision. Watches fall back to the local broadcaster when the
+// backend doesn't implement the Watch RPC.
+func (s *BaseStorage[K, D]) WatchFromBackend(backend client.BackendClient, kind string) {
+	s.backendWatch = &backendWatch{backend: backend, kind: kind}
+	go s.backendWatch.trackRevision(context.Background())
+}
+
+// trackRevision follows the change feed to stamp lists with the current feed revision,
+// a list stamped with the revision can be resumed by a watch without missing changes
+func (b *backendWatch) trackRevision(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := b.followRevision(ctx)
+		b.synced.Store(false)
+		if stderrors.Is(err, client.ErrWatchNotSupported) {
+			klog.InfoS("Backend doesn't support watch, lists are served without resourceVersion", "kind", b.kind)
+			return
+		}
+		klog.V(2).InfoS("Revision tracker stream closed, reopening", "kind", b.kind, "error", err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(revisionTrackerRetry):
+		}
+	}
+}
+
+func (b *backendWatch) followRevision(ctx context.Context) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := b.backend.WatchChanges(streamCtx, []string{b.kind}, 0)
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		b.revision.Store(event.Revision)
+		if event.Resource == nil {
+			b.synced.Store(true)
+		}
+	}
+}
+
+// listResourceVersion returns the feed revision for list metadata, empty until the tracker is synced
+func (b *backendWatch) listResourceVersion() string {
+	if b == nil || !b.synced.Load() {
+		return ""
+	}
+	return strconv.FormatUint(b.revision.Load(), 10)
+}
+
+// watchFromBackend opens a watch on the backend change feed.
+// client.ErrWatchNotSupported means the caller should use the local broadcaster.
+func (s *BaseStorage[K, D]) watchFromBackend(ctx context.Context, options *internalversion.ListOptions) (watch.Interface, error) {
+	var (
+		since       uint64
+		sendInitial bool
+		bookmarks   bool
+		labelSel    = labels.Everything()
+		fieldSel    = fields.Everything()
+	)
+	if options != nil {
+		switch options.ResourceVersion {
+		case "":
+		case "0":
+			sendInitial = true
+		default:
+			rv, err := strconv.ParseUint(options.ResourceVersion, 10, 64)
+			if err != nil {
+				return nil, errors.NewBadRequest(fmt.Sprintf("invalid resourceVersion %q: %v", options.ResourceVersion, err))
+			}
+			since = rv
+		}
+		bookmarks = options.AllowWatchBookmarks
+		if options.LabelSelector != nil {
+			labelSel = options.LabelSelector
+		}
+		if options.FieldSelector != nil {
+			fieldSel = options.FieldSelector
+		}
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream, err := s.backendWatch.backend.WatchChanges(streamCtx, []string{s.backendWatch.kind}, since)
+	if err != nil {
+		cancel()
+		return nil, s.watchStreamError(err, options)
+	}
+	// The first event (replayed change or bookmark) reports whether the revision is still available
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, s.watchStreamError(err, options)
+	}
+
+	w := &feedWatcher[K, D]{
+		storage:   s,
+		namespace: utils.NamespaceFrom(ctx),
+		labels:    labelSel,
+		fields:    fieldSel,
+		bookmarks: bookmarks,
+		known:     make(map[string]runtime.Object),
+		result:    make(chan watch.Event, backendWatchBuffer),
+	}
+	w.proxy = watch.NewProxyWatcher(w.result)
+
+	// Objects present before the watch are reported as modified, new ones as added
+	current, err := s.listFromBackend(streamCtx, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	initial := make([]runtime.Object, 0, len(current))
+	for _, obj := range current {
+		k8sObj, err := s.converter.FromDomain(streamCtx, obj)
+		if err != nil {
+			klog.V(2).InfoS("Failed to convert object for watch", "resource", s.resourceName, "error", err)
+			continue
+		}
+		w.known[objectKey(k8sObj)] = k8sObj
+		if sendInitial && w.matches(k8sObj) {
+			initial = append(initial, k8sObj.DeepCopyObject())
+		}
+	}
+
+	go func() {
+		<-w.proxy.StopChan()
+		cancel()
+	}()
+	go w.run(streamCtx, stream, first, initial)
+
+	klog.V(2).InfoS("Opened backend watch",
+		"resource", s.resourceName,
+		"resourceVersion", since,
+		"namespace", w.namespace)
+	return w.proxy, nil
+}
+
+// watchStreamError converts a change feed error into the API error returned to the watcher
+func (s *BaseStorage[K, D]) watchStreamError(err error, options *internalversion.ListOptions) error {
+	switch {
+	case stderrors.Is(err, client.ErrWatchNotSupported):
+		return err
+	case stderrors.Is(err, ports.ErrRevisionCompacted):
+		return errors.NewResourceExpired(fmt.Sprintf("too old resource version: %s", options.ResourceVersion))
+	default:
+		return errors.NewInternalError(err)
+	}
+}
+
+// feedWatcher translates change feed events of one watch into watch events
+type feedWatcher[K runtime.Object, D any] struct {
+	storage   *BaseStorage[K, D]
+	namespace string
+	labels    labels.Selector
+	fields    fields.Selector
+	bookmarks bool
+
+	// known holds the last state of the objects seen by the watch to tell added objects
+	// from modified ones and to report deleted objects with their last state
+	known map[string]runtime.Object
+
+	result chan watch.Event
+	proxy  *watch.ProxyWatcher
+}
+
+func (w *feedWatcher[K, D]) run(ctx context.Context, stream client.ChangeStream, first models.ChangeEvent, initial []runtime.Object) {
+	defer close(w.result)
+
+	event := first
+	if first.Resource == nil && len(initial) > 0 {
+		// Synthetic ADDED events for resourceVersion=0 carry the revision of the bookmark
+		rv := strconv.FormatUint(first.Revision, 10)
+		for _, obj := range initial {
+			setResourceVersion(obj, rv)
+			if !w.send(watch.Event{Type: watch.Added, Object: obj}) {
+				return
+			}
+		}
+	}
+
+	for {
+		if !w.handle(ctx, event) {
+			return
+		}
+
+		var err error
+		event, err = stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			klog.V(2).InfoS("Backend watch stream closed", "resource", w.storage.resourceName, "error", err)
+			status := errors.NewInternalError(err).Status()
+			if stderrors.Is(err, ports.ErrRevisionCompacted) {
+				status = errors.NewResourceExpired(err.Error()).Status()
+			}
+			w.send(watch.Event{Type: watch.Error, Object: &status})
+			return
+		}
+	}
+}
+
+// handle sends the watch event of a change feed event, false stops the watch
+func (w *feedWatcher[K, D]) handle(ctx context.Context, event models.ChangeEvent) bool {
+	rv := strconv.FormatUint(event.Revision, 10)
+
+	if event.Resource == nil {
+		if !w.bookmarks {
+			return true
+		}
+		obj := w.storage.NewFunc()
+		setResourceVersion(obj, rv)
+		return w.send(watch.Event{Type: watch.Bookmark, Object: obj})
+	}
+
+	domainObj, ok := resourceAs[D](event.Resource)
+	if !ok {
+		klog.V(2).InfoS("Unexpected change feed resource",
+			"resource", w.storage.resourceName,
+			"type", fmt.Sprintf("%T", event.Resource))
+		return true
+	}
+	converted, err := w.storage.converter.FromDomain(ctx, domainObj)
+	if err != nil {
+		klog.V(2).InfoS("Failed to convert change feed resource", "resource", w.storage.resourceName, "error", err)
+		return true
+	}
+	var obj runtime.Object = converted
+	key := objectKey(obj)
+
+	eventType := watch.Modified
+	if event.SyncOp == models.SyncOpDelete {
+		eventType = watch.Deleted
+		// Delete events carry only the identifier, report the last known state
+		if last, ok := w.known[key]; ok {
+			obj = last.DeepCopyObject()
+		}
+		delete(w.known, key)
+	} else {
+		if _, ok := w.known[key]; !ok {
+			eventType = watch.Added
+		}
+		w.known[key] = obj
+		obj = obj.DeepCopyObject()
+	}
+	setResourceVersion(obj, rv)
+
+	if !w.matches(obj) {
+		return true
+	}
+	return w.send(watch.Event{Type: eventType, Object: obj})
+}
+
+func (w *feedWatcher[K, D]) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.proxy.StopChan():
+		return false
+	}
+}
+
+// matches applies the namespace of the request and the label and field selectors
+func (w *feedWatcher[K, D]) matches(obj runtime.Object) bool {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	if w.namespace != "" && accessor.GetNamespace() != w.namespace {
+		return false
+	}
+	if !w.labels.Matches(labels.Set(accessor.GetLabels())) {
+		return false
+	}
+	return w.fields.Matches(fields.Set{
+		"metadata.name":      accessor.GetName(),
+		"metadata.namespace": accessor.GetNamespace(),
+	})
+}
+
+// resourceAs converts a change feed resource (a domain value) into the domain type of the storage
+func resourceAs[D any](resource interface{}) (D, bool) {
+	var zero D
+	if d, ok := resource.(D); ok {
+		return d, true
+	}
+	target := reflect.TypeOf((*D)(nil)).Elem()
+	value := reflect.ValueOf(resource)
+	if target.Kind() != reflect.Ptr || value.Type() != target.Elem() {
+		return zero, false
+	}
+	ptr := reflect.New(value.Type())
+	ptr.Elem().Set(value)
+	return ptr.Interface().(D), true
+}
+
+func objectKey(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetNamespace() + "/" + accessor.GetName()
+}
+
+func setResourceVersion(obj runtime.Object, rv string) {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetResourceVersion(rv)
+	}
+}
+
+// stampListResourceVersion sets the feed revision on a list served by a backend-watched storage
+func stampListResourceVersion(list runtime.Object, rv string) {
+	if rv == "" {
+		return
+	}
+	if accessor, err := meta.ListAccessor(list); err == nil {
+		accessor.SetResourceVersion(rv)
+	}
+}
@@ -0,0 +1,151 @@
+package base
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/client"
+)
+
+// fakeFeed is a backend whose change feed replays the given events
+type fakeFeed struct {
+	client.BackendClient
+
+	events []models.ChangeEvent
+	err    error
+	since  uint64
+}
+
+func (f *fakeFeed) WatchChanges(ctx context.Context, kinds []string, sinceRevision uint64) (client.ChangeStream, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.since = sinceRevision
+	return &fakeChangeStream{ctx: ctx, events: f.events}, nil
+}
+
+type fakeChangeStream struct {
+	ctx    context.Context
+	events []models.ChangeEvent
+}
+
+func (s *fakeChangeStream) Recv() (models.ChangeEvent, error) {
+	if len(s.events) == 0 {
+		<-s.ctx.Done()
+		return models.ChangeEvent{}, io.EOF
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+func nextEvent(t *testing.T, w watch.Interface) watch.Event {
+	t.Helper()
+	select {
+	case event := <-w.ResultChan():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no watch event")
+		return watch.Event{}
+	}
+}
+
+func TestBaseStorage_Watch_FromBackendFeed(t *testing.T) {
+	storage := createTestStorage()
+	storage.backendOps = &MockBackendOperations{
+		listFunc: func(ctx context.Context, scope ports.Scope) ([]*MockDomain, error) {
+			return []*MockDomain{{Namespace: "test-ns", Name: "existing", Value: "old"}}, nil
+		},
+	}
+	feed := &fakeFeed{events: []models.ChangeEvent{
+		{Revision: 6, SyncOp: models.SyncOpUpsert, Resource: MockDomain{Namespace: "test-ns", Name: "existing", Value: "new"}},
+		{Revision: 7, SyncOp: models.SyncOpUpsert, Resource: MockDomain{Namespace: "test-ns", Name: "created"}},
+		{Revision: 8, SyncOp: models.SyncOpUpsert, Resource: MockDomain{Namespace: "other-ns", Name: "foreign"}},
+		{Revision: 9, SyncOp: models.SyncOpDelete, Resource: MockDomain{Namespace: "test-ns", Name: "existing"}},
+		{Revision: 9},
+	}}
+	storage.backendWatch = &backendWatch{backend: feed, kind: "Mock"}
+
+	w, err := storage.Watch(createTestContext("test-ns"), &internalversion.ListOptions{ResourceVersion: "5", AllowWatchBookmarks: true})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer w.Stop()
+	if feed.since != 5 {
+		t.Errorf("expected the feed to be resumed from 5, got %d", feed.since)
+	}
+
+	for _, expected := range []struct {
+		eventType watch.EventType
+		name      string
+		value     string
+		rv        string
+	}{
+		{watch.Modified, "existing", "new", "6"},
+		{watch.Added, "created", "", "7"},
+		{watch.Deleted, "existing", "new", "9"},
+		{watch.Bookmark, "", "", "9"},
+	} {
+		event := nextEvent(t, w)
+		obj, ok := event.Object.(*MockK8sObject)
+		if !ok {
+			t.Fatalf("expected *MockK8sObject, got %T", event.Object)
+		}
+		if event.Type != expected.eventType || obj.Name != expected.name || obj.Spec.Value != expected.value || obj.ResourceVersion != expected.rv {
+			t.Errorf("expected %s %s=%q at %s, got %s %s=%q at %s",
+				expected.eventType, expected.name, expected.value, expected.rv,
+				event.Type, obj.Name, obj.Spec.Value, obj.ResourceVersion)
+		}
+	}
+}
+
+func TestBaseStorage_Watch_InitialEventsForResourceVersionZero(t *testing.T) {
+	storage := createTestStorage()
+	storage.backendOps = &MockBackendOperations{
+		listFunc: func(ctx context.Context, scope ports.Scope) ([]*MockDomain, error) {
+			return []*MockDomain{{Namespace: "test-ns", Name: "existing"}}, nil
+		},
+	}
+	storage.backendWatch = &backendWatch{backend: &fakeFeed{events: []models.ChangeEvent{{Revision: 3}}}, kind: "Mock"}
+
+	w, err := storage.Watch(createTestContext("test-ns"), &internalversion.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	defer w.Stop()
+
+	event := nextEvent(t, w)
+	accessor, err := meta.Accessor(event.Object)
+	if err != nil {
+		t.Fatalf("Accessor: %v", err)
+	}
+	if event.Type != watch.Added || accessor.GetName() != "existing" || accessor.GetResourceVersion() != "3" {
+		t.Errorf("expected ADDED existing at 3, got %s %s at %s", event.Type, accessor.GetName(), accessor.GetResourceVersion())
+	}
+}
+
+func TestBaseStorage_Watch_BackendErrors(t *testing.T) {
+	storage := createTestStorage()
+
+	storage.backendWatch = &backendWatch{backend: &fakeFeed{err: ports.ErrRevisionCompacted}, kind: "Mock"}
+	_, err := storage.Watch(createTestContext("test-ns"), &internalversion.ListOptions{ResourceVersion: "1"})
+	if !errors.IsResourceExpired(err) {
+		t.Errorf("expected a resource expired error for a compacted revision, got %v", err)
+	}
+
+	storage.backendWatch = &backendWatch{backend: &fakeFeed{err: client.ErrWatchNotSupported}, kind: "Mock"}
+	w, err := storage.Watch(createTestContext("test-ns"), &internalversion.ListOptions{})
+	if err != nil {
+		t.Fatalf("expected a fallback to the local broadcaster, got %v", err)
+	}
+	w.Stop()
+}
@@ -0,0 +1,114 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// initDeletionState prepares a created object for finalizer-based deletion: the deletion timestamp
+// is set only by Delete, the backend finalizer keeps the object until the backend deletion completes
+func initDeletionState(obj runtime.Object) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	accessor.SetDeletionTimestamp(nil)
+	for _, finalizer := range accessor.GetFinalizers() {
+		if finalizer == models.BackendSyncFinalizer {
+			return
+		}
+	}
+	accessor.SetFinalizers(append(accessor.GetFinalizers(), models.BackendSyncFinalizer))
+}
+
+// copyDeletionState keeps the deletion timestamp of the stored object src in the updated object dst.
+// Finalizers of an object being deleted can only be removed.
+func copyDeletionState(dst, src runtime.Object) field.ErrorList {
+	dstAccessor, err := meta.Accessor(dst)
+	if err != nil {
+		return nil
+	}
+	srcAccessor, err := meta.Accessor(src)
+	if err != nil {
+		return nil
+	}
+
+	dstAccessor.SetDeletionTimestamp(srcAccessor.GetDeletionTimestamp())
+	if srcAccessor.GetDeletionTimestamp() == nil {
+		return nil
+	}
+
+	stored := make(map[string]bool, len(srcAccessor.GetFinalizers()))
+	for _, finalizer := range srcAccessor.GetFinalizers() {
+		stored[finalizer] = true
+	}
+	var errs field.ErrorList
+	finalizersPath := field.NewPath("metadata", "finalizers")
+	for i, finalizer := range dstAccessor.GetFinalizers() {
+		if !stored[finalizer] {
+			errs = append(errs, field.Forbidden(finalizersPath.Index(i), fmt.Sprintf("no new finalizers can be added if the object is being deleted, found new finalizer %q", finalizer)))
+		}
+	}
+	return errs
+}
+
+// markDeleting records the deletion intent of an object with finalizers in the backend.
+// The backend deletes the object once only models.BackendSyncFinalizer is left and the cascade
+// cleanup and the sgroups deletion complete. The second return value reports whether the object
+// is already gone.
+func (s *BaseStorage[K, D]) markDeleting(ctx context.Context, namespace, name string, k8sObj K) (runtime.Object, bool, error) {
+	accessor, err := meta.Accessor(k8sObj)
+	if err != nil {
+		return nil, false, err
+	}
+	if accessor.GetDeletionTimestamp() == nil {
+		now := metav1.Now()
+		accessor.SetDeletionTimestamp(&now)
+	}
+
+	domainObj, err := s.converter.ToDomain(ctx, k8sObj)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to convert k8s object to domain object: %w", err)
+	}
+	if _, err := s.updateInBackend(ctx, &domainObj); err != nil {
+		return nil, false, err
+	}
+
+	if s.broadcastUpdate(ctx, namespace, name, k8sObj) {
+		klog.InfoS("🎉 DELETE: Object deleted by the backend after finalization",
+			"resource", s.resourceName,
+			"name", name,
+			"namespace", namespace)
+		return k8sObj, true, nil
+	}
+	klog.InfoS("⏳ DELETE: Object marked for deletion, waiting for finalizers",
+		"resource", s.resourceName,
+		"name", name,
+		"namespace", namespace,
+		"finalizers", accessor.GetFinalizers())
+	return k8sObj, false, nil
+}
+
+// broadcastUpdate broadcasts an updated object. An object being deleted may be removed by the backend
+// with the update that released its last finalizer, a Deleted event is broadcast then.
+// The return value reports whether the object is gone.
+func (s *BaseStorage[K, D]) broadcastUpdate(ctx context.Context, namespace, name string, obj runtime.Object) bool {
+	if accessor, err := meta.Accessor(obj); err == nil && accessor.GetDeletionTimestamp() != nil {
+		id := models.NewResourceIdentifier(name, models.WithNamespace(namespace))
+		if _, err := s.backendOps.Get(ctx, id); isNotFoundError(err) {
+			s.broadcastWatchEvent(watch.Deleted, obj)
+			return true
+		}
+	}
+	s.broadcastWatchEvent(watch.Modified, obj)
+	return false
+}
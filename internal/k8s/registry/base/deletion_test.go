@@ -0,0 +1,59 @@
+package base
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+func TestBaseStorage_Delete_MarksObjectsWithFinalizers(t *testing.T) {
+	storage := createTestStorage()
+	stored := captureToDomain(storage)
+	storage.converter.(*MockConverter).fromDomainFunc = func(ctx context.Context, domainObj *MockDomain) (*MockK8sObject, error) {
+		return &MockK8sObject{ObjectMeta: metav1.ObjectMeta{
+			Name:       domainObj.Name,
+			Namespace:  domainObj.Namespace,
+			Finalizers: []string{models.BackendSyncFinalizer},
+		}}, nil
+	}
+	deleted := false
+	storage.backendOps.(*MockBackendOperations).deleteFunc = func(context.Context, models.ResourceIdentifier) error {
+		deleted = true
+		return nil
+	}
+
+	_, gone, err := storage.Delete(createTestContext("test-ns"), "test-name", nil, &metav1.DeleteOptions{})
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if gone || deleted {
+		t.Errorf("expected the object to be kept until the backend finalizes it")
+	}
+	if stored.DeletionTimestamp == nil {
+		t.Errorf("expected the deletion intent to be stored")
+	}
+}
+
+func TestCopyDeletionState(t *testing.T) {
+	now := metav1.Now()
+	current := &MockK8sObject{ObjectMeta: metav1.ObjectMeta{
+		DeletionTimestamp: &now,
+		Finalizers:        []string{models.BackendSyncFinalizer, "example.com/cleanup"},
+	}}
+
+	updated := &MockK8sObject{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{models.BackendSyncFinalizer}}}
+	if errs := copyDeletionState(updated, current); len(errs) > 0 {
+		t.Fatalf("expected finalizers to be removable, got %v", errs)
+	}
+	if updated.DeletionTimestamp == nil {
+		t.Errorf("expected the stored deletion timestamp to be kept")
+	}
+
+	updated = &MockK8sObject{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{models.BackendSyncFinalizer, "example.com/other"}}}
+	if errs := copyDeletionState(updated, current); len(errs) != 1 {
+		t.Errorf("expected new finalizers of a deleting object to be forbidden, got %v", errs)
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"net/http"
 	"strings"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // StandardErrorConverter provides standard backend error → K8s error conversions
@@ -81,6 +85,42 @@ func (c *StandardErrorConverter) ConvertValidationError(err error, resourceName
 	return apierrors.NewBadRequest(fmt.Sprintf("Invalid %s %s field %s: %s (value: %v)", c.groupResource.Resource, resourceName, field, err.Error(), badValue))
 }
 
+// ConvertFieldViolations converts InvalidArgument backend errors carrying field violations
+// (e.g. changed immutable fields) to Kubernetes Invalid errors, other errors are returned as is
+func ConvertFieldViolations(err error, groupKind schema.GroupKind, name string) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return err
+	}
+
+	var errs field.ErrorList
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			errs = append(errs, field.Forbidden(fieldViolationPath(violation.GetField()), violation.GetDescription()))
+		}
+	}
+	if len(errs) == 0 {
+		return err
+	}
+	return apierrors.NewInvalid(groupKind, name, errs)
+}
+
+// fieldViolationPath maps backend field paths to object paths: name and namespace
+// belong to metadata, everything else to spec
+func fieldViolationPath(path string) *field.Path {
+	switch path {
+	case "name", "namespace":
+		return field.NewPath("metadata", path)
+	case "":
+		return field.NewPath("spec")
+	}
+	return field.NewPath("spec").Child(path)
+}
+
 // ConvertRequiredError converts missing required field errors to Kubernetes required field errors
 func (c *StandardErrorConverter) ConvertRequiredError(resourceName string, field string) error {
 	return apierrors.NewBadRequest(fmt.Sprintf("Required field %s is missing for %s %s", field, c.groupResource.Resource, resourceName))
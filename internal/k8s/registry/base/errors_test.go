@@ -2,11 +2,15 @@ package base
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -418,3 +422,29 @@ func TestStandardErrorConverters_Constants(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertFieldViolations(t *testing.T) {
+	groupKind := schema.GroupKind{Group: "netguard.sgroups.io", Kind: "AddressGroupBinding"}
+
+	st, err := status.New(codes.InvalidArgument, "cannot change serviceRef").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "serviceRef.name", Description: `cannot change serviceRef: serviceRef.name: "web" -> "api"`},
+			{Field: "namespace", Description: "name and namespace cannot be changed"},
+		},
+	})
+	require.NoError(t, err)
+
+	converted := ConvertFieldViolations(fmt.Errorf("failed to sync: %w", st.Err()), groupKind, "binding")
+	require.True(t, apierrors.IsInvalid(converted))
+
+	statusErr := converted.(*apierrors.StatusError)
+	causes := statusErr.ErrStatus.Details.Causes
+	require.Len(t, causes, 2)
+	assert.Equal(t, "spec.serviceRef.name", causes[0].Field)
+	assert.Equal(t, "metadata.namespace", causes[1].Field)
+
+	plain := status.Error(codes.InvalidArgument, "invalid")
+	assert.Equal(t, plain, ConvertFieldViolations(plain, groupKind, "binding"))
+	other := errors.New("backend unavailable")
+	assert.Equal(t, other, ConvertFieldViolations(other, groupKind, "binding"))
+}
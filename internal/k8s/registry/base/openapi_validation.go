@@ -0,0 +1,164 @@
+package base
+
+import (
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kube-openapi/pkg/common"
+	openapierrors "k8s.io/kube-openapi/pkg/validation/errors"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// OpenAPIReference is the reference callback of OpenAPI definitions validated by the storage,
+// definitions reference each other by the definition name
+func OpenAPIReference(name string) spec.Ref {
+	return spec.MustCreateRef(name)
+}
+
+// OpenAPISchema validates the spec of objects against the OpenAPI schema of their kind.
+// The generic apiserver validates objects of aggregated APIs only by the REST storage,
+// so the storage checks patterns, enums and ranges of the schema itself.
+type OpenAPISchema struct {
+	validator *validate.SchemaValidator
+}
+
+// NewOpenAPISchema returns the schema of the spec of definition, definitions built with
+// OpenAPIReference are inlined from defs
+func NewOpenAPISchema(defs map[string]common.OpenAPIDefinition, definition string) (*OpenAPISchema, error) {
+	def, ok := defs[definition]
+	if !ok {
+		return nil, fmt.Errorf("no OpenAPI definition %s", definition)
+	}
+	specSchema, ok := def.Schema.Properties["spec"]
+	if !ok {
+		return nil, fmt.Errorf("OpenAPI definition %s has no spec", definition)
+	}
+	schema := inlineReferences(specSchema, defs, map[string]bool{})
+	return &OpenAPISchema{validator: validate.NewSchemaValidator(&schema, nil, "spec", strfmt.Default)}, nil
+}
+
+// Validate validates the spec of obj
+func (o *OpenAPISchema) Validate(obj runtime.Object) field.ErrorList {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec"), err)}
+	}
+	specContent, ok := content["spec"]
+	if !ok {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	for _, err := range o.validator.Validate(specContent).Errors {
+		var validationErr *openapierrors.Validation
+		if !stderrors.As(err, &validationErr) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec"), nil, err.Error()))
+			continue
+		}
+		path := openAPIFieldPath(validationErr.Name)
+		switch validationErr.Code() {
+		case openapierrors.RequiredFailCode:
+			allErrs = append(allErrs, field.Required(path, ""))
+		case openapierrors.EnumFailCode:
+			values := make([]string, 0, len(validationErr.Values))
+			for _, value := range validationErr.Values {
+				values = append(values, fmt.Sprint(value))
+			}
+			allErrs = append(allErrs, field.NotSupported(path, validationErr.Value, values))
+		default:
+			detail := strings.TrimPrefix(validationErr.Error(), validationErr.Name+" in "+validationErr.In+" ")
+			allErrs = append(allErrs, field.Invalid(path, validationErr.Value, detail))
+		}
+	}
+	return allErrs
+}
+
+// inlineReferences replaces the references of schema with the referenced definitions,
+// references to unknown or recursive definitions accept any value
+func inlineReferences(schema spec.Schema, defs map[string]common.OpenAPIDefinition, visiting map[string]bool) spec.Schema {
+	if name := schema.Ref.String(); name != "" {
+		def, ok := defs[name]
+		if !ok || visiting[name] {
+			return spec.Schema{}
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+		return inlineReferences(def.Schema, defs, visiting)
+	}
+
+	if schema.Properties != nil {
+		properties := make(map[string]spec.Schema, len(schema.Properties))
+		for name, property := range schema.Properties {
+			properties[name] = inlineReferences(property, defs, visiting)
+		}
+		schema.Properties = properties
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		items := inlineReferences(*schema.Items.Schema, defs, visiting)
+		schema.Items = &spec.SchemaOrArray{Schema: &items}
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		additional := inlineReferences(*schema.AdditionalProperties.Schema, defs, visiting)
+		schema.AdditionalProperties = &spec.SchemaOrBool{Allows: true, Schema: &additional}
+	}
+	if schema.AllOf != nil {
+		allOf := make([]spec.Schema, len(schema.AllOf))
+		for i := range schema.AllOf {
+			allOf[i] = inlineReferences(schema.AllOf[i], defs, visiting)
+		}
+		schema.AllOf = allOf
+	}
+	return schema
+}
+
+// openAPIFieldPath converts a path of the schema validator ("spec.ingressPorts[0].port") to a field path
+func openAPIFieldPath(name string) *field.Path {
+	var path *field.Path
+	for _, part := range strings.Split(name, ".") {
+		index := -1
+		if open := strings.IndexByte(part, '['); open > 0 && strings.HasSuffix(part, "]") {
+			if i, err := strconv.Atoi(part[open+1 : len(part)-1]); err == nil {
+				part, index = part[:open], i
+			}
+		}
+		if path == nil {
+			path = field.NewPath(part)
+		} else {
+			path = path.Child(part)
+		}
+		if index >= 0 {
+			path = path.Index(index)
+		}
+	}
+	return path
+}
+
+// ValidateWithOpenAPI validates the spec of created and updated objects against the OpenAPI schema
+// of the kind from defs, before the validator and the admission webhooks run
+func (s *BaseStorage[K, D]) ValidateWithOpenAPI(defs map[string]common.OpenAPIDefinition) error {
+	objType := reflect.TypeOf(s.NewFunc())
+	if objType.Kind() == reflect.Pointer {
+		objType = objType.Elem()
+	}
+	schema, err := NewOpenAPISchema(defs, objType.PkgPath()+"."+objType.Name())
+	if err != nil {
+		return err
+	}
+	s.openAPISchema = schema
+	return nil
+}
+
+// validateOpenAPI validates obj against the OpenAPI schema of the kind, when it is set
+func (s *BaseStorage[K, D]) validateOpenAPI(obj K) field.ErrorList {
+	if s.openAPISchema == nil {
+		return nil
+	}
+	return s.openAPISchema.Validate(obj)
+}
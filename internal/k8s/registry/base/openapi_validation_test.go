@@ -0,0 +1,104 @@
+package base
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+const v1beta1Package = "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1."
+
+func newTestOpenAPISchema(t *testing.T, kind string) *OpenAPISchema {
+	t.Helper()
+	schema, err := NewOpenAPISchema(netguardv1beta1.GetOpenAPIDefinitionsWithEnums(OpenAPIReference), v1beta1Package+kind)
+	if err != nil {
+		t.Fatalf("NewOpenAPISchema(%s) failed: %v", kind, err)
+	}
+	return schema
+}
+
+func TestOpenAPISchema_ValidatesServicePorts(t *testing.T) {
+	schema := newTestOpenAPISchema(t, "Service")
+	service := &netguardv1beta1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: netguardv1beta1.ServiceSpec{
+			IngressPorts: []netguardv1beta1.IngressPort{
+				{Protocol: netguardv1beta1.ProtocolTCP, Port: "80, 8080-9090"},
+			},
+		},
+	}
+	if errs := schema.Validate(service); len(errs) != 0 {
+		t.Fatalf("Expected valid service, got %v", errs)
+	}
+
+	service.Spec.IngressPorts = append(service.Spec.IngressPorts,
+		netguardv1beta1.IngressPort{Protocol: netguardv1beta1.ProtocolUDP, Port: "abc"},
+		netguardv1beta1.IngressPort{Protocol: "ICMP", Port: "70000"},
+	)
+	errs := schema.Validate(service)
+	expected := map[string]field.ErrorType{
+		"spec.ingressPorts[1].port":     field.ErrorTypeInvalid,
+		"spec.ingressPorts[2].port":     field.ErrorTypeInvalid,
+		"spec.ingressPorts[2].protocol": field.ErrorTypeNotSupported,
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), errs)
+	}
+	for _, err := range errs {
+		if errType, ok := expected[err.Field]; !ok || errType != err.Type {
+			t.Errorf("Unexpected error %v", err)
+		}
+	}
+}
+
+func TestOpenAPISchema_ValidatesRuleS2SEnums(t *testing.T) {
+	schema := newTestOpenAPISchema(t, "RuleS2S")
+	rule := &netguardv1beta1.RuleS2S{
+		ObjectMeta: metav1.ObjectMeta{Name: "r1", Namespace: "default"},
+		Spec: netguardv1beta1.RuleS2SSpec{
+			Traffic:         "X",
+			ServiceLocalRef: netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "Service", Name: "a"}},
+			ServiceRef:      netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{APIVersion: "netguard.sgroups.io/v1beta1", Kind: "Service", Name: "b"}},
+			Action:          netguardv1beta1.ActionAccept,
+		},
+	}
+	errs := schema.Validate(rule)
+	if len(errs) != 1 || errs[0].Field != "spec.traffic" || errs[0].Type != field.ErrorTypeNotSupported {
+		t.Fatalf("Expected unsupported traffic, got %v", errs)
+	}
+
+	rule.Spec.Traffic = netguardv1beta1.INGRESS
+	if errs := schema.Validate(rule); len(errs) != 0 {
+		t.Fatalf("Expected valid rule, got %v", errs)
+	}
+}
+
+func TestOpenAPISchema_EmptySpecsMatchSchemaTypes(t *testing.T) {
+	objects := map[string]runtime.Object{
+		"AddressGroup":              &netguardv1beta1.AddressGroup{},
+		"AddressGroupBinding":       &netguardv1beta1.AddressGroupBinding{},
+		"AddressGroupBindingPolicy": &netguardv1beta1.AddressGroupBindingPolicy{},
+		"AddressGroupPortMapping":   &netguardv1beta1.AddressGroupPortMapping{},
+		"Service":                   &netguardv1beta1.Service{},
+		"ServiceAlias":              &netguardv1beta1.ServiceAlias{},
+		"RuleS2S":                   &netguardv1beta1.RuleS2S{},
+		"IEAgAgRule":                &netguardv1beta1.IEAgAgRule{},
+		"Network":                   &netguardv1beta1.Network{},
+		"NetworkBinding":            &netguardv1beta1.NetworkBinding{},
+		"Host":                      &netguardv1beta1.Host{},
+		"HostBinding":               &netguardv1beta1.HostBinding{},
+	}
+	for kind, obj := range objects {
+		// Empty values may be rejected by enums and patterns, but never by the types of the schema
+		for _, err := range newTestOpenAPISchema(t, kind).Validate(obj) {
+			if strings.Contains(err.Detail, "must be of type") {
+				t.Errorf("%s: unexpected type error %v", kind, err)
+			}
+		}
+	}
+}
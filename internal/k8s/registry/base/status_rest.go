@@ -3,6 +3,7 @@ package base
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -80,7 +81,8 @@ func (s *StatusREST[K, D]) Get(ctx context.Context, name string, options *metav1
 	return k8sObj, nil
 }
 
-// Update updates the status of an existing resource
+// Update updates the status of an existing resource. Only the status is taken from the
+// request: spec and metadata stay as stored, so status writers can't change the spec.
 func (s *StatusREST[K, D]) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
 	namespace := utils.NamespaceFrom(ctx)
 
@@ -97,7 +99,7 @@ func (s *StatusREST[K, D]) Update(ctx context.Context, name string, objInfo rest
 	}
 
 	// Get the updated object
-	updatedObj, err := objInfo.UpdatedObject(ctx, currentK8sObj)
+	updatedObj, err := objInfo.UpdatedObject(ctx, currentK8sObj.DeepCopyObject())
 	if err != nil {
 		return nil, false, err
 	}
@@ -107,47 +109,14 @@ func (s *StatusREST[K, D]) Update(ctx context.Context, name string, objInfo rest
 		return nil, false, fmt.Errorf("expected %T, got %T", s.parent.NewFunc(), updatedObj)
 	}
 
-	// Validate the updated object (status update validation)
-	if errs := s.validator.ValidateUpdate(ctx, updatedK8sObj, currentK8sObj); len(errs) > 0 {
-		return nil, false, errors.NewInvalid(
-			schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName},
-			getObjectName(updatedK8sObj),
-			errs,
-		)
-	}
-
-	// Run additional validation if provided
-	if updateValidation != nil {
-		if err := updateValidation(ctx, updatedObj, currentK8sObj); err != nil {
-			return nil, false, err
-		}
-	}
-
-	// Convert to domain object
-	updatedDomainObj, err := s.converter.ToDomain(ctx, updatedK8sObj)
-	if err != nil {
-		return nil, false, fmt.Errorf("failed to convert updated k8s object to domain object: %w", err)
-	}
-
-	// Update status in backend
-	finalDomainObj, err := s.updateStatusInBackend(ctx, updatedDomainObj)
+	finalK8sObj, err := s.updateStatus(ctx, currentK8sObj, updatedK8sObj, updateValidation)
 	if err != nil {
 		return nil, false, err
 	}
-
-	// Convert back to Kubernetes object
-	finalK8sObj, err := s.converter.FromDomain(ctx, finalDomainObj)
-	if err != nil {
-		return nil, false, fmt.Errorf("failed to convert updated domain object to k8s object: %w", err)
-	}
-
-	// Broadcast watch event
-	s.broadcastWatchEvent(watch.Modified, finalK8sObj)
-
 	return finalK8sObj, false, nil
 }
 
-// Patch applies a patch to the status of a resource
+// Patch applies a patch to the status of a resource, changes outside of the status are ignored
 func (s *StatusREST[K, D]) Patch(ctx context.Context, name string, patchType types.PatchType, data []byte, options *metav1.PatchOptions, subresources ...string) (runtime.Object, error) {
 	namespace := utils.NamespaceFrom(ctx)
 	klog.V(1).InfoS("🚀 STATUS PATCH METHOD CALLED",
@@ -156,15 +125,15 @@ func (s *StatusREST[K, D]) Patch(ctx context.Context, name string, patchType typ
 		"namespace", namespace,
 		"patchType", string(patchType))
 
-	// For Server-Side Apply CREATE operations, delegate to parent storage
-	if patchType == types.ApplyPatchType {
-		klog.V(1).InfoS("🔍 STATUS: Server-Side Apply detected, delegating to parent storage", "name", name)
-		return s.parent.Patch(ctx, name, patchType, data, options, subresources...)
-	}
-
-	// Get the current object
+	// Get the current object, the status subresource never creates it
 	currentDomainObj, err := s.getFromBackend(ctx, namespace, name)
 	if err != nil {
+		if isNotFoundError(err) {
+			return nil, errors.NewNotFound(
+				schema.GroupResource{Group: "netguard.sgroups.io", Resource: s.resourceName},
+				name,
+			)
+		}
 		return nil, err
 	}
 
@@ -175,7 +144,7 @@ func (s *StatusREST[K, D]) Patch(ctx context.Context, name string, patchType typ
 	}
 
 	// Apply patch
-	patchedObj, err := s.applyPatch(currentK8sObj, patchType, data)
+	patchedObj, err := s.parent.patchObject(ctx, currentK8sObj.DeepCopyObject(), patchType, data, options)
 	if err != nil {
 		return nil, err
 	}
@@ -185,31 +154,51 @@ func (s *StatusREST[K, D]) Patch(ctx context.Context, name string, patchType typ
 		return nil, fmt.Errorf("expected %T, got %T", s.parent.NewFunc(), patchedObj)
 	}
 
-	// Validate the patched object
-	if errs := s.validator.ValidateUpdate(ctx, patchedK8sObj, currentK8sObj); len(errs) > 0 {
-		return nil, errors.NewInvalid(
+	return s.updateStatus(ctx, currentK8sObj, patchedK8sObj, nil)
+}
+
+// updateStatus stores the status of updated on top of current
+func (s *StatusREST[K, D]) updateStatus(ctx context.Context, current, updated K, updateValidation rest.ValidateObjectUpdateFunc) (K, error) {
+	var zero K
+
+	statusK8sObj, ok := current.DeepCopyObject().(K)
+	if !ok {
+		return zero, fmt.Errorf("expected %T, got %T", s.parent.NewFunc(), current.DeepCopyObject())
+	}
+	copyStatus(statusK8sObj, updated)
+
+	// Validate the updated object (status update validation)
+	if errs := s.validator.ValidateUpdate(ctx, statusK8sObj, current); len(errs) > 0 {
+		return zero, errors.NewInvalid(
 			schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName},
-			getObjectName(patchedK8sObj),
+			getObjectName(statusK8sObj),
 			errs,
 		)
 	}
 
+	// Run additional validation if provided
+	if updateValidation != nil {
+		if err := updateValidation(ctx, statusK8sObj, current); err != nil {
+			return zero, err
+		}
+	}
+
 	// Convert to domain object
-	patchedDomainObj, err := s.converter.ToDomain(ctx, patchedK8sObj)
+	updatedDomainObj, err := s.converter.ToDomain(ctx, statusK8sObj)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert patched k8s object to domain object: %w", err)
+		return zero, fmt.Errorf("failed to convert updated k8s object to domain object: %w", err)
 	}
 
 	// Update status in backend
-	finalDomainObj, err := s.updateStatusInBackend(ctx, patchedDomainObj)
+	finalDomainObj, err := s.updateStatusInBackend(ctx, updatedDomainObj)
 	if err != nil {
-		return nil, err
+		return zero, ConvertFieldViolations(err, schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName}, getObjectName(statusK8sObj))
 	}
 
 	// Convert back to Kubernetes object
 	finalK8sObj, err := s.converter.FromDomain(ctx, finalDomainObj)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert final domain object to k8s object: %w", err)
+		return zero, fmt.Errorf("failed to convert updated domain object to k8s object: %w", err)
 	}
 
 	// Broadcast watch event
@@ -230,10 +219,13 @@ func (s *StatusREST[K, D]) getFromBackend(ctx context.Context, namespace, name s
 }
 
 func (s *StatusREST[K, D]) updateStatusInBackend(ctx context.Context, obj D) (D, error) {
-	// This is a generic method that needs to be implemented by specific backend methods
-	// For now, we'll return an error indicating the method needs to be implemented
-	var zero D
-	return zero, fmt.Errorf("updateStatusInBackend not implemented for resource %s", s.resourceName)
+	// The backend stores spec and status together, the spec is the stored one
+	updated, err := s.parent.updateInBackend(ctx, &obj)
+	if err != nil {
+		var zero D
+		return zero, err
+	}
+	return *updated, nil
 }
 
 // Helper methods
@@ -243,7 +235,16 @@ func (s *StatusREST[K, D]) broadcastWatchEvent(eventType watch.EventType, obj ru
 	}
 }
 
-func (s *StatusREST[K, D]) applyPatch(current runtime.Object, patchType types.PatchType, data []byte) (runtime.Object, error) {
-	// Delegate to parent storage
-	return s.parent.applyPatch(current, patchType, data)
+// copyStatus sets the Status of dst to the Status of src, objects without a Status field are left as is
+func copyStatus(dst, src runtime.Object) {
+	dstValue, srcValue := reflect.ValueOf(dst), reflect.ValueOf(src)
+	if dstValue.Kind() != reflect.Ptr || srcValue.Kind() != reflect.Ptr || dstValue.IsNil() || srcValue.IsNil() {
+		return
+	}
+	dstStatus := dstValue.Elem().FieldByName("Status")
+	srcStatus := srcValue.Elem().FieldByName("Status")
+	if !dstStatus.IsValid() || !srcStatus.IsValid() || !dstStatus.CanSet() || dstStatus.Type() != srcStatus.Type() {
+		return
+	}
+	dstStatus.Set(srcStatus)
 }
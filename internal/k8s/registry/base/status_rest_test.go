@@ -0,0 +1,78 @@
+package base
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// captureToDomain records the object the storage persists
+func captureToDomain(storage *BaseStorage[*MockK8sObject, *MockDomain]) *MockK8sObject {
+	stored := &MockK8sObject{}
+	storage.converter = &MockConverter{
+		toDomainFunc: func(ctx context.Context, k8sObj *MockK8sObject) (*MockDomain, error) {
+			*stored = *k8sObj
+			return &MockDomain{Namespace: k8sObj.Namespace, Name: k8sObj.Name, Value: k8sObj.Spec.Value}, nil
+		},
+	}
+	return stored
+}
+
+func requestedObject() *MockK8sObject {
+	return &MockK8sObject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "test-ns"},
+		Spec:       MockSpec{Value: "changed"},
+		Status:     MockStatus{Phase: "Degraded"},
+	}
+}
+
+func TestStatusREST_Update_KeepsSpec(t *testing.T) {
+	storage := createTestStorage()
+	stored := captureToDomain(storage)
+
+	_, _, err := NewStatusREST(storage).Update(createTestContext("test-ns"), "test-name",
+		rest.DefaultUpdatedObjectInfo(requestedObject()), nil, nil, false, &metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if stored.Spec.Value != "test-value" {
+		t.Errorf("expected the stored spec to be kept, got %q", stored.Spec.Value)
+	}
+	if stored.Status.Phase != "Degraded" {
+		t.Errorf("expected the requested status, got %q", stored.Status.Phase)
+	}
+}
+
+func TestStatusREST_Patch_KeepsSpec(t *testing.T) {
+	storage := createTestStorage()
+	stored := captureToDomain(storage)
+
+	_, err := NewStatusREST(storage).Patch(createTestContext("test-ns"), "test-name", types.MergePatchType,
+		[]byte(`{"spec":{"value":"changed"},"status":{"phase":"Failed"}}`), &metav1.PatchOptions{})
+	if err != nil {
+		t.Fatalf("Patch: %v", err)
+	}
+	if stored.Spec.Value != "test-value" || stored.Status.Phase != "Failed" {
+		t.Errorf("expected only the status to change, got spec %q status %q", stored.Spec.Value, stored.Status.Phase)
+	}
+}
+
+func TestBaseStorage_Update_KeepsStatus(t *testing.T) {
+	storage := createTestStorage()
+	stored := captureToDomain(storage)
+
+	_, _, err := storage.Update(createTestContext("test-ns"), "test-name",
+		rest.DefaultUpdatedObjectInfo(requestedObject()), nil, nil, false, &metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if stored.Spec.Value != "changed" {
+		t.Errorf("expected the requested spec, got %q", stored.Spec.Value)
+	}
+	if stored.Status.Phase != "Ready" {
+		t.Errorf("expected the stored status to be kept, got %q", stored.Status.Phase)
+	}
+}
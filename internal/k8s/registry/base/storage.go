@@ -3,6 +3,7 @@ package base
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"math/rand"
 	"reflect"
@@ -11,6 +12,8 @@ import (
 	"unsafe"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
@@ -26,6 +29,7 @@ import (
 
 	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base/fieldmanager"
 	"netguard-pg-backend/internal/k8s/registry/base/patch"
 	"netguard-pg-backend/internal/k8s/registry/utils"
@@ -60,6 +64,12 @@ type BaseStorage[K runtime.Object, D any] struct {
 
 	// isNamespaced indicates if the resource is namespaced
 	isNamespaced bool
+
+	// backendWatch serves watches from the backend change feed, nil uses the local broadcaster
+	backendWatch *backendWatch
+
+	// openAPISchema validates the spec of written objects, nil skips the schema validation
+	openAPISchema *OpenAPISchema
 }
 
 // NewBaseStorage creates a new BaseStorage instance
@@ -159,11 +169,17 @@ func (s *BaseStorage[K, D]) Get(ctx context.Context, name string, options *metav
 
 // List retrieves a list of resources
 func (s *BaseStorage[K, D]) List(ctx context.Context, options *internalversion.ListOptions) (runtime.Object, error) {
-	scope := utils.ScopeFromContext(ctx)
+	// Label and field selectors are applied by the backend
+	scope := listScope(ctx, options)
+	// The revision is taken before listing: a watch resumed from it may repeat changes but never misses them
+	listRV := s.backendWatch.listResourceVersion()
 
 	// Get resources from backend
 	domainObjs, err := s.listFromBackend(ctx, scope)
 	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return nil, errors.NewBadRequest(st.Message())
+		}
 		return nil, err
 	}
 
@@ -206,10 +222,27 @@ func (s *BaseStorage[K, D]) List(ctx context.Context, options *internalversion.L
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert domain objects to k8s list: %w", err)
 	}
+	stampListResourceVersion(listObj, listRV)
 
 	return listObj, nil
 }
 
+// listScope returns the backend scope of a list: the namespace of the request narrowed by the selectors
+func listScope(ctx context.Context, options *internalversion.ListOptions) ports.Scope {
+	scope := utils.ScopeFromContext(ctx)
+	if options == nil {
+		return scope
+	}
+	var labelSelector, fieldSelector string
+	if options.LabelSelector != nil {
+		labelSelector = options.LabelSelector.String()
+	}
+	if options.FieldSelector != nil {
+		fieldSelector = options.FieldSelector.String()
+	}
+	return ports.NewSelectorScope(scope, labelSelector, fieldSelector)
+}
+
 // ConvertToTable converts the list to table format (required by rest.Lister)
 // This is a basic implementation that should be overridden by specific storage types
 func (s *BaseStorage[K, D]) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
@@ -269,7 +302,7 @@ func (s *BaseStorage[K, D]) Create(ctx context.Context, obj runtime.Object, crea
 	}
 
 	// Validate the object
-	if errs := s.validator.ValidateCreate(ctx, k8sObj); len(errs) > 0 {
+	if errs := append(s.validateOpenAPI(k8sObj), s.validator.ValidateCreate(ctx, k8sObj)...); len(errs) > 0 {
 		return nil, errors.NewInvalid(
 			schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName},
 			getObjectName(k8sObj),
@@ -289,6 +322,9 @@ func (s *BaseStorage[K, D]) Create(ctx context.Context, obj runtime.Object, crea
 		return nil, fmt.Errorf("failed to handle generated name: %w", err)
 	}
 
+	// The backend finalizer keeps the object until its backend deletion completes
+	initDeletionState(k8sObj)
+
 	// Convert to domain object
 	domainObj, err := s.converter.ToDomain(ctx, k8sObj)
 	if err != nil {
@@ -586,8 +622,18 @@ func (s *BaseStorage[K, D]) Update(ctx context.Context, name string, objInfo res
 		return nil, false, fmt.Errorf("expected %T, got %T", s.NewFunc(), updatedObj)
 	}
 
+	// Status is written through the status subresource, the resource keeps the stored status
+	copyStatus(updatedK8sObj, currentK8sObj)
+	if errs := copyDeletionState(updatedK8sObj, currentK8sObj); len(errs) > 0 {
+		return nil, false, errors.NewInvalid(
+			schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName},
+			getObjectName(updatedK8sObj),
+			errs,
+		)
+	}
+
 	// Validate the updated object
-	if errs := s.validator.ValidateUpdate(ctx, updatedK8sObj, currentK8sObj); len(errs) > 0 {
+	if errs := append(s.validateOpenAPI(updatedK8sObj), s.validator.ValidateUpdate(ctx, updatedK8sObj, currentK8sObj)...); len(errs) > 0 {
 		return nil, false, errors.NewInvalid(
 			schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName},
 			getObjectName(updatedK8sObj),
@@ -611,7 +657,7 @@ func (s *BaseStorage[K, D]) Update(ctx context.Context, name string, objInfo res
 	// Update in backend
 	finalDomainObj, err := s.updateInBackend(ctx, &updatedDomainObj)
 	if err != nil {
-		return nil, false, err
+		return nil, false, ConvertFieldViolations(err, schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName}, name)
 	}
 
 	// Convert back to Kubernetes object
@@ -621,7 +667,7 @@ func (s *BaseStorage[K, D]) Update(ctx context.Context, name string, objInfo res
 	}
 
 	// Broadcast watch event
-	s.broadcastWatchEvent(watch.Modified, finalK8sObj)
+	s.broadcastUpdate(ctx, namespace, name, finalK8sObj)
 
 	klog.InfoS("✅ BaseStorage.Update SUCCESS",
 		"resource", s.resourceName,
@@ -719,6 +765,12 @@ func (s *BaseStorage[K, D]) Delete(ctx context.Context, name string, deleteValid
 			"namespace", namespace)
 	}
 
+	// Objects with finalizers are only marked for deletion, the backend deletes them
+	// once the finalizers are removed
+	if accessor, err := meta.Accessor(k8sObj); err == nil && len(accessor.GetFinalizers()) > 0 {
+		return s.markDeleting(ctx, namespace, name, k8sObj)
+	}
+
 	// Delete from backend
 	klog.InfoS("🗑️ DELETE: Calling deleteFromBackend",
 		"resource", s.resourceName,
@@ -835,38 +887,9 @@ func (s *BaseStorage[K, D]) Patch(ctx context.Context, name string, patchType ty
 	}
 
 	// Apply patch
-	var patchedObj runtime.Object
-	if patchType == types.ApplyPatchType {
-		// Server-side apply path
-		mgr := "netguard-apiserver"
-		force := false
-		if options != nil {
-			if options.FieldManager != "" {
-				mgr = options.FieldManager
-			}
-			if options.Force != nil {
-				force = *options.Force
-			}
-		}
-		jsonPatch := data
-		// Convert YAML to JSON as apply patch content type is application/apply-patch+yaml
-		if converted, err := sigyaml.YAMLToJSON(data); err == nil {
-			jsonPatch = converted
-		} else {
-			klog.V(3).InfoS("YAML to JSON conversion failed; assuming JSON input for apply patch", "error", err)
-		}
-		fm := fieldmanager.NewServerSideFieldManager(mgr)
-		var applyErr error
-		patchedObj, applyErr = fm.Apply(ctx, currentK8sObj, jsonPatch, mgr, force)
-		if applyErr != nil {
-			return nil, applyErr
-		}
-	} else {
-		var err error
-		patchedObj, err = s.applyPatch(currentK8sObj, patchType, data)
-		if err != nil {
-			return nil, err
-		}
+	patchedObj, err := s.patchObject(ctx, currentK8sObj, patchType, data, options)
+	if err != nil {
+		return nil, err
 	}
 
 	patchedK8sObj, ok := patchedObj.(K)
@@ -874,8 +897,18 @@ func (s *BaseStorage[K, D]) Patch(ctx context.Context, name string, patchType ty
 		return nil, fmt.Errorf("expected %T, got %T", s.NewFunc(), patchedObj)
 	}
 
+	// Status is written through the status subresource, the resource keeps the stored status
+	copyStatus(patchedK8sObj, currentK8sObj)
+	if errs := copyDeletionState(patchedK8sObj, currentK8sObj); len(errs) > 0 {
+		return nil, errors.NewInvalid(
+			schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName},
+			getObjectName(patchedK8sObj),
+			errs,
+		)
+	}
+
 	// Validate the patched object
-	if errs := s.validator.ValidateUpdate(ctx, patchedK8sObj, currentK8sObj); len(errs) > 0 {
+	if errs := append(s.validateOpenAPI(patchedK8sObj), s.validator.ValidateUpdate(ctx, patchedK8sObj, currentK8sObj)...); len(errs) > 0 {
 		return nil, errors.NewInvalid(
 			schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName},
 			getObjectName(patchedK8sObj),
@@ -902,7 +935,7 @@ func (s *BaseStorage[K, D]) Patch(ctx context.Context, name string, patchType ty
 	}
 
 	if backendErr != nil {
-		return nil, backendErr
+		return nil, ConvertFieldViolations(backendErr, schema.GroupKind{Group: "netguard.sgroups.io", Kind: s.kindName}, getObjectName(patchedK8sObj))
 	}
 
 	// Convert back to Kubernetes object
@@ -922,13 +955,21 @@ func (s *BaseStorage[K, D]) Patch(ctx context.Context, name string, patchType ty
 	}
 
 	// Broadcast watch event
-	s.broadcastWatchEvent(watch.Modified, finalK8sObj)
+	s.broadcastUpdate(ctx, namespace, name, finalK8sObj)
 
 	return finalK8sObj, nil
 }
 
 // Watch returns a watch.Interface for the resource
 func (s *BaseStorage[K, D]) Watch(ctx context.Context, options *internalversion.ListOptions) (watch.Interface, error) {
+	if s.backendWatch != nil {
+		watchInterface, err := s.watchFromBackend(ctx, options)
+		if !stderrors.Is(err, client.ErrWatchNotSupported) {
+			return watchInterface, err
+		}
+		klog.V(2).InfoS("Backend doesn't support watch, using the local broadcaster", "resource", s.resourceName)
+	}
+
 	watchInterface, err := s.watcher.Watch()
 	if err != nil {
 		return nil, err
@@ -1090,6 +1131,34 @@ func (s *BaseStorage[K, D]) applyPatch(current runtime.Object, patchType types.P
 	return result, err
 }
 
+// patchObject applies a patch of any supported type, including server-side apply, to current
+func (s *BaseStorage[K, D]) patchObject(ctx context.Context, current runtime.Object, patchType types.PatchType, data []byte, options *metav1.PatchOptions) (runtime.Object, error) {
+	if patchType != types.ApplyPatchType {
+		return s.applyPatch(current, patchType, data)
+	}
+
+	// Server-side apply path
+	mgr := "netguard-apiserver"
+	force := false
+	if options != nil {
+		if options.FieldManager != "" {
+			mgr = options.FieldManager
+		}
+		if options.Force != nil {
+			force = *options.Force
+		}
+	}
+	jsonPatch := data
+	// Convert YAML to JSON as apply patch content type is application/apply-patch+yaml
+	if converted, err := sigyaml.YAMLToJSON(data); err == nil {
+		jsonPatch = converted
+	} else {
+		klog.V(3).InfoS("YAML to JSON conversion failed; assuming JSON input for apply patch", "error", err)
+	}
+	fm := fieldmanager.NewServerSideFieldManager(mgr)
+	return fm.Apply(ctx, current, jsonPatch, mgr, force)
+}
+
 func applyJSONPatch(current runtime.Object, data []byte) (runtime.Object, error) {
 	objectName := getObjectName(current)
 	namespace := getObjectNamespace(current)
@@ -1230,7 +1299,7 @@ func (s *BaseStorage[K, D]) handleSimpleSSACreate(ctx context.Context, namespace
 	}})
 
 	// Validate the minimal object
-	if errs := s.validator.ValidateCreate(ctx, obj); len(errs) > 0 {
+	if errs := append(s.validateOpenAPI(obj), s.validator.ValidateCreate(ctx, obj)...); len(errs) > 0 {
 		// Log validation errors but try to proceed
 		klog.V(1).InfoS("⚠️ Validation errors for minimal object",
 			"errors", errs.ToAggregate().Error())
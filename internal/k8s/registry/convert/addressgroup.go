@@ -58,6 +58,7 @@ func (c *AddressGroupConverter) ToDomain(ctx context.Context, k8sObj *netguardv1
 		Networks:         networks,
 		Hosts:            k8sObj.Spec.Hosts,
 		AggregatedHosts:  aggregatedHosts,
+		IncludedGroups:   k8sObj.Spec.IncludedGroups,
 		AddressGroupName: finalAddressGroupName,
 		Meta:             ConvertMetadataToDomain(k8sObj.ObjectMeta, k8sObj.Status.Conditions, k8sObj.Status.ObservedGeneration),
 	}
@@ -82,10 +83,11 @@ func (c *AddressGroupConverter) FromDomain(ctx context.Context, domainObj *model
 		TypeMeta:   CreateStandardTypeMetaForResource("AddressGroup"),
 		ObjectMeta: ConvertMetadataFromDomain(domainObj.Meta, domainObj.ResourceIdentifier.Name, domainObj.ResourceIdentifier.Namespace),
 		Spec: netguardv1beta1.AddressGroupSpec{
-			DefaultAction: netguardv1beta1.RuleAction(domainObj.DefaultAction),
-			Logs:          domainObj.Logs,
-			Trace:         domainObj.Trace,
-			Hosts:         domainObj.Hosts,
+			DefaultAction:  netguardv1beta1.RuleAction(domainObj.DefaultAction),
+			Logs:           domainObj.Logs,
+			Trace:          domainObj.Trace,
+			Hosts:          domainObj.Hosts,
+			IncludedGroups: domainObj.IncludedGroups,
 		},
 		Networks:        networks,
 		AggregatedHosts: aggregatedHostsK8s,
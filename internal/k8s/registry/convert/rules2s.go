@@ -42,9 +42,29 @@ func (c *RuleS2SConverter) ToDomain(ctx context.Context, k8sObj *netguardv1beta1
 		ServiceLocalRef: k8sObj.Spec.ServiceLocalRef,
 		ServiceRef:      k8sObj.Spec.ServiceRef,
 		Trace:           k8sObj.Spec.Trace, // Copy trace field from spec
+		Action:          models.RuleAction(k8sObj.Spec.Action),
+		PortsSource:     models.PortsSource(k8sObj.Spec.PortsSource),
+		Priority:        k8sObj.Spec.Priority,
 		Meta:            ConvertMetadataToDomain(k8sObj.ObjectMeta, k8sObj.Status.Conditions, k8sObj.Status.ObservedGeneration),
 	}
 
+	// Convert extra ports
+	for _, port := range k8sObj.Spec.ExtraPorts {
+		domainRule.ExtraPorts = append(domainRule.ExtraPorts, models.IngressPort{
+			Protocol:    models.TransportProtocol(port.Protocol),
+			Port:        port.Port,
+			Description: port.Description,
+		})
+	}
+
+	// Convert validity window
+	if k8sObj.Spec.ValidFrom != nil {
+		domainRule.ValidFrom = k8sObj.Spec.ValidFrom.Time
+	}
+	if k8sObj.Spec.ValidUntil != nil {
+		domainRule.ValidUntil = k8sObj.Spec.ValidUntil.Time
+	}
+
 	// Convert IEAgAgRuleRefs from status
 	if len(k8sObj.Status.IEAgAgRuleRefs) > 0 {
 		domainRule.IEAgAgRuleRefs = make([]netguardv1beta1.NamespacedObjectReference, len(k8sObj.Status.IEAgAgRuleRefs))
@@ -79,9 +99,31 @@ func (c *RuleS2SConverter) FromDomain(ctx context.Context, domainObj *models.Rul
 			ServiceLocalRef: EnsureNamespacedObjectReferenceFields(domainObj.ServiceLocalRef, "Service"),
 			ServiceRef:      EnsureNamespacedObjectReferenceFields(domainObj.ServiceRef, "Service"),
 			Trace:           domainObj.Trace, // Copy trace field from domain
+			Action:          netguardv1beta1.RuleAction(domainObj.Action),
+			PortsSource:     string(domainObj.PortsSource),
+			Priority:        domainObj.Priority,
 		},
 	}
 
+	// Convert extra ports
+	for _, port := range domainObj.ExtraPorts {
+		k8sRule.Spec.ExtraPorts = append(k8sRule.Spec.ExtraPorts, netguardv1beta1.IngressPort{
+			Protocol:    netguardv1beta1.TransportProtocol(port.Protocol),
+			Port:        port.Port,
+			Description: port.Description,
+		})
+	}
+
+	// Convert validity window
+	if !domainObj.ValidFrom.IsZero() {
+		validFrom := metav1.NewTime(domainObj.ValidFrom)
+		k8sRule.Spec.ValidFrom = &validFrom
+	}
+	if !domainObj.ValidUntil.IsZero() {
+		validUntil := metav1.NewTime(domainObj.ValidUntil)
+		k8sRule.Spec.ValidUntil = &validUntil
+	}
+
 	// Metadata already converted by ConvertMetadataFromDomain helper
 
 	// Convert status using standard helper
@@ -106,7 +106,7 @@ func (c *ServiceConverter) FromDomain(ctx context.Context, domainObj *models.Ser
 	}
 
 	// Convert AggregatedAddressGroups to ROOT level (not Status!)
-	aggregatedAGsK8s := convertAddressGroupReferencesToK8s(domainObj.AggregatedAddressGroups)
+	aggregatedAGsK8s := convertAddressGroupReferencesToK8s(domainObj.GetAggregatedAddressGroups())
 
 	// Defensive: If AggregatedAddressGroups is empty but Spec.AddressGroups is not,
 	// populate AggregatedAddressGroups from Spec to maintain data consistency.
@@ -57,6 +57,20 @@ func ConvertMetadataToDomain(objMeta metav1.ObjectMeta, statusConditions []metav
 		copy(meta.ManagedFields, objMeta.ManagedFields)
 	}
 
+	// Finalizers and the deletion intent are coordinated with the backend
+	if objMeta.Finalizers != nil {
+		meta.Finalizers = append([]string(nil), objMeta.Finalizers...)
+	}
+	if objMeta.DeletionTimestamp != nil {
+		deletionTimestamp := *objMeta.DeletionTimestamp
+		meta.DeletionTimestamp = &deletionTimestamp
+	}
+
+	// Owner references of generated IEAgAgRules let Kubernetes GC cascade deletions of their owners
+	if objMeta.OwnerReferences != nil {
+		meta.OwnerReferences = append([]metav1.OwnerReference(nil), objMeta.OwnerReferences...)
+	}
+
 	return meta
 }
 
@@ -96,6 +110,17 @@ func ConvertMetadataFromDomain(meta models.Meta, name, namespace string) metav1.
 		copy(objMeta.ManagedFields, meta.ManagedFields)
 	}
 
+	if meta.Finalizers != nil {
+		objMeta.Finalizers = append([]string(nil), meta.Finalizers...)
+	}
+	if meta.DeletionTimestamp != nil {
+		deletionTimestamp := *meta.DeletionTimestamp
+		objMeta.DeletionTimestamp = &deletionTimestamp
+	}
+	if meta.OwnerReferences != nil {
+		objMeta.OwnerReferences = append([]metav1.OwnerReference(nil), meta.OwnerReferences...)
+	}
+
 	return objMeta
 }
 
@@ -15,6 +15,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -123,6 +124,7 @@ func (s *HostStorage) ConvertToTable(ctx context.Context, object runtime.Object,
 			{Name: "UUID", Type: "string"},
 			{Name: "Bound", Type: "boolean"},
 			{Name: "AddressGroup", Type: "string"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -135,6 +137,7 @@ func (s *HostStorage) ConvertToTable(ctx context.Context, object runtime.Object,
 				host.Spec.UUID,
 				host.Status.IsBound,
 				host.Status.AddressGroupName,
+				utils.ReadyStatus(host.Status.Conditions),
 				translateTimestampSince(host.CreationTimestamp),
 			},
 		}
@@ -148,6 +151,7 @@ func (s *HostStorage) ConvertToTable(ctx context.Context, object runtime.Object,
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
@@ -16,6 +16,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -125,6 +126,7 @@ func (s *HostBindingStorage) ConvertToTable(ctx context.Context, object runtime.
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Host", Type: "string"},
 			{Name: "AddressGroup", Type: "string"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -134,8 +136,9 @@ func (s *HostBindingStorage) ConvertToTable(ctx context.Context, object runtime.
 			Object: runtime.RawExtension{Object: binding},
 			Cells: []interface{}{
 				binding.Name,
-				binding.Spec.HostRef.Name,
-				binding.Spec.AddressGroupRef.Name,
+				utils.RefName(binding.Spec.HostRef.Name, binding.Spec.HostRef.Namespace, binding.Namespace),
+				utils.RefName(binding.Spec.AddressGroupRef.Name, binding.Spec.AddressGroupRef.Namespace, binding.Namespace),
+				utils.ReadyStatus(binding.Status.Conditions),
 				translateTimestampSince(binding.CreationTimestamp),
 			},
 		}
@@ -149,6 +152,7 @@ func (s *HostBindingStorage) ConvertToTable(ctx context.Context, object runtime.
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
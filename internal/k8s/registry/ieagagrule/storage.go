@@ -14,6 +14,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 )
 
@@ -53,23 +54,38 @@ func (s *IEAgAgRuleStorage) GetSingularName() string {
 	return "ieagagrule"
 }
 
-// ConvertToTable provides a minimal table representation
+// ConvertToTable shows the traffic, the address groups and the ports of the rules
 func (s *IEAgAgRuleStorage) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
 	table := &metav1.Table{
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Traffic", Type: "string"},
+			{Name: "Local AG", Type: "string"},
+			{Name: "Target AG", Type: "string"},
+			{Name: "Transport", Type: "string"},
+			{Name: "Ports", Type: "string"},
 			{Name: "Action", Type: "string"},
+			{Name: "Priority", Type: "integer", Priority: 1},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
 
 	addRow := func(rule *netguardv1beta1.IEAgAgRule) {
-		traffic := string(rule.Spec.Traffic)
-		action := string(rule.Spec.Action)
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: rule},
-			Cells:  []interface{}{rule.Name, traffic, action, translateTimestampSince(rule.CreationTimestamp)},
+			Cells: []interface{}{
+				rule.Name,
+				string(rule.Spec.Traffic),
+				utils.RefName(rule.Spec.AddressGroupLocal.Name, rule.Spec.AddressGroupLocal.Namespace, rule.Namespace),
+				utils.RefName(rule.Spec.AddressGroup.Name, rule.Spec.AddressGroup.Namespace, rule.Namespace),
+				string(rule.Spec.Transport),
+				formatPorts(rule.Spec.Ports),
+				string(rule.Spec.Action),
+				rule.Spec.Priority,
+				utils.ReadyStatus(rule.Status.Conditions),
+				translateTimestampSince(rule.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -81,12 +97,26 @@ func (s *IEAgAgRuleStorage) ConvertToTable(ctx context.Context, object runtime.O
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
 	return table, nil
 }
 
+// formatPorts formats the ports of a rule as a comma-separated list of ports and port ranges
+func formatPorts(ports []netguardv1beta1.PortSpec) string {
+	values := make([]string, 0, len(ports))
+	for _, port := range ports {
+		if port.PortRange != nil {
+			values = append(values, fmt.Sprintf("%d-%d", port.PortRange.From, port.PortRange.To))
+			continue
+		}
+		values = append(values, fmt.Sprintf("%d", port.Port))
+	}
+	return utils.JoinCells(values)
+}
+
 // translateTimestampSince returns the elapsed time since timestamp in human-readable form.
 func translateTimestampSince(ts metav1.Time) string {
 	if ts.IsZero() {
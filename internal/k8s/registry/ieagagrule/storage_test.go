@@ -0,0 +1,55 @@
+package ieagagrule
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	netguardv1beta1 "netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+)
+
+func TestIEAgAgRuleStorage_ConvertToTable(t *testing.T) {
+	list := &netguardv1beta1.IEAgAgRuleList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "42"},
+		Items: []netguardv1beta1.IEAgAgRule{{
+			ObjectMeta: metav1.ObjectMeta{Name: "egr-tcp-web-db", Namespace: "prod"},
+			Spec: netguardv1beta1.IEAgAgRuleSpec{
+				Transport:         netguardv1beta1.ProtocolTCP,
+				Traffic:           netguardv1beta1.EGRESS,
+				AddressGroupLocal: netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: "web"}, Namespace: "prod"},
+				AddressGroup:      netguardv1beta1.NamespacedObjectReference{ObjectReference: netguardv1beta1.ObjectReference{Name: "db"}, Namespace: "data"},
+				Ports: []netguardv1beta1.PortSpec{
+					{Port: 5432},
+					{PortRange: &netguardv1beta1.PortRange{From: 8000, To: 8080}},
+				},
+				Action: netguardv1beta1.ActionAccept,
+			},
+			Status: netguardv1beta1.IEAgAgRuleStatus{
+				Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}},
+			},
+		}},
+	}
+
+	table, err := (&IEAgAgRuleStorage{}).ConvertToTable(context.Background(), list, nil)
+	if err != nil {
+		t.Fatalf("ConvertToTable: %v", err)
+	}
+	if table.ResourceVersion != "42" {
+		t.Errorf("expected the list resourceVersion, got %q", table.ResourceVersion)
+	}
+	if len(table.Rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(table.Rows))
+	}
+
+	cells := table.Rows[0].Cells
+	if len(cells) != len(table.ColumnDefinitions) {
+		t.Fatalf("expected %d cells, got %d", len(table.ColumnDefinitions), len(cells))
+	}
+	expected := []interface{}{"egr-tcp-web-db", "EGRESS", "web", "data/db", "TCP", "5432,8000-8080", "ACCEPT", int32(0), "True"}
+	for i, value := range expected {
+		if cells[i] != value {
+			t.Errorf("column %s: expected %v, got %v", table.ColumnDefinitions[i].Name, value, cells[i])
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -108,6 +109,7 @@ func (s *NetworkStorage) ConvertToTable(ctx context.Context, object runtime.Obje
 			{Name: "CIDR", Type: "string"},
 			{Name: "Network Name", Type: "string"},
 			{Name: "Bound", Type: "boolean"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -120,6 +122,7 @@ func (s *NetworkStorage) ConvertToTable(ctx context.Context, object runtime.Obje
 				network.Spec.CIDR,
 				network.Status.NetworkName,
 				network.Status.IsBound,
+				utils.ReadyStatus(network.Status.Conditions),
 				networkTranslateTimestampSince(network.CreationTimestamp),
 			},
 		}
@@ -133,6 +136,7 @@ func (s *NetworkStorage) ConvertToTable(ctx context.Context, object runtime.Obje
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
@@ -15,6 +15,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -107,7 +108,8 @@ func (s *NetworkBindingStorage) ConvertToTable(ctx context.Context, object runti
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Network", Type: "string"},
 			{Name: "AddressGroup", Type: "string"},
-			{Name: "Network Item", Type: "string"},
+			{Name: "CIDR", Type: "string"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -117,9 +119,10 @@ func (s *NetworkBindingStorage) ConvertToTable(ctx context.Context, object runti
 			Object: runtime.RawExtension{Object: binding},
 			Cells: []interface{}{
 				binding.Name,
-				binding.Spec.NetworkRef,
-				binding.Spec.AddressGroupRef,
-				binding.NetworkItem.Name,
+				binding.Spec.NetworkRef.Name,
+				binding.Spec.AddressGroupRef.Name,
+				binding.NetworkItem.CIDR,
+				utils.ReadyStatus(binding.Status.Conditions),
 				networkBindingTranslateTimestampSince(binding.CreationTimestamp),
 			},
 		}
@@ -133,6 +136,7 @@ func (s *NetworkBindingStorage) ConvertToTable(ctx context.Context, object runti
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
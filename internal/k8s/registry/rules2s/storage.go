@@ -14,6 +14,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 )
 
@@ -53,21 +54,34 @@ func (s *RuleS2SStorage) GetSingularName() string {
 	return "rules2s"
 }
 
-// ConvertToTable provides a minimal table representation
+// ConvertToTable shows the traffic and the services of the rules
 func (s *RuleS2SStorage) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
 	table := &metav1.Table{
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Traffic", Type: "string"},
+			{Name: "Local Service", Type: "string"},
+			{Name: "Target Service", Type: "string"},
+			{Name: "Action", Type: "string"},
+			{Name: "Trace", Type: "boolean", Priority: 1},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
 
 	addRow := func(rule *netguardv1beta1.RuleS2S) {
-		traffic := string(rule.Spec.Traffic)
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: rule},
-			Cells:  []interface{}{rule.Name, traffic, translateTimestampSince(rule.CreationTimestamp)},
+			Cells: []interface{}{
+				rule.Name,
+				string(rule.Spec.Traffic),
+				utils.RefName(rule.Spec.ServiceLocalRef.Name, rule.Spec.ServiceLocalRef.Namespace, rule.Namespace),
+				utils.RefName(rule.Spec.ServiceRef.Name, rule.Spec.ServiceRef.Namespace, rule.Namespace),
+				string(rule.Spec.Action),
+				rule.Spec.Trace,
+				utils.ReadyStatus(rule.Status.Conditions),
+				translateTimestampSince(rule.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -79,6 +93,7 @@ func (s *RuleS2SStorage) ConvertToTable(ctx context.Context, object runtime.Obje
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
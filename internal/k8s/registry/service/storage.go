@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -199,6 +198,9 @@ func (s *ServiceStorage) ConvertToTable(ctx context.Context, object runtime.Obje
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Ports", Type: "string"},
+			{Name: "AddressGroups", Type: "string"},
+			{Name: "Description", Type: "string", Priority: 1},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
@@ -208,9 +210,20 @@ func (s *ServiceStorage) ConvertToTable(ctx context.Context, object runtime.Obje
 		for _, p := range svc.Spec.IngressPorts {
 			ports = append(ports, fmt.Sprintf("%s/%s", p.Protocol, p.Port))
 		}
+		addressGroups := make([]string, 0, len(svc.Spec.AddressGroups))
+		for _, ag := range svc.Spec.AddressGroups {
+			addressGroups = append(addressGroups, utils.RefName(ag.Name, ag.Namespace, svc.Namespace))
+		}
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: svc},
-			Cells:  []interface{}{svc.Name, strings.Join(ports, ","), translateTimestampSince(svc.CreationTimestamp)},
+			Cells: []interface{}{
+				svc.Name,
+				utils.JoinCells(ports),
+				utils.JoinCells(addressGroups),
+				svc.Spec.Description,
+				utils.ReadyStatus(svc.Status.Conditions),
+				translateTimestampSince(svc.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -222,6 +235,7 @@ func (s *ServiceStorage) ConvertToTable(ctx context.Context, object runtime.Obje
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
@@ -14,6 +14,7 @@ import (
 	"netguard-pg-backend/internal/k8s/client"
 	"netguard-pg-backend/internal/k8s/registry/base"
 	"netguard-pg-backend/internal/k8s/registry/convert"
+	"netguard-pg-backend/internal/k8s/registry/utils"
 	"netguard-pg-backend/internal/k8s/registry/validation"
 )
 
@@ -59,18 +60,20 @@ func (s *ServiceAliasStorage) ConvertToTable(ctx context.Context, object runtime
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Service", Type: "string"},
+			utils.ReadyColumn,
 			{Name: "Age", Type: "string"},
 		},
 	}
 
 	addRow := func(alias *netguardv1beta1.ServiceAlias) {
-		service := "unknown"
-		if alias.Spec.ServiceRef.Name != "" {
-			service = alias.Spec.ServiceRef.Name
-		}
 		row := metav1.TableRow{
 			Object: runtime.RawExtension{Object: alias},
-			Cells:  []interface{}{alias.Name, service, translateTimestampSince(alias.CreationTimestamp)},
+			Cells: []interface{}{
+				alias.Name,
+				utils.RefName(alias.Spec.ServiceRef.Name, alias.Spec.ServiceRef.Namespace, alias.Namespace),
+				utils.ReadyStatus(alias.Status.Conditions),
+				translateTimestampSince(alias.CreationTimestamp),
+			},
 		}
 		table.Rows = append(table.Rows, row)
 	}
@@ -82,6 +85,7 @@ func (s *ServiceAliasStorage) ConvertToTable(ctx context.Context, object runtime
 		for i := range v.Items {
 			addRow(&v.Items[i])
 		}
+		utils.SetTableListMeta(table, v)
 	default:
 		return nil, fmt.Errorf("unexpected object type %T", object)
 	}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// ReadyColumn is the printer column with the status of the Ready condition
+var ReadyColumn = metav1.TableColumnDefinition{
+	Name:        "Ready",
+	Type:        "string",
+	Description: "Status of the Ready condition",
+}
+
+// ReadyStatus returns the status of the Ready condition, Unknown when the condition is not reported
+func ReadyStatus(conditions []metav1.Condition) string {
+	if ready := meta.FindStatusCondition(conditions, models.ConditionReady); ready != nil {
+		return string(ready.Status)
+	}
+	return string(metav1.ConditionUnknown)
+}
+
+// RefName formats a reference for a printer column: namespace/name when the reference
+// points to another namespace, name otherwise
+func RefName(name, namespace, objectNamespace string) string {
+	if name == "" {
+		return "<none>"
+	}
+	if namespace != "" && namespace != objectNamespace {
+		return namespace + "/" + name
+	}
+	return name
+}
+
+// JoinCells joins values of a multi-value printer column, <none> for no values
+func JoinCells(values []string) string {
+	if len(values) == 0 {
+		return "<none>"
+	}
+	return strings.Join(values, ",")
+}
+
+// SetTableListMeta copies resourceVersion and continue of a listed object into the table,
+// kubectl get --watch resumes the watch from the resourceVersion of the table
+func SetTableListMeta(table *metav1.Table, list runtime.Object) {
+	accessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return
+	}
+	table.ResourceVersion = accessor.GetResourceVersion()
+	table.Continue = accessor.GetContinue()
+	table.RemainingItemCount = accessor.GetRemainingItemCount()
+}
@@ -109,8 +109,15 @@ func (v *AddressGroupBindingPolicyValidator) validateAddressGroupRef(ref v1beta1
 func (v *AddressGroupBindingPolicyValidator) validateServiceRef(ref v1beta1.NamespacedObjectReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	// Use standard NamespacedObjectReference validation
-	allErrs = append(allErrs, ValidateNamespacedObjectReference(&ref, fldPath)...)
+	// Use standard NamespacedObjectReference validation, the name may be omitted: such a policy
+	// allows every Service of the namespace to use a global AddressGroup
+	namePath := fldPath.Child("name").String()
+	for _, err := range ValidateNamespacedObjectReference(&ref, fldPath) {
+		if err.Type == field.ErrorTypeRequired && err.Field == namePath {
+			continue
+		}
+		allErrs = append(allErrs, err)
+	}
 
 	// Domain-specific validation: APIVersion must be netguard.sgroups.io/v1beta1
 	if ref.APIVersion != "" && ref.APIVersion != "netguard.sgroups.io/v1beta1" {
@@ -117,7 +117,7 @@ func TestNetworkValidator_ValidateCreate(t *testing.T) {
 				},
 			},
 			expectError: true,
-			errorMsg:    "must be a valid CIDR notation",
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
 		},
 		{
 			name: "invalid IP address in CIDR",
@@ -131,7 +131,7 @@ func TestNetworkValidator_ValidateCreate(t *testing.T) {
 				},
 			},
 			expectError: true,
-			errorMsg:    "must be a valid CIDR notation",
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
 		},
 		{
 			name: "invalid subnet mask",
@@ -145,7 +145,7 @@ func TestNetworkValidator_ValidateCreate(t *testing.T) {
 				},
 			},
 			expectError: true,
-			errorMsg:    "must be a valid CIDR notation",
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
 		},
 		{
 			name: "IP without CIDR notation",
@@ -159,7 +159,62 @@ func TestNetworkValidator_ValidateCreate(t *testing.T) {
 				},
 			},
 			expectError: true,
-			errorMsg:    "must be a valid CIDR notation",
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
+		},
+		{
+			name: "valid Network with single IPv6 host CIDR",
+			network: &v1beta1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ipv6-host",
+					Namespace: "default",
+				},
+				Spec: v1beta1.NetworkSpec{
+					CIDR: "fd00::1/128",
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid IPv6 prefix length",
+			network: &v1beta1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-ipv6-mask",
+					Namespace: "default",
+				},
+				Spec: v1beta1.NetworkSpec{
+					CIDR: "2001:db8::/129",
+				},
+			},
+			expectError: true,
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
+		},
+		{
+			name: "invalid IPv6 address in CIDR",
+			network: &v1beta1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid-ipv6",
+					Namespace: "default",
+				},
+				Spec: v1beta1.NetworkSpec{
+					CIDR: "2001:db8::zz/64",
+				},
+			},
+			expectError: true,
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
+		},
+		{
+			name: "IPv6 address without CIDR notation",
+			network: &v1beta1.Network{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "no-ipv6-cidr-notation",
+					Namespace: "default",
+				},
+				Spec: v1beta1.NetworkSpec{
+					CIDR: "2001:db8::1",
+				},
+			},
+			expectError: true,
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
 		},
 		{
 			name: "valid generateName instead of name",
@@ -313,7 +368,28 @@ func TestNetworkValidator_ValidateUpdate(t *testing.T) {
 			},
 			oldNetwork:  baseNetwork,
 			expectError: true,
-			errorMsg:    "must be a valid CIDR notation",
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
+		},
+		{
+			name: "valid IPv6 CIDR change when not ready",
+			newNetwork: func() *v1beta1.Network {
+				network := baseNetwork.DeepCopy()
+				network.Spec.CIDR = "fd00:10::/48"
+				return network
+			}(),
+			oldNetwork:  baseNetwork,
+			expectError: false,
+		},
+		{
+			name: "update with invalid new IPv6 CIDR",
+			newNetwork: func() *v1beta1.Network {
+				network := baseNetwork.DeepCopy()
+				network.Spec.CIDR = "fd00:10::/200"
+				return network
+			}(),
+			oldNetwork:  baseNetwork,
+			expectError: true,
+			errorMsg:    "must be a valid IPv4 or IPv6 CIDR notation",
 		},
 		{
 			name: "update with invalid metadata",
@@ -2,7 +2,7 @@ package validation
 
 import (
 	"fmt"
-	"net"
+	"net/netip"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -153,7 +153,7 @@ func ValidatePortRange(portRange *netguardv1beta1.PortRange, fldPath *field.Path
 	return allErrs
 }
 
-// ValidateCIDR validates CIDR notation using net.ParseCIDR
+// ValidateCIDR validates IPv4 and IPv6 CIDR notation
 func ValidateCIDR(cidr string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -161,8 +161,14 @@ func ValidateCIDR(cidr string, fldPath *field.Path) field.ErrorList {
 		return append(allErrs, field.Required(fldPath, "CIDR cannot be empty"))
 	}
 
-	if _, _, err := net.ParseCIDR(cidr); err != nil {
-		allErrs = append(allErrs, field.Invalid(fldPath, cidr, "must be a valid CIDR notation"))
+	prefix, err := netip.ParsePrefix(cidr)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, "must be a valid IPv4 or IPv6 CIDR notation"))
+	case prefix.Addr().Is4In6():
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, "IPv4-mapped IPv6 CIDR is not supported, use the IPv4 CIDR"))
+	case prefix.Addr().Zone() != "":
+		allErrs = append(allErrs, field.Invalid(fldPath, cidr, "CIDR must not have an IPv6 zone"))
 	}
 
 	return allErrs
@@ -0,0 +1,62 @@
+package logging
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// levelsResponse is the payload returned by the verbosity handler
+type levelsResponse struct {
+	Default    int            `json:"default"`
+	Subsystems map[string]int `json:"subsystems"`
+}
+
+// Handler exposes subsystem verbosity of the default registry over HTTP.
+//
+//	GET  /debug/logging                               - current levels
+//	PUT  /debug/logging?subsystem=aggregation&v=-1    - change verbosity
+//	PUT  /debug/logging?subsystem=aggregation&v=reset - follow the default level
+func Handler() http.Handler {
+	return std.Handler()
+}
+
+// Handler exposes subsystem verbosity of the registry over HTTP
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			subsystem := req.URL.Query().Get("subsystem")
+			if subsystem == "" {
+				http.Error(w, "subsystem is required", http.StatusBadRequest)
+				return
+			}
+
+			value := req.URL.Query().Get("v")
+			if value == "" {
+				http.Error(w, "v is required", http.StatusBadRequest)
+				return
+			}
+			if value == "reset" {
+				r.ResetVerbosity(subsystem)
+				break
+			}
+			v, err := ParseLevel(value)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			r.SetVerbosity(subsystem, v)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		defaultV, subsystems := r.Levels()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(levelsResponse{
+			Default:    defaultV,
+			Subsystems: subsystems,
+		})
+	})
+}
@@ -0,0 +1,271 @@
+// Package logging provides the process-wide structured logger.
+//
+// All loggers are logr.Logger values backed by zap. Every subsystem gets its
+// own named logger whose verbosity can be changed at runtime, so chatty
+// subsystems (e.g. port aggregation) can be silenced without a restart.
+package logging
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/zapr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/klog/v2"
+)
+
+// Supported output formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Well-known subsystem names
+const (
+	SubsystemAggregation = "aggregation"
+	SubsystemSync        = "sync"
+	SubsystemStartup     = "startup"
+	SubsystemLeader      = "leader"
+	SubsystemEvents      = "events"
+	SubsystemChangeData  = "change-data"
+	SubsystemValidation  = "validation-webhooks"
+	SubsystemRules       = "rules"
+)
+
+// maxVerbosity is the highest V-level passed down to zap
+const maxVerbosity = 10
+
+// Options describes logger configuration
+type Options struct {
+	// Level is the default level: error, warn, info, debug or a numeric V-level
+	Level string
+	// Format is the output format: text or json
+	Format string
+	// Subsystems overrides verbosity per subsystem. A negative value disables
+	// all Info logs of the subsystem, errors are always logged.
+	Subsystems map[string]int
+}
+
+// Registry holds the root logger and per-subsystem verbosity
+type Registry struct {
+	root     atomic.Pointer[logr.Logger]
+	defaultV atomic.Int32
+
+	mu         sync.RWMutex
+	subsystems map[string]*atomic.Int32
+}
+
+var std = NewRegistry()
+
+// NewRegistry creates a registry which logs through klog until configured
+func NewRegistry() *Registry {
+	r := &Registry{subsystems: make(map[string]*atomic.Int32)}
+	root := klog.NewKlogr()
+	r.root.Store(&root)
+	return r
+}
+
+// Configure builds the root logger from options and redirects klog output to it
+func Configure(opts Options) error {
+	root, err := std.Configure(opts)
+	if err != nil {
+		return err
+	}
+
+	klog.SetLogger(root)
+	// klog gates V() calls by its own -v flag, keep it in sync with the default level
+	fs := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(fs)
+	if v := std.defaultV.Load(); v > 0 {
+		_ = fs.Set("v", strconv.Itoa(int(v)))
+	}
+	return nil
+}
+
+// For returns the logger of the given subsystem from the default registry
+func For(subsystem string) logr.Logger {
+	return std.For(subsystem)
+}
+
+// SetVerbosity changes subsystem verbosity in the default registry
+func SetVerbosity(subsystem string, v int) {
+	std.SetVerbosity(subsystem, v)
+}
+
+// Levels returns configured verbosity of the default registry
+func Levels() (int, map[string]int) {
+	return std.Levels()
+}
+
+// Configure builds a zap-backed root logger and applies verbosity settings
+func (r *Registry) Configure(opts Options) (logr.Logger, error) {
+	defaultV, err := ParseLevel(opts.Level)
+	if err != nil {
+		return logr.Logger{}, err
+	}
+
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	encoderConfig.EncodeLevel = encodeLevel
+
+	var encoder zapcore.Encoder
+	switch strings.ToLower(opts.Format) {
+	case "", FormatText:
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	case FormatJSON:
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	default:
+		return logr.Logger{}, fmt.Errorf("unknown log format: %s", opts.Format)
+	}
+
+	// Verbosity is filtered by the registry, zap accepts everything up to maxVerbosity
+	core := zapcore.NewCore(encoder, zapcore.Lock(os.Stderr), zapcore.Level(-maxVerbosity))
+	root := zapr.NewLoggerWithOptions(zap.New(core, zap.AddCaller()), zapr.LogInfoLevel("v"))
+
+	r.root.Store(&root)
+	r.defaultV.Store(int32(defaultV))
+	for subsystem, v := range opts.Subsystems {
+		r.SetVerbosity(subsystem, v)
+	}
+	return root, nil
+}
+
+// For returns a named logger whose verbosity is controlled by the registry
+func (r *Registry) For(subsystem string) logr.Logger {
+	return logr.New(&subsystemSink{registry: r, subsystem: subsystem})
+}
+
+// SetVerbosity sets verbosity of a subsystem
+func (r *Registry) SetVerbosity(subsystem string, v int) {
+	r.level(subsystem).Store(int32(v))
+}
+
+// ResetVerbosity makes a subsystem follow the default level again
+func (r *Registry) ResetVerbosity(subsystem string) {
+	r.mu.Lock()
+	delete(r.subsystems, subsystem)
+	r.mu.Unlock()
+}
+
+// Levels returns default verbosity and explicit per-subsystem overrides
+func (r *Registry) Levels() (int, map[string]int) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	levels := make(map[string]int, len(r.subsystems))
+	for subsystem, v := range r.subsystems {
+		levels[subsystem] = int(v.Load())
+	}
+	return int(r.defaultV.Load()), levels
+}
+
+func (r *Registry) level(subsystem string) *atomic.Int32 {
+	r.mu.RLock()
+	v, ok := r.subsystems[subsystem]
+	r.mu.RUnlock()
+	if ok {
+		return v
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if v, ok = r.subsystems[subsystem]; !ok {
+		v = &atomic.Int32{}
+		r.subsystems[subsystem] = v
+	}
+	return v
+}
+
+func (r *Registry) verbosity(subsystem string) int {
+	r.mu.RLock()
+	v, ok := r.subsystems[subsystem]
+	r.mu.RUnlock()
+	if ok {
+		return int(v.Load())
+	}
+	return int(r.defaultV.Load())
+}
+
+// ParseLevel converts a level name or a numeric V-level into verbosity
+func ParseLevel(level string) (int, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "error", "warn", "warning":
+		return -1, nil
+	case "", "info":
+		return 0, nil
+	case "debug":
+		return 4, nil
+	case "trace":
+		return maxVerbosity, nil
+	}
+
+	v, err := strconv.Atoi(level)
+	if err != nil {
+		return 0, fmt.Errorf("unknown log level: %s", level)
+	}
+	return v, nil
+}
+
+func encodeLevel(l zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+	if l < zapcore.InfoLevel {
+		enc.AppendString("DEBUG")
+		return
+	}
+	zapcore.CapitalLevelEncoder(l, enc)
+}
+
+// subsystemSink resolves the root logger on every call, so loggers created
+// before Configure (e.g. package-level variables) pick up the final setup.
+type subsystemSink struct {
+	registry  *Registry
+	subsystem string
+	names     []string
+	values    []interface{}
+}
+
+var _ logr.LogSink = &subsystemSink{}
+
+func (s *subsystemSink) Init(logr.RuntimeInfo) {}
+
+func (s *subsystemSink) Enabled(level int) bool {
+	return level <= s.registry.verbosity(s.subsystem)
+}
+
+func (s *subsystemSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	s.logger().V(level).Info(msg, keysAndValues...)
+}
+
+func (s *subsystemSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.logger().Error(err, msg, keysAndValues...)
+}
+
+func (s *subsystemSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	clone := *s
+	clone.values = append(append([]interface{}{}, s.values...), keysAndValues...)
+	return &clone
+}
+
+func (s *subsystemSink) WithName(name string) logr.LogSink {
+	clone := *s
+	clone.names = append(append([]string{}, s.names...), name)
+	return &clone
+}
+
+func (s *subsystemSink) logger() logr.Logger {
+	// Skip the outer logr.Logger call and this sink
+	l := s.registry.root.Load().WithCallDepth(2).WithName(s.subsystem)
+	for _, name := range s.names {
+		l = l.WithName(name)
+	}
+	if len(s.values) > 0 {
+		l = l.WithValues(s.values...)
+	}
+	return l
+}
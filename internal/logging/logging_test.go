@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegistry(lines *[]string) *Registry {
+	r := NewRegistry()
+	root := funcr.New(func(prefix, args string) {
+		*lines = append(*lines, prefix+" "+args)
+	}, funcr.Options{Verbosity: maxVerbosity})
+	r.root.Store(&root)
+	return r
+}
+
+func TestRegistry_SubsystemVerbosity(t *testing.T) {
+	var lines []string
+	r := newTestRegistry(&lines)
+	r.defaultV.Store(1)
+
+	aggregation := r.For(SubsystemAggregation)
+	other := r.For("other")
+
+	aggregation.V(1).Info("visible")
+	aggregation.V(2).Info("hidden")
+	other.V(1).Info("visible")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], SubsystemAggregation)
+
+	// Отключаем info-логи агрегации в runtime, ошибки продолжают логироваться
+	r.SetVerbosity(SubsystemAggregation, -1)
+	aggregation.Info("hidden")
+	aggregation.Error(nil, "still logged")
+	other.Info("visible")
+	assert.Len(t, lines, 4)
+
+	r.ResetVerbosity(SubsystemAggregation)
+	aggregation.V(1).Info("visible again")
+	assert.Len(t, lines, 5)
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	var lines []string
+	r := newTestRegistry(&lines)
+
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/logging?subsystem=aggregation&v=error", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"default":0,"subsystems":{"aggregation":-1}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/debug/logging?subsystem=aggregation&v=loud", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestRegistry_Configure(t *testing.T) {
+	r := NewRegistry()
+	_, err := r.Configure(Options{Level: "DEBUG", Format: FormatJSON, Subsystems: map[string]int{"sync": 2}})
+	require.NoError(t, err)
+
+	defaultV, subsystems := r.Levels()
+	assert.Equal(t, 4, defaultV)
+	assert.Equal(t, map[string]int{"sync": 2}, subsystems)
+
+	_, err = r.Configure(Options{Format: "xml"})
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/sync/synchronizer"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// HostQuarantine implements synchronizer.HostQuarantine on top of ports.Quarantine.
+// The document of a quarantined host is its types.HostIPSetUpdate in JSON.
+type HostQuarantine struct {
+	quarantine ports.Quarantine
+}
+
+// NewHostQuarantine creates a HostQuarantine storing hosts in the quarantine
+func NewHostQuarantine(quarantine ports.Quarantine) synchronizer.HostQuarantine {
+	return &HostQuarantine{
+		quarantine: quarantine,
+	}
+}
+
+// QuarantineHost stores the host update rejected by the reverse sync
+func (q *HostQuarantine) QuarantineHost(ctx context.Context, update types.HostIPSetUpdate, reasons []string) error {
+	document, err := json.MarshalIndent(update, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal host %s update: %w", update.HostID, err)
+	}
+
+	_, err = q.quarantine.Quarantine(ctx, models.QuarantinedResource{
+		Source:             models.QuarantineSourceReverseSync,
+		Kind:               "Host",
+		ResourceIdentifier: models.NewResourceIdentifier(update.Name, models.WithNamespace(update.Namespace)),
+		Reasons:            reasons,
+		Document:           document,
+	})
+	return err
+}
+
+// PromoteQuarantinedHost returns the promoter of hosts quarantined by the reverse sync:
+// the IP set of the (reviewed) document is validated and written to the quarantined host
+func PromoteQuarantinedHost(hostWriter synchronizer.HostWriter) func(ctx context.Context, resource models.QuarantinedResource) error {
+	return func(ctx context.Context, resource models.QuarantinedResource) error {
+		var update types.HostIPSetUpdate
+		if err := json.Unmarshal(resource.Document, &update); err != nil {
+			return fmt.Errorf("failed to decode host update: %w", err)
+		}
+		if len(update.IPSet) == 0 {
+			return fmt.Errorf("host update has no IP addresses")
+		}
+		for _, ip := range update.IPSet {
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("invalid IP address %q", ip)
+			}
+		}
+
+		// The quarantined host is updated even if the document names another one
+		return hostWriter.UpdateHostIPSet(ctx, resource.Key(), update.IPSet)
+	}
+}
@@ -25,7 +25,7 @@ func NewPostgreSQLHostReader(registry ports.Registry) synchronizer.HostReader {
 
 // GetHostsWithoutIPSet returns hosts that don't have IPSet filled
 func (r *PostgreSQLHostReader) GetHostsWithoutIPSet(ctx context.Context, namespace string) ([]models.Host, error) {
-	reader, err := r.registry.Reader(ctx)
+	reader, err := r.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -57,7 +57,7 @@ func (r *PostgreSQLHostReader) GetHostsWithoutIPSet(ctx context.Context, namespa
 
 // GetHostByUUID returns a host by its UUID
 func (r *PostgreSQLHostReader) GetHostByUUID(ctx context.Context, uuid string) (*models.Host, error) {
-	reader, err := r.registry.Reader(ctx)
+	reader, err := r.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -86,7 +86,7 @@ func (r *PostgreSQLHostReader) GetHostByUUID(ctx context.Context, uuid string) (
 
 // ListHosts lists hosts by identifiers (namespace, name pairs)
 func (r *PostgreSQLHostReader) ListHosts(ctx context.Context, identifiers []synchronizer.HostIdentifier) ([]models.Host, error) {
-	reader, err := r.registry.Reader(ctx)
+	reader, err := r.registry.PrimaryReader(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -119,26 +119,29 @@ func (r *PostgreSQLHostReader) ListHosts(ctx context.Context, identifiers []sync
 
 // PostgreSQLHostWriter implements synchronizer.HostWriter using PostgreSQL registry
 type PostgreSQLHostWriter struct {
-	registry ports.Registry
+	registry   ports.Registry
+	namespaces *synchronizer.NamespaceMapper
 }
 
-// NewPostgreSQLHostWriter creates a new PostgreSQL-based HostWriter
-func NewPostgreSQLHostWriter(registry ports.Registry) synchronizer.HostWriter {
+// NewPostgreSQLHostWriter creates a new PostgreSQL-based HostWriter.
+// Host IDs without namespace are mapped by the namespace rules, nil keeps the default namespace.
+func NewPostgreSQLHostWriter(registry ports.Registry, namespaces *synchronizer.NamespaceMapper) synchronizer.HostWriter {
 	return &PostgreSQLHostWriter{
-		registry: registry,
+		registry:   registry,
+		namespaces: namespaces,
 	}
 }
 
 // UpdateHostIPSet updates the IPSet for a specific host
 func (w *PostgreSQLHostWriter) UpdateHostIPSet(ctx context.Context, hostID string, ipSet []string) error {
 	// Parse hostID to get namespace and name
-	namespace, name, err := parseHostID(hostID)
+	namespace, name, err := w.parseHostID(hostID)
 	if err != nil {
 		return fmt.Errorf("invalid host ID %s: %w", hostID, err)
 	}
 
 	// Create a reader to get the existing host
-	reader, err := w.registry.Reader(ctx)
+	reader, err := w.registry.PrimaryReader(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get reader: %w", err)
 	}
@@ -197,8 +200,9 @@ func (w *PostgreSQLHostWriter) UpdateHostsIPSet(ctx context.Context, updates []t
 	return nil
 }
 
-// parseHostID parses a host ID in format "namespace/name" into components
-func parseHostID(hostID string) (namespace, name string, err error) {
+// parseHostID parses a host ID in format "namespace/name" into components,
+// other IDs are mapped by the namespace rules
+func (w *PostgreSQLHostWriter) parseHostID(hostID string) (namespace, name string, err error) {
 	// Host ID format is based on Key() method: "namespace/name" or just "name" for default namespace
 	if hostID == "" {
 		return "", "", fmt.Errorf("host ID cannot be empty")
@@ -211,8 +215,11 @@ func parseHostID(hostID string) (namespace, name string, err error) {
 		if name == "" {
 			return "", "", fmt.Errorf("invalid host ID format, empty name: %s", hostID)
 		}
+	} else if id, ok := w.namespaces.Map(hostID); ok {
+		namespace = id.Namespace
+		name = id.Name
 	} else {
-		// No namespace separator, use default namespace
+		// No namespace separator and no mapping rule, use default namespace
 		namespace = "default"
 		name = hostID
 	}
@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/sync/synchronizer"
+)
+
+// PostgreSQLNetworkReader implements synchronizer.NetworkReader using PostgreSQL registry
+type PostgreSQLNetworkReader struct {
+	registry ports.Registry
+}
+
+// NewPostgreSQLNetworkReader creates a new PostgreSQL-based NetworkReader
+func NewPostgreSQLNetworkReader(registry ports.Registry) synchronizer.NetworkReader {
+	return &PostgreSQLNetworkReader{
+		registry: registry,
+	}
+}
+
+// ListNetworks returns networks of all namespaces
+func (r *PostgreSQLNetworkReader) ListNetworks(ctx context.Context) ([]models.Network, error) {
+	reader, err := r.registry.PrimaryReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reader: %w", err)
+	}
+	defer reader.Close()
+
+	var networks []models.Network
+	err = reader.ListNetworks(ctx, func(network models.Network) error {
+		networks = append(networks, network)
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	return networks, nil
+}
+
+// PostgreSQLNetworkWriter implements synchronizer.NetworkWriter using PostgreSQL registry
+type PostgreSQLNetworkWriter struct {
+	registry ports.Registry
+}
+
+// NewPostgreSQLNetworkWriter creates a new PostgreSQL-based NetworkWriter
+func NewPostgreSQLNetworkWriter(registry ports.Registry) synchronizer.NetworkWriter {
+	return &PostgreSQLNetworkWriter{
+		registry: registry,
+	}
+}
+
+// UpsertNetworks creates or updates networks in a single transaction
+func (w *PostgreSQLNetworkWriter) UpsertNetworks(ctx context.Context, networks []models.Network) error {
+	writer, err := w.registry.Writer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get writer: %w", err)
+	}
+	defer writer.Abort()
+
+	ids := make([]models.ResourceIdentifier, 0, len(networks))
+	for _, network := range networks {
+		ids = append(ids, network.ResourceIdentifier)
+	}
+
+	err = writer.SyncNetworks(ctx, networks, ports.NewResourceIdentifierScope(ids...), ports.WithSyncOp(models.SyncOpUpsert))
+	if err != nil {
+		return fmt.Errorf("failed to upsert networks: %w", err)
+	}
+
+	if err := writer.Commit(); err != nil {
+		return fmt.Errorf("failed to commit networks: %w", err)
+	}
+	return nil
+}
+
+// PostgreSQLAddressGroupReader implements synchronizer.AddressGroupReader using PostgreSQL registry
+type PostgreSQLAddressGroupReader struct {
+	registry ports.Registry
+}
+
+// NewPostgreSQLAddressGroupReader creates a new PostgreSQL-based AddressGroupReader
+func NewPostgreSQLAddressGroupReader(registry ports.Registry) synchronizer.AddressGroupReader {
+	return &PostgreSQLAddressGroupReader{
+		registry: registry,
+	}
+}
+
+// ListAddressGroups returns address groups of all namespaces
+func (r *PostgreSQLAddressGroupReader) ListAddressGroups(ctx context.Context) ([]models.AddressGroup, error) {
+	reader, err := r.registry.PrimaryReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reader: %w", err)
+	}
+	defer reader.Close()
+
+	var addressGroups []models.AddressGroup
+	err = reader.ListAddressGroups(ctx, func(addressGroup models.AddressGroup) error {
+		addressGroups = append(addressGroups, addressGroup)
+		return nil
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list address groups: %w", err)
+	}
+
+	return addressGroups, nil
+}
+
+// PostgreSQLAddressGroupWriter implements synchronizer.AddressGroupWriter using PostgreSQL registry
+type PostgreSQLAddressGroupWriter struct {
+	registry ports.Registry
+}
+
+// NewPostgreSQLAddressGroupWriter creates a new PostgreSQL-based AddressGroupWriter
+func NewPostgreSQLAddressGroupWriter(registry ports.Registry) synchronizer.AddressGroupWriter {
+	return &PostgreSQLAddressGroupWriter{
+		registry: registry,
+	}
+}
+
+// UpsertAddressGroups creates or updates address groups in a single transaction
+func (w *PostgreSQLAddressGroupWriter) UpsertAddressGroups(ctx context.Context, addressGroups []models.AddressGroup) error {
+	writer, err := w.registry.Writer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get writer: %w", err)
+	}
+	defer writer.Abort()
+
+	ids := make([]models.ResourceIdentifier, 0, len(addressGroups))
+	for _, addressGroup := range addressGroups {
+		ids = append(ids, addressGroup.ResourceIdentifier)
+	}
+
+	err = writer.SyncAddressGroups(ctx, addressGroups, ports.NewResourceIdentifierScope(ids...), ports.WithSyncOp(models.SyncOpUpsert))
+	if err != nil {
+		return fmt.Errorf("failed to upsert address groups: %w", err)
+	}
+
+	if err := writer.Commit(); err != nil {
+		return fmt.Errorf("failed to commit address groups: %w", err)
+	}
+	return nil
+}
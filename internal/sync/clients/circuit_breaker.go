@@ -0,0 +1,382 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// ErrCircuitOpen is returned without calling sgroups while the circuit breaker is open.
+// It carries codes.Unavailable, so callers treat it as sgroups unavailability.
+var ErrCircuitOpen = status.Error(codes.Unavailable, "sgroups circuit breaker is open")
+
+// CircuitBreakerConfig holds circuit breaker configuration of a sgroups client
+type CircuitBreakerConfig struct {
+	// Enabled determines if calls go through the circuit breaker
+	Enabled bool `yaml:"enabled"`
+	// FailureThreshold is the number of consecutive failures opening the circuit
+	FailureThreshold int `yaml:"failure_threshold"`
+	// OpenTimeout is the time the circuit stays open before a trial call is let through
+	OpenTimeout time.Duration `yaml:"open_timeout"`
+}
+
+// DefaultCircuitBreakerConfig returns default circuit breaker configuration
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		Enabled:          true,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+	}
+}
+
+// CircuitState is the state of a circuit breaker
+type CircuitState int
+
+const (
+	// CircuitClosed - calls reach sgroups
+	CircuitClosed CircuitState = iota
+	// CircuitOpen - calls fail fast with ErrCircuitOpen
+	CircuitOpen
+	// CircuitHalfOpen - a single trial call decides whether the circuit closes again
+	CircuitHalfOpen
+)
+
+// String returns the state name
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitBreakerStats are the circuit breaker counters
+type CircuitBreakerStats struct {
+	Name                string    `json:"name"`
+	State               string    `json:"state"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	Failures            int64     `json:"failures"`
+	Rejected            int64     `json:"rejected"`
+	Opened              int64     `json:"opened"`
+	OpenedAt            time.Time `json:"openedAt,omitempty"`
+}
+
+// CircuitBreakerGateway wraps a sgroups gateway with a circuit breaker.
+// The circuit opens after FailureThreshold consecutive failures caused by sgroups
+// unavailability, fails fast while open and lets a trial call through every OpenTimeout.
+type CircuitBreakerGateway struct {
+	gateway interfaces.SGroupGateway
+	config  CircuitBreakerConfig
+	name    string
+
+	mu       sync.Mutex
+	state    CircuitState
+	trial    bool
+	stats    CircuitBreakerStats
+	listener func(CircuitState)
+	now      func() time.Time
+}
+
+var (
+	_ interfaces.SGroupGateway     = &CircuitBreakerGateway{}
+	_ interfaces.SGroupStateLister = &CircuitBreakerGateway{}
+)
+
+// NewCircuitBreakerGateway wraps gateway with a circuit breaker, name identifies it in metrics
+func NewCircuitBreakerGateway(gateway interfaces.SGroupGateway, config CircuitBreakerConfig, name string) *CircuitBreakerGateway {
+	defaults := DefaultCircuitBreakerConfig()
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaults.FailureThreshold
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = defaults.OpenTimeout
+	}
+	return &CircuitBreakerGateway{
+		gateway: gateway,
+		config:  config,
+		name:    name,
+		stats:   CircuitBreakerStats{Name: name},
+		now:     time.Now,
+	}
+}
+
+// OnStateChange sets the listener called in a separate goroutine after every state change
+func (b *CircuitBreakerGateway) OnStateChange(listener func(CircuitState)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.listener = listener
+}
+
+// State returns the current state
+func (b *CircuitBreakerGateway) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState()
+}
+
+// Stats returns a copy of the circuit breaker counters
+func (b *CircuitBreakerGateway) Stats() CircuitBreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	stats := b.stats
+	stats.State = b.currentState().String()
+	return stats
+}
+
+// currentState reports an open circuit whose timeout elapsed as half-open
+func (b *CircuitBreakerGateway) currentState() CircuitState {
+	if b.state == CircuitOpen && b.now().Sub(b.stats.OpenedAt) >= b.config.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// allow returns ErrCircuitOpen if the call must not reach sgroups and whether the call is a half-open trial
+func (b *CircuitBreakerGateway) allow() (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.currentState() {
+	case CircuitOpen:
+		b.stats.Rejected++
+		return false, ErrCircuitOpen
+	case CircuitHalfOpen:
+		// Only one trial call at a time, the others fail fast until it returns
+		if b.trial {
+			b.stats.Rejected++
+			return false, ErrCircuitOpen
+		}
+		b.trial = true
+		b.setState(CircuitHalfOpen)
+		return true, nil
+	}
+	return false, nil
+}
+
+// done records the result of a call let through by allow
+func (b *CircuitBreakerGateway) done(trial bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if trial {
+		b.trial = false
+	}
+
+	if !isUnavailable(err) {
+		b.stats.ConsecutiveFailures = 0
+		if b.state != CircuitClosed {
+			b.setState(CircuitClosed)
+		}
+		return
+	}
+
+	b.stats.Failures++
+	b.stats.ConsecutiveFailures++
+	if trial || b.state == CircuitClosed && b.stats.ConsecutiveFailures >= b.config.FailureThreshold {
+		b.stats.Opened++
+		b.stats.OpenedAt = b.now()
+		b.setState(CircuitOpen)
+	}
+}
+
+func (b *CircuitBreakerGateway) setState(state CircuitState) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	if listener := b.listener; listener != nil {
+		go listener(state)
+	}
+}
+
+// isUnavailable reports whether the error means sgroups is unavailable. Errors caused by
+// the request itself, e.g. InvalidArgument, and canceled calls don't count as failures.
+func isUnavailable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// call executes fn through the circuit breaker
+func call[T any](b *CircuitBreakerGateway, fn func() (T, error)) (T, error) {
+	trial, err := b.allow()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	result, err := fn()
+	b.done(trial, err)
+	return result, err
+}
+
+// Sync sends a synchronization request to sgroups
+func (b *CircuitBreakerGateway) Sync(ctx context.Context, req *types.SyncRequest) error {
+	_, err := call(b, func() (struct{}, error) {
+		return struct{}{}, b.gateway.Sync(ctx, req)
+	})
+	return err
+}
+
+// Health checks the health of sgroups service
+func (b *CircuitBreakerGateway) Health(ctx context.Context) error {
+	_, err := call(b, func() (struct{}, error) {
+		return struct{}{}, b.gateway.Health(ctx)
+	})
+	return err
+}
+
+// GetStatuses returns a channel of timestamp updates from SGROUP, only opening the stream is guarded
+func (b *CircuitBreakerGateway) GetStatuses(ctx context.Context) (chan *timestamppb.Timestamp, error) {
+	return call(b, func() (chan *timestamppb.Timestamp, error) {
+		return b.gateway.GetStatuses(ctx)
+	})
+}
+
+// GetHostsByUUIDs retrieves hosts from SGROUP by their UUIDs
+func (b *CircuitBreakerGateway) GetHostsByUUIDs(ctx context.Context, uuids []string) ([]*pb.Host, error) {
+	return call(b, func() ([]*pb.Host, error) {
+		return b.gateway.GetHostsByUUIDs(ctx, uuids)
+	})
+}
+
+// ListAllHosts retrieves all hosts from SGROUP
+func (b *CircuitBreakerGateway) ListAllHosts(ctx context.Context) ([]*pb.Host, error) {
+	return call(b, func() ([]*pb.Host, error) {
+		return b.gateway.ListAllHosts(ctx)
+	})
+}
+
+// GetHostsInSecurityGroup retrieves hosts from SGROUP that belong to specific security groups
+func (b *CircuitBreakerGateway) GetHostsInSecurityGroup(ctx context.Context, sgNames []string) ([]*pb.Host, error) {
+	return call(b, func() ([]*pb.Host, error) {
+		return b.gateway.GetHostsInSecurityGroup(ctx, sgNames)
+	})
+}
+
+// ListSecurityGroups retrieves all security groups, if the wrapped gateway can list them
+func (b *CircuitBreakerGateway) ListSecurityGroups(ctx context.Context) ([]*pb.SecGroup, error) {
+	lister, err := b.lister()
+	if err != nil {
+		return nil, err
+	}
+	return call(b, func() ([]*pb.SecGroup, error) {
+		return lister.ListSecurityGroups(ctx)
+	})
+}
+
+// ListNetworks retrieves all networks, if the wrapped gateway can list them
+func (b *CircuitBreakerGateway) ListNetworks(ctx context.Context) ([]*pb.Network, error) {
+	lister, err := b.lister()
+	if err != nil {
+		return nil, err
+	}
+	return call(b, func() ([]*pb.Network, error) {
+		return lister.ListNetworks(ctx)
+	})
+}
+
+// ListIESgSgRules retrieves all IESgSgRules, if the wrapped gateway can list them
+func (b *CircuitBreakerGateway) ListIESgSgRules(ctx context.Context) ([]*pb.IESgSgRule, error) {
+	lister, err := b.lister()
+	if err != nil {
+		return nil, err
+	}
+	return call(b, func() ([]*pb.IESgSgRule, error) {
+		return lister.ListIESgSgRules(ctx)
+	})
+}
+
+func (b *CircuitBreakerGateway) lister() (interfaces.SGroupStateLister, error) {
+	lister, ok := b.gateway.(interfaces.SGroupStateLister)
+	if !ok {
+		return nil, fmt.Errorf("listing sgroups state is not supported by %T", b.gateway)
+	}
+	return lister, nil
+}
+
+// Close closes the wrapped gateway
+func (b *CircuitBreakerGateway) Close() error {
+	return b.gateway.Close()
+}
+
+// WriteMetrics writes circuit breaker metrics in the Prometheus text format
+func WriteMetrics(w io.Writer, breakers ...*CircuitBreakerGateway) error {
+	metrics := []struct {
+		name, help, kind string
+		value            func(CircuitBreakerStats, *CircuitBreakerGateway) float64
+	}{
+		{"netguard_sgroups_circuit_breaker_state", "Circuit breaker state: 0 closed, 1 open, 2 half-open", "gauge",
+			func(_ CircuitBreakerStats, b *CircuitBreakerGateway) float64 { return float64(b.State()) }},
+		{"netguard_sgroups_circuit_breaker_consecutive_failures", "Consecutive failed sgroups calls", "gauge",
+			func(s CircuitBreakerStats, _ *CircuitBreakerGateway) float64 { return float64(s.ConsecutiveFailures) }},
+		{"netguard_sgroups_circuit_breaker_failures_total", "Failed sgroups calls", "counter",
+			func(s CircuitBreakerStats, _ *CircuitBreakerGateway) float64 { return float64(s.Failures) }},
+		{"netguard_sgroups_circuit_breaker_rejected_total", "Calls rejected while the circuit was open", "counter",
+			func(s CircuitBreakerStats, _ *CircuitBreakerGateway) float64 { return float64(s.Rejected) }},
+		{"netguard_sgroups_circuit_breaker_opened_total", "Times the circuit opened", "counter",
+			func(s CircuitBreakerStats, _ *CircuitBreakerGateway) float64 { return float64(s.Opened) }},
+	}
+
+	stats := make([]CircuitBreakerStats, len(breakers))
+	for i, breaker := range breakers {
+		stats[i] = breaker.Stats()
+	}
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind); err != nil {
+			return err
+		}
+		for i, breaker := range breakers {
+			if _, err := fmt.Fprintf(w, "%s{target=%q} %g\n", metric.name, breaker.name, metric.value(stats[i], breaker)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MetricsHandler serves circuit breaker and connection metrics in the Prometheus text format,
+// followed by the metrics of extra writers
+func MetricsHandler(breakers []*CircuitBreakerGateway, monitors []*ConnectionMonitor, extra ...func(io.Writer) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if err := WriteMetrics(w, breakers...); err != nil {
+			return
+		}
+		if err := WriteConnectionMetrics(w, monitors...); err != nil {
+			return
+		}
+		for _, write := range extra {
+			if err := write(w); err != nil {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// fakeGateway returns err from every call
+type fakeGateway struct {
+	err   error
+	calls int
+}
+
+func (g *fakeGateway) Sync(context.Context, *types.SyncRequest) error {
+	g.calls++
+	return g.err
+}
+func (g *fakeGateway) Health(context.Context) error { return g.err }
+func (g *fakeGateway) GetStatuses(context.Context) (chan *timestamppb.Timestamp, error) {
+	return nil, g.err
+}
+func (g *fakeGateway) Close() error { return nil }
+func (g *fakeGateway) GetHostsByUUIDs(context.Context, []string) ([]*pb.Host, error) {
+	return nil, g.err
+}
+func (g *fakeGateway) ListAllHosts(context.Context) ([]*pb.Host, error) { return nil, g.err }
+func (g *fakeGateway) GetHostsInSecurityGroup(context.Context, []string) ([]*pb.Host, error) {
+	return nil, g.err
+}
+
+func TestCircuitBreakerGateway_OpensAndRecovers(t *testing.T) {
+	gateway := &fakeGateway{err: status.Error(codes.Unavailable, "connection refused")}
+	breaker := NewCircuitBreakerGateway(gateway, CircuitBreakerConfig{Enabled: true, FailureThreshold: 3, OpenTimeout: time.Minute}, "default")
+	now := time.Now()
+	breaker.now = func() time.Time { return now }
+
+	states := make(chan CircuitState, 4)
+	breaker.OnStateChange(func(state CircuitState) { states <- state })
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		assert.Error(t, breaker.Sync(ctx, &types.SyncRequest{}))
+	}
+	assert.Equal(t, CircuitOpen, breaker.State())
+	assert.Equal(t, CircuitOpen, <-states)
+
+	// Open circuit fails fast without calling sgroups
+	assert.ErrorIs(t, breaker.Sync(ctx, &types.SyncRequest{}), ErrCircuitOpen)
+	assert.Equal(t, 3, gateway.calls)
+
+	// Failed trial call opens the circuit again
+	now = now.Add(time.Minute)
+	assert.Equal(t, CircuitHalfOpen, breaker.State())
+	assert.Error(t, breaker.Sync(ctx, &types.SyncRequest{}))
+	assert.Equal(t, 4, gateway.calls)
+	assert.Equal(t, CircuitOpen, breaker.State())
+
+	// Successful trial call closes the circuit
+	now = now.Add(time.Minute)
+	gateway.err = nil
+	require.NoError(t, breaker.Sync(ctx, &types.SyncRequest{}))
+	assert.Equal(t, CircuitClosed, breaker.State())
+
+	stats := breaker.Stats()
+	assert.EqualValues(t, 4, stats.Failures)
+	assert.EqualValues(t, 1, stats.Rejected)
+	assert.EqualValues(t, 2, stats.Opened)
+	assert.Zero(t, stats.ConsecutiveFailures)
+}
+
+func TestCircuitBreakerGateway_IgnoresRequestErrors(t *testing.T) {
+	gateway := &fakeGateway{err: status.Error(codes.InvalidArgument, "bad request")}
+	breaker := NewCircuitBreakerGateway(gateway, CircuitBreakerConfig{Enabled: true, FailureThreshold: 1}, "default")
+
+	for i := 0; i < 3; i++ {
+		assert.Error(t, breaker.Sync(context.Background(), &types.SyncRequest{}))
+	}
+	assert.Equal(t, CircuitClosed, breaker.State())
+	assert.Equal(t, 3, gateway.calls)
+}
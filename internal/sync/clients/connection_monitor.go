@@ -0,0 +1,233 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+)
+
+// ConnectionConfig holds health checking configuration of a sgroups connection
+type ConnectionConfig struct {
+	// CheckInterval is the time between health checks of a reachable sgroups
+	CheckInterval time.Duration `yaml:"check_interval"`
+	// InitialBackoff is the delay before the first retry after a failed health check
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	// MaxBackoff limits the doubling delay between retries of an unreachable sgroups
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+}
+
+// DefaultConnectionConfig returns default health checking configuration
+func DefaultConnectionConfig() ConnectionConfig {
+	return ConnectionConfig{
+		CheckInterval:  30 * time.Second,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+// ConnectionState is the state of a sgroups connection
+type ConnectionState int
+
+const (
+	// ConnectionConnecting - sgroups was never reached yet
+	ConnectionConnecting ConnectionState = iota
+	// ConnectionReady - the last health check succeeded
+	ConnectionReady
+	// ConnectionLost - sgroups was reached before, the last health check failed
+	ConnectionLost
+)
+
+// String returns the state name
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionConnecting:
+		return "connecting"
+	case ConnectionReady:
+		return "ready"
+	case ConnectionLost:
+		return "lost"
+	}
+	return fmt.Sprintf("ConnectionState(%d)", int(s))
+}
+
+// ConnectionStats describes the connection to a sgroups instance
+type ConnectionStats struct {
+	Name                string    `json:"name"`
+	State               string    `json:"state"`
+	Since               time.Time `json:"since"`
+	LastCheck           time.Time `json:"lastCheck,omitempty"`
+	LastError           string    `json:"lastError,omitempty"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	Checks              int64     `json:"checks"`
+	Failures            int64     `json:"failures"`
+}
+
+// ConnectionMonitor health checks a sgroups gateway in the background. It retries an
+// unreachable sgroups with exponential backoff, so a sgroups down at startup doesn't
+// disable synchronization: syncs fail and are retried until the connection is ready.
+type ConnectionMonitor struct {
+	gateway interfaces.SGroupGateway
+	config  ConnectionConfig
+	name    string
+
+	mu       sync.Mutex
+	state    ConnectionState
+	stats    ConnectionStats
+	listener func(ConnectionState)
+	ready    chan struct{}
+	now      func() time.Time
+}
+
+// NewConnectionMonitor creates a monitor of gateway, name identifies it in health and metrics
+func NewConnectionMonitor(gateway interfaces.SGroupGateway, config ConnectionConfig, name string) *ConnectionMonitor {
+	defaults := DefaultConnectionConfig()
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaults.CheckInterval
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = config.InitialBackoff
+	}
+	return &ConnectionMonitor{
+		gateway: gateway,
+		config:  config,
+		name:    name,
+		stats:   ConnectionStats{Name: name, Since: time.Now()},
+		ready:   make(chan struct{}),
+		now:     time.Now,
+	}
+}
+
+// Name returns the monitored sgroups target
+func (m *ConnectionMonitor) Name() string {
+	return m.name
+}
+
+// OnStateChange sets the listener called in a separate goroutine after every state change
+func (m *ConnectionMonitor) OnStateChange(listener func(ConnectionState)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listener = listener
+}
+
+// State returns the connection state
+func (m *ConnectionMonitor) State() ConnectionState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state
+}
+
+// Stats returns the connection statistics
+func (m *ConnectionMonitor) Stats() ConnectionStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := m.stats
+	stats.State = m.state.String()
+	return stats
+}
+
+// Ready returns a channel closed once sgroups was reached for the first time
+func (m *ConnectionMonitor) Ready() <-chan struct{} {
+	return m.ready
+}
+
+// Check health checks sgroups once and updates the connection state
+func (m *ConnectionMonitor) Check(ctx context.Context) error {
+	err := m.gateway.Health(ctx)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.stats.Checks++
+	m.stats.LastCheck = now
+
+	previous := m.state
+	if err == nil {
+		m.stats.ConsecutiveFailures = 0
+		m.stats.LastError = ""
+		m.state = ConnectionReady
+		select {
+		case <-m.ready:
+		default:
+			close(m.ready)
+		}
+	} else {
+		m.stats.Failures++
+		m.stats.ConsecutiveFailures++
+		m.stats.LastError = err.Error()
+		if previous == ConnectionReady {
+			m.state = ConnectionLost
+		}
+	}
+
+	if m.state != previous {
+		m.stats.Since = now
+		if listener := m.listener; listener != nil {
+			state := m.state
+			go listener(state)
+		}
+	}
+	return err
+}
+
+// Run health checks sgroups until ctx is canceled
+func (m *ConnectionMonitor) Run(ctx context.Context) {
+	backoff := m.config.InitialBackoff
+	for {
+		delay := m.config.CheckInterval
+		if err := m.Check(ctx); err != nil {
+			delay = backoff
+			backoff = min(backoff*2, m.config.MaxBackoff)
+		} else {
+			backoff = m.config.InitialBackoff
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// WriteConnectionMetrics writes connection metrics in the Prometheus text format
+func WriteConnectionMetrics(w io.Writer, monitors ...*ConnectionMonitor) error {
+	metrics := []struct {
+		name, help, kind string
+		value            func(ConnectionStats, *ConnectionMonitor) float64
+	}{
+		{"netguard_sgroups_connection_state", "Connection state: 0 connecting, 1 ready, 2 lost", "gauge",
+			func(_ ConnectionStats, m *ConnectionMonitor) float64 { return float64(m.State()) }},
+		{"netguard_sgroups_connection_consecutive_failures", "Consecutive failed sgroups health checks", "gauge",
+			func(s ConnectionStats, _ *ConnectionMonitor) float64 { return float64(s.ConsecutiveFailures) }},
+		{"netguard_sgroups_connection_checks_total", "sgroups health checks", "counter",
+			func(s ConnectionStats, _ *ConnectionMonitor) float64 { return float64(s.Checks) }},
+		{"netguard_sgroups_connection_failures_total", "Failed sgroups health checks", "counter",
+			func(s ConnectionStats, _ *ConnectionMonitor) float64 { return float64(s.Failures) }},
+	}
+
+	stats := make([]ConnectionStats, len(monitors))
+	for i, monitor := range monitors {
+		stats[i] = monitor.Stats()
+	}
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind); err != nil {
+			return err
+		}
+		for i, monitor := range monitors {
+			if _, err := fmt.Fprintf(w, "%s{target=%q} %g\n", metric.name, monitor.name, metric.value(stats[i], monitor)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
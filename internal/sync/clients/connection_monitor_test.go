@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConnectionMonitor_ReconnectsAfterStartupFailure(t *testing.T) {
+	gateway := &fakeGateway{err: status.Error(codes.Unavailable, "connection refused")}
+	monitor := NewConnectionMonitor(gateway, ConnectionConfig{}, "default")
+
+	states := make(chan ConnectionState, 4)
+	monitor.OnStateChange(func(state ConnectionState) { states <- state })
+
+	ctx := context.Background()
+	require.Error(t, monitor.Check(ctx))
+	require.Error(t, monitor.Check(ctx))
+	assert.Equal(t, ConnectionConnecting, monitor.State())
+	assert.Equal(t, 2, monitor.Stats().ConsecutiveFailures)
+	select {
+	case <-monitor.Ready():
+		t.Fatal("monitor is ready before sgroups was reached")
+	default:
+	}
+
+	gateway.err = nil
+	require.NoError(t, monitor.Check(ctx))
+	assert.Equal(t, ConnectionReady, <-states)
+	<-monitor.Ready()
+
+	stats := monitor.Stats()
+	assert.Equal(t, "ready", stats.State)
+	assert.Zero(t, stats.ConsecutiveFailures)
+	assert.Equal(t, int64(3), stats.Checks)
+	assert.Equal(t, int64(2), stats.Failures)
+
+	gateway.err = errors.New("connection reset")
+	require.Error(t, monitor.Check(ctx))
+	assert.Equal(t, ConnectionLost, <-states)
+	assert.Equal(t, "connection reset", monitor.Stats().LastError)
+}
+
+func TestWriteConnectionMetrics(t *testing.T) {
+	monitor := NewConnectionMonitor(&fakeGateway{}, ConnectionConfig{}, "default")
+	require.NoError(t, monitor.Check(context.Background()))
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteConnectionMetrics(&buf, monitor))
+	assert.Contains(t, buf.String(), `netguard_sgroups_connection_state{target="default"} 1`)
+	assert.Contains(t, buf.String(), `netguard_sgroups_connection_checks_total{target="default"} 1`)
+}
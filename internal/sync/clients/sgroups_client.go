@@ -28,6 +28,10 @@ type SGroupsConfig struct {
 	RequestTimeout time.Duration   `yaml:"request_timeout" env:"SGROUPS_REQUEST_TIMEOUT"`
 	KeepAlive      KeepAliveConfig `yaml:"keep_alive"`
 	TLS            TLSConfig       `yaml:"tls"`
+	// CircuitBreaker fails calls fast while sgroups is unavailable
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker"`
+	// Connection configures background health checks and reconnect backoff
+	Connection ConnectionConfig `yaml:"connection"`
 }
 
 // KeepAliveConfig holds gRPC keep-alive configuration
@@ -46,13 +50,15 @@ type TLSConfig struct {
 	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
 }
 
-// sgroupsClient implements SGroupGateway interface
+// sgroupsClient implements SGroupGateway and SGroupStateLister interfaces
 type sgroupsClient struct {
 	conn   *grpc.ClientConn
 	client pb.SecGroupServiceClient
 	config SGroupsConfig
 }
 
+var _ interfaces.SGroupStateLister = &sgroupsClient{}
+
 // NewSGroupsClient creates a new sgroups client
 func NewSGroupsClient(config SGroupsConfig) (interfaces.SGroupGateway, error) {
 	// Set default values
@@ -129,7 +135,7 @@ func (c *sgroupsClient) Sync(ctx context.Context, req *types.SyncRequest) error
 	defer cancel()
 
 	// Convert sync request to protobuf format
-	pbReq, err := c.convertSyncRequestToProto(req)
+	pbReq, err := convertSyncRequestToProto(req)
 	if err != nil {
 		return fmt.Errorf("failed to convert sync request to proto: %w", err)
 	}
@@ -259,6 +265,48 @@ func (c *sgroupsClient) GetHostsInSecurityGroup(ctx context.Context, sgNames []s
 	return resp.Hosts, nil
 }
 
+// ListSecurityGroups retrieves all security groups from SGROUP
+func (c *sgroupsClient) ListSecurityGroups(ctx context.Context) ([]*pb.SecGroup, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+
+	// Empty scope lists all security groups
+	resp, err := c.client.ListSecurityGroups(ctx, &pb.ListSecurityGroupsReq{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security groups: %w", err)
+	}
+
+	return resp.Groups, nil
+}
+
+// ListNetworks retrieves all networks from SGROUP
+func (c *sgroupsClient) ListNetworks(ctx context.Context) ([]*pb.Network, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+
+	// Empty scope lists all networks
+	resp, err := c.client.ListNetworks(ctx, &pb.ListNetworksReq{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	return resp.Networks, nil
+}
+
+// ListIESgSgRules retrieves all IESgSgRules from SGROUP
+func (c *sgroupsClient) ListIESgSgRules(ctx context.Context) ([]*pb.IESgSgRule, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+	defer cancel()
+
+	// Empty scope finds rules of all security groups
+	resp, err := c.client.FindIESgSgRules(ctx, &pb.FindIESgSgRulesReq{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IESgSgRules: %w", err)
+	}
+
+	return resp.Rules, nil
+}
+
 // Close closes the gRPC connection
 func (c *sgroupsClient) Close() error {
 	if c.conn != nil {
@@ -268,7 +316,7 @@ func (c *sgroupsClient) Close() error {
 }
 
 // convertSyncRequestToProto converts sync request to protobuf format
-func (c *sgroupsClient) convertSyncRequestToProto(req *types.SyncRequest) (*pb.SyncReq, error) {
+func convertSyncRequestToProto(req *types.SyncRequest) (*pb.SyncReq, error) {
 	pbReq := &pb.SyncReq{}
 
 	// Convert SyncOperation to pb.SyncReq_SyncOp
@@ -313,6 +361,9 @@ func (c *sgroupsClient) convertSyncRequestToProto(req *types.SyncRequest) (*pb.S
 		if rules, ok := req.Data.(*pb.SyncIESgSgRules); ok {
 			// Batch case: already aggregated by syncer
 			pbReq.Subject = &pb.SyncReq_IeSgSgRules{IeSgSgRules: rules}
+		} else if icmpRules, ok := req.Data.(*pb.SyncIESgSgIcmpRules); ok {
+			// ICMP rules of the same IEAgAgRule subject
+			pbReq.Subject = &pb.SyncReq_IeSgSgIcmpRules{IeSgSgIcmpRules: icmpRules}
 		} else if rule, ok := req.Data.(*models.IEAgAgRule); ok {
 			// Legacy single rule case (backward compatibility)
 			pbRule := convertIEAgAgRuleToSGroupsProto(*rule)
@@ -322,7 +373,7 @@ func (c *sgroupsClient) convertSyncRequestToProto(req *types.SyncRequest) (*pb.S
 				},
 			}
 		} else {
-			return nil, fmt.Errorf("invalid data type for IEAgAgRules subject, expected *pb.SyncIESgSgRules or *models.IEAgAgRule, got %T", req.Data)
+			return nil, fmt.Errorf("invalid data type for IEAgAgRules subject, expected *pb.SyncIESgSgRules, *pb.SyncIESgSgIcmpRules or *models.IEAgAgRule, got %T", req.Data)
 		}
 	default:
 		return nil, fmt.Errorf("unknown subject type: %s", req.SubjectType)
@@ -366,6 +417,12 @@ func convertIEAgAgRuleToSGroupsProto(rule models.IEAgAgRule) *pb.IESgSgRule {
 		action = pb.RuleAction_ACCEPT // default to ACCEPT
 	}
 
+	// Priority orders DROP above ACCEPT rules in sgroups, zero leaves the sgroups default
+	var priority *pb.RulePriority
+	if rule.Priority != 0 {
+		priority = &pb.RulePriority{Value: &pb.RulePriority_Some{Some: rule.Priority}}
+	}
+
 	// Convert Ports
 	var ports []*pb.AccPorts
 	for _, port := range rule.Ports {
@@ -386,6 +443,7 @@ func convertIEAgAgRuleToSGroupsProto(rule models.IEAgAgRule) *pb.IESgSgRule {
 		Logs:      rule.Logs,
 		Action:    action,
 		Trace:     rule.Trace,
+		Priority:  priority,
 	}
 }
 
@@ -402,5 +460,7 @@ func DefaultSGroupsConfig() SGroupsConfig {
 		TLS: TLSConfig{
 			Enabled: false,
 		},
+		CircuitBreaker: DefaultCircuitBreakerConfig(),
+		Connection:     DefaultConnectionConfig(),
 	}
 }
@@ -0,0 +1,199 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"netguard-pg-backend/internal/logging"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// ShadowConfig holds configuration of the sync shadow (dry-run) mode
+type ShadowConfig struct {
+	// Enabled converts sync requests to sgroups requests and records them instead of sending
+	Enabled bool `yaml:"enabled" env:"SYNC_SHADOW_ENABLED"`
+	// Keep is the number of the latest recorded requests kept in memory
+	Keep int `yaml:"keep" env:"SYNC_SHADOW_KEEP"`
+	// LogRequests logs every recorded request body, not only its summary
+	LogRequests bool `yaml:"log_requests" env:"SYNC_SHADOW_LOG_REQUESTS"`
+}
+
+// DefaultShadowKeep is the number of recorded requests kept when Keep is not set
+const DefaultShadowKeep = 1000
+
+// ShadowedSync is a sync request recorded instead of being sent to sgroups
+type ShadowedSync struct {
+	Time        time.Time `json:"time"`
+	Target      string    `json:"target"`
+	Operation   string    `json:"operation"`
+	SubjectType string    `json:"subjectType"`
+	// Request is the sgroups SyncReq in the protobuf JSON format
+	Request json.RawMessage `json:"request"`
+}
+
+// SyncShadow records sync requests of shadow gateways, the latest Keep of them are kept
+type SyncShadow struct {
+	config ShadowConfig
+
+	mu       sync.Mutex
+	requests []ShadowedSync
+	total    int64
+	now      func() time.Time
+	logger   logr.Logger
+}
+
+// NewSyncShadow creates a recorder of shadowed sync requests
+func NewSyncShadow(config ShadowConfig) *SyncShadow {
+	if config.Keep <= 0 {
+		config.Keep = DefaultShadowKeep
+	}
+	return &SyncShadow{config: config, now: time.Now, logger: logging.For(logging.SubsystemSync)}
+}
+
+// Record stores the request converted for target
+func (s *SyncShadow) Record(target string, req *types.SyncRequest, pbReq *pb.SyncReq) error {
+	body, err := protojson.Marshal(pbReq)
+	if err != nil {
+		return fmt.Errorf("failed to marshal shadowed sync request: %w", err)
+	}
+	shadowed := ShadowedSync{
+		Time:        s.now(),
+		Target:      target,
+		Operation:   string(req.Operation),
+		SubjectType: string(req.SubjectType),
+		Request:     body,
+	}
+
+	s.mu.Lock()
+	s.total++
+	s.requests = append(s.requests, shadowed)
+	if overflow := len(s.requests) - s.config.Keep; overflow > 0 {
+		s.requests = append(s.requests[:0:0], s.requests[overflow:]...)
+	}
+	s.mu.Unlock()
+
+	keysAndValues := []any{"operation", shadowed.Operation, "subjectType", shadowed.SubjectType, "target", target, "bytes", len(body)}
+	if s.config.LogRequests {
+		keysAndValues = append(keysAndValues, "request", string(body))
+	}
+	s.logger.Info("Shadowed sync request", keysAndValues...)
+	return nil
+}
+
+// Requests returns recorded requests, oldest first
+func (s *SyncShadow) Requests() []ShadowedSync {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ShadowedSync{}, s.requests...)
+}
+
+// Total returns the number of requests recorded since start, including dropped ones
+func (s *SyncShadow) Total() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.total
+}
+
+// ShadowGateway converts sync requests exactly as the sgroups client does and records them
+// in a SyncShadow instead of sending, so mapping correctness can be validated before going live.
+// Reads and health checks are delegated to the wrapped gateway.
+type ShadowGateway struct {
+	gateway interfaces.SGroupGateway
+	shadow  *SyncShadow
+	target  string
+}
+
+var (
+	_ interfaces.SGroupGateway     = &ShadowGateway{}
+	_ interfaces.SGroupStateLister = &ShadowGateway{}
+)
+
+// NewShadowGateway creates a gateway recording sync requests of target into shadow
+func NewShadowGateway(gateway interfaces.SGroupGateway, shadow *SyncShadow, target string) *ShadowGateway {
+	return &ShadowGateway{gateway: gateway, shadow: shadow, target: target}
+}
+
+// Sync records the synchronization request without sending it to sgroups
+func (g *ShadowGateway) Sync(_ context.Context, req *types.SyncRequest) error {
+	pbReq, err := convertSyncRequestToProto(req)
+	if err != nil {
+		g.shadow.logger.Error(err, "Failed to convert shadowed sync request",
+			"operation", req.Operation, "subjectType", req.SubjectType, "target", g.target)
+		return fmt.Errorf("failed to convert sync request to proto: %w", err)
+	}
+	return g.shadow.Record(g.target, req, pbReq)
+}
+
+// Health checks the health of sgroups service
+func (g *ShadowGateway) Health(ctx context.Context) error {
+	return g.gateway.Health(ctx)
+}
+
+// GetStatuses returns a channel of timestamp updates from SGROUP
+func (g *ShadowGateway) GetStatuses(ctx context.Context) (chan *timestamppb.Timestamp, error) {
+	return g.gateway.GetStatuses(ctx)
+}
+
+// GetHostsByUUIDs retrieves hosts from SGROUP by their UUIDs
+func (g *ShadowGateway) GetHostsByUUIDs(ctx context.Context, uuids []string) ([]*pb.Host, error) {
+	return g.gateway.GetHostsByUUIDs(ctx, uuids)
+}
+
+// ListAllHosts retrieves all hosts from SGROUP
+func (g *ShadowGateway) ListAllHosts(ctx context.Context) ([]*pb.Host, error) {
+	return g.gateway.ListAllHosts(ctx)
+}
+
+// GetHostsInSecurityGroup retrieves hosts from SGROUP that belong to specific security groups
+func (g *ShadowGateway) GetHostsInSecurityGroup(ctx context.Context, sgNames []string) ([]*pb.Host, error) {
+	return g.gateway.GetHostsInSecurityGroup(ctx, sgNames)
+}
+
+// ListSecurityGroups retrieves all security groups, if the wrapped gateway can list them
+func (g *ShadowGateway) ListSecurityGroups(ctx context.Context) ([]*pb.SecGroup, error) {
+	lister, err := g.lister()
+	if err != nil {
+		return nil, err
+	}
+	return lister.ListSecurityGroups(ctx)
+}
+
+// ListNetworks retrieves all networks, if the wrapped gateway can list them
+func (g *ShadowGateway) ListNetworks(ctx context.Context) ([]*pb.Network, error) {
+	lister, err := g.lister()
+	if err != nil {
+		return nil, err
+	}
+	return lister.ListNetworks(ctx)
+}
+
+// ListIESgSgRules retrieves all IESgSgRules, if the wrapped gateway can list them
+func (g *ShadowGateway) ListIESgSgRules(ctx context.Context) ([]*pb.IESgSgRule, error) {
+	lister, err := g.lister()
+	if err != nil {
+		return nil, err
+	}
+	return lister.ListIESgSgRules(ctx)
+}
+
+func (g *ShadowGateway) lister() (interfaces.SGroupStateLister, error) {
+	lister, ok := g.gateway.(interfaces.SGroupStateLister)
+	if !ok {
+		return nil, fmt.Errorf("listing sgroups state is not supported by %T", g.gateway)
+	}
+	return lister, nil
+}
+
+// Close closes the wrapped gateway
+func (g *ShadowGateway) Close() error {
+	return g.gateway.Close()
+}
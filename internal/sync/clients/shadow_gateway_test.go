@@ -0,0 +1,47 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/sync/types"
+)
+
+func TestShadowGateway_RecordsInsteadOfSending(t *testing.T) {
+	gateway := &fakeGateway{}
+	shadow := NewSyncShadow(ShadowConfig{Enabled: true, Keep: 2})
+	shadowGateway := NewShadowGateway(gateway, shadow, "default")
+
+	ctx := context.Background()
+	for _, name := range []string{"net-a", "net-b", "net-c"} {
+		require.NoError(t, shadowGateway.Sync(ctx, &types.SyncRequest{
+			Operation:   types.SyncOperationUpsert,
+			SubjectType: types.SyncSubjectTypeNetworks,
+			Data:        &pb.SyncNetworks{Networks: []*pb.Network{{Name: name}}},
+		}))
+	}
+	assert.Zero(t, gateway.calls, "shadowed requests must not reach sgroups")
+
+	requests := shadow.Requests()
+	require.Len(t, requests, 2, "only the latest Keep requests are kept")
+	assert.Equal(t, int64(3), shadow.Total())
+	assert.Equal(t, "default", requests[0].Target)
+	assert.Equal(t, "Upsert", requests[0].Operation)
+	assert.Equal(t, "Networks", requests[0].SubjectType)
+	assert.Contains(t, string(requests[0].Request), "net-b")
+	assert.Contains(t, string(requests[1].Request), "net-c")
+
+	// Mapping errors are reported as they would be by the sgroups client
+	assert.Error(t, shadowGateway.Sync(ctx, &types.SyncRequest{
+		Operation:   types.SyncOperationUpsert,
+		SubjectType: types.SyncSubjectTypeNetworks,
+		Data:        &pb.SyncHosts{},
+	}))
+	assert.Len(t, shadow.Requests(), 2)
+
+	require.NoError(t, shadowGateway.Health(ctx))
+}
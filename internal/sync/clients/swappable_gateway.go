@@ -0,0 +1,119 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// SwappableGateway delegates to a sgroups gateway that can be replaced at runtime,
+// e.g. when the sgroups endpoint is changed by a configuration reload.
+// Calls in flight finish on the gateway they started on.
+type SwappableGateway struct {
+	current atomic.Pointer[gatewayHolder]
+}
+
+// gatewayHolder boxes the interface value for atomic.Pointer
+type gatewayHolder struct {
+	gateway interfaces.SGroupGateway
+}
+
+var (
+	_ interfaces.SGroupGateway     = &SwappableGateway{}
+	_ interfaces.SGroupStateLister = &SwappableGateway{}
+)
+
+// NewSwappableGateway creates a gateway delegating to gateway until it is swapped
+func NewSwappableGateway(gateway interfaces.SGroupGateway) *SwappableGateway {
+	g := &SwappableGateway{}
+	g.current.Store(&gatewayHolder{gateway: gateway})
+	return g
+}
+
+// Swap replaces the gateway and closes the previous one
+func (g *SwappableGateway) Swap(gateway interfaces.SGroupGateway) error {
+	previous := g.current.Swap(&gatewayHolder{gateway: gateway})
+	return previous.gateway.Close()
+}
+
+// Gateway returns the current gateway
+func (g *SwappableGateway) Gateway() interfaces.SGroupGateway {
+	return g.current.Load().gateway
+}
+
+// Sync sends a synchronization request to sgroups
+func (g *SwappableGateway) Sync(ctx context.Context, req *types.SyncRequest) error {
+	return g.Gateway().Sync(ctx, req)
+}
+
+// Health checks the health of sgroups service
+func (g *SwappableGateway) Health(ctx context.Context) error {
+	return g.Gateway().Health(ctx)
+}
+
+// GetStatuses returns a channel of timestamp updates from SGROUP
+func (g *SwappableGateway) GetStatuses(ctx context.Context) (chan *timestamppb.Timestamp, error) {
+	return g.Gateway().GetStatuses(ctx)
+}
+
+// GetHostsByUUIDs retrieves hosts from SGROUP by their UUIDs
+func (g *SwappableGateway) GetHostsByUUIDs(ctx context.Context, uuids []string) ([]*pb.Host, error) {
+	return g.Gateway().GetHostsByUUIDs(ctx, uuids)
+}
+
+// ListAllHosts retrieves all hosts from SGROUP
+func (g *SwappableGateway) ListAllHosts(ctx context.Context) ([]*pb.Host, error) {
+	return g.Gateway().ListAllHosts(ctx)
+}
+
+// GetHostsInSecurityGroup retrieves hosts from SGROUP that belong to specific security groups
+func (g *SwappableGateway) GetHostsInSecurityGroup(ctx context.Context, sgNames []string) ([]*pb.Host, error) {
+	return g.Gateway().GetHostsInSecurityGroup(ctx, sgNames)
+}
+
+// ListSecurityGroups retrieves all security groups, if the current gateway can list them
+func (g *SwappableGateway) ListSecurityGroups(ctx context.Context) ([]*pb.SecGroup, error) {
+	lister, err := g.lister()
+	if err != nil {
+		return nil, err
+	}
+	return lister.ListSecurityGroups(ctx)
+}
+
+// ListNetworks retrieves all networks, if the current gateway can list them
+func (g *SwappableGateway) ListNetworks(ctx context.Context) ([]*pb.Network, error) {
+	lister, err := g.lister()
+	if err != nil {
+		return nil, err
+	}
+	return lister.ListNetworks(ctx)
+}
+
+// ListIESgSgRules retrieves all IESgSgRules, if the current gateway can list them
+func (g *SwappableGateway) ListIESgSgRules(ctx context.Context) ([]*pb.IESgSgRule, error) {
+	lister, err := g.lister()
+	if err != nil {
+		return nil, err
+	}
+	return lister.ListIESgSgRules(ctx)
+}
+
+func (g *SwappableGateway) lister() (interfaces.SGroupStateLister, error) {
+	gateway := g.Gateway()
+	lister, ok := gateway.(interfaces.SGroupStateLister)
+	if !ok {
+		return nil, fmt.Errorf("listing sgroups state is not supported by %T", gateway)
+	}
+	return lister, nil
+}
+
+// Close closes the current gateway
+func (g *SwappableGateway) Close() error {
+	return g.Gateway().Close()
+}
@@ -0,0 +1,44 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// closingGateway records whether it was closed
+type closingGateway struct {
+	fakeGateway
+	closed bool
+}
+
+func (g *closingGateway) Close() error {
+	g.closed = true
+	return nil
+}
+
+func TestSwappableGateway_Swap(t *testing.T) {
+	previous := &closingGateway{fakeGateway: fakeGateway{err: errors.New("connection refused")}}
+	gateway := NewSwappableGateway(previous)
+
+	ctx := context.Background()
+	assert.Error(t, gateway.Sync(ctx, &types.SyncRequest{}))
+
+	next := &closingGateway{}
+	require.NoError(t, gateway.Swap(next))
+	assert.True(t, previous.closed)
+	assert.False(t, next.closed)
+	assert.Same(t, next, gateway.Gateway())
+
+	assert.NoError(t, gateway.Sync(ctx, &types.SyncRequest{}))
+	assert.Equal(t, 1, previous.calls)
+	assert.Equal(t, 1, next.calls)
+
+	_, err := gateway.ListNetworks(ctx)
+	assert.Error(t, err, "fake gateway can't list sgroups state")
+}
@@ -24,6 +24,15 @@ type ReverseSyncSystemConfig struct {
 	// Host processor configuration
 	HostProcessor processors.HostProcessorConfig `json:"host_processor" yaml:"host_processor"`
 
+	// Network synchronization configuration
+	NetworkSynchronizer synchronizer.ResourceSyncConfig `json:"network_synchronizer" yaml:"network_synchronizer"`
+
+	// Address group synchronization configuration
+	AddressGroupSynchronizer synchronizer.ResourceSyncConfig `json:"address_group_synchronizer" yaml:"address_group_synchronizer"`
+
+	// Namespace mapping of SGROUP names to NETGUARD identifiers for imported resources and hosts
+	NamespaceMapping synchronizer.NamespaceMappingConfig `json:"namespace_mapping" yaml:"namespace_mapping"`
+
 	// System-wide settings
 	System SystemConfig `json:"system" yaml:"system"`
 }
@@ -63,6 +72,9 @@ func DefaultReverseSyncSystemConfig() ReverseSyncSystemConfig {
 		},
 		HostSynchronizer: synchronizer.DefaultHostSyncConfig(),
 		HostProcessor:    processors.DefaultHostProcessorConfig(),
+
+		NetworkSynchronizer:      synchronizer.DefaultResourceSyncConfig(),
+		AddressGroupSynchronizer: synchronizer.DefaultResourceSyncConfig(),
 		System: SystemConfig{
 			LogLevel:                "info",
 			EnableMetrics:           true,
@@ -180,6 +192,27 @@ func (c *ReverseSyncSystemConfig) Validate() error {
 		return fmt.Errorf("host synchronizer sync timeout must be positive")
 	}
 
+	if err := c.HostSynchronizer.ConflictPolicy.Validate(); err != nil {
+		return fmt.Errorf("host synchronizer: %w", err)
+	}
+
+	// Validate network and address group synchronizer configs
+	if c.NetworkSynchronizer.Enabled {
+		if err := c.NetworkSynchronizer.Validate(); err != nil {
+			return fmt.Errorf("network synchronizer: %w", err)
+		}
+	}
+
+	if c.AddressGroupSynchronizer.Enabled {
+		if err := c.AddressGroupSynchronizer.Validate(); err != nil {
+			return fmt.Errorf("address group synchronizer: %w", err)
+		}
+	}
+
+	if err := c.NamespaceMapping.Validate(); err != nil {
+		return fmt.Errorf("namespace mapping: %w", err)
+	}
+
 	// Validate system config
 	if c.System.LogLevel == "" {
 		return fmt.Errorf("system log level cannot be empty")
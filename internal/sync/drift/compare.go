@@ -0,0 +1,143 @@
+package drift
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/PRO-Robotech/protos/pkg/api/common"
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/k8s/apis/netguard/v1beta1"
+	"netguard-pg-backend/internal/sync/interfaces"
+)
+
+// object is a resource of one side matched by its sgroups identity
+type object struct {
+	// id is the netguard resource, empty for sgroups objects
+	id          models.ResourceIdentifier
+	fingerprint string
+	// entity syncs the object, nil when it can't be synced
+	entity interfaces.SyncableEntity
+	// outOfScope marks netguard resources excluded from synchronization,
+	// sgroups objects of the same identity are left alone
+	outOfScope bool
+}
+
+// compare diffs netguard and sgroups objects of a single subject type
+func compare(desired, actual map[string]object) SubjectDrift {
+	var drift SubjectDrift
+	for name, want := range desired {
+		if want.outOfScope {
+			continue
+		}
+		got, exists := actual[name]
+		switch {
+		case !exists:
+			drift.Missing = append(drift.Missing, name)
+		case got.fingerprint != want.fingerprint:
+			drift.Changed = append(drift.Changed, name)
+		default:
+			continue
+		}
+		drift.Resources = append(drift.Resources, want.id)
+	}
+	for name := range actual {
+		if _, exists := desired[name]; !exists {
+			drift.Extra = append(drift.Extra, name)
+		}
+	}
+
+	sort.Strings(drift.Missing)
+	sort.Strings(drift.Changed)
+	sort.Strings(drift.Extra)
+	sort.Slice(drift.Resources, func(i, j int) bool {
+		return drift.Resources[i].Key() < drift.Resources[j].Key()
+	})
+	return drift
+}
+
+// identify returns the sgroups identity of a proto object and a fingerprint of its synchronized fields
+func identify(proto interface{}) (string, string) {
+	switch p := proto.(type) {
+	case *pb.SecGroup:
+		networks := append([]string(nil), p.GetNetworks()...)
+		sort.Strings(networks)
+		return p.GetName(), fmt.Sprintf("%s|%s|%t|%t", strings.Join(networks, ","), p.GetDefaultAction(), p.GetLogs(), p.GetTrace())
+	case *pb.Network:
+		// IPv6 CIDRs can be written differently on both sides
+		cidr := p.GetNetwork().GetCIDR()
+		if normalized, err := models.NormalizeCIDR(cidr); err == nil {
+			cidr = normalized
+		}
+		return p.GetName(), cidr
+	case *pb.Host:
+		// IPs are reported by agents, only the registration is compared
+		return p.GetName(), fmt.Sprintf("%s|%s", p.GetUuid(), p.GetSgName())
+	case *pb.IESgSgRule:
+		ports := make([]string, 0, len(p.GetPorts()))
+		for _, port := range p.GetPorts() {
+			ports = append(ports, port.GetS()+":"+port.GetD())
+		}
+		sort.Strings(ports)
+		name := fmt.Sprintf("%s:%s:%s>%s", p.GetTransport(), p.GetTraffic(), p.GetSgLocal(), p.GetSG())
+		return name, fmt.Sprintf("%s|%s|%d|%t|%t", strings.Join(ports, ","), p.GetAction(), p.GetPriority().GetSome(), p.GetLogs(), p.GetTrace())
+	}
+	return fmt.Sprintf("%v", proto), ""
+}
+
+// entityFromProto builds an entity able to delete the sgroups object and returns its namespace.
+// Hosts can't be deleted by netguard, nil is returned for them.
+func entityFromProto(proto interface{}) (interfaces.SyncableEntity, string) {
+	switch p := proto.(type) {
+	case *pb.SecGroup:
+		namespace, name := splitName(p.GetName())
+		return &models.AddressGroup{
+			SelfRef:          models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(namespace))),
+			AddressGroupName: p.GetName(),
+		}, namespace
+	case *pb.Network:
+		namespace, name := splitName(p.GetName())
+		return &models.Network{
+			SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(namespace))),
+			CIDR:    p.GetNetwork().GetCIDR(),
+		}, namespace
+	case *pb.Host:
+		namespace, _ := splitName(p.GetName())
+		return nil, namespace
+	case *pb.IESgSgRule:
+		rule := &models.IEAgAgRule{
+			Transport:         models.TCP,
+			Traffic:           models.INGRESS,
+			AddressGroupLocal: namespacedRef(p.GetSgLocal()),
+			AddressGroup:      namespacedRef(p.GetSG()),
+		}
+		if p.GetTransport() == common.Networks_NetIP_UDP {
+			rule.Transport = models.UDP
+		}
+		if p.GetTraffic() == common.Traffic_Egress {
+			rule.Traffic = models.EGRESS
+		}
+		name, _ := identify(p)
+		rule.SelfRef = models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(rule.AddressGroupLocal.Namespace)))
+		return rule, rule.AddressGroupLocal.Namespace
+	}
+	return nil, ""
+}
+
+// splitName splits a "namespace/name" sgroups name
+func splitName(fullName string) (string, string) {
+	if i := strings.Index(fullName, "/"); i >= 0 {
+		return fullName[:i], fullName[i+1:]
+	}
+	return "", fullName
+}
+
+func namespacedRef(fullName string) v1beta1.NamespacedObjectReference {
+	namespace, name := splitName(fullName)
+	return v1beta1.NamespacedObjectReference{
+		ObjectReference: v1beta1.ObjectReference{Name: name},
+		Namespace:       namespace,
+	}
+}
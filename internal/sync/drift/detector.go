@@ -0,0 +1,453 @@
+// Package drift detects divergence between the netguard database and sgroups.
+//
+// The detector periodically lists AddressGroups, Networks, Hosts and IEAgAgRules
+// from both sides, matches them by their sgroups identity and compares the
+// synchronized fields. Drift is reported in logs, detector stats and, through
+// the Reporter, in resource conditions. Depending on the policy drifted
+// resources are re-synced to sgroups and objects unknown to netguard are
+// removed from sgroups.
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// Policy defines what the detector does with found drift
+type Policy string
+
+const (
+	// PolicyReport only reports drift
+	PolicyReport Policy = "report"
+	// PolicyRepair re-syncs resources missing in sgroups or differing from netguard
+	PolicyRepair Policy = "repair"
+	// PolicyPrune repairs drift and also deletes objects unknown to netguard from sgroups.
+	// Hosts are never deleted, they are registered in sgroups by agents.
+	PolicyPrune Policy = "prune"
+)
+
+// Valid checks that the policy is known
+func (p Policy) Valid() bool {
+	switch p {
+	case PolicyReport, PolicyRepair, PolicyPrune:
+		return true
+	}
+	return false
+}
+
+// Config holds detector configuration
+type Config struct {
+	// Interval is the interval between drift checks
+	Interval time.Duration
+	// Policy defines how found drift is handled
+	Policy Policy
+	// ExcludeNamespaces are namespaces not synchronized to the checked sgroups instance
+	ExcludeNamespaces []string
+}
+
+// DefaultConfig returns default detector configuration
+func DefaultConfig() Config {
+	return Config{
+		Interval: 10 * time.Minute,
+		Policy:   PolicyReport,
+	}
+}
+
+// Reporter is notified about drift of netguard resources, e.g. to surface it in resource conditions
+type Reporter interface {
+	// ReportDrift is called after every check with the drifted resources of the subject type,
+	// an empty list means no resource of the subject type drifted
+	ReportDrift(ctx context.Context, subjectType types.SyncSubjectType, drifted []models.ResourceIdentifier)
+}
+
+// SubjectDrift describes drift of a single subject type, objects are named by their sgroups identity
+type SubjectDrift struct {
+	// Missing objects exist in netguard only
+	Missing []string `json:"missing,omitempty"`
+	// Changed objects exist on both sides with different content
+	Changed []string `json:"changed,omitempty"`
+	// Extra objects exist in sgroups only
+	Extra []string `json:"extra,omitempty"`
+	// Resources are netguard resources of missing and changed objects
+	Resources []models.ResourceIdentifier `json:"-"`
+}
+
+// Count returns the number of drifted objects
+func (d SubjectDrift) Count() int {
+	return len(d.Missing) + len(d.Changed) + len(d.Extra)
+}
+
+// Report is the result of a single drift check
+type Report struct {
+	Time     time.Time                              `json:"time"`
+	Subjects map[types.SyncSubjectType]SubjectDrift `json:"subjects,omitempty"`
+	// Repaired is the number of re-synced objects
+	Repaired int `json:"repaired"`
+	// Pruned is the number of objects deleted from sgroups
+	Pruned int `json:"pruned"`
+}
+
+// HasDrift returns true if any subject type drifted
+func (r Report) HasDrift() bool {
+	for _, subject := range r.Subjects {
+		if subject.Count() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Stats holds detector counters
+type Stats struct {
+	Checks        int64   `json:"checks"`
+	FailedChecks  int64   `json:"failedChecks"`
+	DriftedChecks int64   `json:"driftedChecks"`
+	Repaired      int64   `json:"repaired"`
+	Pruned        int64   `json:"pruned"`
+	LastError     string  `json:"lastError,omitempty"`
+	LastReport    *Report `json:"lastReport,omitempty"`
+}
+
+// subjectOrder is the order objects are repaired in, dependencies first. Deletes go in reverse order.
+var subjectOrder = []types.SyncSubjectType{
+	types.SyncSubjectTypeNetworks,
+	types.SyncSubjectTypeGroups,
+	types.SyncSubjectTypeHosts,
+	types.SyncSubjectTypeIEAgAgRules,
+}
+
+// Detector periodically compares netguard and sgroups state
+type Detector struct {
+	registry    ports.Registry
+	lister      interfaces.SGroupStateLister
+	syncManager interfaces.SyncManager
+	config      Config
+	logger      logr.Logger
+	reporter    Reporter
+	excluded    map[string]bool
+	// reconfigured wakes up Run to reset its ticker after SetConfig
+	reconfigured chan struct{}
+
+	// mu guards stats, config and excluded
+	mu    sync.Mutex
+	stats Stats
+}
+
+// NewDetector creates a new drift detector
+func NewDetector(registry ports.Registry, lister interfaces.SGroupStateLister, syncManager interfaces.SyncManager, config Config, logger logr.Logger) *Detector {
+	return &Detector{
+		registry:     registry,
+		lister:       lister,
+		syncManager:  syncManager,
+		config:       config,
+		logger:       logger.WithName("drift"),
+		excluded:     excludedNamespaces(config),
+		reconfigured: make(chan struct{}, 1),
+	}
+}
+
+// SetConfig replaces detector configuration, a running detector restarts its interval
+func (d *Detector) SetConfig(config Config) {
+	d.mu.Lock()
+	d.config = config
+	d.excluded = excludedNamespaces(config)
+	d.mu.Unlock()
+
+	select {
+	case d.reconfigured <- struct{}{}:
+	default:
+	}
+	d.logger.Info("Configured drift detection", "interval", config.Interval, "policy", config.Policy)
+}
+
+func excludedNamespaces(config Config) map[string]bool {
+	excluded := make(map[string]bool, len(config.ExcludeNamespaces))
+	for _, namespace := range config.ExcludeNamespaces {
+		excluded[namespace] = true
+	}
+	return excluded
+}
+
+func (d *Detector) currentConfig() Config {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.config
+}
+
+func (d *Detector) isExcluded(namespace string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.excluded[namespace]
+}
+
+// SetReporter sets the receiver of drifted resources
+func (d *Detector) SetReporter(reporter Reporter) {
+	d.reporter = reporter
+}
+
+// Stats returns a copy of detector counters
+func (d *Detector) Stats() Stats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.stats
+}
+
+// Run checks drift every Interval until ctx is done
+func (d *Detector) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.currentConfig().Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.reconfigured:
+			ticker.Reset(d.currentConfig().Interval)
+		case <-ticker.C:
+			if _, err := d.Check(ctx); err != nil {
+				d.logger.Error(err, "Drift check failed")
+			}
+		}
+	}
+}
+
+// Check compares netguard and sgroups state once and handles drift according to the policy
+func (d *Detector) Check(ctx context.Context) (Report, error) {
+	report, err := d.check(ctx)
+
+	d.mu.Lock()
+	d.stats.Checks++
+	if err != nil {
+		d.stats.FailedChecks++
+		d.stats.LastError = err.Error()
+	} else {
+		d.stats.LastError = ""
+		if report.HasDrift() {
+			d.stats.DriftedChecks++
+		}
+		d.stats.Repaired += int64(report.Repaired)
+		d.stats.Pruned += int64(report.Pruned)
+		d.stats.LastReport = &report
+	}
+	d.mu.Unlock()
+
+	return report, err
+}
+
+func (d *Detector) check(ctx context.Context) (Report, error) {
+	desired, err := d.loadNetguard(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to load netguard state: %w", err)
+	}
+	actual, err := d.loadSGroups(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to load sgroups state: %w", err)
+	}
+
+	report := Report{
+		Time:     time.Now(),
+		Subjects: make(map[types.SyncSubjectType]SubjectDrift, len(subjectOrder)),
+	}
+	for _, subjectType := range subjectOrder {
+		subject := compare(desired[subjectType], actual[subjectType])
+		report.Subjects[subjectType] = subject
+		if subject.Count() > 0 {
+			d.logger.Info("Drift detected", "subjectType", subjectType,
+				"missing", len(subject.Missing), "changed", len(subject.Changed), "extra", len(subject.Extra))
+		}
+	}
+
+	policy := d.currentConfig().Policy
+	if policy == PolicyRepair || policy == PolicyPrune {
+		report.Repaired = d.repair(ctx, report, desired)
+	}
+	if policy == PolicyPrune {
+		report.Pruned = d.prune(ctx, report, actual)
+	}
+
+	if d.reporter != nil {
+		for _, subjectType := range subjectOrder {
+			d.reporter.ReportDrift(ctx, subjectType, report.Subjects[subjectType].Resources)
+		}
+	}
+
+	return report, nil
+}
+
+// repair upserts missing and changed objects, returning the number of synced objects
+func (d *Detector) repair(ctx context.Context, report Report, desired map[types.SyncSubjectType]map[string]object) int {
+	repaired := 0
+	for _, subjectType := range subjectOrder {
+		subject := report.Subjects[subjectType]
+		var entities []interfaces.SyncableEntity
+		for _, names := range [][]string{subject.Missing, subject.Changed} {
+			for _, name := range names {
+				entities = append(entities, desired[subjectType][name].entity)
+			}
+		}
+		if len(entities) == 0 {
+			continue
+		}
+
+		if err := d.syncManager.SyncBatch(ctx, entities, types.SyncOperationUpsert); err != nil {
+			d.logger.Error(err, "Failed to repair drift", "subjectType", subjectType, "count", len(entities))
+			continue
+		}
+		d.logger.Info("Drift repaired", "subjectType", subjectType, "count", len(entities))
+		repaired += len(entities)
+	}
+	return repaired
+}
+
+// prune deletes extra objects from sgroups, returning the number of deleted objects
+func (d *Detector) prune(ctx context.Context, report Report, actual map[types.SyncSubjectType]map[string]object) int {
+	pruned := 0
+	for i := len(subjectOrder) - 1; i >= 0; i-- {
+		subjectType := subjectOrder[i]
+		if subjectType == types.SyncSubjectTypeHosts {
+			continue
+		}
+		var entities []interfaces.SyncableEntity
+		for _, name := range report.Subjects[subjectType].Extra {
+			if entity := actual[subjectType][name].entity; entity != nil {
+				entities = append(entities, entity)
+			}
+		}
+		if len(entities) == 0 {
+			continue
+		}
+
+		if err := d.syncManager.SyncBatch(ctx, entities, types.SyncOperationDelete); err != nil {
+			d.logger.Error(err, "Failed to prune sgroups objects", "subjectType", subjectType, "count", len(entities))
+			continue
+		}
+		d.logger.Info("Pruned sgroups objects unknown to netguard", "subjectType", subjectType, "count", len(entities))
+		pruned += len(entities)
+	}
+	return pruned
+}
+
+// loadNetguard lists resources stored in netguard keyed by their sgroups identity. All kinds
+// are read from one snapshot, a commit between two lists can't report drift of its own. The
+// detector only reports and prunes sgroups, the snapshot may be the one of the replica.
+func (d *Detector) loadNetguard(ctx context.Context) (map[types.SyncSubjectType]map[string]object, error) {
+	reader, err := d.registry.ReaderAtReplicaSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	state := newState()
+	err = reader.ListAddressGroups(ctx, func(ag models.AddressGroup) error {
+		return d.addNetguard(state, ag.ResourceIdentifier, &ag)
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, err
+	}
+	err = reader.ListNetworks(ctx, func(network models.Network) error {
+		return d.addNetguard(state, network.ResourceIdentifier, &network)
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, err
+	}
+	err = reader.ListHosts(ctx, func(host models.Host) error {
+		return d.addNetguard(state, host.ResourceIdentifier, &host)
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, err
+	}
+	err = reader.ListIEAgAgRules(ctx, func(rule models.IEAgAgRule) error {
+		return d.addNetguard(state, rule.ResourceIdentifier, &rule)
+	}, ports.EmptyScope{})
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (d *Detector) addNetguard(state map[types.SyncSubjectType]map[string]object, id models.ResourceIdentifier, entity interfaces.SyncableEntity) error {
+	if d.isExcluded(id.Namespace) {
+		return nil
+	}
+	proto, err := entity.ToSGroupsProto()
+	if err != nil {
+		return fmt.Errorf("failed to convert %s: %w", entity.GetSyncKey(), err)
+	}
+	name, fingerprint := identify(proto)
+	// Resources out of the sync scope are neither expected in sgroups nor pruned from it
+	if scope, ok := d.syncManager.(interfaces.SyncScopeConfigurer); ok && !scope.InSyncScope(entity) {
+		state[entity.GetSyncSubjectType()][name] = object{id: id, outOfScope: true}
+		return nil
+	}
+	state[entity.GetSyncSubjectType()][name] = object{id: id, fingerprint: fingerprint, entity: entity}
+	return nil
+}
+
+// loadSGroups lists objects stored in sgroups keyed by their identity
+func (d *Detector) loadSGroups(ctx context.Context) (map[types.SyncSubjectType]map[string]object, error) {
+	state := newState()
+
+	groups, err := d.lister.ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		d.addSGroups(state, types.SyncSubjectTypeGroups, group)
+	}
+
+	networks, err := d.lister.ListNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, network := range networks {
+		d.addSGroups(state, types.SyncSubjectTypeNetworks, network)
+	}
+
+	hosts, err := d.lister.ListAllHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, host := range hosts {
+		d.addSGroups(state, types.SyncSubjectTypeHosts, host)
+	}
+
+	rules, err := d.lister.ListIESgSgRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		d.addSGroups(state, types.SyncSubjectTypeIEAgAgRules, rule)
+	}
+
+	return state, nil
+}
+
+func (d *Detector) addSGroups(state map[types.SyncSubjectType]map[string]object, subjectType types.SyncSubjectType, proto interface{}) {
+	entity, namespace := entityFromProto(proto)
+	// Objects of excluded namespaces belong to other sgroups instances and must not be pruned from here
+	if d.isExcluded(namespace) {
+		return
+	}
+	if scope, ok := d.syncManager.(interfaces.SyncScopeConfigurer); ok && namespace != "" && !scope.NamespaceInSyncScope(namespace) {
+		return
+	}
+	name, fingerprint := identify(proto)
+	state[subjectType][name] = object{fingerprint: fingerprint, entity: entity}
+}
+
+func newState() map[types.SyncSubjectType]map[string]object {
+	state := make(map[types.SyncSubjectType]map[string]object, len(subjectOrder))
+	for _, subjectType := range subjectOrder {
+		state[subjectType] = make(map[string]object)
+	}
+	return state
+}
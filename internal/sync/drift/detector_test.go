@@ -0,0 +1,222 @@
+package drift
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PRO-Robotech/protos/pkg/api/common"
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// fakeLister returns a fixed sgroups state
+type fakeLister struct {
+	groups   []*pb.SecGroup
+	networks []*pb.Network
+	hosts    []*pb.Host
+	rules    []*pb.IESgSgRule
+}
+
+func (l *fakeLister) ListSecurityGroups(context.Context) ([]*pb.SecGroup, error) {
+	return l.groups, nil
+}
+func (l *fakeLister) ListNetworks(context.Context) ([]*pb.Network, error)       { return l.networks, nil }
+func (l *fakeLister) ListAllHosts(context.Context) ([]*pb.Host, error)          { return l.hosts, nil }
+func (l *fakeLister) ListIESgSgRules(context.Context) ([]*pb.IESgSgRule, error) { return l.rules, nil }
+
+// fakeSyncManager records synced keys by operation
+type fakeSyncManager struct {
+	synced map[types.SyncOperation][]string
+}
+
+func (m *fakeSyncManager) RegisterSyncer(types.SyncSubjectType, interface{}) error { return nil }
+func (m *fakeSyncManager) SyncEntity(context.Context, interfaces.SyncableEntity, types.SyncOperation) error {
+	return nil
+}
+func (m *fakeSyncManager) SyncEntityForced(context.Context, interfaces.SyncableEntity, types.SyncOperation) error {
+	return nil
+}
+func (m *fakeSyncManager) Start(context.Context) error { return nil }
+func (m *fakeSyncManager) Stop() error                 { return nil }
+
+func (m *fakeSyncManager) SyncBatch(_ context.Context, entities []interfaces.SyncableEntity, operation types.SyncOperation) error {
+	if m.synced == nil {
+		m.synced = make(map[types.SyncOperation][]string)
+	}
+	for _, entity := range entities {
+		m.synced[operation] = append(m.synced[operation], entity.GetSyncKey())
+	}
+	return nil
+}
+
+// fakeReporter records reported resources by subject type
+type fakeReporter map[types.SyncSubjectType][]models.ResourceIdentifier
+
+func (r fakeReporter) ReportDrift(_ context.Context, subjectType types.SyncSubjectType, drifted []models.ResourceIdentifier) {
+	r[subjectType] = drifted
+}
+
+func seed(t *testing.T) *mem.Registry {
+	registry := mem.NewRegistry()
+	writer, err := registry.Writer(context.Background())
+	require.NoError(t, err)
+
+	groups := []models.AddressGroup{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("app"))), DefaultAction: models.ActionAccept},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("db", models.WithNamespace("app"))), DefaultAction: models.ActionDrop},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("cache", models.WithNamespace("app"))), DefaultAction: models.ActionAccept},
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("ext", models.WithNamespace("remote"))), DefaultAction: models.ActionAccept},
+	}
+	require.NoError(t, writer.SyncAddressGroups(context.Background(), groups, ports.EmptyScope{}))
+
+	networks := []models.Network{
+		{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("net", models.WithNamespace("app"))), CIDR: "10.0.0.0/24"},
+	}
+	require.NoError(t, writer.SyncNetworks(context.Background(), networks, ports.EmptyScope{}))
+	require.NoError(t, writer.Commit())
+	return registry
+}
+
+func sgroupsState() *fakeLister {
+	return &fakeLister{
+		groups: []*pb.SecGroup{
+			{Name: "app/web", DefaultAction: pb.SecGroup_ACCEPT},
+			// db has a different default action, cache is missing
+			{Name: "app/db", DefaultAction: pb.SecGroup_ACCEPT},
+			{Name: "app/stale", DefaultAction: pb.SecGroup_ACCEPT},
+			{Name: "remote/other", DefaultAction: pb.SecGroup_ACCEPT},
+		},
+		networks: []*pb.Network{
+			{Name: "app/net", Network: &common.Networks_NetIP{CIDR: "10.0.0.0/24"}},
+		},
+		hosts: []*pb.Host{{Name: "app/agent-host", Uuid: "uuid-1"}},
+	}
+}
+
+func TestDetector_ReportsDrift(t *testing.T) {
+	registry := seed(t)
+	syncManager := &fakeSyncManager{}
+	reporter := fakeReporter{}
+	config := DefaultConfig()
+	config.ExcludeNamespaces = []string{"remote"}
+
+	detector := NewDetector(registry, sgroupsState(), syncManager, config, logr.Discard())
+	detector.SetReporter(reporter)
+
+	report, err := detector.Check(context.Background())
+	require.NoError(t, err)
+	require.True(t, report.HasDrift())
+
+	groups := report.Subjects[types.SyncSubjectTypeGroups]
+	assert.Equal(t, []string{"app/cache"}, groups.Missing)
+	assert.Equal(t, []string{"app/db"}, groups.Changed)
+	assert.Equal(t, []string{"app/stale"}, groups.Extra)
+	assert.Zero(t, report.Subjects[types.SyncSubjectTypeNetworks].Count())
+	assert.Equal(t, []string{"app/agent-host"}, report.Subjects[types.SyncSubjectTypeHosts].Extra)
+
+	assert.Empty(t, syncManager.synced, "report policy must not sync")
+	assert.Len(t, reporter[types.SyncSubjectTypeGroups], 2)
+	assert.Empty(t, reporter[types.SyncSubjectTypeNetworks])
+
+	stats := detector.Stats()
+	assert.EqualValues(t, 1, stats.Checks)
+	assert.EqualValues(t, 1, stats.DriftedChecks)
+}
+
+func TestDetector_PruneRepairsAndDeletes(t *testing.T) {
+	registry := seed(t)
+	syncManager := &fakeSyncManager{}
+	config := DefaultConfig()
+	config.Policy = PolicyPrune
+	config.ExcludeNamespaces = []string{"remote"}
+
+	detector := NewDetector(registry, sgroupsState(), syncManager, config, logr.Discard())
+
+	report, err := detector.Check(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Repaired)
+	assert.Equal(t, 1, report.Pruned)
+
+	assert.ElementsMatch(t, []string{"addressgroup-app/cache", "addressgroup-app/db"}, syncManager.synced[types.SyncOperationUpsert])
+	// Hosts and objects of excluded namespaces are never deleted
+	assert.Equal(t, []string{"addressgroup-app/stale"}, syncManager.synced[types.SyncOperationDelete])
+}
+
+func TestDetector_SetConfig(t *testing.T) {
+	registry := seed(t)
+	syncManager := &fakeSyncManager{}
+	config := DefaultConfig()
+	config.ExcludeNamespaces = []string{"remote"}
+
+	detector := NewDetector(registry, sgroupsState(), syncManager, config, logr.Discard())
+	_, err := detector.Check(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, syncManager.synced)
+
+	config.Policy = PolicyRepair
+	detector.SetConfig(config)
+	report, err := detector.Check(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Repaired)
+	assert.Empty(t, syncManager.synced[types.SyncOperationDelete], "repair policy must not prune")
+}
+
+// scopedSyncManager excludes a namespace and AddressGroups by name from synchronization
+type scopedSyncManager struct {
+	fakeSyncManager
+	namespace string
+	name      string
+}
+
+func (m *scopedSyncManager) SetSyncScope(interfaces.SyncScope) error { return nil }
+func (m *scopedSyncManager) NamespaceInSyncScope(namespace string) bool {
+	return namespace != m.namespace
+}
+func (m *scopedSyncManager) InSyncScope(entity interfaces.SyncableEntity) bool {
+	ag, ok := entity.(*models.AddressGroup)
+	return !ok || ag.Namespace != m.namespace && ag.Name != m.name
+}
+
+func TestDetector_IgnoresResourcesOutOfSyncScope(t *testing.T) {
+	registry := seed(t)
+	syncManager := &scopedSyncManager{namespace: "remote", name: "db"}
+	config := DefaultConfig()
+	config.Policy = PolicyPrune
+
+	detector := NewDetector(registry, sgroupsState(), syncManager, config, logr.Discard())
+
+	report, err := detector.Check(context.Background())
+	require.NoError(t, err)
+
+	groups := report.Subjects[types.SyncSubjectTypeGroups]
+	assert.Equal(t, []string{"app/cache"}, groups.Missing)
+	assert.Empty(t, groups.Changed, "out of scope resources are not compared")
+	assert.Equal(t, []string{"app/stale"}, groups.Extra, "objects of namespaces out of scope are not pruned")
+}
+
+func TestIdentify_RulePriorityChangesFingerprint(t *testing.T) {
+	rule := func(priority int32) *pb.IESgSgRule {
+		return &pb.IESgSgRule{
+			Transport: common.Networks_NetIP_TCP,
+			Traffic:   common.Traffic_Ingress,
+			SgLocal:   "app/web",
+			SG:        "app/db",
+			Ports:     []*pb.AccPorts{{D: "443"}},
+			Action:    pb.RuleAction_DROP,
+			Priority:  &pb.RulePriority{Value: &pb.RulePriority_Some{Some: priority}},
+		}
+	}
+
+	name, drop := identify(rule(models.RulePriorityDrop))
+	sameName, accept := identify(rule(models.RulePriorityAccept))
+	assert.Equal(t, name, sameName)
+	assert.NotEqual(t, drop, accept)
+}
@@ -2,6 +2,8 @@ package sync
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"netguard-pg-backend/internal/sync/config"
 	"netguard-pg-backend/internal/sync/detector"
@@ -14,9 +16,19 @@ import (
 // ReverseSyncSystem demonstrates how to integrate all components
 // This is an example of how to wire up the complete reverse synchronization system
 type ReverseSyncSystem struct {
-	manager        *manager.ReverseSyncManager
-	changeDetector detector.ChangeDetector
-	config         config.ReverseSyncSystemConfig
+	manager          *manager.ReverseSyncManager
+	changeDetector   detector.ChangeDetector
+	hostSynchronizer synchronizer.HostSynchronizer
+	config           config.ReverseSyncSystemConfig
+}
+
+// ResourceStores give the reverse sync system access to Networks and AddressGroups of NETGUARD.
+// Processors of resources without stores are not registered.
+type ResourceStores struct {
+	NetworkReader      synchronizer.NetworkReader
+	NetworkWriter      synchronizer.NetworkWriter
+	AddressGroupReader synchronizer.AddressGroupReader
+	AddressGroupWriter synchronizer.AddressGroupWriter
 }
 
 // NewReverseSyncSystem creates a complete reverse synchronization system
@@ -24,7 +36,9 @@ func NewReverseSyncSystem(
 	sgroupGateway interfaces.SGroupGateway, // Interface to SGROUP system
 	hostReader synchronizer.HostReader, // Interface to read hosts from NETGUARD
 	hostWriter synchronizer.HostWriter, // Interface to write hosts to NETGUARD
+	resourceStores ResourceStores, // Interfaces to read and write networks and address groups of NETGUARD
 	systemConfig config.ReverseSyncSystemConfig,
+	hostOptions ...synchronizer.HostSynchronizerOption, // Optional host synchronizer dependencies
 ) (*ReverseSyncSystem, error) {
 	// 1. Create SGROUP change detector
 	changeDetector := detector.NewSGROUPChangeDetector(sgroupGateway, systemConfig.SGROUPDetector)
@@ -35,6 +49,7 @@ func NewReverseSyncSystem(
 		hostWriter,
 		sgroupGateway, // Also implements SGROUPHostReader
 		systemConfig.HostSynchronizer,
+		hostOptions...,
 	)
 
 	// 3. Create host processor
@@ -49,13 +64,71 @@ func NewReverseSyncSystem(
 		return nil, err
 	}
 
+	// 6. Register network and address group processors, SGROUP state is read by the gateway lister
+	resourceProcessors, err := newResourceProcessors(sgroupGateway, resourceStores, systemConfig)
+	if err != nil {
+		return nil, err
+	}
+	for _, processor := range resourceProcessors {
+		if err := reverseSyncManager.RegisterProcessor(processor); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ReverseSyncSystem{
-		manager:        reverseSyncManager,
-		changeDetector: changeDetector,
-		config:         systemConfig,
+		manager:          reverseSyncManager,
+		changeDetector:   changeDetector,
+		hostSynchronizer: hostSynchronizer,
+		config:           systemConfig,
 	}, nil
 }
 
+// newResourceProcessors creates processors of the enabled network and address group synchronizers
+func newResourceProcessors(
+	sgroupGateway interfaces.SGroupGateway,
+	stores ResourceStores,
+	systemConfig config.ReverseSyncSystemConfig,
+) ([]processors.EntityProcessor, error) {
+	networksEnabled := systemConfig.NetworkSynchronizer.Enabled && stores.NetworkReader != nil && stores.NetworkWriter != nil
+	addressGroupsEnabled := systemConfig.AddressGroupSynchronizer.Enabled && stores.AddressGroupReader != nil && stores.AddressGroupWriter != nil
+	if !networksEnabled && !addressGroupsEnabled {
+		return nil, nil
+	}
+
+	lister, ok := sgroupGateway.(interfaces.SGroupStateLister)
+	if !ok {
+		return nil, fmt.Errorf("reverse sync of networks and address groups requires listing sgroups state, not supported by %T", sgroupGateway)
+	}
+
+	namespaces, err := synchronizer.NewNamespaceMapper(systemConfig.NamespaceMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceProcessors []processors.EntityProcessor
+	if networksEnabled {
+		networkSynchronizer := synchronizer.NewNetworkSynchronizer(
+			stores.NetworkReader,
+			stores.NetworkWriter,
+			lister,
+			systemConfig.NetworkSynchronizer,
+			synchronizer.WithNamespaceMapper(namespaces),
+		)
+		resourceProcessors = append(resourceProcessors, processors.NewNetworkProcessor(networkSynchronizer))
+	}
+	if addressGroupsEnabled {
+		addressGroupSynchronizer := synchronizer.NewAddressGroupSynchronizer(
+			stores.AddressGroupReader,
+			stores.AddressGroupWriter,
+			lister,
+			systemConfig.AddressGroupSynchronizer,
+			synchronizer.WithNamespaceMapper(namespaces),
+		)
+		resourceProcessors = append(resourceProcessors, processors.NewAddressGroupProcessor(addressGroupSynchronizer))
+	}
+	return resourceProcessors, nil
+}
+
 // Start starts the reverse synchronization system
 func (s *ReverseSyncSystem) Start(ctx context.Context) error {
 
@@ -83,6 +156,69 @@ func (s *ReverseSyncSystem) GetStats() manager.ReverseSyncStats {
 	return s.manager.GetStats()
 }
 
+// Status returns system statistics with pending host conflicts, entity types are sorted
+func (s *ReverseSyncSystem) Status() interfaces.ReverseSyncStatus {
+	stats := s.manager.GetStats()
+	status := interfaces.ReverseSyncStatus{
+		Running:            s.manager.IsRunning(),
+		Paused:             s.manager.IsPaused(),
+		StartTime:          stats.StartTime,
+		TotalEvents:        stats.TotalEvents,
+		ProcessedEvents:    stats.ProcessedEvents,
+		FailedEvents:       stats.FailedEvents,
+		SkippedEvents:      stats.SkippedEvents,
+		LastEventTime:      stats.LastEventTimestamp,
+		LastSuccessfulSync: stats.LastSuccessfulSync,
+		Lag:                stats.Lag,
+	}
+	if reviewer := s.HostConflicts(); reviewer != nil {
+		status.PendingConflicts = len(reviewer.PendingConflicts())
+	}
+
+	status.Entities = make([]interfaces.ReverseSyncEntityStatus, 0, len(stats.EntityCounts))
+	for entityType, entity := range stats.EntityCounts {
+		status.Entities = append(status.Entities, interfaces.ReverseSyncEntityStatus{
+			EntityType:       entityType,
+			TotalRequests:    entity.TotalRequests,
+			SuccessfulSyncs:  entity.SuccessfulSyncs,
+			FailedSyncs:      entity.FailedSyncs,
+			ObjectsProcessed: entity.ObjectsProcessed,
+			ObjectsFailed:    entity.ObjectsFailed,
+			Conflicts:        entity.Conflicts,
+			LastSyncTime:     entity.LastSyncTime,
+		})
+	}
+	sort.Slice(status.Entities, func(i, j int) bool {
+		return status.Entities[i].EntityType < status.Entities[j].EntityType
+	})
+	return status
+}
+
+// Pause skips detected SGROUP changes until Resume
+func (s *ReverseSyncSystem) Pause() {
+	s.manager.Pause()
+}
+
+// Resume continues processing of detected SGROUP changes
+func (s *ReverseSyncSystem) Resume() {
+	s.manager.Resume()
+}
+
+// TriggerSync runs a synchronization cycle immediately, also while paused
+func (s *ReverseSyncSystem) TriggerSync(ctx context.Context) error {
+	return s.manager.TriggerSync(ctx)
+}
+
+// HostConflicts returns the hosts queued for manual review of their IPSet,
+// nil unless the host conflict policy is manual-review
+func (s *ReverseSyncSystem) HostConflicts() synchronizer.HostConflictReviewer {
+	if s.config.HostSynchronizer.ConflictPolicy != synchronizer.HostConflictManualReview {
+		return nil
+	}
+	reviewer, _ := s.hostSynchronizer.(synchronizer.HostConflictReviewer)
+	return reviewer
+}
+
 // IsRunning returns true if the system is running
 func (s *ReverseSyncSystem) IsRunning() bool {
 	return s.manager.IsRunning()
@@ -108,7 +244,7 @@ func ExampleUsage() {
 	// hostWriter := yourHostWriterImplementation()
 
 	// 4. Create reverse sync system
-	// reverseSyncSystem, err := NewReverseSyncSystem(sgroupGateway, hostReader, hostWriter, config)
+	// reverseSyncSystem, err := NewReverseSyncSystem(sgroupGateway, hostReader, hostWriter, ResourceStores{}, config)
 	// if err != nil {
 	//     // log.Fatalf("Failed to create reverse sync system: %v", err)
 	// }
@@ -125,6 +261,7 @@ func ExampleUsage() {
 	//    - When changes detected, find hosts without IPSet in NETGUARD
 	//    - Query SGROUP for those hosts' IP information
 	//    - Update NETGUARD hosts with the IP information
+	//    - Compare networks and address groups with SGROUP and resolve conflicts by policy
 	//    - Handle connection failures with automatic reconnection
 	//    - Provide statistics and health monitoring
 
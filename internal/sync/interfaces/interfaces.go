@@ -2,6 +2,8 @@ package interfaces
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -54,6 +56,82 @@ type SyncManager interface {
 	Stop() error
 }
 
+// SyncTargetRouter routes entities to sgroups targets by namespace.
+// Namespaces without a route use types.SyncTargetDefault.
+type SyncTargetRouter interface {
+	// RegisterTargetSyncer registers a syncer of a named sgroups target
+	RegisterTargetSyncer(target string, subjectType types.SyncSubjectType, syncer interface{}) error
+
+	// SetNamespaceTargets replaces namespace to target routes.
+	// types.SyncTargetNone disables synchronization of the namespace resources.
+	SetNamespaceTargets(routes map[string]string) error
+}
+
+// SyncerInspector is implemented by sync managers able to list registered syncers
+type SyncerInspector interface {
+	// RegisteredSyncers returns subject types with a registered syncer per sgroups target
+	RegisteredSyncers() map[string][]types.SyncSubjectType
+}
+
+// ErrSyncerNotRegistered is returned for subject types without a registered syncer
+var ErrSyncerNotRegistered = errors.New("no syncer registered for subject type")
+
+// SyncerState describes the syncers of a subject type
+type SyncerState struct {
+	SubjectType types.SyncSubjectType `json:"subjectType"`
+	// Targets are the sgroups targets with a registered syncer of the subject type
+	Targets []string `json:"targets"`
+	// Enabled is false while changes of the subject type are not synchronized
+	Enabled bool `json:"enabled"`
+}
+
+// SyncerController is implemented by sync managers able to pause syncers and
+// unregister them at runtime, e.g. to stop Host sync during sgroups maintenance
+type SyncerController interface {
+	// SetSyncerEnabled enables or disables the syncers of the subject type in all sgroups targets.
+	// Changes of a disabled subject type are skipped, they are not replayed once it is enabled.
+	SetSyncerEnabled(subjectType types.SyncSubjectType, enabled bool) error
+
+	// SyncerStates returns the registered syncers sorted by subject type
+	SyncerStates() []SyncerState
+
+	// UnregisterSyncer removes the syncer of the subject type registered for the sgroups target
+	UnregisterSyncer(target string, subjectType types.SyncSubjectType) error
+}
+
+// SyncScope limits synchronization to a part of the cluster
+type SyncScope struct {
+	// IncludeNamespaces are the only synchronized namespaces, empty means all namespaces
+	IncludeNamespaces []string `yaml:"include_namespaces"`
+	// ExcludeNamespaces are never synchronized, they take precedence over IncludeNamespaces
+	ExcludeNamespaces []string `yaml:"exclude_namespaces"`
+	// LabelSelector selects synchronized resources by labels, e.g. "sgroups.io/managed=true".
+	// IEAgAgRules are generated without labels and are scoped by namespace only.
+	LabelSelector string `yaml:"label_selector"`
+	// SubjectTypes are the only synchronized subject types, empty means all registered syncers
+	SubjectTypes []types.SyncSubjectType `yaml:"subject_types"`
+}
+
+// SyncScopeConfigurer is implemented by sync managers able to limit synchronization to a scope.
+// Entities out of scope are skipped as if their namespace was routed to types.SyncTargetNone.
+type SyncScopeConfigurer interface {
+	// SetSyncScope replaces the sync scope, an empty scope synchronizes everything
+	SetSyncScope(scope SyncScope) error
+
+	// InSyncScope returns true if upserts of the entity are synchronized.
+	// Deletes are scoped by namespace only, labels of a deleted resource may be unknown.
+	InSyncScope(entity SyncableEntity) bool
+
+	// NamespaceInSyncScope returns true if resources of the namespace may be synchronized
+	NamespaceInSyncScope(namespace string) bool
+}
+
+// SyncOutboxNotifier is notified after sync operations were committed to the outbox
+type SyncOutboxNotifier interface {
+	// Notify triggers delivery of pending outbox entries
+	Notify()
+}
+
 // SGroupGateway defines the interface for communicating with sgroups service
 type SGroupGateway interface {
 	// Sync sends a synchronization request to sgroups
@@ -79,12 +157,55 @@ type SGroupGateway interface {
 	GetHostsInSecurityGroup(ctx context.Context, sgNames []string) ([]*pb.Host, error)
 }
 
+// SGroupStateLister is implemented by gateways able to list the whole state stored in sgroups,
+// it is used to detect drift between the netguard database and sgroups
+type SGroupStateLister interface {
+	// ListSecurityGroups retrieves all security groups
+	ListSecurityGroups(ctx context.Context) ([]*pb.SecGroup, error)
+
+	// ListNetworks retrieves all networks
+	ListNetworks(ctx context.Context) ([]*pb.Network, error)
+
+	// ListIESgSgRules retrieves all IESgSgRules
+	ListIESgSgRules(ctx context.Context) ([]*pb.IESgSgRule, error)
+
+	// ListAllHosts retrieves all hosts
+	ListAllHosts(ctx context.Context) ([]*pb.Host, error)
+}
+
 // RetryConfig defines retry configuration for synchronization
 type RetryConfig struct {
-	MaxRetries    int
-	InitialDelay  int // milliseconds
-	MaxDelay      int // milliseconds
-	BackoffFactor float64
+	MaxRetries    int     `yaml:"max_retries"`
+	InitialDelay  int     `yaml:"initial_delay"` // milliseconds
+	MaxDelay      int     `yaml:"max_delay"`     // milliseconds
+	BackoffFactor float64 `yaml:"backoff_factor"`
+	// Jitter randomizes every delay by up to the given fraction (0..1) in both directions,
+	// so entities failed at the same time are not retried in lockstep
+	Jitter float64 `yaml:"jitter"`
+}
+
+// RetryPolicyConfigurer is implemented by sync managers with configurable retry policies
+type RetryPolicyConfigurer interface {
+	// SetRetryPolicy sets the default retry policy and per subject type overrides.
+	// Zero fields of an override are taken from the default policy.
+	SetRetryPolicy(defaultPolicy RetryConfig, overrides map[types.SyncSubjectType]RetryConfig)
+}
+
+// BatchingConfig describes coalescing of SyncEntity calls into bulk sgroups syncs
+type BatchingConfig struct {
+	// Window is the time changes are collected before they are synced in bulk, 0 disables batching
+	Window time.Duration `yaml:"window"`
+	// MaxBatchSize flushes collected changes early once that many entities are pending
+	MaxBatchSize int `yaml:"max_batch_size"`
+	// SubjectTypes are the subject types whose SyncEntity calls are batched
+	SubjectTypes []types.SyncSubjectType `yaml:"subject_types"`
+}
+
+// SyncBatchingConfigurer is implemented by sync managers able to batch SyncEntity calls.
+// Batched SyncEntity calls return before the entity reaches sgroups, failures are
+// reported through SyncStatusProvider. SyncEntityForced is never batched.
+type SyncBatchingConfigurer interface {
+	SetBatching(config BatchingConfig)
 }
 
 // SyncTracker tracks synchronization statistics and provides debouncing
@@ -102,6 +223,96 @@ type SyncTracker interface {
 	ShouldSyncForced(key string, operation types.SyncOperation) bool
 }
 
+// SyncStatsProvider is implemented by sync managers that expose runtime statistics
+type SyncStatsProvider interface {
+	// GetStats returns synchronization statistics
+	GetStats() map[types.SyncSubjectType]SyncStats
+
+	// InFlight returns the number of sync operations currently executing per subject type
+	InFlight() map[types.SyncSubjectType]int64
+}
+
+// SyncStatusProvider is implemented by sync managers that track per-kind and per-entity sync status
+type SyncStatusProvider interface {
+	// GetDetailedSyncStatus returns status of every subject type and of recently synced entities
+	GetDetailedSyncStatus() DetailedSyncStatus
+}
+
+// DetailedSyncStatus represents sync status per subject type and per entity
+type DetailedSyncStatus struct {
+	Kinds    []KindSyncStatus
+	Entities []EntitySyncStatus
+}
+
+// KindSyncStatus represents sync status of a subject type
+type KindSyncStatus struct {
+	SubjectType     types.SyncSubjectType
+	TotalRequests   int64
+	SuccessfulSyncs int64
+	FailedSyncs     int64
+	Retries         int64 // Total retries performed for the subject type
+	LastSyncTime    time.Time
+	LastSuccessTime time.Time
+	LastError       string
+	LastErrorTime   time.Time
+}
+
+// EntitySyncStatus represents sync status of a single entity
+type EntitySyncStatus struct {
+	SubjectType         types.SyncSubjectType
+	Key                 string
+	Operation           types.SyncOperation
+	LastSyncTime        time.Time
+	LastSuccessTime     time.Time
+	LastError           string // Empty if the last sync succeeded
+	Retries             int    // Retries performed during the last sync
+	ConsecutiveFailures int
+}
+
+// ErrReverseSyncDisabled is returned when reverse synchronization is not configured
+var ErrReverseSyncDisabled = errors.New("reverse synchronization is disabled")
+
+// ErrReverseSyncNotRunning is returned when a cycle is triggered before reverse synchronization started
+var ErrReverseSyncNotRunning = errors.New("reverse synchronization is not running")
+
+// ReverseSyncController pauses, resumes and triggers the reverse (SGROUP -> NETGUARD) synchronization
+type ReverseSyncController interface {
+	// PauseReverseSync skips SGROUP changes until ResumeReverseSync
+	PauseReverseSync() error
+	// ResumeReverseSync continues processing of SGROUP changes
+	ResumeReverseSync() error
+	// TriggerReverseSync runs a cycle immediately and waits for it, also while paused
+	TriggerReverseSync(ctx context.Context) error
+}
+
+// ReverseSyncStatus represents statistics of the reverse (SGROUP -> NETGUARD) synchronization
+type ReverseSyncStatus struct {
+	Running            bool
+	Paused             bool
+	StartTime          time.Time
+	TotalEvents        int64
+	ProcessedEvents    int64
+	FailedEvents       int64
+	SkippedEvents      int64         // Events detected while paused
+	LastEventTime      time.Time     // Time of the last SGROUP change
+	LastSuccessfulSync time.Time     // Last time a change was processed without errors
+	Lag                time.Duration // Delay between the last processed SGROUP change and the end of its processing
+	PendingConflicts   int           // Host conflicts waiting for manual review
+	Entities           []ReverseSyncEntityStatus
+}
+
+// ReverseSyncEntityStatus represents reverse synchronization statistics of an entity type
+type ReverseSyncEntityStatus struct {
+	EntityType       string // host, network, address_group
+	TotalRequests    int64
+	SuccessfulSyncs  int64
+	FailedSyncs      int64
+	ObjectsProcessed int64 // Objects written to NETGUARD
+	ObjectsFailed    int64
+	Conflicts        int64
+	LastSyncTime     time.Time
+}
+
 // SyncStats represents synchronization statistics for a subject type
 type SyncStats struct {
 	TotalRequests   int64
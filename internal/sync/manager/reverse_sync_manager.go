@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"netguard-pg-backend/internal/sync/detector"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
 )
 
 // EntityProcessorInterface defines the interface for entity processors
@@ -18,6 +21,12 @@ type EntityProcessorInterface interface {
 	ProcessChanges(ctx context.Context, event detector.ChangeEvent) error
 }
 
+// ResultReportingProcessor is implemented by entity processors that report how many
+// objects a change event synchronized, the counts are accumulated in EntityStats
+type ResultReportingProcessor interface {
+	ProcessChangesWithResult(ctx context.Context, event detector.ChangeEvent) (types.ProcessingResult, error)
+}
+
 // ReverseSyncManager manages the reverse synchronization system
 type ReverseSyncManager struct {
 	mu sync.RWMutex
@@ -32,6 +41,9 @@ type ReverseSyncManager struct {
 	ctx       context.Context
 	cancel    context.CancelFunc
 
+	// paused makes OnChange skip detected SGROUP changes, TriggerSync still runs cycles
+	paused atomic.Bool
+
 	// Statistics
 	stats ReverseSyncStats
 }
@@ -65,8 +77,15 @@ type ReverseSyncStats struct {
 	TotalEvents        int64
 	ProcessedEvents    int64
 	FailedEvents       int64
+	SkippedEvents      int64 // Events detected while paused
 	LastEventTimestamp time.Time
 
+	// LastSuccessfulSync is when an event was last processed by all processors without errors
+	LastSuccessfulSync time.Time
+
+	// Lag is the delay between the last successfully processed SGROUP change and the end of its processing
+	Lag time.Duration
+
 	// Entity counters by type
 	EntityCounts map[string]EntityStats
 
@@ -82,6 +101,11 @@ type EntityStats struct {
 	FailedSyncs        int64
 	LastSyncTime       time.Time
 	AverageSuccessRate float64
+
+	// Objects reported by processors implementing ResultReportingProcessor
+	ObjectsProcessed int64
+	ObjectsFailed    int64
+	Conflicts        int64
 }
 
 // DefaultReverseSyncConfig returns default configuration
@@ -216,8 +240,45 @@ func (m *ReverseSyncManager) Stop() error {
 	return nil
 }
 
-// OnChange implements detector.ChangeHandler interface
+// ManualTriggerSource is the source of change events created by TriggerSync
+const ManualTriggerSource = "manual-trigger"
+
+// OnChange implements detector.ChangeHandler interface. Changes detected while the manager
+// is paused are skipped: processors compare the full state, so the next cycle after resume
+// reconciles them.
 func (m *ReverseSyncManager) OnChange(ctx context.Context, event detector.ChangeEvent) error {
+	if m.paused.Load() {
+		m.updateSkippedEventStats()
+		return nil
+	}
+	return m.processEvent(ctx, event)
+}
+
+// Pause stops processing of detected SGROUP changes until Resume
+func (m *ReverseSyncManager) Pause() {
+	m.paused.Store(true)
+}
+
+// Resume continues processing of detected SGROUP changes
+func (m *ReverseSyncManager) Resume() {
+	m.paused.Store(false)
+}
+
+// IsPaused returns true if detected SGROUP changes are skipped
+func (m *ReverseSyncManager) IsPaused() bool {
+	return m.paused.Load()
+}
+
+// TriggerSync runs a synchronization cycle immediately and waits for it, also while paused
+func (m *ReverseSyncManager) TriggerSync(ctx context.Context) error {
+	if !m.IsRunning() {
+		return interfaces.ErrReverseSyncNotRunning
+	}
+	return m.processEvent(ctx, detector.ChangeEvent{Timestamp: time.Now(), Source: ManualTriggerSource})
+}
+
+// processEvent runs all registered processors for a change event
+func (m *ReverseSyncManager) processEvent(ctx context.Context, event detector.ChangeEvent) error {
 	startTime := time.Now()
 
 	m.updateEventStats(event)
@@ -291,7 +352,7 @@ func (m *ReverseSyncManager) OnChange(ctx context.Context, event detector.Change
 		return fmt.Errorf("processing failed for some entities: %s", errorMsg)
 	}
 
-	m.updateProcessedEventStats()
+	m.updateProcessedEventStats(event)
 
 	return nil
 }
@@ -306,11 +367,17 @@ func (m *ReverseSyncManager) processWithProcessor(
 	startTime := time.Now()
 
 
-	err := processor.ProcessChanges(ctx, event)
+	var result types.ProcessingResult
+	var err error
+	if reporting, ok := processor.(ResultReportingProcessor); ok {
+		result, err = reporting.ProcessChangesWithResult(ctx, event)
+	} else {
+		err = processor.ProcessChanges(ctx, event)
+	}
 
 	// Update entity-specific statistics
 	if m.config.EnableStatistics {
-		m.updateEntityStats(entityType, err == nil, time.Since(startTime))
+		m.updateEntityStats(entityType, err == nil, time.Since(startTime), result)
 	}
 
 	if err != nil {
@@ -349,7 +416,10 @@ func (m *ReverseSyncManager) GetStats() ReverseSyncStats {
 		TotalEvents:           m.stats.TotalEvents,
 		ProcessedEvents:       m.stats.ProcessedEvents,
 		FailedEvents:          m.stats.FailedEvents,
+		SkippedEvents:         m.stats.SkippedEvents,
 		LastEventTimestamp:    m.stats.LastEventTimestamp,
+		LastSuccessfulSync:    m.stats.LastSuccessfulSync,
+		Lag:                   m.stats.Lag,
 		AverageProcessingTime: m.stats.AverageProcessingTime,
 		TotalProcessingTime:   m.stats.TotalProcessingTime,
 		EntityCounts:          make(map[string]EntityStats),
@@ -404,8 +474,21 @@ func (m *ReverseSyncManager) updateEventStats(event detector.ChangeEvent) {
 	m.stats.LastEventTimestamp = event.Timestamp
 }
 
-// updateProcessedEventStats updates processed event statistics
-func (m *ReverseSyncManager) updateProcessedEventStats() {
+// updateSkippedEventStats counts events detected while paused
+func (m *ReverseSyncManager) updateSkippedEventStats() {
+	if !m.config.EnableStatistics {
+		return
+	}
+
+	m.stats.mu.Lock()
+	defer m.stats.mu.Unlock()
+
+	m.stats.TotalEvents++
+	m.stats.SkippedEvents++
+}
+
+// updateProcessedEventStats updates processed event statistics and the sync lag
+func (m *ReverseSyncManager) updateProcessedEventStats(event detector.ChangeEvent) {
 	if !m.config.EnableStatistics {
 		return
 	}
@@ -414,6 +497,10 @@ func (m *ReverseSyncManager) updateProcessedEventStats() {
 	defer m.stats.mu.Unlock()
 
 	m.stats.ProcessedEvents++
+	m.stats.LastSuccessfulSync = time.Now()
+	if !event.Timestamp.IsZero() {
+		m.stats.Lag = m.stats.LastSuccessfulSync.Sub(event.Timestamp)
+	}
 }
 
 // updateFailedEventStats updates failed event statistics
@@ -440,7 +527,7 @@ func (m *ReverseSyncManager) updateProcessingStats(duration time.Duration) {
 }
 
 // updateEntityStats updates entity-specific statistics
-func (m *ReverseSyncManager) updateEntityStats(entityType string, success bool, duration time.Duration) {
+func (m *ReverseSyncManager) updateEntityStats(entityType string, success bool, duration time.Duration, result types.ProcessingResult) {
 	m.stats.mu.Lock()
 	defer m.stats.mu.Unlock()
 
@@ -453,6 +540,9 @@ func (m *ReverseSyncManager) updateEntityStats(entityType string, success bool,
 	} else {
 		entityStats.FailedSyncs++
 	}
+	entityStats.ObjectsProcessed += int64(result.Processed)
+	entityStats.ObjectsFailed += int64(result.Failed)
+	entityStats.Conflicts += int64(result.Conflicts)
 
 	// Update success rate
 	if entityStats.TotalRequests > 0 {
@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"netguard-pg-backend/internal/sync/detector"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
 )
 
 // MockChangeDetector implements detector.ChangeDetector for testing
@@ -409,6 +411,89 @@ func TestReverseSyncManager_Statistics(t *testing.T) {
 	processor.AssertExpectations(t)
 }
 
+// reportingProcessor reports a fixed result for every event
+type reportingProcessor struct {
+	result types.ProcessingResult
+	err    error
+}
+
+func (p *reportingProcessor) GetEntityType() string { return "network" }
+
+func (p *reportingProcessor) ProcessChanges(ctx context.Context, event detector.ChangeEvent) error {
+	_, err := p.ProcessChangesWithResult(ctx, event)
+	return err
+}
+
+func (p *reportingProcessor) ProcessChangesWithResult(context.Context, detector.ChangeEvent) (types.ProcessingResult, error) {
+	return p.result, p.err
+}
+
+func TestReverseSyncManager_StatisticsReportedByProcessors(t *testing.T) {
+	config := DefaultReverseSyncConfig()
+	config.EnableStatistics = true
+	manager := NewReverseSyncManager(NewMockChangeDetector(), config)
+
+	processor := &reportingProcessor{result: types.ProcessingResult{Processed: 3, Conflicts: 1}}
+	require.NoError(t, manager.RegisterProcessor(processor))
+
+	changedAt := time.Now().Add(-2 * time.Second)
+	require.NoError(t, manager.OnChange(context.Background(), detector.ChangeEvent{Source: "test-sgroup", Timestamp: changedAt}))
+
+	processor.result = types.ProcessingResult{Failed: 2}
+	processor.err = errors.New("write failed")
+	require.Error(t, manager.OnChange(context.Background(), detector.ChangeEvent{Source: "test-sgroup", Timestamp: time.Now()}))
+
+	stats := manager.GetStats()
+	networkStats := stats.EntityCounts["network"]
+	assert.Equal(t, int64(3), networkStats.ObjectsProcessed)
+	assert.Equal(t, int64(2), networkStats.ObjectsFailed)
+	assert.Equal(t, int64(1), networkStats.Conflicts)
+
+	assert.Equal(t, int64(1), stats.ProcessedEvents)
+	assert.False(t, stats.LastSuccessfulSync.IsZero())
+	assert.GreaterOrEqual(t, stats.Lag, 2*time.Second, "lag is measured for the last successful event")
+}
+
+func TestReverseSyncManager_PauseResumeTrigger(t *testing.T) {
+	mockDetector := NewMockChangeDetector()
+	config := DefaultReverseSyncConfig()
+	config.EnableStatistics = true
+	manager := NewReverseSyncManager(mockDetector, config)
+
+	processor := &reportingProcessor{result: types.ProcessingResult{Processed: 1}}
+	require.NoError(t, manager.RegisterProcessor(processor))
+
+	ctx := context.Background()
+	require.ErrorIs(t, manager.TriggerSync(ctx), interfaces.ErrReverseSyncNotRunning)
+
+	mockDetector.On("Subscribe", manager).Return(nil)
+	mockDetector.On("Start", mock.Anything).Return(nil)
+	mockDetector.On("Stop").Return(nil)
+	mockDetector.On("Unsubscribe", manager).Return(nil)
+	require.NoError(t, manager.Start(ctx))
+	defer manager.Stop()
+
+	manager.Pause()
+	assert.True(t, manager.IsPaused())
+	require.NoError(t, manager.OnChange(ctx, detector.ChangeEvent{Source: "test-sgroup", Timestamp: time.Now()}))
+
+	stats := manager.GetStats()
+	assert.Equal(t, int64(1), stats.SkippedEvents)
+	assert.Equal(t, int64(0), stats.ProcessedEvents, "changes are skipped while paused")
+
+	require.NoError(t, manager.TriggerSync(ctx), "a manual cycle runs while paused")
+	assert.Equal(t, int64(1), manager.GetStats().ProcessedEvents)
+
+	manager.Resume()
+	assert.False(t, manager.IsPaused())
+	require.NoError(t, manager.OnChange(ctx, detector.ChangeEvent{Source: "test-sgroup", Timestamp: time.Now()}))
+
+	stats = manager.GetStats()
+	assert.Equal(t, int64(2), stats.ProcessedEvents)
+	assert.Equal(t, int64(3), stats.TotalEvents)
+	assert.Equal(t, int64(2), stats.EntityCounts["network"].ObjectsProcessed)
+}
+
 func TestReverseSyncManager_StatisticsDisabled(t *testing.T) {
 	mockDetector := NewMockChangeDetector()
 	config := DefaultReverseSyncConfig()
@@ -0,0 +1,126 @@
+package manager
+
+import (
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// pendingSync is the latest requested operation of an entity
+type pendingSync struct {
+	entity    interfaces.SyncableEntity
+	operation types.SyncOperation
+}
+
+// syncBatcher coalesces SyncEntity calls of configured subject types and
+// delivers them through SyncBatch, so e.g. a FullSync of a large namespace
+// uses a few bulk sgroups calls instead of one call per AddressGroup
+type syncBatcher struct {
+	mu           sync.Mutex
+	config       interfaces.BatchingConfig
+	subjectTypes map[types.SyncSubjectType]bool
+	pending      map[string]pendingSync
+	order        []string
+	timer        *time.Timer
+
+	// flushMu keeps flushes in order, so a later operation of an entity is never overtaken
+	flushMu sync.Mutex
+}
+
+// SetBatching enables batching of SyncEntity calls for the configured subject types
+func (sm *syncManager) SetBatching(config interfaces.BatchingConfig) {
+	subjectTypes := make(map[types.SyncSubjectType]bool, len(config.SubjectTypes))
+	for _, subjectType := range config.SubjectTypes {
+		subjectTypes[subjectType] = true
+	}
+
+	sm.batcher.mu.Lock()
+	sm.batcher.config = config
+	sm.batcher.subjectTypes = subjectTypes
+	sm.batcher.mu.Unlock()
+
+	sm.logger.Info("Configured sync batching", "window", config.Window, "maxBatchSize", config.MaxBatchSize, "subjectTypes", config.SubjectTypes)
+}
+
+// batched returns true when SyncEntity calls of the subject type are batched.
+// Only upserts and deletes are coalesced.
+func (b *syncBatcher) batched(subjectType types.SyncSubjectType, operation types.SyncOperation) bool {
+	if operation != types.SyncOperationUpsert && operation != types.SyncOperationDelete {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.config.Window > 0 && b.subjectTypes[subjectType]
+}
+
+// enqueueBatched stores the entity until the batch is flushed. A later operation
+// of the same entity replaces the earlier one.
+func (sm *syncManager) enqueueBatched(entity interfaces.SyncableEntity, operation types.SyncOperation) {
+	b := &sm.batcher
+	key := string(entity.GetSyncSubjectType()) + "/" + entity.GetSyncKey()
+
+	b.mu.Lock()
+	if b.pending == nil {
+		b.pending = make(map[string]pendingSync)
+	}
+	if _, exists := b.pending[key]; !exists {
+		b.order = append(b.order, key)
+	}
+	b.pending[key] = pendingSync{entity: entity, operation: operation}
+
+	full := b.config.MaxBatchSize > 0 && len(b.pending) >= b.config.MaxBatchSize
+	if full && b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	if !full && b.timer == nil {
+		b.timer = time.AfterFunc(b.config.Window, sm.flushBatched)
+	}
+	b.mu.Unlock()
+
+	if full {
+		go sm.flushBatched()
+	}
+}
+
+// flushBatched syncs all pending entities, upserts first
+func (sm *syncManager) flushBatched() {
+	b := &sm.batcher
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	pending, order := b.pending, b.order
+	b.pending, b.order = nil, nil
+	b.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	var upserts, deletes []interfaces.SyncableEntity
+	for _, key := range order {
+		item := pending[key]
+		if item.operation == types.SyncOperationDelete {
+			deletes = append(deletes, item.entity)
+		} else {
+			upserts = append(upserts, item.entity)
+		}
+	}
+
+	sm.logger.V(1).Info("Flushing batched syncs", "upserts", len(upserts), "deletes", len(deletes))
+	// SyncBatch records failures in the sync status, there is no caller to return them to
+	if len(upserts) > 0 {
+		_ = sm.SyncBatch(sm.ctx, upserts, types.SyncOperationUpsert)
+	}
+	if len(deletes) > 0 {
+		_ = sm.SyncBatch(sm.ctx, deletes, types.SyncOperationDelete)
+	}
+}
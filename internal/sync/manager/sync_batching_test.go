@@ -0,0 +1,91 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// batchCountingSyncer counts bulk calls per operation
+type batchCountingSyncer struct {
+	recordingSyncer
+	mu      sync.Mutex
+	batches map[types.SyncOperation]int
+}
+
+func (s *batchCountingSyncer) SyncBatch(ctx context.Context, entities []interfaces.SyncableEntity, operation types.SyncOperation) error {
+	err := s.recordingSyncer.SyncBatch(ctx, entities, operation)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.batches == nil {
+		s.batches = make(map[types.SyncOperation]int)
+	}
+	s.batches[operation]++
+	return err
+}
+
+func (s *batchCountingSyncer) batchCount(operation types.SyncOperation) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.batches[operation]
+}
+
+func TestSyncManager_BatchesSyncEntity(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	syncer := &batchCountingSyncer{}
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeGroups, syncer))
+	sm.(interfaces.SyncBatchingConfigurer).SetBatching(interfaces.BatchingConfig{
+		Window:       20 * time.Millisecond,
+		SubjectTypes: []types.SyncSubjectType{types.SyncSubjectTypeGroups},
+	})
+
+	ctx := context.Background()
+	for _, name := range []string{"ag-1", "ag-2", "ag-3"} {
+		require.NoError(t, sm.SyncEntity(ctx, newTestAddressGroup("default", name), types.SyncOperationUpsert))
+	}
+	// The latest operation of an entity wins
+	require.NoError(t, sm.SyncEntity(ctx, newTestAddressGroup("default", "ag-3"), types.SyncOperationDelete))
+	assert.Equal(t, 0, syncer.batchCount(types.SyncOperationUpsert), "nothing is synced before the window expires")
+
+	require.Eventually(t, func() bool {
+		return syncer.batchCount(types.SyncOperationDelete) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, 1, syncer.batchCount(types.SyncOperationUpsert))
+	assert.Len(t, syncer.keys, 3)
+
+	// Forced syncs bypass batching
+	require.NoError(t, sm.SyncEntityForced(ctx, newTestAddressGroup("default", "ag-4"), types.SyncOperationUpsert))
+	assert.Len(t, syncer.keys, 4)
+}
+
+func TestSyncManager_BatchFlushesWhenFull(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	syncer := &batchCountingSyncer{}
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeGroups, syncer))
+	sm.(interfaces.SyncBatchingConfigurer).SetBatching(interfaces.BatchingConfig{
+		Window:       time.Hour,
+		MaxBatchSize: 2,
+		SubjectTypes: []types.SyncSubjectType{types.SyncSubjectTypeGroups},
+	})
+
+	ctx := context.Background()
+	require.NoError(t, sm.SyncEntity(ctx, newTestAddressGroup("default", "ag-1"), types.SyncOperationUpsert))
+	require.NoError(t, sm.SyncEntity(ctx, newTestAddressGroup("default", "ag-2"), types.SyncOperationUpsert))
+	require.Eventually(t, func() bool {
+		return syncer.batchCount(types.SyncOperationUpsert) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	// Stop delivers whatever is still pending
+	require.NoError(t, sm.SyncEntity(ctx, newTestAddressGroup("default", "ag-3"), types.SyncOperationUpsert))
+	require.NoError(t, sm.Stop())
+	assert.Equal(t, 2, syncer.batchCount(types.SyncOperationUpsert))
+}
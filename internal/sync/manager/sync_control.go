@@ -0,0 +1,99 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// SetSyncerEnabled enables or disables the syncers of the subject type in all sgroups targets
+func (sm *syncManager) SetSyncerEnabled(subjectType types.SyncSubjectType, enabled bool) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if len(sm.targetsOf(subjectType)) == 0 {
+		return fmt.Errorf("%w %s", interfaces.ErrSyncerNotRegistered, subjectType)
+	}
+	if enabled {
+		delete(sm.disabled, subjectType)
+	} else {
+		if sm.disabled == nil {
+			sm.disabled = make(map[types.SyncSubjectType]bool)
+		}
+		sm.disabled[subjectType] = true
+	}
+	sm.logger.Info("Configured syncer", "subjectType", subjectType, "enabled", enabled)
+
+	return nil
+}
+
+// SyncerStates returns the registered syncers sorted by subject type
+func (sm *syncManager) SyncerStates() []interfaces.SyncerState {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	subjectTypes := make(map[types.SyncSubjectType]bool, len(sm.syncers))
+	for subjectType := range sm.syncers {
+		subjectTypes[subjectType] = true
+	}
+	for _, syncers := range sm.targetSyncers {
+		for subjectType := range syncers {
+			subjectTypes[subjectType] = true
+		}
+	}
+
+	states := make([]interfaces.SyncerState, 0, len(subjectTypes))
+	for subjectType := range subjectTypes {
+		states = append(states, interfaces.SyncerState{
+			SubjectType: subjectType,
+			Targets:     sm.targetsOf(subjectType),
+			Enabled:     !sm.disabled[subjectType],
+		})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].SubjectType < states[j].SubjectType })
+	return states
+}
+
+// UnregisterSyncer removes the syncer of the subject type registered for the sgroups target.
+// Entities of the subject type routed to the target fail to sync until a syncer is registered again.
+func (sm *syncManager) UnregisterSyncer(target string, subjectType types.SyncSubjectType) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	syncers := sm.targetSyncers[target]
+	if target == types.SyncTargetDefault {
+		syncers = sm.syncers
+	}
+	if _, exists := syncers[subjectType]; !exists {
+		return fmt.Errorf("%w %s of target %s", interfaces.ErrSyncerNotRegistered, subjectType, target)
+	}
+	delete(syncers, subjectType)
+	sm.logger.Info("Unregistered syncer", "subjectType", subjectType, "target", target)
+
+	return nil
+}
+
+// syncerEnabled returns false if the syncers of the subject type are disabled
+func (sm *syncManager) syncerEnabled(subjectType types.SyncSubjectType) bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return !sm.disabled[subjectType]
+}
+
+// targetsOf returns sorted sgroups targets with a registered syncer of the subject type.
+// The caller holds sm.mu.
+func (sm *syncManager) targetsOf(subjectType types.SyncSubjectType) []string {
+	var targets []string
+	if _, exists := sm.syncers[subjectType]; exists {
+		targets = append(targets, types.SyncTargetDefault)
+	}
+	for target, syncers := range sm.targetSyncers {
+		if _, exists := syncers[subjectType]; exists {
+			targets = append(targets, target)
+		}
+	}
+	sort.Strings(targets)
+	return targets
+}
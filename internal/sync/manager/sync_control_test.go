@@ -0,0 +1,47 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+func TestSyncManager_SyncerController(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	controller, ok := sm.(interfaces.SyncerController)
+	require.True(t, ok)
+	router := sm.(interfaces.SyncTargetRouter)
+
+	syncer := &recordingSyncer{}
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeGroups, syncer))
+	require.NoError(t, router.RegisterTargetSyncer("secondary", types.SyncSubjectTypeGroups, &recordingSyncer{}))
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeHosts, &recordingSyncer{}))
+
+	assert.ErrorIs(t, controller.SetSyncerEnabled(types.SyncSubjectTypeNetworks, false), interfaces.ErrSyncerNotRegistered)
+	require.NoError(t, controller.SetSyncerEnabled(types.SyncSubjectTypeGroups, false))
+	assert.Equal(t, []interfaces.SyncerState{
+		{SubjectType: types.SyncSubjectTypeGroups, Targets: []string{types.SyncTargetDefault, "secondary"}, Enabled: false},
+		{SubjectType: types.SyncSubjectTypeHosts, Targets: []string{types.SyncTargetDefault}, Enabled: true},
+	}, controller.SyncerStates())
+
+	ctx := context.Background()
+	ag := newTestAddressGroup("default", "ag-1")
+	require.NoError(t, sm.SyncEntityForced(ctx, ag, types.SyncOperationUpsert))
+	require.NoError(t, sm.SyncBatch(ctx, []interfaces.SyncableEntity{ag}, types.SyncOperationUpsert))
+	assert.Empty(t, syncer.keys, "changes of a disabled syncer are skipped")
+
+	require.NoError(t, controller.SetSyncerEnabled(types.SyncSubjectTypeGroups, true))
+	require.NoError(t, sm.SyncEntityForced(ctx, ag, types.SyncOperationUpsert))
+	assert.Equal(t, []string{ag.GetSyncKey()}, syncer.keys)
+
+	require.NoError(t, controller.UnregisterSyncer(types.SyncTargetDefault, types.SyncSubjectTypeGroups))
+	assert.ErrorIs(t, controller.UnregisterSyncer(types.SyncTargetDefault, types.SyncSubjectTypeGroups), interfaces.ErrSyncerNotRegistered)
+	assert.Error(t, sm.SyncEntityForced(ctx, newTestAddressGroup("default", "ag-2"), types.SyncOperationUpsert))
+	assert.Equal(t, []string{"secondary"}, controller.SyncerStates()[0].Targets)
+}
@@ -22,12 +22,35 @@ type syncManager struct {
 	retryConfig interfaces.RetryConfig
 	logger      logr.Logger
 
+	// Retry policies overriding retryConfig per subject type
+	retryOverrides map[types.SyncSubjectType]interfaces.RetryConfig
+
 	// Background processing
 	ctx    context.Context
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 	mu     sync.RWMutex
 
+	// Syncers of named sgroups targets and namespace routes to them
+	targetSyncers    map[string]map[types.SyncSubjectType]interface{}
+	namespaceTargets map[string]string
+
+	// Part of the cluster synchronized with sgroups
+	scope syncScope
+
+	// Subject types whose syncers are paused
+	disabled map[types.SyncSubjectType]bool
+
+	// Last sync results per subject type and entity
+	status *syncStatusTracker
+
+	// Coalesces SyncEntity calls into bulk syncs
+	batcher syncBatcher
+
+	// In-flight sync operations per subject type
+	inFlightMu sync.Mutex
+	inFlight   map[types.SyncSubjectType]int64
+
 	// Configuration
 	cleanupInterval time.Duration
 	maxEntryAge     time.Duration
@@ -40,9 +63,12 @@ func NewSyncManager(gateway interfaces.SGroupGateway, logger logr.Logger) interf
 	return &syncManager{
 		gateway:         gateway,
 		syncers:         make(map[types.SyncSubjectType]interface{}),
+		targetSyncers:   make(map[string]map[types.SyncSubjectType]interface{}),
 		syncTracker:     utils.NewSyncTracker(1 * time.Second), // 1 second debounce
 		retryConfig:     utils.DefaultRetryConfig(),
 		logger:          logger,
+		status:          newSyncStatusTracker(),
+		inFlight:        make(map[types.SyncSubjectType]int64),
 		ctx:             ctx,
 		cancel:          cancel,
 		cleanupInterval: 10 * time.Minute,
@@ -85,6 +111,21 @@ func (sm *syncManager) syncEntityInternal(ctx context.Context, entity interfaces
 	subjectType := entity.GetSyncSubjectType()
 	syncKey := entity.GetSyncKey()
 
+	if !sm.inScope(entity, operation) {
+		sm.logger.V(1).Info("Skipping sync of entity out of sync scope", "key", syncKey, "operation", operation)
+		return nil
+	}
+
+	if !sm.syncerEnabled(subjectType) {
+		sm.logger.Info("Skipping sync of entity, syncer is disabled", "key", syncKey, "subjectType", subjectType, "operation", operation)
+		return nil
+	}
+
+	// Batched entities are synced in bulk after the batching window
+	if !forced && sm.batcher.batched(subjectType, operation) {
+		sm.enqueueBatched(entity, operation)
+		return nil
+	}
 
 	// Check if we should sync (debouncing or forced)
 	var shouldSync bool
@@ -99,27 +140,38 @@ func (sm *syncManager) syncEntityInternal(ctx context.Context, entity interfaces
 		return nil
 	}
 
+	// Route the entity to the sgroups target of its namespace
+	target := sm.targetFor(entity)
+	if target == types.SyncTargetNone {
+		sm.logger.V(1).Info("Skipping sync of not enforced namespace", "key", syncKey, "operation", operation)
+		return nil
+	}
+
 	// Get the appropriate syncer
-	sm.mu.RLock()
-	syncer, exists := sm.syncers[subjectType]
-	sm.mu.RUnlock()
+	syncer, exists := sm.syncerFor(target, subjectType)
 
 
 	if !exists {
-		err := fmt.Errorf("no syncer registered for subject type: %s", subjectType)
+		err := fmt.Errorf("no syncer registered for subject type %s of target %s", subjectType, target)
 		sm.syncTracker.Track(subjectType, operation, false)
+		sm.status.record(subjectType, []string{syncKey}, operation, 0, err)
 		return err
 	}
 
 	// Execute sync with retry
 	startTime := time.Now()
-	err := utils.ExecuteWithRetry(ctx, sm.retryConfig, func() error {
+	attempts := 0
+	sm.trackInFlight(subjectType, 1)
+	err := utils.ExecuteWithRetry(ctx, sm.retryPolicyFor(subjectType), func() error {
+		attempts++
 		return sm.executeSyncWithReflection(ctx, syncer, entity, operation)
 	})
+	sm.trackInFlight(subjectType, -1)
 
 	// Track the result
 	success := err == nil
 	sm.syncTracker.Track(subjectType, operation, success)
+	sm.status.record(subjectType, []string{syncKey}, operation, attempts, err)
 
 	if success {
 		sm.logger.Info("Successfully synced entity",
@@ -132,6 +184,7 @@ func (sm *syncManager) syncEntityInternal(ctx context.Context, entity interfaces
 			"key", syncKey,
 			"subjectType", subjectType,
 			"operation", operation,
+			"attempts", attempts,
 			"duration", time.Since(startTime))
 	}
 
@@ -144,50 +197,75 @@ func (sm *syncManager) SyncBatch(ctx context.Context, entities []interfaces.Sync
 		return nil
 	}
 
-	// Group entities by subject type
-	entityGroups := make(map[types.SyncSubjectType][]interfaces.SyncableEntity)
+	// Group entities by sgroups target and subject type
+	type groupKey struct {
+		target      string
+		subjectType types.SyncSubjectType
+	}
+	entityGroups := make(map[groupKey][]interfaces.SyncableEntity)
 	for _, entity := range entities {
-		if entity == nil {
+		if entity == nil || !sm.inScope(entity, operation) {
+			continue
+		}
+		if !sm.syncerEnabled(entity.GetSyncSubjectType()) {
+			sm.logger.Info("Skipping sync of entity, syncer is disabled", "key", entity.GetSyncKey(), "subjectType", entity.GetSyncSubjectType(), "operation", operation)
+			continue
+		}
+		target := sm.targetFor(entity)
+		if target == types.SyncTargetNone {
 			continue
 		}
-		subjectType := entity.GetSyncSubjectType()
-		entityGroups[subjectType] = append(entityGroups[subjectType], entity)
+		key := groupKey{target: target, subjectType: entity.GetSyncSubjectType()}
+		entityGroups[key] = append(entityGroups[key], entity)
 	}
 
 	// Sync each group
 	var lastErr error
-	for subjectType, groupEntities := range entityGroups {
-		sm.mu.RLock()
-		syncer, exists := sm.syncers[subjectType]
-		sm.mu.RUnlock()
+	for group, groupEntities := range entityGroups {
+		target, subjectType := group.target, group.subjectType
+		syncer, exists := sm.syncerFor(target, subjectType)
+
+		keys := make([]string, 0, len(groupEntities))
+		for _, entity := range groupEntities {
+			keys = append(keys, entity.GetSyncKey())
+		}
 
 		if !exists {
-			err := fmt.Errorf("no syncer registered for subject type: %s", subjectType)
+			err := fmt.Errorf("no syncer registered for subject type %s of target %s", subjectType, target)
 			sm.syncTracker.Track(subjectType, operation, false)
+			sm.status.record(subjectType, keys, operation, 0, err)
 			lastErr = err
 			continue
 		}
 
 		// Execute batch sync with retry
 		startTime := time.Now()
-		err := utils.ExecuteWithRetry(ctx, sm.retryConfig, func() error {
+		attempts := 0
+		sm.trackInFlight(subjectType, int64(len(groupEntities)))
+		err := utils.ExecuteWithRetry(ctx, sm.retryPolicyFor(subjectType), func() error {
+			attempts++
 			return sm.executeBatchSyncWithReflection(ctx, syncer, groupEntities, operation)
 		})
+		sm.trackInFlight(subjectType, -int64(len(groupEntities)))
 
 		// Track the result
 		success := err == nil
 		sm.syncTracker.Track(subjectType, operation, success)
+		sm.status.record(subjectType, keys, operation, attempts, err)
 
 		if success {
 			sm.logger.Info("Successfully synced batch",
 				"subjectType", subjectType,
+				"target", target,
 				"operation", operation,
 				"count", len(groupEntities),
 				"duration", time.Since(startTime))
 		} else {
 			sm.logger.Error(err, "Failed to sync batch",
 				"subjectType", subjectType,
+				"target", target,
 				"operation", operation,
+				"attempts", attempts,
 				"count", len(groupEntities),
 				"duration", time.Since(startTime))
 			lastErr = err
@@ -212,12 +290,65 @@ func (sm *syncManager) Start(ctx context.Context) error {
 func (sm *syncManager) Stop() error {
 	sm.logger.Info("Stopping sync manager")
 
+	// Deliver batched changes before the context is canceled
+	sm.flushBatched()
 	sm.cancel()
 	sm.wg.Wait()
 
 	return nil
 }
 
+// GetStats returns synchronization statistics collected by the sync tracker
+func (sm *syncManager) GetStats() map[types.SyncSubjectType]interfaces.SyncStats {
+	return sm.syncTracker.GetStats()
+}
+
+// InFlight returns the number of entities currently being synced per subject type
+func (sm *syncManager) InFlight() map[types.SyncSubjectType]int64 {
+	sm.inFlightMu.Lock()
+	defer sm.inFlightMu.Unlock()
+
+	result := make(map[types.SyncSubjectType]int64, len(sm.inFlight))
+	for subjectType, count := range sm.inFlight {
+		result[subjectType] = count
+	}
+	return result
+}
+
+// SetRetryPolicy sets the default retry policy and per subject type overrides
+func (sm *syncManager) SetRetryPolicy(defaultPolicy interfaces.RetryConfig, overrides map[types.SyncSubjectType]interfaces.RetryConfig) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.retryConfig = defaultPolicy
+	sm.retryOverrides = make(map[types.SyncSubjectType]interfaces.RetryConfig, len(overrides))
+	for subjectType, override := range overrides {
+		sm.retryOverrides[subjectType] = utils.MergeRetryConfig(sm.retryConfig, override)
+	}
+}
+
+// retryPolicyFor returns the retry policy of the subject type
+func (sm *syncManager) retryPolicyFor(subjectType types.SyncSubjectType) interfaces.RetryConfig {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	if policy, exists := sm.retryOverrides[subjectType]; exists {
+		return policy
+	}
+	return sm.retryConfig
+}
+
+// GetDetailedSyncStatus returns last sync results per subject type and per entity
+func (sm *syncManager) GetDetailedSyncStatus() interfaces.DetailedSyncStatus {
+	return sm.status.snapshot()
+}
+
+func (sm *syncManager) trackInFlight(subjectType types.SyncSubjectType, delta int64) {
+	sm.inFlightMu.Lock()
+	sm.inFlight[subjectType] += delta
+	sm.inFlightMu.Unlock()
+}
+
 // validateSyncer validates that syncer implements EntitySyncer interface using reflection
 func (sm *syncManager) validateSyncer(syncer interface{}) error {
 	syncerType := reflect.TypeOf(syncer)
@@ -306,8 +437,9 @@ func (sm *syncManager) cleanupRoutine() {
 		case <-sm.ctx.Done():
 			return
 		case <-ticker.C:
-			// Periodic cleanup - for now just log
+			// Periodic cleanup of per-entity sync statuses
 			// TODO: Add cleanup method to SyncTracker interface if needed
+			sm.status.cleanup(sm.maxEntryAge)
 			sm.logger.V(1).Info("Cleanup routine executed")
 		}
 	}
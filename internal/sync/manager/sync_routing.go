@@ -0,0 +1,113 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// namespacedEntity is implemented by entities living in a namespace
+type namespacedEntity interface {
+	GetNamespace() string
+}
+
+// RegisterTargetSyncer registers a syncer of a named sgroups target
+func (sm *syncManager) RegisterTargetSyncer(target string, subjectType types.SyncSubjectType, syncer interface{}) error {
+	if target == types.SyncTargetDefault {
+		return sm.RegisterSyncer(subjectType, syncer)
+	}
+	if target == "" || target == types.SyncTargetNone {
+		return fmt.Errorf("invalid sync target name: %q", target)
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if err := sm.validateSyncer(syncer); err != nil {
+		return fmt.Errorf("invalid syncer for subject type %s of target %s: %w", subjectType, target, err)
+	}
+
+	if sm.targetSyncers[target] == nil {
+		sm.targetSyncers[target] = make(map[types.SyncSubjectType]interface{})
+	}
+	sm.targetSyncers[target][subjectType] = syncer
+	sm.logger.Info("Registered syncer", "subjectType", subjectType, "target", target)
+
+	return nil
+}
+
+// SetNamespaceTargets replaces namespace to target routes
+func (sm *syncManager) SetNamespaceTargets(routes map[string]string) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	namespaceTargets := make(map[string]string, len(routes))
+	for namespace, target := range routes {
+		switch target {
+		case types.SyncTargetDefault, types.SyncTargetNone:
+		default:
+			if _, exists := sm.targetSyncers[target]; !exists {
+				return fmt.Errorf("namespace %s is routed to unknown sync target %s", namespace, target)
+			}
+		}
+		namespaceTargets[namespace] = target
+	}
+	sm.namespaceTargets = namespaceTargets
+	sm.logger.Info("Configured namespace sync targets", "routes", len(namespaceTargets))
+
+	return nil
+}
+
+// targetFor returns the sgroups target of the entity
+func (sm *syncManager) targetFor(entity interfaces.SyncableEntity) string {
+	namespaced, ok := entity.(namespacedEntity)
+	if !ok {
+		return types.SyncTargetDefault
+	}
+
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	if target, exists := sm.namespaceTargets[namespaced.GetNamespace()]; exists {
+		return target
+	}
+	return types.SyncTargetDefault
+}
+
+// syncerFor returns the syncer of the subject type registered for the target
+func (sm *syncManager) syncerFor(target string, subjectType types.SyncSubjectType) (interface{}, bool) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	if target == types.SyncTargetDefault {
+		syncer, exists := sm.syncers[subjectType]
+		return syncer, exists
+	}
+	syncer, exists := sm.targetSyncers[target][subjectType]
+	return syncer, exists
+}
+
+// RegisteredSyncers returns subject types with a registered syncer per sgroups target
+func (sm *syncManager) RegisteredSyncers() map[string][]types.SyncSubjectType {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	registered := make(map[string][]types.SyncSubjectType, len(sm.targetSyncers)+1)
+	collect := func(target string, syncers map[types.SyncSubjectType]interface{}) {
+		subjectTypes := make([]types.SyncSubjectType, 0, len(syncers))
+		for subjectType := range syncers {
+			subjectTypes = append(subjectTypes, subjectType)
+		}
+		sort.Slice(subjectTypes, func(i, j int) bool { return subjectTypes[i] < subjectTypes[j] })
+		registered[target] = subjectTypes
+	}
+
+	if len(sm.syncers) > 0 {
+		collect(types.SyncTargetDefault, sm.syncers)
+	}
+	for target, syncers := range sm.targetSyncers {
+		collect(target, syncers)
+	}
+	return registered
+}
@@ -0,0 +1,102 @@
+package manager
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// recordingSyncer records keys of synced entities
+type recordingSyncer struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (s *recordingSyncer) Sync(_ context.Context, entity interfaces.SyncableEntity, _ types.SyncOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = append(s.keys, entity.GetSyncKey())
+	return nil
+}
+
+func (s *recordingSyncer) SyncBatch(_ context.Context, entities []interfaces.SyncableEntity, _ types.SyncOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, entity := range entities {
+		s.keys = append(s.keys, entity.GetSyncKey())
+	}
+	return nil
+}
+
+func (s *recordingSyncer) GetSupportedSubjectType() types.SyncSubjectType {
+	return types.SyncSubjectTypeGroups
+}
+
+func newTestAddressGroup(namespace, name string) *models.AddressGroup {
+	return &models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(namespace)))}
+}
+
+func TestSyncManager_NamespaceTargets(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	router, ok := sm.(interfaces.SyncTargetRouter)
+	require.True(t, ok)
+
+	defaultSyncer := &recordingSyncer{}
+	secondarySyncer := &recordingSyncer{}
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeGroups, defaultSyncer))
+	require.NoError(t, router.RegisterTargetSyncer("secondary", types.SyncSubjectTypeGroups, secondarySyncer))
+
+	assert.Error(t, router.SetNamespaceTargets(map[string]string{"team-a": "unknown"}))
+	require.NoError(t, router.SetNamespaceTargets(map[string]string{
+		"team-a":  "secondary",
+		"sandbox": types.SyncTargetNone,
+	}))
+
+	ctx := context.Background()
+	err := sm.SyncBatch(ctx, []interfaces.SyncableEntity{
+		newTestAddressGroup("default", "ag-1"),
+		newTestAddressGroup("team-a", "ag-2"),
+		newTestAddressGroup("sandbox", "ag-3"),
+	}, types.SyncOperationUpsert)
+	require.NoError(t, err)
+
+	require.NoError(t, sm.SyncEntityForced(ctx, newTestAddressGroup("sandbox", "ag-4"), types.SyncOperationUpsert))
+	require.NoError(t, sm.SyncEntityForced(ctx, newTestAddressGroup("team-a", "ag-5"), types.SyncOperationUpsert))
+
+	assert.Equal(t, []string{newTestAddressGroup("default", "ag-1").GetSyncKey()}, defaultSyncer.keys)
+	assert.Equal(t, []string{
+		newTestAddressGroup("team-a", "ag-2").GetSyncKey(),
+		newTestAddressGroup("team-a", "ag-5").GetSyncKey(),
+	}, secondarySyncer.keys)
+}
+
+func TestSyncManager_RegisterTargetSyncerReservedNames(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	router := sm.(interfaces.SyncTargetRouter)
+
+	assert.Error(t, router.RegisterTargetSyncer(types.SyncTargetNone, types.SyncSubjectTypeGroups, &recordingSyncer{}))
+	assert.Error(t, router.RegisterTargetSyncer("", types.SyncSubjectTypeGroups, &recordingSyncer{}))
+	assert.NoError(t, router.RegisterTargetSyncer(types.SyncTargetDefault, types.SyncSubjectTypeGroups, &recordingSyncer{}))
+}
+
+func TestSyncManager_RegisteredSyncers(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	router := sm.(interfaces.SyncTargetRouter)
+
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeGroups, &recordingSyncer{}))
+	require.NoError(t, router.RegisterTargetSyncer("secondary", types.SyncSubjectTypeGroups, &recordingSyncer{}))
+
+	registered := sm.(interfaces.SyncerInspector).RegisteredSyncers()
+	assert.Equal(t, map[string][]types.SyncSubjectType{
+		types.SyncTargetDefault: {types.SyncSubjectTypeGroups},
+		"secondary":             {types.SyncSubjectTypeGroups},
+	}, registered)
+}
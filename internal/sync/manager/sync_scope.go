@@ -0,0 +1,110 @@
+package manager
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// labeledEntity is implemented by entities carrying Kubernetes metadata
+type labeledEntity interface {
+	GetMeta() *models.Meta
+}
+
+// syncScope is the compiled interfaces.SyncScope
+type syncScope struct {
+	included map[string]bool
+	excluded map[string]bool
+	selector labels.Selector
+	subjects map[types.SyncSubjectType]bool
+}
+
+// SetSyncScope replaces the part of the cluster synchronized with sgroups
+func (sm *syncManager) SetSyncScope(scope interfaces.SyncScope) error {
+	compiled := syncScope{}
+	if len(scope.IncludeNamespaces) > 0 {
+		compiled.included = make(map[string]bool, len(scope.IncludeNamespaces))
+		for _, namespace := range scope.IncludeNamespaces {
+			compiled.included[namespace] = true
+		}
+	}
+	if len(scope.ExcludeNamespaces) > 0 {
+		compiled.excluded = make(map[string]bool, len(scope.ExcludeNamespaces))
+		for _, namespace := range scope.ExcludeNamespaces {
+			compiled.excluded[namespace] = true
+		}
+	}
+	if scope.LabelSelector != "" {
+		selector, err := labels.Parse(scope.LabelSelector)
+		if err != nil {
+			return fmt.Errorf("invalid sync label selector %q: %w", scope.LabelSelector, err)
+		}
+		compiled.selector = selector
+	}
+	if len(scope.SubjectTypes) > 0 {
+		compiled.subjects = make(map[types.SyncSubjectType]bool, len(scope.SubjectTypes))
+		for _, subjectType := range scope.SubjectTypes {
+			compiled.subjects[subjectType] = true
+		}
+	}
+
+	sm.mu.Lock()
+	sm.scope = compiled
+	sm.mu.Unlock()
+
+	sm.logger.Info("Configured sync scope",
+		"includeNamespaces", scope.IncludeNamespaces,
+		"excludeNamespaces", scope.ExcludeNamespaces,
+		"labelSelector", scope.LabelSelector,
+		"subjectTypes", scope.SubjectTypes)
+	return nil
+}
+
+// InSyncScope returns true if upserts of the entity are synchronized
+func (sm *syncManager) InSyncScope(entity interfaces.SyncableEntity) bool {
+	return sm.inScope(entity, types.SyncOperationUpsert)
+}
+
+// NamespaceInSyncScope returns true if resources of the namespace may be synchronized
+func (sm *syncManager) NamespaceInSyncScope(namespace string) bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.scope.namespaceIncluded(namespace)
+}
+
+// inScope returns true if the operation on the entity is synchronized.
+// Deletes ignore the label selector: labels of a deleted resource may be unknown.
+func (sm *syncManager) inScope(entity interfaces.SyncableEntity, operation types.SyncOperation) bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	if sm.scope.subjects != nil && !sm.scope.subjects[entity.GetSyncSubjectType()] {
+		return false
+	}
+	if namespaced, ok := entity.(namespacedEntity); ok && !sm.scope.namespaceIncluded(namespaced.GetNamespace()) {
+		return false
+	}
+	if sm.scope.selector == nil || operation == types.SyncOperationDelete {
+		return true
+	}
+	labeled, ok := entity.(labeledEntity)
+	if !ok {
+		return true
+	}
+	var entityLabels map[string]string
+	if meta := labeled.GetMeta(); meta != nil {
+		entityLabels = meta.Labels
+	}
+	return sm.scope.selector.Matches(labels.Set(entityLabels))
+}
+
+func (s syncScope) namespaceIncluded(namespace string) bool {
+	if s.excluded[namespace] {
+		return false
+	}
+	return s.included == nil || s.included[namespace]
+}
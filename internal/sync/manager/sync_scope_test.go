@@ -0,0 +1,81 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+func newLabeledAddressGroup(namespace, name string, labels map[string]string) *models.AddressGroup {
+	ag := newTestAddressGroup(namespace, name)
+	ag.Meta.Labels = labels
+	return ag
+}
+
+func TestSyncManager_SyncScope(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	scope, ok := sm.(interfaces.SyncScopeConfigurer)
+	require.True(t, ok)
+
+	syncer := &recordingSyncer{}
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeGroups, syncer))
+
+	assert.Error(t, scope.SetSyncScope(interfaces.SyncScope{LabelSelector: "a in (b"}))
+	require.NoError(t, scope.SetSyncScope(interfaces.SyncScope{
+		IncludeNamespaces: []string{"team-a", "team-b"},
+		ExcludeNamespaces: []string{"team-b"},
+		LabelSelector:     "sgroups.io/managed=true",
+	}))
+
+	managed := map[string]string{"sgroups.io/managed": "true"}
+	assert.True(t, scope.NamespaceInSyncScope("team-a"))
+	assert.False(t, scope.NamespaceInSyncScope("team-b"), "exclusion takes precedence")
+	assert.False(t, scope.NamespaceInSyncScope("default"))
+
+	ctx := context.Background()
+	err := sm.SyncBatch(ctx, []interfaces.SyncableEntity{
+		newLabeledAddressGroup("team-a", "ag-1", managed),
+		newLabeledAddressGroup("team-a", "ag-2", nil),
+		newLabeledAddressGroup("team-b", "ag-3", managed),
+		newLabeledAddressGroup("default", "ag-4", managed),
+	}, types.SyncOperationUpsert)
+	require.NoError(t, err)
+
+	// Deletes ignore labels but still respect namespaces
+	require.NoError(t, sm.SyncEntityForced(ctx, newTestAddressGroup("team-a", "ag-5"), types.SyncOperationDelete))
+	require.NoError(t, sm.SyncEntityForced(ctx, newTestAddressGroup("default", "ag-6"), types.SyncOperationDelete))
+
+	assert.Equal(t, []string{
+		newTestAddressGroup("team-a", "ag-1").GetSyncKey(),
+		newTestAddressGroup("team-a", "ag-5").GetSyncKey(),
+	}, syncer.keys)
+
+	require.NoError(t, scope.SetSyncScope(interfaces.SyncScope{}))
+	assert.True(t, scope.InSyncScope(newTestAddressGroup("default", "ag-7")), "empty scope synchronizes everything")
+}
+
+func TestSyncManager_SyncScopeSubjectTypes(t *testing.T) {
+	sm := NewSyncManager(nil, logr.Discard())
+	scope, ok := sm.(interfaces.SyncScopeConfigurer)
+	require.True(t, ok)
+
+	syncer := &recordingSyncer{}
+	require.NoError(t, sm.RegisterSyncer(types.SyncSubjectTypeGroups, syncer))
+
+	require.NoError(t, scope.SetSyncScope(interfaces.SyncScope{SubjectTypes: []types.SyncSubjectType{types.SyncSubjectTypeNetworks}}))
+	ag := newTestAddressGroup("default", "ag-1")
+	assert.False(t, scope.InSyncScope(ag), "syncer of the subject type is disabled")
+	require.NoError(t, sm.SyncEntityForced(context.Background(), ag, types.SyncOperationDelete))
+	assert.Empty(t, syncer.keys)
+
+	require.NoError(t, scope.SetSyncScope(interfaces.SyncScope{SubjectTypes: []types.SyncSubjectType{types.SyncSubjectTypeGroups}}))
+	require.NoError(t, sm.SyncEntityForced(context.Background(), ag, types.SyncOperationUpsert))
+	assert.Equal(t, []string{ag.GetSyncKey()}, syncer.keys)
+}
@@ -0,0 +1,114 @@
+package manager
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// syncStatusTracker records last sync results per subject type and per entity
+type syncStatusTracker struct {
+	mu       sync.RWMutex
+	kinds    map[types.SyncSubjectType]*interfaces.KindSyncStatus
+	entities map[string]*interfaces.EntitySyncStatus
+}
+
+func newSyncStatusTracker() *syncStatusTracker {
+	return &syncStatusTracker{
+		kinds:    make(map[types.SyncSubjectType]*interfaces.KindSyncStatus),
+		entities: make(map[string]*interfaces.EntitySyncStatus),
+	}
+}
+
+// record stores the result of a sync of the given entities
+func (t *syncStatusTracker) record(subjectType types.SyncSubjectType, keys []string, operation types.SyncOperation, attempts int, err error) {
+	now := time.Now()
+	retries := 0
+	if attempts > 1 {
+		retries = attempts - 1
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	kind, exists := t.kinds[subjectType]
+	if !exists {
+		kind = &interfaces.KindSyncStatus{SubjectType: subjectType}
+		t.kinds[subjectType] = kind
+	}
+	kind.TotalRequests++
+	kind.Retries += int64(retries)
+	kind.LastSyncTime = now
+	if err == nil {
+		kind.SuccessfulSyncs++
+		kind.LastSuccessTime = now
+	} else {
+		kind.FailedSyncs++
+		kind.LastError = err.Error()
+		kind.LastErrorTime = now
+	}
+
+	for _, key := range keys {
+		entityKey := string(subjectType) + "/" + key
+		entity, exists := t.entities[entityKey]
+		if !exists {
+			entity = &interfaces.EntitySyncStatus{SubjectType: subjectType, Key: key}
+			t.entities[entityKey] = entity
+		}
+		entity.Operation = operation
+		entity.LastSyncTime = now
+		entity.Retries = retries
+		if err == nil {
+			entity.LastSuccessTime = now
+			entity.LastError = ""
+			entity.ConsecutiveFailures = 0
+		} else {
+			entity.LastError = err.Error()
+			entity.ConsecutiveFailures++
+		}
+	}
+}
+
+// cleanup forgets entities that weren't synced for maxAge
+func (t *syncStatusTracker) cleanup(maxAge time.Duration) {
+	cutoff := time.Now().Add(-maxAge)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, entity := range t.entities {
+		if entity.LastSyncTime.Before(cutoff) {
+			delete(t.entities, key)
+		}
+	}
+}
+
+// snapshot returns copies of all statuses sorted by subject type and key
+func (t *syncStatusTracker) snapshot() interfaces.DetailedSyncStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	status := interfaces.DetailedSyncStatus{
+		Kinds:    make([]interfaces.KindSyncStatus, 0, len(t.kinds)),
+		Entities: make([]interfaces.EntitySyncStatus, 0, len(t.entities)),
+	}
+	for _, kind := range t.kinds {
+		status.Kinds = append(status.Kinds, *kind)
+	}
+	for _, entity := range t.entities {
+		status.Entities = append(status.Entities, *entity)
+	}
+
+	sort.Slice(status.Kinds, func(i, j int) bool {
+		return status.Kinds[i].SubjectType < status.Kinds[j].SubjectType
+	})
+	sort.Slice(status.Entities, func(i, j int) bool {
+		if status.Entities[i].SubjectType != status.Entities[j].SubjectType {
+			return status.Entities[i].SubjectType < status.Entities[j].SubjectType
+		}
+		return status.Entities[i].Key < status.Entities[j].Key
+	})
+	return status
+}
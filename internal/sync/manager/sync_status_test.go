@@ -0,0 +1,72 @@
+package manager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/sync/types"
+)
+
+func TestSyncStatusTracker_Record(t *testing.T) {
+	tracker := newSyncStatusTracker()
+
+	tracker.record(types.SyncSubjectTypeGroups, []string{"ns/ag-a", "ns/ag-b"}, types.SyncOperationUpsert, 1, nil)
+	tracker.record(types.SyncSubjectTypeGroups, []string{"ns/ag-a"}, types.SyncOperationUpsert, 3, errors.New("sgroups unavailable"))
+	tracker.record(types.SyncSubjectTypeNetworks, []string{"ns/net"}, types.SyncOperationDelete, 2, nil)
+
+	status := tracker.snapshot()
+	require.Len(t, status.Kinds, 2)
+
+	groups := status.Kinds[0]
+	assert.Equal(t, types.SyncSubjectTypeGroups, groups.SubjectType)
+	assert.Equal(t, int64(2), groups.TotalRequests)
+	assert.Equal(t, int64(1), groups.SuccessfulSyncs)
+	assert.Equal(t, int64(1), groups.FailedSyncs)
+	assert.Equal(t, int64(2), groups.Retries)
+	assert.Equal(t, "sgroups unavailable", groups.LastError)
+	assert.False(t, groups.LastErrorTime.IsZero())
+
+	networks := status.Kinds[1]
+	assert.Equal(t, types.SyncSubjectTypeNetworks, networks.SubjectType)
+	assert.Equal(t, int64(1), networks.Retries)
+	assert.Empty(t, networks.LastError)
+
+	require.Len(t, status.Entities, 3)
+	failed := status.Entities[0]
+	assert.Equal(t, "ns/ag-a", failed.Key)
+	assert.Equal(t, "sgroups unavailable", failed.LastError)
+	assert.Equal(t, 2, failed.Retries)
+	assert.Equal(t, 1, failed.ConsecutiveFailures)
+	assert.False(t, failed.LastSuccessTime.IsZero())
+
+	assert.Equal(t, "ns/ag-b", status.Entities[1].Key)
+	assert.Empty(t, status.Entities[1].LastError)
+	assert.Equal(t, types.SyncOperationDelete, status.Entities[2].Operation)
+
+	// A successful sync resets the failure streak
+	tracker.record(types.SyncSubjectTypeGroups, []string{"ns/ag-a"}, types.SyncOperationUpsert, 1, nil)
+	recovered := tracker.snapshot().Entities[0]
+	assert.Empty(t, recovered.LastError)
+	assert.Equal(t, 0, recovered.ConsecutiveFailures)
+	assert.Equal(t, 0, recovered.Retries)
+}
+
+func TestSyncStatusTracker_Cleanup(t *testing.T) {
+	tracker := newSyncStatusTracker()
+	tracker.record(types.SyncSubjectTypeGroups, []string{"ns/ag"}, types.SyncOperationUpsert, 1, nil)
+
+	tracker.cleanup(time.Hour)
+	assert.Len(t, tracker.snapshot().Entities, 1)
+
+	tracker.entities["Groups/ns/ag"].LastSyncTime = time.Now().Add(-2 * time.Hour)
+	tracker.cleanup(time.Hour)
+
+	status := tracker.snapshot()
+	assert.Empty(t, status.Entities)
+	// Per-kind counters are kept
+	assert.Len(t, status.Kinds, 1)
+}
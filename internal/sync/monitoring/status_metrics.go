@@ -0,0 +1,70 @@
+package monitoring
+
+import (
+	"fmt"
+	"io"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+)
+
+// WriteStatusMetrics writes reverse sync statistics in the Prometheus text format
+func WriteStatusMetrics(w io.Writer, status interfaces.ReverseSyncStatus) error {
+	running := 0
+	if status.Running {
+		running = 1
+	}
+	paused := 0
+	if status.Paused {
+		paused = 1
+	}
+	// Zero until a change is processed without errors
+	lastSuccess := 0.0
+	if !status.LastSuccessfulSync.IsZero() {
+		lastSuccess = float64(status.LastSuccessfulSync.Unix())
+	}
+
+	system := []struct {
+		name, help, kind string
+		value            float64
+	}{
+		{"netguard_reverse_sync_running", "Whether the reverse sync system is running", "gauge", float64(running)},
+		{"netguard_reverse_sync_paused", "Whether the reverse sync is paused by an operator", "gauge", float64(paused)},
+		{"netguard_reverse_sync_events_total", "SGROUP change events received", "counter", float64(status.TotalEvents)},
+		{"netguard_reverse_sync_events_processed_total", "SGROUP change events processed without errors", "counter", float64(status.ProcessedEvents)},
+		{"netguard_reverse_sync_events_failed_total", "SGROUP change events that failed to process", "counter", float64(status.FailedEvents)},
+		{"netguard_reverse_sync_events_skipped_total", "SGROUP change events skipped while the reverse sync was paused", "counter", float64(status.SkippedEvents)},
+		{"netguard_reverse_sync_last_success_timestamp_seconds", "Unix time of the last change processed without errors", "gauge", lastSuccess},
+		{"netguard_reverse_sync_lag_seconds", "Delay between the last processed SGROUP change and the end of its processing", "gauge", status.Lag.Seconds()},
+		{"netguard_reverse_sync_pending_conflicts", "Host conflicts waiting for manual review", "gauge", float64(status.PendingConflicts)},
+	}
+	for _, metric := range system {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %g\n", metric.name, metric.help, metric.name, metric.kind, metric.name, metric.value); err != nil {
+			return err
+		}
+	}
+
+	entities := []struct {
+		name, help string
+		value      func(interfaces.ReverseSyncEntityStatus) float64
+	}{
+		{"netguard_reverse_sync_objects_processed_total", "Objects written to NETGUARD by the reverse sync",
+			func(e interfaces.ReverseSyncEntityStatus) float64 { return float64(e.ObjectsProcessed) }},
+		{"netguard_reverse_sync_objects_failed_total", "Objects that failed to synchronize from SGROUP",
+			func(e interfaces.ReverseSyncEntityStatus) float64 { return float64(e.ObjectsFailed) }},
+		{"netguard_reverse_sync_conflicts_total", "Objects changed on both sides or kept by the conflict policy",
+			func(e interfaces.ReverseSyncEntityStatus) float64 { return float64(e.Conflicts) }},
+		{"netguard_reverse_sync_entity_failures_total", "Failed processings of SGROUP changes by entity processors",
+			func(e interfaces.ReverseSyncEntityStatus) float64 { return float64(e.FailedSyncs) }},
+	}
+	for _, metric := range entities {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", metric.name, metric.help, metric.name); err != nil {
+			return err
+		}
+		for _, entity := range status.Entities {
+			if _, err := fmt.Fprintf(w, "%s{entity=%q} %g\n", metric.name, entity.EntityType, metric.value(entity)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+// Package outbox delivers sgroups sync operations stored in the transactional outbox.
+//
+// Resource services enqueue sync operations in the same transaction as the
+// resource change (see ports.SyncOutboxWriter). The dispatcher claims due
+// entries, delivers them through the sync manager and retries failed
+// deliveries with exponential backoff, so no sync is lost after a crash
+// between the commit and the sgroups call. Entries that keep failing are
+// moved to the dead-letter queue (see ports.SyncDeadLetterQueue) after
+// MaxAttempts deliveries.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/sync/interfaces"
+)
+
+// Config holds dispatcher configuration
+type Config struct {
+	// PollInterval is the interval between outbox scans when not notified
+	PollInterval time.Duration
+	// BatchSize is the maximum number of entries claimed at once
+	BatchSize int
+	// Lease is the time claimed entries are hidden from other dispatchers
+	Lease time.Duration
+	// InitialBackoff is the delay before the first redelivery
+	InitialBackoff time.Duration
+	// MaxBackoff limits the delay between redeliveries
+	MaxBackoff time.Duration
+	// MaxAttempts is the number of failed deliveries after which an entry is
+	// dead-lettered, 0 retries forever
+	MaxAttempts int
+}
+
+// DefaultConfig returns default dispatcher configuration
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:   5 * time.Second,
+		BatchSize:      100,
+		Lease:          time.Minute,
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Minute,
+		MaxAttempts:    20,
+	}
+}
+
+// Reporter is notified about delivery results, e.g. to surface them in resource conditions
+type Reporter interface {
+	// ReportDeadLettered is called after an entry was moved to the dead-letter queue
+	ReportDeadLettered(ctx context.Context, entry models.SyncOutboxEntry)
+	// ReportDelivered is called after entries were delivered to sgroups
+	ReportDelivered(ctx context.Context, entries []models.SyncOutboxEntry)
+}
+
+// Dispatcher delivers outbox entries to sgroups
+type Dispatcher struct {
+	outbox      ports.SyncOutbox
+	syncManager interfaces.SyncManager
+	config      Config
+	logger      logr.Logger
+	notify      chan struct{}
+	reporter    Reporter
+}
+
+var _ interfaces.SyncOutboxNotifier = &Dispatcher{}
+
+// NewDispatcher creates a new outbox dispatcher
+func NewDispatcher(outbox ports.SyncOutbox, syncManager interfaces.SyncManager, config Config, logger logr.Logger) *Dispatcher {
+	return &Dispatcher{
+		outbox:      outbox,
+		syncManager: syncManager,
+		config:      config,
+		logger:      logger.WithName("outbox"),
+		notify:      make(chan struct{}, 1),
+	}
+}
+
+// SetReporter sets the receiver of delivery results
+func (d *Dispatcher) SetReporter(reporter Reporter) {
+	d.reporter = reporter
+}
+
+// Notify wakes the dispatcher up after new entries were committed
+func (d *Dispatcher) Notify() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run dispatches entries until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Drain the outbox: a full batch means more entries may be due
+		for {
+			n, err := d.DispatchOnce(ctx)
+			if err != nil {
+				d.logger.Error(err, "Failed to dispatch sync outbox")
+				break
+			}
+			if n < d.config.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.notify:
+		}
+	}
+}
+
+// DispatchOnce claims due entries and delivers them, returning the number of claimed entries
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	entries, err := d.outbox.Claim(ctx, d.config.BatchSize, d.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	// Consecutive entries with the same operation are delivered in one batch,
+	// keeping the enqueue order between upserts and deletes
+	for start := 0; start < len(entries); {
+		end := start + 1
+		for end < len(entries) && entries[end].Operation == entries[start].Operation {
+			end++
+		}
+		d.deliver(ctx, entries[start:end])
+		start = end
+	}
+	return len(entries), nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, entries []models.SyncOutboxEntry) {
+	operation := entries[0].Operation
+
+	var (
+		entities []interfaces.SyncableEntity
+		ids      []int64
+	)
+	for _, entry := range entries {
+		entity, err := syncableEntity(entry)
+		if err != nil {
+			// Such an entry can never be delivered, drop it instead of retrying forever
+			d.logger.Error(err, "Dropping invalid sync outbox entry", "id", entry.ID, "kind", entry.Kind)
+			ids = append(ids, entry.ID)
+			continue
+		}
+		entities = append(entities, entity)
+		ids = append(ids, entry.ID)
+	}
+
+	if len(entities) > 0 {
+		if err := d.syncManager.SyncBatch(ctx, entities, operation); err != nil {
+			d.logger.Error(err, "Failed to deliver sync outbox entries", "operation", operation, "count", len(entities))
+			for _, entry := range entries {
+				d.fail(ctx, entry, err)
+			}
+			return
+		}
+	}
+
+	if err := d.outbox.Complete(ctx, ids...); err != nil {
+		// Entries will be redelivered after the lease expires, sgroups sync is idempotent
+		d.logger.Error(err, "Failed to complete sync outbox entries", "count", len(ids))
+		return
+	}
+	d.logger.V(1).Info("Delivered sync outbox entries", "operation", operation, "count", len(entities))
+	if d.reporter != nil {
+		d.reporter.ReportDelivered(ctx, entries)
+	}
+}
+
+// fail reschedules a failed entry or dead-letters it once MaxAttempts is reached
+func (d *Dispatcher) fail(ctx context.Context, entry models.SyncOutboxEntry, cause error) {
+	deadLetters, ok := d.outbox.(ports.SyncDeadLetterQueue)
+	if !ok || d.config.MaxAttempts <= 0 || entry.Attempts+1 < d.config.MaxAttempts {
+		next := time.Now().Add(d.backoff(entry.Attempts))
+		if err := d.outbox.Retry(ctx, entry.ID, cause, next); err != nil {
+			d.logger.Error(err, "Failed to reschedule sync outbox entry", "id", entry.ID)
+		}
+		return
+	}
+
+	if err := deadLetters.DeadLetter(ctx, entry.ID, cause); err != nil {
+		// The entry stays in the outbox and is claimed again after the lease expires
+		d.logger.Error(err, "Failed to dead-letter sync outbox entry", "id", entry.ID)
+		return
+	}
+	d.logger.Error(cause, "Sync outbox entry moved to dead-letter queue", "id", entry.ID, "kind", entry.Kind, "attempts", entry.Attempts+1)
+
+	if d.reporter != nil {
+		entry.Attempts++
+		entry.LastError = cause.Error()
+		entry.FailedAt = time.Now()
+		d.reporter.ReportDeadLettered(ctx, entry)
+	}
+}
+
+// backoff returns the delay before the next attempt after the given number of failed attempts
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.config.InitialBackoff
+	for i := 0; i < attempts && delay < d.config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.config.MaxBackoff {
+		delay = d.config.MaxBackoff
+	}
+	return delay
+}
+
+func syncableEntity(entry models.SyncOutboxEntry) (interfaces.SyncableEntity, error) {
+	switch resource := entry.Resource.(type) {
+	case models.AddressGroup:
+		return &resource, nil
+	default:
+		return nil, fmt.Errorf("unsupported sync outbox resource %T", entry.Resource)
+	}
+}
@@ -0,0 +1,190 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/domain/ports"
+	"netguard-pg-backend/internal/infrastructure/repositories/mem"
+	"netguard-pg-backend/internal/sync/interfaces"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// fakeSyncManager records batches and fails while err is set
+type fakeSyncManager struct {
+	mu      sync.Mutex
+	err     error
+	batches [][]string
+	ops     []types.SyncOperation
+}
+
+func (m *fakeSyncManager) RegisterSyncer(types.SyncSubjectType, interface{}) error { return nil }
+func (m *fakeSyncManager) SyncEntity(context.Context, interfaces.SyncableEntity, types.SyncOperation) error {
+	return nil
+}
+func (m *fakeSyncManager) SyncEntityForced(context.Context, interfaces.SyncableEntity, types.SyncOperation) error {
+	return nil
+}
+func (m *fakeSyncManager) Start(context.Context) error { return nil }
+func (m *fakeSyncManager) Stop() error                 { return nil }
+
+func (m *fakeSyncManager) SyncBatch(_ context.Context, entities []interfaces.SyncableEntity, operation types.SyncOperation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	var keys []string
+	for _, entity := range entities {
+		keys = append(keys, entity.GetSyncKey())
+	}
+	m.batches = append(m.batches, keys)
+	m.ops = append(m.ops, operation)
+	return nil
+}
+
+func enqueue(t *testing.T, registry *mem.Registry, operation types.SyncOperation, names ...string) {
+	writer, err := registry.Writer(context.Background())
+	require.NoError(t, err)
+
+	var entries []models.SyncOutboxEntry
+	for _, name := range names {
+		ag := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace("default")))}
+		entries = append(entries, models.NewAddressGroupSyncOutboxEntry(ag, operation))
+	}
+	require.NoError(t, writer.(ports.SyncOutboxWriter).EnqueueSyncOutbox(context.Background(), entries))
+	require.NoError(t, writer.Commit())
+}
+
+func TestDispatcher_DeliversInOrder(t *testing.T) {
+	registry := mem.NewRegistry()
+	syncManager := &fakeSyncManager{}
+	dispatcher := NewDispatcher(registry.SyncOutbox(), syncManager, DefaultConfig(), logr.Discard())
+
+	enqueue(t, registry, types.SyncOperationUpsert, "ag-a", "ag-b")
+	enqueue(t, registry, types.SyncOperationDelete, "ag-a")
+
+	n, err := dispatcher.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	require.Len(t, syncManager.batches, 2)
+	assert.Equal(t, []types.SyncOperation{types.SyncOperationUpsert, types.SyncOperationDelete}, syncManager.ops)
+	assert.Len(t, syncManager.batches[0], 2)
+	assert.Len(t, syncManager.batches[1], 1)
+	assert.Equal(t, 0, registry.SyncOutbox().Len())
+}
+
+func TestDispatcher_RetriesFailedDelivery(t *testing.T) {
+	registry := mem.NewRegistry()
+	syncManager := &fakeSyncManager{err: errors.New("sgroups unavailable")}
+	config := DefaultConfig()
+	config.InitialBackoff = 10 * time.Millisecond
+	dispatcher := NewDispatcher(registry.SyncOutbox(), syncManager, config, logr.Discard())
+
+	enqueue(t, registry, types.SyncOperationUpsert, "ag")
+
+	_, err := dispatcher.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.SyncOutbox().Len())
+
+	// The entry is not due until the backoff expires
+	n, err := dispatcher.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	syncManager.err = nil
+	time.Sleep(20 * time.Millisecond)
+
+	n, err = dispatcher.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 0, registry.SyncOutbox().Len())
+	require.Len(t, syncManager.batches, 1)
+}
+
+// recordingReporter records reported delivery results
+type recordingReporter struct {
+	deadLettered []models.SyncOutboxEntry
+	delivered    []models.SyncOutboxEntry
+}
+
+func (r *recordingReporter) ReportDeadLettered(_ context.Context, entry models.SyncOutboxEntry) {
+	r.deadLettered = append(r.deadLettered, entry)
+}
+
+func (r *recordingReporter) ReportDelivered(_ context.Context, entries []models.SyncOutboxEntry) {
+	r.delivered = append(r.delivered, entries...)
+}
+
+func TestDispatcher_DeadLettersAfterMaxAttempts(t *testing.T) {
+	registry := mem.NewRegistry()
+	syncManager := &fakeSyncManager{err: errors.New("sgroups rejected")}
+	config := DefaultConfig()
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+	config.MaxAttempts = 2
+	dispatcher := NewDispatcher(registry.SyncOutbox(), syncManager, config, logr.Discard())
+	reporter := &recordingReporter{}
+	dispatcher.SetReporter(reporter)
+
+	enqueue(t, registry, types.SyncOperationUpsert, "ag")
+
+	_, err := dispatcher.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.SyncOutbox().Len())
+	assert.Empty(t, reporter.deadLettered)
+
+	time.Sleep(5 * time.Millisecond)
+	_, err = dispatcher.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, registry.SyncOutbox().Len())
+
+	require.Len(t, reporter.deadLettered, 1)
+	assert.Equal(t, 2, reporter.deadLettered[0].Attempts)
+
+	deadLetters, err := registry.SyncOutbox().ListDeadLetters(context.Background())
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, "sgroups rejected", deadLetters[0].LastError)
+	assert.False(t, deadLetters[0].FailedAt.IsZero())
+
+	// Dead letters are not claimed again
+	n, err := dispatcher.DispatchOnce(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	require.NoError(t, registry.SyncOutbox().RemoveDeadLetter(context.Background(), deadLetters[0].ID))
+	_, err = registry.SyncOutbox().GetDeadLetter(context.Background(), deadLetters[0].ID)
+	assert.ErrorIs(t, err, ports.ErrNotFound)
+}
+
+func TestDispatcher_AbortedWriterDoesNotEnqueue(t *testing.T) {
+	registry := mem.NewRegistry()
+
+	writer, err := registry.Writer(context.Background())
+	require.NoError(t, err)
+	ag := models.AddressGroup{SelfRef: models.NewSelfRef(models.NewResourceIdentifier("ag"))}
+	require.NoError(t, writer.(ports.SyncOutboxWriter).EnqueueSyncOutbox(context.Background(), []models.SyncOutboxEntry{
+		models.NewAddressGroupSyncOutboxEntry(ag, types.SyncOperationUpsert),
+	}))
+	writer.Abort()
+
+	assert.Equal(t, 0, registry.SyncOutbox().Len())
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	dispatcher := NewDispatcher(nil, nil, Config{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}, logr.Discard())
+
+	assert.Equal(t, time.Second, dispatcher.backoff(0))
+	assert.Equal(t, 4*time.Second, dispatcher.backoff(2))
+	assert.Equal(t, 5*time.Second, dispatcher.backoff(10))
+}
@@ -55,6 +55,12 @@ func (p *hostProcessor) GetEntityType() string {
 
 // ProcessChanges processes change events for hosts
 func (p *hostProcessor) ProcessChanges(ctx context.Context, event detector.ChangeEvent) error {
+	_, err := p.ProcessChangesWithResult(ctx, event)
+	return err
+}
+
+// ProcessChangesWithResult processes change events for hosts and reports synchronized hosts
+func (p *hostProcessor) ProcessChangesWithResult(ctx context.Context, event detector.ChangeEvent) (types.ProcessingResult, error) {
 
 	var result *types.HostSyncResult
 	var err error
@@ -68,13 +74,17 @@ func (p *hostProcessor) ProcessChanges(ctx context.Context, event detector.Chang
 	}
 
 	if err != nil {
-		return p.handleSyncError(err, event)
+		return types.ProcessingResult{}, p.handleSyncError(err, event)
 	}
 
 	// Log sync results
 	p.logSyncResults(result, event)
 
-	return nil
+	return types.ProcessingResult{
+		Processed: result.TotalSynced,
+		Failed:    result.TotalFailed,
+		Conflicts: len(result.ConflictUUIDs),
+	}, nil
 }
 
 // performFullSync performs full synchronization of all hosts
@@ -122,6 +132,11 @@ func (p *hostProcessor) mergeResults(aggregate, individual *types.HostSyncResult
 		aggregate.AddFailedHost(uuid, errorMsg)
 	}
 
+	// Add conflicting hosts
+	for _, uuid := range individual.ConflictUUIDs {
+		aggregate.AddConflict(uuid)
+	}
+
 	// Update totals
 	aggregate.TotalRequested += individual.TotalRequested
 }
@@ -0,0 +1,70 @@
+package processors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	"netguard-pg-backend/internal/logging"
+	"netguard-pg-backend/internal/sync/detector"
+	"netguard-pg-backend/internal/sync/synchronizer"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// resourceProcessor implements EntityProcessor for the reverse synchronization of
+// Networks and AddressGroups: every SGROUP change compares all objects of its type
+type resourceProcessor struct {
+	entityType string
+	sync       func(ctx context.Context) (*types.ResourceSyncResult, error)
+	logger     logr.Logger
+}
+
+// NewNetworkProcessor creates a new network processor
+func NewNetworkProcessor(synchronizer synchronizer.NetworkSynchronizer) EntityProcessor {
+	return &resourceProcessor{entityType: "network", sync: synchronizer.SyncAllNetworks, logger: logging.For(logging.SubsystemSync)}
+}
+
+// NewAddressGroupProcessor creates a new address group processor
+func NewAddressGroupProcessor(synchronizer synchronizer.AddressGroupSynchronizer) EntityProcessor {
+	return &resourceProcessor{entityType: "address_group", sync: synchronizer.SyncAllAddressGroups, logger: logging.For(logging.SubsystemSync)}
+}
+
+// GetEntityType returns the entity type this processor handles
+func (p *resourceProcessor) GetEntityType() string {
+	return p.entityType
+}
+
+// ProcessChanges processes change events for the entity type
+func (p *resourceProcessor) ProcessChanges(ctx context.Context, event detector.ChangeEvent) error {
+	_, err := p.ProcessChangesWithResult(ctx, event)
+	return err
+}
+
+// ProcessChangesWithResult processes change events and reports imported, updated and conflicting resources
+func (p *resourceProcessor) ProcessChangesWithResult(ctx context.Context, event detector.ChangeEvent) (types.ProcessingResult, error) {
+	result, err := p.sync(ctx)
+	if err != nil {
+		return types.ProcessingResult{Failed: len(result.Errors)}, fmt.Errorf("%s sync failed for event from %s: %w", p.entityType, event.Source, err)
+	}
+	p.logSyncResults(result)
+	return types.ProcessingResult{
+		Processed: len(result.Imported) + len(result.Updated),
+		Failed:    len(result.Errors),
+		Conflicts: len(result.Conflicts),
+	}, nil
+}
+
+// logSyncResults logs imported, updated and conflicting resources
+func (p *resourceProcessor) logSyncResults(result *types.ResourceSyncResult) {
+	if len(result.Imported) > 0 {
+		p.logger.Info("Reverse sync imported resources from sgroups", "entityType", p.entityType, "count", len(result.Imported), "resources", result.Imported)
+	}
+	if len(result.Updated) > 0 {
+		p.logger.Info("Reverse sync updated resources from sgroups", "entityType", p.entityType, "count", len(result.Updated), "resources", result.Updated)
+	}
+	if len(result.Conflicts) > 0 {
+		p.logger.Info("Reverse sync kept resources owned by netguard that differ from sgroups",
+			"entityType", p.entityType, "count", len(result.Conflicts), "resources", result.Conflicts)
+	}
+}
@@ -2,11 +2,13 @@ package syncers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
 	"github.com/go-logr/logr"
 
+	"netguard-pg-backend/internal/domain/models"
 	"netguard-pg-backend/internal/sync/interfaces"
 	"netguard-pg-backend/internal/sync/types"
 )
@@ -36,38 +38,8 @@ func (s *IEAgAgRuleSyncer) Sync(ctx context.Context, entity interfaces.SyncableE
 		return fmt.Errorf("invalid entity type for IEAgAgRuleSyncer: %s", entity.GetSyncSubjectType())
 	}
 
-	// Convert entity to single protobuf rule
-	protoData, err := entity.ToSGroupsProto()
-	if err != nil {
-		return fmt.Errorf("failed to convert entity to sgroups proto: %w", err)
-	}
-
-	// Cast to *pb.IESgSgRule and wrap in SyncIESgSgRules for single entity
-	protoRule, ok := protoData.(*pb.IESgSgRule)
-	if !ok {
-		return fmt.Errorf("invalid proto data type for entity %s, expected *pb.IESgSgRule, got %T", entity.GetSyncKey(), protoData)
-	}
-
-	// Create single-entity batch structure for backward compatibility
-	singleEntityBatch := &pb.SyncIESgSgRules{
-		Rules: []*pb.IESgSgRule{protoRule},
-	}
-
-	// Create sync request
-	syncReq := &types.SyncRequest{
-		Operation:   operation,
-		SubjectType: types.SyncSubjectTypeIEAgAgRules,
-		Data:        singleEntityBatch, // Send single-entity batch structure
-	}
-
-	// Send sync request to sgroups
-	if err := s.gateway.Sync(ctx, syncReq); err != nil {
-		return fmt.Errorf("failed to sync entity with sgroups: %w", err)
-	}
-
-	s.logger.V(1).Info("Successfully synced IEAgAgRule", "key", entity.GetSyncKey(), "operation", operation)
-
-	return nil
+	// Single-entity batch keeps the sgroups request format of SyncBatch
+	return s.SyncBatch(ctx, []interfaces.SyncableEntity{entity}, operation)
 }
 
 // SyncBatch synchronizes multiple IEAgAgRule entities in a batch
@@ -76,8 +48,9 @@ func (s *IEAgAgRuleSyncer) SyncBatch(ctx context.Context, entities []interfaces.
 		return nil
 	}
 
-	// Validate all entities and convert to protobuf rules
+	// Validate all entities and convert to protobuf rules, ICMP rules are a separate sgroups subject
 	var protoRules []*pb.IESgSgRule
+	var icmpRules []*pb.IESgSgIcmpRule
 	entityKeys := make([]string, 0, len(entities))
 
 	for _, entity := range entities {
@@ -92,40 +65,54 @@ func (s *IEAgAgRuleSyncer) SyncBatch(ctx context.Context, entities []interfaces.
 
 		// Convert entity to single protobuf rule
 		protoData, err := entity.ToSGroupsProto()
+		if errors.Is(err, models.ErrTransportNotSupported) {
+			// Rules sgroups can't enforce are kept in netguard only
+			s.logger.Info("Skipping IEAgAgRule not supported by sgroups", "key", entity.GetSyncKey(), "reason", err.Error())
+			continue
+		}
 		if err != nil {
 			return fmt.Errorf("failed to convert entity %s to sgroups proto: %w", entity.GetSyncKey(), err)
 		}
 
-		// Cast to *pb.IESgSgRule
-		if protoRule, ok := protoData.(*pb.IESgSgRule); ok {
+		switch protoRule := protoData.(type) {
+		case *pb.IESgSgRule:
 			protoRules = append(protoRules, protoRule)
-			entityKeys = append(entityKeys, entity.GetSyncKey())
-		} else {
-			return fmt.Errorf("invalid proto data type for entity %s, expected *pb.IESgSgRule, got %T", entity.GetSyncKey(), protoData)
+		case *pb.IESgSgIcmpRule:
+			icmpRules = append(icmpRules, protoRule)
+		default:
+			return fmt.Errorf("invalid proto data type for entity %s, expected *pb.IESgSgRule or *pb.IESgSgIcmpRule, got %T", entity.GetSyncKey(), protoData)
 		}
+		entityKeys = append(entityKeys, entity.GetSyncKey())
 	}
 
-	if len(protoRules) == 0 {
-		return nil
-	}
+	if len(protoRules) > 0 {
+		syncReq := &types.SyncRequest{
+			Operation:   operation,
+			SubjectType: types.SyncSubjectTypeIEAgAgRules,
+			Data:        &pb.SyncIESgSgRules{Rules: protoRules}, // Send aggregated structure
+		}
 
-	// Create aggregated batch sync request
-	batchProtoData := &pb.SyncIESgSgRules{
-		Rules: protoRules,
+		// Send batch sync request to sgroups
+		if err := s.gateway.Sync(ctx, syncReq); err != nil {
+			return fmt.Errorf("failed to sync IEAgAgRule batch with sgroups: %w", err)
+		}
 	}
 
-	syncReq := &types.SyncRequest{
-		Operation:   operation,
-		SubjectType: types.SyncSubjectTypeIEAgAgRules,
-		Data:        batchProtoData, // Send aggregated structure
-	}
+	if len(icmpRules) > 0 {
+		syncReq := &types.SyncRequest{
+			Operation:   operation,
+			SubjectType: types.SyncSubjectTypeIEAgAgRules,
+			Data:        &pb.SyncIESgSgIcmpRules{Rules: icmpRules},
+		}
 
-	// Send batch sync request to sgroups
-	if err := s.gateway.Sync(ctx, syncReq); err != nil {
-		return fmt.Errorf("failed to sync IEAgAgRule batch with sgroups: %w", err)
+		if err := s.gateway.Sync(ctx, syncReq); err != nil {
+			return fmt.Errorf("failed to sync ICMP IEAgAgRule batch with sgroups: %w", err)
+		}
 	}
 
-	s.logger.V(1).Info("Successfully synced batch of IEAgAgRules", "count", len(entities), "keys", entityKeys, "operation", operation)
+	if len(entityKeys) > 0 {
+		s.logger.V(1).Info("Successfully synced batch of IEAgAgRules", "count", len(entityKeys), "keys", entityKeys, "operation", operation)
+	}
 
 	return nil
 }
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
@@ -12,12 +15,30 @@ import (
 	"netguard-pg-backend/internal/sync/types"
 )
 
-// hostSynchronizer implements HostSynchronizer interface
+// hostSynchronizer implements HostSynchronizer and HostConflictReviewer interfaces
 type hostSynchronizer struct {
 	hostReader   HostReader
 	hostWriter   HostWriter
 	sgroupReader SGROUPHostReader
 	config       HostSyncConfig
+	// quarantine keeps hosts with invalid SGROUP IP addresses (nil - they are dropped)
+	quarantine HostQuarantine
+
+	// conflicts are queued for manual review by host UUID, dismissed holds the
+	// SGROUP IPSets rejected by a review so they are not queued again
+	mu        sync.Mutex
+	conflicts map[string]HostConflict
+	dismissed map[string]string
+}
+
+// HostSynchronizerOption configures optional host synchronizer dependencies
+type HostSynchronizerOption func(*hostSynchronizer)
+
+// WithHostQuarantine places hosts with IP addresses rejected by validation into the quarantine
+func WithHostQuarantine(quarantine HostQuarantine) HostSynchronizerOption {
+	return func(s *hostSynchronizer) {
+		s.quarantine = quarantine
+	}
 }
 
 // NewHostSynchronizer creates a new host synchronizer
@@ -26,13 +47,20 @@ func NewHostSynchronizer(
 	hostWriter HostWriter,
 	sgroupReader SGROUPHostReader,
 	config HostSyncConfig,
+	opts ...HostSynchronizerOption,
 ) HostSynchronizer {
-	return &hostSynchronizer{
+	s := &hostSynchronizer{
 		hostReader:   hostReader,
 		hostWriter:   hostWriter,
 		sgroupReader: sgroupReader,
 		config:       config,
+		conflicts:    make(map[string]HostConflict),
+		dismissed:    make(map[string]string),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // SyncHosts synchronizes hosts for a specific namespace
@@ -253,12 +281,25 @@ func (s *hostSynchronizer) syncHostBatch(ctx context.Context, uuids []string, ho
 			// Validate IP addresses if enabled
 			if s.config.EnableIPSetValidation {
 				validIPs := make([]string, 0, len(ipSet))
+				var reasons []string
 				for _, ip := range ipSet {
 					if s.isValidIP(ip) {
 						validIPs = append(validIPs, ip)
 					} else {
+						reasons = append(reasons, fmt.Sprintf("invalid IP address %q", ip))
 					}
 				}
+				if len(reasons) > 0 {
+					// The valid addresses are still applied, the host is kept with all of them for review
+					s.quarantineHost(ctx, result, types.HostIPSetUpdate{
+						HostUUID:  uuid,
+						HostID:    netguardHost.GetID(),
+						Namespace: netguardHost.Namespace,
+						Name:      netguardHost.Name,
+						IPSet:     ipSet,
+						SGName:    sgroupHost.SgName,
+					}, reasons)
+				}
 				ipSet = validIPs
 			}
 		}
@@ -277,6 +318,16 @@ func (s *hostSynchronizer) syncHostBatch(ctx context.Context, uuids []string, ho
 		}
 	}
 
+	// Hosts whose IPSet was set in NETGUARD meanwhile are resolved by the conflict policy
+	resolved, err := s.resolveConflicts(ctx, updates, result)
+	if err != nil {
+		for _, update := range updates {
+			result.AddFailedHost(update.HostUUID, fmt.Sprintf("conflict check failed: %v", err))
+		}
+		return result, err
+	}
+	updates = resolved
+
 	// Apply updates
 	if len(updates) > 0 {
 
@@ -298,6 +349,18 @@ func (s *hostSynchronizer) syncHostBatch(ctx context.Context, uuids []string, ho
 	return result, nil
 }
 
+// quarantineHost places a host with SGROUP data rejected by validation into the quarantine
+func (s *hostSynchronizer) quarantineHost(ctx context.Context, result *types.HostSyncResult, update types.HostIPSetUpdate, reasons []string) {
+	if s.quarantine == nil {
+		return
+	}
+	if err := s.quarantine.QuarantineHost(ctx, update, reasons); err != nil {
+		result.SetDetail("quarantine_error", err.Error())
+		return
+	}
+	result.AddQuarantinedHost(update.HostUUID)
+}
+
 // createBatches creates batches of UUIDs for processing
 func (s *hostSynchronizer) createBatches(uuids []string, batchSize int) [][]string {
 	if batchSize <= 0 {
@@ -328,9 +391,160 @@ func (s *hostSynchronizer) mergeBatchResult(mainResult, batchResult *types.HostS
 		errorMsg := batchResult.GetError(uuid)
 		mainResult.AddFailedHost(uuid, errorMsg)
 	}
+
+	// Add conflicting hosts
+	for _, uuid := range batchResult.ConflictUUIDs {
+		mainResult.AddConflict(uuid)
+	}
+
+	// Add quarantined hosts
+	for _, uuid := range batchResult.QuarantinedUUIDs {
+		mainResult.AddQuarantinedHost(uuid)
+	}
+	if quarantineErr := batchResult.GetDetail("quarantine_error"); quarantineErr != nil {
+		mainResult.SetDetail("quarantine_error", quarantineErr)
+	}
 }
 
 // isValidIP validates if the given string is a valid IP address
 func (s *hostSynchronizer) isValidIP(ip string) bool {
 	return net.ParseIP(ip) != nil
 }
+
+// resolveConflicts re-reads the hosts right before they are written and applies the conflict
+// policy to hosts whose NETGUARD IPSet is set and differs from SGROUP. Returns updates to write.
+// SGROUP IPSets overwrite NETGUARD ones by the default policy, hosts are not re-read then.
+func (s *hostSynchronizer) resolveConflicts(ctx context.Context, updates []types.HostIPSetUpdate, result *types.HostSyncResult) ([]types.HostIPSetUpdate, error) {
+	if len(updates) == 0 || s.config.ConflictPolicy == "" || s.config.ConflictPolicy == HostConflictSGroupWins {
+		return updates, nil
+	}
+
+	identifiers := make([]HostIdentifier, len(updates))
+	for i, update := range updates {
+		identifiers[i] = HostIdentifier{Namespace: update.Namespace, Name: update.Name}
+	}
+	hosts, err := s.hostReader.ListHosts(ctx, identifiers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-read hosts: %w", err)
+	}
+	current := make(map[string]models.Host, len(hosts))
+	for _, host := range hosts {
+		current[host.UUID] = host
+	}
+
+	resolved := make([]types.HostIPSetUpdate, 0, len(updates))
+	for _, update := range updates {
+		host, exists := current[update.HostUUID]
+		netguardIPs := host.GetIpList()
+		if !exists || len(netguardIPs) == 0 || ipSetKey(netguardIPs) == ipSetKey(update.IPSet) {
+			s.forgetConflict(update.HostUUID)
+			resolved = append(resolved, update)
+			continue
+		}
+
+		result.AddConflict(update.HostUUID)
+		switch s.config.ConflictPolicy {
+		case HostConflictNetguardWins:
+			continue
+		case HostConflictMergeByField:
+			update.IPSet = mergeIPSets(netguardIPs, update.IPSet)
+			resolved = append(resolved, update)
+		case HostConflictManualReview:
+			s.queueConflict(HostConflict{
+				HostUUID:    update.HostUUID,
+				HostID:      update.HostID,
+				NetguardIPs: netguardIPs,
+				SGroupIPs:   update.IPSet,
+				SGName:      update.SGName,
+				DetectedAt:  time.Now(),
+			})
+		}
+	}
+	return resolved, nil
+}
+
+// queueConflict queues or refreshes a conflict, unless its SGROUP IPSet was dismissed by a review
+func (s *hostSynchronizer) queueConflict(conflict HostConflict) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dismissed[conflict.HostUUID] == ipSetKey(conflict.SGroupIPs) {
+		return
+	}
+	if queued, exists := s.conflicts[conflict.HostUUID]; exists {
+		conflict.DetectedAt = queued.DetectedAt
+	}
+	s.conflicts[conflict.HostUUID] = conflict
+}
+
+// forgetConflict removes the host from the queue once NETGUARD and SGROUP agree
+func (s *hostSynchronizer) forgetConflict(hostUUID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.conflicts, hostUUID)
+	delete(s.dismissed, hostUUID)
+}
+
+// PendingConflicts returns the queued conflicts ordered by detection time
+func (s *hostSynchronizer) PendingConflicts() []HostConflict {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conflicts := make([]HostConflict, 0, len(s.conflicts))
+	for _, conflict := range s.conflicts {
+		conflicts = append(conflicts, conflict)
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		if !conflicts[i].DetectedAt.Equal(conflicts[j].DetectedAt) {
+			return conflicts[i].DetectedAt.Before(conflicts[j].DetectedAt)
+		}
+		return conflicts[i].HostUUID < conflicts[j].HostUUID
+	})
+	return conflicts
+}
+
+// ResolveConflict applies the SGROUP IPSet of the host if useSGroup is true,
+// otherwise keeps the NETGUARD one, and removes the host from the queue
+func (s *hostSynchronizer) ResolveConflict(ctx context.Context, hostUUID string, useSGroup bool) error {
+	s.mu.Lock()
+	conflict, exists := s.conflicts[hostUUID]
+	s.mu.Unlock()
+	if !exists {
+		return fmt.Errorf("no pending conflict for host %s", hostUUID)
+	}
+
+	if useSGroup {
+		if err := s.hostWriter.UpdateHostIPSet(ctx, conflict.HostID, conflict.SGroupIPs); err != nil {
+			return fmt.Errorf("failed to apply SGROUP IPSet to host %s: %w", conflict.HostID, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.conflicts, hostUUID)
+	if !useSGroup {
+		s.dismissed[hostUUID] = ipSetKey(conflict.SGroupIPs)
+	}
+	return nil
+}
+
+// ipSetKey returns an order independent representation of an IPSet
+func ipSetKey(ips []string) string {
+	sorted := append([]string(nil), ips...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// mergeIPSets returns the NETGUARD IPs followed by the SGROUP IPs missing from them
+func mergeIPSets(netguardIPs, sgroupIPs []string) []string {
+	merged := append([]string(nil), netguardIPs...)
+	seen := make(map[string]bool, len(netguardIPs))
+	for _, ip := range netguardIPs {
+		seen[ip] = true
+	}
+	for _, ip := range sgroupIPs {
+		if !seen[ip] {
+			seen[ip] = true
+			merged = append(merged, ip)
+		}
+	}
+	return merged
+}
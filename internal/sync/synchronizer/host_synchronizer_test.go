@@ -240,6 +240,60 @@ func TestHostSynchronizer_SyncHosts_NoIPSet(t *testing.T) {
 	sgroupReader.AssertExpectations(t)
 }
 
+func TestHostSynchronizer_SyncHosts_QuarantinesInvalidIPs(t *testing.T) {
+	hostReader := &MockHostReader{}
+	hostWriter := &MockHostWriter{}
+	sgroupReader := &MockSGROUPHostReader{}
+	quarantine := &MockHostQuarantine{}
+	config := DefaultHostSyncConfig()
+
+	synchronizer := NewHostSynchronizer(hostReader, hostWriter, sgroupReader, config, WithHostQuarantine(quarantine))
+
+	host1 := models.Host{}
+	host1.Name = "host1"
+	host1.UUID = "uuid1"
+	host1.Namespace = "default"
+
+	hostReader.On("GetHostsWithoutIPSet", mock.Anything, "default").
+		Return([]models.Host{host1}, nil)
+
+	sgroupReader.On("GetHostsByUUIDs", mock.Anything, []string{"uuid1"}).
+		Return([]*pb.Host{{
+			Name:   "host1",
+			Uuid:   "uuid1",
+			SgName: "sg1",
+			IpList: &pb.IPList{IPs: []string{"192.168.1.10", "192.168.1.300"}},
+		}}, nil)
+
+	// The host is quarantined with all SGROUP addresses, the valid ones are applied
+	quarantine.On("QuarantineHost", mock.Anything, types.HostIPSetUpdate{
+		HostUUID:  "uuid1",
+		HostID:    host1.GetID(),
+		Namespace: "default",
+		Name:      "host1",
+		IPSet:     []string{"192.168.1.10", "192.168.1.300"},
+		SGName:    "sg1",
+	}, []string{`invalid IP address "192.168.1.300"`}).Return(nil)
+
+	hostWriter.On("UpdateHostsIPSet", mock.Anything, []types.HostIPSetUpdate{{
+		HostUUID:  "uuid1",
+		HostID:    host1.GetID(),
+		Namespace: "default",
+		Name:      "host1",
+		IPSet:     []string{"192.168.1.10"},
+		SGName:    "sg1",
+	}}).Return(nil)
+
+	result, err := synchronizer.SyncHosts(context.Background(), "default")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.TotalSynced)
+	assert.Equal(t, []string{"uuid1"}, result.QuarantinedUUIDs)
+
+	quarantine.AssertExpectations(t)
+	hostWriter.AssertExpectations(t)
+}
+
 func TestHostSynchronizer_SyncHostsByUUIDs(t *testing.T) {
 	hostReader := &MockHostReader{}
 	hostWriter := &MockHostWriter{}
@@ -409,3 +463,76 @@ func TestHostSynchronizer_createBatches(t *testing.T) {
 	zeroBatches := synchronizer.createBatches([]string{"uuid1", "uuid2"}, 0)
 	assert.Len(t, zeroBatches, 1) // Should use default batch size
 }
+
+func TestHostSynchronizer_ConflictPolicies(t *testing.T) {
+	host := models.Host{}
+	host.Name = "host1"
+	host.UUID = "uuid1"
+	host.Namespace = "default"
+
+	// The IPSet was set in NETGUARD after the host was read without it
+	changedHost := host
+	changedHost.IpList = []models.IPItem{{IP: "10.0.0.1"}}
+
+	sgroupHost := &pb.Host{
+		Name:   "host1",
+		Uuid:   "uuid1",
+		IpList: &pb.IPList{IPs: []string{"192.168.1.10"}},
+	}
+
+	tests := []struct {
+		policy     HostConflictPolicy
+		expectedIP []string // nil when nothing is written
+	}{
+		{policy: HostConflictSGroupWins, expectedIP: []string{"192.168.1.10"}},
+		{policy: HostConflictNetguardWins},
+		{policy: HostConflictMergeByField, expectedIP: []string{"10.0.0.1", "192.168.1.10"}},
+		{policy: HostConflictManualReview},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			hostReader := &MockHostReader{}
+			hostWriter := &MockHostWriter{}
+			sgroupReader := &MockSGROUPHostReader{}
+			config := DefaultHostSyncConfig()
+			config.ConflictPolicy = tt.policy
+
+			synchronizer := NewHostSynchronizer(hostReader, hostWriter, sgroupReader, config)
+
+			hostReader.On("GetHostsWithoutIPSet", mock.Anything, "default").Return([]models.Host{host}, nil)
+			hostReader.On("ListHosts", mock.Anything, []HostIdentifier{{Namespace: "default", Name: "host1"}}).
+				Return([]models.Host{changedHost}, nil).Maybe()
+			sgroupReader.On("GetHostsByUUIDs", mock.Anything, []string{"uuid1"}).Return([]*pb.Host{sgroupHost}, nil)
+			if tt.expectedIP != nil {
+				hostWriter.On("UpdateHostsIPSet", mock.Anything, mock.MatchedBy(func(updates []types.HostIPSetUpdate) bool {
+					return len(updates) == 1 && assert.ObjectsAreEqual(tt.expectedIP, updates[0].IPSet)
+				})).Return(nil)
+			}
+
+			result, err := synchronizer.SyncHosts(context.Background(), "default")
+			require.NoError(t, err)
+			assert.Zero(t, result.TotalFailed)
+			if tt.policy != HostConflictSGroupWins {
+				assert.Equal(t, []string{"uuid1"}, result.ConflictUUIDs)
+			}
+			hostWriter.AssertExpectations(t)
+
+			reviewer := synchronizer.(HostConflictReviewer)
+			if tt.policy != HostConflictManualReview {
+				assert.Empty(t, reviewer.PendingConflicts())
+				return
+			}
+
+			conflicts := reviewer.PendingConflicts()
+			require.Len(t, conflicts, 1)
+			assert.Equal(t, []string{"10.0.0.1"}, conflicts[0].NetguardIPs)
+			assert.Equal(t, []string{"192.168.1.10"}, conflicts[0].SGroupIPs)
+
+			hostWriter.On("UpdateHostIPSet", mock.Anything, host.GetID(), []string{"192.168.1.10"}).Return(nil)
+			require.NoError(t, reviewer.ResolveConflict(context.Background(), "uuid1", true))
+			assert.Empty(t, reviewer.PendingConflicts())
+			assert.Error(t, reviewer.ResolveConflict(context.Background(), "uuid1", false))
+			hostWriter.AssertExpectations(t)
+		})
+	}
+}
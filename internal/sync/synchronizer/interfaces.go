@@ -2,6 +2,8 @@ package synchronizer
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
 
@@ -42,6 +44,12 @@ type SGROUPHostReader interface {
 	GetHostsInSecurityGroup(ctx context.Context, sgNames []string) ([]*pb.Host, error)
 }
 
+// HostQuarantine keeps SGROUP hosts with data rejected by validation for review
+type HostQuarantine interface {
+	// QuarantineHost stores the host update as received from SGROUP with the rejection reasons
+	QuarantineHost(ctx context.Context, update types.HostIPSetUpdate, reasons []string) error
+}
+
 // HostSynchronizer defines interface for synchronizing hosts between NETGUARD and SGROUP
 type HostSynchronizer interface {
 	// SyncHosts synchronizes hosts for a specific namespace
@@ -76,6 +84,53 @@ type HostSyncConfig struct {
 
 	// EnableIPSetValidation enables validation of IP addresses
 	EnableIPSetValidation bool
+
+	// ConflictPolicy resolves IPSets changed in both NETGUARD and SGROUP
+	ConflictPolicy HostConflictPolicy `yaml:"conflict_policy"`
+}
+
+// HostConflictPolicy resolves a host whose IPSet was set in NETGUARD and differs from SGROUP
+type HostConflictPolicy string
+
+const (
+	// HostConflictSGroupWins replaces the NETGUARD IPSet with the SGROUP one
+	HostConflictSGroupWins HostConflictPolicy = "sgroup-wins"
+	// HostConflictNetguardWins keeps the NETGUARD IPSet
+	HostConflictNetguardWins HostConflictPolicy = "netguard-wins"
+	// HostConflictMergeByField merges the IPSets: IPs of both sides are kept
+	HostConflictMergeByField HostConflictPolicy = "merge-by-field"
+	// HostConflictManualReview keeps the NETGUARD IPSet and queues the host for review
+	HostConflictManualReview HostConflictPolicy = "manual-review"
+)
+
+// Validate validates the policy, empty means HostConflictSGroupWins
+func (p HostConflictPolicy) Validate() error {
+	switch p {
+	case "", HostConflictSGroupWins, HostConflictNetguardWins, HostConflictMergeByField, HostConflictManualReview:
+		return nil
+	}
+	return fmt.Errorf("unknown host conflict policy %q, expected %q, %q, %q or %q",
+		p, HostConflictSGroupWins, HostConflictNetguardWins, HostConflictMergeByField, HostConflictManualReview)
+}
+
+// HostConflict is a host queued for manual review of its IPSet
+type HostConflict struct {
+	HostUUID    string    `json:"hostUUID"`
+	HostID      string    `json:"hostID"`
+	NetguardIPs []string  `json:"netguardIPs"`
+	SGroupIPs   []string  `json:"sgroupIPs"`
+	SGName      string    `json:"sgName,omitempty"`
+	DetectedAt  time.Time `json:"detectedAt"`
+}
+
+// HostConflictReviewer is implemented by host synchronizers queueing conflicts for manual review
+type HostConflictReviewer interface {
+	// PendingConflicts returns the queued conflicts ordered by detection time
+	PendingConflicts() []HostConflict
+
+	// ResolveConflict applies the SGROUP IPSet of the host if useSGroup is true,
+	// otherwise keeps the NETGUARD one, and removes the host from the queue
+	ResolveConflict(ctx context.Context, hostUUID string, useSGroup bool) error
 }
 
 // DefaultHostSyncConfig returns default configuration for host synchronization
@@ -86,5 +141,106 @@ func DefaultHostSyncConfig() HostSyncConfig {
 		SyncTimeout:           30, // 30 seconds
 		RetryAttempts:         3,
 		EnableIPSetValidation: true,
+		ConflictPolicy:        HostConflictSGroupWins,
+	}
+}
+
+// ConflictPolicy resolves differences between sgroups objects and the NETGUARD resources owning them
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyNetguard keeps NETGUARD resources, differences are only reported
+	// and are repaired by the forward sync or drift detection
+	ConflictPolicyNetguard ConflictPolicy = "netguard"
+	// ConflictPolicySGroups overwrites NETGUARD resources with their sgroups state
+	ConflictPolicySGroups ConflictPolicy = "sgroups"
+)
+
+// NetworkReader defines interface for reading networks from NETGUARD
+type NetworkReader interface {
+	// ListNetworks returns networks of all namespaces
+	ListNetworks(ctx context.Context) ([]models.Network, error)
+}
+
+// NetworkWriter defines interface for writing networks to NETGUARD
+type NetworkWriter interface {
+	// UpsertNetworks creates or updates networks without touching others
+	UpsertNetworks(ctx context.Context, networks []models.Network) error
+}
+
+// AddressGroupReader defines interface for reading address groups from NETGUARD
+type AddressGroupReader interface {
+	// ListAddressGroups returns address groups of all namespaces
+	ListAddressGroups(ctx context.Context) ([]models.AddressGroup, error)
+}
+
+// AddressGroupWriter defines interface for writing address groups to NETGUARD
+type AddressGroupWriter interface {
+	// UpsertAddressGroups creates or updates address groups without touching others
+	UpsertAddressGroups(ctx context.Context, addressGroups []models.AddressGroup) error
+}
+
+// SGROUPNetworkReader defines interface for reading networks from SGROUP
+type SGROUPNetworkReader interface {
+	// ListNetworks retrieves all networks from SGROUP
+	ListNetworks(ctx context.Context) ([]*pb.Network, error)
+}
+
+// SGROUPSecurityGroupReader defines interface for reading security groups from SGROUP
+type SGROUPSecurityGroupReader interface {
+	// ListSecurityGroups retrieves all security groups from SGROUP
+	ListSecurityGroups(ctx context.Context) ([]*pb.SecGroup, error)
+}
+
+// NetworkSynchronizer defines interface for synchronizing networks from SGROUP to NETGUARD
+type NetworkSynchronizer interface {
+	// SyncAllNetworks compares all SGROUP networks with NETGUARD and resolves differences
+	SyncAllNetworks(ctx context.Context) (*types.ResourceSyncResult, error)
+}
+
+// AddressGroupSynchronizer defines interface for synchronizing address groups from SGROUP to NETGUARD
+type AddressGroupSynchronizer interface {
+	// SyncAllAddressGroups compares all SGROUP security groups with NETGUARD and resolves differences
+	SyncAllAddressGroups(ctx context.Context) (*types.ResourceSyncResult, error)
+}
+
+// ResourceSyncConfig holds configuration for the reverse synchronization of Networks or AddressGroups
+type ResourceSyncConfig struct {
+	// Enabled registers the synchronizer in the reverse sync system
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// ConflictPolicy resolves differences of resources existing in both NETGUARD and SGROUP
+	ConflictPolicy ConflictPolicy `json:"conflict_policy" yaml:"conflict_policy"`
+
+	// ImportUnknown creates in NETGUARD the SGROUP objects it doesn't have, named "namespace/name"
+	// or mapped by the namespace mapping rules.
+	// Don't combine with drift detection policy "prune", which deletes such objects from SGROUP.
+	ImportUnknown bool `json:"import_unknown" yaml:"import_unknown"`
+
+	// SyncTimeout is the timeout for synchronization operations
+	SyncTimeout int `json:"sync_timeout" yaml:"sync_timeout"` // seconds
+}
+
+// DefaultResourceSyncConfig returns default configuration for the reverse synchronization
+// of Networks or AddressGroups: NETGUARD owns its resources and nothing is imported
+func DefaultResourceSyncConfig() ResourceSyncConfig {
+	return ResourceSyncConfig{
+		Enabled:        true,
+		ConflictPolicy: ConflictPolicyNetguard,
+		ImportUnknown:  false,
+		SyncTimeout:    30, // 30 seconds
+	}
+}
+
+// Validate validates the configuration
+func (c ResourceSyncConfig) Validate() error {
+	switch c.ConflictPolicy {
+	case ConflictPolicyNetguard, ConflictPolicySGroups:
+	default:
+		return fmt.Errorf("unknown conflict policy %q, expected %q or %q", c.ConflictPolicy, ConflictPolicyNetguard, ConflictPolicySGroups)
+	}
+	if c.SyncTimeout <= 0 {
+		return fmt.Errorf("sync timeout must be positive")
 	}
+	return nil
 }
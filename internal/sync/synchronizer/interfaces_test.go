@@ -71,6 +71,16 @@ func (m *MockSGROUPHostReader) GetHostsInSecurityGroup(ctx context.Context, sgNa
 	return args.Get(0).([]*pb.Host), args.Error(1)
 }
 
+// MockHostQuarantine implements HostQuarantine interface for testing
+type MockHostQuarantine struct {
+	mock.Mock
+}
+
+func (m *MockHostQuarantine) QuarantineHost(ctx context.Context, update types.HostIPSetUpdate, reasons []string) error {
+	args := m.Called(ctx, update, reasons)
+	return args.Error(0)
+}
+
 // Test interface compliance
 func TestInterfaceCompliance(t *testing.T) {
 	// Test that our mocks implement the interfaces
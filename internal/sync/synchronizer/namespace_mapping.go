@@ -0,0 +1,130 @@
+package synchronizer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// NamespaceRule maps SGROUP names to a NETGUARD namespace. A rule matches either by Prefix
+// or by the Pattern regular expression.
+type NamespaceRule struct {
+	// Prefix matches names starting with it, the rest of the name is the resource name
+	Prefix string `json:"prefix" yaml:"prefix"`
+
+	// Pattern matches names by a regular expression, Replacement rewrites matched names
+	Pattern string `json:"pattern" yaml:"pattern"`
+
+	// Replacement expands submatches of Pattern ("$1", "${ns}"): into "namespace/name", or into
+	// the resource name when Namespace is set. Empty keeps the name.
+	Replacement string `json:"replacement" yaml:"replacement"`
+
+	// Namespace is the NETGUARD namespace of matched names
+	Namespace string `json:"namespace" yaml:"namespace"`
+}
+
+// NamespaceMappingConfig maps SGROUP names of imported resources to NETGUARD identifiers.
+// Rules are tried in order, names no rule matches are parsed as "namespace/name".
+type NamespaceMappingConfig struct {
+	// Rules are tried in order, the first matching rule maps the name
+	Rules []NamespaceRule `json:"rules" yaml:"rules"`
+
+	// DefaultNamespace is the namespace of names without rule and namespace.
+	// Empty leaves such names unmapped.
+	DefaultNamespace string `json:"default_namespace" yaml:"default_namespace"`
+}
+
+// Validate validates the namespace mapping rules
+func (c NamespaceMappingConfig) Validate() error {
+	_, err := NewNamespaceMapper(c)
+	return err
+}
+
+// namespaceRule is a NamespaceRule with a compiled pattern
+type namespaceRule struct {
+	NamespaceRule
+	pattern *regexp.Regexp
+}
+
+// NamespaceMapper maps SGROUP names to NETGUARD identifiers by NamespaceMappingConfig.
+// A nil mapper only parses "namespace/name".
+type NamespaceMapper struct {
+	rules            []namespaceRule
+	defaultNamespace string
+}
+
+// NewNamespaceMapper compiles the namespace mapping rules
+func NewNamespaceMapper(config NamespaceMappingConfig) (*NamespaceMapper, error) {
+	mapper := &NamespaceMapper{defaultNamespace: config.DefaultNamespace}
+	for i, rule := range config.Rules {
+		switch {
+		case rule.Prefix != "" && rule.Pattern != "":
+			return nil, fmt.Errorf("namespace rule %d: prefix and pattern are mutually exclusive", i)
+		case rule.Prefix != "":
+			if rule.Namespace == "" {
+				return nil, fmt.Errorf("namespace rule %d: namespace is required for prefix %q", i, rule.Prefix)
+			}
+			mapper.rules = append(mapper.rules, namespaceRule{NamespaceRule: rule})
+		case rule.Pattern != "":
+			pattern, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("namespace rule %d: invalid pattern: %w", i, err)
+			}
+			if rule.Namespace == "" && rule.Replacement == "" {
+				return nil, fmt.Errorf("namespace rule %d: namespace or replacement is required for pattern %q", i, rule.Pattern)
+			}
+			mapper.rules = append(mapper.rules, namespaceRule{NamespaceRule: rule, pattern: pattern})
+		default:
+			return nil, fmt.Errorf("namespace rule %d: prefix or pattern is required", i)
+		}
+	}
+	return mapper, nil
+}
+
+// Map returns the NETGUARD identifier of a SGROUP name, false if the name can't be mapped
+func (m *NamespaceMapper) Map(name string) (models.ResourceIdentifier, bool) {
+	if m == nil {
+		return parseSGroupsName(name)
+	}
+
+	for _, rule := range m.rules {
+		if rule.pattern == nil {
+			resourceName, found := strings.CutPrefix(name, rule.Prefix)
+			if !found {
+				continue
+			}
+			return newMappedIdentifier(rule.Namespace, resourceName)
+		}
+
+		match := rule.pattern.FindStringSubmatchIndex(name)
+		if match == nil {
+			continue
+		}
+		rewritten := name
+		if rule.Replacement != "" {
+			rewritten = string(rule.pattern.ExpandString(nil, rule.Replacement, name, match))
+		}
+		if rule.Namespace != "" {
+			return newMappedIdentifier(rule.Namespace, rewritten)
+		}
+		return parseSGroupsName(rewritten)
+	}
+
+	if id, ok := parseSGroupsName(name); ok {
+		return id, true
+	}
+	if m.defaultNamespace != "" && !strings.Contains(name, "/") {
+		return newMappedIdentifier(m.defaultNamespace, name)
+	}
+	return models.ResourceIdentifier{}, false
+}
+
+// newMappedIdentifier returns the identifier of a mapped name, false for empty or nested names
+func newMappedIdentifier(namespace, name string) (models.ResourceIdentifier, bool) {
+	if name == "" || strings.Contains(name, "/") {
+		return models.ResourceIdentifier{}, false
+	}
+	return models.NewResourceIdentifier(name, models.WithNamespace(namespace)), true
+}
@@ -0,0 +1,96 @@
+package synchronizer
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+func TestNamespaceMapper_Map(t *testing.T) {
+	mapper, err := NewNamespaceMapper(NamespaceMappingConfig{
+		Rules: []NamespaceRule{
+			{Prefix: "prod-", Namespace: "production"},
+			{Pattern: `^team-([a-z0-9]+)-(.+)$`, Replacement: "team-$1/$2"},
+			{Pattern: `^(?P<name>[a-z]+)\.legacy$`, Replacement: "${name}", Namespace: "legacy"},
+		},
+		DefaultNamespace: "imported",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		expected string
+		mapped   bool
+	}{
+		{"prod-web", "production/web", true},
+		{"team-payments-db", "team-payments/db", true},
+		{"billing.legacy", "legacy/billing", true},
+		{"ns/web", "ns/web", true},
+		{"flat", "imported/flat", true},
+		{"prod-", "", false},
+		{"prod-a/b", "", false},
+		{"a/b/c", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := mapper.Map(tt.name)
+			assert.Equal(t, tt.mapped, ok)
+			if tt.mapped {
+				assert.Equal(t, tt.expected, id.Key())
+			}
+		})
+	}
+
+	var nilMapper *NamespaceMapper
+	_, ok := nilMapper.Map("flat")
+	assert.False(t, ok, "without rules names need a namespace")
+	id, ok := nilMapper.Map("ns/web")
+	require.True(t, ok)
+	assert.Equal(t, "ns/web", id.Key())
+}
+
+func TestNamespaceMappingConfig_Validate(t *testing.T) {
+	require.NoError(t, NamespaceMappingConfig{}.Validate())
+
+	for name, rule := range map[string]NamespaceRule{
+		"prefix and pattern": {Prefix: "a-", Pattern: "^a", Namespace: "a"},
+		"no matcher":         {Namespace: "a"},
+		"prefix namespace":   {Prefix: "a-"},
+		"invalid pattern":    {Pattern: "(", Namespace: "a"},
+		"pattern target":     {Pattern: "^a"},
+	} {
+		assert.Error(t, NamespaceMappingConfig{Rules: []NamespaceRule{rule}}.Validate(), name)
+	}
+}
+
+func TestNetworkSynchronizer_ImportsMappedNames(t *testing.T) {
+	mapper, err := NewNamespaceMapper(NamespaceMappingConfig{Rules: []NamespaceRule{{Prefix: "prod-", Namespace: "production"}}})
+	require.NoError(t, err)
+
+	store := &fakeResourceStore{}
+	sgroupState := &fakeSGROUPState{networks: []*pb.Network{
+		sgroupNetwork("prod-web", "10.0.0.0/24"),
+		sgroupNetwork("ns/db", "10.0.1.0/24"),
+		sgroupNetwork("unmanaged", "10.0.2.0/24"),
+	}}
+	config := DefaultResourceSyncConfig()
+	config.ImportUnknown = true
+	synchronizer := NewNetworkSynchronizer(store, store, sgroupState, config, WithNamespaceMapper(mapper))
+
+	result, err := synchronizer.SyncAllNetworks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod-web", "ns/db"}, result.Imported)
+	assert.Equal(t, []string{"unmanaged"}, result.Skipped)
+	assert.ElementsMatch(t, []string{"production/web=10.0.0.0/24", "ns/db=10.0.1.0/24"}, store.upserted)
+
+	store.networks = []models.Network{testNetwork("production", "web", "10.0.0.0/24")}
+	store.upserted = nil
+	result, err = synchronizer.SyncAllNetworks(context.Background())
+	require.NoError(t, err)
+	assert.NotContains(t, result.Imported, "prod-web", "mapped objects are matched with the imported network")
+}
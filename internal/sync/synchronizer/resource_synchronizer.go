@@ -0,0 +1,301 @@
+package synchronizer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+
+	"netguard-pg-backend/internal/domain/models"
+	"netguard-pg-backend/internal/sync/types"
+)
+
+// networkSynchronizer implements NetworkSynchronizer interface.
+// Networks are matched with SGROUP by their sgroups name, the CIDR is compared.
+type networkSynchronizer struct {
+	reader       NetworkReader
+	writer       NetworkWriter
+	sgroupReader SGROUPNetworkReader
+	config       ResourceSyncConfig
+	namespaces   *NamespaceMapper
+}
+
+// ResourceSynchronizerOption configures a network or address group synchronizer
+type ResourceSynchronizerOption func(*resourceSynchronizerOptions)
+
+type resourceSynchronizerOptions struct {
+	namespaces *NamespaceMapper
+}
+
+// WithNamespaceMapper maps names of imported SGROUP objects to NETGUARD identifiers,
+// by default only "namespace/name" names are imported
+func WithNamespaceMapper(namespaces *NamespaceMapper) ResourceSynchronizerOption {
+	return func(o *resourceSynchronizerOptions) {
+		o.namespaces = namespaces
+	}
+}
+
+func newResourceSynchronizerOptions(opts []ResourceSynchronizerOption) resourceSynchronizerOptions {
+	var options resourceSynchronizerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// NewNetworkSynchronizer creates a new network synchronizer
+func NewNetworkSynchronizer(
+	reader NetworkReader,
+	writer NetworkWriter,
+	sgroupReader SGROUPNetworkReader,
+	config ResourceSyncConfig,
+	opts ...ResourceSynchronizerOption,
+) NetworkSynchronizer {
+	options := newResourceSynchronizerOptions(opts)
+	return &networkSynchronizer{
+		reader:       reader,
+		writer:       writer,
+		sgroupReader: sgroupReader,
+		config:       config,
+		namespaces:   options.namespaces,
+	}
+}
+
+// SyncAllNetworks compares all SGROUP networks with NETGUARD and resolves differences
+func (s *networkSynchronizer) SyncAllNetworks(ctx context.Context) (*types.ResourceSyncResult, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(s.config.SyncTimeout)*time.Second)
+	defer cancel()
+
+	result := types.NewResourceSyncResult()
+
+	sgroupNetworks, err := s.sgroupReader.ListNetworks(timeoutCtx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list SGROUP networks: %w", err)
+	}
+	networks, err := s.reader.ListNetworks(timeoutCtx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list NETGUARD networks: %w", err)
+	}
+
+	owned := make(map[string]models.Network, len(networks))
+	byKey := make(map[string]models.Network, len(networks))
+	for _, network := range networks {
+		proto, err := network.ToSGroupsProto()
+		if err != nil {
+			return result, fmt.Errorf("failed to convert network %s: %w", network.Key(), err)
+		}
+		owned[proto.(*pb.Network).GetName()] = network
+		byKey[network.Key()] = network
+	}
+
+	var writes []models.Network
+	var imported, updated []string
+	for _, sgroupNetwork := range sgroupNetworks {
+		name := sgroupNetwork.GetName()
+		cidr := sgroupNetwork.GetNetwork().GetCIDR()
+		result.TotalCompared++
+
+		network, exists := owned[name]
+		if !exists {
+			// Objects named by the namespace mapping rules belong to the mapped resource
+			if id, ok := s.namespaces.Map(name); ok {
+				network, exists = byKey[id.Key()]
+			}
+		}
+		if !exists {
+			id, ok := s.importable(name)
+			if !ok {
+				result.Skipped = append(result.Skipped, name)
+				continue
+			}
+			writes = append(writes, models.Network{SelfRef: models.NewSelfRef(id), CIDR: cidr, NetworkName: name})
+			imported = append(imported, name)
+			continue
+		}
+
+		if models.CIDRsEqual(network.CIDR, cidr) {
+			continue
+		}
+		if s.config.ConflictPolicy != ConflictPolicySGroups {
+			result.Conflicts = append(result.Conflicts, name)
+			continue
+		}
+		network.CIDR = cidr
+		writes = append(writes, network)
+		updated = append(updated, name)
+	}
+
+	if len(writes) == 0 {
+		return result, nil
+	}
+	if err := s.writer.UpsertNetworks(timeoutCtx, writes); err != nil {
+		for _, name := range append(imported, updated...) {
+			result.AddError(name, err.Error())
+		}
+		return result, fmt.Errorf("failed to write networks: %w", err)
+	}
+	result.Imported = append(result.Imported, imported...)
+	result.Updated = append(result.Updated, updated...)
+	return result, nil
+}
+
+// importable returns the NETGUARD identifier of an unknown SGROUP object, if it is imported
+func (s *networkSynchronizer) importable(name string) (models.ResourceIdentifier, bool) {
+	if !s.config.ImportUnknown {
+		return models.ResourceIdentifier{}, false
+	}
+	return s.namespaces.Map(name)
+}
+
+// addressGroupSynchronizer implements AddressGroupSynchronizer interface.
+// Address groups are matched with SGROUP security groups by their sgroups name, the default
+// action, logs and trace flags are compared. Networks of security groups are owned by
+// NetworkBindings and are not synchronized back.
+type addressGroupSynchronizer struct {
+	reader       AddressGroupReader
+	writer       AddressGroupWriter
+	sgroupReader SGROUPSecurityGroupReader
+	config       ResourceSyncConfig
+	namespaces   *NamespaceMapper
+}
+
+// NewAddressGroupSynchronizer creates a new address group synchronizer
+func NewAddressGroupSynchronizer(
+	reader AddressGroupReader,
+	writer AddressGroupWriter,
+	sgroupReader SGROUPSecurityGroupReader,
+	config ResourceSyncConfig,
+	opts ...ResourceSynchronizerOption,
+) AddressGroupSynchronizer {
+	options := newResourceSynchronizerOptions(opts)
+	return &addressGroupSynchronizer{
+		reader:       reader,
+		writer:       writer,
+		sgroupReader: sgroupReader,
+		config:       config,
+		namespaces:   options.namespaces,
+	}
+}
+
+// SyncAllAddressGroups compares all SGROUP security groups with NETGUARD and resolves differences
+func (s *addressGroupSynchronizer) SyncAllAddressGroups(ctx context.Context) (*types.ResourceSyncResult, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Duration(s.config.SyncTimeout)*time.Second)
+	defer cancel()
+
+	result := types.NewResourceSyncResult()
+
+	securityGroups, err := s.sgroupReader.ListSecurityGroups(timeoutCtx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list SGROUP security groups: %w", err)
+	}
+	addressGroups, err := s.reader.ListAddressGroups(timeoutCtx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list NETGUARD address groups: %w", err)
+	}
+
+	type ownedGroup struct {
+		addressGroup models.AddressGroup
+		proto        *pb.SecGroup
+	}
+	owned := make(map[string]ownedGroup, len(addressGroups))
+	byKey := make(map[string]ownedGroup, len(addressGroups))
+	for _, addressGroup := range addressGroups {
+		proto, err := addressGroup.ToSGroupsProto()
+		if err != nil {
+			return result, fmt.Errorf("failed to convert address group %s: %w", addressGroup.Key(), err)
+		}
+		secGroup := proto.(*pb.SecGroup)
+		owned[secGroup.GetName()] = ownedGroup{addressGroup: addressGroup, proto: secGroup}
+		byKey[addressGroup.Key()] = owned[secGroup.GetName()]
+	}
+
+	var writes []models.AddressGroup
+	var imported, updated []string
+	for _, securityGroup := range securityGroups {
+		name := securityGroup.GetName()
+		result.TotalCompared++
+
+		group, exists := owned[name]
+		if !exists {
+			// Objects named by the namespace mapping rules belong to the mapped resource
+			if id, ok := s.namespaces.Map(name); ok {
+				group, exists = byKey[id.Key()]
+			}
+		}
+		if !exists {
+			id, ok := s.importable(name)
+			if !ok {
+				result.Skipped = append(result.Skipped, name)
+				continue
+			}
+			addressGroup := models.AddressGroup{SelfRef: models.NewSelfRef(id), AddressGroupName: name}
+			applySecurityGroup(&addressGroup, securityGroup)
+			writes = append(writes, addressGroup)
+			imported = append(imported, name)
+			continue
+		}
+
+		if group.proto.GetDefaultAction() == normalizeDefaultAction(securityGroup.GetDefaultAction()) &&
+			group.proto.GetLogs() == securityGroup.GetLogs() &&
+			group.proto.GetTrace() == securityGroup.GetTrace() {
+			continue
+		}
+		if s.config.ConflictPolicy != ConflictPolicySGroups {
+			result.Conflicts = append(result.Conflicts, name)
+			continue
+		}
+		applySecurityGroup(&group.addressGroup, securityGroup)
+		writes = append(writes, group.addressGroup)
+		updated = append(updated, name)
+	}
+
+	if len(writes) == 0 {
+		return result, nil
+	}
+	if err := s.writer.UpsertAddressGroups(timeoutCtx, writes); err != nil {
+		for _, name := range append(imported, updated...) {
+			result.AddError(name, err.Error())
+		}
+		return result, fmt.Errorf("failed to write address groups: %w", err)
+	}
+	result.Imported = append(result.Imported, imported...)
+	result.Updated = append(result.Updated, updated...)
+	return result, nil
+}
+
+// importable returns the NETGUARD identifier of an unknown SGROUP object, if it is imported
+func (s *addressGroupSynchronizer) importable(name string) (models.ResourceIdentifier, bool) {
+	if !s.config.ImportUnknown {
+		return models.ResourceIdentifier{}, false
+	}
+	return s.namespaces.Map(name)
+}
+
+// applySecurityGroup copies the synchronized fields of a SGROUP security group to an address group
+func applySecurityGroup(addressGroup *models.AddressGroup, securityGroup *pb.SecGroup) {
+	addressGroup.DefaultAction = models.ActionAccept
+	if securityGroup.GetDefaultAction() == pb.SecGroup_DROP {
+		addressGroup.DefaultAction = models.ActionDrop
+	}
+	addressGroup.Logs = securityGroup.GetLogs()
+	addressGroup.Trace = securityGroup.GetTrace()
+}
+
+// normalizeDefaultAction maps DEFAULT to ACCEPT, as NETGUARD does when syncing address groups
+func normalizeDefaultAction(action pb.SecGroup_DefaultAction) pb.SecGroup_DefaultAction {
+	if action == pb.SecGroup_DROP {
+		return pb.SecGroup_DROP
+	}
+	return pb.SecGroup_ACCEPT
+}
+
+// parseSGroupsName parses a "namespace/name" sgroups name into a NETGUARD identifier
+func parseSGroupsName(name string) (models.ResourceIdentifier, bool) {
+	namespace, resourceName, found := strings.Cut(name, "/")
+	if !found || namespace == "" || resourceName == "" || strings.Contains(resourceName, "/") {
+		return models.ResourceIdentifier{}, false
+	}
+	return models.NewResourceIdentifier(resourceName, models.WithNamespace(namespace)), true
+}
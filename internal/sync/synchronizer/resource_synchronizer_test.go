@@ -0,0 +1,171 @@
+package synchronizer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/PRO-Robotech/protos/pkg/api/common"
+	pb "github.com/PRO-Robotech/protos/pkg/api/sgroups"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"netguard-pg-backend/internal/domain/models"
+)
+
+// fakeResourceStore keeps NETGUARD networks and address groups and records upserts
+type fakeResourceStore struct {
+	networks      []models.Network
+	addressGroups []models.AddressGroup
+	upserted      []string
+	writeErr      error
+}
+
+func (s *fakeResourceStore) ListNetworks(context.Context) ([]models.Network, error) {
+	return s.networks, nil
+}
+
+func (s *fakeResourceStore) UpsertNetworks(_ context.Context, networks []models.Network) error {
+	if s.writeErr != nil {
+		return s.writeErr
+	}
+	for _, network := range networks {
+		s.upserted = append(s.upserted, network.Key()+"="+network.CIDR)
+	}
+	return nil
+}
+
+func (s *fakeResourceStore) ListAddressGroups(context.Context) ([]models.AddressGroup, error) {
+	return s.addressGroups, nil
+}
+
+func (s *fakeResourceStore) UpsertAddressGroups(_ context.Context, addressGroups []models.AddressGroup) error {
+	if s.writeErr != nil {
+		return s.writeErr
+	}
+	for _, addressGroup := range addressGroups {
+		s.upserted = append(s.upserted, addressGroup.Key()+"="+string(addressGroup.DefaultAction))
+	}
+	return nil
+}
+
+// fakeSGROUPState returns fixed SGROUP networks and security groups
+type fakeSGROUPState struct {
+	networks       []*pb.Network
+	securityGroups []*pb.SecGroup
+}
+
+func (s *fakeSGROUPState) ListNetworks(context.Context) ([]*pb.Network, error) {
+	return s.networks, nil
+}
+
+func (s *fakeSGROUPState) ListSecurityGroups(context.Context) ([]*pb.SecGroup, error) {
+	return s.securityGroups, nil
+}
+
+func testNetwork(namespace, name, cidr string) models.Network {
+	return models.Network{
+		SelfRef: models.NewSelfRef(models.NewResourceIdentifier(name, models.WithNamespace(namespace))),
+		CIDR:    cidr,
+	}
+}
+
+func sgroupNetwork(name, cidr string) *pb.Network {
+	return &pb.Network{Name: name, Network: &common.Networks_NetIP{CIDR: cidr}}
+}
+
+func TestNetworkSynchronizer_ConflictPolicies(t *testing.T) {
+	sgroupState := &fakeSGROUPState{networks: []*pb.Network{
+		sgroupNetwork("ns/same", "10.0.0.0/24"),
+		sgroupNetwork("ns/changed", "10.0.2.0/24"),
+		sgroupNetwork("ns/unknown", "10.0.3.0/24"),
+		sgroupNetwork("unmanaged", "10.0.4.0/24"),
+	}}
+	newStore := func() *fakeResourceStore {
+		return &fakeResourceStore{networks: []models.Network{
+			testNetwork("ns", "same", "10.0.0.0/24"),
+			testNetwork("ns", "changed", "10.0.1.0/24"),
+		}}
+	}
+
+	t.Run("netguard owns resources", func(t *testing.T) {
+		store := newStore()
+		synchronizer := NewNetworkSynchronizer(store, store, sgroupState, DefaultResourceSyncConfig())
+
+		result, err := synchronizer.SyncAllNetworks(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.TotalCompared)
+		assert.Equal(t, []string{"ns/changed"}, result.Conflicts)
+		assert.ElementsMatch(t, []string{"ns/unknown", "unmanaged"}, result.Skipped)
+		assert.Empty(t, result.Updated)
+		assert.Empty(t, store.upserted)
+	})
+
+	t.Run("sgroups state wins and unknown objects are imported", func(t *testing.T) {
+		store := newStore()
+		config := DefaultResourceSyncConfig()
+		config.ConflictPolicy = ConflictPolicySGroups
+		config.ImportUnknown = true
+		synchronizer := NewNetworkSynchronizer(store, store, sgroupState, config)
+
+		result, err := synchronizer.SyncAllNetworks(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ns/changed"}, result.Updated)
+		assert.Equal(t, []string{"ns/unknown"}, result.Imported)
+		assert.Equal(t, []string{"unmanaged"}, result.Skipped, "names without namespace are not imported")
+		assert.Empty(t, result.Conflicts)
+		assert.ElementsMatch(t, []string{"ns/unknown=10.0.3.0/24", "ns/changed=10.0.2.0/24"}, store.upserted)
+	})
+
+	t.Run("write failure", func(t *testing.T) {
+		store := newStore()
+		store.writeErr = errors.New("connection reset")
+		config := DefaultResourceSyncConfig()
+		config.ConflictPolicy = ConflictPolicySGroups
+		synchronizer := NewNetworkSynchronizer(store, store, sgroupState, config)
+
+		result, err := synchronizer.SyncAllNetworks(context.Background())
+		require.Error(t, err)
+		assert.Empty(t, result.Updated)
+		assert.Contains(t, result.Errors, "ns/changed")
+	})
+}
+
+func TestAddressGroupSynchronizer_ConflictPolicies(t *testing.T) {
+	addressGroup := models.AddressGroup{
+		SelfRef:       models.NewSelfRef(models.NewResourceIdentifier("web", models.WithNamespace("ns"))),
+		DefaultAction: models.ActionAccept,
+		Networks:      []models.NetworkItem{{Name: "ns/net"}},
+	}
+	sgroupState := &fakeSGROUPState{securityGroups: []*pb.SecGroup{
+		// Networks are owned by NetworkBindings and are not compared
+		{Name: "ns/web", DefaultAction: pb.SecGroup_DROP, Logs: true},
+		{Name: "ns/db", DefaultAction: pb.SecGroup_DEFAULT},
+	}}
+
+	store := &fakeResourceStore{addressGroups: []models.AddressGroup{addressGroup}}
+	synchronizer := NewAddressGroupSynchronizer(store, store, sgroupState, DefaultResourceSyncConfig())
+	result, err := synchronizer.SyncAllAddressGroups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns/web"}, result.Conflicts)
+	assert.Equal(t, []string{"ns/db"}, result.Skipped)
+	assert.Empty(t, store.upserted)
+
+	config := DefaultResourceSyncConfig()
+	config.ConflictPolicy = ConflictPolicySGroups
+	config.ImportUnknown = true
+	synchronizer = NewAddressGroupSynchronizer(store, store, sgroupState, config)
+	result, err = synchronizer.SyncAllAddressGroups(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns/web"}, result.Updated)
+	assert.Equal(t, []string{"ns/db"}, result.Imported)
+	assert.ElementsMatch(t, []string{"ns/web=DROP", "ns/db=ACCEPT"}, store.upserted)
+}
+
+func TestResourceSyncConfig_Validate(t *testing.T) {
+	config := DefaultResourceSyncConfig()
+	require.NoError(t, config.Validate())
+
+	config.ConflictPolicy = "newest"
+	assert.Error(t, config.Validate())
+}
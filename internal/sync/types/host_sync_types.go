@@ -35,6 +35,12 @@ type HostSyncResult struct {
 	// TotalFailed is the total number of hosts that failed to synchronize
 	TotalFailed int `json:"total_failed"`
 
+	// ConflictUUIDs contains the UUIDs of hosts whose IPSet was changed in both NETGUARD and SGROUP
+	ConflictUUIDs []string `json:"conflict_uuids,omitempty"`
+
+	// QuarantinedUUIDs contains the UUIDs of hosts with SGROUP data rejected by validation
+	QuarantinedUUIDs []string `json:"quarantined_uuids,omitempty"`
+
 	// Details contains additional information about the synchronization
 	Details map[string]interface{} `json:"details,omitempty"`
 }
@@ -98,6 +104,16 @@ func (r *HostSyncResult) AddFailedHost(uuid string, errorMsg string) {
 	r.TotalFailed++
 }
 
+// AddConflict adds a host whose IPSet differs in NETGUARD and SGROUP to the result
+func (r *HostSyncResult) AddConflict(hostUUID string) {
+	r.ConflictUUIDs = append(r.ConflictUUIDs, hostUUID)
+}
+
+// AddQuarantinedHost adds a host with SGROUP data placed into the quarantine to the result
+func (r *HostSyncResult) AddQuarantinedHost(uuid string) {
+	r.QuarantinedUUIDs = append(r.QuarantinedUUIDs, uuid)
+}
+
 // SetTotalRequested sets the total number of hosts requested for synchronization
 func (r *HostSyncResult) SetTotalRequested(total int) {
 	r.TotalRequested = total
@@ -0,0 +1,13 @@
+package types
+
+// ProcessingResult counts the objects an entity processor reconciled for one change event
+type ProcessingResult struct {
+	// Processed is the number of objects written to NETGUARD
+	Processed int `json:"processed"`
+
+	// Failed is the number of objects that failed to synchronize
+	Failed int `json:"failed"`
+
+	// Conflicts is the number of objects changed on both sides or kept by the conflict policy
+	Conflicts int `json:"conflicts"`
+}
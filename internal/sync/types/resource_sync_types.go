@@ -0,0 +1,50 @@
+package types
+
+// ResourceSyncResult represents the result of the reverse synchronization of
+// Networks or AddressGroups. Resources are identified by their sgroups names.
+type ResourceSyncResult struct {
+	// Imported contains sgroups objects created in NETGUARD
+	Imported []string `json:"imported"`
+
+	// Updated contains NETGUARD resources overwritten with their sgroups state
+	Updated []string `json:"updated"`
+
+	// Conflicts contains NETGUARD resources that differ from sgroups and were kept
+	Conflicts []string `json:"conflicts"`
+
+	// Skipped contains sgroups objects unknown to NETGUARD that were not imported
+	Skipped []string `json:"skipped"`
+
+	// Errors maps sgroups names to their synchronization error messages
+	Errors map[string]string `json:"errors,omitempty"`
+
+	// TotalCompared is the total number of sgroups objects compared with NETGUARD
+	TotalCompared int `json:"total_compared"`
+}
+
+// NewResourceSyncResult creates a new ResourceSyncResult
+func NewResourceSyncResult() *ResourceSyncResult {
+	return &ResourceSyncResult{
+		Imported:  make([]string, 0),
+		Updated:   make([]string, 0),
+		Conflicts: make([]string, 0),
+		Skipped:   make([]string, 0),
+		Errors:    make(map[string]string),
+	}
+}
+
+// AddError records a failed object
+func (r *ResourceSyncResult) AddError(name, errorMsg string) {
+	r.Errors[name] = errorMsg
+}
+
+// HasErrors returns true if any object failed to synchronize
+func (r *ResourceSyncResult) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+// IsEmpty returns true if nothing was imported, updated, skipped or in conflict
+func (r *ResourceSyncResult) IsEmpty() bool {
+	return len(r.Imported) == 0 && len(r.Updated) == 0 && len(r.Conflicts) == 0 &&
+		len(r.Skipped) == 0 && len(r.Errors) == 0
+}
@@ -17,6 +17,15 @@ const (
 	SyncOperationDelete SyncOperation = "Delete"
 )
 
+// Names of sgroups sync targets with a special meaning
+const (
+	// SyncTargetDefault - the sgroups instance configured in sync.sgroups
+	SyncTargetDefault = "default"
+
+	// SyncTargetNone - resources are not synchronized with sgroups
+	SyncTargetNone = "none"
+)
+
 // SyncSubjectType defines the type of entity being synchronized
 type SyncSubjectType string
 
@@ -2,10 +2,15 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"netguard-pg-backend/internal/sync/interfaces"
 )
 
@@ -34,6 +39,11 @@ func (re *retryExecutor) Execute(ctx context.Context, operation func() error) er
 
 		lastErr = err
 
+		// Permanent errors won't go away on retry
+		if !IsRetryable(err) {
+			return fmt.Errorf("operation failed with non-retryable error after %d attempts: %w", attempt+1, err)
+		}
+
 		// If this was the last attempt, don't wait
 		if attempt == re.config.MaxRetries {
 			break
@@ -64,7 +74,59 @@ func (re *retryExecutor) calculateDelay(attempt int) time.Duration {
 		delay = float64(re.config.MaxDelay)
 	}
 
-	return time.Duration(delay) * time.Millisecond
+	// Spread the delay in [delay*(1-jitter), delay*(1+jitter)]
+	if re.config.Jitter > 0 {
+		jitter := math.Min(re.config.Jitter, 1)
+		delay *= 1 + jitter*(2*rand.Float64()-1)
+	}
+
+	return time.Duration(delay * float64(time.Millisecond))
+}
+
+// IsRetryable reports whether a failed sync may succeed on retry.
+// gRPC errors caused by the request itself are permanent, everything else
+// (sgroups unavailability, timeouts, transport errors) is retried.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	// FromError also finds status errors wrapped by syncers
+	st, ok := status.FromError(err)
+	if !ok {
+		return true
+	}
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied,
+		codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange, codes.Unimplemented:
+		return false
+	default:
+		return true
+	}
+}
+
+// MergeRetryConfig returns override with zero fields taken from base
+func MergeRetryConfig(base, override interfaces.RetryConfig) interfaces.RetryConfig {
+	merged := override
+	if merged.MaxRetries == 0 {
+		merged.MaxRetries = base.MaxRetries
+	}
+	if merged.InitialDelay == 0 {
+		merged.InitialDelay = base.InitialDelay
+	}
+	if merged.MaxDelay == 0 {
+		merged.MaxDelay = base.MaxDelay
+	}
+	if merged.BackoffFactor == 0 {
+		merged.BackoffFactor = base.BackoffFactor
+	}
+	if merged.Jitter == 0 {
+		merged.Jitter = base.Jitter
+	}
+	return merged
 }
 
 // DefaultRetryConfig returns a default retry configuration
@@ -74,6 +136,7 @@ func DefaultRetryConfig() interfaces.RetryConfig {
 		InitialDelay:  100,  // 100ms
 		MaxDelay:      5000, // 5s
 		BackoffFactor: 2.0,  // Double the delay each time
+		Jitter:        0.2,  // ±20%
 	}
 }
 
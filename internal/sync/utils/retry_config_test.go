@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"netguard-pg-backend/internal/sync/interfaces"
+)
+
+func TestRetryExecutor_CalculateDelayJitter(t *testing.T) {
+	executor := NewRetryExecutor(interfaces.RetryConfig{
+		InitialDelay:  100,
+		MaxDelay:      1000,
+		BackoffFactor: 2,
+		Jitter:        0.5,
+	})
+
+	for i := 0; i < 100; i++ {
+		delay := executor.calculateDelay(1)
+		assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		assert.LessOrEqual(t, delay, 300*time.Millisecond)
+	}
+
+	executor.config.Jitter = 0
+	assert.Equal(t, 200*time.Millisecond, executor.calculateDelay(1))
+	assert.Equal(t, time.Second, executor.calculateDelay(10))
+}
+
+func TestExecuteWithRetry_StopsOnPermanentError(t *testing.T) {
+	config := interfaces.RetryConfig{MaxRetries: 3, InitialDelay: 1, MaxDelay: 1, BackoffFactor: 2}
+
+	attempts := 0
+	err := ExecuteWithRetry(context.Background(), config, func() error {
+		attempts++
+		return fmt.Errorf("sync failed: %w", status.Error(codes.InvalidArgument, "bad group"))
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+
+	attempts = 0
+	err = ExecuteWithRetry(context.Background(), config, func() error {
+		attempts++
+		if attempts < 3 {
+			return status.Error(codes.Unavailable, "sgroups is down")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(errors.New("connection refused")))
+	assert.True(t, IsRetryable(status.Error(codes.DeadlineExceeded, "timeout")))
+	assert.False(t, IsRetryable(status.Error(codes.PermissionDenied, "denied")))
+	assert.False(t, IsRetryable(context.Canceled))
+	assert.False(t, IsRetryable(nil))
+}
+
+func TestMergeRetryConfig(t *testing.T) {
+	base := DefaultRetryConfig()
+	merged := MergeRetryConfig(base, interfaces.RetryConfig{MaxRetries: 7})
+
+	assert.Equal(t, 7, merged.MaxRetries)
+	assert.Equal(t, base.InitialDelay, merged.InitialDelay)
+	assert.Equal(t, base.MaxDelay, merged.MaxDelay)
+	assert.Equal(t, base.BackoffFactor, merged.BackoffFactor)
+	assert.Equal(t, base.Jitter, merged.Jitter)
+}
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Persistent change feed used by the Watch RPC.
+-- Watchers can resume from any revision newer than change_log_state.compacted_revision.
+
+CREATE TABLE change_log (
+    revision BIGINT PRIMARY KEY,
+    kind TEXT NOT NULL,
+    sync_op SMALLINT NOT NULL,
+    namespace TEXT NOT NULL,
+    name TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Compaction deletes events by age
+CREATE INDEX idx_change_log_created_at ON change_log(created_at);
+
+-- Single-row table with the highest compacted revision
+CREATE TABLE change_log_state (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    compacted_revision BIGINT NOT NULL
+);
+
+COMMENT ON TABLE change_log IS 'Compacted log of committed resource changes for Watch resume';
+COMMENT ON TABLE change_log_state IS 'Change log compaction state';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS change_log_state;
+DROP TABLE IF EXISTS change_log;
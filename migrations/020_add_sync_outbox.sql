@@ -0,0 +1,24 @@
+-- +goose Up
+-- Outbox of pending sgroups sync operations.
+-- Entries are inserted in the same transaction as the resource change and
+-- removed by the dispatcher after successful delivery to sgroups.
+
+CREATE TABLE sync_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    kind TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The dispatcher claims due entries
+CREATE INDEX idx_sync_outbox_next_attempt_at ON sync_outbox(next_attempt_at);
+
+COMMENT ON TABLE sync_outbox IS 'Pending sgroups sync operations (transactional outbox)';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS sync_outbox;
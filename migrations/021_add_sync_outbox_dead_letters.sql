@@ -0,0 +1,21 @@
+-- +goose Up
+-- Dead-letter queue of sgroups sync operations.
+-- The dispatcher moves an outbox entry here after it exhausted delivery
+-- attempts. Entries are kept until an operator retries them.
+
+CREATE TABLE sync_outbox_dead_letters (
+    id BIGINT PRIMARY KEY,
+    kind TEXT NOT NULL,
+    operation TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    failed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE sync_outbox_dead_letters IS 'Permanently failed sgroups sync operations';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS sync_outbox_dead_letters;
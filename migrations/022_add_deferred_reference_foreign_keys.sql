@@ -0,0 +1,156 @@
+-- +goose Up
+-- Model resource references as deferred foreign keys so integrity violations are
+-- caught by the database, not only by application validation.
+--
+-- Constraints are DEFERRABLE INITIALLY DEFERRED and their existence checks run at
+-- commit: scoped syncs delete and re-insert rows and a single transaction may create
+-- referenced and referencing resources in any order. Deferred checks require NO
+-- ACTION, CASCADE actions are still applied immediately.
+--
+-- ON DELETE behavior follows the application cascade logic:
+--   * AddressGroupBinding -> Service: NO ACTION, services can't be deleted while
+--     bindings exist
+--   * AddressGroupBinding -> AddressGroup: CASCADE is kept, AddressGroup deletion
+--     removes its bindings
+--   * ServiceAlias -> Service: NO ACTION, services with aliases can't be deleted
+--   * RuleS2S -> Service, ServiceAlias (local and target): NO ACTION, services and
+--     aliases referenced by rules can't be deleted
+--   * IEAgAgRule -> AddressGroup: CASCADE is kept, IEAgAgRules are generated from
+--     RuleS2S and are not managed by users
+
+-- AddressGroupBinding -> Service
+ALTER TABLE address_group_bindings
+DROP CONSTRAINT address_group_bindings_service_namespace_service_name_fkey;
+
+ALTER TABLE address_group_bindings
+ADD CONSTRAINT address_group_bindings_service_namespace_service_name_fkey
+FOREIGN KEY (service_namespace, service_name)
+REFERENCES services(namespace, name) ON DELETE NO ACTION
+DEFERRABLE INITIALLY DEFERRED;
+
+-- AddressGroupBinding -> AddressGroup, the generated name of the initial constraint
+-- is truncated to the identifier length limit, so it is looked up
+-- +goose StatementBegin
+DO $$
+DECLARE
+    fk_name TEXT;
+BEGIN
+    SELECT con.conname INTO fk_name
+    FROM pg_constraint con
+    WHERE con.conrelid = 'address_group_bindings'::regclass
+      AND con.confrelid = 'address_groups'::regclass
+      AND con.contype = 'f';
+
+    IF fk_name IS NOT NULL THEN
+        EXECUTE format('ALTER TABLE address_group_bindings DROP CONSTRAINT %I', fk_name);
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+ALTER TABLE address_group_bindings
+ADD CONSTRAINT address_group_bindings_address_group_fkey
+FOREIGN KEY (address_group_namespace, address_group_name)
+REFERENCES address_groups(namespace, name) ON DELETE CASCADE
+DEFERRABLE INITIALLY DEFERRED;
+
+-- ServiceAlias -> Service
+ALTER TABLE service_aliases
+DROP CONSTRAINT service_aliases_service_namespace_service_name_fkey;
+
+ALTER TABLE service_aliases
+ADD CONSTRAINT service_aliases_service_namespace_service_name_fkey
+FOREIGN KEY (service_namespace, service_name)
+REFERENCES services(namespace, name) ON DELETE NO ACTION
+DEFERRABLE INITIALLY DEFERRED;
+
+-- RuleS2S -> Service, ServiceAlias: references are stored as JSONB (migration 007), key
+-- columns are generated from them. An empty reference namespace means the rule namespace.
+-- The name column of the referenced kind is set, the other one is NULL and its key isn't
+-- checked.
+ALTER TABLE rule_s2s
+ADD COLUMN service_local_namespace TEXT GENERATED ALWAYS AS
+    (COALESCE(NULLIF(service_local_ref->>'namespace', ''), namespace)) STORED,
+ADD COLUMN service_local_name TEXT GENERATED ALWAYS AS
+    (CASE WHEN service_local_ref->>'kind' = 'ServiceAlias' THEN NULL ELSE service_local_ref->>'name' END) STORED,
+ADD COLUMN service_local_alias_name TEXT GENERATED ALWAYS AS
+    (CASE WHEN service_local_ref->>'kind' = 'ServiceAlias' THEN service_local_ref->>'name' END) STORED,
+ADD COLUMN service_namespace TEXT GENERATED ALWAYS AS
+    (COALESCE(NULLIF(service_ref->>'namespace', ''), namespace)) STORED,
+ADD COLUMN service_name TEXT GENERATED ALWAYS AS
+    (CASE WHEN service_ref->>'kind' = 'ServiceAlias' THEN NULL ELSE service_ref->>'name' END) STORED,
+ADD COLUMN service_alias_name TEXT GENERATED ALWAYS AS
+    (CASE WHEN service_ref->>'kind' = 'ServiceAlias' THEN service_ref->>'name' END) STORED;
+
+-- Existing rules may reference already deleted services, the constraints are added
+-- NOT VALID so they apply to new and updated rules only. Run
+-- ALTER TABLE rule_s2s VALIDATE CONSTRAINT ... after dangling rules were removed.
+ALTER TABLE rule_s2s
+ADD CONSTRAINT rule_s2s_service_local_fkey
+FOREIGN KEY (service_local_namespace, service_local_name)
+REFERENCES services(namespace, name) ON DELETE NO ACTION
+DEFERRABLE INITIALLY DEFERRED NOT VALID;
+
+ALTER TABLE rule_s2s
+ADD CONSTRAINT rule_s2s_service_fkey
+FOREIGN KEY (service_namespace, service_name)
+REFERENCES services(namespace, name) ON DELETE NO ACTION
+DEFERRABLE INITIALLY DEFERRED NOT VALID;
+
+ALTER TABLE rule_s2s
+ADD CONSTRAINT rule_s2s_service_local_alias_fkey
+FOREIGN KEY (service_local_namespace, service_local_alias_name)
+REFERENCES service_aliases(namespace, name) ON DELETE NO ACTION
+DEFERRABLE INITIALLY DEFERRED NOT VALID;
+
+ALTER TABLE rule_s2s
+ADD CONSTRAINT rule_s2s_service_alias_fkey
+FOREIGN KEY (service_namespace, service_alias_name)
+REFERENCES service_aliases(namespace, name) ON DELETE NO ACTION
+DEFERRABLE INITIALLY DEFERRED NOT VALID;
+
+CREATE INDEX idx_rule_s2s_service_local ON rule_s2s (service_local_namespace, service_local_name);
+CREATE INDEX idx_rule_s2s_service ON rule_s2s (service_namespace, service_name);
+CREATE INDEX idx_rule_s2s_service_local_alias ON rule_s2s (service_local_namespace, service_local_alias_name);
+CREATE INDEX idx_rule_s2s_service_alias ON rule_s2s (service_namespace, service_alias_name);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_rule_s2s_service_alias;
+DROP INDEX IF EXISTS idx_rule_s2s_service_local_alias;
+DROP INDEX IF EXISTS idx_rule_s2s_service;
+DROP INDEX IF EXISTS idx_rule_s2s_service_local;
+
+ALTER TABLE rule_s2s DROP CONSTRAINT IF EXISTS rule_s2s_service_alias_fkey;
+ALTER TABLE rule_s2s DROP CONSTRAINT IF EXISTS rule_s2s_service_local_alias_fkey;
+ALTER TABLE rule_s2s DROP CONSTRAINT IF EXISTS rule_s2s_service_fkey;
+ALTER TABLE rule_s2s DROP CONSTRAINT IF EXISTS rule_s2s_service_local_fkey;
+
+ALTER TABLE rule_s2s
+DROP COLUMN service_local_namespace,
+DROP COLUMN service_local_name,
+DROP COLUMN service_local_alias_name,
+DROP COLUMN service_namespace,
+DROP COLUMN service_name,
+DROP COLUMN service_alias_name;
+
+ALTER TABLE service_aliases
+DROP CONSTRAINT service_aliases_service_namespace_service_name_fkey;
+
+ALTER TABLE service_aliases
+ADD CONSTRAINT service_aliases_service_namespace_service_name_fkey
+FOREIGN KEY (service_namespace, service_name)
+REFERENCES services(namespace, name) ON DELETE RESTRICT;
+
+ALTER TABLE address_group_bindings
+DROP CONSTRAINT address_group_bindings_address_group_fkey;
+
+ALTER TABLE address_group_bindings
+ADD FOREIGN KEY (address_group_namespace, address_group_name)
+REFERENCES address_groups(namespace, name) ON DELETE CASCADE;
+
+ALTER TABLE address_group_bindings
+DROP CONSTRAINT address_group_bindings_service_namespace_service_name_fkey;
+
+ALTER TABLE address_group_bindings
+ADD CONSTRAINT address_group_bindings_service_namespace_service_name_fkey
+FOREIGN KEY (service_namespace, service_name)
+REFERENCES services(namespace, name) ON DELETE RESTRICT;
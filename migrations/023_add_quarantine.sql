@@ -0,0 +1,28 @@
+-- +goose Up
+-- Quarantine of imported resources which failed validation.
+-- Importers (/v2/apply, reverse sync) store rejected resources here instead of
+-- dropping them. Entries are kept until an operator promotes or deletes them.
+
+CREATE TABLE quarantined_resources (
+    id BIGSERIAL PRIMARY KEY,
+    source TEXT NOT NULL,
+    kind TEXT NOT NULL DEFAULT '',
+    namespace TEXT NOT NULL DEFAULT '',
+    name TEXT NOT NULL DEFAULT '',
+    reasons TEXT[] NOT NULL DEFAULT '{}',
+    document TEXT NOT NULL,
+    occurrences INTEGER NOT NULL DEFAULT 1,
+    first_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A repeated import of a named resource updates its entry
+CREATE UNIQUE INDEX idx_quarantined_resources_resource
+    ON quarantined_resources(source, kind, namespace, name)
+    WHERE name <> '';
+
+COMMENT ON TABLE quarantined_resources IS 'Imported resources rejected by validation, kept for review';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS quarantined_resources;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Allow SCTP rules, sgroups doesn't enforce them but netguard keeps them
+
+ALTER DOMAIN transport_protocol DROP CONSTRAINT transport_protocol_check;
+ALTER DOMAIN transport_protocol ADD CONSTRAINT transport_protocol_check CHECK (VALUE IN ('TCP', 'UDP', 'SCTP', 'ICMP'));
+
+-- +goose Down
+
+ALTER DOMAIN transport_protocol DROP CONSTRAINT transport_protocol_check;
+ALTER DOMAIN transport_protocol ADD CONSTRAINT transport_protocol_check CHECK (VALUE IN ('TCP', 'UDP', 'ICMP'));
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Action of IEAgAg rules generated from RuleS2S, existing rules keep accepting traffic
+ALTER TABLE rule_s2s ADD COLUMN action rule_action NOT NULL DEFAULT 'ACCEPT';
+
+COMMENT ON COLUMN rule_s2s.action IS 'Action of the generated IEAgAg rules: ACCEPT or DROP';
+
+-- +goose Down
+ALTER TABLE rule_s2s DROP COLUMN IF EXISTS action;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Explicit priority of IEAgAg rules generated from RuleS2S, 0 leaves it to the priority strategy
+ALTER TABLE rule_s2s ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN rule_s2s.priority IS 'Priority of the generated IEAgAg rules, 0 - calculated by the priority strategy';
+
+-- +goose Down
+ALTER TABLE rule_s2s DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Per-rule port overrides: the service whose ports are opened and ports opened in addition to them
+ALTER TABLE rule_s2s ADD COLUMN ports_source TEXT NOT NULL DEFAULT ''
+    CHECK (ports_source IN ('', 'LOCAL', 'TARGET'));
+ALTER TABLE rule_s2s ADD COLUMN extra_ports JSONB NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN rule_s2s.ports_source IS 'Service whose ports are opened: LOCAL, TARGET, empty selects by traffic direction';
+COMMENT ON COLUMN rule_s2s.extra_ports IS 'IngressPort[] - ports opened in addition to the service ports';
+
+-- +goose Down
+ALTER TABLE rule_s2s DROP COLUMN IF EXISTS extra_ports;
+ALTER TABLE rule_s2s DROP COLUMN IF EXISTS ports_source;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Ports excluded from the IEAgAg rules aggregated between two address groups
+CREATE TABLE rule_s2s_exceptions (
+    namespace namespace_name NOT NULL,
+    name resource_name NOT NULL,
+    traffic traffic_direction NOT NULL,
+    address_group_local_namespace namespace_name NOT NULL,
+    address_group_local_name resource_name NOT NULL,
+    address_group_namespace namespace_name NOT NULL,
+    address_group_name resource_name NOT NULL,
+    transport transport_protocol NOT NULL,
+    ports TEXT NOT NULL DEFAULT '',
+    resource_version BIGINT NOT NULL REFERENCES k8s_metadata(resource_version) ON DELETE CASCADE,
+    PRIMARY KEY (namespace, name),
+    FOREIGN KEY (address_group_local_namespace, address_group_local_name) REFERENCES address_groups(namespace, name) ON DELETE CASCADE,
+    FOREIGN KEY (address_group_namespace, address_group_name) REFERENCES address_groups(namespace, name) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_rule_s2s_exceptions_address_groups ON rule_s2s_exceptions(address_group_local_namespace, address_group_local_name, address_group_namespace, address_group_name);
+
+COMMENT ON COLUMN rule_s2s_exceptions.ports IS 'Excluded ports or ICMP types, empty excludes all ports of the transport';
+
+-- +goose Down
+DROP TABLE IF EXISTS rule_s2s_exceptions;
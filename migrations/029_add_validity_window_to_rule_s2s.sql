@@ -0,0 +1,14 @@
+-- +goose Up
+-- Optional validity window: IEAgAg rules are generated only while the rule is active
+ALTER TABLE rule_s2s ADD COLUMN valid_from TIMESTAMPTZ;
+ALTER TABLE rule_s2s ADD COLUMN valid_until TIMESTAMPTZ;
+ALTER TABLE rule_s2s ADD CONSTRAINT rule_s2s_validity_window_check
+    CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_until > valid_from);
+
+COMMENT ON COLUMN rule_s2s.valid_from IS 'Start of the validity window, NULL - active since creation';
+COMMENT ON COLUMN rule_s2s.valid_until IS 'End of the validity window, NULL - never expires';
+
+-- +goose Down
+ALTER TABLE rule_s2s DROP CONSTRAINT IF EXISTS rule_s2s_validity_window_check;
+ALTER TABLE rule_s2s DROP COLUMN IF EXISTS valid_until;
+ALTER TABLE rule_s2s DROP COLUMN IF EXISTS valid_from;
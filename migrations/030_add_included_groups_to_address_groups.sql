@@ -0,0 +1,8 @@
+-- +goose Up
+-- AddressGroup hierarchies: child groups covered by rules targeting the group
+ALTER TABLE address_groups ADD COLUMN included_groups JSONB NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN address_groups.included_groups IS 'NamespacedObjectReference[] - child address groups included transitively into rule generation';
+
+-- +goose Down
+ALTER TABLE address_groups DROP COLUMN IF EXISTS included_groups;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Namespaces whose RuleS2S may reference Services of the policy namespace
+CREATE TABLE cross_namespace_policies (
+    namespace namespace_name NOT NULL,
+    name resource_name NOT NULL,
+    allowed_namespaces TEXT[] NOT NULL DEFAULT '{}',
+    resource_version BIGINT NOT NULL REFERENCES k8s_metadata(resource_version) ON DELETE CASCADE,
+    PRIMARY KEY (namespace, name)
+);
+
+CREATE INDEX idx_cross_namespace_policies_allowed_namespaces ON cross_namespace_policies USING GIN (allowed_namespaces);
+
+COMMENT ON COLUMN cross_namespace_policies.allowed_namespaces IS 'Namespaces of RuleS2S allowed to reference Services of the policy namespace';
+
+-- +goose Down
+DROP TABLE IF EXISTS cross_namespace_policies;
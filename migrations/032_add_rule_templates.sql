@@ -0,0 +1,20 @@
+-- +goose Up
+-- Templates expanding into RuleS2S between services selected by labels
+CREATE TABLE rule_templates (
+    namespace namespace_name NOT NULL,
+    name resource_name NOT NULL,
+    traffic traffic_direction NOT NULL,
+    local_service_selector JSONB NOT NULL DEFAULT '{}',
+    target_service_selector JSONB NOT NULL DEFAULT '{}',
+    action TEXT NOT NULL DEFAULT '',
+    trace BOOLEAN NOT NULL DEFAULT FALSE,
+    resource_version BIGINT NOT NULL REFERENCES k8s_metadata(resource_version) ON DELETE CASCADE,
+    PRIMARY KEY (namespace, name)
+);
+
+COMMENT ON COLUMN rule_templates.local_service_selector IS 'Labels of the local services of the generated RuleS2S';
+COMMENT ON COLUMN rule_templates.target_service_selector IS 'Labels of the target services of the generated RuleS2S';
+COMMENT ON COLUMN rule_templates.action IS 'Action of the generated RuleS2S, empty means ACCEPT';
+
+-- +goose Down
+DROP TABLE IF EXISTS rule_templates;
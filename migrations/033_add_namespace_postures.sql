@@ -0,0 +1,14 @@
+-- +goose Up
+-- Default traffic posture of a namespace
+CREATE TABLE namespace_postures (
+    namespace namespace_name NOT NULL,
+    name resource_name NOT NULL,
+    mode TEXT NOT NULL DEFAULT 'DefaultAllow' CHECK (mode IN ('DefaultAllow', 'DefaultDeny')),
+    resource_version BIGINT NOT NULL REFERENCES k8s_metadata(resource_version) ON DELETE CASCADE,
+    PRIMARY KEY (namespace, name)
+);
+
+COMMENT ON COLUMN namespace_postures.mode IS 'DefaultDeny adds baseline deny IEAgAg rules between all AddressGroups of the namespace';
+
+-- +goose Down
+DROP TABLE IF EXISTS namespace_postures;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Aggregation index of IEAgAg rules.
+-- Every row tells that a RuleS2S contributes ports to an aggregated IEAgAg rule,
+-- so only the aggregation groups of changed RuleS2S are recalculated.
+-- Rows are replaced by recalculation, there are no foreign keys: the index of a
+-- deleted RuleS2S is needed to find its aggregation groups after the deletion.
+
+CREATE TABLE ieagag_rule_contributions (
+    rule_s2s_namespace namespace_name NOT NULL,
+    rule_s2s_name resource_name NOT NULL,
+    ieagag_rule_namespace namespace_name NOT NULL,
+    ieagag_rule_name resource_name NOT NULL,
+    PRIMARY KEY (ieagag_rule_namespace, ieagag_rule_name, rule_s2s_namespace, rule_s2s_name)
+);
+
+-- Aggregation groups of a RuleS2S
+CREATE INDEX idx_ieagag_rule_contributions_rule_s2s ON ieagag_rule_contributions(rule_s2s_namespace, rule_s2s_name);
+
+COMMENT ON TABLE ieagag_rule_contributions IS 'RuleS2S contributing ports to aggregated IEAgAg rules';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS ieagag_rule_contributions;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Explainable aggregation of IEAgAg rules.
+-- Every contribution keeps the ports the RuleS2S contributes to the aggregated rule
+-- (before exception ports are subtracted), so the origin of every aggregated port is visible.
+-- The index is maintained in the transactions changing IEAgAg rules; the singleton
+-- ieagag_rule_contribution_index row tells that it was built from all RuleS2S and can be
+-- trusted after a restart without rebuilding.
+
+ALTER TABLE ieagag_rule_contributions ADD COLUMN ports TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE ieagag_rule_contribution_index (
+    id INTEGER PRIMARY KEY DEFAULT 1 CHECK (id = 1), -- Singleton pattern
+    built_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN ieagag_rule_contributions.ports IS 'Ports contributed by the RuleS2S before exceptions are subtracted';
+COMMENT ON TABLE ieagag_rule_contribution_index IS 'Build state of the IEAgAg rule aggregation index';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS ieagag_rule_contribution_index;
+ALTER TABLE ieagag_rule_contributions DROP COLUMN IF EXISTS ports;
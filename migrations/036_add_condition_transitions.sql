@@ -0,0 +1,30 @@
+-- +goose Up
+-- History of resource condition transitions (e.g. RuleS2S Ready True -> False).
+-- A row is recorded every time the status of a condition changes; rows older than
+-- the condition history horizon are compacted.
+
+CREATE TABLE condition_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    kind TEXT NOT NULL,
+    namespace TEXT NOT NULL,
+    name TEXT NOT NULL,
+    condition_type TEXT NOT NULL,
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL DEFAULT '',
+    transitioned_at TIMESTAMPTZ NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- History of a single resource
+CREATE INDEX idx_condition_transitions_resource ON condition_transitions(kind, namespace, name, id);
+
+-- Compaction deletes transitions by age
+CREATE INDEX idx_condition_transitions_recorded_at ON condition_transitions(recorded_at);
+
+COMMENT ON TABLE condition_transitions IS 'History of resource condition status transitions';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS condition_transitions;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Deletion intent of resources with finalizers. A resource with deletion_timestamp set
+-- is kept until its finalizers are removed; the backend removes netguard.sgroups.io/backend-sync
+-- together with the resource after cascade cleanup and sgroups deletion.
+
+ALTER TABLE k8s_metadata ADD COLUMN deletion_timestamp TIMESTAMPTZ;
+
+COMMENT ON COLUMN k8s_metadata.deletion_timestamp IS 'Time the deletion of the resource was requested, NULL unless deleting';
+
+-- +goose Down
+
+ALTER TABLE k8s_metadata DROP COLUMN IF EXISTS deletion_timestamp;
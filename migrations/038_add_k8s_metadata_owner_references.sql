@@ -0,0 +1,11 @@
+-- +goose Up
+-- Owner references of resources, e.g. the RuleS2S and AddressGroups of generated IEAgAgRules.
+-- Kubernetes GC deletes a dependent once all of its owners are gone.
+
+ALTER TABLE k8s_metadata ADD COLUMN owner_references JSONB NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN k8s_metadata.owner_references IS 'Kubernetes owner references of the resource';
+
+-- +goose Down
+
+ALTER TABLE k8s_metadata DROP COLUMN IF EXISTS owner_references;
@@ -0,0 +1,190 @@
+-- +goose Up
+-- Hash partitioning of the largest tables of big clusters by namespace.
+--
+-- ie_ag_ag_rules grows with the RuleS2S of all namespaces and condition_transitions with
+-- every condition change. Both are read by namespace: scoped lists, syncs and deletes of
+-- IEAgAgRules and the history of a resource. With 16 hash partitions per table queries
+-- with a namespace predicate (namespace = $1, namespace = ANY($1)) only scan the
+-- partitions of their namespaces, and vacuum and index maintenance work per partition.
+--
+-- Primary keys of partitioned tables must contain the partition key: ie_ag_ag_rules is
+-- already keyed by (namespace, name), condition_transitions becomes keyed by (id, namespace).
+-- Rows are copied into the new tables, the migration takes ACCESS EXCLUSIVE locks on both
+-- tables and should be scheduled like other rewrites (see the migration dry run).
+
+-- IEAgAgRules
+
+ALTER TABLE ie_ag_ag_rules RENAME TO ie_ag_ag_rules_unpartitioned;
+ALTER TABLE ie_ag_ag_rules_unpartitioned RENAME CONSTRAINT ie_ag_ag_rules_pkey TO ie_ag_ag_rules_unpartitioned_pkey;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_local;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_target;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_ports;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_trace;
+
+CREATE TABLE ie_ag_ag_rules (
+    namespace namespace_name NOT NULL,
+    name resource_name NOT NULL,
+    transport transport_protocol NOT NULL,
+    traffic traffic_direction NOT NULL,
+    action rule_action NOT NULL,
+    address_group_local_namespace namespace_name NOT NULL,
+    address_group_local_name resource_name NOT NULL,
+    address_group_namespace namespace_name NOT NULL,
+    address_group_name resource_name NOT NULL,
+    ports JSONB DEFAULT '[]', -- PortSpec[]
+    resource_version BIGINT NOT NULL REFERENCES k8s_metadata(resource_version) ON DELETE CASCADE,
+    trace BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (namespace, name),
+    FOREIGN KEY (address_group_local_namespace, address_group_local_name) REFERENCES address_groups(namespace, name) ON DELETE CASCADE,
+    FOREIGN KEY (address_group_namespace, address_group_name) REFERENCES address_groups(namespace, name) ON DELETE CASCADE
+) PARTITION BY HASH (namespace);
+
+-- +goose StatementBegin
+DO $$
+BEGIN
+    FOR i IN 0..15 LOOP
+        EXECUTE format('CREATE TABLE ie_ag_ag_rules_p%s PARTITION OF ie_ag_ag_rules FOR VALUES WITH (MODULUS 16, REMAINDER %s)', i, i);
+    END LOOP;
+END $$;
+-- +goose StatementEnd
+
+INSERT INTO ie_ag_ag_rules (namespace, name, transport, traffic, action,
+                            address_group_local_namespace, address_group_local_name,
+                            address_group_namespace, address_group_name, ports, resource_version, trace)
+SELECT namespace, name, transport, traffic, action,
+       address_group_local_namespace, address_group_local_name,
+       address_group_namespace, address_group_name, ports, resource_version, trace
+FROM ie_ag_ag_rules_unpartitioned;
+
+DROP TABLE ie_ag_ag_rules_unpartitioned;
+
+CREATE INDEX idx_ie_ag_ag_rules_local ON ie_ag_ag_rules(address_group_local_namespace, address_group_local_name);
+CREATE INDEX idx_ie_ag_ag_rules_target ON ie_ag_ag_rules(address_group_namespace, address_group_name);
+CREATE INDEX idx_ie_ag_ag_rules_ports ON ie_ag_ag_rules USING GIN(ports);
+CREATE INDEX idx_ie_ag_ag_rules_trace ON ie_ag_ag_rules(trace);
+
+COMMENT ON TABLE ie_ag_ag_rules IS 'IEAgAgRules hash partitioned by namespace';
+
+-- Condition transitions
+
+ALTER TABLE condition_transitions RENAME TO condition_transitions_unpartitioned;
+ALTER TABLE condition_transitions_unpartitioned RENAME CONSTRAINT condition_transitions_pkey TO condition_transitions_unpartitioned_pkey;
+DROP INDEX IF EXISTS idx_condition_transitions_resource;
+DROP INDEX IF EXISTS idx_condition_transitions_recorded_at;
+
+-- The id sequence is kept, transitions recorded after the migration continue its ids
+CREATE TABLE condition_transitions (
+    id BIGINT NOT NULL DEFAULT nextval('condition_transitions_id_seq'),
+    kind TEXT NOT NULL,
+    namespace TEXT NOT NULL,
+    name TEXT NOT NULL,
+    condition_type TEXT NOT NULL,
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL DEFAULT '',
+    transitioned_at TIMESTAMPTZ NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, namespace)
+) PARTITION BY HASH (namespace);
+
+-- +goose StatementBegin
+DO $$
+BEGIN
+    FOR i IN 0..15 LOOP
+        EXECUTE format('CREATE TABLE condition_transitions_p%s PARTITION OF condition_transitions FOR VALUES WITH (MODULUS 16, REMAINDER %s)', i, i);
+    END LOOP;
+END $$;
+-- +goose StatementEnd
+
+INSERT INTO condition_transitions (id, kind, namespace, name, condition_type, from_status, to_status,
+                                   reason, message, transitioned_at, recorded_at)
+SELECT id, kind, namespace, name, condition_type, from_status, to_status,
+       reason, message, transitioned_at, recorded_at
+FROM condition_transitions_unpartitioned;
+
+ALTER SEQUENCE condition_transitions_id_seq OWNED BY condition_transitions.id;
+DROP TABLE condition_transitions_unpartitioned;
+
+CREATE INDEX idx_condition_transitions_resource ON condition_transitions(kind, namespace, name, id);
+CREATE INDEX idx_condition_transitions_recorded_at ON condition_transitions(recorded_at);
+
+COMMENT ON TABLE condition_transitions IS 'History of resource condition status transitions, hash partitioned by namespace';
+
+-- +goose Down
+
+-- Condition transitions
+
+ALTER TABLE condition_transitions RENAME TO condition_transitions_partitioned;
+ALTER TABLE condition_transitions_partitioned RENAME CONSTRAINT condition_transitions_pkey TO condition_transitions_partitioned_pkey;
+DROP INDEX IF EXISTS idx_condition_transitions_resource;
+DROP INDEX IF EXISTS idx_condition_transitions_recorded_at;
+
+CREATE TABLE condition_transitions (
+    id BIGINT PRIMARY KEY DEFAULT nextval('condition_transitions_id_seq'),
+    kind TEXT NOT NULL,
+    namespace TEXT NOT NULL,
+    name TEXT NOT NULL,
+    condition_type TEXT NOT NULL,
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL DEFAULT '',
+    transitioned_at TIMESTAMPTZ NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO condition_transitions
+SELECT id, kind, namespace, name, condition_type, from_status, to_status,
+       reason, message, transitioned_at, recorded_at
+FROM condition_transitions_partitioned;
+
+ALTER SEQUENCE condition_transitions_id_seq OWNED BY condition_transitions.id;
+DROP TABLE condition_transitions_partitioned;
+
+CREATE INDEX idx_condition_transitions_resource ON condition_transitions(kind, namespace, name, id);
+CREATE INDEX idx_condition_transitions_recorded_at ON condition_transitions(recorded_at);
+
+COMMENT ON TABLE condition_transitions IS 'History of resource condition status transitions';
+
+-- IEAgAgRules
+
+ALTER TABLE ie_ag_ag_rules RENAME TO ie_ag_ag_rules_partitioned;
+ALTER TABLE ie_ag_ag_rules_partitioned RENAME CONSTRAINT ie_ag_ag_rules_pkey TO ie_ag_ag_rules_partitioned_pkey;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_local;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_target;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_ports;
+DROP INDEX IF EXISTS idx_ie_ag_ag_rules_trace;
+
+CREATE TABLE ie_ag_ag_rules (
+    namespace namespace_name NOT NULL,
+    name resource_name NOT NULL,
+    transport transport_protocol NOT NULL,
+    traffic traffic_direction NOT NULL,
+    action rule_action NOT NULL,
+    address_group_local_namespace namespace_name NOT NULL,
+    address_group_local_name resource_name NOT NULL,
+    address_group_namespace namespace_name NOT NULL,
+    address_group_name resource_name NOT NULL,
+    ports JSONB DEFAULT '[]', -- PortSpec[]
+    resource_version BIGINT NOT NULL REFERENCES k8s_metadata(resource_version) ON DELETE CASCADE,
+    trace BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (namespace, name),
+    FOREIGN KEY (address_group_local_namespace, address_group_local_name) REFERENCES address_groups(namespace, name) ON DELETE CASCADE,
+    FOREIGN KEY (address_group_namespace, address_group_name) REFERENCES address_groups(namespace, name) ON DELETE CASCADE
+);
+
+INSERT INTO ie_ag_ag_rules
+SELECT namespace, name, transport, traffic, action,
+       address_group_local_namespace, address_group_local_name,
+       address_group_namespace, address_group_name, ports, resource_version, trace
+FROM ie_ag_ag_rules_partitioned;
+
+DROP TABLE ie_ag_ag_rules_partitioned;
+
+CREATE INDEX idx_ie_ag_ag_rules_local ON ie_ag_ag_rules(address_group_local_namespace, address_group_local_name);
+CREATE INDEX idx_ie_ag_ag_rules_target ON ie_ag_ag_rules(address_group_namespace, address_group_name);
+CREATE INDEX idx_ie_ag_ag_rules_ports ON ie_ag_ag_rules USING GIN(ports);
+CREATE INDEX idx_ie_ag_ag_rules_trace ON ie_ag_ag_rules(trace);
+
+COMMENT ON TABLE ie_ag_ag_rules IS NULL;
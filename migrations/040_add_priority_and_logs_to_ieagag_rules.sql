@@ -0,0 +1,14 @@
+-- +goose Up
+-- Priority and logging of generated IEAgAg rules. Rules generated before the migration get
+-- the default priority of their action, so DROP rules keep being ordered above ACCEPT rules.
+ALTER TABLE ie_ag_ag_rules ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ie_ag_ag_rules ADD COLUMN logs BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE ie_ag_ag_rules SET priority = CASE action WHEN 'DROP' THEN 50 ELSE 100 END;
+
+COMMENT ON COLUMN ie_ag_ag_rules.priority IS 'Priority of the rule in sgroups, lower is evaluated first, 0 - sgroups default';
+COMMENT ON COLUMN ie_ag_ag_rules.logs IS 'Logging of traffic matched by the rule';
+
+-- +goose Down
+ALTER TABLE ie_ag_ag_rules DROP COLUMN IF EXISTS logs;
+ALTER TABLE ie_ag_ag_rules DROP COLUMN IF EXISTS priority;
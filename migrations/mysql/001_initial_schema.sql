@@ -0,0 +1,222 @@
+-- +goose Up
+-- Initial schema of the MySQL/MariaDB registry
+-- Every resource kind has its own table keyed by (namespace, name). The resource itself is
+-- stored gob-encoded in data, columns next to it are copies of the fields the registry
+-- filters and joins on. uid and created_at survive full syncs of the resource.
+
+-- Service
+CREATE TABLE services (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- AddressGroup
+CREATE TABLE address_groups (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- AddressGroupBinding
+CREATE TABLE address_group_bindings (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    service_namespace VARCHAR(253) NOT NULL,
+    service_name VARCHAR(253) NOT NULL,
+    address_group_namespace VARCHAR(253) NOT NULL,
+    address_group_name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    INDEX idx_address_group_bindings_service (service_namespace, service_name),
+    INDEX idx_address_group_bindings_address_group (address_group_namespace, address_group_name),
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- AddressGroupPortMapping
+CREATE TABLE address_group_port_mappings (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- RuleS2S
+CREATE TABLE rule_s2s (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- ServiceAlias
+CREATE TABLE service_aliases (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- AddressGroupBindingPolicy
+CREATE TABLE address_group_binding_policies (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- IEAgAgRule
+CREATE TABLE ie_ag_ag_rules (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Network
+CREATE TABLE networks (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    cidr VARCHAR(64) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    INDEX idx_networks_cidr (cidr),
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- NetworkBinding
+CREATE TABLE network_bindings (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Host
+CREATE TABLE hosts (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uuid VARCHAR(64) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- HostBinding
+CREATE TABLE host_bindings (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    host_namespace VARCHAR(253) NOT NULL,
+    host_name VARCHAR(253) NOT NULL,
+    address_group_namespace VARCHAR(253) NOT NULL,
+    address_group_name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    INDEX idx_host_bindings_host (host_namespace, host_name),
+    INDEX idx_host_bindings_address_group (address_group_namespace, address_group_name),
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- RuleS2SException
+CREATE TABLE rule_s2s_exceptions (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- CrossNamespacePolicy
+CREATE TABLE cross_namespace_policies (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- RuleTemplate
+CREATE TABLE rule_templates (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- NamespacePosture
+CREATE TABLE namespace_postures (
+    namespace VARCHAR(253) NOT NULL,
+    name VARCHAR(253) NOT NULL,
+    uid VARCHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    data LONGBLOB NOT NULL,
+    PRIMARY KEY (namespace, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- Time of the last committed change, a single row with id 1
+CREATE TABLE sync_status (
+    id TINYINT NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- +goose Down
+DROP TABLE IF EXISTS sync_status;
+DROP TABLE IF EXISTS namespace_postures;
+DROP TABLE IF EXISTS rule_templates;
+DROP TABLE IF EXISTS cross_namespace_policies;
+DROP TABLE IF EXISTS rule_s2s_exceptions;
+DROP TABLE IF EXISTS host_bindings;
+DROP TABLE IF EXISTS hosts;
+DROP TABLE IF EXISTS network_bindings;
+DROP TABLE IF EXISTS networks;
+DROP TABLE IF EXISTS ie_ag_ag_rules;
+DROP TABLE IF EXISTS address_group_binding_policies;
+DROP TABLE IF EXISTS service_aliases;
+DROP TABLE IF EXISTS rule_s2s;
+DROP TABLE IF EXISTS address_group_port_mappings;
+DROP TABLE IF EXISTS address_group_bindings;
+DROP TABLE IF EXISTS address_groups;
+DROP TABLE IF EXISTS services;
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	genericserver "k8s.io/apiserver/pkg/server"
+)
+
+// FlowControlOptions configures how many requests the server handles concurrently.
+// With priority and fairness enabled (--enable-priority-and-fairness) both limits add up to the
+// concurrency shared by the priority levels of the cluster, and the FlowSchemas of the cluster
+// queue requests fairly per user (see config/k8s/base/flowcontrol.yaml). Otherwise the limits cap
+// readonly and mutating requests in flight separately.
+type FlowControlOptions struct {
+	MaxRequestsInFlight         int
+	MaxMutatingRequestsInFlight int
+}
+
+// NewFlowControlOptions returns the limits kube-apiserver uses by default
+func NewFlowControlOptions() *FlowControlOptions {
+	return &FlowControlOptions{
+		MaxRequestsInFlight:         400,
+		MaxMutatingRequestsInFlight: 200,
+	}
+}
+
+// AddFlags adds the flags of the limits to fs
+func (o *FlowControlOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&o.MaxRequestsInFlight, "max-requests-inflight", o.MaxRequestsInFlight,
+		"The maximum number of non-mutating requests in flight at a given time. When the server exceeds this, "+
+			"it rejects requests. Zero for no limit. With priority and fairness it is part of the total concurrency limit.")
+	fs.IntVar(&o.MaxMutatingRequestsInFlight, "max-mutating-requests-inflight", o.MaxMutatingRequestsInFlight,
+		"The maximum number of mutating requests in flight at a given time. When the server exceeds this, "+
+			"it rejects requests. Zero for no limit. With priority and fairness it is part of the total concurrency limit.")
+}
+
+// Validate checks the limits
+func (o *FlowControlOptions) Validate() []error {
+	var errs []error
+	if o.MaxRequestsInFlight < 0 {
+		errs = append(errs, fmt.Errorf("--max-requests-inflight can not be negative value"))
+	}
+	if o.MaxMutatingRequestsInFlight < 0 {
+		errs = append(errs, fmt.Errorf("--max-mutating-requests-inflight can not be negative value"))
+	}
+	return errs
+}
+
+// ApplyTo sets the limits of config. It must run before the recommended options are applied,
+// they size the priority and fairness dispatcher by the limits.
+func (o *FlowControlOptions) ApplyTo(config *genericserver.RecommendedConfig) error {
+	config.MaxRequestsInFlight = o.MaxRequestsInFlight
+	config.MaxMutatingRequestsInFlight = o.MaxMutatingRequestsInFlight
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apiserver/pkg/server/options"
 	"k8s.io/klog/v2"
 
@@ -27,13 +28,22 @@ func NewCommandStartNetguardServer(ctx context.Context, out, errOut io.Writer) *
 	opts.Authentication.RemoteKubeConfigFileOptional = true
 	opts.Authorization.RemoteKubeConfigFileOptional = true
 
+	// Limit concurrent requests so a runaway client cannot starve the others
+	flowControl := NewFlowControlOptions()
+
 	cmd := &cobra.Command{
 		Use:   "netguard-apiserver",
 		Short: "Launch a netguard API server",
 		RunE: func(c *cobra.Command, args []string) error {
 			klog.Info("🚀 Starting Netguard API server with correct pattern...")
 
-			server, err := apiserver.NewServer(opts)
+			if errs := flowControl.Validate(); len(errs) > 0 {
+				return utilerrors.NewAggregate(errs)
+			}
+			klog.Infof("🚦 Request limits: readonly=%d mutating=%d priority-and-fairness=%t",
+				flowControl.MaxRequestsInFlight, flowControl.MaxMutatingRequestsInFlight, opts.Features.EnablePriorityAndFairness)
+
+			server, err := apiserver.NewServer(opts, flowControl.ApplyTo)
 			if err != nil {
 				return fmt.Errorf("failed to create server: %v", err)
 			}
@@ -44,6 +54,7 @@ func NewCommandStartNetguardServer(ctx context.Context, out, errOut io.Writer) *
 
 	// Add flags from recommended options
 	opts.AddFlags(cmd.Flags())
+	flowControl.AddFlags(cmd.Flags())
 	// Make Go standard flags (including klog) available to the command so users can use -v, --v etc.
 	cmd.Flags().AddGoFlagSet(flag.CommandLine)
 
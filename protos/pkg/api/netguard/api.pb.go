@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -164,6 +165,103 @@ func (AddressGroupRegistrationSource) EnumDescriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{2}
 }
 
+// RuleS2SPortsSource selects the service whose ports a RuleS2S opens
+type RuleS2SPortsSource int32
+
+const (
+	RuleS2SPortsSource_PORTS_SOURCE_AUTO   RuleS2SPortsSource = 0 // By traffic direction: local service for Ingress, target service for Egress
+	RuleS2SPortsSource_PORTS_SOURCE_LOCAL  RuleS2SPortsSource = 1 // Ports of the local service
+	RuleS2SPortsSource_PORTS_SOURCE_TARGET RuleS2SPortsSource = 2 // Ports of the target service
+)
+
+// Enum value maps for RuleS2SPortsSource.
+var (
+	RuleS2SPortsSource_name = map[int32]string{
+		0: "PORTS_SOURCE_AUTO",
+		1: "PORTS_SOURCE_LOCAL",
+		2: "PORTS_SOURCE_TARGET",
+	}
+	RuleS2SPortsSource_value = map[string]int32{
+		"PORTS_SOURCE_AUTO":   0,
+		"PORTS_SOURCE_LOCAL":  1,
+		"PORTS_SOURCE_TARGET": 2,
+	}
+)
+
+func (x RuleS2SPortsSource) Enum() *RuleS2SPortsSource {
+	p := new(RuleS2SPortsSource)
+	*p = x
+	return p
+}
+
+func (x RuleS2SPortsSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleS2SPortsSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_netguard_api_proto_enumTypes[3].Descriptor()
+}
+
+func (RuleS2SPortsSource) Type() protoreflect.EnumType {
+	return &file_netguard_api_proto_enumTypes[3]
+}
+
+func (x RuleS2SPortsSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleS2SPortsSource.Descriptor instead.
+func (RuleS2SPortsSource) EnumDescriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{3}
+}
+
+// NamespacePostureMode - default traffic posture of a namespace
+type NamespacePostureMode int32
+
+const (
+	NamespacePostureMode_POSTURE_DEFAULT_ALLOW NamespacePostureMode = 0 // Only rules generated from RuleS2S
+	NamespacePostureMode_POSTURE_DEFAULT_DENY  NamespacePostureMode = 1 // Baseline deny rules between all AddressGroups of the namespace
+)
+
+// Enum value maps for NamespacePostureMode.
+var (
+	NamespacePostureMode_name = map[int32]string{
+		0: "POSTURE_DEFAULT_ALLOW",
+		1: "POSTURE_DEFAULT_DENY",
+	}
+	NamespacePostureMode_value = map[string]int32{
+		"POSTURE_DEFAULT_ALLOW": 0,
+		"POSTURE_DEFAULT_DENY":  1,
+	}
+)
+
+func (x NamespacePostureMode) Enum() *NamespacePostureMode {
+	p := new(NamespacePostureMode)
+	*p = x
+	return p
+}
+
+func (x NamespacePostureMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NamespacePostureMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_netguard_api_proto_enumTypes[4].Descriptor()
+}
+
+func (NamespacePostureMode) Type() protoreflect.EnumType {
+	return &file_netguard_api_proto_enumTypes[4]
+}
+
+func (x NamespacePostureMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NamespacePostureMode.Descriptor instead.
+func (NamespacePostureMode) EnumDescriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{4}
+}
+
 // RuleAction - action for rules and address groups
 type RuleAction int32
 
@@ -201,11 +299,11 @@ func (x RuleAction) String() string {
 }
 
 func (RuleAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_netguard_api_proto_enumTypes[3].Descriptor()
+	return file_netguard_api_proto_enumTypes[5].Descriptor()
 }
 
 func (RuleAction) Type() protoreflect.EnumType {
-	return &file_netguard_api_proto_enumTypes[3]
+	return &file_netguard_api_proto_enumTypes[5]
 }
 
 func (x RuleAction) Number() protoreflect.EnumNumber {
@@ -214,7 +312,7 @@ func (x RuleAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuleAction.Descriptor instead.
 func (RuleAction) EnumDescriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{3}
+	return file_netguard_api_proto_rawDescGZIP(), []int{5}
 }
 
 // SyncOp - sync operation type
@@ -258,11 +356,11 @@ func (x SyncOp) String() string {
 }
 
 func (SyncOp) Descriptor() protoreflect.EnumDescriptor {
-	return file_netguard_api_proto_enumTypes[4].Descriptor()
+	return file_netguard_api_proto_enumTypes[6].Descriptor()
 }
 
 func (SyncOp) Type() protoreflect.EnumType {
-	return &file_netguard_api_proto_enumTypes[4]
+	return &file_netguard_api_proto_enumTypes[6]
 }
 
 func (x SyncOp) Number() protoreflect.EnumNumber {
@@ -271,14 +369,16 @@ func (x SyncOp) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SyncOp.Descriptor instead.
 func (SyncOp) EnumDescriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{4}
+	return file_netguard_api_proto_rawDescGZIP(), []int{6}
 }
 
 type Networks_NetIP_Transport int32
 
 const (
-	Networks_NetIP_TCP Networks_NetIP_Transport = 0
-	Networks_NetIP_UDP Networks_NetIP_Transport = 1
+	Networks_NetIP_TCP  Networks_NetIP_Transport = 0
+	Networks_NetIP_UDP  Networks_NetIP_Transport = 1
+	Networks_NetIP_SCTP Networks_NetIP_Transport = 2
+	Networks_NetIP_ICMP Networks_NetIP_Transport = 3
 )
 
 // Enum value maps for Networks_NetIP_Transport.
@@ -286,10 +386,14 @@ var (
 	Networks_NetIP_Transport_name = map[int32]string{
 		0: "TCP",
 		1: "UDP",
+		2: "SCTP",
+		3: "ICMP",
 	}
 	Networks_NetIP_Transport_value = map[string]int32{
-		"TCP": 0,
-		"UDP": 1,
+		"TCP":  0,
+		"UDP":  1,
+		"SCTP": 2,
+		"ICMP": 3,
 	}
 )
 
@@ -304,11 +408,11 @@ func (x Networks_NetIP_Transport) String() string {
 }
 
 func (Networks_NetIP_Transport) Descriptor() protoreflect.EnumDescriptor {
-	return file_netguard_api_proto_enumTypes[5].Descriptor()
+	return file_netguard_api_proto_enumTypes[7].Descriptor()
 }
 
 func (Networks_NetIP_Transport) Type() protoreflect.EnumType {
-	return &file_netguard_api_proto_enumTypes[5]
+	return &file_netguard_api_proto_enumTypes[7]
 }
 
 func (x Networks_NetIP_Transport) Number() protoreflect.EnumNumber {
@@ -556,6 +660,59 @@ func (x *ResourceIdentifier) GetNamespace() string {
 	return ""
 }
 
+// ListSelector - label and field selectors of a list request in the Kubernetes selector syntax
+type ListSelector struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LabelSelector string                 `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"` // e.g. "app=web,tier!=db", all resources when empty
+	FieldSelector string                 `protobuf:"bytes,2,opt,name=field_selector,json=fieldSelector,proto3" json:"field_selector,omitempty"` // e.g. "spec.traffic=INGRESS", all resources when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSelector) Reset() {
+	*x = ListSelector{}
+	mi := &file_netguard_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSelector) ProtoMessage() {}
+
+func (x *ListSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSelector.ProtoReflect.Descriptor instead.
+func (*ListSelector) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSelector) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *ListSelector) GetFieldSelector() string {
+	if x != nil {
+		return x.FieldSelector
+	}
+	return ""
+}
+
 // ObjectReference contains enough information to let you locate the referenced object
 type ObjectReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -571,7 +728,7 @@ type ObjectReference struct {
 
 func (x *ObjectReference) Reset() {
 	*x = ObjectReference{}
-	mi := &file_netguard_api_proto_msgTypes[4]
+	mi := &file_netguard_api_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -583,7 +740,7 @@ func (x *ObjectReference) String() string {
 func (*ObjectReference) ProtoMessage() {}
 
 func (x *ObjectReference) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[4]
+	mi := &file_netguard_api_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +753,7 @@ func (x *ObjectReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectReference.ProtoReflect.Descriptor instead.
 func (*ObjectReference) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{4}
+	return file_netguard_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ObjectReference) GetApiVersion() string {
@@ -635,7 +792,7 @@ type HostReference struct {
 
 func (x *HostReference) Reset() {
 	*x = HostReference{}
-	mi := &file_netguard_api_proto_msgTypes[5]
+	mi := &file_netguard_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +804,7 @@ func (x *HostReference) String() string {
 func (*HostReference) ProtoMessage() {}
 
 func (x *HostReference) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[5]
+	mi := &file_netguard_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +817,7 @@ func (x *HostReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostReference.ProtoReflect.Descriptor instead.
 func (*HostReference) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{5}
+	return file_netguard_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HostReference) GetRef() *ObjectReference {
@@ -697,7 +854,7 @@ type AddressGroupReference struct {
 
 func (x *AddressGroupReference) Reset() {
 	*x = AddressGroupReference{}
-	mi := &file_netguard_api_proto_msgTypes[6]
+	mi := &file_netguard_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -709,7 +866,7 @@ func (x *AddressGroupReference) String() string {
 func (*AddressGroupReference) ProtoMessage() {}
 
 func (x *AddressGroupReference) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[6]
+	mi := &file_netguard_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +879,7 @@ func (x *AddressGroupReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressGroupReference.ProtoReflect.Descriptor instead.
 func (*AddressGroupReference) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{6}
+	return file_netguard_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AddressGroupReference) GetRef() *NamespacedObjectReference {
@@ -756,7 +913,7 @@ type NamespacedObjectReference struct {
 
 func (x *NamespacedObjectReference) Reset() {
 	*x = NamespacedObjectReference{}
-	mi := &file_netguard_api_proto_msgTypes[7]
+	mi := &file_netguard_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -768,7 +925,7 @@ func (x *NamespacedObjectReference) String() string {
 func (*NamespacedObjectReference) ProtoMessage() {}
 
 func (x *NamespacedObjectReference) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[7]
+	mi := &file_netguard_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +938,7 @@ func (x *NamespacedObjectReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespacedObjectReference.ProtoReflect.Descriptor instead.
 func (*NamespacedObjectReference) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{7}
+	return file_netguard_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *NamespacedObjectReference) GetApiVersion() string {
@@ -827,7 +984,7 @@ type Condition struct {
 
 func (x *Condition) Reset() {
 	*x = Condition{}
-	mi := &file_netguard_api_proto_msgTypes[8]
+	mi := &file_netguard_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +996,7 @@ func (x *Condition) String() string {
 func (*Condition) ProtoMessage() {}
 
 func (x *Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[8]
+	mi := &file_netguard_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1009,7 @@ func (x *Condition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Condition.ProtoReflect.Descriptor instead.
 func (*Condition) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{8}
+	return file_netguard_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Condition) GetType() string {
@@ -897,6 +1054,92 @@ func (x *Condition) GetMessage() string {
 	return ""
 }
 
+// OwnerReference identifies a resource the object depends on
+// Compatible with k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference
+type OwnerReference struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion         string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind               string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Uid                string                 `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Controller         bool                   `protobuf:"varint,5,opt,name=controller,proto3" json:"controller,omitempty"`
+	BlockOwnerDeletion bool                   `protobuf:"varint,6,opt,name=block_owner_deletion,json=blockOwnerDeletion,proto3" json:"block_owner_deletion,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OwnerReference) Reset() {
+	*x = OwnerReference{}
+	mi := &file_netguard_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerReference) ProtoMessage() {}
+
+func (x *OwnerReference) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerReference.ProtoReflect.Descriptor instead.
+func (*OwnerReference) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OwnerReference) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetController() bool {
+	if x != nil {
+		return x.Controller
+	}
+	return false
+}
+
+func (x *OwnerReference) GetBlockOwnerDeletion() bool {
+	if x != nil {
+		return x.BlockOwnerDeletion
+	}
+	return false
+}
+
 // ManagedFieldsEntry represents a single entry in the managedFields list
 // Compatible with k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry
 type ManagedFieldsEntry struct {
@@ -923,7 +1166,7 @@ type ManagedFieldsEntry struct {
 
 func (x *ManagedFieldsEntry) Reset() {
 	*x = ManagedFieldsEntry{}
-	mi := &file_netguard_api_proto_msgTypes[9]
+	mi := &file_netguard_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -935,7 +1178,7 @@ func (x *ManagedFieldsEntry) String() string {
 func (*ManagedFieldsEntry) ProtoMessage() {}
 
 func (x *ManagedFieldsEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[9]
+	mi := &file_netguard_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,7 +1191,7 @@ func (x *ManagedFieldsEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManagedFieldsEntry.ProtoReflect.Descriptor instead.
 func (*ManagedFieldsEntry) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{9}
+	return file_netguard_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ManagedFieldsEntry) GetManager() string {
@@ -1018,13 +1261,19 @@ type Meta struct {
 	// ManagedFields stores Server-Side Apply field ownership information
 	// Compatible with k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry
 	ManagedFields []*ManagedFieldsEntry `protobuf:"bytes,10,rep,name=managed_fields,json=managedFields,proto3" json:"managed_fields,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Finalizers must be removed before the resource is deleted
+	Finalizers []string `protobuf:"bytes,11,rep,name=finalizers,proto3" json:"finalizers,omitempty"`
+	// DeletionTs - deletion intent of a resource with finalizers
+	DeletionTs *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deletion_ts,json=deletionTs,proto3" json:"deletion_ts,omitempty"`
+	// OwnerReferences - resources the object depends on, e.g. the RuleS2S of a generated IEAgAgRule
+	OwnerReferences []*OwnerReference `protobuf:"bytes,13,rep,name=owner_references,json=ownerReferences,proto3" json:"owner_references,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Meta) Reset() {
 	*x = Meta{}
-	mi := &file_netguard_api_proto_msgTypes[10]
+	mi := &file_netguard_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1036,7 +1285,7 @@ func (x *Meta) String() string {
 func (*Meta) ProtoMessage() {}
 
 func (x *Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[10]
+	mi := &file_netguard_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1298,7 @@ func (x *Meta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Meta.ProtoReflect.Descriptor instead.
 func (*Meta) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{10}
+	return file_netguard_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Meta) GetUid() string {
@@ -1122,6 +1371,27 @@ func (x *Meta) GetManagedFields() []*ManagedFieldsEntry {
 	return nil
 }
 
+func (x *Meta) GetFinalizers() []string {
+	if x != nil {
+		return x.Finalizers
+	}
+	return nil
+}
+
+func (x *Meta) GetDeletionTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionTs
+	}
+	return nil
+}
+
+func (x *Meta) GetOwnerReferences() []*OwnerReference {
+	if x != nil {
+		return x.OwnerReferences
+	}
+	return nil
+}
+
 // AddressGroupRef - reference to an address group
 type AddressGroupRef struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
@@ -1133,7 +1403,7 @@ type AddressGroupRef struct {
 
 func (x *AddressGroupRef) Reset() {
 	*x = AddressGroupRef{}
-	mi := &file_netguard_api_proto_msgTypes[11]
+	mi := &file_netguard_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1145,7 +1415,7 @@ func (x *AddressGroupRef) String() string {
 func (*AddressGroupRef) ProtoMessage() {}
 
 func (x *AddressGroupRef) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[11]
+	mi := &file_netguard_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1428,7 @@ func (x *AddressGroupRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressGroupRef.ProtoReflect.Descriptor instead.
 func (*AddressGroupRef) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{11}
+	return file_netguard_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AddressGroupRef) GetIdentifier() *ResourceIdentifier {
@@ -1186,7 +1456,7 @@ type ServiceRef struct {
 
 func (x *ServiceRef) Reset() {
 	*x = ServiceRef{}
-	mi := &file_netguard_api_proto_msgTypes[12]
+	mi := &file_netguard_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1198,7 +1468,7 @@ func (x *ServiceRef) String() string {
 func (*ServiceRef) ProtoMessage() {}
 
 func (x *ServiceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[12]
+	mi := &file_netguard_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,7 +1481,7 @@ func (x *ServiceRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceRef.ProtoReflect.Descriptor instead.
 func (*ServiceRef) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{12}
+	return file_netguard_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ServiceRef) GetIdentifier() *ResourceIdentifier {
@@ -1242,7 +1512,7 @@ type NetworkItem struct {
 
 func (x *NetworkItem) Reset() {
 	*x = NetworkItem{}
-	mi := &file_netguard_api_proto_msgTypes[13]
+	mi := &file_netguard_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1254,7 +1524,7 @@ func (x *NetworkItem) String() string {
 func (*NetworkItem) ProtoMessage() {}
 
 func (x *NetworkItem) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[13]
+	mi := &file_netguard_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +1537,7 @@ func (x *NetworkItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkItem.ProtoReflect.Descriptor instead.
 func (*NetworkItem) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{13}
+	return file_netguard_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *NetworkItem) GetName() string {
@@ -1321,7 +1591,7 @@ type Network struct {
 
 func (x *Network) Reset() {
 	*x = Network{}
-	mi := &file_netguard_api_proto_msgTypes[14]
+	mi := &file_netguard_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1333,7 +1603,7 @@ func (x *Network) String() string {
 func (*Network) ProtoMessage() {}
 
 func (x *Network) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[14]
+	mi := &file_netguard_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1616,7 @@ func (x *Network) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Network.ProtoReflect.Descriptor instead.
 func (*Network) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{14}
+	return file_netguard_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Network) GetSelfRef() *ResourceIdentifier {
@@ -1403,13 +1673,14 @@ type AddressGroup struct {
 	Hosts            []*ObjectReference     `protobuf:"bytes,7,rep,name=hosts,proto3" json:"hosts,omitempty"`                                                 // Hosts that belong to this address group (exclusively)
 	AddressGroupName string                 `protobuf:"bytes,8,opt,name=address_group_name,json=addressGroupName,proto3" json:"address_group_name,omitempty"` // Computed address group name (e.g., "namespace/name")
 	AggregatedHosts  []*HostReference       `protobuf:"bytes,9,rep,name=aggregated_hosts,json=aggregatedHosts,proto3" json:"aggregated_hosts,omitempty"`      // All hosts from any source (spec + HostBinding)
+	IncludedGroups   []*AddressGroupRef     `protobuf:"bytes,10,rep,name=included_groups,json=includedGroups,proto3" json:"included_groups,omitempty"`        // Child address groups covered by rules targeting this group
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *AddressGroup) Reset() {
 	*x = AddressGroup{}
-	mi := &file_netguard_api_proto_msgTypes[15]
+	mi := &file_netguard_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1421,7 +1692,7 @@ func (x *AddressGroup) String() string {
 func (*AddressGroup) ProtoMessage() {}
 
 func (x *AddressGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[15]
+	mi := &file_netguard_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,7 +1705,7 @@ func (x *AddressGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressGroup.ProtoReflect.Descriptor instead.
 func (*AddressGroup) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{15}
+	return file_netguard_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AddressGroup) GetSelfRef() *ResourceIdentifier {
@@ -1500,6 +1771,13 @@ func (x *AddressGroup) GetAggregatedHosts() []*HostReference {
 	return nil
 }
 
+func (x *AddressGroup) GetIncludedGroups() []*AddressGroupRef {
+	if x != nil {
+		return x.IncludedGroups
+	}
+	return nil
+}
+
 // AddressGroupBinding - binding between a service and an address group
 type AddressGroupBinding struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -1513,7 +1791,7 @@ type AddressGroupBinding struct {
 
 func (x *AddressGroupBinding) Reset() {
 	*x = AddressGroupBinding{}
-	mi := &file_netguard_api_proto_msgTypes[16]
+	mi := &file_netguard_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1525,7 +1803,7 @@ func (x *AddressGroupBinding) String() string {
 func (*AddressGroupBinding) ProtoMessage() {}
 
 func (x *AddressGroupBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[16]
+	mi := &file_netguard_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +1816,7 @@ func (x *AddressGroupBinding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressGroupBinding.ProtoReflect.Descriptor instead.
 func (*AddressGroupBinding) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{16}
+	return file_netguard_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AddressGroupBinding) GetSelfRef() *ResourceIdentifier {
@@ -1583,7 +1861,7 @@ type NetworkBinding struct {
 
 func (x *NetworkBinding) Reset() {
 	*x = NetworkBinding{}
-	mi := &file_netguard_api_proto_msgTypes[17]
+	mi := &file_netguard_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +1873,7 @@ func (x *NetworkBinding) String() string {
 func (*NetworkBinding) ProtoMessage() {}
 
 func (x *NetworkBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[17]
+	mi := &file_netguard_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +1886,7 @@ func (x *NetworkBinding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkBinding.ProtoReflect.Descriptor instead.
 func (*NetworkBinding) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{17}
+	return file_netguard_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *NetworkBinding) GetSelfRef() *ResourceIdentifier {
@@ -1656,7 +1934,7 @@ type IPItem struct {
 
 func (x *IPItem) Reset() {
 	*x = IPItem{}
-	mi := &file_netguard_api_proto_msgTypes[18]
+	mi := &file_netguard_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1668,7 +1946,7 @@ func (x *IPItem) String() string {
 func (*IPItem) ProtoMessage() {}
 
 func (x *IPItem) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[18]
+	mi := &file_netguard_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1681,7 +1959,7 @@ func (x *IPItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPItem.ProtoReflect.Descriptor instead.
 func (*IPItem) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{18}
+	return file_netguard_api_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *IPItem) GetIp() string {
@@ -1710,7 +1988,7 @@ type Host struct {
 
 func (x *Host) Reset() {
 	*x = Host{}
-	mi := &file_netguard_api_proto_msgTypes[19]
+	mi := &file_netguard_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1722,7 +2000,7 @@ func (x *Host) String() string {
 func (*Host) ProtoMessage() {}
 
 func (x *Host) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[19]
+	mi := &file_netguard_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,7 +2013,7 @@ func (x *Host) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Host.ProtoReflect.Descriptor instead.
 func (*Host) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{19}
+	return file_netguard_api_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Host) GetSelfRef() *ResourceIdentifier {
@@ -1814,7 +2092,7 @@ type HostBinding struct {
 
 func (x *HostBinding) Reset() {
 	*x = HostBinding{}
-	mi := &file_netguard_api_proto_msgTypes[20]
+	mi := &file_netguard_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1826,7 +2104,7 @@ func (x *HostBinding) String() string {
 func (*HostBinding) ProtoMessage() {}
 
 func (x *HostBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[20]
+	mi := &file_netguard_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1839,7 +2117,7 @@ func (x *HostBinding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostBinding.ProtoReflect.Descriptor instead.
 func (*HostBinding) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{20}
+	return file_netguard_api_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *HostBinding) GetSelfRef() *ResourceIdentifier {
@@ -1880,7 +2158,7 @@ type ProtocolPorts struct {
 
 func (x *ProtocolPorts) Reset() {
 	*x = ProtocolPorts{}
-	mi := &file_netguard_api_proto_msgTypes[21]
+	mi := &file_netguard_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1892,7 +2170,7 @@ func (x *ProtocolPorts) String() string {
 func (*ProtocolPorts) ProtoMessage() {}
 
 func (x *ProtocolPorts) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[21]
+	mi := &file_netguard_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,7 +2183,7 @@ func (x *ProtocolPorts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtocolPorts.ProtoReflect.Descriptor instead.
 func (*ProtocolPorts) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{21}
+	return file_netguard_api_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ProtocolPorts) GetPorts() map[string]*PortRanges {
@@ -1926,7 +2204,7 @@ type PortRange struct {
 
 func (x *PortRange) Reset() {
 	*x = PortRange{}
-	mi := &file_netguard_api_proto_msgTypes[22]
+	mi := &file_netguard_api_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1938,7 +2216,7 @@ func (x *PortRange) String() string {
 func (*PortRange) ProtoMessage() {}
 
 func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[22]
+	mi := &file_netguard_api_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,7 +2229,7 @@ func (x *PortRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
 func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{22}
+	return file_netguard_api_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PortRange) GetStart() int32 {
@@ -1978,7 +2256,7 @@ type PortRanges struct {
 
 func (x *PortRanges) Reset() {
 	*x = PortRanges{}
-	mi := &file_netguard_api_proto_msgTypes[23]
+	mi := &file_netguard_api_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1990,7 +2268,7 @@ func (x *PortRanges) String() string {
 func (*PortRanges) ProtoMessage() {}
 
 func (x *PortRanges) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[23]
+	mi := &file_netguard_api_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2281,7 @@ func (x *PortRanges) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortRanges.ProtoReflect.Descriptor instead.
 func (*PortRanges) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{23}
+	return file_netguard_api_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PortRanges) GetRanges() []*PortRange {
@@ -2025,7 +2303,7 @@ type ServicePortsRef struct {
 
 func (x *ServicePortsRef) Reset() {
 	*x = ServicePortsRef{}
-	mi := &file_netguard_api_proto_msgTypes[24]
+	mi := &file_netguard_api_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2037,7 +2315,7 @@ func (x *ServicePortsRef) String() string {
 func (*ServicePortsRef) ProtoMessage() {}
 
 func (x *ServicePortsRef) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[24]
+	mi := &file_netguard_api_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2050,7 +2328,7 @@ func (x *ServicePortsRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServicePortsRef.ProtoReflect.Descriptor instead.
 func (*ServicePortsRef) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{24}
+	return file_netguard_api_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ServicePortsRef) GetIdentifier() *ResourceIdentifier {
@@ -2086,7 +2364,7 @@ type AddressGroupPortMapping struct {
 
 func (x *AddressGroupPortMapping) Reset() {
 	*x = AddressGroupPortMapping{}
-	mi := &file_netguard_api_proto_msgTypes[25]
+	mi := &file_netguard_api_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2098,7 +2376,7 @@ func (x *AddressGroupPortMapping) String() string {
 func (*AddressGroupPortMapping) ProtoMessage() {}
 
 func (x *AddressGroupPortMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[25]
+	mi := &file_netguard_api_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,7 +2389,7 @@ func (x *AddressGroupPortMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressGroupPortMapping.ProtoReflect.Descriptor instead.
 func (*AddressGroupPortMapping) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{25}
+	return file_netguard_api_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AddressGroupPortMapping) GetSelfRef() *ResourceIdentifier {
@@ -2147,7 +2425,7 @@ type ServiceAlias struct {
 
 func (x *ServiceAlias) Reset() {
 	*x = ServiceAlias{}
-	mi := &file_netguard_api_proto_msgTypes[26]
+	mi := &file_netguard_api_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2159,7 +2437,7 @@ func (x *ServiceAlias) String() string {
 func (*ServiceAlias) ProtoMessage() {}
 
 func (x *ServiceAlias) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[26]
+	mi := &file_netguard_api_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2172,7 +2450,7 @@ func (x *ServiceAlias) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceAlias.ProtoReflect.Descriptor instead.
 func (*ServiceAlias) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{26}
+	return file_netguard_api_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ServiceAlias) GetSelfRef() *ResourceIdentifier {
@@ -2209,7 +2487,7 @@ type AddressGroupBindingPolicy struct {
 
 func (x *AddressGroupBindingPolicy) Reset() {
 	*x = AddressGroupBindingPolicy{}
-	mi := &file_netguard_api_proto_msgTypes[27]
+	mi := &file_netguard_api_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2221,7 +2499,7 @@ func (x *AddressGroupBindingPolicy) String() string {
 func (*AddressGroupBindingPolicy) ProtoMessage() {}
 
 func (x *AddressGroupBindingPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[27]
+	mi := &file_netguard_api_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2234,7 +2512,7 @@ func (x *AddressGroupBindingPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressGroupBindingPolicy.ProtoReflect.Descriptor instead.
 func (*AddressGroupBindingPolicy) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{27}
+	return file_netguard_api_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AddressGroupBindingPolicy) GetSelfRef() *ResourceIdentifier {
@@ -2276,13 +2554,19 @@ type RuleS2S struct {
 	IeagAgRuleObjectRefs []*NamespacedObjectReference `protobuf:"bytes,8,rep,name=ieag_ag_rule_object_refs,json=ieagAgRuleObjectRefs,proto3" json:"ieag_ag_rule_object_refs,omitempty"` // NEW: Full object references
 	Meta                 *Meta                        `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
 	Trace                bool                         `protobuf:"varint,7,opt,name=trace,proto3" json:"trace,omitempty"`
+	Action               RuleAction                   `protobuf:"varint,9,opt,name=action,proto3,enum=netguard.v1.RuleAction" json:"action,omitempty"`                                       // Action of generated IEAgAg rules, UNDEFINED means ACCEPT
+	PortsSource          RuleS2SPortsSource           `protobuf:"varint,10,opt,name=ports_source,json=portsSource,proto3,enum=netguard.v1.RuleS2SPortsSource" json:"ports_source,omitempty"` // Service whose ports are opened
+	ExtraPorts           []*IngressPort               `protobuf:"bytes,11,rep,name=extra_ports,json=extraPorts,proto3" json:"extra_ports,omitempty"`                                         // Ports opened in addition to the service ports
+	ValidFrom            *timestamppb.Timestamp       `protobuf:"bytes,12,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`                                            // Rule generates IEAgAg rules from this time, unset - immediately
+	ValidUntil           *timestamppb.Timestamp       `protobuf:"bytes,13,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`                                         // Rule stops generating IEAgAg rules at this time, unset - never
+	Priority             int32                        `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`                                                              // Priority of generated IEAgAg rules, 0 - calculated by the priority strategy
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
 
 func (x *RuleS2S) Reset() {
 	*x = RuleS2S{}
-	mi := &file_netguard_api_proto_msgTypes[28]
+	mi := &file_netguard_api_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2294,7 +2578,7 @@ func (x *RuleS2S) String() string {
 func (*RuleS2S) ProtoMessage() {}
 
 func (x *RuleS2S) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[28]
+	mi := &file_netguard_api_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2307,7 +2591,7 @@ func (x *RuleS2S) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleS2S.ProtoReflect.Descriptor instead.
 func (*RuleS2S) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{28}
+	return file_netguard_api_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RuleS2S) GetSelfRef() *ResourceIdentifier {
@@ -2366,6 +2650,48 @@ func (x *RuleS2S) GetTrace() bool {
 	return false
 }
 
+func (x *RuleS2S) GetAction() RuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return RuleAction_UNDEFINED
+}
+
+func (x *RuleS2S) GetPortsSource() RuleS2SPortsSource {
+	if x != nil {
+		return x.PortsSource
+	}
+	return RuleS2SPortsSource_PORTS_SOURCE_AUTO
+}
+
+func (x *RuleS2S) GetExtraPorts() []*IngressPort {
+	if x != nil {
+		return x.ExtraPorts
+	}
+	return nil
+}
+
+func (x *RuleS2S) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *RuleS2S) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *RuleS2S) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 // IEAgAgRule - rule between two address groups
 type IEAgAgRule struct {
 	state             protoimpl.MessageState   `protogen:"open.v1"`
@@ -2386,7 +2712,7 @@ type IEAgAgRule struct {
 
 func (x *IEAgAgRule) Reset() {
 	*x = IEAgAgRule{}
-	mi := &file_netguard_api_proto_msgTypes[29]
+	mi := &file_netguard_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2398,7 +2724,7 @@ func (x *IEAgAgRule) String() string {
 func (*IEAgAgRule) ProtoMessage() {}
 
 func (x *IEAgAgRule) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[29]
+	mi := &file_netguard_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,7 +2737,7 @@ func (x *IEAgAgRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IEAgAgRule.ProtoReflect.Descriptor instead.
 func (*IEAgAgRule) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{29}
+	return file_netguard_api_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *IEAgAgRule) GetSelfRef() *ResourceIdentifier {
@@ -2491,30 +2817,35 @@ func (x *IEAgAgRule) GetTrace() bool {
 	return false
 }
 
-// PortSpec - port specification
-type PortSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// RuleS2SException - ports excluded from IEAgAg rules between two address groups
+type RuleS2SException struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	SelfRef           *ResourceIdentifier      `protobuf:"bytes,1,opt,name=self_ref,json=selfRef,proto3" json:"self_ref,omitempty"`
+	Traffic           Traffic                  `protobuf:"varint,2,opt,name=traffic,proto3,enum=netguard.v1.Traffic" json:"traffic,omitempty"`
+	AddressGroupLocal *AddressGroupRef         `protobuf:"bytes,3,opt,name=address_group_local,json=addressGroupLocal,proto3" json:"address_group_local,omitempty"`
+	AddressGroup      *AddressGroupRef         `protobuf:"bytes,4,opt,name=address_group,json=addressGroup,proto3" json:"address_group,omitempty"`
+	Transport         Networks_NetIP_Transport `protobuf:"varint,5,opt,name=transport,proto3,enum=netguard.v1.Networks_NetIP_Transport" json:"transport,omitempty"`
+	Ports             string                   `protobuf:"bytes,6,opt,name=ports,proto3" json:"ports,omitempty"` // Excluded ports or ICMP types, empty excludes all ports of the transport
+	Meta              *Meta                    `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *PortSpec) Reset() {
-	*x = PortSpec{}
-	mi := &file_netguard_api_proto_msgTypes[30]
+func (x *RuleS2SException) Reset() {
+	*x = RuleS2SException{}
+	mi := &file_netguard_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortSpec) String() string {
+func (x *RuleS2SException) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortSpec) ProtoMessage() {}
+func (*RuleS2SException) ProtoMessage() {}
 
-func (x *PortSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[30]
+func (x *RuleS2SException) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,93 +2856,85 @@ func (x *PortSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortSpec.ProtoReflect.Descriptor instead.
-func (*PortSpec) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use RuleS2SException.ProtoReflect.Descriptor instead.
+func (*RuleS2SException) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *PortSpec) GetSource() string {
+func (x *RuleS2SException) GetSelfRef() *ResourceIdentifier {
 	if x != nil {
-		return x.Source
+		return x.SelfRef
 	}
-	return ""
+	return nil
 }
 
-func (x *PortSpec) GetDestination() string {
+func (x *RuleS2SException) GetTraffic() Traffic {
 	if x != nil {
-		return x.Destination
+		return x.Traffic
 	}
-	return ""
-}
-
-// SyncStatusResp - sync status
-type SyncStatusResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return Traffic_Ingress
 }
 
-func (x *SyncStatusResp) Reset() {
-	*x = SyncStatusResp{}
-	mi := &file_netguard_api_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RuleS2SException) GetAddressGroupLocal() *AddressGroupRef {
+	if x != nil {
+		return x.AddressGroupLocal
+	}
+	return nil
 }
 
-func (x *SyncStatusResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleS2SException) GetAddressGroup() *AddressGroupRef {
+	if x != nil {
+		return x.AddressGroup
+	}
+	return nil
 }
 
-func (*SyncStatusResp) ProtoMessage() {}
-
-func (x *SyncStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[31]
+func (x *RuleS2SException) GetTransport() Networks_NetIP_Transport {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Transport
 	}
-	return mi.MessageOf(x)
+	return Networks_NetIP_TCP
 }
 
-// Deprecated: Use SyncStatusResp.ProtoReflect.Descriptor instead.
-func (*SyncStatusResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{31}
+func (x *RuleS2SException) GetPorts() string {
+	if x != nil {
+		return x.Ports
+	}
+	return ""
 }
 
-func (x *SyncStatusResp) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *RuleS2SException) GetMeta() *Meta {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Meta
 	}
 	return nil
 }
 
-// SyncServices - subject of Services to sync
-type SyncServices struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CrossNamespacePolicy - namespaces whose RuleS2S may reference Services of the policy namespace
+type CrossNamespacePolicy struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SelfRef           *ResourceIdentifier    `protobuf:"bytes,1,opt,name=self_ref,json=selfRef,proto3" json:"self_ref,omitempty"`
+	AllowedNamespaces []string               `protobuf:"bytes,2,rep,name=allowed_namespaces,json=allowedNamespaces,proto3" json:"allowed_namespaces,omitempty"` // Namespaces of RuleS2S allowed to reference Services of the policy namespace
+	Meta              *Meta                  `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SyncServices) Reset() {
-	*x = SyncServices{}
-	mi := &file_netguard_api_proto_msgTypes[32]
+func (x *CrossNamespacePolicy) Reset() {
+	*x = CrossNamespacePolicy{}
+	mi := &file_netguard_api_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncServices) String() string {
+func (x *CrossNamespacePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncServices) ProtoMessage() {}
+func (*CrossNamespacePolicy) ProtoMessage() {}
 
-func (x *SyncServices) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[32]
+func (x *CrossNamespacePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2622,41 +2945,61 @@ func (x *SyncServices) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncServices.ProtoReflect.Descriptor instead.
-func (*SyncServices) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CrossNamespacePolicy.ProtoReflect.Descriptor instead.
+func (*CrossNamespacePolicy) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SyncServices) GetServices() []*Service {
+func (x *CrossNamespacePolicy) GetSelfRef() *ResourceIdentifier {
 	if x != nil {
-		return x.Services
+		return x.SelfRef
 	}
 	return nil
 }
 
-// SyncAddressGroups - subject of Address Groups to sync
-type SyncAddressGroups struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressGroups []*AddressGroup        `protobuf:"bytes,1,rep,name=address_groups,json=addressGroups,proto3" json:"address_groups,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CrossNamespacePolicy) GetAllowedNamespaces() []string {
+	if x != nil {
+		return x.AllowedNamespaces
+	}
+	return nil
 }
 
-func (x *SyncAddressGroups) Reset() {
-	*x = SyncAddressGroups{}
-	mi := &file_netguard_api_proto_msgTypes[33]
+func (x *CrossNamespacePolicy) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// RuleTemplate - expands into RuleS2S between services selected by labels
+type RuleTemplate struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SelfRef               *ResourceIdentifier    `protobuf:"bytes,1,opt,name=self_ref,json=selfRef,proto3" json:"self_ref,omitempty"`
+	Traffic               Traffic                `protobuf:"varint,2,opt,name=traffic,proto3,enum=netguard.v1.Traffic" json:"traffic,omitempty"`
+	LocalServiceSelector  map[string]string      `protobuf:"bytes,3,rep,name=local_service_selector,json=localServiceSelector,proto3" json:"local_service_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`    // Labels of the local services of the generated RuleS2S
+	TargetServiceSelector map[string]string      `protobuf:"bytes,4,rep,name=target_service_selector,json=targetServiceSelector,proto3" json:"target_service_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Labels of the target services of the generated RuleS2S
+	Action                RuleAction             `protobuf:"varint,5,opt,name=action,proto3,enum=netguard.v1.RuleAction" json:"action,omitempty"`                                                                                                           // Action of generated rules, UNDEFINED means ACCEPT
+	Trace                 bool                   `protobuf:"varint,6,opt,name=trace,proto3" json:"trace,omitempty"`
+	Meta                  *Meta                  `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RuleTemplate) Reset() {
+	*x = RuleTemplate{}
+	mi := &file_netguard_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncAddressGroups) String() string {
+func (x *RuleTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAddressGroups) ProtoMessage() {}
+func (*RuleTemplate) ProtoMessage() {}
 
-func (x *SyncAddressGroups) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[33]
+func (x *RuleTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2667,85 +3010,84 @@ func (x *SyncAddressGroups) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAddressGroups.ProtoReflect.Descriptor instead.
-func (*SyncAddressGroups) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use RuleTemplate.ProtoReflect.Descriptor instead.
+func (*RuleTemplate) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SyncAddressGroups) GetAddressGroups() []*AddressGroup {
+func (x *RuleTemplate) GetSelfRef() *ResourceIdentifier {
 	if x != nil {
-		return x.AddressGroups
+		return x.SelfRef
 	}
 	return nil
 }
 
-// SyncAddressGroupBindings - subject of Address Group Bindings to sync
-type SyncAddressGroupBindings struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	AddressGroupBindings []*AddressGroupBinding `protobuf:"bytes,1,rep,name=address_group_bindings,json=addressGroupBindings,proto3" json:"address_group_bindings,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *RuleTemplate) GetTraffic() Traffic {
+	if x != nil {
+		return x.Traffic
+	}
+	return Traffic_Ingress
 }
 
-func (x *SyncAddressGroupBindings) Reset() {
-	*x = SyncAddressGroupBindings{}
-	mi := &file_netguard_api_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RuleTemplate) GetLocalServiceSelector() map[string]string {
+	if x != nil {
+		return x.LocalServiceSelector
+	}
+	return nil
 }
 
-func (x *SyncAddressGroupBindings) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleTemplate) GetTargetServiceSelector() map[string]string {
+	if x != nil {
+		return x.TargetServiceSelector
+	}
+	return nil
 }
 
-func (*SyncAddressGroupBindings) ProtoMessage() {}
-
-func (x *SyncAddressGroupBindings) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[34]
+func (x *RuleTemplate) GetAction() RuleAction {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Action
 	}
-	return mi.MessageOf(x)
+	return RuleAction_UNDEFINED
 }
 
-// Deprecated: Use SyncAddressGroupBindings.ProtoReflect.Descriptor instead.
-func (*SyncAddressGroupBindings) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{34}
+func (x *RuleTemplate) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
 }
 
-func (x *SyncAddressGroupBindings) GetAddressGroupBindings() []*AddressGroupBinding {
+func (x *RuleTemplate) GetMeta() *Meta {
 	if x != nil {
-		return x.AddressGroupBindings
+		return x.Meta
 	}
 	return nil
 }
 
-// SyncAddressGroupPortMappings - subject of Address Group Port Mappings to sync
-type SyncAddressGroupPortMappings struct {
-	state                    protoimpl.MessageState     `protogen:"open.v1"`
-	AddressGroupPortMappings []*AddressGroupPortMapping `protobuf:"bytes,1,rep,name=address_group_port_mappings,json=addressGroupPortMappings,proto3" json:"address_group_port_mappings,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// NamespacePosture - default traffic posture of a namespace
+type NamespacePosture struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SelfRef       *ResourceIdentifier    `protobuf:"bytes,1,opt,name=self_ref,json=selfRef,proto3" json:"self_ref,omitempty"`
+	Mode          NamespacePostureMode   `protobuf:"varint,2,opt,name=mode,proto3,enum=netguard.v1.NamespacePostureMode" json:"mode,omitempty"`
+	Meta          *Meta                  `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncAddressGroupPortMappings) Reset() {
-	*x = SyncAddressGroupPortMappings{}
+func (x *NamespacePosture) Reset() {
+	*x = NamespacePosture{}
 	mi := &file_netguard_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncAddressGroupPortMappings) String() string {
+func (x *NamespacePosture) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAddressGroupPortMappings) ProtoMessage() {}
+func (*NamespacePosture) ProtoMessage() {}
 
-func (x *SyncAddressGroupPortMappings) ProtoReflect() protoreflect.Message {
+func (x *NamespacePosture) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2757,40 +3099,55 @@ func (x *SyncAddressGroupPortMappings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAddressGroupPortMappings.ProtoReflect.Descriptor instead.
-func (*SyncAddressGroupPortMappings) Descriptor() ([]byte, []int) {
+// Deprecated: Use NamespacePosture.ProtoReflect.Descriptor instead.
+func (*NamespacePosture) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SyncAddressGroupPortMappings) GetAddressGroupPortMappings() []*AddressGroupPortMapping {
+func (x *NamespacePosture) GetSelfRef() *ResourceIdentifier {
 	if x != nil {
-		return x.AddressGroupPortMappings
+		return x.SelfRef
 	}
 	return nil
 }
 
-// SyncRuleS2S - subject of Rule S2S to sync
-type SyncRuleS2S struct {
+func (x *NamespacePosture) GetMode() NamespacePostureMode {
+	if x != nil {
+		return x.Mode
+	}
+	return NamespacePostureMode_POSTURE_DEFAULT_ALLOW
+}
+
+func (x *NamespacePosture) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// PortSpec - port specification
+type PortSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleS2S       []*RuleS2S             `protobuf:"bytes,1,rep,name=rule_s2s,json=ruleS2s,proto3" json:"rule_s2s,omitempty"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncRuleS2S) Reset() {
-	*x = SyncRuleS2S{}
+func (x *PortSpec) Reset() {
+	*x = PortSpec{}
 	mi := &file_netguard_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncRuleS2S) String() string {
+func (x *PortSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncRuleS2S) ProtoMessage() {}
+func (*PortSpec) ProtoMessage() {}
 
-func (x *SyncRuleS2S) ProtoReflect() protoreflect.Message {
+func (x *PortSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2802,40 +3159,47 @@ func (x *SyncRuleS2S) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncRuleS2S.ProtoReflect.Descriptor instead.
-func (*SyncRuleS2S) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortSpec.ProtoReflect.Descriptor instead.
+func (*PortSpec) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SyncRuleS2S) GetRuleS2S() []*RuleS2S {
+func (x *PortSpec) GetSource() string {
 	if x != nil {
-		return x.RuleS2S
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-// SyncServiceAliases - subject of Service Aliases to sync
-type SyncServiceAliases struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ServiceAliases []*ServiceAlias        `protobuf:"bytes,1,rep,name=service_aliases,json=serviceAliases,proto3" json:"service_aliases,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PortSpec) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
 }
 
-func (x *SyncServiceAliases) Reset() {
-	*x = SyncServiceAliases{}
+// SyncStatusResp - sync status
+type SyncStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncStatusResp) Reset() {
+	*x = SyncStatusResp{}
 	mi := &file_netguard_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncServiceAliases) String() string {
+func (x *SyncStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncServiceAliases) ProtoMessage() {}
+func (*SyncStatusResp) ProtoMessage() {}
 
-func (x *SyncServiceAliases) ProtoReflect() protoreflect.Message {
+func (x *SyncStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2847,40 +3211,45 @@ func (x *SyncServiceAliases) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncServiceAliases.ProtoReflect.Descriptor instead.
-func (*SyncServiceAliases) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncStatusResp.ProtoReflect.Descriptor instead.
+func (*SyncStatusResp) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SyncServiceAliases) GetServiceAliases() []*ServiceAlias {
+func (x *SyncStatusResp) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ServiceAliases
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-// SyncAddressGroupBindingPolicies - subject of Address Group Binding Policies to sync
-type SyncAddressGroupBindingPolicies struct {
-	state                       protoimpl.MessageState       `protogen:"open.v1"`
-	AddressGroupBindingPolicies []*AddressGroupBindingPolicy `protobuf:"bytes,1,rep,name=address_group_binding_policies,json=addressGroupBindingPolicies,proto3" json:"address_group_binding_policies,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+// GetDetailedSyncStatusReq - request for detailed sync status
+type GetDetailedSyncStatusReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kinds - subject types to report (Services, AddressGroups, ...), all if empty
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// include_resources - include per-resource statuses
+	IncludeResources bool `protobuf:"varint,2,opt,name=include_resources,json=includeResources,proto3" json:"include_resources,omitempty"`
+	// failed_only - report only resources whose last sync failed
+	FailedOnly    bool `protobuf:"varint,3,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncAddressGroupBindingPolicies) Reset() {
-	*x = SyncAddressGroupBindingPolicies{}
+func (x *GetDetailedSyncStatusReq) Reset() {
+	*x = GetDetailedSyncStatusReq{}
 	mi := &file_netguard_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncAddressGroupBindingPolicies) String() string {
+func (x *GetDetailedSyncStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncAddressGroupBindingPolicies) ProtoMessage() {}
+func (*GetDetailedSyncStatusReq) ProtoMessage() {}
 
-func (x *SyncAddressGroupBindingPolicies) ProtoReflect() protoreflect.Message {
+func (x *GetDetailedSyncStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2892,40 +3261,62 @@ func (x *SyncAddressGroupBindingPolicies) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncAddressGroupBindingPolicies.ProtoReflect.Descriptor instead.
-func (*SyncAddressGroupBindingPolicies) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDetailedSyncStatusReq.ProtoReflect.Descriptor instead.
+func (*GetDetailedSyncStatusReq) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SyncAddressGroupBindingPolicies) GetAddressGroupBindingPolicies() []*AddressGroupBindingPolicy {
+func (x *GetDetailedSyncStatusReq) GetKinds() []string {
 	if x != nil {
-		return x.AddressGroupBindingPolicies
+		return x.Kinds
 	}
 	return nil
 }
 
-// SyncIEAgAgRules - subject of IEAgAgRules to sync
-type SyncIEAgAgRules struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IeagagRules   []*IEAgAgRule          `protobuf:"bytes,1,rep,name=ieagag_rules,json=ieagagRules,proto3" json:"ieagag_rules,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetDetailedSyncStatusReq) GetIncludeResources() bool {
+	if x != nil {
+		return x.IncludeResources
+	}
+	return false
 }
 
-func (x *SyncIEAgAgRules) Reset() {
-	*x = SyncIEAgAgRules{}
+func (x *GetDetailedSyncStatusReq) GetFailedOnly() bool {
+	if x != nil {
+		return x.FailedOnly
+	}
+	return false
+}
+
+// KindSyncStatus - sync status of a resource kind
+type KindSyncStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	TotalRequests   int64                  `protobuf:"varint,2,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
+	SuccessfulSyncs int64                  `protobuf:"varint,3,opt,name=successful_syncs,json=successfulSyncs,proto3" json:"successful_syncs,omitempty"`
+	FailedSyncs     int64                  `protobuf:"varint,4,opt,name=failed_syncs,json=failedSyncs,proto3" json:"failed_syncs,omitempty"`
+	Retries         int64                  `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
+	LastSyncTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	LastSuccessTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_success_time,json=lastSuccessTime,proto3" json:"last_success_time,omitempty"`
+	LastError       string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *KindSyncStatus) Reset() {
+	*x = KindSyncStatus{}
 	mi := &file_netguard_api_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncIEAgAgRules) String() string {
+func (x *KindSyncStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncIEAgAgRules) ProtoMessage() {}
+func (*KindSyncStatus) ProtoMessage() {}
 
-func (x *SyncIEAgAgRules) ProtoReflect() protoreflect.Message {
+func (x *KindSyncStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2937,40 +3328,103 @@ func (x *SyncIEAgAgRules) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncIEAgAgRules.ProtoReflect.Descriptor instead.
-func (*SyncIEAgAgRules) Descriptor() ([]byte, []int) {
+// Deprecated: Use KindSyncStatus.ProtoReflect.Descriptor instead.
+func (*KindSyncStatus) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SyncIEAgAgRules) GetIeagagRules() []*IEAgAgRule {
+func (x *KindSyncStatus) GetKind() string {
 	if x != nil {
-		return x.IeagagRules
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KindSyncStatus) GetTotalRequests() int64 {
+	if x != nil {
+		return x.TotalRequests
+	}
+	return 0
+}
+
+func (x *KindSyncStatus) GetSuccessfulSyncs() int64 {
+	if x != nil {
+		return x.SuccessfulSyncs
+	}
+	return 0
+}
+
+func (x *KindSyncStatus) GetFailedSyncs() int64 {
+	if x != nil {
+		return x.FailedSyncs
+	}
+	return 0
+}
+
+func (x *KindSyncStatus) GetRetries() int64 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *KindSyncStatus) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
 	}
 	return nil
 }
 
-// SyncNetworks - subject of Networks to sync
-type SyncNetworks struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Networks      []*Network             `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *KindSyncStatus) GetLastSuccessTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessTime
+	}
+	return nil
 }
 
-func (x *SyncNetworks) Reset() {
-	*x = SyncNetworks{}
+func (x *KindSyncStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *KindSyncStatus) GetLastErrorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return nil
+}
+
+// ResourceSyncStatus - sync status of a single resource
+type ResourceSyncStatus struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Kind                string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Key                 string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Operation           string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	LastSyncTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	LastSuccessTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_success_time,json=lastSuccessTime,proto3" json:"last_success_time,omitempty"`
+	LastError           string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Retries             int32                  `protobuf:"varint,7,opt,name=retries,proto3" json:"retries,omitempty"`
+	ConsecutiveFailures int32                  `protobuf:"varint,8,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ResourceSyncStatus) Reset() {
+	*x = ResourceSyncStatus{}
 	mi := &file_netguard_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncNetworks) String() string {
+func (x *ResourceSyncStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncNetworks) ProtoMessage() {}
+func (*ResourceSyncStatus) ProtoMessage() {}
 
-func (x *SyncNetworks) ProtoReflect() protoreflect.Message {
+func (x *ResourceSyncStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2982,40 +3436,92 @@ func (x *SyncNetworks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncNetworks.ProtoReflect.Descriptor instead.
-func (*SyncNetworks) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResourceSyncStatus.ProtoReflect.Descriptor instead.
+func (*ResourceSyncStatus) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SyncNetworks) GetNetworks() []*Network {
+func (x *ResourceSyncStatus) GetKind() string {
 	if x != nil {
-		return x.Networks
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-// SyncNetworkBindings - subject of Network Bindings to sync
-type SyncNetworkBindings struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	NetworkBindings []*NetworkBinding      `protobuf:"bytes,1,rep,name=network_bindings,json=networkBindings,proto3" json:"network_bindings,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ResourceSyncStatus) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
 }
 
-func (x *SyncNetworkBindings) Reset() {
-	*x = SyncNetworkBindings{}
+func (x *ResourceSyncStatus) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ResourceSyncStatus) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *ResourceSyncStatus) GetLastSuccessTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessTime
+	}
+	return nil
+}
+
+func (x *ResourceSyncStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ResourceSyncStatus) GetRetries() int32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *ResourceSyncStatus) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+// GetDetailedSyncStatusResp - detailed sync status
+type GetDetailedSyncStatusResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled - false if synchronization with sgroups is disabled
+	Enabled       bool                  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Kinds         []*KindSyncStatus     `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	Resources     []*ResourceSyncStatus `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDetailedSyncStatusResp) Reset() {
+	*x = GetDetailedSyncStatusResp{}
 	mi := &file_netguard_api_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncNetworkBindings) String() string {
+func (x *GetDetailedSyncStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncNetworkBindings) ProtoMessage() {}
+func (*GetDetailedSyncStatusResp) ProtoMessage() {}
 
-func (x *SyncNetworkBindings) ProtoReflect() protoreflect.Message {
+func (x *GetDetailedSyncStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3027,40 +3533,64 @@ func (x *SyncNetworkBindings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncNetworkBindings.ProtoReflect.Descriptor instead.
-func (*SyncNetworkBindings) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDetailedSyncStatusResp.ProtoReflect.Descriptor instead.
+func (*GetDetailedSyncStatusResp) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SyncNetworkBindings) GetNetworkBindings() []*NetworkBinding {
+func (x *GetDetailedSyncStatusResp) GetEnabled() bool {
 	if x != nil {
-		return x.NetworkBindings
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetDetailedSyncStatusResp) GetKinds() []*KindSyncStatus {
+	if x != nil {
+		return x.Kinds
 	}
 	return nil
 }
 
-// SyncHosts - subject of Hosts to sync
-type SyncHosts struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hosts         []*Host                `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+func (x *GetDetailedSyncStatusResp) GetResources() []*ResourceSyncStatus {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// ReverseSyncEntityStatus - reverse sync statistics of an entity type
+type ReverseSyncEntityStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity_type - host, network or address_group
+	EntityType      string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	TotalRequests   int64  `protobuf:"varint,2,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
+	SuccessfulSyncs int64  `protobuf:"varint,3,opt,name=successful_syncs,json=successfulSyncs,proto3" json:"successful_syncs,omitempty"`
+	FailedSyncs     int64  `protobuf:"varint,4,opt,name=failed_syncs,json=failedSyncs,proto3" json:"failed_syncs,omitempty"`
+	// objects_processed - objects written to netguard
+	ObjectsProcessed int64 `protobuf:"varint,5,opt,name=objects_processed,json=objectsProcessed,proto3" json:"objects_processed,omitempty"`
+	ObjectsFailed    int64 `protobuf:"varint,6,opt,name=objects_failed,json=objectsFailed,proto3" json:"objects_failed,omitempty"`
+	// conflicts - objects changed on both sides or kept by the conflict policy
+	Conflicts     int64                  `protobuf:"varint,7,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	LastSyncTime  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncHosts) Reset() {
-	*x = SyncHosts{}
+func (x *ReverseSyncEntityStatus) Reset() {
+	*x = ReverseSyncEntityStatus{}
 	mi := &file_netguard_api_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncHosts) String() string {
+func (x *ReverseSyncEntityStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncHosts) ProtoMessage() {}
+func (*ReverseSyncEntityStatus) ProtoMessage() {}
 
-func (x *SyncHosts) ProtoReflect() protoreflect.Message {
+func (x *ReverseSyncEntityStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_netguard_api_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3072,86 +3602,109 @@ func (x *SyncHosts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncHosts.ProtoReflect.Descriptor instead.
-func (*SyncHosts) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReverseSyncEntityStatus.ProtoReflect.Descriptor instead.
+func (*ReverseSyncEntityStatus) Descriptor() ([]byte, []int) {
 	return file_netguard_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SyncHosts) GetHosts() []*Host {
+func (x *ReverseSyncEntityStatus) GetEntityType() string {
 	if x != nil {
-		return x.Hosts
+		return x.EntityType
 	}
-	return nil
+	return ""
 }
 
-// SyncHostBindings - subject of Host Bindings to sync
-type SyncHostBindings struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostBindings  []*HostBinding         `protobuf:"bytes,1,rep,name=host_bindings,json=hostBindings,proto3" json:"host_bindings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReverseSyncEntityStatus) GetTotalRequests() int64 {
+	if x != nil {
+		return x.TotalRequests
+	}
+	return 0
 }
 
-func (x *SyncHostBindings) Reset() {
-	*x = SyncHostBindings{}
-	mi := &file_netguard_api_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReverseSyncEntityStatus) GetSuccessfulSyncs() int64 {
+	if x != nil {
+		return x.SuccessfulSyncs
+	}
+	return 0
 }
 
-func (x *SyncHostBindings) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReverseSyncEntityStatus) GetFailedSyncs() int64 {
+	if x != nil {
+		return x.FailedSyncs
+	}
+	return 0
 }
 
-func (*SyncHostBindings) ProtoMessage() {}
+func (x *ReverseSyncEntityStatus) GetObjectsProcessed() int64 {
+	if x != nil {
+		return x.ObjectsProcessed
+	}
+	return 0
+}
 
-func (x *SyncHostBindings) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[43]
+func (x *ReverseSyncEntityStatus) GetObjectsFailed() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ObjectsFailed
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SyncHostBindings.ProtoReflect.Descriptor instead.
-func (*SyncHostBindings) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{43}
+func (x *ReverseSyncEntityStatus) GetConflicts() int64 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
 }
 
-func (x *SyncHostBindings) GetHostBindings() []*HostBinding {
+func (x *ReverseSyncEntityStatus) GetLastSyncTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HostBindings
+		return x.LastSyncTime
 	}
 	return nil
 }
 
-// ListServicesReq - request to list services
-type ListServicesReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+// GetReverseSyncStatusResp - statistics of the reverse (sgroups -> netguard) synchronization
+type GetReverseSyncStatusResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled - false if reverse synchronization is disabled
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Running         bool                   `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TotalEvents     int64                  `protobuf:"varint,4,opt,name=total_events,json=totalEvents,proto3" json:"total_events,omitempty"`
+	ProcessedEvents int64                  `protobuf:"varint,5,opt,name=processed_events,json=processedEvents,proto3" json:"processed_events,omitempty"`
+	FailedEvents    int64                  `protobuf:"varint,6,opt,name=failed_events,json=failedEvents,proto3" json:"failed_events,omitempty"`
+	// last_event_time - time of the last sgroups change
+	LastEventTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_event_time,json=lastEventTime,proto3" json:"last_event_time,omitempty"`
+	// last_successful_sync - last time a change was processed without errors
+	LastSuccessfulSync *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_successful_sync,json=lastSuccessfulSync,proto3" json:"last_successful_sync,omitempty"`
+	// lag - delay between the last processed sgroups change and the end of its processing
+	Lag *durationpb.Duration `protobuf:"bytes,9,opt,name=lag,proto3" json:"lag,omitempty"`
+	// pending_conflicts - host conflicts waiting for manual review
+	PendingConflicts int32                      `protobuf:"varint,10,opt,name=pending_conflicts,json=pendingConflicts,proto3" json:"pending_conflicts,omitempty"`
+	Entities         []*ReverseSyncEntityStatus `protobuf:"bytes,11,rep,name=entities,proto3" json:"entities,omitempty"`
+	// paused - sgroups changes are skipped until the reverse sync is resumed
+	Paused bool `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
+	// skipped_events - sgroups change events detected while paused
+	SkippedEvents int64 `protobuf:"varint,13,opt,name=skipped_events,json=skippedEvents,proto3" json:"skipped_events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListServicesReq) Reset() {
-	*x = ListServicesReq{}
-	mi := &file_netguard_api_proto_msgTypes[44]
+func (x *GetReverseSyncStatusResp) Reset() {
+	*x = GetReverseSyncStatusResp{}
+	mi := &file_netguard_api_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServicesReq) String() string {
+func (x *GetReverseSyncStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServicesReq) ProtoMessage() {}
+func (*GetReverseSyncStatusResp) ProtoMessage() {}
 
-func (x *ListServicesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[44]
+func (x *GetReverseSyncStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3162,131 +3715,126 @@ func (x *ListServicesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServicesReq.ProtoReflect.Descriptor instead.
-func (*ListServicesReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetReverseSyncStatusResp.ProtoReflect.Descriptor instead.
+func (*GetReverseSyncStatusResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListServicesReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *GetReverseSyncStatusResp) GetEnabled() bool {
 	if x != nil {
-		return x.Identifiers
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-// ListServicesResp - response with list of services
-type ListServicesResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Service             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetReverseSyncStatusResp) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
 }
 
-func (x *ListServicesResp) Reset() {
-	*x = ListServicesResp{}
-	mi := &file_netguard_api_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetReverseSyncStatusResp) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (x *ListServicesResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetReverseSyncStatusResp) GetTotalEvents() int64 {
+	if x != nil {
+		return x.TotalEvents
+	}
+	return 0
 }
 
-func (*ListServicesResp) ProtoMessage() {}
-
-func (x *ListServicesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[45]
+func (x *GetReverseSyncStatusResp) GetProcessedEvents() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProcessedEvents
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListServicesResp.ProtoReflect.Descriptor instead.
-func (*ListServicesResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{45}
+func (x *GetReverseSyncStatusResp) GetFailedEvents() int64 {
+	if x != nil {
+		return x.FailedEvents
+	}
+	return 0
 }
 
-func (x *ListServicesResp) GetItems() []*Service {
+func (x *GetReverseSyncStatusResp) GetLastEventTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Items
+		return x.LastEventTime
 	}
 	return nil
 }
 
-// GetServiceReq - request to get a specific service
-type GetServiceReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetReverseSyncStatusResp) GetLastSuccessfulSync() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessfulSync
+	}
+	return nil
 }
 
-func (x *GetServiceReq) Reset() {
-	*x = GetServiceReq{}
-	mi := &file_netguard_api_proto_msgTypes[46]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetReverseSyncStatusResp) GetLag() *durationpb.Duration {
+	if x != nil {
+		return x.Lag
+	}
+	return nil
 }
 
-func (x *GetServiceReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetReverseSyncStatusResp) GetPendingConflicts() int32 {
+	if x != nil {
+		return x.PendingConflicts
+	}
+	return 0
 }
 
-func (*GetServiceReq) ProtoMessage() {}
-
-func (x *GetServiceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[46]
+func (x *GetReverseSyncStatusResp) GetEntities() []*ReverseSyncEntityStatus {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Entities
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetServiceReq.ProtoReflect.Descriptor instead.
-func (*GetServiceReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{46}
+func (x *GetReverseSyncStatusResp) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
 }
 
-func (x *GetServiceReq) GetIdentifier() *ResourceIdentifier {
+func (x *GetReverseSyncStatusResp) GetSkippedEvents() int64 {
 	if x != nil {
-		return x.Identifier
+		return x.SkippedEvents
 	}
-	return nil
+	return 0
 }
 
-// GetServiceResp - response with a specific service
-type GetServiceResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+// ListFailedSyncsReq - request for sgroups syncs in the dead-letter queue
+type ListFailedSyncsReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kinds - resource kinds to report (AddressGroup, ...), all if empty
+	Kinds         []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServiceResp) Reset() {
-	*x = GetServiceResp{}
-	mi := &file_netguard_api_proto_msgTypes[47]
+func (x *ListFailedSyncsReq) Reset() {
+	*x = ListFailedSyncsReq{}
+	mi := &file_netguard_api_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServiceResp) String() string {
+func (x *ListFailedSyncsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceResp) ProtoMessage() {}
+func (*ListFailedSyncsReq) ProtoMessage() {}
 
-func (x *GetServiceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[47]
+func (x *ListFailedSyncsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3297,41 +3845,48 @@ func (x *GetServiceResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceResp.ProtoReflect.Descriptor instead.
-func (*GetServiceResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListFailedSyncsReq.ProtoReflect.Descriptor instead.
+func (*ListFailedSyncsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetServiceResp) GetService() *Service {
+func (x *ListFailedSyncsReq) GetKinds() []string {
 	if x != nil {
-		return x.Service
+		return x.Kinds
 	}
 	return nil
 }
 
-// ListAddressGroupsReq - request to list address groups
-type ListAddressGroupsReq struct {
+// FailedSync - sgroups sync moved to the dead-letter queue after exhausting retries
+type FailedSync struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Operation     string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,4,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FailedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAddressGroupsReq) Reset() {
-	*x = ListAddressGroupsReq{}
-	mi := &file_netguard_api_proto_msgTypes[48]
+func (x *FailedSync) Reset() {
+	*x = FailedSync{}
+	mi := &file_netguard_api_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAddressGroupsReq) String() string {
+func (x *FailedSync) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAddressGroupsReq) ProtoMessage() {}
+func (*FailedSync) ProtoMessage() {}
 
-func (x *ListAddressGroupsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[48]
+func (x *FailedSync) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3342,86 +3897,90 @@ func (x *ListAddressGroupsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAddressGroupsReq.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupsReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use FailedSync.ProtoReflect.Descriptor instead.
+func (*FailedSync) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListAddressGroupsReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *FailedSync) GetId() int64 {
 	if x != nil {
-		return x.Identifiers
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// ListAddressGroupsResp - response with list of address groups
-type ListAddressGroupsResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*AddressGroup        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FailedSync) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *ListAddressGroupsResp) Reset() {
-	*x = ListAddressGroupsResp{}
-	mi := &file_netguard_api_proto_msgTypes[49]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FailedSync) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
 }
 
-func (x *ListAddressGroupsResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FailedSync) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
 }
 
-func (*ListAddressGroupsResp) ProtoMessage() {}
+func (x *FailedSync) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
 
-func (x *ListAddressGroupsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[49]
+func (x *FailedSync) GetLastError() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastError
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListAddressGroupsResp.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupsResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{49}
+func (x *FailedSync) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *ListAddressGroupsResp) GetItems() []*AddressGroup {
+func (x *FailedSync) GetFailedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Items
+		return x.FailedAt
 	}
 	return nil
 }
 
-// GetAddressGroupReq - request to get a specific address group
-type GetAddressGroupReq struct {
+// ListFailedSyncsResp - sgroups syncs in the dead-letter queue
+type ListFailedSyncsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Items         []*FailedSync          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAddressGroupReq) Reset() {
-	*x = GetAddressGroupReq{}
-	mi := &file_netguard_api_proto_msgTypes[50]
+func (x *ListFailedSyncsResp) Reset() {
+	*x = ListFailedSyncsResp{}
+	mi := &file_netguard_api_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAddressGroupReq) String() string {
+func (x *ListFailedSyncsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressGroupReq) ProtoMessage() {}
+func (*ListFailedSyncsResp) ProtoMessage() {}
 
-func (x *GetAddressGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[50]
+func (x *ListFailedSyncsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3432,41 +3991,41 @@ func (x *GetAddressGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressGroupReq.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ListFailedSyncsResp.ProtoReflect.Descriptor instead.
+func (*ListFailedSyncsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetAddressGroupReq) GetIdentifier() *ResourceIdentifier {
+func (x *ListFailedSyncsResp) GetItems() []*FailedSync {
 	if x != nil {
-		return x.Identifier
+		return x.Items
 	}
 	return nil
 }
 
-// GetAddressGroupResp - response with a specific address group
-type GetAddressGroupResp struct {
+// RetryFailedSyncReq - request to retry a dead-lettered sgroups sync
+type RetryFailedSyncReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AddressGroup  *AddressGroup          `protobuf:"bytes,1,opt,name=address_group,json=addressGroup,proto3" json:"address_group,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAddressGroupResp) Reset() {
-	*x = GetAddressGroupResp{}
-	mi := &file_netguard_api_proto_msgTypes[51]
+func (x *RetryFailedSyncReq) Reset() {
+	*x = RetryFailedSyncReq{}
+	mi := &file_netguard_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAddressGroupResp) String() string {
+func (x *RetryFailedSyncReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressGroupResp) ProtoMessage() {}
+func (*RetryFailedSyncReq) ProtoMessage() {}
 
-func (x *GetAddressGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[51]
+func (x *RetryFailedSyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3477,41 +4036,44 @@ func (x *GetAddressGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressGroupResp.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use RetryFailedSyncReq.ProtoReflect.Descriptor instead.
+func (*RetryFailedSyncReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetAddressGroupResp) GetAddressGroup() *AddressGroup {
+func (x *RetryFailedSyncReq) GetId() int64 {
 	if x != nil {
-		return x.AddressGroup
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// ListAddressGroupBindingsReq - request to list address group bindings
-type ListAddressGroupBindingsReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+// ListQuarantinedResourcesReq - request for imported resources kept in the quarantine
+type ListQuarantinedResourcesReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kinds - resource kinds to report (Host, Service, ...), all if empty
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// sources - importers to report (apply, reverse-sync), all if empty
+	Sources       []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAddressGroupBindingsReq) Reset() {
-	*x = ListAddressGroupBindingsReq{}
-	mi := &file_netguard_api_proto_msgTypes[52]
+func (x *ListQuarantinedResourcesReq) Reset() {
+	*x = ListQuarantinedResourcesReq{}
+	mi := &file_netguard_api_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAddressGroupBindingsReq) String() string {
+func (x *ListQuarantinedResourcesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAddressGroupBindingsReq) ProtoMessage() {}
+func (*ListQuarantinedResourcesReq) ProtoMessage() {}
 
-func (x *ListAddressGroupBindingsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[52]
+func (x *ListQuarantinedResourcesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3522,41 +4084,61 @@ func (x *ListAddressGroupBindingsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAddressGroupBindingsReq.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupBindingsReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ListQuarantinedResourcesReq.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedResourcesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ListAddressGroupBindingsReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *ListQuarantinedResourcesReq) GetKinds() []string {
 	if x != nil {
-		return x.Identifiers
+		return x.Kinds
 	}
 	return nil
 }
 
-// ListAddressGroupBindingsResp - response with list of address group bindings
-type ListAddressGroupBindingsResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*AddressGroupBinding `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+func (x *ListQuarantinedResourcesReq) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+// QuarantinedResource - imported resource which failed validation
+type QuarantinedResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source - importer which rejected the resource
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// kind - kind of the resource, empty if the document could not be parsed
+	Kind       string              `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Identifier *ResourceIdentifier `protobuf:"bytes,4,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// reasons - validation failures of the last import
+	Reasons []string `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// document - the resource as it was imported
+	Document string `protobuf:"bytes,6,opt,name=document,proto3" json:"document,omitempty"`
+	// occurrences - number of rejected imports of the resource
+	Occurrences   int32                  `protobuf:"varint,7,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	FirstSeenAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAddressGroupBindingsResp) Reset() {
-	*x = ListAddressGroupBindingsResp{}
-	mi := &file_netguard_api_proto_msgTypes[53]
+func (x *QuarantinedResource) Reset() {
+	*x = QuarantinedResource{}
+	mi := &file_netguard_api_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAddressGroupBindingsResp) String() string {
+func (x *QuarantinedResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAddressGroupBindingsResp) ProtoMessage() {}
+func (*QuarantinedResource) ProtoMessage() {}
 
-func (x *ListAddressGroupBindingsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[53]
+func (x *QuarantinedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3567,86 +4149,97 @@ func (x *ListAddressGroupBindingsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAddressGroupBindingsResp.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupBindingsResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use QuarantinedResource.ProtoReflect.Descriptor instead.
+func (*QuarantinedResource) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListAddressGroupBindingsResp) GetItems() []*AddressGroupBinding {
+func (x *QuarantinedResource) GetId() int64 {
 	if x != nil {
-		return x.Items
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// ListAddressGroupPortMappingsReq - request to list address group port mappings
-type ListAddressGroupPortMappingsReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QuarantinedResource) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
 }
 
-func (x *ListAddressGroupPortMappingsReq) Reset() {
-	*x = ListAddressGroupPortMappingsReq{}
-	mi := &file_netguard_api_proto_msgTypes[54]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QuarantinedResource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *ListAddressGroupPortMappingsReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuarantinedResource) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
 }
 
-func (*ListAddressGroupPortMappingsReq) ProtoMessage() {}
+func (x *QuarantinedResource) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
 
-func (x *ListAddressGroupPortMappingsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[54]
+func (x *QuarantinedResource) GetDocument() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Document
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListAddressGroupPortMappingsReq.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupPortMappingsReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{54}
+func (x *QuarantinedResource) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
 }
 
-func (x *ListAddressGroupPortMappingsReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *QuarantinedResource) GetFirstSeenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Identifiers
+		return x.FirstSeenAt
 	}
 	return nil
 }
 
-// ListAddressGroupPortMappingsResp - response with list of address group port mappings
-type ListAddressGroupPortMappingsResp struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Items         []*AddressGroupPortMapping `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+func (x *QuarantinedResource) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+// ListQuarantinedResourcesResp - imported resources kept in the quarantine
+type ListQuarantinedResourcesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*QuarantinedResource `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAddressGroupPortMappingsResp) Reset() {
-	*x = ListAddressGroupPortMappingsResp{}
-	mi := &file_netguard_api_proto_msgTypes[55]
+func (x *ListQuarantinedResourcesResp) Reset() {
+	*x = ListQuarantinedResourcesResp{}
+	mi := &file_netguard_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAddressGroupPortMappingsResp) String() string {
+func (x *ListQuarantinedResourcesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAddressGroupPortMappingsResp) ProtoMessage() {}
+func (*ListQuarantinedResourcesResp) ProtoMessage() {}
 
-func (x *ListAddressGroupPortMappingsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[55]
+func (x *ListQuarantinedResourcesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3657,41 +4250,43 @@ func (x *ListAddressGroupPortMappingsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAddressGroupPortMappingsResp.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupPortMappingsResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListQuarantinedResourcesResp.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedResourcesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListAddressGroupPortMappingsResp) GetItems() []*AddressGroupPortMapping {
+func (x *ListQuarantinedResourcesResp) GetItems() []*QuarantinedResource {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-// ListRuleS2SReq - request to list rule s2s
-type ListRuleS2SReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+// PromoteQuarantinedResourceReq - request to import a quarantined resource again
+type PromoteQuarantinedResourceReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// document - fixed document to import instead of the quarantined one, optional
+	Document      string `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRuleS2SReq) Reset() {
-	*x = ListRuleS2SReq{}
-	mi := &file_netguard_api_proto_msgTypes[56]
+func (x *PromoteQuarantinedResourceReq) Reset() {
+	*x = PromoteQuarantinedResourceReq{}
+	mi := &file_netguard_api_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRuleS2SReq) String() string {
+func (x *PromoteQuarantinedResourceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRuleS2SReq) ProtoMessage() {}
+func (*PromoteQuarantinedResourceReq) ProtoMessage() {}
 
-func (x *ListRuleS2SReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[56]
+func (x *PromoteQuarantinedResourceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3702,41 +4297,48 @@ func (x *ListRuleS2SReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRuleS2SReq.ProtoReflect.Descriptor instead.
-func (*ListRuleS2SReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use PromoteQuarantinedResourceReq.ProtoReflect.Descriptor instead.
+func (*PromoteQuarantinedResourceReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListRuleS2SReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *PromoteQuarantinedResourceReq) GetId() int64 {
 	if x != nil {
-		return x.Identifiers
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// ListRuleS2SResp - response with list of rule s2s
-type ListRuleS2SResp struct {
+func (x *PromoteQuarantinedResourceReq) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+// DeleteQuarantinedResourceReq - request to drop a quarantined resource
+type DeleteQuarantinedResourceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*RuleS2S             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRuleS2SResp) Reset() {
-	*x = ListRuleS2SResp{}
-	mi := &file_netguard_api_proto_msgTypes[57]
+func (x *DeleteQuarantinedResourceReq) Reset() {
+	*x = DeleteQuarantinedResourceReq{}
+	mi := &file_netguard_api_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRuleS2SResp) String() string {
+func (x *DeleteQuarantinedResourceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRuleS2SResp) ProtoMessage() {}
+func (*DeleteQuarantinedResourceReq) ProtoMessage() {}
 
-func (x *ListRuleS2SResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[57]
+func (x *DeleteQuarantinedResourceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3747,41 +4349,42 @@ func (x *ListRuleS2SResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRuleS2SResp.ProtoReflect.Descriptor instead.
-func (*ListRuleS2SResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use DeleteQuarantinedResourceReq.ProtoReflect.Descriptor instead.
+func (*DeleteQuarantinedResourceReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListRuleS2SResp) GetItems() []*RuleS2S {
+func (x *DeleteQuarantinedResourceReq) GetId() int64 {
 	if x != nil {
-		return x.Items
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// ListServiceAliasesReq - request to list service aliases
-type ListServiceAliasesReq struct {
+// StartupSyncer - subject types synchronized to a sgroups target
+type StartupSyncer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	SubjectTypes  []string               `protobuf:"bytes,2,rep,name=subject_types,json=subjectTypes,proto3" json:"subject_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListServiceAliasesReq) Reset() {
-	*x = ListServiceAliasesReq{}
-	mi := &file_netguard_api_proto_msgTypes[58]
+func (x *StartupSyncer) Reset() {
+	*x = StartupSyncer{}
+	mi := &file_netguard_api_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServiceAliasesReq) String() string {
+func (x *StartupSyncer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServiceAliasesReq) ProtoMessage() {}
+func (*StartupSyncer) ProtoMessage() {}
 
-func (x *ListServiceAliasesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[58]
+func (x *StartupSyncer) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3792,41 +4395,49 @@ func (x *ListServiceAliasesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServiceAliasesReq.ProtoReflect.Descriptor instead.
-func (*ListServiceAliasesReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use StartupSyncer.ProtoReflect.Descriptor instead.
+func (*StartupSyncer) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListServiceAliasesReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *StartupSyncer) GetTarget() string {
 	if x != nil {
-		return x.Identifiers
+		return x.Target
+	}
+	return ""
+}
+
+func (x *StartupSyncer) GetSubjectTypes() []string {
+	if x != nil {
+		return x.SubjectTypes
 	}
 	return nil
 }
 
-// ListServiceAliasesResp - response with list of service aliases
-type ListServiceAliasesResp struct {
+// StartupReverseSync - state of the SGROUP -> NETGUARD synchronization
+type StartupReverseSync struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ServiceAlias        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AutoStart     bool                   `protobuf:"varint,2,opt,name=auto_start,json=autoStart,proto3" json:"auto_start,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListServiceAliasesResp) Reset() {
-	*x = ListServiceAliasesResp{}
-	mi := &file_netguard_api_proto_msgTypes[59]
+func (x *StartupReverseSync) Reset() {
+	*x = StartupReverseSync{}
+	mi := &file_netguard_api_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListServiceAliasesResp) String() string {
+func (x *StartupReverseSync) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListServiceAliasesResp) ProtoMessage() {}
+func (*StartupReverseSync) ProtoMessage() {}
 
-func (x *ListServiceAliasesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[59]
+func (x *StartupReverseSync) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3837,41 +4448,61 @@ func (x *ListServiceAliasesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListServiceAliasesResp.ProtoReflect.Descriptor instead.
-func (*ListServiceAliasesResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use StartupReverseSync.ProtoReflect.Descriptor instead.
+func (*StartupReverseSync) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListServiceAliasesResp) GetItems() []*ServiceAlias {
+func (x *StartupReverseSync) GetEnabled() bool {
 	if x != nil {
-		return x.Items
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-// GetAddressGroupBindingReq - request to get a specific address group binding
-type GetAddressGroupBindingReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+func (x *StartupReverseSync) GetAutoStart() bool {
+	if x != nil {
+		return x.AutoStart
+	}
+	return false
+}
+
+// GetStartupReportResp - how the backend was started
+type GetStartupReportResp struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	App       string                 `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Version   string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// storage_backend - memory or postgres
+	StorageBackend string `protobuf:"bytes,4,opt,name=storage_backend,json=storageBackend,proto3" json:"storage_backend,omitempty"`
+	// schema_version - last applied migration, 0 if unknown
+	SchemaVersion int64               `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	Syncers       []*StartupSyncer    `protobuf:"bytes,6,rep,name=syncers,proto3" json:"syncers,omitempty"`
+	ReverseSync   *StartupReverseSync `protobuf:"bytes,7,opt,name=reverse_sync,json=reverseSync,proto3" json:"reverse_sync,omitempty"`
+	AuthMode      string              `protobuf:"bytes,8,opt,name=auth_mode,json=authMode,proto3" json:"auth_mode,omitempty"`
+	GrpcAddr      string              `protobuf:"bytes,9,opt,name=grpc_addr,json=grpcAddr,proto3" json:"grpc_addr,omitempty"`
+	HttpAddr      string              `protobuf:"bytes,10,opt,name=http_addr,json=httpAddr,proto3" json:"http_addr,omitempty"`
+	// features - optional features and whether they are enabled
+	Features      map[string]bool `protobuf:"bytes,11,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAddressGroupBindingReq) Reset() {
-	*x = GetAddressGroupBindingReq{}
-	mi := &file_netguard_api_proto_msgTypes[60]
+func (x *GetStartupReportResp) Reset() {
+	*x = GetStartupReportResp{}
+	mi := &file_netguard_api_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAddressGroupBindingReq) String() string {
+func (x *GetStartupReportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressGroupBindingReq) ProtoMessage() {}
+func (*GetStartupReportResp) ProtoMessage() {}
 
-func (x *GetAddressGroupBindingReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[60]
+func (x *GetStartupReportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3882,86 +4513,116 @@ func (x *GetAddressGroupBindingReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressGroupBindingReq.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupBindingReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use GetStartupReportResp.ProtoReflect.Descriptor instead.
+func (*GetStartupReportResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetAddressGroupBindingReq) GetIdentifier() *ResourceIdentifier {
+func (x *GetStartupReportResp) GetApp() string {
 	if x != nil {
-		return x.Identifier
+		return x.App
+	}
+	return ""
+}
+
+func (x *GetStartupReportResp) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetStartupReportResp) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
 	}
 	return nil
 }
 
-// GetAddressGroupBindingResp - response with a specific address group binding
-type GetAddressGroupBindingResp struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	AddressGroupBinding *AddressGroupBinding   `protobuf:"bytes,1,opt,name=address_group_binding,json=addressGroupBinding,proto3" json:"address_group_binding,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *GetStartupReportResp) GetStorageBackend() string {
+	if x != nil {
+		return x.StorageBackend
+	}
+	return ""
 }
 
-func (x *GetAddressGroupBindingResp) Reset() {
-	*x = GetAddressGroupBindingResp{}
-	mi := &file_netguard_api_proto_msgTypes[61]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetStartupReportResp) GetSchemaVersion() int64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
 }
 
-func (x *GetAddressGroupBindingResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetStartupReportResp) GetSyncers() []*StartupSyncer {
+	if x != nil {
+		return x.Syncers
+	}
+	return nil
 }
 
-func (*GetAddressGroupBindingResp) ProtoMessage() {}
+func (x *GetStartupReportResp) GetReverseSync() *StartupReverseSync {
+	if x != nil {
+		return x.ReverseSync
+	}
+	return nil
+}
 
-func (x *GetAddressGroupBindingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[61]
+func (x *GetStartupReportResp) GetAuthMode() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AuthMode
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAddressGroupBindingResp.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupBindingResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{61}
+func (x *GetStartupReportResp) GetGrpcAddr() string {
+	if x != nil {
+		return x.GrpcAddr
+	}
+	return ""
 }
 
-func (x *GetAddressGroupBindingResp) GetAddressGroupBinding() *AddressGroupBinding {
+func (x *GetStartupReportResp) GetHttpAddr() string {
 	if x != nil {
-		return x.AddressGroupBinding
+		return x.HttpAddr
+	}
+	return ""
+}
+
+func (x *GetStartupReportResp) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
 	}
 	return nil
 }
 
-// GetAddressGroupPortMappingReq - request to get a specific address group port mapping
-type GetAddressGroupPortMappingReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+// GetSchemaStatusResp - schema version of the database and of the backend
+type GetSchemaStatusResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version - last applied migration, 0 if no migration was applied
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// supported_version - last migration known to the backend
+	SupportedVersion int64 `protobuf:"varint,2,opt,name=supported_version,json=supportedVersion,proto3" json:"supported_version,omitempty"`
+	// compatible - false if the backend refuses to serve the database schema
+	Compatible    bool `protobuf:"varint,3,opt,name=compatible,proto3" json:"compatible,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAddressGroupPortMappingReq) Reset() {
-	*x = GetAddressGroupPortMappingReq{}
-	mi := &file_netguard_api_proto_msgTypes[62]
+func (x *GetSchemaStatusResp) Reset() {
+	*x = GetSchemaStatusResp{}
+	mi := &file_netguard_api_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAddressGroupPortMappingReq) String() string {
+func (x *GetSchemaStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressGroupPortMappingReq) ProtoMessage() {}
+func (*GetSchemaStatusResp) ProtoMessage() {}
 
-func (x *GetAddressGroupPortMappingReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[62]
+func (x *GetSchemaStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3972,41 +4633,60 @@ func (x *GetAddressGroupPortMappingReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressGroupPortMappingReq.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupPortMappingReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetSchemaStatusResp.ProtoReflect.Descriptor instead.
+func (*GetSchemaStatusResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetAddressGroupPortMappingReq) GetIdentifier() *ResourceIdentifier {
+func (x *GetSchemaStatusResp) GetVersion() int64 {
 	if x != nil {
-		return x.Identifier
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-// GetAddressGroupPortMappingResp - response with a specific address group port mapping
-type GetAddressGroupPortMappingResp struct {
-	state                   protoimpl.MessageState   `protogen:"open.v1"`
-	AddressGroupPortMapping *AddressGroupPortMapping `protobuf:"bytes,1,opt,name=address_group_port_mapping,json=addressGroupPortMapping,proto3" json:"address_group_port_mapping,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *GetSchemaStatusResp) GetSupportedVersion() int64 {
+	if x != nil {
+		return x.SupportedVersion
+	}
+	return 0
 }
 
-func (x *GetAddressGroupPortMappingResp) Reset() {
-	*x = GetAddressGroupPortMappingResp{}
-	mi := &file_netguard_api_proto_msgTypes[63]
+func (x *GetSchemaStatusResp) GetCompatible() bool {
+	if x != nil {
+		return x.Compatible
+	}
+	return false
+}
+
+// Syncer - sgroups syncers of a subject type
+type Syncer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subject_type - Groups, Networks, Hosts or IEAgAgRules
+	SubjectType string `protobuf:"bytes,1,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	// targets - sgroups targets with a registered syncer of the subject type
+	Targets []string `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	// enabled - false while changes of the subject type are not synchronized
+	Enabled       bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Syncer) Reset() {
+	*x = Syncer{}
+	mi := &file_netguard_api_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAddressGroupPortMappingResp) String() string {
+func (x *Syncer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressGroupPortMappingResp) ProtoMessage() {}
+func (*Syncer) ProtoMessage() {}
 
-func (x *GetAddressGroupPortMappingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[63]
+func (x *Syncer) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4017,41 +4697,55 @@ func (x *GetAddressGroupPortMappingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressGroupPortMappingResp.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupPortMappingResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use Syncer.ProtoReflect.Descriptor instead.
+func (*Syncer) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetAddressGroupPortMappingResp) GetAddressGroupPortMapping() *AddressGroupPortMapping {
+func (x *Syncer) GetSubjectType() string {
 	if x != nil {
-		return x.AddressGroupPortMapping
+		return x.SubjectType
+	}
+	return ""
+}
+
+func (x *Syncer) GetTargets() []string {
+	if x != nil {
+		return x.Targets
 	}
 	return nil
 }
 
-// GetRuleS2SReq - request to get a specific rule s2s
-type GetRuleS2SReq struct {
+func (x *Syncer) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// ListSyncersResp - sgroups syncers
+type ListSyncersResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Items         []*Syncer              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleS2SReq) Reset() {
-	*x = GetRuleS2SReq{}
-	mi := &file_netguard_api_proto_msgTypes[64]
+func (x *ListSyncersResp) Reset() {
+	*x = ListSyncersResp{}
+	mi := &file_netguard_api_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleS2SReq) String() string {
+func (x *ListSyncersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleS2SReq) ProtoMessage() {}
+func (*ListSyncersResp) ProtoMessage() {}
 
-func (x *GetRuleS2SReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[64]
+func (x *ListSyncersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4062,41 +4756,42 @@ func (x *GetRuleS2SReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleS2SReq.ProtoReflect.Descriptor instead.
-func (*GetRuleS2SReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ListSyncersResp.ProtoReflect.Descriptor instead.
+func (*ListSyncersResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetRuleS2SReq) GetIdentifier() *ResourceIdentifier {
+func (x *ListSyncersResp) GetItems() []*Syncer {
 	if x != nil {
-		return x.Identifier
+		return x.Items
 	}
 	return nil
 }
 
-// GetRuleS2SResp - response with a specific rule s2s
-type GetRuleS2SResp struct {
+// SetSyncerEnabledReq - request to enable or disable the syncers of a subject type
+type SetSyncerEnabledReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleS2S       *RuleS2S               `protobuf:"bytes,1,opt,name=rule_s2s,json=ruleS2s,proto3" json:"rule_s2s,omitempty"`
+	SubjectType   string                 `protobuf:"bytes,1,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleS2SResp) Reset() {
-	*x = GetRuleS2SResp{}
-	mi := &file_netguard_api_proto_msgTypes[65]
+func (x *SetSyncerEnabledReq) Reset() {
+	*x = SetSyncerEnabledReq{}
+	mi := &file_netguard_api_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleS2SResp) String() string {
+func (x *SetSyncerEnabledReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleS2SResp) ProtoMessage() {}
+func (*SetSyncerEnabledReq) ProtoMessage() {}
 
-func (x *GetRuleS2SResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[65]
+func (x *SetSyncerEnabledReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4107,41 +4802,48 @@ func (x *GetRuleS2SResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleS2SResp.ProtoReflect.Descriptor instead.
-func (*GetRuleS2SResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use SetSyncerEnabledReq.ProtoReflect.Descriptor instead.
+func (*SetSyncerEnabledReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetRuleS2SResp) GetRuleS2S() *RuleS2S {
+func (x *SetSyncerEnabledReq) GetSubjectType() string {
 	if x != nil {
-		return x.RuleS2S
+		return x.SubjectType
 	}
-	return nil
+	return ""
 }
 
-// GetServiceAliasReq - request to get a specific service alias
-type GetServiceAliasReq struct {
+func (x *SetSyncerEnabledReq) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// PurgeNamespaceReq - request to delete all resources of a namespace
+type PurgeNamespaceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServiceAliasReq) Reset() {
-	*x = GetServiceAliasReq{}
-	mi := &file_netguard_api_proto_msgTypes[66]
+func (x *PurgeNamespaceReq) Reset() {
+	*x = PurgeNamespaceReq{}
+	mi := &file_netguard_api_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServiceAliasReq) String() string {
+func (x *PurgeNamespaceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceAliasReq) ProtoMessage() {}
+func (*PurgeNamespaceReq) ProtoMessage() {}
 
-func (x *GetServiceAliasReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[66]
+func (x *PurgeNamespaceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4152,41 +4854,43 @@ func (x *GetServiceAliasReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceAliasReq.ProtoReflect.Descriptor instead.
-func (*GetServiceAliasReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use PurgeNamespaceReq.ProtoReflect.Descriptor instead.
+func (*PurgeNamespaceReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetServiceAliasReq) GetIdentifier() *ResourceIdentifier {
+func (x *PurgeNamespaceReq) GetNamespace() string {
 	if x != nil {
-		return x.Identifier
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-// GetServiceAliasResp - response with a specific service alias
-type GetServiceAliasResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceAlias  *ServiceAlias          `protobuf:"bytes,1,opt,name=service_alias,json=serviceAlias,proto3" json:"service_alias,omitempty"`
+// PurgeNamespaceResp - resources deleted by a namespace purge
+type PurgeNamespaceResp struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// deleted - number of deleted resources per kind
+	Deleted       map[string]int32 `protobuf:"bytes,2,rep,name=deleted,proto3" json:"deleted,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServiceAliasResp) Reset() {
-	*x = GetServiceAliasResp{}
-	mi := &file_netguard_api_proto_msgTypes[67]
+func (x *PurgeNamespaceResp) Reset() {
+	*x = PurgeNamespaceResp{}
+	mi := &file_netguard_api_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServiceAliasResp) String() string {
+func (x *PurgeNamespaceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceAliasResp) ProtoMessage() {}
+func (*PurgeNamespaceResp) ProtoMessage() {}
 
-func (x *GetServiceAliasResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[67]
+func (x *PurgeNamespaceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4197,41 +4901,48 @@ func (x *GetServiceAliasResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceAliasResp.ProtoReflect.Descriptor instead.
-func (*GetServiceAliasResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use PurgeNamespaceResp.ProtoReflect.Descriptor instead.
+func (*PurgeNamespaceResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetServiceAliasResp) GetServiceAlias() *ServiceAlias {
+func (x *PurgeNamespaceResp) GetNamespace() string {
 	if x != nil {
-		return x.ServiceAlias
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PurgeNamespaceResp) GetDeleted() map[string]int32 {
+	if x != nil {
+		return x.Deleted
 	}
 	return nil
 }
 
-// ListAddressGroupBindingPoliciesReq - request to list address group binding policies
-type ListAddressGroupBindingPoliciesReq struct {
+// SyncServices - subject of Services to sync
+type SyncServices struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAddressGroupBindingPoliciesReq) Reset() {
-	*x = ListAddressGroupBindingPoliciesReq{}
-	mi := &file_netguard_api_proto_msgTypes[68]
+func (x *SyncServices) Reset() {
+	*x = SyncServices{}
+	mi := &file_netguard_api_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAddressGroupBindingPoliciesReq) String() string {
+func (x *SyncServices) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAddressGroupBindingPoliciesReq) ProtoMessage() {}
+func (*SyncServices) ProtoMessage() {}
 
-func (x *ListAddressGroupBindingPoliciesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[68]
+func (x *SyncServices) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4242,41 +4953,41 @@ func (x *ListAddressGroupBindingPoliciesReq) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAddressGroupBindingPoliciesReq.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupBindingPoliciesReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use SyncServices.ProtoReflect.Descriptor instead.
+func (*SyncServices) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ListAddressGroupBindingPoliciesReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *SyncServices) GetServices() []*Service {
 	if x != nil {
-		return x.Identifiers
+		return x.Services
 	}
 	return nil
 }
 
-// ListAddressGroupBindingPoliciesResp - response with list of address group binding policies
-type ListAddressGroupBindingPoliciesResp struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Items         []*AddressGroupBindingPolicy `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+// SyncAddressGroups - subject of Address Groups to sync
+type SyncAddressGroups struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressGroups []*AddressGroup        `protobuf:"bytes,1,rep,name=address_groups,json=addressGroups,proto3" json:"address_groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAddressGroupBindingPoliciesResp) Reset() {
-	*x = ListAddressGroupBindingPoliciesResp{}
-	mi := &file_netguard_api_proto_msgTypes[69]
+func (x *SyncAddressGroups) Reset() {
+	*x = SyncAddressGroups{}
+	mi := &file_netguard_api_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAddressGroupBindingPoliciesResp) String() string {
+func (x *SyncAddressGroups) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAddressGroupBindingPoliciesResp) ProtoMessage() {}
+func (*SyncAddressGroups) ProtoMessage() {}
 
-func (x *ListAddressGroupBindingPoliciesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[69]
+func (x *SyncAddressGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4287,41 +4998,41 @@ func (x *ListAddressGroupBindingPoliciesResp) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAddressGroupBindingPoliciesResp.ProtoReflect.Descriptor instead.
-func (*ListAddressGroupBindingPoliciesResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use SyncAddressGroups.ProtoReflect.Descriptor instead.
+func (*SyncAddressGroups) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListAddressGroupBindingPoliciesResp) GetItems() []*AddressGroupBindingPolicy {
+func (x *SyncAddressGroups) GetAddressGroups() []*AddressGroup {
 	if x != nil {
-		return x.Items
+		return x.AddressGroups
 	}
 	return nil
 }
 
-// GetAddressGroupBindingPolicyReq - request to get a specific address group binding policy
-type GetAddressGroupBindingPolicyReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SyncAddressGroupBindings - subject of Address Group Bindings to sync
+type SyncAddressGroupBindings struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AddressGroupBindings []*AddressGroupBinding `protobuf:"bytes,1,rep,name=address_group_bindings,json=addressGroupBindings,proto3" json:"address_group_bindings,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetAddressGroupBindingPolicyReq) Reset() {
-	*x = GetAddressGroupBindingPolicyReq{}
-	mi := &file_netguard_api_proto_msgTypes[70]
+func (x *SyncAddressGroupBindings) Reset() {
+	*x = SyncAddressGroupBindings{}
+	mi := &file_netguard_api_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAddressGroupBindingPolicyReq) String() string {
+func (x *SyncAddressGroupBindings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressGroupBindingPolicyReq) ProtoMessage() {}
+func (*SyncAddressGroupBindings) ProtoMessage() {}
 
-func (x *GetAddressGroupBindingPolicyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[70]
+func (x *SyncAddressGroupBindings) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4332,41 +5043,41 @@ func (x *GetAddressGroupBindingPolicyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressGroupBindingPolicyReq.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupBindingPolicyReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use SyncAddressGroupBindings.ProtoReflect.Descriptor instead.
+func (*SyncAddressGroupBindings) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetAddressGroupBindingPolicyReq) GetIdentifier() *ResourceIdentifier {
+func (x *SyncAddressGroupBindings) GetAddressGroupBindings() []*AddressGroupBinding {
 	if x != nil {
-		return x.Identifier
+		return x.AddressGroupBindings
 	}
 	return nil
 }
 
-// GetAddressGroupBindingPolicyResp - response with a specific address group binding policy
-type GetAddressGroupBindingPolicyResp struct {
-	state                     protoimpl.MessageState     `protogen:"open.v1"`
-	AddressGroupBindingPolicy *AddressGroupBindingPolicy `protobuf:"bytes,1,opt,name=address_group_binding_policy,json=addressGroupBindingPolicy,proto3" json:"address_group_binding_policy,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+// SyncAddressGroupPortMappings - subject of Address Group Port Mappings to sync
+type SyncAddressGroupPortMappings struct {
+	state                    protoimpl.MessageState     `protogen:"open.v1"`
+	AddressGroupPortMappings []*AddressGroupPortMapping `protobuf:"bytes,1,rep,name=address_group_port_mappings,json=addressGroupPortMappings,proto3" json:"address_group_port_mappings,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetAddressGroupBindingPolicyResp) Reset() {
-	*x = GetAddressGroupBindingPolicyResp{}
-	mi := &file_netguard_api_proto_msgTypes[71]
+func (x *SyncAddressGroupPortMappings) Reset() {
+	*x = SyncAddressGroupPortMappings{}
+	mi := &file_netguard_api_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAddressGroupBindingPolicyResp) String() string {
+func (x *SyncAddressGroupPortMappings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAddressGroupBindingPolicyResp) ProtoMessage() {}
+func (*SyncAddressGroupPortMappings) ProtoMessage() {}
 
-func (x *GetAddressGroupBindingPolicyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[71]
+func (x *SyncAddressGroupPortMappings) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4377,41 +5088,41 @@ func (x *GetAddressGroupBindingPolicyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAddressGroupBindingPolicyResp.ProtoReflect.Descriptor instead.
-func (*GetAddressGroupBindingPolicyResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use SyncAddressGroupPortMappings.ProtoReflect.Descriptor instead.
+func (*SyncAddressGroupPortMappings) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetAddressGroupBindingPolicyResp) GetAddressGroupBindingPolicy() *AddressGroupBindingPolicy {
+func (x *SyncAddressGroupPortMappings) GetAddressGroupPortMappings() []*AddressGroupPortMapping {
 	if x != nil {
-		return x.AddressGroupBindingPolicy
+		return x.AddressGroupPortMappings
 	}
 	return nil
 }
 
-// ListIEAgAgRulesReq - request to list IEAgAgRules
-type ListIEAgAgRulesReq struct {
+// SyncRuleS2S - subject of Rule S2S to sync
+type SyncRuleS2S struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	RuleS2S       []*RuleS2S             `protobuf:"bytes,1,rep,name=rule_s2s,json=ruleS2s,proto3" json:"rule_s2s,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIEAgAgRulesReq) Reset() {
-	*x = ListIEAgAgRulesReq{}
-	mi := &file_netguard_api_proto_msgTypes[72]
+func (x *SyncRuleS2S) Reset() {
+	*x = SyncRuleS2S{}
+	mi := &file_netguard_api_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIEAgAgRulesReq) String() string {
+func (x *SyncRuleS2S) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIEAgAgRulesReq) ProtoMessage() {}
+func (*SyncRuleS2S) ProtoMessage() {}
 
-func (x *ListIEAgAgRulesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[72]
+func (x *SyncRuleS2S) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4422,41 +5133,41 @@ func (x *ListIEAgAgRulesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIEAgAgRulesReq.ProtoReflect.Descriptor instead.
-func (*ListIEAgAgRulesReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use SyncRuleS2S.ProtoReflect.Descriptor instead.
+func (*SyncRuleS2S) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListIEAgAgRulesReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *SyncRuleS2S) GetRuleS2S() []*RuleS2S {
 	if x != nil {
-		return x.Identifiers
+		return x.RuleS2S
 	}
 	return nil
 }
 
-// ListIEAgAgRulesResp - response with list of IEAgAgRules
-type ListIEAgAgRulesResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*IEAgAgRule          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SyncServiceAliases - subject of Service Aliases to sync
+type SyncServiceAliases struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAliases []*ServiceAlias        `protobuf:"bytes,1,rep,name=service_aliases,json=serviceAliases,proto3" json:"service_aliases,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListIEAgAgRulesResp) Reset() {
-	*x = ListIEAgAgRulesResp{}
-	mi := &file_netguard_api_proto_msgTypes[73]
+func (x *SyncServiceAliases) Reset() {
+	*x = SyncServiceAliases{}
+	mi := &file_netguard_api_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIEAgAgRulesResp) String() string {
+func (x *SyncServiceAliases) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIEAgAgRulesResp) ProtoMessage() {}
+func (*SyncServiceAliases) ProtoMessage() {}
 
-func (x *ListIEAgAgRulesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[73]
+func (x *SyncServiceAliases) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4467,41 +5178,41 @@ func (x *ListIEAgAgRulesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIEAgAgRulesResp.ProtoReflect.Descriptor instead.
-func (*ListIEAgAgRulesResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use SyncServiceAliases.ProtoReflect.Descriptor instead.
+func (*SyncServiceAliases) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListIEAgAgRulesResp) GetItems() []*IEAgAgRule {
+func (x *SyncServiceAliases) GetServiceAliases() []*ServiceAlias {
 	if x != nil {
-		return x.Items
+		return x.ServiceAliases
 	}
 	return nil
 }
 
-// GetIEAgAgRuleReq - request to get a specific IEAgAgRule
-type GetIEAgAgRuleReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SyncAddressGroupBindingPolicies - subject of Address Group Binding Policies to sync
+type SyncAddressGroupBindingPolicies struct {
+	state                       protoimpl.MessageState       `protogen:"open.v1"`
+	AddressGroupBindingPolicies []*AddressGroupBindingPolicy `protobuf:"bytes,1,rep,name=address_group_binding_policies,json=addressGroupBindingPolicies,proto3" json:"address_group_binding_policies,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *GetIEAgAgRuleReq) Reset() {
-	*x = GetIEAgAgRuleReq{}
-	mi := &file_netguard_api_proto_msgTypes[74]
+func (x *SyncAddressGroupBindingPolicies) Reset() {
+	*x = SyncAddressGroupBindingPolicies{}
+	mi := &file_netguard_api_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIEAgAgRuleReq) String() string {
+func (x *SyncAddressGroupBindingPolicies) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIEAgAgRuleReq) ProtoMessage() {}
+func (*SyncAddressGroupBindingPolicies) ProtoMessage() {}
 
-func (x *GetIEAgAgRuleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[74]
+func (x *SyncAddressGroupBindingPolicies) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4512,41 +5223,41 @@ func (x *GetIEAgAgRuleReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIEAgAgRuleReq.ProtoReflect.Descriptor instead.
-func (*GetIEAgAgRuleReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use SyncAddressGroupBindingPolicies.ProtoReflect.Descriptor instead.
+func (*SyncAddressGroupBindingPolicies) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetIEAgAgRuleReq) GetIdentifier() *ResourceIdentifier {
+func (x *SyncAddressGroupBindingPolicies) GetAddressGroupBindingPolicies() []*AddressGroupBindingPolicy {
 	if x != nil {
-		return x.Identifier
+		return x.AddressGroupBindingPolicies
 	}
 	return nil
 }
 
-// GetIEAgAgRuleResp - response with a specific IEAgAgRule
-type GetIEAgAgRuleResp struct {
+// SyncIEAgAgRules - subject of IEAgAgRules to sync
+type SyncIEAgAgRules struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IeagagRule    *IEAgAgRule            `protobuf:"bytes,1,opt,name=ieagag_rule,json=ieagagRule,proto3" json:"ieagag_rule,omitempty"`
+	IeagagRules   []*IEAgAgRule          `protobuf:"bytes,1,rep,name=ieagag_rules,json=ieagagRules,proto3" json:"ieagag_rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIEAgAgRuleResp) Reset() {
-	*x = GetIEAgAgRuleResp{}
-	mi := &file_netguard_api_proto_msgTypes[75]
+func (x *SyncIEAgAgRules) Reset() {
+	*x = SyncIEAgAgRules{}
+	mi := &file_netguard_api_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIEAgAgRuleResp) String() string {
+func (x *SyncIEAgAgRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIEAgAgRuleResp) ProtoMessage() {}
+func (*SyncIEAgAgRules) ProtoMessage() {}
 
-func (x *GetIEAgAgRuleResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[75]
+func (x *SyncIEAgAgRules) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4557,41 +5268,41 @@ func (x *GetIEAgAgRuleResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIEAgAgRuleResp.ProtoReflect.Descriptor instead.
-func (*GetIEAgAgRuleResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use SyncIEAgAgRules.ProtoReflect.Descriptor instead.
+func (*SyncIEAgAgRules) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetIEAgAgRuleResp) GetIeagagRule() *IEAgAgRule {
+func (x *SyncIEAgAgRules) GetIeagagRules() []*IEAgAgRule {
 	if x != nil {
-		return x.IeagagRule
+		return x.IeagagRules
 	}
 	return nil
 }
 
-// ListNetworksReq - request to list networks
-type ListNetworksReq struct {
+// SyncNetworks - subject of Networks to sync
+type SyncNetworks struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Networks      []*Network             `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNetworksReq) Reset() {
-	*x = ListNetworksReq{}
-	mi := &file_netguard_api_proto_msgTypes[76]
+func (x *SyncNetworks) Reset() {
+	*x = SyncNetworks{}
+	mi := &file_netguard_api_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworksReq) String() string {
+func (x *SyncNetworks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworksReq) ProtoMessage() {}
+func (*SyncNetworks) ProtoMessage() {}
 
-func (x *ListNetworksReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[76]
+func (x *SyncNetworks) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4602,41 +5313,41 @@ func (x *ListNetworksReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworksReq.ProtoReflect.Descriptor instead.
-func (*ListNetworksReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use SyncNetworks.ProtoReflect.Descriptor instead.
+func (*SyncNetworks) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListNetworksReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *SyncNetworks) GetNetworks() []*Network {
 	if x != nil {
-		return x.Identifiers
+		return x.Networks
 	}
 	return nil
 }
 
-// ListNetworksResp - response with list of networks
-type ListNetworksResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Network             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SyncNetworkBindings - subject of Network Bindings to sync
+type SyncNetworkBindings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NetworkBindings []*NetworkBinding      `protobuf:"bytes,1,rep,name=network_bindings,json=networkBindings,proto3" json:"network_bindings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListNetworksResp) Reset() {
-	*x = ListNetworksResp{}
-	mi := &file_netguard_api_proto_msgTypes[77]
+func (x *SyncNetworkBindings) Reset() {
+	*x = SyncNetworkBindings{}
+	mi := &file_netguard_api_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworksResp) String() string {
+func (x *SyncNetworkBindings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworksResp) ProtoMessage() {}
+func (*SyncNetworkBindings) ProtoMessage() {}
 
-func (x *ListNetworksResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[77]
+func (x *SyncNetworkBindings) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4647,41 +5358,41 @@ func (x *ListNetworksResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworksResp.ProtoReflect.Descriptor instead.
-func (*ListNetworksResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use SyncNetworkBindings.ProtoReflect.Descriptor instead.
+func (*SyncNetworkBindings) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListNetworksResp) GetItems() []*Network {
+func (x *SyncNetworkBindings) GetNetworkBindings() []*NetworkBinding {
 	if x != nil {
-		return x.Items
+		return x.NetworkBindings
 	}
 	return nil
 }
 
-// GetNetworkReq - request to get a specific network
-type GetNetworkReq struct {
+// SyncHosts - subject of Hosts to sync
+type SyncHosts struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Hosts         []*Host                `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNetworkReq) Reset() {
-	*x = GetNetworkReq{}
-	mi := &file_netguard_api_proto_msgTypes[78]
+func (x *SyncHosts) Reset() {
+	*x = SyncHosts{}
+	mi := &file_netguard_api_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkReq) String() string {
+func (x *SyncHosts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkReq) ProtoMessage() {}
+func (*SyncHosts) ProtoMessage() {}
 
-func (x *GetNetworkReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[78]
+func (x *SyncHosts) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4692,41 +5403,41 @@ func (x *GetNetworkReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkReq.ProtoReflect.Descriptor instead.
-func (*GetNetworkReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use SyncHosts.ProtoReflect.Descriptor instead.
+func (*SyncHosts) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetNetworkReq) GetIdentifier() *ResourceIdentifier {
+func (x *SyncHosts) GetHosts() []*Host {
 	if x != nil {
-		return x.Identifier
+		return x.Hosts
 	}
 	return nil
 }
 
-// GetNetworkResp - response with a specific network
-type GetNetworkResp struct {
+// SyncHostBindings - subject of Host Bindings to sync
+type SyncHostBindings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	HostBindings  []*HostBinding         `protobuf:"bytes,1,rep,name=host_bindings,json=hostBindings,proto3" json:"host_bindings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNetworkResp) Reset() {
-	*x = GetNetworkResp{}
-	mi := &file_netguard_api_proto_msgTypes[79]
+func (x *SyncHostBindings) Reset() {
+	*x = SyncHostBindings{}
+	mi := &file_netguard_api_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkResp) String() string {
+func (x *SyncHostBindings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkResp) ProtoMessage() {}
+func (*SyncHostBindings) ProtoMessage() {}
 
-func (x *GetNetworkResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[79]
+func (x *SyncHostBindings) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4737,41 +5448,41 @@ func (x *GetNetworkResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkResp.ProtoReflect.Descriptor instead.
-func (*GetNetworkResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use SyncHostBindings.ProtoReflect.Descriptor instead.
+func (*SyncHostBindings) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetNetworkResp) GetNetwork() *Network {
+func (x *SyncHostBindings) GetHostBindings() []*HostBinding {
 	if x != nil {
-		return x.Network
+		return x.HostBindings
 	}
 	return nil
 }
 
-// ListNetworkBindingsReq - request to list network bindings
-type ListNetworkBindingsReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SyncRuleS2SExceptions - subject of RuleS2S Exceptions to sync
+type SyncRuleS2SExceptions struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RuleS2SExceptions []*RuleS2SException    `protobuf:"bytes,1,rep,name=rule_s2s_exceptions,json=ruleS2sExceptions,proto3" json:"rule_s2s_exceptions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListNetworkBindingsReq) Reset() {
-	*x = ListNetworkBindingsReq{}
-	mi := &file_netguard_api_proto_msgTypes[80]
+func (x *SyncRuleS2SExceptions) Reset() {
+	*x = SyncRuleS2SExceptions{}
+	mi := &file_netguard_api_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworkBindingsReq) String() string {
+func (x *SyncRuleS2SExceptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworkBindingsReq) ProtoMessage() {}
+func (*SyncRuleS2SExceptions) ProtoMessage() {}
 
-func (x *ListNetworkBindingsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[80]
+func (x *SyncRuleS2SExceptions) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4782,41 +5493,41 @@ func (x *ListNetworkBindingsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworkBindingsReq.ProtoReflect.Descriptor instead.
-func (*ListNetworkBindingsReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use SyncRuleS2SExceptions.ProtoReflect.Descriptor instead.
+func (*SyncRuleS2SExceptions) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ListNetworkBindingsReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *SyncRuleS2SExceptions) GetRuleS2SExceptions() []*RuleS2SException {
 	if x != nil {
-		return x.Identifiers
+		return x.RuleS2SExceptions
 	}
 	return nil
 }
 
-// ListNetworkBindingsResp - response with list of network bindings
-type ListNetworkBindingsResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*NetworkBinding      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SyncCrossNamespacePolicies - subject of Cross Namespace Policies to sync
+type SyncCrossNamespacePolicies struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	CrossNamespacePolicies []*CrossNamespacePolicy `protobuf:"bytes,1,rep,name=cross_namespace_policies,json=crossNamespacePolicies,proto3" json:"cross_namespace_policies,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *ListNetworkBindingsResp) Reset() {
-	*x = ListNetworkBindingsResp{}
-	mi := &file_netguard_api_proto_msgTypes[81]
+func (x *SyncCrossNamespacePolicies) Reset() {
+	*x = SyncCrossNamespacePolicies{}
+	mi := &file_netguard_api_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworkBindingsResp) String() string {
+func (x *SyncCrossNamespacePolicies) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworkBindingsResp) ProtoMessage() {}
+func (*SyncCrossNamespacePolicies) ProtoMessage() {}
 
-func (x *ListNetworkBindingsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[81]
+func (x *SyncCrossNamespacePolicies) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,41 +5538,41 @@ func (x *ListNetworkBindingsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworkBindingsResp.ProtoReflect.Descriptor instead.
-func (*ListNetworkBindingsResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use SyncCrossNamespacePolicies.ProtoReflect.Descriptor instead.
+func (*SyncCrossNamespacePolicies) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListNetworkBindingsResp) GetItems() []*NetworkBinding {
+func (x *SyncCrossNamespacePolicies) GetCrossNamespacePolicies() []*CrossNamespacePolicy {
 	if x != nil {
-		return x.Items
+		return x.CrossNamespacePolicies
 	}
 	return nil
 }
 
-// GetNetworkBindingReq - request to get a specific network binding
-type GetNetworkBindingReq struct {
+// SyncRuleTemplates - subject of Rule Templates to sync
+type SyncRuleTemplates struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	RuleTemplates []*RuleTemplate        `protobuf:"bytes,1,rep,name=rule_templates,json=ruleTemplates,proto3" json:"rule_templates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNetworkBindingReq) Reset() {
-	*x = GetNetworkBindingReq{}
-	mi := &file_netguard_api_proto_msgTypes[82]
+func (x *SyncRuleTemplates) Reset() {
+	*x = SyncRuleTemplates{}
+	mi := &file_netguard_api_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkBindingReq) String() string {
+func (x *SyncRuleTemplates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkBindingReq) ProtoMessage() {}
+func (*SyncRuleTemplates) ProtoMessage() {}
 
-func (x *GetNetworkBindingReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[82]
+func (x *SyncRuleTemplates) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4872,41 +5583,41 @@ func (x *GetNetworkBindingReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkBindingReq.ProtoReflect.Descriptor instead.
-func (*GetNetworkBindingReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use SyncRuleTemplates.ProtoReflect.Descriptor instead.
+func (*SyncRuleTemplates) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetNetworkBindingReq) GetIdentifier() *ResourceIdentifier {
+func (x *SyncRuleTemplates) GetRuleTemplates() []*RuleTemplate {
 	if x != nil {
-		return x.Identifier
+		return x.RuleTemplates
 	}
 	return nil
 }
 
-// GetNetworkBindingResp - response with a specific network binding
-type GetNetworkBindingResp struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NetworkBinding *NetworkBinding        `protobuf:"bytes,1,opt,name=network_binding,json=networkBinding,proto3" json:"network_binding,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// SyncNamespacePostures - subject of Namespace Postures to sync
+type SyncNamespacePostures struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespacePostures []*NamespacePosture    `protobuf:"bytes,1,rep,name=namespace_postures,json=namespacePostures,proto3" json:"namespace_postures,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetNetworkBindingResp) Reset() {
-	*x = GetNetworkBindingResp{}
-	mi := &file_netguard_api_proto_msgTypes[83]
+func (x *SyncNamespacePostures) Reset() {
+	*x = SyncNamespacePostures{}
+	mi := &file_netguard_api_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNetworkBindingResp) String() string {
+func (x *SyncNamespacePostures) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNetworkBindingResp) ProtoMessage() {}
+func (*SyncNamespacePostures) ProtoMessage() {}
 
-func (x *GetNetworkBindingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[83]
+func (x *SyncNamespacePostures) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4917,41 +5628,42 @@ func (x *GetNetworkBindingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNetworkBindingResp.ProtoReflect.Descriptor instead.
-func (*GetNetworkBindingResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use SyncNamespacePostures.ProtoReflect.Descriptor instead.
+func (*SyncNamespacePostures) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetNetworkBindingResp) GetNetworkBinding() *NetworkBinding {
+func (x *SyncNamespacePostures) GetNamespacePostures() []*NamespacePosture {
 	if x != nil {
-		return x.NetworkBinding
+		return x.NamespacePostures
 	}
 	return nil
 }
 
-// ListHostsReq - request to list hosts
-type ListHostsReq struct {
+// ListServicesReq - request to list services
+type ListServicesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHostsReq) Reset() {
-	*x = ListHostsReq{}
-	mi := &file_netguard_api_proto_msgTypes[84]
+func (x *ListServicesReq) Reset() {
+	*x = ListServicesReq{}
+	mi := &file_netguard_api_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHostsReq) String() string {
+func (x *ListServicesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHostsReq) ProtoMessage() {}
+func (*ListServicesReq) ProtoMessage() {}
 
-func (x *ListHostsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[84]
+func (x *ListServicesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4962,41 +5674,48 @@ func (x *ListHostsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHostsReq.ProtoReflect.Descriptor instead.
-func (*ListHostsReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use ListServicesReq.ProtoReflect.Descriptor instead.
+func (*ListServicesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListHostsReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *ListServicesReq) GetIdentifiers() []*ResourceIdentifier {
 	if x != nil {
 		return x.Identifiers
 	}
 	return nil
 }
 
-// ListHostsResp - response with list of hosts
-type ListHostsResp struct {
+func (x *ListServicesReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListServicesResp - response with list of services
+type ListServicesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Host                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*Service             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHostsResp) Reset() {
-	*x = ListHostsResp{}
-	mi := &file_netguard_api_proto_msgTypes[85]
+func (x *ListServicesResp) Reset() {
+	*x = ListServicesResp{}
+	mi := &file_netguard_api_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHostsResp) String() string {
+func (x *ListServicesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHostsResp) ProtoMessage() {}
+func (*ListServicesResp) ProtoMessage() {}
 
-func (x *ListHostsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[85]
+func (x *ListServicesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5007,41 +5726,41 @@ func (x *ListHostsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHostsResp.ProtoReflect.Descriptor instead.
-func (*ListHostsResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use ListServicesResp.ProtoReflect.Descriptor instead.
+func (*ListServicesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ListHostsResp) GetItems() []*Host {
+func (x *ListServicesResp) GetItems() []*Service {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-// GetHostReq - request to get a specific host
-type GetHostReq struct {
+// GetServiceReq - request to get a specific service
+type GetServiceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHostReq) Reset() {
-	*x = GetHostReq{}
-	mi := &file_netguard_api_proto_msgTypes[86]
+func (x *GetServiceReq) Reset() {
+	*x = GetServiceReq{}
+	mi := &file_netguard_api_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHostReq) String() string {
+func (x *GetServiceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostReq) ProtoMessage() {}
+func (*GetServiceReq) ProtoMessage() {}
 
-func (x *GetHostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[86]
+func (x *GetServiceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5052,41 +5771,41 @@ func (x *GetHostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostReq.ProtoReflect.Descriptor instead.
-func (*GetHostReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use GetServiceReq.ProtoReflect.Descriptor instead.
+func (*GetServiceReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetHostReq) GetIdentifier() *ResourceIdentifier {
+func (x *GetServiceReq) GetIdentifier() *ResourceIdentifier {
 	if x != nil {
 		return x.Identifier
 	}
 	return nil
 }
 
-// GetHostResp - response with a specific host
-type GetHostResp struct {
+// GetServiceResp - response with a specific service
+type GetServiceResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          *Host                  `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHostResp) Reset() {
-	*x = GetHostResp{}
-	mi := &file_netguard_api_proto_msgTypes[87]
+func (x *GetServiceResp) Reset() {
+	*x = GetServiceResp{}
+	mi := &file_netguard_api_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHostResp) String() string {
+func (x *GetServiceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostResp) ProtoMessage() {}
+func (*GetServiceResp) ProtoMessage() {}
 
-func (x *GetHostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[87]
+func (x *GetServiceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5097,41 +5816,42 @@ func (x *GetHostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostResp.ProtoReflect.Descriptor instead.
-func (*GetHostResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use GetServiceResp.ProtoReflect.Descriptor instead.
+func (*GetServiceResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetHostResp) GetHost() *Host {
+func (x *GetServiceResp) GetService() *Service {
 	if x != nil {
-		return x.Host
+		return x.Service
 	}
 	return nil
 }
 
-// ListHostBindingsReq - request to list host bindings
-type ListHostBindingsReq struct {
+// ListAddressGroupsReq - request to list address groups
+type ListAddressGroupsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHostBindingsReq) Reset() {
-	*x = ListHostBindingsReq{}
-	mi := &file_netguard_api_proto_msgTypes[88]
+func (x *ListAddressGroupsReq) Reset() {
+	*x = ListAddressGroupsReq{}
+	mi := &file_netguard_api_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHostBindingsReq) String() string {
+func (x *ListAddressGroupsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHostBindingsReq) ProtoMessage() {}
+func (*ListAddressGroupsReq) ProtoMessage() {}
 
-func (x *ListHostBindingsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[88]
+func (x *ListAddressGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5142,41 +5862,48 @@ func (x *ListHostBindingsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHostBindingsReq.ProtoReflect.Descriptor instead.
-func (*ListHostBindingsReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ListAddressGroupsReq.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ListHostBindingsReq) GetIdentifiers() []*ResourceIdentifier {
+func (x *ListAddressGroupsReq) GetIdentifiers() []*ResourceIdentifier {
 	if x != nil {
 		return x.Identifiers
 	}
 	return nil
 }
 
-// ListHostBindingsResp - response with list of host bindings
-type ListHostBindingsResp struct {
+func (x *ListAddressGroupsReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListAddressGroupsResp - response with list of address groups
+type ListAddressGroupsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*HostBinding         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*AddressGroup        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListHostBindingsResp) Reset() {
-	*x = ListHostBindingsResp{}
-	mi := &file_netguard_api_proto_msgTypes[89]
+func (x *ListAddressGroupsResp) Reset() {
+	*x = ListAddressGroupsResp{}
+	mi := &file_netguard_api_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListHostBindingsResp) String() string {
+func (x *ListAddressGroupsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListHostBindingsResp) ProtoMessage() {}
+func (*ListAddressGroupsResp) ProtoMessage() {}
 
-func (x *ListHostBindingsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[89]
+func (x *ListAddressGroupsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5187,41 +5914,41 @@ func (x *ListHostBindingsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListHostBindingsResp.ProtoReflect.Descriptor instead.
-func (*ListHostBindingsResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use ListAddressGroupsResp.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ListHostBindingsResp) GetItems() []*HostBinding {
+func (x *ListAddressGroupsResp) GetItems() []*AddressGroup {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-// GetHostBindingReq - request to get a specific host binding
-type GetHostBindingReq struct {
+// GetAddressGroupReq - request to get a specific address group
+type GetAddressGroupReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHostBindingReq) Reset() {
-	*x = GetHostBindingReq{}
-	mi := &file_netguard_api_proto_msgTypes[90]
+func (x *GetAddressGroupReq) Reset() {
+	*x = GetAddressGroupReq{}
+	mi := &file_netguard_api_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHostBindingReq) String() string {
+func (x *GetAddressGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostBindingReq) ProtoMessage() {}
+func (*GetAddressGroupReq) ProtoMessage() {}
 
-func (x *GetHostBindingReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[90]
+func (x *GetAddressGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5232,41 +5959,41 @@ func (x *GetHostBindingReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostBindingReq.ProtoReflect.Descriptor instead.
-func (*GetHostBindingReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use GetAddressGroupReq.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetHostBindingReq) GetIdentifier() *ResourceIdentifier {
+func (x *GetAddressGroupReq) GetIdentifier() *ResourceIdentifier {
 	if x != nil {
 		return x.Identifier
 	}
 	return nil
 }
 
-// GetHostBindingResp - response with a specific host binding
-type GetHostBindingResp struct {
+// GetAddressGroupResp - response with a specific address group
+type GetAddressGroupResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostBinding   *HostBinding           `protobuf:"bytes,1,opt,name=host_binding,json=hostBinding,proto3" json:"host_binding,omitempty"`
+	AddressGroup  *AddressGroup          `protobuf:"bytes,1,opt,name=address_group,json=addressGroup,proto3" json:"address_group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHostBindingResp) Reset() {
-	*x = GetHostBindingResp{}
-	mi := &file_netguard_api_proto_msgTypes[91]
+func (x *GetAddressGroupResp) Reset() {
+	*x = GetAddressGroupResp{}
+	mi := &file_netguard_api_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHostBindingResp) String() string {
+func (x *GetAddressGroupResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHostBindingResp) ProtoMessage() {}
+func (*GetAddressGroupResp) ProtoMessage() {}
 
-func (x *GetHostBindingResp) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[91]
+func (x *GetAddressGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5277,59 +6004,42 @@ func (x *GetHostBindingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHostBindingResp.ProtoReflect.Descriptor instead.
-func (*GetHostBindingResp) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use GetAddressGroupResp.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetHostBindingResp) GetHostBinding() *HostBinding {
+func (x *GetAddressGroupResp) GetAddressGroup() *AddressGroup {
 	if x != nil {
-		return x.HostBinding
+		return x.AddressGroup
 	}
 	return nil
 }
 
-// SyncReq - request to sync
-type SyncReq struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Sync operation to apply
-	SyncOp SyncOp `protobuf:"varint,1,opt,name=sync_op,json=syncOp,proto3,enum=netguard.v1.SyncOp" json:"sync_op,omitempty"`
-	// One of subject
-	//
-	// Types that are valid to be assigned to Subject:
-	//
-	//	*SyncReq_Services
-	//	*SyncReq_AddressGroups
-	//	*SyncReq_AddressGroupBindings
-	//	*SyncReq_AddressGroupPortMappings
-	//	*SyncReq_RuleS2S
-	//	*SyncReq_ServiceAliases
-	//	*SyncReq_AddressGroupBindingPolicies
-	//	*SyncReq_IeagagRules
-	//	*SyncReq_Networks
-	//	*SyncReq_NetworkBindings
-	//	*SyncReq_Hosts
-	//	*SyncReq_HostBindings
-	Subject       isSyncReq_Subject `protobuf_oneof:"subject"`
+// ListAddressGroupBindingsReq - request to list address group bindings
+type ListAddressGroupBindingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncReq) Reset() {
-	*x = SyncReq{}
-	mi := &file_netguard_api_proto_msgTypes[92]
+func (x *ListAddressGroupBindingsReq) Reset() {
+	*x = ListAddressGroupBindingsReq{}
+	mi := &file_netguard_api_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncReq) String() string {
+func (x *ListAddressGroupBindingsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncReq) ProtoMessage() {}
+func (*ListAddressGroupBindingsReq) ProtoMessage() {}
 
-func (x *SyncReq) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[92]
+func (x *ListAddressGroupBindingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5340,221 +6050,4007 @@ func (x *SyncReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncReq.ProtoReflect.Descriptor instead.
-func (*SyncReq) Descriptor() ([]byte, []int) {
-	return file_netguard_api_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use ListAddressGroupBindingsReq.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupBindingsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *SyncReq) GetSyncOp() SyncOp {
+func (x *ListAddressGroupBindingsReq) GetIdentifiers() []*ResourceIdentifier {
 	if x != nil {
-		return x.SyncOp
+		return x.Identifiers
 	}
-	return SyncOp_NoOp
+	return nil
 }
 
-func (x *SyncReq) GetSubject() isSyncReq_Subject {
+func (x *ListAddressGroupBindingsReq) GetSelector() *ListSelector {
 	if x != nil {
-		return x.Subject
+		return x.Selector
 	}
 	return nil
 }
 
-func (x *SyncReq) GetServices() *SyncServices {
+// ListAddressGroupBindingsResp - response with list of address group bindings
+type ListAddressGroupBindingsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AddressGroupBinding `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressGroupBindingsResp) Reset() {
+	*x = ListAddressGroupBindingsResp{}
+	mi := &file_netguard_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressGroupBindingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressGroupBindingsResp) ProtoMessage() {}
+
+func (x *ListAddressGroupBindingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[87]
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_Services); ok {
-			return x.Services
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SyncReq) GetAddressGroups() *SyncAddressGroups {
+// Deprecated: Use ListAddressGroupBindingsResp.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupBindingsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListAddressGroupBindingsResp) GetItems() []*AddressGroupBinding {
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_AddressGroups); ok {
-			return x.AddressGroups
-		}
+		return x.Items
 	}
 	return nil
 }
 
-func (x *SyncReq) GetAddressGroupBindings() *SyncAddressGroupBindings {
+// ListAddressGroupPortMappingsReq - request to list address group port mappings
+type ListAddressGroupPortMappingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressGroupPortMappingsReq) Reset() {
+	*x = ListAddressGroupPortMappingsReq{}
+	mi := &file_netguard_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressGroupPortMappingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressGroupPortMappingsReq) ProtoMessage() {}
+
+func (x *ListAddressGroupPortMappingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[88]
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_AddressGroupBindings); ok {
-			return x.AddressGroupBindings
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressGroupPortMappingsReq.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupPortMappingsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListAddressGroupPortMappingsReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
 	}
 	return nil
 }
 
-func (x *SyncReq) GetAddressGroupPortMappings() *SyncAddressGroupPortMappings {
+func (x *ListAddressGroupPortMappingsReq) GetSelector() *ListSelector {
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_AddressGroupPortMappings); ok {
-			return x.AddressGroupPortMappings
-		}
+		return x.Selector
 	}
 	return nil
 }
 
-func (x *SyncReq) GetRuleS2S() *SyncRuleS2S {
+// ListAddressGroupPortMappingsResp - response with list of address group port mappings
+type ListAddressGroupPortMappingsResp struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Items         []*AddressGroupPortMapping `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressGroupPortMappingsResp) Reset() {
+	*x = ListAddressGroupPortMappingsResp{}
+	mi := &file_netguard_api_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressGroupPortMappingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressGroupPortMappingsResp) ProtoMessage() {}
+
+func (x *ListAddressGroupPortMappingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[89]
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_RuleS2S); ok {
-			return x.RuleS2S
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressGroupPortMappingsResp.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupPortMappingsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListAddressGroupPortMappingsResp) GetItems() []*AddressGroupPortMapping {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-func (x *SyncReq) GetServiceAliases() *SyncServiceAliases {
+// ListRuleS2SReq - request to list rule s2s
+type ListRuleS2SReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleS2SReq) Reset() {
+	*x = ListRuleS2SReq{}
+	mi := &file_netguard_api_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleS2SReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleS2SReq) ProtoMessage() {}
+
+func (x *ListRuleS2SReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[90]
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_ServiceAliases); ok {
-			return x.ServiceAliases
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleS2SReq.ProtoReflect.Descriptor instead.
+func (*ListRuleS2SReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListRuleS2SReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
 	}
 	return nil
 }
 
-func (x *SyncReq) GetAddressGroupBindingPolicies() *SyncAddressGroupBindingPolicies {
+func (x *ListRuleS2SReq) GetSelector() *ListSelector {
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_AddressGroupBindingPolicies); ok {
-			return x.AddressGroupBindingPolicies
-		}
+		return x.Selector
 	}
 	return nil
 }
 
-func (x *SyncReq) GetIeagagRules() *SyncIEAgAgRules {
+// ListRuleS2SResp - response with list of rule s2s
+type ListRuleS2SResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RuleS2S             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleS2SResp) Reset() {
+	*x = ListRuleS2SResp{}
+	mi := &file_netguard_api_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleS2SResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleS2SResp) ProtoMessage() {}
+
+func (x *ListRuleS2SResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[91]
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_IeagagRules); ok {
-			return x.IeagagRules
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleS2SResp.ProtoReflect.Descriptor instead.
+func (*ListRuleS2SResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListRuleS2SResp) GetItems() []*RuleS2S {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-func (x *SyncReq) GetNetworks() *SyncNetworks {
+// ListServiceAliasesReq - request to list service aliases
+type ListServiceAliasesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAliasesReq) Reset() {
+	*x = ListServiceAliasesReq{}
+	mi := &file_netguard_api_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAliasesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAliasesReq) ProtoMessage() {}
+
+func (x *ListServiceAliasesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAliasesReq.ProtoReflect.Descriptor instead.
+func (*ListServiceAliasesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListServiceAliasesReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *ListServiceAliasesReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListServiceAliasesResp - response with list of service aliases
+type ListServiceAliasesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ServiceAlias        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceAliasesResp) Reset() {
+	*x = ListServiceAliasesResp{}
+	mi := &file_netguard_api_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceAliasesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAliasesResp) ProtoMessage() {}
+
+func (x *ListServiceAliasesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAliasesResp.ProtoReflect.Descriptor instead.
+func (*ListServiceAliasesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListServiceAliasesResp) GetItems() []*ServiceAlias {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetAddressGroupBindingReq - request to get a specific address group binding
+type GetAddressGroupBindingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressGroupBindingReq) Reset() {
+	*x = GetAddressGroupBindingReq{}
+	mi := &file_netguard_api_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressGroupBindingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressGroupBindingReq) ProtoMessage() {}
+
+func (x *GetAddressGroupBindingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressGroupBindingReq.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupBindingReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetAddressGroupBindingReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetAddressGroupBindingResp - response with a specific address group binding
+type GetAddressGroupBindingResp struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AddressGroupBinding *AddressGroupBinding   `protobuf:"bytes,1,opt,name=address_group_binding,json=addressGroupBinding,proto3" json:"address_group_binding,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetAddressGroupBindingResp) Reset() {
+	*x = GetAddressGroupBindingResp{}
+	mi := &file_netguard_api_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressGroupBindingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressGroupBindingResp) ProtoMessage() {}
+
+func (x *GetAddressGroupBindingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressGroupBindingResp.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupBindingResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetAddressGroupBindingResp) GetAddressGroupBinding() *AddressGroupBinding {
+	if x != nil {
+		return x.AddressGroupBinding
+	}
+	return nil
+}
+
+// GetAddressGroupPortMappingReq - request to get a specific address group port mapping
+type GetAddressGroupPortMappingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressGroupPortMappingReq) Reset() {
+	*x = GetAddressGroupPortMappingReq{}
+	mi := &file_netguard_api_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressGroupPortMappingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressGroupPortMappingReq) ProtoMessage() {}
+
+func (x *GetAddressGroupPortMappingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressGroupPortMappingReq.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupPortMappingReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetAddressGroupPortMappingReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetAddressGroupPortMappingResp - response with a specific address group port mapping
+type GetAddressGroupPortMappingResp struct {
+	state                   protoimpl.MessageState   `protogen:"open.v1"`
+	AddressGroupPortMapping *AddressGroupPortMapping `protobuf:"bytes,1,opt,name=address_group_port_mapping,json=addressGroupPortMapping,proto3" json:"address_group_port_mapping,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetAddressGroupPortMappingResp) Reset() {
+	*x = GetAddressGroupPortMappingResp{}
+	mi := &file_netguard_api_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressGroupPortMappingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressGroupPortMappingResp) ProtoMessage() {}
+
+func (x *GetAddressGroupPortMappingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressGroupPortMappingResp.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupPortMappingResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetAddressGroupPortMappingResp) GetAddressGroupPortMapping() *AddressGroupPortMapping {
+	if x != nil {
+		return x.AddressGroupPortMapping
+	}
+	return nil
+}
+
+// GetRuleS2SReq - request to get a specific rule s2s
+type GetRuleS2SReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleS2SReq) Reset() {
+	*x = GetRuleS2SReq{}
+	mi := &file_netguard_api_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleS2SReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleS2SReq) ProtoMessage() {}
+
+func (x *GetRuleS2SReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleS2SReq.ProtoReflect.Descriptor instead.
+func (*GetRuleS2SReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetRuleS2SReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetRuleS2SResp - response with a specific rule s2s
+type GetRuleS2SResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleS2S       *RuleS2S               `protobuf:"bytes,1,opt,name=rule_s2s,json=ruleS2s,proto3" json:"rule_s2s,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleS2SResp) Reset() {
+	*x = GetRuleS2SResp{}
+	mi := &file_netguard_api_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleS2SResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleS2SResp) ProtoMessage() {}
+
+func (x *GetRuleS2SResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleS2SResp.ProtoReflect.Descriptor instead.
+func (*GetRuleS2SResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetRuleS2SResp) GetRuleS2S() *RuleS2S {
+	if x != nil {
+		return x.RuleS2S
+	}
+	return nil
+}
+
+// GetServiceAliasReq - request to get a specific service alias
+type GetServiceAliasReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceAliasReq) Reset() {
+	*x = GetServiceAliasReq{}
+	mi := &file_netguard_api_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAliasReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAliasReq) ProtoMessage() {}
+
+func (x *GetServiceAliasReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAliasReq.ProtoReflect.Descriptor instead.
+func (*GetServiceAliasReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetServiceAliasReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetServiceAliasResp - response with a specific service alias
+type GetServiceAliasResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceAlias  *ServiceAlias          `protobuf:"bytes,1,opt,name=service_alias,json=serviceAlias,proto3" json:"service_alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceAliasResp) Reset() {
+	*x = GetServiceAliasResp{}
+	mi := &file_netguard_api_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceAliasResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAliasResp) ProtoMessage() {}
+
+func (x *GetServiceAliasResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAliasResp.ProtoReflect.Descriptor instead.
+func (*GetServiceAliasResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetServiceAliasResp) GetServiceAlias() *ServiceAlias {
+	if x != nil {
+		return x.ServiceAlias
+	}
+	return nil
+}
+
+// ListAddressGroupBindingPoliciesReq - request to list address group binding policies
+type ListAddressGroupBindingPoliciesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressGroupBindingPoliciesReq) Reset() {
+	*x = ListAddressGroupBindingPoliciesReq{}
+	mi := &file_netguard_api_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressGroupBindingPoliciesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressGroupBindingPoliciesReq) ProtoMessage() {}
+
+func (x *ListAddressGroupBindingPoliciesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressGroupBindingPoliciesReq.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupBindingPoliciesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListAddressGroupBindingPoliciesReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *ListAddressGroupBindingPoliciesReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListAddressGroupBindingPoliciesResp - response with list of address group binding policies
+type ListAddressGroupBindingPoliciesResp struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Items         []*AddressGroupBindingPolicy `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressGroupBindingPoliciesResp) Reset() {
+	*x = ListAddressGroupBindingPoliciesResp{}
+	mi := &file_netguard_api_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressGroupBindingPoliciesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressGroupBindingPoliciesResp) ProtoMessage() {}
+
+func (x *ListAddressGroupBindingPoliciesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressGroupBindingPoliciesResp.ProtoReflect.Descriptor instead.
+func (*ListAddressGroupBindingPoliciesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListAddressGroupBindingPoliciesResp) GetItems() []*AddressGroupBindingPolicy {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetAddressGroupBindingPolicyReq - request to get a specific address group binding policy
+type GetAddressGroupBindingPolicyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAddressGroupBindingPolicyReq) Reset() {
+	*x = GetAddressGroupBindingPolicyReq{}
+	mi := &file_netguard_api_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressGroupBindingPolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressGroupBindingPolicyReq) ProtoMessage() {}
+
+func (x *GetAddressGroupBindingPolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressGroupBindingPolicyReq.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupBindingPolicyReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetAddressGroupBindingPolicyReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetAddressGroupBindingPolicyResp - response with a specific address group binding policy
+type GetAddressGroupBindingPolicyResp struct {
+	state                     protoimpl.MessageState     `protogen:"open.v1"`
+	AddressGroupBindingPolicy *AddressGroupBindingPolicy `protobuf:"bytes,1,opt,name=address_group_binding_policy,json=addressGroupBindingPolicy,proto3" json:"address_group_binding_policy,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetAddressGroupBindingPolicyResp) Reset() {
+	*x = GetAddressGroupBindingPolicyResp{}
+	mi := &file_netguard_api_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAddressGroupBindingPolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressGroupBindingPolicyResp) ProtoMessage() {}
+
+func (x *GetAddressGroupBindingPolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressGroupBindingPolicyResp.ProtoReflect.Descriptor instead.
+func (*GetAddressGroupBindingPolicyResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetAddressGroupBindingPolicyResp) GetAddressGroupBindingPolicy() *AddressGroupBindingPolicy {
+	if x != nil {
+		return x.AddressGroupBindingPolicy
+	}
+	return nil
+}
+
+// ListIEAgAgRulesReq - request to list IEAgAgRules
+type ListIEAgAgRulesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIEAgAgRulesReq) Reset() {
+	*x = ListIEAgAgRulesReq{}
+	mi := &file_netguard_api_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIEAgAgRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIEAgAgRulesReq) ProtoMessage() {}
+
+func (x *ListIEAgAgRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIEAgAgRulesReq.ProtoReflect.Descriptor instead.
+func (*ListIEAgAgRulesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListIEAgAgRulesReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *ListIEAgAgRulesReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListIEAgAgRulesResp - response with list of IEAgAgRules
+type ListIEAgAgRulesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*IEAgAgRule          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIEAgAgRulesResp) Reset() {
+	*x = ListIEAgAgRulesResp{}
+	mi := &file_netguard_api_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIEAgAgRulesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIEAgAgRulesResp) ProtoMessage() {}
+
+func (x *ListIEAgAgRulesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIEAgAgRulesResp.ProtoReflect.Descriptor instead.
+func (*ListIEAgAgRulesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListIEAgAgRulesResp) GetItems() []*IEAgAgRule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetIEAgAgRuleReq - request to get a specific IEAgAgRule
+type GetIEAgAgRuleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIEAgAgRuleReq) Reset() {
+	*x = GetIEAgAgRuleReq{}
+	mi := &file_netguard_api_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIEAgAgRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIEAgAgRuleReq) ProtoMessage() {}
+
+func (x *GetIEAgAgRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIEAgAgRuleReq.ProtoReflect.Descriptor instead.
+func (*GetIEAgAgRuleReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetIEAgAgRuleReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetIEAgAgRuleResp - response with a specific IEAgAgRule
+type GetIEAgAgRuleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IeagagRule    *IEAgAgRule            `protobuf:"bytes,1,opt,name=ieagag_rule,json=ieagagRule,proto3" json:"ieagag_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIEAgAgRuleResp) Reset() {
+	*x = GetIEAgAgRuleResp{}
+	mi := &file_netguard_api_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIEAgAgRuleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIEAgAgRuleResp) ProtoMessage() {}
+
+func (x *GetIEAgAgRuleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIEAgAgRuleResp.ProtoReflect.Descriptor instead.
+func (*GetIEAgAgRuleResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetIEAgAgRuleResp) GetIeagagRule() *IEAgAgRule {
+	if x != nil {
+		return x.IeagagRule
+	}
+	return nil
+}
+
+// IEAgAgRuleContribution - ports a RuleS2S contributes to an aggregated IEAgAgRule
+type IEAgAgRuleContribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleS2S       *ResourceIdentifier    `protobuf:"bytes,1,opt,name=rule_s2s,json=ruleS2s,proto3" json:"rule_s2s,omitempty"`
+	IeagagRule    *ResourceIdentifier    `protobuf:"bytes,2,opt,name=ieagag_rule,json=ieagagRule,proto3" json:"ieagag_rule,omitempty"`
+	Ports         []string               `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"` // Ports before exception ports are subtracted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IEAgAgRuleContribution) Reset() {
+	*x = IEAgAgRuleContribution{}
+	mi := &file_netguard_api_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IEAgAgRuleContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IEAgAgRuleContribution) ProtoMessage() {}
+
+func (x *IEAgAgRuleContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IEAgAgRuleContribution.ProtoReflect.Descriptor instead.
+func (*IEAgAgRuleContribution) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *IEAgAgRuleContribution) GetRuleS2S() *ResourceIdentifier {
+	if x != nil {
+		return x.RuleS2S
+	}
+	return nil
+}
+
+func (x *IEAgAgRuleContribution) GetIeagagRule() *ResourceIdentifier {
+	if x != nil {
+		return x.IeagagRule
+	}
+	return nil
+}
+
+func (x *IEAgAgRuleContribution) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// ListIEAgAgRuleContributionsReq - request to explain the aggregation of IEAgAgRules
+type ListIEAgAgRuleContributionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleS2S       []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=rule_s2s,json=ruleS2s,proto3" json:"rule_s2s,omitempty"`             // Contributions of these RuleS2S
+	IeagagRules   []*ResourceIdentifier  `protobuf:"bytes,2,rep,name=ieagag_rules,json=ieagagRules,proto3" json:"ieagag_rules,omitempty"` // Contributions to these IEAgAgRules, all contributions when both are empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIEAgAgRuleContributionsReq) Reset() {
+	*x = ListIEAgAgRuleContributionsReq{}
+	mi := &file_netguard_api_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIEAgAgRuleContributionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIEAgAgRuleContributionsReq) ProtoMessage() {}
+
+func (x *ListIEAgAgRuleContributionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIEAgAgRuleContributionsReq.ProtoReflect.Descriptor instead.
+func (*ListIEAgAgRuleContributionsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListIEAgAgRuleContributionsReq) GetRuleS2S() []*ResourceIdentifier {
+	if x != nil {
+		return x.RuleS2S
+	}
+	return nil
+}
+
+func (x *ListIEAgAgRuleContributionsReq) GetIeagagRules() []*ResourceIdentifier {
+	if x != nil {
+		return x.IeagagRules
+	}
+	return nil
+}
+
+// ListIEAgAgRuleContributionsResp - response with contributions of RuleS2S to IEAgAgRules
+type ListIEAgAgRuleContributionsResp struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*IEAgAgRuleContribution `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIEAgAgRuleContributionsResp) Reset() {
+	*x = ListIEAgAgRuleContributionsResp{}
+	mi := &file_netguard_api_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIEAgAgRuleContributionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIEAgAgRuleContributionsResp) ProtoMessage() {}
+
+func (x *ListIEAgAgRuleContributionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIEAgAgRuleContributionsResp.ProtoReflect.Descriptor instead.
+func (*ListIEAgAgRuleContributionsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListIEAgAgRuleContributionsResp) GetItems() []*IEAgAgRuleContribution {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ConditionTransition - change of the status of a resource condition
+type ConditionTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // Kind of the resource, e.g. RuleS2S
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                               // Condition type, e.g. Ready
+	FromStatus    string                 `protobuf:"bytes,5,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // Empty when the condition appeared
+	ToStatus      string                 `protobuf:"bytes,6,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConditionTransition) Reset() {
+	*x = ConditionTransition{}
+	mi := &file_netguard_api_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConditionTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionTransition) ProtoMessage() {}
+
+func (x *ConditionTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionTransition.ProtoReflect.Descriptor instead.
+func (*ConditionTransition) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ConditionTransition) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConditionTransition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConditionTransition) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *ConditionTransition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConditionTransition) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *ConditionTransition) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *ConditionTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ConditionTransition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConditionTransition) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// ListConditionTransitionsReq - request to list the condition history
+type ListConditionTransitionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                       // Transitions of all kinds when empty
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`           // Transitions of all resources of the kind when unset
+	AfterId       int64                  `protobuf:"varint,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"` // Only transitions recorded after this one
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                    // No limit when zero
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConditionTransitionsReq) Reset() {
+	*x = ListConditionTransitionsReq{}
+	mi := &file_netguard_api_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConditionTransitionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConditionTransitionsReq) ProtoMessage() {}
+
+func (x *ListConditionTransitionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConditionTransitionsReq.ProtoReflect.Descriptor instead.
+func (*ListConditionTransitionsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListConditionTransitionsReq) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListConditionTransitionsReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *ListConditionTransitionsReq) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListConditionTransitionsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListConditionTransitionsResp - response with condition transitions in recording order
+type ListConditionTransitionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ConditionTransition `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	LastId        int64                  `protobuf:"varint,2,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"` // ID of the last recorded transition of any resource
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConditionTransitionsResp) Reset() {
+	*x = ListConditionTransitionsResp{}
+	mi := &file_netguard_api_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConditionTransitionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConditionTransitionsResp) ProtoMessage() {}
+
+func (x *ListConditionTransitionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConditionTransitionsResp.ProtoReflect.Descriptor instead.
+func (*ListConditionTransitionsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListConditionTransitionsResp) GetItems() []*ConditionTransition {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListConditionTransitionsResp) GetLastId() int64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+// ListNetworksReq - request to list networks
+type ListNetworksReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksReq) Reset() {
+	*x = ListNetworksReq{}
+	mi := &file_netguard_api_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksReq) ProtoMessage() {}
+
+func (x *ListNetworksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksReq.ProtoReflect.Descriptor instead.
+func (*ListNetworksReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListNetworksReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *ListNetworksReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListNetworksResp - response with list of networks
+type ListNetworksResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Network             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksResp) Reset() {
+	*x = ListNetworksResp{}
+	mi := &file_netguard_api_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksResp) ProtoMessage() {}
+
+func (x *ListNetworksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksResp.ProtoReflect.Descriptor instead.
+func (*ListNetworksResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListNetworksResp) GetItems() []*Network {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetNetworkReq - request to get a specific network
+type GetNetworkReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkReq) Reset() {
+	*x = GetNetworkReq{}
+	mi := &file_netguard_api_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkReq) ProtoMessage() {}
+
+func (x *GetNetworkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkReq.ProtoReflect.Descriptor instead.
+func (*GetNetworkReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetNetworkReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetNetworkResp - response with a specific network
+type GetNetworkResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkResp) Reset() {
+	*x = GetNetworkResp{}
+	mi := &file_netguard_api_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkResp) ProtoMessage() {}
+
+func (x *GetNetworkResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkResp.ProtoReflect.Descriptor instead.
+func (*GetNetworkResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetNetworkResp) GetNetwork() *Network {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+// ListNetworkBindingsReq - request to list network bindings
+type ListNetworkBindingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworkBindingsReq) Reset() {
+	*x = ListNetworkBindingsReq{}
+	mi := &file_netguard_api_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworkBindingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworkBindingsReq) ProtoMessage() {}
+
+func (x *ListNetworkBindingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworkBindingsReq.ProtoReflect.Descriptor instead.
+func (*ListNetworkBindingsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListNetworkBindingsReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *ListNetworkBindingsReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListNetworkBindingsResp - response with list of network bindings
+type ListNetworkBindingsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NetworkBinding      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworkBindingsResp) Reset() {
+	*x = ListNetworkBindingsResp{}
+	mi := &file_netguard_api_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworkBindingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworkBindingsResp) ProtoMessage() {}
+
+func (x *ListNetworkBindingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworkBindingsResp.ProtoReflect.Descriptor instead.
+func (*ListNetworkBindingsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListNetworkBindingsResp) GetItems() []*NetworkBinding {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetNetworkBindingReq - request to get a specific network binding
+type GetNetworkBindingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNetworkBindingReq) Reset() {
+	*x = GetNetworkBindingReq{}
+	mi := &file_netguard_api_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkBindingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkBindingReq) ProtoMessage() {}
+
+func (x *GetNetworkBindingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkBindingReq.ProtoReflect.Descriptor instead.
+func (*GetNetworkBindingReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetNetworkBindingReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetNetworkBindingResp - response with a specific network binding
+type GetNetworkBindingResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NetworkBinding *NetworkBinding        `protobuf:"bytes,1,opt,name=network_binding,json=networkBinding,proto3" json:"network_binding,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetNetworkBindingResp) Reset() {
+	*x = GetNetworkBindingResp{}
+	mi := &file_netguard_api_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNetworkBindingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkBindingResp) ProtoMessage() {}
+
+func (x *GetNetworkBindingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkBindingResp.ProtoReflect.Descriptor instead.
+func (*GetNetworkBindingResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetNetworkBindingResp) GetNetworkBinding() *NetworkBinding {
+	if x != nil {
+		return x.NetworkBinding
+	}
+	return nil
+}
+
+// ListHostsReq - request to list hosts
+type ListHostsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHostsReq) Reset() {
+	*x = ListHostsReq{}
+	mi := &file_netguard_api_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHostsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHostsReq) ProtoMessage() {}
+
+func (x *ListHostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHostsReq.ProtoReflect.Descriptor instead.
+func (*ListHostsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListHostsReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *ListHostsReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListHostsResp - response with list of hosts
+type ListHostsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Host                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHostsResp) Reset() {
+	*x = ListHostsResp{}
+	mi := &file_netguard_api_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHostsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHostsResp) ProtoMessage() {}
+
+func (x *ListHostsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHostsResp.ProtoReflect.Descriptor instead.
+func (*ListHostsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListHostsResp) GetItems() []*Host {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetHostReq - request to get a specific host
+type GetHostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostReq) Reset() {
+	*x = GetHostReq{}
+	mi := &file_netguard_api_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostReq) ProtoMessage() {}
+
+func (x *GetHostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostReq.ProtoReflect.Descriptor instead.
+func (*GetHostReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetHostReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetHostResp - response with a specific host
+type GetHostResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          *Host                  `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostResp) Reset() {
+	*x = GetHostResp{}
+	mi := &file_netguard_api_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostResp) ProtoMessage() {}
+
+func (x *GetHostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostResp.ProtoReflect.Descriptor instead.
+func (*GetHostResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetHostResp) GetHost() *Host {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+// ListHostBindingsReq - request to list host bindings
+type ListHostBindingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	Selector      *ListSelector          `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"` // Only resources matching the selectors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHostBindingsReq) Reset() {
+	*x = ListHostBindingsReq{}
+	mi := &file_netguard_api_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHostBindingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHostBindingsReq) ProtoMessage() {}
+
+func (x *ListHostBindingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHostBindingsReq.ProtoReflect.Descriptor instead.
+func (*ListHostBindingsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListHostBindingsReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+func (x *ListHostBindingsReq) GetSelector() *ListSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ListHostBindingsResp - response with list of host bindings
+type ListHostBindingsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*HostBinding         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHostBindingsResp) Reset() {
+	*x = ListHostBindingsResp{}
+	mi := &file_netguard_api_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHostBindingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHostBindingsResp) ProtoMessage() {}
+
+func (x *ListHostBindingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHostBindingsResp.ProtoReflect.Descriptor instead.
+func (*ListHostBindingsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListHostBindingsResp) GetItems() []*HostBinding {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetHostBindingReq - request to get a specific host binding
+type GetHostBindingReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostBindingReq) Reset() {
+	*x = GetHostBindingReq{}
+	mi := &file_netguard_api_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostBindingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostBindingReq) ProtoMessage() {}
+
+func (x *GetHostBindingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostBindingReq.ProtoReflect.Descriptor instead.
+func (*GetHostBindingReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetHostBindingReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetHostBindingResp - response with a specific host binding
+type GetHostBindingResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostBinding   *HostBinding           `protobuf:"bytes,1,opt,name=host_binding,json=hostBinding,proto3" json:"host_binding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostBindingResp) Reset() {
+	*x = GetHostBindingResp{}
+	mi := &file_netguard_api_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostBindingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostBindingResp) ProtoMessage() {}
+
+func (x *GetHostBindingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostBindingResp.ProtoReflect.Descriptor instead.
+func (*GetHostBindingResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetHostBindingResp) GetHostBinding() *HostBinding {
+	if x != nil {
+		return x.HostBinding
+	}
+	return nil
+}
+
+// ListRuleS2SExceptionsReq - request to list RuleS2S exceptions
+type ListRuleS2SExceptionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleS2SExceptionsReq) Reset() {
+	*x = ListRuleS2SExceptionsReq{}
+	mi := &file_netguard_api_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleS2SExceptionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleS2SExceptionsReq) ProtoMessage() {}
+
+func (x *ListRuleS2SExceptionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleS2SExceptionsReq.ProtoReflect.Descriptor instead.
+func (*ListRuleS2SExceptionsReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListRuleS2SExceptionsReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+// ListRuleS2SExceptionsResp - response with list of RuleS2S exceptions
+type ListRuleS2SExceptionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RuleS2SException    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleS2SExceptionsResp) Reset() {
+	*x = ListRuleS2SExceptionsResp{}
+	mi := &file_netguard_api_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleS2SExceptionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleS2SExceptionsResp) ProtoMessage() {}
+
+func (x *ListRuleS2SExceptionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleS2SExceptionsResp.ProtoReflect.Descriptor instead.
+func (*ListRuleS2SExceptionsResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListRuleS2SExceptionsResp) GetItems() []*RuleS2SException {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetRuleS2SExceptionReq - request to get a specific RuleS2S exception
+type GetRuleS2SExceptionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleS2SExceptionReq) Reset() {
+	*x = GetRuleS2SExceptionReq{}
+	mi := &file_netguard_api_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleS2SExceptionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleS2SExceptionReq) ProtoMessage() {}
+
+func (x *GetRuleS2SExceptionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleS2SExceptionReq.ProtoReflect.Descriptor instead.
+func (*GetRuleS2SExceptionReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetRuleS2SExceptionReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetRuleS2SExceptionResp - response with a specific RuleS2S exception
+type GetRuleS2SExceptionResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RuleS2SException *RuleS2SException      `protobuf:"bytes,1,opt,name=rule_s2s_exception,json=ruleS2sException,proto3" json:"rule_s2s_exception,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetRuleS2SExceptionResp) Reset() {
+	*x = GetRuleS2SExceptionResp{}
+	mi := &file_netguard_api_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleS2SExceptionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleS2SExceptionResp) ProtoMessage() {}
+
+func (x *GetRuleS2SExceptionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleS2SExceptionResp.ProtoReflect.Descriptor instead.
+func (*GetRuleS2SExceptionResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetRuleS2SExceptionResp) GetRuleS2SException() *RuleS2SException {
+	if x != nil {
+		return x.RuleS2SException
+	}
+	return nil
+}
+
+// ListCrossNamespacePoliciesReq - request to list cross namespace policies
+type ListCrossNamespacePoliciesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCrossNamespacePoliciesReq) Reset() {
+	*x = ListCrossNamespacePoliciesReq{}
+	mi := &file_netguard_api_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCrossNamespacePoliciesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrossNamespacePoliciesReq) ProtoMessage() {}
+
+func (x *ListCrossNamespacePoliciesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrossNamespacePoliciesReq.ProtoReflect.Descriptor instead.
+func (*ListCrossNamespacePoliciesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListCrossNamespacePoliciesReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+// ListCrossNamespacePoliciesResp - response with list of cross namespace policies
+type ListCrossNamespacePoliciesResp struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*CrossNamespacePolicy `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCrossNamespacePoliciesResp) Reset() {
+	*x = ListCrossNamespacePoliciesResp{}
+	mi := &file_netguard_api_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCrossNamespacePoliciesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCrossNamespacePoliciesResp) ProtoMessage() {}
+
+func (x *ListCrossNamespacePoliciesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCrossNamespacePoliciesResp.ProtoReflect.Descriptor instead.
+func (*ListCrossNamespacePoliciesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListCrossNamespacePoliciesResp) GetItems() []*CrossNamespacePolicy {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetCrossNamespacePolicyReq - request to get a specific cross namespace policy
+type GetCrossNamespacePolicyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCrossNamespacePolicyReq) Reset() {
+	*x = GetCrossNamespacePolicyReq{}
+	mi := &file_netguard_api_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrossNamespacePolicyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrossNamespacePolicyReq) ProtoMessage() {}
+
+func (x *GetCrossNamespacePolicyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrossNamespacePolicyReq.ProtoReflect.Descriptor instead.
+func (*GetCrossNamespacePolicyReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetCrossNamespacePolicyReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetCrossNamespacePolicyResp - response with a specific cross namespace policy
+type GetCrossNamespacePolicyResp struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CrossNamespacePolicy *CrossNamespacePolicy  `protobuf:"bytes,1,opt,name=cross_namespace_policy,json=crossNamespacePolicy,proto3" json:"cross_namespace_policy,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetCrossNamespacePolicyResp) Reset() {
+	*x = GetCrossNamespacePolicyResp{}
+	mi := &file_netguard_api_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrossNamespacePolicyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrossNamespacePolicyResp) ProtoMessage() {}
+
+func (x *GetCrossNamespacePolicyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrossNamespacePolicyResp.ProtoReflect.Descriptor instead.
+func (*GetCrossNamespacePolicyResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetCrossNamespacePolicyResp) GetCrossNamespacePolicy() *CrossNamespacePolicy {
+	if x != nil {
+		return x.CrossNamespacePolicy
+	}
+	return nil
+}
+
+// ListRuleTemplatesReq - request to list rule templates
+type ListRuleTemplatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleTemplatesReq) Reset() {
+	*x = ListRuleTemplatesReq{}
+	mi := &file_netguard_api_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleTemplatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleTemplatesReq) ProtoMessage() {}
+
+func (x *ListRuleTemplatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleTemplatesReq.ProtoReflect.Descriptor instead.
+func (*ListRuleTemplatesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListRuleTemplatesReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+// ListRuleTemplatesResp - response with list of rule templates
+type ListRuleTemplatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RuleTemplate        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleTemplatesResp) Reset() {
+	*x = ListRuleTemplatesResp{}
+	mi := &file_netguard_api_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleTemplatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleTemplatesResp) ProtoMessage() {}
+
+func (x *ListRuleTemplatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleTemplatesResp.ProtoReflect.Descriptor instead.
+func (*ListRuleTemplatesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ListRuleTemplatesResp) GetItems() []*RuleTemplate {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetRuleTemplateReq - request to get a specific rule template
+type GetRuleTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleTemplateReq) Reset() {
+	*x = GetRuleTemplateReq{}
+	mi := &file_netguard_api_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleTemplateReq) ProtoMessage() {}
+
+func (x *GetRuleTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleTemplateReq.ProtoReflect.Descriptor instead.
+func (*GetRuleTemplateReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetRuleTemplateReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetRuleTemplateResp - response with a specific rule template
+type GetRuleTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleTemplate  *RuleTemplate          `protobuf:"bytes,1,opt,name=rule_template,json=ruleTemplate,proto3" json:"rule_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleTemplateResp) Reset() {
+	*x = GetRuleTemplateResp{}
+	mi := &file_netguard_api_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleTemplateResp) ProtoMessage() {}
+
+func (x *GetRuleTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleTemplateResp.ProtoReflect.Descriptor instead.
+func (*GetRuleTemplateResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetRuleTemplateResp) GetRuleTemplate() *RuleTemplate {
+	if x != nil {
+		return x.RuleTemplate
+	}
+	return nil
+}
+
+// ListNamespacePosturesReq - request to list namespace postures
+type ListNamespacePosturesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifiers   []*ResourceIdentifier  `protobuf:"bytes,1,rep,name=identifiers,proto3" json:"identifiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacePosturesReq) Reset() {
+	*x = ListNamespacePosturesReq{}
+	mi := &file_netguard_api_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacePosturesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacePosturesReq) ProtoMessage() {}
+
+func (x *ListNamespacePosturesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacePosturesReq.ProtoReflect.Descriptor instead.
+func (*ListNamespacePosturesReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListNamespacePosturesReq) GetIdentifiers() []*ResourceIdentifier {
+	if x != nil {
+		return x.Identifiers
+	}
+	return nil
+}
+
+// ListNamespacePosturesResp - response with list of namespace postures
+type ListNamespacePosturesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NamespacePosture    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacePosturesResp) Reset() {
+	*x = ListNamespacePosturesResp{}
+	mi := &file_netguard_api_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacePosturesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacePosturesResp) ProtoMessage() {}
+
+func (x *ListNamespacePosturesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacePosturesResp.ProtoReflect.Descriptor instead.
+func (*ListNamespacePosturesResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListNamespacePosturesResp) GetItems() []*NamespacePosture {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetNamespacePostureReq - request to get a specific namespace posture
+type GetNamespacePostureReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    *ResourceIdentifier    `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespacePostureReq) Reset() {
+	*x = GetNamespacePostureReq{}
+	mi := &file_netguard_api_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespacePostureReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespacePostureReq) ProtoMessage() {}
+
+func (x *GetNamespacePostureReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespacePostureReq.ProtoReflect.Descriptor instead.
+func (*GetNamespacePostureReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetNamespacePostureReq) GetIdentifier() *ResourceIdentifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+// GetNamespacePostureResp - response with a specific namespace posture
+type GetNamespacePostureResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NamespacePosture *NamespacePosture      `protobuf:"bytes,1,opt,name=namespace_posture,json=namespacePosture,proto3" json:"namespace_posture,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNamespacePostureResp) Reset() {
+	*x = GetNamespacePostureResp{}
+	mi := &file_netguard_api_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespacePostureResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespacePostureResp) ProtoMessage() {}
+
+func (x *GetNamespacePostureResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespacePostureResp.ProtoReflect.Descriptor instead.
+func (*GetNamespacePostureResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetNamespacePostureResp) GetNamespacePosture() *NamespacePosture {
+	if x != nil {
+		return x.NamespacePosture
+	}
+	return nil
+}
+
+// TrafficEndpoint - source or destination of a simulated connection
+type TrafficEndpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Endpoint:
+	//
+	//	*TrafficEndpoint_AddressGroup
+	//	*TrafficEndpoint_Host
+	Endpoint      isTrafficEndpoint_Endpoint `protobuf_oneof:"endpoint"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficEndpoint) Reset() {
+	*x = TrafficEndpoint{}
+	mi := &file_netguard_api_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficEndpoint) ProtoMessage() {}
+
+func (x *TrafficEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficEndpoint.ProtoReflect.Descriptor instead.
+func (*TrafficEndpoint) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *TrafficEndpoint) GetEndpoint() isTrafficEndpoint_Endpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *TrafficEndpoint) GetAddressGroup() *AddressGroupRef {
+	if x != nil {
+		if x, ok := x.Endpoint.(*TrafficEndpoint_AddressGroup); ok {
+			return x.AddressGroup
+		}
+	}
+	return nil
+}
+
+func (x *TrafficEndpoint) GetHost() *ResourceIdentifier {
+	if x != nil {
+		if x, ok := x.Endpoint.(*TrafficEndpoint_Host); ok {
+			return x.Host
+		}
+	}
+	return nil
+}
+
+type isTrafficEndpoint_Endpoint interface {
+	isTrafficEndpoint_Endpoint()
+}
+
+type TrafficEndpoint_AddressGroup struct {
+	AddressGroup *AddressGroupRef `protobuf:"bytes,1,opt,name=address_group,json=addressGroup,proto3,oneof"`
+}
+
+type TrafficEndpoint_Host struct {
+	Host *ResourceIdentifier `protobuf:"bytes,2,opt,name=host,proto3,oneof"` // Host bound to an address group
+}
+
+func (*TrafficEndpoint_AddressGroup) isTrafficEndpoint_Endpoint() {}
+
+func (*TrafficEndpoint_Host) isTrafficEndpoint_Endpoint() {}
+
+// SimulateTrafficReq - request to check whether a connection would be allowed
+type SimulateTrafficReq struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Source        *TrafficEndpoint         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination   *TrafficEndpoint         `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Transport     Networks_NetIP_Transport `protobuf:"varint,3,opt,name=transport,proto3,enum=netguard.v1.Networks_NetIP_Transport" json:"transport,omitempty"`
+	Port          uint32                   `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"` // Destination port, ICMP type for ICMP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateTrafficReq) Reset() {
+	*x = SimulateTrafficReq{}
+	mi := &file_netguard_api_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateTrafficReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTrafficReq) ProtoMessage() {}
+
+func (x *SimulateTrafficReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTrafficReq.ProtoReflect.Descriptor instead.
+func (*SimulateTrafficReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SimulateTrafficReq) GetSource() *TrafficEndpoint {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *SimulateTrafficReq) GetDestination() *TrafficEndpoint {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *SimulateTrafficReq) GetTransport() Networks_NetIP_Transport {
+	if x != nil {
+		return x.Transport
+	}
+	return Networks_NetIP_TCP
+}
+
+func (x *SimulateTrafficReq) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// TrafficRuleMatch - IEAgAg rule matching a simulated connection
+type TrafficRuleMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *ResourceIdentifier    `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Action        RuleAction             `protobuf:"varint,2,opt,name=action,proto3,enum=netguard.v1.RuleAction" json:"action,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Ports         string                 `protobuf:"bytes,4,opt,name=ports,proto3" json:"ports,omitempty"`
+	Decisive      bool                   `protobuf:"varint,5,opt,name=decisive,proto3" json:"decisive,omitempty"` // The rule decides the verdict of its direction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrafficRuleMatch) Reset() {
+	*x = TrafficRuleMatch{}
+	mi := &file_netguard_api_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficRuleMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficRuleMatch) ProtoMessage() {}
+
+func (x *TrafficRuleMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficRuleMatch.ProtoReflect.Descriptor instead.
+func (*TrafficRuleMatch) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *TrafficRuleMatch) GetRule() *ResourceIdentifier {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *TrafficRuleMatch) GetAction() RuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return RuleAction_UNDEFINED
+}
+
+func (x *TrafficRuleMatch) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TrafficRuleMatch) GetPorts() string {
+	if x != nil {
+		return x.Ports
+	}
+	return ""
+}
+
+func (x *TrafficRuleMatch) GetDecisive() bool {
+	if x != nil {
+		return x.Decisive
+	}
+	return false
+}
+
+// TrafficSimulationSide - evaluation of a simulated connection in one traffic direction
+type TrafficSimulationSide struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Traffic           Traffic                `protobuf:"varint,1,opt,name=traffic,proto3,enum=netguard.v1.Traffic" json:"traffic,omitempty"`
+	AddressGroupLocal *AddressGroupRef       `protobuf:"bytes,2,opt,name=address_group_local,json=addressGroupLocal,proto3" json:"address_group_local,omitempty"`
+	AddressGroup      *AddressGroupRef       `protobuf:"bytes,3,opt,name=address_group,json=addressGroup,proto3" json:"address_group,omitempty"`
+	Matches           []*TrafficRuleMatch    `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"` // Matching rules in evaluation order
+	Verdict           RuleAction             `protobuf:"varint,5,opt,name=verdict,proto3,enum=netguard.v1.RuleAction" json:"verdict,omitempty"`
+	DefaultAction     bool                   `protobuf:"varint,6,opt,name=default_action,json=defaultAction,proto3" json:"default_action,omitempty"` // No rule matched, the verdict is the default action of the local address group
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TrafficSimulationSide) Reset() {
+	*x = TrafficSimulationSide{}
+	mi := &file_netguard_api_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrafficSimulationSide) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficSimulationSide) ProtoMessage() {}
+
+func (x *TrafficSimulationSide) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficSimulationSide.ProtoReflect.Descriptor instead.
+func (*TrafficSimulationSide) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *TrafficSimulationSide) GetTraffic() Traffic {
+	if x != nil {
+		return x.Traffic
+	}
+	return Traffic_Ingress
+}
+
+func (x *TrafficSimulationSide) GetAddressGroupLocal() *AddressGroupRef {
+	if x != nil {
+		return x.AddressGroupLocal
+	}
+	return nil
+}
+
+func (x *TrafficSimulationSide) GetAddressGroup() *AddressGroupRef {
+	if x != nil {
+		return x.AddressGroup
+	}
+	return nil
+}
+
+func (x *TrafficSimulationSide) GetMatches() []*TrafficRuleMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *TrafficSimulationSide) GetVerdict() RuleAction {
+	if x != nil {
+		return x.Verdict
+	}
+	return RuleAction_UNDEFINED
+}
+
+func (x *TrafficSimulationSide) GetDefaultAction() bool {
+	if x != nil {
+		return x.DefaultAction
+	}
+	return false
+}
+
+// SimulateTrafficResp - matching rule chain and final verdict of a simulated connection
+type SimulateTrafficResp struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Verdict       RuleAction               `protobuf:"varint,1,opt,name=verdict,proto3,enum=netguard.v1.RuleAction" json:"verdict,omitempty"`
+	Sides         []*TrafficSimulationSide `protobuf:"bytes,2,rep,name=sides,proto3" json:"sides,omitempty"` // Egress of the source and ingress of the destination
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateTrafficResp) Reset() {
+	*x = SimulateTrafficResp{}
+	mi := &file_netguard_api_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateTrafficResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTrafficResp) ProtoMessage() {}
+
+func (x *SimulateTrafficResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTrafficResp.ProtoReflect.Descriptor instead.
+func (*SimulateTrafficResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SimulateTrafficResp) GetVerdict() RuleAction {
+	if x != nil {
+		return x.Verdict
+	}
+	return RuleAction_UNDEFINED
+}
+
+func (x *SimulateTrafficResp) GetSides() []*TrafficSimulationSide {
+	if x != nil {
+		return x.Sides
+	}
+	return nil
+}
+
+// SyncReq - request to sync
+type SyncReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sync operation to apply
+	SyncOp SyncOp `protobuf:"varint,1,opt,name=sync_op,json=syncOp,proto3,enum=netguard.v1.SyncOp" json:"sync_op,omitempty"`
+	// One of subject
+	//
+	// Types that are valid to be assigned to Subject:
+	//
+	//	*SyncReq_Services
+	//	*SyncReq_AddressGroups
+	//	*SyncReq_AddressGroupBindings
+	//	*SyncReq_AddressGroupPortMappings
+	//	*SyncReq_RuleS2S
+	//	*SyncReq_ServiceAliases
+	//	*SyncReq_AddressGroupBindingPolicies
+	//	*SyncReq_IeagagRules
+	//	*SyncReq_Networks
+	//	*SyncReq_NetworkBindings
+	//	*SyncReq_Hosts
+	//	*SyncReq_HostBindings
+	//	*SyncReq_RuleS2SExceptions
+	//	*SyncReq_CrossNamespacePolicies
+	//	*SyncReq_RuleTemplates
+	//	*SyncReq_NamespacePostures
+	Subject       isSyncReq_Subject `protobuf_oneof:"subject"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncReq) Reset() {
+	*x = SyncReq{}
+	mi := &file_netguard_api_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncReq) ProtoMessage() {}
+
+func (x *SyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncReq.ProtoReflect.Descriptor instead.
+func (*SyncReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *SyncReq) GetSyncOp() SyncOp {
+	if x != nil {
+		return x.SyncOp
+	}
+	return SyncOp_NoOp
+}
+
+func (x *SyncReq) GetSubject() isSyncReq_Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *SyncReq) GetServices() *SyncServices {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_Services); ok {
+			return x.Services
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetAddressGroups() *SyncAddressGroups {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_AddressGroups); ok {
+			return x.AddressGroups
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetAddressGroupBindings() *SyncAddressGroupBindings {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_AddressGroupBindings); ok {
+			return x.AddressGroupBindings
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetAddressGroupPortMappings() *SyncAddressGroupPortMappings {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_AddressGroupPortMappings); ok {
+			return x.AddressGroupPortMappings
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetRuleS2S() *SyncRuleS2S {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_RuleS2S); ok {
+			return x.RuleS2S
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetServiceAliases() *SyncServiceAliases {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_ServiceAliases); ok {
+			return x.ServiceAliases
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetAddressGroupBindingPolicies() *SyncAddressGroupBindingPolicies {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_AddressGroupBindingPolicies); ok {
+			return x.AddressGroupBindingPolicies
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetIeagagRules() *SyncIEAgAgRules {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_IeagagRules); ok {
+			return x.IeagagRules
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetNetworks() *SyncNetworks {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_Networks); ok {
+			return x.Networks
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetNetworkBindings() *SyncNetworkBindings {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_NetworkBindings); ok {
+			return x.NetworkBindings
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetHosts() *SyncHosts {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_Hosts); ok {
+			return x.Hosts
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetHostBindings() *SyncHostBindings {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_HostBindings); ok {
+			return x.HostBindings
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetRuleS2SExceptions() *SyncRuleS2SExceptions {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_RuleS2SExceptions); ok {
+			return x.RuleS2SExceptions
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetCrossNamespacePolicies() *SyncCrossNamespacePolicies {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_CrossNamespacePolicies); ok {
+			return x.CrossNamespacePolicies
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetRuleTemplates() *SyncRuleTemplates {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_RuleTemplates); ok {
+			return x.RuleTemplates
+		}
+	}
+	return nil
+}
+
+func (x *SyncReq) GetNamespacePostures() *SyncNamespacePostures {
+	if x != nil {
+		if x, ok := x.Subject.(*SyncReq_NamespacePostures); ok {
+			return x.NamespacePostures
+		}
+	}
+	return nil
+}
+
+type isSyncReq_Subject interface {
+	isSyncReq_Subject()
+}
+
+type SyncReq_Services struct {
+	// Subject of Services
+	Services *SyncServices `protobuf:"bytes,2,opt,name=services,proto3,oneof"`
+}
+
+type SyncReq_AddressGroups struct {
+	// Subject of Address Groups
+	AddressGroups *SyncAddressGroups `protobuf:"bytes,3,opt,name=address_groups,json=addressGroups,proto3,oneof"`
+}
+
+type SyncReq_AddressGroupBindings struct {
+	// Subject of Address Group Bindings
+	AddressGroupBindings *SyncAddressGroupBindings `protobuf:"bytes,4,opt,name=address_group_bindings,json=addressGroupBindings,proto3,oneof"`
+}
+
+type SyncReq_AddressGroupPortMappings struct {
+	// Subject of Address Group Port Mappings
+	AddressGroupPortMappings *SyncAddressGroupPortMappings `protobuf:"bytes,5,opt,name=address_group_port_mappings,json=addressGroupPortMappings,proto3,oneof"`
+}
+
+type SyncReq_RuleS2S struct {
+	// Subject of Rule S2S
+	RuleS2S *SyncRuleS2S `protobuf:"bytes,6,opt,name=rule_s2s,json=ruleS2s,proto3,oneof"`
+}
+
+type SyncReq_ServiceAliases struct {
+	// Subject of Service Aliases
+	ServiceAliases *SyncServiceAliases `protobuf:"bytes,7,opt,name=service_aliases,json=serviceAliases,proto3,oneof"`
+}
+
+type SyncReq_AddressGroupBindingPolicies struct {
+	// Subject of Address Group Binding Policies
+	AddressGroupBindingPolicies *SyncAddressGroupBindingPolicies `protobuf:"bytes,8,opt,name=address_group_binding_policies,json=addressGroupBindingPolicies,proto3,oneof"`
+}
+
+type SyncReq_IeagagRules struct {
+	// Subject of IEAgAgRules
+	IeagagRules *SyncIEAgAgRules `protobuf:"bytes,9,opt,name=ieagag_rules,json=ieagagRules,proto3,oneof"`
+}
+
+type SyncReq_Networks struct {
+	// Subject of Networks
+	Networks *SyncNetworks `protobuf:"bytes,10,opt,name=networks,proto3,oneof"`
+}
+
+type SyncReq_NetworkBindings struct {
+	// Subject of Network Bindings
+	NetworkBindings *SyncNetworkBindings `protobuf:"bytes,11,opt,name=network_bindings,json=networkBindings,proto3,oneof"`
+}
+
+type SyncReq_Hosts struct {
+	// Subject of Hosts
+	Hosts *SyncHosts `protobuf:"bytes,12,opt,name=hosts,proto3,oneof"`
+}
+
+type SyncReq_HostBindings struct {
+	// Subject of Host Bindings
+	HostBindings *SyncHostBindings `protobuf:"bytes,13,opt,name=host_bindings,json=hostBindings,proto3,oneof"`
+}
+
+type SyncReq_RuleS2SExceptions struct {
+	// Subject of RuleS2S Exceptions
+	RuleS2SExceptions *SyncRuleS2SExceptions `protobuf:"bytes,14,opt,name=rule_s2s_exceptions,json=ruleS2sExceptions,proto3,oneof"`
+}
+
+type SyncReq_CrossNamespacePolicies struct {
+	// Subject of Cross Namespace Policies
+	CrossNamespacePolicies *SyncCrossNamespacePolicies `protobuf:"bytes,15,opt,name=cross_namespace_policies,json=crossNamespacePolicies,proto3,oneof"`
+}
+
+type SyncReq_RuleTemplates struct {
+	// Subject of Rule Templates
+	RuleTemplates *SyncRuleTemplates `protobuf:"bytes,16,opt,name=rule_templates,json=ruleTemplates,proto3,oneof"`
+}
+
+type SyncReq_NamespacePostures struct {
+	// Subject of Namespace Postures
+	NamespacePostures *SyncNamespacePostures `protobuf:"bytes,17,opt,name=namespace_postures,json=namespacePostures,proto3,oneof"`
+}
+
+func (*SyncReq_Services) isSyncReq_Subject() {}
+
+func (*SyncReq_AddressGroups) isSyncReq_Subject() {}
+
+func (*SyncReq_AddressGroupBindings) isSyncReq_Subject() {}
+
+func (*SyncReq_AddressGroupPortMappings) isSyncReq_Subject() {}
+
+func (*SyncReq_RuleS2S) isSyncReq_Subject() {}
+
+func (*SyncReq_ServiceAliases) isSyncReq_Subject() {}
+
+func (*SyncReq_AddressGroupBindingPolicies) isSyncReq_Subject() {}
+
+func (*SyncReq_IeagagRules) isSyncReq_Subject() {}
+
+func (*SyncReq_Networks) isSyncReq_Subject() {}
+
+func (*SyncReq_NetworkBindings) isSyncReq_Subject() {}
+
+func (*SyncReq_Hosts) isSyncReq_Subject() {}
+
+func (*SyncReq_HostBindings) isSyncReq_Subject() {}
+
+func (*SyncReq_RuleS2SExceptions) isSyncReq_Subject() {}
+
+func (*SyncReq_CrossNamespacePolicies) isSyncReq_Subject() {}
+
+func (*SyncReq_RuleTemplates) isSyncReq_Subject() {}
+
+func (*SyncReq_NamespacePostures) isSyncReq_Subject() {}
+
+// WatchReq - request to subscribe to resource change events
+type WatchReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kinds to watch (e.g. "Service", "AddressGroup", "RuleS2S"); empty means all supported kinds
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	// Resume from this revision: events after it are replayed from the change log.
+	// 0 means start from the current state (client is expected to relist).
+	// OUT_OF_RANGE is returned when the revision has been compacted.
+	SinceRevision uint64 `protobuf:"varint,2,opt,name=since_revision,json=sinceRevision,proto3" json:"since_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchReq) Reset() {
+	*x = WatchReq{}
+	mi := &file_netguard_api_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReq) ProtoMessage() {}
+
+func (x *WatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReq.ProtoReflect.Descriptor instead.
+func (*WatchReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *WatchReq) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *WatchReq) GetSinceRevision() uint64 {
+	if x != nil {
+		return x.SinceRevision
+	}
+	return 0
+}
+
+// WatchEvent - single resource change event.
+// An event without resource is a bookmark: all events up to its revision were delivered.
+type WatchEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Upsert for created/updated resources, Delete for removed ones
+	SyncOp SyncOp `protobuf:"varint,1,opt,name=sync_op,json=syncOp,proto3,enum=netguard.v1.SyncOp" json:"sync_op,omitempty"`
+	// Monotonic revision of the change feed
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// One of changed resource
+	//
+	// Types that are valid to be assigned to Resource:
+	//
+	//	*WatchEvent_Service
+	//	*WatchEvent_AddressGroup
+	//	*WatchEvent_RuleS2S
+	Resource      isWatchEvent_Resource `protobuf_oneof:"resource"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	mi := &file_netguard_api_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *WatchEvent) GetSyncOp() SyncOp {
+	if x != nil {
+		return x.SyncOp
+	}
+	return SyncOp_NoOp
+}
+
+func (x *WatchEvent) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetResource() isWatchEvent_Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetService() *Service {
+	if x != nil {
+		if x, ok := x.Resource.(*WatchEvent_Service); ok {
+			return x.Service
+		}
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetAddressGroup() *AddressGroup {
+	if x != nil {
+		if x, ok := x.Resource.(*WatchEvent_AddressGroup); ok {
+			return x.AddressGroup
+		}
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetRuleS2S() *RuleS2S {
+	if x != nil {
+		if x, ok := x.Resource.(*WatchEvent_RuleS2S); ok {
+			return x.RuleS2S
+		}
+	}
+	return nil
+}
+
+type isWatchEvent_Resource interface {
+	isWatchEvent_Resource()
+}
+
+type WatchEvent_Service struct {
+	// Changed Service
+	Service *Service `protobuf:"bytes,10,opt,name=service,proto3,oneof"`
+}
+
+type WatchEvent_AddressGroup struct {
+	// Changed AddressGroup
+	AddressGroup *AddressGroup `protobuf:"bytes,11,opt,name=address_group,json=addressGroup,proto3,oneof"`
+}
+
+type WatchEvent_RuleS2S struct {
+	// Changed RuleS2S
+	RuleS2S *RuleS2S `protobuf:"bytes,12,opt,name=rule_s2s,json=ruleS2s,proto3,oneof"`
+}
+
+func (*WatchEvent_Service) isWatchEvent_Resource() {}
+
+func (*WatchEvent_AddressGroup) isWatchEvent_Resource() {}
+
+func (*WatchEvent_RuleS2S) isWatchEvent_Resource() {}
+
+// AnalyzeAddressGroupImpactReq - request to analyze impact of an AddressGroup change
+type AnalyzeAddressGroupImpactReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// AddressGroup to be changed
+	Identifier *ResourceIdentifier `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// Proposed AddressGroup state, ignored when delete is set
+	Proposed *AddressGroup `protobuf:"bytes,2,opt,name=proposed,proto3" json:"proposed,omitempty"`
+	// Analyze deletion of the AddressGroup
+	Delete        bool `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeAddressGroupImpactReq) Reset() {
+	*x = AnalyzeAddressGroupImpactReq{}
+	mi := &file_netguard_api_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeAddressGroupImpactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeAddressGroupImpactReq) ProtoMessage() {}
+
+func (x *AnalyzeAddressGroupImpactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[156]
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_Networks); ok {
-			return x.Networks
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SyncReq) GetNetworkBindings() *SyncNetworkBindings {
+// Deprecated: Use AnalyzeAddressGroupImpactReq.ProtoReflect.Descriptor instead.
+func (*AnalyzeAddressGroupImpactReq) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *AnalyzeAddressGroupImpactReq) GetIdentifier() *ResourceIdentifier {
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_NetworkBindings); ok {
-			return x.NetworkBindings
-		}
+		return x.Identifier
 	}
 	return nil
 }
 
-func (x *SyncReq) GetHosts() *SyncHosts {
+func (x *AnalyzeAddressGroupImpactReq) GetProposed() *AddressGroup {
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_Hosts); ok {
-			return x.Hosts
-		}
+		return x.Proposed
 	}
 	return nil
 }
 
-func (x *SyncReq) GetHostBindings() *SyncHostBindings {
+func (x *AnalyzeAddressGroupImpactReq) GetDelete() bool {
 	if x != nil {
-		if x, ok := x.Subject.(*SyncReq_HostBindings); ok {
-			return x.HostBindings
-		}
+		return x.Delete
 	}
-	return nil
+	return false
 }
 
-type isSyncReq_Subject interface {
-	isSyncReq_Subject()
+// AnalyzeAddressGroupImpactResp - impact of an AddressGroup change
+type AnalyzeAddressGroupImpactResp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False when the proposed change doesn't affect enforcement
+	EnforcementChanged bool `protobuf:"varint,1,opt,name=enforcement_changed,json=enforcementChanged,proto3" json:"enforcement_changed,omitempty"`
+	// IEAgAgRules whose enforcement would change
+	IeagagRules []*ResourceIdentifier `protobuf:"bytes,2,rep,name=ieagag_rules,json=ieagagRules,proto3" json:"ieagag_rules,omitempty"`
+	// Services bound to the AddressGroup
+	Services []*ResourceIdentifier `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty"`
+	// Namespaces of affected rules and services
+	Namespaces []string `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// IEAgAgRules referencing the AddressGroup now
+	RuleCountBefore int32 `protobuf:"varint,5,opt,name=rule_count_before,json=ruleCountBefore,proto3" json:"rule_count_before,omitempty"`
+	// Estimated IEAgAgRules referencing the AddressGroup after the change
+	RuleCountAfter int32 `protobuf:"varint,6,opt,name=rule_count_after,json=ruleCountAfter,proto3" json:"rule_count_after,omitempty"`
+	// rule_count_after - rule_count_before
+	RuleCountDelta int32 `protobuf:"varint,7,opt,name=rule_count_delta,json=ruleCountDelta,proto3" json:"rule_count_delta,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-type SyncReq_Services struct {
-	// Subject of Services
-	Services *SyncServices `protobuf:"bytes,2,opt,name=services,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) Reset() {
+	*x = AnalyzeAddressGroupImpactResp{}
+	mi := &file_netguard_api_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type SyncReq_AddressGroups struct {
-	// Subject of Address Groups
-	AddressGroups *SyncAddressGroups `protobuf:"bytes,3,opt,name=address_groups,json=addressGroups,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type SyncReq_AddressGroupBindings struct {
-	// Subject of Address Group Bindings
-	AddressGroupBindings *SyncAddressGroupBindings `protobuf:"bytes,4,opt,name=address_group_bindings,json=addressGroupBindings,proto3,oneof"`
-}
+func (*AnalyzeAddressGroupImpactResp) ProtoMessage() {}
 
-type SyncReq_AddressGroupPortMappings struct {
-	// Subject of Address Group Port Mappings
-	AddressGroupPortMappings *SyncAddressGroupPortMappings `protobuf:"bytes,5,opt,name=address_group_port_mappings,json=addressGroupPortMappings,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_netguard_api_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type SyncReq_RuleS2S struct {
-	// Subject of Rule S2S
-	RuleS2S *SyncRuleS2S `protobuf:"bytes,6,opt,name=rule_s2s,json=ruleS2s,proto3,oneof"`
+// Deprecated: Use AnalyzeAddressGroupImpactResp.ProtoReflect.Descriptor instead.
+func (*AnalyzeAddressGroupImpactResp) Descriptor() ([]byte, []int) {
+	return file_netguard_api_proto_rawDescGZIP(), []int{157}
 }
 
-type SyncReq_ServiceAliases struct {
-	// Subject of Service Aliases
-	ServiceAliases *SyncServiceAliases `protobuf:"bytes,7,opt,name=service_aliases,json=serviceAliases,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) GetEnforcementChanged() bool {
+	if x != nil {
+		return x.EnforcementChanged
+	}
+	return false
 }
 
-type SyncReq_AddressGroupBindingPolicies struct {
-	// Subject of Address Group Binding Policies
-	AddressGroupBindingPolicies *SyncAddressGroupBindingPolicies `protobuf:"bytes,8,opt,name=address_group_binding_policies,json=addressGroupBindingPolicies,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) GetIeagagRules() []*ResourceIdentifier {
+	if x != nil {
+		return x.IeagagRules
+	}
+	return nil
 }
 
-type SyncReq_IeagagRules struct {
-	// Subject of IEAgAgRules
-	IeagagRules *SyncIEAgAgRules `protobuf:"bytes,9,opt,name=ieagag_rules,json=ieagagRules,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) GetServices() []*ResourceIdentifier {
+	if x != nil {
+		return x.Services
+	}
+	return nil
 }
 
-type SyncReq_Networks struct {
-	// Subject of Networks
-	Networks *SyncNetworks `protobuf:"bytes,10,opt,name=networks,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
 }
 
-type SyncReq_NetworkBindings struct {
-	// Subject of Network Bindings
-	NetworkBindings *SyncNetworkBindings `protobuf:"bytes,11,opt,name=network_bindings,json=networkBindings,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) GetRuleCountBefore() int32 {
+	if x != nil {
+		return x.RuleCountBefore
+	}
+	return 0
 }
 
-type SyncReq_Hosts struct {
-	// Subject of Hosts
-	Hosts *SyncHosts `protobuf:"bytes,12,opt,name=hosts,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) GetRuleCountAfter() int32 {
+	if x != nil {
+		return x.RuleCountAfter
+	}
+	return 0
 }
 
-type SyncReq_HostBindings struct {
-	// Subject of Host Bindings
-	HostBindings *SyncHostBindings `protobuf:"bytes,13,opt,name=host_bindings,json=hostBindings,proto3,oneof"`
+func (x *AnalyzeAddressGroupImpactResp) GetRuleCountDelta() int32 {
+	if x != nil {
+		return x.RuleCountDelta
+	}
+	return 0
 }
 
-func (*SyncReq_Services) isSyncReq_Subject() {}
-
-func (*SyncReq_AddressGroups) isSyncReq_Subject() {}
-
-func (*SyncReq_AddressGroupBindings) isSyncReq_Subject() {}
-
-func (*SyncReq_AddressGroupPortMappings) isSyncReq_Subject() {}
-
-func (*SyncReq_RuleS2S) isSyncReq_Subject() {}
-
-func (*SyncReq_ServiceAliases) isSyncReq_Subject() {}
-
-func (*SyncReq_AddressGroupBindingPolicies) isSyncReq_Subject() {}
-
-func (*SyncReq_IeagagRules) isSyncReq_Subject() {}
-
-func (*SyncReq_Networks) isSyncReq_Subject() {}
-
-func (*SyncReq_NetworkBindings) isSyncReq_Subject() {}
-
-func (*SyncReq_Hosts) isSyncReq_Subject() {}
-
-func (*SyncReq_HostBindings) isSyncReq_Subject() {}
-
 type Networks_NetIP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -5563,7 +10059,7 @@ type Networks_NetIP struct {
 
 func (x *Networks_NetIP) Reset() {
 	*x = Networks_NetIP{}
-	mi := &file_netguard_api_proto_msgTypes[93]
+	mi := &file_netguard_api_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5575,7 +10071,7 @@ func (x *Networks_NetIP) String() string {
 func (*Networks_NetIP) ProtoMessage() {}
 
 func (x *Networks_NetIP) ProtoReflect() protoreflect.Message {
-	mi := &file_netguard_api_proto_msgTypes[93]
+	mi := &file_netguard_api_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5596,7 +10092,9 @@ var File_netguard_api_proto protoreflect.FileDescriptor
 var file_netguard_api_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
@@ -5604,161 +10102,181 @@ var file_netguard_api_proto_rawDesc = []byte{
 	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
 	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a,
-	0x08, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x1a, 0x26, 0x0a, 0x05, 0x4e, 0x65, 0x74,
-	0x49, 0x50, 0x22, 0x1d, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x46, 0x0a,
+	0x08, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x1a, 0x3a, 0x0a, 0x05, 0x4e, 0x65, 0x74,
+	0x49, 0x50, 0x22, 0x31, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
 	0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10,
-	0x01, 0x22, 0x84, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a,
-	0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0c, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66,
-	0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
-	0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x19, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x17, 0x61,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x10, 0x92, 0x41, 0x0d, 0x0a, 0x0b, 0xd2, 0x01, 0x08,
-	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x22, 0x86, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x73, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x5a, 0x0a, 0x0f, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0d, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x38, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x43, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x22, 0x82, 0x01, 0x0a, 0x19, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0xfd, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x76, 0x31, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x56, 0x31, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x22, 0xf0, 0x04, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x46, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x22, 0x94, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x43, 0x54, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49,
+	0x43, 0x4d, 0x50, 0x10, 0x03, 0x22, 0x84, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3d, 0x0a, 0x0d, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x43,
+	0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x66, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x19, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x17, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x10, 0x92, 0x41, 0x0d, 0x0a,
+	0x0b, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x22, 0x86, 0x01, 0x0a,
+	0x0b, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x41, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x5c, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x0f, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0d, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x3b, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x43,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x19, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xfd, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x76,
+	0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x56,
+	0x31, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x22, 0x95, 0x06, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x44, 0x0a,
+	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x0f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12,
 	0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
 	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
@@ -5767,1047 +10285,2235 @@ var file_netguard_api_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
 	0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a,
 	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x22, 0x88, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x69, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x22, 0xc7, 0x02, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a,
-	0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
-	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x25,
-	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x3d, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x66, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x12,
-	0x52, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x66, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c,
-	0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x04, 0x63, 0x69, 0x64, 0x72, 0x22, 0xdd, 0x03, 0x0a,
-	0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3a, 0x0a,
-	0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x3e, 0x0a, 0x0e, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
-	0x12, 0x32, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x3a, 0x21, 0x92, 0x41, 0x1e, 0x0a, 0x1c,
-	0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x0e, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x02, 0x0a,
-	0x13, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x22, 0x88,
+	0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xc7, 0x02, 0x0a, 0x07, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65,
+	0x66, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x73, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x12, 0x52, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x3a, 0x17, 0x92, 0x41, 0x14, 0x0a,
+	0x12, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x04, 0x63,
+	0x69, 0x64, 0x72, 0x22, 0xa4, 0x04, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
 	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65,
 	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66,
-	0x12, 0x38, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0a,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x48, 0x0a, 0x11, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x66, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x32, 0x92, 0x41, 0x2f,
-	0x0a, 0x2d, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x0b,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x11, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x22,
-	0xed, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x12, 0x3e, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73,
+	0x12, 0x45, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x21, 0x92, 0x41, 0x1e, 0x0a, 0x1c, 0xd2, 0x01,
+	0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x0e, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x02, 0x0a, 0x13, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69,
 	0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
 	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x3d,
-	0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x38,
+	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x48, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x12, 0x48, 0x0a,
-	0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72,
-	0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x3b, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x32, 0x92, 0x41, 0x2f,
-	0x0a, 0x2d, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x0b,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x11, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x22,
-	0x18, 0x0a, 0x06, 0x49, 0x50, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0xbc, 0x03, 0x0a, 0x04, 0x48, 0x6f,
-	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x66,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x66, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x66, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x32, 0x92, 0x41, 0x2f, 0x0a, 0x2d,
+	0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x11, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xed, 0x02,
+	0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x3d, 0x0a, 0x0b,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x12, 0x48, 0x0a, 0x11, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
 	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x66,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x3b, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x32, 0x92, 0x41, 0x2f, 0x0a, 0x2d,
+	0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x0b, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x11, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x22, 0x18, 0x0a,
+	0x06, 0x49, 0x50, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0xbc, 0x03, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x3d, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x12, 0x48,
+	0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x07, 0x69, 0x70, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06,
+	0x69, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x17, 0x92,
+	0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2,
+	0x01, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xb8, 0x02, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52,
+	0x65, 0x66, 0x12, 0x41, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x68, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x66, 0x12, 0x52, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x3a, 0x2f, 0x92, 0x41, 0x2c, 0x0a, 0x2a, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72,
+	0x65, 0x66, 0xd2, 0x01, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x11,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65,
+	0x66, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x1a, 0x51, 0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x3c, 0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x66, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x05, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x3f, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x66,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x25, 0x0a,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x3a, 0x10, 0x92, 0x41, 0x0d, 0x0a, 0x0b, 0xd2, 0x01, 0x08, 0x73, 0x65,
+	0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xd3, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66,
+	0x52, 0x65, 0x66, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x3a, 0x26, 0x92, 0x41, 0x23, 0x0a, 0x21, 0xd2, 0x01, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0xd2, 0x01,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xb6, 0x02, 0x0a,
+	0x19, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65,
+	0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73,
+	0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66,
 	0x12, 0x48, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x2c, 0x0a, 0x07, 0x69, 0x70,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x50, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x06, 0x69, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a,
-	0x17, 0x92, 0x41, 0x14, 0x0a, 0x12, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65,
-	0x66, 0xd2, 0x01, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xb8, 0x02, 0x0a, 0x0b, 0x48, 0x6f, 0x73,
-	0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c,
-	0x66, 0x52, 0x65, 0x66, 0x12, 0x41, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07,
-	0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x12, 0x52, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x3a, 0x2f, 0x92, 0x41, 0x2c, 0x0a, 0x2a, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66,
-	0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0xd2,
-	0x01, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x72, 0x65, 0x66, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x73,
-	0x2e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x1a, 0x51, 0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x3c, 0x0a, 0x0a, 0x50, 0x6f,
-	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x66, 0x12, 0x3f, 0x0a, 0x0a,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x3a, 0x32, 0x92, 0x41, 0x2f, 0x0a, 0x2d, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f,
+	0x72, 0x65, 0x66, 0xd2, 0x01, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0xd2, 0x01, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xe7, 0x06, 0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32,
+	0x53, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x2e, 0x0a,
+	0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x43, 0x0a,
+	0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x66, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x4a, 0x0a, 0x11,
+	0x69, 0x65, 0x61, 0x67, 0x5f, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0e, 0x69, 0x65, 0x61, 0x67, 0x41, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x5e, 0x0a, 0x18, 0x69, 0x65, 0x61, 0x67,
+	0x5f, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x72, 0x65, 0x66, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x14, 0x69, 0x65, 0x61, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x32, 0x53, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x3b, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x3a, 0x44, 0x92, 0x41, 0x41, 0x0a, 0x3f,
+	0xd2, 0x01, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0xd2, 0x01, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0xd2, 0x01, 0x11,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x66, 0xd2, 0x01, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x22,
+	0xf0, 0x04, 0x0a, 0x0a, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x3a,
+	0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
 	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x45, 0x0a,
-	0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x66, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52,
-	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x3f,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52,
-	0x65, 0x66, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
-	0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x10, 0x92, 0x41, 0x0d, 0x0a, 0x0b, 0xd2, 0x01, 0x08,
-	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xd3, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c,
-	0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65,
-	0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12,
-	0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2e, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12,
+	0x4c, 0x0a, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x41, 0x0a,
+	0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x66, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x25,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x3a, 0x55, 0x92, 0x41, 0x52,
+	0x0a, 0x50, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x09,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0xd2, 0x01, 0x07, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0xd2, 0x01, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0xd2, 0x01, 0x0d, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0xd2, 0x01, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xdf, 0x03, 0x0a, 0x10, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66,
+	0x52, 0x65, 0x66, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x12, 0x4c, 0x0a, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x11,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2e, 0x4e,
+	0x65, 0x74, 0x49, 0x50, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
 	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x26, 0x92, 0x41, 0x23, 0x0a, 0x21, 0xd2, 0x01, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0xd2, 0x01, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xb6,
-	0x02, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a, 0x08,
-	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x66, 0x12, 0x48, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0xd2, 0x01, 0x08,
+	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0xd2, 0x01, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0xd2, 0x01, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0xd2, 0x01, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a,
+	0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a,
+	0x25, 0x92, 0x41, 0x22, 0x0a, 0x20, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65,
+	0x66, 0xd2, 0x01, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0xa3, 0x05, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66,
+	0x52, 0x65, 0x66, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x12, 0x69, 0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x6c,
+	0x0a, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a, 0x47, 0x0a, 0x19, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x48, 0x0a, 0x1a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x4d, 0x92,
+	0x41, 0x4a, 0x0a, 0x48, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2,
+	0x01, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0xd2, 0x01, 0x16, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0xd2, 0x01, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xc5, 0x01, 0x0a,
+	0x10, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x35, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x3a, 0x17, 0x92, 0x41, 0x14,
+	0x0a, 0x12, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x44, 0x0a, 0x08, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x0e, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xa0, 0x03, 0x0a, 0x0e, 0x4b, 0x69, 0x6e, 0x64,
+	0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79,
+	0x6e, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x40, 0x0a,
+	0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x46, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xce, 0x02, 0x0a, 0x12, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53,
+	0x79, 0x6e, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73,
+	0x79, 0x6e, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xe9, 0x04, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4c,
+	0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2b, 0x0a, 0x03,
+	0x6c, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79,
+	0x6e, 0x63, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x0a, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79,
+	0x6e, 0x63, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x4d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22,
+	0xe8, 0x02, 0x0a, 0x13, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0d,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x22, 0x56, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x4b, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x2e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x4d, 0x0a,
+	0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
+	0x79, 0x6e, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0xa8, 0x04, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07,
+	0x73, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x75, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x65,
+	0x72, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x70, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x4b, 0x0a,
+	0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x5f, 0x0a, 0x06, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x3c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x52, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65,
+	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x12,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x46, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x72, 0x0a,
+	0x18, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x56, 0x0a, 0x16, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x83, 0x01, 0x0a, 0x1c, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x63, 0x0a, 0x1b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3e, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x32, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x73, 0x22, 0x58, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x42, 0x0a,
+	0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x22, 0x8e, 0x01, 0x0a, 0x1f, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x1e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
 	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x0f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x3a, 0x32, 0x92, 0x41, 0x2f, 0x0a, 0x2d, 0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c,
-	0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x72, 0x65, 0x66, 0xd2, 0x01, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xa3, 0x04, 0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65,
-	0x53, 0x32, 0x53, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x1b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x22, 0x4d, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x12, 0x30, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x34, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63,
+	0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3d, 0x0a, 0x0d,
+	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x68,
+	0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x66, 0x0a, 0x15, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x13, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73,
+	0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x11, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x73, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x1a, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x5b, 0x0a, 0x18, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x16, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x55,
+	0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x4c,
+	0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x50, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e,
+	0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x90,
+	0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x22, 0x48, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x55, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
 	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12,
-	0x2e, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12,
-	0x43, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x66, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x52, 0x65, 0x66, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x66, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x66, 0x12, 0x4a,
-	0x0a, 0x11, 0x69, 0x65, 0x61, 0x67, 0x5f, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x72,
-	0x65, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0e, 0x69, 0x65, 0x61, 0x67,
-	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x5e, 0x0a, 0x18, 0x69, 0x65,
-	0x61, 0x67, 0x5f, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x14, 0x69, 0x65, 0x61, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x3a, 0x44, 0x92, 0x41, 0x41, 0x0a, 0x3f, 0xd2, 0x01,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0xd2, 0x01, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0xd2, 0x01, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0xd2, 0x01, 0x11, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0xd2,
-	0x01, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x22, 0xf0, 0x04,
-	0x0a, 0x0a, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x08,
-	0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x55, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0d, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x97, 0x01, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x07, 0x73, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x66, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a,
-	0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x4c, 0x0a,
-	0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66,
-	0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2b,
-	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x3a, 0x55, 0x92, 0x41, 0x52, 0x0a, 0x50,
-	0xd2, 0x01, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x72, 0x65, 0x66, 0xd2, 0x01, 0x09, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0xd2, 0x01, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0xd2, 0x01, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0xd2, 0x01, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0xd2, 0x01, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x44, 0x0a, 0x08, 0x50, 0x6f, 0x72, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0d, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x72, 0x0a, 0x18,
-	0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x56, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x22, 0x83, 0x01, 0x0a, 0x1c, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x63, 0x0a, 0x1b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x18, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3e, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75,
-	0x6c, 0x65, 0x53, 0x32, 0x53, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x07, 0x72,
-	0x75, 0x6c, 0x65, 0x53, 0x32, 0x73, 0x22, 0x58, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73,
-	0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
-	0x22, 0x8e, 0x01, 0x0a, 0x1f, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x1e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e,
+	0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x22, 0x56, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x1f,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x5e, 0x0a, 0x20, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72,
+	0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e,
 	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x1b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x22, 0x4d, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x5f, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x0b, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x22, 0x40, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x12, 0x30, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x73, 0x22, 0x34, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x27,
-	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
-	0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x48,
-	0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x68,
-	0x6f, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x68, 0x6f,
-	0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x54, 0x0a, 0x0f, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a,
-	0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
-	0x22, 0x3e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x22, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x35, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
 	0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
 	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
 	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x22, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x22, 0x59, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22,
-	0x48, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x65, 0x72, 0x22, 0x72, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x54, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x60, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x1a, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x17, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x50,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x71, 0x12,
 	0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
 	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
 	0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x22, 0x55, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x60, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x22, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65,
+	0x53, 0x32, 0x73, 0x22, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x22, 0x9e, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x63, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
 	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0x64, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x5e, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x62, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x3d, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x53, 0x32, 0x53, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5a, 0x0a, 0x15, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x49, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12,
-	0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x22, 0x72, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54,
-	0x0a, 0x15, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x22, 0x60, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x1a, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x52, 0x17, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x50, 0x0a, 0x0d,
-	0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a,
-	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x67, 0x0a, 0x1c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x19,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67,
+	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0b, 0x69, 0x65,
+	0x61, 0x67, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x45,
+	0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52,
+	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3a, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x41,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x2f, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x32,
-	0x73, 0x22, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
-	0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x3e, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61,
-	0x73, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22,
-	0x67, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x3c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x62, 0x0a,
-	0x1f, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
-	0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
+	0x65, 0x72, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x69,
+	0x65, 0x61, 0x67, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0a, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67,
+	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x32, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53,
+	0x32, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x65, 0x61, 0x67, 0x61,
+	0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45,
+	0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
 	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x22, 0x8b, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x1c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x19, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
-	0x57, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x44, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x45, 0x41,
-	0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x53,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6f, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x50, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x92,
+	0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x62,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x88, 0x01, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a,
+	0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4d,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x34, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x35,
+	0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x46, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73,
+	0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x54, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
 	0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
 	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65,
 	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0b, 0x69, 0x65, 0x61, 0x67,
-	0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x45, 0x41, 0x67,
-	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x52, 0x75,
-	0x6c, 0x65, 0x22, 0x54, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x5b, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
+	0x69, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x0c, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x5d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x50, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x59, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x66, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53,
+	0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a,
+	0x12, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45,
+	0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x32,
+	0x73, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x1d, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x59,
+	0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5d, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
 	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x4c, 0x0a, 0x17, 0x4c, 0x69, 0x73,
-	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12,
-	0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x22, 0x5d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22,
-	0x51, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x76, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x16, 0x63, 0x72, 0x6f, 0x73, 0x73,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x14, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x59, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x55, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x72, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x22, 0x5d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
 	0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
 	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x73, 0x22, 0x38, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4d, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x22, 0x58, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x46, 0x0a, 0x14, 0x4c,
-	0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x22, 0x54, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3f, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x3b, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x0b, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xc8, 0x07, 0x0a,
-	0x07, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63,
-	0x5f, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x52, 0x06,
-	0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
-	0x47, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x5d, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x48,
-	0x00, 0x52, 0x14, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x6a, 0x0a, 0x1b, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x18, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x48,
-	0x00, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x73, 0x12, 0x4a, 0x0a, 0x0f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69,
-	0x61, 0x73, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
-	0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x73, 0x0a, 0x1e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e,
-	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x1b,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x69,
-	0x65, 0x61, 0x67, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x72, 0x73, 0x22, 0x50, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x59, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3f,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22,
+	0x65, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x11, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x10, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x79, 0x6e, 0x63, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48,
-	0x00, 0x52, 0x0b, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x37,
-	0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x48,
+	0x00, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x35, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x12, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x43, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x50, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xc6, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x33, 0x0a,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x76,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x76,
+	0x65, 0x22, 0xeb, 0x02, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x07, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x4c, 0x0a, 0x13, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x52, 0x0c,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x37, 0x0a, 0x07,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x82, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x05, 0x73,
+	0x69, 0x64, 0x65, 0x73, 0x22, 0xa1, 0x0a, 0x0a, 0x07, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x12, 0x2c, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x12, 0x37,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x48, 0x00, 0x52, 0x08, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x48, 0x00, 0x52,
-	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x62,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x48,
+	0x00, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x5d, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x14, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x6a, 0x0a, 0x1b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x48,
+	0x00, 0x52, 0x18, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x48, 0x00, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53,
+	0x32, 0x73, 0x12, 0x4a, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x73,
+	0x0a, 0x1e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x1b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x45, 0x41, 0x67,
+	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x65, 0x61, 0x67, 0x61,
+	0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12,
+	0x4d, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
 	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63,
-	0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0c,
-	0x68, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x09, 0x0a, 0x07,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2a, 0x22, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x2a, 0x47, 0x0a, 0x16, 0x48,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x48,
-	0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x2a, 0x4b, 0x0a, 0x1e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x47, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x47,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x2a, 0x31, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45,
-	0x50, 0x54, 0x10, 0x02, 0x2a, 0x38, 0x0a, 0x06, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x12, 0x08,
-	0x0a, 0x04, 0x4e, 0x6f, 0x4f, 0x70, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c,
-	0x53, 0x79, 0x6e, 0x63, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x03, 0x32, 0x97,
-	0x27, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x67, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x14, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x48, 0x6f, 0x73, 0x74, 0x73, 0x48, 0x00, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x44,
+	0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x13, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73,
+	0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x11, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x73,
+	0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x63, 0x0a, 0x18, 0x63, 0x72,
+	0x6f, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43,
+	0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x16, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x47, 0x0a, 0x0e, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x75, 0x6c, 0x65, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x48, 0x00, 0x52, 0x11, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x42, 0x09, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x47, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x89, 0x02, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0d,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00,
+	0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x31,
+	0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x32, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x48, 0x00, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x32,
+	0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xae, 0x01,
+	0x0a, 0x1c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x3f,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xf1,
+	0x02, 0x0a, 0x1d, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65,
+	0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x12, 0x42, 0x0a, 0x0c, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72,
+	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x72, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x2a, 0x22, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x0b, 0x0a,
+	0x07, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x2a, 0x47, 0x0a, 0x16, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x53, 0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a,
+	0x4b, 0x0a, 0x1e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53,
+	0x50, 0x45, 0x43, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x47, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x5c, 0x0a, 0x12,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f, 0x52,
+	0x54, 0x53, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x14, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4f, 0x53, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x44, 0x45,
+	0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x4f, 0x53, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x2a, 0x31, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x02, 0x2a, 0x38, 0x0a, 0x06, 0x53, 0x79,
+	0x6e, 0x63, 0x4f, 0x70, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x4f, 0x70, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x10, 0x03, 0x32, 0xcb, 0x58, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x14, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x31,
+	0x92, 0x41, 0x1b, 0x1a, 0x19, 0x53, 0x79, 0x6e, 0x63, 0x3a, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x73,
+	0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x44, 0x42, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e,
+	0x63, 0x12, 0x8f, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4c, 0x92, 0x41, 0x32, 0x1a, 0x30, 0x53, 0x79, 0x6e, 0x63,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x61, 0x73,
+	0x74, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x20, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x20, 0x44, 0x42, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0xf3, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8a, 0x01, 0x92,
+	0x41, 0x67, 0x1a, 0x65, 0x47, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20,
+	0x6c, 0x61, 0x73, 0x74, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x20,
+	0x6c, 0x61, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x20, 0x70, 0x65, 0x72, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x20, 0x6b, 0x69, 0x6e, 0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x65, 0x72,
+	0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
+	0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0xec, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x94, 0x01, 0x92, 0x41, 0x72, 0x1a, 0x70, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x20, 0x67,
+	0x65, 0x74, 0x73, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2c, 0x20, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x2c, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x20, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x6c, 0x61, 0x67, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2d, 0x73, 0x79, 0x6e,
+	0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0xcd, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x88, 0x01,
+	0x92, 0x41, 0x64, 0x1a, 0x62, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x3a, 0x20, 0x73, 0x6b, 0x69, 0x70, 0x73, 0x20, 0x73, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6e, 0x65, 0x78, 0x74, 0x20, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a,
+	0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2d, 0x73, 0x79,
+	0x6e, 0x63, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x59,
+	0x92, 0x41, 0x34, 0x1a, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x3a, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x20, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a,
+	0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2d, 0x73, 0x79,
+	0x6e, 0x63, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0xd6, 0x01, 0x0a, 0x12, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x8f, 0x01, 0x92, 0x41, 0x69, 0x1a, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x3a, 0x20, 0x73, 0x79, 0x6e, 0x63,
+	0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x73, 0x20, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x65, 0x6c, 0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x77, 0x61, 0x69, 0x74, 0x73, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x2c, 0x20, 0x61, 0x6c,
+	0x73, 0x6f, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x2d, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x12, 0xad, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53,
+	0x79, 0x6e, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x53, 0x79, 0x6e, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x57, 0x92, 0x41, 0x3d, 0x1a, 0x3b,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x73, 0x3a,
+	0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x73, 0x79,
+	0x6e, 0x63, 0x73, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0xde, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x91, 0x01, 0x92, 0x41, 0x69, 0x1a, 0x67, 0x52, 0x65, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x3a, 0x20, 0x65, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x20, 0x61, 0x20, 0x66, 0x72, 0x65, 0x73, 0x68, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x61,
+	0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e,
+	0x63, 0x2f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0xdf, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x72,
+	0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x28, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6e, 0x92, 0x41, 0x55, 0x1a, 0x53, 0x4c, 0x69, 0x73, 0x74,
+	0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x20, 0x6b, 0x65,
+	0x70, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x61, 0x72, 0x61,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x12, 0xff, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x73, 0x1a, 0x71,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x20, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x20, 0x28, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x78, 0x65, 0x64, 0x20, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x29, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x73, 0x20, 0x69, 0x74, 0x20, 0x66, 0x72,
+	0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0xd8, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
 	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x31, 0x92, 0x41, 0x1b, 0x1a, 0x19, 0x53,
-	0x79, 0x6e, 0x63, 0x3a, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x73, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x44, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01,
-	0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x8f, 0x01, 0x0a, 0x0a,
-	0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x78, 0x92, 0x41, 0x5a, 0x1a, 0x58, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f,
+	0x76, 0x31, 0x2f, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xe9, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75,
+	0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x99, 0x01, 0x92, 0x41, 0x76, 0x1a, 0x74, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73,
+	0x20, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72,
+	0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x20, 0x77, 0x61,
+	0x73, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x2d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xd6,
+	0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x88, 0x01, 0x92,
+	0x41, 0x6d, 0x1a, 0x6b, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6a, 0x6f, 0x62, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x20, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0xc9, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83, 0x01,
+	0x92, 0x41, 0x67, 0x1a, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x73,
+	0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x20, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x65,
+	0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2c, 0x20,
+	0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x77, 0x68, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20, 0x61,
+	0x72, 0x65, 0x20, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x79, 0x6e, 0x63,
+	0x65, 0x72, 0x73, 0x12, 0x8b, 0x02, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65,
+	0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x72,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x4c, 0x92, 0x41, 0x32, 0x1a, 0x30, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x65, 0x64, 0x65, 0x64, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x44, 0x42, 0x20,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x89, 0x01,
-	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c,
+	0x74, 0x79, 0x22, 0xbc, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x1a, 0x8a, 0x01, 0x53, 0x65, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x20, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x73, 0x20,
+	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x74, 0x79, 0x70,
+	0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x73, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x2c, 0x20, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x64, 0x65, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x7d, 0x12, 0xc0, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0xec, 0x01, 0x92, 0x41, 0xb7, 0x01, 0x1a, 0xb4, 0x01, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x3a, 0x20, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2c,
+	0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2c,
+	0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2c, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x49,
+	0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x79,
+	0x6e, 0x63, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2c, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x70,
+	0x75, 0x72, 0x67, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x3c, 0x92, 0x41, 0x25, 0x1a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74,
+	0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0xb2, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6b, 0x92, 0x41, 0x2b, 0x1a, 0x29, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20,
+	0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa9, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22,
 	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3c, 0x92, 0x41, 0x25,
-	0x1a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x20,
-	0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x6b, 0x92, 0x41, 0x2b, 0x1a, 0x29, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49,
-	0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa9,
-	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4d, 0x92, 0x41, 0x30,
-	0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f,
-	0x66, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x4d, 0x92, 0x41, 0x30, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0xd2, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7c, 0x92, 0x41, 0x36, 0x1a, 0x34, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x20,
+	0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x79,
+	0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xaa, 0x02, 0x0a, 0x19, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x2a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb5, 0x01, 0x92, 0x41,
+	0x65, 0x1a, 0x63, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x3a, 0x20, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x20, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61,
+	0x6e, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x3a, 0x01, 0x2a, 0x22,
+	0x42, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x12, 0xd5, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x28, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x64, 0x92, 0x41, 0x3f, 0x1a, 0x3d, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12,
+	0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xff, 0x01, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x27,
 	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a,
-	0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x7c, 0x92, 0x41, 0x36, 0x1a, 0x34, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20,
-	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x3d, 0x12, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12,
-	0xd5, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x93, 0x01, 0x92, 0x41, 0x45, 0x1a, 0x43, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x79, 0x20,
+	0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x12, 0x43, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2d, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xef, 0x01,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72,
+	0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6e,
 	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x64, 0x92, 0x41, 0x3f, 0x1a, 0x3d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2d, 0x62,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xff, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x12, 0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6e, 0x65, 0x74,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x72, 0x92, 0x41, 0x48,
+	0x1a, 0x46, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x20,
+	0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x20,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2d, 0x70, 0x6f, 0x72, 0x74, 0x2d, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x99, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x2a,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6e, 0x65, 0x74,
 	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x93, 0x01, 0x92, 0x41, 0x45, 0x1a, 0x43, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x45, 0x12, 0x43, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0xa1, 0x01, 0x92, 0x41, 0x4e, 0x1a, 0x4c, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20,
+	0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x6d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x4a, 0x12, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2d, 0x70, 0x6f, 0x72, 0x74, 0x2d, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x12, 0x1b, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x32, 0x53, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3b, 0x92, 0x41, 0x24, 0x1a, 0x22, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c,
+	0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x73, 0x32, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2d,
+	0x73, 0x32, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x32, 0x53, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x71, 0x1a, 0x1b,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6c, 0x92, 0x41, 0x2c,
+	0x1a, 0x2a, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x3a, 0x20, 0x67, 0x65,
+	0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x72, 0x75,
+	0x6c, 0x65, 0x20, 0x73, 0x32, 0x73, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2d, 0x73, 0x32, 0x73,
 	0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d,
 	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xef, 0x01, 0x0a, 0x1c, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
-	0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x72, 0x92, 0x41, 0x48, 0x1a, 0x46, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
-	0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73,
-	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x6d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2d, 0x70, 0x6f,
-	0x72, 0x74, 0x2d, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x99, 0x02, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x12, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x92, 0x41, 0x32, 0x1a, 0x30,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2d, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0xd3, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12,
+	0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x7d, 0x92, 0x41, 0x36, 0x1a, 0x34, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61,
+	0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2d, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f,
+	0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x12, 0x81, 0x02, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7b, 0x92, 0x41, 0x4e, 0x1a, 0x4c, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x3a, 0x20,
+	0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0xa6, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
 	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0xa1, 0x01, 0x92, 0x41, 0x4e, 0x1a, 0x4c, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70,
-	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x20, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2d, 0x70, 0x6f, 0x72, 0x74, 0x2d, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
-	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32,
-	0x53, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x3b, 0x92, 0x41, 0x24, 0x1a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c,
-	0x65, 0x53, 0x32, 0x53, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20,
-	0x6f, 0x66, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x73, 0x32, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2d, 0x73, 0x32, 0x73, 0x12,
-	0xb3, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x12, 0x1a,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0xa8, 0x01, 0x92, 0x41, 0x52, 0x1a, 0x50, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x4d, 0x12, 0x4b, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x9c,
+	0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x46, 0x92, 0x41, 0x2b, 0x1a, 0x29, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74,
+	0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xc5, 0x01,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e,
 	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65,
-	0x53, 0x32, 0x53, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6c, 0x92, 0x41, 0x2c, 0x1a, 0x2a, 0x47, 0x65,
-	0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61,
-	0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x73,
-	0x32, 0x73, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35,
-	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2d, 0x73, 0x32, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x75,
+	0x92, 0x41, 0x31, 0x1a, 0x2f, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75,
+	0x6c, 0x65, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x20, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x20, 0x62,
+	0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
 	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
 	0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x23, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x92, 0x41, 0x32, 0x1a, 0x30, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x3a, 0x20,
-	0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0xd3, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7d,
-	0x92, 0x41, 0x36, 0x1a, 0x34, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
-	0x6c, 0x69, 0x61, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x6c,
-	0x69, 0x61, 0x73, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12,
-	0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x81, 0x02,
-	0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0x2f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x1a, 0x30, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x7b, 0x92, 0x41, 0x4e, 0x1a, 0x4c, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73,
-	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0xa6, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
-	0x1a, 0x2d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0xa8, 0x01, 0x92, 0x41, 0x52, 0x1a, 0x50, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x63, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x12, 0x4b, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f,
-	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x46, 0x92, 0x41, 0x2b, 0x1a, 0x29, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67,
-	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69,
-	0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x65, 0x61,
-	0x67, 0x61, 0x67, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41,
-	0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67,
-	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x75, 0x92, 0x41, 0x31, 0x1a,
-	0x2f, 0x47, 0x65, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x3a, 0x20,
-	0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20,
-	0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x65, 0x61, 0x67,
-	0x61, 0x67, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x85, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45,
+	0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x8a, 0x01, 0x92, 0x41, 0x62, 0x1a, 0x60, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x45, 0x41, 0x67,
+	0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x3a, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x20, 0x74,
+	0x6f, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x20, 0x49, 0x45, 0x41,
+	0x67, 0x41, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x65, 0x61, 0x67, 0x61, 0x67, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x2d,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xe0, 0x01,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x6f, 0x92, 0x41, 0x4b, 0x1a, 0x49, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x20,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x20, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x89, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3c,
+	0x92, 0x41, 0x25, 0x1a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c,
+	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0xb2, 0x01, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x6b, 0x92, 0x41, 0x2b, 0x1a, 0x29, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x62,
+	0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
 	0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f,
 	0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x12, 0x89, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x3c, 0x92, 0x41, 0x25, 0x1a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
-	0x20, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12,
-	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0xb2, 0x01,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x2e, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6b, 0x92, 0x41, 0x2b, 0x1a, 0x29, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20,
-	0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x76, 0x31,
-	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d,
-	0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x24, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x53, 0x92, 0x41, 0x34, 0x1a, 0x32, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x3a,
-	0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xdf, 0x01, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x12, 0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x82, 0x01, 0x92, 0x41, 0x3a, 0x1a, 0x38, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x20, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f,
-	0x76, 0x31, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x7d, 0x12, 0xb5, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x53, 0x92, 0x41, 0x34, 0x1a, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x20,
+	0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xdf, 0x01, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x82, 0x01, 0x92, 0x41, 0x3a, 0x1a, 0x38, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x3a,
+	0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x20, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x76,
+	0x31, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x77, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x33, 0x92, 0x41, 0x1f, 0x1a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x3a,
+	0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x68,
+	0x6f, 0x73, 0x74, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x62, 0x92, 0x41, 0x25, 0x1a, 0x23, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73,
+	0x74, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x63, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x6e,
+	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48,
+	0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x4a, 0x92, 0x41, 0x2e, 0x1a, 0x2c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c,
+	0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f,
+	0x68, 0x6f, 0x73, 0x74, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xcc, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x79, 0x92, 0x41, 0x34, 0x1a, 0x32, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c,
+	0x12, 0x3a, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69,
 	0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x77, 0x0a, 0x09,
-	0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x6e, 0x65, 0x74, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x33, 0x92, 0x41, 0x1f, 0x1a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73,
-	0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x68,
-	0x6f, 0x73, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f,
-	0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73,
-	0x74, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x74,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x62, 0x92, 0x41, 0x25, 0x1a, 0x23, 0x47, 0x65, 0x74, 0x48, 0x6f,
-	0x73, 0x74, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xc2, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53,
+	0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e,
 	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x4a, 0x92, 0x41, 0x2e, 0x1a, 0x2c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x73,
-	0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20,
-	0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31,
-	0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xcc,
-	0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x79, 0x92, 0x41, 0x34, 0x1a, 0x32, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20,
-	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x62, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x3c, 0x12, 0x3a, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x2d, 0x62, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5a, 0x92, 0x41, 0x38, 0x1a, 0x36, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x20, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75,
+	0x6c, 0x65, 0x2d, 0x73, 0x32, 0x73, 0x2d, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0xec, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53,
+	0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x89, 0x01, 0x92, 0x41, 0x3e, 0x1a, 0x3c, 0x47, 0x65, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x20, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x32, 0x53, 0x20, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x12, 0x40,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2d, 0x73, 0x32, 0x73, 0x2d, 0x65, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0xe1, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x2a, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72,
+	0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6a, 0x92, 0x41, 0x43, 0x1a, 0x41, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20,
+	0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x20, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x86, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x27, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6e, 0x65, 0x74, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x97, 0x01, 0x92, 0x41, 0x47, 0x1a, 0x45, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x6f, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x20, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x12, 0x45, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
 	0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x1a, 0x19, 0x92,
-	0x41, 0x16, 0x12, 0x14, 0x4e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x20, 0x41, 0x50, 0x49,
-	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0xbc, 0x01, 0x92, 0x41, 0x82, 0x01, 0x12,
-	0x13, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x20, 0x41, 0x50, 0x49, 0x32,
-	0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x44, 0x0a, 0x0d, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x79, 0x6f, 0x75, 0x72, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x6e, 0x65,
-	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2d, 0x70, 0x67, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x5a, 0x34, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2d, 0x70, 0x67, 0x2d, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x3b, 0x6e,
-	0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa9, 0x01,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4d, 0x92, 0x41, 0x30, 0x1a,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
+	0x20, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2d,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x20,
+	0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x7c, 0x92, 0x41, 0x36, 0x1a, 0x34, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x20, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3d, 0x12, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2d, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xc1,
+	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x26, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x59, 0x92, 0x41, 0x38, 0x1a, 0x36, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f,
+	0x66, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x73, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0xeb, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x2e, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x88, 0x01, 0x92, 0x41, 0x3e, 0x1a, 0x3c, 0x47, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x75, 0x72, 0x65,
+	0x3a, 0x20, 0x67, 0x65, 0x74, 0x73, 0x20, 0x61, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x70, 0x6f, 0x73, 0x74,
+	0x75, 0x72, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12,
+	0x3f, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2d, 0x70,
+	0x6f, 0x73, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x2f, 0x7b,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0xec, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22, 0x95, 0x01, 0x92, 0x41, 0x73, 0x1a, 0x71, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x3a, 0x20,
+	0x77, 0x61, 0x6c, 0x6b, 0x73, 0x20, 0x49, 0x45, 0x41, 0x67, 0x41, 0x67, 0x20, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x6f, 0x74, 0x68, 0x20, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x62, 0x79, 0x20, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x76, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x17, 0x2e, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x3b, 0x92, 0x41, 0x27, 0x1a, 0x25, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x3a, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x20, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x1a, 0x19, 0x92, 0x41, 0x16, 0x12, 0x14, 0x4e, 0x65,
+	0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x20, 0x41, 0x50, 0x49, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x42, 0xbc, 0x01, 0x92, 0x41, 0x82, 0x01, 0x12, 0x13, 0x0a, 0x0c, 0x4e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x20, 0x41, 0x50, 0x49, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01,
+	0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x44, 0x0a, 0x0d, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x75, 0x72, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2d, 0x70, 0x67, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5a, 0x34, 0x6e, 0x65, 0x74,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x2d, 0x70, 0x67, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72, 0x64, 0x3b, 0x6e, 0x65, 0x74, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6822,327 +12528,574 @@ func file_netguard_api_proto_rawDescGZIP() []byte {
 	return file_netguard_api_proto_rawDescData
 }
 
-var file_netguard_api_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_netguard_api_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_netguard_api_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_netguard_api_proto_msgTypes = make([]protoimpl.MessageInfo, 166)
 var file_netguard_api_proto_goTypes = []any{
 	(Traffic)(0),                                // 0: netguard.v1.Traffic
 	(HostRegistrationSource)(0),                 // 1: netguard.v1.HostRegistrationSource
 	(AddressGroupRegistrationSource)(0),         // 2: netguard.v1.AddressGroupRegistrationSource
-	(RuleAction)(0),                             // 3: netguard.v1.RuleAction
-	(SyncOp)(0),                                 // 4: netguard.v1.SyncOp
-	(Networks_NetIP_Transport)(0),               // 5: netguard.v1.Networks.NetIP.Transport
-	(*Networks)(nil),                            // 6: netguard.v1.Networks
-	(*Service)(nil),                             // 7: netguard.v1.Service
-	(*IngressPort)(nil),                         // 8: netguard.v1.IngressPort
-	(*ResourceIdentifier)(nil),                  // 9: netguard.v1.ResourceIdentifier
-	(*ObjectReference)(nil),                     // 10: netguard.v1.ObjectReference
-	(*HostReference)(nil),                       // 11: netguard.v1.HostReference
-	(*AddressGroupReference)(nil),               // 12: netguard.v1.AddressGroupReference
-	(*NamespacedObjectReference)(nil),           // 13: netguard.v1.NamespacedObjectReference
-	(*Condition)(nil),                           // 14: netguard.v1.Condition
-	(*ManagedFieldsEntry)(nil),                  // 15: netguard.v1.ManagedFieldsEntry
-	(*Meta)(nil),                                // 16: netguard.v1.Meta
-	(*AddressGroupRef)(nil),                     // 17: netguard.v1.AddressGroupRef
-	(*ServiceRef)(nil),                          // 18: netguard.v1.ServiceRef
-	(*NetworkItem)(nil),                         // 19: netguard.v1.NetworkItem
-	(*Network)(nil),                             // 20: netguard.v1.Network
-	(*AddressGroup)(nil),                        // 21: netguard.v1.AddressGroup
-	(*AddressGroupBinding)(nil),                 // 22: netguard.v1.AddressGroupBinding
-	(*NetworkBinding)(nil),                      // 23: netguard.v1.NetworkBinding
-	(*IPItem)(nil),                              // 24: netguard.v1.IPItem
-	(*Host)(nil),                                // 25: netguard.v1.Host
-	(*HostBinding)(nil),                         // 26: netguard.v1.HostBinding
-	(*ProtocolPorts)(nil),                       // 27: netguard.v1.ProtocolPorts
-	(*PortRange)(nil),                           // 28: netguard.v1.PortRange
-	(*PortRanges)(nil),                          // 29: netguard.v1.PortRanges
-	(*ServicePortsRef)(nil),                     // 30: netguard.v1.ServicePortsRef
-	(*AddressGroupPortMapping)(nil),             // 31: netguard.v1.AddressGroupPortMapping
-	(*ServiceAlias)(nil),                        // 32: netguard.v1.ServiceAlias
-	(*AddressGroupBindingPolicy)(nil),           // 33: netguard.v1.AddressGroupBindingPolicy
-	(*RuleS2S)(nil),                             // 34: netguard.v1.RuleS2S
-	(*IEAgAgRule)(nil),                          // 35: netguard.v1.IEAgAgRule
-	(*PortSpec)(nil),                            // 36: netguard.v1.PortSpec
-	(*SyncStatusResp)(nil),                      // 37: netguard.v1.SyncStatusResp
-	(*SyncServices)(nil),                        // 38: netguard.v1.SyncServices
-	(*SyncAddressGroups)(nil),                   // 39: netguard.v1.SyncAddressGroups
-	(*SyncAddressGroupBindings)(nil),            // 40: netguard.v1.SyncAddressGroupBindings
-	(*SyncAddressGroupPortMappings)(nil),        // 41: netguard.v1.SyncAddressGroupPortMappings
-	(*SyncRuleS2S)(nil),                         // 42: netguard.v1.SyncRuleS2S
-	(*SyncServiceAliases)(nil),                  // 43: netguard.v1.SyncServiceAliases
-	(*SyncAddressGroupBindingPolicies)(nil),     // 44: netguard.v1.SyncAddressGroupBindingPolicies
-	(*SyncIEAgAgRules)(nil),                     // 45: netguard.v1.SyncIEAgAgRules
-	(*SyncNetworks)(nil),                        // 46: netguard.v1.SyncNetworks
-	(*SyncNetworkBindings)(nil),                 // 47: netguard.v1.SyncNetworkBindings
-	(*SyncHosts)(nil),                           // 48: netguard.v1.SyncHosts
-	(*SyncHostBindings)(nil),                    // 49: netguard.v1.SyncHostBindings
-	(*ListServicesReq)(nil),                     // 50: netguard.v1.ListServicesReq
-	(*ListServicesResp)(nil),                    // 51: netguard.v1.ListServicesResp
-	(*GetServiceReq)(nil),                       // 52: netguard.v1.GetServiceReq
-	(*GetServiceResp)(nil),                      // 53: netguard.v1.GetServiceResp
-	(*ListAddressGroupsReq)(nil),                // 54: netguard.v1.ListAddressGroupsReq
-	(*ListAddressGroupsResp)(nil),               // 55: netguard.v1.ListAddressGroupsResp
-	(*GetAddressGroupReq)(nil),                  // 56: netguard.v1.GetAddressGroupReq
-	(*GetAddressGroupResp)(nil),                 // 57: netguard.v1.GetAddressGroupResp
-	(*ListAddressGroupBindingsReq)(nil),         // 58: netguard.v1.ListAddressGroupBindingsReq
-	(*ListAddressGroupBindingsResp)(nil),        // 59: netguard.v1.ListAddressGroupBindingsResp
-	(*ListAddressGroupPortMappingsReq)(nil),     // 60: netguard.v1.ListAddressGroupPortMappingsReq
-	(*ListAddressGroupPortMappingsResp)(nil),    // 61: netguard.v1.ListAddressGroupPortMappingsResp
-	(*ListRuleS2SReq)(nil),                      // 62: netguard.v1.ListRuleS2SReq
-	(*ListRuleS2SResp)(nil),                     // 63: netguard.v1.ListRuleS2SResp
-	(*ListServiceAliasesReq)(nil),               // 64: netguard.v1.ListServiceAliasesReq
-	(*ListServiceAliasesResp)(nil),              // 65: netguard.v1.ListServiceAliasesResp
-	(*GetAddressGroupBindingReq)(nil),           // 66: netguard.v1.GetAddressGroupBindingReq
-	(*GetAddressGroupBindingResp)(nil),          // 67: netguard.v1.GetAddressGroupBindingResp
-	(*GetAddressGroupPortMappingReq)(nil),       // 68: netguard.v1.GetAddressGroupPortMappingReq
-	(*GetAddressGroupPortMappingResp)(nil),      // 69: netguard.v1.GetAddressGroupPortMappingResp
-	(*GetRuleS2SReq)(nil),                       // 70: netguard.v1.GetRuleS2SReq
-	(*GetRuleS2SResp)(nil),                      // 71: netguard.v1.GetRuleS2SResp
-	(*GetServiceAliasReq)(nil),                  // 72: netguard.v1.GetServiceAliasReq
-	(*GetServiceAliasResp)(nil),                 // 73: netguard.v1.GetServiceAliasResp
-	(*ListAddressGroupBindingPoliciesReq)(nil),  // 74: netguard.v1.ListAddressGroupBindingPoliciesReq
-	(*ListAddressGroupBindingPoliciesResp)(nil), // 75: netguard.v1.ListAddressGroupBindingPoliciesResp
-	(*GetAddressGroupBindingPolicyReq)(nil),     // 76: netguard.v1.GetAddressGroupBindingPolicyReq
-	(*GetAddressGroupBindingPolicyResp)(nil),    // 77: netguard.v1.GetAddressGroupBindingPolicyResp
-	(*ListIEAgAgRulesReq)(nil),                  // 78: netguard.v1.ListIEAgAgRulesReq
-	(*ListIEAgAgRulesResp)(nil),                 // 79: netguard.v1.ListIEAgAgRulesResp
-	(*GetIEAgAgRuleReq)(nil),                    // 80: netguard.v1.GetIEAgAgRuleReq
-	(*GetIEAgAgRuleResp)(nil),                   // 81: netguard.v1.GetIEAgAgRuleResp
-	(*ListNetworksReq)(nil),                     // 82: netguard.v1.ListNetworksReq
-	(*ListNetworksResp)(nil),                    // 83: netguard.v1.ListNetworksResp
-	(*GetNetworkReq)(nil),                       // 84: netguard.v1.GetNetworkReq
-	(*GetNetworkResp)(nil),                      // 85: netguard.v1.GetNetworkResp
-	(*ListNetworkBindingsReq)(nil),              // 86: netguard.v1.ListNetworkBindingsReq
-	(*ListNetworkBindingsResp)(nil),             // 87: netguard.v1.ListNetworkBindingsResp
-	(*GetNetworkBindingReq)(nil),                // 88: netguard.v1.GetNetworkBindingReq
-	(*GetNetworkBindingResp)(nil),               // 89: netguard.v1.GetNetworkBindingResp
-	(*ListHostsReq)(nil),                        // 90: netguard.v1.ListHostsReq
-	(*ListHostsResp)(nil),                       // 91: netguard.v1.ListHostsResp
-	(*GetHostReq)(nil),                          // 92: netguard.v1.GetHostReq
-	(*GetHostResp)(nil),                         // 93: netguard.v1.GetHostResp
-	(*ListHostBindingsReq)(nil),                 // 94: netguard.v1.ListHostBindingsReq
-	(*ListHostBindingsResp)(nil),                // 95: netguard.v1.ListHostBindingsResp
-	(*GetHostBindingReq)(nil),                   // 96: netguard.v1.GetHostBindingReq
-	(*GetHostBindingResp)(nil),                  // 97: netguard.v1.GetHostBindingResp
-	(*SyncReq)(nil),                             // 98: netguard.v1.SyncReq
-	(*Networks_NetIP)(nil),                      // 99: netguard.v1.Networks.NetIP
-	nil,                                         // 100: netguard.v1.Meta.LabelsEntry
-	nil,                                         // 101: netguard.v1.Meta.AnnotationsEntry
-	nil,                                         // 102: netguard.v1.ProtocolPorts.PortsEntry
-	(*timestamppb.Timestamp)(nil),               // 103: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                       // 104: google.protobuf.Empty
+	(RuleS2SPortsSource)(0),                     // 3: netguard.v1.RuleS2SPortsSource
+	(NamespacePostureMode)(0),                   // 4: netguard.v1.NamespacePostureMode
+	(RuleAction)(0),                             // 5: netguard.v1.RuleAction
+	(SyncOp)(0),                                 // 6: netguard.v1.SyncOp
+	(Networks_NetIP_Transport)(0),               // 7: netguard.v1.Networks.NetIP.Transport
+	(*Networks)(nil),                            // 8: netguard.v1.Networks
+	(*Service)(nil),                             // 9: netguard.v1.Service
+	(*IngressPort)(nil),                         // 10: netguard.v1.IngressPort
+	(*ResourceIdentifier)(nil),                  // 11: netguard.v1.ResourceIdentifier
+	(*ListSelector)(nil),                        // 12: netguard.v1.ListSelector
+	(*ObjectReference)(nil),                     // 13: netguard.v1.ObjectReference
+	(*HostReference)(nil),                       // 14: netguard.v1.HostReference
+	(*AddressGroupReference)(nil),               // 15: netguard.v1.AddressGroupReference
+	(*NamespacedObjectReference)(nil),           // 16: netguard.v1.NamespacedObjectReference
+	(*Condition)(nil),                           // 17: netguard.v1.Condition
+	(*OwnerReference)(nil),                      // 18: netguard.v1.OwnerReference
+	(*ManagedFieldsEntry)(nil),                  // 19: netguard.v1.ManagedFieldsEntry
+	(*Meta)(nil),                                // 20: netguard.v1.Meta
+	(*AddressGroupRef)(nil),                     // 21: netguard.v1.AddressGroupRef
+	(*ServiceRef)(nil),                          // 22: netguard.v1.ServiceRef
+	(*NetworkItem)(nil),                         // 23: netguard.v1.NetworkItem
+	(*Network)(nil),                             // 24: netguard.v1.Network
+	(*AddressGroup)(nil),                        // 25: netguard.v1.AddressGroup
+	(*AddressGroupBinding)(nil),                 // 26: netguard.v1.AddressGroupBinding
+	(*NetworkBinding)(nil),                      // 27: netguard.v1.NetworkBinding
+	(*IPItem)(nil),                              // 28: netguard.v1.IPItem
+	(*Host)(nil),                                // 29: netguard.v1.Host
+	(*HostBinding)(nil),                         // 30: netguard.v1.HostBinding
+	(*ProtocolPorts)(nil),                       // 31: netguard.v1.ProtocolPorts
+	(*PortRange)(nil),                           // 32: netguard.v1.PortRange
+	(*PortRanges)(nil),                          // 33: netguard.v1.PortRanges
+	(*ServicePortsRef)(nil),                     // 34: netguard.v1.ServicePortsRef
+	(*AddressGroupPortMapping)(nil),             // 35: netguard.v1.AddressGroupPortMapping
+	(*ServiceAlias)(nil),                        // 36: netguard.v1.ServiceAlias
+	(*AddressGroupBindingPolicy)(nil),           // 37: netguard.v1.AddressGroupBindingPolicy
+	(*RuleS2S)(nil),                             // 38: netguard.v1.RuleS2S
+	(*IEAgAgRule)(nil),                          // 39: netguard.v1.IEAgAgRule
+	(*RuleS2SException)(nil),                    // 40: netguard.v1.RuleS2SException
+	(*CrossNamespacePolicy)(nil),                // 41: netguard.v1.CrossNamespacePolicy
+	(*RuleTemplate)(nil),                        // 42: netguard.v1.RuleTemplate
+	(*NamespacePosture)(nil),                    // 43: netguard.v1.NamespacePosture
+	(*PortSpec)(nil),                            // 44: netguard.v1.PortSpec
+	(*SyncStatusResp)(nil),                      // 45: netguard.v1.SyncStatusResp
+	(*GetDetailedSyncStatusReq)(nil),            // 46: netguard.v1.GetDetailedSyncStatusReq
+	(*KindSyncStatus)(nil),                      // 47: netguard.v1.KindSyncStatus
+	(*ResourceSyncStatus)(nil),                  // 48: netguard.v1.ResourceSyncStatus
+	(*GetDetailedSyncStatusResp)(nil),           // 49: netguard.v1.GetDetailedSyncStatusResp
+	(*ReverseSyncEntityStatus)(nil),             // 50: netguard.v1.ReverseSyncEntityStatus
+	(*GetReverseSyncStatusResp)(nil),            // 51: netguard.v1.GetReverseSyncStatusResp
+	(*ListFailedSyncsReq)(nil),                  // 52: netguard.v1.ListFailedSyncsReq
+	(*FailedSync)(nil),                          // 53: netguard.v1.FailedSync
+	(*ListFailedSyncsResp)(nil),                 // 54: netguard.v1.ListFailedSyncsResp
+	(*RetryFailedSyncReq)(nil),                  // 55: netguard.v1.RetryFailedSyncReq
+	(*ListQuarantinedResourcesReq)(nil),         // 56: netguard.v1.ListQuarantinedResourcesReq
+	(*QuarantinedResource)(nil),                 // 57: netguard.v1.QuarantinedResource
+	(*ListQuarantinedResourcesResp)(nil),        // 58: netguard.v1.ListQuarantinedResourcesResp
+	(*PromoteQuarantinedResourceReq)(nil),       // 59: netguard.v1.PromoteQuarantinedResourceReq
+	(*DeleteQuarantinedResourceReq)(nil),        // 60: netguard.v1.DeleteQuarantinedResourceReq
+	(*StartupSyncer)(nil),                       // 61: netguard.v1.StartupSyncer
+	(*StartupReverseSync)(nil),                  // 62: netguard.v1.StartupReverseSync
+	(*GetStartupReportResp)(nil),                // 63: netguard.v1.GetStartupReportResp
+	(*GetSchemaStatusResp)(nil),                 // 64: netguard.v1.GetSchemaStatusResp
+	(*Syncer)(nil),                              // 65: netguard.v1.Syncer
+	(*ListSyncersResp)(nil),                     // 66: netguard.v1.ListSyncersResp
+	(*SetSyncerEnabledReq)(nil),                 // 67: netguard.v1.SetSyncerEnabledReq
+	(*PurgeNamespaceReq)(nil),                   // 68: netguard.v1.PurgeNamespaceReq
+	(*PurgeNamespaceResp)(nil),                  // 69: netguard.v1.PurgeNamespaceResp
+	(*SyncServices)(nil),                        // 70: netguard.v1.SyncServices
+	(*SyncAddressGroups)(nil),                   // 71: netguard.v1.SyncAddressGroups
+	(*SyncAddressGroupBindings)(nil),            // 72: netguard.v1.SyncAddressGroupBindings
+	(*SyncAddressGroupPortMappings)(nil),        // 73: netguard.v1.SyncAddressGroupPortMappings
+	(*SyncRuleS2S)(nil),                         // 74: netguard.v1.SyncRuleS2S
+	(*SyncServiceAliases)(nil),                  // 75: netguard.v1.SyncServiceAliases
+	(*SyncAddressGroupBindingPolicies)(nil),     // 76: netguard.v1.SyncAddressGroupBindingPolicies
+	(*SyncIEAgAgRules)(nil),                     // 77: netguard.v1.SyncIEAgAgRules
+	(*SyncNetworks)(nil),                        // 78: netguard.v1.SyncNetworks
+	(*SyncNetworkBindings)(nil),                 // 79: netguard.v1.SyncNetworkBindings
+	(*SyncHosts)(nil),                           // 80: netguard.v1.SyncHosts
+	(*SyncHostBindings)(nil),                    // 81: netguard.v1.SyncHostBindings
+	(*SyncRuleS2SExceptions)(nil),               // 82: netguard.v1.SyncRuleS2SExceptions
+	(*SyncCrossNamespacePolicies)(nil),          // 83: netguard.v1.SyncCrossNamespacePolicies
+	(*SyncRuleTemplates)(nil),                   // 84: netguard.v1.SyncRuleTemplates
+	(*SyncNamespacePostures)(nil),               // 85: netguard.v1.SyncNamespacePostures
+	(*ListServicesReq)(nil),                     // 86: netguard.v1.ListServicesReq
+	(*ListServicesResp)(nil),                    // 87: netguard.v1.ListServicesResp
+	(*GetServiceReq)(nil),                       // 88: netguard.v1.GetServiceReq
+	(*GetServiceResp)(nil),                      // 89: netguard.v1.GetServiceResp
+	(*ListAddressGroupsReq)(nil),                // 90: netguard.v1.ListAddressGroupsReq
+	(*ListAddressGroupsResp)(nil),               // 91: netguard.v1.ListAddressGroupsResp
+	(*GetAddressGroupReq)(nil),                  // 92: netguard.v1.GetAddressGroupReq
+	(*GetAddressGroupResp)(nil),                 // 93: netguard.v1.GetAddressGroupResp
+	(*ListAddressGroupBindingsReq)(nil),         // 94: netguard.v1.ListAddressGroupBindingsReq
+	(*ListAddressGroupBindingsResp)(nil),        // 95: netguard.v1.ListAddressGroupBindingsResp
+	(*ListAddressGroupPortMappingsReq)(nil),     // 96: netguard.v1.ListAddressGroupPortMappingsReq
+	(*ListAddressGroupPortMappingsResp)(nil),    // 97: netguard.v1.ListAddressGroupPortMappingsResp
+	(*ListRuleS2SReq)(nil),                      // 98: netguard.v1.ListRuleS2SReq
+	(*ListRuleS2SResp)(nil),                     // 99: netguard.v1.ListRuleS2SResp
+	(*ListServiceAliasesReq)(nil),               // 100: netguard.v1.ListServiceAliasesReq
+	(*ListServiceAliasesResp)(nil),              // 101: netguard.v1.ListServiceAliasesResp
+	(*GetAddressGroupBindingReq)(nil),           // 102: netguard.v1.GetAddressGroupBindingReq
+	(*GetAddressGroupBindingResp)(nil),          // 103: netguard.v1.GetAddressGroupBindingResp
+	(*GetAddressGroupPortMappingReq)(nil),       // 104: netguard.v1.GetAddressGroupPortMappingReq
+	(*GetAddressGroupPortMappingResp)(nil),      // 105: netguard.v1.GetAddressGroupPortMappingResp
+	(*GetRuleS2SReq)(nil),                       // 106: netguard.v1.GetRuleS2SReq
+	(*GetRuleS2SResp)(nil),                      // 107: netguard.v1.GetRuleS2SResp
+	(*GetServiceAliasReq)(nil),                  // 108: netguard.v1.GetServiceAliasReq
+	(*GetServiceAliasResp)(nil),                 // 109: netguard.v1.GetServiceAliasResp
+	(*ListAddressGroupBindingPoliciesReq)(nil),  // 110: netguard.v1.ListAddressGroupBindingPoliciesReq
+	(*ListAddressGroupBindingPoliciesResp)(nil), // 111: netguard.v1.ListAddressGroupBindingPoliciesResp
+	(*GetAddressGroupBindingPolicyReq)(nil),     // 112: netguard.v1.GetAddressGroupBindingPolicyReq
+	(*GetAddressGroupBindingPolicyResp)(nil),    // 113: netguard.v1.GetAddressGroupBindingPolicyResp
+	(*ListIEAgAgRulesReq)(nil),                  // 114: netguard.v1.ListIEAgAgRulesReq
+	(*ListIEAgAgRulesResp)(nil),                 // 115: netguard.v1.ListIEAgAgRulesResp
+	(*GetIEAgAgRuleReq)(nil),                    // 116: netguard.v1.GetIEAgAgRuleReq
+	(*GetIEAgAgRuleResp)(nil),                   // 117: netguard.v1.GetIEAgAgRuleResp
+	(*IEAgAgRuleContribution)(nil),              // 118: netguard.v1.IEAgAgRuleContribution
+	(*ListIEAgAgRuleContributionsReq)(nil),      // 119: netguard.v1.ListIEAgAgRuleContributionsReq
+	(*ListIEAgAgRuleContributionsResp)(nil),     // 120: netguard.v1.ListIEAgAgRuleContributionsResp
+	(*ConditionTransition)(nil),                 // 121: netguard.v1.ConditionTransition
+	(*ListConditionTransitionsReq)(nil),         // 122: netguard.v1.ListConditionTransitionsReq
+	(*ListConditionTransitionsResp)(nil),        // 123: netguard.v1.ListConditionTransitionsResp
+	(*ListNetworksReq)(nil),                     // 124: netguard.v1.ListNetworksReq
+	(*ListNetworksResp)(nil),                    // 125: netguard.v1.ListNetworksResp
+	(*GetNetworkReq)(nil),                       // 126: netguard.v1.GetNetworkReq
+	(*GetNetworkResp)(nil),                      // 127: netguard.v1.GetNetworkResp
+	(*ListNetworkBindingsReq)(nil),              // 128: netguard.v1.ListNetworkBindingsReq
+	(*ListNetworkBindingsResp)(nil),             // 129: netguard.v1.ListNetworkBindingsResp
+	(*GetNetworkBindingReq)(nil),                // 130: netguard.v1.GetNetworkBindingReq
+	(*GetNetworkBindingResp)(nil),               // 131: netguard.v1.GetNetworkBindingResp
+	(*ListHostsReq)(nil),                        // 132: netguard.v1.ListHostsReq
+	(*ListHostsResp)(nil),                       // 133: netguard.v1.ListHostsResp
+	(*GetHostReq)(nil),                          // 134: netguard.v1.GetHostReq
+	(*GetHostResp)(nil),                         // 135: netguard.v1.GetHostResp
+	(*ListHostBindingsReq)(nil),                 // 136: netguard.v1.ListHostBindingsReq
+	(*ListHostBindingsResp)(nil),                // 137: netguard.v1.ListHostBindingsResp
+	(*GetHostBindingReq)(nil),                   // 138: netguard.v1.GetHostBindingReq
+	(*GetHostBindingResp)(nil),                  // 139: netguard.v1.GetHostBindingResp
+	(*ListRuleS2SExceptionsReq)(nil),            // 140: netguard.v1.ListRuleS2SExceptionsReq
+	(*ListRuleS2SExceptionsResp)(nil),           // 141: netguard.v1.ListRuleS2SExceptionsResp
+	(*GetRuleS2SExceptionReq)(nil),              // 142: netguard.v1.GetRuleS2SExceptionReq
+	(*GetRuleS2SExceptionResp)(nil),             // 143: netguard.v1.GetRuleS2SExceptionResp
+	(*ListCrossNamespacePoliciesReq)(nil),       // 144: netguard.v1.ListCrossNamespacePoliciesReq
+	(*ListCrossNamespacePoliciesResp)(nil),      // 145: netguard.v1.ListCrossNamespacePoliciesResp
+	(*GetCrossNamespacePolicyReq)(nil),          // 146: netguard.v1.GetCrossNamespacePolicyReq
+	(*GetCrossNamespacePolicyResp)(nil),         // 147: netguard.v1.GetCrossNamespacePolicyResp
+	(*ListRuleTemplatesReq)(nil),                // 148: netguard.v1.ListRuleTemplatesReq
+	(*ListRuleTemplatesResp)(nil),               // 149: netguard.v1.ListRuleTemplatesResp
+	(*GetRuleTemplateReq)(nil),                  // 150: netguard.v1.GetRuleTemplateReq
+	(*GetRuleTemplateResp)(nil),                 // 151: netguard.v1.GetRuleTemplateResp
+	(*ListNamespacePosturesReq)(nil),            // 152: netguard.v1.ListNamespacePosturesReq
+	(*ListNamespacePosturesResp)(nil),           // 153: netguard.v1.ListNamespacePosturesResp
+	(*GetNamespacePostureReq)(nil),              // 154: netguard.v1.GetNamespacePostureReq
+	(*GetNamespacePostureResp)(nil),             // 155: netguard.v1.GetNamespacePostureResp
+	(*TrafficEndpoint)(nil),                     // 156: netguard.v1.TrafficEndpoint
+	(*SimulateTrafficReq)(nil),                  // 157: netguard.v1.SimulateTrafficReq
+	(*TrafficRuleMatch)(nil),                    // 158: netguard.v1.TrafficRuleMatch
+	(*TrafficSimulationSide)(nil),               // 159: netguard.v1.TrafficSimulationSide
+	(*SimulateTrafficResp)(nil),                 // 160: netguard.v1.SimulateTrafficResp
+	(*SyncReq)(nil),                             // 161: netguard.v1.SyncReq
+	(*WatchReq)(nil),                            // 162: netguard.v1.WatchReq
+	(*WatchEvent)(nil),                          // 163: netguard.v1.WatchEvent
+	(*AnalyzeAddressGroupImpactReq)(nil),        // 164: netguard.v1.AnalyzeAddressGroupImpactReq
+	(*AnalyzeAddressGroupImpactResp)(nil),       // 165: netguard.v1.AnalyzeAddressGroupImpactResp
+	(*Networks_NetIP)(nil),                      // 166: netguard.v1.Networks.NetIP
+	nil,                                         // 167: netguard.v1.Meta.LabelsEntry
+	nil,                                         // 168: netguard.v1.Meta.AnnotationsEntry
+	nil,                                         // 169: netguard.v1.ProtocolPorts.PortsEntry
+	nil,                                         // 170: netguard.v1.RuleTemplate.LocalServiceSelectorEntry
+	nil,                                         // 171: netguard.v1.RuleTemplate.TargetServiceSelectorEntry
+	nil,                                         // 172: netguard.v1.GetStartupReportResp.FeaturesEntry
+	nil,                                         // 173: netguard.v1.PurgeNamespaceResp.DeletedEntry
+	(*timestamppb.Timestamp)(nil),               // 174: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                 // 175: google.protobuf.Duration
+	(*emptypb.Empty)(nil),                       // 176: google.protobuf.Empty
 }
 var file_netguard_api_proto_depIdxs = []int32{
-	9,   // 0: netguard.v1.Service.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	8,   // 1: netguard.v1.Service.ingress_ports:type_name -> netguard.v1.IngressPort
-	17,  // 2: netguard.v1.Service.address_groups:type_name -> netguard.v1.AddressGroupRef
-	16,  // 3: netguard.v1.Service.meta:type_name -> netguard.v1.Meta
-	12,  // 4: netguard.v1.Service.aggregated_address_groups:type_name -> netguard.v1.AddressGroupReference
-	5,   // 5: netguard.v1.IngressPort.protocol:type_name -> netguard.v1.Networks.NetIP.Transport
-	10,  // 6: netguard.v1.HostReference.ref:type_name -> netguard.v1.ObjectReference
+	11,  // 0: netguard.v1.Service.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	10,  // 1: netguard.v1.Service.ingress_ports:type_name -> netguard.v1.IngressPort
+	21,  // 2: netguard.v1.Service.address_groups:type_name -> netguard.v1.AddressGroupRef
+	20,  // 3: netguard.v1.Service.meta:type_name -> netguard.v1.Meta
+	15,  // 4: netguard.v1.Service.aggregated_address_groups:type_name -> netguard.v1.AddressGroupReference
+	7,   // 5: netguard.v1.IngressPort.protocol:type_name -> netguard.v1.Networks.NetIP.Transport
+	13,  // 6: netguard.v1.HostReference.ref:type_name -> netguard.v1.ObjectReference
 	1,   // 7: netguard.v1.HostReference.source:type_name -> netguard.v1.HostRegistrationSource
-	13,  // 8: netguard.v1.AddressGroupReference.ref:type_name -> netguard.v1.NamespacedObjectReference
+	16,  // 8: netguard.v1.AddressGroupReference.ref:type_name -> netguard.v1.NamespacedObjectReference
 	2,   // 9: netguard.v1.AddressGroupReference.source:type_name -> netguard.v1.AddressGroupRegistrationSource
-	103, // 10: netguard.v1.Condition.last_transition_time:type_name -> google.protobuf.Timestamp
-	103, // 11: netguard.v1.ManagedFieldsEntry.time:type_name -> google.protobuf.Timestamp
-	103, // 12: netguard.v1.Meta.creation_ts:type_name -> google.protobuf.Timestamp
-	100, // 13: netguard.v1.Meta.labels:type_name -> netguard.v1.Meta.LabelsEntry
-	101, // 14: netguard.v1.Meta.annotations:type_name -> netguard.v1.Meta.AnnotationsEntry
-	14,  // 15: netguard.v1.Meta.conditions:type_name -> netguard.v1.Condition
-	15,  // 16: netguard.v1.Meta.managed_fields:type_name -> netguard.v1.ManagedFieldsEntry
-	9,   // 17: netguard.v1.AddressGroupRef.identifier:type_name -> netguard.v1.ResourceIdentifier
-	13,  // 18: netguard.v1.AddressGroupRef.object_ref:type_name -> netguard.v1.NamespacedObjectReference
-	9,   // 19: netguard.v1.ServiceRef.identifier:type_name -> netguard.v1.ResourceIdentifier
-	13,  // 20: netguard.v1.ServiceRef.object_ref:type_name -> netguard.v1.NamespacedObjectReference
-	9,   // 21: netguard.v1.Network.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	16,  // 22: netguard.v1.Network.meta:type_name -> netguard.v1.Meta
-	10,  // 23: netguard.v1.Network.binding_ref:type_name -> netguard.v1.ObjectReference
-	13,  // 24: netguard.v1.Network.address_group_ref:type_name -> netguard.v1.NamespacedObjectReference
-	9,   // 25: netguard.v1.AddressGroup.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	3,   // 26: netguard.v1.AddressGroup.default_action:type_name -> netguard.v1.RuleAction
-	19,  // 27: netguard.v1.AddressGroup.networks:type_name -> netguard.v1.NetworkItem
-	16,  // 28: netguard.v1.AddressGroup.meta:type_name -> netguard.v1.Meta
-	10,  // 29: netguard.v1.AddressGroup.hosts:type_name -> netguard.v1.ObjectReference
-	11,  // 30: netguard.v1.AddressGroup.aggregated_hosts:type_name -> netguard.v1.HostReference
-	9,   // 31: netguard.v1.AddressGroupBinding.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	18,  // 32: netguard.v1.AddressGroupBinding.service_ref:type_name -> netguard.v1.ServiceRef
-	17,  // 33: netguard.v1.AddressGroupBinding.address_group_ref:type_name -> netguard.v1.AddressGroupRef
-	16,  // 34: netguard.v1.AddressGroupBinding.meta:type_name -> netguard.v1.Meta
-	9,   // 35: netguard.v1.NetworkBinding.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	10,  // 36: netguard.v1.NetworkBinding.network_ref:type_name -> netguard.v1.ObjectReference
-	10,  // 37: netguard.v1.NetworkBinding.address_group_ref:type_name -> netguard.v1.ObjectReference
-	19,  // 38: netguard.v1.NetworkBinding.network_item:type_name -> netguard.v1.NetworkItem
-	16,  // 39: netguard.v1.NetworkBinding.meta:type_name -> netguard.v1.Meta
-	9,   // 40: netguard.v1.Host.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	10,  // 41: netguard.v1.Host.binding_ref:type_name -> netguard.v1.ObjectReference
-	10,  // 42: netguard.v1.Host.address_group_ref:type_name -> netguard.v1.ObjectReference
-	24,  // 43: netguard.v1.Host.ip_list:type_name -> netguard.v1.IPItem
-	16,  // 44: netguard.v1.Host.meta:type_name -> netguard.v1.Meta
-	9,   // 45: netguard.v1.HostBinding.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	13,  // 46: netguard.v1.HostBinding.host_ref:type_name -> netguard.v1.NamespacedObjectReference
-	13,  // 47: netguard.v1.HostBinding.address_group_ref:type_name -> netguard.v1.NamespacedObjectReference
-	16,  // 48: netguard.v1.HostBinding.meta:type_name -> netguard.v1.Meta
-	102, // 49: netguard.v1.ProtocolPorts.ports:type_name -> netguard.v1.ProtocolPorts.PortsEntry
-	28,  // 50: netguard.v1.PortRanges.ranges:type_name -> netguard.v1.PortRange
-	9,   // 51: netguard.v1.ServicePortsRef.identifier:type_name -> netguard.v1.ResourceIdentifier
-	13,  // 52: netguard.v1.ServicePortsRef.object_ref:type_name -> netguard.v1.NamespacedObjectReference
-	27,  // 53: netguard.v1.ServicePortsRef.ports:type_name -> netguard.v1.ProtocolPorts
-	9,   // 54: netguard.v1.AddressGroupPortMapping.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	30,  // 55: netguard.v1.AddressGroupPortMapping.access_ports:type_name -> netguard.v1.ServicePortsRef
-	16,  // 56: netguard.v1.AddressGroupPortMapping.meta:type_name -> netguard.v1.Meta
-	9,   // 57: netguard.v1.ServiceAlias.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	18,  // 58: netguard.v1.ServiceAlias.service_ref:type_name -> netguard.v1.ServiceRef
-	16,  // 59: netguard.v1.ServiceAlias.meta:type_name -> netguard.v1.Meta
-	9,   // 60: netguard.v1.AddressGroupBindingPolicy.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	18,  // 61: netguard.v1.AddressGroupBindingPolicy.service_ref:type_name -> netguard.v1.ServiceRef
-	17,  // 62: netguard.v1.AddressGroupBindingPolicy.address_group_ref:type_name -> netguard.v1.AddressGroupRef
-	16,  // 63: netguard.v1.AddressGroupBindingPolicy.meta:type_name -> netguard.v1.Meta
-	9,   // 64: netguard.v1.RuleS2S.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	0,   // 65: netguard.v1.RuleS2S.traffic:type_name -> netguard.v1.Traffic
-	18,  // 66: netguard.v1.RuleS2S.service_local_ref:type_name -> netguard.v1.ServiceRef
-	18,  // 67: netguard.v1.RuleS2S.service_ref:type_name -> netguard.v1.ServiceRef
-	9,   // 68: netguard.v1.RuleS2S.ieag_ag_rule_refs:type_name -> netguard.v1.ResourceIdentifier
-	13,  // 69: netguard.v1.RuleS2S.ieag_ag_rule_object_refs:type_name -> netguard.v1.NamespacedObjectReference
-	16,  // 70: netguard.v1.RuleS2S.meta:type_name -> netguard.v1.Meta
-	9,   // 71: netguard.v1.IEAgAgRule.self_ref:type_name -> netguard.v1.ResourceIdentifier
-	5,   // 72: netguard.v1.IEAgAgRule.transport:type_name -> netguard.v1.Networks.NetIP.Transport
-	0,   // 73: netguard.v1.IEAgAgRule.traffic:type_name -> netguard.v1.Traffic
-	17,  // 74: netguard.v1.IEAgAgRule.address_group_local:type_name -> netguard.v1.AddressGroupRef
-	17,  // 75: netguard.v1.IEAgAgRule.address_group:type_name -> netguard.v1.AddressGroupRef
-	36,  // 76: netguard.v1.IEAgAgRule.ports:type_name -> netguard.v1.PortSpec
-	3,   // 77: netguard.v1.IEAgAgRule.action:type_name -> netguard.v1.RuleAction
-	16,  // 78: netguard.v1.IEAgAgRule.meta:type_name -> netguard.v1.Meta
-	103, // 79: netguard.v1.SyncStatusResp.updated_at:type_name -> google.protobuf.Timestamp
-	7,   // 80: netguard.v1.SyncServices.services:type_name -> netguard.v1.Service
-	21,  // 81: netguard.v1.SyncAddressGroups.address_groups:type_name -> netguard.v1.AddressGroup
-	22,  // 82: netguard.v1.SyncAddressGroupBindings.address_group_bindings:type_name -> netguard.v1.AddressGroupBinding
-	31,  // 83: netguard.v1.SyncAddressGroupPortMappings.address_group_port_mappings:type_name -> netguard.v1.AddressGroupPortMapping
-	34,  // 84: netguard.v1.SyncRuleS2S.rule_s2s:type_name -> netguard.v1.RuleS2S
-	32,  // 85: netguard.v1.SyncServiceAliases.service_aliases:type_name -> netguard.v1.ServiceAlias
-	33,  // 86: netguard.v1.SyncAddressGroupBindingPolicies.address_group_binding_policies:type_name -> netguard.v1.AddressGroupBindingPolicy
-	35,  // 87: netguard.v1.SyncIEAgAgRules.ieagag_rules:type_name -> netguard.v1.IEAgAgRule
-	20,  // 88: netguard.v1.SyncNetworks.networks:type_name -> netguard.v1.Network
-	23,  // 89: netguard.v1.SyncNetworkBindings.network_bindings:type_name -> netguard.v1.NetworkBinding
-	25,  // 90: netguard.v1.SyncHosts.hosts:type_name -> netguard.v1.Host
-	26,  // 91: netguard.v1.SyncHostBindings.host_bindings:type_name -> netguard.v1.HostBinding
-	9,   // 92: netguard.v1.ListServicesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	7,   // 93: netguard.v1.ListServicesResp.items:type_name -> netguard.v1.Service
-	9,   // 94: netguard.v1.GetServiceReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	7,   // 95: netguard.v1.GetServiceResp.service:type_name -> netguard.v1.Service
-	9,   // 96: netguard.v1.ListAddressGroupsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	21,  // 97: netguard.v1.ListAddressGroupsResp.items:type_name -> netguard.v1.AddressGroup
-	9,   // 98: netguard.v1.GetAddressGroupReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	21,  // 99: netguard.v1.GetAddressGroupResp.address_group:type_name -> netguard.v1.AddressGroup
-	9,   // 100: netguard.v1.ListAddressGroupBindingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	22,  // 101: netguard.v1.ListAddressGroupBindingsResp.items:type_name -> netguard.v1.AddressGroupBinding
-	9,   // 102: netguard.v1.ListAddressGroupPortMappingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	31,  // 103: netguard.v1.ListAddressGroupPortMappingsResp.items:type_name -> netguard.v1.AddressGroupPortMapping
-	9,   // 104: netguard.v1.ListRuleS2SReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	34,  // 105: netguard.v1.ListRuleS2SResp.items:type_name -> netguard.v1.RuleS2S
-	9,   // 106: netguard.v1.ListServiceAliasesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	32,  // 107: netguard.v1.ListServiceAliasesResp.items:type_name -> netguard.v1.ServiceAlias
-	9,   // 108: netguard.v1.GetAddressGroupBindingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	22,  // 109: netguard.v1.GetAddressGroupBindingResp.address_group_binding:type_name -> netguard.v1.AddressGroupBinding
-	9,   // 110: netguard.v1.GetAddressGroupPortMappingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	31,  // 111: netguard.v1.GetAddressGroupPortMappingResp.address_group_port_mapping:type_name -> netguard.v1.AddressGroupPortMapping
-	9,   // 112: netguard.v1.GetRuleS2SReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	34,  // 113: netguard.v1.GetRuleS2SResp.rule_s2s:type_name -> netguard.v1.RuleS2S
-	9,   // 114: netguard.v1.GetServiceAliasReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	32,  // 115: netguard.v1.GetServiceAliasResp.service_alias:type_name -> netguard.v1.ServiceAlias
-	9,   // 116: netguard.v1.ListAddressGroupBindingPoliciesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	33,  // 117: netguard.v1.ListAddressGroupBindingPoliciesResp.items:type_name -> netguard.v1.AddressGroupBindingPolicy
-	9,   // 118: netguard.v1.GetAddressGroupBindingPolicyReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	33,  // 119: netguard.v1.GetAddressGroupBindingPolicyResp.address_group_binding_policy:type_name -> netguard.v1.AddressGroupBindingPolicy
-	9,   // 120: netguard.v1.ListIEAgAgRulesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	35,  // 121: netguard.v1.ListIEAgAgRulesResp.items:type_name -> netguard.v1.IEAgAgRule
-	9,   // 122: netguard.v1.GetIEAgAgRuleReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	35,  // 123: netguard.v1.GetIEAgAgRuleResp.ieagag_rule:type_name -> netguard.v1.IEAgAgRule
-	9,   // 124: netguard.v1.ListNetworksReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	20,  // 125: netguard.v1.ListNetworksResp.items:type_name -> netguard.v1.Network
-	9,   // 126: netguard.v1.GetNetworkReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	20,  // 127: netguard.v1.GetNetworkResp.network:type_name -> netguard.v1.Network
-	9,   // 128: netguard.v1.ListNetworkBindingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	23,  // 129: netguard.v1.ListNetworkBindingsResp.items:type_name -> netguard.v1.NetworkBinding
-	9,   // 130: netguard.v1.GetNetworkBindingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	23,  // 131: netguard.v1.GetNetworkBindingResp.network_binding:type_name -> netguard.v1.NetworkBinding
-	9,   // 132: netguard.v1.ListHostsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	25,  // 133: netguard.v1.ListHostsResp.items:type_name -> netguard.v1.Host
-	9,   // 134: netguard.v1.GetHostReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	25,  // 135: netguard.v1.GetHostResp.host:type_name -> netguard.v1.Host
-	9,   // 136: netguard.v1.ListHostBindingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
-	26,  // 137: netguard.v1.ListHostBindingsResp.items:type_name -> netguard.v1.HostBinding
-	9,   // 138: netguard.v1.GetHostBindingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
-	26,  // 139: netguard.v1.GetHostBindingResp.host_binding:type_name -> netguard.v1.HostBinding
-	4,   // 140: netguard.v1.SyncReq.sync_op:type_name -> netguard.v1.SyncOp
-	38,  // 141: netguard.v1.SyncReq.services:type_name -> netguard.v1.SyncServices
-	39,  // 142: netguard.v1.SyncReq.address_groups:type_name -> netguard.v1.SyncAddressGroups
-	40,  // 143: netguard.v1.SyncReq.address_group_bindings:type_name -> netguard.v1.SyncAddressGroupBindings
-	41,  // 144: netguard.v1.SyncReq.address_group_port_mappings:type_name -> netguard.v1.SyncAddressGroupPortMappings
-	42,  // 145: netguard.v1.SyncReq.rule_s2s:type_name -> netguard.v1.SyncRuleS2S
-	43,  // 146: netguard.v1.SyncReq.service_aliases:type_name -> netguard.v1.SyncServiceAliases
-	44,  // 147: netguard.v1.SyncReq.address_group_binding_policies:type_name -> netguard.v1.SyncAddressGroupBindingPolicies
-	45,  // 148: netguard.v1.SyncReq.ieagag_rules:type_name -> netguard.v1.SyncIEAgAgRules
-	46,  // 149: netguard.v1.SyncReq.networks:type_name -> netguard.v1.SyncNetworks
-	47,  // 150: netguard.v1.SyncReq.network_bindings:type_name -> netguard.v1.SyncNetworkBindings
-	48,  // 151: netguard.v1.SyncReq.hosts:type_name -> netguard.v1.SyncHosts
-	49,  // 152: netguard.v1.SyncReq.host_bindings:type_name -> netguard.v1.SyncHostBindings
-	29,  // 153: netguard.v1.ProtocolPorts.PortsEntry.value:type_name -> netguard.v1.PortRanges
-	98,  // 154: netguard.v1.NetguardService.Sync:input_type -> netguard.v1.SyncReq
-	104, // 155: netguard.v1.NetguardService.SyncStatus:input_type -> google.protobuf.Empty
-	50,  // 156: netguard.v1.NetguardService.ListServices:input_type -> netguard.v1.ListServicesReq
-	52,  // 157: netguard.v1.NetguardService.GetService:input_type -> netguard.v1.GetServiceReq
-	54,  // 158: netguard.v1.NetguardService.ListAddressGroups:input_type -> netguard.v1.ListAddressGroupsReq
-	56,  // 159: netguard.v1.NetguardService.GetAddressGroup:input_type -> netguard.v1.GetAddressGroupReq
-	58,  // 160: netguard.v1.NetguardService.ListAddressGroupBindings:input_type -> netguard.v1.ListAddressGroupBindingsReq
-	66,  // 161: netguard.v1.NetguardService.GetAddressGroupBinding:input_type -> netguard.v1.GetAddressGroupBindingReq
-	60,  // 162: netguard.v1.NetguardService.ListAddressGroupPortMappings:input_type -> netguard.v1.ListAddressGroupPortMappingsReq
-	68,  // 163: netguard.v1.NetguardService.GetAddressGroupPortMapping:input_type -> netguard.v1.GetAddressGroupPortMappingReq
-	62,  // 164: netguard.v1.NetguardService.ListRuleS2S:input_type -> netguard.v1.ListRuleS2SReq
-	70,  // 165: netguard.v1.NetguardService.GetRuleS2S:input_type -> netguard.v1.GetRuleS2SReq
-	64,  // 166: netguard.v1.NetguardService.ListServiceAliases:input_type -> netguard.v1.ListServiceAliasesReq
-	72,  // 167: netguard.v1.NetguardService.GetServiceAlias:input_type -> netguard.v1.GetServiceAliasReq
-	74,  // 168: netguard.v1.NetguardService.ListAddressGroupBindingPolicies:input_type -> netguard.v1.ListAddressGroupBindingPoliciesReq
-	76,  // 169: netguard.v1.NetguardService.GetAddressGroupBindingPolicy:input_type -> netguard.v1.GetAddressGroupBindingPolicyReq
-	78,  // 170: netguard.v1.NetguardService.ListIEAgAgRules:input_type -> netguard.v1.ListIEAgAgRulesReq
-	80,  // 171: netguard.v1.NetguardService.GetIEAgAgRule:input_type -> netguard.v1.GetIEAgAgRuleReq
-	82,  // 172: netguard.v1.NetguardService.ListNetworks:input_type -> netguard.v1.ListNetworksReq
-	84,  // 173: netguard.v1.NetguardService.GetNetwork:input_type -> netguard.v1.GetNetworkReq
-	86,  // 174: netguard.v1.NetguardService.ListNetworkBindings:input_type -> netguard.v1.ListNetworkBindingsReq
-	88,  // 175: netguard.v1.NetguardService.GetNetworkBinding:input_type -> netguard.v1.GetNetworkBindingReq
-	90,  // 176: netguard.v1.NetguardService.ListHosts:input_type -> netguard.v1.ListHostsReq
-	92,  // 177: netguard.v1.NetguardService.GetHost:input_type -> netguard.v1.GetHostReq
-	94,  // 178: netguard.v1.NetguardService.ListHostBindings:input_type -> netguard.v1.ListHostBindingsReq
-	96,  // 179: netguard.v1.NetguardService.GetHostBinding:input_type -> netguard.v1.GetHostBindingReq
-	104, // 180: netguard.v1.NetguardService.Sync:output_type -> google.protobuf.Empty
-	37,  // 181: netguard.v1.NetguardService.SyncStatus:output_type -> netguard.v1.SyncStatusResp
-	51,  // 182: netguard.v1.NetguardService.ListServices:output_type -> netguard.v1.ListServicesResp
-	53,  // 183: netguard.v1.NetguardService.GetService:output_type -> netguard.v1.GetServiceResp
-	55,  // 184: netguard.v1.NetguardService.ListAddressGroups:output_type -> netguard.v1.ListAddressGroupsResp
-	57,  // 185: netguard.v1.NetguardService.GetAddressGroup:output_type -> netguard.v1.GetAddressGroupResp
-	59,  // 186: netguard.v1.NetguardService.ListAddressGroupBindings:output_type -> netguard.v1.ListAddressGroupBindingsResp
-	67,  // 187: netguard.v1.NetguardService.GetAddressGroupBinding:output_type -> netguard.v1.GetAddressGroupBindingResp
-	61,  // 188: netguard.v1.NetguardService.ListAddressGroupPortMappings:output_type -> netguard.v1.ListAddressGroupPortMappingsResp
-	69,  // 189: netguard.v1.NetguardService.GetAddressGroupPortMapping:output_type -> netguard.v1.GetAddressGroupPortMappingResp
-	63,  // 190: netguard.v1.NetguardService.ListRuleS2S:output_type -> netguard.v1.ListRuleS2SResp
-	71,  // 191: netguard.v1.NetguardService.GetRuleS2S:output_type -> netguard.v1.GetRuleS2SResp
-	65,  // 192: netguard.v1.NetguardService.ListServiceAliases:output_type -> netguard.v1.ListServiceAliasesResp
-	73,  // 193: netguard.v1.NetguardService.GetServiceAlias:output_type -> netguard.v1.GetServiceAliasResp
-	75,  // 194: netguard.v1.NetguardService.ListAddressGroupBindingPolicies:output_type -> netguard.v1.ListAddressGroupBindingPoliciesResp
-	77,  // 195: netguard.v1.NetguardService.GetAddressGroupBindingPolicy:output_type -> netguard.v1.GetAddressGroupBindingPolicyResp
-	79,  // 196: netguard.v1.NetguardService.ListIEAgAgRules:output_type -> netguard.v1.ListIEAgAgRulesResp
-	81,  // 197: netguard.v1.NetguardService.GetIEAgAgRule:output_type -> netguard.v1.GetIEAgAgRuleResp
-	83,  // 198: netguard.v1.NetguardService.ListNetworks:output_type -> netguard.v1.ListNetworksResp
-	85,  // 199: netguard.v1.NetguardService.GetNetwork:output_type -> netguard.v1.GetNetworkResp
-	87,  // 200: netguard.v1.NetguardService.ListNetworkBindings:output_type -> netguard.v1.ListNetworkBindingsResp
-	89,  // 201: netguard.v1.NetguardService.GetNetworkBinding:output_type -> netguard.v1.GetNetworkBindingResp
-	91,  // 202: netguard.v1.NetguardService.ListHosts:output_type -> netguard.v1.ListHostsResp
-	93,  // 203: netguard.v1.NetguardService.GetHost:output_type -> netguard.v1.GetHostResp
-	95,  // 204: netguard.v1.NetguardService.ListHostBindings:output_type -> netguard.v1.ListHostBindingsResp
-	97,  // 205: netguard.v1.NetguardService.GetHostBinding:output_type -> netguard.v1.GetHostBindingResp
-	180, // [180:206] is the sub-list for method output_type
-	154, // [154:180] is the sub-list for method input_type
-	154, // [154:154] is the sub-list for extension type_name
-	154, // [154:154] is the sub-list for extension extendee
-	0,   // [0:154] is the sub-list for field type_name
+	174, // 10: netguard.v1.Condition.last_transition_time:type_name -> google.protobuf.Timestamp
+	174, // 11: netguard.v1.ManagedFieldsEntry.time:type_name -> google.protobuf.Timestamp
+	174, // 12: netguard.v1.Meta.creation_ts:type_name -> google.protobuf.Timestamp
+	167, // 13: netguard.v1.Meta.labels:type_name -> netguard.v1.Meta.LabelsEntry
+	168, // 14: netguard.v1.Meta.annotations:type_name -> netguard.v1.Meta.AnnotationsEntry
+	17,  // 15: netguard.v1.Meta.conditions:type_name -> netguard.v1.Condition
+	19,  // 16: netguard.v1.Meta.managed_fields:type_name -> netguard.v1.ManagedFieldsEntry
+	174, // 17: netguard.v1.Meta.deletion_ts:type_name -> google.protobuf.Timestamp
+	18,  // 18: netguard.v1.Meta.owner_references:type_name -> netguard.v1.OwnerReference
+	11,  // 19: netguard.v1.AddressGroupRef.identifier:type_name -> netguard.v1.ResourceIdentifier
+	16,  // 20: netguard.v1.AddressGroupRef.object_ref:type_name -> netguard.v1.NamespacedObjectReference
+	11,  // 21: netguard.v1.ServiceRef.identifier:type_name -> netguard.v1.ResourceIdentifier
+	16,  // 22: netguard.v1.ServiceRef.object_ref:type_name -> netguard.v1.NamespacedObjectReference
+	11,  // 23: netguard.v1.Network.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	20,  // 24: netguard.v1.Network.meta:type_name -> netguard.v1.Meta
+	13,  // 25: netguard.v1.Network.binding_ref:type_name -> netguard.v1.ObjectReference
+	16,  // 26: netguard.v1.Network.address_group_ref:type_name -> netguard.v1.NamespacedObjectReference
+	11,  // 27: netguard.v1.AddressGroup.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	5,   // 28: netguard.v1.AddressGroup.default_action:type_name -> netguard.v1.RuleAction
+	23,  // 29: netguard.v1.AddressGroup.networks:type_name -> netguard.v1.NetworkItem
+	20,  // 30: netguard.v1.AddressGroup.meta:type_name -> netguard.v1.Meta
+	13,  // 31: netguard.v1.AddressGroup.hosts:type_name -> netguard.v1.ObjectReference
+	14,  // 32: netguard.v1.AddressGroup.aggregated_hosts:type_name -> netguard.v1.HostReference
+	21,  // 33: netguard.v1.AddressGroup.included_groups:type_name -> netguard.v1.AddressGroupRef
+	11,  // 34: netguard.v1.AddressGroupBinding.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	22,  // 35: netguard.v1.AddressGroupBinding.service_ref:type_name -> netguard.v1.ServiceRef
+	21,  // 36: netguard.v1.AddressGroupBinding.address_group_ref:type_name -> netguard.v1.AddressGroupRef
+	20,  // 37: netguard.v1.AddressGroupBinding.meta:type_name -> netguard.v1.Meta
+	11,  // 38: netguard.v1.NetworkBinding.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	13,  // 39: netguard.v1.NetworkBinding.network_ref:type_name -> netguard.v1.ObjectReference
+	13,  // 40: netguard.v1.NetworkBinding.address_group_ref:type_name -> netguard.v1.ObjectReference
+	23,  // 41: netguard.v1.NetworkBinding.network_item:type_name -> netguard.v1.NetworkItem
+	20,  // 42: netguard.v1.NetworkBinding.meta:type_name -> netguard.v1.Meta
+	11,  // 43: netguard.v1.Host.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	13,  // 44: netguard.v1.Host.binding_ref:type_name -> netguard.v1.ObjectReference
+	13,  // 45: netguard.v1.Host.address_group_ref:type_name -> netguard.v1.ObjectReference
+	28,  // 46: netguard.v1.Host.ip_list:type_name -> netguard.v1.IPItem
+	20,  // 47: netguard.v1.Host.meta:type_name -> netguard.v1.Meta
+	11,  // 48: netguard.v1.HostBinding.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	16,  // 49: netguard.v1.HostBinding.host_ref:type_name -> netguard.v1.NamespacedObjectReference
+	16,  // 50: netguard.v1.HostBinding.address_group_ref:type_name -> netguard.v1.NamespacedObjectReference
+	20,  // 51: netguard.v1.HostBinding.meta:type_name -> netguard.v1.Meta
+	169, // 52: netguard.v1.ProtocolPorts.ports:type_name -> netguard.v1.ProtocolPorts.PortsEntry
+	32,  // 53: netguard.v1.PortRanges.ranges:type_name -> netguard.v1.PortRange
+	11,  // 54: netguard.v1.ServicePortsRef.identifier:type_name -> netguard.v1.ResourceIdentifier
+	16,  // 55: netguard.v1.ServicePortsRef.object_ref:type_name -> netguard.v1.NamespacedObjectReference
+	31,  // 56: netguard.v1.ServicePortsRef.ports:type_name -> netguard.v1.ProtocolPorts
+	11,  // 57: netguard.v1.AddressGroupPortMapping.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	34,  // 58: netguard.v1.AddressGroupPortMapping.access_ports:type_name -> netguard.v1.ServicePortsRef
+	20,  // 59: netguard.v1.AddressGroupPortMapping.meta:type_name -> netguard.v1.Meta
+	11,  // 60: netguard.v1.ServiceAlias.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	22,  // 61: netguard.v1.ServiceAlias.service_ref:type_name -> netguard.v1.ServiceRef
+	20,  // 62: netguard.v1.ServiceAlias.meta:type_name -> netguard.v1.Meta
+	11,  // 63: netguard.v1.AddressGroupBindingPolicy.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	22,  // 64: netguard.v1.AddressGroupBindingPolicy.service_ref:type_name -> netguard.v1.ServiceRef
+	21,  // 65: netguard.v1.AddressGroupBindingPolicy.address_group_ref:type_name -> netguard.v1.AddressGroupRef
+	20,  // 66: netguard.v1.AddressGroupBindingPolicy.meta:type_name -> netguard.v1.Meta
+	11,  // 67: netguard.v1.RuleS2S.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	0,   // 68: netguard.v1.RuleS2S.traffic:type_name -> netguard.v1.Traffic
+	22,  // 69: netguard.v1.RuleS2S.service_local_ref:type_name -> netguard.v1.ServiceRef
+	22,  // 70: netguard.v1.RuleS2S.service_ref:type_name -> netguard.v1.ServiceRef
+	11,  // 71: netguard.v1.RuleS2S.ieag_ag_rule_refs:type_name -> netguard.v1.ResourceIdentifier
+	16,  // 72: netguard.v1.RuleS2S.ieag_ag_rule_object_refs:type_name -> netguard.v1.NamespacedObjectReference
+	20,  // 73: netguard.v1.RuleS2S.meta:type_name -> netguard.v1.Meta
+	5,   // 74: netguard.v1.RuleS2S.action:type_name -> netguard.v1.RuleAction
+	3,   // 75: netguard.v1.RuleS2S.ports_source:type_name -> netguard.v1.RuleS2SPortsSource
+	10,  // 76: netguard.v1.RuleS2S.extra_ports:type_name -> netguard.v1.IngressPort
+	174, // 77: netguard.v1.RuleS2S.valid_from:type_name -> google.protobuf.Timestamp
+	174, // 78: netguard.v1.RuleS2S.valid_until:type_name -> google.protobuf.Timestamp
+	11,  // 79: netguard.v1.IEAgAgRule.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	7,   // 80: netguard.v1.IEAgAgRule.transport:type_name -> netguard.v1.Networks.NetIP.Transport
+	0,   // 81: netguard.v1.IEAgAgRule.traffic:type_name -> netguard.v1.Traffic
+	21,  // 82: netguard.v1.IEAgAgRule.address_group_local:type_name -> netguard.v1.AddressGroupRef
+	21,  // 83: netguard.v1.IEAgAgRule.address_group:type_name -> netguard.v1.AddressGroupRef
+	44,  // 84: netguard.v1.IEAgAgRule.ports:type_name -> netguard.v1.PortSpec
+	5,   // 85: netguard.v1.IEAgAgRule.action:type_name -> netguard.v1.RuleAction
+	20,  // 86: netguard.v1.IEAgAgRule.meta:type_name -> netguard.v1.Meta
+	11,  // 87: netguard.v1.RuleS2SException.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	0,   // 88: netguard.v1.RuleS2SException.traffic:type_name -> netguard.v1.Traffic
+	21,  // 89: netguard.v1.RuleS2SException.address_group_local:type_name -> netguard.v1.AddressGroupRef
+	21,  // 90: netguard.v1.RuleS2SException.address_group:type_name -> netguard.v1.AddressGroupRef
+	7,   // 91: netguard.v1.RuleS2SException.transport:type_name -> netguard.v1.Networks.NetIP.Transport
+	20,  // 92: netguard.v1.RuleS2SException.meta:type_name -> netguard.v1.Meta
+	11,  // 93: netguard.v1.CrossNamespacePolicy.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	20,  // 94: netguard.v1.CrossNamespacePolicy.meta:type_name -> netguard.v1.Meta
+	11,  // 95: netguard.v1.RuleTemplate.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	0,   // 96: netguard.v1.RuleTemplate.traffic:type_name -> netguard.v1.Traffic
+	170, // 97: netguard.v1.RuleTemplate.local_service_selector:type_name -> netguard.v1.RuleTemplate.LocalServiceSelectorEntry
+	171, // 98: netguard.v1.RuleTemplate.target_service_selector:type_name -> netguard.v1.RuleTemplate.TargetServiceSelectorEntry
+	5,   // 99: netguard.v1.RuleTemplate.action:type_name -> netguard.v1.RuleAction
+	20,  // 100: netguard.v1.RuleTemplate.meta:type_name -> netguard.v1.Meta
+	11,  // 101: netguard.v1.NamespacePosture.self_ref:type_name -> netguard.v1.ResourceIdentifier
+	4,   // 102: netguard.v1.NamespacePosture.mode:type_name -> netguard.v1.NamespacePostureMode
+	20,  // 103: netguard.v1.NamespacePosture.meta:type_name -> netguard.v1.Meta
+	174, // 104: netguard.v1.SyncStatusResp.updated_at:type_name -> google.protobuf.Timestamp
+	174, // 105: netguard.v1.KindSyncStatus.last_sync_time:type_name -> google.protobuf.Timestamp
+	174, // 106: netguard.v1.KindSyncStatus.last_success_time:type_name -> google.protobuf.Timestamp
+	174, // 107: netguard.v1.KindSyncStatus.last_error_time:type_name -> google.protobuf.Timestamp
+	174, // 108: netguard.v1.ResourceSyncStatus.last_sync_time:type_name -> google.protobuf.Timestamp
+	174, // 109: netguard.v1.ResourceSyncStatus.last_success_time:type_name -> google.protobuf.Timestamp
+	47,  // 110: netguard.v1.GetDetailedSyncStatusResp.kinds:type_name -> netguard.v1.KindSyncStatus
+	48,  // 111: netguard.v1.GetDetailedSyncStatusResp.resources:type_name -> netguard.v1.ResourceSyncStatus
+	174, // 112: netguard.v1.ReverseSyncEntityStatus.last_sync_time:type_name -> google.protobuf.Timestamp
+	174, // 113: netguard.v1.GetReverseSyncStatusResp.start_time:type_name -> google.protobuf.Timestamp
+	174, // 114: netguard.v1.GetReverseSyncStatusResp.last_event_time:type_name -> google.protobuf.Timestamp
+	174, // 115: netguard.v1.GetReverseSyncStatusResp.last_successful_sync:type_name -> google.protobuf.Timestamp
+	175, // 116: netguard.v1.GetReverseSyncStatusResp.lag:type_name -> google.protobuf.Duration
+	50,  // 117: netguard.v1.GetReverseSyncStatusResp.entities:type_name -> netguard.v1.ReverseSyncEntityStatus
+	11,  // 118: netguard.v1.FailedSync.identifier:type_name -> netguard.v1.ResourceIdentifier
+	174, // 119: netguard.v1.FailedSync.created_at:type_name -> google.protobuf.Timestamp
+	174, // 120: netguard.v1.FailedSync.failed_at:type_name -> google.protobuf.Timestamp
+	53,  // 121: netguard.v1.ListFailedSyncsResp.items:type_name -> netguard.v1.FailedSync
+	11,  // 122: netguard.v1.QuarantinedResource.identifier:type_name -> netguard.v1.ResourceIdentifier
+	174, // 123: netguard.v1.QuarantinedResource.first_seen_at:type_name -> google.protobuf.Timestamp
+	174, // 124: netguard.v1.QuarantinedResource.last_seen_at:type_name -> google.protobuf.Timestamp
+	57,  // 125: netguard.v1.ListQuarantinedResourcesResp.items:type_name -> netguard.v1.QuarantinedResource
+	174, // 126: netguard.v1.GetStartupReportResp.started_at:type_name -> google.protobuf.Timestamp
+	61,  // 127: netguard.v1.GetStartupReportResp.syncers:type_name -> netguard.v1.StartupSyncer
+	62,  // 128: netguard.v1.GetStartupReportResp.reverse_sync:type_name -> netguard.v1.StartupReverseSync
+	172, // 129: netguard.v1.GetStartupReportResp.features:type_name -> netguard.v1.GetStartupReportResp.FeaturesEntry
+	65,  // 130: netguard.v1.ListSyncersResp.items:type_name -> netguard.v1.Syncer
+	173, // 131: netguard.v1.PurgeNamespaceResp.deleted:type_name -> netguard.v1.PurgeNamespaceResp.DeletedEntry
+	9,   // 132: netguard.v1.SyncServices.services:type_name -> netguard.v1.Service
+	25,  // 133: netguard.v1.SyncAddressGroups.address_groups:type_name -> netguard.v1.AddressGroup
+	26,  // 134: netguard.v1.SyncAddressGroupBindings.address_group_bindings:type_name -> netguard.v1.AddressGroupBinding
+	35,  // 135: netguard.v1.SyncAddressGroupPortMappings.address_group_port_mappings:type_name -> netguard.v1.AddressGroupPortMapping
+	38,  // 136: netguard.v1.SyncRuleS2S.rule_s2s:type_name -> netguard.v1.RuleS2S
+	36,  // 137: netguard.v1.SyncServiceAliases.service_aliases:type_name -> netguard.v1.ServiceAlias
+	37,  // 138: netguard.v1.SyncAddressGroupBindingPolicies.address_group_binding_policies:type_name -> netguard.v1.AddressGroupBindingPolicy
+	39,  // 139: netguard.v1.SyncIEAgAgRules.ieagag_rules:type_name -> netguard.v1.IEAgAgRule
+	24,  // 140: netguard.v1.SyncNetworks.networks:type_name -> netguard.v1.Network
+	27,  // 141: netguard.v1.SyncNetworkBindings.network_bindings:type_name -> netguard.v1.NetworkBinding
+	29,  // 142: netguard.v1.SyncHosts.hosts:type_name -> netguard.v1.Host
+	30,  // 143: netguard.v1.SyncHostBindings.host_bindings:type_name -> netguard.v1.HostBinding
+	40,  // 144: netguard.v1.SyncRuleS2SExceptions.rule_s2s_exceptions:type_name -> netguard.v1.RuleS2SException
+	41,  // 145: netguard.v1.SyncCrossNamespacePolicies.cross_namespace_policies:type_name -> netguard.v1.CrossNamespacePolicy
+	42,  // 146: netguard.v1.SyncRuleTemplates.rule_templates:type_name -> netguard.v1.RuleTemplate
+	43,  // 147: netguard.v1.SyncNamespacePostures.namespace_postures:type_name -> netguard.v1.NamespacePosture
+	11,  // 148: netguard.v1.ListServicesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 149: netguard.v1.ListServicesReq.selector:type_name -> netguard.v1.ListSelector
+	9,   // 150: netguard.v1.ListServicesResp.items:type_name -> netguard.v1.Service
+	11,  // 151: netguard.v1.GetServiceReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	9,   // 152: netguard.v1.GetServiceResp.service:type_name -> netguard.v1.Service
+	11,  // 153: netguard.v1.ListAddressGroupsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 154: netguard.v1.ListAddressGroupsReq.selector:type_name -> netguard.v1.ListSelector
+	25,  // 155: netguard.v1.ListAddressGroupsResp.items:type_name -> netguard.v1.AddressGroup
+	11,  // 156: netguard.v1.GetAddressGroupReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	25,  // 157: netguard.v1.GetAddressGroupResp.address_group:type_name -> netguard.v1.AddressGroup
+	11,  // 158: netguard.v1.ListAddressGroupBindingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 159: netguard.v1.ListAddressGroupBindingsReq.selector:type_name -> netguard.v1.ListSelector
+	26,  // 160: netguard.v1.ListAddressGroupBindingsResp.items:type_name -> netguard.v1.AddressGroupBinding
+	11,  // 161: netguard.v1.ListAddressGroupPortMappingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 162: netguard.v1.ListAddressGroupPortMappingsReq.selector:type_name -> netguard.v1.ListSelector
+	35,  // 163: netguard.v1.ListAddressGroupPortMappingsResp.items:type_name -> netguard.v1.AddressGroupPortMapping
+	11,  // 164: netguard.v1.ListRuleS2SReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 165: netguard.v1.ListRuleS2SReq.selector:type_name -> netguard.v1.ListSelector
+	38,  // 166: netguard.v1.ListRuleS2SResp.items:type_name -> netguard.v1.RuleS2S
+	11,  // 167: netguard.v1.ListServiceAliasesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 168: netguard.v1.ListServiceAliasesReq.selector:type_name -> netguard.v1.ListSelector
+	36,  // 169: netguard.v1.ListServiceAliasesResp.items:type_name -> netguard.v1.ServiceAlias
+	11,  // 170: netguard.v1.GetAddressGroupBindingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	26,  // 171: netguard.v1.GetAddressGroupBindingResp.address_group_binding:type_name -> netguard.v1.AddressGroupBinding
+	11,  // 172: netguard.v1.GetAddressGroupPortMappingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	35,  // 173: netguard.v1.GetAddressGroupPortMappingResp.address_group_port_mapping:type_name -> netguard.v1.AddressGroupPortMapping
+	11,  // 174: netguard.v1.GetRuleS2SReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	38,  // 175: netguard.v1.GetRuleS2SResp.rule_s2s:type_name -> netguard.v1.RuleS2S
+	11,  // 176: netguard.v1.GetServiceAliasReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	36,  // 177: netguard.v1.GetServiceAliasResp.service_alias:type_name -> netguard.v1.ServiceAlias
+	11,  // 178: netguard.v1.ListAddressGroupBindingPoliciesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 179: netguard.v1.ListAddressGroupBindingPoliciesReq.selector:type_name -> netguard.v1.ListSelector
+	37,  // 180: netguard.v1.ListAddressGroupBindingPoliciesResp.items:type_name -> netguard.v1.AddressGroupBindingPolicy
+	11,  // 181: netguard.v1.GetAddressGroupBindingPolicyReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	37,  // 182: netguard.v1.GetAddressGroupBindingPolicyResp.address_group_binding_policy:type_name -> netguard.v1.AddressGroupBindingPolicy
+	11,  // 183: netguard.v1.ListIEAgAgRulesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 184: netguard.v1.ListIEAgAgRulesReq.selector:type_name -> netguard.v1.ListSelector
+	39,  // 185: netguard.v1.ListIEAgAgRulesResp.items:type_name -> netguard.v1.IEAgAgRule
+	11,  // 186: netguard.v1.GetIEAgAgRuleReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	39,  // 187: netguard.v1.GetIEAgAgRuleResp.ieagag_rule:type_name -> netguard.v1.IEAgAgRule
+	11,  // 188: netguard.v1.IEAgAgRuleContribution.rule_s2s:type_name -> netguard.v1.ResourceIdentifier
+	11,  // 189: netguard.v1.IEAgAgRuleContribution.ieagag_rule:type_name -> netguard.v1.ResourceIdentifier
+	11,  // 190: netguard.v1.ListIEAgAgRuleContributionsReq.rule_s2s:type_name -> netguard.v1.ResourceIdentifier
+	11,  // 191: netguard.v1.ListIEAgAgRuleContributionsReq.ieagag_rules:type_name -> netguard.v1.ResourceIdentifier
+	118, // 192: netguard.v1.ListIEAgAgRuleContributionsResp.items:type_name -> netguard.v1.IEAgAgRuleContribution
+	11,  // 193: netguard.v1.ConditionTransition.identifier:type_name -> netguard.v1.ResourceIdentifier
+	174, // 194: netguard.v1.ConditionTransition.time:type_name -> google.protobuf.Timestamp
+	11,  // 195: netguard.v1.ListConditionTransitionsReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	121, // 196: netguard.v1.ListConditionTransitionsResp.items:type_name -> netguard.v1.ConditionTransition
+	11,  // 197: netguard.v1.ListNetworksReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 198: netguard.v1.ListNetworksReq.selector:type_name -> netguard.v1.ListSelector
+	24,  // 199: netguard.v1.ListNetworksResp.items:type_name -> netguard.v1.Network
+	11,  // 200: netguard.v1.GetNetworkReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	24,  // 201: netguard.v1.GetNetworkResp.network:type_name -> netguard.v1.Network
+	11,  // 202: netguard.v1.ListNetworkBindingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 203: netguard.v1.ListNetworkBindingsReq.selector:type_name -> netguard.v1.ListSelector
+	27,  // 204: netguard.v1.ListNetworkBindingsResp.items:type_name -> netguard.v1.NetworkBinding
+	11,  // 205: netguard.v1.GetNetworkBindingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	27,  // 206: netguard.v1.GetNetworkBindingResp.network_binding:type_name -> netguard.v1.NetworkBinding
+	11,  // 207: netguard.v1.ListHostsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 208: netguard.v1.ListHostsReq.selector:type_name -> netguard.v1.ListSelector
+	29,  // 209: netguard.v1.ListHostsResp.items:type_name -> netguard.v1.Host
+	11,  // 210: netguard.v1.GetHostReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	29,  // 211: netguard.v1.GetHostResp.host:type_name -> netguard.v1.Host
+	11,  // 212: netguard.v1.ListHostBindingsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	12,  // 213: netguard.v1.ListHostBindingsReq.selector:type_name -> netguard.v1.ListSelector
+	30,  // 214: netguard.v1.ListHostBindingsResp.items:type_name -> netguard.v1.HostBinding
+	11,  // 215: netguard.v1.GetHostBindingReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	30,  // 216: netguard.v1.GetHostBindingResp.host_binding:type_name -> netguard.v1.HostBinding
+	11,  // 217: netguard.v1.ListRuleS2SExceptionsReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	40,  // 218: netguard.v1.ListRuleS2SExceptionsResp.items:type_name -> netguard.v1.RuleS2SException
+	11,  // 219: netguard.v1.GetRuleS2SExceptionReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	40,  // 220: netguard.v1.GetRuleS2SExceptionResp.rule_s2s_exception:type_name -> netguard.v1.RuleS2SException
+	11,  // 221: netguard.v1.ListCrossNamespacePoliciesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	41,  // 222: netguard.v1.ListCrossNamespacePoliciesResp.items:type_name -> netguard.v1.CrossNamespacePolicy
+	11,  // 223: netguard.v1.GetCrossNamespacePolicyReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	41,  // 224: netguard.v1.GetCrossNamespacePolicyResp.cross_namespace_policy:type_name -> netguard.v1.CrossNamespacePolicy
+	11,  // 225: netguard.v1.ListRuleTemplatesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	42,  // 226: netguard.v1.ListRuleTemplatesResp.items:type_name -> netguard.v1.RuleTemplate
+	11,  // 227: netguard.v1.GetRuleTemplateReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	42,  // 228: netguard.v1.GetRuleTemplateResp.rule_template:type_name -> netguard.v1.RuleTemplate
+	11,  // 229: netguard.v1.ListNamespacePosturesReq.identifiers:type_name -> netguard.v1.ResourceIdentifier
+	43,  // 230: netguard.v1.ListNamespacePosturesResp.items:type_name -> netguard.v1.NamespacePosture
+	11,  // 231: netguard.v1.GetNamespacePostureReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	43,  // 232: netguard.v1.GetNamespacePostureResp.namespace_posture:type_name -> netguard.v1.NamespacePosture
+	21,  // 233: netguard.v1.TrafficEndpoint.address_group:type_name -> netguard.v1.AddressGroupRef
+	11,  // 234: netguard.v1.TrafficEndpoint.host:type_name -> netguard.v1.ResourceIdentifier
+	156, // 235: netguard.v1.SimulateTrafficReq.source:type_name -> netguard.v1.TrafficEndpoint
+	156, // 236: netguard.v1.SimulateTrafficReq.destination:type_name -> netguard.v1.TrafficEndpoint
+	7,   // 237: netguard.v1.SimulateTrafficReq.transport:type_name -> netguard.v1.Networks.NetIP.Transport
+	11,  // 238: netguard.v1.TrafficRuleMatch.rule:type_name -> netguard.v1.ResourceIdentifier
+	5,   // 239: netguard.v1.TrafficRuleMatch.action:type_name -> netguard.v1.RuleAction
+	0,   // 240: netguard.v1.TrafficSimulationSide.traffic:type_name -> netguard.v1.Traffic
+	21,  // 241: netguard.v1.TrafficSimulationSide.address_group_local:type_name -> netguard.v1.AddressGroupRef
+	21,  // 242: netguard.v1.TrafficSimulationSide.address_group:type_name -> netguard.v1.AddressGroupRef
+	158, // 243: netguard.v1.TrafficSimulationSide.matches:type_name -> netguard.v1.TrafficRuleMatch
+	5,   // 244: netguard.v1.TrafficSimulationSide.verdict:type_name -> netguard.v1.RuleAction
+	5,   // 245: netguard.v1.SimulateTrafficResp.verdict:type_name -> netguard.v1.RuleAction
+	159, // 246: netguard.v1.SimulateTrafficResp.sides:type_name -> netguard.v1.TrafficSimulationSide
+	6,   // 247: netguard.v1.SyncReq.sync_op:type_name -> netguard.v1.SyncOp
+	70,  // 248: netguard.v1.SyncReq.services:type_name -> netguard.v1.SyncServices
+	71,  // 249: netguard.v1.SyncReq.address_groups:type_name -> netguard.v1.SyncAddressGroups
+	72,  // 250: netguard.v1.SyncReq.address_group_bindings:type_name -> netguard.v1.SyncAddressGroupBindings
+	73,  // 251: netguard.v1.SyncReq.address_group_port_mappings:type_name -> netguard.v1.SyncAddressGroupPortMappings
+	74,  // 252: netguard.v1.SyncReq.rule_s2s:type_name -> netguard.v1.SyncRuleS2S
+	75,  // 253: netguard.v1.SyncReq.service_aliases:type_name -> netguard.v1.SyncServiceAliases
+	76,  // 254: netguard.v1.SyncReq.address_group_binding_policies:type_name -> netguard.v1.SyncAddressGroupBindingPolicies
+	77,  // 255: netguard.v1.SyncReq.ieagag_rules:type_name -> netguard.v1.SyncIEAgAgRules
+	78,  // 256: netguard.v1.SyncReq.networks:type_name -> netguard.v1.SyncNetworks
+	79,  // 257: netguard.v1.SyncReq.network_bindings:type_name -> netguard.v1.SyncNetworkBindings
+	80,  // 258: netguard.v1.SyncReq.hosts:type_name -> netguard.v1.SyncHosts
+	81,  // 259: netguard.v1.SyncReq.host_bindings:type_name -> netguard.v1.SyncHostBindings
+	82,  // 260: netguard.v1.SyncReq.rule_s2s_exceptions:type_name -> netguard.v1.SyncRuleS2SExceptions
+	83,  // 261: netguard.v1.SyncReq.cross_namespace_policies:type_name -> netguard.v1.SyncCrossNamespacePolicies
+	84,  // 262: netguard.v1.SyncReq.rule_templates:type_name -> netguard.v1.SyncRuleTemplates
+	85,  // 263: netguard.v1.SyncReq.namespace_postures:type_name -> netguard.v1.SyncNamespacePostures
+	6,   // 264: netguard.v1.WatchEvent.sync_op:type_name -> netguard.v1.SyncOp
+	9,   // 265: netguard.v1.WatchEvent.service:type_name -> netguard.v1.Service
+	25,  // 266: netguard.v1.WatchEvent.address_group:type_name -> netguard.v1.AddressGroup
+	38,  // 267: netguard.v1.WatchEvent.rule_s2s:type_name -> netguard.v1.RuleS2S
+	11,  // 268: netguard.v1.AnalyzeAddressGroupImpactReq.identifier:type_name -> netguard.v1.ResourceIdentifier
+	25,  // 269: netguard.v1.AnalyzeAddressGroupImpactReq.proposed:type_name -> netguard.v1.AddressGroup
+	11,  // 270: netguard.v1.AnalyzeAddressGroupImpactResp.ieagag_rules:type_name -> netguard.v1.ResourceIdentifier
+	11,  // 271: netguard.v1.AnalyzeAddressGroupImpactResp.services:type_name -> netguard.v1.ResourceIdentifier
+	33,  // 272: netguard.v1.ProtocolPorts.PortsEntry.value:type_name -> netguard.v1.PortRanges
+	161, // 273: netguard.v1.NetguardService.Sync:input_type -> netguard.v1.SyncReq
+	176, // 274: netguard.v1.NetguardService.SyncStatus:input_type -> google.protobuf.Empty
+	46,  // 275: netguard.v1.NetguardService.GetDetailedSyncStatus:input_type -> netguard.v1.GetDetailedSyncStatusReq
+	176, // 276: netguard.v1.NetguardService.GetReverseSyncStatus:input_type -> google.protobuf.Empty
+	176, // 277: netguard.v1.NetguardService.PauseReverseSync:input_type -> google.protobuf.Empty
+	176, // 278: netguard.v1.NetguardService.ResumeReverseSync:input_type -> google.protobuf.Empty
+	176, // 279: netguard.v1.NetguardService.TriggerReverseSync:input_type -> google.protobuf.Empty
+	52,  // 280: netguard.v1.NetguardService.ListFailedSyncs:input_type -> netguard.v1.ListFailedSyncsReq
+	55,  // 281: netguard.v1.NetguardService.RetryFailedSync:input_type -> netguard.v1.RetryFailedSyncReq
+	56,  // 282: netguard.v1.NetguardService.ListQuarantinedResources:input_type -> netguard.v1.ListQuarantinedResourcesReq
+	59,  // 283: netguard.v1.NetguardService.PromoteQuarantinedResource:input_type -> netguard.v1.PromoteQuarantinedResourceReq
+	60,  // 284: netguard.v1.NetguardService.DeleteQuarantinedResource:input_type -> netguard.v1.DeleteQuarantinedResourceReq
+	176, // 285: netguard.v1.NetguardService.GetStartupReport:input_type -> google.protobuf.Empty
+	176, // 286: netguard.v1.NetguardService.GetSchemaStatus:input_type -> google.protobuf.Empty
+	176, // 287: netguard.v1.NetguardService.ListSyncers:input_type -> google.protobuf.Empty
+	67,  // 288: netguard.v1.NetguardService.SetSyncerEnabled:input_type -> netguard.v1.SetSyncerEnabledReq
+	68,  // 289: netguard.v1.NetguardService.PurgeNamespace:input_type -> netguard.v1.PurgeNamespaceReq
+	86,  // 290: netguard.v1.NetguardService.ListServices:input_type -> netguard.v1.ListServicesReq
+	88,  // 291: netguard.v1.NetguardService.GetService:input_type -> netguard.v1.GetServiceReq
+	90,  // 292: netguard.v1.NetguardService.ListAddressGroups:input_type -> netguard.v1.ListAddressGroupsReq
+	92,  // 293: netguard.v1.NetguardService.GetAddressGroup:input_type -> netguard.v1.GetAddressGroupReq
+	164, // 294: netguard.v1.NetguardService.AnalyzeAddressGroupImpact:input_type -> netguard.v1.AnalyzeAddressGroupImpactReq
+	94,  // 295: netguard.v1.NetguardService.ListAddressGroupBindings:input_type -> netguard.v1.ListAddressGroupBindingsReq
+	102, // 296: netguard.v1.NetguardService.GetAddressGroupBinding:input_type -> netguard.v1.GetAddressGroupBindingReq
+	96,  // 297: netguard.v1.NetguardService.ListAddressGroupPortMappings:input_type -> netguard.v1.ListAddressGroupPortMappingsReq
+	104, // 298: netguard.v1.NetguardService.GetAddressGroupPortMapping:input_type -> netguard.v1.GetAddressGroupPortMappingReq
+	98,  // 299: netguard.v1.NetguardService.ListRuleS2S:input_type -> netguard.v1.ListRuleS2SReq
+	106, // 300: netguard.v1.NetguardService.GetRuleS2S:input_type -> netguard.v1.GetRuleS2SReq
+	100, // 301: netguard.v1.NetguardService.ListServiceAliases:input_type -> netguard.v1.ListServiceAliasesReq
+	108, // 302: netguard.v1.NetguardService.GetServiceAlias:input_type -> netguard.v1.GetServiceAliasReq
+	110, // 303: netguard.v1.NetguardService.ListAddressGroupBindingPolicies:input_type -> netguard.v1.ListAddressGroupBindingPoliciesReq
+	112, // 304: netguard.v1.NetguardService.GetAddressGroupBindingPolicy:input_type -> netguard.v1.GetAddressGroupBindingPolicyReq
+	114, // 305: netguard.v1.NetguardService.ListIEAgAgRules:input_type -> netguard.v1.ListIEAgAgRulesReq
+	116, // 306: netguard.v1.NetguardService.GetIEAgAgRule:input_type -> netguard.v1.GetIEAgAgRuleReq
+	119, // 307: netguard.v1.NetguardService.ListIEAgAgRuleContributions:input_type -> netguard.v1.ListIEAgAgRuleContributionsReq
+	122, // 308: netguard.v1.NetguardService.ListConditionTransitions:input_type -> netguard.v1.ListConditionTransitionsReq
+	124, // 309: netguard.v1.NetguardService.ListNetworks:input_type -> netguard.v1.ListNetworksReq
+	126, // 310: netguard.v1.NetguardService.GetNetwork:input_type -> netguard.v1.GetNetworkReq
+	128, // 311: netguard.v1.NetguardService.ListNetworkBindings:input_type -> netguard.v1.ListNetworkBindingsReq
+	130, // 312: netguard.v1.NetguardService.GetNetworkBinding:input_type -> netguard.v1.GetNetworkBindingReq
+	132, // 313: netguard.v1.NetguardService.ListHosts:input_type -> netguard.v1.ListHostsReq
+	134, // 314: netguard.v1.NetguardService.GetHost:input_type -> netguard.v1.GetHostReq
+	136, // 315: netguard.v1.NetguardService.ListHostBindings:input_type -> netguard.v1.ListHostBindingsReq
+	138, // 316: netguard.v1.NetguardService.GetHostBinding:input_type -> netguard.v1.GetHostBindingReq
+	140, // 317: netguard.v1.NetguardService.ListRuleS2SExceptions:input_type -> netguard.v1.ListRuleS2SExceptionsReq
+	142, // 318: netguard.v1.NetguardService.GetRuleS2SException:input_type -> netguard.v1.GetRuleS2SExceptionReq
+	144, // 319: netguard.v1.NetguardService.ListCrossNamespacePolicies:input_type -> netguard.v1.ListCrossNamespacePoliciesReq
+	146, // 320: netguard.v1.NetguardService.GetCrossNamespacePolicy:input_type -> netguard.v1.GetCrossNamespacePolicyReq
+	148, // 321: netguard.v1.NetguardService.ListRuleTemplates:input_type -> netguard.v1.ListRuleTemplatesReq
+	150, // 322: netguard.v1.NetguardService.GetRuleTemplate:input_type -> netguard.v1.GetRuleTemplateReq
+	152, // 323: netguard.v1.NetguardService.ListNamespacePostures:input_type -> netguard.v1.ListNamespacePosturesReq
+	154, // 324: netguard.v1.NetguardService.GetNamespacePosture:input_type -> netguard.v1.GetNamespacePostureReq
+	157, // 325: netguard.v1.NetguardService.SimulateTraffic:input_type -> netguard.v1.SimulateTrafficReq
+	162, // 326: netguard.v1.NetguardService.Watch:input_type -> netguard.v1.WatchReq
+	176, // 327: netguard.v1.NetguardService.Sync:output_type -> google.protobuf.Empty
+	45,  // 328: netguard.v1.NetguardService.SyncStatus:output_type -> netguard.v1.SyncStatusResp
+	49,  // 329: netguard.v1.NetguardService.GetDetailedSyncStatus:output_type -> netguard.v1.GetDetailedSyncStatusResp
+	51,  // 330: netguard.v1.NetguardService.GetReverseSyncStatus:output_type -> netguard.v1.GetReverseSyncStatusResp
+	176, // 331: netguard.v1.NetguardService.PauseReverseSync:output_type -> google.protobuf.Empty
+	176, // 332: netguard.v1.NetguardService.ResumeReverseSync:output_type -> google.protobuf.Empty
+	176, // 333: netguard.v1.NetguardService.TriggerReverseSync:output_type -> google.protobuf.Empty
+	54,  // 334: netguard.v1.NetguardService.ListFailedSyncs:output_type -> netguard.v1.ListFailedSyncsResp
+	176, // 335: netguard.v1.NetguardService.RetryFailedSync:output_type -> google.protobuf.Empty
+	58,  // 336: netguard.v1.NetguardService.ListQuarantinedResources:output_type -> netguard.v1.ListQuarantinedResourcesResp
+	176, // 337: netguard.v1.NetguardService.PromoteQuarantinedResource:output_type -> google.protobuf.Empty
+	176, // 338: netguard.v1.NetguardService.DeleteQuarantinedResource:output_type -> google.protobuf.Empty
+	63,  // 339: netguard.v1.NetguardService.GetStartupReport:output_type -> netguard.v1.GetStartupReportResp
+	64,  // 340: netguard.v1.NetguardService.GetSchemaStatus:output_type -> netguard.v1.GetSchemaStatusResp
+	66,  // 341: netguard.v1.NetguardService.ListSyncers:output_type -> netguard.v1.ListSyncersResp
+	176, // 342: netguard.v1.NetguardService.SetSyncerEnabled:output_type -> google.protobuf.Empty
+	69,  // 343: netguard.v1.NetguardService.PurgeNamespace:output_type -> netguard.v1.PurgeNamespaceResp
+	87,  // 344: netguard.v1.NetguardService.ListServices:output_type -> netguard.v1.ListServicesResp
+	89,  // 345: netguard.v1.NetguardService.GetService:output_type -> netguard.v1.GetServiceResp
+	91,  // 346: netguard.v1.NetguardService.ListAddressGroups:output_type -> netguard.v1.ListAddressGroupsResp
+	93,  // 347: netguard.v1.NetguardService.GetAddressGroup:output_type -> netguard.v1.GetAddressGroupResp
+	165, // 348: netguard.v1.NetguardService.AnalyzeAddressGroupImpact:output_type -> netguard.v1.AnalyzeAddressGroupImpactResp
+	95,  // 349: netguard.v1.NetguardService.ListAddressGroupBindings:output_type -> netguard.v1.ListAddressGroupBindingsResp
+	103, // 350: netguard.v1.NetguardService.GetAddressGroupBinding:output_type -> netguard.v1.GetAddressGroupBindingResp
+	97,  // 351: netguard.v1.NetguardService.ListAddressGroupPortMappings:output_type -> netguard.v1.ListAddressGroupPortMappingsResp
+	105, // 352: netguard.v1.NetguardService.GetAddressGroupPortMapping:output_type -> netguard.v1.GetAddressGroupPortMappingResp
+	99,  // 353: netguard.v1.NetguardService.ListRuleS2S:output_type -> netguard.v1.ListRuleS2SResp
+	107, // 354: netguard.v1.NetguardService.GetRuleS2S:output_type -> netguard.v1.GetRuleS2SResp
+	101, // 355: netguard.v1.NetguardService.ListServiceAliases:output_type -> netguard.v1.ListServiceAliasesResp
+	109, // 356: netguard.v1.NetguardService.GetServiceAlias:output_type -> netguard.v1.GetServiceAliasResp
+	111, // 357: netguard.v1.NetguardService.ListAddressGroupBindingPolicies:output_type -> netguard.v1.ListAddressGroupBindingPoliciesResp
+	113, // 358: netguard.v1.NetguardService.GetAddressGroupBindingPolicy:output_type -> netguard.v1.GetAddressGroupBindingPolicyResp
+	115, // 359: netguard.v1.NetguardService.ListIEAgAgRules:output_type -> netguard.v1.ListIEAgAgRulesResp
+	117, // 360: netguard.v1.NetguardService.GetIEAgAgRule:output_type -> netguard.v1.GetIEAgAgRuleResp
+	120, // 361: netguard.v1.NetguardService.ListIEAgAgRuleContributions:output_type -> netguard.v1.ListIEAgAgRuleContributionsResp
+	123, // 362: netguard.v1.NetguardService.ListConditionTransitions:output_type -> netguard.v1.ListConditionTransitionsResp
+	125, // 363: netguard.v1.NetguardService.ListNetworks:output_type -> netguard.v1.ListNetworksResp
+	127, // 364: netguard.v1.NetguardService.GetNetwork:output_type -> netguard.v1.GetNetworkResp
+	129, // 365: netguard.v1.NetguardService.ListNetworkBindings:output_type -> netguard.v1.ListNetworkBindingsResp
+	131, // 366: netguard.v1.NetguardService.GetNetworkBinding:output_type -> netguard.v1.GetNetworkBindingResp
+	133, // 367: netguard.v1.NetguardService.ListHosts:output_type -> netguard.v1.ListHostsResp
+	135, // 368: netguard.v1.NetguardService.GetHost:output_type -> netguard.v1.GetHostResp
+	137, // 369: netguard.v1.NetguardService.ListHostBindings:output_type -> netguard.v1.ListHostBindingsResp
+	139, // 370: netguard.v1.NetguardService.GetHostBinding:output_type -> netguard.v1.GetHostBindingResp
+	141, // 371: netguard.v1.NetguardService.ListRuleS2SExceptions:output_type -> netguard.v1.ListRuleS2SExceptionsResp
+	143, // 372: netguard.v1.NetguardService.GetRuleS2SException:output_type -> netguard.v1.GetRuleS2SExceptionResp
+	145, // 373: netguard.v1.NetguardService.ListCrossNamespacePolicies:output_type -> netguard.v1.ListCrossNamespacePoliciesResp
+	147, // 374: netguard.v1.NetguardService.GetCrossNamespacePolicy:output_type -> netguard.v1.GetCrossNamespacePolicyResp
+	149, // 375: netguard.v1.NetguardService.ListRuleTemplates:output_type -> netguard.v1.ListRuleTemplatesResp
+	151, // 376: netguard.v1.NetguardService.GetRuleTemplate:output_type -> netguard.v1.GetRuleTemplateResp
+	153, // 377: netguard.v1.NetguardService.ListNamespacePostures:output_type -> netguard.v1.ListNamespacePosturesResp
+	155, // 378: netguard.v1.NetguardService.GetNamespacePosture:output_type -> netguard.v1.GetNamespacePostureResp
+	160, // 379: netguard.v1.NetguardService.SimulateTraffic:output_type -> netguard.v1.SimulateTrafficResp
+	163, // 380: netguard.v1.NetguardService.Watch:output_type -> netguard.v1.WatchEvent
+	327, // [327:381] is the sub-list for method output_type
+	273, // [273:327] is the sub-list for method input_type
+	273, // [273:273] is the sub-list for extension type_name
+	273, // [273:273] is the sub-list for extension extendee
+	0,   // [0:273] is the sub-list for field type_name
 }
 
 func init() { file_netguard_api_proto_init() }
@@ -7150,7 +13103,11 @@ func file_netguard_api_proto_init() {
 	if File_netguard_api_proto != nil {
 		return
 	}
-	file_netguard_api_proto_msgTypes[92].OneofWrappers = []any{
+	file_netguard_api_proto_msgTypes[148].OneofWrappers = []any{
+		(*TrafficEndpoint_AddressGroup)(nil),
+		(*TrafficEndpoint_Host)(nil),
+	}
+	file_netguard_api_proto_msgTypes[153].OneofWrappers = []any{
 		(*SyncReq_Services)(nil),
 		(*SyncReq_AddressGroups)(nil),
 		(*SyncReq_AddressGroupBindings)(nil),
@@ -7163,14 +13120,23 @@ func file_netguard_api_proto_init() {
 		(*SyncReq_NetworkBindings)(nil),
 		(*SyncReq_Hosts)(nil),
 		(*SyncReq_HostBindings)(nil),
+		(*SyncReq_RuleS2SExceptions)(nil),
+		(*SyncReq_CrossNamespacePolicies)(nil),
+		(*SyncReq_RuleTemplates)(nil),
+		(*SyncReq_NamespacePostures)(nil),
+	}
+	file_netguard_api_proto_msgTypes[155].OneofWrappers = []any{
+		(*WatchEvent_Service)(nil),
+		(*WatchEvent_AddressGroup)(nil),
+		(*WatchEvent_RuleS2S)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_netguard_api_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   97,
+			NumEnums:      8,
+			NumMessages:   166,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,10 +22,26 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	NetguardService_Sync_FullMethodName                            = "/netguard.v1.NetguardService/Sync"
 	NetguardService_SyncStatus_FullMethodName                      = "/netguard.v1.NetguardService/SyncStatus"
+	NetguardService_GetDetailedSyncStatus_FullMethodName           = "/netguard.v1.NetguardService/GetDetailedSyncStatus"
+	NetguardService_GetReverseSyncStatus_FullMethodName            = "/netguard.v1.NetguardService/GetReverseSyncStatus"
+	NetguardService_PauseReverseSync_FullMethodName                = "/netguard.v1.NetguardService/PauseReverseSync"
+	NetguardService_ResumeReverseSync_FullMethodName               = "/netguard.v1.NetguardService/ResumeReverseSync"
+	NetguardService_TriggerReverseSync_FullMethodName              = "/netguard.v1.NetguardService/TriggerReverseSync"
+	NetguardService_ListFailedSyncs_FullMethodName                 = "/netguard.v1.NetguardService/ListFailedSyncs"
+	NetguardService_RetryFailedSync_FullMethodName                 = "/netguard.v1.NetguardService/RetryFailedSync"
+	NetguardService_ListQuarantinedResources_FullMethodName        = "/netguard.v1.NetguardService/ListQuarantinedResources"
+	NetguardService_PromoteQuarantinedResource_FullMethodName      = "/netguard.v1.NetguardService/PromoteQuarantinedResource"
+	NetguardService_DeleteQuarantinedResource_FullMethodName       = "/netguard.v1.NetguardService/DeleteQuarantinedResource"
+	NetguardService_GetStartupReport_FullMethodName                = "/netguard.v1.NetguardService/GetStartupReport"
+	NetguardService_GetSchemaStatus_FullMethodName                 = "/netguard.v1.NetguardService/GetSchemaStatus"
+	NetguardService_ListSyncers_FullMethodName                     = "/netguard.v1.NetguardService/ListSyncers"
+	NetguardService_SetSyncerEnabled_FullMethodName                = "/netguard.v1.NetguardService/SetSyncerEnabled"
+	NetguardService_PurgeNamespace_FullMethodName                  = "/netguard.v1.NetguardService/PurgeNamespace"
 	NetguardService_ListServices_FullMethodName                    = "/netguard.v1.NetguardService/ListServices"
 	NetguardService_GetService_FullMethodName                      = "/netguard.v1.NetguardService/GetService"
 	NetguardService_ListAddressGroups_FullMethodName               = "/netguard.v1.NetguardService/ListAddressGroups"
 	NetguardService_GetAddressGroup_FullMethodName                 = "/netguard.v1.NetguardService/GetAddressGroup"
+	NetguardService_AnalyzeAddressGroupImpact_FullMethodName       = "/netguard.v1.NetguardService/AnalyzeAddressGroupImpact"
 	NetguardService_ListAddressGroupBindings_FullMethodName        = "/netguard.v1.NetguardService/ListAddressGroupBindings"
 	NetguardService_GetAddressGroupBinding_FullMethodName          = "/netguard.v1.NetguardService/GetAddressGroupBinding"
 	NetguardService_ListAddressGroupPortMappings_FullMethodName    = "/netguard.v1.NetguardService/ListAddressGroupPortMappings"
@@ -38,6 +54,8 @@ const (
 	NetguardService_GetAddressGroupBindingPolicy_FullMethodName    = "/netguard.v1.NetguardService/GetAddressGroupBindingPolicy"
 	NetguardService_ListIEAgAgRules_FullMethodName                 = "/netguard.v1.NetguardService/ListIEAgAgRules"
 	NetguardService_GetIEAgAgRule_FullMethodName                   = "/netguard.v1.NetguardService/GetIEAgAgRule"
+	NetguardService_ListIEAgAgRuleContributions_FullMethodName     = "/netguard.v1.NetguardService/ListIEAgAgRuleContributions"
+	NetguardService_ListConditionTransitions_FullMethodName        = "/netguard.v1.NetguardService/ListConditionTransitions"
 	NetguardService_ListNetworks_FullMethodName                    = "/netguard.v1.NetguardService/ListNetworks"
 	NetguardService_GetNetwork_FullMethodName                      = "/netguard.v1.NetguardService/GetNetwork"
 	NetguardService_ListNetworkBindings_FullMethodName             = "/netguard.v1.NetguardService/ListNetworkBindings"
@@ -46,6 +64,16 @@ const (
 	NetguardService_GetHost_FullMethodName                         = "/netguard.v1.NetguardService/GetHost"
 	NetguardService_ListHostBindings_FullMethodName                = "/netguard.v1.NetguardService/ListHostBindings"
 	NetguardService_GetHostBinding_FullMethodName                  = "/netguard.v1.NetguardService/GetHostBinding"
+	NetguardService_ListRuleS2SExceptions_FullMethodName           = "/netguard.v1.NetguardService/ListRuleS2SExceptions"
+	NetguardService_GetRuleS2SException_FullMethodName             = "/netguard.v1.NetguardService/GetRuleS2SException"
+	NetguardService_ListCrossNamespacePolicies_FullMethodName      = "/netguard.v1.NetguardService/ListCrossNamespacePolicies"
+	NetguardService_GetCrossNamespacePolicy_FullMethodName         = "/netguard.v1.NetguardService/GetCrossNamespacePolicy"
+	NetguardService_ListRuleTemplates_FullMethodName               = "/netguard.v1.NetguardService/ListRuleTemplates"
+	NetguardService_GetRuleTemplate_FullMethodName                 = "/netguard.v1.NetguardService/GetRuleTemplate"
+	NetguardService_ListNamespacePostures_FullMethodName           = "/netguard.v1.NetguardService/ListNamespacePostures"
+	NetguardService_GetNamespacePosture_FullMethodName             = "/netguard.v1.NetguardService/GetNamespacePosture"
+	NetguardService_SimulateTraffic_FullMethodName                 = "/netguard.v1.NetguardService/SimulateTraffic"
+	NetguardService_Watch_FullMethodName                           = "/netguard.v1.NetguardService/Watch"
 )
 
 // NetguardServiceClient is the client API for NetguardService service.
@@ -58,6 +86,36 @@ type NetguardServiceClient interface {
 	Sync(ctx context.Context, in *SyncReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// SyncStatus - gets last succeeded update DB status
 	SyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SyncStatusResp, error)
+	// GetDetailedSyncStatus - gets sync status per resource kind and per resource
+	GetDetailedSyncStatus(ctx context.Context, in *GetDetailedSyncStatusReq, opts ...grpc.CallOption) (*GetDetailedSyncStatusResp, error)
+	// GetReverseSyncStatus - gets statistics of the reverse synchronization from sgroups
+	GetReverseSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetReverseSyncStatusResp, error)
+	// PauseReverseSync - pauses synchronization of changes from sgroups
+	PauseReverseSync(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResumeReverseSync - resumes synchronization of changes from sgroups
+	ResumeReverseSync(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TriggerReverseSync - runs a reverse sync cycle immediately
+	TriggerReverseSync(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListFailedSyncs - gets sgroups syncs moved to the dead-letter queue
+	ListFailedSyncs(ctx context.Context, in *ListFailedSyncsReq, opts ...grpc.CallOption) (*ListFailedSyncsResp, error)
+	// RetryFailedSync - syncs the resource of a dead-lettered entry again
+	RetryFailedSync(ctx context.Context, in *RetryFailedSyncReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListQuarantinedResources - imported resources which failed validation
+	ListQuarantinedResources(ctx context.Context, in *ListQuarantinedResourcesReq, opts ...grpc.CallOption) (*ListQuarantinedResourcesResp, error)
+	// PromoteQuarantinedResource - import a quarantined resource again
+	PromoteQuarantinedResource(ctx context.Context, in *PromoteQuarantinedResourceReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteQuarantinedResource - drop a quarantined resource
+	DeleteQuarantinedResource(ctx context.Context, in *DeleteQuarantinedResourceReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetStartupReport - gets the report logged on startup
+	GetStartupReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStartupReportResp, error)
+	// GetSchemaStatus - gets the applied and the supported schema version
+	GetSchemaStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSchemaStatusResp, error)
+	// ListSyncers - gets sgroups syncers and whether they are enabled
+	ListSyncers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSyncersResp, error)
+	// SetSyncerEnabled - enables or disables the syncers of a subject type
+	SetSyncerEnabled(ctx context.Context, in *SetSyncerEnabledReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PurgeNamespace - deletes all resources of a namespace in one transaction
+	PurgeNamespace(ctx context.Context, in *PurgeNamespaceReq, opts ...grpc.CallOption) (*PurgeNamespaceResp, error)
 	// ListServices - gets list of services
 	ListServices(ctx context.Context, in *ListServicesReq, opts ...grpc.CallOption) (*ListServicesResp, error)
 	// GetService - gets a specific service by ID
@@ -66,6 +124,8 @@ type NetguardServiceClient interface {
 	ListAddressGroups(ctx context.Context, in *ListAddressGroupsReq, opts ...grpc.CallOption) (*ListAddressGroupsResp, error)
 	// GetAddressGroup - gets a specific address group by ID
 	GetAddressGroup(ctx context.Context, in *GetAddressGroupReq, opts ...grpc.CallOption) (*GetAddressGroupResp, error)
+	// AnalyzeAddressGroupImpact - analyzes impact of an address group change
+	AnalyzeAddressGroupImpact(ctx context.Context, in *AnalyzeAddressGroupImpactReq, opts ...grpc.CallOption) (*AnalyzeAddressGroupImpactResp, error)
 	// ListAddressGroupBindings - gets list of address group bindings
 	ListAddressGroupBindings(ctx context.Context, in *ListAddressGroupBindingsReq, opts ...grpc.CallOption) (*ListAddressGroupBindingsResp, error)
 	// GetAddressGroupBinding - gets a specific address group binding by ID
@@ -90,6 +150,10 @@ type NetguardServiceClient interface {
 	ListIEAgAgRules(ctx context.Context, in *ListIEAgAgRulesReq, opts ...grpc.CallOption) (*ListIEAgAgRulesResp, error)
 	// GetIEAgAgRule - gets a specific IEAgAgRule by ID
 	GetIEAgAgRule(ctx context.Context, in *GetIEAgAgRuleReq, opts ...grpc.CallOption) (*GetIEAgAgRuleResp, error)
+	// ListIEAgAgRuleContributions - explains which RuleS2S contribute which ports to IEAgAgRules
+	ListIEAgAgRuleContributions(ctx context.Context, in *ListIEAgAgRuleContributionsReq, opts ...grpc.CallOption) (*ListIEAgAgRuleContributionsResp, error)
+	// ListConditionTransitions - lists when and why conditions of resources changed their status
+	ListConditionTransitions(ctx context.Context, in *ListConditionTransitionsReq, opts ...grpc.CallOption) (*ListConditionTransitionsResp, error)
 	// ListNetworks - gets list of networks
 	ListNetworks(ctx context.Context, in *ListNetworksReq, opts ...grpc.CallOption) (*ListNetworksResp, error)
 	// GetNetwork - gets a specific network by ID
@@ -106,6 +170,26 @@ type NetguardServiceClient interface {
 	ListHostBindings(ctx context.Context, in *ListHostBindingsReq, opts ...grpc.CallOption) (*ListHostBindingsResp, error)
 	// GetHostBinding - gets a specific host binding by ID
 	GetHostBinding(ctx context.Context, in *GetHostBindingReq, opts ...grpc.CallOption) (*GetHostBindingResp, error)
+	// ListRuleS2SExceptions - gets list of RuleS2S exceptions
+	ListRuleS2SExceptions(ctx context.Context, in *ListRuleS2SExceptionsReq, opts ...grpc.CallOption) (*ListRuleS2SExceptionsResp, error)
+	// GetRuleS2SException - gets a specific RuleS2S exception by ID
+	GetRuleS2SException(ctx context.Context, in *GetRuleS2SExceptionReq, opts ...grpc.CallOption) (*GetRuleS2SExceptionResp, error)
+	// ListCrossNamespacePolicies - gets list of cross namespace policies
+	ListCrossNamespacePolicies(ctx context.Context, in *ListCrossNamespacePoliciesReq, opts ...grpc.CallOption) (*ListCrossNamespacePoliciesResp, error)
+	// GetCrossNamespacePolicy - gets a specific cross namespace policy by ID
+	GetCrossNamespacePolicy(ctx context.Context, in *GetCrossNamespacePolicyReq, opts ...grpc.CallOption) (*GetCrossNamespacePolicyResp, error)
+	// ListRuleTemplates - gets list of rule templates
+	ListRuleTemplates(ctx context.Context, in *ListRuleTemplatesReq, opts ...grpc.CallOption) (*ListRuleTemplatesResp, error)
+	// GetRuleTemplate - gets a specific rule template by ID
+	GetRuleTemplate(ctx context.Context, in *GetRuleTemplateReq, opts ...grpc.CallOption) (*GetRuleTemplateResp, error)
+	// ListNamespacePostures - gets list of namespace postures
+	ListNamespacePostures(ctx context.Context, in *ListNamespacePosturesReq, opts ...grpc.CallOption) (*ListNamespacePosturesResp, error)
+	// GetNamespacePosture - gets a specific namespace posture by ID
+	GetNamespacePosture(ctx context.Context, in *GetNamespacePostureReq, opts ...grpc.CallOption) (*GetNamespacePostureResp, error)
+	// SimulateTraffic - checks whether a connection would be allowed by the stored IEAgAg rules
+	SimulateTraffic(ctx context.Context, in *SimulateTrafficReq, opts ...grpc.CallOption) (*SimulateTrafficResp, error)
+	// Watch - streams resource change events
+	Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error)
 }
 
 type netguardServiceClient struct {
@@ -136,6 +220,156 @@ func (c *netguardServiceClient) SyncStatus(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *netguardServiceClient) GetDetailedSyncStatus(ctx context.Context, in *GetDetailedSyncStatusReq, opts ...grpc.CallOption) (*GetDetailedSyncStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDetailedSyncStatusResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetDetailedSyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) GetReverseSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetReverseSyncStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReverseSyncStatusResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetReverseSyncStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) PauseReverseSync(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetguardService_PauseReverseSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ResumeReverseSync(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetguardService_ResumeReverseSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) TriggerReverseSync(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetguardService_TriggerReverseSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ListFailedSyncs(ctx context.Context, in *ListFailedSyncsReq, opts ...grpc.CallOption) (*ListFailedSyncsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFailedSyncsResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListFailedSyncs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) RetryFailedSync(ctx context.Context, in *RetryFailedSyncReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetguardService_RetryFailedSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ListQuarantinedResources(ctx context.Context, in *ListQuarantinedResourcesReq, opts ...grpc.CallOption) (*ListQuarantinedResourcesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuarantinedResourcesResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListQuarantinedResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) PromoteQuarantinedResource(ctx context.Context, in *PromoteQuarantinedResourceReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetguardService_PromoteQuarantinedResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) DeleteQuarantinedResource(ctx context.Context, in *DeleteQuarantinedResourceReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetguardService_DeleteQuarantinedResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) GetStartupReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStartupReportResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStartupReportResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetStartupReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) GetSchemaStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSchemaStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSchemaStatusResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetSchemaStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ListSyncers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSyncersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSyncersResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListSyncers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) SetSyncerEnabled(ctx context.Context, in *SetSyncerEnabledReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NetguardService_SetSyncerEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) PurgeNamespace(ctx context.Context, in *PurgeNamespaceReq, opts ...grpc.CallOption) (*PurgeNamespaceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeNamespaceResp)
+	err := c.cc.Invoke(ctx, NetguardService_PurgeNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *netguardServiceClient) ListServices(ctx context.Context, in *ListServicesReq, opts ...grpc.CallOption) (*ListServicesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListServicesResp)
@@ -176,6 +410,16 @@ func (c *netguardServiceClient) GetAddressGroup(ctx context.Context, in *GetAddr
 	return out, nil
 }
 
+func (c *netguardServiceClient) AnalyzeAddressGroupImpact(ctx context.Context, in *AnalyzeAddressGroupImpactReq, opts ...grpc.CallOption) (*AnalyzeAddressGroupImpactResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeAddressGroupImpactResp)
+	err := c.cc.Invoke(ctx, NetguardService_AnalyzeAddressGroupImpact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *netguardServiceClient) ListAddressGroupBindings(ctx context.Context, in *ListAddressGroupBindingsReq, opts ...grpc.CallOption) (*ListAddressGroupBindingsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAddressGroupBindingsResp)
@@ -296,6 +540,26 @@ func (c *netguardServiceClient) GetIEAgAgRule(ctx context.Context, in *GetIEAgAg
 	return out, nil
 }
 
+func (c *netguardServiceClient) ListIEAgAgRuleContributions(ctx context.Context, in *ListIEAgAgRuleContributionsReq, opts ...grpc.CallOption) (*ListIEAgAgRuleContributionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIEAgAgRuleContributionsResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListIEAgAgRuleContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ListConditionTransitions(ctx context.Context, in *ListConditionTransitionsReq, opts ...grpc.CallOption) (*ListConditionTransitionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConditionTransitionsResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListConditionTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *netguardServiceClient) ListNetworks(ctx context.Context, in *ListNetworksReq, opts ...grpc.CallOption) (*ListNetworksResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListNetworksResp)
@@ -376,6 +640,115 @@ func (c *netguardServiceClient) GetHostBinding(ctx context.Context, in *GetHostB
 	return out, nil
 }
 
+func (c *netguardServiceClient) ListRuleS2SExceptions(ctx context.Context, in *ListRuleS2SExceptionsReq, opts ...grpc.CallOption) (*ListRuleS2SExceptionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuleS2SExceptionsResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListRuleS2SExceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) GetRuleS2SException(ctx context.Context, in *GetRuleS2SExceptionReq, opts ...grpc.CallOption) (*GetRuleS2SExceptionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRuleS2SExceptionResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetRuleS2SException_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ListCrossNamespacePolicies(ctx context.Context, in *ListCrossNamespacePoliciesReq, opts ...grpc.CallOption) (*ListCrossNamespacePoliciesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCrossNamespacePoliciesResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListCrossNamespacePolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) GetCrossNamespacePolicy(ctx context.Context, in *GetCrossNamespacePolicyReq, opts ...grpc.CallOption) (*GetCrossNamespacePolicyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCrossNamespacePolicyResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetCrossNamespacePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ListRuleTemplates(ctx context.Context, in *ListRuleTemplatesReq, opts ...grpc.CallOption) (*ListRuleTemplatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuleTemplatesResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListRuleTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) GetRuleTemplate(ctx context.Context, in *GetRuleTemplateReq, opts ...grpc.CallOption) (*GetRuleTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRuleTemplateResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetRuleTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) ListNamespacePostures(ctx context.Context, in *ListNamespacePosturesReq, opts ...grpc.CallOption) (*ListNamespacePosturesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespacePosturesResp)
+	err := c.cc.Invoke(ctx, NetguardService_ListNamespacePostures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) GetNamespacePosture(ctx context.Context, in *GetNamespacePostureReq, opts ...grpc.CallOption) (*GetNamespacePostureResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNamespacePostureResp)
+	err := c.cc.Invoke(ctx, NetguardService_GetNamespacePosture_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) SimulateTraffic(ctx context.Context, in *SimulateTrafficReq, opts ...grpc.CallOption) (*SimulateTrafficResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateTrafficResp)
+	err := c.cc.Invoke(ctx, NetguardService_SimulateTraffic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *netguardServiceClient) Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NetguardService_ServiceDesc.Streams[0], NetguardService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchReq, WatchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NetguardService_WatchClient = grpc.ServerStreamingClient[WatchEvent]
+
 // NetguardServiceServer is the server API for NetguardService service.
 // All implementations must embed UnimplementedNetguardServiceServer
 // for forward compatibility.
@@ -386,6 +759,36 @@ type NetguardServiceServer interface {
 	Sync(context.Context, *SyncReq) (*emptypb.Empty, error)
 	// SyncStatus - gets last succeeded update DB status
 	SyncStatus(context.Context, *emptypb.Empty) (*SyncStatusResp, error)
+	// GetDetailedSyncStatus - gets sync status per resource kind and per resource
+	GetDetailedSyncStatus(context.Context, *GetDetailedSyncStatusReq) (*GetDetailedSyncStatusResp, error)
+	// GetReverseSyncStatus - gets statistics of the reverse synchronization from sgroups
+	GetReverseSyncStatus(context.Context, *emptypb.Empty) (*GetReverseSyncStatusResp, error)
+	// PauseReverseSync - pauses synchronization of changes from sgroups
+	PauseReverseSync(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// ResumeReverseSync - resumes synchronization of changes from sgroups
+	ResumeReverseSync(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// TriggerReverseSync - runs a reverse sync cycle immediately
+	TriggerReverseSync(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// ListFailedSyncs - gets sgroups syncs moved to the dead-letter queue
+	ListFailedSyncs(context.Context, *ListFailedSyncsReq) (*ListFailedSyncsResp, error)
+	// RetryFailedSync - syncs the resource of a dead-lettered entry again
+	RetryFailedSync(context.Context, *RetryFailedSyncReq) (*emptypb.Empty, error)
+	// ListQuarantinedResources - imported resources which failed validation
+	ListQuarantinedResources(context.Context, *ListQuarantinedResourcesReq) (*ListQuarantinedResourcesResp, error)
+	// PromoteQuarantinedResource - import a quarantined resource again
+	PromoteQuarantinedResource(context.Context, *PromoteQuarantinedResourceReq) (*emptypb.Empty, error)
+	// DeleteQuarantinedResource - drop a quarantined resource
+	DeleteQuarantinedResource(context.Context, *DeleteQuarantinedResourceReq) (*emptypb.Empty, error)
+	// GetStartupReport - gets the report logged on startup
+	GetStartupReport(context.Context, *emptypb.Empty) (*GetStartupReportResp, error)
+	// GetSchemaStatus - gets the applied and the supported schema version
+	GetSchemaStatus(context.Context, *emptypb.Empty) (*GetSchemaStatusResp, error)
+	// ListSyncers - gets sgroups syncers and whether they are enabled
+	ListSyncers(context.Context, *emptypb.Empty) (*ListSyncersResp, error)
+	// SetSyncerEnabled - enables or disables the syncers of a subject type
+	SetSyncerEnabled(context.Context, *SetSyncerEnabledReq) (*emptypb.Empty, error)
+	// PurgeNamespace - deletes all resources of a namespace in one transaction
+	PurgeNamespace(context.Context, *PurgeNamespaceReq) (*PurgeNamespaceResp, error)
 	// ListServices - gets list of services
 	ListServices(context.Context, *ListServicesReq) (*ListServicesResp, error)
 	// GetService - gets a specific service by ID
@@ -394,6 +797,8 @@ type NetguardServiceServer interface {
 	ListAddressGroups(context.Context, *ListAddressGroupsReq) (*ListAddressGroupsResp, error)
 	// GetAddressGroup - gets a specific address group by ID
 	GetAddressGroup(context.Context, *GetAddressGroupReq) (*GetAddressGroupResp, error)
+	// AnalyzeAddressGroupImpact - analyzes impact of an address group change
+	AnalyzeAddressGroupImpact(context.Context, *AnalyzeAddressGroupImpactReq) (*AnalyzeAddressGroupImpactResp, error)
 	// ListAddressGroupBindings - gets list of address group bindings
 	ListAddressGroupBindings(context.Context, *ListAddressGroupBindingsReq) (*ListAddressGroupBindingsResp, error)
 	// GetAddressGroupBinding - gets a specific address group binding by ID
@@ -418,6 +823,10 @@ type NetguardServiceServer interface {
 	ListIEAgAgRules(context.Context, *ListIEAgAgRulesReq) (*ListIEAgAgRulesResp, error)
 	// GetIEAgAgRule - gets a specific IEAgAgRule by ID
 	GetIEAgAgRule(context.Context, *GetIEAgAgRuleReq) (*GetIEAgAgRuleResp, error)
+	// ListIEAgAgRuleContributions - explains which RuleS2S contribute which ports to IEAgAgRules
+	ListIEAgAgRuleContributions(context.Context, *ListIEAgAgRuleContributionsReq) (*ListIEAgAgRuleContributionsResp, error)
+	// ListConditionTransitions - lists when and why conditions of resources changed their status
+	ListConditionTransitions(context.Context, *ListConditionTransitionsReq) (*ListConditionTransitionsResp, error)
 	// ListNetworks - gets list of networks
 	ListNetworks(context.Context, *ListNetworksReq) (*ListNetworksResp, error)
 	// GetNetwork - gets a specific network by ID
@@ -434,6 +843,26 @@ type NetguardServiceServer interface {
 	ListHostBindings(context.Context, *ListHostBindingsReq) (*ListHostBindingsResp, error)
 	// GetHostBinding - gets a specific host binding by ID
 	GetHostBinding(context.Context, *GetHostBindingReq) (*GetHostBindingResp, error)
+	// ListRuleS2SExceptions - gets list of RuleS2S exceptions
+	ListRuleS2SExceptions(context.Context, *ListRuleS2SExceptionsReq) (*ListRuleS2SExceptionsResp, error)
+	// GetRuleS2SException - gets a specific RuleS2S exception by ID
+	GetRuleS2SException(context.Context, *GetRuleS2SExceptionReq) (*GetRuleS2SExceptionResp, error)
+	// ListCrossNamespacePolicies - gets list of cross namespace policies
+	ListCrossNamespacePolicies(context.Context, *ListCrossNamespacePoliciesReq) (*ListCrossNamespacePoliciesResp, error)
+	// GetCrossNamespacePolicy - gets a specific cross namespace policy by ID
+	GetCrossNamespacePolicy(context.Context, *GetCrossNamespacePolicyReq) (*GetCrossNamespacePolicyResp, error)
+	// ListRuleTemplates - gets list of rule templates
+	ListRuleTemplates(context.Context, *ListRuleTemplatesReq) (*ListRuleTemplatesResp, error)
+	// GetRuleTemplate - gets a specific rule template by ID
+	GetRuleTemplate(context.Context, *GetRuleTemplateReq) (*GetRuleTemplateResp, error)
+	// ListNamespacePostures - gets list of namespace postures
+	ListNamespacePostures(context.Context, *ListNamespacePosturesReq) (*ListNamespacePosturesResp, error)
+	// GetNamespacePosture - gets a specific namespace posture by ID
+	GetNamespacePosture(context.Context, *GetNamespacePostureReq) (*GetNamespacePostureResp, error)
+	// SimulateTraffic - checks whether a connection would be allowed by the stored IEAgAg rules
+	SimulateTraffic(context.Context, *SimulateTrafficReq) (*SimulateTrafficResp, error)
+	// Watch - streams resource change events
+	Watch(*WatchReq, grpc.ServerStreamingServer[WatchEvent]) error
 	mustEmbedUnimplementedNetguardServiceServer()
 }
 
@@ -450,6 +879,51 @@ func (UnimplementedNetguardServiceServer) Sync(context.Context, *SyncReq) (*empt
 func (UnimplementedNetguardServiceServer) SyncStatus(context.Context, *emptypb.Empty) (*SyncStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncStatus not implemented")
 }
+func (UnimplementedNetguardServiceServer) GetDetailedSyncStatus(context.Context, *GetDetailedSyncStatusReq) (*GetDetailedSyncStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDetailedSyncStatus not implemented")
+}
+func (UnimplementedNetguardServiceServer) GetReverseSyncStatus(context.Context, *emptypb.Empty) (*GetReverseSyncStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReverseSyncStatus not implemented")
+}
+func (UnimplementedNetguardServiceServer) PauseReverseSync(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseReverseSync not implemented")
+}
+func (UnimplementedNetguardServiceServer) ResumeReverseSync(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeReverseSync not implemented")
+}
+func (UnimplementedNetguardServiceServer) TriggerReverseSync(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerReverseSync not implemented")
+}
+func (UnimplementedNetguardServiceServer) ListFailedSyncs(context.Context, *ListFailedSyncsReq) (*ListFailedSyncsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedSyncs not implemented")
+}
+func (UnimplementedNetguardServiceServer) RetryFailedSync(context.Context, *RetryFailedSyncReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedSync not implemented")
+}
+func (UnimplementedNetguardServiceServer) ListQuarantinedResources(context.Context, *ListQuarantinedResourcesReq) (*ListQuarantinedResourcesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedResources not implemented")
+}
+func (UnimplementedNetguardServiceServer) PromoteQuarantinedResource(context.Context, *PromoteQuarantinedResourceReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteQuarantinedResource not implemented")
+}
+func (UnimplementedNetguardServiceServer) DeleteQuarantinedResource(context.Context, *DeleteQuarantinedResourceReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuarantinedResource not implemented")
+}
+func (UnimplementedNetguardServiceServer) GetStartupReport(context.Context, *emptypb.Empty) (*GetStartupReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStartupReport not implemented")
+}
+func (UnimplementedNetguardServiceServer) GetSchemaStatus(context.Context, *emptypb.Empty) (*GetSchemaStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaStatus not implemented")
+}
+func (UnimplementedNetguardServiceServer) ListSyncers(context.Context, *emptypb.Empty) (*ListSyncersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncers not implemented")
+}
+func (UnimplementedNetguardServiceServer) SetSyncerEnabled(context.Context, *SetSyncerEnabledReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSyncerEnabled not implemented")
+}
+func (UnimplementedNetguardServiceServer) PurgeNamespace(context.Context, *PurgeNamespaceReq) (*PurgeNamespaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeNamespace not implemented")
+}
 func (UnimplementedNetguardServiceServer) ListServices(context.Context, *ListServicesReq) (*ListServicesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
 }
@@ -462,6 +936,9 @@ func (UnimplementedNetguardServiceServer) ListAddressGroups(context.Context, *Li
 func (UnimplementedNetguardServiceServer) GetAddressGroup(context.Context, *GetAddressGroupReq) (*GetAddressGroupResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAddressGroup not implemented")
 }
+func (UnimplementedNetguardServiceServer) AnalyzeAddressGroupImpact(context.Context, *AnalyzeAddressGroupImpactReq) (*AnalyzeAddressGroupImpactResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeAddressGroupImpact not implemented")
+}
 func (UnimplementedNetguardServiceServer) ListAddressGroupBindings(context.Context, *ListAddressGroupBindingsReq) (*ListAddressGroupBindingsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAddressGroupBindings not implemented")
 }
@@ -498,6 +975,12 @@ func (UnimplementedNetguardServiceServer) ListIEAgAgRules(context.Context, *List
 func (UnimplementedNetguardServiceServer) GetIEAgAgRule(context.Context, *GetIEAgAgRuleReq) (*GetIEAgAgRuleResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIEAgAgRule not implemented")
 }
+func (UnimplementedNetguardServiceServer) ListIEAgAgRuleContributions(context.Context, *ListIEAgAgRuleContributionsReq) (*ListIEAgAgRuleContributionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIEAgAgRuleContributions not implemented")
+}
+func (UnimplementedNetguardServiceServer) ListConditionTransitions(context.Context, *ListConditionTransitionsReq) (*ListConditionTransitionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConditionTransitions not implemented")
+}
 func (UnimplementedNetguardServiceServer) ListNetworks(context.Context, *ListNetworksReq) (*ListNetworksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNetworks not implemented")
 }
@@ -522,6 +1005,36 @@ func (UnimplementedNetguardServiceServer) ListHostBindings(context.Context, *Lis
 func (UnimplementedNetguardServiceServer) GetHostBinding(context.Context, *GetHostBindingReq) (*GetHostBindingResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHostBinding not implemented")
 }
+func (UnimplementedNetguardServiceServer) ListRuleS2SExceptions(context.Context, *ListRuleS2SExceptionsReq) (*ListRuleS2SExceptionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleS2SExceptions not implemented")
+}
+func (UnimplementedNetguardServiceServer) GetRuleS2SException(context.Context, *GetRuleS2SExceptionReq) (*GetRuleS2SExceptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleS2SException not implemented")
+}
+func (UnimplementedNetguardServiceServer) ListCrossNamespacePolicies(context.Context, *ListCrossNamespacePoliciesReq) (*ListCrossNamespacePoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCrossNamespacePolicies not implemented")
+}
+func (UnimplementedNetguardServiceServer) GetCrossNamespacePolicy(context.Context, *GetCrossNamespacePolicyReq) (*GetCrossNamespacePolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrossNamespacePolicy not implemented")
+}
+func (UnimplementedNetguardServiceServer) ListRuleTemplates(context.Context, *ListRuleTemplatesReq) (*ListRuleTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleTemplates not implemented")
+}
+func (UnimplementedNetguardServiceServer) GetRuleTemplate(context.Context, *GetRuleTemplateReq) (*GetRuleTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleTemplate not implemented")
+}
+func (UnimplementedNetguardServiceServer) ListNamespacePostures(context.Context, *ListNamespacePosturesReq) (*ListNamespacePosturesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespacePostures not implemented")
+}
+func (UnimplementedNetguardServiceServer) GetNamespacePosture(context.Context, *GetNamespacePostureReq) (*GetNamespacePostureResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespacePosture not implemented")
+}
+func (UnimplementedNetguardServiceServer) SimulateTraffic(context.Context, *SimulateTrafficReq) (*SimulateTrafficResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTraffic not implemented")
+}
+func (UnimplementedNetguardServiceServer) Watch(*WatchReq, grpc.ServerStreamingServer[WatchEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedNetguardServiceServer) mustEmbedUnimplementedNetguardServiceServer() {}
 func (UnimplementedNetguardServiceServer) testEmbeddedByValue()                         {}
 
@@ -532,49 +1045,319 @@ type UnsafeNetguardServiceServer interface {
 	mustEmbedUnimplementedNetguardServiceServer()
 }
 
-func RegisterNetguardServiceServer(s grpc.ServiceRegistrar, srv NetguardServiceServer) {
-	// If the following call pancis, it indicates UnimplementedNetguardServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func RegisterNetguardServiceServer(s grpc.ServiceRegistrar, srv NetguardServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNetguardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NetguardService_ServiceDesc, srv)
+}
+
+func _NetguardService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_Sync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).Sync(ctx, req.(*SyncReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_SyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).SyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_SyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).SyncStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetDetailedSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDetailedSyncStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetDetailedSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetDetailedSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetDetailedSyncStatus(ctx, req.(*GetDetailedSyncStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetReverseSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetReverseSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetReverseSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetReverseSyncStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_PauseReverseSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).PauseReverseSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_PauseReverseSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).PauseReverseSync(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ResumeReverseSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ResumeReverseSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ResumeReverseSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ResumeReverseSync(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_TriggerReverseSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).TriggerReverseSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_TriggerReverseSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).TriggerReverseSync(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ListFailedSyncs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedSyncsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListFailedSyncs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListFailedSyncs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListFailedSyncs(ctx, req.(*ListFailedSyncsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_RetryFailedSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryFailedSyncReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).RetryFailedSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_RetryFailedSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).RetryFailedSync(ctx, req.(*RetryFailedSyncReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ListQuarantinedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListQuarantinedResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListQuarantinedResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListQuarantinedResources(ctx, req.(*ListQuarantinedResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_PromoteQuarantinedResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteQuarantinedResourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).PromoteQuarantinedResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_PromoteQuarantinedResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).PromoteQuarantinedResource(ctx, req.(*PromoteQuarantinedResourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_DeleteQuarantinedResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuarantinedResourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).DeleteQuarantinedResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_DeleteQuarantinedResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).DeleteQuarantinedResource(ctx, req.(*DeleteQuarantinedResourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetStartupReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetStartupReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetStartupReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetStartupReport(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetSchemaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetSchemaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetSchemaStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetSchemaStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ListSyncers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&NetguardService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListSyncers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListSyncers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListSyncers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _NetguardService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncReq)
+func _NetguardService_SetSyncerEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSyncerEnabledReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetguardServiceServer).Sync(ctx, in)
+		return srv.(NetguardServiceServer).SetSyncerEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetguardService_Sync_FullMethodName,
+		FullMethod: NetguardService_SetSyncerEnabled_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetguardServiceServer).Sync(ctx, req.(*SyncReq))
+		return srv.(NetguardServiceServer).SetSyncerEnabled(ctx, req.(*SetSyncerEnabledReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NetguardService_SyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _NetguardService_PurgeNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeNamespaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetguardServiceServer).SyncStatus(ctx, in)
+		return srv.(NetguardServiceServer).PurgeNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetguardService_SyncStatus_FullMethodName,
+		FullMethod: NetguardService_PurgeNamespace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetguardServiceServer).SyncStatus(ctx, req.(*emptypb.Empty))
+		return srv.(NetguardServiceServer).PurgeNamespace(ctx, req.(*PurgeNamespaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -651,6 +1434,24 @@ func _NetguardService_GetAddressGroup_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetguardService_AnalyzeAddressGroupImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeAddressGroupImpactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).AnalyzeAddressGroupImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_AnalyzeAddressGroupImpact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).AnalyzeAddressGroupImpact(ctx, req.(*AnalyzeAddressGroupImpactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NetguardService_ListAddressGroupBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAddressGroupBindingsReq)
 	if err := dec(in); err != nil {
@@ -867,6 +1668,42 @@ func _NetguardService_GetIEAgAgRule_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetguardService_ListIEAgAgRuleContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIEAgAgRuleContributionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListIEAgAgRuleContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListIEAgAgRuleContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListIEAgAgRuleContributions(ctx, req.(*ListIEAgAgRuleContributionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ListConditionTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConditionTransitionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListConditionTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListConditionTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListConditionTransitions(ctx, req.(*ListConditionTransitionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NetguardService_ListNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNetworksReq)
 	if err := dec(in); err != nil {
@@ -1011,6 +1848,179 @@ func _NetguardService_GetHostBinding_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetguardService_ListRuleS2SExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleS2SExceptionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListRuleS2SExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListRuleS2SExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListRuleS2SExceptions(ctx, req.(*ListRuleS2SExceptionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetRuleS2SException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleS2SExceptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetRuleS2SException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetRuleS2SException_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetRuleS2SException(ctx, req.(*GetRuleS2SExceptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ListCrossNamespacePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCrossNamespacePoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListCrossNamespacePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListCrossNamespacePolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListCrossNamespacePolicies(ctx, req.(*ListCrossNamespacePoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetCrossNamespacePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrossNamespacePolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetCrossNamespacePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetCrossNamespacePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetCrossNamespacePolicy(ctx, req.(*GetCrossNamespacePolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ListRuleTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListRuleTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListRuleTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListRuleTemplates(ctx, req.(*ListRuleTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetRuleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetRuleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetRuleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetRuleTemplate(ctx, req.(*GetRuleTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_ListNamespacePostures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacePosturesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).ListNamespacePostures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_ListNamespacePostures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).ListNamespacePostures(ctx, req.(*ListNamespacePosturesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_GetNamespacePosture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespacePostureReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).GetNamespacePosture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_GetNamespacePosture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).GetNamespacePosture(ctx, req.(*GetNamespacePostureReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_SimulateTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTrafficReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetguardServiceServer).SimulateTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetguardService_SimulateTraffic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetguardServiceServer).SimulateTraffic(ctx, req.(*SimulateTrafficReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetguardService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NetguardServiceServer).Watch(m, &grpc.GenericServerStream[WatchReq, WatchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NetguardService_WatchServer = grpc.ServerStreamingServer[WatchEvent]
+
 // NetguardService_ServiceDesc is the grpc.ServiceDesc for NetguardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1026,6 +2036,66 @@ var NetguardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncStatus",
 			Handler:    _NetguardService_SyncStatus_Handler,
 		},
+		{
+			MethodName: "GetDetailedSyncStatus",
+			Handler:    _NetguardService_GetDetailedSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetReverseSyncStatus",
+			Handler:    _NetguardService_GetReverseSyncStatus_Handler,
+		},
+		{
+			MethodName: "PauseReverseSync",
+			Handler:    _NetguardService_PauseReverseSync_Handler,
+		},
+		{
+			MethodName: "ResumeReverseSync",
+			Handler:    _NetguardService_ResumeReverseSync_Handler,
+		},
+		{
+			MethodName: "TriggerReverseSync",
+			Handler:    _NetguardService_TriggerReverseSync_Handler,
+		},
+		{
+			MethodName: "ListFailedSyncs",
+			Handler:    _NetguardService_ListFailedSyncs_Handler,
+		},
+		{
+			MethodName: "RetryFailedSync",
+			Handler:    _NetguardService_RetryFailedSync_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedResources",
+			Handler:    _NetguardService_ListQuarantinedResources_Handler,
+		},
+		{
+			MethodName: "PromoteQuarantinedResource",
+			Handler:    _NetguardService_PromoteQuarantinedResource_Handler,
+		},
+		{
+			MethodName: "DeleteQuarantinedResource",
+			Handler:    _NetguardService_DeleteQuarantinedResource_Handler,
+		},
+		{
+			MethodName: "GetStartupReport",
+			Handler:    _NetguardService_GetStartupReport_Handler,
+		},
+		{
+			MethodName: "GetSchemaStatus",
+			Handler:    _NetguardService_GetSchemaStatus_Handler,
+		},
+		{
+			MethodName: "ListSyncers",
+			Handler:    _NetguardService_ListSyncers_Handler,
+		},
+		{
+			MethodName: "SetSyncerEnabled",
+			Handler:    _NetguardService_SetSyncerEnabled_Handler,
+		},
+		{
+			MethodName: "PurgeNamespace",
+			Handler:    _NetguardService_PurgeNamespace_Handler,
+		},
 		{
 			MethodName: "ListServices",
 			Handler:    _NetguardService_ListServices_Handler,
@@ -1042,6 +2112,10 @@ var NetguardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAddressGroup",
 			Handler:    _NetguardService_GetAddressGroup_Handler,
 		},
+		{
+			MethodName: "AnalyzeAddressGroupImpact",
+			Handler:    _NetguardService_AnalyzeAddressGroupImpact_Handler,
+		},
 		{
 			MethodName: "ListAddressGroupBindings",
 			Handler:    _NetguardService_ListAddressGroupBindings_Handler,
@@ -1090,6 +2164,14 @@ var NetguardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIEAgAgRule",
 			Handler:    _NetguardService_GetIEAgAgRule_Handler,
 		},
+		{
+			MethodName: "ListIEAgAgRuleContributions",
+			Handler:    _NetguardService_ListIEAgAgRuleContributions_Handler,
+		},
+		{
+			MethodName: "ListConditionTransitions",
+			Handler:    _NetguardService_ListConditionTransitions_Handler,
+		},
 		{
 			MethodName: "ListNetworks",
 			Handler:    _NetguardService_ListNetworks_Handler,
@@ -1122,7 +2204,49 @@ var NetguardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHostBinding",
 			Handler:    _NetguardService_GetHostBinding_Handler,
 		},
+		{
+			MethodName: "ListRuleS2SExceptions",
+			Handler:    _NetguardService_ListRuleS2SExceptions_Handler,
+		},
+		{
+			MethodName: "GetRuleS2SException",
+			Handler:    _NetguardService_GetRuleS2SException_Handler,
+		},
+		{
+			MethodName: "ListCrossNamespacePolicies",
+			Handler:    _NetguardService_ListCrossNamespacePolicies_Handler,
+		},
+		{
+			MethodName: "GetCrossNamespacePolicy",
+			Handler:    _NetguardService_GetCrossNamespacePolicy_Handler,
+		},
+		{
+			MethodName: "ListRuleTemplates",
+			Handler:    _NetguardService_ListRuleTemplates_Handler,
+		},
+		{
+			MethodName: "GetRuleTemplate",
+			Handler:    _NetguardService_GetRuleTemplate_Handler,
+		},
+		{
+			MethodName: "ListNamespacePostures",
+			Handler:    _NetguardService_ListNamespacePostures_Handler,
+		},
+		{
+			MethodName: "GetNamespacePosture",
+			Handler:    _NetguardService_GetNamespacePosture_Handler,
+		},
+		{
+			MethodName: "SimulateTraffic",
+			Handler:    _NetguardService_SimulateTraffic_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _NetguardService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "netguard/api.proto",
 }
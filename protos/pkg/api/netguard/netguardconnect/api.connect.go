@@ -39,6 +39,51 @@ const (
 	// NetguardServiceSyncStatusProcedure is the fully-qualified name of the NetguardService's
 	// SyncStatus RPC.
 	NetguardServiceSyncStatusProcedure = "/netguard.v1.NetguardService/SyncStatus"
+	// NetguardServiceGetDetailedSyncStatusProcedure is the fully-qualified name of the
+	// NetguardService's GetDetailedSyncStatus RPC.
+	NetguardServiceGetDetailedSyncStatusProcedure = "/netguard.v1.NetguardService/GetDetailedSyncStatus"
+	// NetguardServiceGetReverseSyncStatusProcedure is the fully-qualified name of the NetguardService's
+	// GetReverseSyncStatus RPC.
+	NetguardServiceGetReverseSyncStatusProcedure = "/netguard.v1.NetguardService/GetReverseSyncStatus"
+	// NetguardServicePauseReverseSyncProcedure is the fully-qualified name of the NetguardService's
+	// PauseReverseSync RPC.
+	NetguardServicePauseReverseSyncProcedure = "/netguard.v1.NetguardService/PauseReverseSync"
+	// NetguardServiceResumeReverseSyncProcedure is the fully-qualified name of the NetguardService's
+	// ResumeReverseSync RPC.
+	NetguardServiceResumeReverseSyncProcedure = "/netguard.v1.NetguardService/ResumeReverseSync"
+	// NetguardServiceTriggerReverseSyncProcedure is the fully-qualified name of the NetguardService's
+	// TriggerReverseSync RPC.
+	NetguardServiceTriggerReverseSyncProcedure = "/netguard.v1.NetguardService/TriggerReverseSync"
+	// NetguardServiceListFailedSyncsProcedure is the fully-qualified name of the NetguardService's
+	// ListFailedSyncs RPC.
+	NetguardServiceListFailedSyncsProcedure = "/netguard.v1.NetguardService/ListFailedSyncs"
+	// NetguardServiceRetryFailedSyncProcedure is the fully-qualified name of the NetguardService's
+	// RetryFailedSync RPC.
+	NetguardServiceRetryFailedSyncProcedure = "/netguard.v1.NetguardService/RetryFailedSync"
+	// NetguardServiceListQuarantinedResourcesProcedure is the fully-qualified name of the
+	// NetguardService's ListQuarantinedResources RPC.
+	NetguardServiceListQuarantinedResourcesProcedure = "/netguard.v1.NetguardService/ListQuarantinedResources"
+	// NetguardServicePromoteQuarantinedResourceProcedure is the fully-qualified name of the
+	// NetguardService's PromoteQuarantinedResource RPC.
+	NetguardServicePromoteQuarantinedResourceProcedure = "/netguard.v1.NetguardService/PromoteQuarantinedResource"
+	// NetguardServiceDeleteQuarantinedResourceProcedure is the fully-qualified name of the
+	// NetguardService's DeleteQuarantinedResource RPC.
+	NetguardServiceDeleteQuarantinedResourceProcedure = "/netguard.v1.NetguardService/DeleteQuarantinedResource"
+	// NetguardServiceGetStartupReportProcedure is the fully-qualified name of the NetguardService's
+	// GetStartupReport RPC.
+	NetguardServiceGetStartupReportProcedure = "/netguard.v1.NetguardService/GetStartupReport"
+	// NetguardServiceGetSchemaStatusProcedure is the fully-qualified name of the NetguardService's
+	// GetSchemaStatus RPC.
+	NetguardServiceGetSchemaStatusProcedure = "/netguard.v1.NetguardService/GetSchemaStatus"
+	// NetguardServiceListSyncersProcedure is the fully-qualified name of the NetguardService's
+	// ListSyncers RPC.
+	NetguardServiceListSyncersProcedure = "/netguard.v1.NetguardService/ListSyncers"
+	// NetguardServiceSetSyncerEnabledProcedure is the fully-qualified name of the NetguardService's
+	// SetSyncerEnabled RPC.
+	NetguardServiceSetSyncerEnabledProcedure = "/netguard.v1.NetguardService/SetSyncerEnabled"
+	// NetguardServicePurgeNamespaceProcedure is the fully-qualified name of the NetguardService's
+	// PurgeNamespace RPC.
+	NetguardServicePurgeNamespaceProcedure = "/netguard.v1.NetguardService/PurgeNamespace"
 	// NetguardServiceListServicesProcedure is the fully-qualified name of the NetguardService's
 	// ListServices RPC.
 	NetguardServiceListServicesProcedure = "/netguard.v1.NetguardService/ListServices"
@@ -51,6 +96,9 @@ const (
 	// NetguardServiceGetAddressGroupProcedure is the fully-qualified name of the NetguardService's
 	// GetAddressGroup RPC.
 	NetguardServiceGetAddressGroupProcedure = "/netguard.v1.NetguardService/GetAddressGroup"
+	// NetguardServiceAnalyzeAddressGroupImpactProcedure is the fully-qualified name of the
+	// NetguardService's AnalyzeAddressGroupImpact RPC.
+	NetguardServiceAnalyzeAddressGroupImpactProcedure = "/netguard.v1.NetguardService/AnalyzeAddressGroupImpact"
 	// NetguardServiceListAddressGroupBindingsProcedure is the fully-qualified name of the
 	// NetguardService's ListAddressGroupBindings RPC.
 	NetguardServiceListAddressGroupBindingsProcedure = "/netguard.v1.NetguardService/ListAddressGroupBindings"
@@ -87,6 +135,12 @@ const (
 	// NetguardServiceGetIEAgAgRuleProcedure is the fully-qualified name of the NetguardService's
 	// GetIEAgAgRule RPC.
 	NetguardServiceGetIEAgAgRuleProcedure = "/netguard.v1.NetguardService/GetIEAgAgRule"
+	// NetguardServiceListIEAgAgRuleContributionsProcedure is the fully-qualified name of the
+	// NetguardService's ListIEAgAgRuleContributions RPC.
+	NetguardServiceListIEAgAgRuleContributionsProcedure = "/netguard.v1.NetguardService/ListIEAgAgRuleContributions"
+	// NetguardServiceListConditionTransitionsProcedure is the fully-qualified name of the
+	// NetguardService's ListConditionTransitions RPC.
+	NetguardServiceListConditionTransitionsProcedure = "/netguard.v1.NetguardService/ListConditionTransitions"
 	// NetguardServiceListNetworksProcedure is the fully-qualified name of the NetguardService's
 	// ListNetworks RPC.
 	NetguardServiceListNetworksProcedure = "/netguard.v1.NetguardService/ListNetworks"
@@ -110,6 +164,35 @@ const (
 	// NetguardServiceGetHostBindingProcedure is the fully-qualified name of the NetguardService's
 	// GetHostBinding RPC.
 	NetguardServiceGetHostBindingProcedure = "/netguard.v1.NetguardService/GetHostBinding"
+	// NetguardServiceListRuleS2SExceptionsProcedure is the fully-qualified name of the
+	// NetguardService's ListRuleS2SExceptions RPC.
+	NetguardServiceListRuleS2SExceptionsProcedure = "/netguard.v1.NetguardService/ListRuleS2SExceptions"
+	// NetguardServiceGetRuleS2SExceptionProcedure is the fully-qualified name of the NetguardService's
+	// GetRuleS2SException RPC.
+	NetguardServiceGetRuleS2SExceptionProcedure = "/netguard.v1.NetguardService/GetRuleS2SException"
+	// NetguardServiceListCrossNamespacePoliciesProcedure is the fully-qualified name of the
+	// NetguardService's ListCrossNamespacePolicies RPC.
+	NetguardServiceListCrossNamespacePoliciesProcedure = "/netguard.v1.NetguardService/ListCrossNamespacePolicies"
+	// NetguardServiceGetCrossNamespacePolicyProcedure is the fully-qualified name of the
+	// NetguardService's GetCrossNamespacePolicy RPC.
+	NetguardServiceGetCrossNamespacePolicyProcedure = "/netguard.v1.NetguardService/GetCrossNamespacePolicy"
+	// NetguardServiceListRuleTemplatesProcedure is the fully-qualified name of the NetguardService's
+	// ListRuleTemplates RPC.
+	NetguardServiceListRuleTemplatesProcedure = "/netguard.v1.NetguardService/ListRuleTemplates"
+	// NetguardServiceGetRuleTemplateProcedure is the fully-qualified name of the NetguardService's
+	// GetRuleTemplate RPC.
+	NetguardServiceGetRuleTemplateProcedure = "/netguard.v1.NetguardService/GetRuleTemplate"
+	// NetguardServiceListNamespacePosturesProcedure is the fully-qualified name of the
+	// NetguardService's ListNamespacePostures RPC.
+	NetguardServiceListNamespacePosturesProcedure = "/netguard.v1.NetguardService/ListNamespacePostures"
+	// NetguardServiceGetNamespacePostureProcedure is the fully-qualified name of the NetguardService's
+	// GetNamespacePosture RPC.
+	NetguardServiceGetNamespacePostureProcedure = "/netguard.v1.NetguardService/GetNamespacePosture"
+	// NetguardServiceSimulateTrafficProcedure is the fully-qualified name of the NetguardService's
+	// SimulateTraffic RPC.
+	NetguardServiceSimulateTrafficProcedure = "/netguard.v1.NetguardService/SimulateTraffic"
+	// NetguardServiceWatchProcedure is the fully-qualified name of the NetguardService's Watch RPC.
+	NetguardServiceWatchProcedure = "/netguard.v1.NetguardService/Watch"
 )
 
 // NetguardServiceClient is a client for the netguard.v1.NetguardService service.
@@ -118,6 +201,36 @@ type NetguardServiceClient interface {
 	Sync(context.Context, *connect.Request[netguard.SyncReq]) (*connect.Response[emptypb.Empty], error)
 	// SyncStatus - gets last succeeded update DB status
 	SyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.SyncStatusResp], error)
+	// GetDetailedSyncStatus - gets sync status per resource kind and per resource
+	GetDetailedSyncStatus(context.Context, *connect.Request[netguard.GetDetailedSyncStatusReq]) (*connect.Response[netguard.GetDetailedSyncStatusResp], error)
+	// GetReverseSyncStatus - gets statistics of the reverse synchronization from sgroups
+	GetReverseSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetReverseSyncStatusResp], error)
+	// PauseReverseSync - pauses synchronization of changes from sgroups
+	PauseReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// ResumeReverseSync - resumes synchronization of changes from sgroups
+	ResumeReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// TriggerReverseSync - runs a reverse sync cycle immediately
+	TriggerReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// ListFailedSyncs - gets sgroups syncs moved to the dead-letter queue
+	ListFailedSyncs(context.Context, *connect.Request[netguard.ListFailedSyncsReq]) (*connect.Response[netguard.ListFailedSyncsResp], error)
+	// RetryFailedSync - syncs the resource of a dead-lettered entry again
+	RetryFailedSync(context.Context, *connect.Request[netguard.RetryFailedSyncReq]) (*connect.Response[emptypb.Empty], error)
+	// ListQuarantinedResources - imported resources which failed validation
+	ListQuarantinedResources(context.Context, *connect.Request[netguard.ListQuarantinedResourcesReq]) (*connect.Response[netguard.ListQuarantinedResourcesResp], error)
+	// PromoteQuarantinedResource - import a quarantined resource again
+	PromoteQuarantinedResource(context.Context, *connect.Request[netguard.PromoteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error)
+	// DeleteQuarantinedResource - drop a quarantined resource
+	DeleteQuarantinedResource(context.Context, *connect.Request[netguard.DeleteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error)
+	// GetStartupReport - gets the report logged on startup
+	GetStartupReport(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetStartupReportResp], error)
+	// GetSchemaStatus - gets the applied and the supported schema version
+	GetSchemaStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetSchemaStatusResp], error)
+	// ListSyncers - gets sgroups syncers and whether they are enabled
+	ListSyncers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.ListSyncersResp], error)
+	// SetSyncerEnabled - enables or disables the syncers of a subject type
+	SetSyncerEnabled(context.Context, *connect.Request[netguard.SetSyncerEnabledReq]) (*connect.Response[emptypb.Empty], error)
+	// PurgeNamespace - deletes all resources of a namespace in one transaction
+	PurgeNamespace(context.Context, *connect.Request[netguard.PurgeNamespaceReq]) (*connect.Response[netguard.PurgeNamespaceResp], error)
 	// ListServices - gets list of services
 	ListServices(context.Context, *connect.Request[netguard.ListServicesReq]) (*connect.Response[netguard.ListServicesResp], error)
 	// GetService - gets a specific service by ID
@@ -126,6 +239,8 @@ type NetguardServiceClient interface {
 	ListAddressGroups(context.Context, *connect.Request[netguard.ListAddressGroupsReq]) (*connect.Response[netguard.ListAddressGroupsResp], error)
 	// GetAddressGroup - gets a specific address group by ID
 	GetAddressGroup(context.Context, *connect.Request[netguard.GetAddressGroupReq]) (*connect.Response[netguard.GetAddressGroupResp], error)
+	// AnalyzeAddressGroupImpact - analyzes impact of an address group change
+	AnalyzeAddressGroupImpact(context.Context, *connect.Request[netguard.AnalyzeAddressGroupImpactReq]) (*connect.Response[netguard.AnalyzeAddressGroupImpactResp], error)
 	// ListAddressGroupBindings - gets list of address group bindings
 	ListAddressGroupBindings(context.Context, *connect.Request[netguard.ListAddressGroupBindingsReq]) (*connect.Response[netguard.ListAddressGroupBindingsResp], error)
 	// GetAddressGroupBinding - gets a specific address group binding by ID
@@ -150,6 +265,10 @@ type NetguardServiceClient interface {
 	ListIEAgAgRules(context.Context, *connect.Request[netguard.ListIEAgAgRulesReq]) (*connect.Response[netguard.ListIEAgAgRulesResp], error)
 	// GetIEAgAgRule - gets a specific IEAgAgRule by ID
 	GetIEAgAgRule(context.Context, *connect.Request[netguard.GetIEAgAgRuleReq]) (*connect.Response[netguard.GetIEAgAgRuleResp], error)
+	// ListIEAgAgRuleContributions - explains which RuleS2S contribute which ports to IEAgAgRules
+	ListIEAgAgRuleContributions(context.Context, *connect.Request[netguard.ListIEAgAgRuleContributionsReq]) (*connect.Response[netguard.ListIEAgAgRuleContributionsResp], error)
+	// ListConditionTransitions - lists when and why conditions of resources changed their status
+	ListConditionTransitions(context.Context, *connect.Request[netguard.ListConditionTransitionsReq]) (*connect.Response[netguard.ListConditionTransitionsResp], error)
 	// ListNetworks - gets list of networks
 	ListNetworks(context.Context, *connect.Request[netguard.ListNetworksReq]) (*connect.Response[netguard.ListNetworksResp], error)
 	// GetNetwork - gets a specific network by ID
@@ -166,6 +285,26 @@ type NetguardServiceClient interface {
 	ListHostBindings(context.Context, *connect.Request[netguard.ListHostBindingsReq]) (*connect.Response[netguard.ListHostBindingsResp], error)
 	// GetHostBinding - gets a specific host binding by ID
 	GetHostBinding(context.Context, *connect.Request[netguard.GetHostBindingReq]) (*connect.Response[netguard.GetHostBindingResp], error)
+	// ListRuleS2SExceptions - gets list of RuleS2S exceptions
+	ListRuleS2SExceptions(context.Context, *connect.Request[netguard.ListRuleS2SExceptionsReq]) (*connect.Response[netguard.ListRuleS2SExceptionsResp], error)
+	// GetRuleS2SException - gets a specific RuleS2S exception by ID
+	GetRuleS2SException(context.Context, *connect.Request[netguard.GetRuleS2SExceptionReq]) (*connect.Response[netguard.GetRuleS2SExceptionResp], error)
+	// ListCrossNamespacePolicies - gets list of cross namespace policies
+	ListCrossNamespacePolicies(context.Context, *connect.Request[netguard.ListCrossNamespacePoliciesReq]) (*connect.Response[netguard.ListCrossNamespacePoliciesResp], error)
+	// GetCrossNamespacePolicy - gets a specific cross namespace policy by ID
+	GetCrossNamespacePolicy(context.Context, *connect.Request[netguard.GetCrossNamespacePolicyReq]) (*connect.Response[netguard.GetCrossNamespacePolicyResp], error)
+	// ListRuleTemplates - gets list of rule templates
+	ListRuleTemplates(context.Context, *connect.Request[netguard.ListRuleTemplatesReq]) (*connect.Response[netguard.ListRuleTemplatesResp], error)
+	// GetRuleTemplate - gets a specific rule template by ID
+	GetRuleTemplate(context.Context, *connect.Request[netguard.GetRuleTemplateReq]) (*connect.Response[netguard.GetRuleTemplateResp], error)
+	// ListNamespacePostures - gets list of namespace postures
+	ListNamespacePostures(context.Context, *connect.Request[netguard.ListNamespacePosturesReq]) (*connect.Response[netguard.ListNamespacePosturesResp], error)
+	// GetNamespacePosture - gets a specific namespace posture by ID
+	GetNamespacePosture(context.Context, *connect.Request[netguard.GetNamespacePostureReq]) (*connect.Response[netguard.GetNamespacePostureResp], error)
+	// SimulateTraffic - checks whether a connection would be allowed by the stored IEAgAg rules
+	SimulateTraffic(context.Context, *connect.Request[netguard.SimulateTrafficReq]) (*connect.Response[netguard.SimulateTrafficResp], error)
+	// Watch - streams resource change events
+	Watch(context.Context, *connect.Request[netguard.WatchReq]) (*connect.ServerStreamForClient[netguard.WatchEvent], error)
 }
 
 // NewNetguardServiceClient constructs a client for the netguard.v1.NetguardService service. By
@@ -191,6 +330,96 @@ func NewNetguardServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(netguardServiceMethods.ByName("SyncStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		getDetailedSyncStatus: connect.NewClient[netguard.GetDetailedSyncStatusReq, netguard.GetDetailedSyncStatusResp](
+			httpClient,
+			baseURL+NetguardServiceGetDetailedSyncStatusProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetDetailedSyncStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		getReverseSyncStatus: connect.NewClient[emptypb.Empty, netguard.GetReverseSyncStatusResp](
+			httpClient,
+			baseURL+NetguardServiceGetReverseSyncStatusProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetReverseSyncStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseReverseSync: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+NetguardServicePauseReverseSyncProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("PauseReverseSync")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeReverseSync: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+NetguardServiceResumeReverseSyncProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ResumeReverseSync")),
+			connect.WithClientOptions(opts...),
+		),
+		triggerReverseSync: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+NetguardServiceTriggerReverseSyncProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("TriggerReverseSync")),
+			connect.WithClientOptions(opts...),
+		),
+		listFailedSyncs: connect.NewClient[netguard.ListFailedSyncsReq, netguard.ListFailedSyncsResp](
+			httpClient,
+			baseURL+NetguardServiceListFailedSyncsProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListFailedSyncs")),
+			connect.WithClientOptions(opts...),
+		),
+		retryFailedSync: connect.NewClient[netguard.RetryFailedSyncReq, emptypb.Empty](
+			httpClient,
+			baseURL+NetguardServiceRetryFailedSyncProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("RetryFailedSync")),
+			connect.WithClientOptions(opts...),
+		),
+		listQuarantinedResources: connect.NewClient[netguard.ListQuarantinedResourcesReq, netguard.ListQuarantinedResourcesResp](
+			httpClient,
+			baseURL+NetguardServiceListQuarantinedResourcesProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListQuarantinedResources")),
+			connect.WithClientOptions(opts...),
+		),
+		promoteQuarantinedResource: connect.NewClient[netguard.PromoteQuarantinedResourceReq, emptypb.Empty](
+			httpClient,
+			baseURL+NetguardServicePromoteQuarantinedResourceProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("PromoteQuarantinedResource")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteQuarantinedResource: connect.NewClient[netguard.DeleteQuarantinedResourceReq, emptypb.Empty](
+			httpClient,
+			baseURL+NetguardServiceDeleteQuarantinedResourceProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("DeleteQuarantinedResource")),
+			connect.WithClientOptions(opts...),
+		),
+		getStartupReport: connect.NewClient[emptypb.Empty, netguard.GetStartupReportResp](
+			httpClient,
+			baseURL+NetguardServiceGetStartupReportProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetStartupReport")),
+			connect.WithClientOptions(opts...),
+		),
+		getSchemaStatus: connect.NewClient[emptypb.Empty, netguard.GetSchemaStatusResp](
+			httpClient,
+			baseURL+NetguardServiceGetSchemaStatusProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetSchemaStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		listSyncers: connect.NewClient[emptypb.Empty, netguard.ListSyncersResp](
+			httpClient,
+			baseURL+NetguardServiceListSyncersProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListSyncers")),
+			connect.WithClientOptions(opts...),
+		),
+		setSyncerEnabled: connect.NewClient[netguard.SetSyncerEnabledReq, emptypb.Empty](
+			httpClient,
+			baseURL+NetguardServiceSetSyncerEnabledProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("SetSyncerEnabled")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeNamespace: connect.NewClient[netguard.PurgeNamespaceReq, netguard.PurgeNamespaceResp](
+			httpClient,
+			baseURL+NetguardServicePurgeNamespaceProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("PurgeNamespace")),
+			connect.WithClientOptions(opts...),
+		),
 		listServices: connect.NewClient[netguard.ListServicesReq, netguard.ListServicesResp](
 			httpClient,
 			baseURL+NetguardServiceListServicesProcedure,
@@ -215,6 +444,12 @@ func NewNetguardServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(netguardServiceMethods.ByName("GetAddressGroup")),
 			connect.WithClientOptions(opts...),
 		),
+		analyzeAddressGroupImpact: connect.NewClient[netguard.AnalyzeAddressGroupImpactReq, netguard.AnalyzeAddressGroupImpactResp](
+			httpClient,
+			baseURL+NetguardServiceAnalyzeAddressGroupImpactProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("AnalyzeAddressGroupImpact")),
+			connect.WithClientOptions(opts...),
+		),
 		listAddressGroupBindings: connect.NewClient[netguard.ListAddressGroupBindingsReq, netguard.ListAddressGroupBindingsResp](
 			httpClient,
 			baseURL+NetguardServiceListAddressGroupBindingsProcedure,
@@ -287,6 +522,18 @@ func NewNetguardServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(netguardServiceMethods.ByName("GetIEAgAgRule")),
 			connect.WithClientOptions(opts...),
 		),
+		listIEAgAgRuleContributions: connect.NewClient[netguard.ListIEAgAgRuleContributionsReq, netguard.ListIEAgAgRuleContributionsResp](
+			httpClient,
+			baseURL+NetguardServiceListIEAgAgRuleContributionsProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListIEAgAgRuleContributions")),
+			connect.WithClientOptions(opts...),
+		),
+		listConditionTransitions: connect.NewClient[netguard.ListConditionTransitionsReq, netguard.ListConditionTransitionsResp](
+			httpClient,
+			baseURL+NetguardServiceListConditionTransitionsProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListConditionTransitions")),
+			connect.WithClientOptions(opts...),
+		),
 		listNetworks: connect.NewClient[netguard.ListNetworksReq, netguard.ListNetworksResp](
 			httpClient,
 			baseURL+NetguardServiceListNetworksProcedure,
@@ -335,6 +582,66 @@ func NewNetguardServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(netguardServiceMethods.ByName("GetHostBinding")),
 			connect.WithClientOptions(opts...),
 		),
+		listRuleS2SExceptions: connect.NewClient[netguard.ListRuleS2SExceptionsReq, netguard.ListRuleS2SExceptionsResp](
+			httpClient,
+			baseURL+NetguardServiceListRuleS2SExceptionsProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListRuleS2SExceptions")),
+			connect.WithClientOptions(opts...),
+		),
+		getRuleS2SException: connect.NewClient[netguard.GetRuleS2SExceptionReq, netguard.GetRuleS2SExceptionResp](
+			httpClient,
+			baseURL+NetguardServiceGetRuleS2SExceptionProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetRuleS2SException")),
+			connect.WithClientOptions(opts...),
+		),
+		listCrossNamespacePolicies: connect.NewClient[netguard.ListCrossNamespacePoliciesReq, netguard.ListCrossNamespacePoliciesResp](
+			httpClient,
+			baseURL+NetguardServiceListCrossNamespacePoliciesProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListCrossNamespacePolicies")),
+			connect.WithClientOptions(opts...),
+		),
+		getCrossNamespacePolicy: connect.NewClient[netguard.GetCrossNamespacePolicyReq, netguard.GetCrossNamespacePolicyResp](
+			httpClient,
+			baseURL+NetguardServiceGetCrossNamespacePolicyProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetCrossNamespacePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listRuleTemplates: connect.NewClient[netguard.ListRuleTemplatesReq, netguard.ListRuleTemplatesResp](
+			httpClient,
+			baseURL+NetguardServiceListRuleTemplatesProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListRuleTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		getRuleTemplate: connect.NewClient[netguard.GetRuleTemplateReq, netguard.GetRuleTemplateResp](
+			httpClient,
+			baseURL+NetguardServiceGetRuleTemplateProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetRuleTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		listNamespacePostures: connect.NewClient[netguard.ListNamespacePosturesReq, netguard.ListNamespacePosturesResp](
+			httpClient,
+			baseURL+NetguardServiceListNamespacePosturesProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("ListNamespacePostures")),
+			connect.WithClientOptions(opts...),
+		),
+		getNamespacePosture: connect.NewClient[netguard.GetNamespacePostureReq, netguard.GetNamespacePostureResp](
+			httpClient,
+			baseURL+NetguardServiceGetNamespacePostureProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("GetNamespacePosture")),
+			connect.WithClientOptions(opts...),
+		),
+		simulateTraffic: connect.NewClient[netguard.SimulateTrafficReq, netguard.SimulateTrafficResp](
+			httpClient,
+			baseURL+NetguardServiceSimulateTrafficProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("SimulateTraffic")),
+			connect.WithClientOptions(opts...),
+		),
+		watch: connect.NewClient[netguard.WatchReq, netguard.WatchEvent](
+			httpClient,
+			baseURL+NetguardServiceWatchProcedure,
+			connect.WithSchema(netguardServiceMethods.ByName("Watch")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -342,10 +649,26 @@ func NewNetguardServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 type netguardServiceClient struct {
 	sync                            *connect.Client[netguard.SyncReq, emptypb.Empty]
 	syncStatus                      *connect.Client[emptypb.Empty, netguard.SyncStatusResp]
+	getDetailedSyncStatus           *connect.Client[netguard.GetDetailedSyncStatusReq, netguard.GetDetailedSyncStatusResp]
+	getReverseSyncStatus            *connect.Client[emptypb.Empty, netguard.GetReverseSyncStatusResp]
+	pauseReverseSync                *connect.Client[emptypb.Empty, emptypb.Empty]
+	resumeReverseSync               *connect.Client[emptypb.Empty, emptypb.Empty]
+	triggerReverseSync              *connect.Client[emptypb.Empty, emptypb.Empty]
+	listFailedSyncs                 *connect.Client[netguard.ListFailedSyncsReq, netguard.ListFailedSyncsResp]
+	retryFailedSync                 *connect.Client[netguard.RetryFailedSyncReq, emptypb.Empty]
+	listQuarantinedResources        *connect.Client[netguard.ListQuarantinedResourcesReq, netguard.ListQuarantinedResourcesResp]
+	promoteQuarantinedResource      *connect.Client[netguard.PromoteQuarantinedResourceReq, emptypb.Empty]
+	deleteQuarantinedResource       *connect.Client[netguard.DeleteQuarantinedResourceReq, emptypb.Empty]
+	getStartupReport                *connect.Client[emptypb.Empty, netguard.GetStartupReportResp]
+	getSchemaStatus                 *connect.Client[emptypb.Empty, netguard.GetSchemaStatusResp]
+	listSyncers                     *connect.Client[emptypb.Empty, netguard.ListSyncersResp]
+	setSyncerEnabled                *connect.Client[netguard.SetSyncerEnabledReq, emptypb.Empty]
+	purgeNamespace                  *connect.Client[netguard.PurgeNamespaceReq, netguard.PurgeNamespaceResp]
 	listServices                    *connect.Client[netguard.ListServicesReq, netguard.ListServicesResp]
 	getService                      *connect.Client[netguard.GetServiceReq, netguard.GetServiceResp]
 	listAddressGroups               *connect.Client[netguard.ListAddressGroupsReq, netguard.ListAddressGroupsResp]
 	getAddressGroup                 *connect.Client[netguard.GetAddressGroupReq, netguard.GetAddressGroupResp]
+	analyzeAddressGroupImpact       *connect.Client[netguard.AnalyzeAddressGroupImpactReq, netguard.AnalyzeAddressGroupImpactResp]
 	listAddressGroupBindings        *connect.Client[netguard.ListAddressGroupBindingsReq, netguard.ListAddressGroupBindingsResp]
 	getAddressGroupBinding          *connect.Client[netguard.GetAddressGroupBindingReq, netguard.GetAddressGroupBindingResp]
 	listAddressGroupPortMappings    *connect.Client[netguard.ListAddressGroupPortMappingsReq, netguard.ListAddressGroupPortMappingsResp]
@@ -358,6 +681,8 @@ type netguardServiceClient struct {
 	getAddressGroupBindingPolicy    *connect.Client[netguard.GetAddressGroupBindingPolicyReq, netguard.GetAddressGroupBindingPolicyResp]
 	listIEAgAgRules                 *connect.Client[netguard.ListIEAgAgRulesReq, netguard.ListIEAgAgRulesResp]
 	getIEAgAgRule                   *connect.Client[netguard.GetIEAgAgRuleReq, netguard.GetIEAgAgRuleResp]
+	listIEAgAgRuleContributions     *connect.Client[netguard.ListIEAgAgRuleContributionsReq, netguard.ListIEAgAgRuleContributionsResp]
+	listConditionTransitions        *connect.Client[netguard.ListConditionTransitionsReq, netguard.ListConditionTransitionsResp]
 	listNetworks                    *connect.Client[netguard.ListNetworksReq, netguard.ListNetworksResp]
 	getNetwork                      *connect.Client[netguard.GetNetworkReq, netguard.GetNetworkResp]
 	listNetworkBindings             *connect.Client[netguard.ListNetworkBindingsReq, netguard.ListNetworkBindingsResp]
@@ -366,6 +691,16 @@ type netguardServiceClient struct {
 	getHost                         *connect.Client[netguard.GetHostReq, netguard.GetHostResp]
 	listHostBindings                *connect.Client[netguard.ListHostBindingsReq, netguard.ListHostBindingsResp]
 	getHostBinding                  *connect.Client[netguard.GetHostBindingReq, netguard.GetHostBindingResp]
+	listRuleS2SExceptions           *connect.Client[netguard.ListRuleS2SExceptionsReq, netguard.ListRuleS2SExceptionsResp]
+	getRuleS2SException             *connect.Client[netguard.GetRuleS2SExceptionReq, netguard.GetRuleS2SExceptionResp]
+	listCrossNamespacePolicies      *connect.Client[netguard.ListCrossNamespacePoliciesReq, netguard.ListCrossNamespacePoliciesResp]
+	getCrossNamespacePolicy         *connect.Client[netguard.GetCrossNamespacePolicyReq, netguard.GetCrossNamespacePolicyResp]
+	listRuleTemplates               *connect.Client[netguard.ListRuleTemplatesReq, netguard.ListRuleTemplatesResp]
+	getRuleTemplate                 *connect.Client[netguard.GetRuleTemplateReq, netguard.GetRuleTemplateResp]
+	listNamespacePostures           *connect.Client[netguard.ListNamespacePosturesReq, netguard.ListNamespacePosturesResp]
+	getNamespacePosture             *connect.Client[netguard.GetNamespacePostureReq, netguard.GetNamespacePostureResp]
+	simulateTraffic                 *connect.Client[netguard.SimulateTrafficReq, netguard.SimulateTrafficResp]
+	watch                           *connect.Client[netguard.WatchReq, netguard.WatchEvent]
 }
 
 // Sync calls netguard.v1.NetguardService.Sync.
@@ -378,6 +713,81 @@ func (c *netguardServiceClient) SyncStatus(ctx context.Context, req *connect.Req
 	return c.syncStatus.CallUnary(ctx, req)
 }
 
+// GetDetailedSyncStatus calls netguard.v1.NetguardService.GetDetailedSyncStatus.
+func (c *netguardServiceClient) GetDetailedSyncStatus(ctx context.Context, req *connect.Request[netguard.GetDetailedSyncStatusReq]) (*connect.Response[netguard.GetDetailedSyncStatusResp], error) {
+	return c.getDetailedSyncStatus.CallUnary(ctx, req)
+}
+
+// GetReverseSyncStatus calls netguard.v1.NetguardService.GetReverseSyncStatus.
+func (c *netguardServiceClient) GetReverseSyncStatus(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetReverseSyncStatusResp], error) {
+	return c.getReverseSyncStatus.CallUnary(ctx, req)
+}
+
+// PauseReverseSync calls netguard.v1.NetguardService.PauseReverseSync.
+func (c *netguardServiceClient) PauseReverseSync(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return c.pauseReverseSync.CallUnary(ctx, req)
+}
+
+// ResumeReverseSync calls netguard.v1.NetguardService.ResumeReverseSync.
+func (c *netguardServiceClient) ResumeReverseSync(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return c.resumeReverseSync.CallUnary(ctx, req)
+}
+
+// TriggerReverseSync calls netguard.v1.NetguardService.TriggerReverseSync.
+func (c *netguardServiceClient) TriggerReverseSync(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return c.triggerReverseSync.CallUnary(ctx, req)
+}
+
+// ListFailedSyncs calls netguard.v1.NetguardService.ListFailedSyncs.
+func (c *netguardServiceClient) ListFailedSyncs(ctx context.Context, req *connect.Request[netguard.ListFailedSyncsReq]) (*connect.Response[netguard.ListFailedSyncsResp], error) {
+	return c.listFailedSyncs.CallUnary(ctx, req)
+}
+
+// RetryFailedSync calls netguard.v1.NetguardService.RetryFailedSync.
+func (c *netguardServiceClient) RetryFailedSync(ctx context.Context, req *connect.Request[netguard.RetryFailedSyncReq]) (*connect.Response[emptypb.Empty], error) {
+	return c.retryFailedSync.CallUnary(ctx, req)
+}
+
+// ListQuarantinedResources calls netguard.v1.NetguardService.ListQuarantinedResources.
+func (c *netguardServiceClient) ListQuarantinedResources(ctx context.Context, req *connect.Request[netguard.ListQuarantinedResourcesReq]) (*connect.Response[netguard.ListQuarantinedResourcesResp], error) {
+	return c.listQuarantinedResources.CallUnary(ctx, req)
+}
+
+// PromoteQuarantinedResource calls netguard.v1.NetguardService.PromoteQuarantinedResource.
+func (c *netguardServiceClient) PromoteQuarantinedResource(ctx context.Context, req *connect.Request[netguard.PromoteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error) {
+	return c.promoteQuarantinedResource.CallUnary(ctx, req)
+}
+
+// DeleteQuarantinedResource calls netguard.v1.NetguardService.DeleteQuarantinedResource.
+func (c *netguardServiceClient) DeleteQuarantinedResource(ctx context.Context, req *connect.Request[netguard.DeleteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteQuarantinedResource.CallUnary(ctx, req)
+}
+
+// GetStartupReport calls netguard.v1.NetguardService.GetStartupReport.
+func (c *netguardServiceClient) GetStartupReport(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetStartupReportResp], error) {
+	return c.getStartupReport.CallUnary(ctx, req)
+}
+
+// GetSchemaStatus calls netguard.v1.NetguardService.GetSchemaStatus.
+func (c *netguardServiceClient) GetSchemaStatus(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetSchemaStatusResp], error) {
+	return c.getSchemaStatus.CallUnary(ctx, req)
+}
+
+// ListSyncers calls netguard.v1.NetguardService.ListSyncers.
+func (c *netguardServiceClient) ListSyncers(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[netguard.ListSyncersResp], error) {
+	return c.listSyncers.CallUnary(ctx, req)
+}
+
+// SetSyncerEnabled calls netguard.v1.NetguardService.SetSyncerEnabled.
+func (c *netguardServiceClient) SetSyncerEnabled(ctx context.Context, req *connect.Request[netguard.SetSyncerEnabledReq]) (*connect.Response[emptypb.Empty], error) {
+	return c.setSyncerEnabled.CallUnary(ctx, req)
+}
+
+// PurgeNamespace calls netguard.v1.NetguardService.PurgeNamespace.
+func (c *netguardServiceClient) PurgeNamespace(ctx context.Context, req *connect.Request[netguard.PurgeNamespaceReq]) (*connect.Response[netguard.PurgeNamespaceResp], error) {
+	return c.purgeNamespace.CallUnary(ctx, req)
+}
+
 // ListServices calls netguard.v1.NetguardService.ListServices.
 func (c *netguardServiceClient) ListServices(ctx context.Context, req *connect.Request[netguard.ListServicesReq]) (*connect.Response[netguard.ListServicesResp], error) {
 	return c.listServices.CallUnary(ctx, req)
@@ -398,6 +808,11 @@ func (c *netguardServiceClient) GetAddressGroup(ctx context.Context, req *connec
 	return c.getAddressGroup.CallUnary(ctx, req)
 }
 
+// AnalyzeAddressGroupImpact calls netguard.v1.NetguardService.AnalyzeAddressGroupImpact.
+func (c *netguardServiceClient) AnalyzeAddressGroupImpact(ctx context.Context, req *connect.Request[netguard.AnalyzeAddressGroupImpactReq]) (*connect.Response[netguard.AnalyzeAddressGroupImpactResp], error) {
+	return c.analyzeAddressGroupImpact.CallUnary(ctx, req)
+}
+
 // ListAddressGroupBindings calls netguard.v1.NetguardService.ListAddressGroupBindings.
 func (c *netguardServiceClient) ListAddressGroupBindings(ctx context.Context, req *connect.Request[netguard.ListAddressGroupBindingsReq]) (*connect.Response[netguard.ListAddressGroupBindingsResp], error) {
 	return c.listAddressGroupBindings.CallUnary(ctx, req)
@@ -459,6 +874,16 @@ func (c *netguardServiceClient) GetIEAgAgRule(ctx context.Context, req *connect.
 	return c.getIEAgAgRule.CallUnary(ctx, req)
 }
 
+// ListIEAgAgRuleContributions calls netguard.v1.NetguardService.ListIEAgAgRuleContributions.
+func (c *netguardServiceClient) ListIEAgAgRuleContributions(ctx context.Context, req *connect.Request[netguard.ListIEAgAgRuleContributionsReq]) (*connect.Response[netguard.ListIEAgAgRuleContributionsResp], error) {
+	return c.listIEAgAgRuleContributions.CallUnary(ctx, req)
+}
+
+// ListConditionTransitions calls netguard.v1.NetguardService.ListConditionTransitions.
+func (c *netguardServiceClient) ListConditionTransitions(ctx context.Context, req *connect.Request[netguard.ListConditionTransitionsReq]) (*connect.Response[netguard.ListConditionTransitionsResp], error) {
+	return c.listConditionTransitions.CallUnary(ctx, req)
+}
+
 // ListNetworks calls netguard.v1.NetguardService.ListNetworks.
 func (c *netguardServiceClient) ListNetworks(ctx context.Context, req *connect.Request[netguard.ListNetworksReq]) (*connect.Response[netguard.ListNetworksResp], error) {
 	return c.listNetworks.CallUnary(ctx, req)
@@ -499,12 +924,92 @@ func (c *netguardServiceClient) GetHostBinding(ctx context.Context, req *connect
 	return c.getHostBinding.CallUnary(ctx, req)
 }
 
+// ListRuleS2SExceptions calls netguard.v1.NetguardService.ListRuleS2SExceptions.
+func (c *netguardServiceClient) ListRuleS2SExceptions(ctx context.Context, req *connect.Request[netguard.ListRuleS2SExceptionsReq]) (*connect.Response[netguard.ListRuleS2SExceptionsResp], error) {
+	return c.listRuleS2SExceptions.CallUnary(ctx, req)
+}
+
+// GetRuleS2SException calls netguard.v1.NetguardService.GetRuleS2SException.
+func (c *netguardServiceClient) GetRuleS2SException(ctx context.Context, req *connect.Request[netguard.GetRuleS2SExceptionReq]) (*connect.Response[netguard.GetRuleS2SExceptionResp], error) {
+	return c.getRuleS2SException.CallUnary(ctx, req)
+}
+
+// ListCrossNamespacePolicies calls netguard.v1.NetguardService.ListCrossNamespacePolicies.
+func (c *netguardServiceClient) ListCrossNamespacePolicies(ctx context.Context, req *connect.Request[netguard.ListCrossNamespacePoliciesReq]) (*connect.Response[netguard.ListCrossNamespacePoliciesResp], error) {
+	return c.listCrossNamespacePolicies.CallUnary(ctx, req)
+}
+
+// GetCrossNamespacePolicy calls netguard.v1.NetguardService.GetCrossNamespacePolicy.
+func (c *netguardServiceClient) GetCrossNamespacePolicy(ctx context.Context, req *connect.Request[netguard.GetCrossNamespacePolicyReq]) (*connect.Response[netguard.GetCrossNamespacePolicyResp], error) {
+	return c.getCrossNamespacePolicy.CallUnary(ctx, req)
+}
+
+// ListRuleTemplates calls netguard.v1.NetguardService.ListRuleTemplates.
+func (c *netguardServiceClient) ListRuleTemplates(ctx context.Context, req *connect.Request[netguard.ListRuleTemplatesReq]) (*connect.Response[netguard.ListRuleTemplatesResp], error) {
+	return c.listRuleTemplates.CallUnary(ctx, req)
+}
+
+// GetRuleTemplate calls netguard.v1.NetguardService.GetRuleTemplate.
+func (c *netguardServiceClient) GetRuleTemplate(ctx context.Context, req *connect.Request[netguard.GetRuleTemplateReq]) (*connect.Response[netguard.GetRuleTemplateResp], error) {
+	return c.getRuleTemplate.CallUnary(ctx, req)
+}
+
+// ListNamespacePostures calls netguard.v1.NetguardService.ListNamespacePostures.
+func (c *netguardServiceClient) ListNamespacePostures(ctx context.Context, req *connect.Request[netguard.ListNamespacePosturesReq]) (*connect.Response[netguard.ListNamespacePosturesResp], error) {
+	return c.listNamespacePostures.CallUnary(ctx, req)
+}
+
+// GetNamespacePosture calls netguard.v1.NetguardService.GetNamespacePosture.
+func (c *netguardServiceClient) GetNamespacePosture(ctx context.Context, req *connect.Request[netguard.GetNamespacePostureReq]) (*connect.Response[netguard.GetNamespacePostureResp], error) {
+	return c.getNamespacePosture.CallUnary(ctx, req)
+}
+
+// SimulateTraffic calls netguard.v1.NetguardService.SimulateTraffic.
+func (c *netguardServiceClient) SimulateTraffic(ctx context.Context, req *connect.Request[netguard.SimulateTrafficReq]) (*connect.Response[netguard.SimulateTrafficResp], error) {
+	return c.simulateTraffic.CallUnary(ctx, req)
+}
+
+// Watch calls netguard.v1.NetguardService.Watch.
+func (c *netguardServiceClient) Watch(ctx context.Context, req *connect.Request[netguard.WatchReq]) (*connect.ServerStreamForClient[netguard.WatchEvent], error) {
+	return c.watch.CallServerStream(ctx, req)
+}
+
 // NetguardServiceHandler is an implementation of the netguard.v1.NetguardService service.
 type NetguardServiceHandler interface {
 	// Sync - syncs data in DB
 	Sync(context.Context, *connect.Request[netguard.SyncReq]) (*connect.Response[emptypb.Empty], error)
 	// SyncStatus - gets last succeeded update DB status
 	SyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.SyncStatusResp], error)
+	// GetDetailedSyncStatus - gets sync status per resource kind and per resource
+	GetDetailedSyncStatus(context.Context, *connect.Request[netguard.GetDetailedSyncStatusReq]) (*connect.Response[netguard.GetDetailedSyncStatusResp], error)
+	// GetReverseSyncStatus - gets statistics of the reverse synchronization from sgroups
+	GetReverseSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetReverseSyncStatusResp], error)
+	// PauseReverseSync - pauses synchronization of changes from sgroups
+	PauseReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// ResumeReverseSync - resumes synchronization of changes from sgroups
+	ResumeReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// TriggerReverseSync - runs a reverse sync cycle immediately
+	TriggerReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// ListFailedSyncs - gets sgroups syncs moved to the dead-letter queue
+	ListFailedSyncs(context.Context, *connect.Request[netguard.ListFailedSyncsReq]) (*connect.Response[netguard.ListFailedSyncsResp], error)
+	// RetryFailedSync - syncs the resource of a dead-lettered entry again
+	RetryFailedSync(context.Context, *connect.Request[netguard.RetryFailedSyncReq]) (*connect.Response[emptypb.Empty], error)
+	// ListQuarantinedResources - imported resources which failed validation
+	ListQuarantinedResources(context.Context, *connect.Request[netguard.ListQuarantinedResourcesReq]) (*connect.Response[netguard.ListQuarantinedResourcesResp], error)
+	// PromoteQuarantinedResource - import a quarantined resource again
+	PromoteQuarantinedResource(context.Context, *connect.Request[netguard.PromoteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error)
+	// DeleteQuarantinedResource - drop a quarantined resource
+	DeleteQuarantinedResource(context.Context, *connect.Request[netguard.DeleteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error)
+	// GetStartupReport - gets the report logged on startup
+	GetStartupReport(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetStartupReportResp], error)
+	// GetSchemaStatus - gets the applied and the supported schema version
+	GetSchemaStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetSchemaStatusResp], error)
+	// ListSyncers - gets sgroups syncers and whether they are enabled
+	ListSyncers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.ListSyncersResp], error)
+	// SetSyncerEnabled - enables or disables the syncers of a subject type
+	SetSyncerEnabled(context.Context, *connect.Request[netguard.SetSyncerEnabledReq]) (*connect.Response[emptypb.Empty], error)
+	// PurgeNamespace - deletes all resources of a namespace in one transaction
+	PurgeNamespace(context.Context, *connect.Request[netguard.PurgeNamespaceReq]) (*connect.Response[netguard.PurgeNamespaceResp], error)
 	// ListServices - gets list of services
 	ListServices(context.Context, *connect.Request[netguard.ListServicesReq]) (*connect.Response[netguard.ListServicesResp], error)
 	// GetService - gets a specific service by ID
@@ -513,6 +1018,8 @@ type NetguardServiceHandler interface {
 	ListAddressGroups(context.Context, *connect.Request[netguard.ListAddressGroupsReq]) (*connect.Response[netguard.ListAddressGroupsResp], error)
 	// GetAddressGroup - gets a specific address group by ID
 	GetAddressGroup(context.Context, *connect.Request[netguard.GetAddressGroupReq]) (*connect.Response[netguard.GetAddressGroupResp], error)
+	// AnalyzeAddressGroupImpact - analyzes impact of an address group change
+	AnalyzeAddressGroupImpact(context.Context, *connect.Request[netguard.AnalyzeAddressGroupImpactReq]) (*connect.Response[netguard.AnalyzeAddressGroupImpactResp], error)
 	// ListAddressGroupBindings - gets list of address group bindings
 	ListAddressGroupBindings(context.Context, *connect.Request[netguard.ListAddressGroupBindingsReq]) (*connect.Response[netguard.ListAddressGroupBindingsResp], error)
 	// GetAddressGroupBinding - gets a specific address group binding by ID
@@ -537,6 +1044,10 @@ type NetguardServiceHandler interface {
 	ListIEAgAgRules(context.Context, *connect.Request[netguard.ListIEAgAgRulesReq]) (*connect.Response[netguard.ListIEAgAgRulesResp], error)
 	// GetIEAgAgRule - gets a specific IEAgAgRule by ID
 	GetIEAgAgRule(context.Context, *connect.Request[netguard.GetIEAgAgRuleReq]) (*connect.Response[netguard.GetIEAgAgRuleResp], error)
+	// ListIEAgAgRuleContributions - explains which RuleS2S contribute which ports to IEAgAgRules
+	ListIEAgAgRuleContributions(context.Context, *connect.Request[netguard.ListIEAgAgRuleContributionsReq]) (*connect.Response[netguard.ListIEAgAgRuleContributionsResp], error)
+	// ListConditionTransitions - lists when and why conditions of resources changed their status
+	ListConditionTransitions(context.Context, *connect.Request[netguard.ListConditionTransitionsReq]) (*connect.Response[netguard.ListConditionTransitionsResp], error)
 	// ListNetworks - gets list of networks
 	ListNetworks(context.Context, *connect.Request[netguard.ListNetworksReq]) (*connect.Response[netguard.ListNetworksResp], error)
 	// GetNetwork - gets a specific network by ID
@@ -553,6 +1064,26 @@ type NetguardServiceHandler interface {
 	ListHostBindings(context.Context, *connect.Request[netguard.ListHostBindingsReq]) (*connect.Response[netguard.ListHostBindingsResp], error)
 	// GetHostBinding - gets a specific host binding by ID
 	GetHostBinding(context.Context, *connect.Request[netguard.GetHostBindingReq]) (*connect.Response[netguard.GetHostBindingResp], error)
+	// ListRuleS2SExceptions - gets list of RuleS2S exceptions
+	ListRuleS2SExceptions(context.Context, *connect.Request[netguard.ListRuleS2SExceptionsReq]) (*connect.Response[netguard.ListRuleS2SExceptionsResp], error)
+	// GetRuleS2SException - gets a specific RuleS2S exception by ID
+	GetRuleS2SException(context.Context, *connect.Request[netguard.GetRuleS2SExceptionReq]) (*connect.Response[netguard.GetRuleS2SExceptionResp], error)
+	// ListCrossNamespacePolicies - gets list of cross namespace policies
+	ListCrossNamespacePolicies(context.Context, *connect.Request[netguard.ListCrossNamespacePoliciesReq]) (*connect.Response[netguard.ListCrossNamespacePoliciesResp], error)
+	// GetCrossNamespacePolicy - gets a specific cross namespace policy by ID
+	GetCrossNamespacePolicy(context.Context, *connect.Request[netguard.GetCrossNamespacePolicyReq]) (*connect.Response[netguard.GetCrossNamespacePolicyResp], error)
+	// ListRuleTemplates - gets list of rule templates
+	ListRuleTemplates(context.Context, *connect.Request[netguard.ListRuleTemplatesReq]) (*connect.Response[netguard.ListRuleTemplatesResp], error)
+	// GetRuleTemplate - gets a specific rule template by ID
+	GetRuleTemplate(context.Context, *connect.Request[netguard.GetRuleTemplateReq]) (*connect.Response[netguard.GetRuleTemplateResp], error)
+	// ListNamespacePostures - gets list of namespace postures
+	ListNamespacePostures(context.Context, *connect.Request[netguard.ListNamespacePosturesReq]) (*connect.Response[netguard.ListNamespacePosturesResp], error)
+	// GetNamespacePosture - gets a specific namespace posture by ID
+	GetNamespacePosture(context.Context, *connect.Request[netguard.GetNamespacePostureReq]) (*connect.Response[netguard.GetNamespacePostureResp], error)
+	// SimulateTraffic - checks whether a connection would be allowed by the stored IEAgAg rules
+	SimulateTraffic(context.Context, *connect.Request[netguard.SimulateTrafficReq]) (*connect.Response[netguard.SimulateTrafficResp], error)
+	// Watch - streams resource change events
+	Watch(context.Context, *connect.Request[netguard.WatchReq], *connect.ServerStream[netguard.WatchEvent]) error
 }
 
 // NewNetguardServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -574,6 +1105,96 @@ func NewNetguardServiceHandler(svc NetguardServiceHandler, opts ...connect.Handl
 		connect.WithSchema(netguardServiceMethods.ByName("SyncStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	netguardServiceGetDetailedSyncStatusHandler := connect.NewUnaryHandler(
+		NetguardServiceGetDetailedSyncStatusProcedure,
+		svc.GetDetailedSyncStatus,
+		connect.WithSchema(netguardServiceMethods.ByName("GetDetailedSyncStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceGetReverseSyncStatusHandler := connect.NewUnaryHandler(
+		NetguardServiceGetReverseSyncStatusProcedure,
+		svc.GetReverseSyncStatus,
+		connect.WithSchema(netguardServiceMethods.ByName("GetReverseSyncStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServicePauseReverseSyncHandler := connect.NewUnaryHandler(
+		NetguardServicePauseReverseSyncProcedure,
+		svc.PauseReverseSync,
+		connect.WithSchema(netguardServiceMethods.ByName("PauseReverseSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceResumeReverseSyncHandler := connect.NewUnaryHandler(
+		NetguardServiceResumeReverseSyncProcedure,
+		svc.ResumeReverseSync,
+		connect.WithSchema(netguardServiceMethods.ByName("ResumeReverseSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceTriggerReverseSyncHandler := connect.NewUnaryHandler(
+		NetguardServiceTriggerReverseSyncProcedure,
+		svc.TriggerReverseSync,
+		connect.WithSchema(netguardServiceMethods.ByName("TriggerReverseSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceListFailedSyncsHandler := connect.NewUnaryHandler(
+		NetguardServiceListFailedSyncsProcedure,
+		svc.ListFailedSyncs,
+		connect.WithSchema(netguardServiceMethods.ByName("ListFailedSyncs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceRetryFailedSyncHandler := connect.NewUnaryHandler(
+		NetguardServiceRetryFailedSyncProcedure,
+		svc.RetryFailedSync,
+		connect.WithSchema(netguardServiceMethods.ByName("RetryFailedSync")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceListQuarantinedResourcesHandler := connect.NewUnaryHandler(
+		NetguardServiceListQuarantinedResourcesProcedure,
+		svc.ListQuarantinedResources,
+		connect.WithSchema(netguardServiceMethods.ByName("ListQuarantinedResources")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServicePromoteQuarantinedResourceHandler := connect.NewUnaryHandler(
+		NetguardServicePromoteQuarantinedResourceProcedure,
+		svc.PromoteQuarantinedResource,
+		connect.WithSchema(netguardServiceMethods.ByName("PromoteQuarantinedResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceDeleteQuarantinedResourceHandler := connect.NewUnaryHandler(
+		NetguardServiceDeleteQuarantinedResourceProcedure,
+		svc.DeleteQuarantinedResource,
+		connect.WithSchema(netguardServiceMethods.ByName("DeleteQuarantinedResource")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceGetStartupReportHandler := connect.NewUnaryHandler(
+		NetguardServiceGetStartupReportProcedure,
+		svc.GetStartupReport,
+		connect.WithSchema(netguardServiceMethods.ByName("GetStartupReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceGetSchemaStatusHandler := connect.NewUnaryHandler(
+		NetguardServiceGetSchemaStatusProcedure,
+		svc.GetSchemaStatus,
+		connect.WithSchema(netguardServiceMethods.ByName("GetSchemaStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceListSyncersHandler := connect.NewUnaryHandler(
+		NetguardServiceListSyncersProcedure,
+		svc.ListSyncers,
+		connect.WithSchema(netguardServiceMethods.ByName("ListSyncers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceSetSyncerEnabledHandler := connect.NewUnaryHandler(
+		NetguardServiceSetSyncerEnabledProcedure,
+		svc.SetSyncerEnabled,
+		connect.WithSchema(netguardServiceMethods.ByName("SetSyncerEnabled")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServicePurgeNamespaceHandler := connect.NewUnaryHandler(
+		NetguardServicePurgeNamespaceProcedure,
+		svc.PurgeNamespace,
+		connect.WithSchema(netguardServiceMethods.ByName("PurgeNamespace")),
+		connect.WithHandlerOptions(opts...),
+	)
 	netguardServiceListServicesHandler := connect.NewUnaryHandler(
 		NetguardServiceListServicesProcedure,
 		svc.ListServices,
@@ -598,6 +1219,12 @@ func NewNetguardServiceHandler(svc NetguardServiceHandler, opts ...connect.Handl
 		connect.WithSchema(netguardServiceMethods.ByName("GetAddressGroup")),
 		connect.WithHandlerOptions(opts...),
 	)
+	netguardServiceAnalyzeAddressGroupImpactHandler := connect.NewUnaryHandler(
+		NetguardServiceAnalyzeAddressGroupImpactProcedure,
+		svc.AnalyzeAddressGroupImpact,
+		connect.WithSchema(netguardServiceMethods.ByName("AnalyzeAddressGroupImpact")),
+		connect.WithHandlerOptions(opts...),
+	)
 	netguardServiceListAddressGroupBindingsHandler := connect.NewUnaryHandler(
 		NetguardServiceListAddressGroupBindingsProcedure,
 		svc.ListAddressGroupBindings,
@@ -670,6 +1297,18 @@ func NewNetguardServiceHandler(svc NetguardServiceHandler, opts ...connect.Handl
 		connect.WithSchema(netguardServiceMethods.ByName("GetIEAgAgRule")),
 		connect.WithHandlerOptions(opts...),
 	)
+	netguardServiceListIEAgAgRuleContributionsHandler := connect.NewUnaryHandler(
+		NetguardServiceListIEAgAgRuleContributionsProcedure,
+		svc.ListIEAgAgRuleContributions,
+		connect.WithSchema(netguardServiceMethods.ByName("ListIEAgAgRuleContributions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceListConditionTransitionsHandler := connect.NewUnaryHandler(
+		NetguardServiceListConditionTransitionsProcedure,
+		svc.ListConditionTransitions,
+		connect.WithSchema(netguardServiceMethods.ByName("ListConditionTransitions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	netguardServiceListNetworksHandler := connect.NewUnaryHandler(
 		NetguardServiceListNetworksProcedure,
 		svc.ListNetworks,
@@ -718,12 +1357,102 @@ func NewNetguardServiceHandler(svc NetguardServiceHandler, opts ...connect.Handl
 		connect.WithSchema(netguardServiceMethods.ByName("GetHostBinding")),
 		connect.WithHandlerOptions(opts...),
 	)
+	netguardServiceListRuleS2SExceptionsHandler := connect.NewUnaryHandler(
+		NetguardServiceListRuleS2SExceptionsProcedure,
+		svc.ListRuleS2SExceptions,
+		connect.WithSchema(netguardServiceMethods.ByName("ListRuleS2SExceptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceGetRuleS2SExceptionHandler := connect.NewUnaryHandler(
+		NetguardServiceGetRuleS2SExceptionProcedure,
+		svc.GetRuleS2SException,
+		connect.WithSchema(netguardServiceMethods.ByName("GetRuleS2SException")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceListCrossNamespacePoliciesHandler := connect.NewUnaryHandler(
+		NetguardServiceListCrossNamespacePoliciesProcedure,
+		svc.ListCrossNamespacePolicies,
+		connect.WithSchema(netguardServiceMethods.ByName("ListCrossNamespacePolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceGetCrossNamespacePolicyHandler := connect.NewUnaryHandler(
+		NetguardServiceGetCrossNamespacePolicyProcedure,
+		svc.GetCrossNamespacePolicy,
+		connect.WithSchema(netguardServiceMethods.ByName("GetCrossNamespacePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceListRuleTemplatesHandler := connect.NewUnaryHandler(
+		NetguardServiceListRuleTemplatesProcedure,
+		svc.ListRuleTemplates,
+		connect.WithSchema(netguardServiceMethods.ByName("ListRuleTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceGetRuleTemplateHandler := connect.NewUnaryHandler(
+		NetguardServiceGetRuleTemplateProcedure,
+		svc.GetRuleTemplate,
+		connect.WithSchema(netguardServiceMethods.ByName("GetRuleTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceListNamespacePosturesHandler := connect.NewUnaryHandler(
+		NetguardServiceListNamespacePosturesProcedure,
+		svc.ListNamespacePostures,
+		connect.WithSchema(netguardServiceMethods.ByName("ListNamespacePostures")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceGetNamespacePostureHandler := connect.NewUnaryHandler(
+		NetguardServiceGetNamespacePostureProcedure,
+		svc.GetNamespacePosture,
+		connect.WithSchema(netguardServiceMethods.ByName("GetNamespacePosture")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceSimulateTrafficHandler := connect.NewUnaryHandler(
+		NetguardServiceSimulateTrafficProcedure,
+		svc.SimulateTraffic,
+		connect.WithSchema(netguardServiceMethods.ByName("SimulateTraffic")),
+		connect.WithHandlerOptions(opts...),
+	)
+	netguardServiceWatchHandler := connect.NewServerStreamHandler(
+		NetguardServiceWatchProcedure,
+		svc.Watch,
+		connect.WithSchema(netguardServiceMethods.ByName("Watch")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/netguard.v1.NetguardService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NetguardServiceSyncProcedure:
 			netguardServiceSyncHandler.ServeHTTP(w, r)
 		case NetguardServiceSyncStatusProcedure:
 			netguardServiceSyncStatusHandler.ServeHTTP(w, r)
+		case NetguardServiceGetDetailedSyncStatusProcedure:
+			netguardServiceGetDetailedSyncStatusHandler.ServeHTTP(w, r)
+		case NetguardServiceGetReverseSyncStatusProcedure:
+			netguardServiceGetReverseSyncStatusHandler.ServeHTTP(w, r)
+		case NetguardServicePauseReverseSyncProcedure:
+			netguardServicePauseReverseSyncHandler.ServeHTTP(w, r)
+		case NetguardServiceResumeReverseSyncProcedure:
+			netguardServiceResumeReverseSyncHandler.ServeHTTP(w, r)
+		case NetguardServiceTriggerReverseSyncProcedure:
+			netguardServiceTriggerReverseSyncHandler.ServeHTTP(w, r)
+		case NetguardServiceListFailedSyncsProcedure:
+			netguardServiceListFailedSyncsHandler.ServeHTTP(w, r)
+		case NetguardServiceRetryFailedSyncProcedure:
+			netguardServiceRetryFailedSyncHandler.ServeHTTP(w, r)
+		case NetguardServiceListQuarantinedResourcesProcedure:
+			netguardServiceListQuarantinedResourcesHandler.ServeHTTP(w, r)
+		case NetguardServicePromoteQuarantinedResourceProcedure:
+			netguardServicePromoteQuarantinedResourceHandler.ServeHTTP(w, r)
+		case NetguardServiceDeleteQuarantinedResourceProcedure:
+			netguardServiceDeleteQuarantinedResourceHandler.ServeHTTP(w, r)
+		case NetguardServiceGetStartupReportProcedure:
+			netguardServiceGetStartupReportHandler.ServeHTTP(w, r)
+		case NetguardServiceGetSchemaStatusProcedure:
+			netguardServiceGetSchemaStatusHandler.ServeHTTP(w, r)
+		case NetguardServiceListSyncersProcedure:
+			netguardServiceListSyncersHandler.ServeHTTP(w, r)
+		case NetguardServiceSetSyncerEnabledProcedure:
+			netguardServiceSetSyncerEnabledHandler.ServeHTTP(w, r)
+		case NetguardServicePurgeNamespaceProcedure:
+			netguardServicePurgeNamespaceHandler.ServeHTTP(w, r)
 		case NetguardServiceListServicesProcedure:
 			netguardServiceListServicesHandler.ServeHTTP(w, r)
 		case NetguardServiceGetServiceProcedure:
@@ -732,6 +1461,8 @@ func NewNetguardServiceHandler(svc NetguardServiceHandler, opts ...connect.Handl
 			netguardServiceListAddressGroupsHandler.ServeHTTP(w, r)
 		case NetguardServiceGetAddressGroupProcedure:
 			netguardServiceGetAddressGroupHandler.ServeHTTP(w, r)
+		case NetguardServiceAnalyzeAddressGroupImpactProcedure:
+			netguardServiceAnalyzeAddressGroupImpactHandler.ServeHTTP(w, r)
 		case NetguardServiceListAddressGroupBindingsProcedure:
 			netguardServiceListAddressGroupBindingsHandler.ServeHTTP(w, r)
 		case NetguardServiceGetAddressGroupBindingProcedure:
@@ -756,6 +1487,10 @@ func NewNetguardServiceHandler(svc NetguardServiceHandler, opts ...connect.Handl
 			netguardServiceListIEAgAgRulesHandler.ServeHTTP(w, r)
 		case NetguardServiceGetIEAgAgRuleProcedure:
 			netguardServiceGetIEAgAgRuleHandler.ServeHTTP(w, r)
+		case NetguardServiceListIEAgAgRuleContributionsProcedure:
+			netguardServiceListIEAgAgRuleContributionsHandler.ServeHTTP(w, r)
+		case NetguardServiceListConditionTransitionsProcedure:
+			netguardServiceListConditionTransitionsHandler.ServeHTTP(w, r)
 		case NetguardServiceListNetworksProcedure:
 			netguardServiceListNetworksHandler.ServeHTTP(w, r)
 		case NetguardServiceGetNetworkProcedure:
@@ -772,6 +1507,26 @@ func NewNetguardServiceHandler(svc NetguardServiceHandler, opts ...connect.Handl
 			netguardServiceListHostBindingsHandler.ServeHTTP(w, r)
 		case NetguardServiceGetHostBindingProcedure:
 			netguardServiceGetHostBindingHandler.ServeHTTP(w, r)
+		case NetguardServiceListRuleS2SExceptionsProcedure:
+			netguardServiceListRuleS2SExceptionsHandler.ServeHTTP(w, r)
+		case NetguardServiceGetRuleS2SExceptionProcedure:
+			netguardServiceGetRuleS2SExceptionHandler.ServeHTTP(w, r)
+		case NetguardServiceListCrossNamespacePoliciesProcedure:
+			netguardServiceListCrossNamespacePoliciesHandler.ServeHTTP(w, r)
+		case NetguardServiceGetCrossNamespacePolicyProcedure:
+			netguardServiceGetCrossNamespacePolicyHandler.ServeHTTP(w, r)
+		case NetguardServiceListRuleTemplatesProcedure:
+			netguardServiceListRuleTemplatesHandler.ServeHTTP(w, r)
+		case NetguardServiceGetRuleTemplateProcedure:
+			netguardServiceGetRuleTemplateHandler.ServeHTTP(w, r)
+		case NetguardServiceListNamespacePosturesProcedure:
+			netguardServiceListNamespacePosturesHandler.ServeHTTP(w, r)
+		case NetguardServiceGetNamespacePostureProcedure:
+			netguardServiceGetNamespacePostureHandler.ServeHTTP(w, r)
+		case NetguardServiceSimulateTrafficProcedure:
+			netguardServiceSimulateTrafficHandler.ServeHTTP(w, r)
+		case NetguardServiceWatchProcedure:
+			netguardServiceWatchHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -789,6 +1544,66 @@ func (UnimplementedNetguardServiceHandler) SyncStatus(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.SyncStatus is not implemented"))
 }
 
+func (UnimplementedNetguardServiceHandler) GetDetailedSyncStatus(context.Context, *connect.Request[netguard.GetDetailedSyncStatusReq]) (*connect.Response[netguard.GetDetailedSyncStatusResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetDetailedSyncStatus is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) GetReverseSyncStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetReverseSyncStatusResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetReverseSyncStatus is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) PauseReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.PauseReverseSync is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ResumeReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ResumeReverseSync is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) TriggerReverseSync(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.TriggerReverseSync is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ListFailedSyncs(context.Context, *connect.Request[netguard.ListFailedSyncsReq]) (*connect.Response[netguard.ListFailedSyncsResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListFailedSyncs is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) RetryFailedSync(context.Context, *connect.Request[netguard.RetryFailedSyncReq]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.RetryFailedSync is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ListQuarantinedResources(context.Context, *connect.Request[netguard.ListQuarantinedResourcesReq]) (*connect.Response[netguard.ListQuarantinedResourcesResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListQuarantinedResources is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) PromoteQuarantinedResource(context.Context, *connect.Request[netguard.PromoteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.PromoteQuarantinedResource is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) DeleteQuarantinedResource(context.Context, *connect.Request[netguard.DeleteQuarantinedResourceReq]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.DeleteQuarantinedResource is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) GetStartupReport(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetStartupReportResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetStartupReport is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) GetSchemaStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.GetSchemaStatusResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetSchemaStatus is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ListSyncers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[netguard.ListSyncersResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListSyncers is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) SetSyncerEnabled(context.Context, *connect.Request[netguard.SetSyncerEnabledReq]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.SetSyncerEnabled is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) PurgeNamespace(context.Context, *connect.Request[netguard.PurgeNamespaceReq]) (*connect.Response[netguard.PurgeNamespaceResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.PurgeNamespace is not implemented"))
+}
+
 func (UnimplementedNetguardServiceHandler) ListServices(context.Context, *connect.Request[netguard.ListServicesReq]) (*connect.Response[netguard.ListServicesResp], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListServices is not implemented"))
 }
@@ -805,6 +1620,10 @@ func (UnimplementedNetguardServiceHandler) GetAddressGroup(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetAddressGroup is not implemented"))
 }
 
+func (UnimplementedNetguardServiceHandler) AnalyzeAddressGroupImpact(context.Context, *connect.Request[netguard.AnalyzeAddressGroupImpactReq]) (*connect.Response[netguard.AnalyzeAddressGroupImpactResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.AnalyzeAddressGroupImpact is not implemented"))
+}
+
 func (UnimplementedNetguardServiceHandler) ListAddressGroupBindings(context.Context, *connect.Request[netguard.ListAddressGroupBindingsReq]) (*connect.Response[netguard.ListAddressGroupBindingsResp], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListAddressGroupBindings is not implemented"))
 }
@@ -853,6 +1672,14 @@ func (UnimplementedNetguardServiceHandler) GetIEAgAgRule(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetIEAgAgRule is not implemented"))
 }
 
+func (UnimplementedNetguardServiceHandler) ListIEAgAgRuleContributions(context.Context, *connect.Request[netguard.ListIEAgAgRuleContributionsReq]) (*connect.Response[netguard.ListIEAgAgRuleContributionsResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListIEAgAgRuleContributions is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ListConditionTransitions(context.Context, *connect.Request[netguard.ListConditionTransitionsReq]) (*connect.Response[netguard.ListConditionTransitionsResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListConditionTransitions is not implemented"))
+}
+
 func (UnimplementedNetguardServiceHandler) ListNetworks(context.Context, *connect.Request[netguard.ListNetworksReq]) (*connect.Response[netguard.ListNetworksResp], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListNetworks is not implemented"))
 }
@@ -884,3 +1711,43 @@ func (UnimplementedNetguardServiceHandler) ListHostBindings(context.Context, *co
 func (UnimplementedNetguardServiceHandler) GetHostBinding(context.Context, *connect.Request[netguard.GetHostBindingReq]) (*connect.Response[netguard.GetHostBindingResp], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetHostBinding is not implemented"))
 }
+
+func (UnimplementedNetguardServiceHandler) ListRuleS2SExceptions(context.Context, *connect.Request[netguard.ListRuleS2SExceptionsReq]) (*connect.Response[netguard.ListRuleS2SExceptionsResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListRuleS2SExceptions is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) GetRuleS2SException(context.Context, *connect.Request[netguard.GetRuleS2SExceptionReq]) (*connect.Response[netguard.GetRuleS2SExceptionResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetRuleS2SException is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ListCrossNamespacePolicies(context.Context, *connect.Request[netguard.ListCrossNamespacePoliciesReq]) (*connect.Response[netguard.ListCrossNamespacePoliciesResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListCrossNamespacePolicies is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) GetCrossNamespacePolicy(context.Context, *connect.Request[netguard.GetCrossNamespacePolicyReq]) (*connect.Response[netguard.GetCrossNamespacePolicyResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetCrossNamespacePolicy is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ListRuleTemplates(context.Context, *connect.Request[netguard.ListRuleTemplatesReq]) (*connect.Response[netguard.ListRuleTemplatesResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListRuleTemplates is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) GetRuleTemplate(context.Context, *connect.Request[netguard.GetRuleTemplateReq]) (*connect.Response[netguard.GetRuleTemplateResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetRuleTemplate is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) ListNamespacePostures(context.Context, *connect.Request[netguard.ListNamespacePosturesReq]) (*connect.Response[netguard.ListNamespacePosturesResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.ListNamespacePostures is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) GetNamespacePosture(context.Context, *connect.Request[netguard.GetNamespacePostureReq]) (*connect.Response[netguard.GetNamespacePostureResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.GetNamespacePosture is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) SimulateTraffic(context.Context, *connect.Request[netguard.SimulateTrafficReq]) (*connect.Response[netguard.SimulateTrafficResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.SimulateTraffic is not implemented"))
+}
+
+func (UnimplementedNetguardServiceHandler) Watch(context.Context, *connect.Request[netguard.WatchReq], *connect.ServerStream[netguard.WatchEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("netguard.v1.NetguardService.Watch is not implemented"))
+}